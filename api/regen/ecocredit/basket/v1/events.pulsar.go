@@ -1239,6 +1239,8 @@ var (
 	fd_EventTake_basket_denom protoreflect.FieldDescriptor
 	fd_EventTake_credits      protoreflect.FieldDescriptor
 	fd_EventTake_amount       protoreflect.FieldDescriptor
+	fd_EventTake_strategy     protoreflect.FieldDescriptor
+	fd_EventTake_premium      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1248,6 +1250,8 @@ func init() {
 	fd_EventTake_basket_denom = md_EventTake.Fields().ByName("basket_denom")
 	fd_EventTake_credits = md_EventTake.Fields().ByName("credits")
 	fd_EventTake_amount = md_EventTake.Fields().ByName("amount")
+	fd_EventTake_strategy = md_EventTake.Fields().ByName("strategy")
+	fd_EventTake_premium = md_EventTake.Fields().ByName("premium")
 }
 
 var _ protoreflect.Message = (*fastReflection_EventTake)(nil)
@@ -1339,6 +1343,18 @@ func (x *fastReflection_EventTake) Range(f func(protoreflect.FieldDescriptor, pr
 			return
 		}
 	}
+	if x.Strategy != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Strategy))
+		if !f(fd_EventTake_strategy, value) {
+			return
+		}
+	}
+	if x.Premium != "" {
+		value := protoreflect.ValueOfString(x.Premium)
+		if !f(fd_EventTake_premium, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1362,6 +1378,10 @@ func (x *fastReflection_EventTake) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.Credits) != 0
 	case "regen.ecocredit.basket.v1.EventTake.amount":
 		return x.Amount != ""
+	case "regen.ecocredit.basket.v1.EventTake.strategy":
+		return x.Strategy != 0
+	case "regen.ecocredit.basket.v1.EventTake.premium":
+		return x.Premium != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventTake"))
@@ -1386,6 +1406,10 @@ func (x *fastReflection_EventTake) Clear(fd protoreflect.FieldDescriptor) {
 		x.Credits = nil
 	case "regen.ecocredit.basket.v1.EventTake.amount":
 		x.Amount = ""
+	case "regen.ecocredit.basket.v1.EventTake.strategy":
+		x.Strategy = 0
+	case "regen.ecocredit.basket.v1.EventTake.premium":
+		x.Premium = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventTake"))
@@ -1417,6 +1441,12 @@ func (x *fastReflection_EventTake) Get(descriptor protoreflect.FieldDescriptor)
 	case "regen.ecocredit.basket.v1.EventTake.amount":
 		value := x.Amount
 		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.basket.v1.EventTake.strategy":
+		value := x.Strategy
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "regen.ecocredit.basket.v1.EventTake.premium":
+		value := x.Premium
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventTake"))
@@ -1447,6 +1477,10 @@ func (x *fastReflection_EventTake) Set(fd protoreflect.FieldDescriptor, value pr
 		x.Credits = *clv.list
 	case "regen.ecocredit.basket.v1.EventTake.amount":
 		x.Amount = value.Interface().(string)
+	case "regen.ecocredit.basket.v1.EventTake.strategy":
+		x.Strategy = (TakeStrategy)(value.Enum())
+	case "regen.ecocredit.basket.v1.EventTake.premium":
+		x.Premium = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventTake"))
@@ -1479,6 +1513,10 @@ func (x *fastReflection_EventTake) Mutable(fd protoreflect.FieldDescriptor) prot
 		panic(fmt.Errorf("field basket_denom of message regen.ecocredit.basket.v1.EventTake is not mutable"))
 	case "regen.ecocredit.basket.v1.EventTake.amount":
 		panic(fmt.Errorf("field amount of message regen.ecocredit.basket.v1.EventTake is not mutable"))
+	case "regen.ecocredit.basket.v1.EventTake.strategy":
+		panic(fmt.Errorf("field strategy of message regen.ecocredit.basket.v1.EventTake is not mutable"))
+	case "regen.ecocredit.basket.v1.EventTake.premium":
+		panic(fmt.Errorf("field premium of message regen.ecocredit.basket.v1.EventTake is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventTake"))
@@ -1501,6 +1539,10 @@ func (x *fastReflection_EventTake) NewField(fd protoreflect.FieldDescriptor) pro
 		return protoreflect.ValueOfList(&_EventTake_3_list{list: &list})
 	case "regen.ecocredit.basket.v1.EventTake.amount":
 		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.basket.v1.EventTake.strategy":
+		return protoreflect.ValueOfEnum(0)
+	case "regen.ecocredit.basket.v1.EventTake.premium":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventTake"))
@@ -1588,6 +1630,13 @@ func (x *fastReflection_EventTake) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Strategy != 0 {
+			n += 1 + runtime.Sov(uint64(x.Strategy))
+		}
+		l = len(x.Premium)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1617,6 +1666,18 @@ func (x *fastReflection_EventTake) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Premium) > 0 {
+			i -= len(x.Premium)
+			copy(dAtA[i:], x.Premium)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Premium)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.Strategy != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Strategy))
+			i--
+			dAtA[i] = 0x28
+		}
 		if len(x.Amount) > 0 {
 			i -= len(x.Amount)
 			copy(dAtA[i:], x.Amount)
@@ -1833,6 +1894,57 @@ func (x *fastReflection_EventTake) ProtoMethods() *protoiface.Methods {
 				}
 				x.Amount = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+				}
+				x.Strategy = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Strategy |= TakeStrategy(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Premium", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Premium = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1868,161 +1980,3619 @@ func (x *fastReflection_EventTake) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: regen/ecocredit/basket/v1/events.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_EventUpdateBasketCurator              protoreflect.MessageDescriptor
+	fd_EventUpdateBasketCurator_basket_denom protoreflect.FieldDescriptor
+	fd_EventUpdateBasketCurator_curator      protoreflect.FieldDescriptor
 )
 
-// EventCreate is an event emitted when a basket is created.
-type EventCreate struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_regen_ecocredit_basket_v1_events_proto_init()
+	md_EventUpdateBasketCurator = File_regen_ecocredit_basket_v1_events_proto.Messages().ByName("EventUpdateBasketCurator")
+	fd_EventUpdateBasketCurator_basket_denom = md_EventUpdateBasketCurator.Fields().ByName("basket_denom")
+	fd_EventUpdateBasketCurator_curator = md_EventUpdateBasketCurator.Fields().ByName("curator")
+}
 
-	// basket_denom is the basket bank denom.
-	BasketDenom string `protobuf:"bytes,1,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
-	// curator is the address of the basket curator who is able to change certain
-	// basket settings.
-	//
-	// Deprecated (Since Revision 1): This field is still populated and will be
-	// removed in the next version.
-	//
-	// Deprecated: Do not use.
-	Curator string `protobuf:"bytes,2,opt,name=curator,proto3" json:"curator,omitempty"`
+var _ protoreflect.Message = (*fastReflection_EventUpdateBasketCurator)(nil)
+
+type fastReflection_EventUpdateBasketCurator EventUpdateBasketCurator
+
+func (x *EventUpdateBasketCurator) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventUpdateBasketCurator)(x)
 }
 
-func (x *EventCreate) Reset() {
-	*x = EventCreate{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_basket_v1_events_proto_msgTypes[0]
+func (x *EventUpdateBasketCurator) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_basket_v1_events_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *EventCreate) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_EventUpdateBasketCurator_messageType fastReflection_EventUpdateBasketCurator_messageType
+var _ protoreflect.MessageType = fastReflection_EventUpdateBasketCurator_messageType{}
 
-func (*EventCreate) ProtoMessage() {}
+type fastReflection_EventUpdateBasketCurator_messageType struct{}
 
-// Deprecated: Use EventCreate.ProtoReflect.Descriptor instead.
-func (*EventCreate) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_basket_v1_events_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_EventUpdateBasketCurator_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventUpdateBasketCurator)(nil)
 }
-
-func (x *EventCreate) GetBasketDenom() string {
-	if x != nil {
-		return x.BasketDenom
-	}
-	return ""
+func (x fastReflection_EventUpdateBasketCurator_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateBasketCurator)
 }
-
-// Deprecated: Do not use.
-func (x *EventCreate) GetCurator() string {
-	if x != nil {
-		return x.Curator
-	}
-	return ""
+func (x fastReflection_EventUpdateBasketCurator_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateBasketCurator
 }
 
-// EventPut is an event emitted when credits are put into a basket in return for
-// basket tokens.
-type EventPut struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// owner is the owner of the credits put into the basket.
-	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	// basket_denom is the basket bank denom that the credits were added to.
-	BasketDenom string `protobuf:"bytes,2,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
-	// credits are the credits that were added to the basket.
-	//
-	// Deprecated (Since Revision 1): This field is still populated and will be
-	// removed in the next version.
-	//
-	// Deprecated: Do not use.
-	Credits []*BasketCredit `protobuf:"bytes,3,rep,name=credits,proto3" json:"credits,omitempty"`
-	// amount is the integer number of basket tokens converted from credits.
-	//
-	// Deprecated (Since Revision 1): This field is still populated and will be
-	// removed in the next version.
-	//
-	// Deprecated: Do not use.
-	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventUpdateBasketCurator) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateBasketCurator
 }
 
-func (x *EventPut) Reset() {
-	*x = EventPut{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_basket_v1_events_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventUpdateBasketCurator) Type() protoreflect.MessageType {
+	return _fastReflection_EventUpdateBasketCurator_messageType
 }
 
-func (x *EventPut) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventUpdateBasketCurator) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateBasketCurator)
 }
 
-func (*EventPut) ProtoMessage() {}
-
-// Deprecated: Use EventPut.ProtoReflect.Descriptor instead.
-func (*EventPut) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_basket_v1_events_proto_rawDescGZIP(), []int{1}
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventUpdateBasketCurator) Interface() protoreflect.ProtoMessage {
+	return (*EventUpdateBasketCurator)(x)
 }
 
-func (x *EventPut) GetOwner() string {
-	if x != nil {
-		return x.Owner
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventUpdateBasketCurator) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BasketDenom != "" {
+		value := protoreflect.ValueOfString(x.BasketDenom)
+		if !f(fd_EventUpdateBasketCurator_basket_denom, value) {
+			return
+		}
+	}
+	if x.Curator != "" {
+		value := protoreflect.ValueOfString(x.Curator)
+		if !f(fd_EventUpdateBasketCurator_curator, value) {
+			return
+		}
 	}
-	return ""
 }
 
-func (x *EventPut) GetBasketDenom() string {
-	if x != nil {
-		return x.BasketDenom
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventUpdateBasketCurator) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.EventUpdateBasketCurator.basket_denom":
+		return x.BasketDenom != ""
+	case "regen.ecocredit.basket.v1.EventUpdateBasketCurator.curator":
+		return x.Curator != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventUpdateBasketCurator"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventUpdateBasketCurator does not contain field %s", fd.FullName()))
 	}
-	return ""
 }
 
-// Deprecated: Do not use.
-func (x *EventPut) GetCredits() []*BasketCredit {
-	if x != nil {
-		return x.Credits
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateBasketCurator) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.EventUpdateBasketCurator.basket_denom":
+		x.BasketDenom = ""
+	case "regen.ecocredit.basket.v1.EventUpdateBasketCurator.curator":
+		x.Curator = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventUpdateBasketCurator"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventUpdateBasketCurator does not contain field %s", fd.FullName()))
 	}
-	return nil
 }
 
-// Deprecated: Do not use.
-func (x *EventPut) GetAmount() string {
-	if x != nil {
-		return x.Amount
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventUpdateBasketCurator) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.basket.v1.EventUpdateBasketCurator.basket_denom":
+		value := x.BasketDenom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.basket.v1.EventUpdateBasketCurator.curator":
+		value := x.Curator
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventUpdateBasketCurator"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventUpdateBasketCurator does not contain field %s", descriptor.FullName()))
 	}
-	return ""
 }
 
-// EventTake is an event emitted when credits are taken from a basket starting
-// from the oldest credits first.
-type EventTake struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// owner is the owner of the credits taken from the basket.
-	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	// basket_denom is the basket bank denom that credits were taken from.
-	BasketDenom string `protobuf:"bytes,2,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
-	// credits are the credits that were taken from the basket.
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateBasketCurator) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.EventUpdateBasketCurator.basket_denom":
+		x.BasketDenom = value.Interface().(string)
+	case "regen.ecocredit.basket.v1.EventUpdateBasketCurator.curator":
+		x.Curator = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventUpdateBasketCurator"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventUpdateBasketCurator does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateBasketCurator) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.EventUpdateBasketCurator.basket_denom":
+		panic(fmt.Errorf("field basket_denom of message regen.ecocredit.basket.v1.EventUpdateBasketCurator is not mutable"))
+	case "regen.ecocredit.basket.v1.EventUpdateBasketCurator.curator":
+		panic(fmt.Errorf("field curator of message regen.ecocredit.basket.v1.EventUpdateBasketCurator is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventUpdateBasketCurator"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventUpdateBasketCurator does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventUpdateBasketCurator) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.EventUpdateBasketCurator.basket_denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.basket.v1.EventUpdateBasketCurator.curator":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventUpdateBasketCurator"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventUpdateBasketCurator does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventUpdateBasketCurator) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.basket.v1.EventUpdateBasketCurator", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventUpdateBasketCurator) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateBasketCurator) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventUpdateBasketCurator) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventUpdateBasketCurator) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventUpdateBasketCurator)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.BasketDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Curator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventUpdateBasketCurator)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Curator) > 0 {
+			i -= len(x.Curator)
+			copy(dAtA[i:], x.Curator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Curator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.BasketDenom) > 0 {
+			i -= len(x.BasketDenom)
+			copy(dAtA[i:], x.BasketDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BasketDenom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventUpdateBasketCurator)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateBasketCurator: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateBasketCurator: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BasketDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Curator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EventAddBasketClasses_2_list)(nil)
+
+type _EventAddBasketClasses_2_list struct {
+	list *[]string
+}
+
+func (x *_EventAddBasketClasses_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventAddBasketClasses_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_EventAddBasketClasses_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventAddBasketClasses_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventAddBasketClasses_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EventAddBasketClasses at list field ClassIds as it is not of Message kind"))
+}
+
+func (x *_EventAddBasketClasses_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventAddBasketClasses_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_EventAddBasketClasses_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EventAddBasketClasses              protoreflect.MessageDescriptor
+	fd_EventAddBasketClasses_basket_denom protoreflect.FieldDescriptor
+	fd_EventAddBasketClasses_class_ids    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_basket_v1_events_proto_init()
+	md_EventAddBasketClasses = File_regen_ecocredit_basket_v1_events_proto.Messages().ByName("EventAddBasketClasses")
+	fd_EventAddBasketClasses_basket_denom = md_EventAddBasketClasses.Fields().ByName("basket_denom")
+	fd_EventAddBasketClasses_class_ids = md_EventAddBasketClasses.Fields().ByName("class_ids")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventAddBasketClasses)(nil)
+
+type fastReflection_EventAddBasketClasses EventAddBasketClasses
+
+func (x *EventAddBasketClasses) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventAddBasketClasses)(x)
+}
+
+func (x *EventAddBasketClasses) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_basket_v1_events_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventAddBasketClasses_messageType fastReflection_EventAddBasketClasses_messageType
+var _ protoreflect.MessageType = fastReflection_EventAddBasketClasses_messageType{}
+
+type fastReflection_EventAddBasketClasses_messageType struct{}
+
+func (x fastReflection_EventAddBasketClasses_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventAddBasketClasses)(nil)
+}
+func (x fastReflection_EventAddBasketClasses_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventAddBasketClasses)
+}
+func (x fastReflection_EventAddBasketClasses_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventAddBasketClasses
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventAddBasketClasses) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventAddBasketClasses
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventAddBasketClasses) Type() protoreflect.MessageType {
+	return _fastReflection_EventAddBasketClasses_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventAddBasketClasses) New() protoreflect.Message {
+	return new(fastReflection_EventAddBasketClasses)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventAddBasketClasses) Interface() protoreflect.ProtoMessage {
+	return (*EventAddBasketClasses)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventAddBasketClasses) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BasketDenom != "" {
+		value := protoreflect.ValueOfString(x.BasketDenom)
+		if !f(fd_EventAddBasketClasses_basket_denom, value) {
+			return
+		}
+	}
+	if len(x.ClassIds) != 0 {
+		value := protoreflect.ValueOfList(&_EventAddBasketClasses_2_list{list: &x.ClassIds})
+		if !f(fd_EventAddBasketClasses_class_ids, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventAddBasketClasses) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.EventAddBasketClasses.basket_denom":
+		return x.BasketDenom != ""
+	case "regen.ecocredit.basket.v1.EventAddBasketClasses.class_ids":
+		return len(x.ClassIds) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventAddBasketClasses"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventAddBasketClasses does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventAddBasketClasses) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.EventAddBasketClasses.basket_denom":
+		x.BasketDenom = ""
+	case "regen.ecocredit.basket.v1.EventAddBasketClasses.class_ids":
+		x.ClassIds = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventAddBasketClasses"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventAddBasketClasses does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventAddBasketClasses) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.basket.v1.EventAddBasketClasses.basket_denom":
+		value := x.BasketDenom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.basket.v1.EventAddBasketClasses.class_ids":
+		if len(x.ClassIds) == 0 {
+			return protoreflect.ValueOfList(&_EventAddBasketClasses_2_list{})
+		}
+		listValue := &_EventAddBasketClasses_2_list{list: &x.ClassIds}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventAddBasketClasses"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventAddBasketClasses does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventAddBasketClasses) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.EventAddBasketClasses.basket_denom":
+		x.BasketDenom = value.Interface().(string)
+	case "regen.ecocredit.basket.v1.EventAddBasketClasses.class_ids":
+		lv := value.List()
+		clv := lv.(*_EventAddBasketClasses_2_list)
+		x.ClassIds = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventAddBasketClasses"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventAddBasketClasses does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventAddBasketClasses) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.EventAddBasketClasses.class_ids":
+		if x.ClassIds == nil {
+			x.ClassIds = []string{}
+		}
+		value := &_EventAddBasketClasses_2_list{list: &x.ClassIds}
+		return protoreflect.ValueOfList(value)
+	case "regen.ecocredit.basket.v1.EventAddBasketClasses.basket_denom":
+		panic(fmt.Errorf("field basket_denom of message regen.ecocredit.basket.v1.EventAddBasketClasses is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventAddBasketClasses"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventAddBasketClasses does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventAddBasketClasses) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.EventAddBasketClasses.basket_denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.basket.v1.EventAddBasketClasses.class_ids":
+		list := []string{}
+		return protoreflect.ValueOfList(&_EventAddBasketClasses_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventAddBasketClasses"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventAddBasketClasses does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventAddBasketClasses) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.basket.v1.EventAddBasketClasses", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventAddBasketClasses) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventAddBasketClasses) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventAddBasketClasses) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventAddBasketClasses) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventAddBasketClasses)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.BasketDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ClassIds) > 0 {
+			for _, s := range x.ClassIds {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventAddBasketClasses)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ClassIds) > 0 {
+			for iNdEx := len(x.ClassIds) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ClassIds[iNdEx])
+				copy(dAtA[i:], x.ClassIds[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClassIds[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.BasketDenom) > 0 {
+			i -= len(x.BasketDenom)
+			copy(dAtA[i:], x.BasketDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BasketDenom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventAddBasketClasses)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventAddBasketClasses: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventAddBasketClasses: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BasketDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassIds", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClassIds = append(x.ClassIds, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EventRemoveBasketClasses_2_list)(nil)
+
+type _EventRemoveBasketClasses_2_list struct {
+	list *[]string
+}
+
+func (x *_EventRemoveBasketClasses_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventRemoveBasketClasses_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_EventRemoveBasketClasses_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventRemoveBasketClasses_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventRemoveBasketClasses_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EventRemoveBasketClasses at list field ClassIds as it is not of Message kind"))
+}
+
+func (x *_EventRemoveBasketClasses_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventRemoveBasketClasses_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_EventRemoveBasketClasses_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EventRemoveBasketClasses              protoreflect.MessageDescriptor
+	fd_EventRemoveBasketClasses_basket_denom protoreflect.FieldDescriptor
+	fd_EventRemoveBasketClasses_class_ids    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_basket_v1_events_proto_init()
+	md_EventRemoveBasketClasses = File_regen_ecocredit_basket_v1_events_proto.Messages().ByName("EventRemoveBasketClasses")
+	fd_EventRemoveBasketClasses_basket_denom = md_EventRemoveBasketClasses.Fields().ByName("basket_denom")
+	fd_EventRemoveBasketClasses_class_ids = md_EventRemoveBasketClasses.Fields().ByName("class_ids")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventRemoveBasketClasses)(nil)
+
+type fastReflection_EventRemoveBasketClasses EventRemoveBasketClasses
+
+func (x *EventRemoveBasketClasses) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventRemoveBasketClasses)(x)
+}
+
+func (x *EventRemoveBasketClasses) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_basket_v1_events_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventRemoveBasketClasses_messageType fastReflection_EventRemoveBasketClasses_messageType
+var _ protoreflect.MessageType = fastReflection_EventRemoveBasketClasses_messageType{}
+
+type fastReflection_EventRemoveBasketClasses_messageType struct{}
+
+func (x fastReflection_EventRemoveBasketClasses_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventRemoveBasketClasses)(nil)
+}
+func (x fastReflection_EventRemoveBasketClasses_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventRemoveBasketClasses)
+}
+func (x fastReflection_EventRemoveBasketClasses_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventRemoveBasketClasses
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventRemoveBasketClasses) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventRemoveBasketClasses
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventRemoveBasketClasses) Type() protoreflect.MessageType {
+	return _fastReflection_EventRemoveBasketClasses_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventRemoveBasketClasses) New() protoreflect.Message {
+	return new(fastReflection_EventRemoveBasketClasses)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventRemoveBasketClasses) Interface() protoreflect.ProtoMessage {
+	return (*EventRemoveBasketClasses)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventRemoveBasketClasses) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BasketDenom != "" {
+		value := protoreflect.ValueOfString(x.BasketDenom)
+		if !f(fd_EventRemoveBasketClasses_basket_denom, value) {
+			return
+		}
+	}
+	if len(x.ClassIds) != 0 {
+		value := protoreflect.ValueOfList(&_EventRemoveBasketClasses_2_list{list: &x.ClassIds})
+		if !f(fd_EventRemoveBasketClasses_class_ids, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventRemoveBasketClasses) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.EventRemoveBasketClasses.basket_denom":
+		return x.BasketDenom != ""
+	case "regen.ecocredit.basket.v1.EventRemoveBasketClasses.class_ids":
+		return len(x.ClassIds) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventRemoveBasketClasses"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventRemoveBasketClasses does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventRemoveBasketClasses) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.EventRemoveBasketClasses.basket_denom":
+		x.BasketDenom = ""
+	case "regen.ecocredit.basket.v1.EventRemoveBasketClasses.class_ids":
+		x.ClassIds = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventRemoveBasketClasses"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventRemoveBasketClasses does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventRemoveBasketClasses) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.basket.v1.EventRemoveBasketClasses.basket_denom":
+		value := x.BasketDenom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.basket.v1.EventRemoveBasketClasses.class_ids":
+		if len(x.ClassIds) == 0 {
+			return protoreflect.ValueOfList(&_EventRemoveBasketClasses_2_list{})
+		}
+		listValue := &_EventRemoveBasketClasses_2_list{list: &x.ClassIds}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventRemoveBasketClasses"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventRemoveBasketClasses does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventRemoveBasketClasses) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.EventRemoveBasketClasses.basket_denom":
+		x.BasketDenom = value.Interface().(string)
+	case "regen.ecocredit.basket.v1.EventRemoveBasketClasses.class_ids":
+		lv := value.List()
+		clv := lv.(*_EventRemoveBasketClasses_2_list)
+		x.ClassIds = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventRemoveBasketClasses"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventRemoveBasketClasses does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventRemoveBasketClasses) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.EventRemoveBasketClasses.class_ids":
+		if x.ClassIds == nil {
+			x.ClassIds = []string{}
+		}
+		value := &_EventRemoveBasketClasses_2_list{list: &x.ClassIds}
+		return protoreflect.ValueOfList(value)
+	case "regen.ecocredit.basket.v1.EventRemoveBasketClasses.basket_denom":
+		panic(fmt.Errorf("field basket_denom of message regen.ecocredit.basket.v1.EventRemoveBasketClasses is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventRemoveBasketClasses"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventRemoveBasketClasses does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventRemoveBasketClasses) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.EventRemoveBasketClasses.basket_denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.basket.v1.EventRemoveBasketClasses.class_ids":
+		list := []string{}
+		return protoreflect.ValueOfList(&_EventRemoveBasketClasses_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventRemoveBasketClasses"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventRemoveBasketClasses does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventRemoveBasketClasses) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.basket.v1.EventRemoveBasketClasses", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventRemoveBasketClasses) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventRemoveBasketClasses) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventRemoveBasketClasses) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventRemoveBasketClasses) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventRemoveBasketClasses)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.BasketDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ClassIds) > 0 {
+			for _, s := range x.ClassIds {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventRemoveBasketClasses)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ClassIds) > 0 {
+			for iNdEx := len(x.ClassIds) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ClassIds[iNdEx])
+				copy(dAtA[i:], x.ClassIds[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClassIds[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.BasketDenom) > 0 {
+			i -= len(x.BasketDenom)
+			copy(dAtA[i:], x.BasketDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BasketDenom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventRemoveBasketClasses)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventRemoveBasketClasses: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventRemoveBasketClasses: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BasketDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassIds", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClassIds = append(x.ClassIds, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventUpdateBasketDateCriteria               protoreflect.MessageDescriptor
+	fd_EventUpdateBasketDateCriteria_basket_denom  protoreflect.FieldDescriptor
+	fd_EventUpdateBasketDateCriteria_date_criteria protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_basket_v1_events_proto_init()
+	md_EventUpdateBasketDateCriteria = File_regen_ecocredit_basket_v1_events_proto.Messages().ByName("EventUpdateBasketDateCriteria")
+	fd_EventUpdateBasketDateCriteria_basket_denom = md_EventUpdateBasketDateCriteria.Fields().ByName("basket_denom")
+	fd_EventUpdateBasketDateCriteria_date_criteria = md_EventUpdateBasketDateCriteria.Fields().ByName("date_criteria")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventUpdateBasketDateCriteria)(nil)
+
+type fastReflection_EventUpdateBasketDateCriteria EventUpdateBasketDateCriteria
+
+func (x *EventUpdateBasketDateCriteria) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventUpdateBasketDateCriteria)(x)
+}
+
+func (x *EventUpdateBasketDateCriteria) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_basket_v1_events_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventUpdateBasketDateCriteria_messageType fastReflection_EventUpdateBasketDateCriteria_messageType
+var _ protoreflect.MessageType = fastReflection_EventUpdateBasketDateCriteria_messageType{}
+
+type fastReflection_EventUpdateBasketDateCriteria_messageType struct{}
+
+func (x fastReflection_EventUpdateBasketDateCriteria_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventUpdateBasketDateCriteria)(nil)
+}
+func (x fastReflection_EventUpdateBasketDateCriteria_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateBasketDateCriteria)
+}
+func (x fastReflection_EventUpdateBasketDateCriteria_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateBasketDateCriteria
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventUpdateBasketDateCriteria) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateBasketDateCriteria
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventUpdateBasketDateCriteria) Type() protoreflect.MessageType {
+	return _fastReflection_EventUpdateBasketDateCriteria_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventUpdateBasketDateCriteria) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateBasketDateCriteria)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventUpdateBasketDateCriteria) Interface() protoreflect.ProtoMessage {
+	return (*EventUpdateBasketDateCriteria)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventUpdateBasketDateCriteria) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BasketDenom != "" {
+		value := protoreflect.ValueOfString(x.BasketDenom)
+		if !f(fd_EventUpdateBasketDateCriteria_basket_denom, value) {
+			return
+		}
+	}
+	if x.DateCriteria != nil {
+		value := protoreflect.ValueOfMessage(x.DateCriteria.ProtoReflect())
+		if !f(fd_EventUpdateBasketDateCriteria_date_criteria, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventUpdateBasketDateCriteria) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.EventUpdateBasketDateCriteria.basket_denom":
+		return x.BasketDenom != ""
+	case "regen.ecocredit.basket.v1.EventUpdateBasketDateCriteria.date_criteria":
+		return x.DateCriteria != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventUpdateBasketDateCriteria"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventUpdateBasketDateCriteria does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateBasketDateCriteria) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.EventUpdateBasketDateCriteria.basket_denom":
+		x.BasketDenom = ""
+	case "regen.ecocredit.basket.v1.EventUpdateBasketDateCriteria.date_criteria":
+		x.DateCriteria = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventUpdateBasketDateCriteria"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventUpdateBasketDateCriteria does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventUpdateBasketDateCriteria) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.basket.v1.EventUpdateBasketDateCriteria.basket_denom":
+		value := x.BasketDenom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.basket.v1.EventUpdateBasketDateCriteria.date_criteria":
+		value := x.DateCriteria
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventUpdateBasketDateCriteria"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventUpdateBasketDateCriteria does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateBasketDateCriteria) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.EventUpdateBasketDateCriteria.basket_denom":
+		x.BasketDenom = value.Interface().(string)
+	case "regen.ecocredit.basket.v1.EventUpdateBasketDateCriteria.date_criteria":
+		x.DateCriteria = value.Message().Interface().(*DateCriteria)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventUpdateBasketDateCriteria"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventUpdateBasketDateCriteria does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateBasketDateCriteria) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.EventUpdateBasketDateCriteria.date_criteria":
+		if x.DateCriteria == nil {
+			x.DateCriteria = new(DateCriteria)
+		}
+		return protoreflect.ValueOfMessage(x.DateCriteria.ProtoReflect())
+	case "regen.ecocredit.basket.v1.EventUpdateBasketDateCriteria.basket_denom":
+		panic(fmt.Errorf("field basket_denom of message regen.ecocredit.basket.v1.EventUpdateBasketDateCriteria is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventUpdateBasketDateCriteria"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventUpdateBasketDateCriteria does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventUpdateBasketDateCriteria) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.EventUpdateBasketDateCriteria.basket_denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.basket.v1.EventUpdateBasketDateCriteria.date_criteria":
+		m := new(DateCriteria)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventUpdateBasketDateCriteria"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventUpdateBasketDateCriteria does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventUpdateBasketDateCriteria) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.basket.v1.EventUpdateBasketDateCriteria", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventUpdateBasketDateCriteria) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateBasketDateCriteria) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventUpdateBasketDateCriteria) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventUpdateBasketDateCriteria) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventUpdateBasketDateCriteria)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.BasketDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DateCriteria != nil {
+			l = options.Size(x.DateCriteria)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventUpdateBasketDateCriteria)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.DateCriteria != nil {
+			encoded, err := options.Marshal(x.DateCriteria)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.BasketDenom) > 0 {
+			i -= len(x.BasketDenom)
+			copy(dAtA[i:], x.BasketDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BasketDenom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventUpdateBasketDateCriteria)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateBasketDateCriteria: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateBasketDateCriteria: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BasketDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DateCriteria", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DateCriteria == nil {
+					x.DateCriteria = &DateCriteria{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DateCriteria); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventUpdateBasketDescription              protoreflect.MessageDescriptor
+	fd_EventUpdateBasketDescription_basket_denom protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_basket_v1_events_proto_init()
+	md_EventUpdateBasketDescription = File_regen_ecocredit_basket_v1_events_proto.Messages().ByName("EventUpdateBasketDescription")
+	fd_EventUpdateBasketDescription_basket_denom = md_EventUpdateBasketDescription.Fields().ByName("basket_denom")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventUpdateBasketDescription)(nil)
+
+type fastReflection_EventUpdateBasketDescription EventUpdateBasketDescription
+
+func (x *EventUpdateBasketDescription) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventUpdateBasketDescription)(x)
+}
+
+func (x *EventUpdateBasketDescription) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_basket_v1_events_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventUpdateBasketDescription_messageType fastReflection_EventUpdateBasketDescription_messageType
+var _ protoreflect.MessageType = fastReflection_EventUpdateBasketDescription_messageType{}
+
+type fastReflection_EventUpdateBasketDescription_messageType struct{}
+
+func (x fastReflection_EventUpdateBasketDescription_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventUpdateBasketDescription)(nil)
+}
+func (x fastReflection_EventUpdateBasketDescription_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateBasketDescription)
+}
+func (x fastReflection_EventUpdateBasketDescription_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateBasketDescription
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventUpdateBasketDescription) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateBasketDescription
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventUpdateBasketDescription) Type() protoreflect.MessageType {
+	return _fastReflection_EventUpdateBasketDescription_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventUpdateBasketDescription) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateBasketDescription)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventUpdateBasketDescription) Interface() protoreflect.ProtoMessage {
+	return (*EventUpdateBasketDescription)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventUpdateBasketDescription) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BasketDenom != "" {
+		value := protoreflect.ValueOfString(x.BasketDenom)
+		if !f(fd_EventUpdateBasketDescription_basket_denom, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventUpdateBasketDescription) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.EventUpdateBasketDescription.basket_denom":
+		return x.BasketDenom != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventUpdateBasketDescription"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventUpdateBasketDescription does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateBasketDescription) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.EventUpdateBasketDescription.basket_denom":
+		x.BasketDenom = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventUpdateBasketDescription"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventUpdateBasketDescription does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventUpdateBasketDescription) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.basket.v1.EventUpdateBasketDescription.basket_denom":
+		value := x.BasketDenom
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventUpdateBasketDescription"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventUpdateBasketDescription does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateBasketDescription) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.EventUpdateBasketDescription.basket_denom":
+		x.BasketDenom = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventUpdateBasketDescription"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventUpdateBasketDescription does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateBasketDescription) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.EventUpdateBasketDescription.basket_denom":
+		panic(fmt.Errorf("field basket_denom of message regen.ecocredit.basket.v1.EventUpdateBasketDescription is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventUpdateBasketDescription"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventUpdateBasketDescription does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventUpdateBasketDescription) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.EventUpdateBasketDescription.basket_denom":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventUpdateBasketDescription"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventUpdateBasketDescription does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventUpdateBasketDescription) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.basket.v1.EventUpdateBasketDescription", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventUpdateBasketDescription) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateBasketDescription) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventUpdateBasketDescription) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventUpdateBasketDescription) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventUpdateBasketDescription)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.BasketDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventUpdateBasketDescription)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.BasketDenom) > 0 {
+			i -= len(x.BasketDenom)
+			copy(dAtA[i:], x.BasketDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BasketDenom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventUpdateBasketDescription)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateBasketDescription: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateBasketDescription: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BasketDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventUpdateBasketTakePremium                  protoreflect.MessageDescriptor
+	fd_EventUpdateBasketTakePremium_basket_denom     protoreflect.FieldDescriptor
+	fd_EventUpdateBasketTakePremium_take_premium_bps protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_basket_v1_events_proto_init()
+	md_EventUpdateBasketTakePremium = File_regen_ecocredit_basket_v1_events_proto.Messages().ByName("EventUpdateBasketTakePremium")
+	fd_EventUpdateBasketTakePremium_basket_denom = md_EventUpdateBasketTakePremium.Fields().ByName("basket_denom")
+	fd_EventUpdateBasketTakePremium_take_premium_bps = md_EventUpdateBasketTakePremium.Fields().ByName("take_premium_bps")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventUpdateBasketTakePremium)(nil)
+
+type fastReflection_EventUpdateBasketTakePremium EventUpdateBasketTakePremium
+
+func (x *EventUpdateBasketTakePremium) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventUpdateBasketTakePremium)(x)
+}
+
+func (x *EventUpdateBasketTakePremium) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_basket_v1_events_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventUpdateBasketTakePremium_messageType fastReflection_EventUpdateBasketTakePremium_messageType
+var _ protoreflect.MessageType = fastReflection_EventUpdateBasketTakePremium_messageType{}
+
+type fastReflection_EventUpdateBasketTakePremium_messageType struct{}
+
+func (x fastReflection_EventUpdateBasketTakePremium_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventUpdateBasketTakePremium)(nil)
+}
+func (x fastReflection_EventUpdateBasketTakePremium_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateBasketTakePremium)
+}
+func (x fastReflection_EventUpdateBasketTakePremium_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateBasketTakePremium
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventUpdateBasketTakePremium) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateBasketTakePremium
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventUpdateBasketTakePremium) Type() protoreflect.MessageType {
+	return _fastReflection_EventUpdateBasketTakePremium_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventUpdateBasketTakePremium) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateBasketTakePremium)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventUpdateBasketTakePremium) Interface() protoreflect.ProtoMessage {
+	return (*EventUpdateBasketTakePremium)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventUpdateBasketTakePremium) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BasketDenom != "" {
+		value := protoreflect.ValueOfString(x.BasketDenom)
+		if !f(fd_EventUpdateBasketTakePremium_basket_denom, value) {
+			return
+		}
+	}
+	if x.TakePremiumBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.TakePremiumBps)
+		if !f(fd_EventUpdateBasketTakePremium_take_premium_bps, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventUpdateBasketTakePremium) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.EventUpdateBasketTakePremium.basket_denom":
+		return x.BasketDenom != ""
+	case "regen.ecocredit.basket.v1.EventUpdateBasketTakePremium.take_premium_bps":
+		return x.TakePremiumBps != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventUpdateBasketTakePremium"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventUpdateBasketTakePremium does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateBasketTakePremium) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.EventUpdateBasketTakePremium.basket_denom":
+		x.BasketDenom = ""
+	case "regen.ecocredit.basket.v1.EventUpdateBasketTakePremium.take_premium_bps":
+		x.TakePremiumBps = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventUpdateBasketTakePremium"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventUpdateBasketTakePremium does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventUpdateBasketTakePremium) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.basket.v1.EventUpdateBasketTakePremium.basket_denom":
+		value := x.BasketDenom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.basket.v1.EventUpdateBasketTakePremium.take_premium_bps":
+		value := x.TakePremiumBps
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventUpdateBasketTakePremium"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventUpdateBasketTakePremium does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateBasketTakePremium) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.EventUpdateBasketTakePremium.basket_denom":
+		x.BasketDenom = value.Interface().(string)
+	case "regen.ecocredit.basket.v1.EventUpdateBasketTakePremium.take_premium_bps":
+		x.TakePremiumBps = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventUpdateBasketTakePremium"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventUpdateBasketTakePremium does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateBasketTakePremium) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.EventUpdateBasketTakePremium.basket_denom":
+		panic(fmt.Errorf("field basket_denom of message regen.ecocredit.basket.v1.EventUpdateBasketTakePremium is not mutable"))
+	case "regen.ecocredit.basket.v1.EventUpdateBasketTakePremium.take_premium_bps":
+		panic(fmt.Errorf("field take_premium_bps of message regen.ecocredit.basket.v1.EventUpdateBasketTakePremium is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventUpdateBasketTakePremium"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventUpdateBasketTakePremium does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventUpdateBasketTakePremium) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.EventUpdateBasketTakePremium.basket_denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.basket.v1.EventUpdateBasketTakePremium.take_premium_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventUpdateBasketTakePremium"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventUpdateBasketTakePremium does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventUpdateBasketTakePremium) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.basket.v1.EventUpdateBasketTakePremium", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventUpdateBasketTakePremium) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateBasketTakePremium) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventUpdateBasketTakePremium) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventUpdateBasketTakePremium) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventUpdateBasketTakePremium)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.BasketDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TakePremiumBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.TakePremiumBps))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventUpdateBasketTakePremium)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.TakePremiumBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TakePremiumBps))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.BasketDenom) > 0 {
+			i -= len(x.BasketDenom)
+			copy(dAtA[i:], x.BasketDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BasketDenom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventUpdateBasketTakePremium)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateBasketTakePremium: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateBasketTakePremium: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BasketDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TakePremiumBps", wireType)
+				}
+				x.TakePremiumBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TakePremiumBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventUpdateBasketAdmissionCriteria                    protoreflect.MessageDescriptor
+	fd_EventUpdateBasketAdmissionCriteria_basket_denom       protoreflect.FieldDescriptor
+	fd_EventUpdateBasketAdmissionCriteria_admission_criteria protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_basket_v1_events_proto_init()
+	md_EventUpdateBasketAdmissionCriteria = File_regen_ecocredit_basket_v1_events_proto.Messages().ByName("EventUpdateBasketAdmissionCriteria")
+	fd_EventUpdateBasketAdmissionCriteria_basket_denom = md_EventUpdateBasketAdmissionCriteria.Fields().ByName("basket_denom")
+	fd_EventUpdateBasketAdmissionCriteria_admission_criteria = md_EventUpdateBasketAdmissionCriteria.Fields().ByName("admission_criteria")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventUpdateBasketAdmissionCriteria)(nil)
+
+type fastReflection_EventUpdateBasketAdmissionCriteria EventUpdateBasketAdmissionCriteria
+
+func (x *EventUpdateBasketAdmissionCriteria) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventUpdateBasketAdmissionCriteria)(x)
+}
+
+func (x *EventUpdateBasketAdmissionCriteria) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_basket_v1_events_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventUpdateBasketAdmissionCriteria_messageType fastReflection_EventUpdateBasketAdmissionCriteria_messageType
+var _ protoreflect.MessageType = fastReflection_EventUpdateBasketAdmissionCriteria_messageType{}
+
+type fastReflection_EventUpdateBasketAdmissionCriteria_messageType struct{}
+
+func (x fastReflection_EventUpdateBasketAdmissionCriteria_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventUpdateBasketAdmissionCriteria)(nil)
+}
+func (x fastReflection_EventUpdateBasketAdmissionCriteria_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateBasketAdmissionCriteria)
+}
+func (x fastReflection_EventUpdateBasketAdmissionCriteria_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateBasketAdmissionCriteria
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventUpdateBasketAdmissionCriteria) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateBasketAdmissionCriteria
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventUpdateBasketAdmissionCriteria) Type() protoreflect.MessageType {
+	return _fastReflection_EventUpdateBasketAdmissionCriteria_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventUpdateBasketAdmissionCriteria) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateBasketAdmissionCriteria)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventUpdateBasketAdmissionCriteria) Interface() protoreflect.ProtoMessage {
+	return (*EventUpdateBasketAdmissionCriteria)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventUpdateBasketAdmissionCriteria) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BasketDenom != "" {
+		value := protoreflect.ValueOfString(x.BasketDenom)
+		if !f(fd_EventUpdateBasketAdmissionCriteria_basket_denom, value) {
+			return
+		}
+	}
+	if x.AdmissionCriteria != nil {
+		value := protoreflect.ValueOfMessage(x.AdmissionCriteria.ProtoReflect())
+		if !f(fd_EventUpdateBasketAdmissionCriteria_admission_criteria, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventUpdateBasketAdmissionCriteria) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.EventUpdateBasketAdmissionCriteria.basket_denom":
+		return x.BasketDenom != ""
+	case "regen.ecocredit.basket.v1.EventUpdateBasketAdmissionCriteria.admission_criteria":
+		return x.AdmissionCriteria != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventUpdateBasketAdmissionCriteria"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventUpdateBasketAdmissionCriteria does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateBasketAdmissionCriteria) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.EventUpdateBasketAdmissionCriteria.basket_denom":
+		x.BasketDenom = ""
+	case "regen.ecocredit.basket.v1.EventUpdateBasketAdmissionCriteria.admission_criteria":
+		x.AdmissionCriteria = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventUpdateBasketAdmissionCriteria"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventUpdateBasketAdmissionCriteria does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventUpdateBasketAdmissionCriteria) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.basket.v1.EventUpdateBasketAdmissionCriteria.basket_denom":
+		value := x.BasketDenom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.basket.v1.EventUpdateBasketAdmissionCriteria.admission_criteria":
+		value := x.AdmissionCriteria
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventUpdateBasketAdmissionCriteria"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventUpdateBasketAdmissionCriteria does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateBasketAdmissionCriteria) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.EventUpdateBasketAdmissionCriteria.basket_denom":
+		x.BasketDenom = value.Interface().(string)
+	case "regen.ecocredit.basket.v1.EventUpdateBasketAdmissionCriteria.admission_criteria":
+		x.AdmissionCriteria = value.Message().Interface().(*AdmissionCriteria)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventUpdateBasketAdmissionCriteria"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventUpdateBasketAdmissionCriteria does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateBasketAdmissionCriteria) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.EventUpdateBasketAdmissionCriteria.admission_criteria":
+		if x.AdmissionCriteria == nil {
+			x.AdmissionCriteria = new(AdmissionCriteria)
+		}
+		return protoreflect.ValueOfMessage(x.AdmissionCriteria.ProtoReflect())
+	case "regen.ecocredit.basket.v1.EventUpdateBasketAdmissionCriteria.basket_denom":
+		panic(fmt.Errorf("field basket_denom of message regen.ecocredit.basket.v1.EventUpdateBasketAdmissionCriteria is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventUpdateBasketAdmissionCriteria"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventUpdateBasketAdmissionCriteria does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventUpdateBasketAdmissionCriteria) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.EventUpdateBasketAdmissionCriteria.basket_denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.basket.v1.EventUpdateBasketAdmissionCriteria.admission_criteria":
+		m := new(AdmissionCriteria)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.EventUpdateBasketAdmissionCriteria"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.EventUpdateBasketAdmissionCriteria does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventUpdateBasketAdmissionCriteria) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.basket.v1.EventUpdateBasketAdmissionCriteria", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventUpdateBasketAdmissionCriteria) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateBasketAdmissionCriteria) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventUpdateBasketAdmissionCriteria) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventUpdateBasketAdmissionCriteria) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventUpdateBasketAdmissionCriteria)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.BasketDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AdmissionCriteria != nil {
+			l = options.Size(x.AdmissionCriteria)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventUpdateBasketAdmissionCriteria)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.AdmissionCriteria != nil {
+			encoded, err := options.Marshal(x.AdmissionCriteria)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.BasketDenom) > 0 {
+			i -= len(x.BasketDenom)
+			copy(dAtA[i:], x.BasketDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BasketDenom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventUpdateBasketAdmissionCriteria)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateBasketAdmissionCriteria: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateBasketAdmissionCriteria: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BasketDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AdmissionCriteria", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.AdmissionCriteria == nil {
+					x.AdmissionCriteria = &AdmissionCriteria{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AdmissionCriteria); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: regen/ecocredit/basket/v1/events.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EventCreate is an event emitted when a basket is created.
+type EventCreate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// basket_denom is the basket bank denom.
+	BasketDenom string `protobuf:"bytes,1,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// curator is the address of the basket curator who is able to change certain
+	// basket settings.
+	//
+	// Deprecated (Since Revision 1): This field is still populated and will be
+	// removed in the next version.
+	//
+	// Deprecated: Do not use.
+	Curator string `protobuf:"bytes,2,opt,name=curator,proto3" json:"curator,omitempty"`
+}
+
+func (x *EventCreate) Reset() {
+	*x = EventCreate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_basket_v1_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventCreate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventCreate) ProtoMessage() {}
+
+// Deprecated: Use EventCreate.ProtoReflect.Descriptor instead.
+func (*EventCreate) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_basket_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EventCreate) GetBasketDenom() string {
+	if x != nil {
+		return x.BasketDenom
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (x *EventCreate) GetCurator() string {
+	if x != nil {
+		return x.Curator
+	}
+	return ""
+}
+
+// EventPut is an event emitted when credits are put into a basket in return for
+// basket tokens.
+type EventPut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// owner is the owner of the credits put into the basket.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// basket_denom is the basket bank denom that the credits were added to.
+	BasketDenom string `protobuf:"bytes,2,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// credits are the credits that were added to the basket.
+	//
+	// Deprecated (Since Revision 1): This field is still populated and will be
+	// removed in the next version.
+	//
+	// Deprecated: Do not use.
+	Credits []*BasketCredit `protobuf:"bytes,3,rep,name=credits,proto3" json:"credits,omitempty"`
+	// amount is the integer number of basket tokens converted from credits.
+	//
+	// Deprecated (Since Revision 1): This field is still populated and will be
+	// removed in the next version.
+	//
+	// Deprecated: Do not use.
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *EventPut) Reset() {
+	*x = EventPut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_basket_v1_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventPut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventPut) ProtoMessage() {}
+
+// Deprecated: Use EventPut.ProtoReflect.Descriptor instead.
+func (*EventPut) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_basket_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EventPut) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *EventPut) GetBasketDenom() string {
+	if x != nil {
+		return x.BasketDenom
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (x *EventPut) GetCredits() []*BasketCredit {
+	if x != nil {
+		return x.Credits
+	}
+	return nil
+}
+
+// Deprecated: Do not use.
+func (x *EventPut) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+// EventTake is an event emitted when credits are taken from a basket starting
+// from the oldest credits first.
+type EventTake struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// owner is the owner of the credits taken from the basket.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// basket_denom is the basket bank denom that credits were taken from.
+	BasketDenom string `protobuf:"bytes,2,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// credits are the credits that were taken from the basket.
 	//
 	// Deprecated (Since Revision 1): This field is still populated and will be
 	// removed in the next version.
@@ -2036,58 +5606,397 @@ type EventTake struct {
 	//
 	// Deprecated: Do not use.
 	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// strategy is the strategy used to select the credits taken from the basket.
+	Strategy TakeStrategy `protobuf:"varint,5,opt,name=strategy,proto3,enum=regen.ecocredit.basket.v1.TakeStrategy" json:"strategy,omitempty"`
+	// premium is the integer number of basket tokens paid to the basket curator
+	// as the take premium. Empty if no premium was charged.
+	Premium string `protobuf:"bytes,6,opt,name=premium,proto3" json:"premium,omitempty"`
+}
+
+func (x *EventTake) Reset() {
+	*x = EventTake{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_basket_v1_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventTake) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventTake) ProtoMessage() {}
+
+// Deprecated: Use EventTake.ProtoReflect.Descriptor instead.
+func (*EventTake) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_basket_v1_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventTake) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *EventTake) GetBasketDenom() string {
+	if x != nil {
+		return x.BasketDenom
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (x *EventTake) GetCredits() []*BasketCredit {
+	if x != nil {
+		return x.Credits
+	}
+	return nil
+}
+
+// Deprecated: Do not use.
+func (x *EventTake) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *EventTake) GetStrategy() TakeStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return TakeStrategy_TAKE_STRATEGY_UNSPECIFIED
+}
+
+func (x *EventTake) GetPremium() string {
+	if x != nil {
+		return x.Premium
+	}
+	return ""
+}
+
+// EventUpdateBasketCurator is an event emitted when the curator of a basket is
+// updated.
+type EventUpdateBasketCurator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// basket_denom is the basket bank denom of the updated basket.
+	BasketDenom string `protobuf:"bytes,1,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// curator is the address of the new basket curator.
+	Curator string `protobuf:"bytes,2,opt,name=curator,proto3" json:"curator,omitempty"`
 }
 
-func (x *EventTake) Reset() {
-	*x = EventTake{}
+func (x *EventUpdateBasketCurator) Reset() {
+	*x = EventUpdateBasketCurator{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_basket_v1_events_proto_msgTypes[2]
+		mi := &file_regen_ecocredit_basket_v1_events_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventTake) String() string {
+func (x *EventUpdateBasketCurator) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventTake) ProtoMessage() {}
+func (*EventUpdateBasketCurator) ProtoMessage() {}
 
-// Deprecated: Use EventTake.ProtoReflect.Descriptor instead.
-func (*EventTake) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_basket_v1_events_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use EventUpdateBasketCurator.ProtoReflect.Descriptor instead.
+func (*EventUpdateBasketCurator) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_basket_v1_events_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *EventTake) GetOwner() string {
+func (x *EventUpdateBasketCurator) GetBasketDenom() string {
 	if x != nil {
-		return x.Owner
+		return x.BasketDenom
 	}
 	return ""
 }
 
-func (x *EventTake) GetBasketDenom() string {
+func (x *EventUpdateBasketCurator) GetCurator() string {
+	if x != nil {
+		return x.Curator
+	}
+	return ""
+}
+
+// EventAddBasketClasses is an event emitted when credit classes are added to
+// the allowed classes of a basket.
+type EventAddBasketClasses struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// basket_denom is the basket bank denom of the updated basket.
+	BasketDenom string `protobuf:"bytes,1,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// class_ids are the credit classes that were added to the basket.
+	ClassIds []string `protobuf:"bytes,2,rep,name=class_ids,json=classIds,proto3" json:"class_ids,omitempty"`
+}
+
+func (x *EventAddBasketClasses) Reset() {
+	*x = EventAddBasketClasses{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_basket_v1_events_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventAddBasketClasses) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventAddBasketClasses) ProtoMessage() {}
+
+// Deprecated: Use EventAddBasketClasses.ProtoReflect.Descriptor instead.
+func (*EventAddBasketClasses) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_basket_v1_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EventAddBasketClasses) GetBasketDenom() string {
 	if x != nil {
 		return x.BasketDenom
 	}
 	return ""
 }
 
-// Deprecated: Do not use.
-func (x *EventTake) GetCredits() []*BasketCredit {
+func (x *EventAddBasketClasses) GetClassIds() []string {
 	if x != nil {
-		return x.Credits
+		return x.ClassIds
 	}
 	return nil
 }
 
-// Deprecated: Do not use.
-func (x *EventTake) GetAmount() string {
+// EventRemoveBasketClasses is an event emitted when credit classes are removed
+// from the allowed classes of a basket.
+type EventRemoveBasketClasses struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// basket_denom is the basket bank denom of the updated basket.
+	BasketDenom string `protobuf:"bytes,1,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// class_ids are the credit classes that were removed from the basket.
+	ClassIds []string `protobuf:"bytes,2,rep,name=class_ids,json=classIds,proto3" json:"class_ids,omitempty"`
+}
+
+func (x *EventRemoveBasketClasses) Reset() {
+	*x = EventRemoveBasketClasses{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_basket_v1_events_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventRemoveBasketClasses) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventRemoveBasketClasses) ProtoMessage() {}
+
+// Deprecated: Use EventRemoveBasketClasses.ProtoReflect.Descriptor instead.
+func (*EventRemoveBasketClasses) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_basket_v1_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EventRemoveBasketClasses) GetBasketDenom() string {
 	if x != nil {
-		return x.Amount
+		return x.BasketDenom
+	}
+	return ""
+}
+
+func (x *EventRemoveBasketClasses) GetClassIds() []string {
+	if x != nil {
+		return x.ClassIds
+	}
+	return nil
+}
+
+// EventUpdateBasketDateCriteria is an event emitted when the date criteria of
+// a basket is updated.
+type EventUpdateBasketDateCriteria struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// basket_denom is the basket bank denom of the updated basket.
+	BasketDenom string `protobuf:"bytes,1,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// date_criteria is the new date criteria of the basket.
+	DateCriteria *DateCriteria `protobuf:"bytes,2,opt,name=date_criteria,json=dateCriteria,proto3" json:"date_criteria,omitempty"`
+}
+
+func (x *EventUpdateBasketDateCriteria) Reset() {
+	*x = EventUpdateBasketDateCriteria{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_basket_v1_events_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventUpdateBasketDateCriteria) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventUpdateBasketDateCriteria) ProtoMessage() {}
+
+// Deprecated: Use EventUpdateBasketDateCriteria.ProtoReflect.Descriptor instead.
+func (*EventUpdateBasketDateCriteria) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_basket_v1_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EventUpdateBasketDateCriteria) GetBasketDenom() string {
+	if x != nil {
+		return x.BasketDenom
+	}
+	return ""
+}
+
+func (x *EventUpdateBasketDateCriteria) GetDateCriteria() *DateCriteria {
+	if x != nil {
+		return x.DateCriteria
+	}
+	return nil
+}
+
+// EventUpdateBasketDescription is an event emitted when the description of a
+// basket is updated.
+type EventUpdateBasketDescription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// basket_denom is the basket bank denom of the updated basket.
+	BasketDenom string `protobuf:"bytes,1,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+}
+
+func (x *EventUpdateBasketDescription) Reset() {
+	*x = EventUpdateBasketDescription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_basket_v1_events_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventUpdateBasketDescription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventUpdateBasketDescription) ProtoMessage() {}
+
+// Deprecated: Use EventUpdateBasketDescription.ProtoReflect.Descriptor instead.
+func (*EventUpdateBasketDescription) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_basket_v1_events_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EventUpdateBasketDescription) GetBasketDenom() string {
+	if x != nil {
+		return x.BasketDenom
+	}
+	return ""
+}
+
+// EventUpdateBasketTakePremium is an event emitted when the take premium of a
+// basket is updated.
+type EventUpdateBasketTakePremium struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// basket_denom is the basket bank denom of the updated basket.
+	BasketDenom string `protobuf:"bytes,1,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// take_premium_bps is the new take premium of the basket in basis points.
+	TakePremiumBps uint32 `protobuf:"varint,2,opt,name=take_premium_bps,json=takePremiumBps,proto3" json:"take_premium_bps,omitempty"`
+}
+
+func (x *EventUpdateBasketTakePremium) Reset() {
+	*x = EventUpdateBasketTakePremium{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_basket_v1_events_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventUpdateBasketTakePremium) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventUpdateBasketTakePremium) ProtoMessage() {}
+
+// Deprecated: Use EventUpdateBasketTakePremium.ProtoReflect.Descriptor instead.
+func (*EventUpdateBasketTakePremium) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_basket_v1_events_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EventUpdateBasketTakePremium) GetBasketDenom() string {
+	if x != nil {
+		return x.BasketDenom
+	}
+	return ""
+}
+
+func (x *EventUpdateBasketTakePremium) GetTakePremiumBps() uint32 {
+	if x != nil {
+		return x.TakePremiumBps
+	}
+	return 0
+}
+
+// EventUpdateBasketAdmissionCriteria is an event emitted when the admission
+// criteria of a basket is updated.
+type EventUpdateBasketAdmissionCriteria struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// basket_denom is the basket bank denom of the updated basket.
+	BasketDenom string `protobuf:"bytes,1,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// admission_criteria is the new admission criteria of the basket.
+	AdmissionCriteria *AdmissionCriteria `protobuf:"bytes,2,opt,name=admission_criteria,json=admissionCriteria,proto3" json:"admission_criteria,omitempty"`
+}
+
+func (x *EventUpdateBasketAdmissionCriteria) Reset() {
+	*x = EventUpdateBasketAdmissionCriteria{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_basket_v1_events_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventUpdateBasketAdmissionCriteria) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventUpdateBasketAdmissionCriteria) ProtoMessage() {}
+
+// Deprecated: Use EventUpdateBasketAdmissionCriteria.ProtoReflect.Descriptor instead.
+func (*EventUpdateBasketAdmissionCriteria) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_basket_v1_events_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EventUpdateBasketAdmissionCriteria) GetBasketDenom() string {
+	if x != nil {
+		return x.BasketDenom
 	}
 	return ""
 }
 
+func (x *EventUpdateBasketAdmissionCriteria) GetAdmissionCriteria() *AdmissionCriteria {
+	if x != nil {
+		return x.AdmissionCriteria
+	}
+	return nil
+}
+
 var File_regen_ecocredit_basket_v1_events_proto protoreflect.FileDescriptor
 
 var file_regen_ecocredit_basket_v1_events_proto_rawDesc = []byte{
@@ -2113,7 +6022,7 @@ var file_regen_ecocredit_basket_v1_events_proto_rawDesc = []byte{
 	0x73, 0x6b, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x42, 0x02, 0x18, 0x01, 0x52, 0x07,
 	0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
 	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x22, 0xa7, 0x01, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x6b,
+	0x75, 0x6e, 0x74, 0x22, 0x86, 0x02, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x6b,
 	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x6b, 0x65,
 	0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62,
@@ -2123,24 +6032,77 @@ var file_regen_ecocredit_basket_v1_events_proto_rawDesc = []byte{
 	0x73, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x43, 0x72,
 	0x65, 0x64, 0x69, 0x74, 0x42, 0x02, 0x18, 0x01, 0x52, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
 	0x73, 0x12, 0x1a, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x81, 0x02,
-	0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63,
-	0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x42,
-	0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4c,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e,
-	0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6c,
-	0x65, 0x64, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f,
-	0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2f, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74,
-	0x2f, 0x76, 0x31, 0x3b, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x52,
-	0x45, 0x42, 0xaa, 0x02, 0x19, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x45, 0x63, 0x6f, 0x63, 0x72,
-	0x65, 0x64, 0x69, 0x74, 0x2e, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02,
-	0x19, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
-	0x5c, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x25, 0x52, 0x65, 0x67,
-	0x65, 0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5c, 0x42, 0x61, 0x73,
-	0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x1c, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x3a, 0x3a, 0x45, 0x63, 0x6f, 0x63,
-	0x72, 0x65, 0x64, 0x69, 0x74, 0x3a, 0x3a, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x3a, 0x3a, 0x56,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x43, 0x0a,
+	0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x27, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x6b, 0x65,
+	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x22, 0x57, 0x0a, 0x18,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x6b, 0x65,
+	0x74, 0x43, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x6b,
+	0x65, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x75,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x57, 0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x64,
+	0x64, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x6e, 0x6f,
+	0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x73, 0x22, 0x5a,
+	0x0a, 0x18, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x61, 0x73,
+	0x6b, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61,
+	0x73, 0x6b, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x1d, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74,
+	0x44, 0x61, 0x74, 0x65, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12,
+	0x4c, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65,
+	0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x52,
+	0x0c, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x22, 0x41, 0x0a,
+	0x1c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x6b,
+	0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a,
+	0x0c, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
+	0x22, 0x6b, 0x0a, 0x1c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42,
+	0x61, 0x73, 0x6b, 0x65, 0x74, 0x54, 0x61, 0x6b, 0x65, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d,
+	0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x44, 0x65,
+	0x6e, 0x6f, 0x6d, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x6d,
+	0x69, 0x75, 0x6d, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x74,
+	0x61, 0x6b, 0x65, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x42, 0x70, 0x73, 0x22, 0xa4, 0x01,
+	0x0a, 0x22, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73,
+	0x6b, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x69, 0x74,
+	0x65, 0x72, 0x69, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x6b,
+	0x65, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x5b, 0x0a, 0x12, 0x61, 0x64, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69,
+	0x61, 0x52, 0x11, 0x61, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x69, 0x74,
+	0x65, 0x72, 0x69, 0x61, 0x42, 0x81, 0x02, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x65, 0x67,
+	0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x62, 0x61, 0x73,
+	0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f,
+	0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x2f, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x62, 0x61, 0x73, 0x6b, 0x65,
+	0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x52, 0x45, 0x42, 0xaa, 0x02, 0x19, 0x52, 0x65, 0x67, 0x65,
+	0x6e, 0x2e, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x42, 0x61, 0x73, 0x6b,
+	0x65, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x19, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45, 0x63,
+	0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5c, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5c, 0x56,
+	0x31, 0xe2, 0x02, 0x25, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x5c, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1c, 0x52, 0x65, 0x67, 0x65,
+	0x6e, 0x3a, 0x3a, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x3a, 0x3a, 0x42, 0x61,
+	0x73, 0x6b, 0x65, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2155,21 +6117,34 @@ func file_regen_ecocredit_basket_v1_events_proto_rawDescGZIP() []byte {
 	return file_regen_ecocredit_basket_v1_events_proto_rawDescData
 }
 
-var file_regen_ecocredit_basket_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_regen_ecocredit_basket_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_regen_ecocredit_basket_v1_events_proto_goTypes = []interface{}{
-	(*EventCreate)(nil),  // 0: regen.ecocredit.basket.v1.EventCreate
-	(*EventPut)(nil),     // 1: regen.ecocredit.basket.v1.EventPut
-	(*EventTake)(nil),    // 2: regen.ecocredit.basket.v1.EventTake
-	(*BasketCredit)(nil), // 3: regen.ecocredit.basket.v1.BasketCredit
+	(*EventCreate)(nil),                        // 0: regen.ecocredit.basket.v1.EventCreate
+	(*EventPut)(nil),                           // 1: regen.ecocredit.basket.v1.EventPut
+	(*EventTake)(nil),                          // 2: regen.ecocredit.basket.v1.EventTake
+	(*EventUpdateBasketCurator)(nil),           // 3: regen.ecocredit.basket.v1.EventUpdateBasketCurator
+	(*EventAddBasketClasses)(nil),              // 4: regen.ecocredit.basket.v1.EventAddBasketClasses
+	(*EventRemoveBasketClasses)(nil),           // 5: regen.ecocredit.basket.v1.EventRemoveBasketClasses
+	(*EventUpdateBasketDateCriteria)(nil),      // 6: regen.ecocredit.basket.v1.EventUpdateBasketDateCriteria
+	(*EventUpdateBasketDescription)(nil),       // 7: regen.ecocredit.basket.v1.EventUpdateBasketDescription
+	(*EventUpdateBasketTakePremium)(nil),       // 8: regen.ecocredit.basket.v1.EventUpdateBasketTakePremium
+	(*EventUpdateBasketAdmissionCriteria)(nil), // 9: regen.ecocredit.basket.v1.EventUpdateBasketAdmissionCriteria
+	(*BasketCredit)(nil),                       // 10: regen.ecocredit.basket.v1.BasketCredit
+	(TakeStrategy)(0),                          // 11: regen.ecocredit.basket.v1.TakeStrategy
+	(*DateCriteria)(nil),                       // 12: regen.ecocredit.basket.v1.DateCriteria
+	(*AdmissionCriteria)(nil),                  // 13: regen.ecocredit.basket.v1.AdmissionCriteria
 }
 var file_regen_ecocredit_basket_v1_events_proto_depIdxs = []int32{
-	3, // 0: regen.ecocredit.basket.v1.EventPut.credits:type_name -> regen.ecocredit.basket.v1.BasketCredit
-	3, // 1: regen.ecocredit.basket.v1.EventTake.credits:type_name -> regen.ecocredit.basket.v1.BasketCredit
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	10, // 0: regen.ecocredit.basket.v1.EventPut.credits:type_name -> regen.ecocredit.basket.v1.BasketCredit
+	10, // 1: regen.ecocredit.basket.v1.EventTake.credits:type_name -> regen.ecocredit.basket.v1.BasketCredit
+	11, // 2: regen.ecocredit.basket.v1.EventTake.strategy:type_name -> regen.ecocredit.basket.v1.TakeStrategy
+	12, // 3: regen.ecocredit.basket.v1.EventUpdateBasketDateCriteria.date_criteria:type_name -> regen.ecocredit.basket.v1.DateCriteria
+	13, // 4: regen.ecocredit.basket.v1.EventUpdateBasketAdmissionCriteria.admission_criteria:type_name -> regen.ecocredit.basket.v1.AdmissionCriteria
+	5,  // [5:5] is the sub-list for method output_type
+	5,  // [5:5] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_regen_ecocredit_basket_v1_events_proto_init() }
@@ -2215,6 +6190,90 @@ func file_regen_ecocredit_basket_v1_events_proto_init() {
 				return nil
 			}
 		}
+		file_regen_ecocredit_basket_v1_events_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventUpdateBasketCurator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_basket_v1_events_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventAddBasketClasses); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_basket_v1_events_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventRemoveBasketClasses); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_basket_v1_events_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventUpdateBasketDateCriteria); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_basket_v1_events_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventUpdateBasketDescription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_basket_v1_events_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventUpdateBasketTakePremium); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_basket_v1_events_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventUpdateBasketAdmissionCriteria); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2222,7 +6281,7 @@ func file_regen_ecocredit_basket_v1_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_regen_ecocredit_basket_v1_events_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -30,6 +30,10 @@ type QueryClient interface {
 	BasketBalances(ctx context.Context, in *QueryBasketBalancesRequest, opts ...grpc.CallOption) (*QueryBasketBalancesResponse, error)
 	// BasketBalance queries the balance of a specific credit batch in the basket.
 	BasketBalance(ctx context.Context, in *QueryBasketBalanceRequest, opts ...grpc.CallOption) (*QueryBasketBalanceResponse, error)
+	// BasketAdmissibility queries whether credits from a credit batch can be put
+	// into the basket and the reasons why they cannot if they are not
+	// admissible.
+	BasketAdmissibility(ctx context.Context, in *QueryBasketAdmissibilityRequest, opts ...grpc.CallOption) (*QueryBasketAdmissibilityResponse, error)
 }
 
 type queryClient struct {
@@ -76,6 +80,15 @@ func (c *queryClient) BasketBalance(ctx context.Context, in *QueryBasketBalanceR
 	return out, nil
 }
 
+func (c *queryClient) BasketAdmissibility(ctx context.Context, in *QueryBasketAdmissibilityRequest, opts ...grpc.CallOption) (*QueryBasketAdmissibilityResponse, error) {
+	out := new(QueryBasketAdmissibilityResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.basket.v1.Query/BasketAdmissibility", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -88,6 +101,10 @@ type QueryServer interface {
 	BasketBalances(context.Context, *QueryBasketBalancesRequest) (*QueryBasketBalancesResponse, error)
 	// BasketBalance queries the balance of a specific credit batch in the basket.
 	BasketBalance(context.Context, *QueryBasketBalanceRequest) (*QueryBasketBalanceResponse, error)
+	// BasketAdmissibility queries whether credits from a credit batch can be put
+	// into the basket and the reasons why they cannot if they are not
+	// admissible.
+	BasketAdmissibility(context.Context, *QueryBasketAdmissibilityRequest) (*QueryBasketAdmissibilityResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -107,6 +124,9 @@ func (UnimplementedQueryServer) BasketBalances(context.Context, *QueryBasketBala
 func (UnimplementedQueryServer) BasketBalance(context.Context, *QueryBasketBalanceRequest) (*QueryBasketBalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BasketBalance not implemented")
 }
+func (UnimplementedQueryServer) BasketAdmissibility(context.Context, *QueryBasketAdmissibilityRequest) (*QueryBasketAdmissibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BasketAdmissibility not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -192,6 +212,24 @@ func _Query_BasketBalance_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BasketAdmissibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBasketAdmissibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BasketAdmissibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.basket.v1.Query/BasketAdmissibility",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BasketAdmissibility(ctx, req.(*QueryBasketAdmissibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -215,6 +253,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BasketBalance",
 			Handler:    _Query_BasketBalance_Handler,
 		},
+		{
+			MethodName: "BasketAdmissibility",
+			Handler:    _Query_BasketAdmissibility_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "regen/ecocredit/basket/v1/query.proto",
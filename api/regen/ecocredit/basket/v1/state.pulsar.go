@@ -24,6 +24,8 @@ var (
 	fd_Basket_date_criteria       protoreflect.FieldDescriptor
 	fd_Basket_exponent            protoreflect.FieldDescriptor
 	fd_Basket_curator             protoreflect.FieldDescriptor
+	fd_Basket_take_premium_bps    protoreflect.FieldDescriptor
+	fd_Basket_admission_criteria  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -37,6 +39,8 @@ func init() {
 	fd_Basket_date_criteria = md_Basket.Fields().ByName("date_criteria")
 	fd_Basket_exponent = md_Basket.Fields().ByName("exponent")
 	fd_Basket_curator = md_Basket.Fields().ByName("curator")
+	fd_Basket_take_premium_bps = md_Basket.Fields().ByName("take_premium_bps")
+	fd_Basket_admission_criteria = md_Basket.Fields().ByName("admission_criteria")
 }
 
 var _ protoreflect.Message = (*fastReflection_Basket)(nil)
@@ -152,6 +156,18 @@ func (x *fastReflection_Basket) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.TakePremiumBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.TakePremiumBps)
+		if !f(fd_Basket_take_premium_bps, value) {
+			return
+		}
+	}
+	if x.AdmissionCriteria != nil {
+		value := protoreflect.ValueOfMessage(x.AdmissionCriteria.ProtoReflect())
+		if !f(fd_Basket_admission_criteria, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -183,6 +199,10 @@ func (x *fastReflection_Basket) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Exponent != uint32(0)
 	case "regen.ecocredit.basket.v1.Basket.curator":
 		return len(x.Curator) != 0
+	case "regen.ecocredit.basket.v1.Basket.take_premium_bps":
+		return x.TakePremiumBps != uint32(0)
+	case "regen.ecocredit.basket.v1.Basket.admission_criteria":
+		return x.AdmissionCriteria != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.Basket"))
@@ -215,6 +235,10 @@ func (x *fastReflection_Basket) Clear(fd protoreflect.FieldDescriptor) {
 		x.Exponent = uint32(0)
 	case "regen.ecocredit.basket.v1.Basket.curator":
 		x.Curator = nil
+	case "regen.ecocredit.basket.v1.Basket.take_premium_bps":
+		x.TakePremiumBps = uint32(0)
+	case "regen.ecocredit.basket.v1.Basket.admission_criteria":
+		x.AdmissionCriteria = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.Basket"))
@@ -255,6 +279,12 @@ func (x *fastReflection_Basket) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "regen.ecocredit.basket.v1.Basket.curator":
 		value := x.Curator
 		return protoreflect.ValueOfBytes(value)
+	case "regen.ecocredit.basket.v1.Basket.take_premium_bps":
+		value := x.TakePremiumBps
+		return protoreflect.ValueOfUint32(value)
+	case "regen.ecocredit.basket.v1.Basket.admission_criteria":
+		value := x.AdmissionCriteria
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.Basket"))
@@ -291,6 +321,10 @@ func (x *fastReflection_Basket) Set(fd protoreflect.FieldDescriptor, value proto
 		x.Exponent = uint32(value.Uint())
 	case "regen.ecocredit.basket.v1.Basket.curator":
 		x.Curator = value.Bytes()
+	case "regen.ecocredit.basket.v1.Basket.take_premium_bps":
+		x.TakePremiumBps = uint32(value.Uint())
+	case "regen.ecocredit.basket.v1.Basket.admission_criteria":
+		x.AdmissionCriteria = value.Message().Interface().(*AdmissionCriteria)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.Basket"))
@@ -316,6 +350,11 @@ func (x *fastReflection_Basket) Mutable(fd protoreflect.FieldDescriptor) protore
 			x.DateCriteria = new(DateCriteria)
 		}
 		return protoreflect.ValueOfMessage(x.DateCriteria.ProtoReflect())
+	case "regen.ecocredit.basket.v1.Basket.admission_criteria":
+		if x.AdmissionCriteria == nil {
+			x.AdmissionCriteria = new(AdmissionCriteria)
+		}
+		return protoreflect.ValueOfMessage(x.AdmissionCriteria.ProtoReflect())
 	case "regen.ecocredit.basket.v1.Basket.id":
 		panic(fmt.Errorf("field id of message regen.ecocredit.basket.v1.Basket is not mutable"))
 	case "regen.ecocredit.basket.v1.Basket.basket_denom":
@@ -330,6 +369,8 @@ func (x *fastReflection_Basket) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field exponent of message regen.ecocredit.basket.v1.Basket is not mutable"))
 	case "regen.ecocredit.basket.v1.Basket.curator":
 		panic(fmt.Errorf("field curator of message regen.ecocredit.basket.v1.Basket is not mutable"))
+	case "regen.ecocredit.basket.v1.Basket.take_premium_bps":
+		panic(fmt.Errorf("field take_premium_bps of message regen.ecocredit.basket.v1.Basket is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.Basket"))
@@ -360,6 +401,11 @@ func (x *fastReflection_Basket) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfUint32(uint32(0))
 	case "regen.ecocredit.basket.v1.Basket.curator":
 		return protoreflect.ValueOfBytes(nil)
+	case "regen.ecocredit.basket.v1.Basket.take_premium_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "regen.ecocredit.basket.v1.Basket.admission_criteria":
+		m := new(AdmissionCriteria)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.Basket"))
@@ -458,6 +504,13 @@ func (x *fastReflection_Basket) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.TakePremiumBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.TakePremiumBps))
+		}
+		if x.AdmissionCriteria != nil {
+			l = options.Size(x.AdmissionCriteria)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -487,6 +540,25 @@ func (x *fastReflection_Basket) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.AdmissionCriteria != nil {
+			encoded, err := options.Marshal(x.AdmissionCriteria)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.TakePremiumBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TakePremiumBps))
+			i--
+			dAtA[i] = 0x48
+		}
 		if len(x.Curator) > 0 {
 			i -= len(x.Curator)
 			copy(dAtA[i:], x.Curator)
@@ -822,6 +894,61 @@ func (x *fastReflection_Basket) ProtoMethods() *protoiface.Methods {
 					x.Curator = []byte{}
 				}
 				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TakePremiumBps", wireType)
+				}
+				x.TakePremiumBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TakePremiumBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AdmissionCriteria", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.AdmissionCriteria == nil {
+					x.AdmissionCriteria = &AdmissionCriteria{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AdmissionCriteria); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1988,18 +2115,23 @@ type Basket struct {
 	CreditTypeAbbrev string `protobuf:"bytes,5,opt,name=credit_type_abbrev,json=creditTypeAbbrev,proto3" json:"credit_type_abbrev,omitempty"`
 	// date_criteria is the date criteria for batches admitted to the basket.
 	DateCriteria *DateCriteria `protobuf:"bytes,6,opt,name=date_criteria,json=dateCriteria,proto3" json:"date_criteria,omitempty"`
-	// Deprecated (Since Revision 1): This field is no longer used and will be
-	// removed in the next version. The value of credit type precision is always
-	// used as the exponent when converting credits to/from basket tokens. This
-	// field will be set to the value of credit type precision until removed.
-	//
-	// Deprecated: Do not use.
+	// exponent is the exponent used when converting credits to/from basket
+	// tokens. The exponent is set to the precision of the credit type when the
+	// basket is created and remains fixed when the precision of the credit type
+	// is raised so that existing basket tokens keep their value.
 	Exponent uint32 `protobuf:"varint,7,opt,name=exponent,proto3" json:"exponent,omitempty"`
 	// curator is the address of the basket curator who is able to change certain
 	// basket settings.
 	//
 	// Since Revision 1
 	Curator []byte `protobuf:"bytes,8,opt,name=curator,proto3" json:"curator,omitempty"`
+	// take_premium_bps is the premium in basis points of the basket tokens being
+	// converted that is paid to the curator when credits are taken from the
+	// basket with a strategy other than oldest first.
+	TakePremiumBps uint32 `protobuf:"varint,9,opt,name=take_premium_bps,json=takePremiumBps,proto3" json:"take_premium_bps,omitempty"`
+	// admission_criteria is the additional criteria for batches admitted to the
+	// basket.
+	AdmissionCriteria *AdmissionCriteria `protobuf:"bytes,10,opt,name=admission_criteria,json=admissionCriteria,proto3" json:"admission_criteria,omitempty"`
 }
 
 func (x *Basket) Reset() {
@@ -2064,7 +2196,6 @@ func (x *Basket) GetDateCriteria() *DateCriteria {
 	return nil
 }
 
-// Deprecated: Do not use.
 func (x *Basket) GetExponent() uint32 {
 	if x != nil {
 		return x.Exponent
@@ -2079,6 +2210,20 @@ func (x *Basket) GetCurator() []byte {
 	return nil
 }
 
+func (x *Basket) GetTakePremiumBps() uint32 {
+	if x != nil {
+		return x.TakePremiumBps
+	}
+	return 0
+}
+
+func (x *Basket) GetAdmissionCriteria() *AdmissionCriteria {
+	if x != nil {
+		return x.AdmissionCriteria
+	}
+	return nil
+}
+
 // BasketClass describes a credit class that can be deposited in a basket.
 type BasketClass struct {
 	state         protoimpl.MessageState
@@ -2204,7 +2349,7 @@ var file_regen_ecocredit_basket_v1_state_proto_rawDesc = []byte{
 	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x25, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65,
 	0x64, 0x69, 0x74, 0x2f, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe7, 0x02, 0x0a, 0x06, 0x42, 0x61,
+	0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xea, 0x03, 0x0a, 0x06, 0x42, 0x61,
 	0x73, 0x6b, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
 	0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f, 0x64,
 	0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x6b,
@@ -2220,52 +2365,60 @@ var file_regen_ecocredit_basket_v1_state_proto_rawDesc = []byte{
 	0x32, 0x27, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64,
 	0x69, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74,
 	0x65, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x65, 0x43,
-	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x1e, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x6e,
-	0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x02, 0x18, 0x01, 0x52, 0x08, 0x65,
-	0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x75, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x3a, 0x30, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x2a, 0x0a, 0x06, 0x0a, 0x02, 0x69, 0x64, 0x10,
-	0x01, 0x12, 0x12, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f,
-	0x6d, 0x10, 0x01, 0x18, 0x01, 0x12, 0x0a, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x10, 0x02, 0x18,
-	0x01, 0x18, 0x01, 0x22, 0x65, 0x0a, 0x0b, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x43, 0x6c, 0x61,
-	0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12,
-	0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x3a, 0x1e, 0xf2, 0x9e, 0xd3, 0x8e,
-	0x03, 0x18, 0x0a, 0x14, 0x0a, 0x12, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x2c,
-	0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x22, 0xf0, 0x01, 0x0a, 0x0d, 0x42,
-	0x61, 0x73, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09,
-	0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x08, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x74,
-	0x63, 0x68, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x10, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x62, 0x61, 0x74, 0x63,
-	0x68, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x3a, 0x41, 0xf2, 0x9e, 0xd3, 0x8e,
-	0x03, 0x3b, 0x0a, 0x17, 0x0a, 0x15, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x2c,
-	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1e, 0x0a, 0x1a, 0x62,
-	0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x2c, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x10, 0x01, 0x18, 0x03, 0x42, 0x80, 0x02,
-	0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63,
-	0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x42,
-	0x0a, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4c, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6c, 0x65,
-	0x64, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x65,
-	0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2f, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2f,
-	0x76, 0x31, 0x3b, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x52, 0x45,
-	0x42, 0xaa, 0x02, 0x19, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65,
-	0x64, 0x69, 0x74, 0x2e, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x19,
-	0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5c,
-	0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x25, 0x52, 0x65, 0x67, 0x65,
-	0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5c, 0x42, 0x61, 0x73, 0x6b,
-	0x65, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x1c, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x3a, 0x3a, 0x45, 0x63, 0x6f, 0x63, 0x72,
-	0x65, 0x64, 0x69, 0x74, 0x3a, 0x3a, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x3a, 0x3a, 0x56, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x28, 0x0a,
+	0x10, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x5f, 0x62, 0x70,
+	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x74, 0x61, 0x6b, 0x65, 0x50, 0x72, 0x65,
+	0x6d, 0x69, 0x75, 0x6d, 0x42, 0x70, 0x73, 0x12, 0x5b, 0x0a, 0x12, 0x61, 0x64, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69,
+	0x61, 0x52, 0x11, 0x61, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x69, 0x74,
+	0x65, 0x72, 0x69, 0x61, 0x3a, 0x30, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x2a, 0x0a, 0x06, 0x0a, 0x02,
+	0x69, 0x64, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x10, 0x01, 0x18, 0x01, 0x12, 0x0a, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x10, 0x02, 0x18, 0x01, 0x18, 0x01, 0x22, 0x65, 0x0a, 0x0b, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74,
+	0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74,
+	0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x3a, 0x1e, 0xf2,
+	0x9e, 0xd3, 0x8e, 0x03, 0x18, 0x0a, 0x14, 0x0a, 0x12, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x2c, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x22, 0xf0, 0x01,
+	0x0a, 0x0d, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x18, 0x0a,
+	0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x10, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x3a, 0x41, 0xf2,
+	0x9e, 0xd3, 0x8e, 0x03, 0x3b, 0x0a, 0x17, 0x0a, 0x15, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x2c, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1e,
+	0x0a, 0x1a, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x2c, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x10, 0x01, 0x18, 0x03,
+	0x42, 0x80, 0x02, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65,
+	0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2e,
+	0x76, 0x31, 0x42, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x4c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x65, 0x67,
+	0x65, 0x6e, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e,
+	0x2d, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x67, 0x65,
+	0x6e, 0x2f, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2f, 0x62, 0x61, 0x73, 0x6b,
+	0x65, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x76, 0x31, 0xa2, 0x02,
+	0x03, 0x52, 0x45, 0x42, 0xaa, 0x02, 0x19, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x45, 0x63, 0x6f,
+	0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2e, 0x56, 0x31,
+	0xca, 0x02, 0x19, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x5c, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x25, 0x52,
+	0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5c, 0x42,
+	0x61, 0x73, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1c, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x3a, 0x3a, 0x45, 0x63,
+	0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x3a, 0x3a, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2286,16 +2439,18 @@ var file_regen_ecocredit_basket_v1_state_proto_goTypes = []interface{}{
 	(*BasketClass)(nil),           // 1: regen.ecocredit.basket.v1.BasketClass
 	(*BasketBalance)(nil),         // 2: regen.ecocredit.basket.v1.BasketBalance
 	(*DateCriteria)(nil),          // 3: regen.ecocredit.basket.v1.DateCriteria
-	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+	(*AdmissionCriteria)(nil),     // 4: regen.ecocredit.basket.v1.AdmissionCriteria
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
 }
 var file_regen_ecocredit_basket_v1_state_proto_depIdxs = []int32{
 	3, // 0: regen.ecocredit.basket.v1.Basket.date_criteria:type_name -> regen.ecocredit.basket.v1.DateCriteria
-	4, // 1: regen.ecocredit.basket.v1.BasketBalance.batch_start_date:type_name -> google.protobuf.Timestamp
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	4, // 1: regen.ecocredit.basket.v1.Basket.admission_criteria:type_name -> regen.ecocredit.basket.v1.AdmissionCriteria
+	5, // 2: regen.ecocredit.basket.v1.BasketBalance.batch_start_date:type_name -> google.protobuf.Timestamp
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_regen_ecocredit_basket_v1_state_proto_init() }
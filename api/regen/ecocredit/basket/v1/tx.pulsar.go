@@ -122,6 +122,7 @@ var (
 	fd_MsgCreate_allowed_classes     protoreflect.FieldDescriptor
 	fd_MsgCreate_date_criteria       protoreflect.FieldDescriptor
 	fd_MsgCreate_fee                 protoreflect.FieldDescriptor
+	fd_MsgCreate_admission_criteria  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -136,6 +137,7 @@ func init() {
 	fd_MsgCreate_allowed_classes = md_MsgCreate.Fields().ByName("allowed_classes")
 	fd_MsgCreate_date_criteria = md_MsgCreate.Fields().ByName("date_criteria")
 	fd_MsgCreate_fee = md_MsgCreate.Fields().ByName("fee")
+	fd_MsgCreate_admission_criteria = md_MsgCreate.Fields().ByName("admission_criteria")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgCreate)(nil)
@@ -257,6 +259,12 @@ func (x *fastReflection_MsgCreate) Range(f func(protoreflect.FieldDescriptor, pr
 			return
 		}
 	}
+	if x.AdmissionCriteria != nil {
+		value := protoreflect.ValueOfMessage(x.AdmissionCriteria.ProtoReflect())
+		if !f(fd_MsgCreate_admission_criteria, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -290,6 +298,8 @@ func (x *fastReflection_MsgCreate) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.DateCriteria != nil
 	case "regen.ecocredit.basket.v1.MsgCreate.fee":
 		return len(x.Fee) != 0
+	case "regen.ecocredit.basket.v1.MsgCreate.admission_criteria":
+		return x.AdmissionCriteria != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgCreate"))
@@ -324,6 +334,8 @@ func (x *fastReflection_MsgCreate) Clear(fd protoreflect.FieldDescriptor) {
 		x.DateCriteria = nil
 	case "regen.ecocredit.basket.v1.MsgCreate.fee":
 		x.Fee = nil
+	case "regen.ecocredit.basket.v1.MsgCreate.admission_criteria":
+		x.AdmissionCriteria = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgCreate"))
@@ -373,6 +385,9 @@ func (x *fastReflection_MsgCreate) Get(descriptor protoreflect.FieldDescriptor)
 		}
 		listValue := &_MsgCreate_9_list{list: &x.Fee}
 		return protoreflect.ValueOfList(listValue)
+	case "regen.ecocredit.basket.v1.MsgCreate.admission_criteria":
+		value := x.AdmissionCriteria
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgCreate"))
@@ -415,6 +430,8 @@ func (x *fastReflection_MsgCreate) Set(fd protoreflect.FieldDescriptor, value pr
 		lv := value.List()
 		clv := lv.(*_MsgCreate_9_list)
 		x.Fee = *clv.list
+	case "regen.ecocredit.basket.v1.MsgCreate.admission_criteria":
+		x.AdmissionCriteria = value.Message().Interface().(*AdmissionCriteria)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgCreate"))
@@ -452,6 +469,11 @@ func (x *fastReflection_MsgCreate) Mutable(fd protoreflect.FieldDescriptor) prot
 		}
 		value := &_MsgCreate_9_list{list: &x.Fee}
 		return protoreflect.ValueOfList(value)
+	case "regen.ecocredit.basket.v1.MsgCreate.admission_criteria":
+		if x.AdmissionCriteria == nil {
+			x.AdmissionCriteria = new(AdmissionCriteria)
+		}
+		return protoreflect.ValueOfMessage(x.AdmissionCriteria.ProtoReflect())
 	case "regen.ecocredit.basket.v1.MsgCreate.curator":
 		panic(fmt.Errorf("field curator of message regen.ecocredit.basket.v1.MsgCreate is not mutable"))
 	case "regen.ecocredit.basket.v1.MsgCreate.name":
@@ -498,6 +520,9 @@ func (x *fastReflection_MsgCreate) NewField(fd protoreflect.FieldDescriptor) pro
 	case "regen.ecocredit.basket.v1.MsgCreate.fee":
 		list := []*v1beta1.Coin{}
 		return protoreflect.ValueOfList(&_MsgCreate_9_list{list: &list})
+	case "regen.ecocredit.basket.v1.MsgCreate.admission_criteria":
+		m := new(AdmissionCriteria)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgCreate"))
@@ -605,6 +630,10 @@ func (x *fastReflection_MsgCreate) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.AdmissionCriteria != nil {
+			l = options.Size(x.AdmissionCriteria)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -634,6 +663,20 @@ func (x *fastReflection_MsgCreate) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.AdmissionCriteria != nil {
+			encoded, err := options.Marshal(x.AdmissionCriteria)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
 		if len(x.Fee) > 0 {
 			for iNdEx := len(x.Fee) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.Fee[iNdEx])
@@ -1034,6 +1077,42 @@ func (x *fastReflection_MsgCreate) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AdmissionCriteria", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.AdmissionCriteria == nil {
+					x.AdmissionCriteria = &AdmissionCriteria{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AdmissionCriteria); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2531,6 +2610,52 @@ func (x *fastReflection_MsgPutResponse) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_MsgTake_11_list)(nil)
+
+type _MsgTake_11_list struct {
+	list *[]string
+}
+
+func (x *_MsgTake_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgTake_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgTake_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgTake_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgTake_11_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgTake at list field BatchDenoms as it is not of Message kind"))
+}
+
+func (x *_MsgTake_11_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgTake_11_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgTake_11_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_MsgTake                         protoreflect.MessageDescriptor
 	fd_MsgTake_owner                   protoreflect.FieldDescriptor
@@ -2539,6 +2664,12 @@ var (
 	fd_MsgTake_retirement_location     protoreflect.FieldDescriptor
 	fd_MsgTake_retire_on_take          protoreflect.FieldDescriptor
 	fd_MsgTake_retirement_jurisdiction protoreflect.FieldDescriptor
+	fd_MsgTake_beneficiary_address     protoreflect.FieldDescriptor
+	fd_MsgTake_beneficiary_name        protoreflect.FieldDescriptor
+	fd_MsgTake_reason                  protoreflect.FieldDescriptor
+	fd_MsgTake_strategy                protoreflect.FieldDescriptor
+	fd_MsgTake_batch_denoms            protoreflect.FieldDescriptor
+	fd_MsgTake_max_premium             protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -2550,6 +2681,12 @@ func init() {
 	fd_MsgTake_retirement_location = md_MsgTake.Fields().ByName("retirement_location")
 	fd_MsgTake_retire_on_take = md_MsgTake.Fields().ByName("retire_on_take")
 	fd_MsgTake_retirement_jurisdiction = md_MsgTake.Fields().ByName("retirement_jurisdiction")
+	fd_MsgTake_beneficiary_address = md_MsgTake.Fields().ByName("beneficiary_address")
+	fd_MsgTake_beneficiary_name = md_MsgTake.Fields().ByName("beneficiary_name")
+	fd_MsgTake_reason = md_MsgTake.Fields().ByName("reason")
+	fd_MsgTake_strategy = md_MsgTake.Fields().ByName("strategy")
+	fd_MsgTake_batch_denoms = md_MsgTake.Fields().ByName("batch_denoms")
+	fd_MsgTake_max_premium = md_MsgTake.Fields().ByName("max_premium")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgTake)(nil)
@@ -2653,6 +2790,42 @@ func (x *fastReflection_MsgTake) Range(f func(protoreflect.FieldDescriptor, prot
 			return
 		}
 	}
+	if x.BeneficiaryAddress != "" {
+		value := protoreflect.ValueOfString(x.BeneficiaryAddress)
+		if !f(fd_MsgTake_beneficiary_address, value) {
+			return
+		}
+	}
+	if x.BeneficiaryName != "" {
+		value := protoreflect.ValueOfString(x.BeneficiaryName)
+		if !f(fd_MsgTake_beneficiary_name, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_MsgTake_reason, value) {
+			return
+		}
+	}
+	if x.Strategy != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Strategy))
+		if !f(fd_MsgTake_strategy, value) {
+			return
+		}
+	}
+	if len(x.BatchDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_MsgTake_11_list{list: &x.BatchDenoms})
+		if !f(fd_MsgTake_batch_denoms, value) {
+			return
+		}
+	}
+	if x.MaxPremium != "" {
+		value := protoreflect.ValueOfString(x.MaxPremium)
+		if !f(fd_MsgTake_max_premium, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2680,6 +2853,18 @@ func (x *fastReflection_MsgTake) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.RetireOnTake != false
 	case "regen.ecocredit.basket.v1.MsgTake.retirement_jurisdiction":
 		return x.RetirementJurisdiction != ""
+	case "regen.ecocredit.basket.v1.MsgTake.beneficiary_address":
+		return x.BeneficiaryAddress != ""
+	case "regen.ecocredit.basket.v1.MsgTake.beneficiary_name":
+		return x.BeneficiaryName != ""
+	case "regen.ecocredit.basket.v1.MsgTake.reason":
+		return x.Reason != ""
+	case "regen.ecocredit.basket.v1.MsgTake.strategy":
+		return x.Strategy != 0
+	case "regen.ecocredit.basket.v1.MsgTake.batch_denoms":
+		return len(x.BatchDenoms) != 0
+	case "regen.ecocredit.basket.v1.MsgTake.max_premium":
+		return x.MaxPremium != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgTake"))
@@ -2708,6 +2893,18 @@ func (x *fastReflection_MsgTake) Clear(fd protoreflect.FieldDescriptor) {
 		x.RetireOnTake = false
 	case "regen.ecocredit.basket.v1.MsgTake.retirement_jurisdiction":
 		x.RetirementJurisdiction = ""
+	case "regen.ecocredit.basket.v1.MsgTake.beneficiary_address":
+		x.BeneficiaryAddress = ""
+	case "regen.ecocredit.basket.v1.MsgTake.beneficiary_name":
+		x.BeneficiaryName = ""
+	case "regen.ecocredit.basket.v1.MsgTake.reason":
+		x.Reason = ""
+	case "regen.ecocredit.basket.v1.MsgTake.strategy":
+		x.Strategy = 0
+	case "regen.ecocredit.basket.v1.MsgTake.batch_denoms":
+		x.BatchDenoms = nil
+	case "regen.ecocredit.basket.v1.MsgTake.max_premium":
+		x.MaxPremium = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgTake"))
@@ -2742,6 +2939,27 @@ func (x *fastReflection_MsgTake) Get(descriptor protoreflect.FieldDescriptor) pr
 	case "regen.ecocredit.basket.v1.MsgTake.retirement_jurisdiction":
 		value := x.RetirementJurisdiction
 		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.basket.v1.MsgTake.beneficiary_address":
+		value := x.BeneficiaryAddress
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.basket.v1.MsgTake.beneficiary_name":
+		value := x.BeneficiaryName
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.basket.v1.MsgTake.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.basket.v1.MsgTake.strategy":
+		value := x.Strategy
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "regen.ecocredit.basket.v1.MsgTake.batch_denoms":
+		if len(x.BatchDenoms) == 0 {
+			return protoreflect.ValueOfList(&_MsgTake_11_list{})
+		}
+		listValue := &_MsgTake_11_list{list: &x.BatchDenoms}
+		return protoreflect.ValueOfList(listValue)
+	case "regen.ecocredit.basket.v1.MsgTake.max_premium":
+		value := x.MaxPremium
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgTake"))
@@ -2774,6 +2992,20 @@ func (x *fastReflection_MsgTake) Set(fd protoreflect.FieldDescriptor, value prot
 		x.RetireOnTake = value.Bool()
 	case "regen.ecocredit.basket.v1.MsgTake.retirement_jurisdiction":
 		x.RetirementJurisdiction = value.Interface().(string)
+	case "regen.ecocredit.basket.v1.MsgTake.beneficiary_address":
+		x.BeneficiaryAddress = value.Interface().(string)
+	case "regen.ecocredit.basket.v1.MsgTake.beneficiary_name":
+		x.BeneficiaryName = value.Interface().(string)
+	case "regen.ecocredit.basket.v1.MsgTake.reason":
+		x.Reason = value.Interface().(string)
+	case "regen.ecocredit.basket.v1.MsgTake.strategy":
+		x.Strategy = (TakeStrategy)(value.Enum())
+	case "regen.ecocredit.basket.v1.MsgTake.batch_denoms":
+		lv := value.List()
+		clv := lv.(*_MsgTake_11_list)
+		x.BatchDenoms = *clv.list
+	case "regen.ecocredit.basket.v1.MsgTake.max_premium":
+		x.MaxPremium = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgTake"))
@@ -2794,6 +3026,12 @@ func (x *fastReflection_MsgTake) Set(fd protoreflect.FieldDescriptor, value prot
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_MsgTake) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.MsgTake.batch_denoms":
+		if x.BatchDenoms == nil {
+			x.BatchDenoms = []string{}
+		}
+		value := &_MsgTake_11_list{list: &x.BatchDenoms}
+		return protoreflect.ValueOfList(value)
 	case "regen.ecocredit.basket.v1.MsgTake.owner":
 		panic(fmt.Errorf("field owner of message regen.ecocredit.basket.v1.MsgTake is not mutable"))
 	case "regen.ecocredit.basket.v1.MsgTake.basket_denom":
@@ -2806,6 +3044,16 @@ func (x *fastReflection_MsgTake) Mutable(fd protoreflect.FieldDescriptor) protor
 		panic(fmt.Errorf("field retire_on_take of message regen.ecocredit.basket.v1.MsgTake is not mutable"))
 	case "regen.ecocredit.basket.v1.MsgTake.retirement_jurisdiction":
 		panic(fmt.Errorf("field retirement_jurisdiction of message regen.ecocredit.basket.v1.MsgTake is not mutable"))
+	case "regen.ecocredit.basket.v1.MsgTake.beneficiary_address":
+		panic(fmt.Errorf("field beneficiary_address of message regen.ecocredit.basket.v1.MsgTake is not mutable"))
+	case "regen.ecocredit.basket.v1.MsgTake.beneficiary_name":
+		panic(fmt.Errorf("field beneficiary_name of message regen.ecocredit.basket.v1.MsgTake is not mutable"))
+	case "regen.ecocredit.basket.v1.MsgTake.reason":
+		panic(fmt.Errorf("field reason of message regen.ecocredit.basket.v1.MsgTake is not mutable"))
+	case "regen.ecocredit.basket.v1.MsgTake.strategy":
+		panic(fmt.Errorf("field strategy of message regen.ecocredit.basket.v1.MsgTake is not mutable"))
+	case "regen.ecocredit.basket.v1.MsgTake.max_premium":
+		panic(fmt.Errorf("field max_premium of message regen.ecocredit.basket.v1.MsgTake is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgTake"))
@@ -2831,6 +3079,19 @@ func (x *fastReflection_MsgTake) NewField(fd protoreflect.FieldDescriptor) proto
 		return protoreflect.ValueOfBool(false)
 	case "regen.ecocredit.basket.v1.MsgTake.retirement_jurisdiction":
 		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.basket.v1.MsgTake.beneficiary_address":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.basket.v1.MsgTake.beneficiary_name":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.basket.v1.MsgTake.reason":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.basket.v1.MsgTake.strategy":
+		return protoreflect.ValueOfEnum(0)
+	case "regen.ecocredit.basket.v1.MsgTake.batch_denoms":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgTake_11_list{list: &list})
+	case "regen.ecocredit.basket.v1.MsgTake.max_premium":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgTake"))
@@ -2923,6 +3184,31 @@ func (x *fastReflection_MsgTake) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.BeneficiaryAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BeneficiaryName)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Strategy != 0 {
+			n += 1 + runtime.Sov(uint64(x.Strategy))
+		}
+		if len(x.BatchDenoms) > 0 {
+			for _, s := range x.BatchDenoms {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.MaxPremium)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2952,6 +3238,48 @@ func (x *fastReflection_MsgTake) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.MaxPremium) > 0 {
+			i -= len(x.MaxPremium)
+			copy(dAtA[i:], x.MaxPremium)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxPremium)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.BatchDenoms) > 0 {
+			for iNdEx := len(x.BatchDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.BatchDenoms[iNdEx])
+				copy(dAtA[i:], x.BatchDenoms[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BatchDenoms[iNdEx])))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if x.Strategy != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Strategy))
+			i--
+			dAtA[i] = 0x50
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.BeneficiaryName) > 0 {
+			i -= len(x.BeneficiaryName)
+			copy(dAtA[i:], x.BeneficiaryName)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BeneficiaryName)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.BeneficiaryAddress) > 0 {
+			i -= len(x.BeneficiaryAddress)
+			copy(dAtA[i:], x.BeneficiaryAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BeneficiaryAddress)))
+			i--
+			dAtA[i] = 0x3a
+		}
 		if len(x.RetirementJurisdiction) > 0 {
 			i -= len(x.RetirementJurisdiction)
 			copy(dAtA[i:], x.RetirementJurisdiction)
@@ -3226,101 +3554,282 @@ func (x *fastReflection_MsgTake) ProtoMethods() *protoiface.Methods {
 				}
 				x.RetirementJurisdiction = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BeneficiaryAddress", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.BeneficiaryAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BeneficiaryName", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var _ protoreflect.List = (*_MsgTakeResponse_1_list)(nil)
-
-type _MsgTakeResponse_1_list struct {
-	list *[]*BasketCredit
-}
-
-func (x *_MsgTakeResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_MsgTakeResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_MsgTakeResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*BasketCredit)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_MsgTakeResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*BasketCredit)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_MsgTakeResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(BasketCredit)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MsgTakeResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_MsgTakeResponse_1_list) NewElement() protoreflect.Value {
-	v := new(BasketCredit)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MsgTakeResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
-var (
-	md_MsgTakeResponse         protoreflect.MessageDescriptor
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BeneficiaryName = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+				}
+				x.Strategy = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Strategy |= TakeStrategy(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchDenoms", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BatchDenoms = append(x.BatchDenoms, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPremium", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxPremium = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgTakeResponse_1_list)(nil)
+
+type _MsgTakeResponse_1_list struct {
+	list *[]*BasketCredit
+}
+
+func (x *_MsgTakeResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgTakeResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgTakeResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BasketCredit)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgTakeResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BasketCredit)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgTakeResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(BasketCredit)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgTakeResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgTakeResponse_1_list) NewElement() protoreflect.Value {
+	v := new(BasketCredit)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgTakeResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgTakeResponse         protoreflect.MessageDescriptor
 	fd_MsgTakeResponse_credits protoreflect.FieldDescriptor
+	fd_MsgTakeResponse_premium protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_regen_ecocredit_basket_v1_tx_proto_init()
 	md_MsgTakeResponse = File_regen_ecocredit_basket_v1_tx_proto.Messages().ByName("MsgTakeResponse")
 	fd_MsgTakeResponse_credits = md_MsgTakeResponse.Fields().ByName("credits")
+	fd_MsgTakeResponse_premium = md_MsgTakeResponse.Fields().ByName("premium")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgTakeResponse)(nil)
@@ -3394,6 +3903,12 @@ func (x *fastReflection_MsgTakeResponse) Range(f func(protoreflect.FieldDescript
 			return
 		}
 	}
+	if x.Premium != "" {
+		value := protoreflect.ValueOfString(x.Premium)
+		if !f(fd_MsgTakeResponse_premium, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -3411,6 +3926,8 @@ func (x *fastReflection_MsgTakeResponse) Has(fd protoreflect.FieldDescriptor) bo
 	switch fd.FullName() {
 	case "regen.ecocredit.basket.v1.MsgTakeResponse.credits":
 		return len(x.Credits) != 0
+	case "regen.ecocredit.basket.v1.MsgTakeResponse.premium":
+		return x.Premium != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgTakeResponse"))
@@ -3429,6 +3946,8 @@ func (x *fastReflection_MsgTakeResponse) Clear(fd protoreflect.FieldDescriptor)
 	switch fd.FullName() {
 	case "regen.ecocredit.basket.v1.MsgTakeResponse.credits":
 		x.Credits = nil
+	case "regen.ecocredit.basket.v1.MsgTakeResponse.premium":
+		x.Premium = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgTakeResponse"))
@@ -3451,6 +3970,9 @@ func (x *fastReflection_MsgTakeResponse) Get(descriptor protoreflect.FieldDescri
 		}
 		listValue := &_MsgTakeResponse_1_list{list: &x.Credits}
 		return protoreflect.ValueOfList(listValue)
+	case "regen.ecocredit.basket.v1.MsgTakeResponse.premium":
+		value := x.Premium
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgTakeResponse"))
@@ -3475,6 +3997,8 @@ func (x *fastReflection_MsgTakeResponse) Set(fd protoreflect.FieldDescriptor, va
 		lv := value.List()
 		clv := lv.(*_MsgTakeResponse_1_list)
 		x.Credits = *clv.list
+	case "regen.ecocredit.basket.v1.MsgTakeResponse.premium":
+		x.Premium = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgTakeResponse"))
@@ -3501,6 +4025,8 @@ func (x *fastReflection_MsgTakeResponse) Mutable(fd protoreflect.FieldDescriptor
 		}
 		value := &_MsgTakeResponse_1_list{list: &x.Credits}
 		return protoreflect.ValueOfList(value)
+	case "regen.ecocredit.basket.v1.MsgTakeResponse.premium":
+		panic(fmt.Errorf("field premium of message regen.ecocredit.basket.v1.MsgTakeResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgTakeResponse"))
@@ -3517,6 +4043,8 @@ func (x *fastReflection_MsgTakeResponse) NewField(fd protoreflect.FieldDescripto
 	case "regen.ecocredit.basket.v1.MsgTakeResponse.credits":
 		list := []*BasketCredit{}
 		return protoreflect.ValueOfList(&_MsgTakeResponse_1_list{list: &list})
+	case "regen.ecocredit.basket.v1.MsgTakeResponse.premium":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgTakeResponse"))
@@ -3592,6 +4120,10 @@ func (x *fastReflection_MsgTakeResponse) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		l = len(x.Premium)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3621,6 +4153,13 @@ func (x *fastReflection_MsgTakeResponse) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Premium) > 0 {
+			i -= len(x.Premium)
+			copy(dAtA[i:], x.Premium)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Premium)))
+			i--
+			dAtA[i] = 0x12
+		}
 		if len(x.Credits) > 0 {
 			for iNdEx := len(x.Credits) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.Credits[iNdEx])
@@ -3720,17 +4259,49 @@ func (x *fastReflection_MsgTakeResponse) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Premium", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Premium = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
 				if !options.DiscardUnknown {
 					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
@@ -3739,436 +4310,7575 @@ func (x *fastReflection_MsgTakeResponse) ProtoMethods() *protoiface.Methods {
 			}
 		}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateBasketCurator              protoreflect.MessageDescriptor
+	fd_MsgUpdateBasketCurator_curator      protoreflect.FieldDescriptor
+	fd_MsgUpdateBasketCurator_basket_denom protoreflect.FieldDescriptor
+	fd_MsgUpdateBasketCurator_new_curator  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_basket_v1_tx_proto_init()
+	md_MsgUpdateBasketCurator = File_regen_ecocredit_basket_v1_tx_proto.Messages().ByName("MsgUpdateBasketCurator")
+	fd_MsgUpdateBasketCurator_curator = md_MsgUpdateBasketCurator.Fields().ByName("curator")
+	fd_MsgUpdateBasketCurator_basket_denom = md_MsgUpdateBasketCurator.Fields().ByName("basket_denom")
+	fd_MsgUpdateBasketCurator_new_curator = md_MsgUpdateBasketCurator.Fields().ByName("new_curator")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateBasketCurator)(nil)
+
+type fastReflection_MsgUpdateBasketCurator MsgUpdateBasketCurator
+
+func (x *MsgUpdateBasketCurator) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateBasketCurator)(x)
+}
+
+func (x *MsgUpdateBasketCurator) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateBasketCurator_messageType fastReflection_MsgUpdateBasketCurator_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateBasketCurator_messageType{}
+
+type fastReflection_MsgUpdateBasketCurator_messageType struct{}
+
+func (x fastReflection_MsgUpdateBasketCurator_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateBasketCurator)(nil)
+}
+func (x fastReflection_MsgUpdateBasketCurator_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateBasketCurator)
+}
+func (x fastReflection_MsgUpdateBasketCurator_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateBasketCurator
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateBasketCurator) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateBasketCurator
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateBasketCurator) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateBasketCurator_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateBasketCurator) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateBasketCurator)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateBasketCurator) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateBasketCurator)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateBasketCurator) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Curator != "" {
+		value := protoreflect.ValueOfString(x.Curator)
+		if !f(fd_MsgUpdateBasketCurator_curator, value) {
+			return
+		}
+	}
+	if x.BasketDenom != "" {
+		value := protoreflect.ValueOfString(x.BasketDenom)
+		if !f(fd_MsgUpdateBasketCurator_basket_denom, value) {
+			return
+		}
+	}
+	if x.NewCurator != "" {
+		value := protoreflect.ValueOfString(x.NewCurator)
+		if !f(fd_MsgUpdateBasketCurator_new_curator, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateBasketCurator) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketCurator.curator":
+		return x.Curator != ""
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketCurator.basket_denom":
+		return x.BasketDenom != ""
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketCurator.new_curator":
+		return x.NewCurator != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketCurator"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketCurator does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketCurator) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketCurator.curator":
+		x.Curator = ""
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketCurator.basket_denom":
+		x.BasketDenom = ""
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketCurator.new_curator":
+		x.NewCurator = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketCurator"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketCurator does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateBasketCurator) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketCurator.curator":
+		value := x.Curator
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketCurator.basket_denom":
+		value := x.BasketDenom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketCurator.new_curator":
+		value := x.NewCurator
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketCurator"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketCurator does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketCurator) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketCurator.curator":
+		x.Curator = value.Interface().(string)
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketCurator.basket_denom":
+		x.BasketDenom = value.Interface().(string)
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketCurator.new_curator":
+		x.NewCurator = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketCurator"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketCurator does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketCurator) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketCurator.curator":
+		panic(fmt.Errorf("field curator of message regen.ecocredit.basket.v1.MsgUpdateBasketCurator is not mutable"))
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketCurator.basket_denom":
+		panic(fmt.Errorf("field basket_denom of message regen.ecocredit.basket.v1.MsgUpdateBasketCurator is not mutable"))
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketCurator.new_curator":
+		panic(fmt.Errorf("field new_curator of message regen.ecocredit.basket.v1.MsgUpdateBasketCurator is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketCurator"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketCurator does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateBasketCurator) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketCurator.curator":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketCurator.basket_denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketCurator.new_curator":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketCurator"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketCurator does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateBasketCurator) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.basket.v1.MsgUpdateBasketCurator", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateBasketCurator) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketCurator) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateBasketCurator) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateBasketCurator) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateBasketCurator)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Curator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BasketDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NewCurator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateBasketCurator)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.NewCurator) > 0 {
+			i -= len(x.NewCurator)
+			copy(dAtA[i:], x.NewCurator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewCurator)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.BasketDenom) > 0 {
+			i -= len(x.BasketDenom)
+			copy(dAtA[i:], x.BasketDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BasketDenom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Curator) > 0 {
+			i -= len(x.Curator)
+			copy(dAtA[i:], x.Curator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Curator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateBasketCurator)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateBasketCurator: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateBasketCurator: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Curator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BasketDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewCurator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NewCurator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateBasketCuratorResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_basket_v1_tx_proto_init()
+	md_MsgUpdateBasketCuratorResponse = File_regen_ecocredit_basket_v1_tx_proto.Messages().ByName("MsgUpdateBasketCuratorResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateBasketCuratorResponse)(nil)
+
+type fastReflection_MsgUpdateBasketCuratorResponse MsgUpdateBasketCuratorResponse
+
+func (x *MsgUpdateBasketCuratorResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateBasketCuratorResponse)(x)
+}
+
+func (x *MsgUpdateBasketCuratorResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateBasketCuratorResponse_messageType fastReflection_MsgUpdateBasketCuratorResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateBasketCuratorResponse_messageType{}
+
+type fastReflection_MsgUpdateBasketCuratorResponse_messageType struct{}
+
+func (x fastReflection_MsgUpdateBasketCuratorResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateBasketCuratorResponse)(nil)
+}
+func (x fastReflection_MsgUpdateBasketCuratorResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateBasketCuratorResponse)
+}
+func (x fastReflection_MsgUpdateBasketCuratorResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateBasketCuratorResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateBasketCuratorResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateBasketCuratorResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateBasketCuratorResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateBasketCuratorResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateBasketCuratorResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateBasketCuratorResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateBasketCuratorResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateBasketCuratorResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateBasketCuratorResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateBasketCuratorResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketCuratorResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketCuratorResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketCuratorResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketCuratorResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketCuratorResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateBasketCuratorResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketCuratorResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketCuratorResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketCuratorResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketCuratorResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketCuratorResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketCuratorResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketCuratorResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketCuratorResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateBasketCuratorResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketCuratorResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketCuratorResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateBasketCuratorResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.basket.v1.MsgUpdateBasketCuratorResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateBasketCuratorResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketCuratorResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateBasketCuratorResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateBasketCuratorResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateBasketCuratorResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateBasketCuratorResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateBasketCuratorResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateBasketCuratorResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateBasketCuratorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgAddBasketClasses_3_list)(nil)
+
+type _MsgAddBasketClasses_3_list struct {
+	list *[]string
+}
+
+func (x *_MsgAddBasketClasses_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgAddBasketClasses_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgAddBasketClasses_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgAddBasketClasses_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgAddBasketClasses_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgAddBasketClasses at list field ClassIds as it is not of Message kind"))
+}
+
+func (x *_MsgAddBasketClasses_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgAddBasketClasses_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgAddBasketClasses_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgAddBasketClasses              protoreflect.MessageDescriptor
+	fd_MsgAddBasketClasses_curator      protoreflect.FieldDescriptor
+	fd_MsgAddBasketClasses_basket_denom protoreflect.FieldDescriptor
+	fd_MsgAddBasketClasses_class_ids    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_basket_v1_tx_proto_init()
+	md_MsgAddBasketClasses = File_regen_ecocredit_basket_v1_tx_proto.Messages().ByName("MsgAddBasketClasses")
+	fd_MsgAddBasketClasses_curator = md_MsgAddBasketClasses.Fields().ByName("curator")
+	fd_MsgAddBasketClasses_basket_denom = md_MsgAddBasketClasses.Fields().ByName("basket_denom")
+	fd_MsgAddBasketClasses_class_ids = md_MsgAddBasketClasses.Fields().ByName("class_ids")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgAddBasketClasses)(nil)
+
+type fastReflection_MsgAddBasketClasses MsgAddBasketClasses
+
+func (x *MsgAddBasketClasses) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAddBasketClasses)(x)
+}
+
+func (x *MsgAddBasketClasses) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgAddBasketClasses_messageType fastReflection_MsgAddBasketClasses_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAddBasketClasses_messageType{}
+
+type fastReflection_MsgAddBasketClasses_messageType struct{}
+
+func (x fastReflection_MsgAddBasketClasses_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAddBasketClasses)(nil)
+}
+func (x fastReflection_MsgAddBasketClasses_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAddBasketClasses)
+}
+func (x fastReflection_MsgAddBasketClasses_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAddBasketClasses
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgAddBasketClasses) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAddBasketClasses
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgAddBasketClasses) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAddBasketClasses_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgAddBasketClasses) New() protoreflect.Message {
+	return new(fastReflection_MsgAddBasketClasses)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgAddBasketClasses) Interface() protoreflect.ProtoMessage {
+	return (*MsgAddBasketClasses)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgAddBasketClasses) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Curator != "" {
+		value := protoreflect.ValueOfString(x.Curator)
+		if !f(fd_MsgAddBasketClasses_curator, value) {
+			return
+		}
+	}
+	if x.BasketDenom != "" {
+		value := protoreflect.ValueOfString(x.BasketDenom)
+		if !f(fd_MsgAddBasketClasses_basket_denom, value) {
+			return
+		}
+	}
+	if len(x.ClassIds) != 0 {
+		value := protoreflect.ValueOfList(&_MsgAddBasketClasses_3_list{list: &x.ClassIds})
+		if !f(fd_MsgAddBasketClasses_class_ids, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgAddBasketClasses) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.MsgAddBasketClasses.curator":
+		return x.Curator != ""
+	case "regen.ecocredit.basket.v1.MsgAddBasketClasses.basket_denom":
+		return x.BasketDenom != ""
+	case "regen.ecocredit.basket.v1.MsgAddBasketClasses.class_ids":
+		return len(x.ClassIds) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgAddBasketClasses"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgAddBasketClasses does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAddBasketClasses) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.MsgAddBasketClasses.curator":
+		x.Curator = ""
+	case "regen.ecocredit.basket.v1.MsgAddBasketClasses.basket_denom":
+		x.BasketDenom = ""
+	case "regen.ecocredit.basket.v1.MsgAddBasketClasses.class_ids":
+		x.ClassIds = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgAddBasketClasses"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgAddBasketClasses does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgAddBasketClasses) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.basket.v1.MsgAddBasketClasses.curator":
+		value := x.Curator
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.basket.v1.MsgAddBasketClasses.basket_denom":
+		value := x.BasketDenom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.basket.v1.MsgAddBasketClasses.class_ids":
+		if len(x.ClassIds) == 0 {
+			return protoreflect.ValueOfList(&_MsgAddBasketClasses_3_list{})
+		}
+		listValue := &_MsgAddBasketClasses_3_list{list: &x.ClassIds}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgAddBasketClasses"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgAddBasketClasses does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAddBasketClasses) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.MsgAddBasketClasses.curator":
+		x.Curator = value.Interface().(string)
+	case "regen.ecocredit.basket.v1.MsgAddBasketClasses.basket_denom":
+		x.BasketDenom = value.Interface().(string)
+	case "regen.ecocredit.basket.v1.MsgAddBasketClasses.class_ids":
+		lv := value.List()
+		clv := lv.(*_MsgAddBasketClasses_3_list)
+		x.ClassIds = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgAddBasketClasses"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgAddBasketClasses does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAddBasketClasses) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.MsgAddBasketClasses.class_ids":
+		if x.ClassIds == nil {
+			x.ClassIds = []string{}
+		}
+		value := &_MsgAddBasketClasses_3_list{list: &x.ClassIds}
+		return protoreflect.ValueOfList(value)
+	case "regen.ecocredit.basket.v1.MsgAddBasketClasses.curator":
+		panic(fmt.Errorf("field curator of message regen.ecocredit.basket.v1.MsgAddBasketClasses is not mutable"))
+	case "regen.ecocredit.basket.v1.MsgAddBasketClasses.basket_denom":
+		panic(fmt.Errorf("field basket_denom of message regen.ecocredit.basket.v1.MsgAddBasketClasses is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgAddBasketClasses"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgAddBasketClasses does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgAddBasketClasses) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.MsgAddBasketClasses.curator":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.basket.v1.MsgAddBasketClasses.basket_denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.basket.v1.MsgAddBasketClasses.class_ids":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgAddBasketClasses_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgAddBasketClasses"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgAddBasketClasses does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgAddBasketClasses) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.basket.v1.MsgAddBasketClasses", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgAddBasketClasses) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAddBasketClasses) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgAddBasketClasses) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgAddBasketClasses) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgAddBasketClasses)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Curator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BasketDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ClassIds) > 0 {
+			for _, s := range x.ClassIds {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAddBasketClasses)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ClassIds) > 0 {
+			for iNdEx := len(x.ClassIds) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ClassIds[iNdEx])
+				copy(dAtA[i:], x.ClassIds[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClassIds[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.BasketDenom) > 0 {
+			i -= len(x.BasketDenom)
+			copy(dAtA[i:], x.BasketDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BasketDenom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Curator) > 0 {
+			i -= len(x.Curator)
+			copy(dAtA[i:], x.Curator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Curator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAddBasketClasses)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddBasketClasses: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddBasketClasses: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Curator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BasketDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassIds", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClassIds = append(x.ClassIds, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgAddBasketClassesResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_basket_v1_tx_proto_init()
+	md_MsgAddBasketClassesResponse = File_regen_ecocredit_basket_v1_tx_proto.Messages().ByName("MsgAddBasketClassesResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgAddBasketClassesResponse)(nil)
+
+type fastReflection_MsgAddBasketClassesResponse MsgAddBasketClassesResponse
+
+func (x *MsgAddBasketClassesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAddBasketClassesResponse)(x)
+}
+
+func (x *MsgAddBasketClassesResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgAddBasketClassesResponse_messageType fastReflection_MsgAddBasketClassesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAddBasketClassesResponse_messageType{}
+
+type fastReflection_MsgAddBasketClassesResponse_messageType struct{}
+
+func (x fastReflection_MsgAddBasketClassesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAddBasketClassesResponse)(nil)
+}
+func (x fastReflection_MsgAddBasketClassesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAddBasketClassesResponse)
+}
+func (x fastReflection_MsgAddBasketClassesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAddBasketClassesResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgAddBasketClassesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAddBasketClassesResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgAddBasketClassesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAddBasketClassesResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgAddBasketClassesResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgAddBasketClassesResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgAddBasketClassesResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgAddBasketClassesResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgAddBasketClassesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgAddBasketClassesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgAddBasketClassesResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgAddBasketClassesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAddBasketClassesResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgAddBasketClassesResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgAddBasketClassesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgAddBasketClassesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgAddBasketClassesResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgAddBasketClassesResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAddBasketClassesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgAddBasketClassesResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgAddBasketClassesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAddBasketClassesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgAddBasketClassesResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgAddBasketClassesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgAddBasketClassesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgAddBasketClassesResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgAddBasketClassesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgAddBasketClassesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.basket.v1.MsgAddBasketClassesResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgAddBasketClassesResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAddBasketClassesResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgAddBasketClassesResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgAddBasketClassesResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgAddBasketClassesResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAddBasketClassesResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAddBasketClassesResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddBasketClassesResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddBasketClassesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgRemoveBasketClasses_3_list)(nil)
+
+type _MsgRemoveBasketClasses_3_list struct {
+	list *[]string
+}
+
+func (x *_MsgRemoveBasketClasses_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgRemoveBasketClasses_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgRemoveBasketClasses_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgRemoveBasketClasses_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgRemoveBasketClasses_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgRemoveBasketClasses at list field ClassIds as it is not of Message kind"))
+}
+
+func (x *_MsgRemoveBasketClasses_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgRemoveBasketClasses_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgRemoveBasketClasses_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgRemoveBasketClasses              protoreflect.MessageDescriptor
+	fd_MsgRemoveBasketClasses_curator      protoreflect.FieldDescriptor
+	fd_MsgRemoveBasketClasses_basket_denom protoreflect.FieldDescriptor
+	fd_MsgRemoveBasketClasses_class_ids    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_basket_v1_tx_proto_init()
+	md_MsgRemoveBasketClasses = File_regen_ecocredit_basket_v1_tx_proto.Messages().ByName("MsgRemoveBasketClasses")
+	fd_MsgRemoveBasketClasses_curator = md_MsgRemoveBasketClasses.Fields().ByName("curator")
+	fd_MsgRemoveBasketClasses_basket_denom = md_MsgRemoveBasketClasses.Fields().ByName("basket_denom")
+	fd_MsgRemoveBasketClasses_class_ids = md_MsgRemoveBasketClasses.Fields().ByName("class_ids")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRemoveBasketClasses)(nil)
+
+type fastReflection_MsgRemoveBasketClasses MsgRemoveBasketClasses
+
+func (x *MsgRemoveBasketClasses) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRemoveBasketClasses)(x)
+}
+
+func (x *MsgRemoveBasketClasses) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRemoveBasketClasses_messageType fastReflection_MsgRemoveBasketClasses_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRemoveBasketClasses_messageType{}
+
+type fastReflection_MsgRemoveBasketClasses_messageType struct{}
+
+func (x fastReflection_MsgRemoveBasketClasses_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRemoveBasketClasses)(nil)
+}
+func (x fastReflection_MsgRemoveBasketClasses_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRemoveBasketClasses)
+}
+func (x fastReflection_MsgRemoveBasketClasses_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRemoveBasketClasses
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRemoveBasketClasses) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRemoveBasketClasses
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRemoveBasketClasses) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRemoveBasketClasses_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRemoveBasketClasses) New() protoreflect.Message {
+	return new(fastReflection_MsgRemoveBasketClasses)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRemoveBasketClasses) Interface() protoreflect.ProtoMessage {
+	return (*MsgRemoveBasketClasses)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRemoveBasketClasses) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Curator != "" {
+		value := protoreflect.ValueOfString(x.Curator)
+		if !f(fd_MsgRemoveBasketClasses_curator, value) {
+			return
+		}
+	}
+	if x.BasketDenom != "" {
+		value := protoreflect.ValueOfString(x.BasketDenom)
+		if !f(fd_MsgRemoveBasketClasses_basket_denom, value) {
+			return
+		}
+	}
+	if len(x.ClassIds) != 0 {
+		value := protoreflect.ValueOfList(&_MsgRemoveBasketClasses_3_list{list: &x.ClassIds})
+		if !f(fd_MsgRemoveBasketClasses_class_ids, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRemoveBasketClasses) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.MsgRemoveBasketClasses.curator":
+		return x.Curator != ""
+	case "regen.ecocredit.basket.v1.MsgRemoveBasketClasses.basket_denom":
+		return x.BasketDenom != ""
+	case "regen.ecocredit.basket.v1.MsgRemoveBasketClasses.class_ids":
+		return len(x.ClassIds) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgRemoveBasketClasses"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgRemoveBasketClasses does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRemoveBasketClasses) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.MsgRemoveBasketClasses.curator":
+		x.Curator = ""
+	case "regen.ecocredit.basket.v1.MsgRemoveBasketClasses.basket_denom":
+		x.BasketDenom = ""
+	case "regen.ecocredit.basket.v1.MsgRemoveBasketClasses.class_ids":
+		x.ClassIds = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgRemoveBasketClasses"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgRemoveBasketClasses does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRemoveBasketClasses) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.basket.v1.MsgRemoveBasketClasses.curator":
+		value := x.Curator
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.basket.v1.MsgRemoveBasketClasses.basket_denom":
+		value := x.BasketDenom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.basket.v1.MsgRemoveBasketClasses.class_ids":
+		if len(x.ClassIds) == 0 {
+			return protoreflect.ValueOfList(&_MsgRemoveBasketClasses_3_list{})
+		}
+		listValue := &_MsgRemoveBasketClasses_3_list{list: &x.ClassIds}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgRemoveBasketClasses"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgRemoveBasketClasses does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRemoveBasketClasses) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.MsgRemoveBasketClasses.curator":
+		x.Curator = value.Interface().(string)
+	case "regen.ecocredit.basket.v1.MsgRemoveBasketClasses.basket_denom":
+		x.BasketDenom = value.Interface().(string)
+	case "regen.ecocredit.basket.v1.MsgRemoveBasketClasses.class_ids":
+		lv := value.List()
+		clv := lv.(*_MsgRemoveBasketClasses_3_list)
+		x.ClassIds = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgRemoveBasketClasses"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgRemoveBasketClasses does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRemoveBasketClasses) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.MsgRemoveBasketClasses.class_ids":
+		if x.ClassIds == nil {
+			x.ClassIds = []string{}
+		}
+		value := &_MsgRemoveBasketClasses_3_list{list: &x.ClassIds}
+		return protoreflect.ValueOfList(value)
+	case "regen.ecocredit.basket.v1.MsgRemoveBasketClasses.curator":
+		panic(fmt.Errorf("field curator of message regen.ecocredit.basket.v1.MsgRemoveBasketClasses is not mutable"))
+	case "regen.ecocredit.basket.v1.MsgRemoveBasketClasses.basket_denom":
+		panic(fmt.Errorf("field basket_denom of message regen.ecocredit.basket.v1.MsgRemoveBasketClasses is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgRemoveBasketClasses"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgRemoveBasketClasses does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRemoveBasketClasses) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.MsgRemoveBasketClasses.curator":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.basket.v1.MsgRemoveBasketClasses.basket_denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.basket.v1.MsgRemoveBasketClasses.class_ids":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgRemoveBasketClasses_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgRemoveBasketClasses"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgRemoveBasketClasses does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRemoveBasketClasses) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.basket.v1.MsgRemoveBasketClasses", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRemoveBasketClasses) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRemoveBasketClasses) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRemoveBasketClasses) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRemoveBasketClasses) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRemoveBasketClasses)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Curator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BasketDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ClassIds) > 0 {
+			for _, s := range x.ClassIds {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRemoveBasketClasses)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ClassIds) > 0 {
+			for iNdEx := len(x.ClassIds) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ClassIds[iNdEx])
+				copy(dAtA[i:], x.ClassIds[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClassIds[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.BasketDenom) > 0 {
+			i -= len(x.BasketDenom)
+			copy(dAtA[i:], x.BasketDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BasketDenom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Curator) > 0 {
+			i -= len(x.Curator)
+			copy(dAtA[i:], x.Curator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Curator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRemoveBasketClasses)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveBasketClasses: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveBasketClasses: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Curator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BasketDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassIds", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClassIds = append(x.ClassIds, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRemoveBasketClassesResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_basket_v1_tx_proto_init()
+	md_MsgRemoveBasketClassesResponse = File_regen_ecocredit_basket_v1_tx_proto.Messages().ByName("MsgRemoveBasketClassesResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRemoveBasketClassesResponse)(nil)
+
+type fastReflection_MsgRemoveBasketClassesResponse MsgRemoveBasketClassesResponse
+
+func (x *MsgRemoveBasketClassesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRemoveBasketClassesResponse)(x)
+}
+
+func (x *MsgRemoveBasketClassesResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRemoveBasketClassesResponse_messageType fastReflection_MsgRemoveBasketClassesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRemoveBasketClassesResponse_messageType{}
+
+type fastReflection_MsgRemoveBasketClassesResponse_messageType struct{}
+
+func (x fastReflection_MsgRemoveBasketClassesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRemoveBasketClassesResponse)(nil)
+}
+func (x fastReflection_MsgRemoveBasketClassesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRemoveBasketClassesResponse)
+}
+func (x fastReflection_MsgRemoveBasketClassesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRemoveBasketClassesResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRemoveBasketClassesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRemoveBasketClassesResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRemoveBasketClassesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRemoveBasketClassesResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRemoveBasketClassesResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRemoveBasketClassesResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRemoveBasketClassesResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRemoveBasketClassesResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRemoveBasketClassesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRemoveBasketClassesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgRemoveBasketClassesResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgRemoveBasketClassesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRemoveBasketClassesResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgRemoveBasketClassesResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgRemoveBasketClassesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRemoveBasketClassesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgRemoveBasketClassesResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgRemoveBasketClassesResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRemoveBasketClassesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgRemoveBasketClassesResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgRemoveBasketClassesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRemoveBasketClassesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgRemoveBasketClassesResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgRemoveBasketClassesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRemoveBasketClassesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgRemoveBasketClassesResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgRemoveBasketClassesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRemoveBasketClassesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.basket.v1.MsgRemoveBasketClassesResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRemoveBasketClassesResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRemoveBasketClassesResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRemoveBasketClassesResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRemoveBasketClassesResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRemoveBasketClassesResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRemoveBasketClassesResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRemoveBasketClassesResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveBasketClassesResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveBasketClassesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateBasketDateCriteria               protoreflect.MessageDescriptor
+	fd_MsgUpdateBasketDateCriteria_curator       protoreflect.FieldDescriptor
+	fd_MsgUpdateBasketDateCriteria_basket_denom  protoreflect.FieldDescriptor
+	fd_MsgUpdateBasketDateCriteria_date_criteria protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_basket_v1_tx_proto_init()
+	md_MsgUpdateBasketDateCriteria = File_regen_ecocredit_basket_v1_tx_proto.Messages().ByName("MsgUpdateBasketDateCriteria")
+	fd_MsgUpdateBasketDateCriteria_curator = md_MsgUpdateBasketDateCriteria.Fields().ByName("curator")
+	fd_MsgUpdateBasketDateCriteria_basket_denom = md_MsgUpdateBasketDateCriteria.Fields().ByName("basket_denom")
+	fd_MsgUpdateBasketDateCriteria_date_criteria = md_MsgUpdateBasketDateCriteria.Fields().ByName("date_criteria")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateBasketDateCriteria)(nil)
+
+type fastReflection_MsgUpdateBasketDateCriteria MsgUpdateBasketDateCriteria
+
+func (x *MsgUpdateBasketDateCriteria) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateBasketDateCriteria)(x)
+}
+
+func (x *MsgUpdateBasketDateCriteria) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateBasketDateCriteria_messageType fastReflection_MsgUpdateBasketDateCriteria_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateBasketDateCriteria_messageType{}
+
+type fastReflection_MsgUpdateBasketDateCriteria_messageType struct{}
+
+func (x fastReflection_MsgUpdateBasketDateCriteria_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateBasketDateCriteria)(nil)
+}
+func (x fastReflection_MsgUpdateBasketDateCriteria_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateBasketDateCriteria)
+}
+func (x fastReflection_MsgUpdateBasketDateCriteria_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateBasketDateCriteria
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateBasketDateCriteria) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateBasketDateCriteria
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateBasketDateCriteria) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateBasketDateCriteria_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateBasketDateCriteria) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateBasketDateCriteria)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateBasketDateCriteria) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateBasketDateCriteria)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateBasketDateCriteria) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Curator != "" {
+		value := protoreflect.ValueOfString(x.Curator)
+		if !f(fd_MsgUpdateBasketDateCriteria_curator, value) {
+			return
+		}
+	}
+	if x.BasketDenom != "" {
+		value := protoreflect.ValueOfString(x.BasketDenom)
+		if !f(fd_MsgUpdateBasketDateCriteria_basket_denom, value) {
+			return
+		}
+	}
+	if x.DateCriteria != nil {
+		value := protoreflect.ValueOfMessage(x.DateCriteria.ProtoReflect())
+		if !f(fd_MsgUpdateBasketDateCriteria_date_criteria, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateBasketDateCriteria) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria.curator":
+		return x.Curator != ""
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria.basket_denom":
+		return x.BasketDenom != ""
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria.date_criteria":
+		return x.DateCriteria != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketDateCriteria) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria.curator":
+		x.Curator = ""
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria.basket_denom":
+		x.BasketDenom = ""
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria.date_criteria":
+		x.DateCriteria = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateBasketDateCriteria) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria.curator":
+		value := x.Curator
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria.basket_denom":
+		value := x.BasketDenom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria.date_criteria":
+		value := x.DateCriteria
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketDateCriteria) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria.curator":
+		x.Curator = value.Interface().(string)
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria.basket_denom":
+		x.BasketDenom = value.Interface().(string)
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria.date_criteria":
+		x.DateCriteria = value.Message().Interface().(*DateCriteria)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketDateCriteria) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria.date_criteria":
+		if x.DateCriteria == nil {
+			x.DateCriteria = new(DateCriteria)
+		}
+		return protoreflect.ValueOfMessage(x.DateCriteria.ProtoReflect())
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria.curator":
+		panic(fmt.Errorf("field curator of message regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria is not mutable"))
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria.basket_denom":
+		panic(fmt.Errorf("field basket_denom of message regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateBasketDateCriteria) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria.curator":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria.basket_denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria.date_criteria":
+		m := new(DateCriteria)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateBasketDateCriteria) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateBasketDateCriteria) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketDateCriteria) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateBasketDateCriteria) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateBasketDateCriteria) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateBasketDateCriteria)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Curator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BasketDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DateCriteria != nil {
+			l = options.Size(x.DateCriteria)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateBasketDateCriteria)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.DateCriteria != nil {
+			encoded, err := options.Marshal(x.DateCriteria)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.BasketDenom) > 0 {
+			i -= len(x.BasketDenom)
+			copy(dAtA[i:], x.BasketDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BasketDenom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Curator) > 0 {
+			i -= len(x.Curator)
+			copy(dAtA[i:], x.Curator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Curator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateBasketDateCriteria)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateBasketDateCriteria: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateBasketDateCriteria: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Curator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BasketDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DateCriteria", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DateCriteria == nil {
+					x.DateCriteria = &DateCriteria{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DateCriteria); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateBasketDateCriteriaResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_basket_v1_tx_proto_init()
+	md_MsgUpdateBasketDateCriteriaResponse = File_regen_ecocredit_basket_v1_tx_proto.Messages().ByName("MsgUpdateBasketDateCriteriaResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateBasketDateCriteriaResponse)(nil)
+
+type fastReflection_MsgUpdateBasketDateCriteriaResponse MsgUpdateBasketDateCriteriaResponse
+
+func (x *MsgUpdateBasketDateCriteriaResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateBasketDateCriteriaResponse)(x)
+}
+
+func (x *MsgUpdateBasketDateCriteriaResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateBasketDateCriteriaResponse_messageType fastReflection_MsgUpdateBasketDateCriteriaResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateBasketDateCriteriaResponse_messageType{}
+
+type fastReflection_MsgUpdateBasketDateCriteriaResponse_messageType struct{}
+
+func (x fastReflection_MsgUpdateBasketDateCriteriaResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateBasketDateCriteriaResponse)(nil)
+}
+func (x fastReflection_MsgUpdateBasketDateCriteriaResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateBasketDateCriteriaResponse)
+}
+func (x fastReflection_MsgUpdateBasketDateCriteriaResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateBasketDateCriteriaResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateBasketDateCriteriaResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateBasketDateCriteriaResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateBasketDateCriteriaResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateBasketDateCriteriaResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateBasketDateCriteriaResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateBasketDateCriteriaResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateBasketDateCriteriaResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateBasketDateCriteriaResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateBasketDateCriteriaResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateBasketDateCriteriaResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteriaResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteriaResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketDateCriteriaResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteriaResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteriaResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateBasketDateCriteriaResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteriaResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteriaResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketDateCriteriaResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteriaResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteriaResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketDateCriteriaResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteriaResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteriaResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateBasketDateCriteriaResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteriaResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteriaResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateBasketDateCriteriaResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteriaResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateBasketDateCriteriaResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketDateCriteriaResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateBasketDateCriteriaResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateBasketDateCriteriaResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateBasketDateCriteriaResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateBasketDateCriteriaResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateBasketDateCriteriaResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateBasketDateCriteriaResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateBasketDateCriteriaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateBasketDescription              protoreflect.MessageDescriptor
+	fd_MsgUpdateBasketDescription_curator      protoreflect.FieldDescriptor
+	fd_MsgUpdateBasketDescription_basket_denom protoreflect.FieldDescriptor
+	fd_MsgUpdateBasketDescription_description  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_basket_v1_tx_proto_init()
+	md_MsgUpdateBasketDescription = File_regen_ecocredit_basket_v1_tx_proto.Messages().ByName("MsgUpdateBasketDescription")
+	fd_MsgUpdateBasketDescription_curator = md_MsgUpdateBasketDescription.Fields().ByName("curator")
+	fd_MsgUpdateBasketDescription_basket_denom = md_MsgUpdateBasketDescription.Fields().ByName("basket_denom")
+	fd_MsgUpdateBasketDescription_description = md_MsgUpdateBasketDescription.Fields().ByName("description")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateBasketDescription)(nil)
+
+type fastReflection_MsgUpdateBasketDescription MsgUpdateBasketDescription
+
+func (x *MsgUpdateBasketDescription) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateBasketDescription)(x)
+}
+
+func (x *MsgUpdateBasketDescription) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateBasketDescription_messageType fastReflection_MsgUpdateBasketDescription_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateBasketDescription_messageType{}
+
+type fastReflection_MsgUpdateBasketDescription_messageType struct{}
+
+func (x fastReflection_MsgUpdateBasketDescription_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateBasketDescription)(nil)
+}
+func (x fastReflection_MsgUpdateBasketDescription_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateBasketDescription)
+}
+func (x fastReflection_MsgUpdateBasketDescription_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateBasketDescription
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateBasketDescription) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateBasketDescription
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateBasketDescription) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateBasketDescription_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateBasketDescription) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateBasketDescription)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateBasketDescription) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateBasketDescription)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateBasketDescription) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Curator != "" {
+		value := protoreflect.ValueOfString(x.Curator)
+		if !f(fd_MsgUpdateBasketDescription_curator, value) {
+			return
+		}
+	}
+	if x.BasketDenom != "" {
+		value := protoreflect.ValueOfString(x.BasketDenom)
+		if !f(fd_MsgUpdateBasketDescription_basket_denom, value) {
+			return
+		}
+	}
+	if x.Description != "" {
+		value := protoreflect.ValueOfString(x.Description)
+		if !f(fd_MsgUpdateBasketDescription_description, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateBasketDescription) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketDescription.curator":
+		return x.Curator != ""
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketDescription.basket_denom":
+		return x.BasketDenom != ""
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketDescription.description":
+		return x.Description != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketDescription"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketDescription does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketDescription) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketDescription.curator":
+		x.Curator = ""
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketDescription.basket_denom":
+		x.BasketDenom = ""
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketDescription.description":
+		x.Description = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketDescription"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketDescription does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateBasketDescription) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketDescription.curator":
+		value := x.Curator
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketDescription.basket_denom":
+		value := x.BasketDenom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketDescription.description":
+		value := x.Description
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketDescription"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketDescription does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketDescription) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketDescription.curator":
+		x.Curator = value.Interface().(string)
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketDescription.basket_denom":
+		x.BasketDenom = value.Interface().(string)
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketDescription.description":
+		x.Description = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketDescription"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketDescription does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketDescription) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketDescription.curator":
+		panic(fmt.Errorf("field curator of message regen.ecocredit.basket.v1.MsgUpdateBasketDescription is not mutable"))
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketDescription.basket_denom":
+		panic(fmt.Errorf("field basket_denom of message regen.ecocredit.basket.v1.MsgUpdateBasketDescription is not mutable"))
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketDescription.description":
+		panic(fmt.Errorf("field description of message regen.ecocredit.basket.v1.MsgUpdateBasketDescription is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketDescription"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketDescription does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateBasketDescription) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketDescription.curator":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketDescription.basket_denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketDescription.description":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketDescription"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketDescription does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateBasketDescription) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.basket.v1.MsgUpdateBasketDescription", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateBasketDescription) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketDescription) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateBasketDescription) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateBasketDescription) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateBasketDescription)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Curator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BasketDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Description)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateBasketDescription)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Description) > 0 {
+			i -= len(x.Description)
+			copy(dAtA[i:], x.Description)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Description)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.BasketDenom) > 0 {
+			i -= len(x.BasketDenom)
+			copy(dAtA[i:], x.BasketDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BasketDenom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Curator) > 0 {
+			i -= len(x.Curator)
+			copy(dAtA[i:], x.Curator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Curator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateBasketDescription)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateBasketDescription: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateBasketDescription: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Curator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BasketDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Description = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateBasketDescriptionResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_basket_v1_tx_proto_init()
+	md_MsgUpdateBasketDescriptionResponse = File_regen_ecocredit_basket_v1_tx_proto.Messages().ByName("MsgUpdateBasketDescriptionResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateBasketDescriptionResponse)(nil)
+
+type fastReflection_MsgUpdateBasketDescriptionResponse MsgUpdateBasketDescriptionResponse
+
+func (x *MsgUpdateBasketDescriptionResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateBasketDescriptionResponse)(x)
+}
+
+func (x *MsgUpdateBasketDescriptionResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateBasketDescriptionResponse_messageType fastReflection_MsgUpdateBasketDescriptionResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateBasketDescriptionResponse_messageType{}
+
+type fastReflection_MsgUpdateBasketDescriptionResponse_messageType struct{}
+
+func (x fastReflection_MsgUpdateBasketDescriptionResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateBasketDescriptionResponse)(nil)
+}
+func (x fastReflection_MsgUpdateBasketDescriptionResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateBasketDescriptionResponse)
+}
+func (x fastReflection_MsgUpdateBasketDescriptionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateBasketDescriptionResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateBasketDescriptionResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateBasketDescriptionResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateBasketDescriptionResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateBasketDescriptionResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateBasketDescriptionResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateBasketDescriptionResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateBasketDescriptionResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateBasketDescriptionResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateBasketDescriptionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateBasketDescriptionResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketDescriptionResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketDescriptionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketDescriptionResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketDescriptionResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketDescriptionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateBasketDescriptionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketDescriptionResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketDescriptionResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketDescriptionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketDescriptionResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketDescriptionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketDescriptionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketDescriptionResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketDescriptionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateBasketDescriptionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketDescriptionResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketDescriptionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateBasketDescriptionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.basket.v1.MsgUpdateBasketDescriptionResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateBasketDescriptionResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketDescriptionResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateBasketDescriptionResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateBasketDescriptionResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateBasketDescriptionResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateBasketDescriptionResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateBasketDescriptionResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateBasketDescriptionResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateBasketDescriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateBasketTakePremium                  protoreflect.MessageDescriptor
+	fd_MsgUpdateBasketTakePremium_curator          protoreflect.FieldDescriptor
+	fd_MsgUpdateBasketTakePremium_basket_denom     protoreflect.FieldDescriptor
+	fd_MsgUpdateBasketTakePremium_take_premium_bps protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_basket_v1_tx_proto_init()
+	md_MsgUpdateBasketTakePremium = File_regen_ecocredit_basket_v1_tx_proto.Messages().ByName("MsgUpdateBasketTakePremium")
+	fd_MsgUpdateBasketTakePremium_curator = md_MsgUpdateBasketTakePremium.Fields().ByName("curator")
+	fd_MsgUpdateBasketTakePremium_basket_denom = md_MsgUpdateBasketTakePremium.Fields().ByName("basket_denom")
+	fd_MsgUpdateBasketTakePremium_take_premium_bps = md_MsgUpdateBasketTakePremium.Fields().ByName("take_premium_bps")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateBasketTakePremium)(nil)
+
+type fastReflection_MsgUpdateBasketTakePremium MsgUpdateBasketTakePremium
+
+func (x *MsgUpdateBasketTakePremium) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateBasketTakePremium)(x)
+}
+
+func (x *MsgUpdateBasketTakePremium) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateBasketTakePremium_messageType fastReflection_MsgUpdateBasketTakePremium_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateBasketTakePremium_messageType{}
+
+type fastReflection_MsgUpdateBasketTakePremium_messageType struct{}
+
+func (x fastReflection_MsgUpdateBasketTakePremium_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateBasketTakePremium)(nil)
+}
+func (x fastReflection_MsgUpdateBasketTakePremium_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateBasketTakePremium)
+}
+func (x fastReflection_MsgUpdateBasketTakePremium_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateBasketTakePremium
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateBasketTakePremium) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateBasketTakePremium
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateBasketTakePremium) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateBasketTakePremium_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateBasketTakePremium) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateBasketTakePremium)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateBasketTakePremium) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateBasketTakePremium)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateBasketTakePremium) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Curator != "" {
+		value := protoreflect.ValueOfString(x.Curator)
+		if !f(fd_MsgUpdateBasketTakePremium_curator, value) {
+			return
+		}
+	}
+	if x.BasketDenom != "" {
+		value := protoreflect.ValueOfString(x.BasketDenom)
+		if !f(fd_MsgUpdateBasketTakePremium_basket_denom, value) {
+			return
+		}
+	}
+	if x.TakePremiumBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.TakePremiumBps)
+		if !f(fd_MsgUpdateBasketTakePremium_take_premium_bps, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateBasketTakePremium) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium.curator":
+		return x.Curator != ""
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium.basket_denom":
+		return x.BasketDenom != ""
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium.take_premium_bps":
+		return x.TakePremiumBps != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketTakePremium) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium.curator":
+		x.Curator = ""
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium.basket_denom":
+		x.BasketDenom = ""
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium.take_premium_bps":
+		x.TakePremiumBps = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateBasketTakePremium) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium.curator":
+		value := x.Curator
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium.basket_denom":
+		value := x.BasketDenom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium.take_premium_bps":
+		value := x.TakePremiumBps
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketTakePremium) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium.curator":
+		x.Curator = value.Interface().(string)
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium.basket_denom":
+		x.BasketDenom = value.Interface().(string)
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium.take_premium_bps":
+		x.TakePremiumBps = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketTakePremium) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium.curator":
+		panic(fmt.Errorf("field curator of message regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium is not mutable"))
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium.basket_denom":
+		panic(fmt.Errorf("field basket_denom of message regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium is not mutable"))
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium.take_premium_bps":
+		panic(fmt.Errorf("field take_premium_bps of message regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateBasketTakePremium) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium.curator":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium.basket_denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium.take_premium_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateBasketTakePremium) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateBasketTakePremium) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketTakePremium) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateBasketTakePremium) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateBasketTakePremium) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateBasketTakePremium)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Curator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BasketDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TakePremiumBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.TakePremiumBps))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateBasketTakePremium)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.TakePremiumBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TakePremiumBps))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.BasketDenom) > 0 {
+			i -= len(x.BasketDenom)
+			copy(dAtA[i:], x.BasketDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BasketDenom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Curator) > 0 {
+			i -= len(x.Curator)
+			copy(dAtA[i:], x.Curator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Curator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateBasketTakePremium)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateBasketTakePremium: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateBasketTakePremium: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Curator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BasketDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TakePremiumBps", wireType)
+				}
+				x.TakePremiumBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TakePremiumBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateBasketTakePremiumResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_basket_v1_tx_proto_init()
+	md_MsgUpdateBasketTakePremiumResponse = File_regen_ecocredit_basket_v1_tx_proto.Messages().ByName("MsgUpdateBasketTakePremiumResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateBasketTakePremiumResponse)(nil)
+
+type fastReflection_MsgUpdateBasketTakePremiumResponse MsgUpdateBasketTakePremiumResponse
+
+func (x *MsgUpdateBasketTakePremiumResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateBasketTakePremiumResponse)(x)
+}
+
+func (x *MsgUpdateBasketTakePremiumResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateBasketTakePremiumResponse_messageType fastReflection_MsgUpdateBasketTakePremiumResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateBasketTakePremiumResponse_messageType{}
+
+type fastReflection_MsgUpdateBasketTakePremiumResponse_messageType struct{}
+
+func (x fastReflection_MsgUpdateBasketTakePremiumResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateBasketTakePremiumResponse)(nil)
+}
+func (x fastReflection_MsgUpdateBasketTakePremiumResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateBasketTakePremiumResponse)
+}
+func (x fastReflection_MsgUpdateBasketTakePremiumResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateBasketTakePremiumResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateBasketTakePremiumResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateBasketTakePremiumResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateBasketTakePremiumResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateBasketTakePremiumResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateBasketTakePremiumResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateBasketTakePremiumResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateBasketTakePremiumResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateBasketTakePremiumResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateBasketTakePremiumResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateBasketTakePremiumResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketTakePremiumResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketTakePremiumResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketTakePremiumResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketTakePremiumResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketTakePremiumResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateBasketTakePremiumResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketTakePremiumResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketTakePremiumResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketTakePremiumResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketTakePremiumResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketTakePremiumResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketTakePremiumResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketTakePremiumResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketTakePremiumResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateBasketTakePremiumResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketTakePremiumResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketTakePremiumResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateBasketTakePremiumResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.basket.v1.MsgUpdateBasketTakePremiumResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateBasketTakePremiumResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketTakePremiumResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateBasketTakePremiumResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateBasketTakePremiumResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateBasketTakePremiumResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateBasketTakePremiumResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateBasketTakePremiumResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateBasketTakePremiumResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateBasketTakePremiumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateBasketAdmissionCriteria                    protoreflect.MessageDescriptor
+	fd_MsgUpdateBasketAdmissionCriteria_curator            protoreflect.FieldDescriptor
+	fd_MsgUpdateBasketAdmissionCriteria_basket_denom       protoreflect.FieldDescriptor
+	fd_MsgUpdateBasketAdmissionCriteria_admission_criteria protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_basket_v1_tx_proto_init()
+	md_MsgUpdateBasketAdmissionCriteria = File_regen_ecocredit_basket_v1_tx_proto.Messages().ByName("MsgUpdateBasketAdmissionCriteria")
+	fd_MsgUpdateBasketAdmissionCriteria_curator = md_MsgUpdateBasketAdmissionCriteria.Fields().ByName("curator")
+	fd_MsgUpdateBasketAdmissionCriteria_basket_denom = md_MsgUpdateBasketAdmissionCriteria.Fields().ByName("basket_denom")
+	fd_MsgUpdateBasketAdmissionCriteria_admission_criteria = md_MsgUpdateBasketAdmissionCriteria.Fields().ByName("admission_criteria")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateBasketAdmissionCriteria)(nil)
+
+type fastReflection_MsgUpdateBasketAdmissionCriteria MsgUpdateBasketAdmissionCriteria
+
+func (x *MsgUpdateBasketAdmissionCriteria) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateBasketAdmissionCriteria)(x)
+}
+
+func (x *MsgUpdateBasketAdmissionCriteria) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateBasketAdmissionCriteria_messageType fastReflection_MsgUpdateBasketAdmissionCriteria_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateBasketAdmissionCriteria_messageType{}
+
+type fastReflection_MsgUpdateBasketAdmissionCriteria_messageType struct{}
+
+func (x fastReflection_MsgUpdateBasketAdmissionCriteria_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateBasketAdmissionCriteria)(nil)
+}
+func (x fastReflection_MsgUpdateBasketAdmissionCriteria_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateBasketAdmissionCriteria)
+}
+func (x fastReflection_MsgUpdateBasketAdmissionCriteria_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateBasketAdmissionCriteria
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateBasketAdmissionCriteria) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateBasketAdmissionCriteria
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateBasketAdmissionCriteria) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateBasketAdmissionCriteria_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateBasketAdmissionCriteria) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateBasketAdmissionCriteria)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateBasketAdmissionCriteria) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateBasketAdmissionCriteria)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateBasketAdmissionCriteria) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Curator != "" {
+		value := protoreflect.ValueOfString(x.Curator)
+		if !f(fd_MsgUpdateBasketAdmissionCriteria_curator, value) {
+			return
+		}
+	}
+	if x.BasketDenom != "" {
+		value := protoreflect.ValueOfString(x.BasketDenom)
+		if !f(fd_MsgUpdateBasketAdmissionCriteria_basket_denom, value) {
+			return
+		}
+	}
+	if x.AdmissionCriteria != nil {
+		value := protoreflect.ValueOfMessage(x.AdmissionCriteria.ProtoReflect())
+		if !f(fd_MsgUpdateBasketAdmissionCriteria_admission_criteria, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateBasketAdmissionCriteria) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria.curator":
+		return x.Curator != ""
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria.basket_denom":
+		return x.BasketDenom != ""
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria.admission_criteria":
+		return x.AdmissionCriteria != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketAdmissionCriteria) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria.curator":
+		x.Curator = ""
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria.basket_denom":
+		x.BasketDenom = ""
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria.admission_criteria":
+		x.AdmissionCriteria = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateBasketAdmissionCriteria) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria.curator":
+		value := x.Curator
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria.basket_denom":
+		value := x.BasketDenom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria.admission_criteria":
+		value := x.AdmissionCriteria
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketAdmissionCriteria) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria.curator":
+		x.Curator = value.Interface().(string)
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria.basket_denom":
+		x.BasketDenom = value.Interface().(string)
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria.admission_criteria":
+		x.AdmissionCriteria = value.Message().Interface().(*AdmissionCriteria)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketAdmissionCriteria) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria.admission_criteria":
+		if x.AdmissionCriteria == nil {
+			x.AdmissionCriteria = new(AdmissionCriteria)
+		}
+		return protoreflect.ValueOfMessage(x.AdmissionCriteria.ProtoReflect())
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria.curator":
+		panic(fmt.Errorf("field curator of message regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria is not mutable"))
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria.basket_denom":
+		panic(fmt.Errorf("field basket_denom of message regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateBasketAdmissionCriteria) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria.curator":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria.basket_denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria.admission_criteria":
+		m := new(AdmissionCriteria)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateBasketAdmissionCriteria) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateBasketAdmissionCriteria) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketAdmissionCriteria) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateBasketAdmissionCriteria) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateBasketAdmissionCriteria) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateBasketAdmissionCriteria)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Curator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BasketDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AdmissionCriteria != nil {
+			l = options.Size(x.AdmissionCriteria)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateBasketAdmissionCriteria)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.AdmissionCriteria != nil {
+			encoded, err := options.Marshal(x.AdmissionCriteria)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.BasketDenom) > 0 {
+			i -= len(x.BasketDenom)
+			copy(dAtA[i:], x.BasketDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BasketDenom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Curator) > 0 {
+			i -= len(x.Curator)
+			copy(dAtA[i:], x.Curator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Curator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateBasketAdmissionCriteria)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateBasketAdmissionCriteria: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateBasketAdmissionCriteria: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Curator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BasketDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AdmissionCriteria", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.AdmissionCriteria == nil {
+					x.AdmissionCriteria = &AdmissionCriteria{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AdmissionCriteria); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateBasketAdmissionCriteriaResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_basket_v1_tx_proto_init()
+	md_MsgUpdateBasketAdmissionCriteriaResponse = File_regen_ecocredit_basket_v1_tx_proto.Messages().ByName("MsgUpdateBasketAdmissionCriteriaResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateBasketAdmissionCriteriaResponse)(nil)
+
+type fastReflection_MsgUpdateBasketAdmissionCriteriaResponse MsgUpdateBasketAdmissionCriteriaResponse
+
+func (x *MsgUpdateBasketAdmissionCriteriaResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateBasketAdmissionCriteriaResponse)(x)
+}
+
+func (x *MsgUpdateBasketAdmissionCriteriaResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateBasketAdmissionCriteriaResponse_messageType fastReflection_MsgUpdateBasketAdmissionCriteriaResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateBasketAdmissionCriteriaResponse_messageType{}
+
+type fastReflection_MsgUpdateBasketAdmissionCriteriaResponse_messageType struct{}
+
+func (x fastReflection_MsgUpdateBasketAdmissionCriteriaResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateBasketAdmissionCriteriaResponse)(nil)
+}
+func (x fastReflection_MsgUpdateBasketAdmissionCriteriaResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateBasketAdmissionCriteriaResponse)
+}
+func (x fastReflection_MsgUpdateBasketAdmissionCriteriaResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateBasketAdmissionCriteriaResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateBasketAdmissionCriteriaResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateBasketAdmissionCriteriaResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateBasketAdmissionCriteriaResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateBasketAdmissionCriteriaResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateBasketAdmissionCriteriaResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateBasketAdmissionCriteriaResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateBasketAdmissionCriteriaResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateBasketAdmissionCriteriaResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateBasketAdmissionCriteriaResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateBasketAdmissionCriteriaResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteriaResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteriaResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketAdmissionCriteriaResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteriaResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteriaResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateBasketAdmissionCriteriaResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteriaResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteriaResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketAdmissionCriteriaResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteriaResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteriaResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketAdmissionCriteriaResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteriaResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteriaResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateBasketAdmissionCriteriaResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteriaResponse"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteriaResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateBasketAdmissionCriteriaResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteriaResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateBasketAdmissionCriteriaResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateBasketAdmissionCriteriaResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateBasketAdmissionCriteriaResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateBasketAdmissionCriteriaResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateBasketAdmissionCriteriaResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateBasketAdmissionCriteriaResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateBasketAdmissionCriteriaResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateBasketAdmissionCriteriaResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateBasketAdmissionCriteriaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: regen/ecocredit/basket/v1/tx.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MsgCreateBasket is the Msg/CreateBasket request type.
+type MsgCreate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// curator is the address of the basket curator who is able to change certain
+	// basket settings.
+	Curator string `protobuf:"bytes,1,opt,name=curator,proto3" json:"curator,omitempty"`
+	// name will be used to together with prefix to create a bank denom for this
+	// basket token. It can be between 3-8 alphanumeric characters, with the
+	// first character being alphabetic.
+	//
+	// The bank denom will be formed from name and credit type with the format
+	// `eco.<prefix><credit_type_abbrev>.<name>` where prefix is the prefix of
+	// a standard SI unit derived from credit type precision.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// description is a human-readable description of the basket denom that should
+	// be at most 256 characters.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Deprecated (Since Revision 1): This field is no longer used and will be
+	// removed in the next version. The value of credit type precision is always
+	// used as the exponent when determining the prefix for basket denom, defining
+	// bank denom metadata, and converting credits to/from basket tokens.
+	//
+	// Deprecated: Do not use.
+	Exponent uint32 `protobuf:"varint,4,opt,name=exponent,proto3" json:"exponent,omitempty"`
+	// disable_auto_retire allows auto-retirement to be disabled.
+	// The credits will be auto-retired if disable_auto_retire is
+	// false unless the credits were previously put into the basket by the
+	// address picking them from the basket, in which case they will remain
+	// tradable.
+	DisableAutoRetire bool `protobuf:"varint,5,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
+	// credit_type_abbrev is the abbreviation of the credit type this basket is
+	// able to hold.
+	CreditTypeAbbrev string `protobuf:"bytes,6,opt,name=credit_type_abbrev,json=creditTypeAbbrev,proto3" json:"credit_type_abbrev,omitempty"`
+	// allowed_classes are the credit classes allowed to be put in the basket
+	AllowedClasses []string `protobuf:"bytes,7,rep,name=allowed_classes,json=allowedClasses,proto3" json:"allowed_classes,omitempty"`
+	// date_criteria is the date criteria for batches admitted to the basket.
+	// At most, only one of the fields in the date_criteria should be set.
+	DateCriteria *DateCriteria `protobuf:"bytes,8,opt,name=date_criteria,json=dateCriteria,proto3" json:"date_criteria,omitempty"`
+	// fee is the basket creation fee. A fee is not required if the list of fees
+	// in Params.basket_fee is empty. The provided fee must be one of the fees
+	// listed in Params.basket_fee. The provided amount can be greater than
+	// or equal to the listed amount but the basket creator will only be charged
+	// the listed amount (i.e. the minimum amount).
+	//
+	// Note (Since Revision 1): Although this field supports a list of fees, the
+	// basket creator must provide no more than one fee (i.e. one Coin in a list
+	// of Coins). Providing more than one fee will fail basic message validation.
+	// This field will be updated to a single fee rather than a list of fees in
+	// the next version to reflect these requirements.
+	Fee []*v1beta1.Coin `protobuf:"bytes,9,rep,name=fee,proto3" json:"fee,omitempty"`
+	// admission_criteria is the optional additional criteria for batches
+	// admitted to the basket.
+	AdmissionCriteria *AdmissionCriteria `protobuf:"bytes,10,opt,name=admission_criteria,json=admissionCriteria,proto3" json:"admission_criteria,omitempty"`
+}
+
+func (x *MsgCreate) Reset() {
+	*x = MsgCreate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreate) ProtoMessage() {}
+
+// Deprecated: Use MsgCreate.ProtoReflect.Descriptor instead.
+func (*MsgCreate) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_basket_v1_tx_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MsgCreate) GetCurator() string {
+	if x != nil {
+		return x.Curator
+	}
+	return ""
+}
+
+func (x *MsgCreate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MsgCreate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (x *MsgCreate) GetExponent() uint32 {
+	if x != nil {
+		return x.Exponent
+	}
+	return 0
+}
+
+func (x *MsgCreate) GetDisableAutoRetire() bool {
+	if x != nil {
+		return x.DisableAutoRetire
+	}
+	return false
+}
+
+func (x *MsgCreate) GetCreditTypeAbbrev() string {
+	if x != nil {
+		return x.CreditTypeAbbrev
+	}
+	return ""
+}
+
+func (x *MsgCreate) GetAllowedClasses() []string {
+	if x != nil {
+		return x.AllowedClasses
+	}
+	return nil
+}
+
+func (x *MsgCreate) GetDateCriteria() *DateCriteria {
+	if x != nil {
+		return x.DateCriteria
+	}
+	return nil
+}
+
+func (x *MsgCreate) GetFee() []*v1beta1.Coin {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+func (x *MsgCreate) GetAdmissionCriteria() *AdmissionCriteria {
+	if x != nil {
+		return x.AdmissionCriteria
+	}
+	return nil
+}
+
+// MsgCreateBasketResponse is the Msg/CreateBasket response type.
+type MsgCreateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// basket_denom is the unique denomination ID of the newly created basket.
+	BasketDenom string `protobuf:"bytes,1,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+}
+
+func (x *MsgCreateResponse) Reset() {
+	*x = MsgCreateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreateResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgCreateResponse.ProtoReflect.Descriptor instead.
+func (*MsgCreateResponse) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_basket_v1_tx_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MsgCreateResponse) GetBasketDenom() string {
+	if x != nil {
+		return x.BasketDenom
+	}
+	return ""
+}
+
+// MsgAddToBasket is the Msg/AddToBasket request type.
+type MsgPut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// owner is the owner of credits being put into the basket.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// basket_denom is the basket denom to add credits to.
+	BasketDenom string `protobuf:"bytes,2,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// credits are credits to add to the basket. If they do not match the basket's
+	// admission criteria the operation will fail. If there are any "dust" credits
+	// left over when converting credits to basket tokens, these credits will
+	// not be converted to basket tokens and instead remain with the owner.
+	Credits []*BasketCredit `protobuf:"bytes,3,rep,name=credits,proto3" json:"credits,omitempty"`
+}
+
+func (x *MsgPut) Reset() {
+	*x = MsgPut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgPut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgPut) ProtoMessage() {}
+
+// Deprecated: Use MsgPut.ProtoReflect.Descriptor instead.
+func (*MsgPut) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_basket_v1_tx_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MsgPut) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *MsgPut) GetBasketDenom() string {
+	if x != nil {
+		return x.BasketDenom
+	}
+	return ""
+}
+
+func (x *MsgPut) GetCredits() []*BasketCredit {
+	if x != nil {
+		return x.Credits
+	}
+	return nil
+}
+
+// MsgAddToBasketResponse is the Msg/AddToBasket response type.
+type MsgPutResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// amount_received is the integer amount of basket tokens received.
+	AmountReceived string `protobuf:"bytes,1,opt,name=amount_received,json=amountReceived,proto3" json:"amount_received,omitempty"`
+}
+
+func (x *MsgPutResponse) Reset() {
+	*x = MsgPutResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgPutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgPutResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgPutResponse.ProtoReflect.Descriptor instead.
+func (*MsgPutResponse) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_basket_v1_tx_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MsgPutResponse) GetAmountReceived() string {
+	if x != nil {
+		return x.AmountReceived
+	}
+	return ""
+}
+
+// MsgTakeFromBasket is the Msg/TakeFromBasket request type.
+type MsgTake struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// owner is the owner of the basket tokens.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// basket_denom is the basket bank denom to take credits from.
+	BasketDenom string `protobuf:"bytes,2,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// amount is the integer number of basket tokens to convert into credits.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// retirement_location is the optional retirement jurisdiction for the
+	// credits which will be used only if retire_on_take is true for this basket.
+	//
+	// Deprecated (Since Revision 1): This field will be removed in the next
+	// version in favor of retirement_jurisdiction. Only one of these need to be
+	// set and retirement_jurisdiction will be used if both are set.
+	//
+	// Deprecated: Do not use.
+	RetirementLocation string `protobuf:"bytes,4,opt,name=retirement_location,json=retirementLocation,proto3" json:"retirement_location,omitempty"`
+	// retire_on_take is a boolean that dictates whether the ecocredits
+	// received in exchange for the basket tokens will be received as
+	// retired or tradable credits.
+	RetireOnTake bool `protobuf:"varint,5,opt,name=retire_on_take,json=retireOnTake,proto3" json:"retire_on_take,omitempty"`
+	// retirement_jurisdiction is the optional retirement jurisdiction for the
+	// credits which will be used only if retire_on_take is true for this basket.
+	//
+	// Since Revision 1
+	RetirementJurisdiction string `protobuf:"bytes,6,opt,name=retirement_jurisdiction,json=retirementJurisdiction,proto3" json:"retirement_jurisdiction,omitempty"`
+	// beneficiary_address is the optional address of the account on whose
+	// behalf the credits are retired, which will be used only if retire_on_take
+	// is true. If set, the retired credits are added to the retired balance of
+	// the beneficiary rather than the owner.
+	BeneficiaryAddress string `protobuf:"bytes,7,opt,name=beneficiary_address,json=beneficiaryAddress,proto3" json:"beneficiary_address,omitempty"`
+	// beneficiary_name is the optional name of the individual or organization
+	// on whose behalf the credits are retired, which will be used only if
+	// retire_on_take is true.
+	BeneficiaryName string `protobuf:"bytes,8,opt,name=beneficiary_name,json=beneficiaryName,proto3" json:"beneficiary_name,omitempty"`
+	// reason is the optional reason for retiring the credits, which will be used
+	// only if retire_on_take is true.
+	Reason string `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	// strategy is the strategy used to select the credits taken from the basket.
+	// If unspecified, the oldest credits are taken first. Strategies other than
+	// oldest first are charged the take premium of the basket.
+	Strategy TakeStrategy `protobuf:"varint,10,opt,name=strategy,proto3,enum=regen.ecocredit.basket.v1.TakeStrategy" json:"strategy,omitempty"`
+	// batch_denoms are the batches to take credits from in the order they are
+	// listed, which must be set if and only if strategy is
+	// TAKE_STRATEGY_SELECTED_BATCHES.
+	BatchDenoms []string `protobuf:"bytes,11,rep,name=batch_denoms,json=batchDenoms,proto3" json:"batch_denoms,omitempty"`
+	// max_premium is the optional maximum integer number of basket tokens the
+	// owner is willing to pay as the take premium of the basket. If set and the
+	// premium exceeds the maximum, the take fails.
+	MaxPremium string `protobuf:"bytes,12,opt,name=max_premium,json=maxPremium,proto3" json:"max_premium,omitempty"`
+}
+
+func (x *MsgTake) Reset() {
+	*x = MsgTake{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgTake) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgTake) ProtoMessage() {}
+
+// Deprecated: Use MsgTake.ProtoReflect.Descriptor instead.
+func (*MsgTake) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_basket_v1_tx_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MsgTake) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *MsgTake) GetBasketDenom() string {
+	if x != nil {
+		return x.BasketDenom
+	}
+	return ""
+}
+
+func (x *MsgTake) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (x *MsgTake) GetRetirementLocation() string {
+	if x != nil {
+		return x.RetirementLocation
+	}
+	return ""
+}
+
+func (x *MsgTake) GetRetireOnTake() bool {
+	if x != nil {
+		return x.RetireOnTake
+	}
+	return false
+}
+
+func (x *MsgTake) GetRetirementJurisdiction() string {
+	if x != nil {
+		return x.RetirementJurisdiction
+	}
+	return ""
+}
+
+func (x *MsgTake) GetBeneficiaryAddress() string {
+	if x != nil {
+		return x.BeneficiaryAddress
+	}
+	return ""
+}
+
+func (x *MsgTake) GetBeneficiaryName() string {
+	if x != nil {
+		return x.BeneficiaryName
+	}
+	return ""
+}
+
+func (x *MsgTake) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *MsgTake) GetStrategy() TakeStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return TakeStrategy_TAKE_STRATEGY_UNSPECIFIED
+}
+
+func (x *MsgTake) GetBatchDenoms() []string {
+	if x != nil {
+		return x.BatchDenoms
+	}
+	return nil
+}
+
+func (x *MsgTake) GetMaxPremium() string {
+	if x != nil {
+		return x.MaxPremium
+	}
+	return ""
+}
+
+// MsgTakeFromBasketResponse is the Msg/TakeFromBasket response type.
+type MsgTakeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// credits are the credits taken out of the basket.
+	Credits []*BasketCredit `protobuf:"bytes,1,rep,name=credits,proto3" json:"credits,omitempty"`
+	// premium is the integer number of basket tokens paid to the basket curator
+	// as the take premium. Empty if no premium was charged.
+	Premium string `protobuf:"bytes,2,opt,name=premium,proto3" json:"premium,omitempty"`
+}
+
+func (x *MsgTakeResponse) Reset() {
+	*x = MsgTakeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgTakeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgTakeResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgTakeResponse.ProtoReflect.Descriptor instead.
+func (*MsgTakeResponse) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_basket_v1_tx_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MsgTakeResponse) GetCredits() []*BasketCredit {
+	if x != nil {
+		return x.Credits
+	}
+	return nil
+}
+
+func (x *MsgTakeResponse) GetPremium() string {
+	if x != nil {
+		return x.Premium
+	}
+	return ""
+}
+
+// MsgUpdateBasketCurator is the Msg/UpdateBasketCurator request type.
+type MsgUpdateBasketCurator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// curator is the address of the current basket curator.
+	Curator string `protobuf:"bytes,1,opt,name=curator,proto3" json:"curator,omitempty"`
+	// basket_denom is the basket bank denom of the basket to update.
+	BasketDenom string `protobuf:"bytes,2,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// new_curator is the address of the new basket curator.
+	NewCurator string `protobuf:"bytes,3,opt,name=new_curator,json=newCurator,proto3" json:"new_curator,omitempty"`
+}
+
+func (x *MsgUpdateBasketCurator) Reset() {
+	*x = MsgUpdateBasketCurator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateBasketCurator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateBasketCurator) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateBasketCurator.ProtoReflect.Descriptor instead.
+func (*MsgUpdateBasketCurator) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_basket_v1_tx_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MsgUpdateBasketCurator) GetCurator() string {
+	if x != nil {
+		return x.Curator
+	}
+	return ""
+}
+
+func (x *MsgUpdateBasketCurator) GetBasketDenom() string {
+	if x != nil {
+		return x.BasketDenom
+	}
+	return ""
+}
+
+func (x *MsgUpdateBasketCurator) GetNewCurator() string {
+	if x != nil {
+		return x.NewCurator
+	}
+	return ""
+}
+
+// MsgUpdateBasketCuratorResponse is the Msg/UpdateBasketCurator response type.
+type MsgUpdateBasketCuratorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgUpdateBasketCuratorResponse) Reset() {
+	*x = MsgUpdateBasketCuratorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateBasketCuratorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateBasketCuratorResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateBasketCuratorResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateBasketCuratorResponse) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_basket_v1_tx_proto_rawDescGZIP(), []int{7}
+}
+
+// MsgAddBasketClasses is the Msg/AddBasketClasses request type.
+type MsgAddBasketClasses struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// curator is the address of the basket curator.
+	Curator string `protobuf:"bytes,1,opt,name=curator,proto3" json:"curator,omitempty"`
+	// basket_denom is the basket bank denom of the basket to update.
+	BasketDenom string `protobuf:"bytes,2,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// class_ids are the credit classes to add to the allowed classes of the
+	// basket. The credit classes must exist and have the same credit type as
+	// the basket.
+	ClassIds []string `protobuf:"bytes,3,rep,name=class_ids,json=classIds,proto3" json:"class_ids,omitempty"`
+}
+
+func (x *MsgAddBasketClasses) Reset() {
+	*x = MsgAddBasketClasses{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgAddBasketClasses) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgAddBasketClasses) ProtoMessage() {}
+
+// Deprecated: Use MsgAddBasketClasses.ProtoReflect.Descriptor instead.
+func (*MsgAddBasketClasses) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_basket_v1_tx_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MsgAddBasketClasses) GetCurator() string {
+	if x != nil {
+		return x.Curator
+	}
+	return ""
+}
+
+func (x *MsgAddBasketClasses) GetBasketDenom() string {
+	if x != nil {
+		return x.BasketDenom
+	}
+	return ""
+}
+
+func (x *MsgAddBasketClasses) GetClassIds() []string {
+	if x != nil {
+		return x.ClassIds
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	return nil
+}
+
+// MsgAddBasketClassesResponse is the Msg/AddBasketClasses response type.
+type MsgAddBasketClassesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgAddBasketClassesResponse) Reset() {
+	*x = MsgAddBasketClassesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: regen/ecocredit/basket/v1/tx.proto
+func (x *MsgAddBasketClassesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+func (*MsgAddBasketClassesResponse) ProtoMessage() {}
 
-// MsgCreateBasket is the Msg/CreateBasket request type.
-type MsgCreate struct {
+// Deprecated: Use MsgAddBasketClassesResponse.ProtoReflect.Descriptor instead.
+func (*MsgAddBasketClassesResponse) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_basket_v1_tx_proto_rawDescGZIP(), []int{9}
+}
+
+// MsgRemoveBasketClasses is the Msg/RemoveBasketClasses request type.
+type MsgRemoveBasketClasses struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// curator is the address of the basket curator who is able to change certain
-	// basket settings.
+	// curator is the address of the basket curator.
 	Curator string `protobuf:"bytes,1,opt,name=curator,proto3" json:"curator,omitempty"`
-	// name will be used to together with prefix to create a bank denom for this
-	// basket token. It can be between 3-8 alphanumeric characters, with the
-	// first character being alphabetic.
-	//
-	// The bank denom will be formed from name and credit type with the format
-	// `eco.<prefix><credit_type_abbrev>.<name>` where prefix is the prefix of
-	// a standard SI unit derived from credit type precision.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// description is a human-readable description of the basket denom that should
-	// be at most 256 characters.
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// Deprecated (Since Revision 1): This field is no longer used and will be
-	// removed in the next version. The value of credit type precision is always
-	// used as the exponent when determining the prefix for basket denom, defining
-	// bank denom metadata, and converting credits to/from basket tokens.
-	//
-	// Deprecated: Do not use.
-	Exponent uint32 `protobuf:"varint,4,opt,name=exponent,proto3" json:"exponent,omitempty"`
-	// disable_auto_retire allows auto-retirement to be disabled.
-	// The credits will be auto-retired if disable_auto_retire is
-	// false unless the credits were previously put into the basket by the
-	// address picking them from the basket, in which case they will remain
-	// tradable.
-	DisableAutoRetire bool `protobuf:"varint,5,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
-	// credit_type_abbrev is the abbreviation of the credit type this basket is
-	// able to hold.
-	CreditTypeAbbrev string `protobuf:"bytes,6,opt,name=credit_type_abbrev,json=creditTypeAbbrev,proto3" json:"credit_type_abbrev,omitempty"`
-	// allowed_classes are the credit classes allowed to be put in the basket
-	AllowedClasses []string `protobuf:"bytes,7,rep,name=allowed_classes,json=allowedClasses,proto3" json:"allowed_classes,omitempty"`
-	// date_criteria is the date criteria for batches admitted to the basket.
-	// At most, only one of the fields in the date_criteria should be set.
-	DateCriteria *DateCriteria `protobuf:"bytes,8,opt,name=date_criteria,json=dateCriteria,proto3" json:"date_criteria,omitempty"`
-	// fee is the basket creation fee. A fee is not required if the list of fees
-	// in Params.basket_fee is empty. The provided fee must be one of the fees
-	// listed in Params.basket_fee. The provided amount can be greater than
-	// or equal to the listed amount but the basket creator will only be charged
-	// the listed amount (i.e. the minimum amount).
-	//
-	// Note (Since Revision 1): Although this field supports a list of fees, the
-	// basket creator must provide no more than one fee (i.e. one Coin in a list
-	// of Coins). Providing more than one fee will fail basic message validation.
-	// This field will be updated to a single fee rather than a list of fees in
-	// the next version to reflect these requirements.
-	Fee []*v1beta1.Coin `protobuf:"bytes,9,rep,name=fee,proto3" json:"fee,omitempty"`
+	// basket_denom is the basket bank denom of the basket to update.
+	BasketDenom string `protobuf:"bytes,2,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// class_ids are the credit classes to remove from the allowed classes of the
+	// basket. At least one allowed class must remain after the removal.
+	ClassIds []string `protobuf:"bytes,3,rep,name=class_ids,json=classIds,proto3" json:"class_ids,omitempty"`
 }
 
-func (x *MsgCreate) Reset() {
-	*x = MsgCreate{}
+func (x *MsgRemoveBasketClasses) Reset() {
+	*x = MsgRemoveBasketClasses{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[0]
+		mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreate) String() string {
+func (x *MsgRemoveBasketClasses) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreate) ProtoMessage() {}
+func (*MsgRemoveBasketClasses) ProtoMessage() {}
 
-// Deprecated: Use MsgCreate.ProtoReflect.Descriptor instead.
-func (*MsgCreate) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_basket_v1_tx_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use MsgRemoveBasketClasses.ProtoReflect.Descriptor instead.
+func (*MsgRemoveBasketClasses) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_basket_v1_tx_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *MsgCreate) GetCurator() string {
+func (x *MsgRemoveBasketClasses) GetCurator() string {
 	if x != nil {
 		return x.Curator
 	}
 	return ""
 }
 
-func (x *MsgCreate) GetName() string {
+func (x *MsgRemoveBasketClasses) GetBasketDenom() string {
 	if x != nil {
-		return x.Name
+		return x.BasketDenom
 	}
 	return ""
 }
 
-func (x *MsgCreate) GetDescription() string {
+func (x *MsgRemoveBasketClasses) GetClassIds() []string {
 	if x != nil {
-		return x.Description
+		return x.ClassIds
 	}
-	return ""
+	return nil
 }
 
-// Deprecated: Do not use.
-func (x *MsgCreate) GetExponent() uint32 {
-	if x != nil {
-		return x.Exponent
+// MsgRemoveBasketClassesResponse is the Msg/RemoveBasketClasses response type.
+type MsgRemoveBasketClassesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgRemoveBasketClassesResponse) Reset() {
+	*x = MsgRemoveBasketClassesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *MsgCreate) GetDisableAutoRetire() bool {
-	if x != nil {
-		return x.DisableAutoRetire
+func (x *MsgRemoveBasketClassesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRemoveBasketClassesResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgRemoveBasketClassesResponse.ProtoReflect.Descriptor instead.
+func (*MsgRemoveBasketClassesResponse) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_basket_v1_tx_proto_rawDescGZIP(), []int{11}
+}
+
+// MsgUpdateBasketDateCriteria is the Msg/UpdateBasketDateCriteria request
+// type.
+type MsgUpdateBasketDateCriteria struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// curator is the address of the basket curator.
+	Curator string `protobuf:"bytes,1,opt,name=curator,proto3" json:"curator,omitempty"`
+	// basket_denom is the basket bank denom of the basket to update.
+	BasketDenom string `protobuf:"bytes,2,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// date_criteria is the new date criteria for batches admitted to the basket.
+	// At most, only one of the fields in the date_criteria should be set. If
+	// empty, the date criteria of the basket will be removed.
+	DateCriteria *DateCriteria `protobuf:"bytes,3,opt,name=date_criteria,json=dateCriteria,proto3" json:"date_criteria,omitempty"`
+}
+
+func (x *MsgUpdateBasketDateCriteria) Reset() {
+	*x = MsgUpdateBasketDateCriteria{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *MsgCreate) GetCreditTypeAbbrev() string {
+func (x *MsgUpdateBasketDateCriteria) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateBasketDateCriteria) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateBasketDateCriteria.ProtoReflect.Descriptor instead.
+func (*MsgUpdateBasketDateCriteria) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_basket_v1_tx_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MsgUpdateBasketDateCriteria) GetCurator() string {
 	if x != nil {
-		return x.CreditTypeAbbrev
+		return x.Curator
 	}
 	return ""
 }
 
-func (x *MsgCreate) GetAllowedClasses() []string {
+func (x *MsgUpdateBasketDateCriteria) GetBasketDenom() string {
 	if x != nil {
-		return x.AllowedClasses
+		return x.BasketDenom
 	}
-	return nil
+	return ""
 }
 
-func (x *MsgCreate) GetDateCriteria() *DateCriteria {
+func (x *MsgUpdateBasketDateCriteria) GetDateCriteria() *DateCriteria {
 	if x != nil {
 		return x.DateCriteria
 	}
 	return nil
 }
 
-func (x *MsgCreate) GetFee() []*v1beta1.Coin {
-	if x != nil {
-		return x.Fee
+// MsgUpdateBasketDateCriteriaResponse is the Msg/UpdateBasketDateCriteria
+// response type.
+type MsgUpdateBasketDateCriteriaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgUpdateBasketDateCriteriaResponse) Reset() {
+	*x = MsgUpdateBasketDateCriteriaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-// MsgCreateBasketResponse is the Msg/CreateBasket response type.
-type MsgCreateResponse struct {
+func (x *MsgUpdateBasketDateCriteriaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateBasketDateCriteriaResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateBasketDateCriteriaResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateBasketDateCriteriaResponse) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_basket_v1_tx_proto_rawDescGZIP(), []int{13}
+}
+
+// MsgUpdateBasketDescription is the Msg/UpdateBasketDescription request type.
+type MsgUpdateBasketDescription struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// basket_denom is the unique denomination ID of the newly created basket.
-	BasketDenom string `protobuf:"bytes,1,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// curator is the address of the basket curator.
+	Curator string `protobuf:"bytes,1,opt,name=curator,proto3" json:"curator,omitempty"`
+	// basket_denom is the basket bank denom of the basket to update.
+	BasketDenom string `protobuf:"bytes,2,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// description is the new human-readable description of the basket denom
+	// that should be at most 256 characters.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 }
 
-func (x *MsgCreateResponse) Reset() {
-	*x = MsgCreateResponse{}
+func (x *MsgUpdateBasketDescription) Reset() {
+	*x = MsgUpdateBasketDescription{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[1]
+		mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreateResponse) String() string {
+func (x *MsgUpdateBasketDescription) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreateResponse) ProtoMessage() {}
+func (*MsgUpdateBasketDescription) ProtoMessage() {}
 
-// Deprecated: Use MsgCreateResponse.ProtoReflect.Descriptor instead.
-func (*MsgCreateResponse) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_basket_v1_tx_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use MsgUpdateBasketDescription.ProtoReflect.Descriptor instead.
+func (*MsgUpdateBasketDescription) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_basket_v1_tx_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *MsgCreateResponse) GetBasketDenom() string {
+func (x *MsgUpdateBasketDescription) GetCurator() string {
+	if x != nil {
+		return x.Curator
+	}
+	return ""
+}
+
+func (x *MsgUpdateBasketDescription) GetBasketDenom() string {
 	if x != nil {
 		return x.BasketDenom
 	}
 	return ""
 }
 
-// MsgAddToBasket is the Msg/AddToBasket request type.
-type MsgPut struct {
+func (x *MsgUpdateBasketDescription) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// MsgUpdateBasketDescriptionResponse is the Msg/UpdateBasketDescription
+// response type.
+type MsgUpdateBasketDescriptionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	// owner is the owner of credits being put into the basket.
-	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	// basket_denom is the basket denom to add credits to.
+func (x *MsgUpdateBasketDescriptionResponse) Reset() {
+	*x = MsgUpdateBasketDescriptionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateBasketDescriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateBasketDescriptionResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateBasketDescriptionResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateBasketDescriptionResponse) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_basket_v1_tx_proto_rawDescGZIP(), []int{15}
+}
+
+// MsgUpdateBasketTakePremium is the Msg/UpdateBasketTakePremium request type.
+type MsgUpdateBasketTakePremium struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// curator is the address of the basket curator.
+	Curator string `protobuf:"bytes,1,opt,name=curator,proto3" json:"curator,omitempty"`
+	// basket_denom is the basket bank denom of the basket to update.
 	BasketDenom string `protobuf:"bytes,2,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
-	// credits are credits to add to the basket. If they do not match the basket's
-	// admission criteria the operation will fail. If there are any "dust" credits
-	// left over when converting credits to basket tokens, these credits will
-	// not be converted to basket tokens and instead remain with the owner.
-	Credits []*BasketCredit `protobuf:"bytes,3,rep,name=credits,proto3" json:"credits,omitempty"`
+	// take_premium_bps is the premium in basis points of the basket tokens being
+	// converted that is paid to the curator when credits are taken from the
+	// basket with a strategy other than oldest first. The premium cannot exceed
+	// 10000 basis points.
+	TakePremiumBps uint32 `protobuf:"varint,3,opt,name=take_premium_bps,json=takePremiumBps,proto3" json:"take_premium_bps,omitempty"`
 }
 
-func (x *MsgPut) Reset() {
-	*x = MsgPut{}
+func (x *MsgUpdateBasketTakePremium) Reset() {
+	*x = MsgUpdateBasketTakePremium{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[2]
+		mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgPut) String() string {
+func (x *MsgUpdateBasketTakePremium) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgPut) ProtoMessage() {}
+func (*MsgUpdateBasketTakePremium) ProtoMessage() {}
 
-// Deprecated: Use MsgPut.ProtoReflect.Descriptor instead.
-func (*MsgPut) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_basket_v1_tx_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use MsgUpdateBasketTakePremium.ProtoReflect.Descriptor instead.
+func (*MsgUpdateBasketTakePremium) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_basket_v1_tx_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *MsgPut) GetOwner() string {
+func (x *MsgUpdateBasketTakePremium) GetCurator() string {
 	if x != nil {
-		return x.Owner
+		return x.Curator
 	}
 	return ""
 }
 
-func (x *MsgPut) GetBasketDenom() string {
+func (x *MsgUpdateBasketTakePremium) GetBasketDenom() string {
 	if x != nil {
 		return x.BasketDenom
 	}
 	return ""
 }
 
-func (x *MsgPut) GetCredits() []*BasketCredit {
+func (x *MsgUpdateBasketTakePremium) GetTakePremiumBps() uint32 {
 	if x != nil {
-		return x.Credits
+		return x.TakePremiumBps
 	}
-	return nil
+	return 0
 }
 
-// MsgAddToBasketResponse is the Msg/AddToBasket response type.
-type MsgPutResponse struct {
+// MsgUpdateBasketTakePremiumResponse is the Msg/UpdateBasketTakePremium
+// response type.
+type MsgUpdateBasketTakePremiumResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// amount_received is the integer amount of basket tokens received.
-	AmountReceived string `protobuf:"bytes,1,opt,name=amount_received,json=amountReceived,proto3" json:"amount_received,omitempty"`
 }
-
-func (x *MsgPutResponse) Reset() {
-	*x = MsgPutResponse{}
+
+func (x *MsgUpdateBasketTakePremiumResponse) Reset() {
+	*x = MsgUpdateBasketTakePremiumResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[3]
+		mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgPutResponse) String() string {
+func (x *MsgUpdateBasketTakePremiumResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgPutResponse) ProtoMessage() {}
-
-// Deprecated: Use MsgPutResponse.ProtoReflect.Descriptor instead.
-func (*MsgPutResponse) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_basket_v1_tx_proto_rawDescGZIP(), []int{3}
-}
+func (*MsgUpdateBasketTakePremiumResponse) ProtoMessage() {}
 
-func (x *MsgPutResponse) GetAmountReceived() string {
-	if x != nil {
-		return x.AmountReceived
-	}
-	return ""
+// Deprecated: Use MsgUpdateBasketTakePremiumResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateBasketTakePremiumResponse) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_basket_v1_tx_proto_rawDescGZIP(), []int{17}
 }
 
-// MsgTakeFromBasket is the Msg/TakeFromBasket request type.
-type MsgTake struct {
+// MsgUpdateBasketAdmissionCriteria is the Msg/UpdateBasketAdmissionCriteria
+// request type.
+type MsgUpdateBasketAdmissionCriteria struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// owner is the owner of the basket tokens.
-	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	// basket_denom is the basket bank denom to take credits from.
+	// curator is the address of the basket curator.
+	Curator string `protobuf:"bytes,1,opt,name=curator,proto3" json:"curator,omitempty"`
+	// basket_denom is the basket bank denom of the basket to update.
 	BasketDenom string `protobuf:"bytes,2,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
-	// amount is the integer number of basket tokens to convert into credits.
-	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	// retirement_location is the optional retirement jurisdiction for the
-	// credits which will be used only if retire_on_take is true for this basket.
-	//
-	// Deprecated (Since Revision 1): This field will be removed in the next
-	// version in favor of retirement_jurisdiction. Only one of these need to be
-	// set and retirement_jurisdiction will be used if both are set.
-	//
-	// Deprecated: Do not use.
-	RetirementLocation string `protobuf:"bytes,4,opt,name=retirement_location,json=retirementLocation,proto3" json:"retirement_location,omitempty"`
-	// retire_on_take is a boolean that dictates whether the ecocredits
-	// received in exchange for the basket tokens will be received as
-	// retired or tradable credits.
-	RetireOnTake bool `protobuf:"varint,5,opt,name=retire_on_take,json=retireOnTake,proto3" json:"retire_on_take,omitempty"`
-	// retirement_jurisdiction is the optional retirement jurisdiction for the
-	// credits which will be used only if retire_on_take is true for this basket.
-	//
-	// Since Revision 1
-	RetirementJurisdiction string `protobuf:"bytes,6,opt,name=retirement_jurisdiction,json=retirementJurisdiction,proto3" json:"retirement_jurisdiction,omitempty"`
+	// admission_criteria is the new additional criteria for batches admitted to
+	// the basket. If empty, the admission criteria of the basket will be
+	// removed.
+	AdmissionCriteria *AdmissionCriteria `protobuf:"bytes,3,opt,name=admission_criteria,json=admissionCriteria,proto3" json:"admission_criteria,omitempty"`
 }
 
-func (x *MsgTake) Reset() {
-	*x = MsgTake{}
+func (x *MsgUpdateBasketAdmissionCriteria) Reset() {
+	*x = MsgUpdateBasketAdmissionCriteria{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[4]
+		mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgTake) String() string {
+func (x *MsgUpdateBasketAdmissionCriteria) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgTake) ProtoMessage() {}
+func (*MsgUpdateBasketAdmissionCriteria) ProtoMessage() {}
 
-// Deprecated: Use MsgTake.ProtoReflect.Descriptor instead.
-func (*MsgTake) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_basket_v1_tx_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use MsgUpdateBasketAdmissionCriteria.ProtoReflect.Descriptor instead.
+func (*MsgUpdateBasketAdmissionCriteria) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_basket_v1_tx_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *MsgTake) GetOwner() string {
+func (x *MsgUpdateBasketAdmissionCriteria) GetCurator() string {
 	if x != nil {
-		return x.Owner
+		return x.Curator
 	}
 	return ""
 }
 
-func (x *MsgTake) GetBasketDenom() string {
+func (x *MsgUpdateBasketAdmissionCriteria) GetBasketDenom() string {
 	if x != nil {
 		return x.BasketDenom
 	}
 	return ""
 }
 
-func (x *MsgTake) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
-}
-
-// Deprecated: Do not use.
-func (x *MsgTake) GetRetirementLocation() string {
-	if x != nil {
-		return x.RetirementLocation
-	}
-	return ""
-}
-
-func (x *MsgTake) GetRetireOnTake() bool {
-	if x != nil {
-		return x.RetireOnTake
-	}
-	return false
-}
-
-func (x *MsgTake) GetRetirementJurisdiction() string {
+func (x *MsgUpdateBasketAdmissionCriteria) GetAdmissionCriteria() *AdmissionCriteria {
 	if x != nil {
-		return x.RetirementJurisdiction
+		return x.AdmissionCriteria
 	}
-	return ""
+	return nil
 }
 
-// MsgTakeFromBasketResponse is the Msg/TakeFromBasket response type.
-type MsgTakeResponse struct {
+// MsgUpdateBasketAdmissionCriteriaResponse is the
+// Msg/UpdateBasketAdmissionCriteria response type.
+type MsgUpdateBasketAdmissionCriteriaResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// credits are the credits taken out of the basket.
-	Credits []*BasketCredit `protobuf:"bytes,1,rep,name=credits,proto3" json:"credits,omitempty"`
 }
 
-func (x *MsgTakeResponse) Reset() {
-	*x = MsgTakeResponse{}
+func (x *MsgUpdateBasketAdmissionCriteriaResponse) Reset() {
+	*x = MsgUpdateBasketAdmissionCriteriaResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[5]
+		mi := &file_regen_ecocredit_basket_v1_tx_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgTakeResponse) String() string {
+func (x *MsgUpdateBasketAdmissionCriteriaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgTakeResponse) ProtoMessage() {}
-
-// Deprecated: Use MsgTakeResponse.ProtoReflect.Descriptor instead.
-func (*MsgTakeResponse) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_basket_v1_tx_proto_rawDescGZIP(), []int{5}
-}
+func (*MsgUpdateBasketAdmissionCriteriaResponse) ProtoMessage() {}
 
-func (x *MsgTakeResponse) GetCredits() []*BasketCredit {
-	if x != nil {
-		return x.Credits
-	}
-	return nil
+// Deprecated: Use MsgUpdateBasketAdmissionCriteriaResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateBasketAdmissionCriteriaResponse) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_basket_v1_tx_proto_rawDescGZIP(), []int{19}
 }
 
 var File_regen_ecocredit_basket_v1_tx_proto protoreflect.FileDescriptor
@@ -4183,7 +11893,7 @@ var file_regen_ecocredit_basket_v1_tx_proto_rawDesc = []byte{
 	0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2f, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31,
 	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f,
 	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaf, 0x03, 0x0a,
+	0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8c, 0x04, 0x0a,
 	0x09, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75,
 	0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x75, 0x72,
 	0x61, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
@@ -4210,44 +11920,144 @@ var file_regen_ecocredit_basket_v1_tx_proto_rawDesc = []byte{
 	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f,
 	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x03, 0x66, 0x65, 0x65, 0x22, 0x36,
-	0x0a, 0x11, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f, 0x64, 0x65,
-	0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x6b, 0x65,
-	0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x84, 0x01, 0x0a, 0x06, 0x4d, 0x73, 0x67, 0x50, 0x75,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x5b,
+	0x0a, 0x12, 0x61, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x72, 0x69, 0x74,
+	0x65, 0x72, 0x69, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x72, 0x65, 0x67,
+	0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x62, 0x61, 0x73,
+	0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x52, 0x11, 0x61, 0x64, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x22, 0x36, 0x0a, 0x11, 0x4d,
+	0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x44, 0x65,
+	0x6e, 0x6f, 0x6d, 0x22, 0x84, 0x01, 0x0a, 0x06, 0x4d, 0x73, 0x67, 0x50, 0x75, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x6b,
+	0x65, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x41, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e,
+	0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x52, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x22, 0x39, 0x0a, 0x0e, 0x4d, 0x73,
+	0x67, 0x50, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x64, 0x22, 0xeb, 0x03, 0x0a, 0x07, 0x4d, 0x73, 0x67, 0x54, 0x61, 0x6b,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x6b, 0x65,
 	0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62,
-	0x61, 0x73, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x41, 0x0a, 0x07, 0x63, 0x72,
-	0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x72, 0x65,
-	0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x62, 0x61,
-	0x73, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x43, 0x72,
-	0x65, 0x64, 0x69, 0x74, 0x52, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x22, 0x39, 0x0a,
-	0x0e, 0x4d, 0x73, 0x67, 0x50, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x27, 0x0a, 0x0f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
-	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x22, 0xee, 0x01, 0x0a, 0x07, 0x4d, 0x73, 0x67,
-	0x54, 0x61, 0x6b, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61,
-	0x73, 0x6b, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x13, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x12, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x65,
-	0x74, 0x69, 0x72, 0x65, 0x5f, 0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0c, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x4f, 0x6e, 0x54, 0x61, 0x6b, 0x65,
-	0x12, 0x37, 0x0a, 0x17, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6a,
-	0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x16, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4a, 0x75, 0x72,
-	0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x54, 0x0a, 0x0f, 0x4d, 0x73, 0x67,
-	0x54, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x07,
-	0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e,
-	0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e,
-	0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74,
-	0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x32,
-	0x90, 0x02, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x5c, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x61, 0x73, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x33, 0x0a, 0x13, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x02, 0x18, 0x01, 0x52, 0x12, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x65, 0x74, 0x69, 0x72,
+	0x65, 0x5f, 0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x4f, 0x6e, 0x54, 0x61, 0x6b, 0x65, 0x12, 0x37, 0x0a,
+	0x17, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6a, 0x75, 0x72, 0x69,
+	0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16,
+	0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4a, 0x75, 0x72, 0x69, 0x73, 0x64,
+	0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x13, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69,
+	0x63, 0x69, 0x61, 0x72, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x12, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x62, 0x65, 0x6e, 0x65, 0x66,
+	0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x08, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x72,
+	0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x62,
+	0x61, 0x73, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x6b, 0x65, 0x53, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6e, 0x6f,
+	0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75,
+	0x6d, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x65, 0x6d,
+	0x69, 0x75, 0x6d, 0x22, 0x6e, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x54, 0x61, 0x6b, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e,
+	0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x52, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x65,
+	0x6d, 0x69, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x65, 0x6d,
+	0x69, 0x75, 0x6d, 0x22, 0x76, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x43, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x6b, 0x65,
+	0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62,
+	0x61, 0x73, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65,
+	0x77, 0x5f, 0x63, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6e, 0x65, 0x77, 0x43, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x20, 0x0a, 0x1e, 0x4d,
+	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x43, 0x75,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6f, 0x0a,
+	0x13, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x21,
+	0x0a, 0x0c, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x6e, 0x6f,
+	0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x73, 0x22, 0x1d,
+	0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x72, 0x0a,
+	0x16, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74,
+	0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x75, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x44,
+	0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64,
+	0x73, 0x22, 0x20, 0x0a, 0x1e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x61,
+	0x73, 0x6b, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xa8, 0x01, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x65, 0x43, 0x72, 0x69, 0x74, 0x65,
+	0x72, 0x69, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a,
+	0x0c, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
+	0x12, 0x4c, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e,
+	0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61,
+	0x52, 0x0c, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x22, 0x25,
+	0x0a, 0x23, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x6b, 0x65,
+	0x74, 0x44, 0x61, 0x74, 0x65, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7b, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a,
+	0x0c, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x24, 0x0a, 0x22, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42,
+	0x61, 0x73, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x1a, 0x4d, 0x73, 0x67,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x54, 0x61, 0x6b, 0x65,
+	0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x75, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x44,
+	0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x70, 0x72, 0x65,
+	0x6d, 0x69, 0x75, 0x6d, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e,
+	0x74, 0x61, 0x6b, 0x65, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x42, 0x70, 0x73, 0x22, 0x24,
+	0x0a, 0x22, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x6b, 0x65,
+	0x74, 0x54, 0x61, 0x6b, 0x65, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbc, 0x01, 0x0a, 0x20, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x75, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x6b, 0x65,
+	0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x5b, 0x0a, 0x12, 0x61, 0x64, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61,
+	0x52, 0x11, 0x61, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x69, 0x74, 0x65,
+	0x72, 0x69, 0x61, 0x22, 0x2a, 0x0a, 0x28, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32,
+	0xf5, 0x09, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x5c, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74,
 	0x65, 0x12, 0x24, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65,
 	0x64, 0x69, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
 	0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x1a, 0x2c, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e,
@@ -4264,23 +12074,85 @@ var file_regen_ecocredit_basket_v1_tx_proto_rawDesc = []byte{
 	0x73, 0x67, 0x54, 0x61, 0x6b, 0x65, 0x1a, 0x2a, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65,
 	0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2e,
 	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x54, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x42, 0xfd, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e,
-	0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65,
-	0x74, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x4c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x65, 0x67, 0x65,
-	0x6e, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d,
-	0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e,
-	0x2f, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2f, 0x62, 0x61, 0x73, 0x6b, 0x65,
-	0x74, 0x2f, 0x76, 0x31, 0x3b, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03,
-	0x52, 0x45, 0x42, 0xaa, 0x02, 0x19, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x45, 0x63, 0x6f, 0x63,
-	0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2e, 0x56, 0x31, 0xca,
-	0x02, 0x19, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69,
-	0x74, 0x5c, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x25, 0x52, 0x65,
-	0x67, 0x65, 0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5c, 0x42, 0x61,
-	0x73, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x1c, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x3a, 0x3a, 0x45, 0x63, 0x6f,
-	0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x3a, 0x3a, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x3a, 0x3a,
-	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x65, 0x12, 0x83, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73,
+	0x6b, 0x65, 0x74, 0x43, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x31, 0x2e, 0x72, 0x65, 0x67,
+	0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x62, 0x61, 0x73,
+	0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x43, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x1a, 0x39, 0x2e,
+	0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e,
+	0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x43, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7a, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x42,
+	0x61, 0x73, 0x6b, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12, 0x2e, 0x2e, 0x72,
+	0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x62,
+	0x61, 0x73, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x42,
+	0x61, 0x73, 0x6b, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x1a, 0x36, 0x2e, 0x72,
+	0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x62,
+	0x61, 0x73, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x42,
+	0x61, 0x73, 0x6b, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x83, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42,
+	0x61, 0x73, 0x6b, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12, 0x31, 0x2e, 0x72,
+	0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x62,
+	0x61, 0x73, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x1a,
+	0x39, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x92, 0x01, 0x0a, 0x18, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x65, 0x43,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x36, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e,
+	0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73,
+	0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x65, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x1a,
+	0x3e, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x44, 0x61, 0x74, 0x65, 0x43,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x8f, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x2e, 0x72, 0x65,
+	0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x62, 0x61,
+	0x73, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x1a, 0x3d, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x8f, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x6b,
+	0x65, 0x74, 0x54, 0x61, 0x6b, 0x65, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x12, 0x35, 0x2e,
+	0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e,
+	0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x54, 0x61, 0x6b, 0x65, 0x50, 0x72, 0x65,
+	0x6d, 0x69, 0x75, 0x6d, 0x1a, 0x3d, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f,
+	0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74,
+	0x54, 0x61, 0x6b, 0x65, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0xa1, 0x01, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61,
+	0x73, 0x6b, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x69,
+	0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x3b, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63,
+	0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x6b, 0x65,
+	0x74, 0x41, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72,
+	0x69, 0x61, 0x1a, 0x43, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x41, 0x64,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xfd, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e,
+	0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e,
+	0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x72,
+	0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2f,
+	0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74,
+	0x76, 0x31, 0xa2, 0x02, 0x03, 0x52, 0x45, 0x42, 0xaa, 0x02, 0x19, 0x52, 0x65, 0x67, 0x65, 0x6e,
+	0x2e, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x42, 0x61, 0x73, 0x6b, 0x65,
+	0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x19, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45, 0x63, 0x6f,
+	0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5c, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31,
+	0xe2, 0x02, 0x25, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x5c, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1c, 0x52, 0x65, 0x67, 0x65, 0x6e,
+	0x3a, 0x3a, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x3a, 0x3a, 0x42, 0x61, 0x73,
+	0x6b, 0x65, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4295,34 +12167,68 @@ func file_regen_ecocredit_basket_v1_tx_proto_rawDescGZIP() []byte {
 	return file_regen_ecocredit_basket_v1_tx_proto_rawDescData
 }
 
-var file_regen_ecocredit_basket_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_regen_ecocredit_basket_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_regen_ecocredit_basket_v1_tx_proto_goTypes = []interface{}{
-	(*MsgCreate)(nil),         // 0: regen.ecocredit.basket.v1.MsgCreate
-	(*MsgCreateResponse)(nil), // 1: regen.ecocredit.basket.v1.MsgCreateResponse
-	(*MsgPut)(nil),            // 2: regen.ecocredit.basket.v1.MsgPut
-	(*MsgPutResponse)(nil),    // 3: regen.ecocredit.basket.v1.MsgPutResponse
-	(*MsgTake)(nil),           // 4: regen.ecocredit.basket.v1.MsgTake
-	(*MsgTakeResponse)(nil),   // 5: regen.ecocredit.basket.v1.MsgTakeResponse
-	(*DateCriteria)(nil),      // 6: regen.ecocredit.basket.v1.DateCriteria
-	(*v1beta1.Coin)(nil),      // 7: cosmos.base.v1beta1.Coin
-	(*BasketCredit)(nil),      // 8: regen.ecocredit.basket.v1.BasketCredit
+	(*MsgCreate)(nil),                                // 0: regen.ecocredit.basket.v1.MsgCreate
+	(*MsgCreateResponse)(nil),                        // 1: regen.ecocredit.basket.v1.MsgCreateResponse
+	(*MsgPut)(nil),                                   // 2: regen.ecocredit.basket.v1.MsgPut
+	(*MsgPutResponse)(nil),                           // 3: regen.ecocredit.basket.v1.MsgPutResponse
+	(*MsgTake)(nil),                                  // 4: regen.ecocredit.basket.v1.MsgTake
+	(*MsgTakeResponse)(nil),                          // 5: regen.ecocredit.basket.v1.MsgTakeResponse
+	(*MsgUpdateBasketCurator)(nil),                   // 6: regen.ecocredit.basket.v1.MsgUpdateBasketCurator
+	(*MsgUpdateBasketCuratorResponse)(nil),           // 7: regen.ecocredit.basket.v1.MsgUpdateBasketCuratorResponse
+	(*MsgAddBasketClasses)(nil),                      // 8: regen.ecocredit.basket.v1.MsgAddBasketClasses
+	(*MsgAddBasketClassesResponse)(nil),              // 9: regen.ecocredit.basket.v1.MsgAddBasketClassesResponse
+	(*MsgRemoveBasketClasses)(nil),                   // 10: regen.ecocredit.basket.v1.MsgRemoveBasketClasses
+	(*MsgRemoveBasketClassesResponse)(nil),           // 11: regen.ecocredit.basket.v1.MsgRemoveBasketClassesResponse
+	(*MsgUpdateBasketDateCriteria)(nil),              // 12: regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria
+	(*MsgUpdateBasketDateCriteriaResponse)(nil),      // 13: regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteriaResponse
+	(*MsgUpdateBasketDescription)(nil),               // 14: regen.ecocredit.basket.v1.MsgUpdateBasketDescription
+	(*MsgUpdateBasketDescriptionResponse)(nil),       // 15: regen.ecocredit.basket.v1.MsgUpdateBasketDescriptionResponse
+	(*MsgUpdateBasketTakePremium)(nil),               // 16: regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium
+	(*MsgUpdateBasketTakePremiumResponse)(nil),       // 17: regen.ecocredit.basket.v1.MsgUpdateBasketTakePremiumResponse
+	(*MsgUpdateBasketAdmissionCriteria)(nil),         // 18: regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria
+	(*MsgUpdateBasketAdmissionCriteriaResponse)(nil), // 19: regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteriaResponse
+	(*DateCriteria)(nil),                             // 20: regen.ecocredit.basket.v1.DateCriteria
+	(*v1beta1.Coin)(nil),                             // 21: cosmos.base.v1beta1.Coin
+	(*AdmissionCriteria)(nil),                        // 22: regen.ecocredit.basket.v1.AdmissionCriteria
+	(*BasketCredit)(nil),                             // 23: regen.ecocredit.basket.v1.BasketCredit
+	(TakeStrategy)(0),                                // 24: regen.ecocredit.basket.v1.TakeStrategy
 }
 var file_regen_ecocredit_basket_v1_tx_proto_depIdxs = []int32{
-	6, // 0: regen.ecocredit.basket.v1.MsgCreate.date_criteria:type_name -> regen.ecocredit.basket.v1.DateCriteria
-	7, // 1: regen.ecocredit.basket.v1.MsgCreate.fee:type_name -> cosmos.base.v1beta1.Coin
-	8, // 2: regen.ecocredit.basket.v1.MsgPut.credits:type_name -> regen.ecocredit.basket.v1.BasketCredit
-	8, // 3: regen.ecocredit.basket.v1.MsgTakeResponse.credits:type_name -> regen.ecocredit.basket.v1.BasketCredit
-	0, // 4: regen.ecocredit.basket.v1.Msg.Create:input_type -> regen.ecocredit.basket.v1.MsgCreate
-	2, // 5: regen.ecocredit.basket.v1.Msg.Put:input_type -> regen.ecocredit.basket.v1.MsgPut
-	4, // 6: regen.ecocredit.basket.v1.Msg.Take:input_type -> regen.ecocredit.basket.v1.MsgTake
-	1, // 7: regen.ecocredit.basket.v1.Msg.Create:output_type -> regen.ecocredit.basket.v1.MsgCreateResponse
-	3, // 8: regen.ecocredit.basket.v1.Msg.Put:output_type -> regen.ecocredit.basket.v1.MsgPutResponse
-	5, // 9: regen.ecocredit.basket.v1.Msg.Take:output_type -> regen.ecocredit.basket.v1.MsgTakeResponse
-	7, // [7:10] is the sub-list for method output_type
-	4, // [4:7] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	20, // 0: regen.ecocredit.basket.v1.MsgCreate.date_criteria:type_name -> regen.ecocredit.basket.v1.DateCriteria
+	21, // 1: regen.ecocredit.basket.v1.MsgCreate.fee:type_name -> cosmos.base.v1beta1.Coin
+	22, // 2: regen.ecocredit.basket.v1.MsgCreate.admission_criteria:type_name -> regen.ecocredit.basket.v1.AdmissionCriteria
+	23, // 3: regen.ecocredit.basket.v1.MsgPut.credits:type_name -> regen.ecocredit.basket.v1.BasketCredit
+	24, // 4: regen.ecocredit.basket.v1.MsgTake.strategy:type_name -> regen.ecocredit.basket.v1.TakeStrategy
+	23, // 5: regen.ecocredit.basket.v1.MsgTakeResponse.credits:type_name -> regen.ecocredit.basket.v1.BasketCredit
+	20, // 6: regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria.date_criteria:type_name -> regen.ecocredit.basket.v1.DateCriteria
+	22, // 7: regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria.admission_criteria:type_name -> regen.ecocredit.basket.v1.AdmissionCriteria
+	0,  // 8: regen.ecocredit.basket.v1.Msg.Create:input_type -> regen.ecocredit.basket.v1.MsgCreate
+	2,  // 9: regen.ecocredit.basket.v1.Msg.Put:input_type -> regen.ecocredit.basket.v1.MsgPut
+	4,  // 10: regen.ecocredit.basket.v1.Msg.Take:input_type -> regen.ecocredit.basket.v1.MsgTake
+	6,  // 11: regen.ecocredit.basket.v1.Msg.UpdateBasketCurator:input_type -> regen.ecocredit.basket.v1.MsgUpdateBasketCurator
+	8,  // 12: regen.ecocredit.basket.v1.Msg.AddBasketClasses:input_type -> regen.ecocredit.basket.v1.MsgAddBasketClasses
+	10, // 13: regen.ecocredit.basket.v1.Msg.RemoveBasketClasses:input_type -> regen.ecocredit.basket.v1.MsgRemoveBasketClasses
+	12, // 14: regen.ecocredit.basket.v1.Msg.UpdateBasketDateCriteria:input_type -> regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria
+	14, // 15: regen.ecocredit.basket.v1.Msg.UpdateBasketDescription:input_type -> regen.ecocredit.basket.v1.MsgUpdateBasketDescription
+	16, // 16: regen.ecocredit.basket.v1.Msg.UpdateBasketTakePremium:input_type -> regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium
+	18, // 17: regen.ecocredit.basket.v1.Msg.UpdateBasketAdmissionCriteria:input_type -> regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria
+	1,  // 18: regen.ecocredit.basket.v1.Msg.Create:output_type -> regen.ecocredit.basket.v1.MsgCreateResponse
+	3,  // 19: regen.ecocredit.basket.v1.Msg.Put:output_type -> regen.ecocredit.basket.v1.MsgPutResponse
+	5,  // 20: regen.ecocredit.basket.v1.Msg.Take:output_type -> regen.ecocredit.basket.v1.MsgTakeResponse
+	7,  // 21: regen.ecocredit.basket.v1.Msg.UpdateBasketCurator:output_type -> regen.ecocredit.basket.v1.MsgUpdateBasketCuratorResponse
+	9,  // 22: regen.ecocredit.basket.v1.Msg.AddBasketClasses:output_type -> regen.ecocredit.basket.v1.MsgAddBasketClassesResponse
+	11, // 23: regen.ecocredit.basket.v1.Msg.RemoveBasketClasses:output_type -> regen.ecocredit.basket.v1.MsgRemoveBasketClassesResponse
+	13, // 24: regen.ecocredit.basket.v1.Msg.UpdateBasketDateCriteria:output_type -> regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteriaResponse
+	15, // 25: regen.ecocredit.basket.v1.Msg.UpdateBasketDescription:output_type -> regen.ecocredit.basket.v1.MsgUpdateBasketDescriptionResponse
+	17, // 26: regen.ecocredit.basket.v1.Msg.UpdateBasketTakePremium:output_type -> regen.ecocredit.basket.v1.MsgUpdateBasketTakePremiumResponse
+	19, // 27: regen.ecocredit.basket.v1.Msg.UpdateBasketAdmissionCriteria:output_type -> regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteriaResponse
+	18, // [18:28] is the sub-list for method output_type
+	8,  // [8:18] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_regen_ecocredit_basket_v1_tx_proto_init() }
@@ -4404,6 +12310,174 @@ func file_regen_ecocredit_basket_v1_tx_proto_init() {
 				return nil
 			}
 		}
+		file_regen_ecocredit_basket_v1_tx_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateBasketCurator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_basket_v1_tx_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateBasketCuratorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_basket_v1_tx_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgAddBasketClasses); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_basket_v1_tx_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgAddBasketClassesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_basket_v1_tx_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRemoveBasketClasses); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_basket_v1_tx_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRemoveBasketClassesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_basket_v1_tx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateBasketDateCriteria); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_basket_v1_tx_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateBasketDateCriteriaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_basket_v1_tx_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateBasketDescription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_basket_v1_tx_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateBasketDescriptionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_basket_v1_tx_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateBasketTakePremium); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_basket_v1_tx_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateBasketTakePremiumResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_basket_v1_tx_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateBasketAdmissionCriteria); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_basket_v1_tx_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateBasketAdmissionCriteriaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -4411,7 +12485,7 @@ func file_regen_ecocredit_basket_v1_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_regen_ecocredit_basket_v1_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -26,9 +26,37 @@ type MsgClient interface {
 	Create(ctx context.Context, in *MsgCreate, opts ...grpc.CallOption) (*MsgCreateResponse, error)
 	// Put puts credits into a basket in return for basket tokens.
 	Put(ctx context.Context, in *MsgPut, opts ...grpc.CallOption) (*MsgPutResponse, error)
-	// Take takes credits from a basket starting from the oldest
-	// credits first.
+	// Take takes credits from a basket starting from the oldest credits first
+	// unless a different take strategy is specified.
 	Take(ctx context.Context, in *MsgTake, opts ...grpc.CallOption) (*MsgTakeResponse, error)
+	// UpdateBasketCurator transfers the curator role of a basket to a new
+	// address. Only the current curator can update the curator.
+	UpdateBasketCurator(ctx context.Context, in *MsgUpdateBasketCurator, opts ...grpc.CallOption) (*MsgUpdateBasketCuratorResponse, error)
+	// AddBasketClasses adds credit classes to the list of classes allowed to be
+	// put into a basket. Only the basket curator can add classes.
+	AddBasketClasses(ctx context.Context, in *MsgAddBasketClasses, opts ...grpc.CallOption) (*MsgAddBasketClassesResponse, error)
+	// RemoveBasketClasses removes credit classes from the list of classes
+	// allowed to be put into a basket. Credits from removed classes that are
+	// already in the basket remain in the basket and can still be taken. Only
+	// the basket curator can remove classes.
+	RemoveBasketClasses(ctx context.Context, in *MsgRemoveBasketClasses, opts ...grpc.CallOption) (*MsgRemoveBasketClassesResponse, error)
+	// UpdateBasketDateCriteria updates the date criteria for batches admitted to
+	// a basket. The date criteria only applies to credits put into the basket
+	// after the update. Only the basket curator can update the date criteria.
+	UpdateBasketDateCriteria(ctx context.Context, in *MsgUpdateBasketDateCriteria, opts ...grpc.CallOption) (*MsgUpdateBasketDateCriteriaResponse, error)
+	// UpdateBasketDescription updates the description of a basket stored in the
+	// bank denom metadata of the basket token. Only the basket curator can
+	// update the description.
+	UpdateBasketDescription(ctx context.Context, in *MsgUpdateBasketDescription, opts ...grpc.CallOption) (*MsgUpdateBasketDescriptionResponse, error)
+	// UpdateBasketTakePremium updates the premium paid to the curator when
+	// credits are taken from a basket with a strategy other than oldest first.
+	// Only the basket curator can update the take premium.
+	UpdateBasketTakePremium(ctx context.Context, in *MsgUpdateBasketTakePremium, opts ...grpc.CallOption) (*MsgUpdateBasketTakePremiumResponse, error)
+	// UpdateBasketAdmissionCriteria updates the additional criteria for batches
+	// admitted to a basket. The admission criteria only applies to credits put
+	// into the basket after the update. Only the basket curator can update the
+	// admission criteria.
+	UpdateBasketAdmissionCriteria(ctx context.Context, in *MsgUpdateBasketAdmissionCriteria, opts ...grpc.CallOption) (*MsgUpdateBasketAdmissionCriteriaResponse, error)
 }
 
 type msgClient struct {
@@ -66,6 +94,69 @@ func (c *msgClient) Take(ctx context.Context, in *MsgTake, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) UpdateBasketCurator(ctx context.Context, in *MsgUpdateBasketCurator, opts ...grpc.CallOption) (*MsgUpdateBasketCuratorResponse, error) {
+	out := new(MsgUpdateBasketCuratorResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.basket.v1.Msg/UpdateBasketCurator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddBasketClasses(ctx context.Context, in *MsgAddBasketClasses, opts ...grpc.CallOption) (*MsgAddBasketClassesResponse, error) {
+	out := new(MsgAddBasketClassesResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.basket.v1.Msg/AddBasketClasses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveBasketClasses(ctx context.Context, in *MsgRemoveBasketClasses, opts ...grpc.CallOption) (*MsgRemoveBasketClassesResponse, error) {
+	out := new(MsgRemoveBasketClassesResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.basket.v1.Msg/RemoveBasketClasses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateBasketDateCriteria(ctx context.Context, in *MsgUpdateBasketDateCriteria, opts ...grpc.CallOption) (*MsgUpdateBasketDateCriteriaResponse, error) {
+	out := new(MsgUpdateBasketDateCriteriaResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.basket.v1.Msg/UpdateBasketDateCriteria", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateBasketDescription(ctx context.Context, in *MsgUpdateBasketDescription, opts ...grpc.CallOption) (*MsgUpdateBasketDescriptionResponse, error) {
+	out := new(MsgUpdateBasketDescriptionResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.basket.v1.Msg/UpdateBasketDescription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateBasketTakePremium(ctx context.Context, in *MsgUpdateBasketTakePremium, opts ...grpc.CallOption) (*MsgUpdateBasketTakePremiumResponse, error) {
+	out := new(MsgUpdateBasketTakePremiumResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.basket.v1.Msg/UpdateBasketTakePremium", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateBasketAdmissionCriteria(ctx context.Context, in *MsgUpdateBasketAdmissionCriteria, opts ...grpc.CallOption) (*MsgUpdateBasketAdmissionCriteriaResponse, error) {
+	out := new(MsgUpdateBasketAdmissionCriteriaResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.basket.v1.Msg/UpdateBasketAdmissionCriteria", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -74,9 +165,37 @@ type MsgServer interface {
 	Create(context.Context, *MsgCreate) (*MsgCreateResponse, error)
 	// Put puts credits into a basket in return for basket tokens.
 	Put(context.Context, *MsgPut) (*MsgPutResponse, error)
-	// Take takes credits from a basket starting from the oldest
-	// credits first.
+	// Take takes credits from a basket starting from the oldest credits first
+	// unless a different take strategy is specified.
 	Take(context.Context, *MsgTake) (*MsgTakeResponse, error)
+	// UpdateBasketCurator transfers the curator role of a basket to a new
+	// address. Only the current curator can update the curator.
+	UpdateBasketCurator(context.Context, *MsgUpdateBasketCurator) (*MsgUpdateBasketCuratorResponse, error)
+	// AddBasketClasses adds credit classes to the list of classes allowed to be
+	// put into a basket. Only the basket curator can add classes.
+	AddBasketClasses(context.Context, *MsgAddBasketClasses) (*MsgAddBasketClassesResponse, error)
+	// RemoveBasketClasses removes credit classes from the list of classes
+	// allowed to be put into a basket. Credits from removed classes that are
+	// already in the basket remain in the basket and can still be taken. Only
+	// the basket curator can remove classes.
+	RemoveBasketClasses(context.Context, *MsgRemoveBasketClasses) (*MsgRemoveBasketClassesResponse, error)
+	// UpdateBasketDateCriteria updates the date criteria for batches admitted to
+	// a basket. The date criteria only applies to credits put into the basket
+	// after the update. Only the basket curator can update the date criteria.
+	UpdateBasketDateCriteria(context.Context, *MsgUpdateBasketDateCriteria) (*MsgUpdateBasketDateCriteriaResponse, error)
+	// UpdateBasketDescription updates the description of a basket stored in the
+	// bank denom metadata of the basket token. Only the basket curator can
+	// update the description.
+	UpdateBasketDescription(context.Context, *MsgUpdateBasketDescription) (*MsgUpdateBasketDescriptionResponse, error)
+	// UpdateBasketTakePremium updates the premium paid to the curator when
+	// credits are taken from a basket with a strategy other than oldest first.
+	// Only the basket curator can update the take premium.
+	UpdateBasketTakePremium(context.Context, *MsgUpdateBasketTakePremium) (*MsgUpdateBasketTakePremiumResponse, error)
+	// UpdateBasketAdmissionCriteria updates the additional criteria for batches
+	// admitted to a basket. The admission criteria only applies to credits put
+	// into the basket after the update. Only the basket curator can update the
+	// admission criteria.
+	UpdateBasketAdmissionCriteria(context.Context, *MsgUpdateBasketAdmissionCriteria) (*MsgUpdateBasketAdmissionCriteriaResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -93,6 +212,27 @@ func (UnimplementedMsgServer) Put(context.Context, *MsgPut) (*MsgPutResponse, er
 func (UnimplementedMsgServer) Take(context.Context, *MsgTake) (*MsgTakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Take not implemented")
 }
+func (UnimplementedMsgServer) UpdateBasketCurator(context.Context, *MsgUpdateBasketCurator) (*MsgUpdateBasketCuratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBasketCurator not implemented")
+}
+func (UnimplementedMsgServer) AddBasketClasses(context.Context, *MsgAddBasketClasses) (*MsgAddBasketClassesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBasketClasses not implemented")
+}
+func (UnimplementedMsgServer) RemoveBasketClasses(context.Context, *MsgRemoveBasketClasses) (*MsgRemoveBasketClassesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBasketClasses not implemented")
+}
+func (UnimplementedMsgServer) UpdateBasketDateCriteria(context.Context, *MsgUpdateBasketDateCriteria) (*MsgUpdateBasketDateCriteriaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBasketDateCriteria not implemented")
+}
+func (UnimplementedMsgServer) UpdateBasketDescription(context.Context, *MsgUpdateBasketDescription) (*MsgUpdateBasketDescriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBasketDescription not implemented")
+}
+func (UnimplementedMsgServer) UpdateBasketTakePremium(context.Context, *MsgUpdateBasketTakePremium) (*MsgUpdateBasketTakePremiumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBasketTakePremium not implemented")
+}
+func (UnimplementedMsgServer) UpdateBasketAdmissionCriteria(context.Context, *MsgUpdateBasketAdmissionCriteria) (*MsgUpdateBasketAdmissionCriteriaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBasketAdmissionCriteria not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -160,6 +300,132 @@ func _Msg_Take_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateBasketCurator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBasketCurator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBasketCurator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.basket.v1.Msg/UpdateBasketCurator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBasketCurator(ctx, req.(*MsgUpdateBasketCurator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddBasketClasses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddBasketClasses)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddBasketClasses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.basket.v1.Msg/AddBasketClasses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddBasketClasses(ctx, req.(*MsgAddBasketClasses))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveBasketClasses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveBasketClasses)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveBasketClasses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.basket.v1.Msg/RemoveBasketClasses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveBasketClasses(ctx, req.(*MsgRemoveBasketClasses))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateBasketDateCriteria_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBasketDateCriteria)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBasketDateCriteria(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.basket.v1.Msg/UpdateBasketDateCriteria",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBasketDateCriteria(ctx, req.(*MsgUpdateBasketDateCriteria))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateBasketDescription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBasketDescription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBasketDescription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.basket.v1.Msg/UpdateBasketDescription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBasketDescription(ctx, req.(*MsgUpdateBasketDescription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateBasketTakePremium_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBasketTakePremium)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBasketTakePremium(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.basket.v1.Msg/UpdateBasketTakePremium",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBasketTakePremium(ctx, req.(*MsgUpdateBasketTakePremium))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateBasketAdmissionCriteria_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBasketAdmissionCriteria)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBasketAdmissionCriteria(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.basket.v1.Msg/UpdateBasketAdmissionCriteria",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBasketAdmissionCriteria(ctx, req.(*MsgUpdateBasketAdmissionCriteria))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -179,6 +445,34 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Take",
 			Handler:    _Msg_Take_Handler,
 		},
+		{
+			MethodName: "UpdateBasketCurator",
+			Handler:    _Msg_UpdateBasketCurator_Handler,
+		},
+		{
+			MethodName: "AddBasketClasses",
+			Handler:    _Msg_AddBasketClasses_Handler,
+		},
+		{
+			MethodName: "RemoveBasketClasses",
+			Handler:    _Msg_RemoveBasketClasses_Handler,
+		},
+		{
+			MethodName: "UpdateBasketDateCriteria",
+			Handler:    _Msg_UpdateBasketDateCriteria_Handler,
+		},
+		{
+			MethodName: "UpdateBasketDescription",
+			Handler:    _Msg_UpdateBasketDescription_Handler,
+		},
+		{
+			MethodName: "UpdateBasketTakePremium",
+			Handler:    _Msg_UpdateBasketTakePremium_Handler,
+		},
+		{
+			MethodName: "UpdateBasketAdmissionCriteria",
+			Handler:    _Msg_UpdateBasketAdmissionCriteria_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "regen/ecocredit/basket/v1/tx.proto",
@@ -1060,6 +1060,1001 @@ func (x *fastReflection_DateCriteria) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_AdmissionCriteria_1_list)(nil)
+
+type _AdmissionCriteria_1_list struct {
+	list *[]string
+}
+
+func (x *_AdmissionCriteria_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AdmissionCriteria_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_AdmissionCriteria_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AdmissionCriteria_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AdmissionCriteria_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message AdmissionCriteria at list field AllowedProjectIds as it is not of Message kind"))
+}
+
+func (x *_AdmissionCriteria_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AdmissionCriteria_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_AdmissionCriteria_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_AdmissionCriteria_2_list)(nil)
+
+type _AdmissionCriteria_2_list struct {
+	list *[]string
+}
+
+func (x *_AdmissionCriteria_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AdmissionCriteria_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_AdmissionCriteria_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AdmissionCriteria_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AdmissionCriteria_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message AdmissionCriteria at list field DeniedProjectIds as it is not of Message kind"))
+}
+
+func (x *_AdmissionCriteria_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AdmissionCriteria_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_AdmissionCriteria_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_AdmissionCriteria_3_list)(nil)
+
+type _AdmissionCriteria_3_list struct {
+	list *[]string
+}
+
+func (x *_AdmissionCriteria_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AdmissionCriteria_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_AdmissionCriteria_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AdmissionCriteria_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AdmissionCriteria_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message AdmissionCriteria at list field AllowedJurisdictionPrefixes as it is not of Message kind"))
+}
+
+func (x *_AdmissionCriteria_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AdmissionCriteria_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_AdmissionCriteria_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_AdmissionCriteria_4_list)(nil)
+
+type _AdmissionCriteria_4_list struct {
+	list *[]string
+}
+
+func (x *_AdmissionCriteria_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AdmissionCriteria_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_AdmissionCriteria_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AdmissionCriteria_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AdmissionCriteria_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message AdmissionCriteria at list field DeniedJurisdictionPrefixes as it is not of Message kind"))
+}
+
+func (x *_AdmissionCriteria_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AdmissionCriteria_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_AdmissionCriteria_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_AdmissionCriteria                               protoreflect.MessageDescriptor
+	fd_AdmissionCriteria_allowed_project_ids           protoreflect.FieldDescriptor
+	fd_AdmissionCriteria_denied_project_ids            protoreflect.FieldDescriptor
+	fd_AdmissionCriteria_allowed_jurisdiction_prefixes protoreflect.FieldDescriptor
+	fd_AdmissionCriteria_denied_jurisdiction_prefixes  protoreflect.FieldDescriptor
+	fd_AdmissionCriteria_max_batch_age                 protoreflect.FieldDescriptor
+	fd_AdmissionCriteria_attestor                      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_basket_v1_types_proto_init()
+	md_AdmissionCriteria = File_regen_ecocredit_basket_v1_types_proto.Messages().ByName("AdmissionCriteria")
+	fd_AdmissionCriteria_allowed_project_ids = md_AdmissionCriteria.Fields().ByName("allowed_project_ids")
+	fd_AdmissionCriteria_denied_project_ids = md_AdmissionCriteria.Fields().ByName("denied_project_ids")
+	fd_AdmissionCriteria_allowed_jurisdiction_prefixes = md_AdmissionCriteria.Fields().ByName("allowed_jurisdiction_prefixes")
+	fd_AdmissionCriteria_denied_jurisdiction_prefixes = md_AdmissionCriteria.Fields().ByName("denied_jurisdiction_prefixes")
+	fd_AdmissionCriteria_max_batch_age = md_AdmissionCriteria.Fields().ByName("max_batch_age")
+	fd_AdmissionCriteria_attestor = md_AdmissionCriteria.Fields().ByName("attestor")
+}
+
+var _ protoreflect.Message = (*fastReflection_AdmissionCriteria)(nil)
+
+type fastReflection_AdmissionCriteria AdmissionCriteria
+
+func (x *AdmissionCriteria) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AdmissionCriteria)(x)
+}
+
+func (x *AdmissionCriteria) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_basket_v1_types_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AdmissionCriteria_messageType fastReflection_AdmissionCriteria_messageType
+var _ protoreflect.MessageType = fastReflection_AdmissionCriteria_messageType{}
+
+type fastReflection_AdmissionCriteria_messageType struct{}
+
+func (x fastReflection_AdmissionCriteria_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AdmissionCriteria)(nil)
+}
+func (x fastReflection_AdmissionCriteria_messageType) New() protoreflect.Message {
+	return new(fastReflection_AdmissionCriteria)
+}
+func (x fastReflection_AdmissionCriteria_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AdmissionCriteria
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AdmissionCriteria) Descriptor() protoreflect.MessageDescriptor {
+	return md_AdmissionCriteria
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AdmissionCriteria) Type() protoreflect.MessageType {
+	return _fastReflection_AdmissionCriteria_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AdmissionCriteria) New() protoreflect.Message {
+	return new(fastReflection_AdmissionCriteria)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AdmissionCriteria) Interface() protoreflect.ProtoMessage {
+	return (*AdmissionCriteria)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AdmissionCriteria) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.AllowedProjectIds) != 0 {
+		value := protoreflect.ValueOfList(&_AdmissionCriteria_1_list{list: &x.AllowedProjectIds})
+		if !f(fd_AdmissionCriteria_allowed_project_ids, value) {
+			return
+		}
+	}
+	if len(x.DeniedProjectIds) != 0 {
+		value := protoreflect.ValueOfList(&_AdmissionCriteria_2_list{list: &x.DeniedProjectIds})
+		if !f(fd_AdmissionCriteria_denied_project_ids, value) {
+			return
+		}
+	}
+	if len(x.AllowedJurisdictionPrefixes) != 0 {
+		value := protoreflect.ValueOfList(&_AdmissionCriteria_3_list{list: &x.AllowedJurisdictionPrefixes})
+		if !f(fd_AdmissionCriteria_allowed_jurisdiction_prefixes, value) {
+			return
+		}
+	}
+	if len(x.DeniedJurisdictionPrefixes) != 0 {
+		value := protoreflect.ValueOfList(&_AdmissionCriteria_4_list{list: &x.DeniedJurisdictionPrefixes})
+		if !f(fd_AdmissionCriteria_denied_jurisdiction_prefixes, value) {
+			return
+		}
+	}
+	if x.MaxBatchAge != nil {
+		value := protoreflect.ValueOfMessage(x.MaxBatchAge.ProtoReflect())
+		if !f(fd_AdmissionCriteria_max_batch_age, value) {
+			return
+		}
+	}
+	if x.Attestor != "" {
+		value := protoreflect.ValueOfString(x.Attestor)
+		if !f(fd_AdmissionCriteria_attestor, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AdmissionCriteria) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.AdmissionCriteria.allowed_project_ids":
+		return len(x.AllowedProjectIds) != 0
+	case "regen.ecocredit.basket.v1.AdmissionCriteria.denied_project_ids":
+		return len(x.DeniedProjectIds) != 0
+	case "regen.ecocredit.basket.v1.AdmissionCriteria.allowed_jurisdiction_prefixes":
+		return len(x.AllowedJurisdictionPrefixes) != 0
+	case "regen.ecocredit.basket.v1.AdmissionCriteria.denied_jurisdiction_prefixes":
+		return len(x.DeniedJurisdictionPrefixes) != 0
+	case "regen.ecocredit.basket.v1.AdmissionCriteria.max_batch_age":
+		return x.MaxBatchAge != nil
+	case "regen.ecocredit.basket.v1.AdmissionCriteria.attestor":
+		return x.Attestor != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.AdmissionCriteria"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.AdmissionCriteria does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AdmissionCriteria) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.AdmissionCriteria.allowed_project_ids":
+		x.AllowedProjectIds = nil
+	case "regen.ecocredit.basket.v1.AdmissionCriteria.denied_project_ids":
+		x.DeniedProjectIds = nil
+	case "regen.ecocredit.basket.v1.AdmissionCriteria.allowed_jurisdiction_prefixes":
+		x.AllowedJurisdictionPrefixes = nil
+	case "regen.ecocredit.basket.v1.AdmissionCriteria.denied_jurisdiction_prefixes":
+		x.DeniedJurisdictionPrefixes = nil
+	case "regen.ecocredit.basket.v1.AdmissionCriteria.max_batch_age":
+		x.MaxBatchAge = nil
+	case "regen.ecocredit.basket.v1.AdmissionCriteria.attestor":
+		x.Attestor = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.AdmissionCriteria"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.AdmissionCriteria does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AdmissionCriteria) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.basket.v1.AdmissionCriteria.allowed_project_ids":
+		if len(x.AllowedProjectIds) == 0 {
+			return protoreflect.ValueOfList(&_AdmissionCriteria_1_list{})
+		}
+		listValue := &_AdmissionCriteria_1_list{list: &x.AllowedProjectIds}
+		return protoreflect.ValueOfList(listValue)
+	case "regen.ecocredit.basket.v1.AdmissionCriteria.denied_project_ids":
+		if len(x.DeniedProjectIds) == 0 {
+			return protoreflect.ValueOfList(&_AdmissionCriteria_2_list{})
+		}
+		listValue := &_AdmissionCriteria_2_list{list: &x.DeniedProjectIds}
+		return protoreflect.ValueOfList(listValue)
+	case "regen.ecocredit.basket.v1.AdmissionCriteria.allowed_jurisdiction_prefixes":
+		if len(x.AllowedJurisdictionPrefixes) == 0 {
+			return protoreflect.ValueOfList(&_AdmissionCriteria_3_list{})
+		}
+		listValue := &_AdmissionCriteria_3_list{list: &x.AllowedJurisdictionPrefixes}
+		return protoreflect.ValueOfList(listValue)
+	case "regen.ecocredit.basket.v1.AdmissionCriteria.denied_jurisdiction_prefixes":
+		if len(x.DeniedJurisdictionPrefixes) == 0 {
+			return protoreflect.ValueOfList(&_AdmissionCriteria_4_list{})
+		}
+		listValue := &_AdmissionCriteria_4_list{list: &x.DeniedJurisdictionPrefixes}
+		return protoreflect.ValueOfList(listValue)
+	case "regen.ecocredit.basket.v1.AdmissionCriteria.max_batch_age":
+		value := x.MaxBatchAge
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.basket.v1.AdmissionCriteria.attestor":
+		value := x.Attestor
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.AdmissionCriteria"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.AdmissionCriteria does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AdmissionCriteria) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.AdmissionCriteria.allowed_project_ids":
+		lv := value.List()
+		clv := lv.(*_AdmissionCriteria_1_list)
+		x.AllowedProjectIds = *clv.list
+	case "regen.ecocredit.basket.v1.AdmissionCriteria.denied_project_ids":
+		lv := value.List()
+		clv := lv.(*_AdmissionCriteria_2_list)
+		x.DeniedProjectIds = *clv.list
+	case "regen.ecocredit.basket.v1.AdmissionCriteria.allowed_jurisdiction_prefixes":
+		lv := value.List()
+		clv := lv.(*_AdmissionCriteria_3_list)
+		x.AllowedJurisdictionPrefixes = *clv.list
+	case "regen.ecocredit.basket.v1.AdmissionCriteria.denied_jurisdiction_prefixes":
+		lv := value.List()
+		clv := lv.(*_AdmissionCriteria_4_list)
+		x.DeniedJurisdictionPrefixes = *clv.list
+	case "regen.ecocredit.basket.v1.AdmissionCriteria.max_batch_age":
+		x.MaxBatchAge = value.Message().Interface().(*durationpb.Duration)
+	case "regen.ecocredit.basket.v1.AdmissionCriteria.attestor":
+		x.Attestor = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.AdmissionCriteria"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.AdmissionCriteria does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AdmissionCriteria) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.AdmissionCriteria.allowed_project_ids":
+		if x.AllowedProjectIds == nil {
+			x.AllowedProjectIds = []string{}
+		}
+		value := &_AdmissionCriteria_1_list{list: &x.AllowedProjectIds}
+		return protoreflect.ValueOfList(value)
+	case "regen.ecocredit.basket.v1.AdmissionCriteria.denied_project_ids":
+		if x.DeniedProjectIds == nil {
+			x.DeniedProjectIds = []string{}
+		}
+		value := &_AdmissionCriteria_2_list{list: &x.DeniedProjectIds}
+		return protoreflect.ValueOfList(value)
+	case "regen.ecocredit.basket.v1.AdmissionCriteria.allowed_jurisdiction_prefixes":
+		if x.AllowedJurisdictionPrefixes == nil {
+			x.AllowedJurisdictionPrefixes = []string{}
+		}
+		value := &_AdmissionCriteria_3_list{list: &x.AllowedJurisdictionPrefixes}
+		return protoreflect.ValueOfList(value)
+	case "regen.ecocredit.basket.v1.AdmissionCriteria.denied_jurisdiction_prefixes":
+		if x.DeniedJurisdictionPrefixes == nil {
+			x.DeniedJurisdictionPrefixes = []string{}
+		}
+		value := &_AdmissionCriteria_4_list{list: &x.DeniedJurisdictionPrefixes}
+		return protoreflect.ValueOfList(value)
+	case "regen.ecocredit.basket.v1.AdmissionCriteria.max_batch_age":
+		if x.MaxBatchAge == nil {
+			x.MaxBatchAge = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.MaxBatchAge.ProtoReflect())
+	case "regen.ecocredit.basket.v1.AdmissionCriteria.attestor":
+		panic(fmt.Errorf("field attestor of message regen.ecocredit.basket.v1.AdmissionCriteria is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.AdmissionCriteria"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.AdmissionCriteria does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AdmissionCriteria) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.basket.v1.AdmissionCriteria.allowed_project_ids":
+		list := []string{}
+		return protoreflect.ValueOfList(&_AdmissionCriteria_1_list{list: &list})
+	case "regen.ecocredit.basket.v1.AdmissionCriteria.denied_project_ids":
+		list := []string{}
+		return protoreflect.ValueOfList(&_AdmissionCriteria_2_list{list: &list})
+	case "regen.ecocredit.basket.v1.AdmissionCriteria.allowed_jurisdiction_prefixes":
+		list := []string{}
+		return protoreflect.ValueOfList(&_AdmissionCriteria_3_list{list: &list})
+	case "regen.ecocredit.basket.v1.AdmissionCriteria.denied_jurisdiction_prefixes":
+		list := []string{}
+		return protoreflect.ValueOfList(&_AdmissionCriteria_4_list{list: &list})
+	case "regen.ecocredit.basket.v1.AdmissionCriteria.max_batch_age":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.ecocredit.basket.v1.AdmissionCriteria.attestor":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.basket.v1.AdmissionCriteria"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.basket.v1.AdmissionCriteria does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AdmissionCriteria) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.basket.v1.AdmissionCriteria", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AdmissionCriteria) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AdmissionCriteria) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AdmissionCriteria) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AdmissionCriteria) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AdmissionCriteria)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.AllowedProjectIds) > 0 {
+			for _, s := range x.AllowedProjectIds {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DeniedProjectIds) > 0 {
+			for _, s := range x.DeniedProjectIds {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AllowedJurisdictionPrefixes) > 0 {
+			for _, s := range x.AllowedJurisdictionPrefixes {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DeniedJurisdictionPrefixes) > 0 {
+			for _, s := range x.DeniedJurisdictionPrefixes {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxBatchAge != nil {
+			l = options.Size(x.MaxBatchAge)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Attestor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AdmissionCriteria)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Attestor) > 0 {
+			i -= len(x.Attestor)
+			copy(dAtA[i:], x.Attestor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Attestor)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.MaxBatchAge != nil {
+			encoded, err := options.Marshal(x.MaxBatchAge)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.DeniedJurisdictionPrefixes) > 0 {
+			for iNdEx := len(x.DeniedJurisdictionPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.DeniedJurisdictionPrefixes[iNdEx])
+				copy(dAtA[i:], x.DeniedJurisdictionPrefixes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DeniedJurisdictionPrefixes[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.AllowedJurisdictionPrefixes) > 0 {
+			for iNdEx := len(x.AllowedJurisdictionPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedJurisdictionPrefixes[iNdEx])
+				copy(dAtA[i:], x.AllowedJurisdictionPrefixes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedJurisdictionPrefixes[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.DeniedProjectIds) > 0 {
+			for iNdEx := len(x.DeniedProjectIds) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.DeniedProjectIds[iNdEx])
+				copy(dAtA[i:], x.DeniedProjectIds[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DeniedProjectIds[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.AllowedProjectIds) > 0 {
+			for iNdEx := len(x.AllowedProjectIds) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedProjectIds[iNdEx])
+				copy(dAtA[i:], x.AllowedProjectIds[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedProjectIds[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AdmissionCriteria)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AdmissionCriteria: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AdmissionCriteria: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedProjectIds", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedProjectIds = append(x.AllowedProjectIds, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeniedProjectIds", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DeniedProjectIds = append(x.DeniedProjectIds, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedJurisdictionPrefixes", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedJurisdictionPrefixes = append(x.AllowedJurisdictionPrefixes, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeniedJurisdictionPrefixes", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DeniedJurisdictionPrefixes = append(x.DeniedJurisdictionPrefixes, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBatchAge", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MaxBatchAge == nil {
+					x.MaxBatchAge = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxBatchAge); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attestor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Attestor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.27.0
@@ -1075,6 +2070,71 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// TakeStrategy is the strategy used to select the credits taken from a basket.
+type TakeStrategy int32
+
+const (
+	// TAKE_STRATEGY_UNSPECIFIED defaults to taking the oldest credits first.
+	TakeStrategy_TAKE_STRATEGY_UNSPECIFIED TakeStrategy = 0
+	// TAKE_STRATEGY_OLDEST_FIRST takes credits from the batches with the oldest
+	// start date first.
+	TakeStrategy_TAKE_STRATEGY_OLDEST_FIRST TakeStrategy = 1
+	// TAKE_STRATEGY_NEWEST_FIRST takes credits from the batches with the newest
+	// start date first.
+	TakeStrategy_TAKE_STRATEGY_NEWEST_FIRST TakeStrategy = 2
+	// TAKE_STRATEGY_PROPORTIONAL takes credits from all batches in the basket
+	// proportionally to the balance of each batch in the basket.
+	TakeStrategy_TAKE_STRATEGY_PROPORTIONAL TakeStrategy = 3
+	// TAKE_STRATEGY_SELECTED_BATCHES takes credits from the batches listed in
+	// the request in the order they are listed.
+	TakeStrategy_TAKE_STRATEGY_SELECTED_BATCHES TakeStrategy = 4
+)
+
+// Enum value maps for TakeStrategy.
+var (
+	TakeStrategy_name = map[int32]string{
+		0: "TAKE_STRATEGY_UNSPECIFIED",
+		1: "TAKE_STRATEGY_OLDEST_FIRST",
+		2: "TAKE_STRATEGY_NEWEST_FIRST",
+		3: "TAKE_STRATEGY_PROPORTIONAL",
+		4: "TAKE_STRATEGY_SELECTED_BATCHES",
+	}
+	TakeStrategy_value = map[string]int32{
+		"TAKE_STRATEGY_UNSPECIFIED":      0,
+		"TAKE_STRATEGY_OLDEST_FIRST":     1,
+		"TAKE_STRATEGY_NEWEST_FIRST":     2,
+		"TAKE_STRATEGY_PROPORTIONAL":     3,
+		"TAKE_STRATEGY_SELECTED_BATCHES": 4,
+	}
+)
+
+func (x TakeStrategy) Enum() *TakeStrategy {
+	p := new(TakeStrategy)
+	*p = x
+	return p
+}
+
+func (x TakeStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TakeStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_regen_ecocredit_basket_v1_types_proto_enumTypes[0].Descriptor()
+}
+
+func (TakeStrategy) Type() protoreflect.EnumType {
+	return &file_regen_ecocredit_basket_v1_types_proto_enumTypes[0]
+}
+
+func (x TakeStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TakeStrategy.Descriptor instead.
+func (TakeStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_regen_ecocredit_basket_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
 // BasketCredit represents the information for a credit batch inside a basket.
 type BasketCredit struct {
 	state         protoimpl.MessageState
@@ -1193,6 +2253,103 @@ func (x *DateCriteria) GetYearsInThePast() uint32 {
 	return 0
 }
 
+// AdmissionCriteria represents additional criteria for batches admitted to a
+// basket beyond the credit type, allowed classes and date criteria of the
+// basket. All of the fields are optional and only the fields that are set are
+// checked when credits are put into the basket.
+type AdmissionCriteria struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// allowed_project_ids (optional) are the only projects from which batches
+	// are allowed into the basket. At most only one of `allowed_project_ids` and
+	// `denied_project_ids` can be set for a basket.
+	AllowedProjectIds []string `protobuf:"bytes,1,rep,name=allowed_project_ids,json=allowedProjectIds,proto3" json:"allowed_project_ids,omitempty"`
+	// denied_project_ids (optional) are projects from which batches are not
+	// allowed into the basket. At most only one of `allowed_project_ids` and
+	// `denied_project_ids` can be set for a basket.
+	DeniedProjectIds []string `protobuf:"bytes,2,rep,name=denied_project_ids,json=deniedProjectIds,proto3" json:"denied_project_ids,omitempty"`
+	// allowed_jurisdiction_prefixes (optional) are jurisdiction prefixes (e.g.
+	// "US-" or "KE") of which the project jurisdiction of a batch must start with
+	// at least one to be allowed into the basket.
+	AllowedJurisdictionPrefixes []string `protobuf:"bytes,3,rep,name=allowed_jurisdiction_prefixes,json=allowedJurisdictionPrefixes,proto3" json:"allowed_jurisdiction_prefixes,omitempty"`
+	// denied_jurisdiction_prefixes (optional) are jurisdiction prefixes (e.g.
+	// "US-" or "KE") with which the project jurisdiction of a batch must not
+	// start to be allowed into the basket.
+	DeniedJurisdictionPrefixes []string `protobuf:"bytes,4,rep,name=denied_jurisdiction_prefixes,json=deniedJurisdictionPrefixes,proto3" json:"denied_jurisdiction_prefixes,omitempty"`
+	// max_batch_age (optional) is the maximum duration of time between the
+	// issuance date of a batch and the block timestamp at which credits from the
+	// batch can be put into the basket.
+	MaxBatchAge *durationpb.Duration `protobuf:"bytes,5,opt,name=max_batch_age,json=maxBatchAge,proto3" json:"max_batch_age,omitempty"`
+	// attestor (optional) is the address of an attestor that must have attested
+	// to the metadata IRI of a batch in the data module for credits from the
+	// batch to be allowed into the basket.
+	Attestor string `protobuf:"bytes,6,opt,name=attestor,proto3" json:"attestor,omitempty"`
+}
+
+func (x *AdmissionCriteria) Reset() {
+	*x = AdmissionCriteria{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_basket_v1_types_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdmissionCriteria) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdmissionCriteria) ProtoMessage() {}
+
+// Deprecated: Use AdmissionCriteria.ProtoReflect.Descriptor instead.
+func (*AdmissionCriteria) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_basket_v1_types_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AdmissionCriteria) GetAllowedProjectIds() []string {
+	if x != nil {
+		return x.AllowedProjectIds
+	}
+	return nil
+}
+
+func (x *AdmissionCriteria) GetDeniedProjectIds() []string {
+	if x != nil {
+		return x.DeniedProjectIds
+	}
+	return nil
+}
+
+func (x *AdmissionCriteria) GetAllowedJurisdictionPrefixes() []string {
+	if x != nil {
+		return x.AllowedJurisdictionPrefixes
+	}
+	return nil
+}
+
+func (x *AdmissionCriteria) GetDeniedJurisdictionPrefixes() []string {
+	if x != nil {
+		return x.DeniedJurisdictionPrefixes
+	}
+	return nil
+}
+
+func (x *AdmissionCriteria) GetMaxBatchAge() *durationpb.Duration {
+	if x != nil {
+		return x.MaxBatchAge
+	}
+	return nil
+}
+
+func (x *AdmissionCriteria) GetAttestor() string {
+	if x != nil {
+		return x.Attestor
+	}
+	return ""
+}
+
 var File_regen_ecocredit_basket_v1_types_proto protoreflect.FileDescriptor
 
 var file_regen_ecocredit_basket_v1_types_proto_rawDesc = []byte{
@@ -1221,23 +2378,56 @@ var file_regen_ecocredit_basket_v1_types_proto_rawDesc = []byte{
 	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x29, 0x0a, 0x11, 0x79, 0x65, 0x61, 0x72, 0x73, 0x5f,
 	0x69, 0x6e, 0x5f, 0x74, 0x68, 0x65, 0x5f, 0x70, 0x61, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x0d, 0x52, 0x0e, 0x79, 0x65, 0x61, 0x72, 0x73, 0x49, 0x6e, 0x54, 0x68, 0x65, 0x50, 0x61, 0x73,
-	0x74, 0x42, 0x80, 0x02, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e,
-	0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74,
-	0x2e, 0x76, 0x31, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x4c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x65,
-	0x67, 0x65, 0x6e, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x72, 0x65, 0x67, 0x65,
-	0x6e, 0x2d, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x67,
-	0x65, 0x6e, 0x2f, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2f, 0x62, 0x61, 0x73,
-	0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x76, 0x31, 0xa2,
-	0x02, 0x03, 0x52, 0x45, 0x42, 0xaa, 0x02, 0x19, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x45, 0x63,
-	0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2e, 0x56,
-	0x31, 0xca, 0x02, 0x19, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65,
-	0x64, 0x69, 0x74, 0x5c, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x25,
-	0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5c,
-	0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1c, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x3a, 0x3a, 0x45,
-	0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x3a, 0x3a, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74,
-	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x22, 0xd2, 0x02, 0x0a, 0x11, 0x41, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43,
+	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x65, 0x6e, 0x69, 0x65,
+	0x64, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x10, 0x64, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x49, 0x64, 0x73, 0x12, 0x42, 0x0a, 0x1d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x5f, 0x6a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x1b, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x1c, 0x64, 0x65, 0x6e,
+	0x69, 0x65, 0x64, 0x5f, 0x6a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x1a, 0x64, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x4a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x0d, 0x6d,
+	0x61, 0x78, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x6d,
+	0x61, 0x78, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x2a, 0xb1, 0x01, 0x0a, 0x0c, 0x54, 0x61, 0x6b, 0x65, 0x53,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x41, 0x4b, 0x45, 0x5f,
+	0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x41, 0x4b, 0x45, 0x5f, 0x53,
+	0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x4f, 0x4c, 0x44, 0x45, 0x53, 0x54, 0x5f, 0x46,
+	0x49, 0x52, 0x53, 0x54, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x41, 0x4b, 0x45, 0x5f, 0x53,
+	0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x4e, 0x45, 0x57, 0x45, 0x53, 0x54, 0x5f, 0x46,
+	0x49, 0x52, 0x53, 0x54, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x41, 0x4b, 0x45, 0x5f, 0x53,
+	0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x52, 0x54, 0x49,
+	0x4f, 0x4e, 0x41, 0x4c, 0x10, 0x03, 0x12, 0x22, 0x0a, 0x1e, 0x54, 0x41, 0x4b, 0x45, 0x5f, 0x53,
+	0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x45, 0x44,
+	0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x45, 0x53, 0x10, 0x04, 0x42, 0x80, 0x02, 0x0a, 0x1d, 0x63,
+	0x6f, 0x6d, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x2e, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4c, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x63, 0x6f, 0x63,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x2f, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x3b,
+	0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x52, 0x45, 0x42, 0xaa, 0x02,
+	0x19, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x2e, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x19, 0x52, 0x65, 0x67,
+	0x65, 0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5c, 0x42, 0x61, 0x73,
+	0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x25, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45,
+	0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5c, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5c,
+	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x1c, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x3a, 0x3a, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x3a, 0x3a, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1252,21 +2442,25 @@ func file_regen_ecocredit_basket_v1_types_proto_rawDescGZIP() []byte {
 	return file_regen_ecocredit_basket_v1_types_proto_rawDescData
 }
 
-var file_regen_ecocredit_basket_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_regen_ecocredit_basket_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_regen_ecocredit_basket_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_regen_ecocredit_basket_v1_types_proto_goTypes = []interface{}{
-	(*BasketCredit)(nil),          // 0: regen.ecocredit.basket.v1.BasketCredit
-	(*DateCriteria)(nil),          // 1: regen.ecocredit.basket.v1.DateCriteria
-	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
-	(*durationpb.Duration)(nil),   // 3: google.protobuf.Duration
+	(TakeStrategy)(0),             // 0: regen.ecocredit.basket.v1.TakeStrategy
+	(*BasketCredit)(nil),          // 1: regen.ecocredit.basket.v1.BasketCredit
+	(*DateCriteria)(nil),          // 2: regen.ecocredit.basket.v1.DateCriteria
+	(*AdmissionCriteria)(nil),     // 3: regen.ecocredit.basket.v1.AdmissionCriteria
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 5: google.protobuf.Duration
 }
 var file_regen_ecocredit_basket_v1_types_proto_depIdxs = []int32{
-	2, // 0: regen.ecocredit.basket.v1.DateCriteria.min_start_date:type_name -> google.protobuf.Timestamp
-	3, // 1: regen.ecocredit.basket.v1.DateCriteria.start_date_window:type_name -> google.protobuf.Duration
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	4, // 0: regen.ecocredit.basket.v1.DateCriteria.min_start_date:type_name -> google.protobuf.Timestamp
+	5, // 1: regen.ecocredit.basket.v1.DateCriteria.start_date_window:type_name -> google.protobuf.Duration
+	5, // 2: regen.ecocredit.basket.v1.AdmissionCriteria.max_batch_age:type_name -> google.protobuf.Duration
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_regen_ecocredit_basket_v1_types_proto_init() }
@@ -1299,19 +2493,32 @@ func file_regen_ecocredit_basket_v1_types_proto_init() {
 				return nil
 			}
 		}
+		file_regen_ecocredit_basket_v1_types_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdmissionCriteria); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_regen_ecocredit_basket_v1_types_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_regen_ecocredit_basket_v1_types_proto_goTypes,
 		DependencyIndexes: file_regen_ecocredit_basket_v1_types_proto_depIdxs,
+		EnumInfos:         file_regen_ecocredit_basket_v1_types_proto_enumTypes,
 		MessageInfos:      file_regen_ecocredit_basket_v1_types_proto_msgTypes,
 	}.Build()
 	File_regen_ecocredit_basket_v1_types_proto = out.File
@@ -32,9 +32,87 @@ type QueryClient interface {
 	// SellOrdersBySeller queries a paginated list of all sell orders based on the
 	// account address of the seller.
 	SellOrdersBySeller(ctx context.Context, in *QuerySellOrdersBySellerRequest, opts ...grpc.CallOption) (*QuerySellOrdersBySellerResponse, error)
+	// SellOrdersPendingExpiry queries a paginated list of all sell orders with
+	// an expiration sorted by expiration (soonest first). Sell orders that have
+	// expired but have not yet been pruned are included first.
+	SellOrdersPendingExpiry(ctx context.Context, in *QuerySellOrdersPendingExpiryRequest, opts ...grpc.CallOption) (*QuerySellOrdersPendingExpiryResponse, error)
+	// SellOrdersByClass queries a paginated list of all sell orders based on the
+	// credit class of the credits being sold with optional filters for the
+	// jurisdiction of the project and the start date of the credit batch.
+	SellOrdersByClass(ctx context.Context, in *QuerySellOrdersByClassRequest, opts ...grpc.CallOption) (*QuerySellOrdersByClassResponse, error)
+	// SellOrdersByProject queries a paginated list of all sell orders based on
+	// the project of the credits being sold with an optional filter for the
+	// start date of the credit batch.
+	SellOrdersByProject(ctx context.Context, in *QuerySellOrdersByProjectRequest, opts ...grpc.CallOption) (*QuerySellOrdersByProjectResponse, error)
+	// SellOrdersByMarket queries a paginated list of all sell orders within a
+	// market sorted by ask price (lowest ask price first, or highest ask price
+	// first when pagination.reverse is set).
+	SellOrdersByMarket(ctx context.Context, in *QuerySellOrdersByMarketRequest, opts ...grpc.CallOption) (*QuerySellOrdersByMarketResponse, error)
+	// BuyOrder queries a buy order by its unique identifier.
+	BuyOrder(ctx context.Context, in *QueryBuyOrderRequest, opts ...grpc.CallOption) (*QueryBuyOrderResponse, error)
+	// BuyOrders queries a paginated list of all buy orders.
+	BuyOrders(ctx context.Context, in *QueryBuyOrdersRequest, opts ...grpc.CallOption) (*QueryBuyOrdersResponse, error)
+	// BuyOrdersByBuyer queries a paginated list of all buy orders based on the
+	// account address of the buyer.
+	BuyOrdersByBuyer(ctx context.Context, in *QueryBuyOrdersByBuyerRequest, opts ...grpc.CallOption) (*QueryBuyOrdersByBuyerResponse, error)
 	// AllowedDenoms queries a paginated list of all bank denoms allowed to be
 	// used in the marketplace.
 	AllowedDenoms(ctx context.Context, in *QueryAllowedDenomsRequest, opts ...grpc.CallOption) (*QueryAllowedDenomsResponse, error)
+	// MarketFees queries the fees and the total amount of fees collected in a
+	// market.
+	MarketFees(ctx context.Context, in *QueryMarketFeesRequest, opts ...grpc.CallOption) (*QueryMarketFeesResponse, error)
+	// TradesByBatch queries a paginated list of all trades based on the batch
+	// denom of the credits traded.
+	TradesByBatch(ctx context.Context, in *QueryTradesByBatchRequest, opts ...grpc.CallOption) (*QueryTradesByBatchResponse, error)
+	// TradesByMarket queries a paginated list of all trades in a market ordered
+	// by the time of the trade.
+	TradesByMarket(ctx context.Context, in *QueryTradesByMarketRequest, opts ...grpc.CallOption) (*QueryTradesByMarketResponse, error)
+	// TradesByAddress queries a paginated list of all trades in which the
+	// account address was the buyer or the seller.
+	TradesByAddress(ctx context.Context, in *QueryTradesByAddressRequest, opts ...grpc.CallOption) (*QueryTradesByAddressResponse, error)
+	// MarketStats queries price statistics of a market over a window of time.
+	MarketStats(ctx context.Context, in *QueryMarketStatsRequest, opts ...grpc.CallOption) (*QueryMarketStatsResponse, error)
+	// FeeDestination queries the destination of marketplace fees.
+	FeeDestination(ctx context.Context, in *QueryFeeDestinationRequest, opts ...grpc.CallOption) (*QueryFeeDestinationResponse, error)
+	// Offer queries an offer by its unique identifier.
+	Offer(ctx context.Context, in *QueryOfferRequest, opts ...grpc.CallOption) (*QueryOfferResponse, error)
+	// OffersByBatch queries a paginated list of all offers based on the batch
+	// denom of the credits being bought.
+	OffersByBatch(ctx context.Context, in *QueryOffersByBatchRequest, opts ...grpc.CallOption) (*QueryOffersByBatchResponse, error)
+	// OffersByBuyer queries a paginated list of all offers based on the account
+	// address of the buyer.
+	OffersByBuyer(ctx context.Context, in *QueryOffersByBuyerRequest, opts ...grpc.CallOption) (*QueryOffersByBuyerResponse, error)
+	// OffersByHolder queries a paginated list of all offers made to a specific
+	// credit holder. Offers that any holder can accept are not included.
+	OffersByHolder(ctx context.Context, in *QueryOffersByHolderRequest, opts ...grpc.CallOption) (*QueryOffersByHolderResponse, error)
+	// DutchAuction queries a dutch auction by its unique identifier.
+	DutchAuction(ctx context.Context, in *QueryDutchAuctionRequest, opts ...grpc.CallOption) (*QueryDutchAuctionResponse, error)
+	// DutchAuctions queries a paginated list of all dutch auctions.
+	DutchAuctions(ctx context.Context, in *QueryDutchAuctionsRequest, opts ...grpc.CallOption) (*QueryDutchAuctionsResponse, error)
+	// SealedBidAuction queries a sealed-bid auction by its unique identifier.
+	SealedBidAuction(ctx context.Context, in *QuerySealedBidAuctionRequest, opts ...grpc.CallOption) (*QuerySealedBidAuctionResponse, error)
+	// SealedBidAuctions queries a paginated list of all sealed-bid auctions.
+	SealedBidAuctions(ctx context.Context, in *QuerySealedBidAuctionsRequest, opts ...grpc.CallOption) (*QuerySealedBidAuctionsResponse, error)
+	// SealedBids queries a paginated list of all bids in a sealed-bid auction.
+	SealedBids(ctx context.Context, in *QuerySealedBidsRequest, opts ...grpc.CallOption) (*QuerySealedBidsResponse, error)
+	// ForwardSale queries a forward sale by its unique identifier.
+	ForwardSale(ctx context.Context, in *QueryForwardSaleRequest, opts ...grpc.CallOption) (*QueryForwardSaleResponse, error)
+	// ForwardSalesByProject queries a paginated list of all forward sales of a
+	// project.
+	ForwardSalesByProject(ctx context.Context, in *QueryForwardSalesByProjectRequest, opts ...grpc.CallOption) (*QueryForwardSalesByProjectResponse, error)
+	// ForwardContract queries a forward contract by its unique identifier.
+	ForwardContract(ctx context.Context, in *QueryForwardContractRequest, opts ...grpc.CallOption) (*QueryForwardContractResponse, error)
+	// ForwardContractsBySale queries a paginated list of all forward contracts
+	// of a forward sale in delivery priority order.
+	ForwardContractsBySale(ctx context.Context, in *QueryForwardContractsBySaleRequest, opts ...grpc.CallOption) (*QueryForwardContractsBySaleResponse, error)
+	// ForwardContractsByBuyer queries a paginated list of all forward contracts
+	// bought by a specific buyer.
+	ForwardContractsByBuyer(ctx context.Context, in *QueryForwardContractsByBuyerRequest, opts ...grpc.CallOption) (*QueryForwardContractsByBuyerResponse, error)
+	// Markets queries a paginated list of all markets.
+	Markets(ctx context.Context, in *QueryMarketsRequest, opts ...grpc.CallOption) (*QueryMarketsResponse, error)
+	// MarketRegistration queries whether market registration is permissionless
+	// and the deposit paid to register a market.
+	MarketRegistration(ctx context.Context, in *QueryMarketRegistrationRequest, opts ...grpc.CallOption) (*QueryMarketRegistrationResponse, error)
 }
 
 type queryClient struct {
@@ -81,6 +159,69 @@ func (c *queryClient) SellOrdersBySeller(ctx context.Context, in *QuerySellOrder
 	return out, nil
 }
 
+func (c *queryClient) SellOrdersPendingExpiry(ctx context.Context, in *QuerySellOrdersPendingExpiryRequest, opts ...grpc.CallOption) (*QuerySellOrdersPendingExpiryResponse, error) {
+	out := new(QuerySellOrdersPendingExpiryResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/SellOrdersPendingExpiry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SellOrdersByClass(ctx context.Context, in *QuerySellOrdersByClassRequest, opts ...grpc.CallOption) (*QuerySellOrdersByClassResponse, error) {
+	out := new(QuerySellOrdersByClassResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/SellOrdersByClass", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SellOrdersByProject(ctx context.Context, in *QuerySellOrdersByProjectRequest, opts ...grpc.CallOption) (*QuerySellOrdersByProjectResponse, error) {
+	out := new(QuerySellOrdersByProjectResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/SellOrdersByProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SellOrdersByMarket(ctx context.Context, in *QuerySellOrdersByMarketRequest, opts ...grpc.CallOption) (*QuerySellOrdersByMarketResponse, error) {
+	out := new(QuerySellOrdersByMarketResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/SellOrdersByMarket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BuyOrder(ctx context.Context, in *QueryBuyOrderRequest, opts ...grpc.CallOption) (*QueryBuyOrderResponse, error) {
+	out := new(QueryBuyOrderResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/BuyOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BuyOrders(ctx context.Context, in *QueryBuyOrdersRequest, opts ...grpc.CallOption) (*QueryBuyOrdersResponse, error) {
+	out := new(QueryBuyOrdersResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/BuyOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BuyOrdersByBuyer(ctx context.Context, in *QueryBuyOrdersByBuyerRequest, opts ...grpc.CallOption) (*QueryBuyOrdersByBuyerResponse, error) {
+	out := new(QueryBuyOrdersByBuyerResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/BuyOrdersByBuyer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) AllowedDenoms(ctx context.Context, in *QueryAllowedDenomsRequest, opts ...grpc.CallOption) (*QueryAllowedDenomsResponse, error) {
 	out := new(QueryAllowedDenomsResponse)
 	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/AllowedDenoms", in, out, opts...)
@@ -90,6 +231,204 @@ func (c *queryClient) AllowedDenoms(ctx context.Context, in *QueryAllowedDenomsR
 	return out, nil
 }
 
+func (c *queryClient) MarketFees(ctx context.Context, in *QueryMarketFeesRequest, opts ...grpc.CallOption) (*QueryMarketFeesResponse, error) {
+	out := new(QueryMarketFeesResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/MarketFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TradesByBatch(ctx context.Context, in *QueryTradesByBatchRequest, opts ...grpc.CallOption) (*QueryTradesByBatchResponse, error) {
+	out := new(QueryTradesByBatchResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/TradesByBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TradesByMarket(ctx context.Context, in *QueryTradesByMarketRequest, opts ...grpc.CallOption) (*QueryTradesByMarketResponse, error) {
+	out := new(QueryTradesByMarketResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/TradesByMarket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TradesByAddress(ctx context.Context, in *QueryTradesByAddressRequest, opts ...grpc.CallOption) (*QueryTradesByAddressResponse, error) {
+	out := new(QueryTradesByAddressResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/TradesByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketStats(ctx context.Context, in *QueryMarketStatsRequest, opts ...grpc.CallOption) (*QueryMarketStatsResponse, error) {
+	out := new(QueryMarketStatsResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/MarketStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeDestination(ctx context.Context, in *QueryFeeDestinationRequest, opts ...grpc.CallOption) (*QueryFeeDestinationResponse, error) {
+	out := new(QueryFeeDestinationResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/FeeDestination", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Offer(ctx context.Context, in *QueryOfferRequest, opts ...grpc.CallOption) (*QueryOfferResponse, error) {
+	out := new(QueryOfferResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/Offer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OffersByBatch(ctx context.Context, in *QueryOffersByBatchRequest, opts ...grpc.CallOption) (*QueryOffersByBatchResponse, error) {
+	out := new(QueryOffersByBatchResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/OffersByBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OffersByBuyer(ctx context.Context, in *QueryOffersByBuyerRequest, opts ...grpc.CallOption) (*QueryOffersByBuyerResponse, error) {
+	out := new(QueryOffersByBuyerResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/OffersByBuyer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OffersByHolder(ctx context.Context, in *QueryOffersByHolderRequest, opts ...grpc.CallOption) (*QueryOffersByHolderResponse, error) {
+	out := new(QueryOffersByHolderResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/OffersByHolder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DutchAuction(ctx context.Context, in *QueryDutchAuctionRequest, opts ...grpc.CallOption) (*QueryDutchAuctionResponse, error) {
+	out := new(QueryDutchAuctionResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/DutchAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DutchAuctions(ctx context.Context, in *QueryDutchAuctionsRequest, opts ...grpc.CallOption) (*QueryDutchAuctionsResponse, error) {
+	out := new(QueryDutchAuctionsResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/DutchAuctions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SealedBidAuction(ctx context.Context, in *QuerySealedBidAuctionRequest, opts ...grpc.CallOption) (*QuerySealedBidAuctionResponse, error) {
+	out := new(QuerySealedBidAuctionResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/SealedBidAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SealedBidAuctions(ctx context.Context, in *QuerySealedBidAuctionsRequest, opts ...grpc.CallOption) (*QuerySealedBidAuctionsResponse, error) {
+	out := new(QuerySealedBidAuctionsResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/SealedBidAuctions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SealedBids(ctx context.Context, in *QuerySealedBidsRequest, opts ...grpc.CallOption) (*QuerySealedBidsResponse, error) {
+	out := new(QuerySealedBidsResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/SealedBids", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ForwardSale(ctx context.Context, in *QueryForwardSaleRequest, opts ...grpc.CallOption) (*QueryForwardSaleResponse, error) {
+	out := new(QueryForwardSaleResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/ForwardSale", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ForwardSalesByProject(ctx context.Context, in *QueryForwardSalesByProjectRequest, opts ...grpc.CallOption) (*QueryForwardSalesByProjectResponse, error) {
+	out := new(QueryForwardSalesByProjectResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/ForwardSalesByProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ForwardContract(ctx context.Context, in *QueryForwardContractRequest, opts ...grpc.CallOption) (*QueryForwardContractResponse, error) {
+	out := new(QueryForwardContractResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/ForwardContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ForwardContractsBySale(ctx context.Context, in *QueryForwardContractsBySaleRequest, opts ...grpc.CallOption) (*QueryForwardContractsBySaleResponse, error) {
+	out := new(QueryForwardContractsBySaleResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/ForwardContractsBySale", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ForwardContractsByBuyer(ctx context.Context, in *QueryForwardContractsByBuyerRequest, opts ...grpc.CallOption) (*QueryForwardContractsByBuyerResponse, error) {
+	out := new(QueryForwardContractsByBuyerResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/ForwardContractsByBuyer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Markets(ctx context.Context, in *QueryMarketsRequest, opts ...grpc.CallOption) (*QueryMarketsResponse, error) {
+	out := new(QueryMarketsResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/Markets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketRegistration(ctx context.Context, in *QueryMarketRegistrationRequest, opts ...grpc.CallOption) (*QueryMarketRegistrationResponse, error) {
+	out := new(QueryMarketRegistrationResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/MarketRegistration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -104,9 +443,87 @@ type QueryServer interface {
 	// SellOrdersBySeller queries a paginated list of all sell orders based on the
 	// account address of the seller.
 	SellOrdersBySeller(context.Context, *QuerySellOrdersBySellerRequest) (*QuerySellOrdersBySellerResponse, error)
+	// SellOrdersPendingExpiry queries a paginated list of all sell orders with
+	// an expiration sorted by expiration (soonest first). Sell orders that have
+	// expired but have not yet been pruned are included first.
+	SellOrdersPendingExpiry(context.Context, *QuerySellOrdersPendingExpiryRequest) (*QuerySellOrdersPendingExpiryResponse, error)
+	// SellOrdersByClass queries a paginated list of all sell orders based on the
+	// credit class of the credits being sold with optional filters for the
+	// jurisdiction of the project and the start date of the credit batch.
+	SellOrdersByClass(context.Context, *QuerySellOrdersByClassRequest) (*QuerySellOrdersByClassResponse, error)
+	// SellOrdersByProject queries a paginated list of all sell orders based on
+	// the project of the credits being sold with an optional filter for the
+	// start date of the credit batch.
+	SellOrdersByProject(context.Context, *QuerySellOrdersByProjectRequest) (*QuerySellOrdersByProjectResponse, error)
+	// SellOrdersByMarket queries a paginated list of all sell orders within a
+	// market sorted by ask price (lowest ask price first, or highest ask price
+	// first when pagination.reverse is set).
+	SellOrdersByMarket(context.Context, *QuerySellOrdersByMarketRequest) (*QuerySellOrdersByMarketResponse, error)
+	// BuyOrder queries a buy order by its unique identifier.
+	BuyOrder(context.Context, *QueryBuyOrderRequest) (*QueryBuyOrderResponse, error)
+	// BuyOrders queries a paginated list of all buy orders.
+	BuyOrders(context.Context, *QueryBuyOrdersRequest) (*QueryBuyOrdersResponse, error)
+	// BuyOrdersByBuyer queries a paginated list of all buy orders based on the
+	// account address of the buyer.
+	BuyOrdersByBuyer(context.Context, *QueryBuyOrdersByBuyerRequest) (*QueryBuyOrdersByBuyerResponse, error)
 	// AllowedDenoms queries a paginated list of all bank denoms allowed to be
 	// used in the marketplace.
 	AllowedDenoms(context.Context, *QueryAllowedDenomsRequest) (*QueryAllowedDenomsResponse, error)
+	// MarketFees queries the fees and the total amount of fees collected in a
+	// market.
+	MarketFees(context.Context, *QueryMarketFeesRequest) (*QueryMarketFeesResponse, error)
+	// TradesByBatch queries a paginated list of all trades based on the batch
+	// denom of the credits traded.
+	TradesByBatch(context.Context, *QueryTradesByBatchRequest) (*QueryTradesByBatchResponse, error)
+	// TradesByMarket queries a paginated list of all trades in a market ordered
+	// by the time of the trade.
+	TradesByMarket(context.Context, *QueryTradesByMarketRequest) (*QueryTradesByMarketResponse, error)
+	// TradesByAddress queries a paginated list of all trades in which the
+	// account address was the buyer or the seller.
+	TradesByAddress(context.Context, *QueryTradesByAddressRequest) (*QueryTradesByAddressResponse, error)
+	// MarketStats queries price statistics of a market over a window of time.
+	MarketStats(context.Context, *QueryMarketStatsRequest) (*QueryMarketStatsResponse, error)
+	// FeeDestination queries the destination of marketplace fees.
+	FeeDestination(context.Context, *QueryFeeDestinationRequest) (*QueryFeeDestinationResponse, error)
+	// Offer queries an offer by its unique identifier.
+	Offer(context.Context, *QueryOfferRequest) (*QueryOfferResponse, error)
+	// OffersByBatch queries a paginated list of all offers based on the batch
+	// denom of the credits being bought.
+	OffersByBatch(context.Context, *QueryOffersByBatchRequest) (*QueryOffersByBatchResponse, error)
+	// OffersByBuyer queries a paginated list of all offers based on the account
+	// address of the buyer.
+	OffersByBuyer(context.Context, *QueryOffersByBuyerRequest) (*QueryOffersByBuyerResponse, error)
+	// OffersByHolder queries a paginated list of all offers made to a specific
+	// credit holder. Offers that any holder can accept are not included.
+	OffersByHolder(context.Context, *QueryOffersByHolderRequest) (*QueryOffersByHolderResponse, error)
+	// DutchAuction queries a dutch auction by its unique identifier.
+	DutchAuction(context.Context, *QueryDutchAuctionRequest) (*QueryDutchAuctionResponse, error)
+	// DutchAuctions queries a paginated list of all dutch auctions.
+	DutchAuctions(context.Context, *QueryDutchAuctionsRequest) (*QueryDutchAuctionsResponse, error)
+	// SealedBidAuction queries a sealed-bid auction by its unique identifier.
+	SealedBidAuction(context.Context, *QuerySealedBidAuctionRequest) (*QuerySealedBidAuctionResponse, error)
+	// SealedBidAuctions queries a paginated list of all sealed-bid auctions.
+	SealedBidAuctions(context.Context, *QuerySealedBidAuctionsRequest) (*QuerySealedBidAuctionsResponse, error)
+	// SealedBids queries a paginated list of all bids in a sealed-bid auction.
+	SealedBids(context.Context, *QuerySealedBidsRequest) (*QuerySealedBidsResponse, error)
+	// ForwardSale queries a forward sale by its unique identifier.
+	ForwardSale(context.Context, *QueryForwardSaleRequest) (*QueryForwardSaleResponse, error)
+	// ForwardSalesByProject queries a paginated list of all forward sales of a
+	// project.
+	ForwardSalesByProject(context.Context, *QueryForwardSalesByProjectRequest) (*QueryForwardSalesByProjectResponse, error)
+	// ForwardContract queries a forward contract by its unique identifier.
+	ForwardContract(context.Context, *QueryForwardContractRequest) (*QueryForwardContractResponse, error)
+	// ForwardContractsBySale queries a paginated list of all forward contracts
+	// of a forward sale in delivery priority order.
+	ForwardContractsBySale(context.Context, *QueryForwardContractsBySaleRequest) (*QueryForwardContractsBySaleResponse, error)
+	// ForwardContractsByBuyer queries a paginated list of all forward contracts
+	// bought by a specific buyer.
+	ForwardContractsByBuyer(context.Context, *QueryForwardContractsByBuyerRequest) (*QueryForwardContractsByBuyerResponse, error)
+	// Markets queries a paginated list of all markets.
+	Markets(context.Context, *QueryMarketsRequest) (*QueryMarketsResponse, error)
+	// MarketRegistration queries whether market registration is permissionless
+	// and the deposit paid to register a market.
+	MarketRegistration(context.Context, *QueryMarketRegistrationRequest) (*QueryMarketRegistrationResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -126,9 +543,96 @@ func (UnimplementedQueryServer) SellOrdersByBatch(context.Context, *QuerySellOrd
 func (UnimplementedQueryServer) SellOrdersBySeller(context.Context, *QuerySellOrdersBySellerRequest) (*QuerySellOrdersBySellerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SellOrdersBySeller not implemented")
 }
+func (UnimplementedQueryServer) SellOrdersPendingExpiry(context.Context, *QuerySellOrdersPendingExpiryRequest) (*QuerySellOrdersPendingExpiryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SellOrdersPendingExpiry not implemented")
+}
+func (UnimplementedQueryServer) SellOrdersByClass(context.Context, *QuerySellOrdersByClassRequest) (*QuerySellOrdersByClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SellOrdersByClass not implemented")
+}
+func (UnimplementedQueryServer) SellOrdersByProject(context.Context, *QuerySellOrdersByProjectRequest) (*QuerySellOrdersByProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SellOrdersByProject not implemented")
+}
+func (UnimplementedQueryServer) SellOrdersByMarket(context.Context, *QuerySellOrdersByMarketRequest) (*QuerySellOrdersByMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SellOrdersByMarket not implemented")
+}
+func (UnimplementedQueryServer) BuyOrder(context.Context, *QueryBuyOrderRequest) (*QueryBuyOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyOrder not implemented")
+}
+func (UnimplementedQueryServer) BuyOrders(context.Context, *QueryBuyOrdersRequest) (*QueryBuyOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyOrders not implemented")
+}
+func (UnimplementedQueryServer) BuyOrdersByBuyer(context.Context, *QueryBuyOrdersByBuyerRequest) (*QueryBuyOrdersByBuyerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyOrdersByBuyer not implemented")
+}
 func (UnimplementedQueryServer) AllowedDenoms(context.Context, *QueryAllowedDenomsRequest) (*QueryAllowedDenomsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllowedDenoms not implemented")
 }
+func (UnimplementedQueryServer) MarketFees(context.Context, *QueryMarketFeesRequest) (*QueryMarketFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketFees not implemented")
+}
+func (UnimplementedQueryServer) TradesByBatch(context.Context, *QueryTradesByBatchRequest) (*QueryTradesByBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TradesByBatch not implemented")
+}
+func (UnimplementedQueryServer) TradesByMarket(context.Context, *QueryTradesByMarketRequest) (*QueryTradesByMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TradesByMarket not implemented")
+}
+func (UnimplementedQueryServer) TradesByAddress(context.Context, *QueryTradesByAddressRequest) (*QueryTradesByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TradesByAddress not implemented")
+}
+func (UnimplementedQueryServer) MarketStats(context.Context, *QueryMarketStatsRequest) (*QueryMarketStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketStats not implemented")
+}
+func (UnimplementedQueryServer) FeeDestination(context.Context, *QueryFeeDestinationRequest) (*QueryFeeDestinationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeDestination not implemented")
+}
+func (UnimplementedQueryServer) Offer(context.Context, *QueryOfferRequest) (*QueryOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Offer not implemented")
+}
+func (UnimplementedQueryServer) OffersByBatch(context.Context, *QueryOffersByBatchRequest) (*QueryOffersByBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OffersByBatch not implemented")
+}
+func (UnimplementedQueryServer) OffersByBuyer(context.Context, *QueryOffersByBuyerRequest) (*QueryOffersByBuyerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OffersByBuyer not implemented")
+}
+func (UnimplementedQueryServer) OffersByHolder(context.Context, *QueryOffersByHolderRequest) (*QueryOffersByHolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OffersByHolder not implemented")
+}
+func (UnimplementedQueryServer) DutchAuction(context.Context, *QueryDutchAuctionRequest) (*QueryDutchAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DutchAuction not implemented")
+}
+func (UnimplementedQueryServer) DutchAuctions(context.Context, *QueryDutchAuctionsRequest) (*QueryDutchAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DutchAuctions not implemented")
+}
+func (UnimplementedQueryServer) SealedBidAuction(context.Context, *QuerySealedBidAuctionRequest) (*QuerySealedBidAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SealedBidAuction not implemented")
+}
+func (UnimplementedQueryServer) SealedBidAuctions(context.Context, *QuerySealedBidAuctionsRequest) (*QuerySealedBidAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SealedBidAuctions not implemented")
+}
+func (UnimplementedQueryServer) SealedBids(context.Context, *QuerySealedBidsRequest) (*QuerySealedBidsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SealedBids not implemented")
+}
+func (UnimplementedQueryServer) ForwardSale(context.Context, *QueryForwardSaleRequest) (*QueryForwardSaleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForwardSale not implemented")
+}
+func (UnimplementedQueryServer) ForwardSalesByProject(context.Context, *QueryForwardSalesByProjectRequest) (*QueryForwardSalesByProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForwardSalesByProject not implemented")
+}
+func (UnimplementedQueryServer) ForwardContract(context.Context, *QueryForwardContractRequest) (*QueryForwardContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForwardContract not implemented")
+}
+func (UnimplementedQueryServer) ForwardContractsBySale(context.Context, *QueryForwardContractsBySaleRequest) (*QueryForwardContractsBySaleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForwardContractsBySale not implemented")
+}
+func (UnimplementedQueryServer) ForwardContractsByBuyer(context.Context, *QueryForwardContractsByBuyerRequest) (*QueryForwardContractsByBuyerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForwardContractsByBuyer not implemented")
+}
+func (UnimplementedQueryServer) Markets(context.Context, *QueryMarketsRequest) (*QueryMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Markets not implemented")
+}
+func (UnimplementedQueryServer) MarketRegistration(context.Context, *QueryMarketRegistrationRequest) (*QueryMarketRegistrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketRegistration not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -214,50 +718,688 @@ func _Query_SellOrdersBySeller_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_AllowedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllowedDenomsRequest)
+func _Query_SellOrdersPendingExpiry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySellOrdersPendingExpiryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).AllowedDenoms(ctx, in)
+		return srv.(QueryServer).SellOrdersPendingExpiry(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.marketplace.v1.Query/AllowedDenoms",
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/SellOrdersPendingExpiry",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllowedDenoms(ctx, req.(*QueryAllowedDenomsRequest))
+		return srv.(QueryServer).SellOrdersPendingExpiry(ctx, req.(*QuerySellOrdersPendingExpiryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Query_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "regen.ecocredit.marketplace.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SellOrder",
-			Handler:    _Query_SellOrder_Handler,
-		},
-		{
-			MethodName: "SellOrders",
-			Handler:    _Query_SellOrders_Handler,
-		},
-		{
-			MethodName: "SellOrdersByBatch",
-			Handler:    _Query_SellOrdersByBatch_Handler,
-		},
-		{
-			MethodName: "SellOrdersBySeller",
-			Handler:    _Query_SellOrdersBySeller_Handler,
-		},
-		{
-			MethodName: "AllowedDenoms",
-			Handler:    _Query_AllowedDenoms_Handler,
+func _Query_SellOrdersByClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySellOrdersByClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SellOrdersByClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/SellOrdersByClass",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SellOrdersByClass(ctx, req.(*QuerySellOrdersByClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SellOrdersByProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySellOrdersByProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SellOrdersByProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/SellOrdersByProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SellOrdersByProject(ctx, req.(*QuerySellOrdersByProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SellOrdersByMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySellOrdersByMarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SellOrdersByMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/SellOrdersByMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SellOrdersByMarket(ctx, req.(*QuerySellOrdersByMarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BuyOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBuyOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BuyOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/BuyOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BuyOrder(ctx, req.(*QueryBuyOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BuyOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBuyOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BuyOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/BuyOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BuyOrders(ctx, req.(*QueryBuyOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BuyOrdersByBuyer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBuyOrdersByBuyerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BuyOrdersByBuyer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/BuyOrdersByBuyer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BuyOrdersByBuyer(ctx, req.(*QueryBuyOrdersByBuyerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowedDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/AllowedDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedDenoms(ctx, req.(*QueryAllowedDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarketFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/MarketFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketFees(ctx, req.(*QueryMarketFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TradesByBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTradesByBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TradesByBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/TradesByBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TradesByBatch(ctx, req.(*QueryTradesByBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TradesByMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTradesByMarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TradesByMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/TradesByMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TradesByMarket(ctx, req.(*QueryTradesByMarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TradesByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTradesByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TradesByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/TradesByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TradesByAddress(ctx, req.(*QueryTradesByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarketStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/MarketStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketStats(ctx, req.(*QueryMarketStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeDestination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeDestinationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeDestination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/FeeDestination",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeDestination(ctx, req.(*QueryFeeDestinationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Offer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Offer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/Offer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Offer(ctx, req.(*QueryOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OffersByBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOffersByBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OffersByBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/OffersByBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OffersByBatch(ctx, req.(*QueryOffersByBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OffersByBuyer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOffersByBuyerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OffersByBuyer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/OffersByBuyer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OffersByBuyer(ctx, req.(*QueryOffersByBuyerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OffersByHolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOffersByHolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OffersByHolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/OffersByHolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OffersByHolder(ctx, req.(*QueryOffersByHolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DutchAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDutchAuctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DutchAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/DutchAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DutchAuction(ctx, req.(*QueryDutchAuctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DutchAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDutchAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DutchAuctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/DutchAuctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DutchAuctions(ctx, req.(*QueryDutchAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SealedBidAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySealedBidAuctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SealedBidAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/SealedBidAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SealedBidAuction(ctx, req.(*QuerySealedBidAuctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SealedBidAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySealedBidAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SealedBidAuctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/SealedBidAuctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SealedBidAuctions(ctx, req.(*QuerySealedBidAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SealedBids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySealedBidsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SealedBids(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/SealedBids",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SealedBids(ctx, req.(*QuerySealedBidsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ForwardSale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryForwardSaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ForwardSale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/ForwardSale",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ForwardSale(ctx, req.(*QueryForwardSaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ForwardSalesByProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryForwardSalesByProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ForwardSalesByProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/ForwardSalesByProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ForwardSalesByProject(ctx, req.(*QueryForwardSalesByProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ForwardContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryForwardContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ForwardContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/ForwardContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ForwardContract(ctx, req.(*QueryForwardContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ForwardContractsBySale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryForwardContractsBySaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ForwardContractsBySale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/ForwardContractsBySale",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ForwardContractsBySale(ctx, req.(*QueryForwardContractsBySaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ForwardContractsByBuyer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryForwardContractsByBuyerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ForwardContractsByBuyer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/ForwardContractsByBuyer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ForwardContractsByBuyer(ctx, req.(*QueryForwardContractsByBuyerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Markets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Markets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/Markets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Markets(ctx, req.(*QueryMarketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarketRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/MarketRegistration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketRegistration(ctx, req.(*QueryMarketRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "regen.ecocredit.marketplace.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SellOrder",
+			Handler:    _Query_SellOrder_Handler,
+		},
+		{
+			MethodName: "SellOrders",
+			Handler:    _Query_SellOrders_Handler,
+		},
+		{
+			MethodName: "SellOrdersByBatch",
+			Handler:    _Query_SellOrdersByBatch_Handler,
+		},
+		{
+			MethodName: "SellOrdersBySeller",
+			Handler:    _Query_SellOrdersBySeller_Handler,
+		},
+		{
+			MethodName: "SellOrdersPendingExpiry",
+			Handler:    _Query_SellOrdersPendingExpiry_Handler,
+		},
+		{
+			MethodName: "SellOrdersByClass",
+			Handler:    _Query_SellOrdersByClass_Handler,
+		},
+		{
+			MethodName: "SellOrdersByProject",
+			Handler:    _Query_SellOrdersByProject_Handler,
+		},
+		{
+			MethodName: "SellOrdersByMarket",
+			Handler:    _Query_SellOrdersByMarket_Handler,
+		},
+		{
+			MethodName: "BuyOrder",
+			Handler:    _Query_BuyOrder_Handler,
+		},
+		{
+			MethodName: "BuyOrders",
+			Handler:    _Query_BuyOrders_Handler,
+		},
+		{
+			MethodName: "BuyOrdersByBuyer",
+			Handler:    _Query_BuyOrdersByBuyer_Handler,
+		},
+		{
+			MethodName: "AllowedDenoms",
+			Handler:    _Query_AllowedDenoms_Handler,
+		},
+		{
+			MethodName: "MarketFees",
+			Handler:    _Query_MarketFees_Handler,
+		},
+		{
+			MethodName: "TradesByBatch",
+			Handler:    _Query_TradesByBatch_Handler,
+		},
+		{
+			MethodName: "TradesByMarket",
+			Handler:    _Query_TradesByMarket_Handler,
+		},
+		{
+			MethodName: "TradesByAddress",
+			Handler:    _Query_TradesByAddress_Handler,
+		},
+		{
+			MethodName: "MarketStats",
+			Handler:    _Query_MarketStats_Handler,
+		},
+		{
+			MethodName: "FeeDestination",
+			Handler:    _Query_FeeDestination_Handler,
+		},
+		{
+			MethodName: "Offer",
+			Handler:    _Query_Offer_Handler,
+		},
+		{
+			MethodName: "OffersByBatch",
+			Handler:    _Query_OffersByBatch_Handler,
+		},
+		{
+			MethodName: "OffersByBuyer",
+			Handler:    _Query_OffersByBuyer_Handler,
+		},
+		{
+			MethodName: "OffersByHolder",
+			Handler:    _Query_OffersByHolder_Handler,
+		},
+		{
+			MethodName: "DutchAuction",
+			Handler:    _Query_DutchAuction_Handler,
+		},
+		{
+			MethodName: "DutchAuctions",
+			Handler:    _Query_DutchAuctions_Handler,
+		},
+		{
+			MethodName: "SealedBidAuction",
+			Handler:    _Query_SealedBidAuction_Handler,
+		},
+		{
+			MethodName: "SealedBidAuctions",
+			Handler:    _Query_SealedBidAuctions_Handler,
+		},
+		{
+			MethodName: "SealedBids",
+			Handler:    _Query_SealedBids_Handler,
+		},
+		{
+			MethodName: "ForwardSale",
+			Handler:    _Query_ForwardSale_Handler,
+		},
+		{
+			MethodName: "ForwardSalesByProject",
+			Handler:    _Query_ForwardSalesByProject_Handler,
+		},
+		{
+			MethodName: "ForwardContract",
+			Handler:    _Query_ForwardContract_Handler,
+		},
+		{
+			MethodName: "ForwardContractsBySale",
+			Handler:    _Query_ForwardContractsBySale_Handler,
+		},
+		{
+			MethodName: "ForwardContractsByBuyer",
+			Handler:    _Query_ForwardContractsByBuyer_Handler,
+		},
+		{
+			MethodName: "Markets",
+			Handler:    _Query_Markets_Handler,
+		},
+		{
+			MethodName: "MarketRegistration",
+			Handler:    _Query_MarketRegistration_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
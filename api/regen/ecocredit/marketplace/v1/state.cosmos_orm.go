@@ -98,6 +98,60 @@ func (this SellOrderExpirationIndexKey) WithExpiration(expiration *timestamppb.T
 	return this
 }
 
+type SellOrderClassKeyBatchStartDateIndexKey struct {
+	vs []interface{}
+}
+
+func (x SellOrderClassKeyBatchStartDateIndexKey) id() uint32            { return 4 }
+func (x SellOrderClassKeyBatchStartDateIndexKey) values() []interface{} { return x.vs }
+func (x SellOrderClassKeyBatchStartDateIndexKey) sellOrderIndexKey()    {}
+
+func (this SellOrderClassKeyBatchStartDateIndexKey) WithClassKey(class_key uint64) SellOrderClassKeyBatchStartDateIndexKey {
+	this.vs = []interface{}{class_key}
+	return this
+}
+
+func (this SellOrderClassKeyBatchStartDateIndexKey) WithClassKeyBatchStartDate(class_key uint64, batch_start_date *timestamppb.Timestamp) SellOrderClassKeyBatchStartDateIndexKey {
+	this.vs = []interface{}{class_key, batch_start_date}
+	return this
+}
+
+type SellOrderProjectKeyBatchStartDateIndexKey struct {
+	vs []interface{}
+}
+
+func (x SellOrderProjectKeyBatchStartDateIndexKey) id() uint32            { return 5 }
+func (x SellOrderProjectKeyBatchStartDateIndexKey) values() []interface{} { return x.vs }
+func (x SellOrderProjectKeyBatchStartDateIndexKey) sellOrderIndexKey()    {}
+
+func (this SellOrderProjectKeyBatchStartDateIndexKey) WithProjectKey(project_key uint64) SellOrderProjectKeyBatchStartDateIndexKey {
+	this.vs = []interface{}{project_key}
+	return this
+}
+
+func (this SellOrderProjectKeyBatchStartDateIndexKey) WithProjectKeyBatchStartDate(project_key uint64, batch_start_date *timestamppb.Timestamp) SellOrderProjectKeyBatchStartDateIndexKey {
+	this.vs = []interface{}{project_key, batch_start_date}
+	return this
+}
+
+type SellOrderMarketIdAskAmountKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x SellOrderMarketIdAskAmountKeyIndexKey) id() uint32            { return 6 }
+func (x SellOrderMarketIdAskAmountKeyIndexKey) values() []interface{} { return x.vs }
+func (x SellOrderMarketIdAskAmountKeyIndexKey) sellOrderIndexKey()    {}
+
+func (this SellOrderMarketIdAskAmountKeyIndexKey) WithMarketId(market_id uint64) SellOrderMarketIdAskAmountKeyIndexKey {
+	this.vs = []interface{}{market_id}
+	return this
+}
+
+func (this SellOrderMarketIdAskAmountKeyIndexKey) WithMarketIdAskAmountKey(market_id uint64, ask_amount_key []byte) SellOrderMarketIdAskAmountKeyIndexKey {
+	this.vs = []interface{}{market_id, ask_amount_key}
+	return this
+}
+
 type sellOrderTable struct {
 	table ormtable.AutoIncrementTable
 }
@@ -168,6 +222,164 @@ func NewSellOrderTable(db ormtable.Schema) (SellOrderTable, error) {
 	return sellOrderTable{table.(ormtable.AutoIncrementTable)}, nil
 }
 
+type BuyOrderTable interface {
+	Insert(ctx context.Context, buyOrder *BuyOrder) error
+	InsertReturningID(ctx context.Context, buyOrder *BuyOrder) (uint64, error)
+	Update(ctx context.Context, buyOrder *BuyOrder) error
+	Save(ctx context.Context, buyOrder *BuyOrder) error
+	Delete(ctx context.Context, buyOrder *BuyOrder) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*BuyOrder, error)
+	List(ctx context.Context, prefixKey BuyOrderIndexKey, opts ...ormlist.Option) (BuyOrderIterator, error)
+	ListRange(ctx context.Context, from, to BuyOrderIndexKey, opts ...ormlist.Option) (BuyOrderIterator, error)
+	DeleteBy(ctx context.Context, prefixKey BuyOrderIndexKey) error
+	DeleteRange(ctx context.Context, from, to BuyOrderIndexKey) error
+
+	doNotImplement()
+}
+
+type BuyOrderIterator struct {
+	ormtable.Iterator
+}
+
+func (i BuyOrderIterator) Value() (*BuyOrder, error) {
+	var buyOrder BuyOrder
+	err := i.UnmarshalMessage(&buyOrder)
+	return &buyOrder, err
+}
+
+type BuyOrderIndexKey interface {
+	id() uint32
+	values() []interface{}
+	buyOrderIndexKey()
+}
+
+// primary key starting index..
+type BuyOrderPrimaryKey = BuyOrderIdIndexKey
+
+type BuyOrderIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x BuyOrderIdIndexKey) id() uint32            { return 0 }
+func (x BuyOrderIdIndexKey) values() []interface{} { return x.vs }
+func (x BuyOrderIdIndexKey) buyOrderIndexKey()     {}
+
+func (this BuyOrderIdIndexKey) WithId(id uint64) BuyOrderIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type BuyOrderBuyerIndexKey struct {
+	vs []interface{}
+}
+
+func (x BuyOrderBuyerIndexKey) id() uint32            { return 1 }
+func (x BuyOrderBuyerIndexKey) values() []interface{} { return x.vs }
+func (x BuyOrderBuyerIndexKey) buyOrderIndexKey()     {}
+
+func (this BuyOrderBuyerIndexKey) WithBuyer(buyer []byte) BuyOrderBuyerIndexKey {
+	this.vs = []interface{}{buyer}
+	return this
+}
+
+type BuyOrderExpirationIndexKey struct {
+	vs []interface{}
+}
+
+func (x BuyOrderExpirationIndexKey) id() uint32            { return 2 }
+func (x BuyOrderExpirationIndexKey) values() []interface{} { return x.vs }
+func (x BuyOrderExpirationIndexKey) buyOrderIndexKey()     {}
+
+func (this BuyOrderExpirationIndexKey) WithExpiration(expiration *timestamppb.Timestamp) BuyOrderExpirationIndexKey {
+	this.vs = []interface{}{expiration}
+	return this
+}
+
+type BuyOrderMarketIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x BuyOrderMarketIdIndexKey) id() uint32            { return 3 }
+func (x BuyOrderMarketIdIndexKey) values() []interface{} { return x.vs }
+func (x BuyOrderMarketIdIndexKey) buyOrderIndexKey()     {}
+
+func (this BuyOrderMarketIdIndexKey) WithMarketId(market_id uint64) BuyOrderMarketIdIndexKey {
+	this.vs = []interface{}{market_id}
+	return this
+}
+
+type buyOrderTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this buyOrderTable) Insert(ctx context.Context, buyOrder *BuyOrder) error {
+	return this.table.Insert(ctx, buyOrder)
+}
+
+func (this buyOrderTable) Update(ctx context.Context, buyOrder *BuyOrder) error {
+	return this.table.Update(ctx, buyOrder)
+}
+
+func (this buyOrderTable) Save(ctx context.Context, buyOrder *BuyOrder) error {
+	return this.table.Save(ctx, buyOrder)
+}
+
+func (this buyOrderTable) Delete(ctx context.Context, buyOrder *BuyOrder) error {
+	return this.table.Delete(ctx, buyOrder)
+}
+
+func (this buyOrderTable) InsertReturningID(ctx context.Context, buyOrder *BuyOrder) (uint64, error) {
+	return this.table.InsertReturningID(ctx, buyOrder)
+}
+
+func (this buyOrderTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this buyOrderTable) Get(ctx context.Context, id uint64) (*BuyOrder, error) {
+	var buyOrder BuyOrder
+	found, err := this.table.PrimaryKey().Get(ctx, &buyOrder, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &buyOrder, nil
+}
+
+func (this buyOrderTable) List(ctx context.Context, prefixKey BuyOrderIndexKey, opts ...ormlist.Option) (BuyOrderIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return BuyOrderIterator{it}, err
+}
+
+func (this buyOrderTable) ListRange(ctx context.Context, from, to BuyOrderIndexKey, opts ...ormlist.Option) (BuyOrderIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return BuyOrderIterator{it}, err
+}
+
+func (this buyOrderTable) DeleteBy(ctx context.Context, prefixKey BuyOrderIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this buyOrderTable) DeleteRange(ctx context.Context, from, to BuyOrderIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this buyOrderTable) doNotImplement() {}
+
+var _ BuyOrderTable = buyOrderTable{}
+
+func NewBuyOrderTable(db ormtable.Schema) (BuyOrderTable, error) {
+	table := db.GetTable(&BuyOrder{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&BuyOrder{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return buyOrderTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
 type AllowedDenomTable interface {
 	Insert(ctx context.Context, allowedDenom *AllowedDenom) error
 	Update(ctx context.Context, allowedDenom *AllowedDenom) error
@@ -480,55 +692,1816 @@ func NewMarketTable(db ormtable.Schema) (MarketTable, error) {
 	return marketTable{table.(ormtable.AutoIncrementTable)}, nil
 }
 
-type StateStore interface {
-	SellOrderTable() SellOrderTable
-	AllowedDenomTable() AllowedDenomTable
-	MarketTable() MarketTable
+type MarketFeeTable interface {
+	Insert(ctx context.Context, marketFee *MarketFee) error
+	Update(ctx context.Context, marketFee *MarketFee) error
+	Save(ctx context.Context, marketFee *MarketFee) error
+	Delete(ctx context.Context, marketFee *MarketFee) error
+	Has(ctx context.Context, market_id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, market_id uint64) (*MarketFee, error)
+	List(ctx context.Context, prefixKey MarketFeeIndexKey, opts ...ormlist.Option) (MarketFeeIterator, error)
+	ListRange(ctx context.Context, from, to MarketFeeIndexKey, opts ...ormlist.Option) (MarketFeeIterator, error)
+	DeleteBy(ctx context.Context, prefixKey MarketFeeIndexKey) error
+	DeleteRange(ctx context.Context, from, to MarketFeeIndexKey) error
 
 	doNotImplement()
 }
 
-type stateStore struct {
-	sellOrder    SellOrderTable
-	allowedDenom AllowedDenomTable
-	market       MarketTable
+type MarketFeeIterator struct {
+	ormtable.Iterator
 }
 
-func (x stateStore) SellOrderTable() SellOrderTable {
-	return x.sellOrder
+func (i MarketFeeIterator) Value() (*MarketFee, error) {
+	var marketFee MarketFee
+	err := i.UnmarshalMessage(&marketFee)
+	return &marketFee, err
 }
 
-func (x stateStore) AllowedDenomTable() AllowedDenomTable {
-	return x.allowedDenom
+type MarketFeeIndexKey interface {
+	id() uint32
+	values() []interface{}
+	marketFeeIndexKey()
 }
 
-func (x stateStore) MarketTable() MarketTable {
-	return x.market
+// primary key starting index..
+type MarketFeePrimaryKey = MarketFeeMarketIdIndexKey
+
+type MarketFeeMarketIdIndexKey struct {
+	vs []interface{}
 }
 
-func (stateStore) doNotImplement() {}
+func (x MarketFeeMarketIdIndexKey) id() uint32            { return 0 }
+func (x MarketFeeMarketIdIndexKey) values() []interface{} { return x.vs }
+func (x MarketFeeMarketIdIndexKey) marketFeeIndexKey()    {}
 
-var _ StateStore = stateStore{}
+func (this MarketFeeMarketIdIndexKey) WithMarketId(market_id uint64) MarketFeeMarketIdIndexKey {
+	this.vs = []interface{}{market_id}
+	return this
+}
 
-func NewStateStore(db ormtable.Schema) (StateStore, error) {
-	sellOrderTable, err := NewSellOrderTable(db)
+type marketFeeTable struct {
+	table ormtable.Table
+}
+
+func (this marketFeeTable) Insert(ctx context.Context, marketFee *MarketFee) error {
+	return this.table.Insert(ctx, marketFee)
+}
+
+func (this marketFeeTable) Update(ctx context.Context, marketFee *MarketFee) error {
+	return this.table.Update(ctx, marketFee)
+}
+
+func (this marketFeeTable) Save(ctx context.Context, marketFee *MarketFee) error {
+	return this.table.Save(ctx, marketFee)
+}
+
+func (this marketFeeTable) Delete(ctx context.Context, marketFee *MarketFee) error {
+	return this.table.Delete(ctx, marketFee)
+}
+
+func (this marketFeeTable) Has(ctx context.Context, market_id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, market_id)
+}
+
+func (this marketFeeTable) Get(ctx context.Context, market_id uint64) (*MarketFee, error) {
+	var marketFee MarketFee
+	found, err := this.table.PrimaryKey().Get(ctx, &marketFee, market_id)
 	if err != nil {
 		return nil, err
 	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &marketFee, nil
+}
+
+func (this marketFeeTable) List(ctx context.Context, prefixKey MarketFeeIndexKey, opts ...ormlist.Option) (MarketFeeIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return MarketFeeIterator{it}, err
+}
+
+func (this marketFeeTable) ListRange(ctx context.Context, from, to MarketFeeIndexKey, opts ...ormlist.Option) (MarketFeeIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return MarketFeeIterator{it}, err
+}
+
+func (this marketFeeTable) DeleteBy(ctx context.Context, prefixKey MarketFeeIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this marketFeeTable) DeleteRange(ctx context.Context, from, to MarketFeeIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this marketFeeTable) doNotImplement() {}
+
+var _ MarketFeeTable = marketFeeTable{}
+
+func NewMarketFeeTable(db ormtable.Schema) (MarketFeeTable, error) {
+	table := db.GetTable(&MarketFee{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&MarketFee{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return marketFeeTable{table}, nil
+}
 
-	allowedDenomTable, err := NewAllowedDenomTable(db)
+type CreditTypeFeeTable interface {
+	Insert(ctx context.Context, creditTypeFee *CreditTypeFee) error
+	Update(ctx context.Context, creditTypeFee *CreditTypeFee) error
+	Save(ctx context.Context, creditTypeFee *CreditTypeFee) error
+	Delete(ctx context.Context, creditTypeFee *CreditTypeFee) error
+	Has(ctx context.Context, credit_type_abbrev string) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, credit_type_abbrev string) (*CreditTypeFee, error)
+	List(ctx context.Context, prefixKey CreditTypeFeeIndexKey, opts ...ormlist.Option) (CreditTypeFeeIterator, error)
+	ListRange(ctx context.Context, from, to CreditTypeFeeIndexKey, opts ...ormlist.Option) (CreditTypeFeeIterator, error)
+	DeleteBy(ctx context.Context, prefixKey CreditTypeFeeIndexKey) error
+	DeleteRange(ctx context.Context, from, to CreditTypeFeeIndexKey) error
+
+	doNotImplement()
+}
+
+type CreditTypeFeeIterator struct {
+	ormtable.Iterator
+}
+
+func (i CreditTypeFeeIterator) Value() (*CreditTypeFee, error) {
+	var creditTypeFee CreditTypeFee
+	err := i.UnmarshalMessage(&creditTypeFee)
+	return &creditTypeFee, err
+}
+
+type CreditTypeFeeIndexKey interface {
+	id() uint32
+	values() []interface{}
+	creditTypeFeeIndexKey()
+}
+
+// primary key starting index..
+type CreditTypeFeePrimaryKey = CreditTypeFeeCreditTypeAbbrevIndexKey
+
+type CreditTypeFeeCreditTypeAbbrevIndexKey struct {
+	vs []interface{}
+}
+
+func (x CreditTypeFeeCreditTypeAbbrevIndexKey) id() uint32             { return 0 }
+func (x CreditTypeFeeCreditTypeAbbrevIndexKey) values() []interface{}  { return x.vs }
+func (x CreditTypeFeeCreditTypeAbbrevIndexKey) creditTypeFeeIndexKey() {}
+
+func (this CreditTypeFeeCreditTypeAbbrevIndexKey) WithCreditTypeAbbrev(credit_type_abbrev string) CreditTypeFeeCreditTypeAbbrevIndexKey {
+	this.vs = []interface{}{credit_type_abbrev}
+	return this
+}
+
+type creditTypeFeeTable struct {
+	table ormtable.Table
+}
+
+func (this creditTypeFeeTable) Insert(ctx context.Context, creditTypeFee *CreditTypeFee) error {
+	return this.table.Insert(ctx, creditTypeFee)
+}
+
+func (this creditTypeFeeTable) Update(ctx context.Context, creditTypeFee *CreditTypeFee) error {
+	return this.table.Update(ctx, creditTypeFee)
+}
+
+func (this creditTypeFeeTable) Save(ctx context.Context, creditTypeFee *CreditTypeFee) error {
+	return this.table.Save(ctx, creditTypeFee)
+}
+
+func (this creditTypeFeeTable) Delete(ctx context.Context, creditTypeFee *CreditTypeFee) error {
+	return this.table.Delete(ctx, creditTypeFee)
+}
+
+func (this creditTypeFeeTable) Has(ctx context.Context, credit_type_abbrev string) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, credit_type_abbrev)
+}
+
+func (this creditTypeFeeTable) Get(ctx context.Context, credit_type_abbrev string) (*CreditTypeFee, error) {
+	var creditTypeFee CreditTypeFee
+	found, err := this.table.PrimaryKey().Get(ctx, &creditTypeFee, credit_type_abbrev)
 	if err != nil {
 		return nil, err
 	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &creditTypeFee, nil
+}
 
-	marketTable, err := NewMarketTable(db)
+func (this creditTypeFeeTable) List(ctx context.Context, prefixKey CreditTypeFeeIndexKey, opts ...ormlist.Option) (CreditTypeFeeIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return CreditTypeFeeIterator{it}, err
+}
+
+func (this creditTypeFeeTable) ListRange(ctx context.Context, from, to CreditTypeFeeIndexKey, opts ...ormlist.Option) (CreditTypeFeeIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return CreditTypeFeeIterator{it}, err
+}
+
+func (this creditTypeFeeTable) DeleteBy(ctx context.Context, prefixKey CreditTypeFeeIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this creditTypeFeeTable) DeleteRange(ctx context.Context, from, to CreditTypeFeeIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this creditTypeFeeTable) doNotImplement() {}
+
+var _ CreditTypeFeeTable = creditTypeFeeTable{}
+
+func NewCreditTypeFeeTable(db ormtable.Schema) (CreditTypeFeeTable, error) {
+	table := db.GetTable(&CreditTypeFee{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&CreditTypeFee{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return creditTypeFeeTable{table}, nil
+}
+
+// singleton store
+type FeeDestinationTable interface {
+	Get(ctx context.Context) (*FeeDestination, error)
+	Save(ctx context.Context, feeDestination *FeeDestination) error
+}
+
+type feeDestinationTable struct {
+	table ormtable.Table
+}
+
+var _ FeeDestinationTable = feeDestinationTable{}
+
+func (x feeDestinationTable) Get(ctx context.Context) (*FeeDestination, error) {
+	feeDestination := &FeeDestination{}
+	_, err := x.table.Get(ctx, feeDestination)
+	return feeDestination, err
+}
+
+func (x feeDestinationTable) Save(ctx context.Context, feeDestination *FeeDestination) error {
+	return x.table.Save(ctx, feeDestination)
+}
+
+func NewFeeDestinationTable(db ormtable.Schema) (FeeDestinationTable, error) {
+	table := db.GetTable(&FeeDestination{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&FeeDestination{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return &feeDestinationTable{table}, nil
+}
+
+type MarketFeeTotalTable interface {
+	Insert(ctx context.Context, marketFeeTotal *MarketFeeTotal) error
+	Update(ctx context.Context, marketFeeTotal *MarketFeeTotal) error
+	Save(ctx context.Context, marketFeeTotal *MarketFeeTotal) error
+	Delete(ctx context.Context, marketFeeTotal *MarketFeeTotal) error
+	Has(ctx context.Context, market_id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, market_id uint64) (*MarketFeeTotal, error)
+	List(ctx context.Context, prefixKey MarketFeeTotalIndexKey, opts ...ormlist.Option) (MarketFeeTotalIterator, error)
+	ListRange(ctx context.Context, from, to MarketFeeTotalIndexKey, opts ...ormlist.Option) (MarketFeeTotalIterator, error)
+	DeleteBy(ctx context.Context, prefixKey MarketFeeTotalIndexKey) error
+	DeleteRange(ctx context.Context, from, to MarketFeeTotalIndexKey) error
+
+	doNotImplement()
+}
+
+type MarketFeeTotalIterator struct {
+	ormtable.Iterator
+}
+
+func (i MarketFeeTotalIterator) Value() (*MarketFeeTotal, error) {
+	var marketFeeTotal MarketFeeTotal
+	err := i.UnmarshalMessage(&marketFeeTotal)
+	return &marketFeeTotal, err
+}
+
+type MarketFeeTotalIndexKey interface {
+	id() uint32
+	values() []interface{}
+	marketFeeTotalIndexKey()
+}
+
+// primary key starting index..
+type MarketFeeTotalPrimaryKey = MarketFeeTotalMarketIdIndexKey
+
+type MarketFeeTotalMarketIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x MarketFeeTotalMarketIdIndexKey) id() uint32              { return 0 }
+func (x MarketFeeTotalMarketIdIndexKey) values() []interface{}   { return x.vs }
+func (x MarketFeeTotalMarketIdIndexKey) marketFeeTotalIndexKey() {}
+
+func (this MarketFeeTotalMarketIdIndexKey) WithMarketId(market_id uint64) MarketFeeTotalMarketIdIndexKey {
+	this.vs = []interface{}{market_id}
+	return this
+}
+
+type marketFeeTotalTable struct {
+	table ormtable.Table
+}
+
+func (this marketFeeTotalTable) Insert(ctx context.Context, marketFeeTotal *MarketFeeTotal) error {
+	return this.table.Insert(ctx, marketFeeTotal)
+}
+
+func (this marketFeeTotalTable) Update(ctx context.Context, marketFeeTotal *MarketFeeTotal) error {
+	return this.table.Update(ctx, marketFeeTotal)
+}
+
+func (this marketFeeTotalTable) Save(ctx context.Context, marketFeeTotal *MarketFeeTotal) error {
+	return this.table.Save(ctx, marketFeeTotal)
+}
+
+func (this marketFeeTotalTable) Delete(ctx context.Context, marketFeeTotal *MarketFeeTotal) error {
+	return this.table.Delete(ctx, marketFeeTotal)
+}
+
+func (this marketFeeTotalTable) Has(ctx context.Context, market_id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, market_id)
+}
+
+func (this marketFeeTotalTable) Get(ctx context.Context, market_id uint64) (*MarketFeeTotal, error) {
+	var marketFeeTotal MarketFeeTotal
+	found, err := this.table.PrimaryKey().Get(ctx, &marketFeeTotal, market_id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &marketFeeTotal, nil
+}
+
+func (this marketFeeTotalTable) List(ctx context.Context, prefixKey MarketFeeTotalIndexKey, opts ...ormlist.Option) (MarketFeeTotalIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return MarketFeeTotalIterator{it}, err
+}
+
+func (this marketFeeTotalTable) ListRange(ctx context.Context, from, to MarketFeeTotalIndexKey, opts ...ormlist.Option) (MarketFeeTotalIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return MarketFeeTotalIterator{it}, err
+}
+
+func (this marketFeeTotalTable) DeleteBy(ctx context.Context, prefixKey MarketFeeTotalIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this marketFeeTotalTable) DeleteRange(ctx context.Context, from, to MarketFeeTotalIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this marketFeeTotalTable) doNotImplement() {}
+
+var _ MarketFeeTotalTable = marketFeeTotalTable{}
+
+func NewMarketFeeTotalTable(db ormtable.Schema) (MarketFeeTotalTable, error) {
+	table := db.GetTable(&MarketFeeTotal{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&MarketFeeTotal{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return marketFeeTotalTable{table}, nil
+}
+
+type TradeTable interface {
+	Insert(ctx context.Context, trade *Trade) error
+	InsertReturningID(ctx context.Context, trade *Trade) (uint64, error)
+	Update(ctx context.Context, trade *Trade) error
+	Save(ctx context.Context, trade *Trade) error
+	Delete(ctx context.Context, trade *Trade) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*Trade, error)
+	List(ctx context.Context, prefixKey TradeIndexKey, opts ...ormlist.Option) (TradeIterator, error)
+	ListRange(ctx context.Context, from, to TradeIndexKey, opts ...ormlist.Option) (TradeIterator, error)
+	DeleteBy(ctx context.Context, prefixKey TradeIndexKey) error
+	DeleteRange(ctx context.Context, from, to TradeIndexKey) error
+
+	doNotImplement()
+}
+
+type TradeIterator struct {
+	ormtable.Iterator
+}
+
+func (i TradeIterator) Value() (*Trade, error) {
+	var trade Trade
+	err := i.UnmarshalMessage(&trade)
+	return &trade, err
+}
+
+type TradeIndexKey interface {
+	id() uint32
+	values() []interface{}
+	tradeIndexKey()
+}
+
+// primary key starting index..
+type TradePrimaryKey = TradeIdIndexKey
+
+type TradeIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x TradeIdIndexKey) id() uint32            { return 0 }
+func (x TradeIdIndexKey) values() []interface{} { return x.vs }
+func (x TradeIdIndexKey) tradeIndexKey()        {}
+
+func (this TradeIdIndexKey) WithId(id uint64) TradeIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type TradeBatchKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x TradeBatchKeyIndexKey) id() uint32            { return 1 }
+func (x TradeBatchKeyIndexKey) values() []interface{} { return x.vs }
+func (x TradeBatchKeyIndexKey) tradeIndexKey()        {}
+
+func (this TradeBatchKeyIndexKey) WithBatchKey(batch_key uint64) TradeBatchKeyIndexKey {
+	this.vs = []interface{}{batch_key}
+	return this
+}
+
+type TradeMarketIdTimestampIndexKey struct {
+	vs []interface{}
+}
+
+func (x TradeMarketIdTimestampIndexKey) id() uint32            { return 2 }
+func (x TradeMarketIdTimestampIndexKey) values() []interface{} { return x.vs }
+func (x TradeMarketIdTimestampIndexKey) tradeIndexKey()        {}
+
+func (this TradeMarketIdTimestampIndexKey) WithMarketId(market_id uint64) TradeMarketIdTimestampIndexKey {
+	this.vs = []interface{}{market_id}
+	return this
+}
+
+func (this TradeMarketIdTimestampIndexKey) WithMarketIdTimestamp(market_id uint64, timestamp *timestamppb.Timestamp) TradeMarketIdTimestampIndexKey {
+	this.vs = []interface{}{market_id, timestamp}
+	return this
+}
+
+type tradeTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this tradeTable) Insert(ctx context.Context, trade *Trade) error {
+	return this.table.Insert(ctx, trade)
+}
+
+func (this tradeTable) Update(ctx context.Context, trade *Trade) error {
+	return this.table.Update(ctx, trade)
+}
+
+func (this tradeTable) Save(ctx context.Context, trade *Trade) error {
+	return this.table.Save(ctx, trade)
+}
+
+func (this tradeTable) Delete(ctx context.Context, trade *Trade) error {
+	return this.table.Delete(ctx, trade)
+}
+
+func (this tradeTable) InsertReturningID(ctx context.Context, trade *Trade) (uint64, error) {
+	return this.table.InsertReturningID(ctx, trade)
+}
+
+func (this tradeTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this tradeTable) Get(ctx context.Context, id uint64) (*Trade, error) {
+	var trade Trade
+	found, err := this.table.PrimaryKey().Get(ctx, &trade, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &trade, nil
+}
+
+func (this tradeTable) List(ctx context.Context, prefixKey TradeIndexKey, opts ...ormlist.Option) (TradeIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return TradeIterator{it}, err
+}
+
+func (this tradeTable) ListRange(ctx context.Context, from, to TradeIndexKey, opts ...ormlist.Option) (TradeIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return TradeIterator{it}, err
+}
+
+func (this tradeTable) DeleteBy(ctx context.Context, prefixKey TradeIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this tradeTable) DeleteRange(ctx context.Context, from, to TradeIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this tradeTable) doNotImplement() {}
+
+var _ TradeTable = tradeTable{}
+
+func NewTradeTable(db ormtable.Schema) (TradeTable, error) {
+	table := db.GetTable(&Trade{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&Trade{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return tradeTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type AddressTradeTable interface {
+	Insert(ctx context.Context, addressTrade *AddressTrade) error
+	Update(ctx context.Context, addressTrade *AddressTrade) error
+	Save(ctx context.Context, addressTrade *AddressTrade) error
+	Delete(ctx context.Context, addressTrade *AddressTrade) error
+	Has(ctx context.Context, address []byte, trade_id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, address []byte, trade_id uint64) (*AddressTrade, error)
+	List(ctx context.Context, prefixKey AddressTradeIndexKey, opts ...ormlist.Option) (AddressTradeIterator, error)
+	ListRange(ctx context.Context, from, to AddressTradeIndexKey, opts ...ormlist.Option) (AddressTradeIterator, error)
+	DeleteBy(ctx context.Context, prefixKey AddressTradeIndexKey) error
+	DeleteRange(ctx context.Context, from, to AddressTradeIndexKey) error
+
+	doNotImplement()
+}
+
+type AddressTradeIterator struct {
+	ormtable.Iterator
+}
+
+func (i AddressTradeIterator) Value() (*AddressTrade, error) {
+	var addressTrade AddressTrade
+	err := i.UnmarshalMessage(&addressTrade)
+	return &addressTrade, err
+}
+
+type AddressTradeIndexKey interface {
+	id() uint32
+	values() []interface{}
+	addressTradeIndexKey()
+}
+
+// primary key starting index..
+type AddressTradePrimaryKey = AddressTradeAddressTradeIdIndexKey
+
+type AddressTradeAddressTradeIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x AddressTradeAddressTradeIdIndexKey) id() uint32            { return 0 }
+func (x AddressTradeAddressTradeIdIndexKey) values() []interface{} { return x.vs }
+func (x AddressTradeAddressTradeIdIndexKey) addressTradeIndexKey() {}
+
+func (this AddressTradeAddressTradeIdIndexKey) WithAddress(address []byte) AddressTradeAddressTradeIdIndexKey {
+	this.vs = []interface{}{address}
+	return this
+}
+
+func (this AddressTradeAddressTradeIdIndexKey) WithAddressTradeId(address []byte, trade_id uint64) AddressTradeAddressTradeIdIndexKey {
+	this.vs = []interface{}{address, trade_id}
+	return this
+}
+
+type addressTradeTable struct {
+	table ormtable.Table
+}
+
+func (this addressTradeTable) Insert(ctx context.Context, addressTrade *AddressTrade) error {
+	return this.table.Insert(ctx, addressTrade)
+}
+
+func (this addressTradeTable) Update(ctx context.Context, addressTrade *AddressTrade) error {
+	return this.table.Update(ctx, addressTrade)
+}
+
+func (this addressTradeTable) Save(ctx context.Context, addressTrade *AddressTrade) error {
+	return this.table.Save(ctx, addressTrade)
+}
+
+func (this addressTradeTable) Delete(ctx context.Context, addressTrade *AddressTrade) error {
+	return this.table.Delete(ctx, addressTrade)
+}
+
+func (this addressTradeTable) Has(ctx context.Context, address []byte, trade_id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, address, trade_id)
+}
+
+func (this addressTradeTable) Get(ctx context.Context, address []byte, trade_id uint64) (*AddressTrade, error) {
+	var addressTrade AddressTrade
+	found, err := this.table.PrimaryKey().Get(ctx, &addressTrade, address, trade_id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &addressTrade, nil
+}
+
+func (this addressTradeTable) List(ctx context.Context, prefixKey AddressTradeIndexKey, opts ...ormlist.Option) (AddressTradeIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return AddressTradeIterator{it}, err
+}
+
+func (this addressTradeTable) ListRange(ctx context.Context, from, to AddressTradeIndexKey, opts ...ormlist.Option) (AddressTradeIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return AddressTradeIterator{it}, err
+}
+
+func (this addressTradeTable) DeleteBy(ctx context.Context, prefixKey AddressTradeIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this addressTradeTable) DeleteRange(ctx context.Context, from, to AddressTradeIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this addressTradeTable) doNotImplement() {}
+
+var _ AddressTradeTable = addressTradeTable{}
+
+func NewAddressTradeTable(db ormtable.Schema) (AddressTradeTable, error) {
+	table := db.GetTable(&AddressTrade{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&AddressTrade{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return addressTradeTable{table}, nil
+}
+
+type OfferTable interface {
+	Insert(ctx context.Context, offer *Offer) error
+	InsertReturningID(ctx context.Context, offer *Offer) (uint64, error)
+	Update(ctx context.Context, offer *Offer) error
+	Save(ctx context.Context, offer *Offer) error
+	Delete(ctx context.Context, offer *Offer) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*Offer, error)
+	List(ctx context.Context, prefixKey OfferIndexKey, opts ...ormlist.Option) (OfferIterator, error)
+	ListRange(ctx context.Context, from, to OfferIndexKey, opts ...ormlist.Option) (OfferIterator, error)
+	DeleteBy(ctx context.Context, prefixKey OfferIndexKey) error
+	DeleteRange(ctx context.Context, from, to OfferIndexKey) error
+
+	doNotImplement()
+}
+
+type OfferIterator struct {
+	ormtable.Iterator
+}
+
+func (i OfferIterator) Value() (*Offer, error) {
+	var offer Offer
+	err := i.UnmarshalMessage(&offer)
+	return &offer, err
+}
+
+type OfferIndexKey interface {
+	id() uint32
+	values() []interface{}
+	offerIndexKey()
+}
+
+// primary key starting index..
+type OfferPrimaryKey = OfferIdIndexKey
+
+type OfferIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x OfferIdIndexKey) id() uint32            { return 0 }
+func (x OfferIdIndexKey) values() []interface{} { return x.vs }
+func (x OfferIdIndexKey) offerIndexKey()        {}
+
+func (this OfferIdIndexKey) WithId(id uint64) OfferIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type OfferBuyerIndexKey struct {
+	vs []interface{}
+}
+
+func (x OfferBuyerIndexKey) id() uint32            { return 1 }
+func (x OfferBuyerIndexKey) values() []interface{} { return x.vs }
+func (x OfferBuyerIndexKey) offerIndexKey()        {}
+
+func (this OfferBuyerIndexKey) WithBuyer(buyer []byte) OfferBuyerIndexKey {
+	this.vs = []interface{}{buyer}
+	return this
+}
+
+type OfferBatchKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x OfferBatchKeyIndexKey) id() uint32            { return 2 }
+func (x OfferBatchKeyIndexKey) values() []interface{} { return x.vs }
+func (x OfferBatchKeyIndexKey) offerIndexKey()        {}
+
+func (this OfferBatchKeyIndexKey) WithBatchKey(batch_key uint64) OfferBatchKeyIndexKey {
+	this.vs = []interface{}{batch_key}
+	return this
+}
+
+type OfferHolderIndexKey struct {
+	vs []interface{}
+}
+
+func (x OfferHolderIndexKey) id() uint32            { return 3 }
+func (x OfferHolderIndexKey) values() []interface{} { return x.vs }
+func (x OfferHolderIndexKey) offerIndexKey()        {}
+
+func (this OfferHolderIndexKey) WithHolder(holder []byte) OfferHolderIndexKey {
+	this.vs = []interface{}{holder}
+	return this
+}
+
+type OfferExpirationIndexKey struct {
+	vs []interface{}
+}
+
+func (x OfferExpirationIndexKey) id() uint32            { return 4 }
+func (x OfferExpirationIndexKey) values() []interface{} { return x.vs }
+func (x OfferExpirationIndexKey) offerIndexKey()        {}
+
+func (this OfferExpirationIndexKey) WithExpiration(expiration *timestamppb.Timestamp) OfferExpirationIndexKey {
+	this.vs = []interface{}{expiration}
+	return this
+}
+
+type offerTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this offerTable) Insert(ctx context.Context, offer *Offer) error {
+	return this.table.Insert(ctx, offer)
+}
+
+func (this offerTable) Update(ctx context.Context, offer *Offer) error {
+	return this.table.Update(ctx, offer)
+}
+
+func (this offerTable) Save(ctx context.Context, offer *Offer) error {
+	return this.table.Save(ctx, offer)
+}
+
+func (this offerTable) Delete(ctx context.Context, offer *Offer) error {
+	return this.table.Delete(ctx, offer)
+}
+
+func (this offerTable) InsertReturningID(ctx context.Context, offer *Offer) (uint64, error) {
+	return this.table.InsertReturningID(ctx, offer)
+}
+
+func (this offerTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this offerTable) Get(ctx context.Context, id uint64) (*Offer, error) {
+	var offer Offer
+	found, err := this.table.PrimaryKey().Get(ctx, &offer, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &offer, nil
+}
+
+func (this offerTable) List(ctx context.Context, prefixKey OfferIndexKey, opts ...ormlist.Option) (OfferIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return OfferIterator{it}, err
+}
+
+func (this offerTable) ListRange(ctx context.Context, from, to OfferIndexKey, opts ...ormlist.Option) (OfferIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return OfferIterator{it}, err
+}
+
+func (this offerTable) DeleteBy(ctx context.Context, prefixKey OfferIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this offerTable) DeleteRange(ctx context.Context, from, to OfferIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this offerTable) doNotImplement() {}
+
+var _ OfferTable = offerTable{}
+
+func NewOfferTable(db ormtable.Schema) (OfferTable, error) {
+	table := db.GetTable(&Offer{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&Offer{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return offerTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type DutchAuctionTable interface {
+	Insert(ctx context.Context, dutchAuction *DutchAuction) error
+	InsertReturningID(ctx context.Context, dutchAuction *DutchAuction) (uint64, error)
+	Update(ctx context.Context, dutchAuction *DutchAuction) error
+	Save(ctx context.Context, dutchAuction *DutchAuction) error
+	Delete(ctx context.Context, dutchAuction *DutchAuction) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*DutchAuction, error)
+	List(ctx context.Context, prefixKey DutchAuctionIndexKey, opts ...ormlist.Option) (DutchAuctionIterator, error)
+	ListRange(ctx context.Context, from, to DutchAuctionIndexKey, opts ...ormlist.Option) (DutchAuctionIterator, error)
+	DeleteBy(ctx context.Context, prefixKey DutchAuctionIndexKey) error
+	DeleteRange(ctx context.Context, from, to DutchAuctionIndexKey) error
+
+	doNotImplement()
+}
+
+type DutchAuctionIterator struct {
+	ormtable.Iterator
+}
+
+func (i DutchAuctionIterator) Value() (*DutchAuction, error) {
+	var dutchAuction DutchAuction
+	err := i.UnmarshalMessage(&dutchAuction)
+	return &dutchAuction, err
+}
+
+type DutchAuctionIndexKey interface {
+	id() uint32
+	values() []interface{}
+	dutchAuctionIndexKey()
+}
+
+// primary key starting index..
+type DutchAuctionPrimaryKey = DutchAuctionIdIndexKey
+
+type DutchAuctionIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x DutchAuctionIdIndexKey) id() uint32            { return 0 }
+func (x DutchAuctionIdIndexKey) values() []interface{} { return x.vs }
+func (x DutchAuctionIdIndexKey) dutchAuctionIndexKey() {}
+
+func (this DutchAuctionIdIndexKey) WithId(id uint64) DutchAuctionIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type DutchAuctionSellerIndexKey struct {
+	vs []interface{}
+}
+
+func (x DutchAuctionSellerIndexKey) id() uint32            { return 1 }
+func (x DutchAuctionSellerIndexKey) values() []interface{} { return x.vs }
+func (x DutchAuctionSellerIndexKey) dutchAuctionIndexKey() {}
+
+func (this DutchAuctionSellerIndexKey) WithSeller(seller []byte) DutchAuctionSellerIndexKey {
+	this.vs = []interface{}{seller}
+	return this
+}
+
+type DutchAuctionBatchKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x DutchAuctionBatchKeyIndexKey) id() uint32            { return 2 }
+func (x DutchAuctionBatchKeyIndexKey) values() []interface{} { return x.vs }
+func (x DutchAuctionBatchKeyIndexKey) dutchAuctionIndexKey() {}
+
+func (this DutchAuctionBatchKeyIndexKey) WithBatchKey(batch_key uint64) DutchAuctionBatchKeyIndexKey {
+	this.vs = []interface{}{batch_key}
+	return this
+}
+
+type dutchAuctionTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this dutchAuctionTable) Insert(ctx context.Context, dutchAuction *DutchAuction) error {
+	return this.table.Insert(ctx, dutchAuction)
+}
+
+func (this dutchAuctionTable) Update(ctx context.Context, dutchAuction *DutchAuction) error {
+	return this.table.Update(ctx, dutchAuction)
+}
+
+func (this dutchAuctionTable) Save(ctx context.Context, dutchAuction *DutchAuction) error {
+	return this.table.Save(ctx, dutchAuction)
+}
+
+func (this dutchAuctionTable) Delete(ctx context.Context, dutchAuction *DutchAuction) error {
+	return this.table.Delete(ctx, dutchAuction)
+}
+
+func (this dutchAuctionTable) InsertReturningID(ctx context.Context, dutchAuction *DutchAuction) (uint64, error) {
+	return this.table.InsertReturningID(ctx, dutchAuction)
+}
+
+func (this dutchAuctionTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this dutchAuctionTable) Get(ctx context.Context, id uint64) (*DutchAuction, error) {
+	var dutchAuction DutchAuction
+	found, err := this.table.PrimaryKey().Get(ctx, &dutchAuction, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &dutchAuction, nil
+}
+
+func (this dutchAuctionTable) List(ctx context.Context, prefixKey DutchAuctionIndexKey, opts ...ormlist.Option) (DutchAuctionIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return DutchAuctionIterator{it}, err
+}
+
+func (this dutchAuctionTable) ListRange(ctx context.Context, from, to DutchAuctionIndexKey, opts ...ormlist.Option) (DutchAuctionIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return DutchAuctionIterator{it}, err
+}
+
+func (this dutchAuctionTable) DeleteBy(ctx context.Context, prefixKey DutchAuctionIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this dutchAuctionTable) DeleteRange(ctx context.Context, from, to DutchAuctionIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this dutchAuctionTable) doNotImplement() {}
+
+var _ DutchAuctionTable = dutchAuctionTable{}
+
+func NewDutchAuctionTable(db ormtable.Schema) (DutchAuctionTable, error) {
+	table := db.GetTable(&DutchAuction{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&DutchAuction{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return dutchAuctionTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type SealedBidAuctionTable interface {
+	Insert(ctx context.Context, sealedBidAuction *SealedBidAuction) error
+	InsertReturningID(ctx context.Context, sealedBidAuction *SealedBidAuction) (uint64, error)
+	Update(ctx context.Context, sealedBidAuction *SealedBidAuction) error
+	Save(ctx context.Context, sealedBidAuction *SealedBidAuction) error
+	Delete(ctx context.Context, sealedBidAuction *SealedBidAuction) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*SealedBidAuction, error)
+	List(ctx context.Context, prefixKey SealedBidAuctionIndexKey, opts ...ormlist.Option) (SealedBidAuctionIterator, error)
+	ListRange(ctx context.Context, from, to SealedBidAuctionIndexKey, opts ...ormlist.Option) (SealedBidAuctionIterator, error)
+	DeleteBy(ctx context.Context, prefixKey SealedBidAuctionIndexKey) error
+	DeleteRange(ctx context.Context, from, to SealedBidAuctionIndexKey) error
+
+	doNotImplement()
+}
+
+type SealedBidAuctionIterator struct {
+	ormtable.Iterator
+}
+
+func (i SealedBidAuctionIterator) Value() (*SealedBidAuction, error) {
+	var sealedBidAuction SealedBidAuction
+	err := i.UnmarshalMessage(&sealedBidAuction)
+	return &sealedBidAuction, err
+}
+
+type SealedBidAuctionIndexKey interface {
+	id() uint32
+	values() []interface{}
+	sealedBidAuctionIndexKey()
+}
+
+// primary key starting index..
+type SealedBidAuctionPrimaryKey = SealedBidAuctionIdIndexKey
+
+type SealedBidAuctionIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x SealedBidAuctionIdIndexKey) id() uint32                { return 0 }
+func (x SealedBidAuctionIdIndexKey) values() []interface{}     { return x.vs }
+func (x SealedBidAuctionIdIndexKey) sealedBidAuctionIndexKey() {}
+
+func (this SealedBidAuctionIdIndexKey) WithId(id uint64) SealedBidAuctionIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type SealedBidAuctionSellerIndexKey struct {
+	vs []interface{}
+}
+
+func (x SealedBidAuctionSellerIndexKey) id() uint32                { return 1 }
+func (x SealedBidAuctionSellerIndexKey) values() []interface{}     { return x.vs }
+func (x SealedBidAuctionSellerIndexKey) sealedBidAuctionIndexKey() {}
+
+func (this SealedBidAuctionSellerIndexKey) WithSeller(seller []byte) SealedBidAuctionSellerIndexKey {
+	this.vs = []interface{}{seller}
+	return this
+}
+
+type SealedBidAuctionBatchKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x SealedBidAuctionBatchKeyIndexKey) id() uint32                { return 2 }
+func (x SealedBidAuctionBatchKeyIndexKey) values() []interface{}     { return x.vs }
+func (x SealedBidAuctionBatchKeyIndexKey) sealedBidAuctionIndexKey() {}
+
+func (this SealedBidAuctionBatchKeyIndexKey) WithBatchKey(batch_key uint64) SealedBidAuctionBatchKeyIndexKey {
+	this.vs = []interface{}{batch_key}
+	return this
+}
+
+type SealedBidAuctionRevealEndHeightIndexKey struct {
+	vs []interface{}
+}
+
+func (x SealedBidAuctionRevealEndHeightIndexKey) id() uint32                { return 3 }
+func (x SealedBidAuctionRevealEndHeightIndexKey) values() []interface{}     { return x.vs }
+func (x SealedBidAuctionRevealEndHeightIndexKey) sealedBidAuctionIndexKey() {}
+
+func (this SealedBidAuctionRevealEndHeightIndexKey) WithRevealEndHeight(reveal_end_height int64) SealedBidAuctionRevealEndHeightIndexKey {
+	this.vs = []interface{}{reveal_end_height}
+	return this
+}
+
+type sealedBidAuctionTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this sealedBidAuctionTable) Insert(ctx context.Context, sealedBidAuction *SealedBidAuction) error {
+	return this.table.Insert(ctx, sealedBidAuction)
+}
+
+func (this sealedBidAuctionTable) Update(ctx context.Context, sealedBidAuction *SealedBidAuction) error {
+	return this.table.Update(ctx, sealedBidAuction)
+}
+
+func (this sealedBidAuctionTable) Save(ctx context.Context, sealedBidAuction *SealedBidAuction) error {
+	return this.table.Save(ctx, sealedBidAuction)
+}
+
+func (this sealedBidAuctionTable) Delete(ctx context.Context, sealedBidAuction *SealedBidAuction) error {
+	return this.table.Delete(ctx, sealedBidAuction)
+}
+
+func (this sealedBidAuctionTable) InsertReturningID(ctx context.Context, sealedBidAuction *SealedBidAuction) (uint64, error) {
+	return this.table.InsertReturningID(ctx, sealedBidAuction)
+}
+
+func (this sealedBidAuctionTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this sealedBidAuctionTable) Get(ctx context.Context, id uint64) (*SealedBidAuction, error) {
+	var sealedBidAuction SealedBidAuction
+	found, err := this.table.PrimaryKey().Get(ctx, &sealedBidAuction, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &sealedBidAuction, nil
+}
+
+func (this sealedBidAuctionTable) List(ctx context.Context, prefixKey SealedBidAuctionIndexKey, opts ...ormlist.Option) (SealedBidAuctionIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return SealedBidAuctionIterator{it}, err
+}
+
+func (this sealedBidAuctionTable) ListRange(ctx context.Context, from, to SealedBidAuctionIndexKey, opts ...ormlist.Option) (SealedBidAuctionIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return SealedBidAuctionIterator{it}, err
+}
+
+func (this sealedBidAuctionTable) DeleteBy(ctx context.Context, prefixKey SealedBidAuctionIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this sealedBidAuctionTable) DeleteRange(ctx context.Context, from, to SealedBidAuctionIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this sealedBidAuctionTable) doNotImplement() {}
+
+var _ SealedBidAuctionTable = sealedBidAuctionTable{}
+
+func NewSealedBidAuctionTable(db ormtable.Schema) (SealedBidAuctionTable, error) {
+	table := db.GetTable(&SealedBidAuction{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&SealedBidAuction{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return sealedBidAuctionTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type SealedBidTable interface {
+	Insert(ctx context.Context, sealedBid *SealedBid) error
+	Update(ctx context.Context, sealedBid *SealedBid) error
+	Save(ctx context.Context, sealedBid *SealedBid) error
+	Delete(ctx context.Context, sealedBid *SealedBid) error
+	Has(ctx context.Context, auction_id uint64, bidder []byte) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, auction_id uint64, bidder []byte) (*SealedBid, error)
+	List(ctx context.Context, prefixKey SealedBidIndexKey, opts ...ormlist.Option) (SealedBidIterator, error)
+	ListRange(ctx context.Context, from, to SealedBidIndexKey, opts ...ormlist.Option) (SealedBidIterator, error)
+	DeleteBy(ctx context.Context, prefixKey SealedBidIndexKey) error
+	DeleteRange(ctx context.Context, from, to SealedBidIndexKey) error
+
+	doNotImplement()
+}
+
+type SealedBidIterator struct {
+	ormtable.Iterator
+}
+
+func (i SealedBidIterator) Value() (*SealedBid, error) {
+	var sealedBid SealedBid
+	err := i.UnmarshalMessage(&sealedBid)
+	return &sealedBid, err
+}
+
+type SealedBidIndexKey interface {
+	id() uint32
+	values() []interface{}
+	sealedBidIndexKey()
+}
+
+// primary key starting index..
+type SealedBidPrimaryKey = SealedBidAuctionIdBidderIndexKey
+
+type SealedBidAuctionIdBidderIndexKey struct {
+	vs []interface{}
+}
+
+func (x SealedBidAuctionIdBidderIndexKey) id() uint32            { return 0 }
+func (x SealedBidAuctionIdBidderIndexKey) values() []interface{} { return x.vs }
+func (x SealedBidAuctionIdBidderIndexKey) sealedBidIndexKey()    {}
+
+func (this SealedBidAuctionIdBidderIndexKey) WithAuctionId(auction_id uint64) SealedBidAuctionIdBidderIndexKey {
+	this.vs = []interface{}{auction_id}
+	return this
+}
+
+func (this SealedBidAuctionIdBidderIndexKey) WithAuctionIdBidder(auction_id uint64, bidder []byte) SealedBidAuctionIdBidderIndexKey {
+	this.vs = []interface{}{auction_id, bidder}
+	return this
+}
+
+type SealedBidBidderIndexKey struct {
+	vs []interface{}
+}
+
+func (x SealedBidBidderIndexKey) id() uint32            { return 1 }
+func (x SealedBidBidderIndexKey) values() []interface{} { return x.vs }
+func (x SealedBidBidderIndexKey) sealedBidIndexKey()    {}
+
+func (this SealedBidBidderIndexKey) WithBidder(bidder []byte) SealedBidBidderIndexKey {
+	this.vs = []interface{}{bidder}
+	return this
+}
+
+type sealedBidTable struct {
+	table ormtable.Table
+}
+
+func (this sealedBidTable) Insert(ctx context.Context, sealedBid *SealedBid) error {
+	return this.table.Insert(ctx, sealedBid)
+}
+
+func (this sealedBidTable) Update(ctx context.Context, sealedBid *SealedBid) error {
+	return this.table.Update(ctx, sealedBid)
+}
+
+func (this sealedBidTable) Save(ctx context.Context, sealedBid *SealedBid) error {
+	return this.table.Save(ctx, sealedBid)
+}
+
+func (this sealedBidTable) Delete(ctx context.Context, sealedBid *SealedBid) error {
+	return this.table.Delete(ctx, sealedBid)
+}
+
+func (this sealedBidTable) Has(ctx context.Context, auction_id uint64, bidder []byte) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, auction_id, bidder)
+}
+
+func (this sealedBidTable) Get(ctx context.Context, auction_id uint64, bidder []byte) (*SealedBid, error) {
+	var sealedBid SealedBid
+	found, err := this.table.PrimaryKey().Get(ctx, &sealedBid, auction_id, bidder)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &sealedBid, nil
+}
+
+func (this sealedBidTable) List(ctx context.Context, prefixKey SealedBidIndexKey, opts ...ormlist.Option) (SealedBidIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return SealedBidIterator{it}, err
+}
+
+func (this sealedBidTable) ListRange(ctx context.Context, from, to SealedBidIndexKey, opts ...ormlist.Option) (SealedBidIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return SealedBidIterator{it}, err
+}
+
+func (this sealedBidTable) DeleteBy(ctx context.Context, prefixKey SealedBidIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this sealedBidTable) DeleteRange(ctx context.Context, from, to SealedBidIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this sealedBidTable) doNotImplement() {}
+
+var _ SealedBidTable = sealedBidTable{}
+
+func NewSealedBidTable(db ormtable.Schema) (SealedBidTable, error) {
+	table := db.GetTable(&SealedBid{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&SealedBid{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return sealedBidTable{table}, nil
+}
+
+type ForwardSaleTable interface {
+	Insert(ctx context.Context, forwardSale *ForwardSale) error
+	InsertReturningID(ctx context.Context, forwardSale *ForwardSale) (uint64, error)
+	Update(ctx context.Context, forwardSale *ForwardSale) error
+	Save(ctx context.Context, forwardSale *ForwardSale) error
+	Delete(ctx context.Context, forwardSale *ForwardSale) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*ForwardSale, error)
+	List(ctx context.Context, prefixKey ForwardSaleIndexKey, opts ...ormlist.Option) (ForwardSaleIterator, error)
+	ListRange(ctx context.Context, from, to ForwardSaleIndexKey, opts ...ormlist.Option) (ForwardSaleIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ForwardSaleIndexKey) error
+	DeleteRange(ctx context.Context, from, to ForwardSaleIndexKey) error
+
+	doNotImplement()
+}
+
+type ForwardSaleIterator struct {
+	ormtable.Iterator
+}
+
+func (i ForwardSaleIterator) Value() (*ForwardSale, error) {
+	var forwardSale ForwardSale
+	err := i.UnmarshalMessage(&forwardSale)
+	return &forwardSale, err
+}
+
+type ForwardSaleIndexKey interface {
+	id() uint32
+	values() []interface{}
+	forwardSaleIndexKey()
+}
+
+// primary key starting index..
+type ForwardSalePrimaryKey = ForwardSaleIdIndexKey
+
+type ForwardSaleIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x ForwardSaleIdIndexKey) id() uint32            { return 0 }
+func (x ForwardSaleIdIndexKey) values() []interface{} { return x.vs }
+func (x ForwardSaleIdIndexKey) forwardSaleIndexKey()  {}
+
+func (this ForwardSaleIdIndexKey) WithId(id uint64) ForwardSaleIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type ForwardSaleSellerIndexKey struct {
+	vs []interface{}
+}
+
+func (x ForwardSaleSellerIndexKey) id() uint32            { return 1 }
+func (x ForwardSaleSellerIndexKey) values() []interface{} { return x.vs }
+func (x ForwardSaleSellerIndexKey) forwardSaleIndexKey()  {}
+
+func (this ForwardSaleSellerIndexKey) WithSeller(seller []byte) ForwardSaleSellerIndexKey {
+	this.vs = []interface{}{seller}
+	return this
+}
+
+type ForwardSaleProjectKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x ForwardSaleProjectKeyIndexKey) id() uint32            { return 2 }
+func (x ForwardSaleProjectKeyIndexKey) values() []interface{} { return x.vs }
+func (x ForwardSaleProjectKeyIndexKey) forwardSaleIndexKey()  {}
+
+func (this ForwardSaleProjectKeyIndexKey) WithProjectKey(project_key uint64) ForwardSaleProjectKeyIndexKey {
+	this.vs = []interface{}{project_key}
+	return this
+}
+
+type ForwardSaleDeliveryDeadlineIndexKey struct {
+	vs []interface{}
+}
+
+func (x ForwardSaleDeliveryDeadlineIndexKey) id() uint32            { return 3 }
+func (x ForwardSaleDeliveryDeadlineIndexKey) values() []interface{} { return x.vs }
+func (x ForwardSaleDeliveryDeadlineIndexKey) forwardSaleIndexKey()  {}
+
+func (this ForwardSaleDeliveryDeadlineIndexKey) WithDeliveryDeadline(delivery_deadline *timestamppb.Timestamp) ForwardSaleDeliveryDeadlineIndexKey {
+	this.vs = []interface{}{delivery_deadline}
+	return this
+}
+
+type forwardSaleTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this forwardSaleTable) Insert(ctx context.Context, forwardSale *ForwardSale) error {
+	return this.table.Insert(ctx, forwardSale)
+}
+
+func (this forwardSaleTable) Update(ctx context.Context, forwardSale *ForwardSale) error {
+	return this.table.Update(ctx, forwardSale)
+}
+
+func (this forwardSaleTable) Save(ctx context.Context, forwardSale *ForwardSale) error {
+	return this.table.Save(ctx, forwardSale)
+}
+
+func (this forwardSaleTable) Delete(ctx context.Context, forwardSale *ForwardSale) error {
+	return this.table.Delete(ctx, forwardSale)
+}
+
+func (this forwardSaleTable) InsertReturningID(ctx context.Context, forwardSale *ForwardSale) (uint64, error) {
+	return this.table.InsertReturningID(ctx, forwardSale)
+}
+
+func (this forwardSaleTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this forwardSaleTable) Get(ctx context.Context, id uint64) (*ForwardSale, error) {
+	var forwardSale ForwardSale
+	found, err := this.table.PrimaryKey().Get(ctx, &forwardSale, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &forwardSale, nil
+}
+
+func (this forwardSaleTable) List(ctx context.Context, prefixKey ForwardSaleIndexKey, opts ...ormlist.Option) (ForwardSaleIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ForwardSaleIterator{it}, err
+}
+
+func (this forwardSaleTable) ListRange(ctx context.Context, from, to ForwardSaleIndexKey, opts ...ormlist.Option) (ForwardSaleIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ForwardSaleIterator{it}, err
+}
+
+func (this forwardSaleTable) DeleteBy(ctx context.Context, prefixKey ForwardSaleIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this forwardSaleTable) DeleteRange(ctx context.Context, from, to ForwardSaleIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this forwardSaleTable) doNotImplement() {}
+
+var _ ForwardSaleTable = forwardSaleTable{}
+
+func NewForwardSaleTable(db ormtable.Schema) (ForwardSaleTable, error) {
+	table := db.GetTable(&ForwardSale{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&ForwardSale{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return forwardSaleTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type ForwardContractTable interface {
+	Insert(ctx context.Context, forwardContract *ForwardContract) error
+	InsertReturningID(ctx context.Context, forwardContract *ForwardContract) (uint64, error)
+	Update(ctx context.Context, forwardContract *ForwardContract) error
+	Save(ctx context.Context, forwardContract *ForwardContract) error
+	Delete(ctx context.Context, forwardContract *ForwardContract) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*ForwardContract, error)
+	List(ctx context.Context, prefixKey ForwardContractIndexKey, opts ...ormlist.Option) (ForwardContractIterator, error)
+	ListRange(ctx context.Context, from, to ForwardContractIndexKey, opts ...ormlist.Option) (ForwardContractIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ForwardContractIndexKey) error
+	DeleteRange(ctx context.Context, from, to ForwardContractIndexKey) error
+
+	doNotImplement()
+}
+
+type ForwardContractIterator struct {
+	ormtable.Iterator
+}
+
+func (i ForwardContractIterator) Value() (*ForwardContract, error) {
+	var forwardContract ForwardContract
+	err := i.UnmarshalMessage(&forwardContract)
+	return &forwardContract, err
+}
+
+type ForwardContractIndexKey interface {
+	id() uint32
+	values() []interface{}
+	forwardContractIndexKey()
+}
+
+// primary key starting index..
+type ForwardContractPrimaryKey = ForwardContractIdIndexKey
+
+type ForwardContractIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x ForwardContractIdIndexKey) id() uint32               { return 0 }
+func (x ForwardContractIdIndexKey) values() []interface{}    { return x.vs }
+func (x ForwardContractIdIndexKey) forwardContractIndexKey() {}
+
+func (this ForwardContractIdIndexKey) WithId(id uint64) ForwardContractIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type ForwardContractForwardSaleIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x ForwardContractForwardSaleIdIndexKey) id() uint32               { return 1 }
+func (x ForwardContractForwardSaleIdIndexKey) values() []interface{}    { return x.vs }
+func (x ForwardContractForwardSaleIdIndexKey) forwardContractIndexKey() {}
+
+func (this ForwardContractForwardSaleIdIndexKey) WithForwardSaleId(forward_sale_id uint64) ForwardContractForwardSaleIdIndexKey {
+	this.vs = []interface{}{forward_sale_id}
+	return this
+}
+
+type ForwardContractBuyerIndexKey struct {
+	vs []interface{}
+}
+
+func (x ForwardContractBuyerIndexKey) id() uint32               { return 2 }
+func (x ForwardContractBuyerIndexKey) values() []interface{}    { return x.vs }
+func (x ForwardContractBuyerIndexKey) forwardContractIndexKey() {}
+
+func (this ForwardContractBuyerIndexKey) WithBuyer(buyer []byte) ForwardContractBuyerIndexKey {
+	this.vs = []interface{}{buyer}
+	return this
+}
+
+type ForwardContractProjectKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x ForwardContractProjectKeyIndexKey) id() uint32               { return 3 }
+func (x ForwardContractProjectKeyIndexKey) values() []interface{}    { return x.vs }
+func (x ForwardContractProjectKeyIndexKey) forwardContractIndexKey() {}
+
+func (this ForwardContractProjectKeyIndexKey) WithProjectKey(project_key uint64) ForwardContractProjectKeyIndexKey {
+	this.vs = []interface{}{project_key}
+	return this
+}
+
+type forwardContractTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this forwardContractTable) Insert(ctx context.Context, forwardContract *ForwardContract) error {
+	return this.table.Insert(ctx, forwardContract)
+}
+
+func (this forwardContractTable) Update(ctx context.Context, forwardContract *ForwardContract) error {
+	return this.table.Update(ctx, forwardContract)
+}
+
+func (this forwardContractTable) Save(ctx context.Context, forwardContract *ForwardContract) error {
+	return this.table.Save(ctx, forwardContract)
+}
+
+func (this forwardContractTable) Delete(ctx context.Context, forwardContract *ForwardContract) error {
+	return this.table.Delete(ctx, forwardContract)
+}
+
+func (this forwardContractTable) InsertReturningID(ctx context.Context, forwardContract *ForwardContract) (uint64, error) {
+	return this.table.InsertReturningID(ctx, forwardContract)
+}
+
+func (this forwardContractTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this forwardContractTable) Get(ctx context.Context, id uint64) (*ForwardContract, error) {
+	var forwardContract ForwardContract
+	found, err := this.table.PrimaryKey().Get(ctx, &forwardContract, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &forwardContract, nil
+}
+
+func (this forwardContractTable) List(ctx context.Context, prefixKey ForwardContractIndexKey, opts ...ormlist.Option) (ForwardContractIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ForwardContractIterator{it}, err
+}
+
+func (this forwardContractTable) ListRange(ctx context.Context, from, to ForwardContractIndexKey, opts ...ormlist.Option) (ForwardContractIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ForwardContractIterator{it}, err
+}
+
+func (this forwardContractTable) DeleteBy(ctx context.Context, prefixKey ForwardContractIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this forwardContractTable) DeleteRange(ctx context.Context, from, to ForwardContractIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this forwardContractTable) doNotImplement() {}
+
+var _ ForwardContractTable = forwardContractTable{}
+
+func NewForwardContractTable(db ormtable.Schema) (ForwardContractTable, error) {
+	table := db.GetTable(&ForwardContract{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&ForwardContract{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return forwardContractTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+// singleton store
+type MarketRegistrationTable interface {
+	Get(ctx context.Context) (*MarketRegistration, error)
+	Save(ctx context.Context, marketRegistration *MarketRegistration) error
+}
+
+type marketRegistrationTable struct {
+	table ormtable.Table
+}
+
+var _ MarketRegistrationTable = marketRegistrationTable{}
+
+func (x marketRegistrationTable) Get(ctx context.Context) (*MarketRegistration, error) {
+	marketRegistration := &MarketRegistration{}
+	_, err := x.table.Get(ctx, marketRegistration)
+	return marketRegistration, err
+}
+
+func (x marketRegistrationTable) Save(ctx context.Context, marketRegistration *MarketRegistration) error {
+	return x.table.Save(ctx, marketRegistration)
+}
+
+func NewMarketRegistrationTable(db ormtable.Schema) (MarketRegistrationTable, error) {
+	table := db.GetTable(&MarketRegistration{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&MarketRegistration{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return &marketRegistrationTable{table}, nil
+}
+
+type StateStore interface {
+	SellOrderTable() SellOrderTable
+	BuyOrderTable() BuyOrderTable
+	AllowedDenomTable() AllowedDenomTable
+	MarketTable() MarketTable
+	MarketFeeTable() MarketFeeTable
+	CreditTypeFeeTable() CreditTypeFeeTable
+	FeeDestinationTable() FeeDestinationTable
+	MarketFeeTotalTable() MarketFeeTotalTable
+	TradeTable() TradeTable
+	AddressTradeTable() AddressTradeTable
+	OfferTable() OfferTable
+	DutchAuctionTable() DutchAuctionTable
+	SealedBidAuctionTable() SealedBidAuctionTable
+	SealedBidTable() SealedBidTable
+	ForwardSaleTable() ForwardSaleTable
+	ForwardContractTable() ForwardContractTable
+	MarketRegistrationTable() MarketRegistrationTable
+
+	doNotImplement()
+}
+
+type stateStore struct {
+	sellOrder          SellOrderTable
+	buyOrder           BuyOrderTable
+	allowedDenom       AllowedDenomTable
+	market             MarketTable
+	marketFee          MarketFeeTable
+	creditTypeFee      CreditTypeFeeTable
+	feeDestination     FeeDestinationTable
+	marketFeeTotal     MarketFeeTotalTable
+	trade              TradeTable
+	addressTrade       AddressTradeTable
+	offer              OfferTable
+	dutchAuction       DutchAuctionTable
+	sealedBidAuction   SealedBidAuctionTable
+	sealedBid          SealedBidTable
+	forwardSale        ForwardSaleTable
+	forwardContract    ForwardContractTable
+	marketRegistration MarketRegistrationTable
+}
+
+func (x stateStore) SellOrderTable() SellOrderTable {
+	return x.sellOrder
+}
+
+func (x stateStore) BuyOrderTable() BuyOrderTable {
+	return x.buyOrder
+}
+
+func (x stateStore) AllowedDenomTable() AllowedDenomTable {
+	return x.allowedDenom
+}
+
+func (x stateStore) MarketTable() MarketTable {
+	return x.market
+}
+
+func (x stateStore) MarketFeeTable() MarketFeeTable {
+	return x.marketFee
+}
+
+func (x stateStore) CreditTypeFeeTable() CreditTypeFeeTable {
+	return x.creditTypeFee
+}
+
+func (x stateStore) FeeDestinationTable() FeeDestinationTable {
+	return x.feeDestination
+}
+
+func (x stateStore) MarketFeeTotalTable() MarketFeeTotalTable {
+	return x.marketFeeTotal
+}
+
+func (x stateStore) TradeTable() TradeTable {
+	return x.trade
+}
+
+func (x stateStore) AddressTradeTable() AddressTradeTable {
+	return x.addressTrade
+}
+
+func (x stateStore) OfferTable() OfferTable {
+	return x.offer
+}
+
+func (x stateStore) DutchAuctionTable() DutchAuctionTable {
+	return x.dutchAuction
+}
+
+func (x stateStore) SealedBidAuctionTable() SealedBidAuctionTable {
+	return x.sealedBidAuction
+}
+
+func (x stateStore) SealedBidTable() SealedBidTable {
+	return x.sealedBid
+}
+
+func (x stateStore) ForwardSaleTable() ForwardSaleTable {
+	return x.forwardSale
+}
+
+func (x stateStore) ForwardContractTable() ForwardContractTable {
+	return x.forwardContract
+}
+
+func (x stateStore) MarketRegistrationTable() MarketRegistrationTable {
+	return x.marketRegistration
+}
+
+func (stateStore) doNotImplement() {}
+
+var _ StateStore = stateStore{}
+
+func NewStateStore(db ormtable.Schema) (StateStore, error) {
+	sellOrderTable, err := NewSellOrderTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	buyOrderTable, err := NewBuyOrderTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	allowedDenomTable, err := NewAllowedDenomTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	marketTable, err := NewMarketTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	marketFeeTable, err := NewMarketFeeTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	creditTypeFeeTable, err := NewCreditTypeFeeTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	feeDestinationTable, err := NewFeeDestinationTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	marketFeeTotalTable, err := NewMarketFeeTotalTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	tradeTable, err := NewTradeTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	addressTradeTable, err := NewAddressTradeTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	offerTable, err := NewOfferTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	dutchAuctionTable, err := NewDutchAuctionTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	sealedBidAuctionTable, err := NewSealedBidAuctionTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	sealedBidTable, err := NewSealedBidTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	forwardSaleTable, err := NewForwardSaleTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	forwardContractTable, err := NewForwardContractTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	marketRegistrationTable, err := NewMarketRegistrationTable(db)
 	if err != nil {
 		return nil, err
 	}
 
 	return stateStore{
 		sellOrderTable,
+		buyOrderTable,
 		allowedDenomTable,
 		marketTable,
+		marketFeeTable,
+		creditTypeFeeTable,
+		feeDestinationTable,
+		marketFeeTotalTable,
+		tradeTable,
+		addressTradeTable,
+		offerTable,
+		dutchAuctionTable,
+		sealedBidAuctionTable,
+		sealedBidTable,
+		forwardSaleTable,
+		forwardContractTable,
+		marketRegistrationTable,
 	}, nil
 }
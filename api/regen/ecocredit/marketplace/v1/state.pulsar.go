@@ -14,6 +14,52 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_SellOrder_11_list)(nil)
+
+type _SellOrder_11_list struct {
+	list *[][]byte
+}
+
+func (x *_SellOrder_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SellOrder_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
+}
+
+func (x *_SellOrder_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SellOrder_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SellOrder_11_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message SellOrder at list field AllowedBuyers as it is not of Message kind"))
+}
+
+func (x *_SellOrder_11_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SellOrder_11_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_SellOrder_11_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_SellOrder                     protoreflect.MessageDescriptor
 	fd_SellOrder_id                  protoreflect.FieldDescriptor
@@ -25,6 +71,14 @@ var (
 	fd_SellOrder_disable_auto_retire protoreflect.FieldDescriptor
 	fd_SellOrder_expiration          protoreflect.FieldDescriptor
 	fd_SellOrder_maker               protoreflect.FieldDescriptor
+	fd_SellOrder_allowed_buyers      protoreflect.FieldDescriptor
+	fd_SellOrder_buyer_group_policy  protoreflect.FieldDescriptor
+	fd_SellOrder_class_key           protoreflect.FieldDescriptor
+	fd_SellOrder_project_key         protoreflect.FieldDescriptor
+	fd_SellOrder_batch_start_date    protoreflect.FieldDescriptor
+	fd_SellOrder_ask_amount_key      protoreflect.FieldDescriptor
+	fd_SellOrder_min_fill_quantity   protoreflect.FieldDescriptor
+	fd_SellOrder_all_or_none         protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -39,6 +93,14 @@ func init() {
 	fd_SellOrder_disable_auto_retire = md_SellOrder.Fields().ByName("disable_auto_retire")
 	fd_SellOrder_expiration = md_SellOrder.Fields().ByName("expiration")
 	fd_SellOrder_maker = md_SellOrder.Fields().ByName("maker")
+	fd_SellOrder_allowed_buyers = md_SellOrder.Fields().ByName("allowed_buyers")
+	fd_SellOrder_buyer_group_policy = md_SellOrder.Fields().ByName("buyer_group_policy")
+	fd_SellOrder_class_key = md_SellOrder.Fields().ByName("class_key")
+	fd_SellOrder_project_key = md_SellOrder.Fields().ByName("project_key")
+	fd_SellOrder_batch_start_date = md_SellOrder.Fields().ByName("batch_start_date")
+	fd_SellOrder_ask_amount_key = md_SellOrder.Fields().ByName("ask_amount_key")
+	fd_SellOrder_min_fill_quantity = md_SellOrder.Fields().ByName("min_fill_quantity")
+	fd_SellOrder_all_or_none = md_SellOrder.Fields().ByName("all_or_none")
 }
 
 var _ protoreflect.Message = (*fastReflection_SellOrder)(nil)
@@ -160,6 +222,54 @@ func (x *fastReflection_SellOrder) Range(f func(protoreflect.FieldDescriptor, pr
 			return
 		}
 	}
+	if len(x.AllowedBuyers) != 0 {
+		value := protoreflect.ValueOfList(&_SellOrder_11_list{list: &x.AllowedBuyers})
+		if !f(fd_SellOrder_allowed_buyers, value) {
+			return
+		}
+	}
+	if len(x.BuyerGroupPolicy) != 0 {
+		value := protoreflect.ValueOfBytes(x.BuyerGroupPolicy)
+		if !f(fd_SellOrder_buyer_group_policy, value) {
+			return
+		}
+	}
+	if x.ClassKey != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ClassKey)
+		if !f(fd_SellOrder_class_key, value) {
+			return
+		}
+	}
+	if x.ProjectKey != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProjectKey)
+		if !f(fd_SellOrder_project_key, value) {
+			return
+		}
+	}
+	if x.BatchStartDate != nil {
+		value := protoreflect.ValueOfMessage(x.BatchStartDate.ProtoReflect())
+		if !f(fd_SellOrder_batch_start_date, value) {
+			return
+		}
+	}
+	if len(x.AskAmountKey) != 0 {
+		value := protoreflect.ValueOfBytes(x.AskAmountKey)
+		if !f(fd_SellOrder_ask_amount_key, value) {
+			return
+		}
+	}
+	if x.MinFillQuantity != "" {
+		value := protoreflect.ValueOfString(x.MinFillQuantity)
+		if !f(fd_SellOrder_min_fill_quantity, value) {
+			return
+		}
+	}
+	if x.AllOrNone != false {
+		value := protoreflect.ValueOfBool(x.AllOrNone)
+		if !f(fd_SellOrder_all_or_none, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -193,6 +303,22 @@ func (x *fastReflection_SellOrder) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Expiration != nil
 	case "regen.ecocredit.marketplace.v1.SellOrder.maker":
 		return x.Maker != false
+	case "regen.ecocredit.marketplace.v1.SellOrder.allowed_buyers":
+		return len(x.AllowedBuyers) != 0
+	case "regen.ecocredit.marketplace.v1.SellOrder.buyer_group_policy":
+		return len(x.BuyerGroupPolicy) != 0
+	case "regen.ecocredit.marketplace.v1.SellOrder.class_key":
+		return x.ClassKey != uint64(0)
+	case "regen.ecocredit.marketplace.v1.SellOrder.project_key":
+		return x.ProjectKey != uint64(0)
+	case "regen.ecocredit.marketplace.v1.SellOrder.batch_start_date":
+		return x.BatchStartDate != nil
+	case "regen.ecocredit.marketplace.v1.SellOrder.ask_amount_key":
+		return len(x.AskAmountKey) != 0
+	case "regen.ecocredit.marketplace.v1.SellOrder.min_fill_quantity":
+		return x.MinFillQuantity != ""
+	case "regen.ecocredit.marketplace.v1.SellOrder.all_or_none":
+		return x.AllOrNone != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrder"))
@@ -227,6 +353,22 @@ func (x *fastReflection_SellOrder) Clear(fd protoreflect.FieldDescriptor) {
 		x.Expiration = nil
 	case "regen.ecocredit.marketplace.v1.SellOrder.maker":
 		x.Maker = false
+	case "regen.ecocredit.marketplace.v1.SellOrder.allowed_buyers":
+		x.AllowedBuyers = nil
+	case "regen.ecocredit.marketplace.v1.SellOrder.buyer_group_policy":
+		x.BuyerGroupPolicy = nil
+	case "regen.ecocredit.marketplace.v1.SellOrder.class_key":
+		x.ClassKey = uint64(0)
+	case "regen.ecocredit.marketplace.v1.SellOrder.project_key":
+		x.ProjectKey = uint64(0)
+	case "regen.ecocredit.marketplace.v1.SellOrder.batch_start_date":
+		x.BatchStartDate = nil
+	case "regen.ecocredit.marketplace.v1.SellOrder.ask_amount_key":
+		x.AskAmountKey = nil
+	case "regen.ecocredit.marketplace.v1.SellOrder.min_fill_quantity":
+		x.MinFillQuantity = ""
+	case "regen.ecocredit.marketplace.v1.SellOrder.all_or_none":
+		x.AllOrNone = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrder"))
@@ -270,6 +412,33 @@ func (x *fastReflection_SellOrder) Get(descriptor protoreflect.FieldDescriptor)
 	case "regen.ecocredit.marketplace.v1.SellOrder.maker":
 		value := x.Maker
 		return protoreflect.ValueOfBool(value)
+	case "regen.ecocredit.marketplace.v1.SellOrder.allowed_buyers":
+		if len(x.AllowedBuyers) == 0 {
+			return protoreflect.ValueOfList(&_SellOrder_11_list{})
+		}
+		listValue := &_SellOrder_11_list{list: &x.AllowedBuyers}
+		return protoreflect.ValueOfList(listValue)
+	case "regen.ecocredit.marketplace.v1.SellOrder.buyer_group_policy":
+		value := x.BuyerGroupPolicy
+		return protoreflect.ValueOfBytes(value)
+	case "regen.ecocredit.marketplace.v1.SellOrder.class_key":
+		value := x.ClassKey
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.SellOrder.project_key":
+		value := x.ProjectKey
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.SellOrder.batch_start_date":
+		value := x.BatchStartDate
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.SellOrder.ask_amount_key":
+		value := x.AskAmountKey
+		return protoreflect.ValueOfBytes(value)
+	case "regen.ecocredit.marketplace.v1.SellOrder.min_fill_quantity":
+		value := x.MinFillQuantity
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.SellOrder.all_or_none":
+		value := x.AllOrNone
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrder"))
@@ -308,6 +477,24 @@ func (x *fastReflection_SellOrder) Set(fd protoreflect.FieldDescriptor, value pr
 		x.Expiration = value.Message().Interface().(*timestamppb.Timestamp)
 	case "regen.ecocredit.marketplace.v1.SellOrder.maker":
 		x.Maker = value.Bool()
+	case "regen.ecocredit.marketplace.v1.SellOrder.allowed_buyers":
+		lv := value.List()
+		clv := lv.(*_SellOrder_11_list)
+		x.AllowedBuyers = *clv.list
+	case "regen.ecocredit.marketplace.v1.SellOrder.buyer_group_policy":
+		x.BuyerGroupPolicy = value.Bytes()
+	case "regen.ecocredit.marketplace.v1.SellOrder.class_key":
+		x.ClassKey = value.Uint()
+	case "regen.ecocredit.marketplace.v1.SellOrder.project_key":
+		x.ProjectKey = value.Uint()
+	case "regen.ecocredit.marketplace.v1.SellOrder.batch_start_date":
+		x.BatchStartDate = value.Message().Interface().(*timestamppb.Timestamp)
+	case "regen.ecocredit.marketplace.v1.SellOrder.ask_amount_key":
+		x.AskAmountKey = value.Bytes()
+	case "regen.ecocredit.marketplace.v1.SellOrder.min_fill_quantity":
+		x.MinFillQuantity = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.SellOrder.all_or_none":
+		x.AllOrNone = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrder"))
@@ -333,6 +520,17 @@ func (x *fastReflection_SellOrder) Mutable(fd protoreflect.FieldDescriptor) prot
 			x.Expiration = new(timestamppb.Timestamp)
 		}
 		return protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.SellOrder.allowed_buyers":
+		if x.AllowedBuyers == nil {
+			x.AllowedBuyers = [][]byte{}
+		}
+		value := &_SellOrder_11_list{list: &x.AllowedBuyers}
+		return protoreflect.ValueOfList(value)
+	case "regen.ecocredit.marketplace.v1.SellOrder.batch_start_date":
+		if x.BatchStartDate == nil {
+			x.BatchStartDate = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.BatchStartDate.ProtoReflect())
 	case "regen.ecocredit.marketplace.v1.SellOrder.id":
 		panic(fmt.Errorf("field id of message regen.ecocredit.marketplace.v1.SellOrder is not mutable"))
 	case "regen.ecocredit.marketplace.v1.SellOrder.seller":
@@ -349,6 +547,18 @@ func (x *fastReflection_SellOrder) Mutable(fd protoreflect.FieldDescriptor) prot
 		panic(fmt.Errorf("field disable_auto_retire of message regen.ecocredit.marketplace.v1.SellOrder is not mutable"))
 	case "regen.ecocredit.marketplace.v1.SellOrder.maker":
 		panic(fmt.Errorf("field maker of message regen.ecocredit.marketplace.v1.SellOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.SellOrder.buyer_group_policy":
+		panic(fmt.Errorf("field buyer_group_policy of message regen.ecocredit.marketplace.v1.SellOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.SellOrder.class_key":
+		panic(fmt.Errorf("field class_key of message regen.ecocredit.marketplace.v1.SellOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.SellOrder.project_key":
+		panic(fmt.Errorf("field project_key of message regen.ecocredit.marketplace.v1.SellOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.SellOrder.ask_amount_key":
+		panic(fmt.Errorf("field ask_amount_key of message regen.ecocredit.marketplace.v1.SellOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.SellOrder.min_fill_quantity":
+		panic(fmt.Errorf("field min_fill_quantity of message regen.ecocredit.marketplace.v1.SellOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.SellOrder.all_or_none":
+		panic(fmt.Errorf("field all_or_none of message regen.ecocredit.marketplace.v1.SellOrder is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrder"))
@@ -381,6 +591,24 @@ func (x *fastReflection_SellOrder) NewField(fd protoreflect.FieldDescriptor) pro
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "regen.ecocredit.marketplace.v1.SellOrder.maker":
 		return protoreflect.ValueOfBool(false)
+	case "regen.ecocredit.marketplace.v1.SellOrder.allowed_buyers":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_SellOrder_11_list{list: &list})
+	case "regen.ecocredit.marketplace.v1.SellOrder.buyer_group_policy":
+		return protoreflect.ValueOfBytes(nil)
+	case "regen.ecocredit.marketplace.v1.SellOrder.class_key":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.SellOrder.project_key":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.SellOrder.batch_start_date":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.SellOrder.ask_amount_key":
+		return protoreflect.ValueOfBytes(nil)
+	case "regen.ecocredit.marketplace.v1.SellOrder.min_fill_quantity":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.SellOrder.all_or_none":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrder"))
@@ -481,6 +709,37 @@ func (x *fastReflection_SellOrder) ProtoMethods() *protoiface.Methods {
 		if x.Maker {
 			n += 2
 		}
+		if len(x.AllowedBuyers) > 0 {
+			for _, b := range x.AllowedBuyers {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.BuyerGroupPolicy)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ClassKey != 0 {
+			n += 1 + runtime.Sov(uint64(x.ClassKey))
+		}
+		if x.ProjectKey != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProjectKey))
+		}
+		if x.BatchStartDate != nil {
+			l = options.Size(x.BatchStartDate)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AskAmountKey)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MinFillQuantity)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.AllOrNone {
+			n += 3
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -510,6 +769,76 @@ func (x *fastReflection_SellOrder) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.AllOrNone {
+			i--
+			if x.AllOrNone {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x90
+		}
+		if len(x.MinFillQuantity) > 0 {
+			i -= len(x.MinFillQuantity)
+			copy(dAtA[i:], x.MinFillQuantity)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinFillQuantity)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if len(x.AskAmountKey) > 0 {
+			i -= len(x.AskAmountKey)
+			copy(dAtA[i:], x.AskAmountKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AskAmountKey)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if x.BatchStartDate != nil {
+			encoded, err := options.Marshal(x.BatchStartDate)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if x.ProjectKey != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProjectKey))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.ClassKey != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ClassKey))
+			i--
+			dAtA[i] = 0x68
+		}
+		if len(x.BuyerGroupPolicy) > 0 {
+			i -= len(x.BuyerGroupPolicy)
+			copy(dAtA[i:], x.BuyerGroupPolicy)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BuyerGroupPolicy)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.AllowedBuyers) > 0 {
+			for iNdEx := len(x.AllowedBuyers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedBuyers[iNdEx])
+				copy(dAtA[i:], x.AllowedBuyers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedBuyers[iNdEx])))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
 		if x.Maker {
 			i--
 			if x.Maker {
@@ -860,20 +1189,246 @@ func (x *fastReflection_SellOrder) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.Maker = bool(v != 0)
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedBuyers", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedBuyers = append(x.AllowedBuyers, make([]byte, postIndex-iNdEx))
+				copy(x.AllowedBuyers[len(x.AllowedBuyers)-1], dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BuyerGroupPolicy", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BuyerGroupPolicy = append(x.BuyerGroupPolicy[:0], dAtA[iNdEx:postIndex]...)
+				if x.BuyerGroupPolicy == nil {
+					x.BuyerGroupPolicy = []byte{}
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
+				}
+				x.ClassKey = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ClassKey |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
+				}
+				x.ProjectKey = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProjectKey |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchStartDate", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BatchStartDate == nil {
+					x.BatchStartDate = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BatchStartDate); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AskAmountKey", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.AskAmountKey = append(x.AskAmountKey[:0], dAtA[iNdEx:postIndex]...)
+				if x.AskAmountKey == nil {
+					x.AskAmountKey = []byte{}
+				}
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinFillQuantity", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinFillQuantity = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 18:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllOrNone", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.AllOrNone = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -896,29 +1451,43 @@ func (x *fastReflection_SellOrder) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_AllowedDenom               protoreflect.MessageDescriptor
-	fd_AllowedDenom_bank_denom    protoreflect.FieldDescriptor
-	fd_AllowedDenom_display_denom protoreflect.FieldDescriptor
-	fd_AllowedDenom_exponent      protoreflect.FieldDescriptor
+	md_BuyOrder                         protoreflect.MessageDescriptor
+	fd_BuyOrder_id                      protoreflect.FieldDescriptor
+	fd_BuyOrder_buyer                   protoreflect.FieldDescriptor
+	fd_BuyOrder_filter                  protoreflect.FieldDescriptor
+	fd_BuyOrder_quantity                protoreflect.FieldDescriptor
+	fd_BuyOrder_market_id               protoreflect.FieldDescriptor
+	fd_BuyOrder_bid_amount              protoreflect.FieldDescriptor
+	fd_BuyOrder_disable_auto_retire     protoreflect.FieldDescriptor
+	fd_BuyOrder_retirement_jurisdiction protoreflect.FieldDescriptor
+	fd_BuyOrder_expiration              protoreflect.FieldDescriptor
+	fd_BuyOrder_taker_fee_bps           protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_regen_ecocredit_marketplace_v1_state_proto_init()
-	md_AllowedDenom = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("AllowedDenom")
-	fd_AllowedDenom_bank_denom = md_AllowedDenom.Fields().ByName("bank_denom")
-	fd_AllowedDenom_display_denom = md_AllowedDenom.Fields().ByName("display_denom")
-	fd_AllowedDenom_exponent = md_AllowedDenom.Fields().ByName("exponent")
+	md_BuyOrder = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("BuyOrder")
+	fd_BuyOrder_id = md_BuyOrder.Fields().ByName("id")
+	fd_BuyOrder_buyer = md_BuyOrder.Fields().ByName("buyer")
+	fd_BuyOrder_filter = md_BuyOrder.Fields().ByName("filter")
+	fd_BuyOrder_quantity = md_BuyOrder.Fields().ByName("quantity")
+	fd_BuyOrder_market_id = md_BuyOrder.Fields().ByName("market_id")
+	fd_BuyOrder_bid_amount = md_BuyOrder.Fields().ByName("bid_amount")
+	fd_BuyOrder_disable_auto_retire = md_BuyOrder.Fields().ByName("disable_auto_retire")
+	fd_BuyOrder_retirement_jurisdiction = md_BuyOrder.Fields().ByName("retirement_jurisdiction")
+	fd_BuyOrder_expiration = md_BuyOrder.Fields().ByName("expiration")
+	fd_BuyOrder_taker_fee_bps = md_BuyOrder.Fields().ByName("taker_fee_bps")
 }
 
-var _ protoreflect.Message = (*fastReflection_AllowedDenom)(nil)
+var _ protoreflect.Message = (*fastReflection_BuyOrder)(nil)
 
-type fastReflection_AllowedDenom AllowedDenom
+type fastReflection_BuyOrder BuyOrder
 
-func (x *AllowedDenom) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_AllowedDenom)(x)
+func (x *BuyOrder) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BuyOrder)(x)
 }
 
-func (x *AllowedDenom) slowProtoReflect() protoreflect.Message {
+func (x *BuyOrder) slowProtoReflect() protoreflect.Message {
 	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -930,43 +1499,43 @@ func (x *AllowedDenom) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_AllowedDenom_messageType fastReflection_AllowedDenom_messageType
-var _ protoreflect.MessageType = fastReflection_AllowedDenom_messageType{}
+var _fastReflection_BuyOrder_messageType fastReflection_BuyOrder_messageType
+var _ protoreflect.MessageType = fastReflection_BuyOrder_messageType{}
 
-type fastReflection_AllowedDenom_messageType struct{}
+type fastReflection_BuyOrder_messageType struct{}
 
-func (x fastReflection_AllowedDenom_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_AllowedDenom)(nil)
+func (x fastReflection_BuyOrder_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BuyOrder)(nil)
 }
-func (x fastReflection_AllowedDenom_messageType) New() protoreflect.Message {
-	return new(fastReflection_AllowedDenom)
+func (x fastReflection_BuyOrder_messageType) New() protoreflect.Message {
+	return new(fastReflection_BuyOrder)
 }
-func (x fastReflection_AllowedDenom_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_AllowedDenom
+func (x fastReflection_BuyOrder_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BuyOrder
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_AllowedDenom) Descriptor() protoreflect.MessageDescriptor {
-	return md_AllowedDenom
+func (x *fastReflection_BuyOrder) Descriptor() protoreflect.MessageDescriptor {
+	return md_BuyOrder
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_AllowedDenom) Type() protoreflect.MessageType {
-	return _fastReflection_AllowedDenom_messageType
+func (x *fastReflection_BuyOrder) Type() protoreflect.MessageType {
+	return _fastReflection_BuyOrder_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_AllowedDenom) New() protoreflect.Message {
-	return new(fastReflection_AllowedDenom)
+func (x *fastReflection_BuyOrder) New() protoreflect.Message {
+	return new(fastReflection_BuyOrder)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_AllowedDenom) Interface() protoreflect.ProtoMessage {
-	return (*AllowedDenom)(x)
+func (x *fastReflection_BuyOrder) Interface() protoreflect.ProtoMessage {
+	return (*BuyOrder)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -974,22 +1543,64 @@ func (x *fastReflection_AllowedDenom) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_AllowedDenom) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.BankDenom != "" {
-		value := protoreflect.ValueOfString(x.BankDenom)
-		if !f(fd_AllowedDenom_bank_denom, value) {
+func (x *fastReflection_BuyOrder) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_BuyOrder_id, value) {
 			return
 		}
 	}
-	if x.DisplayDenom != "" {
-		value := protoreflect.ValueOfString(x.DisplayDenom)
-		if !f(fd_AllowedDenom_display_denom, value) {
+	if len(x.Buyer) != 0 {
+		value := protoreflect.ValueOfBytes(x.Buyer)
+		if !f(fd_BuyOrder_buyer, value) {
 			return
 		}
 	}
-	if x.Exponent != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.Exponent)
-		if !f(fd_AllowedDenom_exponent, value) {
+	if x.Filter != nil {
+		value := protoreflect.ValueOfMessage(x.Filter.ProtoReflect())
+		if !f(fd_BuyOrder_filter, value) {
+			return
+		}
+	}
+	if x.Quantity != "" {
+		value := protoreflect.ValueOfString(x.Quantity)
+		if !f(fd_BuyOrder_quantity, value) {
+			return
+		}
+	}
+	if x.MarketId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MarketId)
+		if !f(fd_BuyOrder_market_id, value) {
+			return
+		}
+	}
+	if x.BidAmount != "" {
+		value := protoreflect.ValueOfString(x.BidAmount)
+		if !f(fd_BuyOrder_bid_amount, value) {
+			return
+		}
+	}
+	if x.DisableAutoRetire != false {
+		value := protoreflect.ValueOfBool(x.DisableAutoRetire)
+		if !f(fd_BuyOrder_disable_auto_retire, value) {
+			return
+		}
+	}
+	if x.RetirementJurisdiction != "" {
+		value := protoreflect.ValueOfString(x.RetirementJurisdiction)
+		if !f(fd_BuyOrder_retirement_jurisdiction, value) {
+			return
+		}
+	}
+	if x.Expiration != nil {
+		value := protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+		if !f(fd_BuyOrder_expiration, value) {
+			return
+		}
+	}
+	if x.TakerFeeBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.TakerFeeBps)
+		if !f(fd_BuyOrder_taker_fee_bps, value) {
 			return
 		}
 	}
@@ -1006,19 +1617,33 @@ func (x *fastReflection_AllowedDenom) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_AllowedDenom) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_BuyOrder) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.bank_denom":
-		return x.BankDenom != ""
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.display_denom":
-		return x.DisplayDenom != ""
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.exponent":
-		return x.Exponent != uint32(0)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.id":
+		return x.Id != uint64(0)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.buyer":
+		return len(x.Buyer) != 0
+	case "regen.ecocredit.marketplace.v1.BuyOrder.filter":
+		return x.Filter != nil
+	case "regen.ecocredit.marketplace.v1.BuyOrder.quantity":
+		return x.Quantity != ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.market_id":
+		return x.MarketId != uint64(0)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.bid_amount":
+		return x.BidAmount != ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.disable_auto_retire":
+		return x.DisableAutoRetire != false
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_jurisdiction":
+		return x.RetirementJurisdiction != ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.expiration":
+		return x.Expiration != nil
+	case "regen.ecocredit.marketplace.v1.BuyOrder.taker_fee_bps":
+		return x.TakerFeeBps != uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AllowedDenom"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AllowedDenom does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1028,19 +1653,33 @@ func (x *fastReflection_AllowedDenom) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AllowedDenom) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_BuyOrder) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.bank_denom":
-		x.BankDenom = ""
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.display_denom":
-		x.DisplayDenom = ""
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.exponent":
-		x.Exponent = uint32(0)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.id":
+		x.Id = uint64(0)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.buyer":
+		x.Buyer = nil
+	case "regen.ecocredit.marketplace.v1.BuyOrder.filter":
+		x.Filter = nil
+	case "regen.ecocredit.marketplace.v1.BuyOrder.quantity":
+		x.Quantity = ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.market_id":
+		x.MarketId = uint64(0)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.bid_amount":
+		x.BidAmount = ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.disable_auto_retire":
+		x.DisableAutoRetire = false
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_jurisdiction":
+		x.RetirementJurisdiction = ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.expiration":
+		x.Expiration = nil
+	case "regen.ecocredit.marketplace.v1.BuyOrder.taker_fee_bps":
+		x.TakerFeeBps = uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AllowedDenom"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AllowedDenom does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1050,22 +1689,43 @@ func (x *fastReflection_AllowedDenom) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_AllowedDenom) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BuyOrder) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.bank_denom":
-		value := x.BankDenom
+	case "regen.ecocredit.marketplace.v1.BuyOrder.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.buyer":
+		value := x.Buyer
+		return protoreflect.ValueOfBytes(value)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.filter":
+		value := x.Filter
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.quantity":
+		value := x.Quantity
 		return protoreflect.ValueOfString(value)
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.display_denom":
-		value := x.DisplayDenom
+	case "regen.ecocredit.marketplace.v1.BuyOrder.market_id":
+		value := x.MarketId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.bid_amount":
+		value := x.BidAmount
 		return protoreflect.ValueOfString(value)
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.exponent":
-		value := x.Exponent
+	case "regen.ecocredit.marketplace.v1.BuyOrder.disable_auto_retire":
+		value := x.DisableAutoRetire
+		return protoreflect.ValueOfBool(value)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_jurisdiction":
+		value := x.RetirementJurisdiction
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.expiration":
+		value := x.Expiration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.taker_fee_bps":
+		value := x.TakerFeeBps
 		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AllowedDenom"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AllowedDenom does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1079,19 +1739,33 @@ func (x *fastReflection_AllowedDenom) Get(descriptor protoreflect.FieldDescripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AllowedDenom) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_BuyOrder) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.bank_denom":
-		x.BankDenom = value.Interface().(string)
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.display_denom":
-		x.DisplayDenom = value.Interface().(string)
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.exponent":
-		x.Exponent = uint32(value.Uint())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.id":
+		x.Id = value.Uint()
+	case "regen.ecocredit.marketplace.v1.BuyOrder.buyer":
+		x.Buyer = value.Bytes()
+	case "regen.ecocredit.marketplace.v1.BuyOrder.filter":
+		x.Filter = value.Message().Interface().(*BuyOrder_Filter)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.quantity":
+		x.Quantity = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.market_id":
+		x.MarketId = value.Uint()
+	case "regen.ecocredit.marketplace.v1.BuyOrder.bid_amount":
+		x.BidAmount = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.disable_auto_retire":
+		x.DisableAutoRetire = value.Bool()
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_jurisdiction":
+		x.RetirementJurisdiction = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.expiration":
+		x.Expiration = value.Message().Interface().(*timestamppb.Timestamp)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.taker_fee_bps":
+		x.TakerFeeBps = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AllowedDenom"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AllowedDenom does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1105,48 +1779,84 @@ func (x *fastReflection_AllowedDenom) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AllowedDenom) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BuyOrder) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.bank_denom":
-		panic(fmt.Errorf("field bank_denom of message regen.ecocredit.marketplace.v1.AllowedDenom is not mutable"))
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.display_denom":
-		panic(fmt.Errorf("field display_denom of message regen.ecocredit.marketplace.v1.AllowedDenom is not mutable"))
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.exponent":
-		panic(fmt.Errorf("field exponent of message regen.ecocredit.marketplace.v1.AllowedDenom is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.filter":
+		if x.Filter == nil {
+			x.Filter = new(BuyOrder_Filter)
+		}
+		return protoreflect.ValueOfMessage(x.Filter.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.expiration":
+		if x.Expiration == nil {
+			x.Expiration = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.id":
+		panic(fmt.Errorf("field id of message regen.ecocredit.marketplace.v1.BuyOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.buyer":
+		panic(fmt.Errorf("field buyer of message regen.ecocredit.marketplace.v1.BuyOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.quantity":
+		panic(fmt.Errorf("field quantity of message regen.ecocredit.marketplace.v1.BuyOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.market_id":
+		panic(fmt.Errorf("field market_id of message regen.ecocredit.marketplace.v1.BuyOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.bid_amount":
+		panic(fmt.Errorf("field bid_amount of message regen.ecocredit.marketplace.v1.BuyOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.disable_auto_retire":
+		panic(fmt.Errorf("field disable_auto_retire of message regen.ecocredit.marketplace.v1.BuyOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_jurisdiction":
+		panic(fmt.Errorf("field retirement_jurisdiction of message regen.ecocredit.marketplace.v1.BuyOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.taker_fee_bps":
+		panic(fmt.Errorf("field taker_fee_bps of message regen.ecocredit.marketplace.v1.BuyOrder is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AllowedDenom"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AllowedDenom does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_AllowedDenom) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BuyOrder) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.bank_denom":
+	case "regen.ecocredit.marketplace.v1.BuyOrder.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.buyer":
+		return protoreflect.ValueOfBytes(nil)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.filter":
+		m := new(BuyOrder_Filter)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.quantity":
 		return protoreflect.ValueOfString("")
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.display_denom":
+	case "regen.ecocredit.marketplace.v1.BuyOrder.market_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.bid_amount":
 		return protoreflect.ValueOfString("")
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.exponent":
+	case "regen.ecocredit.marketplace.v1.BuyOrder.disable_auto_retire":
+		return protoreflect.ValueOfBool(false)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_jurisdiction":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.BuyOrder.expiration":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.taker_fee_bps":
 		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AllowedDenom"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AllowedDenom does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_AllowedDenom) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_BuyOrder) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.AllowedDenom", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.BuyOrder", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1154,7 +1864,7 @@ func (x *fastReflection_AllowedDenom) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_AllowedDenom) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_BuyOrder) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1165,7 +1875,7 @@ func (x *fastReflection_AllowedDenom) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AllowedDenom) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_BuyOrder) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1177,7 +1887,7 @@ func (x *fastReflection_AllowedDenom) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_AllowedDenom) IsValid() bool {
+func (x *fastReflection_BuyOrder) IsValid() bool {
 	return x != nil
 }
 
@@ -1187,9 +1897,9 @@ func (x *fastReflection_AllowedDenom) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_AllowedDenom) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_BuyOrder) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*AllowedDenom)
+		x := input.Message.Interface().(*BuyOrder)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1201,28 +1911,53 @@ func (x *fastReflection_AllowedDenom) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.BankDenom)
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Buyer)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.DisplayDenom)
+		if x.Filter != nil {
+			l = options.Size(x.Filter)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Quantity)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Exponent != 0 {
-			n += 1 + runtime.Sov(uint64(x.Exponent))
+		if x.MarketId != 0 {
+			n += 1 + runtime.Sov(uint64(x.MarketId))
 		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
+		l = len(x.BidAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
+		if x.DisableAutoRetire {
+			n += 2
 		}
-	}
-
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*AllowedDenom)
+		l = len(x.RetirementJurisdiction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Expiration != nil {
+			l = options.Size(x.Expiration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TakerFeeBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.TakerFeeBps))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BuyOrder)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1241,24 +1976,86 @@ func (x *fastReflection_AllowedDenom) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Exponent != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Exponent))
+		if x.TakerFeeBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TakerFeeBps))
 			i--
-			dAtA[i] = 0x18
+			dAtA[i] = 0x50
 		}
-		if len(x.DisplayDenom) > 0 {
-			i -= len(x.DisplayDenom)
-			copy(dAtA[i:], x.DisplayDenom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DisplayDenom)))
+		if x.Expiration != nil {
+			encoded, err := options.Marshal(x.Expiration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.RetirementJurisdiction) > 0 {
+			i -= len(x.RetirementJurisdiction)
+			copy(dAtA[i:], x.RetirementJurisdiction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RetirementJurisdiction)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.DisableAutoRetire {
+			i--
+			if x.DisableAutoRetire {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.BidAmount) > 0 {
+			i -= len(x.BidAmount)
+			copy(dAtA[i:], x.BidAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BidAmount)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.MarketId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MarketId))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Quantity) > 0 {
+			i -= len(x.Quantity)
+			copy(dAtA[i:], x.Quantity)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Quantity)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Filter != nil {
+			encoded, err := options.Marshal(x.Filter)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Buyer) > 0 {
+			i -= len(x.Buyer)
+			copy(dAtA[i:], x.Buyer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Buyer)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.BankDenom) > 0 {
-			i -= len(x.BankDenom)
-			copy(dAtA[i:], x.BankDenom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BankDenom)))
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1271,7 +2068,7 @@ func (x *fastReflection_AllowedDenom) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*AllowedDenom)
+		x := input.Message.Interface().(*BuyOrder)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1303,15 +2100,104 @@ func (x *fastReflection_AllowedDenom) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AllowedDenom: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BuyOrder: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AllowedDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BuyOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Buyer = append(x.Buyer[:0], dAtA[iNdEx:postIndex]...)
+				if x.Buyer == nil {
+					x.Buyer = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Filter == nil {
+					x.Filter = &BuyOrder_Filter{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Filter); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1339,11 +2225,30 @@ func (x *fastReflection_AllowedDenom) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BankDenom = string(dAtA[iNdEx:postIndex])
+				x.Quantity = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				}
+				x.MarketId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MarketId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisplayDenom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BidAmount", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1371,13 +2276,13 @@ func (x *fastReflection_AllowedDenom) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.DisplayDenom = string(dAtA[iNdEx:postIndex])
+				x.BidAmount = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
+			case 7:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
 				}
-				x.Exponent = 0
+				var v int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1387,39 +2292,127 @@ func (x *fastReflection_AllowedDenom) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Exponent |= uint32(b&0x7F) << shift
+					v |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.DisableAutoRetire = bool(v != 0)
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetirementJurisdiction", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.RetirementJurisdiction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Expiration == nil {
+					x.Expiration = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TakerFeeBps", wireType)
+				}
+				x.TakerFeeBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TakerFeeBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
 		Marshal:           marshal,
 		Unmarshal:         unmarshal,
 		Merge:             nil,
@@ -1427,33 +2420,78 @@ func (x *fastReflection_AllowedDenom) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_BuyOrder_Filter_1_list)(nil)
+
+type _BuyOrder_Filter_1_list struct {
+	list *[]*BuyOrder_Filter_Criteria
+}
+
+func (x *_BuyOrder_Filter_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_BuyOrder_Filter_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_BuyOrder_Filter_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BuyOrder_Filter_Criteria)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_BuyOrder_Filter_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BuyOrder_Filter_Criteria)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_BuyOrder_Filter_1_list) AppendMutable() protoreflect.Value {
+	v := new(BuyOrder_Filter_Criteria)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BuyOrder_Filter_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_BuyOrder_Filter_1_list) NewElement() protoreflect.Value {
+	v := new(BuyOrder_Filter_Criteria)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BuyOrder_Filter_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Market                    protoreflect.MessageDescriptor
-	fd_Market_id                 protoreflect.FieldDescriptor
-	fd_Market_credit_type_abbrev protoreflect.FieldDescriptor
-	fd_Market_bank_denom         protoreflect.FieldDescriptor
-	fd_Market_precision_modifier protoreflect.FieldDescriptor
+	md_BuyOrder_Filter    protoreflect.MessageDescriptor
+	fd_BuyOrder_Filter_or protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_regen_ecocredit_marketplace_v1_state_proto_init()
-	md_Market = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("Market")
-	fd_Market_id = md_Market.Fields().ByName("id")
-	fd_Market_credit_type_abbrev = md_Market.Fields().ByName("credit_type_abbrev")
-	fd_Market_bank_denom = md_Market.Fields().ByName("bank_denom")
-	fd_Market_precision_modifier = md_Market.Fields().ByName("precision_modifier")
+	md_BuyOrder_Filter = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("BuyOrder").Messages().ByName("Filter")
+	fd_BuyOrder_Filter_or = md_BuyOrder_Filter.Fields().ByName("or")
 }
 
-var _ protoreflect.Message = (*fastReflection_Market)(nil)
+var _ protoreflect.Message = (*fastReflection_BuyOrder_Filter)(nil)
 
-type fastReflection_Market Market
+type fastReflection_BuyOrder_Filter BuyOrder_Filter
 
-func (x *Market) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Market)(x)
+func (x *BuyOrder_Filter) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BuyOrder_Filter)(x)
 }
 
-func (x *Market) slowProtoReflect() protoreflect.Message {
-	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[2]
+func (x *BuyOrder_Filter) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1464,43 +2502,43 @@ func (x *Market) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Market_messageType fastReflection_Market_messageType
-var _ protoreflect.MessageType = fastReflection_Market_messageType{}
+var _fastReflection_BuyOrder_Filter_messageType fastReflection_BuyOrder_Filter_messageType
+var _ protoreflect.MessageType = fastReflection_BuyOrder_Filter_messageType{}
 
-type fastReflection_Market_messageType struct{}
+type fastReflection_BuyOrder_Filter_messageType struct{}
 
-func (x fastReflection_Market_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Market)(nil)
+func (x fastReflection_BuyOrder_Filter_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BuyOrder_Filter)(nil)
 }
-func (x fastReflection_Market_messageType) New() protoreflect.Message {
-	return new(fastReflection_Market)
+func (x fastReflection_BuyOrder_Filter_messageType) New() protoreflect.Message {
+	return new(fastReflection_BuyOrder_Filter)
 }
-func (x fastReflection_Market_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Market
+func (x fastReflection_BuyOrder_Filter_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BuyOrder_Filter
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Market) Descriptor() protoreflect.MessageDescriptor {
-	return md_Market
+func (x *fastReflection_BuyOrder_Filter) Descriptor() protoreflect.MessageDescriptor {
+	return md_BuyOrder_Filter
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Market) Type() protoreflect.MessageType {
-	return _fastReflection_Market_messageType
+func (x *fastReflection_BuyOrder_Filter) Type() protoreflect.MessageType {
+	return _fastReflection_BuyOrder_Filter_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Market) New() protoreflect.Message {
-	return new(fastReflection_Market)
+func (x *fastReflection_BuyOrder_Filter) New() protoreflect.Message {
+	return new(fastReflection_BuyOrder_Filter)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Market) Interface() protoreflect.ProtoMessage {
-	return (*Market)(x)
+func (x *fastReflection_BuyOrder_Filter) Interface() protoreflect.ProtoMessage {
+	return (*BuyOrder_Filter)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1508,28 +2546,10 @@ func (x *fastReflection_Market) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Market) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Id != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Id)
-		if !f(fd_Market_id, value) {
-			return
-		}
-	}
-	if x.CreditTypeAbbrev != "" {
-		value := protoreflect.ValueOfString(x.CreditTypeAbbrev)
-		if !f(fd_Market_credit_type_abbrev, value) {
-			return
-		}
-	}
-	if x.BankDenom != "" {
-		value := protoreflect.ValueOfString(x.BankDenom)
-		if !f(fd_Market_bank_denom, value) {
-			return
-		}
-	}
-	if x.PrecisionModifier != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.PrecisionModifier)
-		if !f(fd_Market_precision_modifier, value) {
+func (x *fastReflection_BuyOrder_Filter) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Or) != 0 {
+		value := protoreflect.ValueOfList(&_BuyOrder_Filter_1_list{list: &x.Or})
+		if !f(fd_BuyOrder_Filter_or, value) {
 			return
 		}
 	}
@@ -1546,21 +2566,15 @@ func (x *fastReflection_Market) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Market) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_BuyOrder_Filter) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "regen.ecocredit.marketplace.v1.Market.id":
-		return x.Id != uint64(0)
-	case "regen.ecocredit.marketplace.v1.Market.credit_type_abbrev":
-		return x.CreditTypeAbbrev != ""
-	case "regen.ecocredit.marketplace.v1.Market.bank_denom":
-		return x.BankDenom != ""
-	case "regen.ecocredit.marketplace.v1.Market.precision_modifier":
-		return x.PrecisionModifier != uint32(0)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Filter.or":
+		return len(x.Or) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Market"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder.Filter"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Market does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder.Filter does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1570,21 +2584,15 @@ func (x *fastReflection_Market) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Market) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_BuyOrder_Filter) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "regen.ecocredit.marketplace.v1.Market.id":
-		x.Id = uint64(0)
-	case "regen.ecocredit.marketplace.v1.Market.credit_type_abbrev":
-		x.CreditTypeAbbrev = ""
-	case "regen.ecocredit.marketplace.v1.Market.bank_denom":
-		x.BankDenom = ""
-	case "regen.ecocredit.marketplace.v1.Market.precision_modifier":
-		x.PrecisionModifier = uint32(0)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Filter.or":
+		x.Or = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Market"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder.Filter"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Market does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder.Filter does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1594,25 +2602,19 @@ func (x *fastReflection_Market) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Market) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BuyOrder_Filter) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "regen.ecocredit.marketplace.v1.Market.id":
-		value := x.Id
-		return protoreflect.ValueOfUint64(value)
-	case "regen.ecocredit.marketplace.v1.Market.credit_type_abbrev":
-		value := x.CreditTypeAbbrev
-		return protoreflect.ValueOfString(value)
-	case "regen.ecocredit.marketplace.v1.Market.bank_denom":
-		value := x.BankDenom
-		return protoreflect.ValueOfString(value)
-	case "regen.ecocredit.marketplace.v1.Market.precision_modifier":
-		value := x.PrecisionModifier
-		return protoreflect.ValueOfUint32(value)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Filter.or":
+		if len(x.Or) == 0 {
+			return protoreflect.ValueOfList(&_BuyOrder_Filter_1_list{})
+		}
+		listValue := &_BuyOrder_Filter_1_list{list: &x.Or}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Market"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder.Filter"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Market does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder.Filter does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1626,21 +2628,17 @@ func (x *fastReflection_Market) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Market) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_BuyOrder_Filter) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "regen.ecocredit.marketplace.v1.Market.id":
-		x.Id = value.Uint()
-	case "regen.ecocredit.marketplace.v1.Market.credit_type_abbrev":
-		x.CreditTypeAbbrev = value.Interface().(string)
-	case "regen.ecocredit.marketplace.v1.Market.bank_denom":
-		x.BankDenom = value.Interface().(string)
-	case "regen.ecocredit.marketplace.v1.Market.precision_modifier":
-		x.PrecisionModifier = uint32(value.Uint())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Filter.or":
+		lv := value.List()
+		clv := lv.(*_BuyOrder_Filter_1_list)
+		x.Or = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Market"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder.Filter"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Market does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder.Filter does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1654,52 +2652,45 @@ func (x *fastReflection_Market) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Market) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BuyOrder_Filter) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.marketplace.v1.Market.id":
-		panic(fmt.Errorf("field id of message regen.ecocredit.marketplace.v1.Market is not mutable"))
-	case "regen.ecocredit.marketplace.v1.Market.credit_type_abbrev":
-		panic(fmt.Errorf("field credit_type_abbrev of message regen.ecocredit.marketplace.v1.Market is not mutable"))
-	case "regen.ecocredit.marketplace.v1.Market.bank_denom":
-		panic(fmt.Errorf("field bank_denom of message regen.ecocredit.marketplace.v1.Market is not mutable"))
-	case "regen.ecocredit.marketplace.v1.Market.precision_modifier":
-		panic(fmt.Errorf("field precision_modifier of message regen.ecocredit.marketplace.v1.Market is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Filter.or":
+		if x.Or == nil {
+			x.Or = []*BuyOrder_Filter_Criteria{}
+		}
+		value := &_BuyOrder_Filter_1_list{list: &x.Or}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Market"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder.Filter"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Market does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder.Filter does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Market) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BuyOrder_Filter) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.marketplace.v1.Market.id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "regen.ecocredit.marketplace.v1.Market.credit_type_abbrev":
-		return protoreflect.ValueOfString("")
-	case "regen.ecocredit.marketplace.v1.Market.bank_denom":
-		return protoreflect.ValueOfString("")
-	case "regen.ecocredit.marketplace.v1.Market.precision_modifier":
-		return protoreflect.ValueOfUint32(uint32(0))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Filter.or":
+		list := []*BuyOrder_Filter_Criteria{}
+		return protoreflect.ValueOfList(&_BuyOrder_Filter_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Market"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder.Filter"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Market does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder.Filter does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Market) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_BuyOrder_Filter) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.Market", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.BuyOrder.Filter", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1707,7 +2698,7 @@ func (x *fastReflection_Market) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Market) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_BuyOrder_Filter) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1718,7 +2709,7 @@ func (x *fastReflection_Market) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Market) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_BuyOrder_Filter) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1730,7 +2721,7 @@ func (x *fastReflection_Market) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Market) IsValid() bool {
+func (x *fastReflection_BuyOrder_Filter) IsValid() bool {
 	return x != nil
 }
 
@@ -1740,9 +2731,9 @@ func (x *fastReflection_Market) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Market) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_BuyOrder_Filter) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Market)
+		x := input.Message.Interface().(*BuyOrder_Filter)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1754,19 +2745,11 @@ func (x *fastReflection_Market) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Id != 0 {
-			n += 1 + runtime.Sov(uint64(x.Id))
-		}
-		l = len(x.CreditTypeAbbrev)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.BankDenom)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.PrecisionModifier != 0 {
-			n += 1 + runtime.Sov(uint64(x.PrecisionModifier))
+		if len(x.Or) > 0 {
+			for _, e := range x.Or {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1778,7 +2761,7 @@ func (x *fastReflection_Market) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Market)
+		x := input.Message.Interface().(*BuyOrder_Filter)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1797,29 +2780,21 @@ func (x *fastReflection_Market) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.PrecisionModifier != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.PrecisionModifier))
-			i--
-			dAtA[i] = 0x20
-		}
-		if len(x.BankDenom) > 0 {
-			i -= len(x.BankDenom)
-			copy(dAtA[i:], x.BankDenom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BankDenom)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.CreditTypeAbbrev) > 0 {
-			i -= len(x.CreditTypeAbbrev)
-			copy(dAtA[i:], x.CreditTypeAbbrev)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CreditTypeAbbrev)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if x.Id != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
-			i--
-			dAtA[i] = 0x8
+		if len(x.Or) > 0 {
+			for iNdEx := len(x.Or) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Or[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1832,7 +2807,7 @@ func (x *fastReflection_Market) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Market)
+		x := input.Message.Interface().(*BuyOrder_Filter)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1864,36 +2839,17 @@ func (x *fastReflection_Market) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Market: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BuyOrder_Filter: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Market: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BuyOrder_Filter: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-				}
-				x.Id = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Id |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Or", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1903,75 +2859,26 @@ func (x *fastReflection_Market) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				x.Or = append(x.Or, &BuyOrder_Filter_Criteria{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Or[len(x.Or)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.BankDenom = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrecisionModifier", wireType)
-				}
-				x.PrecisionModifier = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.PrecisionModifier |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2007,291 +2914,15617 @@ func (x *fastReflection_Market) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: regen/ecocredit/marketplace/v1/state.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_BuyOrder_Filter_Criteria                  protoreflect.MessageDescriptor
+	fd_BuyOrder_Filter_Criteria_class_selector   protoreflect.FieldDescriptor
+	fd_BuyOrder_Filter_Criteria_project_selector protoreflect.FieldDescriptor
+	fd_BuyOrder_Filter_Criteria_batch_selector   protoreflect.FieldDescriptor
 )
 
-// SellOrder represents the information for a sell order.
-type SellOrder struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_BuyOrder_Filter_Criteria = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("BuyOrder").Messages().ByName("Filter").Messages().ByName("Criteria")
+	fd_BuyOrder_Filter_Criteria_class_selector = md_BuyOrder_Filter_Criteria.Fields().ByName("class_selector")
+	fd_BuyOrder_Filter_Criteria_project_selector = md_BuyOrder_Filter_Criteria.Fields().ByName("project_selector")
+	fd_BuyOrder_Filter_Criteria_batch_selector = md_BuyOrder_Filter_Criteria.Fields().ByName("batch_selector")
+}
 
-	// id is the unique ID of sell order.
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// seller is the address of the account that is selling credits.
-	Seller []byte `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
-	// batch_key is the table row identifier of the credit batch used internally
-	// for efficient lookups. This links a sell order to a credit batch.
-	BatchKey uint64 `protobuf:"varint,3,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
-	// quantity is the decimal quantity of credits being sold.
-	Quantity string `protobuf:"bytes,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	// market_id is the market in which this sell order exists and specifies
-	// the bank_denom that ask_amount corresponds to forming the ask_price.
-	MarketId uint64 `protobuf:"varint,5,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// ask_amount is the integer amount (encoded as a string) that the seller is
-	// asking for each credit unit of the batch. Each credit unit of the batch
-	// will be sold for at least the ask_amount. The ask_amount corresponds to the
-	// Market.denom to form the ask price.
-	AskAmount string `protobuf:"bytes,6,opt,name=ask_amount,json=askAmount,proto3" json:"ask_amount,omitempty"`
-	// disable_auto_retire disables auto-retirement of credits which allows a
-	// buyer to disable auto-retirement in their buy order enabling them to
-	// resell the credits to another buyer.
-	DisableAutoRetire bool `protobuf:"varint,7,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
-	// expiration is an optional timestamp when the sell order expires. When the
-	// expiration time is reached, the sell order is removed from state.
-	Expiration *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expiration,proto3" json:"expiration,omitempty"`
-	// maker indicates that this is a maker order, meaning that when it hit
-	// the order book, there were no matching buy orders.
-	Maker bool `protobuf:"varint,10,opt,name=maker,proto3" json:"maker,omitempty"`
+var _ protoreflect.Message = (*fastReflection_BuyOrder_Filter_Criteria)(nil)
+
+type fastReflection_BuyOrder_Filter_Criteria BuyOrder_Filter_Criteria
+
+func (x *BuyOrder_Filter_Criteria) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BuyOrder_Filter_Criteria)(x)
 }
 
-func (x *SellOrder) Reset() {
-	*x = SellOrder{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[0]
+func (x *BuyOrder_Filter_Criteria) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *SellOrder) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_BuyOrder_Filter_Criteria_messageType fastReflection_BuyOrder_Filter_Criteria_messageType
+var _ protoreflect.MessageType = fastReflection_BuyOrder_Filter_Criteria_messageType{}
 
-func (*SellOrder) ProtoMessage() {}
+type fastReflection_BuyOrder_Filter_Criteria_messageType struct{}
 
-// Deprecated: Use SellOrder.ProtoReflect.Descriptor instead.
-func (*SellOrder) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_BuyOrder_Filter_Criteria_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BuyOrder_Filter_Criteria)(nil)
 }
-
-func (x *SellOrder) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+func (x fastReflection_BuyOrder_Filter_Criteria_messageType) New() protoreflect.Message {
+	return new(fastReflection_BuyOrder_Filter_Criteria)
+}
+func (x fastReflection_BuyOrder_Filter_Criteria_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BuyOrder_Filter_Criteria
 }
 
-func (x *SellOrder) GetSeller() []byte {
-	if x != nil {
-		return x.Seller
-	}
-	return nil
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BuyOrder_Filter_Criteria) Descriptor() protoreflect.MessageDescriptor {
+	return md_BuyOrder_Filter_Criteria
 }
 
-func (x *SellOrder) GetBatchKey() uint64 {
-	if x != nil {
-		return x.BatchKey
-	}
-	return 0
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BuyOrder_Filter_Criteria) Type() protoreflect.MessageType {
+	return _fastReflection_BuyOrder_Filter_Criteria_messageType
 }
 
-func (x *SellOrder) GetQuantity() string {
-	if x != nil {
-		return x.Quantity
-	}
-	return ""
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BuyOrder_Filter_Criteria) New() protoreflect.Message {
+	return new(fastReflection_BuyOrder_Filter_Criteria)
 }
 
-func (x *SellOrder) GetMarketId() uint64 {
-	if x != nil {
-		return x.MarketId
-	}
-	return 0
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BuyOrder_Filter_Criteria) Interface() protoreflect.ProtoMessage {
+	return (*BuyOrder_Filter_Criteria)(x)
 }
 
-func (x *SellOrder) GetAskAmount() string {
-	if x != nil {
-		return x.AskAmount
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BuyOrder_Filter_Criteria) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sum != nil {
+		switch o := x.Sum.(type) {
+		case *BuyOrder_Filter_Criteria_ClassSelector:
+			v := o.ClassSelector
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_BuyOrder_Filter_Criteria_class_selector, value) {
+				return
+			}
+		case *BuyOrder_Filter_Criteria_ProjectSelector:
+			v := o.ProjectSelector
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_BuyOrder_Filter_Criteria_project_selector, value) {
+				return
+			}
+		case *BuyOrder_Filter_Criteria_BatchSelector:
+			v := o.BatchSelector
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_BuyOrder_Filter_Criteria_batch_selector, value) {
+				return
+			}
+		}
 	}
-	return ""
 }
 
-func (x *SellOrder) GetDisableAutoRetire() bool {
-	if x != nil {
-		return x.DisableAutoRetire
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BuyOrder_Filter_Criteria) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria.class_selector":
+		if x.Sum == nil {
+			return false
+		} else if _, ok := x.Sum.(*BuyOrder_Filter_Criteria_ClassSelector); ok {
+			return true
+		} else {
+			return false
+		}
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria.project_selector":
+		if x.Sum == nil {
+			return false
+		} else if _, ok := x.Sum.(*BuyOrder_Filter_Criteria_ProjectSelector); ok {
+			return true
+		} else {
+			return false
+		}
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria.batch_selector":
+		if x.Sum == nil {
+			return false
+		} else if _, ok := x.Sum.(*BuyOrder_Filter_Criteria_BatchSelector); ok {
+			return true
+		} else {
+			return false
+		}
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria does not contain field %s", fd.FullName()))
 	}
-	return false
 }
 
-func (x *SellOrder) GetExpiration() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Expiration
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BuyOrder_Filter_Criteria) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria.class_selector":
+		x.Sum = nil
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria.project_selector":
+		x.Sum = nil
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria.batch_selector":
+		x.Sum = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria does not contain field %s", fd.FullName()))
 	}
-	return nil
 }
 
-func (x *SellOrder) GetMaker() bool {
-	if x != nil {
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BuyOrder_Filter_Criteria) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria.class_selector":
+		if x.Sum == nil {
+			return protoreflect.ValueOfMessage((*BuyOrder_ClassSelector)(nil).ProtoReflect())
+		} else if v, ok := x.Sum.(*BuyOrder_Filter_Criteria_ClassSelector); ok {
+			return protoreflect.ValueOfMessage(v.ClassSelector.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*BuyOrder_ClassSelector)(nil).ProtoReflect())
+		}
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria.project_selector":
+		if x.Sum == nil {
+			return protoreflect.ValueOfMessage((*BuyOrder_ProjectSelector)(nil).ProtoReflect())
+		} else if v, ok := x.Sum.(*BuyOrder_Filter_Criteria_ProjectSelector); ok {
+			return protoreflect.ValueOfMessage(v.ProjectSelector.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*BuyOrder_ProjectSelector)(nil).ProtoReflect())
+		}
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria.batch_selector":
+		if x.Sum == nil {
+			return protoreflect.ValueOfMessage((*BuyOrder_BatchSelector)(nil).ProtoReflect())
+		} else if v, ok := x.Sum.(*BuyOrder_Filter_Criteria_BatchSelector); ok {
+			return protoreflect.ValueOfMessage(v.BatchSelector.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*BuyOrder_BatchSelector)(nil).ProtoReflect())
+		}
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BuyOrder_Filter_Criteria) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria.class_selector":
+		cv := value.Message().Interface().(*BuyOrder_ClassSelector)
+		x.Sum = &BuyOrder_Filter_Criteria_ClassSelector{ClassSelector: cv}
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria.project_selector":
+		cv := value.Message().Interface().(*BuyOrder_ProjectSelector)
+		x.Sum = &BuyOrder_Filter_Criteria_ProjectSelector{ProjectSelector: cv}
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria.batch_selector":
+		cv := value.Message().Interface().(*BuyOrder_BatchSelector)
+		x.Sum = &BuyOrder_Filter_Criteria_BatchSelector{BatchSelector: cv}
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BuyOrder_Filter_Criteria) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria.class_selector":
+		if x.Sum == nil {
+			value := &BuyOrder_ClassSelector{}
+			oneofValue := &BuyOrder_Filter_Criteria_ClassSelector{ClassSelector: value}
+			x.Sum = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Sum.(type) {
+		case *BuyOrder_Filter_Criteria_ClassSelector:
+			return protoreflect.ValueOfMessage(m.ClassSelector.ProtoReflect())
+		default:
+			value := &BuyOrder_ClassSelector{}
+			oneofValue := &BuyOrder_Filter_Criteria_ClassSelector{ClassSelector: value}
+			x.Sum = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria.project_selector":
+		if x.Sum == nil {
+			value := &BuyOrder_ProjectSelector{}
+			oneofValue := &BuyOrder_Filter_Criteria_ProjectSelector{ProjectSelector: value}
+			x.Sum = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Sum.(type) {
+		case *BuyOrder_Filter_Criteria_ProjectSelector:
+			return protoreflect.ValueOfMessage(m.ProjectSelector.ProtoReflect())
+		default:
+			value := &BuyOrder_ProjectSelector{}
+			oneofValue := &BuyOrder_Filter_Criteria_ProjectSelector{ProjectSelector: value}
+			x.Sum = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria.batch_selector":
+		if x.Sum == nil {
+			value := &BuyOrder_BatchSelector{}
+			oneofValue := &BuyOrder_Filter_Criteria_BatchSelector{BatchSelector: value}
+			x.Sum = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Sum.(type) {
+		case *BuyOrder_Filter_Criteria_BatchSelector:
+			return protoreflect.ValueOfMessage(m.BatchSelector.ProtoReflect())
+		default:
+			value := &BuyOrder_BatchSelector{}
+			oneofValue := &BuyOrder_Filter_Criteria_BatchSelector{BatchSelector: value}
+			x.Sum = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BuyOrder_Filter_Criteria) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria.class_selector":
+		value := &BuyOrder_ClassSelector{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria.project_selector":
+		value := &BuyOrder_ProjectSelector{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria.batch_selector":
+		value := &BuyOrder_BatchSelector{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BuyOrder_Filter_Criteria) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria.sum":
+		if x.Sum == nil {
+			return nil
+		}
+		switch x.Sum.(type) {
+		case *BuyOrder_Filter_Criteria_ClassSelector:
+			return x.Descriptor().Fields().ByName("class_selector")
+		case *BuyOrder_Filter_Criteria_ProjectSelector:
+			return x.Descriptor().Fields().ByName("project_selector")
+		case *BuyOrder_Filter_Criteria_BatchSelector:
+			return x.Descriptor().Fields().ByName("batch_selector")
+		}
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BuyOrder_Filter_Criteria) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BuyOrder_Filter_Criteria) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BuyOrder_Filter_Criteria) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BuyOrder_Filter_Criteria) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BuyOrder_Filter_Criteria)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		switch x := x.Sum.(type) {
+		case *BuyOrder_Filter_Criteria_ClassSelector:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.ClassSelector)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *BuyOrder_Filter_Criteria_ProjectSelector:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.ProjectSelector)
+			n += 1 + l + runtime.Sov(uint64(l))
+		case *BuyOrder_Filter_Criteria_BatchSelector:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.BatchSelector)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BuyOrder_Filter_Criteria)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		switch x := x.Sum.(type) {
+		case *BuyOrder_Filter_Criteria_ClassSelector:
+			encoded, err := options.Marshal(x.ClassSelector)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		case *BuyOrder_Filter_Criteria_ProjectSelector:
+			encoded, err := options.Marshal(x.ProjectSelector)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		case *BuyOrder_Filter_Criteria_BatchSelector:
+			encoded, err := options.Marshal(x.BatchSelector)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BuyOrder_Filter_Criteria)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BuyOrder_Filter_Criteria: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BuyOrder_Filter_Criteria: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassSelector", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &BuyOrder_ClassSelector{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Sum = &BuyOrder_Filter_Criteria_ClassSelector{v}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProjectSelector", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &BuyOrder_ProjectSelector{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Sum = &BuyOrder_Filter_Criteria_ProjectSelector{v}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchSelector", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &BuyOrder_BatchSelector{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Sum = &BuyOrder_Filter_Criteria_BatchSelector{v}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_BuyOrder_ClassSelector                      protoreflect.MessageDescriptor
+	fd_BuyOrder_ClassSelector_class_id             protoreflect.FieldDescriptor
+	fd_BuyOrder_ClassSelector_project_jurisdiction protoreflect.FieldDescriptor
+	fd_BuyOrder_ClassSelector_min_start_date       protoreflect.FieldDescriptor
+	fd_BuyOrder_ClassSelector_max_end_date         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_BuyOrder_ClassSelector = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("BuyOrder").Messages().ByName("ClassSelector")
+	fd_BuyOrder_ClassSelector_class_id = md_BuyOrder_ClassSelector.Fields().ByName("class_id")
+	fd_BuyOrder_ClassSelector_project_jurisdiction = md_BuyOrder_ClassSelector.Fields().ByName("project_jurisdiction")
+	fd_BuyOrder_ClassSelector_min_start_date = md_BuyOrder_ClassSelector.Fields().ByName("min_start_date")
+	fd_BuyOrder_ClassSelector_max_end_date = md_BuyOrder_ClassSelector.Fields().ByName("max_end_date")
+}
+
+var _ protoreflect.Message = (*fastReflection_BuyOrder_ClassSelector)(nil)
+
+type fastReflection_BuyOrder_ClassSelector BuyOrder_ClassSelector
+
+func (x *BuyOrder_ClassSelector) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BuyOrder_ClassSelector)(x)
+}
+
+func (x *BuyOrder_ClassSelector) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BuyOrder_ClassSelector_messageType fastReflection_BuyOrder_ClassSelector_messageType
+var _ protoreflect.MessageType = fastReflection_BuyOrder_ClassSelector_messageType{}
+
+type fastReflection_BuyOrder_ClassSelector_messageType struct{}
+
+func (x fastReflection_BuyOrder_ClassSelector_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BuyOrder_ClassSelector)(nil)
+}
+func (x fastReflection_BuyOrder_ClassSelector_messageType) New() protoreflect.Message {
+	return new(fastReflection_BuyOrder_ClassSelector)
+}
+func (x fastReflection_BuyOrder_ClassSelector_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BuyOrder_ClassSelector
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BuyOrder_ClassSelector) Descriptor() protoreflect.MessageDescriptor {
+	return md_BuyOrder_ClassSelector
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BuyOrder_ClassSelector) Type() protoreflect.MessageType {
+	return _fastReflection_BuyOrder_ClassSelector_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BuyOrder_ClassSelector) New() protoreflect.Message {
+	return new(fastReflection_BuyOrder_ClassSelector)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BuyOrder_ClassSelector) Interface() protoreflect.ProtoMessage {
+	return (*BuyOrder_ClassSelector)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BuyOrder_ClassSelector) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ClassId != "" {
+		value := protoreflect.ValueOfString(x.ClassId)
+		if !f(fd_BuyOrder_ClassSelector_class_id, value) {
+			return
+		}
+	}
+	if x.ProjectJurisdiction != "" {
+		value := protoreflect.ValueOfString(x.ProjectJurisdiction)
+		if !f(fd_BuyOrder_ClassSelector_project_jurisdiction, value) {
+			return
+		}
+	}
+	if x.MinStartDate != nil {
+		value := protoreflect.ValueOfMessage(x.MinStartDate.ProtoReflect())
+		if !f(fd_BuyOrder_ClassSelector_min_start_date, value) {
+			return
+		}
+	}
+	if x.MaxEndDate != nil {
+		value := protoreflect.ValueOfMessage(x.MaxEndDate.ProtoReflect())
+		if !f(fd_BuyOrder_ClassSelector_max_end_date, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BuyOrder_ClassSelector) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector.class_id":
+		return x.ClassId != ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector.project_jurisdiction":
+		return x.ProjectJurisdiction != ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector.min_start_date":
+		return x.MinStartDate != nil
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector.max_end_date":
+		return x.MaxEndDate != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BuyOrder_ClassSelector) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector.class_id":
+		x.ClassId = ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector.project_jurisdiction":
+		x.ProjectJurisdiction = ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector.min_start_date":
+		x.MinStartDate = nil
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector.max_end_date":
+		x.MaxEndDate = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BuyOrder_ClassSelector) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector.class_id":
+		value := x.ClassId
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector.project_jurisdiction":
+		value := x.ProjectJurisdiction
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector.min_start_date":
+		value := x.MinStartDate
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector.max_end_date":
+		value := x.MaxEndDate
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BuyOrder_ClassSelector) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector.class_id":
+		x.ClassId = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector.project_jurisdiction":
+		x.ProjectJurisdiction = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector.min_start_date":
+		x.MinStartDate = value.Message().Interface().(*timestamppb.Timestamp)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector.max_end_date":
+		x.MaxEndDate = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BuyOrder_ClassSelector) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector.min_start_date":
+		if x.MinStartDate == nil {
+			x.MinStartDate = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.MinStartDate.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector.max_end_date":
+		if x.MaxEndDate == nil {
+			x.MaxEndDate = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.MaxEndDate.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector.class_id":
+		panic(fmt.Errorf("field class_id of message regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector.project_jurisdiction":
+		panic(fmt.Errorf("field project_jurisdiction of message regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BuyOrder_ClassSelector) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector.class_id":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector.project_jurisdiction":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector.min_start_date":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector.max_end_date":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BuyOrder_ClassSelector) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BuyOrder_ClassSelector) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BuyOrder_ClassSelector) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BuyOrder_ClassSelector) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BuyOrder_ClassSelector) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BuyOrder_ClassSelector)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ClassId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ProjectJurisdiction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MinStartDate != nil {
+			l = options.Size(x.MinStartDate)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxEndDate != nil {
+			l = options.Size(x.MaxEndDate)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BuyOrder_ClassSelector)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MaxEndDate != nil {
+			encoded, err := options.Marshal(x.MaxEndDate)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.MinStartDate != nil {
+			encoded, err := options.Marshal(x.MinStartDate)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ProjectJurisdiction) > 0 {
+			i -= len(x.ProjectJurisdiction)
+			copy(dAtA[i:], x.ProjectJurisdiction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProjectJurisdiction)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ClassId) > 0 {
+			i -= len(x.ClassId)
+			copy(dAtA[i:], x.ClassId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClassId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BuyOrder_ClassSelector)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BuyOrder_ClassSelector: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BuyOrder_ClassSelector: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClassId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProjectJurisdiction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProjectJurisdiction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinStartDate", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MinStartDate == nil {
+					x.MinStartDate = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinStartDate); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxEndDate", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MaxEndDate == nil {
+					x.MaxEndDate = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxEndDate); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_BuyOrder_ProjectSelector                protoreflect.MessageDescriptor
+	fd_BuyOrder_ProjectSelector_project_id     protoreflect.FieldDescriptor
+	fd_BuyOrder_ProjectSelector_min_start_date protoreflect.FieldDescriptor
+	fd_BuyOrder_ProjectSelector_max_end_date   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_BuyOrder_ProjectSelector = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("BuyOrder").Messages().ByName("ProjectSelector")
+	fd_BuyOrder_ProjectSelector_project_id = md_BuyOrder_ProjectSelector.Fields().ByName("project_id")
+	fd_BuyOrder_ProjectSelector_min_start_date = md_BuyOrder_ProjectSelector.Fields().ByName("min_start_date")
+	fd_BuyOrder_ProjectSelector_max_end_date = md_BuyOrder_ProjectSelector.Fields().ByName("max_end_date")
+}
+
+var _ protoreflect.Message = (*fastReflection_BuyOrder_ProjectSelector)(nil)
+
+type fastReflection_BuyOrder_ProjectSelector BuyOrder_ProjectSelector
+
+func (x *BuyOrder_ProjectSelector) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BuyOrder_ProjectSelector)(x)
+}
+
+func (x *BuyOrder_ProjectSelector) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BuyOrder_ProjectSelector_messageType fastReflection_BuyOrder_ProjectSelector_messageType
+var _ protoreflect.MessageType = fastReflection_BuyOrder_ProjectSelector_messageType{}
+
+type fastReflection_BuyOrder_ProjectSelector_messageType struct{}
+
+func (x fastReflection_BuyOrder_ProjectSelector_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BuyOrder_ProjectSelector)(nil)
+}
+func (x fastReflection_BuyOrder_ProjectSelector_messageType) New() protoreflect.Message {
+	return new(fastReflection_BuyOrder_ProjectSelector)
+}
+func (x fastReflection_BuyOrder_ProjectSelector_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BuyOrder_ProjectSelector
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BuyOrder_ProjectSelector) Descriptor() protoreflect.MessageDescriptor {
+	return md_BuyOrder_ProjectSelector
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BuyOrder_ProjectSelector) Type() protoreflect.MessageType {
+	return _fastReflection_BuyOrder_ProjectSelector_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BuyOrder_ProjectSelector) New() protoreflect.Message {
+	return new(fastReflection_BuyOrder_ProjectSelector)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BuyOrder_ProjectSelector) Interface() protoreflect.ProtoMessage {
+	return (*BuyOrder_ProjectSelector)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BuyOrder_ProjectSelector) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProjectId != "" {
+		value := protoreflect.ValueOfString(x.ProjectId)
+		if !f(fd_BuyOrder_ProjectSelector_project_id, value) {
+			return
+		}
+	}
+	if x.MinStartDate != nil {
+		value := protoreflect.ValueOfMessage(x.MinStartDate.ProtoReflect())
+		if !f(fd_BuyOrder_ProjectSelector_min_start_date, value) {
+			return
+		}
+	}
+	if x.MaxEndDate != nil {
+		value := protoreflect.ValueOfMessage(x.MaxEndDate.ProtoReflect())
+		if !f(fd_BuyOrder_ProjectSelector_max_end_date, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BuyOrder_ProjectSelector) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector.project_id":
+		return x.ProjectId != ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector.min_start_date":
+		return x.MinStartDate != nil
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector.max_end_date":
+		return x.MaxEndDate != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BuyOrder_ProjectSelector) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector.project_id":
+		x.ProjectId = ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector.min_start_date":
+		x.MinStartDate = nil
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector.max_end_date":
+		x.MaxEndDate = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BuyOrder_ProjectSelector) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector.project_id":
+		value := x.ProjectId
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector.min_start_date":
+		value := x.MinStartDate
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector.max_end_date":
+		value := x.MaxEndDate
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BuyOrder_ProjectSelector) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector.project_id":
+		x.ProjectId = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector.min_start_date":
+		x.MinStartDate = value.Message().Interface().(*timestamppb.Timestamp)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector.max_end_date":
+		x.MaxEndDate = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BuyOrder_ProjectSelector) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector.min_start_date":
+		if x.MinStartDate == nil {
+			x.MinStartDate = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.MinStartDate.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector.max_end_date":
+		if x.MaxEndDate == nil {
+			x.MaxEndDate = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.MaxEndDate.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector.project_id":
+		panic(fmt.Errorf("field project_id of message regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BuyOrder_ProjectSelector) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector.project_id":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector.min_start_date":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector.max_end_date":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BuyOrder_ProjectSelector) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BuyOrder_ProjectSelector) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BuyOrder_ProjectSelector) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BuyOrder_ProjectSelector) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BuyOrder_ProjectSelector) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BuyOrder_ProjectSelector)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ProjectId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MinStartDate != nil {
+			l = options.Size(x.MinStartDate)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxEndDate != nil {
+			l = options.Size(x.MaxEndDate)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BuyOrder_ProjectSelector)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MaxEndDate != nil {
+			encoded, err := options.Marshal(x.MaxEndDate)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.MinStartDate != nil {
+			encoded, err := options.Marshal(x.MinStartDate)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ProjectId) > 0 {
+			i -= len(x.ProjectId)
+			copy(dAtA[i:], x.ProjectId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProjectId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BuyOrder_ProjectSelector)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BuyOrder_ProjectSelector: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BuyOrder_ProjectSelector: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProjectId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProjectId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinStartDate", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MinStartDate == nil {
+					x.MinStartDate = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinStartDate); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxEndDate", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MaxEndDate == nil {
+					x.MaxEndDate = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxEndDate); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_BuyOrder_BatchSelector             protoreflect.MessageDescriptor
+	fd_BuyOrder_BatchSelector_batch_denom protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_BuyOrder_BatchSelector = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("BuyOrder").Messages().ByName("BatchSelector")
+	fd_BuyOrder_BatchSelector_batch_denom = md_BuyOrder_BatchSelector.Fields().ByName("batch_denom")
+}
+
+var _ protoreflect.Message = (*fastReflection_BuyOrder_BatchSelector)(nil)
+
+type fastReflection_BuyOrder_BatchSelector BuyOrder_BatchSelector
+
+func (x *BuyOrder_BatchSelector) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BuyOrder_BatchSelector)(x)
+}
+
+func (x *BuyOrder_BatchSelector) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BuyOrder_BatchSelector_messageType fastReflection_BuyOrder_BatchSelector_messageType
+var _ protoreflect.MessageType = fastReflection_BuyOrder_BatchSelector_messageType{}
+
+type fastReflection_BuyOrder_BatchSelector_messageType struct{}
+
+func (x fastReflection_BuyOrder_BatchSelector_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BuyOrder_BatchSelector)(nil)
+}
+func (x fastReflection_BuyOrder_BatchSelector_messageType) New() protoreflect.Message {
+	return new(fastReflection_BuyOrder_BatchSelector)
+}
+func (x fastReflection_BuyOrder_BatchSelector_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BuyOrder_BatchSelector
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BuyOrder_BatchSelector) Descriptor() protoreflect.MessageDescriptor {
+	return md_BuyOrder_BatchSelector
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BuyOrder_BatchSelector) Type() protoreflect.MessageType {
+	return _fastReflection_BuyOrder_BatchSelector_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BuyOrder_BatchSelector) New() protoreflect.Message {
+	return new(fastReflection_BuyOrder_BatchSelector)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BuyOrder_BatchSelector) Interface() protoreflect.ProtoMessage {
+	return (*BuyOrder_BatchSelector)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BuyOrder_BatchSelector) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BatchDenom != "" {
+		value := protoreflect.ValueOfString(x.BatchDenom)
+		if !f(fd_BuyOrder_BatchSelector_batch_denom, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BuyOrder_BatchSelector) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrder.BatchSelector.batch_denom":
+		return x.BatchDenom != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder.BatchSelector"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder.BatchSelector does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BuyOrder_BatchSelector) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrder.BatchSelector.batch_denom":
+		x.BatchDenom = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder.BatchSelector"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder.BatchSelector does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BuyOrder_BatchSelector) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrder.BatchSelector.batch_denom":
+		value := x.BatchDenom
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder.BatchSelector"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder.BatchSelector does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BuyOrder_BatchSelector) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrder.BatchSelector.batch_denom":
+		x.BatchDenom = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder.BatchSelector"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder.BatchSelector does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BuyOrder_BatchSelector) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrder.BatchSelector.batch_denom":
+		panic(fmt.Errorf("field batch_denom of message regen.ecocredit.marketplace.v1.BuyOrder.BatchSelector is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder.BatchSelector"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder.BatchSelector does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BuyOrder_BatchSelector) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrder.BatchSelector.batch_denom":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder.BatchSelector"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder.BatchSelector does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BuyOrder_BatchSelector) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.BuyOrder.BatchSelector", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BuyOrder_BatchSelector) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BuyOrder_BatchSelector) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BuyOrder_BatchSelector) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BuyOrder_BatchSelector) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BuyOrder_BatchSelector)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.BatchDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BuyOrder_BatchSelector)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.BatchDenom) > 0 {
+			i -= len(x.BatchDenom)
+			copy(dAtA[i:], x.BatchDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BatchDenom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BuyOrder_BatchSelector)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BuyOrder_BatchSelector: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BuyOrder_BatchSelector: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BatchDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_AllowedDenom               protoreflect.MessageDescriptor
+	fd_AllowedDenom_bank_denom    protoreflect.FieldDescriptor
+	fd_AllowedDenom_display_denom protoreflect.FieldDescriptor
+	fd_AllowedDenom_exponent      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_AllowedDenom = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("AllowedDenom")
+	fd_AllowedDenom_bank_denom = md_AllowedDenom.Fields().ByName("bank_denom")
+	fd_AllowedDenom_display_denom = md_AllowedDenom.Fields().ByName("display_denom")
+	fd_AllowedDenom_exponent = md_AllowedDenom.Fields().ByName("exponent")
+}
+
+var _ protoreflect.Message = (*fastReflection_AllowedDenom)(nil)
+
+type fastReflection_AllowedDenom AllowedDenom
+
+func (x *AllowedDenom) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AllowedDenom)(x)
+}
+
+func (x *AllowedDenom) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AllowedDenom_messageType fastReflection_AllowedDenom_messageType
+var _ protoreflect.MessageType = fastReflection_AllowedDenom_messageType{}
+
+type fastReflection_AllowedDenom_messageType struct{}
+
+func (x fastReflection_AllowedDenom_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AllowedDenom)(nil)
+}
+func (x fastReflection_AllowedDenom_messageType) New() protoreflect.Message {
+	return new(fastReflection_AllowedDenom)
+}
+func (x fastReflection_AllowedDenom_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AllowedDenom
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AllowedDenom) Descriptor() protoreflect.MessageDescriptor {
+	return md_AllowedDenom
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AllowedDenom) Type() protoreflect.MessageType {
+	return _fastReflection_AllowedDenom_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AllowedDenom) New() protoreflect.Message {
+	return new(fastReflection_AllowedDenom)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AllowedDenom) Interface() protoreflect.ProtoMessage {
+	return (*AllowedDenom)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AllowedDenom) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BankDenom != "" {
+		value := protoreflect.ValueOfString(x.BankDenom)
+		if !f(fd_AllowedDenom_bank_denom, value) {
+			return
+		}
+	}
+	if x.DisplayDenom != "" {
+		value := protoreflect.ValueOfString(x.DisplayDenom)
+		if !f(fd_AllowedDenom_display_denom, value) {
+			return
+		}
+	}
+	if x.Exponent != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Exponent)
+		if !f(fd_AllowedDenom_exponent, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AllowedDenom) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.bank_denom":
+		return x.BankDenom != ""
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.display_denom":
+		return x.DisplayDenom != ""
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.exponent":
+		return x.Exponent != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AllowedDenom"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AllowedDenom does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AllowedDenom) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.bank_denom":
+		x.BankDenom = ""
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.display_denom":
+		x.DisplayDenom = ""
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.exponent":
+		x.Exponent = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AllowedDenom"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AllowedDenom does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AllowedDenom) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.bank_denom":
+		value := x.BankDenom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.display_denom":
+		value := x.DisplayDenom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.exponent":
+		value := x.Exponent
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AllowedDenom"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AllowedDenom does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AllowedDenom) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.bank_denom":
+		x.BankDenom = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.display_denom":
+		x.DisplayDenom = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.exponent":
+		x.Exponent = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AllowedDenom"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AllowedDenom does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AllowedDenom) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.bank_denom":
+		panic(fmt.Errorf("field bank_denom of message regen.ecocredit.marketplace.v1.AllowedDenom is not mutable"))
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.display_denom":
+		panic(fmt.Errorf("field display_denom of message regen.ecocredit.marketplace.v1.AllowedDenom is not mutable"))
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.exponent":
+		panic(fmt.Errorf("field exponent of message regen.ecocredit.marketplace.v1.AllowedDenom is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AllowedDenom"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AllowedDenom does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AllowedDenom) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.bank_denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.display_denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.exponent":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AllowedDenom"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AllowedDenom does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AllowedDenom) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.AllowedDenom", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AllowedDenom) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AllowedDenom) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AllowedDenom) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AllowedDenom) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AllowedDenom)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.BankDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DisplayDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Exponent != 0 {
+			n += 1 + runtime.Sov(uint64(x.Exponent))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AllowedDenom)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Exponent != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Exponent))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.DisplayDenom) > 0 {
+			i -= len(x.DisplayDenom)
+			copy(dAtA[i:], x.DisplayDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DisplayDenom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.BankDenom) > 0 {
+			i -= len(x.BankDenom)
+			copy(dAtA[i:], x.BankDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BankDenom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AllowedDenom)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AllowedDenom: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AllowedDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BankDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisplayDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DisplayDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+				}
+				x.Exponent = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Exponent |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Market                    protoreflect.MessageDescriptor
+	fd_Market_id                 protoreflect.FieldDescriptor
+	fd_Market_credit_type_abbrev protoreflect.FieldDescriptor
+	fd_Market_bank_denom         protoreflect.FieldDescriptor
+	fd_Market_precision_modifier protoreflect.FieldDescriptor
+	fd_Market_registrant         protoreflect.FieldDescriptor
+	fd_Market_display_denom      protoreflect.FieldDescriptor
+	fd_Market_exponent           protoreflect.FieldDescriptor
+	fd_Market_delisted           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_Market = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("Market")
+	fd_Market_id = md_Market.Fields().ByName("id")
+	fd_Market_credit_type_abbrev = md_Market.Fields().ByName("credit_type_abbrev")
+	fd_Market_bank_denom = md_Market.Fields().ByName("bank_denom")
+	fd_Market_precision_modifier = md_Market.Fields().ByName("precision_modifier")
+	fd_Market_registrant = md_Market.Fields().ByName("registrant")
+	fd_Market_display_denom = md_Market.Fields().ByName("display_denom")
+	fd_Market_exponent = md_Market.Fields().ByName("exponent")
+	fd_Market_delisted = md_Market.Fields().ByName("delisted")
+}
+
+var _ protoreflect.Message = (*fastReflection_Market)(nil)
+
+type fastReflection_Market Market
+
+func (x *Market) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Market)(x)
+}
+
+func (x *Market) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Market_messageType fastReflection_Market_messageType
+var _ protoreflect.MessageType = fastReflection_Market_messageType{}
+
+type fastReflection_Market_messageType struct{}
+
+func (x fastReflection_Market_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Market)(nil)
+}
+func (x fastReflection_Market_messageType) New() protoreflect.Message {
+	return new(fastReflection_Market)
+}
+func (x fastReflection_Market_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Market
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Market) Descriptor() protoreflect.MessageDescriptor {
+	return md_Market
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Market) Type() protoreflect.MessageType {
+	return _fastReflection_Market_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Market) New() protoreflect.Message {
+	return new(fastReflection_Market)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Market) Interface() protoreflect.ProtoMessage {
+	return (*Market)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Market) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_Market_id, value) {
+			return
+		}
+	}
+	if x.CreditTypeAbbrev != "" {
+		value := protoreflect.ValueOfString(x.CreditTypeAbbrev)
+		if !f(fd_Market_credit_type_abbrev, value) {
+			return
+		}
+	}
+	if x.BankDenom != "" {
+		value := protoreflect.ValueOfString(x.BankDenom)
+		if !f(fd_Market_bank_denom, value) {
+			return
+		}
+	}
+	if x.PrecisionModifier != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.PrecisionModifier)
+		if !f(fd_Market_precision_modifier, value) {
+			return
+		}
+	}
+	if len(x.Registrant) != 0 {
+		value := protoreflect.ValueOfBytes(x.Registrant)
+		if !f(fd_Market_registrant, value) {
+			return
+		}
+	}
+	if x.DisplayDenom != "" {
+		value := protoreflect.ValueOfString(x.DisplayDenom)
+		if !f(fd_Market_display_denom, value) {
+			return
+		}
+	}
+	if x.Exponent != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Exponent)
+		if !f(fd_Market_exponent, value) {
+			return
+		}
+	}
+	if x.Delisted != false {
+		value := protoreflect.ValueOfBool(x.Delisted)
+		if !f(fd_Market_delisted, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Market) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Market.id":
+		return x.Id != uint64(0)
+	case "regen.ecocredit.marketplace.v1.Market.credit_type_abbrev":
+		return x.CreditTypeAbbrev != ""
+	case "regen.ecocredit.marketplace.v1.Market.bank_denom":
+		return x.BankDenom != ""
+	case "regen.ecocredit.marketplace.v1.Market.precision_modifier":
+		return x.PrecisionModifier != uint32(0)
+	case "regen.ecocredit.marketplace.v1.Market.registrant":
+		return len(x.Registrant) != 0
+	case "regen.ecocredit.marketplace.v1.Market.display_denom":
+		return x.DisplayDenom != ""
+	case "regen.ecocredit.marketplace.v1.Market.exponent":
+		return x.Exponent != uint32(0)
+	case "regen.ecocredit.marketplace.v1.Market.delisted":
+		return x.Delisted != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Market"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Market does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Market) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Market.id":
+		x.Id = uint64(0)
+	case "regen.ecocredit.marketplace.v1.Market.credit_type_abbrev":
+		x.CreditTypeAbbrev = ""
+	case "regen.ecocredit.marketplace.v1.Market.bank_denom":
+		x.BankDenom = ""
+	case "regen.ecocredit.marketplace.v1.Market.precision_modifier":
+		x.PrecisionModifier = uint32(0)
+	case "regen.ecocredit.marketplace.v1.Market.registrant":
+		x.Registrant = nil
+	case "regen.ecocredit.marketplace.v1.Market.display_denom":
+		x.DisplayDenom = ""
+	case "regen.ecocredit.marketplace.v1.Market.exponent":
+		x.Exponent = uint32(0)
+	case "regen.ecocredit.marketplace.v1.Market.delisted":
+		x.Delisted = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Market"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Market does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Market) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.Market.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.Market.credit_type_abbrev":
+		value := x.CreditTypeAbbrev
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Market.bank_denom":
+		value := x.BankDenom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Market.precision_modifier":
+		value := x.PrecisionModifier
+		return protoreflect.ValueOfUint32(value)
+	case "regen.ecocredit.marketplace.v1.Market.registrant":
+		value := x.Registrant
+		return protoreflect.ValueOfBytes(value)
+	case "regen.ecocredit.marketplace.v1.Market.display_denom":
+		value := x.DisplayDenom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Market.exponent":
+		value := x.Exponent
+		return protoreflect.ValueOfUint32(value)
+	case "regen.ecocredit.marketplace.v1.Market.delisted":
+		value := x.Delisted
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Market"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Market does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Market) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Market.id":
+		x.Id = value.Uint()
+	case "regen.ecocredit.marketplace.v1.Market.credit_type_abbrev":
+		x.CreditTypeAbbrev = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Market.bank_denom":
+		x.BankDenom = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Market.precision_modifier":
+		x.PrecisionModifier = uint32(value.Uint())
+	case "regen.ecocredit.marketplace.v1.Market.registrant":
+		x.Registrant = value.Bytes()
+	case "regen.ecocredit.marketplace.v1.Market.display_denom":
+		x.DisplayDenom = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Market.exponent":
+		x.Exponent = uint32(value.Uint())
+	case "regen.ecocredit.marketplace.v1.Market.delisted":
+		x.Delisted = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Market"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Market does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Market) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Market.id":
+		panic(fmt.Errorf("field id of message regen.ecocredit.marketplace.v1.Market is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Market.credit_type_abbrev":
+		panic(fmt.Errorf("field credit_type_abbrev of message regen.ecocredit.marketplace.v1.Market is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Market.bank_denom":
+		panic(fmt.Errorf("field bank_denom of message regen.ecocredit.marketplace.v1.Market is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Market.precision_modifier":
+		panic(fmt.Errorf("field precision_modifier of message regen.ecocredit.marketplace.v1.Market is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Market.registrant":
+		panic(fmt.Errorf("field registrant of message regen.ecocredit.marketplace.v1.Market is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Market.display_denom":
+		panic(fmt.Errorf("field display_denom of message regen.ecocredit.marketplace.v1.Market is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Market.exponent":
+		panic(fmt.Errorf("field exponent of message regen.ecocredit.marketplace.v1.Market is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Market.delisted":
+		panic(fmt.Errorf("field delisted of message regen.ecocredit.marketplace.v1.Market is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Market"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Market does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Market) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Market.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.Market.credit_type_abbrev":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Market.bank_denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Market.precision_modifier":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "regen.ecocredit.marketplace.v1.Market.registrant":
+		return protoreflect.ValueOfBytes(nil)
+	case "regen.ecocredit.marketplace.v1.Market.display_denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Market.exponent":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "regen.ecocredit.marketplace.v1.Market.delisted":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Market"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Market does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Market) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.Market", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Market) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Market) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Market) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Market) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Market)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.CreditTypeAbbrev)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BankDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PrecisionModifier != 0 {
+			n += 1 + runtime.Sov(uint64(x.PrecisionModifier))
+		}
+		l = len(x.Registrant)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DisplayDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Exponent != 0 {
+			n += 1 + runtime.Sov(uint64(x.Exponent))
+		}
+		if x.Delisted {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Market)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Delisted {
+			i--
+			if x.Delisted {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.Exponent != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Exponent))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.DisplayDenom) > 0 {
+			i -= len(x.DisplayDenom)
+			copy(dAtA[i:], x.DisplayDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DisplayDenom)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Registrant) > 0 {
+			i -= len(x.Registrant)
+			copy(dAtA[i:], x.Registrant)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Registrant)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.PrecisionModifier != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PrecisionModifier))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.BankDenom) > 0 {
+			i -= len(x.BankDenom)
+			copy(dAtA[i:], x.BankDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BankDenom)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.CreditTypeAbbrev) > 0 {
+			i -= len(x.CreditTypeAbbrev)
+			copy(dAtA[i:], x.CreditTypeAbbrev)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CreditTypeAbbrev)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Market)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Market: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Market: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BankDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrecisionModifier", wireType)
+				}
+				x.PrecisionModifier = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PrecisionModifier |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Registrant", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Registrant = append(x.Registrant[:0], dAtA[iNdEx:postIndex]...)
+				if x.Registrant == nil {
+					x.Registrant = []byte{}
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisplayDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DisplayDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+				}
+				x.Exponent = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Exponent |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delisted", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Delisted = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MarketFee               protoreflect.MessageDescriptor
+	fd_MarketFee_market_id     protoreflect.FieldDescriptor
+	fd_MarketFee_maker_fee_bps protoreflect.FieldDescriptor
+	fd_MarketFee_taker_fee_bps protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_MarketFee = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("MarketFee")
+	fd_MarketFee_market_id = md_MarketFee.Fields().ByName("market_id")
+	fd_MarketFee_maker_fee_bps = md_MarketFee.Fields().ByName("maker_fee_bps")
+	fd_MarketFee_taker_fee_bps = md_MarketFee.Fields().ByName("taker_fee_bps")
+}
+
+var _ protoreflect.Message = (*fastReflection_MarketFee)(nil)
+
+type fastReflection_MarketFee MarketFee
+
+func (x *MarketFee) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MarketFee)(x)
+}
+
+func (x *MarketFee) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MarketFee_messageType fastReflection_MarketFee_messageType
+var _ protoreflect.MessageType = fastReflection_MarketFee_messageType{}
+
+type fastReflection_MarketFee_messageType struct{}
+
+func (x fastReflection_MarketFee_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MarketFee)(nil)
+}
+func (x fastReflection_MarketFee_messageType) New() protoreflect.Message {
+	return new(fastReflection_MarketFee)
+}
+func (x fastReflection_MarketFee_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketFee
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MarketFee) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketFee
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MarketFee) Type() protoreflect.MessageType {
+	return _fastReflection_MarketFee_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MarketFee) New() protoreflect.Message {
+	return new(fastReflection_MarketFee)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MarketFee) Interface() protoreflect.ProtoMessage {
+	return (*MarketFee)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MarketFee) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MarketId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MarketId)
+		if !f(fd_MarketFee_market_id, value) {
+			return
+		}
+	}
+	if x.MakerFeeBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MakerFeeBps)
+		if !f(fd_MarketFee_maker_fee_bps, value) {
+			return
+		}
+	}
+	if x.TakerFeeBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.TakerFeeBps)
+		if !f(fd_MarketFee_taker_fee_bps, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MarketFee) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.MarketFee.market_id":
+		return x.MarketId != uint64(0)
+	case "regen.ecocredit.marketplace.v1.MarketFee.maker_fee_bps":
+		return x.MakerFeeBps != uint32(0)
+	case "regen.ecocredit.marketplace.v1.MarketFee.taker_fee_bps":
+		return x.TakerFeeBps != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.MarketFee"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.MarketFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketFee) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.MarketFee.market_id":
+		x.MarketId = uint64(0)
+	case "regen.ecocredit.marketplace.v1.MarketFee.maker_fee_bps":
+		x.MakerFeeBps = uint32(0)
+	case "regen.ecocredit.marketplace.v1.MarketFee.taker_fee_bps":
+		x.TakerFeeBps = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.MarketFee"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.MarketFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MarketFee) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.MarketFee.market_id":
+		value := x.MarketId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.MarketFee.maker_fee_bps":
+		value := x.MakerFeeBps
+		return protoreflect.ValueOfUint32(value)
+	case "regen.ecocredit.marketplace.v1.MarketFee.taker_fee_bps":
+		value := x.TakerFeeBps
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.MarketFee"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.MarketFee does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketFee) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.MarketFee.market_id":
+		x.MarketId = value.Uint()
+	case "regen.ecocredit.marketplace.v1.MarketFee.maker_fee_bps":
+		x.MakerFeeBps = uint32(value.Uint())
+	case "regen.ecocredit.marketplace.v1.MarketFee.taker_fee_bps":
+		x.TakerFeeBps = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.MarketFee"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.MarketFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketFee) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.MarketFee.market_id":
+		panic(fmt.Errorf("field market_id of message regen.ecocredit.marketplace.v1.MarketFee is not mutable"))
+	case "regen.ecocredit.marketplace.v1.MarketFee.maker_fee_bps":
+		panic(fmt.Errorf("field maker_fee_bps of message regen.ecocredit.marketplace.v1.MarketFee is not mutable"))
+	case "regen.ecocredit.marketplace.v1.MarketFee.taker_fee_bps":
+		panic(fmt.Errorf("field taker_fee_bps of message regen.ecocredit.marketplace.v1.MarketFee is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.MarketFee"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.MarketFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MarketFee) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.MarketFee.market_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.MarketFee.maker_fee_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "regen.ecocredit.marketplace.v1.MarketFee.taker_fee_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.MarketFee"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.MarketFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MarketFee) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.MarketFee", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MarketFee) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketFee) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MarketFee) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MarketFee) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MarketFee)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.MarketId != 0 {
+			n += 1 + runtime.Sov(uint64(x.MarketId))
+		}
+		if x.MakerFeeBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.MakerFeeBps))
+		}
+		if x.TakerFeeBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.TakerFeeBps))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MarketFee)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.TakerFeeBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TakerFeeBps))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.MakerFeeBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MakerFeeBps))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.MarketId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MarketId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MarketFee)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketFee: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				}
+				x.MarketId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MarketId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MakerFeeBps", wireType)
+				}
+				x.MakerFeeBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MakerFeeBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TakerFeeBps", wireType)
+				}
+				x.TakerFeeBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TakerFeeBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_CreditTypeFee                    protoreflect.MessageDescriptor
+	fd_CreditTypeFee_credit_type_abbrev protoreflect.FieldDescriptor
+	fd_CreditTypeFee_maker_fee_bps      protoreflect.FieldDescriptor
+	fd_CreditTypeFee_taker_fee_bps      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_CreditTypeFee = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("CreditTypeFee")
+	fd_CreditTypeFee_credit_type_abbrev = md_CreditTypeFee.Fields().ByName("credit_type_abbrev")
+	fd_CreditTypeFee_maker_fee_bps = md_CreditTypeFee.Fields().ByName("maker_fee_bps")
+	fd_CreditTypeFee_taker_fee_bps = md_CreditTypeFee.Fields().ByName("taker_fee_bps")
+}
+
+var _ protoreflect.Message = (*fastReflection_CreditTypeFee)(nil)
+
+type fastReflection_CreditTypeFee CreditTypeFee
+
+func (x *CreditTypeFee) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CreditTypeFee)(x)
+}
+
+func (x *CreditTypeFee) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CreditTypeFee_messageType fastReflection_CreditTypeFee_messageType
+var _ protoreflect.MessageType = fastReflection_CreditTypeFee_messageType{}
+
+type fastReflection_CreditTypeFee_messageType struct{}
+
+func (x fastReflection_CreditTypeFee_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CreditTypeFee)(nil)
+}
+func (x fastReflection_CreditTypeFee_messageType) New() protoreflect.Message {
+	return new(fastReflection_CreditTypeFee)
+}
+func (x fastReflection_CreditTypeFee_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CreditTypeFee
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CreditTypeFee) Descriptor() protoreflect.MessageDescriptor {
+	return md_CreditTypeFee
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CreditTypeFee) Type() protoreflect.MessageType {
+	return _fastReflection_CreditTypeFee_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CreditTypeFee) New() protoreflect.Message {
+	return new(fastReflection_CreditTypeFee)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CreditTypeFee) Interface() protoreflect.ProtoMessage {
+	return (*CreditTypeFee)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CreditTypeFee) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CreditTypeAbbrev != "" {
+		value := protoreflect.ValueOfString(x.CreditTypeAbbrev)
+		if !f(fd_CreditTypeFee_credit_type_abbrev, value) {
+			return
+		}
+	}
+	if x.MakerFeeBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MakerFeeBps)
+		if !f(fd_CreditTypeFee_maker_fee_bps, value) {
+			return
+		}
+	}
+	if x.TakerFeeBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.TakerFeeBps)
+		if !f(fd_CreditTypeFee_taker_fee_bps, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CreditTypeFee) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.CreditTypeFee.credit_type_abbrev":
+		return x.CreditTypeAbbrev != ""
+	case "regen.ecocredit.marketplace.v1.CreditTypeFee.maker_fee_bps":
+		return x.MakerFeeBps != uint32(0)
+	case "regen.ecocredit.marketplace.v1.CreditTypeFee.taker_fee_bps":
+		return x.TakerFeeBps != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.CreditTypeFee"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.CreditTypeFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CreditTypeFee) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.CreditTypeFee.credit_type_abbrev":
+		x.CreditTypeAbbrev = ""
+	case "regen.ecocredit.marketplace.v1.CreditTypeFee.maker_fee_bps":
+		x.MakerFeeBps = uint32(0)
+	case "regen.ecocredit.marketplace.v1.CreditTypeFee.taker_fee_bps":
+		x.TakerFeeBps = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.CreditTypeFee"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.CreditTypeFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CreditTypeFee) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.CreditTypeFee.credit_type_abbrev":
+		value := x.CreditTypeAbbrev
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.CreditTypeFee.maker_fee_bps":
+		value := x.MakerFeeBps
+		return protoreflect.ValueOfUint32(value)
+	case "regen.ecocredit.marketplace.v1.CreditTypeFee.taker_fee_bps":
+		value := x.TakerFeeBps
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.CreditTypeFee"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.CreditTypeFee does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CreditTypeFee) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.CreditTypeFee.credit_type_abbrev":
+		x.CreditTypeAbbrev = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.CreditTypeFee.maker_fee_bps":
+		x.MakerFeeBps = uint32(value.Uint())
+	case "regen.ecocredit.marketplace.v1.CreditTypeFee.taker_fee_bps":
+		x.TakerFeeBps = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.CreditTypeFee"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.CreditTypeFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CreditTypeFee) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.CreditTypeFee.credit_type_abbrev":
+		panic(fmt.Errorf("field credit_type_abbrev of message regen.ecocredit.marketplace.v1.CreditTypeFee is not mutable"))
+	case "regen.ecocredit.marketplace.v1.CreditTypeFee.maker_fee_bps":
+		panic(fmt.Errorf("field maker_fee_bps of message regen.ecocredit.marketplace.v1.CreditTypeFee is not mutable"))
+	case "regen.ecocredit.marketplace.v1.CreditTypeFee.taker_fee_bps":
+		panic(fmt.Errorf("field taker_fee_bps of message regen.ecocredit.marketplace.v1.CreditTypeFee is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.CreditTypeFee"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.CreditTypeFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CreditTypeFee) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.CreditTypeFee.credit_type_abbrev":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.CreditTypeFee.maker_fee_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "regen.ecocredit.marketplace.v1.CreditTypeFee.taker_fee_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.CreditTypeFee"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.CreditTypeFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CreditTypeFee) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.CreditTypeFee", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CreditTypeFee) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CreditTypeFee) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CreditTypeFee) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CreditTypeFee) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CreditTypeFee)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.CreditTypeAbbrev)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MakerFeeBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.MakerFeeBps))
+		}
+		if x.TakerFeeBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.TakerFeeBps))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CreditTypeFee)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.TakerFeeBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TakerFeeBps))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.MakerFeeBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MakerFeeBps))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.CreditTypeAbbrev) > 0 {
+			i -= len(x.CreditTypeAbbrev)
+			copy(dAtA[i:], x.CreditTypeAbbrev)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CreditTypeAbbrev)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CreditTypeFee)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CreditTypeFee: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CreditTypeFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MakerFeeBps", wireType)
+				}
+				x.MakerFeeBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MakerFeeBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TakerFeeBps", wireType)
+				}
+				x.TakerFeeBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TakerFeeBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_FeeDestination             protoreflect.MessageDescriptor
+	fd_FeeDestination_destination protoreflect.FieldDescriptor
+	fd_FeeDestination_address     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_FeeDestination = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("FeeDestination")
+	fd_FeeDestination_destination = md_FeeDestination.Fields().ByName("destination")
+	fd_FeeDestination_address = md_FeeDestination.Fields().ByName("address")
+}
+
+var _ protoreflect.Message = (*fastReflection_FeeDestination)(nil)
+
+type fastReflection_FeeDestination FeeDestination
+
+func (x *FeeDestination) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeDestination)(x)
+}
+
+func (x *FeeDestination) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeeDestination_messageType fastReflection_FeeDestination_messageType
+var _ protoreflect.MessageType = fastReflection_FeeDestination_messageType{}
+
+type fastReflection_FeeDestination_messageType struct{}
+
+func (x fastReflection_FeeDestination_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeDestination)(nil)
+}
+func (x fastReflection_FeeDestination_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeDestination)
+}
+func (x fastReflection_FeeDestination_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeDestination
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeeDestination) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeDestination
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeeDestination) Type() protoreflect.MessageType {
+	return _fastReflection_FeeDestination_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeeDestination) New() protoreflect.Message {
+	return new(fastReflection_FeeDestination)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeeDestination) Interface() protoreflect.ProtoMessage {
+	return (*FeeDestination)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeeDestination) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Destination != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Destination))
+		if !f(fd_FeeDestination_destination, value) {
+			return
+		}
+	}
+	if len(x.Address) != 0 {
+		value := protoreflect.ValueOfBytes(x.Address)
+		if !f(fd_FeeDestination_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeeDestination) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.FeeDestination.destination":
+		return x.Destination != 0
+	case "regen.ecocredit.marketplace.v1.FeeDestination.address":
+		return len(x.Address) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.FeeDestination"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.FeeDestination does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeDestination) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.FeeDestination.destination":
+		x.Destination = 0
+	case "regen.ecocredit.marketplace.v1.FeeDestination.address":
+		x.Address = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.FeeDestination"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.FeeDestination does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeeDestination) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.FeeDestination.destination":
+		value := x.Destination
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "regen.ecocredit.marketplace.v1.FeeDestination.address":
+		value := x.Address
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.FeeDestination"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.FeeDestination does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeDestination) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.FeeDestination.destination":
+		x.Destination = (FeeDestinationType)(value.Enum())
+	case "regen.ecocredit.marketplace.v1.FeeDestination.address":
+		x.Address = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.FeeDestination"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.FeeDestination does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeDestination) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.FeeDestination.destination":
+		panic(fmt.Errorf("field destination of message regen.ecocredit.marketplace.v1.FeeDestination is not mutable"))
+	case "regen.ecocredit.marketplace.v1.FeeDestination.address":
+		panic(fmt.Errorf("field address of message regen.ecocredit.marketplace.v1.FeeDestination is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.FeeDestination"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.FeeDestination does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeeDestination) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.FeeDestination.destination":
+		return protoreflect.ValueOfEnum(0)
+	case "regen.ecocredit.marketplace.v1.FeeDestination.address":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.FeeDestination"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.FeeDestination does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeeDestination) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.FeeDestination", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeeDestination) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeDestination) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeeDestination) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeeDestination) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeeDestination)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Destination != 0 {
+			n += 1 + runtime.Sov(uint64(x.Destination))
+		}
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeeDestination)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Destination != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Destination))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeeDestination)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeDestination: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				}
+				x.Destination = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Destination |= FeeDestinationType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = append(x.Address[:0], dAtA[iNdEx:postIndex]...)
+				if x.Address == nil {
+					x.Address = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MarketFeeTotal           protoreflect.MessageDescriptor
+	fd_MarketFeeTotal_market_id protoreflect.FieldDescriptor
+	fd_MarketFeeTotal_amount    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_MarketFeeTotal = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("MarketFeeTotal")
+	fd_MarketFeeTotal_market_id = md_MarketFeeTotal.Fields().ByName("market_id")
+	fd_MarketFeeTotal_amount = md_MarketFeeTotal.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_MarketFeeTotal)(nil)
+
+type fastReflection_MarketFeeTotal MarketFeeTotal
+
+func (x *MarketFeeTotal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MarketFeeTotal)(x)
+}
+
+func (x *MarketFeeTotal) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MarketFeeTotal_messageType fastReflection_MarketFeeTotal_messageType
+var _ protoreflect.MessageType = fastReflection_MarketFeeTotal_messageType{}
+
+type fastReflection_MarketFeeTotal_messageType struct{}
+
+func (x fastReflection_MarketFeeTotal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MarketFeeTotal)(nil)
+}
+func (x fastReflection_MarketFeeTotal_messageType) New() protoreflect.Message {
+	return new(fastReflection_MarketFeeTotal)
+}
+func (x fastReflection_MarketFeeTotal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketFeeTotal
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MarketFeeTotal) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketFeeTotal
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MarketFeeTotal) Type() protoreflect.MessageType {
+	return _fastReflection_MarketFeeTotal_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MarketFeeTotal) New() protoreflect.Message {
+	return new(fastReflection_MarketFeeTotal)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MarketFeeTotal) Interface() protoreflect.ProtoMessage {
+	return (*MarketFeeTotal)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MarketFeeTotal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MarketId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MarketId)
+		if !f(fd_MarketFeeTotal_market_id, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_MarketFeeTotal_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MarketFeeTotal) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.MarketFeeTotal.market_id":
+		return x.MarketId != uint64(0)
+	case "regen.ecocredit.marketplace.v1.MarketFeeTotal.amount":
+		return x.Amount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.MarketFeeTotal"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.MarketFeeTotal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketFeeTotal) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.MarketFeeTotal.market_id":
+		x.MarketId = uint64(0)
+	case "regen.ecocredit.marketplace.v1.MarketFeeTotal.amount":
+		x.Amount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.MarketFeeTotal"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.MarketFeeTotal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MarketFeeTotal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.MarketFeeTotal.market_id":
+		value := x.MarketId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.MarketFeeTotal.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.MarketFeeTotal"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.MarketFeeTotal does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketFeeTotal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.MarketFeeTotal.market_id":
+		x.MarketId = value.Uint()
+	case "regen.ecocredit.marketplace.v1.MarketFeeTotal.amount":
+		x.Amount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.MarketFeeTotal"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.MarketFeeTotal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketFeeTotal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.MarketFeeTotal.market_id":
+		panic(fmt.Errorf("field market_id of message regen.ecocredit.marketplace.v1.MarketFeeTotal is not mutable"))
+	case "regen.ecocredit.marketplace.v1.MarketFeeTotal.amount":
+		panic(fmt.Errorf("field amount of message regen.ecocredit.marketplace.v1.MarketFeeTotal is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.MarketFeeTotal"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.MarketFeeTotal does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MarketFeeTotal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.MarketFeeTotal.market_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.MarketFeeTotal.amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.MarketFeeTotal"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.MarketFeeTotal does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MarketFeeTotal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.MarketFeeTotal", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MarketFeeTotal) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketFeeTotal) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MarketFeeTotal) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MarketFeeTotal) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MarketFeeTotal)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.MarketId != 0 {
+			n += 1 + runtime.Sov(uint64(x.MarketId))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MarketFeeTotal)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.MarketId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MarketId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MarketFeeTotal)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketFeeTotal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketFeeTotal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				}
+				x.MarketId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MarketId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Trade                       protoreflect.MessageDescriptor
+	fd_Trade_id                    protoreflect.FieldDescriptor
+	fd_Trade_buyer                 protoreflect.FieldDescriptor
+	fd_Trade_seller                protoreflect.FieldDescriptor
+	fd_Trade_batch_key             protoreflect.FieldDescriptor
+	fd_Trade_market_id             protoreflect.FieldDescriptor
+	fd_Trade_sell_order_id         protoreflect.FieldDescriptor
+	fd_Trade_buy_order_id          protoreflect.FieldDescriptor
+	fd_Trade_quantity              protoreflect.FieldDescriptor
+	fd_Trade_unit_price            protoreflect.FieldDescriptor
+	fd_Trade_timestamp             protoreflect.FieldDescriptor
+	fd_Trade_offer_id              protoreflect.FieldDescriptor
+	fd_Trade_dutch_auction_id      protoreflect.FieldDescriptor
+	fd_Trade_sealed_bid_auction_id protoreflect.FieldDescriptor
+	fd_Trade_forward_contract_id   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_Trade = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("Trade")
+	fd_Trade_id = md_Trade.Fields().ByName("id")
+	fd_Trade_buyer = md_Trade.Fields().ByName("buyer")
+	fd_Trade_seller = md_Trade.Fields().ByName("seller")
+	fd_Trade_batch_key = md_Trade.Fields().ByName("batch_key")
+	fd_Trade_market_id = md_Trade.Fields().ByName("market_id")
+	fd_Trade_sell_order_id = md_Trade.Fields().ByName("sell_order_id")
+	fd_Trade_buy_order_id = md_Trade.Fields().ByName("buy_order_id")
+	fd_Trade_quantity = md_Trade.Fields().ByName("quantity")
+	fd_Trade_unit_price = md_Trade.Fields().ByName("unit_price")
+	fd_Trade_timestamp = md_Trade.Fields().ByName("timestamp")
+	fd_Trade_offer_id = md_Trade.Fields().ByName("offer_id")
+	fd_Trade_dutch_auction_id = md_Trade.Fields().ByName("dutch_auction_id")
+	fd_Trade_sealed_bid_auction_id = md_Trade.Fields().ByName("sealed_bid_auction_id")
+	fd_Trade_forward_contract_id = md_Trade.Fields().ByName("forward_contract_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_Trade)(nil)
+
+type fastReflection_Trade Trade
+
+func (x *Trade) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Trade)(x)
+}
+
+func (x *Trade) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Trade_messageType fastReflection_Trade_messageType
+var _ protoreflect.MessageType = fastReflection_Trade_messageType{}
+
+type fastReflection_Trade_messageType struct{}
+
+func (x fastReflection_Trade_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Trade)(nil)
+}
+func (x fastReflection_Trade_messageType) New() protoreflect.Message {
+	return new(fastReflection_Trade)
+}
+func (x fastReflection_Trade_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Trade
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Trade) Descriptor() protoreflect.MessageDescriptor {
+	return md_Trade
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Trade) Type() protoreflect.MessageType {
+	return _fastReflection_Trade_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Trade) New() protoreflect.Message {
+	return new(fastReflection_Trade)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Trade) Interface() protoreflect.ProtoMessage {
+	return (*Trade)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Trade) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_Trade_id, value) {
+			return
+		}
+	}
+	if len(x.Buyer) != 0 {
+		value := protoreflect.ValueOfBytes(x.Buyer)
+		if !f(fd_Trade_buyer, value) {
+			return
+		}
+	}
+	if len(x.Seller) != 0 {
+		value := protoreflect.ValueOfBytes(x.Seller)
+		if !f(fd_Trade_seller, value) {
+			return
+		}
+	}
+	if x.BatchKey != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BatchKey)
+		if !f(fd_Trade_batch_key, value) {
+			return
+		}
+	}
+	if x.MarketId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MarketId)
+		if !f(fd_Trade_market_id, value) {
+			return
+		}
+	}
+	if x.SellOrderId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SellOrderId)
+		if !f(fd_Trade_sell_order_id, value) {
+			return
+		}
+	}
+	if x.BuyOrderId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BuyOrderId)
+		if !f(fd_Trade_buy_order_id, value) {
+			return
+		}
+	}
+	if x.Quantity != "" {
+		value := protoreflect.ValueOfString(x.Quantity)
+		if !f(fd_Trade_quantity, value) {
+			return
+		}
+	}
+	if x.UnitPrice != "" {
+		value := protoreflect.ValueOfString(x.UnitPrice)
+		if !f(fd_Trade_unit_price, value) {
+			return
+		}
+	}
+	if x.Timestamp != nil {
+		value := protoreflect.ValueOfMessage(x.Timestamp.ProtoReflect())
+		if !f(fd_Trade_timestamp, value) {
+			return
+		}
+	}
+	if x.OfferId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OfferId)
+		if !f(fd_Trade_offer_id, value) {
+			return
+		}
+	}
+	if x.DutchAuctionId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DutchAuctionId)
+		if !f(fd_Trade_dutch_auction_id, value) {
+			return
+		}
+	}
+	if x.SealedBidAuctionId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SealedBidAuctionId)
+		if !f(fd_Trade_sealed_bid_auction_id, value) {
+			return
+		}
+	}
+	if x.ForwardContractId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ForwardContractId)
+		if !f(fd_Trade_forward_contract_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Trade) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Trade.id":
+		return x.Id != uint64(0)
+	case "regen.ecocredit.marketplace.v1.Trade.buyer":
+		return len(x.Buyer) != 0
+	case "regen.ecocredit.marketplace.v1.Trade.seller":
+		return len(x.Seller) != 0
+	case "regen.ecocredit.marketplace.v1.Trade.batch_key":
+		return x.BatchKey != uint64(0)
+	case "regen.ecocredit.marketplace.v1.Trade.market_id":
+		return x.MarketId != uint64(0)
+	case "regen.ecocredit.marketplace.v1.Trade.sell_order_id":
+		return x.SellOrderId != uint64(0)
+	case "regen.ecocredit.marketplace.v1.Trade.buy_order_id":
+		return x.BuyOrderId != uint64(0)
+	case "regen.ecocredit.marketplace.v1.Trade.quantity":
+		return x.Quantity != ""
+	case "regen.ecocredit.marketplace.v1.Trade.unit_price":
+		return x.UnitPrice != ""
+	case "regen.ecocredit.marketplace.v1.Trade.timestamp":
+		return x.Timestamp != nil
+	case "regen.ecocredit.marketplace.v1.Trade.offer_id":
+		return x.OfferId != uint64(0)
+	case "regen.ecocredit.marketplace.v1.Trade.dutch_auction_id":
+		return x.DutchAuctionId != uint64(0)
+	case "regen.ecocredit.marketplace.v1.Trade.sealed_bid_auction_id":
+		return x.SealedBidAuctionId != uint64(0)
+	case "regen.ecocredit.marketplace.v1.Trade.forward_contract_id":
+		return x.ForwardContractId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Trade"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Trade does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Trade) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Trade.id":
+		x.Id = uint64(0)
+	case "regen.ecocredit.marketplace.v1.Trade.buyer":
+		x.Buyer = nil
+	case "regen.ecocredit.marketplace.v1.Trade.seller":
+		x.Seller = nil
+	case "regen.ecocredit.marketplace.v1.Trade.batch_key":
+		x.BatchKey = uint64(0)
+	case "regen.ecocredit.marketplace.v1.Trade.market_id":
+		x.MarketId = uint64(0)
+	case "regen.ecocredit.marketplace.v1.Trade.sell_order_id":
+		x.SellOrderId = uint64(0)
+	case "regen.ecocredit.marketplace.v1.Trade.buy_order_id":
+		x.BuyOrderId = uint64(0)
+	case "regen.ecocredit.marketplace.v1.Trade.quantity":
+		x.Quantity = ""
+	case "regen.ecocredit.marketplace.v1.Trade.unit_price":
+		x.UnitPrice = ""
+	case "regen.ecocredit.marketplace.v1.Trade.timestamp":
+		x.Timestamp = nil
+	case "regen.ecocredit.marketplace.v1.Trade.offer_id":
+		x.OfferId = uint64(0)
+	case "regen.ecocredit.marketplace.v1.Trade.dutch_auction_id":
+		x.DutchAuctionId = uint64(0)
+	case "regen.ecocredit.marketplace.v1.Trade.sealed_bid_auction_id":
+		x.SealedBidAuctionId = uint64(0)
+	case "regen.ecocredit.marketplace.v1.Trade.forward_contract_id":
+		x.ForwardContractId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Trade"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Trade does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Trade) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.Trade.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.Trade.buyer":
+		value := x.Buyer
+		return protoreflect.ValueOfBytes(value)
+	case "regen.ecocredit.marketplace.v1.Trade.seller":
+		value := x.Seller
+		return protoreflect.ValueOfBytes(value)
+	case "regen.ecocredit.marketplace.v1.Trade.batch_key":
+		value := x.BatchKey
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.Trade.market_id":
+		value := x.MarketId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.Trade.sell_order_id":
+		value := x.SellOrderId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.Trade.buy_order_id":
+		value := x.BuyOrderId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.Trade.quantity":
+		value := x.Quantity
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Trade.unit_price":
+		value := x.UnitPrice
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Trade.timestamp":
+		value := x.Timestamp
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.Trade.offer_id":
+		value := x.OfferId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.Trade.dutch_auction_id":
+		value := x.DutchAuctionId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.Trade.sealed_bid_auction_id":
+		value := x.SealedBidAuctionId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.Trade.forward_contract_id":
+		value := x.ForwardContractId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Trade"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Trade does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Trade) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Trade.id":
+		x.Id = value.Uint()
+	case "regen.ecocredit.marketplace.v1.Trade.buyer":
+		x.Buyer = value.Bytes()
+	case "regen.ecocredit.marketplace.v1.Trade.seller":
+		x.Seller = value.Bytes()
+	case "regen.ecocredit.marketplace.v1.Trade.batch_key":
+		x.BatchKey = value.Uint()
+	case "regen.ecocredit.marketplace.v1.Trade.market_id":
+		x.MarketId = value.Uint()
+	case "regen.ecocredit.marketplace.v1.Trade.sell_order_id":
+		x.SellOrderId = value.Uint()
+	case "regen.ecocredit.marketplace.v1.Trade.buy_order_id":
+		x.BuyOrderId = value.Uint()
+	case "regen.ecocredit.marketplace.v1.Trade.quantity":
+		x.Quantity = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Trade.unit_price":
+		x.UnitPrice = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Trade.timestamp":
+		x.Timestamp = value.Message().Interface().(*timestamppb.Timestamp)
+	case "regen.ecocredit.marketplace.v1.Trade.offer_id":
+		x.OfferId = value.Uint()
+	case "regen.ecocredit.marketplace.v1.Trade.dutch_auction_id":
+		x.DutchAuctionId = value.Uint()
+	case "regen.ecocredit.marketplace.v1.Trade.sealed_bid_auction_id":
+		x.SealedBidAuctionId = value.Uint()
+	case "regen.ecocredit.marketplace.v1.Trade.forward_contract_id":
+		x.ForwardContractId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Trade"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Trade does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Trade) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Trade.timestamp":
+		if x.Timestamp == nil {
+			x.Timestamp = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Timestamp.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.Trade.id":
+		panic(fmt.Errorf("field id of message regen.ecocredit.marketplace.v1.Trade is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Trade.buyer":
+		panic(fmt.Errorf("field buyer of message regen.ecocredit.marketplace.v1.Trade is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Trade.seller":
+		panic(fmt.Errorf("field seller of message regen.ecocredit.marketplace.v1.Trade is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Trade.batch_key":
+		panic(fmt.Errorf("field batch_key of message regen.ecocredit.marketplace.v1.Trade is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Trade.market_id":
+		panic(fmt.Errorf("field market_id of message regen.ecocredit.marketplace.v1.Trade is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Trade.sell_order_id":
+		panic(fmt.Errorf("field sell_order_id of message regen.ecocredit.marketplace.v1.Trade is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Trade.buy_order_id":
+		panic(fmt.Errorf("field buy_order_id of message regen.ecocredit.marketplace.v1.Trade is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Trade.quantity":
+		panic(fmt.Errorf("field quantity of message regen.ecocredit.marketplace.v1.Trade is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Trade.unit_price":
+		panic(fmt.Errorf("field unit_price of message regen.ecocredit.marketplace.v1.Trade is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Trade.offer_id":
+		panic(fmt.Errorf("field offer_id of message regen.ecocredit.marketplace.v1.Trade is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Trade.dutch_auction_id":
+		panic(fmt.Errorf("field dutch_auction_id of message regen.ecocredit.marketplace.v1.Trade is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Trade.sealed_bid_auction_id":
+		panic(fmt.Errorf("field sealed_bid_auction_id of message regen.ecocredit.marketplace.v1.Trade is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Trade.forward_contract_id":
+		panic(fmt.Errorf("field forward_contract_id of message regen.ecocredit.marketplace.v1.Trade is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Trade"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Trade does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Trade) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Trade.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.Trade.buyer":
+		return protoreflect.ValueOfBytes(nil)
+	case "regen.ecocredit.marketplace.v1.Trade.seller":
+		return protoreflect.ValueOfBytes(nil)
+	case "regen.ecocredit.marketplace.v1.Trade.batch_key":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.Trade.market_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.Trade.sell_order_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.Trade.buy_order_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.Trade.quantity":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Trade.unit_price":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Trade.timestamp":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.Trade.offer_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.Trade.dutch_auction_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.Trade.sealed_bid_auction_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.Trade.forward_contract_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Trade"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Trade does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Trade) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.Trade", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Trade) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Trade) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Trade) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Trade) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Trade)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Buyer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Seller)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BatchKey != 0 {
+			n += 1 + runtime.Sov(uint64(x.BatchKey))
+		}
+		if x.MarketId != 0 {
+			n += 1 + runtime.Sov(uint64(x.MarketId))
+		}
+		if x.SellOrderId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SellOrderId))
+		}
+		if x.BuyOrderId != 0 {
+			n += 1 + runtime.Sov(uint64(x.BuyOrderId))
+		}
+		l = len(x.Quantity)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.UnitPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Timestamp != nil {
+			l = options.Size(x.Timestamp)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.OfferId != 0 {
+			n += 1 + runtime.Sov(uint64(x.OfferId))
+		}
+		if x.DutchAuctionId != 0 {
+			n += 1 + runtime.Sov(uint64(x.DutchAuctionId))
+		}
+		if x.SealedBidAuctionId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SealedBidAuctionId))
+		}
+		if x.ForwardContractId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ForwardContractId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Trade)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ForwardContractId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ForwardContractId))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.SealedBidAuctionId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SealedBidAuctionId))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.DutchAuctionId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DutchAuctionId))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.OfferId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OfferId))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.Timestamp != nil {
+			encoded, err := options.Marshal(x.Timestamp)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.UnitPrice) > 0 {
+			i -= len(x.UnitPrice)
+			copy(dAtA[i:], x.UnitPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UnitPrice)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.Quantity) > 0 {
+			i -= len(x.Quantity)
+			copy(dAtA[i:], x.Quantity)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Quantity)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.BuyOrderId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BuyOrderId))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.SellOrderId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SellOrderId))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.MarketId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MarketId))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.BatchKey != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BatchKey))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Seller) > 0 {
+			i -= len(x.Seller)
+			copy(dAtA[i:], x.Seller)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Seller)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Buyer) > 0 {
+			i -= len(x.Buyer)
+			copy(dAtA[i:], x.Buyer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Buyer)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Trade)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Trade: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Trade: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Buyer = append(x.Buyer[:0], dAtA[iNdEx:postIndex]...)
+				if x.Buyer == nil {
+					x.Buyer = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Seller = append(x.Seller[:0], dAtA[iNdEx:postIndex]...)
+				if x.Seller == nil {
+					x.Seller = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+				}
+				x.BatchKey = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BatchKey |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				}
+				x.MarketId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MarketId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SellOrderId", wireType)
+				}
+				x.SellOrderId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SellOrderId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BuyOrderId", wireType)
+				}
+				x.BuyOrderId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BuyOrderId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Quantity = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnitPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UnitPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Timestamp == nil {
+					x.Timestamp = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Timestamp); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OfferId", wireType)
+				}
+				x.OfferId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OfferId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DutchAuctionId", wireType)
+				}
+				x.DutchAuctionId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DutchAuctionId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SealedBidAuctionId", wireType)
+				}
+				x.SealedBidAuctionId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SealedBidAuctionId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ForwardContractId", wireType)
+				}
+				x.ForwardContractId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ForwardContractId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_AddressTrade          protoreflect.MessageDescriptor
+	fd_AddressTrade_address  protoreflect.FieldDescriptor
+	fd_AddressTrade_trade_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_AddressTrade = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("AddressTrade")
+	fd_AddressTrade_address = md_AddressTrade.Fields().ByName("address")
+	fd_AddressTrade_trade_id = md_AddressTrade.Fields().ByName("trade_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_AddressTrade)(nil)
+
+type fastReflection_AddressTrade AddressTrade
+
+func (x *AddressTrade) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AddressTrade)(x)
+}
+
+func (x *AddressTrade) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AddressTrade_messageType fastReflection_AddressTrade_messageType
+var _ protoreflect.MessageType = fastReflection_AddressTrade_messageType{}
+
+type fastReflection_AddressTrade_messageType struct{}
+
+func (x fastReflection_AddressTrade_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AddressTrade)(nil)
+}
+func (x fastReflection_AddressTrade_messageType) New() protoreflect.Message {
+	return new(fastReflection_AddressTrade)
+}
+func (x fastReflection_AddressTrade_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AddressTrade
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AddressTrade) Descriptor() protoreflect.MessageDescriptor {
+	return md_AddressTrade
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AddressTrade) Type() protoreflect.MessageType {
+	return _fastReflection_AddressTrade_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AddressTrade) New() protoreflect.Message {
+	return new(fastReflection_AddressTrade)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AddressTrade) Interface() protoreflect.ProtoMessage {
+	return (*AddressTrade)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AddressTrade) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Address) != 0 {
+		value := protoreflect.ValueOfBytes(x.Address)
+		if !f(fd_AddressTrade_address, value) {
+			return
+		}
+	}
+	if x.TradeId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TradeId)
+		if !f(fd_AddressTrade_trade_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AddressTrade) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.AddressTrade.address":
+		return len(x.Address) != 0
+	case "regen.ecocredit.marketplace.v1.AddressTrade.trade_id":
+		return x.TradeId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AddressTrade"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AddressTrade does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AddressTrade) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.AddressTrade.address":
+		x.Address = nil
+	case "regen.ecocredit.marketplace.v1.AddressTrade.trade_id":
+		x.TradeId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AddressTrade"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AddressTrade does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AddressTrade) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.AddressTrade.address":
+		value := x.Address
+		return protoreflect.ValueOfBytes(value)
+	case "regen.ecocredit.marketplace.v1.AddressTrade.trade_id":
+		value := x.TradeId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AddressTrade"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AddressTrade does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AddressTrade) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.AddressTrade.address":
+		x.Address = value.Bytes()
+	case "regen.ecocredit.marketplace.v1.AddressTrade.trade_id":
+		x.TradeId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AddressTrade"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AddressTrade does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AddressTrade) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.AddressTrade.address":
+		panic(fmt.Errorf("field address of message regen.ecocredit.marketplace.v1.AddressTrade is not mutable"))
+	case "regen.ecocredit.marketplace.v1.AddressTrade.trade_id":
+		panic(fmt.Errorf("field trade_id of message regen.ecocredit.marketplace.v1.AddressTrade is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AddressTrade"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AddressTrade does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AddressTrade) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.AddressTrade.address":
+		return protoreflect.ValueOfBytes(nil)
+	case "regen.ecocredit.marketplace.v1.AddressTrade.trade_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AddressTrade"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AddressTrade does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AddressTrade) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.AddressTrade", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AddressTrade) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AddressTrade) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AddressTrade) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AddressTrade) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AddressTrade)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TradeId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TradeId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AddressTrade)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.TradeId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TradeId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AddressTrade)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AddressTrade: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AddressTrade: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = append(x.Address[:0], dAtA[iNdEx:postIndex]...)
+				if x.Address == nil {
+					x.Address = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TradeId", wireType)
+				}
+				x.TradeId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TradeId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Offer                         protoreflect.MessageDescriptor
+	fd_Offer_id                      protoreflect.FieldDescriptor
+	fd_Offer_buyer                   protoreflect.FieldDescriptor
+	fd_Offer_batch_key               protoreflect.FieldDescriptor
+	fd_Offer_holder                  protoreflect.FieldDescriptor
+	fd_Offer_quantity                protoreflect.FieldDescriptor
+	fd_Offer_market_id               protoreflect.FieldDescriptor
+	fd_Offer_bid_amount              protoreflect.FieldDescriptor
+	fd_Offer_disable_auto_retire     protoreflect.FieldDescriptor
+	fd_Offer_retirement_jurisdiction protoreflect.FieldDescriptor
+	fd_Offer_expiration              protoreflect.FieldDescriptor
+	fd_Offer_maker_fee_bps           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_Offer = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("Offer")
+	fd_Offer_id = md_Offer.Fields().ByName("id")
+	fd_Offer_buyer = md_Offer.Fields().ByName("buyer")
+	fd_Offer_batch_key = md_Offer.Fields().ByName("batch_key")
+	fd_Offer_holder = md_Offer.Fields().ByName("holder")
+	fd_Offer_quantity = md_Offer.Fields().ByName("quantity")
+	fd_Offer_market_id = md_Offer.Fields().ByName("market_id")
+	fd_Offer_bid_amount = md_Offer.Fields().ByName("bid_amount")
+	fd_Offer_disable_auto_retire = md_Offer.Fields().ByName("disable_auto_retire")
+	fd_Offer_retirement_jurisdiction = md_Offer.Fields().ByName("retirement_jurisdiction")
+	fd_Offer_expiration = md_Offer.Fields().ByName("expiration")
+	fd_Offer_maker_fee_bps = md_Offer.Fields().ByName("maker_fee_bps")
+}
+
+var _ protoreflect.Message = (*fastReflection_Offer)(nil)
+
+type fastReflection_Offer Offer
+
+func (x *Offer) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Offer)(x)
+}
+
+func (x *Offer) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Offer_messageType fastReflection_Offer_messageType
+var _ protoreflect.MessageType = fastReflection_Offer_messageType{}
+
+type fastReflection_Offer_messageType struct{}
+
+func (x fastReflection_Offer_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Offer)(nil)
+}
+func (x fastReflection_Offer_messageType) New() protoreflect.Message {
+	return new(fastReflection_Offer)
+}
+func (x fastReflection_Offer_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Offer
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Offer) Descriptor() protoreflect.MessageDescriptor {
+	return md_Offer
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Offer) Type() protoreflect.MessageType {
+	return _fastReflection_Offer_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Offer) New() protoreflect.Message {
+	return new(fastReflection_Offer)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Offer) Interface() protoreflect.ProtoMessage {
+	return (*Offer)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Offer) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_Offer_id, value) {
+			return
+		}
+	}
+	if len(x.Buyer) != 0 {
+		value := protoreflect.ValueOfBytes(x.Buyer)
+		if !f(fd_Offer_buyer, value) {
+			return
+		}
+	}
+	if x.BatchKey != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BatchKey)
+		if !f(fd_Offer_batch_key, value) {
+			return
+		}
+	}
+	if len(x.Holder) != 0 {
+		value := protoreflect.ValueOfBytes(x.Holder)
+		if !f(fd_Offer_holder, value) {
+			return
+		}
+	}
+	if x.Quantity != "" {
+		value := protoreflect.ValueOfString(x.Quantity)
+		if !f(fd_Offer_quantity, value) {
+			return
+		}
+	}
+	if x.MarketId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MarketId)
+		if !f(fd_Offer_market_id, value) {
+			return
+		}
+	}
+	if x.BidAmount != "" {
+		value := protoreflect.ValueOfString(x.BidAmount)
+		if !f(fd_Offer_bid_amount, value) {
+			return
+		}
+	}
+	if x.DisableAutoRetire != false {
+		value := protoreflect.ValueOfBool(x.DisableAutoRetire)
+		if !f(fd_Offer_disable_auto_retire, value) {
+			return
+		}
+	}
+	if x.RetirementJurisdiction != "" {
+		value := protoreflect.ValueOfString(x.RetirementJurisdiction)
+		if !f(fd_Offer_retirement_jurisdiction, value) {
+			return
+		}
+	}
+	if x.Expiration != nil {
+		value := protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+		if !f(fd_Offer_expiration, value) {
+			return
+		}
+	}
+	if x.MakerFeeBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MakerFeeBps)
+		if !f(fd_Offer_maker_fee_bps, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Offer) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Offer.id":
+		return x.Id != uint64(0)
+	case "regen.ecocredit.marketplace.v1.Offer.buyer":
+		return len(x.Buyer) != 0
+	case "regen.ecocredit.marketplace.v1.Offer.batch_key":
+		return x.BatchKey != uint64(0)
+	case "regen.ecocredit.marketplace.v1.Offer.holder":
+		return len(x.Holder) != 0
+	case "regen.ecocredit.marketplace.v1.Offer.quantity":
+		return x.Quantity != ""
+	case "regen.ecocredit.marketplace.v1.Offer.market_id":
+		return x.MarketId != uint64(0)
+	case "regen.ecocredit.marketplace.v1.Offer.bid_amount":
+		return x.BidAmount != ""
+	case "regen.ecocredit.marketplace.v1.Offer.disable_auto_retire":
+		return x.DisableAutoRetire != false
+	case "regen.ecocredit.marketplace.v1.Offer.retirement_jurisdiction":
+		return x.RetirementJurisdiction != ""
+	case "regen.ecocredit.marketplace.v1.Offer.expiration":
+		return x.Expiration != nil
+	case "regen.ecocredit.marketplace.v1.Offer.maker_fee_bps":
+		return x.MakerFeeBps != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Offer"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Offer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Offer) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Offer.id":
+		x.Id = uint64(0)
+	case "regen.ecocredit.marketplace.v1.Offer.buyer":
+		x.Buyer = nil
+	case "regen.ecocredit.marketplace.v1.Offer.batch_key":
+		x.BatchKey = uint64(0)
+	case "regen.ecocredit.marketplace.v1.Offer.holder":
+		x.Holder = nil
+	case "regen.ecocredit.marketplace.v1.Offer.quantity":
+		x.Quantity = ""
+	case "regen.ecocredit.marketplace.v1.Offer.market_id":
+		x.MarketId = uint64(0)
+	case "regen.ecocredit.marketplace.v1.Offer.bid_amount":
+		x.BidAmount = ""
+	case "regen.ecocredit.marketplace.v1.Offer.disable_auto_retire":
+		x.DisableAutoRetire = false
+	case "regen.ecocredit.marketplace.v1.Offer.retirement_jurisdiction":
+		x.RetirementJurisdiction = ""
+	case "regen.ecocredit.marketplace.v1.Offer.expiration":
+		x.Expiration = nil
+	case "regen.ecocredit.marketplace.v1.Offer.maker_fee_bps":
+		x.MakerFeeBps = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Offer"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Offer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Offer) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.Offer.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.Offer.buyer":
+		value := x.Buyer
+		return protoreflect.ValueOfBytes(value)
+	case "regen.ecocredit.marketplace.v1.Offer.batch_key":
+		value := x.BatchKey
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.Offer.holder":
+		value := x.Holder
+		return protoreflect.ValueOfBytes(value)
+	case "regen.ecocredit.marketplace.v1.Offer.quantity":
+		value := x.Quantity
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Offer.market_id":
+		value := x.MarketId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.Offer.bid_amount":
+		value := x.BidAmount
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Offer.disable_auto_retire":
+		value := x.DisableAutoRetire
+		return protoreflect.ValueOfBool(value)
+	case "regen.ecocredit.marketplace.v1.Offer.retirement_jurisdiction":
+		value := x.RetirementJurisdiction
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Offer.expiration":
+		value := x.Expiration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.Offer.maker_fee_bps":
+		value := x.MakerFeeBps
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Offer"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Offer does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Offer) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Offer.id":
+		x.Id = value.Uint()
+	case "regen.ecocredit.marketplace.v1.Offer.buyer":
+		x.Buyer = value.Bytes()
+	case "regen.ecocredit.marketplace.v1.Offer.batch_key":
+		x.BatchKey = value.Uint()
+	case "regen.ecocredit.marketplace.v1.Offer.holder":
+		x.Holder = value.Bytes()
+	case "regen.ecocredit.marketplace.v1.Offer.quantity":
+		x.Quantity = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Offer.market_id":
+		x.MarketId = value.Uint()
+	case "regen.ecocredit.marketplace.v1.Offer.bid_amount":
+		x.BidAmount = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Offer.disable_auto_retire":
+		x.DisableAutoRetire = value.Bool()
+	case "regen.ecocredit.marketplace.v1.Offer.retirement_jurisdiction":
+		x.RetirementJurisdiction = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Offer.expiration":
+		x.Expiration = value.Message().Interface().(*timestamppb.Timestamp)
+	case "regen.ecocredit.marketplace.v1.Offer.maker_fee_bps":
+		x.MakerFeeBps = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Offer"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Offer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Offer) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Offer.expiration":
+		if x.Expiration == nil {
+			x.Expiration = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.Offer.id":
+		panic(fmt.Errorf("field id of message regen.ecocredit.marketplace.v1.Offer is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Offer.buyer":
+		panic(fmt.Errorf("field buyer of message regen.ecocredit.marketplace.v1.Offer is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Offer.batch_key":
+		panic(fmt.Errorf("field batch_key of message regen.ecocredit.marketplace.v1.Offer is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Offer.holder":
+		panic(fmt.Errorf("field holder of message regen.ecocredit.marketplace.v1.Offer is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Offer.quantity":
+		panic(fmt.Errorf("field quantity of message regen.ecocredit.marketplace.v1.Offer is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Offer.market_id":
+		panic(fmt.Errorf("field market_id of message regen.ecocredit.marketplace.v1.Offer is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Offer.bid_amount":
+		panic(fmt.Errorf("field bid_amount of message regen.ecocredit.marketplace.v1.Offer is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Offer.disable_auto_retire":
+		panic(fmt.Errorf("field disable_auto_retire of message regen.ecocredit.marketplace.v1.Offer is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Offer.retirement_jurisdiction":
+		panic(fmt.Errorf("field retirement_jurisdiction of message regen.ecocredit.marketplace.v1.Offer is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Offer.maker_fee_bps":
+		panic(fmt.Errorf("field maker_fee_bps of message regen.ecocredit.marketplace.v1.Offer is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Offer"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Offer does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Offer) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Offer.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.Offer.buyer":
+		return protoreflect.ValueOfBytes(nil)
+	case "regen.ecocredit.marketplace.v1.Offer.batch_key":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.Offer.holder":
+		return protoreflect.ValueOfBytes(nil)
+	case "regen.ecocredit.marketplace.v1.Offer.quantity":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Offer.market_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.Offer.bid_amount":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Offer.disable_auto_retire":
+		return protoreflect.ValueOfBool(false)
+	case "regen.ecocredit.marketplace.v1.Offer.retirement_jurisdiction":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Offer.expiration":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.Offer.maker_fee_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Offer"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Offer does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Offer) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.Offer", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Offer) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Offer) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Offer) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Offer) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Offer)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Buyer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BatchKey != 0 {
+			n += 1 + runtime.Sov(uint64(x.BatchKey))
+		}
+		l = len(x.Holder)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Quantity)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MarketId != 0 {
+			n += 1 + runtime.Sov(uint64(x.MarketId))
+		}
+		l = len(x.BidAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DisableAutoRetire {
+			n += 2
+		}
+		l = len(x.RetirementJurisdiction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Expiration != nil {
+			l = options.Size(x.Expiration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MakerFeeBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.MakerFeeBps))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Offer)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MakerFeeBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MakerFeeBps))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.Expiration != nil {
+			encoded, err := options.Marshal(x.Expiration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.RetirementJurisdiction) > 0 {
+			i -= len(x.RetirementJurisdiction)
+			copy(dAtA[i:], x.RetirementJurisdiction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RetirementJurisdiction)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.DisableAutoRetire {
+			i--
+			if x.DisableAutoRetire {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.BidAmount) > 0 {
+			i -= len(x.BidAmount)
+			copy(dAtA[i:], x.BidAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BidAmount)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.MarketId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MarketId))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Quantity) > 0 {
+			i -= len(x.Quantity)
+			copy(dAtA[i:], x.Quantity)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Quantity)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Holder) > 0 {
+			i -= len(x.Holder)
+			copy(dAtA[i:], x.Holder)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Holder)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.BatchKey != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BatchKey))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Buyer) > 0 {
+			i -= len(x.Buyer)
+			copy(dAtA[i:], x.Buyer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Buyer)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Offer)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Offer: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Offer: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Buyer = append(x.Buyer[:0], dAtA[iNdEx:postIndex]...)
+				if x.Buyer == nil {
+					x.Buyer = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+				}
+				x.BatchKey = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BatchKey |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Holder = append(x.Holder[:0], dAtA[iNdEx:postIndex]...)
+				if x.Holder == nil {
+					x.Holder = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Quantity = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				}
+				x.MarketId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MarketId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BidAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BidAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.DisableAutoRetire = bool(v != 0)
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetirementJurisdiction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RetirementJurisdiction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Expiration == nil {
+					x.Expiration = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MakerFeeBps", wireType)
+				}
+				x.MakerFeeBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MakerFeeBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_DutchAuction                     protoreflect.MessageDescriptor
+	fd_DutchAuction_id                  protoreflect.FieldDescriptor
+	fd_DutchAuction_seller              protoreflect.FieldDescriptor
+	fd_DutchAuction_batch_key           protoreflect.FieldDescriptor
+	fd_DutchAuction_quantity            protoreflect.FieldDescriptor
+	fd_DutchAuction_market_id           protoreflect.FieldDescriptor
+	fd_DutchAuction_start_amount        protoreflect.FieldDescriptor
+	fd_DutchAuction_floor_amount        protoreflect.FieldDescriptor
+	fd_DutchAuction_start_time          protoreflect.FieldDescriptor
+	fd_DutchAuction_end_time            protoreflect.FieldDescriptor
+	fd_DutchAuction_disable_auto_retire protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_DutchAuction = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("DutchAuction")
+	fd_DutchAuction_id = md_DutchAuction.Fields().ByName("id")
+	fd_DutchAuction_seller = md_DutchAuction.Fields().ByName("seller")
+	fd_DutchAuction_batch_key = md_DutchAuction.Fields().ByName("batch_key")
+	fd_DutchAuction_quantity = md_DutchAuction.Fields().ByName("quantity")
+	fd_DutchAuction_market_id = md_DutchAuction.Fields().ByName("market_id")
+	fd_DutchAuction_start_amount = md_DutchAuction.Fields().ByName("start_amount")
+	fd_DutchAuction_floor_amount = md_DutchAuction.Fields().ByName("floor_amount")
+	fd_DutchAuction_start_time = md_DutchAuction.Fields().ByName("start_time")
+	fd_DutchAuction_end_time = md_DutchAuction.Fields().ByName("end_time")
+	fd_DutchAuction_disable_auto_retire = md_DutchAuction.Fields().ByName("disable_auto_retire")
+}
+
+var _ protoreflect.Message = (*fastReflection_DutchAuction)(nil)
+
+type fastReflection_DutchAuction DutchAuction
+
+func (x *DutchAuction) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DutchAuction)(x)
+}
+
+func (x *DutchAuction) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DutchAuction_messageType fastReflection_DutchAuction_messageType
+var _ protoreflect.MessageType = fastReflection_DutchAuction_messageType{}
+
+type fastReflection_DutchAuction_messageType struct{}
+
+func (x fastReflection_DutchAuction_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DutchAuction)(nil)
+}
+func (x fastReflection_DutchAuction_messageType) New() protoreflect.Message {
+	return new(fastReflection_DutchAuction)
+}
+func (x fastReflection_DutchAuction_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DutchAuction
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DutchAuction) Descriptor() protoreflect.MessageDescriptor {
+	return md_DutchAuction
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DutchAuction) Type() protoreflect.MessageType {
+	return _fastReflection_DutchAuction_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DutchAuction) New() protoreflect.Message {
+	return new(fastReflection_DutchAuction)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DutchAuction) Interface() protoreflect.ProtoMessage {
+	return (*DutchAuction)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DutchAuction) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_DutchAuction_id, value) {
+			return
+		}
+	}
+	if len(x.Seller) != 0 {
+		value := protoreflect.ValueOfBytes(x.Seller)
+		if !f(fd_DutchAuction_seller, value) {
+			return
+		}
+	}
+	if x.BatchKey != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BatchKey)
+		if !f(fd_DutchAuction_batch_key, value) {
+			return
+		}
+	}
+	if x.Quantity != "" {
+		value := protoreflect.ValueOfString(x.Quantity)
+		if !f(fd_DutchAuction_quantity, value) {
+			return
+		}
+	}
+	if x.MarketId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MarketId)
+		if !f(fd_DutchAuction_market_id, value) {
+			return
+		}
+	}
+	if x.StartAmount != "" {
+		value := protoreflect.ValueOfString(x.StartAmount)
+		if !f(fd_DutchAuction_start_amount, value) {
+			return
+		}
+	}
+	if x.FloorAmount != "" {
+		value := protoreflect.ValueOfString(x.FloorAmount)
+		if !f(fd_DutchAuction_floor_amount, value) {
+			return
+		}
+	}
+	if x.StartTime != nil {
+		value := protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+		if !f(fd_DutchAuction_start_time, value) {
+			return
+		}
+	}
+	if x.EndTime != nil {
+		value := protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+		if !f(fd_DutchAuction_end_time, value) {
+			return
+		}
+	}
+	if x.DisableAutoRetire != false {
+		value := protoreflect.ValueOfBool(x.DisableAutoRetire)
+		if !f(fd_DutchAuction_disable_auto_retire, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DutchAuction) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.DutchAuction.id":
+		return x.Id != uint64(0)
+	case "regen.ecocredit.marketplace.v1.DutchAuction.seller":
+		return len(x.Seller) != 0
+	case "regen.ecocredit.marketplace.v1.DutchAuction.batch_key":
+		return x.BatchKey != uint64(0)
+	case "regen.ecocredit.marketplace.v1.DutchAuction.quantity":
+		return x.Quantity != ""
+	case "regen.ecocredit.marketplace.v1.DutchAuction.market_id":
+		return x.MarketId != uint64(0)
+	case "regen.ecocredit.marketplace.v1.DutchAuction.start_amount":
+		return x.StartAmount != ""
+	case "regen.ecocredit.marketplace.v1.DutchAuction.floor_amount":
+		return x.FloorAmount != ""
+	case "regen.ecocredit.marketplace.v1.DutchAuction.start_time":
+		return x.StartTime != nil
+	case "regen.ecocredit.marketplace.v1.DutchAuction.end_time":
+		return x.EndTime != nil
+	case "regen.ecocredit.marketplace.v1.DutchAuction.disable_auto_retire":
+		return x.DisableAutoRetire != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.DutchAuction"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.DutchAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DutchAuction) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.DutchAuction.id":
+		x.Id = uint64(0)
+	case "regen.ecocredit.marketplace.v1.DutchAuction.seller":
+		x.Seller = nil
+	case "regen.ecocredit.marketplace.v1.DutchAuction.batch_key":
+		x.BatchKey = uint64(0)
+	case "regen.ecocredit.marketplace.v1.DutchAuction.quantity":
+		x.Quantity = ""
+	case "regen.ecocredit.marketplace.v1.DutchAuction.market_id":
+		x.MarketId = uint64(0)
+	case "regen.ecocredit.marketplace.v1.DutchAuction.start_amount":
+		x.StartAmount = ""
+	case "regen.ecocredit.marketplace.v1.DutchAuction.floor_amount":
+		x.FloorAmount = ""
+	case "regen.ecocredit.marketplace.v1.DutchAuction.start_time":
+		x.StartTime = nil
+	case "regen.ecocredit.marketplace.v1.DutchAuction.end_time":
+		x.EndTime = nil
+	case "regen.ecocredit.marketplace.v1.DutchAuction.disable_auto_retire":
+		x.DisableAutoRetire = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.DutchAuction"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.DutchAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DutchAuction) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.DutchAuction.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.DutchAuction.seller":
+		value := x.Seller
+		return protoreflect.ValueOfBytes(value)
+	case "regen.ecocredit.marketplace.v1.DutchAuction.batch_key":
+		value := x.BatchKey
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.DutchAuction.quantity":
+		value := x.Quantity
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.DutchAuction.market_id":
+		value := x.MarketId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.DutchAuction.start_amount":
+		value := x.StartAmount
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.DutchAuction.floor_amount":
+		value := x.FloorAmount
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.DutchAuction.start_time":
+		value := x.StartTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.DutchAuction.end_time":
+		value := x.EndTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.DutchAuction.disable_auto_retire":
+		value := x.DisableAutoRetire
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.DutchAuction"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.DutchAuction does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DutchAuction) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.DutchAuction.id":
+		x.Id = value.Uint()
+	case "regen.ecocredit.marketplace.v1.DutchAuction.seller":
+		x.Seller = value.Bytes()
+	case "regen.ecocredit.marketplace.v1.DutchAuction.batch_key":
+		x.BatchKey = value.Uint()
+	case "regen.ecocredit.marketplace.v1.DutchAuction.quantity":
+		x.Quantity = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.DutchAuction.market_id":
+		x.MarketId = value.Uint()
+	case "regen.ecocredit.marketplace.v1.DutchAuction.start_amount":
+		x.StartAmount = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.DutchAuction.floor_amount":
+		x.FloorAmount = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.DutchAuction.start_time":
+		x.StartTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "regen.ecocredit.marketplace.v1.DutchAuction.end_time":
+		x.EndTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "regen.ecocredit.marketplace.v1.DutchAuction.disable_auto_retire":
+		x.DisableAutoRetire = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.DutchAuction"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.DutchAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DutchAuction) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.DutchAuction.start_time":
+		if x.StartTime == nil {
+			x.StartTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.DutchAuction.end_time":
+		if x.EndTime == nil {
+			x.EndTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.DutchAuction.id":
+		panic(fmt.Errorf("field id of message regen.ecocredit.marketplace.v1.DutchAuction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.DutchAuction.seller":
+		panic(fmt.Errorf("field seller of message regen.ecocredit.marketplace.v1.DutchAuction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.DutchAuction.batch_key":
+		panic(fmt.Errorf("field batch_key of message regen.ecocredit.marketplace.v1.DutchAuction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.DutchAuction.quantity":
+		panic(fmt.Errorf("field quantity of message regen.ecocredit.marketplace.v1.DutchAuction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.DutchAuction.market_id":
+		panic(fmt.Errorf("field market_id of message regen.ecocredit.marketplace.v1.DutchAuction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.DutchAuction.start_amount":
+		panic(fmt.Errorf("field start_amount of message regen.ecocredit.marketplace.v1.DutchAuction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.DutchAuction.floor_amount":
+		panic(fmt.Errorf("field floor_amount of message regen.ecocredit.marketplace.v1.DutchAuction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.DutchAuction.disable_auto_retire":
+		panic(fmt.Errorf("field disable_auto_retire of message regen.ecocredit.marketplace.v1.DutchAuction is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.DutchAuction"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.DutchAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DutchAuction) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.DutchAuction.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.DutchAuction.seller":
+		return protoreflect.ValueOfBytes(nil)
+	case "regen.ecocredit.marketplace.v1.DutchAuction.batch_key":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.DutchAuction.quantity":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.DutchAuction.market_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.DutchAuction.start_amount":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.DutchAuction.floor_amount":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.DutchAuction.start_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.DutchAuction.end_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.DutchAuction.disable_auto_retire":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.DutchAuction"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.DutchAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DutchAuction) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.DutchAuction", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DutchAuction) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DutchAuction) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DutchAuction) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DutchAuction) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DutchAuction)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Seller)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BatchKey != 0 {
+			n += 1 + runtime.Sov(uint64(x.BatchKey))
+		}
+		l = len(x.Quantity)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MarketId != 0 {
+			n += 1 + runtime.Sov(uint64(x.MarketId))
+		}
+		l = len(x.StartAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.FloorAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.StartTime != nil {
+			l = options.Size(x.StartTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EndTime != nil {
+			l = options.Size(x.EndTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DisableAutoRetire {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DutchAuction)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.DisableAutoRetire {
+			i--
+			if x.DisableAutoRetire {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.EndTime != nil {
+			encoded, err := options.Marshal(x.EndTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.StartTime != nil {
+			encoded, err := options.Marshal(x.StartTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.FloorAmount) > 0 {
+			i -= len(x.FloorAmount)
+			copy(dAtA[i:], x.FloorAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FloorAmount)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.StartAmount) > 0 {
+			i -= len(x.StartAmount)
+			copy(dAtA[i:], x.StartAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StartAmount)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.MarketId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MarketId))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Quantity) > 0 {
+			i -= len(x.Quantity)
+			copy(dAtA[i:], x.Quantity)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Quantity)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.BatchKey != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BatchKey))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Seller) > 0 {
+			i -= len(x.Seller)
+			copy(dAtA[i:], x.Seller)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Seller)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DutchAuction)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DutchAuction: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DutchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Seller = append(x.Seller[:0], dAtA[iNdEx:postIndex]...)
+				if x.Seller == nil {
+					x.Seller = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+				}
+				x.BatchKey = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BatchKey |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Quantity = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				}
+				x.MarketId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MarketId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StartAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FloorAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FloorAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.StartTime == nil {
+					x.StartTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StartTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EndTime == nil {
+					x.EndTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EndTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.DisableAutoRetire = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SealedBidAuction                     protoreflect.MessageDescriptor
+	fd_SealedBidAuction_id                  protoreflect.FieldDescriptor
+	fd_SealedBidAuction_seller              protoreflect.FieldDescriptor
+	fd_SealedBidAuction_batch_key           protoreflect.FieldDescriptor
+	fd_SealedBidAuction_quantity            protoreflect.FieldDescriptor
+	fd_SealedBidAuction_market_id           protoreflect.FieldDescriptor
+	fd_SealedBidAuction_reserve_amount      protoreflect.FieldDescriptor
+	fd_SealedBidAuction_commit_end_height   protoreflect.FieldDescriptor
+	fd_SealedBidAuction_reveal_end_height   protoreflect.FieldDescriptor
+	fd_SealedBidAuction_disable_auto_retire protoreflect.FieldDescriptor
+	fd_SealedBidAuction_maker_fee_bps       protoreflect.FieldDescriptor
+	fd_SealedBidAuction_taker_fee_bps       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_SealedBidAuction = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("SealedBidAuction")
+	fd_SealedBidAuction_id = md_SealedBidAuction.Fields().ByName("id")
+	fd_SealedBidAuction_seller = md_SealedBidAuction.Fields().ByName("seller")
+	fd_SealedBidAuction_batch_key = md_SealedBidAuction.Fields().ByName("batch_key")
+	fd_SealedBidAuction_quantity = md_SealedBidAuction.Fields().ByName("quantity")
+	fd_SealedBidAuction_market_id = md_SealedBidAuction.Fields().ByName("market_id")
+	fd_SealedBidAuction_reserve_amount = md_SealedBidAuction.Fields().ByName("reserve_amount")
+	fd_SealedBidAuction_commit_end_height = md_SealedBidAuction.Fields().ByName("commit_end_height")
+	fd_SealedBidAuction_reveal_end_height = md_SealedBidAuction.Fields().ByName("reveal_end_height")
+	fd_SealedBidAuction_disable_auto_retire = md_SealedBidAuction.Fields().ByName("disable_auto_retire")
+	fd_SealedBidAuction_maker_fee_bps = md_SealedBidAuction.Fields().ByName("maker_fee_bps")
+	fd_SealedBidAuction_taker_fee_bps = md_SealedBidAuction.Fields().ByName("taker_fee_bps")
+}
+
+var _ protoreflect.Message = (*fastReflection_SealedBidAuction)(nil)
+
+type fastReflection_SealedBidAuction SealedBidAuction
+
+func (x *SealedBidAuction) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SealedBidAuction)(x)
+}
+
+func (x *SealedBidAuction) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SealedBidAuction_messageType fastReflection_SealedBidAuction_messageType
+var _ protoreflect.MessageType = fastReflection_SealedBidAuction_messageType{}
+
+type fastReflection_SealedBidAuction_messageType struct{}
+
+func (x fastReflection_SealedBidAuction_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SealedBidAuction)(nil)
+}
+func (x fastReflection_SealedBidAuction_messageType) New() protoreflect.Message {
+	return new(fastReflection_SealedBidAuction)
+}
+func (x fastReflection_SealedBidAuction_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SealedBidAuction
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SealedBidAuction) Descriptor() protoreflect.MessageDescriptor {
+	return md_SealedBidAuction
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SealedBidAuction) Type() protoreflect.MessageType {
+	return _fastReflection_SealedBidAuction_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SealedBidAuction) New() protoreflect.Message {
+	return new(fastReflection_SealedBidAuction)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SealedBidAuction) Interface() protoreflect.ProtoMessage {
+	return (*SealedBidAuction)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SealedBidAuction) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_SealedBidAuction_id, value) {
+			return
+		}
+	}
+	if len(x.Seller) != 0 {
+		value := protoreflect.ValueOfBytes(x.Seller)
+		if !f(fd_SealedBidAuction_seller, value) {
+			return
+		}
+	}
+	if x.BatchKey != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BatchKey)
+		if !f(fd_SealedBidAuction_batch_key, value) {
+			return
+		}
+	}
+	if x.Quantity != "" {
+		value := protoreflect.ValueOfString(x.Quantity)
+		if !f(fd_SealedBidAuction_quantity, value) {
+			return
+		}
+	}
+	if x.MarketId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MarketId)
+		if !f(fd_SealedBidAuction_market_id, value) {
+			return
+		}
+	}
+	if x.ReserveAmount != "" {
+		value := protoreflect.ValueOfString(x.ReserveAmount)
+		if !f(fd_SealedBidAuction_reserve_amount, value) {
+			return
+		}
+	}
+	if x.CommitEndHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CommitEndHeight)
+		if !f(fd_SealedBidAuction_commit_end_height, value) {
+			return
+		}
+	}
+	if x.RevealEndHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.RevealEndHeight)
+		if !f(fd_SealedBidAuction_reveal_end_height, value) {
+			return
+		}
+	}
+	if x.DisableAutoRetire != false {
+		value := protoreflect.ValueOfBool(x.DisableAutoRetire)
+		if !f(fd_SealedBidAuction_disable_auto_retire, value) {
+			return
+		}
+	}
+	if x.MakerFeeBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MakerFeeBps)
+		if !f(fd_SealedBidAuction_maker_fee_bps, value) {
+			return
+		}
+	}
+	if x.TakerFeeBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.TakerFeeBps)
+		if !f(fd_SealedBidAuction_taker_fee_bps, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SealedBidAuction) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.id":
+		return x.Id != uint64(0)
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.seller":
+		return len(x.Seller) != 0
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.batch_key":
+		return x.BatchKey != uint64(0)
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.quantity":
+		return x.Quantity != ""
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.market_id":
+		return x.MarketId != uint64(0)
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.reserve_amount":
+		return x.ReserveAmount != ""
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.commit_end_height":
+		return x.CommitEndHeight != int64(0)
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.reveal_end_height":
+		return x.RevealEndHeight != int64(0)
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.disable_auto_retire":
+		return x.DisableAutoRetire != false
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.maker_fee_bps":
+		return x.MakerFeeBps != uint32(0)
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.taker_fee_bps":
+		return x.TakerFeeBps != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SealedBidAuction"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SealedBidAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SealedBidAuction) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.id":
+		x.Id = uint64(0)
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.seller":
+		x.Seller = nil
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.batch_key":
+		x.BatchKey = uint64(0)
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.quantity":
+		x.Quantity = ""
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.market_id":
+		x.MarketId = uint64(0)
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.reserve_amount":
+		x.ReserveAmount = ""
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.commit_end_height":
+		x.CommitEndHeight = int64(0)
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.reveal_end_height":
+		x.RevealEndHeight = int64(0)
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.disable_auto_retire":
+		x.DisableAutoRetire = false
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.maker_fee_bps":
+		x.MakerFeeBps = uint32(0)
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.taker_fee_bps":
+		x.TakerFeeBps = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SealedBidAuction"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SealedBidAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SealedBidAuction) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.seller":
+		value := x.Seller
+		return protoreflect.ValueOfBytes(value)
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.batch_key":
+		value := x.BatchKey
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.quantity":
+		value := x.Quantity
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.market_id":
+		value := x.MarketId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.reserve_amount":
+		value := x.ReserveAmount
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.commit_end_height":
+		value := x.CommitEndHeight
+		return protoreflect.ValueOfInt64(value)
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.reveal_end_height":
+		value := x.RevealEndHeight
+		return protoreflect.ValueOfInt64(value)
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.disable_auto_retire":
+		value := x.DisableAutoRetire
+		return protoreflect.ValueOfBool(value)
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.maker_fee_bps":
+		value := x.MakerFeeBps
+		return protoreflect.ValueOfUint32(value)
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.taker_fee_bps":
+		value := x.TakerFeeBps
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SealedBidAuction"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SealedBidAuction does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SealedBidAuction) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.id":
+		x.Id = value.Uint()
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.seller":
+		x.Seller = value.Bytes()
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.batch_key":
+		x.BatchKey = value.Uint()
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.quantity":
+		x.Quantity = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.market_id":
+		x.MarketId = value.Uint()
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.reserve_amount":
+		x.ReserveAmount = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.commit_end_height":
+		x.CommitEndHeight = value.Int()
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.reveal_end_height":
+		x.RevealEndHeight = value.Int()
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.disable_auto_retire":
+		x.DisableAutoRetire = value.Bool()
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.maker_fee_bps":
+		x.MakerFeeBps = uint32(value.Uint())
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.taker_fee_bps":
+		x.TakerFeeBps = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SealedBidAuction"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SealedBidAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SealedBidAuction) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.id":
+		panic(fmt.Errorf("field id of message regen.ecocredit.marketplace.v1.SealedBidAuction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.seller":
+		panic(fmt.Errorf("field seller of message regen.ecocredit.marketplace.v1.SealedBidAuction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.batch_key":
+		panic(fmt.Errorf("field batch_key of message regen.ecocredit.marketplace.v1.SealedBidAuction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.quantity":
+		panic(fmt.Errorf("field quantity of message regen.ecocredit.marketplace.v1.SealedBidAuction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.market_id":
+		panic(fmt.Errorf("field market_id of message regen.ecocredit.marketplace.v1.SealedBidAuction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.reserve_amount":
+		panic(fmt.Errorf("field reserve_amount of message regen.ecocredit.marketplace.v1.SealedBidAuction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.commit_end_height":
+		panic(fmt.Errorf("field commit_end_height of message regen.ecocredit.marketplace.v1.SealedBidAuction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.reveal_end_height":
+		panic(fmt.Errorf("field reveal_end_height of message regen.ecocredit.marketplace.v1.SealedBidAuction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.disable_auto_retire":
+		panic(fmt.Errorf("field disable_auto_retire of message regen.ecocredit.marketplace.v1.SealedBidAuction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.maker_fee_bps":
+		panic(fmt.Errorf("field maker_fee_bps of message regen.ecocredit.marketplace.v1.SealedBidAuction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.taker_fee_bps":
+		panic(fmt.Errorf("field taker_fee_bps of message regen.ecocredit.marketplace.v1.SealedBidAuction is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SealedBidAuction"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SealedBidAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SealedBidAuction) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.seller":
+		return protoreflect.ValueOfBytes(nil)
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.batch_key":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.quantity":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.market_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.reserve_amount":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.commit_end_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.reveal_end_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.disable_auto_retire":
+		return protoreflect.ValueOfBool(false)
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.maker_fee_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "regen.ecocredit.marketplace.v1.SealedBidAuction.taker_fee_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SealedBidAuction"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SealedBidAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SealedBidAuction) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.SealedBidAuction", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SealedBidAuction) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SealedBidAuction) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SealedBidAuction) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SealedBidAuction) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SealedBidAuction)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Seller)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BatchKey != 0 {
+			n += 1 + runtime.Sov(uint64(x.BatchKey))
+		}
+		l = len(x.Quantity)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MarketId != 0 {
+			n += 1 + runtime.Sov(uint64(x.MarketId))
+		}
+		l = len(x.ReserveAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CommitEndHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.CommitEndHeight))
+		}
+		if x.RevealEndHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.RevealEndHeight))
+		}
+		if x.DisableAutoRetire {
+			n += 2
+		}
+		if x.MakerFeeBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.MakerFeeBps))
+		}
+		if x.TakerFeeBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.TakerFeeBps))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SealedBidAuction)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.TakerFeeBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TakerFeeBps))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.MakerFeeBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MakerFeeBps))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.DisableAutoRetire {
+			i--
+			if x.DisableAutoRetire {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.RevealEndHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RevealEndHeight))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.CommitEndHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CommitEndHeight))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.ReserveAmount) > 0 {
+			i -= len(x.ReserveAmount)
+			copy(dAtA[i:], x.ReserveAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReserveAmount)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.MarketId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MarketId))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Quantity) > 0 {
+			i -= len(x.Quantity)
+			copy(dAtA[i:], x.Quantity)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Quantity)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.BatchKey != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BatchKey))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Seller) > 0 {
+			i -= len(x.Seller)
+			copy(dAtA[i:], x.Seller)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Seller)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SealedBidAuction)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SealedBidAuction: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SealedBidAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Seller = append(x.Seller[:0], dAtA[iNdEx:postIndex]...)
+				if x.Seller == nil {
+					x.Seller = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+				}
+				x.BatchKey = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BatchKey |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Quantity = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				}
+				x.MarketId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MarketId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReserveAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReserveAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommitEndHeight", wireType)
+				}
+				x.CommitEndHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CommitEndHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevealEndHeight", wireType)
+				}
+				x.RevealEndHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RevealEndHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.DisableAutoRetire = bool(v != 0)
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MakerFeeBps", wireType)
+				}
+				x.MakerFeeBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MakerFeeBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TakerFeeBps", wireType)
+				}
+				x.TakerFeeBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TakerFeeBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SealedBid                         protoreflect.MessageDescriptor
+	fd_SealedBid_auction_id              protoreflect.FieldDescriptor
+	fd_SealedBid_bidder                  protoreflect.FieldDescriptor
+	fd_SealedBid_commitment              protoreflect.FieldDescriptor
+	fd_SealedBid_deposit_amount          protoreflect.FieldDescriptor
+	fd_SealedBid_revealed                protoreflect.FieldDescriptor
+	fd_SealedBid_quantity                protoreflect.FieldDescriptor
+	fd_SealedBid_bid_amount              protoreflect.FieldDescriptor
+	fd_SealedBid_disable_auto_retire     protoreflect.FieldDescriptor
+	fd_SealedBid_retirement_jurisdiction protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_SealedBid = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("SealedBid")
+	fd_SealedBid_auction_id = md_SealedBid.Fields().ByName("auction_id")
+	fd_SealedBid_bidder = md_SealedBid.Fields().ByName("bidder")
+	fd_SealedBid_commitment = md_SealedBid.Fields().ByName("commitment")
+	fd_SealedBid_deposit_amount = md_SealedBid.Fields().ByName("deposit_amount")
+	fd_SealedBid_revealed = md_SealedBid.Fields().ByName("revealed")
+	fd_SealedBid_quantity = md_SealedBid.Fields().ByName("quantity")
+	fd_SealedBid_bid_amount = md_SealedBid.Fields().ByName("bid_amount")
+	fd_SealedBid_disable_auto_retire = md_SealedBid.Fields().ByName("disable_auto_retire")
+	fd_SealedBid_retirement_jurisdiction = md_SealedBid.Fields().ByName("retirement_jurisdiction")
+}
+
+var _ protoreflect.Message = (*fastReflection_SealedBid)(nil)
+
+type fastReflection_SealedBid SealedBid
+
+func (x *SealedBid) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SealedBid)(x)
+}
+
+func (x *SealedBid) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SealedBid_messageType fastReflection_SealedBid_messageType
+var _ protoreflect.MessageType = fastReflection_SealedBid_messageType{}
+
+type fastReflection_SealedBid_messageType struct{}
+
+func (x fastReflection_SealedBid_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SealedBid)(nil)
+}
+func (x fastReflection_SealedBid_messageType) New() protoreflect.Message {
+	return new(fastReflection_SealedBid)
+}
+func (x fastReflection_SealedBid_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SealedBid
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SealedBid) Descriptor() protoreflect.MessageDescriptor {
+	return md_SealedBid
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SealedBid) Type() protoreflect.MessageType {
+	return _fastReflection_SealedBid_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SealedBid) New() protoreflect.Message {
+	return new(fastReflection_SealedBid)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SealedBid) Interface() protoreflect.ProtoMessage {
+	return (*SealedBid)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SealedBid) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AuctionId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AuctionId)
+		if !f(fd_SealedBid_auction_id, value) {
+			return
+		}
+	}
+	if len(x.Bidder) != 0 {
+		value := protoreflect.ValueOfBytes(x.Bidder)
+		if !f(fd_SealedBid_bidder, value) {
+			return
+		}
+	}
+	if len(x.Commitment) != 0 {
+		value := protoreflect.ValueOfBytes(x.Commitment)
+		if !f(fd_SealedBid_commitment, value) {
+			return
+		}
+	}
+	if x.DepositAmount != "" {
+		value := protoreflect.ValueOfString(x.DepositAmount)
+		if !f(fd_SealedBid_deposit_amount, value) {
+			return
+		}
+	}
+	if x.Revealed != false {
+		value := protoreflect.ValueOfBool(x.Revealed)
+		if !f(fd_SealedBid_revealed, value) {
+			return
+		}
+	}
+	if x.Quantity != "" {
+		value := protoreflect.ValueOfString(x.Quantity)
+		if !f(fd_SealedBid_quantity, value) {
+			return
+		}
+	}
+	if x.BidAmount != "" {
+		value := protoreflect.ValueOfString(x.BidAmount)
+		if !f(fd_SealedBid_bid_amount, value) {
+			return
+		}
+	}
+	if x.DisableAutoRetire != false {
+		value := protoreflect.ValueOfBool(x.DisableAutoRetire)
+		if !f(fd_SealedBid_disable_auto_retire, value) {
+			return
+		}
+	}
+	if x.RetirementJurisdiction != "" {
+		value := protoreflect.ValueOfString(x.RetirementJurisdiction)
+		if !f(fd_SealedBid_retirement_jurisdiction, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SealedBid) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.SealedBid.auction_id":
+		return x.AuctionId != uint64(0)
+	case "regen.ecocredit.marketplace.v1.SealedBid.bidder":
+		return len(x.Bidder) != 0
+	case "regen.ecocredit.marketplace.v1.SealedBid.commitment":
+		return len(x.Commitment) != 0
+	case "regen.ecocredit.marketplace.v1.SealedBid.deposit_amount":
+		return x.DepositAmount != ""
+	case "regen.ecocredit.marketplace.v1.SealedBid.revealed":
+		return x.Revealed != false
+	case "regen.ecocredit.marketplace.v1.SealedBid.quantity":
+		return x.Quantity != ""
+	case "regen.ecocredit.marketplace.v1.SealedBid.bid_amount":
+		return x.BidAmount != ""
+	case "regen.ecocredit.marketplace.v1.SealedBid.disable_auto_retire":
+		return x.DisableAutoRetire != false
+	case "regen.ecocredit.marketplace.v1.SealedBid.retirement_jurisdiction":
+		return x.RetirementJurisdiction != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SealedBid"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SealedBid does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SealedBid) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.SealedBid.auction_id":
+		x.AuctionId = uint64(0)
+	case "regen.ecocredit.marketplace.v1.SealedBid.bidder":
+		x.Bidder = nil
+	case "regen.ecocredit.marketplace.v1.SealedBid.commitment":
+		x.Commitment = nil
+	case "regen.ecocredit.marketplace.v1.SealedBid.deposit_amount":
+		x.DepositAmount = ""
+	case "regen.ecocredit.marketplace.v1.SealedBid.revealed":
+		x.Revealed = false
+	case "regen.ecocredit.marketplace.v1.SealedBid.quantity":
+		x.Quantity = ""
+	case "regen.ecocredit.marketplace.v1.SealedBid.bid_amount":
+		x.BidAmount = ""
+	case "regen.ecocredit.marketplace.v1.SealedBid.disable_auto_retire":
+		x.DisableAutoRetire = false
+	case "regen.ecocredit.marketplace.v1.SealedBid.retirement_jurisdiction":
+		x.RetirementJurisdiction = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SealedBid"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SealedBid does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SealedBid) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.SealedBid.auction_id":
+		value := x.AuctionId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.SealedBid.bidder":
+		value := x.Bidder
+		return protoreflect.ValueOfBytes(value)
+	case "regen.ecocredit.marketplace.v1.SealedBid.commitment":
+		value := x.Commitment
+		return protoreflect.ValueOfBytes(value)
+	case "regen.ecocredit.marketplace.v1.SealedBid.deposit_amount":
+		value := x.DepositAmount
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.SealedBid.revealed":
+		value := x.Revealed
+		return protoreflect.ValueOfBool(value)
+	case "regen.ecocredit.marketplace.v1.SealedBid.quantity":
+		value := x.Quantity
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.SealedBid.bid_amount":
+		value := x.BidAmount
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.SealedBid.disable_auto_retire":
+		value := x.DisableAutoRetire
+		return protoreflect.ValueOfBool(value)
+	case "regen.ecocredit.marketplace.v1.SealedBid.retirement_jurisdiction":
+		value := x.RetirementJurisdiction
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SealedBid"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SealedBid does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SealedBid) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.SealedBid.auction_id":
+		x.AuctionId = value.Uint()
+	case "regen.ecocredit.marketplace.v1.SealedBid.bidder":
+		x.Bidder = value.Bytes()
+	case "regen.ecocredit.marketplace.v1.SealedBid.commitment":
+		x.Commitment = value.Bytes()
+	case "regen.ecocredit.marketplace.v1.SealedBid.deposit_amount":
+		x.DepositAmount = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.SealedBid.revealed":
+		x.Revealed = value.Bool()
+	case "regen.ecocredit.marketplace.v1.SealedBid.quantity":
+		x.Quantity = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.SealedBid.bid_amount":
+		x.BidAmount = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.SealedBid.disable_auto_retire":
+		x.DisableAutoRetire = value.Bool()
+	case "regen.ecocredit.marketplace.v1.SealedBid.retirement_jurisdiction":
+		x.RetirementJurisdiction = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SealedBid"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SealedBid does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SealedBid) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.SealedBid.auction_id":
+		panic(fmt.Errorf("field auction_id of message regen.ecocredit.marketplace.v1.SealedBid is not mutable"))
+	case "regen.ecocredit.marketplace.v1.SealedBid.bidder":
+		panic(fmt.Errorf("field bidder of message regen.ecocredit.marketplace.v1.SealedBid is not mutable"))
+	case "regen.ecocredit.marketplace.v1.SealedBid.commitment":
+		panic(fmt.Errorf("field commitment of message regen.ecocredit.marketplace.v1.SealedBid is not mutable"))
+	case "regen.ecocredit.marketplace.v1.SealedBid.deposit_amount":
+		panic(fmt.Errorf("field deposit_amount of message regen.ecocredit.marketplace.v1.SealedBid is not mutable"))
+	case "regen.ecocredit.marketplace.v1.SealedBid.revealed":
+		panic(fmt.Errorf("field revealed of message regen.ecocredit.marketplace.v1.SealedBid is not mutable"))
+	case "regen.ecocredit.marketplace.v1.SealedBid.quantity":
+		panic(fmt.Errorf("field quantity of message regen.ecocredit.marketplace.v1.SealedBid is not mutable"))
+	case "regen.ecocredit.marketplace.v1.SealedBid.bid_amount":
+		panic(fmt.Errorf("field bid_amount of message regen.ecocredit.marketplace.v1.SealedBid is not mutable"))
+	case "regen.ecocredit.marketplace.v1.SealedBid.disable_auto_retire":
+		panic(fmt.Errorf("field disable_auto_retire of message regen.ecocredit.marketplace.v1.SealedBid is not mutable"))
+	case "regen.ecocredit.marketplace.v1.SealedBid.retirement_jurisdiction":
+		panic(fmt.Errorf("field retirement_jurisdiction of message regen.ecocredit.marketplace.v1.SealedBid is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SealedBid"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SealedBid does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SealedBid) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.SealedBid.auction_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.SealedBid.bidder":
+		return protoreflect.ValueOfBytes(nil)
+	case "regen.ecocredit.marketplace.v1.SealedBid.commitment":
+		return protoreflect.ValueOfBytes(nil)
+	case "regen.ecocredit.marketplace.v1.SealedBid.deposit_amount":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.SealedBid.revealed":
+		return protoreflect.ValueOfBool(false)
+	case "regen.ecocredit.marketplace.v1.SealedBid.quantity":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.SealedBid.bid_amount":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.SealedBid.disable_auto_retire":
+		return protoreflect.ValueOfBool(false)
+	case "regen.ecocredit.marketplace.v1.SealedBid.retirement_jurisdiction":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SealedBid"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SealedBid does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SealedBid) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.SealedBid", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SealedBid) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SealedBid) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SealedBid) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SealedBid) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SealedBid)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.AuctionId != 0 {
+			n += 1 + runtime.Sov(uint64(x.AuctionId))
+		}
+		l = len(x.Bidder)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Commitment)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DepositAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Revealed {
+			n += 2
+		}
+		l = len(x.Quantity)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BidAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DisableAutoRetire {
+			n += 2
+		}
+		l = len(x.RetirementJurisdiction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SealedBid)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RetirementJurisdiction) > 0 {
+			i -= len(x.RetirementJurisdiction)
+			copy(dAtA[i:], x.RetirementJurisdiction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RetirementJurisdiction)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.DisableAutoRetire {
+			i--
+			if x.DisableAutoRetire {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.BidAmount) > 0 {
+			i -= len(x.BidAmount)
+			copy(dAtA[i:], x.BidAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BidAmount)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Quantity) > 0 {
+			i -= len(x.Quantity)
+			copy(dAtA[i:], x.Quantity)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Quantity)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.Revealed {
+			i--
+			if x.Revealed {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.DepositAmount) > 0 {
+			i -= len(x.DepositAmount)
+			copy(dAtA[i:], x.DepositAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DepositAmount)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Commitment) > 0 {
+			i -= len(x.Commitment)
+			copy(dAtA[i:], x.Commitment)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Commitment)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Bidder) > 0 {
+			i -= len(x.Bidder)
+			copy(dAtA[i:], x.Bidder)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Bidder)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.AuctionId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AuctionId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SealedBid)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SealedBid: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SealedBid: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+				}
+				x.AuctionId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AuctionId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Bidder = append(x.Bidder[:0], dAtA[iNdEx:postIndex]...)
+				if x.Bidder == nil {
+					x.Bidder = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Commitment = append(x.Commitment[:0], dAtA[iNdEx:postIndex]...)
+				if x.Commitment == nil {
+					x.Commitment = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DepositAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Revealed", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Revealed = bool(v != 0)
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Quantity = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BidAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BidAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.DisableAutoRetire = bool(v != 0)
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetirementJurisdiction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RetirementJurisdiction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ForwardSale                     protoreflect.MessageDescriptor
+	fd_ForwardSale_id                  protoreflect.FieldDescriptor
+	fd_ForwardSale_seller              protoreflect.FieldDescriptor
+	fd_ForwardSale_project_key         protoreflect.FieldDescriptor
+	fd_ForwardSale_vintage_start       protoreflect.FieldDescriptor
+	fd_ForwardSale_vintage_end         protoreflect.FieldDescriptor
+	fd_ForwardSale_quantity            protoreflect.FieldDescriptor
+	fd_ForwardSale_market_id           protoreflect.FieldDescriptor
+	fd_ForwardSale_ask_amount          protoreflect.FieldDescriptor
+	fd_ForwardSale_delivery_deadline   protoreflect.FieldDescriptor
+	fd_ForwardSale_disable_auto_retire protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_ForwardSale = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("ForwardSale")
+	fd_ForwardSale_id = md_ForwardSale.Fields().ByName("id")
+	fd_ForwardSale_seller = md_ForwardSale.Fields().ByName("seller")
+	fd_ForwardSale_project_key = md_ForwardSale.Fields().ByName("project_key")
+	fd_ForwardSale_vintage_start = md_ForwardSale.Fields().ByName("vintage_start")
+	fd_ForwardSale_vintage_end = md_ForwardSale.Fields().ByName("vintage_end")
+	fd_ForwardSale_quantity = md_ForwardSale.Fields().ByName("quantity")
+	fd_ForwardSale_market_id = md_ForwardSale.Fields().ByName("market_id")
+	fd_ForwardSale_ask_amount = md_ForwardSale.Fields().ByName("ask_amount")
+	fd_ForwardSale_delivery_deadline = md_ForwardSale.Fields().ByName("delivery_deadline")
+	fd_ForwardSale_disable_auto_retire = md_ForwardSale.Fields().ByName("disable_auto_retire")
+}
+
+var _ protoreflect.Message = (*fastReflection_ForwardSale)(nil)
+
+type fastReflection_ForwardSale ForwardSale
+
+func (x *ForwardSale) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ForwardSale)(x)
+}
+
+func (x *ForwardSale) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ForwardSale_messageType fastReflection_ForwardSale_messageType
+var _ protoreflect.MessageType = fastReflection_ForwardSale_messageType{}
+
+type fastReflection_ForwardSale_messageType struct{}
+
+func (x fastReflection_ForwardSale_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ForwardSale)(nil)
+}
+func (x fastReflection_ForwardSale_messageType) New() protoreflect.Message {
+	return new(fastReflection_ForwardSale)
+}
+func (x fastReflection_ForwardSale_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ForwardSale
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ForwardSale) Descriptor() protoreflect.MessageDescriptor {
+	return md_ForwardSale
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ForwardSale) Type() protoreflect.MessageType {
+	return _fastReflection_ForwardSale_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ForwardSale) New() protoreflect.Message {
+	return new(fastReflection_ForwardSale)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ForwardSale) Interface() protoreflect.ProtoMessage {
+	return (*ForwardSale)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ForwardSale) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_ForwardSale_id, value) {
+			return
+		}
+	}
+	if len(x.Seller) != 0 {
+		value := protoreflect.ValueOfBytes(x.Seller)
+		if !f(fd_ForwardSale_seller, value) {
+			return
+		}
+	}
+	if x.ProjectKey != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProjectKey)
+		if !f(fd_ForwardSale_project_key, value) {
+			return
+		}
+	}
+	if x.VintageStart != nil {
+		value := protoreflect.ValueOfMessage(x.VintageStart.ProtoReflect())
+		if !f(fd_ForwardSale_vintage_start, value) {
+			return
+		}
+	}
+	if x.VintageEnd != nil {
+		value := protoreflect.ValueOfMessage(x.VintageEnd.ProtoReflect())
+		if !f(fd_ForwardSale_vintage_end, value) {
+			return
+		}
+	}
+	if x.Quantity != "" {
+		value := protoreflect.ValueOfString(x.Quantity)
+		if !f(fd_ForwardSale_quantity, value) {
+			return
+		}
+	}
+	if x.MarketId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MarketId)
+		if !f(fd_ForwardSale_market_id, value) {
+			return
+		}
+	}
+	if x.AskAmount != "" {
+		value := protoreflect.ValueOfString(x.AskAmount)
+		if !f(fd_ForwardSale_ask_amount, value) {
+			return
+		}
+	}
+	if x.DeliveryDeadline != nil {
+		value := protoreflect.ValueOfMessage(x.DeliveryDeadline.ProtoReflect())
+		if !f(fd_ForwardSale_delivery_deadline, value) {
+			return
+		}
+	}
+	if x.DisableAutoRetire != false {
+		value := protoreflect.ValueOfBool(x.DisableAutoRetire)
+		if !f(fd_ForwardSale_disable_auto_retire, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ForwardSale) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.ForwardSale.id":
+		return x.Id != uint64(0)
+	case "regen.ecocredit.marketplace.v1.ForwardSale.seller":
+		return len(x.Seller) != 0
+	case "regen.ecocredit.marketplace.v1.ForwardSale.project_key":
+		return x.ProjectKey != uint64(0)
+	case "regen.ecocredit.marketplace.v1.ForwardSale.vintage_start":
+		return x.VintageStart != nil
+	case "regen.ecocredit.marketplace.v1.ForwardSale.vintage_end":
+		return x.VintageEnd != nil
+	case "regen.ecocredit.marketplace.v1.ForwardSale.quantity":
+		return x.Quantity != ""
+	case "regen.ecocredit.marketplace.v1.ForwardSale.market_id":
+		return x.MarketId != uint64(0)
+	case "regen.ecocredit.marketplace.v1.ForwardSale.ask_amount":
+		return x.AskAmount != ""
+	case "regen.ecocredit.marketplace.v1.ForwardSale.delivery_deadline":
+		return x.DeliveryDeadline != nil
+	case "regen.ecocredit.marketplace.v1.ForwardSale.disable_auto_retire":
+		return x.DisableAutoRetire != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.ForwardSale"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.ForwardSale does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForwardSale) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.ForwardSale.id":
+		x.Id = uint64(0)
+	case "regen.ecocredit.marketplace.v1.ForwardSale.seller":
+		x.Seller = nil
+	case "regen.ecocredit.marketplace.v1.ForwardSale.project_key":
+		x.ProjectKey = uint64(0)
+	case "regen.ecocredit.marketplace.v1.ForwardSale.vintage_start":
+		x.VintageStart = nil
+	case "regen.ecocredit.marketplace.v1.ForwardSale.vintage_end":
+		x.VintageEnd = nil
+	case "regen.ecocredit.marketplace.v1.ForwardSale.quantity":
+		x.Quantity = ""
+	case "regen.ecocredit.marketplace.v1.ForwardSale.market_id":
+		x.MarketId = uint64(0)
+	case "regen.ecocredit.marketplace.v1.ForwardSale.ask_amount":
+		x.AskAmount = ""
+	case "regen.ecocredit.marketplace.v1.ForwardSale.delivery_deadline":
+		x.DeliveryDeadline = nil
+	case "regen.ecocredit.marketplace.v1.ForwardSale.disable_auto_retire":
+		x.DisableAutoRetire = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.ForwardSale"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.ForwardSale does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ForwardSale) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.ForwardSale.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.ForwardSale.seller":
+		value := x.Seller
+		return protoreflect.ValueOfBytes(value)
+	case "regen.ecocredit.marketplace.v1.ForwardSale.project_key":
+		value := x.ProjectKey
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.ForwardSale.vintage_start":
+		value := x.VintageStart
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.ForwardSale.vintage_end":
+		value := x.VintageEnd
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.ForwardSale.quantity":
+		value := x.Quantity
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.ForwardSale.market_id":
+		value := x.MarketId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.ForwardSale.ask_amount":
+		value := x.AskAmount
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.ForwardSale.delivery_deadline":
+		value := x.DeliveryDeadline
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.ForwardSale.disable_auto_retire":
+		value := x.DisableAutoRetire
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.ForwardSale"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.ForwardSale does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForwardSale) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.ForwardSale.id":
+		x.Id = value.Uint()
+	case "regen.ecocredit.marketplace.v1.ForwardSale.seller":
+		x.Seller = value.Bytes()
+	case "regen.ecocredit.marketplace.v1.ForwardSale.project_key":
+		x.ProjectKey = value.Uint()
+	case "regen.ecocredit.marketplace.v1.ForwardSale.vintage_start":
+		x.VintageStart = value.Message().Interface().(*timestamppb.Timestamp)
+	case "regen.ecocredit.marketplace.v1.ForwardSale.vintage_end":
+		x.VintageEnd = value.Message().Interface().(*timestamppb.Timestamp)
+	case "regen.ecocredit.marketplace.v1.ForwardSale.quantity":
+		x.Quantity = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.ForwardSale.market_id":
+		x.MarketId = value.Uint()
+	case "regen.ecocredit.marketplace.v1.ForwardSale.ask_amount":
+		x.AskAmount = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.ForwardSale.delivery_deadline":
+		x.DeliveryDeadline = value.Message().Interface().(*timestamppb.Timestamp)
+	case "regen.ecocredit.marketplace.v1.ForwardSale.disable_auto_retire":
+		x.DisableAutoRetire = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.ForwardSale"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.ForwardSale does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForwardSale) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.ForwardSale.vintage_start":
+		if x.VintageStart == nil {
+			x.VintageStart = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.VintageStart.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.ForwardSale.vintage_end":
+		if x.VintageEnd == nil {
+			x.VintageEnd = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.VintageEnd.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.ForwardSale.delivery_deadline":
+		if x.DeliveryDeadline == nil {
+			x.DeliveryDeadline = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.DeliveryDeadline.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.ForwardSale.id":
+		panic(fmt.Errorf("field id of message regen.ecocredit.marketplace.v1.ForwardSale is not mutable"))
+	case "regen.ecocredit.marketplace.v1.ForwardSale.seller":
+		panic(fmt.Errorf("field seller of message regen.ecocredit.marketplace.v1.ForwardSale is not mutable"))
+	case "regen.ecocredit.marketplace.v1.ForwardSale.project_key":
+		panic(fmt.Errorf("field project_key of message regen.ecocredit.marketplace.v1.ForwardSale is not mutable"))
+	case "regen.ecocredit.marketplace.v1.ForwardSale.quantity":
+		panic(fmt.Errorf("field quantity of message regen.ecocredit.marketplace.v1.ForwardSale is not mutable"))
+	case "regen.ecocredit.marketplace.v1.ForwardSale.market_id":
+		panic(fmt.Errorf("field market_id of message regen.ecocredit.marketplace.v1.ForwardSale is not mutable"))
+	case "regen.ecocredit.marketplace.v1.ForwardSale.ask_amount":
+		panic(fmt.Errorf("field ask_amount of message regen.ecocredit.marketplace.v1.ForwardSale is not mutable"))
+	case "regen.ecocredit.marketplace.v1.ForwardSale.disable_auto_retire":
+		panic(fmt.Errorf("field disable_auto_retire of message regen.ecocredit.marketplace.v1.ForwardSale is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.ForwardSale"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.ForwardSale does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ForwardSale) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.ForwardSale.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.ForwardSale.seller":
+		return protoreflect.ValueOfBytes(nil)
+	case "regen.ecocredit.marketplace.v1.ForwardSale.project_key":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.ForwardSale.vintage_start":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.ForwardSale.vintage_end":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.ForwardSale.quantity":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.ForwardSale.market_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.ForwardSale.ask_amount":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.ForwardSale.delivery_deadline":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.ForwardSale.disable_auto_retire":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.ForwardSale"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.ForwardSale does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ForwardSale) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.ForwardSale", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ForwardSale) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForwardSale) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ForwardSale) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ForwardSale) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ForwardSale)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Seller)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ProjectKey != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProjectKey))
+		}
+		if x.VintageStart != nil {
+			l = options.Size(x.VintageStart)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.VintageEnd != nil {
+			l = options.Size(x.VintageEnd)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Quantity)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MarketId != 0 {
+			n += 1 + runtime.Sov(uint64(x.MarketId))
+		}
+		l = len(x.AskAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DeliveryDeadline != nil {
+			l = options.Size(x.DeliveryDeadline)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DisableAutoRetire {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ForwardSale)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.DisableAutoRetire {
+			i--
+			if x.DisableAutoRetire {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.DeliveryDeadline != nil {
+			encoded, err := options.Marshal(x.DeliveryDeadline)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.AskAmount) > 0 {
+			i -= len(x.AskAmount)
+			copy(dAtA[i:], x.AskAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AskAmount)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.MarketId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MarketId))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.Quantity) > 0 {
+			i -= len(x.Quantity)
+			copy(dAtA[i:], x.Quantity)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Quantity)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.VintageEnd != nil {
+			encoded, err := options.Marshal(x.VintageEnd)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.VintageStart != nil {
+			encoded, err := options.Marshal(x.VintageStart)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.ProjectKey != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProjectKey))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Seller) > 0 {
+			i -= len(x.Seller)
+			copy(dAtA[i:], x.Seller)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Seller)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ForwardSale)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ForwardSale: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ForwardSale: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Seller = append(x.Seller[:0], dAtA[iNdEx:postIndex]...)
+				if x.Seller == nil {
+					x.Seller = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
+				}
+				x.ProjectKey = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProjectKey |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VintageStart", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.VintageStart == nil {
+					x.VintageStart = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VintageStart); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VintageEnd", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.VintageEnd == nil {
+					x.VintageEnd = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VintageEnd); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Quantity = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				}
+				x.MarketId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MarketId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AskAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AskAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeliveryDeadline", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DeliveryDeadline == nil {
+					x.DeliveryDeadline = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DeliveryDeadline); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.DisableAutoRetire = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ForwardContract                         protoreflect.MessageDescriptor
+	fd_ForwardContract_id                      protoreflect.FieldDescriptor
+	fd_ForwardContract_forward_sale_id         protoreflect.FieldDescriptor
+	fd_ForwardContract_buyer                   protoreflect.FieldDescriptor
+	fd_ForwardContract_project_key             protoreflect.FieldDescriptor
+	fd_ForwardContract_quantity                protoreflect.FieldDescriptor
+	fd_ForwardContract_escrow_amount           protoreflect.FieldDescriptor
+	fd_ForwardContract_disable_auto_retire     protoreflect.FieldDescriptor
+	fd_ForwardContract_retirement_jurisdiction protoreflect.FieldDescriptor
+	fd_ForwardContract_maker_fee_bps           protoreflect.FieldDescriptor
+	fd_ForwardContract_taker_fee_bps           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_ForwardContract = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("ForwardContract")
+	fd_ForwardContract_id = md_ForwardContract.Fields().ByName("id")
+	fd_ForwardContract_forward_sale_id = md_ForwardContract.Fields().ByName("forward_sale_id")
+	fd_ForwardContract_buyer = md_ForwardContract.Fields().ByName("buyer")
+	fd_ForwardContract_project_key = md_ForwardContract.Fields().ByName("project_key")
+	fd_ForwardContract_quantity = md_ForwardContract.Fields().ByName("quantity")
+	fd_ForwardContract_escrow_amount = md_ForwardContract.Fields().ByName("escrow_amount")
+	fd_ForwardContract_disable_auto_retire = md_ForwardContract.Fields().ByName("disable_auto_retire")
+	fd_ForwardContract_retirement_jurisdiction = md_ForwardContract.Fields().ByName("retirement_jurisdiction")
+	fd_ForwardContract_maker_fee_bps = md_ForwardContract.Fields().ByName("maker_fee_bps")
+	fd_ForwardContract_taker_fee_bps = md_ForwardContract.Fields().ByName("taker_fee_bps")
+}
+
+var _ protoreflect.Message = (*fastReflection_ForwardContract)(nil)
+
+type fastReflection_ForwardContract ForwardContract
+
+func (x *ForwardContract) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ForwardContract)(x)
+}
+
+func (x *ForwardContract) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ForwardContract_messageType fastReflection_ForwardContract_messageType
+var _ protoreflect.MessageType = fastReflection_ForwardContract_messageType{}
+
+type fastReflection_ForwardContract_messageType struct{}
+
+func (x fastReflection_ForwardContract_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ForwardContract)(nil)
+}
+func (x fastReflection_ForwardContract_messageType) New() protoreflect.Message {
+	return new(fastReflection_ForwardContract)
+}
+func (x fastReflection_ForwardContract_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ForwardContract
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ForwardContract) Descriptor() protoreflect.MessageDescriptor {
+	return md_ForwardContract
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ForwardContract) Type() protoreflect.MessageType {
+	return _fastReflection_ForwardContract_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ForwardContract) New() protoreflect.Message {
+	return new(fastReflection_ForwardContract)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ForwardContract) Interface() protoreflect.ProtoMessage {
+	return (*ForwardContract)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ForwardContract) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_ForwardContract_id, value) {
+			return
+		}
+	}
+	if x.ForwardSaleId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ForwardSaleId)
+		if !f(fd_ForwardContract_forward_sale_id, value) {
+			return
+		}
+	}
+	if len(x.Buyer) != 0 {
+		value := protoreflect.ValueOfBytes(x.Buyer)
+		if !f(fd_ForwardContract_buyer, value) {
+			return
+		}
+	}
+	if x.ProjectKey != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProjectKey)
+		if !f(fd_ForwardContract_project_key, value) {
+			return
+		}
+	}
+	if x.Quantity != "" {
+		value := protoreflect.ValueOfString(x.Quantity)
+		if !f(fd_ForwardContract_quantity, value) {
+			return
+		}
+	}
+	if x.EscrowAmount != "" {
+		value := protoreflect.ValueOfString(x.EscrowAmount)
+		if !f(fd_ForwardContract_escrow_amount, value) {
+			return
+		}
+	}
+	if x.DisableAutoRetire != false {
+		value := protoreflect.ValueOfBool(x.DisableAutoRetire)
+		if !f(fd_ForwardContract_disable_auto_retire, value) {
+			return
+		}
+	}
+	if x.RetirementJurisdiction != "" {
+		value := protoreflect.ValueOfString(x.RetirementJurisdiction)
+		if !f(fd_ForwardContract_retirement_jurisdiction, value) {
+			return
+		}
+	}
+	if x.MakerFeeBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MakerFeeBps)
+		if !f(fd_ForwardContract_maker_fee_bps, value) {
+			return
+		}
+	}
+	if x.TakerFeeBps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.TakerFeeBps)
+		if !f(fd_ForwardContract_taker_fee_bps, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ForwardContract) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.ForwardContract.id":
+		return x.Id != uint64(0)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.forward_sale_id":
+		return x.ForwardSaleId != uint64(0)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.buyer":
+		return len(x.Buyer) != 0
+	case "regen.ecocredit.marketplace.v1.ForwardContract.project_key":
+		return x.ProjectKey != uint64(0)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.quantity":
+		return x.Quantity != ""
+	case "regen.ecocredit.marketplace.v1.ForwardContract.escrow_amount":
+		return x.EscrowAmount != ""
+	case "regen.ecocredit.marketplace.v1.ForwardContract.disable_auto_retire":
+		return x.DisableAutoRetire != false
+	case "regen.ecocredit.marketplace.v1.ForwardContract.retirement_jurisdiction":
+		return x.RetirementJurisdiction != ""
+	case "regen.ecocredit.marketplace.v1.ForwardContract.maker_fee_bps":
+		return x.MakerFeeBps != uint32(0)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.taker_fee_bps":
+		return x.TakerFeeBps != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.ForwardContract"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.ForwardContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForwardContract) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.ForwardContract.id":
+		x.Id = uint64(0)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.forward_sale_id":
+		x.ForwardSaleId = uint64(0)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.buyer":
+		x.Buyer = nil
+	case "regen.ecocredit.marketplace.v1.ForwardContract.project_key":
+		x.ProjectKey = uint64(0)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.quantity":
+		x.Quantity = ""
+	case "regen.ecocredit.marketplace.v1.ForwardContract.escrow_amount":
+		x.EscrowAmount = ""
+	case "regen.ecocredit.marketplace.v1.ForwardContract.disable_auto_retire":
+		x.DisableAutoRetire = false
+	case "regen.ecocredit.marketplace.v1.ForwardContract.retirement_jurisdiction":
+		x.RetirementJurisdiction = ""
+	case "regen.ecocredit.marketplace.v1.ForwardContract.maker_fee_bps":
+		x.MakerFeeBps = uint32(0)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.taker_fee_bps":
+		x.TakerFeeBps = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.ForwardContract"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.ForwardContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ForwardContract) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.ForwardContract.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.forward_sale_id":
+		value := x.ForwardSaleId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.buyer":
+		value := x.Buyer
+		return protoreflect.ValueOfBytes(value)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.project_key":
+		value := x.ProjectKey
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.quantity":
+		value := x.Quantity
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.escrow_amount":
+		value := x.EscrowAmount
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.disable_auto_retire":
+		value := x.DisableAutoRetire
+		return protoreflect.ValueOfBool(value)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.retirement_jurisdiction":
+		value := x.RetirementJurisdiction
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.maker_fee_bps":
+		value := x.MakerFeeBps
+		return protoreflect.ValueOfUint32(value)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.taker_fee_bps":
+		value := x.TakerFeeBps
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.ForwardContract"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.ForwardContract does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForwardContract) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.ForwardContract.id":
+		x.Id = value.Uint()
+	case "regen.ecocredit.marketplace.v1.ForwardContract.forward_sale_id":
+		x.ForwardSaleId = value.Uint()
+	case "regen.ecocredit.marketplace.v1.ForwardContract.buyer":
+		x.Buyer = value.Bytes()
+	case "regen.ecocredit.marketplace.v1.ForwardContract.project_key":
+		x.ProjectKey = value.Uint()
+	case "regen.ecocredit.marketplace.v1.ForwardContract.quantity":
+		x.Quantity = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.escrow_amount":
+		x.EscrowAmount = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.disable_auto_retire":
+		x.DisableAutoRetire = value.Bool()
+	case "regen.ecocredit.marketplace.v1.ForwardContract.retirement_jurisdiction":
+		x.RetirementJurisdiction = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.maker_fee_bps":
+		x.MakerFeeBps = uint32(value.Uint())
+	case "regen.ecocredit.marketplace.v1.ForwardContract.taker_fee_bps":
+		x.TakerFeeBps = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.ForwardContract"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.ForwardContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForwardContract) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.ForwardContract.id":
+		panic(fmt.Errorf("field id of message regen.ecocredit.marketplace.v1.ForwardContract is not mutable"))
+	case "regen.ecocredit.marketplace.v1.ForwardContract.forward_sale_id":
+		panic(fmt.Errorf("field forward_sale_id of message regen.ecocredit.marketplace.v1.ForwardContract is not mutable"))
+	case "regen.ecocredit.marketplace.v1.ForwardContract.buyer":
+		panic(fmt.Errorf("field buyer of message regen.ecocredit.marketplace.v1.ForwardContract is not mutable"))
+	case "regen.ecocredit.marketplace.v1.ForwardContract.project_key":
+		panic(fmt.Errorf("field project_key of message regen.ecocredit.marketplace.v1.ForwardContract is not mutable"))
+	case "regen.ecocredit.marketplace.v1.ForwardContract.quantity":
+		panic(fmt.Errorf("field quantity of message regen.ecocredit.marketplace.v1.ForwardContract is not mutable"))
+	case "regen.ecocredit.marketplace.v1.ForwardContract.escrow_amount":
+		panic(fmt.Errorf("field escrow_amount of message regen.ecocredit.marketplace.v1.ForwardContract is not mutable"))
+	case "regen.ecocredit.marketplace.v1.ForwardContract.disable_auto_retire":
+		panic(fmt.Errorf("field disable_auto_retire of message regen.ecocredit.marketplace.v1.ForwardContract is not mutable"))
+	case "regen.ecocredit.marketplace.v1.ForwardContract.retirement_jurisdiction":
+		panic(fmt.Errorf("field retirement_jurisdiction of message regen.ecocredit.marketplace.v1.ForwardContract is not mutable"))
+	case "regen.ecocredit.marketplace.v1.ForwardContract.maker_fee_bps":
+		panic(fmt.Errorf("field maker_fee_bps of message regen.ecocredit.marketplace.v1.ForwardContract is not mutable"))
+	case "regen.ecocredit.marketplace.v1.ForwardContract.taker_fee_bps":
+		panic(fmt.Errorf("field taker_fee_bps of message regen.ecocredit.marketplace.v1.ForwardContract is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.ForwardContract"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.ForwardContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ForwardContract) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.ForwardContract.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.ForwardContract.forward_sale_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.ForwardContract.buyer":
+		return protoreflect.ValueOfBytes(nil)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.project_key":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.ForwardContract.quantity":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.ForwardContract.escrow_amount":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.ForwardContract.disable_auto_retire":
+		return protoreflect.ValueOfBool(false)
+	case "regen.ecocredit.marketplace.v1.ForwardContract.retirement_jurisdiction":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.ForwardContract.maker_fee_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "regen.ecocredit.marketplace.v1.ForwardContract.taker_fee_bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.ForwardContract"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.ForwardContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ForwardContract) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.ForwardContract", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ForwardContract) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForwardContract) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ForwardContract) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ForwardContract) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ForwardContract)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.ForwardSaleId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ForwardSaleId))
+		}
+		l = len(x.Buyer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ProjectKey != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProjectKey))
+		}
+		l = len(x.Quantity)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EscrowAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DisableAutoRetire {
+			n += 2
+		}
+		l = len(x.RetirementJurisdiction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MakerFeeBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.MakerFeeBps))
+		}
+		if x.TakerFeeBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.TakerFeeBps))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ForwardContract)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.TakerFeeBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TakerFeeBps))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.MakerFeeBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MakerFeeBps))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.RetirementJurisdiction) > 0 {
+			i -= len(x.RetirementJurisdiction)
+			copy(dAtA[i:], x.RetirementJurisdiction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RetirementJurisdiction)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.DisableAutoRetire {
+			i--
+			if x.DisableAutoRetire {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.EscrowAmount) > 0 {
+			i -= len(x.EscrowAmount)
+			copy(dAtA[i:], x.EscrowAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EscrowAmount)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Quantity) > 0 {
+			i -= len(x.Quantity)
+			copy(dAtA[i:], x.Quantity)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Quantity)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.ProjectKey != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProjectKey))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Buyer) > 0 {
+			i -= len(x.Buyer)
+			copy(dAtA[i:], x.Buyer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Buyer)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.ForwardSaleId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ForwardSaleId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ForwardContract)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ForwardContract: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ForwardContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ForwardSaleId", wireType)
+				}
+				x.ForwardSaleId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ForwardSaleId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Buyer = append(x.Buyer[:0], dAtA[iNdEx:postIndex]...)
+				if x.Buyer == nil {
+					x.Buyer = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
+				}
+				x.ProjectKey = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProjectKey |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Quantity = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EscrowAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EscrowAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.DisableAutoRetire = bool(v != 0)
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetirementJurisdiction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RetirementJurisdiction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MakerFeeBps", wireType)
+				}
+				x.MakerFeeBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MakerFeeBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TakerFeeBps", wireType)
+				}
+				x.TakerFeeBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TakerFeeBps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MarketRegistration                protoreflect.MessageDescriptor
+	fd_MarketRegistration_enabled        protoreflect.FieldDescriptor
+	fd_MarketRegistration_deposit_denom  protoreflect.FieldDescriptor
+	fd_MarketRegistration_deposit_amount protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_MarketRegistration = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("MarketRegistration")
+	fd_MarketRegistration_enabled = md_MarketRegistration.Fields().ByName("enabled")
+	fd_MarketRegistration_deposit_denom = md_MarketRegistration.Fields().ByName("deposit_denom")
+	fd_MarketRegistration_deposit_amount = md_MarketRegistration.Fields().ByName("deposit_amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_MarketRegistration)(nil)
+
+type fastReflection_MarketRegistration MarketRegistration
+
+func (x *MarketRegistration) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MarketRegistration)(x)
+}
+
+func (x *MarketRegistration) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MarketRegistration_messageType fastReflection_MarketRegistration_messageType
+var _ protoreflect.MessageType = fastReflection_MarketRegistration_messageType{}
+
+type fastReflection_MarketRegistration_messageType struct{}
+
+func (x fastReflection_MarketRegistration_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MarketRegistration)(nil)
+}
+func (x fastReflection_MarketRegistration_messageType) New() protoreflect.Message {
+	return new(fastReflection_MarketRegistration)
+}
+func (x fastReflection_MarketRegistration_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketRegistration
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MarketRegistration) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketRegistration
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MarketRegistration) Type() protoreflect.MessageType {
+	return _fastReflection_MarketRegistration_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MarketRegistration) New() protoreflect.Message {
+	return new(fastReflection_MarketRegistration)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MarketRegistration) Interface() protoreflect.ProtoMessage {
+	return (*MarketRegistration)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MarketRegistration) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Enabled != false {
+		value := protoreflect.ValueOfBool(x.Enabled)
+		if !f(fd_MarketRegistration_enabled, value) {
+			return
+		}
+	}
+	if x.DepositDenom != "" {
+		value := protoreflect.ValueOfString(x.DepositDenom)
+		if !f(fd_MarketRegistration_deposit_denom, value) {
+			return
+		}
+	}
+	if x.DepositAmount != "" {
+		value := protoreflect.ValueOfString(x.DepositAmount)
+		if !f(fd_MarketRegistration_deposit_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MarketRegistration) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.MarketRegistration.enabled":
+		return x.Enabled != false
+	case "regen.ecocredit.marketplace.v1.MarketRegistration.deposit_denom":
+		return x.DepositDenom != ""
+	case "regen.ecocredit.marketplace.v1.MarketRegistration.deposit_amount":
+		return x.DepositAmount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.MarketRegistration"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.MarketRegistration does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketRegistration) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.MarketRegistration.enabled":
+		x.Enabled = false
+	case "regen.ecocredit.marketplace.v1.MarketRegistration.deposit_denom":
+		x.DepositDenom = ""
+	case "regen.ecocredit.marketplace.v1.MarketRegistration.deposit_amount":
+		x.DepositAmount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.MarketRegistration"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.MarketRegistration does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MarketRegistration) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.MarketRegistration.enabled":
+		value := x.Enabled
+		return protoreflect.ValueOfBool(value)
+	case "regen.ecocredit.marketplace.v1.MarketRegistration.deposit_denom":
+		value := x.DepositDenom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.MarketRegistration.deposit_amount":
+		value := x.DepositAmount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.MarketRegistration"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.MarketRegistration does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketRegistration) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.MarketRegistration.enabled":
+		x.Enabled = value.Bool()
+	case "regen.ecocredit.marketplace.v1.MarketRegistration.deposit_denom":
+		x.DepositDenom = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.MarketRegistration.deposit_amount":
+		x.DepositAmount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.MarketRegistration"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.MarketRegistration does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketRegistration) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.MarketRegistration.enabled":
+		panic(fmt.Errorf("field enabled of message regen.ecocredit.marketplace.v1.MarketRegistration is not mutable"))
+	case "regen.ecocredit.marketplace.v1.MarketRegistration.deposit_denom":
+		panic(fmt.Errorf("field deposit_denom of message regen.ecocredit.marketplace.v1.MarketRegistration is not mutable"))
+	case "regen.ecocredit.marketplace.v1.MarketRegistration.deposit_amount":
+		panic(fmt.Errorf("field deposit_amount of message regen.ecocredit.marketplace.v1.MarketRegistration is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.MarketRegistration"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.MarketRegistration does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MarketRegistration) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.MarketRegistration.enabled":
+		return protoreflect.ValueOfBool(false)
+	case "regen.ecocredit.marketplace.v1.MarketRegistration.deposit_denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.MarketRegistration.deposit_amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.MarketRegistration"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.MarketRegistration does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MarketRegistration) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.MarketRegistration", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MarketRegistration) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketRegistration) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MarketRegistration) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MarketRegistration) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MarketRegistration)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Enabled {
+			n += 2
+		}
+		l = len(x.DepositDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DepositAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MarketRegistration)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.DepositAmount) > 0 {
+			i -= len(x.DepositAmount)
+			copy(dAtA[i:], x.DepositAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DepositAmount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.DepositDenom) > 0 {
+			i -= len(x.DepositDenom)
+			copy(dAtA[i:], x.DepositDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DepositDenom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Enabled {
+			i--
+			if x.Enabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MarketRegistration)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketRegistration: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketRegistration: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Enabled = bool(v != 0)
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DepositDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DepositAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: regen/ecocredit/marketplace/v1/state.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// FeeDestinationType is the type of destination of marketplace fees.
+type FeeDestinationType int32
+
+const (
+	// FEE_DESTINATION_TYPE_UNSPECIFIED defaults to the community pool.
+	FeeDestinationType_FEE_DESTINATION_TYPE_UNSPECIFIED FeeDestinationType = 0
+	// FEE_DESTINATION_TYPE_COMMUNITY_POOL sends fees to the community pool.
+	FeeDestinationType_FEE_DESTINATION_TYPE_COMMUNITY_POOL FeeDestinationType = 1
+	// FEE_DESTINATION_TYPE_BURN burns fees.
+	FeeDestinationType_FEE_DESTINATION_TYPE_BURN FeeDestinationType = 2
+	// FEE_DESTINATION_TYPE_ACCOUNT sends fees to an account such as a fee
+	// module account.
+	FeeDestinationType_FEE_DESTINATION_TYPE_ACCOUNT FeeDestinationType = 3
+)
+
+// Enum value maps for FeeDestinationType.
+var (
+	FeeDestinationType_name = map[int32]string{
+		0: "FEE_DESTINATION_TYPE_UNSPECIFIED",
+		1: "FEE_DESTINATION_TYPE_COMMUNITY_POOL",
+		2: "FEE_DESTINATION_TYPE_BURN",
+		3: "FEE_DESTINATION_TYPE_ACCOUNT",
+	}
+	FeeDestinationType_value = map[string]int32{
+		"FEE_DESTINATION_TYPE_UNSPECIFIED":    0,
+		"FEE_DESTINATION_TYPE_COMMUNITY_POOL": 1,
+		"FEE_DESTINATION_TYPE_BURN":           2,
+		"FEE_DESTINATION_TYPE_ACCOUNT":        3,
+	}
+)
+
+func (x FeeDestinationType) Enum() *FeeDestinationType {
+	p := new(FeeDestinationType)
+	*p = x
+	return p
+}
+
+func (x FeeDestinationType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FeeDestinationType) Descriptor() protoreflect.EnumDescriptor {
+	return file_regen_ecocredit_marketplace_v1_state_proto_enumTypes[0].Descriptor()
+}
+
+func (FeeDestinationType) Type() protoreflect.EnumType {
+	return &file_regen_ecocredit_marketplace_v1_state_proto_enumTypes[0]
+}
+
+func (x FeeDestinationType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FeeDestinationType.Descriptor instead.
+func (FeeDestinationType) EnumDescriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{0}
+}
+
+// SellOrder represents the information for a sell order.
+type SellOrder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique ID of sell order.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// seller is the address of the account that is selling credits.
+	Seller []byte `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// batch_key is the table row identifier of the credit batch used internally
+	// for efficient lookups. This links a sell order to a credit batch.
+	BatchKey uint64 `protobuf:"varint,3,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// quantity is the decimal quantity of credits being sold.
+	Quantity string `protobuf:"bytes,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// market_id is the market in which this sell order exists and specifies
+	// the bank_denom that ask_amount corresponds to forming the ask_price.
+	MarketId uint64 `protobuf:"varint,5,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// ask_amount is the integer amount (encoded as a string) that the seller is
+	// asking for each credit unit of the batch. Each credit unit of the batch
+	// will be sold for at least the ask_amount. The ask_amount corresponds to the
+	// Market.denom to form the ask price.
+	AskAmount string `protobuf:"bytes,6,opt,name=ask_amount,json=askAmount,proto3" json:"ask_amount,omitempty"`
+	// disable_auto_retire disables auto-retirement of credits which allows a
+	// buyer to disable auto-retirement in their buy order enabling them to
+	// resell the credits to another buyer.
+	DisableAutoRetire bool `protobuf:"varint,7,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
+	// expiration is an optional timestamp when the sell order expires. When the
+	// expiration time is reached, the sell order is removed from state.
+	Expiration *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// maker indicates that this is a maker order, meaning that when it hit
+	// the order book, there were no matching buy orders.
+	Maker bool `protobuf:"varint,10,opt,name=maker,proto3" json:"maker,omitempty"`
+	// allowed_buyers is an optional list of addresses that are allowed to buy
+	// credits from the sell order. When set (or when buyer_group_policy is set),
+	// the sell order is private and can only be filled by the listed addresses.
+	AllowedBuyers [][]byte `protobuf:"bytes,11,rep,name=allowed_buyers,json=allowedBuyers,proto3" json:"allowed_buyers,omitempty"`
+	// buyer_group_policy is an optional group policy address that is allowed to
+	// buy credits from the sell order.
+	BuyerGroupPolicy []byte `protobuf:"bytes,12,opt,name=buyer_group_policy,json=buyerGroupPolicy,proto3" json:"buyer_group_policy,omitempty"`
+	// class_key is the table row identifier of the credit class of the credit
+	// batch used internally for efficient lookups of sell orders by class.
+	ClassKey uint64 `protobuf:"varint,13,opt,name=class_key,json=classKey,proto3" json:"class_key,omitempty"`
+	// project_key is the table row identifier of the project of the credit
+	// batch used internally for efficient lookups of sell orders by project.
+	ProjectKey uint64 `protobuf:"varint,14,opt,name=project_key,json=projectKey,proto3" json:"project_key,omitempty"`
+	// batch_start_date is the start date of the credit batch used internally
+	// for efficient lookups of sell orders by batch start date.
+	BatchStartDate *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=batch_start_date,json=batchStartDate,proto3" json:"batch_start_date,omitempty"`
+	// ask_amount_key is the big-endian encoding of ask_amount used internally
+	// to order sell orders by ask price within a market.
+	AskAmountKey []byte `protobuf:"bytes,16,opt,name=ask_amount_key,json=askAmountKey,proto3" json:"ask_amount_key,omitempty"`
+	// min_fill_quantity is the optional minimum quantity of credits that must be
+	// purchased in a single fill. A fill of less than the minimum quantity is
+	// only allowed if it purchases the entire remaining quantity of the sell
+	// order.
+	MinFillQuantity string `protobuf:"bytes,17,opt,name=min_fill_quantity,json=minFillQuantity,proto3" json:"min_fill_quantity,omitempty"`
+	// all_or_none requires the entire remaining quantity of the sell order to be
+	// purchased in a single fill.
+	AllOrNone bool `protobuf:"varint,18,opt,name=all_or_none,json=allOrNone,proto3" json:"all_or_none,omitempty"`
+}
+
+func (x *SellOrder) Reset() {
+	*x = SellOrder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SellOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SellOrder) ProtoMessage() {}
+
+// Deprecated: Use SellOrder.ProtoReflect.Descriptor instead.
+func (*SellOrder) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SellOrder) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SellOrder) GetSeller() []byte {
+	if x != nil {
+		return x.Seller
+	}
+	return nil
+}
+
+func (x *SellOrder) GetBatchKey() uint64 {
+	if x != nil {
+		return x.BatchKey
+	}
+	return 0
+}
+
+func (x *SellOrder) GetQuantity() string {
+	if x != nil {
+		return x.Quantity
+	}
+	return ""
+}
+
+func (x *SellOrder) GetMarketId() uint64 {
+	if x != nil {
+		return x.MarketId
+	}
+	return 0
+}
+
+func (x *SellOrder) GetAskAmount() string {
+	if x != nil {
+		return x.AskAmount
+	}
+	return ""
+}
+
+func (x *SellOrder) GetDisableAutoRetire() bool {
+	if x != nil {
+		return x.DisableAutoRetire
+	}
+	return false
+}
+
+func (x *SellOrder) GetExpiration() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
+func (x *SellOrder) GetMaker() bool {
+	if x != nil {
 		return x.Maker
 	}
 	return false
 }
 
-// AllowedDenom represents the information for an allowed ask/bid denom.
-type AllowedDenom struct {
+func (x *SellOrder) GetAllowedBuyers() [][]byte {
+	if x != nil {
+		return x.AllowedBuyers
+	}
+	return nil
+}
+
+func (x *SellOrder) GetBuyerGroupPolicy() []byte {
+	if x != nil {
+		return x.BuyerGroupPolicy
+	}
+	return nil
+}
+
+func (x *SellOrder) GetClassKey() uint64 {
+	if x != nil {
+		return x.ClassKey
+	}
+	return 0
+}
+
+func (x *SellOrder) GetProjectKey() uint64 {
+	if x != nil {
+		return x.ProjectKey
+	}
+	return 0
+}
+
+func (x *SellOrder) GetBatchStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BatchStartDate
+	}
+	return nil
+}
+
+func (x *SellOrder) GetAskAmountKey() []byte {
+	if x != nil {
+		return x.AskAmountKey
+	}
+	return nil
+}
+
+func (x *SellOrder) GetMinFillQuantity() string {
+	if x != nil {
+		return x.MinFillQuantity
+	}
+	return ""
+}
+
+func (x *SellOrder) GetAllOrNone() bool {
+	if x != nil {
+		return x.AllOrNone
+	}
+	return false
+}
+
+// BuyOrder represents the information for a buy order.
+type BuyOrder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique ID of buy order.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// buyer is the address of the account that is buying credits.
+	Buyer []byte `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// filter selects the credit batches that the buy order can be matched
+	// against. A sell order is matched when its credit batch satisfies at least
+	// one of the filter criteria.
+	Filter *BuyOrder_Filter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// quantity is the decimal quantity of credits remaining to be bought.
+	Quantity string `protobuf:"bytes,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// market_id is the market in which this buy order exists and specifies
+	// the bank_denom that bid_amount corresponds to forming the bid_price.
+	MarketId uint64 `protobuf:"varint,5,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// bid_amount is the integer amount (encoded as a string) that the buyer is
+	// willing to pay for each credit unit. The bid_amount corresponds to the
+	// Market.denom to form the bid price. The total bid amount for the remaining
+	// quantity is held in escrow by the ecocredit module account.
+	BidAmount string `protobuf:"bytes,6,opt,name=bid_amount,json=bidAmount,proto3" json:"bid_amount,omitempty"`
+	// disable_auto_retire disables auto-retirement of credits which allows a
+	// buyer to receive credits in a tradable state. A buy order with
+	// auto-retirement disabled is only matched with sell orders that also have
+	// auto-retirement disabled.
+	DisableAutoRetire bool `protobuf:"varint,7,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
+	// retirement_jurisdiction is the optional retirement jurisdiction for the
+	// credits which will be used only if disable_auto_retire is false.
+	RetirementJurisdiction string `protobuf:"bytes,8,opt,name=retirement_jurisdiction,json=retirementJurisdiction,proto3" json:"retirement_jurisdiction,omitempty"`
+	// expiration is an optional timestamp when the buy order expires. When the
+	// expiration time is reached, the buy order is removed from state and the
+	// escrowed funds are returned to the buyer.
+	Expiration *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// taker_fee_bps is the taker fee in basis points that applied to the market
+	// when the buy order was created. The taker fee for the total quantity of the
+	// buy order is held in escrow along with the bid amount.
+	TakerFeeBps uint32 `protobuf:"varint,10,opt,name=taker_fee_bps,json=takerFeeBps,proto3" json:"taker_fee_bps,omitempty"`
+}
+
+func (x *BuyOrder) Reset() {
+	*x = BuyOrder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuyOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyOrder) ProtoMessage() {}
+
+// Deprecated: Use BuyOrder.ProtoReflect.Descriptor instead.
+func (*BuyOrder) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BuyOrder) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BuyOrder) GetBuyer() []byte {
+	if x != nil {
+		return x.Buyer
+	}
+	return nil
+}
+
+func (x *BuyOrder) GetFilter() *BuyOrder_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *BuyOrder) GetQuantity() string {
+	if x != nil {
+		return x.Quantity
+	}
+	return ""
+}
+
+func (x *BuyOrder) GetMarketId() uint64 {
+	if x != nil {
+		return x.MarketId
+	}
+	return 0
+}
+
+func (x *BuyOrder) GetBidAmount() string {
+	if x != nil {
+		return x.BidAmount
+	}
+	return ""
+}
+
+func (x *BuyOrder) GetDisableAutoRetire() bool {
+	if x != nil {
+		return x.DisableAutoRetire
+	}
+	return false
+}
+
+func (x *BuyOrder) GetRetirementJurisdiction() string {
+	if x != nil {
+		return x.RetirementJurisdiction
+	}
+	return ""
+}
+
+func (x *BuyOrder) GetExpiration() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
+func (x *BuyOrder) GetTakerFeeBps() uint32 {
+	if x != nil {
+		return x.TakerFeeBps
+	}
+	return 0
+}
+
+// AllowedDenom represents the information for an allowed ask/bid denom.
+type AllowedDenom struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// denom is the bank denom to allow (ex. ibc/GLKHDSG423SGS)
+	BankDenom string `protobuf:"bytes,1,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
+	// display_denom is the denom to display to the user and is informational.
+	// Because the denom is likely an IBC denom, this should be chosen by
+	// governance to represent the consensus trusted name of the denom.
+	DisplayDenom string `protobuf:"bytes,2,opt,name=display_denom,json=displayDenom,proto3" json:"display_denom,omitempty"`
+	// exponent is the exponent that relates the denom to the display_denom and is
+	// informational
+	Exponent uint32 `protobuf:"varint,3,opt,name=exponent,proto3" json:"exponent,omitempty"`
+}
+
+func (x *AllowedDenom) Reset() {
+	*x = AllowedDenom{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllowedDenom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllowedDenom) ProtoMessage() {}
+
+// Deprecated: Use AllowedDenom.ProtoReflect.Descriptor instead.
+func (*AllowedDenom) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AllowedDenom) GetBankDenom() string {
+	if x != nil {
+		return x.BankDenom
+	}
+	return ""
+}
+
+func (x *AllowedDenom) GetDisplayDenom() string {
+	if x != nil {
+		return x.DisplayDenom
+	}
+	return ""
+}
+
+func (x *AllowedDenom) GetExponent() uint32 {
+	if x != nil {
+		return x.Exponent
+	}
+	return 0
+}
+
+// Market describes a distinctly processed market between a credit type and
+// allowed bank denom. Each market has its own precision in the order book
+// and is processed independently of other markets. Governance must enable
+// markets one by one. Every additional enabled market potentially adds more
+// processing overhead to the blockchain and potentially weakens liquidity in
+// competing markets. For instance, enabling side by side USD/Carbon and
+// EUR/Carbon markets may have the end result that each market individually has
+// less liquidity and longer settlement times. Such decisions should be taken
+// with care.
+type Market struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique ID of the market.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// credit_type_abbrev is the abbreviation of the credit type.
+	CreditTypeAbbrev string `protobuf:"bytes,2,opt,name=credit_type_abbrev,json=creditTypeAbbrev,proto3" json:"credit_type_abbrev,omitempty"`
+	// bank_denom is an allowed bank denom.
+	BankDenom string `protobuf:"bytes,3,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
+	// precision_modifier is an optional modifier used to convert arbitrary
+	// precision integer bank amounts to uint32 values used for sorting in the
+	// order book. Given an arbitrary precision integer x, its uint32 conversion
+	// will be x / 10^precision_modifier using round half away from zero
+	// rounding.
+	//
+	// uint32 values range from 0 to 4,294,967,295.
+	// This allows for a full 9 digits of precision. In most real world markets
+	// this amount of precision is sufficient and most common downside -
+	// that some orders with very miniscule price differences may be ordered
+	// equivalently (because of rounding) - is acceptable.
+	// Note that this rounding will not affect settlement price which will
+	// always be done exactly.
+	//
+	// Given a USD stable coin with 6 decimal digits, a precision_modifier
+	// of 0 is probably acceptable as long as credits are always less than
+	// $4,294/unit. With precision down to $0.001 (a precision_modifier of 3
+	// in this case), prices can rise up to $4,294,000/unit. Either scenario
+	// is probably quite acceptable given that carbon prices are unlikely to
+	// rise above $1000/ton any time in the near future.
+	//
+	// If credit prices, exceed the maximum range of uint32 with this
+	// precision_modifier, orders with high prices will fail and governance
+	// will need to adjust precision_modifier to allow for higher prices in
+	// exchange for less precision at the lower end.
+	PrecisionModifier uint32 `protobuf:"varint,4,opt,name=precision_modifier,json=precisionModifier,proto3" json:"precision_modifier,omitempty"`
+	// registrant is the address of the account that registered the market when
+	// market registration is permissionless. The registrant is empty for
+	// markets created for a bank denom allowed by governance.
+	Registrant []byte `protobuf:"bytes,5,opt,name=registrant,proto3" json:"registrant,omitempty"`
+	// display_denom is the display denom of the bank denom read from the bank
+	// denom metadata when the market was registered.
+	DisplayDenom string `protobuf:"bytes,6,opt,name=display_denom,json=displayDenom,proto3" json:"display_denom,omitempty"`
+	// exponent is the exponent that relates the bank denom to the display denom
+	// read from the bank denom metadata when the market was registered.
+	Exponent uint32 `protobuf:"varint,7,opt,name=exponent,proto3" json:"exponent,omitempty"`
+	// delisted is true if the market has been delisted by governance. New
+	// orders cannot be created and existing orders cannot be filled in a
+	// delisted market.
+	Delisted bool `protobuf:"varint,8,opt,name=delisted,proto3" json:"delisted,omitempty"`
+}
+
+func (x *Market) Reset() {
+	*x = Market{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Market) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Market) ProtoMessage() {}
+
+// Deprecated: Use Market.ProtoReflect.Descriptor instead.
+func (*Market) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Market) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Market) GetCreditTypeAbbrev() string {
+	if x != nil {
+		return x.CreditTypeAbbrev
+	}
+	return ""
+}
+
+func (x *Market) GetBankDenom() string {
+	if x != nil {
+		return x.BankDenom
+	}
+	return ""
+}
+
+func (x *Market) GetPrecisionModifier() uint32 {
+	if x != nil {
+		return x.PrecisionModifier
+	}
+	return 0
+}
+
+func (x *Market) GetRegistrant() []byte {
+	if x != nil {
+		return x.Registrant
+	}
+	return nil
+}
+
+func (x *Market) GetDisplayDenom() string {
+	if x != nil {
+		return x.DisplayDenom
+	}
+	return ""
+}
+
+func (x *Market) GetExponent() uint32 {
+	if x != nil {
+		return x.Exponent
+	}
+	return 0
+}
+
+func (x *Market) GetDelisted() bool {
+	if x != nil {
+		return x.Delisted
+	}
+	return false
+}
+
+// MarketFee defines the maker and taker fees of a market. The fees of a market
+// take precedence over the fees of the credit type of the market.
+type MarketFee struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// market_id is the unique ID of the market.
+	MarketId uint64 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// maker_fee_bps is the fee in basis points paid by the seller of a sell
+	// order resting in the order book when the sell order is filled.
+	MakerFeeBps uint32 `protobuf:"varint,2,opt,name=maker_fee_bps,json=makerFeeBps,proto3" json:"maker_fee_bps,omitempty"`
+	// taker_fee_bps is the fee in basis points paid by the buyer when a sell
+	// order is filled.
+	TakerFeeBps uint32 `protobuf:"varint,3,opt,name=taker_fee_bps,json=takerFeeBps,proto3" json:"taker_fee_bps,omitempty"`
+}
+
+func (x *MarketFee) Reset() {
+	*x = MarketFee{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketFee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketFee) ProtoMessage() {}
+
+// Deprecated: Use MarketFee.ProtoReflect.Descriptor instead.
+func (*MarketFee) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MarketFee) GetMarketId() uint64 {
+	if x != nil {
+		return x.MarketId
+	}
+	return 0
+}
+
+func (x *MarketFee) GetMakerFeeBps() uint32 {
+	if x != nil {
+		return x.MakerFeeBps
+	}
+	return 0
+}
+
+func (x *MarketFee) GetTakerFeeBps() uint32 {
+	if x != nil {
+		return x.TakerFeeBps
+	}
+	return 0
+}
+
+// CreditTypeFee defines the maker and taker fees of all markets of a credit
+// type that do not have market specific fees.
+type CreditTypeFee struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// credit_type_abbrev is the abbreviation of the credit type.
+	CreditTypeAbbrev string `protobuf:"bytes,1,opt,name=credit_type_abbrev,json=creditTypeAbbrev,proto3" json:"credit_type_abbrev,omitempty"`
+	// maker_fee_bps is the fee in basis points paid by the seller of a sell
+	// order resting in the order book when the sell order is filled.
+	MakerFeeBps uint32 `protobuf:"varint,2,opt,name=maker_fee_bps,json=makerFeeBps,proto3" json:"maker_fee_bps,omitempty"`
+	// taker_fee_bps is the fee in basis points paid by the buyer when a sell
+	// order is filled.
+	TakerFeeBps uint32 `protobuf:"varint,3,opt,name=taker_fee_bps,json=takerFeeBps,proto3" json:"taker_fee_bps,omitempty"`
+}
+
+func (x *CreditTypeFee) Reset() {
+	*x = CreditTypeFee{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreditTypeFee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditTypeFee) ProtoMessage() {}
+
+// Deprecated: Use CreditTypeFee.ProtoReflect.Descriptor instead.
+func (*CreditTypeFee) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreditTypeFee) GetCreditTypeAbbrev() string {
+	if x != nil {
+		return x.CreditTypeAbbrev
+	}
+	return ""
+}
+
+func (x *CreditTypeFee) GetMakerFeeBps() uint32 {
+	if x != nil {
+		return x.MakerFeeBps
+	}
+	return 0
+}
+
+func (x *CreditTypeFee) GetTakerFeeBps() uint32 {
+	if x != nil {
+		return x.TakerFeeBps
+	}
+	return 0
+}
+
+// FeeDestination defines where marketplace fees are sent when collected.
+type FeeDestination struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// destination is the type of the fee destination. Fees are sent to the
+	// community pool when the destination is unspecified.
+	Destination FeeDestinationType `protobuf:"varint,1,opt,name=destination,proto3,enum=regen.ecocredit.marketplace.v1.FeeDestinationType" json:"destination,omitempty"`
+	// address is the address of the account receiving fees when the destination
+	// is FEE_DESTINATION_TYPE_ACCOUNT.
+	Address []byte `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *FeeDestination) Reset() {
+	*x = FeeDestination{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeDestination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeDestination) ProtoMessage() {}
+
+// Deprecated: Use FeeDestination.ProtoReflect.Descriptor instead.
+func (*FeeDestination) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FeeDestination) GetDestination() FeeDestinationType {
+	if x != nil {
+		return x.Destination
+	}
+	return FeeDestinationType_FEE_DESTINATION_TYPE_UNSPECIFIED
+}
+
+func (x *FeeDestination) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+// MarketFeeTotal stores the total amount of fees collected in a market.
+type MarketFeeTotal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// market_id is the unique ID of the market.
+	MarketId uint64 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// amount is the integer amount (encoded as a string) of fees collected in the
+	// bank denom of the market.
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *MarketFeeTotal) Reset() {
+	*x = MarketFeeTotal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketFeeTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketFeeTotal) ProtoMessage() {}
+
+// Deprecated: Use MarketFeeTotal.ProtoReflect.Descriptor instead.
+func (*MarketFeeTotal) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MarketFeeTotal) GetMarketId() uint64 {
+	if x != nil {
+		return x.MarketId
+	}
+	return 0
+}
+
+func (x *MarketFeeTotal) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+// Trade stores a completed trade of credits in the marketplace.
+type Trade struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique ID of the trade.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// buyer is the address of the account that bought the credits.
+	Buyer []byte `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// seller is the address of the account that sold the credits.
+	Seller []byte `protobuf:"bytes,3,opt,name=seller,proto3" json:"seller,omitempty"`
+	// batch_key is the table row ID of the credit batch of the credits.
+	BatchKey uint64 `protobuf:"varint,4,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// market_id is the market in which the trade occurred.
+	MarketId uint64 `protobuf:"varint,5,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// sell_order_id is the ID of the sell order that was filled. The sell order
+	// ID is 0 if the credits were sold by accepting an offer or in an auction.
+	SellOrderId uint64 `protobuf:"varint,6,opt,name=sell_order_id,json=sellOrderId,proto3" json:"sell_order_id,omitempty"`
+	// buy_order_id is the ID of the buy order that was filled. The buy order ID
+	// is 0 if the credits were bought directly.
+	BuyOrderId uint64 `protobuf:"varint,7,opt,name=buy_order_id,json=buyOrderId,proto3" json:"buy_order_id,omitempty"`
+	// quantity is the decimal quantity of credits traded.
+	Quantity string `protobuf:"bytes,8,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// unit_price is the integer price per credit (encoded as a string) in the
+	// bank denom of the market.
+	UnitPrice string `protobuf:"bytes,9,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	// timestamp is the block time of the trade.
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// offer_id is the ID of the offer that was accepted. The offer ID is 0 if
+	// the credits were not sold by accepting an offer.
+	OfferId uint64 `protobuf:"varint,11,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+	// dutch_auction_id is the ID of the dutch auction the credits were bought
+	// from. The dutch auction ID is 0 if the credits were not bought from a
+	// dutch auction.
+	DutchAuctionId uint64 `protobuf:"varint,12,opt,name=dutch_auction_id,json=dutchAuctionId,proto3" json:"dutch_auction_id,omitempty"`
+	// sealed_bid_auction_id is the ID of the sealed-bid auction that was
+	// settled. The sealed-bid auction ID is 0 if the credits were not sold in a
+	// sealed-bid auction.
+	SealedBidAuctionId uint64 `protobuf:"varint,13,opt,name=sealed_bid_auction_id,json=sealedBidAuctionId,proto3" json:"sealed_bid_auction_id,omitempty"`
+	// forward_contract_id is the ID of the forward contract that was delivered.
+	// The forward contract ID is 0 if the credits were not delivered to fill a
+	// forward contract.
+	ForwardContractId uint64 `protobuf:"varint,14,opt,name=forward_contract_id,json=forwardContractId,proto3" json:"forward_contract_id,omitempty"`
+}
+
+func (x *Trade) Reset() {
+	*x = Trade{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Trade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Trade) ProtoMessage() {}
+
+// Deprecated: Use Trade.ProtoReflect.Descriptor instead.
+func (*Trade) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Trade) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Trade) GetBuyer() []byte {
+	if x != nil {
+		return x.Buyer
+	}
+	return nil
+}
+
+func (x *Trade) GetSeller() []byte {
+	if x != nil {
+		return x.Seller
+	}
+	return nil
+}
+
+func (x *Trade) GetBatchKey() uint64 {
+	if x != nil {
+		return x.BatchKey
+	}
+	return 0
+}
+
+func (x *Trade) GetMarketId() uint64 {
+	if x != nil {
+		return x.MarketId
+	}
+	return 0
+}
+
+func (x *Trade) GetSellOrderId() uint64 {
+	if x != nil {
+		return x.SellOrderId
+	}
+	return 0
+}
+
+func (x *Trade) GetBuyOrderId() uint64 {
+	if x != nil {
+		return x.BuyOrderId
+	}
+	return 0
+}
+
+func (x *Trade) GetQuantity() string {
+	if x != nil {
+		return x.Quantity
+	}
+	return ""
+}
+
+func (x *Trade) GetUnitPrice() string {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return ""
+}
+
+func (x *Trade) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *Trade) GetOfferId() uint64 {
+	if x != nil {
+		return x.OfferId
+	}
+	return 0
+}
+
+func (x *Trade) GetDutchAuctionId() uint64 {
+	if x != nil {
+		return x.DutchAuctionId
+	}
+	return 0
+}
+
+func (x *Trade) GetSealedBidAuctionId() uint64 {
+	if x != nil {
+		return x.SealedBidAuctionId
+	}
+	return 0
+}
+
+func (x *Trade) GetForwardContractId() uint64 {
+	if x != nil {
+		return x.ForwardContractId
+	}
+	return 0
+}
+
+// AddressTrade indexes trades by the address of the buyer and the seller.
+type AddressTrade struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the address of the buyer or the seller of the trade.
+	Address []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// trade_id is the unique ID of the trade.
+	TradeId uint64 `protobuf:"varint,2,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+}
+
+func (x *AddressTrade) Reset() {
+	*x = AddressTrade{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddressTrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressTrade) ProtoMessage() {}
+
+// Deprecated: Use AddressTrade.ProtoReflect.Descriptor instead.
+func (*AddressTrade) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AddressTrade) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *AddressTrade) GetTradeId() uint64 {
+	if x != nil {
+		return x.TradeId
+	}
+	return 0
+}
+
+// Offer represents a buyer-initiated offer to buy credits from a credit batch.
+// The bid amount for the remaining quantity of the offer and the maker fee are
+// held in escrow by the ecocredit module account until the offer is accepted,
+// cancelled or expired.
+type Offer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique ID of the offer.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// buyer is the address of the account that made the offer.
+	Buyer []byte `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// batch_key is the table row ID of the credit batch of the credits being
+	// bought.
+	BatchKey uint64 `protobuf:"varint,3,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// holder is the optional address of the credit holder the offer is made to.
+	// If empty, any holder of credits from the credit batch can accept the offer.
+	Holder []byte `protobuf:"bytes,4,opt,name=holder,proto3" json:"holder,omitempty"`
+	// quantity is the decimal quantity of credits remaining to be bought.
+	Quantity string `protobuf:"bytes,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// market_id is the market in which this offer exists and specifies the
+	// bank_denom that bid_amount corresponds to forming the bid_price.
+	MarketId uint64 `protobuf:"varint,6,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// bid_amount is the integer amount (encoded as a string) that the buyer is
+	// paying for each credit unit.
+	BidAmount string `protobuf:"bytes,7,opt,name=bid_amount,json=bidAmount,proto3" json:"bid_amount,omitempty"`
+	// disable_auto_retire disables auto-retirement of credits which allows a
+	// buyer to receive credits in a tradable state.
+	DisableAutoRetire bool `protobuf:"varint,8,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
+	// retirement_jurisdiction is the optional retirement jurisdiction for the
+	// credits which will be used only if disable_auto_retire is false.
+	RetirementJurisdiction string `protobuf:"bytes,9,opt,name=retirement_jurisdiction,json=retirementJurisdiction,proto3" json:"retirement_jurisdiction,omitempty"`
+	// expiration is an optional timestamp when the offer expires. When the
+	// expiration time is reached, the offer is removed from state and the
+	// escrowed funds are returned to the buyer.
+	Expiration *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// maker_fee_bps is the maker fee in basis points that applied to the market
+	// when the offer was created. The maker fee for the total quantity of the
+	// offer is held in escrow along with the bid amount.
+	MakerFeeBps uint32 `protobuf:"varint,11,opt,name=maker_fee_bps,json=makerFeeBps,proto3" json:"maker_fee_bps,omitempty"`
+}
+
+func (x *Offer) Reset() {
+	*x = Offer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Offer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Offer) ProtoMessage() {}
+
+// Deprecated: Use Offer.ProtoReflect.Descriptor instead.
+func (*Offer) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Offer) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Offer) GetBuyer() []byte {
+	if x != nil {
+		return x.Buyer
+	}
+	return nil
+}
+
+func (x *Offer) GetBatchKey() uint64 {
+	if x != nil {
+		return x.BatchKey
+	}
+	return 0
+}
+
+func (x *Offer) GetHolder() []byte {
+	if x != nil {
+		return x.Holder
+	}
+	return nil
+}
+
+func (x *Offer) GetQuantity() string {
+	if x != nil {
+		return x.Quantity
+	}
+	return ""
+}
+
+func (x *Offer) GetMarketId() uint64 {
+	if x != nil {
+		return x.MarketId
+	}
+	return 0
+}
+
+func (x *Offer) GetBidAmount() string {
+	if x != nil {
+		return x.BidAmount
+	}
+	return ""
+}
+
+func (x *Offer) GetDisableAutoRetire() bool {
+	if x != nil {
+		return x.DisableAutoRetire
+	}
+	return false
+}
+
+func (x *Offer) GetRetirementJurisdiction() string {
+	if x != nil {
+		return x.RetirementJurisdiction
+	}
+	return ""
+}
+
+func (x *Offer) GetExpiration() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
+func (x *Offer) GetMakerFeeBps() uint32 {
+	if x != nil {
+		return x.MakerFeeBps
+	}
+	return 0
+}
+
+// DutchAuction represents a descending-price auction of credits. The ask price
+// decays linearly from the start amount to the floor amount between the start
+// time and the end time and remains at the floor amount after the end time.
+// The credits being sold are held in the escrowed balance of the seller until
+// they are bought or the auction is cancelled.
+type DutchAuction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique ID of the dutch auction.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// seller is the address of the account that is selling credits.
+	Seller []byte `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// batch_key is the table row ID of the credit batch of the credits being
+	// sold.
+	BatchKey uint64 `protobuf:"varint,3,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// quantity is the decimal quantity of credits remaining to be sold.
+	Quantity string `protobuf:"bytes,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// market_id is the market in which this auction exists and specifies the
+	// bank_denom of the start amount and the floor amount.
+	MarketId uint64 `protobuf:"varint,5,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// start_amount is the integer amount (encoded as a string) of the ask price
+	// per credit at the start time of the auction.
+	StartAmount string `protobuf:"bytes,6,opt,name=start_amount,json=startAmount,proto3" json:"start_amount,omitempty"`
+	// floor_amount is the integer amount (encoded as a string) of the ask price
+	// per credit at and after the end time of the auction.
+	FloorAmount string `protobuf:"bytes,7,opt,name=floor_amount,json=floorAmount,proto3" json:"floor_amount,omitempty"`
+	// start_time is the block time when the auction was created.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time is the time when the ask price reaches the floor amount.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// disable_auto_retire disables auto-retirement of credits which allows a
+	// buyer to disable auto-retirement in their buy order enabling them to
+	// resell the credits to another buyer.
+	DisableAutoRetire bool `protobuf:"varint,10,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
+}
+
+func (x *DutchAuction) Reset() {
+	*x = DutchAuction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DutchAuction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DutchAuction) ProtoMessage() {}
+
+// Deprecated: Use DutchAuction.ProtoReflect.Descriptor instead.
+func (*DutchAuction) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DutchAuction) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DutchAuction) GetSeller() []byte {
+	if x != nil {
+		return x.Seller
+	}
+	return nil
+}
+
+func (x *DutchAuction) GetBatchKey() uint64 {
+	if x != nil {
+		return x.BatchKey
+	}
+	return 0
+}
+
+func (x *DutchAuction) GetQuantity() string {
+	if x != nil {
+		return x.Quantity
+	}
+	return ""
+}
+
+func (x *DutchAuction) GetMarketId() uint64 {
+	if x != nil {
+		return x.MarketId
+	}
+	return 0
+}
+
+func (x *DutchAuction) GetStartAmount() string {
+	if x != nil {
+		return x.StartAmount
+	}
+	return ""
+}
+
+func (x *DutchAuction) GetFloorAmount() string {
+	if x != nil {
+		return x.FloorAmount
+	}
+	return ""
+}
+
+func (x *DutchAuction) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *DutchAuction) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *DutchAuction) GetDisableAutoRetire() bool {
+	if x != nil {
+		return x.DisableAutoRetire
+	}
+	return false
+}
+
+// SealedBidAuction represents a sealed-bid auction of credits. Bidders commit
+// to hidden bids until the commit end height, reveal their bids until the
+// reveal end height, and the auction is settled at the reveal end height at a
+// uniform clearing price. The credits being sold are held in the escrowed
+// balance of the seller until the auction is settled.
+type SealedBidAuction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique ID of the sealed-bid auction.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// seller is the address of the account that is selling credits.
+	Seller []byte `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// batch_key is the table row ID of the credit batch of the credits being
+	// sold.
+	BatchKey uint64 `protobuf:"varint,3,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// quantity is the decimal quantity of credits being sold.
+	Quantity string `protobuf:"bytes,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// market_id is the market in which this auction exists and specifies the
+	// bank_denom of the reserve amount and of the bids.
+	MarketId uint64 `protobuf:"varint,5,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// reserve_amount is the integer amount (encoded as a string) of the minimum
+	// price per credit the seller is willing to accept.
+	ReserveAmount string `protobuf:"bytes,6,opt,name=reserve_amount,json=reserveAmount,proto3" json:"reserve_amount,omitempty"`
+	// commit_end_height is the last block height at which bids can be
+	// committed.
+	CommitEndHeight int64 `protobuf:"varint,7,opt,name=commit_end_height,json=commitEndHeight,proto3" json:"commit_end_height,omitempty"`
+	// reveal_end_height is the last block height at which bids can be revealed.
+	// The auction is settled at the end of this block.
+	RevealEndHeight int64 `protobuf:"varint,8,opt,name=reveal_end_height,json=revealEndHeight,proto3" json:"reveal_end_height,omitempty"`
+	// disable_auto_retire disables auto-retirement of credits which allows a
+	// bidder to disable auto-retirement in their bid enabling them to resell
+	// the credits to another buyer.
+	DisableAutoRetire bool `protobuf:"varint,9,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
+	// maker_fee_bps is the maker fee in basis points that applied to the market
+	// when the auction was created. The maker fee is paid by the seller.
+	MakerFeeBps uint32 `protobuf:"varint,10,opt,name=maker_fee_bps,json=makerFeeBps,proto3" json:"maker_fee_bps,omitempty"`
+	// taker_fee_bps is the taker fee in basis points that applied to the market
+	// when the auction was created. The taker fee is paid by the winning
+	// bidders.
+	TakerFeeBps uint32 `protobuf:"varint,11,opt,name=taker_fee_bps,json=takerFeeBps,proto3" json:"taker_fee_bps,omitempty"`
+}
+
+func (x *SealedBidAuction) Reset() {
+	*x = SealedBidAuction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SealedBidAuction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SealedBidAuction) ProtoMessage() {}
+
+// Deprecated: Use SealedBidAuction.ProtoReflect.Descriptor instead.
+func (*SealedBidAuction) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SealedBidAuction) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SealedBidAuction) GetSeller() []byte {
+	if x != nil {
+		return x.Seller
+	}
+	return nil
+}
+
+func (x *SealedBidAuction) GetBatchKey() uint64 {
+	if x != nil {
+		return x.BatchKey
+	}
+	return 0
+}
+
+func (x *SealedBidAuction) GetQuantity() string {
+	if x != nil {
+		return x.Quantity
+	}
+	return ""
+}
+
+func (x *SealedBidAuction) GetMarketId() uint64 {
+	if x != nil {
+		return x.MarketId
+	}
+	return 0
+}
+
+func (x *SealedBidAuction) GetReserveAmount() string {
+	if x != nil {
+		return x.ReserveAmount
+	}
+	return ""
+}
+
+func (x *SealedBidAuction) GetCommitEndHeight() int64 {
+	if x != nil {
+		return x.CommitEndHeight
+	}
+	return 0
+}
+
+func (x *SealedBidAuction) GetRevealEndHeight() int64 {
+	if x != nil {
+		return x.RevealEndHeight
+	}
+	return 0
+}
+
+func (x *SealedBidAuction) GetDisableAutoRetire() bool {
+	if x != nil {
+		return x.DisableAutoRetire
+	}
+	return false
+}
+
+func (x *SealedBidAuction) GetMakerFeeBps() uint32 {
+	if x != nil {
+		return x.MakerFeeBps
+	}
+	return 0
+}
+
+func (x *SealedBidAuction) GetTakerFeeBps() uint32 {
+	if x != nil {
+		return x.TakerFeeBps
+	}
+	return 0
+}
+
+// SealedBid represents a bid in a sealed-bid auction. The deposit is held in
+// escrow by the ecocredit module account until the auction is settled.
+type SealedBid struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// auction_id is the ID of the sealed-bid auction.
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// bidder is the address of the account that committed the bid.
+	Bidder []byte `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// commitment is the hash of the hidden bid (see SealedBidCommitment).
+	Commitment []byte `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// deposit_amount is the integer amount (encoded as a string) escrowed by the
+	// bidder. The deposit must cover the bid amount for the quantity of the bid
+	// and the taker fee when the bid is revealed.
+	DepositAmount string `protobuf:"bytes,4,opt,name=deposit_amount,json=depositAmount,proto3" json:"deposit_amount,omitempty"`
+	// revealed is whether the bid has been revealed.
+	Revealed bool `protobuf:"varint,5,opt,name=revealed,proto3" json:"revealed,omitempty"`
+	// quantity is the decimal quantity of credits bid for once revealed.
+	Quantity string `protobuf:"bytes,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// bid_amount is the integer amount (encoded as a string) the bidder is
+	// willing to pay per credit once revealed.
+	BidAmount string `protobuf:"bytes,7,opt,name=bid_amount,json=bidAmount,proto3" json:"bid_amount,omitempty"`
+	// disable_auto_retire disables auto-retirement of the credits won by the
+	// bidder.
+	DisableAutoRetire bool `protobuf:"varint,8,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
+	// retirement_jurisdiction is the optional retirement jurisdiction for the
+	// credits which will be used only if disable_auto_retire is false.
+	RetirementJurisdiction string `protobuf:"bytes,9,opt,name=retirement_jurisdiction,json=retirementJurisdiction,proto3" json:"retirement_jurisdiction,omitempty"`
+}
+
+func (x *SealedBid) Reset() {
+	*x = SealedBid{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SealedBid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SealedBid) ProtoMessage() {}
+
+// Deprecated: Use SealedBid.ProtoReflect.Descriptor instead.
+func (*SealedBid) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SealedBid) GetAuctionId() uint64 {
+	if x != nil {
+		return x.AuctionId
+	}
+	return 0
+}
+
+func (x *SealedBid) GetBidder() []byte {
+	if x != nil {
+		return x.Bidder
+	}
+	return nil
+}
+
+func (x *SealedBid) GetCommitment() []byte {
+	if x != nil {
+		return x.Commitment
+	}
+	return nil
+}
+
+func (x *SealedBid) GetDepositAmount() string {
+	if x != nil {
+		return x.DepositAmount
+	}
+	return ""
+}
+
+func (x *SealedBid) GetRevealed() bool {
+	if x != nil {
+		return x.Revealed
+	}
+	return false
+}
+
+func (x *SealedBid) GetQuantity() string {
+	if x != nil {
+		return x.Quantity
+	}
+	return ""
+}
+
+func (x *SealedBid) GetBidAmount() string {
+	if x != nil {
+		return x.BidAmount
+	}
+	return ""
+}
+
+func (x *SealedBid) GetDisableAutoRetire() bool {
+	if x != nil {
+		return x.DisableAutoRetire
+	}
+	return false
+}
+
+func (x *SealedBid) GetRetirementJurisdiction() string {
+	if x != nil {
+		return x.RetirementJurisdiction
+	}
+	return ""
+}
+
+// ForwardSale represents a listing of future credits from a project. The
+// project admin lists a quantity of credits from credit batches within a
+// vintage range at an ask price, and buyers buy forward contracts against the
+// listing that are delivered when a matching credit batch is issued.
+type ForwardSale struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique ID of the forward sale.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// seller is the address of the project admin that created the forward sale.
+	Seller []byte `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// project_key is the table row ID of the project of the future credits.
+	ProjectKey uint64 `protobuf:"varint,3,opt,name=project_key,json=projectKey,proto3" json:"project_key,omitempty"`
+	// vintage_start is the earliest start date of a credit batch that can be
+	// delivered to fill forward contracts of the forward sale.
+	VintageStart *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=vintage_start,json=vintageStart,proto3" json:"vintage_start,omitempty"`
+	// vintage_end is the latest end date of a credit batch that can be delivered
+	// to fill forward contracts of the forward sale.
+	VintageEnd *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=vintage_end,json=vintageEnd,proto3" json:"vintage_end,omitempty"`
+	// quantity is the decimal quantity of future credits that remain available
+	// to be bought.
+	Quantity string `protobuf:"bytes,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// market_id is the market in which this forward sale exists and specifies
+	// the bank_denom that ask_amount corresponds to forming the ask_price.
+	MarketId uint64 `protobuf:"varint,7,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// ask_amount is the integer amount (encoded as a string) that the seller is
+	// asking for each credit unit.
+	AskAmount string `protobuf:"bytes,8,opt,name=ask_amount,json=askAmount,proto3" json:"ask_amount,omitempty"`
+	// delivery_deadline is the timestamp when the forward sale expires. When the
+	// delivery deadline is reached, the forward sale and its forward contracts
+	// are removed from state and the escrowed funds for credits that were not
+	// delivered are returned to the buyers.
+	DeliveryDeadline *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=delivery_deadline,json=deliveryDeadline,proto3" json:"delivery_deadline,omitempty"`
+	// disable_auto_retire disables auto-retirement of credits which allows a
+	// buyer to disable auto-retirement in their forward contract and receive
+	// the credits in a tradable state.
+	DisableAutoRetire bool `protobuf:"varint,10,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
+}
+
+func (x *ForwardSale) Reset() {
+	*x = ForwardSale{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForwardSale) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForwardSale) ProtoMessage() {}
+
+// Deprecated: Use ForwardSale.ProtoReflect.Descriptor instead.
+func (*ForwardSale) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ForwardSale) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ForwardSale) GetSeller() []byte {
+	if x != nil {
+		return x.Seller
+	}
+	return nil
+}
+
+func (x *ForwardSale) GetProjectKey() uint64 {
+	if x != nil {
+		return x.ProjectKey
+	}
+	return 0
+}
+
+func (x *ForwardSale) GetVintageStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VintageStart
+	}
+	return nil
+}
+
+func (x *ForwardSale) GetVintageEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VintageEnd
+	}
+	return nil
+}
+
+func (x *ForwardSale) GetQuantity() string {
+	if x != nil {
+		return x.Quantity
+	}
+	return ""
+}
+
+func (x *ForwardSale) GetMarketId() uint64 {
+	if x != nil {
+		return x.MarketId
+	}
+	return 0
+}
+
+func (x *ForwardSale) GetAskAmount() string {
+	if x != nil {
+		return x.AskAmount
+	}
+	return ""
+}
+
+func (x *ForwardSale) GetDeliveryDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveryDeadline
+	}
+	return nil
+}
+
+func (x *ForwardSale) GetDisableAutoRetire() bool {
+	if x != nil {
+		return x.DisableAutoRetire
+	}
+	return false
+}
+
+// ForwardContract represents a purchase of future credits from a forward sale.
+// The total cost of the credits remaining to be delivered and the taker fee
+// are held in escrow by the ecocredit module account until the credits are
+// delivered or the delivery deadline of the forward sale is reached. Forward
+// contracts of a project are delivered in the order they were created.
+type ForwardContract struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// denom is the bank denom to allow (ex. ibc/GLKHDSG423SGS)
-	BankDenom string `protobuf:"bytes,1,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
-	// display_denom is the denom to display to the user and is informational.
-	// Because the denom is likely an IBC denom, this should be chosen by
-	// governance to represent the consensus trusted name of the denom.
-	DisplayDenom string `protobuf:"bytes,2,opt,name=display_denom,json=displayDenom,proto3" json:"display_denom,omitempty"`
-	// exponent is the exponent that relates the denom to the display_denom and is
-	// informational
-	Exponent uint32 `protobuf:"varint,3,opt,name=exponent,proto3" json:"exponent,omitempty"`
+	// id is the unique ID of the forward contract. The ID determines the
+	// priority in which forward contracts of a project are delivered.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// forward_sale_id is the ID of the forward sale.
+	ForwardSaleId uint64 `protobuf:"varint,2,opt,name=forward_sale_id,json=forwardSaleId,proto3" json:"forward_sale_id,omitempty"`
+	// buyer is the address of the account that bought the forward contract.
+	Buyer []byte `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// project_key is the table row ID of the project of the forward sale.
+	ProjectKey uint64 `protobuf:"varint,4,opt,name=project_key,json=projectKey,proto3" json:"project_key,omitempty"`
+	// quantity is the decimal quantity of credits remaining to be delivered.
+	Quantity string `protobuf:"bytes,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// escrow_amount is the integer amount (encoded as a string) remaining in
+	// escrow for the credits that have not been delivered.
+	EscrowAmount string `protobuf:"bytes,6,opt,name=escrow_amount,json=escrowAmount,proto3" json:"escrow_amount,omitempty"`
+	// disable_auto_retire disables auto-retirement of credits which allows a
+	// buyer to receive credits in a tradable state.
+	DisableAutoRetire bool `protobuf:"varint,7,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
+	// retirement_jurisdiction is the optional retirement jurisdiction for the
+	// credits which will be used only if disable_auto_retire is false.
+	RetirementJurisdiction string `protobuf:"bytes,8,opt,name=retirement_jurisdiction,json=retirementJurisdiction,proto3" json:"retirement_jurisdiction,omitempty"`
+	// maker_fee_bps is the maker fee in basis points that applied to the market
+	// when the forward contract was created. The maker fee is paid by the
+	// seller when the credits are delivered.
+	MakerFeeBps uint32 `protobuf:"varint,9,opt,name=maker_fee_bps,json=makerFeeBps,proto3" json:"maker_fee_bps,omitempty"`
+	// taker_fee_bps is the taker fee in basis points that applied to the market
+	// when the forward contract was created. The taker fee is held in escrow
+	// along with the cost of the credits.
+	TakerFeeBps uint32 `protobuf:"varint,10,opt,name=taker_fee_bps,json=takerFeeBps,proto3" json:"taker_fee_bps,omitempty"`
 }
 
-func (x *AllowedDenom) Reset() {
-	*x = AllowedDenom{}
+func (x *ForwardContract) Reset() {
+	*x = ForwardContract{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[1]
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AllowedDenom) String() string {
+func (x *ForwardContract) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AllowedDenom) ProtoMessage() {}
+func (*ForwardContract) ProtoMessage() {}
 
-// Deprecated: Use AllowedDenom.ProtoReflect.Descriptor instead.
-func (*AllowedDenom) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use ForwardContract.ProtoReflect.Descriptor instead.
+func (*ForwardContract) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *AllowedDenom) GetBankDenom() string {
+func (x *ForwardContract) GetId() uint64 {
 	if x != nil {
-		return x.BankDenom
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ForwardContract) GetForwardSaleId() uint64 {
+	if x != nil {
+		return x.ForwardSaleId
+	}
+	return 0
+}
+
+func (x *ForwardContract) GetBuyer() []byte {
+	if x != nil {
+		return x.Buyer
+	}
+	return nil
+}
+
+func (x *ForwardContract) GetProjectKey() uint64 {
+	if x != nil {
+		return x.ProjectKey
+	}
+	return 0
+}
+
+func (x *ForwardContract) GetQuantity() string {
+	if x != nil {
+		return x.Quantity
 	}
 	return ""
 }
 
-func (x *AllowedDenom) GetDisplayDenom() string {
+func (x *ForwardContract) GetEscrowAmount() string {
 	if x != nil {
-		return x.DisplayDenom
+		return x.EscrowAmount
 	}
 	return ""
 }
 
-func (x *AllowedDenom) GetExponent() uint32 {
+func (x *ForwardContract) GetDisableAutoRetire() bool {
 	if x != nil {
-		return x.Exponent
+		return x.DisableAutoRetire
+	}
+	return false
+}
+
+func (x *ForwardContract) GetRetirementJurisdiction() string {
+	if x != nil {
+		return x.RetirementJurisdiction
+	}
+	return ""
+}
+
+func (x *ForwardContract) GetMakerFeeBps() uint32 {
+	if x != nil {
+		return x.MakerFeeBps
 	}
 	return 0
 }
 
-// Market describes a distinctly processed market between a credit type and
-// allowed bank denom. Each market has its own precision in the order book
-// and is processed independently of other markets. Governance must enable
-// markets one by one. Every additional enabled market potentially adds more
-// processing overhead to the blockchain and potentially weakens liquidity in
-// competing markets. For instance, enabling side by side USD/Carbon and
-// EUR/Carbon markets may have the end result that each market individually has
-// less liquidity and longer settlement times. Such decisions should be taken
-// with care.
-type Market struct {
+func (x *ForwardContract) GetTakerFeeBps() uint32 {
+	if x != nil {
+		return x.TakerFeeBps
+	}
+	return 0
+}
+
+// MarketRegistration defines whether any account can register a market for a
+// credit type and bank denom and the deposit paid to register a market.
+type MarketRegistration struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id is the unique ID of the market.
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// credit_type_abbrev is the abbreviation of the credit type.
-	CreditTypeAbbrev string `protobuf:"bytes,2,opt,name=credit_type_abbrev,json=creditTypeAbbrev,proto3" json:"credit_type_abbrev,omitempty"`
-	// bank_denom is an allowed bank denom.
-	BankDenom string `protobuf:"bytes,3,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
-	// precision_modifier is an optional modifier used to convert arbitrary
-	// precision integer bank amounts to uint32 values used for sorting in the
-	// order book. Given an arbitrary precision integer x, its uint32 conversion
-	// will be x / 10^precision_modifier using round half away from zero
-	// rounding.
-	//
-	// uint32 values range from 0 to 4,294,967,295.
-	// This allows for a full 9 digits of precision. In most real world markets
-	// this amount of precision is sufficient and most common downside -
-	// that some orders with very miniscule price differences may be ordered
-	// equivalently (because of rounding) - is acceptable.
-	// Note that this rounding will not affect settlement price which will
-	// always be done exactly.
-	//
-	// Given a USD stable coin with 6 decimal digits, a precision_modifier
-	// of 0 is probably acceptable as long as credits are always less than
-	// $4,294/unit. With precision down to $0.001 (a precision_modifier of 3
-	// in this case), prices can rise up to $4,294,000/unit. Either scenario
-	// is probably quite acceptable given that carbon prices are unlikely to
-	// rise above $1000/ton any time in the near future.
+	// enabled is true if market registration is permissionless.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// deposit_denom is the bank denom of the deposit paid to register a market.
+	DepositDenom string `protobuf:"bytes,2,opt,name=deposit_denom,json=depositDenom,proto3" json:"deposit_denom,omitempty"`
+	// deposit_amount is the amount of the deposit paid to register a market. The
+	// deposit is sent to the fee destination.
+	DepositAmount string `protobuf:"bytes,3,opt,name=deposit_amount,json=depositAmount,proto3" json:"deposit_amount,omitempty"`
+}
+
+func (x *MarketRegistration) Reset() {
+	*x = MarketRegistration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketRegistration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketRegistration) ProtoMessage() {}
+
+// Deprecated: Use MarketRegistration.ProtoReflect.Descriptor instead.
+func (*MarketRegistration) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MarketRegistration) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *MarketRegistration) GetDepositDenom() string {
+	if x != nil {
+		return x.DepositDenom
+	}
+	return ""
+}
+
+func (x *MarketRegistration) GetDepositAmount() string {
+	if x != nil {
+		return x.DepositAmount
+	}
+	return ""
+}
+
+// Filter is used to create filtered buy orders which match credit batch sell
+// orders based on selection criteria rather than matching individual sell
+// orders.
+type BuyOrder_Filter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// or is a list of criteria for matching credit batches. A credit batch
+	// which matches this filter must match at least one of these criteria.
+	Or []*BuyOrder_Filter_Criteria `protobuf:"bytes,1,rep,name=or,proto3" json:"or,omitempty"`
+}
+
+func (x *BuyOrder_Filter) Reset() {
+	*x = BuyOrder_Filter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuyOrder_Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyOrder_Filter) ProtoMessage() {}
+
+// Deprecated: Use BuyOrder_Filter.ProtoReflect.Descriptor instead.
+func (*BuyOrder_Filter) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *BuyOrder_Filter) GetOr() []*BuyOrder_Filter_Criteria {
+	if x != nil {
+		return x.Or
+	}
+	return nil
+}
+
+// ClassSelector is a selector for a credit class.
+type BuyOrder_ClassSelector struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// class_id is the unique identifier of the credit class.
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// project_jurisdiction is an optional jurisdiction prefix that the project
+	// jurisdiction must match (e.g. "US" or "US-WA").
+	ProjectJurisdiction string `protobuf:"bytes,2,opt,name=project_jurisdiction,json=projectJurisdiction,proto3" json:"project_jurisdiction,omitempty"`
+	// min_start_date is an optional minimum start date of the credit batch.
+	MinStartDate *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=min_start_date,json=minStartDate,proto3" json:"min_start_date,omitempty"`
+	// max_end_date is an optional maximum end date of the credit batch.
+	MaxEndDate *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=max_end_date,json=maxEndDate,proto3" json:"max_end_date,omitempty"`
+}
+
+func (x *BuyOrder_ClassSelector) Reset() {
+	*x = BuyOrder_ClassSelector{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuyOrder_ClassSelector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyOrder_ClassSelector) ProtoMessage() {}
+
+// Deprecated: Use BuyOrder_ClassSelector.ProtoReflect.Descriptor instead.
+func (*BuyOrder_ClassSelector) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *BuyOrder_ClassSelector) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *BuyOrder_ClassSelector) GetProjectJurisdiction() string {
+	if x != nil {
+		return x.ProjectJurisdiction
+	}
+	return ""
+}
+
+func (x *BuyOrder_ClassSelector) GetMinStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MinStartDate
+	}
+	return nil
+}
+
+func (x *BuyOrder_ClassSelector) GetMaxEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MaxEndDate
+	}
+	return nil
+}
+
+// ProjectSelector is a selector for a project.
+type BuyOrder_ProjectSelector struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// project_id is the unique identifier of the project.
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// min_start_date is an optional minimum start date of the credit batch.
+	MinStartDate *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=min_start_date,json=minStartDate,proto3" json:"min_start_date,omitempty"`
+	// max_end_date is an optional maximum end date of the credit batch.
+	MaxEndDate *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=max_end_date,json=maxEndDate,proto3" json:"max_end_date,omitempty"`
+}
+
+func (x *BuyOrder_ProjectSelector) Reset() {
+	*x = BuyOrder_ProjectSelector{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuyOrder_ProjectSelector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyOrder_ProjectSelector) ProtoMessage() {}
+
+// Deprecated: Use BuyOrder_ProjectSelector.ProtoReflect.Descriptor instead.
+func (*BuyOrder_ProjectSelector) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{1, 2}
+}
+
+func (x *BuyOrder_ProjectSelector) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *BuyOrder_ProjectSelector) GetMinStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MinStartDate
+	}
+	return nil
+}
+
+func (x *BuyOrder_ProjectSelector) GetMaxEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MaxEndDate
+	}
+	return nil
+}
+
+// BatchSelector is a selector for a credit batch.
+type BuyOrder_BatchSelector struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// batch_denom is the unique identifier of the credit batch.
+	BatchDenom string `protobuf:"bytes,1,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+}
+
+func (x *BuyOrder_BatchSelector) Reset() {
+	*x = BuyOrder_BatchSelector{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuyOrder_BatchSelector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyOrder_BatchSelector) ProtoMessage() {}
+
+// Deprecated: Use BuyOrder_BatchSelector.ProtoReflect.Descriptor instead.
+func (*BuyOrder_BatchSelector) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{1, 3}
+}
+
+func (x *BuyOrder_BatchSelector) GetBatchDenom() string {
+	if x != nil {
+		return x.BatchDenom
+	}
+	return ""
+}
+
+// Criteria is a simple filter criteria for matching a credit batch.
+type BuyOrder_Filter_Criteria struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sum is the selector used by the criteria.
 	//
-	// If credit prices, exceed the maximum range of uint32 with this
-	// precision_modifier, orders with high prices will fail and governance
-	// will need to adjust precision_modifier to allow for higher prices in
-	// exchange for less precision at the lower end.
-	PrecisionModifier uint32 `protobuf:"varint,4,opt,name=precision_modifier,json=precisionModifier,proto3" json:"precision_modifier,omitempty"`
+	// Types that are assignable to Sum:
+	//	*BuyOrder_Filter_Criteria_ClassSelector
+	//	*BuyOrder_Filter_Criteria_ProjectSelector
+	//	*BuyOrder_Filter_Criteria_BatchSelector
+	Sum isBuyOrder_Filter_Criteria_Sum `protobuf_oneof:"sum"`
 }
 
-func (x *Market) Reset() {
-	*x = Market{}
+func (x *BuyOrder_Filter_Criteria) Reset() {
+	*x = BuyOrder_Filter_Criteria{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[2]
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Market) String() string {
+func (x *BuyOrder_Filter_Criteria) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Market) ProtoMessage() {}
+func (*BuyOrder_Filter_Criteria) ProtoMessage() {}
 
-// Deprecated: Use Market.ProtoReflect.Descriptor instead.
-func (*Market) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use BuyOrder_Filter_Criteria.ProtoReflect.Descriptor instead.
+func (*BuyOrder_Filter_Criteria) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{1, 0, 0}
 }
 
-func (x *Market) GetId() uint64 {
+func (x *BuyOrder_Filter_Criteria) GetSum() isBuyOrder_Filter_Criteria_Sum {
 	if x != nil {
-		return x.Id
+		return x.Sum
 	}
-	return 0
+	return nil
 }
 
-func (x *Market) GetCreditTypeAbbrev() string {
-	if x != nil {
-		return x.CreditTypeAbbrev
+func (x *BuyOrder_Filter_Criteria) GetClassSelector() *BuyOrder_ClassSelector {
+	if x, ok := x.GetSum().(*BuyOrder_Filter_Criteria_ClassSelector); ok {
+		return x.ClassSelector
 	}
-	return ""
+	return nil
 }
 
-func (x *Market) GetBankDenom() string {
-	if x != nil {
-		return x.BankDenom
+func (x *BuyOrder_Filter_Criteria) GetProjectSelector() *BuyOrder_ProjectSelector {
+	if x, ok := x.GetSum().(*BuyOrder_Filter_Criteria_ProjectSelector); ok {
+		return x.ProjectSelector
 	}
-	return ""
+	return nil
 }
 
-func (x *Market) GetPrecisionModifier() uint32 {
-	if x != nil {
-		return x.PrecisionModifier
+func (x *BuyOrder_Filter_Criteria) GetBatchSelector() *BuyOrder_BatchSelector {
+	if x, ok := x.GetSum().(*BuyOrder_Filter_Criteria_BatchSelector); ok {
+		return x.BatchSelector
 	}
-	return 0
+	return nil
+}
+
+type isBuyOrder_Filter_Criteria_Sum interface {
+	isBuyOrder_Filter_Criteria_Sum()
+}
+
+type BuyOrder_Filter_Criteria_ClassSelector struct {
+	// class_selector is a credit class selector.
+	ClassSelector *BuyOrder_ClassSelector `protobuf:"bytes,1,opt,name=class_selector,json=classSelector,proto3,oneof"`
+}
+
+type BuyOrder_Filter_Criteria_ProjectSelector struct {
+	// project_selector is a project selector.
+	ProjectSelector *BuyOrder_ProjectSelector `protobuf:"bytes,2,opt,name=project_selector,json=projectSelector,proto3,oneof"`
+}
+
+type BuyOrder_Filter_Criteria_BatchSelector struct {
+	// batch_selector is a credit batch selector.
+	BatchSelector *BuyOrder_BatchSelector `protobuf:"bytes,3,opt,name=batch_selector,json=batchSelector,proto3,oneof"`
 }
 
+func (*BuyOrder_Filter_Criteria_ClassSelector) isBuyOrder_Filter_Criteria_Sum() {}
+
+func (*BuyOrder_Filter_Criteria_ProjectSelector) isBuyOrder_Filter_Criteria_Sum() {}
+
+func (*BuyOrder_Filter_Criteria_BatchSelector) isBuyOrder_Filter_Criteria_Sum() {}
+
 var File_regen_ecocredit_marketplace_v1_state_proto protoreflect.FileDescriptor
 
 var file_regen_ecocredit_marketplace_v1_state_proto_rawDesc = []byte{
@@ -2303,7 +18536,7 @@ var file_regen_ecocredit_marketplace_v1_state_proto_rawDesc = []byte{
 	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
 	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6f, 0x72, 0x6d, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2f, 0x6f, 0x72, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe7, 0x02, 0x0a,
+	0x61, 0x31, 0x2f, 0x6f, 0x72, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x94, 0x06, 0x0a,
 	0x09, 0x53, 0x65, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65,
 	0x6c, 0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x6c,
@@ -2322,52 +18555,429 @@ var file_regen_ecocredit_marketplace_v1_state_proto_rawDesc = []byte{
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
 	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x3a, 0x3b, 0xf2, 0x9e, 0xd3, 0x8e, 0x03,
-	0x35, 0x0a, 0x06, 0x0a, 0x02, 0x69, 0x64, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x62, 0x61, 0x74,
-	0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c,
-	0x65, 0x72, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x10, 0x03, 0x18, 0x01, 0x22, 0x9b, 0x01, 0x0a, 0x0c, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
-	0x65, 0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x6e, 0x6b, 0x5f,
-	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x6e,
-	0x6b, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
-	0x79, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64,
-	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x65,
-	0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x65,
-	0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x3a, 0x2b, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x25, 0x0a,
-	0x0c, 0x0a, 0x0a, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x13, 0x0a,
-	0x0d, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x10, 0x01,
-	0x18, 0x01, 0x18, 0x03, 0x22, 0xcb, 0x01, 0x0a, 0x06, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x2c, 0x0a, 0x12, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x61,
-	0x62, 0x62, 0x72, 0x65, 0x76, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x72, 0x65,
-	0x64, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x41, 0x62, 0x62, 0x72, 0x65, 0x76, 0x12, 0x1d, 0x0a,
-	0x0a, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x62, 0x61, 0x6e, 0x6b, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x2d, 0x0a, 0x12,
-	0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73,
-	0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x3a, 0x35, 0xf2, 0x9e, 0xd3,
-	0x8e, 0x03, 0x2f, 0x0a, 0x06, 0x0a, 0x02, 0x69, 0x64, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1d, 0x63,
-	0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x61, 0x62, 0x62, 0x72, 0x65,
-	0x76, 0x2c, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x10, 0x01, 0x18, 0x01,
-	0x18, 0x04, 0x42, 0xa3, 0x02, 0x0a, 0x22, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e,
-	0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x56, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64,
-	0x69, 0x74, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x76,
-	0x31, 0x3b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x76, 0x31, 0xa2,
-	0x02, 0x03, 0x52, 0x45, 0x4d, 0xaa, 0x02, 0x1e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x45, 0x63,
-	0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c,
-	0x61, 0x63, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45,
-	0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70,
-	0x6c, 0x61, 0x63, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x2a, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c,
-	0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x70, 0x6c, 0x61, 0x63, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x21, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x3a, 0x3a, 0x45, 0x63,
-	0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x3a, 0x3a, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70,
-	0x6c, 0x61, 0x63, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x28, 0x08, 0x52, 0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x5f, 0x62, 0x75, 0x79, 0x65, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x42, 0x75, 0x79, 0x65, 0x72, 0x73,
+	0x12, 0x2c, 0x0a, 0x12, 0x62, 0x75, 0x79, 0x65, 0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x62, 0x75,
+	0x79, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1b,
+	0x0a, 0x09, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x44, 0x0a, 0x10,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0e, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x73, 0x6b, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x61, 0x73, 0x6b, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x5f,
+	0x66, 0x69, 0x6c, 0x6c, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x46, 0x69, 0x6c, 0x6c, 0x51, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x5f, 0x6f, 0x72, 0x5f, 0x6e,
+	0x6f, 0x6e, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x4f, 0x72,
+	0x4e, 0x6f, 0x6e, 0x65, 0x3a, 0x9c, 0x01, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x95, 0x01, 0x0a, 0x06,
+	0x0a, 0x02, 0x69, 0x64, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x6b, 0x65, 0x79, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x10,
+	0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10,
+	0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x2c, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x10,
+	0x04, 0x12, 0x20, 0x0a, 0x1c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6b, 0x65, 0x79,
+	0x2c, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x10, 0x05, 0x12, 0x1c, 0x0a, 0x18, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x2c, 0x61, 0x73, 0x6b, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x10,
+	0x06, 0x18, 0x01, 0x22, 0xad, 0x0a, 0x0a, 0x08, 0x42, 0x75, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x12, 0x47, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65,
+	0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x69, 0x64, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x69,
+	0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74,
+	0x6f, 0x52, 0x65, 0x74, 0x69, 0x72, 0x65, 0x12, 0x37, 0x0a, 0x17, 0x72, 0x65, 0x74, 0x69, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x4a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x3a, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0d,
+	0x74, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x42, 0x70, 0x73,
+	0x1a, 0x8f, 0x03, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x02, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e,
+	0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x79, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69,
+	0x61, 0x52, 0x02, 0x6f, 0x72, 0x1a, 0xba, 0x02, 0x0a, 0x08, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72,
+	0x69, 0x61, 0x12, 0x5f, 0x0a, 0x0e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x72, 0x65, 0x67,
+	0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x79, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x48, 0x00, 0x52, 0x0d, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x65, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e,
+	0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x75, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x5f, 0x0a, 0x0e, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x36, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0d, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x05, 0x0a, 0x03, 0x73,
+	0x75, 0x6d, 0x1a, 0xdd, 0x01, 0x0a, 0x0d, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x12,
+	0x31, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6a, 0x75, 0x72, 0x69, 0x73,
+	0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x6e, 0x64, 0x5f,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x45, 0x6e, 0x64, 0x44, 0x61,
+	0x74, 0x65, 0x1a, 0xb0, 0x01, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x65,
+	0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x45, 0x6e,
+	0x64, 0x44, 0x61, 0x74, 0x65, 0x1a, 0x30, 0x0a, 0x0d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x3a, 0x3a, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x34, 0x0a,
+	0x06, 0x0a, 0x02, 0x69, 0x64, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72,
+	0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x10,
+	0x03, 0x18, 0x02, 0x22, 0x9b, 0x01, 0x0a, 0x0c, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x44,
+	0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x6e, 0x6b, 0x44, 0x65,
+	0x6e, 0x6f, 0x6d, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x3a, 0x2b, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x25, 0x0a, 0x0c, 0x0a, 0x0a,
+	0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x13, 0x0a, 0x0d, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x10, 0x01, 0x18, 0x01, 0x18,
+	0x03, 0x22, 0xc8, 0x02, 0x0a, 0x06, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x12,
+	0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x61, 0x62, 0x62, 0x72,
+	0x65, 0x76, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x41, 0x62, 0x62, 0x72, 0x65, 0x76, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61,
+	0x6e, 0x6b, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x62, 0x61, 0x6e, 0x6b, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x65,
+	0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1a, 0x0a,
+	0x08, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x6c,
+	0x69, 0x73, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x65, 0x6c,
+	0x69, 0x73, 0x74, 0x65, 0x64, 0x3a, 0x35, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x2f, 0x0a, 0x06, 0x0a,
+	0x02, 0x69, 0x64, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1d, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x5f, 0x61, 0x62, 0x62, 0x72, 0x65, 0x76, 0x2c, 0x62, 0x61, 0x6e, 0x6b,
+	0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x10, 0x01, 0x18, 0x01, 0x18, 0x04, 0x22, 0x87, 0x01, 0x0a,
+	0x09, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x6b, 0x65, 0x72,
+	0x5f, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
+	0x6d, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x42, 0x70, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x74,
+	0x61, 0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x42, 0x70, 0x73, 0x3a,
+	0x15, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x0f, 0x0a, 0x0b, 0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x22, 0xa5, 0x01, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x46, 0x65, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x61, 0x62, 0x62, 0x72, 0x65, 0x76, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x41, 0x62, 0x62, 0x72, 0x65, 0x76, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x5f,
+	0x66, 0x65, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d,
+	0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x42, 0x70, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x61,
+	0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x42, 0x70, 0x73, 0x3a, 0x1e,
+	0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x18, 0x0a, 0x14, 0x0a, 0x12, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x61, 0x62, 0x62, 0x72, 0x65, 0x76, 0x18, 0x06, 0x22, 0x8a,
+	0x01, 0x0a, 0x0e, 0x46, 0x65, 0x65, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x54, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65,
+	0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x44, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x3a, 0x08, 0xfa, 0x9e, 0xd3, 0x8e, 0x03, 0x02, 0x08, 0x07, 0x22, 0x5c, 0x0a, 0x0e, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x3a, 0x15, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x0f, 0x0a, 0x0b, 0x0a, 0x09, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x22, 0x9c, 0x04, 0x0a, 0x05, 0x54, 0x72,
+	0x61, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6c,
+	0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65,
+	0x72, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x73,
+	0x65, 0x6c, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x73, 0x65, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x20, 0x0a, 0x0c, 0x62, 0x75, 0x79, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x62, 0x75, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a,
+	0x0a, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x75, 0x6e, 0x69, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x38, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x28, 0x0a, 0x10, 0x64, 0x75, 0x74, 0x63, 0x68, 0x5f, 0x61, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x64, 0x75, 0x74,
+	0x63, 0x68, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x15, 0x73,
+	0x65, 0x61, 0x6c, 0x65, 0x64, 0x5f, 0x62, 0x69, 0x64, 0x5f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x73, 0x65, 0x61, 0x6c,
+	0x65, 0x64, 0x42, 0x69, 0x64, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2e,
+	0x0a, 0x13, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x66, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x3a, 0x38,
+	0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x32, 0x0a, 0x06, 0x0a, 0x02, 0x69, 0x64, 0x10, 0x01, 0x12, 0x0d,
+	0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x10, 0x01, 0x12, 0x17, 0x0a,
+	0x13, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x2c, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x10, 0x02, 0x18, 0x09, 0x22, 0x61, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x54, 0x72, 0x61, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x64, 0x3a, 0x1c, 0xf2,
+	0x9e, 0xd3, 0x8e, 0x03, 0x16, 0x0a, 0x12, 0x0a, 0x10, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x2c, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x22, 0xcb, 0x03, 0x0a, 0x05,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x6f, 0x6c, 0x64,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x69, 0x64,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62,
+	0x69, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75,
+	0x74, 0x6f, 0x52, 0x65, 0x74, 0x69, 0x72, 0x65, 0x12, 0x37, 0x0a, 0x17, 0x72, 0x65, 0x74, 0x69,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x72, 0x65, 0x74, 0x69, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x3a, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a,
+	0x0d, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x42, 0x70,
+	0x73, 0x3a, 0x46, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x40, 0x0a, 0x06, 0x0a, 0x02, 0x69, 0x64, 0x10,
+	0x01, 0x12, 0x09, 0x0a, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x68,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x04, 0x18, 0x0b, 0x22, 0xa1, 0x03, 0x0a, 0x0c, 0x44, 0x75,
+	0x74, 0x63, 0x68, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65,
+	0x6c, 0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x6c,
+	0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12,
+	0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x66,
+	0x6c, 0x6f, 0x6f, 0x72, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x2e, 0x0a, 0x13, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x6f,
+	0x5f, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x64,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x74, 0x69, 0x72, 0x65,
+	0x3a, 0x2b, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x25, 0x0a, 0x06, 0x0a, 0x02, 0x69, 0x64, 0x10, 0x01,
+	0x12, 0x0a, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x10, 0x02, 0x18, 0x0c, 0x22, 0xcb, 0x03,
+	0x0a, 0x10, 0x53, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x42, 0x69, 0x64, 0x41, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64,
+	0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x45, 0x6e, 0x64, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x5f, 0x65, 0x6e,
+	0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
+	0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x45, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x2e, 0x0a, 0x13, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f,
+	0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x74, 0x69, 0x72, 0x65, 0x12,
+	0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x70, 0x73,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65,
+	0x42, 0x70, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65,
+	0x5f, 0x62, 0x70, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x74, 0x61, 0x6b, 0x65,
+	0x72, 0x46, 0x65, 0x65, 0x42, 0x70, 0x73, 0x3a, 0x42, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x3c, 0x0a,
+	0x06, 0x0a, 0x02, 0x69, 0x64, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65,
+	0x72, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79,
+	0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x5f, 0x65, 0x6e, 0x64,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x10, 0x03, 0x18, 0x0d, 0x22, 0xf4, 0x02, 0x0a, 0x09,
+	0x53, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x42, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x69, 0x64, 0x64,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x65, 0x61,
+	0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x76, 0x65, 0x61,
+	0x6c, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
+	0x1d, 0x0a, 0x0a, 0x62, 0x69, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x69, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e,
+	0x0a, 0x13, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72,
+	0x65, 0x74, 0x69, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x64, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x74, 0x69, 0x72, 0x65, 0x12, 0x37,
+	0x0a, 0x17, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6a, 0x75, 0x72,
+	0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x16, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4a, 0x75, 0x72, 0x69, 0x73,
+	0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x29, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x23, 0x0a,
+	0x13, 0x0a, 0x11, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x2c, 0x62, 0x69,
+	0x64, 0x64, 0x65, 0x72, 0x12, 0x0a, 0x0a, 0x06, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x10, 0x01,
+	0x18, 0x0e, 0x22, 0xeb, 0x03, 0x0a, 0x0b, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x53, 0x61,
+	0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x3f, 0x0a, 0x0d, 0x76,
+	0x69, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c,
+	0x76, 0x69, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x3b, 0x0a, 0x0b,
+	0x76, 0x69, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x76,
+	0x69, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x73, 0x6b, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x6b, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x47, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x64, 0x65,
+	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x74, 0x69, 0x72,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x74, 0x69, 0x72, 0x65, 0x3a, 0x44, 0xf2, 0x9e, 0xd3, 0x8e,
+	0x03, 0x3e, 0x0a, 0x06, 0x0a, 0x02, 0x69, 0x64, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x65,
+	0x6c, 0x6c, 0x65, 0x72, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x5f, 0x6b, 0x65, 0x79, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x10, 0x03, 0x18, 0x0f,
+	0x22, 0xb5, 0x03, 0x0a, 0x0f, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f,
+	0x73, 0x61, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x66,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x53, 0x61, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x62, 0x75, 0x79, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x75, 0x79,
+	0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
+	0x23, 0x0a, 0x0d, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x11, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65,
+	0x74, 0x69, 0x72, 0x65, 0x12, 0x37, 0x0a, 0x17, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x6a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x4a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a,
+	0x0d, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x42, 0x70,
+	0x73, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x62,
+	0x70, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x46,
+	0x65, 0x65, 0x42, 0x70, 0x73, 0x3a, 0x41, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x3b, 0x0a, 0x06, 0x0a,
+	0x02, 0x69, 0x64, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x5f, 0x73, 0x61, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x62, 0x75,
+	0x79, 0x65, 0x72, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x5f, 0x6b, 0x65, 0x79, 0x10, 0x03, 0x18, 0x10, 0x22, 0x84, 0x01, 0x0a, 0x12, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x25,
+	0x0a, 0x0e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x08, 0xfa, 0x9e, 0xd3, 0x8e, 0x03, 0x02, 0x08, 0x11, 0x2a,
+	0xa4, 0x01, 0x0a, 0x12, 0x46, 0x65, 0x65, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x20, 0x46, 0x45, 0x45, 0x5f, 0x44, 0x45,
+	0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x27, 0x0a, 0x23,
+	0x46, 0x45, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x55, 0x4e, 0x49, 0x54, 0x59, 0x5f, 0x50,
+	0x4f, 0x4f, 0x4c, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x46, 0x45, 0x45, 0x5f, 0x44, 0x45, 0x53,
+	0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x55,
+	0x52, 0x4e, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x46, 0x45, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x54,
+	0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x43, 0x43,
+	0x4f, 0x55, 0x4e, 0x54, 0x10, 0x03, 0x42, 0xa3, 0x02, 0x0a, 0x22, 0x63, 0x6f, 0x6d, 0x2e, 0x72,
+	0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x56, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x63, 0x6f,
+	0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x52, 0x45, 0x4d, 0xaa, 0x02, 0x1e, 0x52, 0x65, 0x67, 0x65,
+	0x6e, 0x2e, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1e, 0x52, 0x65, 0x67,
+	0x65, 0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5c, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x2a, 0x52, 0x65,
+	0x67, 0x65, 0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5c, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x21, 0x52, 0x65, 0x67, 0x65, 0x6e,
+	0x3a, 0x3a, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x3a, 0x3a, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2382,20 +18992,60 @@ func file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP() []byte {
 	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescData
 }
 
-var file_regen_ecocredit_marketplace_v1_state_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_regen_ecocredit_marketplace_v1_state_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_regen_ecocredit_marketplace_v1_state_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_regen_ecocredit_marketplace_v1_state_proto_goTypes = []interface{}{
-	(*SellOrder)(nil),             // 0: regen.ecocredit.marketplace.v1.SellOrder
-	(*AllowedDenom)(nil),          // 1: regen.ecocredit.marketplace.v1.AllowedDenom
-	(*Market)(nil),                // 2: regen.ecocredit.marketplace.v1.Market
-	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+	(FeeDestinationType)(0),          // 0: regen.ecocredit.marketplace.v1.FeeDestinationType
+	(*SellOrder)(nil),                // 1: regen.ecocredit.marketplace.v1.SellOrder
+	(*BuyOrder)(nil),                 // 2: regen.ecocredit.marketplace.v1.BuyOrder
+	(*AllowedDenom)(nil),             // 3: regen.ecocredit.marketplace.v1.AllowedDenom
+	(*Market)(nil),                   // 4: regen.ecocredit.marketplace.v1.Market
+	(*MarketFee)(nil),                // 5: regen.ecocredit.marketplace.v1.MarketFee
+	(*CreditTypeFee)(nil),            // 6: regen.ecocredit.marketplace.v1.CreditTypeFee
+	(*FeeDestination)(nil),           // 7: regen.ecocredit.marketplace.v1.FeeDestination
+	(*MarketFeeTotal)(nil),           // 8: regen.ecocredit.marketplace.v1.MarketFeeTotal
+	(*Trade)(nil),                    // 9: regen.ecocredit.marketplace.v1.Trade
+	(*AddressTrade)(nil),             // 10: regen.ecocredit.marketplace.v1.AddressTrade
+	(*Offer)(nil),                    // 11: regen.ecocredit.marketplace.v1.Offer
+	(*DutchAuction)(nil),             // 12: regen.ecocredit.marketplace.v1.DutchAuction
+	(*SealedBidAuction)(nil),         // 13: regen.ecocredit.marketplace.v1.SealedBidAuction
+	(*SealedBid)(nil),                // 14: regen.ecocredit.marketplace.v1.SealedBid
+	(*ForwardSale)(nil),              // 15: regen.ecocredit.marketplace.v1.ForwardSale
+	(*ForwardContract)(nil),          // 16: regen.ecocredit.marketplace.v1.ForwardContract
+	(*MarketRegistration)(nil),       // 17: regen.ecocredit.marketplace.v1.MarketRegistration
+	(*BuyOrder_Filter)(nil),          // 18: regen.ecocredit.marketplace.v1.BuyOrder.Filter
+	(*BuyOrder_ClassSelector)(nil),   // 19: regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector
+	(*BuyOrder_ProjectSelector)(nil), // 20: regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector
+	(*BuyOrder_BatchSelector)(nil),   // 21: regen.ecocredit.marketplace.v1.BuyOrder.BatchSelector
+	(*BuyOrder_Filter_Criteria)(nil), // 22: regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria
+	(*timestamppb.Timestamp)(nil),    // 23: google.protobuf.Timestamp
 }
 var file_regen_ecocredit_marketplace_v1_state_proto_depIdxs = []int32{
-	3, // 0: regen.ecocredit.marketplace.v1.SellOrder.expiration:type_name -> google.protobuf.Timestamp
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	23, // 0: regen.ecocredit.marketplace.v1.SellOrder.expiration:type_name -> google.protobuf.Timestamp
+	23, // 1: regen.ecocredit.marketplace.v1.SellOrder.batch_start_date:type_name -> google.protobuf.Timestamp
+	18, // 2: regen.ecocredit.marketplace.v1.BuyOrder.filter:type_name -> regen.ecocredit.marketplace.v1.BuyOrder.Filter
+	23, // 3: regen.ecocredit.marketplace.v1.BuyOrder.expiration:type_name -> google.protobuf.Timestamp
+	0,  // 4: regen.ecocredit.marketplace.v1.FeeDestination.destination:type_name -> regen.ecocredit.marketplace.v1.FeeDestinationType
+	23, // 5: regen.ecocredit.marketplace.v1.Trade.timestamp:type_name -> google.protobuf.Timestamp
+	23, // 6: regen.ecocredit.marketplace.v1.Offer.expiration:type_name -> google.protobuf.Timestamp
+	23, // 7: regen.ecocredit.marketplace.v1.DutchAuction.start_time:type_name -> google.protobuf.Timestamp
+	23, // 8: regen.ecocredit.marketplace.v1.DutchAuction.end_time:type_name -> google.protobuf.Timestamp
+	23, // 9: regen.ecocredit.marketplace.v1.ForwardSale.vintage_start:type_name -> google.protobuf.Timestamp
+	23, // 10: regen.ecocredit.marketplace.v1.ForwardSale.vintage_end:type_name -> google.protobuf.Timestamp
+	23, // 11: regen.ecocredit.marketplace.v1.ForwardSale.delivery_deadline:type_name -> google.protobuf.Timestamp
+	22, // 12: regen.ecocredit.marketplace.v1.BuyOrder.Filter.or:type_name -> regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria
+	23, // 13: regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector.min_start_date:type_name -> google.protobuf.Timestamp
+	23, // 14: regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector.max_end_date:type_name -> google.protobuf.Timestamp
+	23, // 15: regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector.min_start_date:type_name -> google.protobuf.Timestamp
+	23, // 16: regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector.max_end_date:type_name -> google.protobuf.Timestamp
+	19, // 17: regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria.class_selector:type_name -> regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector
+	20, // 18: regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria.project_selector:type_name -> regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector
+	21, // 19: regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria.batch_selector:type_name -> regen.ecocredit.marketplace.v1.BuyOrder.BatchSelector
+	20, // [20:20] is the sub-list for method output_type
+	20, // [20:20] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_regen_ecocredit_marketplace_v1_state_proto_init() }
@@ -2417,7 +19067,7 @@ func file_regen_ecocredit_marketplace_v1_state_proto_init() {
 			}
 		}
 		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AllowedDenom); i {
+			switch v := v.(*BuyOrder); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2429,6 +19079,18 @@ func file_regen_ecocredit_marketplace_v1_state_proto_init() {
 			}
 		}
 		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllowedDenom); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Market); i {
 			case 0:
 				return &v.state
@@ -2440,19 +19102,241 @@ func file_regen_ecocredit_marketplace_v1_state_proto_init() {
 				return nil
 			}
 		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketFee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreditTypeFee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeDestination); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketFeeTotal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Trade); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddressTrade); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Offer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DutchAuction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SealedBidAuction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SealedBid); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForwardSale); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForwardContract); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketRegistration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuyOrder_Filter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuyOrder_ClassSelector); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuyOrder_ProjectSelector); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuyOrder_BatchSelector); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuyOrder_Filter_Criteria); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[21].OneofWrappers = []interface{}{
+		(*BuyOrder_Filter_Criteria_ClassSelector)(nil),
+		(*BuyOrder_Filter_Criteria_ProjectSelector)(nil),
+		(*BuyOrder_Filter_Criteria_BatchSelector)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_regen_ecocredit_marketplace_v1_state_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   3,
+			NumEnums:      1,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_regen_ecocredit_marketplace_v1_state_proto_goTypes,
 		DependencyIndexes: file_regen_ecocredit_marketplace_v1_state_proto_depIdxs,
+		EnumInfos:         file_regen_ecocredit_marketplace_v1_state_proto_enumTypes,
 		MessageInfos:      file_regen_ecocredit_marketplace_v1_state_proto_msgTypes,
 	}.Build()
 	File_regen_ecocredit_marketplace_v1_state_proto = out.File
@@ -30,6 +30,52 @@ type MsgClient interface {
 	CancelSellOrder(ctx context.Context, in *MsgCancelSellOrder, opts ...grpc.CallOption) (*MsgCancelSellOrderResponse, error)
 	// BuyDirect purchases credits directly from the specified sell order.
 	BuyDirect(ctx context.Context, in *MsgBuyDirect, opts ...grpc.CallOption) (*MsgBuyDirectResponse, error)
+	// Buy creates new buy orders. Buy orders are placed in the order book and
+	// matched with sell orders at the end of each block using price-time
+	// priority. The bid price for the full quantity is held in escrow until the
+	// buy order is filled, cancelled or expired.
+	Buy(ctx context.Context, in *MsgBuy, opts ...grpc.CallOption) (*MsgBuyResponse, error)
+	// CancelBuyOrder cancels a buy order and returns the funds from escrow.
+	CancelBuyOrder(ctx context.Context, in *MsgCancelBuyOrder, opts ...grpc.CallOption) (*MsgCancelBuyOrderResponse, error)
+	// MakeOffer creates an offer to buy credits from a credit batch, optionally
+	// from a specific holder. The bid price for the full quantity is held in
+	// escrow until the offer is accepted, cancelled or expired.
+	MakeOffer(ctx context.Context, in *MsgMakeOffer, opts ...grpc.CallOption) (*MsgMakeOfferResponse, error)
+	// AcceptOffer sells tradable credits to the buyer of an offer in exchange
+	// for the funds held in escrow.
+	AcceptOffer(ctx context.Context, in *MsgAcceptOffer, opts ...grpc.CallOption) (*MsgAcceptOfferResponse, error)
+	// CancelOffer cancels an offer and returns the funds from escrow.
+	CancelOffer(ctx context.Context, in *MsgCancelOffer, opts ...grpc.CallOption) (*MsgCancelOfferResponse, error)
+	// CreateDutchAuction creates a descending-price auction. The credits being
+	// sold are held in escrow until they are bought or the auction is
+	// cancelled.
+	CreateDutchAuction(ctx context.Context, in *MsgCreateDutchAuction, opts ...grpc.CallOption) (*MsgCreateDutchAuctionResponse, error)
+	// BuyDutchAuction purchases credits from a dutch auction at the current ask
+	// price of the auction.
+	BuyDutchAuction(ctx context.Context, in *MsgBuyDutchAuction, opts ...grpc.CallOption) (*MsgBuyDutchAuctionResponse, error)
+	// CancelDutchAuction cancels a dutch auction and returns the credits from
+	// escrow.
+	CancelDutchAuction(ctx context.Context, in *MsgCancelDutchAuction, opts ...grpc.CallOption) (*MsgCancelDutchAuctionResponse, error)
+	// CreateSealedBidAuction creates a sealed-bid auction. The credits being
+	// sold are held in escrow until the auction is settled.
+	CreateSealedBidAuction(ctx context.Context, in *MsgCreateSealedBidAuction, opts ...grpc.CallOption) (*MsgCreateSealedBidAuctionResponse, error)
+	// CommitSealedBid commits a hidden bid to a sealed-bid auction. The deposit
+	// is held in escrow until the auction is settled.
+	CommitSealedBid(ctx context.Context, in *MsgCommitSealedBid, opts ...grpc.CallOption) (*MsgCommitSealedBidResponse, error)
+	// RevealSealedBid reveals a bid previously committed to a sealed-bid
+	// auction.
+	RevealSealedBid(ctx context.Context, in *MsgRevealSealedBid, opts ...grpc.CallOption) (*MsgRevealSealedBidResponse, error)
+	// CreateForwardSale lists future credits from a project for sale. Only the
+	// admin of the project can create a forward sale.
+	CreateForwardSale(ctx context.Context, in *MsgCreateForwardSale, opts ...grpc.CallOption) (*MsgCreateForwardSaleResponse, error)
+	// BuyForwardContract buys future credits from a forward sale. The total cost
+	// and the taker fee are held in escrow until the credits are delivered or
+	// the delivery deadline is reached.
+	BuyForwardContract(ctx context.Context, in *MsgBuyForwardContract, opts ...grpc.CallOption) (*MsgBuyForwardContractResponse, error)
+	// RegisterMarket registers a market for a credit type and a bank denom with
+	// denom metadata in the bank module. The registrant pays the registration
+	// deposit. Market registration must be enabled by governance.
+	RegisterMarket(ctx context.Context, in *MsgRegisterMarket, opts ...grpc.CallOption) (*MsgRegisterMarketResponse, error)
 }
 
 type msgClient struct {
@@ -76,6 +122,132 @@ func (c *msgClient) BuyDirect(ctx context.Context, in *MsgBuyDirect, opts ...grp
 	return out, nil
 }
 
+func (c *msgClient) Buy(ctx context.Context, in *MsgBuy, opts ...grpc.CallOption) (*MsgBuyResponse, error) {
+	out := new(MsgBuyResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/Buy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelBuyOrder(ctx context.Context, in *MsgCancelBuyOrder, opts ...grpc.CallOption) (*MsgCancelBuyOrderResponse, error) {
+	out := new(MsgCancelBuyOrderResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/CancelBuyOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MakeOffer(ctx context.Context, in *MsgMakeOffer, opts ...grpc.CallOption) (*MsgMakeOfferResponse, error) {
+	out := new(MsgMakeOfferResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/MakeOffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptOffer(ctx context.Context, in *MsgAcceptOffer, opts ...grpc.CallOption) (*MsgAcceptOfferResponse, error) {
+	out := new(MsgAcceptOfferResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/AcceptOffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelOffer(ctx context.Context, in *MsgCancelOffer, opts ...grpc.CallOption) (*MsgCancelOfferResponse, error) {
+	out := new(MsgCancelOfferResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/CancelOffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateDutchAuction(ctx context.Context, in *MsgCreateDutchAuction, opts ...grpc.CallOption) (*MsgCreateDutchAuctionResponse, error) {
+	out := new(MsgCreateDutchAuctionResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/CreateDutchAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BuyDutchAuction(ctx context.Context, in *MsgBuyDutchAuction, opts ...grpc.CallOption) (*MsgBuyDutchAuctionResponse, error) {
+	out := new(MsgBuyDutchAuctionResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/BuyDutchAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelDutchAuction(ctx context.Context, in *MsgCancelDutchAuction, opts ...grpc.CallOption) (*MsgCancelDutchAuctionResponse, error) {
+	out := new(MsgCancelDutchAuctionResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/CancelDutchAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateSealedBidAuction(ctx context.Context, in *MsgCreateSealedBidAuction, opts ...grpc.CallOption) (*MsgCreateSealedBidAuctionResponse, error) {
+	out := new(MsgCreateSealedBidAuctionResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/CreateSealedBidAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CommitSealedBid(ctx context.Context, in *MsgCommitSealedBid, opts ...grpc.CallOption) (*MsgCommitSealedBidResponse, error) {
+	out := new(MsgCommitSealedBidResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/CommitSealedBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevealSealedBid(ctx context.Context, in *MsgRevealSealedBid, opts ...grpc.CallOption) (*MsgRevealSealedBidResponse, error) {
+	out := new(MsgRevealSealedBidResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/RevealSealedBid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateForwardSale(ctx context.Context, in *MsgCreateForwardSale, opts ...grpc.CallOption) (*MsgCreateForwardSaleResponse, error) {
+	out := new(MsgCreateForwardSaleResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/CreateForwardSale", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BuyForwardContract(ctx context.Context, in *MsgBuyForwardContract, opts ...grpc.CallOption) (*MsgBuyForwardContractResponse, error) {
+	out := new(MsgBuyForwardContractResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/BuyForwardContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterMarket(ctx context.Context, in *MsgRegisterMarket, opts ...grpc.CallOption) (*MsgRegisterMarketResponse, error) {
+	out := new(MsgRegisterMarketResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/RegisterMarket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -88,6 +260,52 @@ type MsgServer interface {
 	CancelSellOrder(context.Context, *MsgCancelSellOrder) (*MsgCancelSellOrderResponse, error)
 	// BuyDirect purchases credits directly from the specified sell order.
 	BuyDirect(context.Context, *MsgBuyDirect) (*MsgBuyDirectResponse, error)
+	// Buy creates new buy orders. Buy orders are placed in the order book and
+	// matched with sell orders at the end of each block using price-time
+	// priority. The bid price for the full quantity is held in escrow until the
+	// buy order is filled, cancelled or expired.
+	Buy(context.Context, *MsgBuy) (*MsgBuyResponse, error)
+	// CancelBuyOrder cancels a buy order and returns the funds from escrow.
+	CancelBuyOrder(context.Context, *MsgCancelBuyOrder) (*MsgCancelBuyOrderResponse, error)
+	// MakeOffer creates an offer to buy credits from a credit batch, optionally
+	// from a specific holder. The bid price for the full quantity is held in
+	// escrow until the offer is accepted, cancelled or expired.
+	MakeOffer(context.Context, *MsgMakeOffer) (*MsgMakeOfferResponse, error)
+	// AcceptOffer sells tradable credits to the buyer of an offer in exchange
+	// for the funds held in escrow.
+	AcceptOffer(context.Context, *MsgAcceptOffer) (*MsgAcceptOfferResponse, error)
+	// CancelOffer cancels an offer and returns the funds from escrow.
+	CancelOffer(context.Context, *MsgCancelOffer) (*MsgCancelOfferResponse, error)
+	// CreateDutchAuction creates a descending-price auction. The credits being
+	// sold are held in escrow until they are bought or the auction is
+	// cancelled.
+	CreateDutchAuction(context.Context, *MsgCreateDutchAuction) (*MsgCreateDutchAuctionResponse, error)
+	// BuyDutchAuction purchases credits from a dutch auction at the current ask
+	// price of the auction.
+	BuyDutchAuction(context.Context, *MsgBuyDutchAuction) (*MsgBuyDutchAuctionResponse, error)
+	// CancelDutchAuction cancels a dutch auction and returns the credits from
+	// escrow.
+	CancelDutchAuction(context.Context, *MsgCancelDutchAuction) (*MsgCancelDutchAuctionResponse, error)
+	// CreateSealedBidAuction creates a sealed-bid auction. The credits being
+	// sold are held in escrow until the auction is settled.
+	CreateSealedBidAuction(context.Context, *MsgCreateSealedBidAuction) (*MsgCreateSealedBidAuctionResponse, error)
+	// CommitSealedBid commits a hidden bid to a sealed-bid auction. The deposit
+	// is held in escrow until the auction is settled.
+	CommitSealedBid(context.Context, *MsgCommitSealedBid) (*MsgCommitSealedBidResponse, error)
+	// RevealSealedBid reveals a bid previously committed to a sealed-bid
+	// auction.
+	RevealSealedBid(context.Context, *MsgRevealSealedBid) (*MsgRevealSealedBidResponse, error)
+	// CreateForwardSale lists future credits from a project for sale. Only the
+	// admin of the project can create a forward sale.
+	CreateForwardSale(context.Context, *MsgCreateForwardSale) (*MsgCreateForwardSaleResponse, error)
+	// BuyForwardContract buys future credits from a forward sale. The total cost
+	// and the taker fee are held in escrow until the credits are delivered or
+	// the delivery deadline is reached.
+	BuyForwardContract(context.Context, *MsgBuyForwardContract) (*MsgBuyForwardContractResponse, error)
+	// RegisterMarket registers a market for a credit type and a bank denom with
+	// denom metadata in the bank module. The registrant pays the registration
+	// deposit. Market registration must be enabled by governance.
+	RegisterMarket(context.Context, *MsgRegisterMarket) (*MsgRegisterMarketResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -107,6 +325,48 @@ func (UnimplementedMsgServer) CancelSellOrder(context.Context, *MsgCancelSellOrd
 func (UnimplementedMsgServer) BuyDirect(context.Context, *MsgBuyDirect) (*MsgBuyDirectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BuyDirect not implemented")
 }
+func (UnimplementedMsgServer) Buy(context.Context, *MsgBuy) (*MsgBuyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Buy not implemented")
+}
+func (UnimplementedMsgServer) CancelBuyOrder(context.Context, *MsgCancelBuyOrder) (*MsgCancelBuyOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBuyOrder not implemented")
+}
+func (UnimplementedMsgServer) MakeOffer(context.Context, *MsgMakeOffer) (*MsgMakeOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MakeOffer not implemented")
+}
+func (UnimplementedMsgServer) AcceptOffer(context.Context, *MsgAcceptOffer) (*MsgAcceptOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptOffer not implemented")
+}
+func (UnimplementedMsgServer) CancelOffer(context.Context, *MsgCancelOffer) (*MsgCancelOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOffer not implemented")
+}
+func (UnimplementedMsgServer) CreateDutchAuction(context.Context, *MsgCreateDutchAuction) (*MsgCreateDutchAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDutchAuction not implemented")
+}
+func (UnimplementedMsgServer) BuyDutchAuction(context.Context, *MsgBuyDutchAuction) (*MsgBuyDutchAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyDutchAuction not implemented")
+}
+func (UnimplementedMsgServer) CancelDutchAuction(context.Context, *MsgCancelDutchAuction) (*MsgCancelDutchAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDutchAuction not implemented")
+}
+func (UnimplementedMsgServer) CreateSealedBidAuction(context.Context, *MsgCreateSealedBidAuction) (*MsgCreateSealedBidAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSealedBidAuction not implemented")
+}
+func (UnimplementedMsgServer) CommitSealedBid(context.Context, *MsgCommitSealedBid) (*MsgCommitSealedBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitSealedBid not implemented")
+}
+func (UnimplementedMsgServer) RevealSealedBid(context.Context, *MsgRevealSealedBid) (*MsgRevealSealedBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealSealedBid not implemented")
+}
+func (UnimplementedMsgServer) CreateForwardSale(context.Context, *MsgCreateForwardSale) (*MsgCreateForwardSaleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateForwardSale not implemented")
+}
+func (UnimplementedMsgServer) BuyForwardContract(context.Context, *MsgBuyForwardContract) (*MsgBuyForwardContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyForwardContract not implemented")
+}
+func (UnimplementedMsgServer) RegisterMarket(context.Context, *MsgRegisterMarket) (*MsgRegisterMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterMarket not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -192,6 +452,258 @@ func _Msg_BuyDirect_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Buy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBuy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Buy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/Buy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Buy(ctx, req.(*MsgBuy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelBuyOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelBuyOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelBuyOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/CancelBuyOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelBuyOrder(ctx, req.(*MsgCancelBuyOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MakeOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMakeOffer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MakeOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/MakeOffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MakeOffer(ctx, req.(*MsgMakeOffer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptOffer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/AcceptOffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptOffer(ctx, req.(*MsgAcceptOffer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelOffer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/CancelOffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelOffer(ctx, req.(*MsgCancelOffer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateDutchAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateDutchAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateDutchAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/CreateDutchAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateDutchAuction(ctx, req.(*MsgCreateDutchAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BuyDutchAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBuyDutchAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BuyDutchAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/BuyDutchAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BuyDutchAuction(ctx, req.(*MsgBuyDutchAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelDutchAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelDutchAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelDutchAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/CancelDutchAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelDutchAuction(ctx, req.(*MsgCancelDutchAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateSealedBidAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSealedBidAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSealedBidAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/CreateSealedBidAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSealedBidAuction(ctx, req.(*MsgCreateSealedBidAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CommitSealedBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommitSealedBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommitSealedBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/CommitSealedBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommitSealedBid(ctx, req.(*MsgCommitSealedBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevealSealedBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealSealedBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealSealedBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/RevealSealedBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealSealedBid(ctx, req.(*MsgRevealSealedBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateForwardSale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateForwardSale)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateForwardSale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/CreateForwardSale",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateForwardSale(ctx, req.(*MsgCreateForwardSale))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BuyForwardContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBuyForwardContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BuyForwardContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/BuyForwardContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BuyForwardContract(ctx, req.(*MsgBuyForwardContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterMarket)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/RegisterMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterMarket(ctx, req.(*MsgRegisterMarket))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -215,6 +727,62 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BuyDirect",
 			Handler:    _Msg_BuyDirect_Handler,
 		},
+		{
+			MethodName: "Buy",
+			Handler:    _Msg_Buy_Handler,
+		},
+		{
+			MethodName: "CancelBuyOrder",
+			Handler:    _Msg_CancelBuyOrder_Handler,
+		},
+		{
+			MethodName: "MakeOffer",
+			Handler:    _Msg_MakeOffer_Handler,
+		},
+		{
+			MethodName: "AcceptOffer",
+			Handler:    _Msg_AcceptOffer_Handler,
+		},
+		{
+			MethodName: "CancelOffer",
+			Handler:    _Msg_CancelOffer_Handler,
+		},
+		{
+			MethodName: "CreateDutchAuction",
+			Handler:    _Msg_CreateDutchAuction_Handler,
+		},
+		{
+			MethodName: "BuyDutchAuction",
+			Handler:    _Msg_BuyDutchAuction_Handler,
+		},
+		{
+			MethodName: "CancelDutchAuction",
+			Handler:    _Msg_CancelDutchAuction_Handler,
+		},
+		{
+			MethodName: "CreateSealedBidAuction",
+			Handler:    _Msg_CreateSealedBidAuction_Handler,
+		},
+		{
+			MethodName: "CommitSealedBid",
+			Handler:    _Msg_CommitSealedBid_Handler,
+		},
+		{
+			MethodName: "RevealSealedBid",
+			Handler:    _Msg_RevealSealedBid_Handler,
+		},
+		{
+			MethodName: "CreateForwardSale",
+			Handler:    _Msg_CreateForwardSale_Handler,
+		},
+		{
+			MethodName: "BuyForwardContract",
+			Handler:    _Msg_BuyForwardContract_Handler,
+		},
+		{
+			MethodName: "RegisterMarket",
+			Handler:    _Msg_RegisterMarket_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "regen/ecocredit/marketplace/v1/tx.proto",
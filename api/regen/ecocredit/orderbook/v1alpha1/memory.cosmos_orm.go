@@ -184,9 +184,9 @@ type BuyOrderClassSelectorTable interface {
 	Update(ctx context.Context, buyOrderClassSelector *BuyOrderClassSelector) error
 	Save(ctx context.Context, buyOrderClassSelector *BuyOrderClassSelector) error
 	Delete(ctx context.Context, buyOrderClassSelector *BuyOrderClassSelector) error
-	Has(ctx context.Context, buy_order_id uint64, class_id uint64) (found bool, err error)
+	Has(ctx context.Context, buy_order_id uint64, class_key uint64) (found bool, err error)
 	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
-	Get(ctx context.Context, buy_order_id uint64, class_id uint64) (*BuyOrderClassSelector, error)
+	Get(ctx context.Context, buy_order_id uint64, class_key uint64) (*BuyOrderClassSelector, error)
 	List(ctx context.Context, prefixKey BuyOrderClassSelectorIndexKey, opts ...ormlist.Option) (BuyOrderClassSelectorIterator, error)
 	ListRange(ctx context.Context, from, to BuyOrderClassSelectorIndexKey, opts ...ormlist.Option) (BuyOrderClassSelectorIterator, error)
 	DeleteBy(ctx context.Context, prefixKey BuyOrderClassSelectorIndexKey) error
@@ -212,36 +212,36 @@ type BuyOrderClassSelectorIndexKey interface {
 }
 
 // primary key starting index..
-type BuyOrderClassSelectorPrimaryKey = BuyOrderClassSelectorBuyOrderIdClassIdIndexKey
+type BuyOrderClassSelectorPrimaryKey = BuyOrderClassSelectorBuyOrderIdClassKeyIndexKey
 
-type BuyOrderClassSelectorBuyOrderIdClassIdIndexKey struct {
+type BuyOrderClassSelectorBuyOrderIdClassKeyIndexKey struct {
 	vs []interface{}
 }
 
-func (x BuyOrderClassSelectorBuyOrderIdClassIdIndexKey) id() uint32                     { return 0 }
-func (x BuyOrderClassSelectorBuyOrderIdClassIdIndexKey) values() []interface{}          { return x.vs }
-func (x BuyOrderClassSelectorBuyOrderIdClassIdIndexKey) buyOrderClassSelectorIndexKey() {}
+func (x BuyOrderClassSelectorBuyOrderIdClassKeyIndexKey) id() uint32                     { return 0 }
+func (x BuyOrderClassSelectorBuyOrderIdClassKeyIndexKey) values() []interface{}          { return x.vs }
+func (x BuyOrderClassSelectorBuyOrderIdClassKeyIndexKey) buyOrderClassSelectorIndexKey() {}
 
-func (this BuyOrderClassSelectorBuyOrderIdClassIdIndexKey) WithBuyOrderId(buy_order_id uint64) BuyOrderClassSelectorBuyOrderIdClassIdIndexKey {
+func (this BuyOrderClassSelectorBuyOrderIdClassKeyIndexKey) WithBuyOrderId(buy_order_id uint64) BuyOrderClassSelectorBuyOrderIdClassKeyIndexKey {
 	this.vs = []interface{}{buy_order_id}
 	return this
 }
 
-func (this BuyOrderClassSelectorBuyOrderIdClassIdIndexKey) WithBuyOrderIdClassId(buy_order_id uint64, class_id uint64) BuyOrderClassSelectorBuyOrderIdClassIdIndexKey {
-	this.vs = []interface{}{buy_order_id, class_id}
+func (this BuyOrderClassSelectorBuyOrderIdClassKeyIndexKey) WithBuyOrderIdClassKey(buy_order_id uint64, class_key uint64) BuyOrderClassSelectorBuyOrderIdClassKeyIndexKey {
+	this.vs = []interface{}{buy_order_id, class_key}
 	return this
 }
 
-type BuyOrderClassSelectorClassIdIndexKey struct {
+type BuyOrderClassSelectorClassKeyIndexKey struct {
 	vs []interface{}
 }
 
-func (x BuyOrderClassSelectorClassIdIndexKey) id() uint32                     { return 1 }
-func (x BuyOrderClassSelectorClassIdIndexKey) values() []interface{}          { return x.vs }
-func (x BuyOrderClassSelectorClassIdIndexKey) buyOrderClassSelectorIndexKey() {}
+func (x BuyOrderClassSelectorClassKeyIndexKey) id() uint32                     { return 1 }
+func (x BuyOrderClassSelectorClassKeyIndexKey) values() []interface{}          { return x.vs }
+func (x BuyOrderClassSelectorClassKeyIndexKey) buyOrderClassSelectorIndexKey() {}
 
-func (this BuyOrderClassSelectorClassIdIndexKey) WithClassId(class_id uint64) BuyOrderClassSelectorClassIdIndexKey {
-	this.vs = []interface{}{class_id}
+func (this BuyOrderClassSelectorClassKeyIndexKey) WithClassKey(class_key uint64) BuyOrderClassSelectorClassKeyIndexKey {
+	this.vs = []interface{}{class_key}
 	return this
 }
 
@@ -265,13 +265,13 @@ func (this buyOrderClassSelectorTable) Delete(ctx context.Context, buyOrderClass
 	return this.table.Delete(ctx, buyOrderClassSelector)
 }
 
-func (this buyOrderClassSelectorTable) Has(ctx context.Context, buy_order_id uint64, class_id uint64) (found bool, err error) {
-	return this.table.PrimaryKey().Has(ctx, buy_order_id, class_id)
+func (this buyOrderClassSelectorTable) Has(ctx context.Context, buy_order_id uint64, class_key uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, buy_order_id, class_key)
 }
 
-func (this buyOrderClassSelectorTable) Get(ctx context.Context, buy_order_id uint64, class_id uint64) (*BuyOrderClassSelector, error) {
+func (this buyOrderClassSelectorTable) Get(ctx context.Context, buy_order_id uint64, class_key uint64) (*BuyOrderClassSelector, error) {
 	var buyOrderClassSelector BuyOrderClassSelector
-	found, err := this.table.PrimaryKey().Get(ctx, &buyOrderClassSelector, buy_order_id, class_id)
+	found, err := this.table.PrimaryKey().Get(ctx, &buyOrderClassSelector, buy_order_id, class_key)
 	if err != nil {
 		return nil, err
 	}
@@ -316,9 +316,9 @@ type BuyOrderProjectSelectorTable interface {
 	Update(ctx context.Context, buyOrderProjectSelector *BuyOrderProjectSelector) error
 	Save(ctx context.Context, buyOrderProjectSelector *BuyOrderProjectSelector) error
 	Delete(ctx context.Context, buyOrderProjectSelector *BuyOrderProjectSelector) error
-	Has(ctx context.Context, buy_order_id uint64, project_id uint64) (found bool, err error)
+	Has(ctx context.Context, buy_order_id uint64, project_key uint64) (found bool, err error)
 	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
-	Get(ctx context.Context, buy_order_id uint64, project_id uint64) (*BuyOrderProjectSelector, error)
+	Get(ctx context.Context, buy_order_id uint64, project_key uint64) (*BuyOrderProjectSelector, error)
 	List(ctx context.Context, prefixKey BuyOrderProjectSelectorIndexKey, opts ...ormlist.Option) (BuyOrderProjectSelectorIterator, error)
 	ListRange(ctx context.Context, from, to BuyOrderProjectSelectorIndexKey, opts ...ormlist.Option) (BuyOrderProjectSelectorIterator, error)
 	DeleteBy(ctx context.Context, prefixKey BuyOrderProjectSelectorIndexKey) error
@@ -344,36 +344,36 @@ type BuyOrderProjectSelectorIndexKey interface {
 }
 
 // primary key starting index..
-type BuyOrderProjectSelectorPrimaryKey = BuyOrderProjectSelectorBuyOrderIdProjectIdIndexKey
+type BuyOrderProjectSelectorPrimaryKey = BuyOrderProjectSelectorBuyOrderIdProjectKeyIndexKey
 
-type BuyOrderProjectSelectorBuyOrderIdProjectIdIndexKey struct {
+type BuyOrderProjectSelectorBuyOrderIdProjectKeyIndexKey struct {
 	vs []interface{}
 }
 
-func (x BuyOrderProjectSelectorBuyOrderIdProjectIdIndexKey) id() uint32                       { return 0 }
-func (x BuyOrderProjectSelectorBuyOrderIdProjectIdIndexKey) values() []interface{}            { return x.vs }
-func (x BuyOrderProjectSelectorBuyOrderIdProjectIdIndexKey) buyOrderProjectSelectorIndexKey() {}
+func (x BuyOrderProjectSelectorBuyOrderIdProjectKeyIndexKey) id() uint32                       { return 0 }
+func (x BuyOrderProjectSelectorBuyOrderIdProjectKeyIndexKey) values() []interface{}            { return x.vs }
+func (x BuyOrderProjectSelectorBuyOrderIdProjectKeyIndexKey) buyOrderProjectSelectorIndexKey() {}
 
-func (this BuyOrderProjectSelectorBuyOrderIdProjectIdIndexKey) WithBuyOrderId(buy_order_id uint64) BuyOrderProjectSelectorBuyOrderIdProjectIdIndexKey {
+func (this BuyOrderProjectSelectorBuyOrderIdProjectKeyIndexKey) WithBuyOrderId(buy_order_id uint64) BuyOrderProjectSelectorBuyOrderIdProjectKeyIndexKey {
 	this.vs = []interface{}{buy_order_id}
 	return this
 }
 
-func (this BuyOrderProjectSelectorBuyOrderIdProjectIdIndexKey) WithBuyOrderIdProjectId(buy_order_id uint64, project_id uint64) BuyOrderProjectSelectorBuyOrderIdProjectIdIndexKey {
-	this.vs = []interface{}{buy_order_id, project_id}
+func (this BuyOrderProjectSelectorBuyOrderIdProjectKeyIndexKey) WithBuyOrderIdProjectKey(buy_order_id uint64, project_key uint64) BuyOrderProjectSelectorBuyOrderIdProjectKeyIndexKey {
+	this.vs = []interface{}{buy_order_id, project_key}
 	return this
 }
 
-type BuyOrderProjectSelectorProjectIdIndexKey struct {
+type BuyOrderProjectSelectorProjectKeyIndexKey struct {
 	vs []interface{}
 }
 
-func (x BuyOrderProjectSelectorProjectIdIndexKey) id() uint32                       { return 1 }
-func (x BuyOrderProjectSelectorProjectIdIndexKey) values() []interface{}            { return x.vs }
-func (x BuyOrderProjectSelectorProjectIdIndexKey) buyOrderProjectSelectorIndexKey() {}
+func (x BuyOrderProjectSelectorProjectKeyIndexKey) id() uint32                       { return 1 }
+func (x BuyOrderProjectSelectorProjectKeyIndexKey) values() []interface{}            { return x.vs }
+func (x BuyOrderProjectSelectorProjectKeyIndexKey) buyOrderProjectSelectorIndexKey() {}
 
-func (this BuyOrderProjectSelectorProjectIdIndexKey) WithProjectId(project_id uint64) BuyOrderProjectSelectorProjectIdIndexKey {
-	this.vs = []interface{}{project_id}
+func (this BuyOrderProjectSelectorProjectKeyIndexKey) WithProjectKey(project_key uint64) BuyOrderProjectSelectorProjectKeyIndexKey {
+	this.vs = []interface{}{project_key}
 	return this
 }
 
@@ -397,13 +397,13 @@ func (this buyOrderProjectSelectorTable) Delete(ctx context.Context, buyOrderPro
 	return this.table.Delete(ctx, buyOrderProjectSelector)
 }
 
-func (this buyOrderProjectSelectorTable) Has(ctx context.Context, buy_order_id uint64, project_id uint64) (found bool, err error) {
-	return this.table.PrimaryKey().Has(ctx, buy_order_id, project_id)
+func (this buyOrderProjectSelectorTable) Has(ctx context.Context, buy_order_id uint64, project_key uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, buy_order_id, project_key)
 }
 
-func (this buyOrderProjectSelectorTable) Get(ctx context.Context, buy_order_id uint64, project_id uint64) (*BuyOrderProjectSelector, error) {
+func (this buyOrderProjectSelectorTable) Get(ctx context.Context, buy_order_id uint64, project_key uint64) (*BuyOrderProjectSelector, error) {
 	var buyOrderProjectSelector BuyOrderProjectSelector
-	found, err := this.table.PrimaryKey().Get(ctx, &buyOrderProjectSelector, buy_order_id, project_id)
+	found, err := this.table.PrimaryKey().Get(ctx, &buyOrderProjectSelector, buy_order_id, project_key)
 	if err != nil {
 		return nil, err
 	}
@@ -448,9 +448,9 @@ type BuyOrderBatchSelectorTable interface {
 	Update(ctx context.Context, buyOrderBatchSelector *BuyOrderBatchSelector) error
 	Save(ctx context.Context, buyOrderBatchSelector *BuyOrderBatchSelector) error
 	Delete(ctx context.Context, buyOrderBatchSelector *BuyOrderBatchSelector) error
-	Has(ctx context.Context, buy_order_id uint64, batch_id uint64) (found bool, err error)
+	Has(ctx context.Context, buy_order_id uint64, batch_key uint64) (found bool, err error)
 	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
-	Get(ctx context.Context, buy_order_id uint64, batch_id uint64) (*BuyOrderBatchSelector, error)
+	Get(ctx context.Context, buy_order_id uint64, batch_key uint64) (*BuyOrderBatchSelector, error)
 	List(ctx context.Context, prefixKey BuyOrderBatchSelectorIndexKey, opts ...ormlist.Option) (BuyOrderBatchSelectorIterator, error)
 	ListRange(ctx context.Context, from, to BuyOrderBatchSelectorIndexKey, opts ...ormlist.Option) (BuyOrderBatchSelectorIterator, error)
 	DeleteBy(ctx context.Context, prefixKey BuyOrderBatchSelectorIndexKey) error
@@ -476,36 +476,36 @@ type BuyOrderBatchSelectorIndexKey interface {
 }
 
 // primary key starting index..
-type BuyOrderBatchSelectorPrimaryKey = BuyOrderBatchSelectorBuyOrderIdBatchIdIndexKey
+type BuyOrderBatchSelectorPrimaryKey = BuyOrderBatchSelectorBuyOrderIdBatchKeyIndexKey
 
-type BuyOrderBatchSelectorBuyOrderIdBatchIdIndexKey struct {
+type BuyOrderBatchSelectorBuyOrderIdBatchKeyIndexKey struct {
 	vs []interface{}
 }
 
-func (x BuyOrderBatchSelectorBuyOrderIdBatchIdIndexKey) id() uint32                     { return 0 }
-func (x BuyOrderBatchSelectorBuyOrderIdBatchIdIndexKey) values() []interface{}          { return x.vs }
-func (x BuyOrderBatchSelectorBuyOrderIdBatchIdIndexKey) buyOrderBatchSelectorIndexKey() {}
+func (x BuyOrderBatchSelectorBuyOrderIdBatchKeyIndexKey) id() uint32                     { return 0 }
+func (x BuyOrderBatchSelectorBuyOrderIdBatchKeyIndexKey) values() []interface{}          { return x.vs }
+func (x BuyOrderBatchSelectorBuyOrderIdBatchKeyIndexKey) buyOrderBatchSelectorIndexKey() {}
 
-func (this BuyOrderBatchSelectorBuyOrderIdBatchIdIndexKey) WithBuyOrderId(buy_order_id uint64) BuyOrderBatchSelectorBuyOrderIdBatchIdIndexKey {
+func (this BuyOrderBatchSelectorBuyOrderIdBatchKeyIndexKey) WithBuyOrderId(buy_order_id uint64) BuyOrderBatchSelectorBuyOrderIdBatchKeyIndexKey {
 	this.vs = []interface{}{buy_order_id}
 	return this
 }
 
-func (this BuyOrderBatchSelectorBuyOrderIdBatchIdIndexKey) WithBuyOrderIdBatchId(buy_order_id uint64, batch_id uint64) BuyOrderBatchSelectorBuyOrderIdBatchIdIndexKey {
-	this.vs = []interface{}{buy_order_id, batch_id}
+func (this BuyOrderBatchSelectorBuyOrderIdBatchKeyIndexKey) WithBuyOrderIdBatchKey(buy_order_id uint64, batch_key uint64) BuyOrderBatchSelectorBuyOrderIdBatchKeyIndexKey {
+	this.vs = []interface{}{buy_order_id, batch_key}
 	return this
 }
 
-type BuyOrderBatchSelectorBatchIdIndexKey struct {
+type BuyOrderBatchSelectorBatchKeyIndexKey struct {
 	vs []interface{}
 }
 
-func (x BuyOrderBatchSelectorBatchIdIndexKey) id() uint32                     { return 1 }
-func (x BuyOrderBatchSelectorBatchIdIndexKey) values() []interface{}          { return x.vs }
-func (x BuyOrderBatchSelectorBatchIdIndexKey) buyOrderBatchSelectorIndexKey() {}
+func (x BuyOrderBatchSelectorBatchKeyIndexKey) id() uint32                     { return 1 }
+func (x BuyOrderBatchSelectorBatchKeyIndexKey) values() []interface{}          { return x.vs }
+func (x BuyOrderBatchSelectorBatchKeyIndexKey) buyOrderBatchSelectorIndexKey() {}
 
-func (this BuyOrderBatchSelectorBatchIdIndexKey) WithBatchId(batch_id uint64) BuyOrderBatchSelectorBatchIdIndexKey {
-	this.vs = []interface{}{batch_id}
+func (this BuyOrderBatchSelectorBatchKeyIndexKey) WithBatchKey(batch_key uint64) BuyOrderBatchSelectorBatchKeyIndexKey {
+	this.vs = []interface{}{batch_key}
 	return this
 }
 
@@ -529,13 +529,13 @@ func (this buyOrderBatchSelectorTable) Delete(ctx context.Context, buyOrderBatch
 	return this.table.Delete(ctx, buyOrderBatchSelector)
 }
 
-func (this buyOrderBatchSelectorTable) Has(ctx context.Context, buy_order_id uint64, batch_id uint64) (found bool, err error) {
-	return this.table.PrimaryKey().Has(ctx, buy_order_id, batch_id)
+func (this buyOrderBatchSelectorTable) Has(ctx context.Context, buy_order_id uint64, batch_key uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, buy_order_id, batch_key)
 }
 
-func (this buyOrderBatchSelectorTable) Get(ctx context.Context, buy_order_id uint64, batch_id uint64) (*BuyOrderBatchSelector, error) {
+func (this buyOrderBatchSelectorTable) Get(ctx context.Context, buy_order_id uint64, batch_key uint64) (*BuyOrderBatchSelector, error) {
 	var buyOrderBatchSelector BuyOrderBatchSelector
-	found, err := this.table.PrimaryKey().Get(ctx, &buyOrderBatchSelector, buy_order_id, batch_id)
+	found, err := this.table.PrimaryKey().Get(ctx, &buyOrderBatchSelector, buy_order_id, batch_key)
 	if err != nil {
 		return nil, err
 	}
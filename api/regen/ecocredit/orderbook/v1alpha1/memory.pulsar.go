@@ -596,20 +596,20 @@ func (x *fastReflection_BuyOrderSellOrderMatch) ProtoMethods() *protoiface.Metho
 }
 
 var (
-	md_BuyOrderClassSelector                  protoreflect.MessageDescriptor
-	fd_BuyOrderClassSelector_buy_order_id     protoreflect.FieldDescriptor
-	fd_BuyOrderClassSelector_class_id         protoreflect.FieldDescriptor
-	fd_BuyOrderClassSelector_project_location protoreflect.FieldDescriptor
-	fd_BuyOrderClassSelector_min_start_date   protoreflect.FieldDescriptor
-	fd_BuyOrderClassSelector_max_end_date     protoreflect.FieldDescriptor
+	md_BuyOrderClassSelector                      protoreflect.MessageDescriptor
+	fd_BuyOrderClassSelector_buy_order_id         protoreflect.FieldDescriptor
+	fd_BuyOrderClassSelector_class_key            protoreflect.FieldDescriptor
+	fd_BuyOrderClassSelector_project_jurisdiction protoreflect.FieldDescriptor
+	fd_BuyOrderClassSelector_min_start_date       protoreflect.FieldDescriptor
+	fd_BuyOrderClassSelector_max_end_date         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_regen_ecocredit_orderbook_v1alpha1_memory_proto_init()
 	md_BuyOrderClassSelector = File_regen_ecocredit_orderbook_v1alpha1_memory_proto.Messages().ByName("BuyOrderClassSelector")
 	fd_BuyOrderClassSelector_buy_order_id = md_BuyOrderClassSelector.Fields().ByName("buy_order_id")
-	fd_BuyOrderClassSelector_class_id = md_BuyOrderClassSelector.Fields().ByName("class_id")
-	fd_BuyOrderClassSelector_project_location = md_BuyOrderClassSelector.Fields().ByName("project_location")
+	fd_BuyOrderClassSelector_class_key = md_BuyOrderClassSelector.Fields().ByName("class_key")
+	fd_BuyOrderClassSelector_project_jurisdiction = md_BuyOrderClassSelector.Fields().ByName("project_jurisdiction")
 	fd_BuyOrderClassSelector_min_start_date = md_BuyOrderClassSelector.Fields().ByName("min_start_date")
 	fd_BuyOrderClassSelector_max_end_date = md_BuyOrderClassSelector.Fields().ByName("max_end_date")
 }
@@ -685,15 +685,15 @@ func (x *fastReflection_BuyOrderClassSelector) Range(f func(protoreflect.FieldDe
 			return
 		}
 	}
-	if x.ClassId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ClassId)
-		if !f(fd_BuyOrderClassSelector_class_id, value) {
+	if x.ClassKey != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ClassKey)
+		if !f(fd_BuyOrderClassSelector_class_key, value) {
 			return
 		}
 	}
-	if x.ProjectLocation != "" {
-		value := protoreflect.ValueOfString(x.ProjectLocation)
-		if !f(fd_BuyOrderClassSelector_project_location, value) {
+	if x.ProjectJurisdiction != "" {
+		value := protoreflect.ValueOfString(x.ProjectJurisdiction)
+		if !f(fd_BuyOrderClassSelector_project_jurisdiction, value) {
 			return
 		}
 	}
@@ -726,10 +726,10 @@ func (x *fastReflection_BuyOrderClassSelector) Has(fd protoreflect.FieldDescript
 	switch fd.FullName() {
 	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.buy_order_id":
 		return x.BuyOrderId != uint64(0)
-	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.class_id":
-		return x.ClassId != uint64(0)
-	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.project_location":
-		return x.ProjectLocation != ""
+	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.class_key":
+		return x.ClassKey != uint64(0)
+	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.project_jurisdiction":
+		return x.ProjectJurisdiction != ""
 	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.min_start_date":
 		return x.MinStartDate != nil
 	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.max_end_date":
@@ -752,10 +752,10 @@ func (x *fastReflection_BuyOrderClassSelector) Clear(fd protoreflect.FieldDescri
 	switch fd.FullName() {
 	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.buy_order_id":
 		x.BuyOrderId = uint64(0)
-	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.class_id":
-		x.ClassId = uint64(0)
-	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.project_location":
-		x.ProjectLocation = ""
+	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.class_key":
+		x.ClassKey = uint64(0)
+	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.project_jurisdiction":
+		x.ProjectJurisdiction = ""
 	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.min_start_date":
 		x.MinStartDate = nil
 	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.max_end_date":
@@ -779,11 +779,11 @@ func (x *fastReflection_BuyOrderClassSelector) Get(descriptor protoreflect.Field
 	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.buy_order_id":
 		value := x.BuyOrderId
 		return protoreflect.ValueOfUint64(value)
-	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.class_id":
-		value := x.ClassId
+	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.class_key":
+		value := x.ClassKey
 		return protoreflect.ValueOfUint64(value)
-	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.project_location":
-		value := x.ProjectLocation
+	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.project_jurisdiction":
+		value := x.ProjectJurisdiction
 		return protoreflect.ValueOfString(value)
 	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.min_start_date":
 		value := x.MinStartDate
@@ -813,10 +813,10 @@ func (x *fastReflection_BuyOrderClassSelector) Set(fd protoreflect.FieldDescript
 	switch fd.FullName() {
 	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.buy_order_id":
 		x.BuyOrderId = value.Uint()
-	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.class_id":
-		x.ClassId = value.Uint()
-	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.project_location":
-		x.ProjectLocation = value.Interface().(string)
+	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.class_key":
+		x.ClassKey = value.Uint()
+	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.project_jurisdiction":
+		x.ProjectJurisdiction = value.Interface().(string)
 	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.min_start_date":
 		x.MinStartDate = value.Message().Interface().(*timestamppb.Timestamp)
 	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.max_end_date":
@@ -853,10 +853,10 @@ func (x *fastReflection_BuyOrderClassSelector) Mutable(fd protoreflect.FieldDesc
 		return protoreflect.ValueOfMessage(x.MaxEndDate.ProtoReflect())
 	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.buy_order_id":
 		panic(fmt.Errorf("field buy_order_id of message regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector is not mutable"))
-	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.class_id":
-		panic(fmt.Errorf("field class_id of message regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector is not mutable"))
-	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.project_location":
-		panic(fmt.Errorf("field project_location of message regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector is not mutable"))
+	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.class_key":
+		panic(fmt.Errorf("field class_key of message regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector is not mutable"))
+	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.project_jurisdiction":
+		panic(fmt.Errorf("field project_jurisdiction of message regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector"))
@@ -872,9 +872,9 @@ func (x *fastReflection_BuyOrderClassSelector) NewField(fd protoreflect.FieldDes
 	switch fd.FullName() {
 	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.buy_order_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.class_id":
+	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.class_key":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.project_location":
+	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.project_jurisdiction":
 		return protoreflect.ValueOfString("")
 	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderClassSelector.min_start_date":
 		m := new(timestamppb.Timestamp)
@@ -954,10 +954,10 @@ func (x *fastReflection_BuyOrderClassSelector) ProtoMethods() *protoiface.Method
 		if x.BuyOrderId != 0 {
 			n += 1 + runtime.Sov(uint64(x.BuyOrderId))
 		}
-		if x.ClassId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ClassId))
+		if x.ClassKey != 0 {
+			n += 1 + runtime.Sov(uint64(x.ClassKey))
 		}
-		l = len(x.ProjectLocation)
+		l = len(x.ProjectJurisdiction)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -1026,15 +1026,15 @@ func (x *fastReflection_BuyOrderClassSelector) ProtoMethods() *protoiface.Method
 			i--
 			dAtA[i] = 0x22
 		}
-		if len(x.ProjectLocation) > 0 {
-			i -= len(x.ProjectLocation)
-			copy(dAtA[i:], x.ProjectLocation)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProjectLocation)))
+		if len(x.ProjectJurisdiction) > 0 {
+			i -= len(x.ProjectJurisdiction)
+			copy(dAtA[i:], x.ProjectJurisdiction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProjectJurisdiction)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if x.ClassId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ClassId))
+		if x.ClassKey != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ClassKey))
 			i--
 			dAtA[i] = 0x10
 		}
@@ -1113,9 +1113,9 @@ func (x *fastReflection_BuyOrderClassSelector) ProtoMethods() *protoiface.Method
 				}
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
 				}
-				x.ClassId = 0
+				x.ClassKey = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1125,14 +1125,14 @@ func (x *fastReflection_BuyOrderClassSelector) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ClassId |= uint64(b&0x7F) << shift
+					x.ClassKey |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProjectLocation", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProjectJurisdiction", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1160,7 +1160,7 @@ func (x *fastReflection_BuyOrderClassSelector) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ProjectLocation = string(dAtA[iNdEx:postIndex])
+				x.ProjectJurisdiction = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 4:
 				if wireType != 2 {
@@ -1272,7 +1272,7 @@ func (x *fastReflection_BuyOrderClassSelector) ProtoMethods() *protoiface.Method
 var (
 	md_BuyOrderProjectSelector                protoreflect.MessageDescriptor
 	fd_BuyOrderProjectSelector_buy_order_id   protoreflect.FieldDescriptor
-	fd_BuyOrderProjectSelector_project_id     protoreflect.FieldDescriptor
+	fd_BuyOrderProjectSelector_project_key    protoreflect.FieldDescriptor
 	fd_BuyOrderProjectSelector_min_start_date protoreflect.FieldDescriptor
 	fd_BuyOrderProjectSelector_max_end_date   protoreflect.FieldDescriptor
 )
@@ -1281,7 +1281,7 @@ func init() {
 	file_regen_ecocredit_orderbook_v1alpha1_memory_proto_init()
 	md_BuyOrderProjectSelector = File_regen_ecocredit_orderbook_v1alpha1_memory_proto.Messages().ByName("BuyOrderProjectSelector")
 	fd_BuyOrderProjectSelector_buy_order_id = md_BuyOrderProjectSelector.Fields().ByName("buy_order_id")
-	fd_BuyOrderProjectSelector_project_id = md_BuyOrderProjectSelector.Fields().ByName("project_id")
+	fd_BuyOrderProjectSelector_project_key = md_BuyOrderProjectSelector.Fields().ByName("project_key")
 	fd_BuyOrderProjectSelector_min_start_date = md_BuyOrderProjectSelector.Fields().ByName("min_start_date")
 	fd_BuyOrderProjectSelector_max_end_date = md_BuyOrderProjectSelector.Fields().ByName("max_end_date")
 }
@@ -1357,9 +1357,9 @@ func (x *fastReflection_BuyOrderProjectSelector) Range(f func(protoreflect.Field
 			return
 		}
 	}
-	if x.ProjectId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ProjectId)
-		if !f(fd_BuyOrderProjectSelector_project_id, value) {
+	if x.ProjectKey != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProjectKey)
+		if !f(fd_BuyOrderProjectSelector_project_key, value) {
 			return
 		}
 	}
@@ -1392,8 +1392,8 @@ func (x *fastReflection_BuyOrderProjectSelector) Has(fd protoreflect.FieldDescri
 	switch fd.FullName() {
 	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderProjectSelector.buy_order_id":
 		return x.BuyOrderId != uint64(0)
-	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderProjectSelector.project_id":
-		return x.ProjectId != uint64(0)
+	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderProjectSelector.project_key":
+		return x.ProjectKey != uint64(0)
 	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderProjectSelector.min_start_date":
 		return x.MinStartDate != nil
 	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderProjectSelector.max_end_date":
@@ -1416,8 +1416,8 @@ func (x *fastReflection_BuyOrderProjectSelector) Clear(fd protoreflect.FieldDesc
 	switch fd.FullName() {
 	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderProjectSelector.buy_order_id":
 		x.BuyOrderId = uint64(0)
-	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderProjectSelector.project_id":
-		x.ProjectId = uint64(0)
+	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderProjectSelector.project_key":
+		x.ProjectKey = uint64(0)
 	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderProjectSelector.min_start_date":
 		x.MinStartDate = nil
 	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderProjectSelector.max_end_date":
@@ -1441,8 +1441,8 @@ func (x *fastReflection_BuyOrderProjectSelector) Get(descriptor protoreflect.Fie
 	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderProjectSelector.buy_order_id":
 		value := x.BuyOrderId
 		return protoreflect.ValueOfUint64(value)
-	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderProjectSelector.project_id":
-		value := x.ProjectId
+	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderProjectSelector.project_key":
+		value := x.ProjectKey
 		return protoreflect.ValueOfUint64(value)
 	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderProjectSelector.min_start_date":
 		value := x.MinStartDate
@@ -1472,8 +1472,8 @@ func (x *fastReflection_BuyOrderProjectSelector) Set(fd protoreflect.FieldDescri
 	switch fd.FullName() {
 	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderProjectSelector.buy_order_id":
 		x.BuyOrderId = value.Uint()
-	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderProjectSelector.project_id":
-		x.ProjectId = value.Uint()
+	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderProjectSelector.project_key":
+		x.ProjectKey = value.Uint()
 	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderProjectSelector.min_start_date":
 		x.MinStartDate = value.Message().Interface().(*timestamppb.Timestamp)
 	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderProjectSelector.max_end_date":
@@ -1510,8 +1510,8 @@ func (x *fastReflection_BuyOrderProjectSelector) Mutable(fd protoreflect.FieldDe
 		return protoreflect.ValueOfMessage(x.MaxEndDate.ProtoReflect())
 	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderProjectSelector.buy_order_id":
 		panic(fmt.Errorf("field buy_order_id of message regen.ecocredit.orderbook.v1alpha1.BuyOrderProjectSelector is not mutable"))
-	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderProjectSelector.project_id":
-		panic(fmt.Errorf("field project_id of message regen.ecocredit.orderbook.v1alpha1.BuyOrderProjectSelector is not mutable"))
+	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderProjectSelector.project_key":
+		panic(fmt.Errorf("field project_key of message regen.ecocredit.orderbook.v1alpha1.BuyOrderProjectSelector is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.orderbook.v1alpha1.BuyOrderProjectSelector"))
@@ -1527,7 +1527,7 @@ func (x *fastReflection_BuyOrderProjectSelector) NewField(fd protoreflect.FieldD
 	switch fd.FullName() {
 	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderProjectSelector.buy_order_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderProjectSelector.project_id":
+	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderProjectSelector.project_key":
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderProjectSelector.min_start_date":
 		m := new(timestamppb.Timestamp)
@@ -1607,8 +1607,8 @@ func (x *fastReflection_BuyOrderProjectSelector) ProtoMethods() *protoiface.Meth
 		if x.BuyOrderId != 0 {
 			n += 1 + runtime.Sov(uint64(x.BuyOrderId))
 		}
-		if x.ProjectId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ProjectId))
+		if x.ProjectKey != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProjectKey))
 		}
 		if x.MinStartDate != nil {
 			l = options.Size(x.MinStartDate)
@@ -1675,8 +1675,8 @@ func (x *fastReflection_BuyOrderProjectSelector) ProtoMethods() *protoiface.Meth
 			i--
 			dAtA[i] = 0x1a
 		}
-		if x.ProjectId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProjectId))
+		if x.ProjectKey != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProjectKey))
 			i--
 			dAtA[i] = 0x10
 		}
@@ -1755,9 +1755,9 @@ func (x *fastReflection_BuyOrderProjectSelector) ProtoMethods() *protoiface.Meth
 				}
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProjectId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
 				}
-				x.ProjectId = 0
+				x.ProjectKey = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1767,7 +1767,7 @@ func (x *fastReflection_BuyOrderProjectSelector) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ProjectId |= uint64(b&0x7F) << shift
+					x.ProjectKey |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -1882,14 +1882,14 @@ func (x *fastReflection_BuyOrderProjectSelector) ProtoMethods() *protoiface.Meth
 var (
 	md_BuyOrderBatchSelector              protoreflect.MessageDescriptor
 	fd_BuyOrderBatchSelector_buy_order_id protoreflect.FieldDescriptor
-	fd_BuyOrderBatchSelector_batch_id     protoreflect.FieldDescriptor
+	fd_BuyOrderBatchSelector_batch_key    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_regen_ecocredit_orderbook_v1alpha1_memory_proto_init()
 	md_BuyOrderBatchSelector = File_regen_ecocredit_orderbook_v1alpha1_memory_proto.Messages().ByName("BuyOrderBatchSelector")
 	fd_BuyOrderBatchSelector_buy_order_id = md_BuyOrderBatchSelector.Fields().ByName("buy_order_id")
-	fd_BuyOrderBatchSelector_batch_id = md_BuyOrderBatchSelector.Fields().ByName("batch_id")
+	fd_BuyOrderBatchSelector_batch_key = md_BuyOrderBatchSelector.Fields().ByName("batch_key")
 }
 
 var _ protoreflect.Message = (*fastReflection_BuyOrderBatchSelector)(nil)
@@ -1963,9 +1963,9 @@ func (x *fastReflection_BuyOrderBatchSelector) Range(f func(protoreflect.FieldDe
 			return
 		}
 	}
-	if x.BatchId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.BatchId)
-		if !f(fd_BuyOrderBatchSelector_batch_id, value) {
+	if x.BatchKey != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BatchKey)
+		if !f(fd_BuyOrderBatchSelector_batch_key, value) {
 			return
 		}
 	}
@@ -1986,8 +1986,8 @@ func (x *fastReflection_BuyOrderBatchSelector) Has(fd protoreflect.FieldDescript
 	switch fd.FullName() {
 	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderBatchSelector.buy_order_id":
 		return x.BuyOrderId != uint64(0)
-	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderBatchSelector.batch_id":
-		return x.BatchId != uint64(0)
+	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderBatchSelector.batch_key":
+		return x.BatchKey != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.orderbook.v1alpha1.BuyOrderBatchSelector"))
@@ -2006,8 +2006,8 @@ func (x *fastReflection_BuyOrderBatchSelector) Clear(fd protoreflect.FieldDescri
 	switch fd.FullName() {
 	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderBatchSelector.buy_order_id":
 		x.BuyOrderId = uint64(0)
-	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderBatchSelector.batch_id":
-		x.BatchId = uint64(0)
+	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderBatchSelector.batch_key":
+		x.BatchKey = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.orderbook.v1alpha1.BuyOrderBatchSelector"))
@@ -2027,8 +2027,8 @@ func (x *fastReflection_BuyOrderBatchSelector) Get(descriptor protoreflect.Field
 	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderBatchSelector.buy_order_id":
 		value := x.BuyOrderId
 		return protoreflect.ValueOfUint64(value)
-	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderBatchSelector.batch_id":
-		value := x.BatchId
+	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderBatchSelector.batch_key":
+		value := x.BatchKey
 		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
@@ -2052,8 +2052,8 @@ func (x *fastReflection_BuyOrderBatchSelector) Set(fd protoreflect.FieldDescript
 	switch fd.FullName() {
 	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderBatchSelector.buy_order_id":
 		x.BuyOrderId = value.Uint()
-	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderBatchSelector.batch_id":
-		x.BatchId = value.Uint()
+	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderBatchSelector.batch_key":
+		x.BatchKey = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.orderbook.v1alpha1.BuyOrderBatchSelector"))
@@ -2076,8 +2076,8 @@ func (x *fastReflection_BuyOrderBatchSelector) Mutable(fd protoreflect.FieldDesc
 	switch fd.FullName() {
 	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderBatchSelector.buy_order_id":
 		panic(fmt.Errorf("field buy_order_id of message regen.ecocredit.orderbook.v1alpha1.BuyOrderBatchSelector is not mutable"))
-	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderBatchSelector.batch_id":
-		panic(fmt.Errorf("field batch_id of message regen.ecocredit.orderbook.v1alpha1.BuyOrderBatchSelector is not mutable"))
+	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderBatchSelector.batch_key":
+		panic(fmt.Errorf("field batch_key of message regen.ecocredit.orderbook.v1alpha1.BuyOrderBatchSelector is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.orderbook.v1alpha1.BuyOrderBatchSelector"))
@@ -2093,7 +2093,7 @@ func (x *fastReflection_BuyOrderBatchSelector) NewField(fd protoreflect.FieldDes
 	switch fd.FullName() {
 	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderBatchSelector.buy_order_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderBatchSelector.batch_id":
+	case "regen.ecocredit.orderbook.v1alpha1.BuyOrderBatchSelector.batch_key":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
@@ -2167,8 +2167,8 @@ func (x *fastReflection_BuyOrderBatchSelector) ProtoMethods() *protoiface.Method
 		if x.BuyOrderId != 0 {
 			n += 1 + runtime.Sov(uint64(x.BuyOrderId))
 		}
-		if x.BatchId != 0 {
-			n += 1 + runtime.Sov(uint64(x.BatchId))
+		if x.BatchKey != 0 {
+			n += 1 + runtime.Sov(uint64(x.BatchKey))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2199,8 +2199,8 @@ func (x *fastReflection_BuyOrderBatchSelector) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.BatchId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BatchId))
+		if x.BatchKey != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BatchKey))
 			i--
 			dAtA[i] = 0x10
 		}
@@ -2279,9 +2279,9 @@ func (x *fastReflection_BuyOrderBatchSelector) ProtoMethods() *protoiface.Method
 				}
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
 				}
-				x.BatchId = 0
+				x.BatchKey = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2291,7 +2291,7 @@ func (x *fastReflection_BuyOrderBatchSelector) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.BatchId |= uint64(b&0x7F) << shift
+					x.BatchKey |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -2430,10 +2430,11 @@ type BuyOrderClassSelector struct {
 
 	// buy_order_id is the buy order ID.
 	BuyOrderId uint64 `protobuf:"varint,1,opt,name=buy_order_id,json=buyOrderId,proto3" json:"buy_order_id,omitempty"`
-	// class_id is the class ID.
-	ClassId uint64 `protobuf:"varint,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
-	// project_location is the project location in the selector's criteria.
-	ProjectLocation string `protobuf:"bytes,3,opt,name=project_location,json=projectLocation,proto3" json:"project_location,omitempty"`
+	// class_key is the table row identifier of the credit class.
+	ClassKey uint64 `protobuf:"varint,2,opt,name=class_key,json=classKey,proto3" json:"class_key,omitempty"`
+	// project_jurisdiction is the project jurisdiction prefix in the selector's
+	// criteria.
+	ProjectJurisdiction string `protobuf:"bytes,3,opt,name=project_jurisdiction,json=projectJurisdiction,proto3" json:"project_jurisdiction,omitempty"`
 	// min_start_date is the minimum start date in the selector's criteria.
 	MinStartDate *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=min_start_date,json=minStartDate,proto3" json:"min_start_date,omitempty"`
 	// max_end_date is the maximum end date in the selector's criteria.
@@ -2467,16 +2468,16 @@ func (x *BuyOrderClassSelector) GetBuyOrderId() uint64 {
 	return 0
 }
 
-func (x *BuyOrderClassSelector) GetClassId() uint64 {
+func (x *BuyOrderClassSelector) GetClassKey() uint64 {
 	if x != nil {
-		return x.ClassId
+		return x.ClassKey
 	}
 	return 0
 }
 
-func (x *BuyOrderClassSelector) GetProjectLocation() string {
+func (x *BuyOrderClassSelector) GetProjectJurisdiction() string {
 	if x != nil {
-		return x.ProjectLocation
+		return x.ProjectJurisdiction
 	}
 	return ""
 }
@@ -2503,8 +2504,8 @@ type BuyOrderProjectSelector struct {
 
 	// buy_order_id is the buy order ID.
 	BuyOrderId uint64 `protobuf:"varint,1,opt,name=buy_order_id,json=buyOrderId,proto3" json:"buy_order_id,omitempty"`
-	// project_id is the project ID.
-	ProjectId uint64 `protobuf:"varint,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// project_key is the table row identifier of the project.
+	ProjectKey uint64 `protobuf:"varint,2,opt,name=project_key,json=projectKey,proto3" json:"project_key,omitempty"`
 	// min_start_date is the minimum start date in the selector's criteria.
 	MinStartDate *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=min_start_date,json=minStartDate,proto3" json:"min_start_date,omitempty"`
 	// max_end_date is the maximum end date in the selector's criteria.
@@ -2538,9 +2539,9 @@ func (x *BuyOrderProjectSelector) GetBuyOrderId() uint64 {
 	return 0
 }
 
-func (x *BuyOrderProjectSelector) GetProjectId() uint64 {
+func (x *BuyOrderProjectSelector) GetProjectKey() uint64 {
 	if x != nil {
-		return x.ProjectId
+		return x.ProjectKey
 	}
 	return 0
 }
@@ -2567,8 +2568,8 @@ type BuyOrderBatchSelector struct {
 
 	// buy_order_id is the buy order ID.
 	BuyOrderId uint64 `protobuf:"varint,1,opt,name=buy_order_id,json=buyOrderId,proto3" json:"buy_order_id,omitempty"`
-	// batch_id is the batch ID.
-	BatchId uint64 `protobuf:"varint,2,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// batch_key is the table row identifier of the credit batch.
+	BatchKey uint64 `protobuf:"varint,2,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
 }
 
 func (x *BuyOrderBatchSelector) Reset() {
@@ -2598,9 +2599,9 @@ func (x *BuyOrderBatchSelector) GetBuyOrderId() uint64 {
 	return 0
 }
 
-func (x *BuyOrderBatchSelector) GetBatchId() uint64 {
+func (x *BuyOrderBatchSelector) GetBatchKey() uint64 {
 	if x != nil {
-		return x.BatchId
+		return x.BatchKey
 	}
 	return 0
 }
@@ -2638,72 +2639,74 @@ var file_regen_ecocredit_orderbook_v1alpha1_memory_proto_rawDesc = []byte{
 	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x2c, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x72, 0x69,
 	0x63, 0x65, 0x2c, 0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64,
 	0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x10, 0x02, 0x18, 0x01, 0x22, 0xb0, 0x02, 0x0a, 0x15, 0x42, 0x75, 0x79, 0x4f,
+	0x5f, 0x69, 0x64, 0x10, 0x02, 0x18, 0x01, 0x22, 0xbc, 0x02, 0x0a, 0x15, 0x42, 0x75, 0x79, 0x4f,
 	0x72, 0x64, 0x65, 0x72, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
 	0x72, 0x12, 0x20, 0x0a, 0x0c, 0x62, 0x75, 0x79, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x62, 0x75, 0x79, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x12, 0x29,
-	0x0a, 0x10, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0e, 0x6d, 0x69, 0x6e,
-	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x6d,
-	0x69, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x6d,
-	0x61, 0x78, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6d,
-	0x61, 0x78, 0x45, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x3a, 0x2f, 0xf2, 0x9e, 0xd3, 0x8e, 0x03,
-	0x29, 0x0a, 0x17, 0x0a, 0x15, 0x62, 0x75, 0x79, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x2c, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x12, 0x0c, 0x0a, 0x08, 0x63, 0x6c,
-	0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x10, 0x01, 0x18, 0x02, 0x22, 0x8f, 0x02, 0x0a, 0x17, 0x42,
-	0x75, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x65,
-	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0c, 0x62, 0x75, 0x79, 0x5f, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x62, 0x75,
-	0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a,
-	0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x5f, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x6d, 0x69, 0x6e,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x6d, 0x61, 0x78,
-	0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6d, 0x61, 0x78,
-	0x45, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x3a, 0x33, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x2d, 0x0a,
-	0x19, 0x0a, 0x17, 0x62, 0x75, 0x79, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x2c,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x12, 0x0e, 0x0a, 0x0a, 0x70, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x10, 0x01, 0x18, 0x03, 0x22, 0x85, 0x01, 0x0a,
+	0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x4b, 0x65, 0x79,
+	0x12, 0x31, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6a, 0x75, 0x72, 0x69,
+	0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x6e, 0x64,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x45, 0x6e, 0x64, 0x44,
+	0x61, 0x74, 0x65, 0x3a, 0x31, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x2b, 0x0a, 0x18, 0x0a, 0x16, 0x62,
+	0x75, 0x79, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x2c, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x5f, 0x6b, 0x65, 0x79, 0x12, 0x0d, 0x0a, 0x09, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x6b,
+	0x65, 0x79, 0x10, 0x01, 0x18, 0x02, 0x22, 0x93, 0x02, 0x0a, 0x17, 0x42, 0x75, 0x79, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0c, 0x62, 0x75, 0x79, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x62, 0x75, 0x79, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x40, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x65,
+	0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x45, 0x6e,
+	0x64, 0x44, 0x61, 0x74, 0x65, 0x3a, 0x35, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x2f, 0x0a, 0x1a, 0x0a,
+	0x18, 0x62, 0x75, 0x79, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x2c, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x12, 0x0f, 0x0a, 0x0b, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x10, 0x01, 0x18, 0x03, 0x22, 0x89, 0x01, 0x0a,
 	0x15, 0x42, 0x75, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65,
 	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0c, 0x62, 0x75, 0x79, 0x5f, 0x6f, 0x72,
 	0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x62, 0x75,
-	0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x74, 0x63,
-	0x68, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63,
-	0x68, 0x49, 0x64, 0x3a, 0x2f, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x29, 0x0a, 0x17, 0x0a, 0x15, 0x62,
-	0x75, 0x79, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x2c, 0x62, 0x61, 0x74, 0x63,
-	0x68, 0x5f, 0x69, 0x64, 0x12, 0x0c, 0x0a, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64,
-	0x10, 0x01, 0x18, 0x04, 0x42, 0xc0, 0x02, 0x0a, 0x26, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x65, 0x67,
-	0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x42,
-	0x0b, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x5e,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e,
-	0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6c,
-	0x65, 0x64, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f,
-	0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x62,
-	0x6f, 0x6f, 0x6b, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x3b, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xa2, 0x02,
-	0x03, 0x52, 0x45, 0x4f, 0xaa, 0x02, 0x22, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x45, 0x63, 0x6f,
-	0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x6f, 0x6b,
-	0x2e, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xca, 0x02, 0x22, 0x52, 0x65, 0x67, 0x65,
-	0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5c, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xe2, 0x02,
-	0x2e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
-	0x5c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x25, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x3a, 0x3a, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64,
-	0x69, 0x74, 0x3a, 0x3a, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x3a, 0x3a, 0x56,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x4b, 0x65, 0x79, 0x3a, 0x31, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x2b, 0x0a, 0x18, 0x0a,
+	0x16, 0x62, 0x75, 0x79, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x2c, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x12, 0x0d, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x5f, 0x6b, 0x65, 0x79, 0x10, 0x01, 0x18, 0x04, 0x42, 0xc0, 0x02, 0x0a, 0x26, 0x63, 0x6f, 0x6d,
+	0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x42, 0x0b, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x5e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72,
+	0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x72, 0x65, 0x67,
+	0x65, 0x6e, 0x2d, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65,
+	0x67, 0x65, 0x6e, 0x2f, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2f, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x3b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0xa2, 0x02, 0x03, 0x52, 0x45, 0x4f, 0xaa, 0x02, 0x22, 0x52, 0x65, 0x67, 0x65, 0x6e,
+	0x2e, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x62, 0x6f, 0x6f, 0x6b, 0x2e, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xca, 0x02, 0x22,
+	0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5c,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0xe2, 0x02, 0x2e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x5c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x6f, 0x6b, 0x5c, 0x56,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x25, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x3a, 0x3a, 0x45, 0x63, 0x6f,
+	0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x3a, 0x3a, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x6f,
+	0x6b, 0x3a, 0x3a, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
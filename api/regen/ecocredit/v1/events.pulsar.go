@@ -2405,6 +2405,7 @@ var (
 	fd_EventTransfer_batch_denom     protoreflect.FieldDescriptor
 	fd_EventTransfer_tradable_amount protoreflect.FieldDescriptor
 	fd_EventTransfer_retired_amount  protoreflect.FieldDescriptor
+	fd_EventTransfer_locked_amount   protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -2415,6 +2416,7 @@ func init() {
 	fd_EventTransfer_batch_denom = md_EventTransfer.Fields().ByName("batch_denom")
 	fd_EventTransfer_tradable_amount = md_EventTransfer.Fields().ByName("tradable_amount")
 	fd_EventTransfer_retired_amount = md_EventTransfer.Fields().ByName("retired_amount")
+	fd_EventTransfer_locked_amount = md_EventTransfer.Fields().ByName("locked_amount")
 }
 
 var _ protoreflect.Message = (*fastReflection_EventTransfer)(nil)
@@ -2512,6 +2514,12 @@ func (x *fastReflection_EventTransfer) Range(f func(protoreflect.FieldDescriptor
 			return
 		}
 	}
+	if x.LockedAmount != "" {
+		value := protoreflect.ValueOfString(x.LockedAmount)
+		if !f(fd_EventTransfer_locked_amount, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2537,6 +2545,8 @@ func (x *fastReflection_EventTransfer) Has(fd protoreflect.FieldDescriptor) bool
 		return x.TradableAmount != ""
 	case "regen.ecocredit.v1.EventTransfer.retired_amount":
 		return x.RetiredAmount != ""
+	case "regen.ecocredit.v1.EventTransfer.locked_amount":
+		return x.LockedAmount != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventTransfer"))
@@ -2563,6 +2573,8 @@ func (x *fastReflection_EventTransfer) Clear(fd protoreflect.FieldDescriptor) {
 		x.TradableAmount = ""
 	case "regen.ecocredit.v1.EventTransfer.retired_amount":
 		x.RetiredAmount = ""
+	case "regen.ecocredit.v1.EventTransfer.locked_amount":
+		x.LockedAmount = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventTransfer"))
@@ -2594,6 +2606,9 @@ func (x *fastReflection_EventTransfer) Get(descriptor protoreflect.FieldDescript
 	case "regen.ecocredit.v1.EventTransfer.retired_amount":
 		value := x.RetiredAmount
 		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventTransfer.locked_amount":
+		value := x.LockedAmount
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventTransfer"))
@@ -2624,6 +2639,8 @@ func (x *fastReflection_EventTransfer) Set(fd protoreflect.FieldDescriptor, valu
 		x.TradableAmount = value.Interface().(string)
 	case "regen.ecocredit.v1.EventTransfer.retired_amount":
 		x.RetiredAmount = value.Interface().(string)
+	case "regen.ecocredit.v1.EventTransfer.locked_amount":
+		x.LockedAmount = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventTransfer"))
@@ -2654,6 +2671,8 @@ func (x *fastReflection_EventTransfer) Mutable(fd protoreflect.FieldDescriptor)
 		panic(fmt.Errorf("field tradable_amount of message regen.ecocredit.v1.EventTransfer is not mutable"))
 	case "regen.ecocredit.v1.EventTransfer.retired_amount":
 		panic(fmt.Errorf("field retired_amount of message regen.ecocredit.v1.EventTransfer is not mutable"))
+	case "regen.ecocredit.v1.EventTransfer.locked_amount":
+		panic(fmt.Errorf("field locked_amount of message regen.ecocredit.v1.EventTransfer is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventTransfer"))
@@ -2677,6 +2696,8 @@ func (x *fastReflection_EventTransfer) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfString("")
 	case "regen.ecocredit.v1.EventTransfer.retired_amount":
 		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventTransfer.locked_amount":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventTransfer"))
@@ -2766,6 +2787,10 @@ func (x *fastReflection_EventTransfer) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.LockedAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2795,6 +2820,13 @@ func (x *fastReflection_EventTransfer) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.LockedAmount) > 0 {
+			i -= len(x.LockedAmount)
+			copy(dAtA[i:], x.LockedAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LockedAmount)))
+			i--
+			dAtA[i] = 0x32
+		}
 		if len(x.RetiredAmount) > 0 {
 			i -= len(x.RetiredAmount)
 			copy(dAtA[i:], x.RetiredAmount)
@@ -3039,6 +3071,38 @@ func (x *fastReflection_EventTransfer) ProtoMethods() *protoiface.Methods {
 				}
 				x.RetiredAmount = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockedAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LockedAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3075,11 +3139,15 @@ func (x *fastReflection_EventTransfer) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_EventRetire              protoreflect.MessageDescriptor
-	fd_EventRetire_owner        protoreflect.FieldDescriptor
-	fd_EventRetire_batch_denom  protoreflect.FieldDescriptor
-	fd_EventRetire_amount       protoreflect.FieldDescriptor
-	fd_EventRetire_jurisdiction protoreflect.FieldDescriptor
+	md_EventRetire                     protoreflect.MessageDescriptor
+	fd_EventRetire_owner               protoreflect.FieldDescriptor
+	fd_EventRetire_batch_denom         protoreflect.FieldDescriptor
+	fd_EventRetire_amount              protoreflect.FieldDescriptor
+	fd_EventRetire_jurisdiction        protoreflect.FieldDescriptor
+	fd_EventRetire_reason              protoreflect.FieldDescriptor
+	fd_EventRetire_retirement_id       protoreflect.FieldDescriptor
+	fd_EventRetire_beneficiary_address protoreflect.FieldDescriptor
+	fd_EventRetire_beneficiary_name    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -3089,6 +3157,10 @@ func init() {
 	fd_EventRetire_batch_denom = md_EventRetire.Fields().ByName("batch_denom")
 	fd_EventRetire_amount = md_EventRetire.Fields().ByName("amount")
 	fd_EventRetire_jurisdiction = md_EventRetire.Fields().ByName("jurisdiction")
+	fd_EventRetire_reason = md_EventRetire.Fields().ByName("reason")
+	fd_EventRetire_retirement_id = md_EventRetire.Fields().ByName("retirement_id")
+	fd_EventRetire_beneficiary_address = md_EventRetire.Fields().ByName("beneficiary_address")
+	fd_EventRetire_beneficiary_name = md_EventRetire.Fields().ByName("beneficiary_name")
 }
 
 var _ protoreflect.Message = (*fastReflection_EventRetire)(nil)
@@ -3180,6 +3252,30 @@ func (x *fastReflection_EventRetire) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_EventRetire_reason, value) {
+			return
+		}
+	}
+	if x.RetirementId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RetirementId)
+		if !f(fd_EventRetire_retirement_id, value) {
+			return
+		}
+	}
+	if x.BeneficiaryAddress != "" {
+		value := protoreflect.ValueOfString(x.BeneficiaryAddress)
+		if !f(fd_EventRetire_beneficiary_address, value) {
+			return
+		}
+	}
+	if x.BeneficiaryName != "" {
+		value := protoreflect.ValueOfString(x.BeneficiaryName)
+		if !f(fd_EventRetire_beneficiary_name, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -3203,6 +3299,14 @@ func (x *fastReflection_EventRetire) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Amount != ""
 	case "regen.ecocredit.v1.EventRetire.jurisdiction":
 		return x.Jurisdiction != ""
+	case "regen.ecocredit.v1.EventRetire.reason":
+		return x.Reason != ""
+	case "regen.ecocredit.v1.EventRetire.retirement_id":
+		return x.RetirementId != uint64(0)
+	case "regen.ecocredit.v1.EventRetire.beneficiary_address":
+		return x.BeneficiaryAddress != ""
+	case "regen.ecocredit.v1.EventRetire.beneficiary_name":
+		return x.BeneficiaryName != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventRetire"))
@@ -3227,6 +3331,14 @@ func (x *fastReflection_EventRetire) Clear(fd protoreflect.FieldDescriptor) {
 		x.Amount = ""
 	case "regen.ecocredit.v1.EventRetire.jurisdiction":
 		x.Jurisdiction = ""
+	case "regen.ecocredit.v1.EventRetire.reason":
+		x.Reason = ""
+	case "regen.ecocredit.v1.EventRetire.retirement_id":
+		x.RetirementId = uint64(0)
+	case "regen.ecocredit.v1.EventRetire.beneficiary_address":
+		x.BeneficiaryAddress = ""
+	case "regen.ecocredit.v1.EventRetire.beneficiary_name":
+		x.BeneficiaryName = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventRetire"))
@@ -3255,6 +3367,18 @@ func (x *fastReflection_EventRetire) Get(descriptor protoreflect.FieldDescriptor
 	case "regen.ecocredit.v1.EventRetire.jurisdiction":
 		value := x.Jurisdiction
 		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventRetire.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventRetire.retirement_id":
+		value := x.RetirementId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.v1.EventRetire.beneficiary_address":
+		value := x.BeneficiaryAddress
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventRetire.beneficiary_name":
+		value := x.BeneficiaryName
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventRetire"))
@@ -3283,6 +3407,14 @@ func (x *fastReflection_EventRetire) Set(fd protoreflect.FieldDescriptor, value
 		x.Amount = value.Interface().(string)
 	case "regen.ecocredit.v1.EventRetire.jurisdiction":
 		x.Jurisdiction = value.Interface().(string)
+	case "regen.ecocredit.v1.EventRetire.reason":
+		x.Reason = value.Interface().(string)
+	case "regen.ecocredit.v1.EventRetire.retirement_id":
+		x.RetirementId = value.Uint()
+	case "regen.ecocredit.v1.EventRetire.beneficiary_address":
+		x.BeneficiaryAddress = value.Interface().(string)
+	case "regen.ecocredit.v1.EventRetire.beneficiary_name":
+		x.BeneficiaryName = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventRetire"))
@@ -3311,6 +3443,14 @@ func (x *fastReflection_EventRetire) Mutable(fd protoreflect.FieldDescriptor) pr
 		panic(fmt.Errorf("field amount of message regen.ecocredit.v1.EventRetire is not mutable"))
 	case "regen.ecocredit.v1.EventRetire.jurisdiction":
 		panic(fmt.Errorf("field jurisdiction of message regen.ecocredit.v1.EventRetire is not mutable"))
+	case "regen.ecocredit.v1.EventRetire.reason":
+		panic(fmt.Errorf("field reason of message regen.ecocredit.v1.EventRetire is not mutable"))
+	case "regen.ecocredit.v1.EventRetire.retirement_id":
+		panic(fmt.Errorf("field retirement_id of message regen.ecocredit.v1.EventRetire is not mutable"))
+	case "regen.ecocredit.v1.EventRetire.beneficiary_address":
+		panic(fmt.Errorf("field beneficiary_address of message regen.ecocredit.v1.EventRetire is not mutable"))
+	case "regen.ecocredit.v1.EventRetire.beneficiary_name":
+		panic(fmt.Errorf("field beneficiary_name of message regen.ecocredit.v1.EventRetire is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventRetire"))
@@ -3332,6 +3472,14 @@ func (x *fastReflection_EventRetire) NewField(fd protoreflect.FieldDescriptor) p
 		return protoreflect.ValueOfString("")
 	case "regen.ecocredit.v1.EventRetire.jurisdiction":
 		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventRetire.reason":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventRetire.retirement_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.v1.EventRetire.beneficiary_address":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventRetire.beneficiary_name":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventRetire"))
@@ -3417,6 +3565,21 @@ func (x *fastReflection_EventRetire) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RetirementId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RetirementId))
+		}
+		l = len(x.BeneficiaryAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BeneficiaryName)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3446,6 +3609,32 @@ func (x *fastReflection_EventRetire) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.BeneficiaryName) > 0 {
+			i -= len(x.BeneficiaryName)
+			copy(dAtA[i:], x.BeneficiaryName)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BeneficiaryName)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.BeneficiaryAddress) > 0 {
+			i -= len(x.BeneficiaryAddress)
+			copy(dAtA[i:], x.BeneficiaryAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BeneficiaryAddress)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.RetirementId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RetirementId))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x2a
+		}
 		if len(x.Jurisdiction) > 0 {
 			i -= len(x.Jurisdiction)
 			copy(dAtA[i:], x.Jurisdiction)
@@ -3651,6 +3840,121 @@ func (x *fastReflection_EventRetire) ProtoMethods() *protoiface.Methods {
 				}
 				x.Jurisdiction = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetirementId", wireType)
+				}
+				x.RetirementId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RetirementId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BeneficiaryAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BeneficiaryAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BeneficiaryName", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BeneficiaryName = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6399,25 +6703,25 @@ func (x *fastReflection_EventUpdateProjectMetadata) ProtoMethods() *protoiface.M
 }
 
 var (
-	md_EventSealBatch             protoreflect.MessageDescriptor
-	fd_EventSealBatch_batch_denom protoreflect.FieldDescriptor
+	md_EventUpdateBatchMetadata             protoreflect.MessageDescriptor
+	fd_EventUpdateBatchMetadata_batch_denom protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_regen_ecocredit_v1_events_proto_init()
-	md_EventSealBatch = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventSealBatch")
-	fd_EventSealBatch_batch_denom = md_EventSealBatch.Fields().ByName("batch_denom")
+	md_EventUpdateBatchMetadata = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventUpdateBatchMetadata")
+	fd_EventUpdateBatchMetadata_batch_denom = md_EventUpdateBatchMetadata.Fields().ByName("batch_denom")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventSealBatch)(nil)
+var _ protoreflect.Message = (*fastReflection_EventUpdateBatchMetadata)(nil)
 
-type fastReflection_EventSealBatch EventSealBatch
+type fastReflection_EventUpdateBatchMetadata EventUpdateBatchMetadata
 
-func (x *EventSealBatch) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventSealBatch)(x)
+func (x *EventUpdateBatchMetadata) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventUpdateBatchMetadata)(x)
 }
 
-func (x *EventSealBatch) slowProtoReflect() protoreflect.Message {
+func (x *EventUpdateBatchMetadata) slowProtoReflect() protoreflect.Message {
 	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6429,43 +6733,43 @@ func (x *EventSealBatch) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventSealBatch_messageType fastReflection_EventSealBatch_messageType
-var _ protoreflect.MessageType = fastReflection_EventSealBatch_messageType{}
+var _fastReflection_EventUpdateBatchMetadata_messageType fastReflection_EventUpdateBatchMetadata_messageType
+var _ protoreflect.MessageType = fastReflection_EventUpdateBatchMetadata_messageType{}
 
-type fastReflection_EventSealBatch_messageType struct{}
+type fastReflection_EventUpdateBatchMetadata_messageType struct{}
 
-func (x fastReflection_EventSealBatch_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventSealBatch)(nil)
+func (x fastReflection_EventUpdateBatchMetadata_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventUpdateBatchMetadata)(nil)
 }
-func (x fastReflection_EventSealBatch_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventSealBatch)
+func (x fastReflection_EventUpdateBatchMetadata_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateBatchMetadata)
 }
-func (x fastReflection_EventSealBatch_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventSealBatch
+func (x fastReflection_EventUpdateBatchMetadata_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateBatchMetadata
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventSealBatch) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventSealBatch
+func (x *fastReflection_EventUpdateBatchMetadata) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateBatchMetadata
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventSealBatch) Type() protoreflect.MessageType {
-	return _fastReflection_EventSealBatch_messageType
+func (x *fastReflection_EventUpdateBatchMetadata) Type() protoreflect.MessageType {
+	return _fastReflection_EventUpdateBatchMetadata_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventSealBatch) New() protoreflect.Message {
-	return new(fastReflection_EventSealBatch)
+func (x *fastReflection_EventUpdateBatchMetadata) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateBatchMetadata)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventSealBatch) Interface() protoreflect.ProtoMessage {
-	return (*EventSealBatch)(x)
+func (x *fastReflection_EventUpdateBatchMetadata) Interface() protoreflect.ProtoMessage {
+	return (*EventUpdateBatchMetadata)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6473,10 +6777,10 @@ func (x *fastReflection_EventSealBatch) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventSealBatch) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_EventUpdateBatchMetadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.BatchDenom != "" {
 		value := protoreflect.ValueOfString(x.BatchDenom)
-		if !f(fd_EventSealBatch_batch_denom, value) {
+		if !f(fd_EventUpdateBatchMetadata_batch_denom, value) {
 			return
 		}
 	}
@@ -6493,15 +6797,15 @@ func (x *fastReflection_EventSealBatch) Range(f func(protoreflect.FieldDescripto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventSealBatch) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventUpdateBatchMetadata) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventSealBatch.batch_denom":
+	case "regen.ecocredit.v1.EventUpdateBatchMetadata.batch_denom":
 		return x.BatchDenom != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventSealBatch"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateBatchMetadata"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventSealBatch does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateBatchMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6511,15 +6815,15 @@ func (x *fastReflection_EventSealBatch) Has(fd protoreflect.FieldDescriptor) boo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventSealBatch) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventUpdateBatchMetadata) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventSealBatch.batch_denom":
+	case "regen.ecocredit.v1.EventUpdateBatchMetadata.batch_denom":
 		x.BatchDenom = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventSealBatch"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateBatchMetadata"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventSealBatch does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateBatchMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6529,16 +6833,16 @@ func (x *fastReflection_EventSealBatch) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventSealBatch) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventUpdateBatchMetadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "regen.ecocredit.v1.EventSealBatch.batch_denom":
+	case "regen.ecocredit.v1.EventUpdateBatchMetadata.batch_denom":
 		value := x.BatchDenom
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventSealBatch"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateBatchMetadata"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventSealBatch does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateBatchMetadata does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6552,15 +6856,15 @@ func (x *fastReflection_EventSealBatch) Get(descriptor protoreflect.FieldDescrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventSealBatch) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventUpdateBatchMetadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventSealBatch.batch_denom":
+	case "regen.ecocredit.v1.EventUpdateBatchMetadata.batch_denom":
 		x.BatchDenom = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventSealBatch"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateBatchMetadata"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventSealBatch does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateBatchMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6574,40 +6878,40 @@ func (x *fastReflection_EventSealBatch) Set(fd protoreflect.FieldDescriptor, val
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventSealBatch) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventUpdateBatchMetadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventSealBatch.batch_denom":
-		panic(fmt.Errorf("field batch_denom of message regen.ecocredit.v1.EventSealBatch is not mutable"))
+	case "regen.ecocredit.v1.EventUpdateBatchMetadata.batch_denom":
+		panic(fmt.Errorf("field batch_denom of message regen.ecocredit.v1.EventUpdateBatchMetadata is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventSealBatch"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateBatchMetadata"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventSealBatch does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateBatchMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventSealBatch) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventUpdateBatchMetadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventSealBatch.batch_denom":
+	case "regen.ecocredit.v1.EventUpdateBatchMetadata.batch_denom":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventSealBatch"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateBatchMetadata"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventSealBatch does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateBatchMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventSealBatch) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventUpdateBatchMetadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventSealBatch", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventUpdateBatchMetadata", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6615,7 +6919,7 @@ func (x *fastReflection_EventSealBatch) WhichOneof(d protoreflect.OneofDescripto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventSealBatch) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventUpdateBatchMetadata) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6626,7 +6930,7 @@ func (x *fastReflection_EventSealBatch) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventSealBatch) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventUpdateBatchMetadata) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6638,7 +6942,7 @@ func (x *fastReflection_EventSealBatch) SetUnknown(fields protoreflect.RawFields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventSealBatch) IsValid() bool {
+func (x *fastReflection_EventUpdateBatchMetadata) IsValid() bool {
 	return x != nil
 }
 
@@ -6648,9 +6952,9 @@ func (x *fastReflection_EventSealBatch) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventSealBatch) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventUpdateBatchMetadata) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventSealBatch)
+		x := input.Message.Interface().(*EventUpdateBatchMetadata)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6676,7 +6980,7 @@ func (x *fastReflection_EventSealBatch) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventSealBatch)
+		x := input.Message.Interface().(*EventUpdateBatchMetadata)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6713,7 +7017,7 @@ func (x *fastReflection_EventSealBatch) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventSealBatch)
+		x := input.Message.Interface().(*EventUpdateBatchMetadata)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6745,10 +7049,10 @@ func (x *fastReflection_EventSealBatch) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSealBatch: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateBatchMetadata: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSealBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateBatchMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -6819,25 +7123,25 @@ func (x *fastReflection_EventSealBatch) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_EventAddCreditType              protoreflect.MessageDescriptor
-	fd_EventAddCreditType_abbreviation protoreflect.FieldDescriptor
+	md_EventSealBatch             protoreflect.MessageDescriptor
+	fd_EventSealBatch_batch_denom protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_regen_ecocredit_v1_events_proto_init()
-	md_EventAddCreditType = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventAddCreditType")
-	fd_EventAddCreditType_abbreviation = md_EventAddCreditType.Fields().ByName("abbreviation")
+	md_EventSealBatch = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventSealBatch")
+	fd_EventSealBatch_batch_denom = md_EventSealBatch.Fields().ByName("batch_denom")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventAddCreditType)(nil)
+var _ protoreflect.Message = (*fastReflection_EventSealBatch)(nil)
 
-type fastReflection_EventAddCreditType EventAddCreditType
+type fastReflection_EventSealBatch EventSealBatch
 
-func (x *EventAddCreditType) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventAddCreditType)(x)
+func (x *EventSealBatch) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventSealBatch)(x)
 }
 
-func (x *EventAddCreditType) slowProtoReflect() protoreflect.Message {
+func (x *EventSealBatch) slowProtoReflect() protoreflect.Message {
 	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6849,43 +7153,43 @@ func (x *EventAddCreditType) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventAddCreditType_messageType fastReflection_EventAddCreditType_messageType
-var _ protoreflect.MessageType = fastReflection_EventAddCreditType_messageType{}
+var _fastReflection_EventSealBatch_messageType fastReflection_EventSealBatch_messageType
+var _ protoreflect.MessageType = fastReflection_EventSealBatch_messageType{}
 
-type fastReflection_EventAddCreditType_messageType struct{}
+type fastReflection_EventSealBatch_messageType struct{}
 
-func (x fastReflection_EventAddCreditType_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventAddCreditType)(nil)
+func (x fastReflection_EventSealBatch_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventSealBatch)(nil)
 }
-func (x fastReflection_EventAddCreditType_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventAddCreditType)
+func (x fastReflection_EventSealBatch_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventSealBatch)
 }
-func (x fastReflection_EventAddCreditType_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventAddCreditType
+func (x fastReflection_EventSealBatch_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSealBatch
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventAddCreditType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventAddCreditType
+func (x *fastReflection_EventSealBatch) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSealBatch
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventAddCreditType) Type() protoreflect.MessageType {
-	return _fastReflection_EventAddCreditType_messageType
+func (x *fastReflection_EventSealBatch) Type() protoreflect.MessageType {
+	return _fastReflection_EventSealBatch_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventAddCreditType) New() protoreflect.Message {
-	return new(fastReflection_EventAddCreditType)
+func (x *fastReflection_EventSealBatch) New() protoreflect.Message {
+	return new(fastReflection_EventSealBatch)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventAddCreditType) Interface() protoreflect.ProtoMessage {
-	return (*EventAddCreditType)(x)
+func (x *fastReflection_EventSealBatch) Interface() protoreflect.ProtoMessage {
+	return (*EventSealBatch)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6893,10 +7197,10 @@ func (x *fastReflection_EventAddCreditType) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventAddCreditType) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Abbreviation != "" {
-		value := protoreflect.ValueOfString(x.Abbreviation)
-		if !f(fd_EventAddCreditType_abbreviation, value) {
+func (x *fastReflection_EventSealBatch) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BatchDenom != "" {
+		value := protoreflect.ValueOfString(x.BatchDenom)
+		if !f(fd_EventSealBatch_batch_denom, value) {
 			return
 		}
 	}
@@ -6913,15 +7217,15 @@ func (x *fastReflection_EventAddCreditType) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventAddCreditType) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventSealBatch) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventAddCreditType.abbreviation":
-		return x.Abbreviation != ""
+	case "regen.ecocredit.v1.EventSealBatch.batch_denom":
+		return x.BatchDenom != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventAddCreditType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventSealBatch"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventAddCreditType does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventSealBatch does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6931,15 +7235,15 @@ func (x *fastReflection_EventAddCreditType) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventAddCreditType) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventSealBatch) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventAddCreditType.abbreviation":
-		x.Abbreviation = ""
+	case "regen.ecocredit.v1.EventSealBatch.batch_denom":
+		x.BatchDenom = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventAddCreditType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventSealBatch"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventAddCreditType does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventSealBatch does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6949,16 +7253,16 @@ func (x *fastReflection_EventAddCreditType) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventAddCreditType) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventSealBatch) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "regen.ecocredit.v1.EventAddCreditType.abbreviation":
-		value := x.Abbreviation
+	case "regen.ecocredit.v1.EventSealBatch.batch_denom":
+		value := x.BatchDenom
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventAddCreditType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventSealBatch"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventAddCreditType does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventSealBatch does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6972,15 +7276,15 @@ func (x *fastReflection_EventAddCreditType) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventAddCreditType) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventSealBatch) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventAddCreditType.abbreviation":
-		x.Abbreviation = value.Interface().(string)
+	case "regen.ecocredit.v1.EventSealBatch.batch_denom":
+		x.BatchDenom = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventAddCreditType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventSealBatch"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventAddCreditType does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventSealBatch does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6994,40 +7298,40 @@ func (x *fastReflection_EventAddCreditType) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventAddCreditType) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventSealBatch) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventAddCreditType.abbreviation":
-		panic(fmt.Errorf("field abbreviation of message regen.ecocredit.v1.EventAddCreditType is not mutable"))
+	case "regen.ecocredit.v1.EventSealBatch.batch_denom":
+		panic(fmt.Errorf("field batch_denom of message regen.ecocredit.v1.EventSealBatch is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventAddCreditType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventSealBatch"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventAddCreditType does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventSealBatch does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventAddCreditType) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventSealBatch) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventAddCreditType.abbreviation":
+	case "regen.ecocredit.v1.EventSealBatch.batch_denom":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventAddCreditType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventSealBatch"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventAddCreditType does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventSealBatch does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventAddCreditType) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventSealBatch) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventAddCreditType", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventSealBatch", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7035,7 +7339,7 @@ func (x *fastReflection_EventAddCreditType) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventAddCreditType) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventSealBatch) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7046,7 +7350,7 @@ func (x *fastReflection_EventAddCreditType) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventAddCreditType) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventSealBatch) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7058,7 +7362,7 @@ func (x *fastReflection_EventAddCreditType) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventAddCreditType) IsValid() bool {
+func (x *fastReflection_EventSealBatch) IsValid() bool {
 	return x != nil
 }
 
@@ -7068,9 +7372,9 @@ func (x *fastReflection_EventAddCreditType) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventAddCreditType) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventSealBatch) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventAddCreditType)
+		x := input.Message.Interface().(*EventSealBatch)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7082,7 +7386,7 @@ func (x *fastReflection_EventAddCreditType) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Abbreviation)
+		l = len(x.BatchDenom)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -7096,7 +7400,7 @@ func (x *fastReflection_EventAddCreditType) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventAddCreditType)
+		x := input.Message.Interface().(*EventSealBatch)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7115,10 +7419,10 @@ func (x *fastReflection_EventAddCreditType) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Abbreviation) > 0 {
-			i -= len(x.Abbreviation)
-			copy(dAtA[i:], x.Abbreviation)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Abbreviation)))
+		if len(x.BatchDenom) > 0 {
+			i -= len(x.BatchDenom)
+			copy(dAtA[i:], x.BatchDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BatchDenom)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -7133,7 +7437,7 @@ func (x *fastReflection_EventAddCreditType) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventAddCreditType)
+		x := input.Message.Interface().(*EventSealBatch)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7165,15 +7469,15 @@ func (x *fastReflection_EventAddCreditType) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventAddCreditType: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSealBatch: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventAddCreditType: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSealBatch: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Abbreviation", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7201,7 +7505,7 @@ func (x *fastReflection_EventAddCreditType) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Abbreviation = string(dAtA[iNdEx:postIndex])
+				x.BatchDenom = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -7239,31 +7543,25 @@ func (x *fastReflection_EventAddCreditType) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_EventBridge           protoreflect.MessageDescriptor
-	fd_EventBridge_target    protoreflect.FieldDescriptor
-	fd_EventBridge_recipient protoreflect.FieldDescriptor
-	fd_EventBridge_contract  protoreflect.FieldDescriptor
-	fd_EventBridge_amount    protoreflect.FieldDescriptor
+	md_EventAddCreditType              protoreflect.MessageDescriptor
+	fd_EventAddCreditType_abbreviation protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_regen_ecocredit_v1_events_proto_init()
-	md_EventBridge = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventBridge")
-	fd_EventBridge_target = md_EventBridge.Fields().ByName("target")
-	fd_EventBridge_recipient = md_EventBridge.Fields().ByName("recipient")
-	fd_EventBridge_contract = md_EventBridge.Fields().ByName("contract")
-	fd_EventBridge_amount = md_EventBridge.Fields().ByName("amount")
+	md_EventAddCreditType = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventAddCreditType")
+	fd_EventAddCreditType_abbreviation = md_EventAddCreditType.Fields().ByName("abbreviation")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventBridge)(nil)
+var _ protoreflect.Message = (*fastReflection_EventAddCreditType)(nil)
 
-type fastReflection_EventBridge EventBridge
+type fastReflection_EventAddCreditType EventAddCreditType
 
-func (x *EventBridge) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventBridge)(x)
+func (x *EventAddCreditType) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventAddCreditType)(x)
 }
 
-func (x *EventBridge) slowProtoReflect() protoreflect.Message {
+func (x *EventAddCreditType) slowProtoReflect() protoreflect.Message {
 	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7275,43 +7573,43 @@ func (x *EventBridge) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventBridge_messageType fastReflection_EventBridge_messageType
-var _ protoreflect.MessageType = fastReflection_EventBridge_messageType{}
+var _fastReflection_EventAddCreditType_messageType fastReflection_EventAddCreditType_messageType
+var _ protoreflect.MessageType = fastReflection_EventAddCreditType_messageType{}
 
-type fastReflection_EventBridge_messageType struct{}
+type fastReflection_EventAddCreditType_messageType struct{}
 
-func (x fastReflection_EventBridge_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventBridge)(nil)
+func (x fastReflection_EventAddCreditType_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventAddCreditType)(nil)
 }
-func (x fastReflection_EventBridge_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventBridge)
+func (x fastReflection_EventAddCreditType_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventAddCreditType)
 }
-func (x fastReflection_EventBridge_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventBridge
+func (x fastReflection_EventAddCreditType_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventAddCreditType
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventBridge) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventBridge
+func (x *fastReflection_EventAddCreditType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventAddCreditType
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventBridge) Type() protoreflect.MessageType {
-	return _fastReflection_EventBridge_messageType
+func (x *fastReflection_EventAddCreditType) Type() protoreflect.MessageType {
+	return _fastReflection_EventAddCreditType_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventBridge) New() protoreflect.Message {
-	return new(fastReflection_EventBridge)
+func (x *fastReflection_EventAddCreditType) New() protoreflect.Message {
+	return new(fastReflection_EventAddCreditType)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventBridge) Interface() protoreflect.ProtoMessage {
-	return (*EventBridge)(x)
+func (x *fastReflection_EventAddCreditType) Interface() protoreflect.ProtoMessage {
+	return (*EventAddCreditType)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7319,28 +7617,10 @@ func (x *fastReflection_EventBridge) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventBridge) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Target != "" {
-		value := protoreflect.ValueOfString(x.Target)
-		if !f(fd_EventBridge_target, value) {
-			return
-		}
-	}
-	if x.Recipient != "" {
-		value := protoreflect.ValueOfString(x.Recipient)
-		if !f(fd_EventBridge_recipient, value) {
-			return
-		}
-	}
-	if x.Contract != "" {
-		value := protoreflect.ValueOfString(x.Contract)
-		if !f(fd_EventBridge_contract, value) {
-			return
-		}
-	}
-	if x.Amount != "" {
-		value := protoreflect.ValueOfString(x.Amount)
-		if !f(fd_EventBridge_amount, value) {
+func (x *fastReflection_EventAddCreditType) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Abbreviation != "" {
+		value := protoreflect.ValueOfString(x.Abbreviation)
+		if !f(fd_EventAddCreditType_abbreviation, value) {
 			return
 		}
 	}
@@ -7357,21 +7637,15 @@ func (x *fastReflection_EventBridge) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventBridge) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventAddCreditType) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventBridge.target":
-		return x.Target != ""
-	case "regen.ecocredit.v1.EventBridge.recipient":
-		return x.Recipient != ""
-	case "regen.ecocredit.v1.EventBridge.contract":
-		return x.Contract != ""
-	case "regen.ecocredit.v1.EventBridge.amount":
-		return x.Amount != ""
+	case "regen.ecocredit.v1.EventAddCreditType.abbreviation":
+		return x.Abbreviation != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridge"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventAddCreditType"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridge does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventAddCreditType does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7381,21 +7655,15 @@ func (x *fastReflection_EventBridge) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventBridge) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventAddCreditType) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventBridge.target":
-		x.Target = ""
-	case "regen.ecocredit.v1.EventBridge.recipient":
-		x.Recipient = ""
-	case "regen.ecocredit.v1.EventBridge.contract":
-		x.Contract = ""
-	case "regen.ecocredit.v1.EventBridge.amount":
-		x.Amount = ""
+	case "regen.ecocredit.v1.EventAddCreditType.abbreviation":
+		x.Abbreviation = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridge"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventAddCreditType"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridge does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventAddCreditType does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7405,25 +7673,16 @@ func (x *fastReflection_EventBridge) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventBridge) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventAddCreditType) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "regen.ecocredit.v1.EventBridge.target":
-		value := x.Target
-		return protoreflect.ValueOfString(value)
-	case "regen.ecocredit.v1.EventBridge.recipient":
-		value := x.Recipient
-		return protoreflect.ValueOfString(value)
-	case "regen.ecocredit.v1.EventBridge.contract":
-		value := x.Contract
-		return protoreflect.ValueOfString(value)
-	case "regen.ecocredit.v1.EventBridge.amount":
-		value := x.Amount
+	case "regen.ecocredit.v1.EventAddCreditType.abbreviation":
+		value := x.Abbreviation
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridge"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventAddCreditType"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridge does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventAddCreditType does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7437,21 +7696,15 @@ func (x *fastReflection_EventBridge) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventBridge) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventAddCreditType) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventBridge.target":
-		x.Target = value.Interface().(string)
-	case "regen.ecocredit.v1.EventBridge.recipient":
-		x.Recipient = value.Interface().(string)
-	case "regen.ecocredit.v1.EventBridge.contract":
-		x.Contract = value.Interface().(string)
-	case "regen.ecocredit.v1.EventBridge.amount":
-		x.Amount = value.Interface().(string)
+	case "regen.ecocredit.v1.EventAddCreditType.abbreviation":
+		x.Abbreviation = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridge"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventAddCreditType"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridge does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventAddCreditType does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7465,52 +7718,40 @@ func (x *fastReflection_EventBridge) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventBridge) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventAddCreditType) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventBridge.target":
-		panic(fmt.Errorf("field target of message regen.ecocredit.v1.EventBridge is not mutable"))
-	case "regen.ecocredit.v1.EventBridge.recipient":
-		panic(fmt.Errorf("field recipient of message regen.ecocredit.v1.EventBridge is not mutable"))
-	case "regen.ecocredit.v1.EventBridge.contract":
-		panic(fmt.Errorf("field contract of message regen.ecocredit.v1.EventBridge is not mutable"))
-	case "regen.ecocredit.v1.EventBridge.amount":
-		panic(fmt.Errorf("field amount of message regen.ecocredit.v1.EventBridge is not mutable"))
+	case "regen.ecocredit.v1.EventAddCreditType.abbreviation":
+		panic(fmt.Errorf("field abbreviation of message regen.ecocredit.v1.EventAddCreditType is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridge"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventAddCreditType"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridge does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventAddCreditType does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventBridge) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventAddCreditType) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventBridge.target":
-		return protoreflect.ValueOfString("")
-	case "regen.ecocredit.v1.EventBridge.recipient":
-		return protoreflect.ValueOfString("")
-	case "regen.ecocredit.v1.EventBridge.contract":
-		return protoreflect.ValueOfString("")
-	case "regen.ecocredit.v1.EventBridge.amount":
+	case "regen.ecocredit.v1.EventAddCreditType.abbreviation":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridge"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventAddCreditType"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridge does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventAddCreditType does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventBridge) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventAddCreditType) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventBridge", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventAddCreditType", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7518,7 +7759,7 @@ func (x *fastReflection_EventBridge) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventBridge) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventAddCreditType) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7529,7 +7770,7 @@ func (x *fastReflection_EventBridge) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventBridge) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventAddCreditType) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7541,7 +7782,7 @@ func (x *fastReflection_EventBridge) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventBridge) IsValid() bool {
+func (x *fastReflection_EventAddCreditType) IsValid() bool {
 	return x != nil
 }
 
@@ -7551,9 +7792,9 @@ func (x *fastReflection_EventBridge) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventBridge) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventAddCreditType) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventBridge)
+		x := input.Message.Interface().(*EventAddCreditType)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7565,19 +7806,7 @@ func (x *fastReflection_EventBridge) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Target)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Recipient)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Contract)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Amount)
+		l = len(x.Abbreviation)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -7591,7 +7820,7 @@ func (x *fastReflection_EventBridge) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventBridge)
+		x := input.Message.Interface().(*EventAddCreditType)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7610,31 +7839,10 @@ func (x *fastReflection_EventBridge) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Amount) > 0 {
-			i -= len(x.Amount)
-			copy(dAtA[i:], x.Amount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.Contract) > 0 {
-			i -= len(x.Contract)
-			copy(dAtA[i:], x.Contract)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Contract)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.Recipient) > 0 {
-			i -= len(x.Recipient)
-			copy(dAtA[i:], x.Recipient)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Recipient)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Target) > 0 {
-			i -= len(x.Target)
-			copy(dAtA[i:], x.Target)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Target)))
+		if len(x.Abbreviation) > 0 {
+			i -= len(x.Abbreviation)
+			copy(dAtA[i:], x.Abbreviation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Abbreviation)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -7649,7 +7857,7 @@ func (x *fastReflection_EventBridge) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventBridge)
+		x := input.Message.Interface().(*EventAddCreditType)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7681,111 +7889,15 @@ func (x *fastReflection_EventBridge) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventBridge: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventAddCreditType: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventBridge: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventAddCreditType: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Target = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Recipient = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Contract = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Abbreviation", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7813,7 +7925,7 @@ func (x *fastReflection_EventBridge) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Amount = string(dAtA[iNdEx:postIndex])
+				x.Abbreviation = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -7851,27 +7963,29 @@ func (x *fastReflection_EventBridge) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_EventBridgeReceive             protoreflect.MessageDescriptor
-	fd_EventBridgeReceive_project_id  protoreflect.FieldDescriptor
-	fd_EventBridgeReceive_batch_denom protoreflect.FieldDescriptor
+	md_EventUpdateCreditTypePrecision                    protoreflect.MessageDescriptor
+	fd_EventUpdateCreditTypePrecision_abbreviation       protoreflect.FieldDescriptor
+	fd_EventUpdateCreditTypePrecision_previous_precision protoreflect.FieldDescriptor
+	fd_EventUpdateCreditTypePrecision_precision          protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_regen_ecocredit_v1_events_proto_init()
-	md_EventBridgeReceive = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventBridgeReceive")
-	fd_EventBridgeReceive_project_id = md_EventBridgeReceive.Fields().ByName("project_id")
-	fd_EventBridgeReceive_batch_denom = md_EventBridgeReceive.Fields().ByName("batch_denom")
+	md_EventUpdateCreditTypePrecision = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventUpdateCreditTypePrecision")
+	fd_EventUpdateCreditTypePrecision_abbreviation = md_EventUpdateCreditTypePrecision.Fields().ByName("abbreviation")
+	fd_EventUpdateCreditTypePrecision_previous_precision = md_EventUpdateCreditTypePrecision.Fields().ByName("previous_precision")
+	fd_EventUpdateCreditTypePrecision_precision = md_EventUpdateCreditTypePrecision.Fields().ByName("precision")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventBridgeReceive)(nil)
+var _ protoreflect.Message = (*fastReflection_EventUpdateCreditTypePrecision)(nil)
 
-type fastReflection_EventBridgeReceive EventBridgeReceive
+type fastReflection_EventUpdateCreditTypePrecision EventUpdateCreditTypePrecision
 
-func (x *EventBridgeReceive) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventBridgeReceive)(x)
+func (x *EventUpdateCreditTypePrecision) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventUpdateCreditTypePrecision)(x)
 }
 
-func (x *EventBridgeReceive) slowProtoReflect() protoreflect.Message {
+func (x *EventUpdateCreditTypePrecision) slowProtoReflect() protoreflect.Message {
 	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7883,43 +7997,43 @@ func (x *EventBridgeReceive) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventBridgeReceive_messageType fastReflection_EventBridgeReceive_messageType
-var _ protoreflect.MessageType = fastReflection_EventBridgeReceive_messageType{}
+var _fastReflection_EventUpdateCreditTypePrecision_messageType fastReflection_EventUpdateCreditTypePrecision_messageType
+var _ protoreflect.MessageType = fastReflection_EventUpdateCreditTypePrecision_messageType{}
 
-type fastReflection_EventBridgeReceive_messageType struct{}
+type fastReflection_EventUpdateCreditTypePrecision_messageType struct{}
 
-func (x fastReflection_EventBridgeReceive_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventBridgeReceive)(nil)
+func (x fastReflection_EventUpdateCreditTypePrecision_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventUpdateCreditTypePrecision)(nil)
 }
-func (x fastReflection_EventBridgeReceive_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventBridgeReceive)
+func (x fastReflection_EventUpdateCreditTypePrecision_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateCreditTypePrecision)
 }
-func (x fastReflection_EventBridgeReceive_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventBridgeReceive
+func (x fastReflection_EventUpdateCreditTypePrecision_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateCreditTypePrecision
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventBridgeReceive) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventBridgeReceive
+func (x *fastReflection_EventUpdateCreditTypePrecision) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateCreditTypePrecision
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventBridgeReceive) Type() protoreflect.MessageType {
-	return _fastReflection_EventBridgeReceive_messageType
+func (x *fastReflection_EventUpdateCreditTypePrecision) Type() protoreflect.MessageType {
+	return _fastReflection_EventUpdateCreditTypePrecision_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventBridgeReceive) New() protoreflect.Message {
-	return new(fastReflection_EventBridgeReceive)
+func (x *fastReflection_EventUpdateCreditTypePrecision) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateCreditTypePrecision)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventBridgeReceive) Interface() protoreflect.ProtoMessage {
-	return (*EventBridgeReceive)(x)
+func (x *fastReflection_EventUpdateCreditTypePrecision) Interface() protoreflect.ProtoMessage {
+	return (*EventUpdateCreditTypePrecision)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7927,16 +8041,22 @@ func (x *fastReflection_EventBridgeReceive) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventBridgeReceive) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ProjectId != "" {
-		value := protoreflect.ValueOfString(x.ProjectId)
-		if !f(fd_EventBridgeReceive_project_id, value) {
+func (x *fastReflection_EventUpdateCreditTypePrecision) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Abbreviation != "" {
+		value := protoreflect.ValueOfString(x.Abbreviation)
+		if !f(fd_EventUpdateCreditTypePrecision_abbreviation, value) {
 			return
 		}
 	}
-	if x.BatchDenom != "" {
-		value := protoreflect.ValueOfString(x.BatchDenom)
-		if !f(fd_EventBridgeReceive_batch_denom, value) {
+	if x.PreviousPrecision != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.PreviousPrecision)
+		if !f(fd_EventUpdateCreditTypePrecision_previous_precision, value) {
+			return
+		}
+	}
+	if x.Precision != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Precision)
+		if !f(fd_EventUpdateCreditTypePrecision_precision, value) {
 			return
 		}
 	}
@@ -7953,17 +8073,19 @@ func (x *fastReflection_EventBridgeReceive) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventBridgeReceive) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventUpdateCreditTypePrecision) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventBridgeReceive.project_id":
-		return x.ProjectId != ""
-	case "regen.ecocredit.v1.EventBridgeReceive.batch_denom":
-		return x.BatchDenom != ""
+	case "regen.ecocredit.v1.EventUpdateCreditTypePrecision.abbreviation":
+		return x.Abbreviation != ""
+	case "regen.ecocredit.v1.EventUpdateCreditTypePrecision.previous_precision":
+		return x.PreviousPrecision != uint32(0)
+	case "regen.ecocredit.v1.EventUpdateCreditTypePrecision.precision":
+		return x.Precision != uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeReceive"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateCreditTypePrecision"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeReceive does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateCreditTypePrecision does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7973,17 +8095,19 @@ func (x *fastReflection_EventBridgeReceive) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventBridgeReceive) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventUpdateCreditTypePrecision) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventBridgeReceive.project_id":
-		x.ProjectId = ""
-	case "regen.ecocredit.v1.EventBridgeReceive.batch_denom":
-		x.BatchDenom = ""
+	case "regen.ecocredit.v1.EventUpdateCreditTypePrecision.abbreviation":
+		x.Abbreviation = ""
+	case "regen.ecocredit.v1.EventUpdateCreditTypePrecision.previous_precision":
+		x.PreviousPrecision = uint32(0)
+	case "regen.ecocredit.v1.EventUpdateCreditTypePrecision.precision":
+		x.Precision = uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeReceive"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateCreditTypePrecision"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeReceive does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateCreditTypePrecision does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7993,19 +8117,22 @@ func (x *fastReflection_EventBridgeReceive) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventBridgeReceive) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventUpdateCreditTypePrecision) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "regen.ecocredit.v1.EventBridgeReceive.project_id":
-		value := x.ProjectId
-		return protoreflect.ValueOfString(value)
-	case "regen.ecocredit.v1.EventBridgeReceive.batch_denom":
-		value := x.BatchDenom
+	case "regen.ecocredit.v1.EventUpdateCreditTypePrecision.abbreviation":
+		value := x.Abbreviation
 		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventUpdateCreditTypePrecision.previous_precision":
+		value := x.PreviousPrecision
+		return protoreflect.ValueOfUint32(value)
+	case "regen.ecocredit.v1.EventUpdateCreditTypePrecision.precision":
+		value := x.Precision
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeReceive"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateCreditTypePrecision"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeReceive does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateCreditTypePrecision does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8019,17 +8146,19 @@ func (x *fastReflection_EventBridgeReceive) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventBridgeReceive) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventUpdateCreditTypePrecision) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventBridgeReceive.project_id":
-		x.ProjectId = value.Interface().(string)
-	case "regen.ecocredit.v1.EventBridgeReceive.batch_denom":
-		x.BatchDenom = value.Interface().(string)
+	case "regen.ecocredit.v1.EventUpdateCreditTypePrecision.abbreviation":
+		x.Abbreviation = value.Interface().(string)
+	case "regen.ecocredit.v1.EventUpdateCreditTypePrecision.previous_precision":
+		x.PreviousPrecision = uint32(value.Uint())
+	case "regen.ecocredit.v1.EventUpdateCreditTypePrecision.precision":
+		x.Precision = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeReceive"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateCreditTypePrecision"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeReceive does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateCreditTypePrecision does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8043,44 +8172,48 @@ func (x *fastReflection_EventBridgeReceive) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventBridgeReceive) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventUpdateCreditTypePrecision) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventBridgeReceive.project_id":
-		panic(fmt.Errorf("field project_id of message regen.ecocredit.v1.EventBridgeReceive is not mutable"))
-	case "regen.ecocredit.v1.EventBridgeReceive.batch_denom":
-		panic(fmt.Errorf("field batch_denom of message regen.ecocredit.v1.EventBridgeReceive is not mutable"))
+	case "regen.ecocredit.v1.EventUpdateCreditTypePrecision.abbreviation":
+		panic(fmt.Errorf("field abbreviation of message regen.ecocredit.v1.EventUpdateCreditTypePrecision is not mutable"))
+	case "regen.ecocredit.v1.EventUpdateCreditTypePrecision.previous_precision":
+		panic(fmt.Errorf("field previous_precision of message regen.ecocredit.v1.EventUpdateCreditTypePrecision is not mutable"))
+	case "regen.ecocredit.v1.EventUpdateCreditTypePrecision.precision":
+		panic(fmt.Errorf("field precision of message regen.ecocredit.v1.EventUpdateCreditTypePrecision is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeReceive"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateCreditTypePrecision"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeReceive does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateCreditTypePrecision does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventBridgeReceive) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventUpdateCreditTypePrecision) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventBridgeReceive.project_id":
-		return protoreflect.ValueOfString("")
-	case "regen.ecocredit.v1.EventBridgeReceive.batch_denom":
+	case "regen.ecocredit.v1.EventUpdateCreditTypePrecision.abbreviation":
 		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventUpdateCreditTypePrecision.previous_precision":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "regen.ecocredit.v1.EventUpdateCreditTypePrecision.precision":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeReceive"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateCreditTypePrecision"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeReceive does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateCreditTypePrecision does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventBridgeReceive) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventUpdateCreditTypePrecision) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventBridgeReceive", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventUpdateCreditTypePrecision", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8088,7 +8221,7 @@ func (x *fastReflection_EventBridgeReceive) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventBridgeReceive) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventUpdateCreditTypePrecision) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8099,7 +8232,7 @@ func (x *fastReflection_EventBridgeReceive) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventBridgeReceive) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventUpdateCreditTypePrecision) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8111,7 +8244,7 @@ func (x *fastReflection_EventBridgeReceive) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventBridgeReceive) IsValid() bool {
+func (x *fastReflection_EventUpdateCreditTypePrecision) IsValid() bool {
 	return x != nil
 }
 
@@ -8121,9 +8254,9 @@ func (x *fastReflection_EventBridgeReceive) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventBridgeReceive) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventUpdateCreditTypePrecision) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventBridgeReceive)
+		x := input.Message.Interface().(*EventUpdateCreditTypePrecision)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8135,13 +8268,15 @@ func (x *fastReflection_EventBridgeReceive) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.ProjectId)
+		l = len(x.Abbreviation)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.BatchDenom)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.PreviousPrecision != 0 {
+			n += 1 + runtime.Sov(uint64(x.PreviousPrecision))
+		}
+		if x.Precision != 0 {
+			n += 1 + runtime.Sov(uint64(x.Precision))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -8153,7 +8288,7 @@ func (x *fastReflection_EventBridgeReceive) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventBridgeReceive)
+		x := input.Message.Interface().(*EventUpdateCreditTypePrecision)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8172,17 +8307,20 @@ func (x *fastReflection_EventBridgeReceive) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.BatchDenom) > 0 {
-			i -= len(x.BatchDenom)
-			copy(dAtA[i:], x.BatchDenom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BatchDenom)))
+		if x.Precision != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Precision))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x18
 		}
-		if len(x.ProjectId) > 0 {
-			i -= len(x.ProjectId)
-			copy(dAtA[i:], x.ProjectId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProjectId)))
+		if x.PreviousPrecision != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PreviousPrecision))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Abbreviation) > 0 {
+			i -= len(x.Abbreviation)
+			copy(dAtA[i:], x.Abbreviation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Abbreviation)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -8197,7 +8335,7 @@ func (x *fastReflection_EventBridgeReceive) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventBridgeReceive)
+		x := input.Message.Interface().(*EventUpdateCreditTypePrecision)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8229,15 +8367,15 @@ func (x *fastReflection_EventBridgeReceive) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventBridgeReceive: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateCreditTypePrecision: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventBridgeReceive: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateCreditTypePrecision: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProjectId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Abbreviation", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -8265,13 +8403,13 @@ func (x *fastReflection_EventBridgeReceive) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ProjectId = string(dAtA[iNdEx:postIndex])
+				x.Abbreviation = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousPrecision", wireType)
 				}
-				var stringLen uint64
+				x.PreviousPrecision = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8281,24 +8419,30 @@ func (x *fastReflection_EventBridgeReceive) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.PreviousPrecision |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				x.Precision = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Precision |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				x.BatchDenom = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -8334,842 +8478,11364 @@ func (x *fastReflection_EventBridgeReceive) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: regen/ecocredit/v1/events.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_EventBridge            protoreflect.MessageDescriptor
+	fd_EventBridge_target     protoreflect.FieldDescriptor
+	fd_EventBridge_recipient  protoreflect.FieldDescriptor
+	fd_EventBridge_contract   protoreflect.FieldDescriptor
+	fd_EventBridge_amount     protoreflect.FieldDescriptor
+	fd_EventBridge_request_id protoreflect.FieldDescriptor
 )
 
-// EventCreateClass is an event emitted when a credit class is created.
-type EventCreateClass struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// class_id is the unique identifier of the credit class.
-	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
-}
-
-func (x *EventCreateClass) Reset() {
-	*x = EventCreateClass{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func init() {
+	file_regen_ecocredit_v1_events_proto_init()
+	md_EventBridge = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventBridge")
+	fd_EventBridge_target = md_EventBridge.Fields().ByName("target")
+	fd_EventBridge_recipient = md_EventBridge.Fields().ByName("recipient")
+	fd_EventBridge_contract = md_EventBridge.Fields().ByName("contract")
+	fd_EventBridge_amount = md_EventBridge.Fields().ByName("amount")
+	fd_EventBridge_request_id = md_EventBridge.Fields().ByName("request_id")
 }
 
-func (x *EventCreateClass) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _ protoreflect.Message = (*fastReflection_EventBridge)(nil)
 
-func (*EventCreateClass) ProtoMessage() {}
+type fastReflection_EventBridge EventBridge
 
-// Deprecated: Use EventCreateClass.ProtoReflect.Descriptor instead.
-func (*EventCreateClass) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{0}
+func (x *EventBridge) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventBridge)(x)
 }
 
-func (x *EventCreateClass) GetClassId() string {
-	if x != nil {
-		return x.ClassId
+func (x *EventBridge) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-// EventCreateProject is an event emitted when a project is created.
-type EventCreateProject struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+var _fastReflection_EventBridge_messageType fastReflection_EventBridge_messageType
+var _ protoreflect.MessageType = fastReflection_EventBridge_messageType{}
 
-	// project_id is the unique identifier of the project.
-	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-}
+type fastReflection_EventBridge_messageType struct{}
 
-func (x *EventCreateProject) Reset() {
-	*x = EventCreateProject{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x fastReflection_EventBridge_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventBridge)(nil)
 }
-
-func (x *EventCreateProject) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x fastReflection_EventBridge_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventBridge)
+}
+func (x fastReflection_EventBridge_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventBridge
 }
 
-func (*EventCreateProject) ProtoMessage() {}
-
-// Deprecated: Use EventCreateProject.ProtoReflect.Descriptor instead.
-func (*EventCreateProject) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{1}
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventBridge) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventBridge
 }
 
-func (x *EventCreateProject) GetProjectId() string {
-	if x != nil {
-		return x.ProjectId
-	}
-	return ""
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventBridge) Type() protoreflect.MessageType {
+	return _fastReflection_EventBridge_messageType
 }
 
-// EventCreateBatch is an event emitted when a credit batch is created.
-type EventCreateBatch struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventBridge) New() protoreflect.Message {
+	return new(fastReflection_EventBridge)
+}
 
-	// batch_denom is the unique identifier of the credit batch.
-	BatchDenom string `protobuf:"bytes,1,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
-	// origin_tx is the transaction from another chain or registry that triggered
-	// the creation of the credit batch.
-	OriginTx *OriginTx `protobuf:"bytes,2,opt,name=origin_tx,json=originTx,proto3" json:"origin_tx,omitempty"`
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventBridge) Interface() protoreflect.ProtoMessage {
+	return (*EventBridge)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventBridge) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Target != "" {
+		value := protoreflect.ValueOfString(x.Target)
+		if !f(fd_EventBridge_target, value) {
+			return
+		}
+	}
+	if x.Recipient != "" {
+		value := protoreflect.ValueOfString(x.Recipient)
+		if !f(fd_EventBridge_recipient, value) {
+			return
+		}
+	}
+	if x.Contract != "" {
+		value := protoreflect.ValueOfString(x.Contract)
+		if !f(fd_EventBridge_contract, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_EventBridge_amount, value) {
+			return
+		}
+	}
+	if x.RequestId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequestId)
+		if !f(fd_EventBridge_request_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventBridge) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventBridge.target":
+		return x.Target != ""
+	case "regen.ecocredit.v1.EventBridge.recipient":
+		return x.Recipient != ""
+	case "regen.ecocredit.v1.EventBridge.contract":
+		return x.Contract != ""
+	case "regen.ecocredit.v1.EventBridge.amount":
+		return x.Amount != ""
+	case "regen.ecocredit.v1.EventBridge.request_id":
+		return x.RequestId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridge"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridge does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventBridge) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventBridge.target":
+		x.Target = ""
+	case "regen.ecocredit.v1.EventBridge.recipient":
+		x.Recipient = ""
+	case "regen.ecocredit.v1.EventBridge.contract":
+		x.Contract = ""
+	case "regen.ecocredit.v1.EventBridge.amount":
+		x.Amount = ""
+	case "regen.ecocredit.v1.EventBridge.request_id":
+		x.RequestId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridge"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridge does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventBridge) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.v1.EventBridge.target":
+		value := x.Target
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventBridge.recipient":
+		value := x.Recipient
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventBridge.contract":
+		value := x.Contract
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventBridge.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventBridge.request_id":
+		value := x.RequestId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridge"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridge does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventBridge) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventBridge.target":
+		x.Target = value.Interface().(string)
+	case "regen.ecocredit.v1.EventBridge.recipient":
+		x.Recipient = value.Interface().(string)
+	case "regen.ecocredit.v1.EventBridge.contract":
+		x.Contract = value.Interface().(string)
+	case "regen.ecocredit.v1.EventBridge.amount":
+		x.Amount = value.Interface().(string)
+	case "regen.ecocredit.v1.EventBridge.request_id":
+		x.RequestId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridge"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridge does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventBridge) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventBridge.target":
+		panic(fmt.Errorf("field target of message regen.ecocredit.v1.EventBridge is not mutable"))
+	case "regen.ecocredit.v1.EventBridge.recipient":
+		panic(fmt.Errorf("field recipient of message regen.ecocredit.v1.EventBridge is not mutable"))
+	case "regen.ecocredit.v1.EventBridge.contract":
+		panic(fmt.Errorf("field contract of message regen.ecocredit.v1.EventBridge is not mutable"))
+	case "regen.ecocredit.v1.EventBridge.amount":
+		panic(fmt.Errorf("field amount of message regen.ecocredit.v1.EventBridge is not mutable"))
+	case "regen.ecocredit.v1.EventBridge.request_id":
+		panic(fmt.Errorf("field request_id of message regen.ecocredit.v1.EventBridge is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridge"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridge does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventBridge) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventBridge.target":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventBridge.recipient":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventBridge.contract":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventBridge.amount":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventBridge.request_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridge"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridge does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventBridge) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventBridge", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventBridge) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventBridge) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventBridge) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventBridge) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventBridge)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Target)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Recipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Contract)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RequestId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventBridge)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.RequestId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Contract) > 0 {
+			i -= len(x.Contract)
+			copy(dAtA[i:], x.Contract)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Contract)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Recipient) > 0 {
+			i -= len(x.Recipient)
+			copy(dAtA[i:], x.Recipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Recipient)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Target) > 0 {
+			i -= len(x.Target)
+			copy(dAtA[i:], x.Target)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Target)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventBridge)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventBridge: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventBridge: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Target = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Recipient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Contract = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				}
+				x.RequestId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventBridgeReceive             protoreflect.MessageDescriptor
+	fd_EventBridgeReceive_project_id  protoreflect.FieldDescriptor
+	fd_EventBridgeReceive_batch_denom protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_v1_events_proto_init()
+	md_EventBridgeReceive = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventBridgeReceive")
+	fd_EventBridgeReceive_project_id = md_EventBridgeReceive.Fields().ByName("project_id")
+	fd_EventBridgeReceive_batch_denom = md_EventBridgeReceive.Fields().ByName("batch_denom")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventBridgeReceive)(nil)
+
+type fastReflection_EventBridgeReceive EventBridgeReceive
+
+func (x *EventBridgeReceive) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventBridgeReceive)(x)
+}
+
+func (x *EventBridgeReceive) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventBridgeReceive_messageType fastReflection_EventBridgeReceive_messageType
+var _ protoreflect.MessageType = fastReflection_EventBridgeReceive_messageType{}
+
+type fastReflection_EventBridgeReceive_messageType struct{}
+
+func (x fastReflection_EventBridgeReceive_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventBridgeReceive)(nil)
+}
+func (x fastReflection_EventBridgeReceive_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventBridgeReceive)
+}
+func (x fastReflection_EventBridgeReceive_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventBridgeReceive
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventBridgeReceive) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventBridgeReceive
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventBridgeReceive) Type() protoreflect.MessageType {
+	return _fastReflection_EventBridgeReceive_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventBridgeReceive) New() protoreflect.Message {
+	return new(fastReflection_EventBridgeReceive)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventBridgeReceive) Interface() protoreflect.ProtoMessage {
+	return (*EventBridgeReceive)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventBridgeReceive) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProjectId != "" {
+		value := protoreflect.ValueOfString(x.ProjectId)
+		if !f(fd_EventBridgeReceive_project_id, value) {
+			return
+		}
+	}
+	if x.BatchDenom != "" {
+		value := protoreflect.ValueOfString(x.BatchDenom)
+		if !f(fd_EventBridgeReceive_batch_denom, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventBridgeReceive) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventBridgeReceive.project_id":
+		return x.ProjectId != ""
+	case "regen.ecocredit.v1.EventBridgeReceive.batch_denom":
+		return x.BatchDenom != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeReceive"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeReceive does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventBridgeReceive) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventBridgeReceive.project_id":
+		x.ProjectId = ""
+	case "regen.ecocredit.v1.EventBridgeReceive.batch_denom":
+		x.BatchDenom = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeReceive"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeReceive does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventBridgeReceive) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.v1.EventBridgeReceive.project_id":
+		value := x.ProjectId
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventBridgeReceive.batch_denom":
+		value := x.BatchDenom
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeReceive"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeReceive does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventBridgeReceive) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventBridgeReceive.project_id":
+		x.ProjectId = value.Interface().(string)
+	case "regen.ecocredit.v1.EventBridgeReceive.batch_denom":
+		x.BatchDenom = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeReceive"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeReceive does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventBridgeReceive) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventBridgeReceive.project_id":
+		panic(fmt.Errorf("field project_id of message regen.ecocredit.v1.EventBridgeReceive is not mutable"))
+	case "regen.ecocredit.v1.EventBridgeReceive.batch_denom":
+		panic(fmt.Errorf("field batch_denom of message regen.ecocredit.v1.EventBridgeReceive is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeReceive"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeReceive does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventBridgeReceive) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventBridgeReceive.project_id":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventBridgeReceive.batch_denom":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeReceive"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeReceive does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventBridgeReceive) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventBridgeReceive", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventBridgeReceive) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventBridgeReceive) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventBridgeReceive) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventBridgeReceive) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventBridgeReceive)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ProjectId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BatchDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventBridgeReceive)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.BatchDenom) > 0 {
+			i -= len(x.BatchDenom)
+			copy(dAtA[i:], x.BatchDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BatchDenom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ProjectId) > 0 {
+			i -= len(x.ProjectId)
+			copy(dAtA[i:], x.ProjectId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProjectId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventBridgeReceive)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventBridgeReceive: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventBridgeReceive: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProjectId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProjectId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BatchDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventApprove             protoreflect.MessageDescriptor
+	fd_EventApprove_owner       protoreflect.FieldDescriptor
+	fd_EventApprove_operator    protoreflect.FieldDescriptor
+	fd_EventApprove_batch_denom protoreflect.FieldDescriptor
+	fd_EventApprove_class_id    protoreflect.FieldDescriptor
+	fd_EventApprove_amount      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_v1_events_proto_init()
+	md_EventApprove = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventApprove")
+	fd_EventApprove_owner = md_EventApprove.Fields().ByName("owner")
+	fd_EventApprove_operator = md_EventApprove.Fields().ByName("operator")
+	fd_EventApprove_batch_denom = md_EventApprove.Fields().ByName("batch_denom")
+	fd_EventApprove_class_id = md_EventApprove.Fields().ByName("class_id")
+	fd_EventApprove_amount = md_EventApprove.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventApprove)(nil)
+
+type fastReflection_EventApprove EventApprove
+
+func (x *EventApprove) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventApprove)(x)
+}
+
+func (x *EventApprove) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventApprove_messageType fastReflection_EventApprove_messageType
+var _ protoreflect.MessageType = fastReflection_EventApprove_messageType{}
+
+type fastReflection_EventApprove_messageType struct{}
+
+func (x fastReflection_EventApprove_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventApprove)(nil)
+}
+func (x fastReflection_EventApprove_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventApprove)
+}
+func (x fastReflection_EventApprove_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventApprove
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventApprove) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventApprove
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventApprove) Type() protoreflect.MessageType {
+	return _fastReflection_EventApprove_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventApprove) New() protoreflect.Message {
+	return new(fastReflection_EventApprove)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventApprove) Interface() protoreflect.ProtoMessage {
+	return (*EventApprove)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventApprove) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_EventApprove_owner, value) {
+			return
+		}
+	}
+	if x.Operator != "" {
+		value := protoreflect.ValueOfString(x.Operator)
+		if !f(fd_EventApprove_operator, value) {
+			return
+		}
+	}
+	if x.BatchDenom != "" {
+		value := protoreflect.ValueOfString(x.BatchDenom)
+		if !f(fd_EventApprove_batch_denom, value) {
+			return
+		}
+	}
+	if x.ClassId != "" {
+		value := protoreflect.ValueOfString(x.ClassId)
+		if !f(fd_EventApprove_class_id, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_EventApprove_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventApprove) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventApprove.owner":
+		return x.Owner != ""
+	case "regen.ecocredit.v1.EventApprove.operator":
+		return x.Operator != ""
+	case "regen.ecocredit.v1.EventApprove.batch_denom":
+		return x.BatchDenom != ""
+	case "regen.ecocredit.v1.EventApprove.class_id":
+		return x.ClassId != ""
+	case "regen.ecocredit.v1.EventApprove.amount":
+		return x.Amount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventApprove"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventApprove does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventApprove) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventApprove.owner":
+		x.Owner = ""
+	case "regen.ecocredit.v1.EventApprove.operator":
+		x.Operator = ""
+	case "regen.ecocredit.v1.EventApprove.batch_denom":
+		x.BatchDenom = ""
+	case "regen.ecocredit.v1.EventApprove.class_id":
+		x.ClassId = ""
+	case "regen.ecocredit.v1.EventApprove.amount":
+		x.Amount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventApprove"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventApprove does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventApprove) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.v1.EventApprove.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventApprove.operator":
+		value := x.Operator
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventApprove.batch_denom":
+		value := x.BatchDenom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventApprove.class_id":
+		value := x.ClassId
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventApprove.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventApprove"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventApprove does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventApprove) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventApprove.owner":
+		x.Owner = value.Interface().(string)
+	case "regen.ecocredit.v1.EventApprove.operator":
+		x.Operator = value.Interface().(string)
+	case "regen.ecocredit.v1.EventApprove.batch_denom":
+		x.BatchDenom = value.Interface().(string)
+	case "regen.ecocredit.v1.EventApprove.class_id":
+		x.ClassId = value.Interface().(string)
+	case "regen.ecocredit.v1.EventApprove.amount":
+		x.Amount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventApprove"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventApprove does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventApprove) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventApprove.owner":
+		panic(fmt.Errorf("field owner of message regen.ecocredit.v1.EventApprove is not mutable"))
+	case "regen.ecocredit.v1.EventApprove.operator":
+		panic(fmt.Errorf("field operator of message regen.ecocredit.v1.EventApprove is not mutable"))
+	case "regen.ecocredit.v1.EventApprove.batch_denom":
+		panic(fmt.Errorf("field batch_denom of message regen.ecocredit.v1.EventApprove is not mutable"))
+	case "regen.ecocredit.v1.EventApprove.class_id":
+		panic(fmt.Errorf("field class_id of message regen.ecocredit.v1.EventApprove is not mutable"))
+	case "regen.ecocredit.v1.EventApprove.amount":
+		panic(fmt.Errorf("field amount of message regen.ecocredit.v1.EventApprove is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventApprove"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventApprove does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventApprove) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventApprove.owner":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventApprove.operator":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventApprove.batch_denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventApprove.class_id":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventApprove.amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventApprove"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventApprove does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventApprove) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventApprove", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventApprove) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventApprove) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventApprove) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventApprove) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventApprove)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Operator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BatchDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ClassId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventApprove)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.ClassId) > 0 {
+			i -= len(x.ClassId)
+			copy(dAtA[i:], x.ClassId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClassId)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.BatchDenom) > 0 {
+			i -= len(x.BatchDenom)
+			copy(dAtA[i:], x.BatchDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BatchDenom)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Operator) > 0 {
+			i -= len(x.Operator)
+			copy(dAtA[i:], x.Operator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Operator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventApprove)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventApprove: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventApprove: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Operator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BatchDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClassId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventRevokeApproval             protoreflect.MessageDescriptor
+	fd_EventRevokeApproval_owner       protoreflect.FieldDescriptor
+	fd_EventRevokeApproval_operator    protoreflect.FieldDescriptor
+	fd_EventRevokeApproval_batch_denom protoreflect.FieldDescriptor
+	fd_EventRevokeApproval_class_id    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_v1_events_proto_init()
+	md_EventRevokeApproval = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventRevokeApproval")
+	fd_EventRevokeApproval_owner = md_EventRevokeApproval.Fields().ByName("owner")
+	fd_EventRevokeApproval_operator = md_EventRevokeApproval.Fields().ByName("operator")
+	fd_EventRevokeApproval_batch_denom = md_EventRevokeApproval.Fields().ByName("batch_denom")
+	fd_EventRevokeApproval_class_id = md_EventRevokeApproval.Fields().ByName("class_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventRevokeApproval)(nil)
+
+type fastReflection_EventRevokeApproval EventRevokeApproval
+
+func (x *EventRevokeApproval) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventRevokeApproval)(x)
+}
+
+func (x *EventRevokeApproval) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventRevokeApproval_messageType fastReflection_EventRevokeApproval_messageType
+var _ protoreflect.MessageType = fastReflection_EventRevokeApproval_messageType{}
+
+type fastReflection_EventRevokeApproval_messageType struct{}
+
+func (x fastReflection_EventRevokeApproval_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventRevokeApproval)(nil)
+}
+func (x fastReflection_EventRevokeApproval_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventRevokeApproval)
+}
+func (x fastReflection_EventRevokeApproval_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventRevokeApproval
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventRevokeApproval) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventRevokeApproval
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventRevokeApproval) Type() protoreflect.MessageType {
+	return _fastReflection_EventRevokeApproval_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventRevokeApproval) New() protoreflect.Message {
+	return new(fastReflection_EventRevokeApproval)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventRevokeApproval) Interface() protoreflect.ProtoMessage {
+	return (*EventRevokeApproval)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventRevokeApproval) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_EventRevokeApproval_owner, value) {
+			return
+		}
+	}
+	if x.Operator != "" {
+		value := protoreflect.ValueOfString(x.Operator)
+		if !f(fd_EventRevokeApproval_operator, value) {
+			return
+		}
+	}
+	if x.BatchDenom != "" {
+		value := protoreflect.ValueOfString(x.BatchDenom)
+		if !f(fd_EventRevokeApproval_batch_denom, value) {
+			return
+		}
+	}
+	if x.ClassId != "" {
+		value := protoreflect.ValueOfString(x.ClassId)
+		if !f(fd_EventRevokeApproval_class_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventRevokeApproval) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventRevokeApproval.owner":
+		return x.Owner != ""
+	case "regen.ecocredit.v1.EventRevokeApproval.operator":
+		return x.Operator != ""
+	case "regen.ecocredit.v1.EventRevokeApproval.batch_denom":
+		return x.BatchDenom != ""
+	case "regen.ecocredit.v1.EventRevokeApproval.class_id":
+		return x.ClassId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventRevokeApproval"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventRevokeApproval does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventRevokeApproval) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventRevokeApproval.owner":
+		x.Owner = ""
+	case "regen.ecocredit.v1.EventRevokeApproval.operator":
+		x.Operator = ""
+	case "regen.ecocredit.v1.EventRevokeApproval.batch_denom":
+		x.BatchDenom = ""
+	case "regen.ecocredit.v1.EventRevokeApproval.class_id":
+		x.ClassId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventRevokeApproval"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventRevokeApproval does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventRevokeApproval) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.v1.EventRevokeApproval.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventRevokeApproval.operator":
+		value := x.Operator
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventRevokeApproval.batch_denom":
+		value := x.BatchDenom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventRevokeApproval.class_id":
+		value := x.ClassId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventRevokeApproval"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventRevokeApproval does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventRevokeApproval) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventRevokeApproval.owner":
+		x.Owner = value.Interface().(string)
+	case "regen.ecocredit.v1.EventRevokeApproval.operator":
+		x.Operator = value.Interface().(string)
+	case "regen.ecocredit.v1.EventRevokeApproval.batch_denom":
+		x.BatchDenom = value.Interface().(string)
+	case "regen.ecocredit.v1.EventRevokeApproval.class_id":
+		x.ClassId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventRevokeApproval"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventRevokeApproval does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventRevokeApproval) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventRevokeApproval.owner":
+		panic(fmt.Errorf("field owner of message regen.ecocredit.v1.EventRevokeApproval is not mutable"))
+	case "regen.ecocredit.v1.EventRevokeApproval.operator":
+		panic(fmt.Errorf("field operator of message regen.ecocredit.v1.EventRevokeApproval is not mutable"))
+	case "regen.ecocredit.v1.EventRevokeApproval.batch_denom":
+		panic(fmt.Errorf("field batch_denom of message regen.ecocredit.v1.EventRevokeApproval is not mutable"))
+	case "regen.ecocredit.v1.EventRevokeApproval.class_id":
+		panic(fmt.Errorf("field class_id of message regen.ecocredit.v1.EventRevokeApproval is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventRevokeApproval"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventRevokeApproval does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventRevokeApproval) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventRevokeApproval.owner":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventRevokeApproval.operator":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventRevokeApproval.batch_denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventRevokeApproval.class_id":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventRevokeApproval"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventRevokeApproval does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventRevokeApproval) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventRevokeApproval", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventRevokeApproval) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventRevokeApproval) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventRevokeApproval) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventRevokeApproval) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventRevokeApproval)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Operator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BatchDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ClassId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventRevokeApproval)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ClassId) > 0 {
+			i -= len(x.ClassId)
+			copy(dAtA[i:], x.ClassId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClassId)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.BatchDenom) > 0 {
+			i -= len(x.BatchDenom)
+			copy(dAtA[i:], x.BatchDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BatchDenom)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Operator) > 0 {
+			i -= len(x.Operator)
+			copy(dAtA[i:], x.Operator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Operator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventRevokeApproval)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventRevokeApproval: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventRevokeApproval: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Operator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BatchDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClassId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventLock             protoreflect.MessageDescriptor
+	fd_EventLock_lock_id     protoreflect.FieldDescriptor
+	fd_EventLock_owner       protoreflect.FieldDescriptor
+	fd_EventLock_batch_denom protoreflect.FieldDescriptor
+	fd_EventLock_amount      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_v1_events_proto_init()
+	md_EventLock = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventLock")
+	fd_EventLock_lock_id = md_EventLock.Fields().ByName("lock_id")
+	fd_EventLock_owner = md_EventLock.Fields().ByName("owner")
+	fd_EventLock_batch_denom = md_EventLock.Fields().ByName("batch_denom")
+	fd_EventLock_amount = md_EventLock.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventLock)(nil)
+
+type fastReflection_EventLock EventLock
+
+func (x *EventLock) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventLock)(x)
+}
+
+func (x *EventLock) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventLock_messageType fastReflection_EventLock_messageType
+var _ protoreflect.MessageType = fastReflection_EventLock_messageType{}
+
+type fastReflection_EventLock_messageType struct{}
+
+func (x fastReflection_EventLock_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventLock)(nil)
+}
+func (x fastReflection_EventLock_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventLock)
+}
+func (x fastReflection_EventLock_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLock
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventLock) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventLock
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventLock) Type() protoreflect.MessageType {
+	return _fastReflection_EventLock_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventLock) New() protoreflect.Message {
+	return new(fastReflection_EventLock)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventLock) Interface() protoreflect.ProtoMessage {
+	return (*EventLock)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventLock) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LockId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LockId)
+		if !f(fd_EventLock_lock_id, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_EventLock_owner, value) {
+			return
+		}
+	}
+	if x.BatchDenom != "" {
+		value := protoreflect.ValueOfString(x.BatchDenom)
+		if !f(fd_EventLock_batch_denom, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_EventLock_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventLock) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventLock.lock_id":
+		return x.LockId != uint64(0)
+	case "regen.ecocredit.v1.EventLock.owner":
+		return x.Owner != ""
+	case "regen.ecocredit.v1.EventLock.batch_denom":
+		return x.BatchDenom != ""
+	case "regen.ecocredit.v1.EventLock.amount":
+		return x.Amount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventLock"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventLock does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLock) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventLock.lock_id":
+		x.LockId = uint64(0)
+	case "regen.ecocredit.v1.EventLock.owner":
+		x.Owner = ""
+	case "regen.ecocredit.v1.EventLock.batch_denom":
+		x.BatchDenom = ""
+	case "regen.ecocredit.v1.EventLock.amount":
+		x.Amount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventLock"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventLock does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventLock) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.v1.EventLock.lock_id":
+		value := x.LockId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.v1.EventLock.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventLock.batch_denom":
+		value := x.BatchDenom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventLock.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventLock"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventLock does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLock) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventLock.lock_id":
+		x.LockId = value.Uint()
+	case "regen.ecocredit.v1.EventLock.owner":
+		x.Owner = value.Interface().(string)
+	case "regen.ecocredit.v1.EventLock.batch_denom":
+		x.BatchDenom = value.Interface().(string)
+	case "regen.ecocredit.v1.EventLock.amount":
+		x.Amount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventLock"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventLock does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLock) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventLock.lock_id":
+		panic(fmt.Errorf("field lock_id of message regen.ecocredit.v1.EventLock is not mutable"))
+	case "regen.ecocredit.v1.EventLock.owner":
+		panic(fmt.Errorf("field owner of message regen.ecocredit.v1.EventLock is not mutable"))
+	case "regen.ecocredit.v1.EventLock.batch_denom":
+		panic(fmt.Errorf("field batch_denom of message regen.ecocredit.v1.EventLock is not mutable"))
+	case "regen.ecocredit.v1.EventLock.amount":
+		panic(fmt.Errorf("field amount of message regen.ecocredit.v1.EventLock is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventLock"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventLock does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventLock) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventLock.lock_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.v1.EventLock.owner":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventLock.batch_denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventLock.amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventLock"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventLock does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventLock) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventLock", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventLock) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventLock) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventLock) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventLock) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventLock)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.LockId != 0 {
+			n += 1 + runtime.Sov(uint64(x.LockId))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BatchDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventLock)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.BatchDenom) > 0 {
+			i -= len(x.BatchDenom)
+			copy(dAtA[i:], x.BatchDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BatchDenom)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.LockId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LockId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventLock)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLock: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventLock: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+				}
+				x.LockId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LockId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BatchDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventUnlock             protoreflect.MessageDescriptor
+	fd_EventUnlock_lock_id     protoreflect.FieldDescriptor
+	fd_EventUnlock_owner       protoreflect.FieldDescriptor
+	fd_EventUnlock_batch_denom protoreflect.FieldDescriptor
+	fd_EventUnlock_amount      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_v1_events_proto_init()
+	md_EventUnlock = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventUnlock")
+	fd_EventUnlock_lock_id = md_EventUnlock.Fields().ByName("lock_id")
+	fd_EventUnlock_owner = md_EventUnlock.Fields().ByName("owner")
+	fd_EventUnlock_batch_denom = md_EventUnlock.Fields().ByName("batch_denom")
+	fd_EventUnlock_amount = md_EventUnlock.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventUnlock)(nil)
+
+type fastReflection_EventUnlock EventUnlock
+
+func (x *EventUnlock) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventUnlock)(x)
+}
+
+func (x *EventUnlock) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventUnlock_messageType fastReflection_EventUnlock_messageType
+var _ protoreflect.MessageType = fastReflection_EventUnlock_messageType{}
+
+type fastReflection_EventUnlock_messageType struct{}
+
+func (x fastReflection_EventUnlock_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventUnlock)(nil)
+}
+func (x fastReflection_EventUnlock_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventUnlock)
+}
+func (x fastReflection_EventUnlock_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUnlock
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventUnlock) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUnlock
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventUnlock) Type() protoreflect.MessageType {
+	return _fastReflection_EventUnlock_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventUnlock) New() protoreflect.Message {
+	return new(fastReflection_EventUnlock)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventUnlock) Interface() protoreflect.ProtoMessage {
+	return (*EventUnlock)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventUnlock) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LockId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LockId)
+		if !f(fd_EventUnlock_lock_id, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_EventUnlock_owner, value) {
+			return
+		}
+	}
+	if x.BatchDenom != "" {
+		value := protoreflect.ValueOfString(x.BatchDenom)
+		if !f(fd_EventUnlock_batch_denom, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_EventUnlock_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventUnlock) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventUnlock.lock_id":
+		return x.LockId != uint64(0)
+	case "regen.ecocredit.v1.EventUnlock.owner":
+		return x.Owner != ""
+	case "regen.ecocredit.v1.EventUnlock.batch_denom":
+		return x.BatchDenom != ""
+	case "regen.ecocredit.v1.EventUnlock.amount":
+		return x.Amount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUnlock"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUnlock does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUnlock) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventUnlock.lock_id":
+		x.LockId = uint64(0)
+	case "regen.ecocredit.v1.EventUnlock.owner":
+		x.Owner = ""
+	case "regen.ecocredit.v1.EventUnlock.batch_denom":
+		x.BatchDenom = ""
+	case "regen.ecocredit.v1.EventUnlock.amount":
+		x.Amount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUnlock"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUnlock does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventUnlock) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.v1.EventUnlock.lock_id":
+		value := x.LockId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.v1.EventUnlock.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventUnlock.batch_denom":
+		value := x.BatchDenom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventUnlock.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUnlock"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUnlock does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUnlock) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventUnlock.lock_id":
+		x.LockId = value.Uint()
+	case "regen.ecocredit.v1.EventUnlock.owner":
+		x.Owner = value.Interface().(string)
+	case "regen.ecocredit.v1.EventUnlock.batch_denom":
+		x.BatchDenom = value.Interface().(string)
+	case "regen.ecocredit.v1.EventUnlock.amount":
+		x.Amount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUnlock"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUnlock does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUnlock) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventUnlock.lock_id":
+		panic(fmt.Errorf("field lock_id of message regen.ecocredit.v1.EventUnlock is not mutable"))
+	case "regen.ecocredit.v1.EventUnlock.owner":
+		panic(fmt.Errorf("field owner of message regen.ecocredit.v1.EventUnlock is not mutable"))
+	case "regen.ecocredit.v1.EventUnlock.batch_denom":
+		panic(fmt.Errorf("field batch_denom of message regen.ecocredit.v1.EventUnlock is not mutable"))
+	case "regen.ecocredit.v1.EventUnlock.amount":
+		panic(fmt.Errorf("field amount of message regen.ecocredit.v1.EventUnlock is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUnlock"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUnlock does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventUnlock) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventUnlock.lock_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.v1.EventUnlock.owner":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventUnlock.batch_denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventUnlock.amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUnlock"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUnlock does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventUnlock) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventUnlock", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventUnlock) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUnlock) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventUnlock) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventUnlock) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventUnlock)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.LockId != 0 {
+			n += 1 + runtime.Sov(uint64(x.LockId))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BatchDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventUnlock)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.BatchDenom) > 0 {
+			i -= len(x.BatchDenom)
+			copy(dAtA[i:], x.BatchDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BatchDenom)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.LockId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LockId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventUnlock)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUnlock: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUnlock: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+				}
+				x.LockId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LockId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BatchDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventUpdateClassBuffer            protoreflect.MessageDescriptor
+	fd_EventUpdateClassBuffer_class_id   protoreflect.FieldDescriptor
+	fd_EventUpdateClassBuffer_percentage protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_v1_events_proto_init()
+	md_EventUpdateClassBuffer = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventUpdateClassBuffer")
+	fd_EventUpdateClassBuffer_class_id = md_EventUpdateClassBuffer.Fields().ByName("class_id")
+	fd_EventUpdateClassBuffer_percentage = md_EventUpdateClassBuffer.Fields().ByName("percentage")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventUpdateClassBuffer)(nil)
+
+type fastReflection_EventUpdateClassBuffer EventUpdateClassBuffer
+
+func (x *EventUpdateClassBuffer) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventUpdateClassBuffer)(x)
+}
+
+func (x *EventUpdateClassBuffer) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventUpdateClassBuffer_messageType fastReflection_EventUpdateClassBuffer_messageType
+var _ protoreflect.MessageType = fastReflection_EventUpdateClassBuffer_messageType{}
+
+type fastReflection_EventUpdateClassBuffer_messageType struct{}
+
+func (x fastReflection_EventUpdateClassBuffer_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventUpdateClassBuffer)(nil)
+}
+func (x fastReflection_EventUpdateClassBuffer_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateClassBuffer)
+}
+func (x fastReflection_EventUpdateClassBuffer_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateClassBuffer
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventUpdateClassBuffer) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateClassBuffer
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventUpdateClassBuffer) Type() protoreflect.MessageType {
+	return _fastReflection_EventUpdateClassBuffer_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventUpdateClassBuffer) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateClassBuffer)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventUpdateClassBuffer) Interface() protoreflect.ProtoMessage {
+	return (*EventUpdateClassBuffer)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventUpdateClassBuffer) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ClassId != "" {
+		value := protoreflect.ValueOfString(x.ClassId)
+		if !f(fd_EventUpdateClassBuffer_class_id, value) {
+			return
+		}
+	}
+	if x.Percentage != "" {
+		value := protoreflect.ValueOfString(x.Percentage)
+		if !f(fd_EventUpdateClassBuffer_percentage, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventUpdateClassBuffer) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventUpdateClassBuffer.class_id":
+		return x.ClassId != ""
+	case "regen.ecocredit.v1.EventUpdateClassBuffer.percentage":
+		return x.Percentage != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassBuffer"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassBuffer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateClassBuffer) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventUpdateClassBuffer.class_id":
+		x.ClassId = ""
+	case "regen.ecocredit.v1.EventUpdateClassBuffer.percentage":
+		x.Percentage = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassBuffer"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassBuffer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventUpdateClassBuffer) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.v1.EventUpdateClassBuffer.class_id":
+		value := x.ClassId
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventUpdateClassBuffer.percentage":
+		value := x.Percentage
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassBuffer"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassBuffer does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateClassBuffer) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventUpdateClassBuffer.class_id":
+		x.ClassId = value.Interface().(string)
+	case "regen.ecocredit.v1.EventUpdateClassBuffer.percentage":
+		x.Percentage = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassBuffer"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassBuffer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateClassBuffer) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventUpdateClassBuffer.class_id":
+		panic(fmt.Errorf("field class_id of message regen.ecocredit.v1.EventUpdateClassBuffer is not mutable"))
+	case "regen.ecocredit.v1.EventUpdateClassBuffer.percentage":
+		panic(fmt.Errorf("field percentage of message regen.ecocredit.v1.EventUpdateClassBuffer is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassBuffer"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassBuffer does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventUpdateClassBuffer) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventUpdateClassBuffer.class_id":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventUpdateClassBuffer.percentage":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassBuffer"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassBuffer does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventUpdateClassBuffer) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventUpdateClassBuffer", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventUpdateClassBuffer) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateClassBuffer) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventUpdateClassBuffer) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventUpdateClassBuffer) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventUpdateClassBuffer)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ClassId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Percentage)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventUpdateClassBuffer)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Percentage) > 0 {
+			i -= len(x.Percentage)
+			copy(dAtA[i:], x.Percentage)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Percentage)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ClassId) > 0 {
+			i -= len(x.ClassId)
+			copy(dAtA[i:], x.ClassId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClassId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventUpdateClassBuffer)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateClassBuffer: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateClassBuffer: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClassId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Percentage = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventReversal             protoreflect.MessageDescriptor
+	fd_EventReversal_reversal_id protoreflect.FieldDescriptor
+	fd_EventReversal_project_id  protoreflect.FieldDescriptor
+	fd_EventReversal_batch_denom protoreflect.FieldDescriptor
+	fd_EventReversal_amount      protoreflect.FieldDescriptor
+	fd_EventReversal_evidence    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_v1_events_proto_init()
+	md_EventReversal = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventReversal")
+	fd_EventReversal_reversal_id = md_EventReversal.Fields().ByName("reversal_id")
+	fd_EventReversal_project_id = md_EventReversal.Fields().ByName("project_id")
+	fd_EventReversal_batch_denom = md_EventReversal.Fields().ByName("batch_denom")
+	fd_EventReversal_amount = md_EventReversal.Fields().ByName("amount")
+	fd_EventReversal_evidence = md_EventReversal.Fields().ByName("evidence")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventReversal)(nil)
+
+type fastReflection_EventReversal EventReversal
+
+func (x *EventReversal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventReversal)(x)
+}
+
+func (x *EventReversal) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventReversal_messageType fastReflection_EventReversal_messageType
+var _ protoreflect.MessageType = fastReflection_EventReversal_messageType{}
+
+type fastReflection_EventReversal_messageType struct{}
+
+func (x fastReflection_EventReversal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventReversal)(nil)
+}
+func (x fastReflection_EventReversal_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventReversal)
+}
+func (x fastReflection_EventReversal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventReversal
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventReversal) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventReversal
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventReversal) Type() protoreflect.MessageType {
+	return _fastReflection_EventReversal_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventReversal) New() protoreflect.Message {
+	return new(fastReflection_EventReversal)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventReversal) Interface() protoreflect.ProtoMessage {
+	return (*EventReversal)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventReversal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ReversalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReversalId)
+		if !f(fd_EventReversal_reversal_id, value) {
+			return
+		}
+	}
+	if x.ProjectId != "" {
+		value := protoreflect.ValueOfString(x.ProjectId)
+		if !f(fd_EventReversal_project_id, value) {
+			return
+		}
+	}
+	if x.BatchDenom != "" {
+		value := protoreflect.ValueOfString(x.BatchDenom)
+		if !f(fd_EventReversal_batch_denom, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_EventReversal_amount, value) {
+			return
+		}
+	}
+	if x.Evidence != "" {
+		value := protoreflect.ValueOfString(x.Evidence)
+		if !f(fd_EventReversal_evidence, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventReversal) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventReversal.reversal_id":
+		return x.ReversalId != uint64(0)
+	case "regen.ecocredit.v1.EventReversal.project_id":
+		return x.ProjectId != ""
+	case "regen.ecocredit.v1.EventReversal.batch_denom":
+		return x.BatchDenom != ""
+	case "regen.ecocredit.v1.EventReversal.amount":
+		return x.Amount != ""
+	case "regen.ecocredit.v1.EventReversal.evidence":
+		return x.Evidence != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventReversal"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventReversal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventReversal) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventReversal.reversal_id":
+		x.ReversalId = uint64(0)
+	case "regen.ecocredit.v1.EventReversal.project_id":
+		x.ProjectId = ""
+	case "regen.ecocredit.v1.EventReversal.batch_denom":
+		x.BatchDenom = ""
+	case "regen.ecocredit.v1.EventReversal.amount":
+		x.Amount = ""
+	case "regen.ecocredit.v1.EventReversal.evidence":
+		x.Evidence = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventReversal"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventReversal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventReversal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.v1.EventReversal.reversal_id":
+		value := x.ReversalId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.v1.EventReversal.project_id":
+		value := x.ProjectId
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventReversal.batch_denom":
+		value := x.BatchDenom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventReversal.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventReversal.evidence":
+		value := x.Evidence
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventReversal"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventReversal does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventReversal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventReversal.reversal_id":
+		x.ReversalId = value.Uint()
+	case "regen.ecocredit.v1.EventReversal.project_id":
+		x.ProjectId = value.Interface().(string)
+	case "regen.ecocredit.v1.EventReversal.batch_denom":
+		x.BatchDenom = value.Interface().(string)
+	case "regen.ecocredit.v1.EventReversal.amount":
+		x.Amount = value.Interface().(string)
+	case "regen.ecocredit.v1.EventReversal.evidence":
+		x.Evidence = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventReversal"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventReversal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventReversal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventReversal.reversal_id":
+		panic(fmt.Errorf("field reversal_id of message regen.ecocredit.v1.EventReversal is not mutable"))
+	case "regen.ecocredit.v1.EventReversal.project_id":
+		panic(fmt.Errorf("field project_id of message regen.ecocredit.v1.EventReversal is not mutable"))
+	case "regen.ecocredit.v1.EventReversal.batch_denom":
+		panic(fmt.Errorf("field batch_denom of message regen.ecocredit.v1.EventReversal is not mutable"))
+	case "regen.ecocredit.v1.EventReversal.amount":
+		panic(fmt.Errorf("field amount of message regen.ecocredit.v1.EventReversal is not mutable"))
+	case "regen.ecocredit.v1.EventReversal.evidence":
+		panic(fmt.Errorf("field evidence of message regen.ecocredit.v1.EventReversal is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventReversal"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventReversal does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventReversal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventReversal.reversal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.v1.EventReversal.project_id":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventReversal.batch_denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventReversal.amount":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventReversal.evidence":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventReversal"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventReversal does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventReversal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventReversal", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventReversal) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventReversal) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventReversal) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventReversal) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventReversal)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ReversalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReversalId))
+		}
+		l = len(x.ProjectId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BatchDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Evidence)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventReversal)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Evidence) > 0 {
+			i -= len(x.Evidence)
+			copy(dAtA[i:], x.Evidence)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Evidence)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.BatchDenom) > 0 {
+			i -= len(x.BatchDenom)
+			copy(dAtA[i:], x.BatchDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BatchDenom)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ProjectId) > 0 {
+			i -= len(x.ProjectId)
+			copy(dAtA[i:], x.ProjectId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProjectId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.ReversalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReversalId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventReversal)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventReversal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventReversal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReversalId", wireType)
+				}
+				x.ReversalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReversalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProjectId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProjectId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BatchDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Evidence = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventFreezeBatch             protoreflect.MessageDescriptor
+	fd_EventFreezeBatch_batch_denom protoreflect.FieldDescriptor
+	fd_EventFreezeBatch_evidence    protoreflect.FieldDescriptor
+	fd_EventFreezeBatch_reason      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_v1_events_proto_init()
+	md_EventFreezeBatch = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventFreezeBatch")
+	fd_EventFreezeBatch_batch_denom = md_EventFreezeBatch.Fields().ByName("batch_denom")
+	fd_EventFreezeBatch_evidence = md_EventFreezeBatch.Fields().ByName("evidence")
+	fd_EventFreezeBatch_reason = md_EventFreezeBatch.Fields().ByName("reason")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventFreezeBatch)(nil)
+
+type fastReflection_EventFreezeBatch EventFreezeBatch
+
+func (x *EventFreezeBatch) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventFreezeBatch)(x)
+}
+
+func (x *EventFreezeBatch) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventFreezeBatch_messageType fastReflection_EventFreezeBatch_messageType
+var _ protoreflect.MessageType = fastReflection_EventFreezeBatch_messageType{}
+
+type fastReflection_EventFreezeBatch_messageType struct{}
+
+func (x fastReflection_EventFreezeBatch_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventFreezeBatch)(nil)
+}
+func (x fastReflection_EventFreezeBatch_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventFreezeBatch)
+}
+func (x fastReflection_EventFreezeBatch_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventFreezeBatch
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventFreezeBatch) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventFreezeBatch
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventFreezeBatch) Type() protoreflect.MessageType {
+	return _fastReflection_EventFreezeBatch_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventFreezeBatch) New() protoreflect.Message {
+	return new(fastReflection_EventFreezeBatch)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventFreezeBatch) Interface() protoreflect.ProtoMessage {
+	return (*EventFreezeBatch)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventFreezeBatch) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BatchDenom != "" {
+		value := protoreflect.ValueOfString(x.BatchDenom)
+		if !f(fd_EventFreezeBatch_batch_denom, value) {
+			return
+		}
+	}
+	if x.Evidence != "" {
+		value := protoreflect.ValueOfString(x.Evidence)
+		if !f(fd_EventFreezeBatch_evidence, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_EventFreezeBatch_reason, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventFreezeBatch) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventFreezeBatch.batch_denom":
+		return x.BatchDenom != ""
+	case "regen.ecocredit.v1.EventFreezeBatch.evidence":
+		return x.Evidence != ""
+	case "regen.ecocredit.v1.EventFreezeBatch.reason":
+		return x.Reason != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventFreezeBatch"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventFreezeBatch does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventFreezeBatch) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventFreezeBatch.batch_denom":
+		x.BatchDenom = ""
+	case "regen.ecocredit.v1.EventFreezeBatch.evidence":
+		x.Evidence = ""
+	case "regen.ecocredit.v1.EventFreezeBatch.reason":
+		x.Reason = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventFreezeBatch"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventFreezeBatch does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventFreezeBatch) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.v1.EventFreezeBatch.batch_denom":
+		value := x.BatchDenom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventFreezeBatch.evidence":
+		value := x.Evidence
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventFreezeBatch.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventFreezeBatch"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventFreezeBatch does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventFreezeBatch) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventFreezeBatch.batch_denom":
+		x.BatchDenom = value.Interface().(string)
+	case "regen.ecocredit.v1.EventFreezeBatch.evidence":
+		x.Evidence = value.Interface().(string)
+	case "regen.ecocredit.v1.EventFreezeBatch.reason":
+		x.Reason = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventFreezeBatch"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventFreezeBatch does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventFreezeBatch) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventFreezeBatch.batch_denom":
+		panic(fmt.Errorf("field batch_denom of message regen.ecocredit.v1.EventFreezeBatch is not mutable"))
+	case "regen.ecocredit.v1.EventFreezeBatch.evidence":
+		panic(fmt.Errorf("field evidence of message regen.ecocredit.v1.EventFreezeBatch is not mutable"))
+	case "regen.ecocredit.v1.EventFreezeBatch.reason":
+		panic(fmt.Errorf("field reason of message regen.ecocredit.v1.EventFreezeBatch is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventFreezeBatch"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventFreezeBatch does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventFreezeBatch) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventFreezeBatch.batch_denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventFreezeBatch.evidence":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventFreezeBatch.reason":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventFreezeBatch"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventFreezeBatch does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventFreezeBatch) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventFreezeBatch", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventFreezeBatch) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventFreezeBatch) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventFreezeBatch) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventFreezeBatch) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventFreezeBatch)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.BatchDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Evidence)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventFreezeBatch)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Evidence) > 0 {
+			i -= len(x.Evidence)
+			copy(dAtA[i:], x.Evidence)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Evidence)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.BatchDenom) > 0 {
+			i -= len(x.BatchDenom)
+			copy(dAtA[i:], x.BatchDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BatchDenom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventFreezeBatch)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventFreezeBatch: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventFreezeBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BatchDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Evidence = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventUnfreezeBatch             protoreflect.MessageDescriptor
+	fd_EventUnfreezeBatch_batch_denom protoreflect.FieldDescriptor
+	fd_EventUnfreezeBatch_evidence    protoreflect.FieldDescriptor
+	fd_EventUnfreezeBatch_reason      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_v1_events_proto_init()
+	md_EventUnfreezeBatch = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventUnfreezeBatch")
+	fd_EventUnfreezeBatch_batch_denom = md_EventUnfreezeBatch.Fields().ByName("batch_denom")
+	fd_EventUnfreezeBatch_evidence = md_EventUnfreezeBatch.Fields().ByName("evidence")
+	fd_EventUnfreezeBatch_reason = md_EventUnfreezeBatch.Fields().ByName("reason")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventUnfreezeBatch)(nil)
+
+type fastReflection_EventUnfreezeBatch EventUnfreezeBatch
+
+func (x *EventUnfreezeBatch) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventUnfreezeBatch)(x)
+}
+
+func (x *EventUnfreezeBatch) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventUnfreezeBatch_messageType fastReflection_EventUnfreezeBatch_messageType
+var _ protoreflect.MessageType = fastReflection_EventUnfreezeBatch_messageType{}
+
+type fastReflection_EventUnfreezeBatch_messageType struct{}
+
+func (x fastReflection_EventUnfreezeBatch_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventUnfreezeBatch)(nil)
+}
+func (x fastReflection_EventUnfreezeBatch_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventUnfreezeBatch)
+}
+func (x fastReflection_EventUnfreezeBatch_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUnfreezeBatch
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventUnfreezeBatch) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUnfreezeBatch
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventUnfreezeBatch) Type() protoreflect.MessageType {
+	return _fastReflection_EventUnfreezeBatch_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventUnfreezeBatch) New() protoreflect.Message {
+	return new(fastReflection_EventUnfreezeBatch)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventUnfreezeBatch) Interface() protoreflect.ProtoMessage {
+	return (*EventUnfreezeBatch)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventUnfreezeBatch) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BatchDenom != "" {
+		value := protoreflect.ValueOfString(x.BatchDenom)
+		if !f(fd_EventUnfreezeBatch_batch_denom, value) {
+			return
+		}
+	}
+	if x.Evidence != "" {
+		value := protoreflect.ValueOfString(x.Evidence)
+		if !f(fd_EventUnfreezeBatch_evidence, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_EventUnfreezeBatch_reason, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventUnfreezeBatch) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventUnfreezeBatch.batch_denom":
+		return x.BatchDenom != ""
+	case "regen.ecocredit.v1.EventUnfreezeBatch.evidence":
+		return x.Evidence != ""
+	case "regen.ecocredit.v1.EventUnfreezeBatch.reason":
+		return x.Reason != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUnfreezeBatch"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUnfreezeBatch does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUnfreezeBatch) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventUnfreezeBatch.batch_denom":
+		x.BatchDenom = ""
+	case "regen.ecocredit.v1.EventUnfreezeBatch.evidence":
+		x.Evidence = ""
+	case "regen.ecocredit.v1.EventUnfreezeBatch.reason":
+		x.Reason = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUnfreezeBatch"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUnfreezeBatch does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventUnfreezeBatch) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.v1.EventUnfreezeBatch.batch_denom":
+		value := x.BatchDenom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventUnfreezeBatch.evidence":
+		value := x.Evidence
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventUnfreezeBatch.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUnfreezeBatch"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUnfreezeBatch does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUnfreezeBatch) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventUnfreezeBatch.batch_denom":
+		x.BatchDenom = value.Interface().(string)
+	case "regen.ecocredit.v1.EventUnfreezeBatch.evidence":
+		x.Evidence = value.Interface().(string)
+	case "regen.ecocredit.v1.EventUnfreezeBatch.reason":
+		x.Reason = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUnfreezeBatch"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUnfreezeBatch does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUnfreezeBatch) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventUnfreezeBatch.batch_denom":
+		panic(fmt.Errorf("field batch_denom of message regen.ecocredit.v1.EventUnfreezeBatch is not mutable"))
+	case "regen.ecocredit.v1.EventUnfreezeBatch.evidence":
+		panic(fmt.Errorf("field evidence of message regen.ecocredit.v1.EventUnfreezeBatch is not mutable"))
+	case "regen.ecocredit.v1.EventUnfreezeBatch.reason":
+		panic(fmt.Errorf("field reason of message regen.ecocredit.v1.EventUnfreezeBatch is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUnfreezeBatch"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUnfreezeBatch does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventUnfreezeBatch) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventUnfreezeBatch.batch_denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventUnfreezeBatch.evidence":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventUnfreezeBatch.reason":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUnfreezeBatch"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUnfreezeBatch does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventUnfreezeBatch) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventUnfreezeBatch", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventUnfreezeBatch) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUnfreezeBatch) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventUnfreezeBatch) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventUnfreezeBatch) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventUnfreezeBatch)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.BatchDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Evidence)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventUnfreezeBatch)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Evidence) > 0 {
+			i -= len(x.Evidence)
+			copy(dAtA[i:], x.Evidence)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Evidence)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.BatchDenom) > 0 {
+			i -= len(x.BatchDenom)
+			copy(dAtA[i:], x.BatchDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BatchDenom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventUnfreezeBatch)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUnfreezeBatch: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUnfreezeBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BatchDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Evidence = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventUpdateProjectStatus                 protoreflect.MessageDescriptor
+	fd_EventUpdateProjectStatus_project_id      protoreflect.FieldDescriptor
+	fd_EventUpdateProjectStatus_previous_status protoreflect.FieldDescriptor
+	fd_EventUpdateProjectStatus_status          protoreflect.FieldDescriptor
+	fd_EventUpdateProjectStatus_reason          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_v1_events_proto_init()
+	md_EventUpdateProjectStatus = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventUpdateProjectStatus")
+	fd_EventUpdateProjectStatus_project_id = md_EventUpdateProjectStatus.Fields().ByName("project_id")
+	fd_EventUpdateProjectStatus_previous_status = md_EventUpdateProjectStatus.Fields().ByName("previous_status")
+	fd_EventUpdateProjectStatus_status = md_EventUpdateProjectStatus.Fields().ByName("status")
+	fd_EventUpdateProjectStatus_reason = md_EventUpdateProjectStatus.Fields().ByName("reason")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventUpdateProjectStatus)(nil)
+
+type fastReflection_EventUpdateProjectStatus EventUpdateProjectStatus
+
+func (x *EventUpdateProjectStatus) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventUpdateProjectStatus)(x)
+}
+
+func (x *EventUpdateProjectStatus) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventUpdateProjectStatus_messageType fastReflection_EventUpdateProjectStatus_messageType
+var _ protoreflect.MessageType = fastReflection_EventUpdateProjectStatus_messageType{}
+
+type fastReflection_EventUpdateProjectStatus_messageType struct{}
+
+func (x fastReflection_EventUpdateProjectStatus_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventUpdateProjectStatus)(nil)
+}
+func (x fastReflection_EventUpdateProjectStatus_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateProjectStatus)
+}
+func (x fastReflection_EventUpdateProjectStatus_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateProjectStatus
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventUpdateProjectStatus) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateProjectStatus
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventUpdateProjectStatus) Type() protoreflect.MessageType {
+	return _fastReflection_EventUpdateProjectStatus_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventUpdateProjectStatus) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateProjectStatus)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventUpdateProjectStatus) Interface() protoreflect.ProtoMessage {
+	return (*EventUpdateProjectStatus)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventUpdateProjectStatus) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProjectId != "" {
+		value := protoreflect.ValueOfString(x.ProjectId)
+		if !f(fd_EventUpdateProjectStatus_project_id, value) {
+			return
+		}
+	}
+	if x.PreviousStatus != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.PreviousStatus))
+		if !f(fd_EventUpdateProjectStatus_previous_status, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_EventUpdateProjectStatus_status, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_EventUpdateProjectStatus_reason, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventUpdateProjectStatus) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.project_id":
+		return x.ProjectId != ""
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.previous_status":
+		return x.PreviousStatus != 0
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.status":
+		return x.Status != 0
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.reason":
+		return x.Reason != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectStatus"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectStatus does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateProjectStatus) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.project_id":
+		x.ProjectId = ""
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.previous_status":
+		x.PreviousStatus = 0
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.status":
+		x.Status = 0
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.reason":
+		x.Reason = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectStatus"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectStatus does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventUpdateProjectStatus) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.project_id":
+		value := x.ProjectId
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.previous_status":
+		value := x.PreviousStatus
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectStatus"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectStatus does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateProjectStatus) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.project_id":
+		x.ProjectId = value.Interface().(string)
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.previous_status":
+		x.PreviousStatus = (ProjectStatus)(value.Enum())
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.status":
+		x.Status = (ProjectStatus)(value.Enum())
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.reason":
+		x.Reason = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectStatus"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectStatus does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateProjectStatus) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.project_id":
+		panic(fmt.Errorf("field project_id of message regen.ecocredit.v1.EventUpdateProjectStatus is not mutable"))
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.previous_status":
+		panic(fmt.Errorf("field previous_status of message regen.ecocredit.v1.EventUpdateProjectStatus is not mutable"))
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.status":
+		panic(fmt.Errorf("field status of message regen.ecocredit.v1.EventUpdateProjectStatus is not mutable"))
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.reason":
+		panic(fmt.Errorf("field reason of message regen.ecocredit.v1.EventUpdateProjectStatus is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectStatus"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectStatus does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventUpdateProjectStatus) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.project_id":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.previous_status":
+		return protoreflect.ValueOfEnum(0)
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.status":
+		return protoreflect.ValueOfEnum(0)
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.reason":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectStatus"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectStatus does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventUpdateProjectStatus) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventUpdateProjectStatus", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventUpdateProjectStatus) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateProjectStatus) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventUpdateProjectStatus) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventUpdateProjectStatus) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventUpdateProjectStatus)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ProjectId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PreviousStatus != 0 {
+			n += 1 + runtime.Sov(uint64(x.PreviousStatus))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventUpdateProjectStatus)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.PreviousStatus != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PreviousStatus))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.ProjectId) > 0 {
+			i -= len(x.ProjectId)
+			copy(dAtA[i:], x.ProjectId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProjectId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventUpdateProjectStatus)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateProjectStatus: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateProjectStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProjectId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProjectId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousStatus", wireType)
+				}
+				x.PreviousStatus = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PreviousStatus |= ProjectStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= ProjectStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventUpdateBridgeOperators          protoreflect.MessageDescriptor
+	fd_EventUpdateBridgeOperators_class_id protoreflect.FieldDescriptor
+	fd_EventUpdateBridgeOperators_source   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_v1_events_proto_init()
+	md_EventUpdateBridgeOperators = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventUpdateBridgeOperators")
+	fd_EventUpdateBridgeOperators_class_id = md_EventUpdateBridgeOperators.Fields().ByName("class_id")
+	fd_EventUpdateBridgeOperators_source = md_EventUpdateBridgeOperators.Fields().ByName("source")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventUpdateBridgeOperators)(nil)
+
+type fastReflection_EventUpdateBridgeOperators EventUpdateBridgeOperators
+
+func (x *EventUpdateBridgeOperators) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventUpdateBridgeOperators)(x)
+}
+
+func (x *EventUpdateBridgeOperators) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventUpdateBridgeOperators_messageType fastReflection_EventUpdateBridgeOperators_messageType
+var _ protoreflect.MessageType = fastReflection_EventUpdateBridgeOperators_messageType{}
+
+type fastReflection_EventUpdateBridgeOperators_messageType struct{}
+
+func (x fastReflection_EventUpdateBridgeOperators_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventUpdateBridgeOperators)(nil)
+}
+func (x fastReflection_EventUpdateBridgeOperators_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateBridgeOperators)
+}
+func (x fastReflection_EventUpdateBridgeOperators_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateBridgeOperators
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventUpdateBridgeOperators) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateBridgeOperators
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventUpdateBridgeOperators) Type() protoreflect.MessageType {
+	return _fastReflection_EventUpdateBridgeOperators_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventUpdateBridgeOperators) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateBridgeOperators)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventUpdateBridgeOperators) Interface() protoreflect.ProtoMessage {
+	return (*EventUpdateBridgeOperators)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventUpdateBridgeOperators) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ClassId != "" {
+		value := protoreflect.ValueOfString(x.ClassId)
+		if !f(fd_EventUpdateBridgeOperators_class_id, value) {
+			return
+		}
+	}
+	if x.Source != "" {
+		value := protoreflect.ValueOfString(x.Source)
+		if !f(fd_EventUpdateBridgeOperators_source, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventUpdateBridgeOperators) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventUpdateBridgeOperators.class_id":
+		return x.ClassId != ""
+	case "regen.ecocredit.v1.EventUpdateBridgeOperators.source":
+		return x.Source != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateBridgeOperators"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateBridgeOperators does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateBridgeOperators) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventUpdateBridgeOperators.class_id":
+		x.ClassId = ""
+	case "regen.ecocredit.v1.EventUpdateBridgeOperators.source":
+		x.Source = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateBridgeOperators"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateBridgeOperators does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventUpdateBridgeOperators) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.v1.EventUpdateBridgeOperators.class_id":
+		value := x.ClassId
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventUpdateBridgeOperators.source":
+		value := x.Source
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateBridgeOperators"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateBridgeOperators does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateBridgeOperators) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventUpdateBridgeOperators.class_id":
+		x.ClassId = value.Interface().(string)
+	case "regen.ecocredit.v1.EventUpdateBridgeOperators.source":
+		x.Source = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateBridgeOperators"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateBridgeOperators does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateBridgeOperators) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventUpdateBridgeOperators.class_id":
+		panic(fmt.Errorf("field class_id of message regen.ecocredit.v1.EventUpdateBridgeOperators is not mutable"))
+	case "regen.ecocredit.v1.EventUpdateBridgeOperators.source":
+		panic(fmt.Errorf("field source of message regen.ecocredit.v1.EventUpdateBridgeOperators is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateBridgeOperators"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateBridgeOperators does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventUpdateBridgeOperators) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventUpdateBridgeOperators.class_id":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventUpdateBridgeOperators.source":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateBridgeOperators"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateBridgeOperators does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventUpdateBridgeOperators) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventUpdateBridgeOperators", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventUpdateBridgeOperators) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateBridgeOperators) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventUpdateBridgeOperators) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventUpdateBridgeOperators) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventUpdateBridgeOperators)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ClassId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Source)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventUpdateBridgeOperators)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Source) > 0 {
+			i -= len(x.Source)
+			copy(dAtA[i:], x.Source)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Source)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ClassId) > 0 {
+			i -= len(x.ClassId)
+			copy(dAtA[i:], x.ClassId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClassId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventUpdateBridgeOperators)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateBridgeOperators: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateBridgeOperators: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClassId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Source = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventAttestBridgeReceive                  protoreflect.MessageDescriptor
+	fd_EventAttestBridgeReceive_class_id         protoreflect.FieldDescriptor
+	fd_EventAttestBridgeReceive_operator         protoreflect.FieldDescriptor
+	fd_EventAttestBridgeReceive_origin_tx_id     protoreflect.FieldDescriptor
+	fd_EventAttestBridgeReceive_origin_tx_source protoreflect.FieldDescriptor
+	fd_EventAttestBridgeReceive_attestations     protoreflect.FieldDescriptor
+	fd_EventAttestBridgeReceive_threshold        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_v1_events_proto_init()
+	md_EventAttestBridgeReceive = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventAttestBridgeReceive")
+	fd_EventAttestBridgeReceive_class_id = md_EventAttestBridgeReceive.Fields().ByName("class_id")
+	fd_EventAttestBridgeReceive_operator = md_EventAttestBridgeReceive.Fields().ByName("operator")
+	fd_EventAttestBridgeReceive_origin_tx_id = md_EventAttestBridgeReceive.Fields().ByName("origin_tx_id")
+	fd_EventAttestBridgeReceive_origin_tx_source = md_EventAttestBridgeReceive.Fields().ByName("origin_tx_source")
+	fd_EventAttestBridgeReceive_attestations = md_EventAttestBridgeReceive.Fields().ByName("attestations")
+	fd_EventAttestBridgeReceive_threshold = md_EventAttestBridgeReceive.Fields().ByName("threshold")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventAttestBridgeReceive)(nil)
+
+type fastReflection_EventAttestBridgeReceive EventAttestBridgeReceive
+
+func (x *EventAttestBridgeReceive) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventAttestBridgeReceive)(x)
+}
+
+func (x *EventAttestBridgeReceive) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventAttestBridgeReceive_messageType fastReflection_EventAttestBridgeReceive_messageType
+var _ protoreflect.MessageType = fastReflection_EventAttestBridgeReceive_messageType{}
+
+type fastReflection_EventAttestBridgeReceive_messageType struct{}
+
+func (x fastReflection_EventAttestBridgeReceive_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventAttestBridgeReceive)(nil)
+}
+func (x fastReflection_EventAttestBridgeReceive_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventAttestBridgeReceive)
+}
+func (x fastReflection_EventAttestBridgeReceive_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventAttestBridgeReceive
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventAttestBridgeReceive) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventAttestBridgeReceive
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventAttestBridgeReceive) Type() protoreflect.MessageType {
+	return _fastReflection_EventAttestBridgeReceive_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventAttestBridgeReceive) New() protoreflect.Message {
+	return new(fastReflection_EventAttestBridgeReceive)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventAttestBridgeReceive) Interface() protoreflect.ProtoMessage {
+	return (*EventAttestBridgeReceive)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventAttestBridgeReceive) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ClassId != "" {
+		value := protoreflect.ValueOfString(x.ClassId)
+		if !f(fd_EventAttestBridgeReceive_class_id, value) {
+			return
+		}
+	}
+	if x.Operator != "" {
+		value := protoreflect.ValueOfString(x.Operator)
+		if !f(fd_EventAttestBridgeReceive_operator, value) {
+			return
+		}
+	}
+	if x.OriginTxId != "" {
+		value := protoreflect.ValueOfString(x.OriginTxId)
+		if !f(fd_EventAttestBridgeReceive_origin_tx_id, value) {
+			return
+		}
+	}
+	if x.OriginTxSource != "" {
+		value := protoreflect.ValueOfString(x.OriginTxSource)
+		if !f(fd_EventAttestBridgeReceive_origin_tx_source, value) {
+			return
+		}
+	}
+	if x.Attestations != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Attestations)
+		if !f(fd_EventAttestBridgeReceive_attestations, value) {
+			return
+		}
+	}
+	if x.Threshold != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Threshold)
+		if !f(fd_EventAttestBridgeReceive_threshold, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventAttestBridgeReceive) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventAttestBridgeReceive.class_id":
+		return x.ClassId != ""
+	case "regen.ecocredit.v1.EventAttestBridgeReceive.operator":
+		return x.Operator != ""
+	case "regen.ecocredit.v1.EventAttestBridgeReceive.origin_tx_id":
+		return x.OriginTxId != ""
+	case "regen.ecocredit.v1.EventAttestBridgeReceive.origin_tx_source":
+		return x.OriginTxSource != ""
+	case "regen.ecocredit.v1.EventAttestBridgeReceive.attestations":
+		return x.Attestations != uint32(0)
+	case "regen.ecocredit.v1.EventAttestBridgeReceive.threshold":
+		return x.Threshold != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventAttestBridgeReceive"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventAttestBridgeReceive does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventAttestBridgeReceive) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventAttestBridgeReceive.class_id":
+		x.ClassId = ""
+	case "regen.ecocredit.v1.EventAttestBridgeReceive.operator":
+		x.Operator = ""
+	case "regen.ecocredit.v1.EventAttestBridgeReceive.origin_tx_id":
+		x.OriginTxId = ""
+	case "regen.ecocredit.v1.EventAttestBridgeReceive.origin_tx_source":
+		x.OriginTxSource = ""
+	case "regen.ecocredit.v1.EventAttestBridgeReceive.attestations":
+		x.Attestations = uint32(0)
+	case "regen.ecocredit.v1.EventAttestBridgeReceive.threshold":
+		x.Threshold = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventAttestBridgeReceive"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventAttestBridgeReceive does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventAttestBridgeReceive) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.v1.EventAttestBridgeReceive.class_id":
+		value := x.ClassId
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventAttestBridgeReceive.operator":
+		value := x.Operator
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventAttestBridgeReceive.origin_tx_id":
+		value := x.OriginTxId
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventAttestBridgeReceive.origin_tx_source":
+		value := x.OriginTxSource
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventAttestBridgeReceive.attestations":
+		value := x.Attestations
+		return protoreflect.ValueOfUint32(value)
+	case "regen.ecocredit.v1.EventAttestBridgeReceive.threshold":
+		value := x.Threshold
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventAttestBridgeReceive"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventAttestBridgeReceive does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventAttestBridgeReceive) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventAttestBridgeReceive.class_id":
+		x.ClassId = value.Interface().(string)
+	case "regen.ecocredit.v1.EventAttestBridgeReceive.operator":
+		x.Operator = value.Interface().(string)
+	case "regen.ecocredit.v1.EventAttestBridgeReceive.origin_tx_id":
+		x.OriginTxId = value.Interface().(string)
+	case "regen.ecocredit.v1.EventAttestBridgeReceive.origin_tx_source":
+		x.OriginTxSource = value.Interface().(string)
+	case "regen.ecocredit.v1.EventAttestBridgeReceive.attestations":
+		x.Attestations = uint32(value.Uint())
+	case "regen.ecocredit.v1.EventAttestBridgeReceive.threshold":
+		x.Threshold = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventAttestBridgeReceive"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventAttestBridgeReceive does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventAttestBridgeReceive) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventAttestBridgeReceive.class_id":
+		panic(fmt.Errorf("field class_id of message regen.ecocredit.v1.EventAttestBridgeReceive is not mutable"))
+	case "regen.ecocredit.v1.EventAttestBridgeReceive.operator":
+		panic(fmt.Errorf("field operator of message regen.ecocredit.v1.EventAttestBridgeReceive is not mutable"))
+	case "regen.ecocredit.v1.EventAttestBridgeReceive.origin_tx_id":
+		panic(fmt.Errorf("field origin_tx_id of message regen.ecocredit.v1.EventAttestBridgeReceive is not mutable"))
+	case "regen.ecocredit.v1.EventAttestBridgeReceive.origin_tx_source":
+		panic(fmt.Errorf("field origin_tx_source of message regen.ecocredit.v1.EventAttestBridgeReceive is not mutable"))
+	case "regen.ecocredit.v1.EventAttestBridgeReceive.attestations":
+		panic(fmt.Errorf("field attestations of message regen.ecocredit.v1.EventAttestBridgeReceive is not mutable"))
+	case "regen.ecocredit.v1.EventAttestBridgeReceive.threshold":
+		panic(fmt.Errorf("field threshold of message regen.ecocredit.v1.EventAttestBridgeReceive is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventAttestBridgeReceive"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventAttestBridgeReceive does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventAttestBridgeReceive) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventAttestBridgeReceive.class_id":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventAttestBridgeReceive.operator":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventAttestBridgeReceive.origin_tx_id":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventAttestBridgeReceive.origin_tx_source":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventAttestBridgeReceive.attestations":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "regen.ecocredit.v1.EventAttestBridgeReceive.threshold":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventAttestBridgeReceive"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventAttestBridgeReceive does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventAttestBridgeReceive) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventAttestBridgeReceive", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventAttestBridgeReceive) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventAttestBridgeReceive) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventAttestBridgeReceive) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventAttestBridgeReceive) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventAttestBridgeReceive)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ClassId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Operator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OriginTxId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OriginTxSource)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Attestations != 0 {
+			n += 1 + runtime.Sov(uint64(x.Attestations))
+		}
+		if x.Threshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.Threshold))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventAttestBridgeReceive)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Threshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Threshold))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Attestations != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Attestations))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.OriginTxSource) > 0 {
+			i -= len(x.OriginTxSource)
+			copy(dAtA[i:], x.OriginTxSource)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OriginTxSource)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.OriginTxId) > 0 {
+			i -= len(x.OriginTxId)
+			copy(dAtA[i:], x.OriginTxId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OriginTxId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Operator) > 0 {
+			i -= len(x.Operator)
+			copy(dAtA[i:], x.Operator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Operator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ClassId) > 0 {
+			i -= len(x.ClassId)
+			copy(dAtA[i:], x.ClassId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClassId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventAttestBridgeReceive)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventAttestBridgeReceive: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventAttestBridgeReceive: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClassId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Operator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OriginTxId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OriginTxId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OriginTxSource", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OriginTxSource = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+				}
+				x.Attestations = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Attestations |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				}
+				x.Threshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Threshold |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventBridgeAttestationExpired                  protoreflect.MessageDescriptor
+	fd_EventBridgeAttestationExpired_class_id         protoreflect.FieldDescriptor
+	fd_EventBridgeAttestationExpired_origin_tx_id     protoreflect.FieldDescriptor
+	fd_EventBridgeAttestationExpired_origin_tx_source protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_v1_events_proto_init()
+	md_EventBridgeAttestationExpired = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventBridgeAttestationExpired")
+	fd_EventBridgeAttestationExpired_class_id = md_EventBridgeAttestationExpired.Fields().ByName("class_id")
+	fd_EventBridgeAttestationExpired_origin_tx_id = md_EventBridgeAttestationExpired.Fields().ByName("origin_tx_id")
+	fd_EventBridgeAttestationExpired_origin_tx_source = md_EventBridgeAttestationExpired.Fields().ByName("origin_tx_source")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventBridgeAttestationExpired)(nil)
+
+type fastReflection_EventBridgeAttestationExpired EventBridgeAttestationExpired
+
+func (x *EventBridgeAttestationExpired) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventBridgeAttestationExpired)(x)
+}
+
+func (x *EventBridgeAttestationExpired) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventBridgeAttestationExpired_messageType fastReflection_EventBridgeAttestationExpired_messageType
+var _ protoreflect.MessageType = fastReflection_EventBridgeAttestationExpired_messageType{}
+
+type fastReflection_EventBridgeAttestationExpired_messageType struct{}
+
+func (x fastReflection_EventBridgeAttestationExpired_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventBridgeAttestationExpired)(nil)
+}
+func (x fastReflection_EventBridgeAttestationExpired_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventBridgeAttestationExpired)
+}
+func (x fastReflection_EventBridgeAttestationExpired_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventBridgeAttestationExpired
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventBridgeAttestationExpired) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventBridgeAttestationExpired
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventBridgeAttestationExpired) Type() protoreflect.MessageType {
+	return _fastReflection_EventBridgeAttestationExpired_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventBridgeAttestationExpired) New() protoreflect.Message {
+	return new(fastReflection_EventBridgeAttestationExpired)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventBridgeAttestationExpired) Interface() protoreflect.ProtoMessage {
+	return (*EventBridgeAttestationExpired)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventBridgeAttestationExpired) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ClassId != "" {
+		value := protoreflect.ValueOfString(x.ClassId)
+		if !f(fd_EventBridgeAttestationExpired_class_id, value) {
+			return
+		}
+	}
+	if x.OriginTxId != "" {
+		value := protoreflect.ValueOfString(x.OriginTxId)
+		if !f(fd_EventBridgeAttestationExpired_origin_tx_id, value) {
+			return
+		}
+	}
+	if x.OriginTxSource != "" {
+		value := protoreflect.ValueOfString(x.OriginTxSource)
+		if !f(fd_EventBridgeAttestationExpired_origin_tx_source, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventBridgeAttestationExpired) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventBridgeAttestationExpired.class_id":
+		return x.ClassId != ""
+	case "regen.ecocredit.v1.EventBridgeAttestationExpired.origin_tx_id":
+		return x.OriginTxId != ""
+	case "regen.ecocredit.v1.EventBridgeAttestationExpired.origin_tx_source":
+		return x.OriginTxSource != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeAttestationExpired"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeAttestationExpired does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventBridgeAttestationExpired) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventBridgeAttestationExpired.class_id":
+		x.ClassId = ""
+	case "regen.ecocredit.v1.EventBridgeAttestationExpired.origin_tx_id":
+		x.OriginTxId = ""
+	case "regen.ecocredit.v1.EventBridgeAttestationExpired.origin_tx_source":
+		x.OriginTxSource = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeAttestationExpired"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeAttestationExpired does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventBridgeAttestationExpired) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.v1.EventBridgeAttestationExpired.class_id":
+		value := x.ClassId
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventBridgeAttestationExpired.origin_tx_id":
+		value := x.OriginTxId
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventBridgeAttestationExpired.origin_tx_source":
+		value := x.OriginTxSource
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeAttestationExpired"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeAttestationExpired does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventBridgeAttestationExpired) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventBridgeAttestationExpired.class_id":
+		x.ClassId = value.Interface().(string)
+	case "regen.ecocredit.v1.EventBridgeAttestationExpired.origin_tx_id":
+		x.OriginTxId = value.Interface().(string)
+	case "regen.ecocredit.v1.EventBridgeAttestationExpired.origin_tx_source":
+		x.OriginTxSource = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeAttestationExpired"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeAttestationExpired does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventBridgeAttestationExpired) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventBridgeAttestationExpired.class_id":
+		panic(fmt.Errorf("field class_id of message regen.ecocredit.v1.EventBridgeAttestationExpired is not mutable"))
+	case "regen.ecocredit.v1.EventBridgeAttestationExpired.origin_tx_id":
+		panic(fmt.Errorf("field origin_tx_id of message regen.ecocredit.v1.EventBridgeAttestationExpired is not mutable"))
+	case "regen.ecocredit.v1.EventBridgeAttestationExpired.origin_tx_source":
+		panic(fmt.Errorf("field origin_tx_source of message regen.ecocredit.v1.EventBridgeAttestationExpired is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeAttestationExpired"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeAttestationExpired does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventBridgeAttestationExpired) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventBridgeAttestationExpired.class_id":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventBridgeAttestationExpired.origin_tx_id":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventBridgeAttestationExpired.origin_tx_source":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeAttestationExpired"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeAttestationExpired does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventBridgeAttestationExpired) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventBridgeAttestationExpired", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventBridgeAttestationExpired) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventBridgeAttestationExpired) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventBridgeAttestationExpired) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventBridgeAttestationExpired) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventBridgeAttestationExpired)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ClassId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OriginTxId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OriginTxSource)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventBridgeAttestationExpired)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.OriginTxSource) > 0 {
+			i -= len(x.OriginTxSource)
+			copy(dAtA[i:], x.OriginTxSource)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OriginTxSource)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.OriginTxId) > 0 {
+			i -= len(x.OriginTxId)
+			copy(dAtA[i:], x.OriginTxId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OriginTxId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ClassId) > 0 {
+			i -= len(x.ClassId)
+			copy(dAtA[i:], x.ClassId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClassId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventBridgeAttestationExpired)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventBridgeAttestationExpired: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventBridgeAttestationExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClassId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OriginTxId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OriginTxId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OriginTxSource", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OriginTxSource = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventBridgeAck                protoreflect.MessageDescriptor
+	fd_EventBridgeAck_request_id     protoreflect.FieldDescriptor
+	fd_EventBridgeAck_operator       protoreflect.FieldDescriptor
+	fd_EventBridgeAck_status         protoreflect.FieldDescriptor
+	fd_EventBridgeAck_target_tx_hash protoreflect.FieldDescriptor
+	fd_EventBridgeAck_attestations   protoreflect.FieldDescriptor
+	fd_EventBridgeAck_threshold      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_v1_events_proto_init()
+	md_EventBridgeAck = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventBridgeAck")
+	fd_EventBridgeAck_request_id = md_EventBridgeAck.Fields().ByName("request_id")
+	fd_EventBridgeAck_operator = md_EventBridgeAck.Fields().ByName("operator")
+	fd_EventBridgeAck_status = md_EventBridgeAck.Fields().ByName("status")
+	fd_EventBridgeAck_target_tx_hash = md_EventBridgeAck.Fields().ByName("target_tx_hash")
+	fd_EventBridgeAck_attestations = md_EventBridgeAck.Fields().ByName("attestations")
+	fd_EventBridgeAck_threshold = md_EventBridgeAck.Fields().ByName("threshold")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventBridgeAck)(nil)
+
+type fastReflection_EventBridgeAck EventBridgeAck
+
+func (x *EventBridgeAck) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventBridgeAck)(x)
+}
+
+func (x *EventBridgeAck) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventBridgeAck_messageType fastReflection_EventBridgeAck_messageType
+var _ protoreflect.MessageType = fastReflection_EventBridgeAck_messageType{}
+
+type fastReflection_EventBridgeAck_messageType struct{}
+
+func (x fastReflection_EventBridgeAck_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventBridgeAck)(nil)
+}
+func (x fastReflection_EventBridgeAck_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventBridgeAck)
+}
+func (x fastReflection_EventBridgeAck_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventBridgeAck
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventBridgeAck) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventBridgeAck
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventBridgeAck) Type() protoreflect.MessageType {
+	return _fastReflection_EventBridgeAck_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventBridgeAck) New() protoreflect.Message {
+	return new(fastReflection_EventBridgeAck)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventBridgeAck) Interface() protoreflect.ProtoMessage {
+	return (*EventBridgeAck)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventBridgeAck) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RequestId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequestId)
+		if !f(fd_EventBridgeAck_request_id, value) {
+			return
+		}
+	}
+	if x.Operator != "" {
+		value := protoreflect.ValueOfString(x.Operator)
+		if !f(fd_EventBridgeAck_operator, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_EventBridgeAck_status, value) {
+			return
+		}
+	}
+	if x.TargetTxHash != "" {
+		value := protoreflect.ValueOfString(x.TargetTxHash)
+		if !f(fd_EventBridgeAck_target_tx_hash, value) {
+			return
+		}
+	}
+	if x.Attestations != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Attestations)
+		if !f(fd_EventBridgeAck_attestations, value) {
+			return
+		}
+	}
+	if x.Threshold != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Threshold)
+		if !f(fd_EventBridgeAck_threshold, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventBridgeAck) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventBridgeAck.request_id":
+		return x.RequestId != uint64(0)
+	case "regen.ecocredit.v1.EventBridgeAck.operator":
+		return x.Operator != ""
+	case "regen.ecocredit.v1.EventBridgeAck.status":
+		return x.Status != 0
+	case "regen.ecocredit.v1.EventBridgeAck.target_tx_hash":
+		return x.TargetTxHash != ""
+	case "regen.ecocredit.v1.EventBridgeAck.attestations":
+		return x.Attestations != uint32(0)
+	case "regen.ecocredit.v1.EventBridgeAck.threshold":
+		return x.Threshold != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeAck"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeAck does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventBridgeAck) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventBridgeAck.request_id":
+		x.RequestId = uint64(0)
+	case "regen.ecocredit.v1.EventBridgeAck.operator":
+		x.Operator = ""
+	case "regen.ecocredit.v1.EventBridgeAck.status":
+		x.Status = 0
+	case "regen.ecocredit.v1.EventBridgeAck.target_tx_hash":
+		x.TargetTxHash = ""
+	case "regen.ecocredit.v1.EventBridgeAck.attestations":
+		x.Attestations = uint32(0)
+	case "regen.ecocredit.v1.EventBridgeAck.threshold":
+		x.Threshold = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeAck"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeAck does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventBridgeAck) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.v1.EventBridgeAck.request_id":
+		value := x.RequestId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.v1.EventBridgeAck.operator":
+		value := x.Operator
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventBridgeAck.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "regen.ecocredit.v1.EventBridgeAck.target_tx_hash":
+		value := x.TargetTxHash
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventBridgeAck.attestations":
+		value := x.Attestations
+		return protoreflect.ValueOfUint32(value)
+	case "regen.ecocredit.v1.EventBridgeAck.threshold":
+		value := x.Threshold
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeAck"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeAck does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventBridgeAck) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventBridgeAck.request_id":
+		x.RequestId = value.Uint()
+	case "regen.ecocredit.v1.EventBridgeAck.operator":
+		x.Operator = value.Interface().(string)
+	case "regen.ecocredit.v1.EventBridgeAck.status":
+		x.Status = (OutboundBridgeStatus)(value.Enum())
+	case "regen.ecocredit.v1.EventBridgeAck.target_tx_hash":
+		x.TargetTxHash = value.Interface().(string)
+	case "regen.ecocredit.v1.EventBridgeAck.attestations":
+		x.Attestations = uint32(value.Uint())
+	case "regen.ecocredit.v1.EventBridgeAck.threshold":
+		x.Threshold = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeAck"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeAck does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventBridgeAck) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventBridgeAck.request_id":
+		panic(fmt.Errorf("field request_id of message regen.ecocredit.v1.EventBridgeAck is not mutable"))
+	case "regen.ecocredit.v1.EventBridgeAck.operator":
+		panic(fmt.Errorf("field operator of message regen.ecocredit.v1.EventBridgeAck is not mutable"))
+	case "regen.ecocredit.v1.EventBridgeAck.status":
+		panic(fmt.Errorf("field status of message regen.ecocredit.v1.EventBridgeAck is not mutable"))
+	case "regen.ecocredit.v1.EventBridgeAck.target_tx_hash":
+		panic(fmt.Errorf("field target_tx_hash of message regen.ecocredit.v1.EventBridgeAck is not mutable"))
+	case "regen.ecocredit.v1.EventBridgeAck.attestations":
+		panic(fmt.Errorf("field attestations of message regen.ecocredit.v1.EventBridgeAck is not mutable"))
+	case "regen.ecocredit.v1.EventBridgeAck.threshold":
+		panic(fmt.Errorf("field threshold of message regen.ecocredit.v1.EventBridgeAck is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeAck"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeAck does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventBridgeAck) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventBridgeAck.request_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.v1.EventBridgeAck.operator":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventBridgeAck.status":
+		return protoreflect.ValueOfEnum(0)
+	case "regen.ecocredit.v1.EventBridgeAck.target_tx_hash":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventBridgeAck.attestations":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "regen.ecocredit.v1.EventBridgeAck.threshold":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeAck"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeAck does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventBridgeAck) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventBridgeAck", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventBridgeAck) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventBridgeAck) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventBridgeAck) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventBridgeAck) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventBridgeAck)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.RequestId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestId))
+		}
+		l = len(x.Operator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		l = len(x.TargetTxHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Attestations != 0 {
+			n += 1 + runtime.Sov(uint64(x.Attestations))
+		}
+		if x.Threshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.Threshold))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventBridgeAck)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Threshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Threshold))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Attestations != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Attestations))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.TargetTxHash) > 0 {
+			i -= len(x.TargetTxHash)
+			copy(dAtA[i:], x.TargetTxHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TargetTxHash)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Operator) > 0 {
+			i -= len(x.Operator)
+			copy(dAtA[i:], x.Operator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Operator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.RequestId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventBridgeAck)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventBridgeAck: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventBridgeAck: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				}
+				x.RequestId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Operator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= OutboundBridgeStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetTxHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TargetTxHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+				}
+				x.Attestations = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Attestations |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				}
+				x.Threshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Threshold |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventBridgeRefund             protoreflect.MessageDescriptor
+	fd_EventBridgeRefund_request_id  protoreflect.FieldDescriptor
+	fd_EventBridgeRefund_owner       protoreflect.FieldDescriptor
+	fd_EventBridgeRefund_batch_denom protoreflect.FieldDescriptor
+	fd_EventBridgeRefund_amount      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_v1_events_proto_init()
+	md_EventBridgeRefund = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventBridgeRefund")
+	fd_EventBridgeRefund_request_id = md_EventBridgeRefund.Fields().ByName("request_id")
+	fd_EventBridgeRefund_owner = md_EventBridgeRefund.Fields().ByName("owner")
+	fd_EventBridgeRefund_batch_denom = md_EventBridgeRefund.Fields().ByName("batch_denom")
+	fd_EventBridgeRefund_amount = md_EventBridgeRefund.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventBridgeRefund)(nil)
+
+type fastReflection_EventBridgeRefund EventBridgeRefund
+
+func (x *EventBridgeRefund) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventBridgeRefund)(x)
+}
+
+func (x *EventBridgeRefund) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventBridgeRefund_messageType fastReflection_EventBridgeRefund_messageType
+var _ protoreflect.MessageType = fastReflection_EventBridgeRefund_messageType{}
+
+type fastReflection_EventBridgeRefund_messageType struct{}
+
+func (x fastReflection_EventBridgeRefund_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventBridgeRefund)(nil)
+}
+func (x fastReflection_EventBridgeRefund_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventBridgeRefund)
+}
+func (x fastReflection_EventBridgeRefund_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventBridgeRefund
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventBridgeRefund) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventBridgeRefund
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventBridgeRefund) Type() protoreflect.MessageType {
+	return _fastReflection_EventBridgeRefund_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventBridgeRefund) New() protoreflect.Message {
+	return new(fastReflection_EventBridgeRefund)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventBridgeRefund) Interface() protoreflect.ProtoMessage {
+	return (*EventBridgeRefund)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventBridgeRefund) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RequestId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequestId)
+		if !f(fd_EventBridgeRefund_request_id, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_EventBridgeRefund_owner, value) {
+			return
+		}
+	}
+	if x.BatchDenom != "" {
+		value := protoreflect.ValueOfString(x.BatchDenom)
+		if !f(fd_EventBridgeRefund_batch_denom, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_EventBridgeRefund_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventBridgeRefund) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventBridgeRefund.request_id":
+		return x.RequestId != uint64(0)
+	case "regen.ecocredit.v1.EventBridgeRefund.owner":
+		return x.Owner != ""
+	case "regen.ecocredit.v1.EventBridgeRefund.batch_denom":
+		return x.BatchDenom != ""
+	case "regen.ecocredit.v1.EventBridgeRefund.amount":
+		return x.Amount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeRefund"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeRefund does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventBridgeRefund) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventBridgeRefund.request_id":
+		x.RequestId = uint64(0)
+	case "regen.ecocredit.v1.EventBridgeRefund.owner":
+		x.Owner = ""
+	case "regen.ecocredit.v1.EventBridgeRefund.batch_denom":
+		x.BatchDenom = ""
+	case "regen.ecocredit.v1.EventBridgeRefund.amount":
+		x.Amount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeRefund"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeRefund does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventBridgeRefund) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.v1.EventBridgeRefund.request_id":
+		value := x.RequestId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.v1.EventBridgeRefund.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventBridgeRefund.batch_denom":
+		value := x.BatchDenom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventBridgeRefund.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeRefund"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeRefund does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventBridgeRefund) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventBridgeRefund.request_id":
+		x.RequestId = value.Uint()
+	case "regen.ecocredit.v1.EventBridgeRefund.owner":
+		x.Owner = value.Interface().(string)
+	case "regen.ecocredit.v1.EventBridgeRefund.batch_denom":
+		x.BatchDenom = value.Interface().(string)
+	case "regen.ecocredit.v1.EventBridgeRefund.amount":
+		x.Amount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeRefund"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeRefund does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventBridgeRefund) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventBridgeRefund.request_id":
+		panic(fmt.Errorf("field request_id of message regen.ecocredit.v1.EventBridgeRefund is not mutable"))
+	case "regen.ecocredit.v1.EventBridgeRefund.owner":
+		panic(fmt.Errorf("field owner of message regen.ecocredit.v1.EventBridgeRefund is not mutable"))
+	case "regen.ecocredit.v1.EventBridgeRefund.batch_denom":
+		panic(fmt.Errorf("field batch_denom of message regen.ecocredit.v1.EventBridgeRefund is not mutable"))
+	case "regen.ecocredit.v1.EventBridgeRefund.amount":
+		panic(fmt.Errorf("field amount of message regen.ecocredit.v1.EventBridgeRefund is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeRefund"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeRefund does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventBridgeRefund) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventBridgeRefund.request_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.v1.EventBridgeRefund.owner":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventBridgeRefund.batch_denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventBridgeRefund.amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeRefund"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeRefund does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventBridgeRefund) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventBridgeRefund", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventBridgeRefund) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventBridgeRefund) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventBridgeRefund) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventBridgeRefund) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventBridgeRefund)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.RequestId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestId))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BatchDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventBridgeRefund)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.BatchDenom) > 0 {
+			i -= len(x.BatchDenom)
+			copy(dAtA[i:], x.BatchDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BatchDenom)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.RequestId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventBridgeRefund)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventBridgeRefund: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventBridgeRefund: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				}
+				x.RequestId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BatchDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: regen/ecocredit/v1/events.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EventCreateClass is an event emitted when a credit class is created.
+type EventCreateClass struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// class_id is the unique identifier of the credit class.
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+}
+
+func (x *EventCreateClass) Reset() {
+	*x = EventCreateClass{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventCreateClass) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventCreateClass) ProtoMessage() {}
+
+// Deprecated: Use EventCreateClass.ProtoReflect.Descriptor instead.
+func (*EventCreateClass) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EventCreateClass) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+// EventCreateProject is an event emitted when a project is created.
+type EventCreateProject struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// project_id is the unique identifier of the project.
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+}
+
+func (x *EventCreateProject) Reset() {
+	*x = EventCreateProject{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventCreateProject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventCreateProject) ProtoMessage() {}
+
+// Deprecated: Use EventCreateProject.ProtoReflect.Descriptor instead.
+func (*EventCreateProject) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EventCreateProject) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+// EventCreateBatch is an event emitted when a credit batch is created.
+type EventCreateBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// batch_denom is the unique identifier of the credit batch.
+	BatchDenom string `protobuf:"bytes,1,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// origin_tx is the transaction from another chain or registry that triggered
+	// the creation of the credit batch.
+	OriginTx *OriginTx `protobuf:"bytes,2,opt,name=origin_tx,json=originTx,proto3" json:"origin_tx,omitempty"`
 }
 
 func (x *EventCreateBatch) Reset() {
 	*x = EventCreateBatch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[2]
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventCreateBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventCreateBatch) ProtoMessage() {}
+
+// Deprecated: Use EventCreateBatch.ProtoReflect.Descriptor instead.
+func (*EventCreateBatch) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventCreateBatch) GetBatchDenom() string {
+	if x != nil {
+		return x.BatchDenom
+	}
+	return ""
+}
+
+func (x *EventCreateBatch) GetOriginTx() *OriginTx {
+	if x != nil {
+		return x.OriginTx
+	}
+	return nil
+}
+
+// EventMint is an event emitted when credits are minted either when creating a
+// credit batch or when bridging assets from another chain or registry.
+type EventMint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// batch_denom is the unique identifier of the credit batch within which the
+	// credits were minted.
+	BatchDenom string `protobuf:"bytes,1,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// tradable_amount is the amount of tradable credits minted.
+	TradableAmount string `protobuf:"bytes,2,opt,name=tradable_amount,json=tradableAmount,proto3" json:"tradable_amount,omitempty"`
+	// retired_amount is the amount of retired credits minted.
+	RetiredAmount string `protobuf:"bytes,3,opt,name=retired_amount,json=retiredAmount,proto3" json:"retired_amount,omitempty"`
+}
+
+func (x *EventMint) Reset() {
+	*x = EventMint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventMint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventMint) ProtoMessage() {}
+
+// Deprecated: Use EventMint.ProtoReflect.Descriptor instead.
+func (*EventMint) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EventMint) GetBatchDenom() string {
+	if x != nil {
+		return x.BatchDenom
+	}
+	return ""
+}
+
+func (x *EventMint) GetTradableAmount() string {
+	if x != nil {
+		return x.TradableAmount
+	}
+	return ""
+}
+
+func (x *EventMint) GetRetiredAmount() string {
+	if x != nil {
+		return x.RetiredAmount
+	}
+	return ""
+}
+
+// EventMintBatchCredits is an event emitted when credits are minted to an
+// existing open credit batch.
+type EventMintBatchCredits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// batch_denom is the unique identifier of the credit batch within which the
+	// credits were minted.
+	BatchDenom string `protobuf:"bytes,1,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// origin_tx is the transaction from another chain or registry that triggered
+	// the minting of credits within the credit batch.
+	OriginTx *OriginTx `protobuf:"bytes,2,opt,name=origin_tx,json=originTx,proto3" json:"origin_tx,omitempty"`
+}
+
+func (x *EventMintBatchCredits) Reset() {
+	*x = EventMintBatchCredits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventMintBatchCredits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventMintBatchCredits) ProtoMessage() {}
+
+// Deprecated: Use EventMintBatchCredits.ProtoReflect.Descriptor instead.
+func (*EventMintBatchCredits) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EventMintBatchCredits) GetBatchDenom() string {
+	if x != nil {
+		return x.BatchDenom
+	}
+	return ""
+}
+
+func (x *EventMintBatchCredits) GetOriginTx() *OriginTx {
+	if x != nil {
+		return x.OriginTx
+	}
+	return nil
+}
+
+// EventTransfer is an event emitted when credits are transferred from one
+// account to another including transfers to or from a module account.
+type EventTransfer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sender is the sender of the credits. In the case that the credits were
+	// transferred from a base account, this will be the account address. In the
+	// case that the credits were transferred from a module, this will be the
+	// module address (i.e. either the ecocredit module or basket submodule).
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// recipient is the recipient of the credits. In the case that the credits
+	// were transferred to a base account, this will be the account address. In
+	// the case that the credits were transferred to a module, this will be the
+	// module address (i.e. either the ecocredit module or basket submodule).
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// batch_denom is the unique identifier of the credit batch.
+	BatchDenom string `protobuf:"bytes,3,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// tradable_amount is the decimal number of tradable credits received.
+	TradableAmount string `protobuf:"bytes,4,opt,name=tradable_amount,json=tradableAmount,proto3" json:"tradable_amount,omitempty"`
+	// retired_amount is the decimal number of retired credits received.
+	RetiredAmount string `protobuf:"bytes,5,opt,name=retired_amount,json=retiredAmount,proto3" json:"retired_amount,omitempty"`
+	// locked_amount is the decimal number of locked credits received. Locked
+	// credits are released into the tradable balance of the recipient according
+	// to the schedule of the lock (see EventLock and EventUnlock).
+	LockedAmount string `protobuf:"bytes,6,opt,name=locked_amount,json=lockedAmount,proto3" json:"locked_amount,omitempty"`
+}
+
+func (x *EventTransfer) Reset() {
+	*x = EventTransfer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventTransfer) ProtoMessage() {}
+
+// Deprecated: Use EventTransfer.ProtoReflect.Descriptor instead.
+func (*EventTransfer) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EventTransfer) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *EventTransfer) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *EventTransfer) GetBatchDenom() string {
+	if x != nil {
+		return x.BatchDenom
+	}
+	return ""
+}
+
+func (x *EventTransfer) GetTradableAmount() string {
+	if x != nil {
+		return x.TradableAmount
+	}
+	return ""
+}
+
+func (x *EventTransfer) GetRetiredAmount() string {
+	if x != nil {
+		return x.RetiredAmount
+	}
+	return ""
+}
+
+func (x *EventTransfer) GetLockedAmount() string {
+	if x != nil {
+		return x.LockedAmount
+	}
+	return ""
+}
+
+// EventRetire is an event emitted when credits are retired. When credits are
+// retired from multiple batches in the same transaction, a separate event is
+// emitted for each batch_denom. This allows for easier indexing.
+type EventRetire struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// owner is the address of the account that owns the retired credits. This
+	// will be the account receiving credits in the case that credits were retired
+	// upon issuance using Msg/CreateBatch, retired upon transfer using Msg/Send,
+	// retired upon taking from a basket using basket.Msg/Take, or retired upon
+	// purchase using marketplace.Msg/BuyDirect.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// batch_denom is the unique identifier of the credit batch within which the
+	// credits were retired.
+	BatchDenom string `protobuf:"bytes,2,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// amount is the decimal number of credits that have been retired.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// jurisdiction is the jurisdiction of the beneficiary or buyer of the retired
+	// credits. It is a string of the form
+	// <country-code>[-<sub-national-code>[ <postal-code>]], with the first two
+	// fields conforming to ISO 3166-2, and postal-code being up to 64
+	// alphanumeric characters.
+	Jurisdiction string `protobuf:"bytes,4,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"`
+	// reason is the reason for retiring the credits. The reason is only set when
+	// credits are retired using Msg/Retire.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// retirement_id is the unique identifier of the retirement record created
+	// when the credits were retired.
+	RetirementId uint64 `protobuf:"varint,6,opt,name=retirement_id,json=retirementId,proto3" json:"retirement_id,omitempty"`
+	// beneficiary_address is the address of the account on whose behalf the
+	// credits were retired and to which the retired credits were attributed.
+	// If empty, the retired credits were attributed to the owner.
+	BeneficiaryAddress string `protobuf:"bytes,7,opt,name=beneficiary_address,json=beneficiaryAddress,proto3" json:"beneficiary_address,omitempty"`
+	// beneficiary_name is the name of the individual or organization on whose
+	// behalf the credits were retired.
+	BeneficiaryName string `protobuf:"bytes,8,opt,name=beneficiary_name,json=beneficiaryName,proto3" json:"beneficiary_name,omitempty"`
+}
+
+func (x *EventRetire) Reset() {
+	*x = EventRetire{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventRetire) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventRetire) ProtoMessage() {}
+
+// Deprecated: Use EventRetire.ProtoReflect.Descriptor instead.
+func (*EventRetire) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EventRetire) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *EventRetire) GetBatchDenom() string {
+	if x != nil {
+		return x.BatchDenom
+	}
+	return ""
+}
+
+func (x *EventRetire) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *EventRetire) GetJurisdiction() string {
+	if x != nil {
+		return x.Jurisdiction
+	}
+	return ""
+}
+
+func (x *EventRetire) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *EventRetire) GetRetirementId() uint64 {
+	if x != nil {
+		return x.RetirementId
+	}
+	return 0
+}
+
+func (x *EventRetire) GetBeneficiaryAddress() string {
+	if x != nil {
+		return x.BeneficiaryAddress
+	}
+	return ""
+}
+
+func (x *EventRetire) GetBeneficiaryName() string {
+	if x != nil {
+		return x.BeneficiaryName
+	}
+	return ""
+}
+
+// EventCancel is an event emitted when credits are cancelled. When credits are
+// cancelled from multiple batches in the same transaction, a separate event is
+// emitted for each batch_denom. This allows for easier indexing.
+type EventCancel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// owner is the address of the account that cancelled the credits.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// batch_denom is the unique identifier of the credit batch within which the
+	// credits were cancelled.
+	BatchDenom string `protobuf:"bytes,2,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// amount is the decimal number of credits that have been cancelled.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// reason is the reason the credits were cancelled.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *EventCancel) Reset() {
+	*x = EventCancel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventCancel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventCancel) ProtoMessage() {}
+
+// Deprecated: Use EventCancel.ProtoReflect.Descriptor instead.
+func (*EventCancel) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EventCancel) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *EventCancel) GetBatchDenom() string {
+	if x != nil {
+		return x.BatchDenom
+	}
+	return ""
+}
+
+func (x *EventCancel) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *EventCancel) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// EventUpdateClassAdmin is emitted when the admin address of a credit class is
+// changed.
+type EventUpdateClassAdmin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// class_id is the unique identifier of the class that was updated.
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+}
+
+func (x *EventUpdateClassAdmin) Reset() {
+	*x = EventUpdateClassAdmin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventUpdateClassAdmin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventUpdateClassAdmin) ProtoMessage() {}
+
+// Deprecated: Use EventUpdateClassAdmin.ProtoReflect.Descriptor instead.
+func (*EventUpdateClassAdmin) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EventUpdateClassAdmin) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+// EventUpdateClassIssuers is emitted when the issuer list for a credit class
+// is updated.
+type EventUpdateClassIssuers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// class_id is the unique identifier of the class that was updated.
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+}
+
+func (x *EventUpdateClassIssuers) Reset() {
+	*x = EventUpdateClassIssuers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventUpdateClassIssuers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventUpdateClassIssuers) ProtoMessage() {}
+
+// Deprecated: Use EventUpdateClassIssuers.ProtoReflect.Descriptor instead.
+func (*EventUpdateClassIssuers) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EventUpdateClassIssuers) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+// EventUpdateClassMetadata is emitted when the credit class metadata is
+// changed.
+type EventUpdateClassMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// class_id is the unique identifier of the class that was updated.
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+}
+
+func (x *EventUpdateClassMetadata) Reset() {
+	*x = EventUpdateClassMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventUpdateClassMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventUpdateClassMetadata) ProtoMessage() {}
+
+// Deprecated: Use EventUpdateClassMetadata.ProtoReflect.Descriptor instead.
+func (*EventUpdateClassMetadata) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EventUpdateClassMetadata) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+// EventUpdateProjectAdmin is emitted when the project admin is changed.
+type EventUpdateProjectAdmin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// project_id is the unique identifier of the project that was updated.
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+}
+
+func (x *EventUpdateProjectAdmin) Reset() {
+	*x = EventUpdateProjectAdmin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventUpdateProjectAdmin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventUpdateProjectAdmin) ProtoMessage() {}
+
+// Deprecated: Use EventUpdateProjectAdmin.ProtoReflect.Descriptor instead.
+func (*EventUpdateProjectAdmin) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EventUpdateProjectAdmin) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+// EventUpdateProjectMetadata is emitted when the project metadata is changed.
+type EventUpdateProjectMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// project_id is the unique identifier of the project that was updated.
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+}
+
+func (x *EventUpdateProjectMetadata) Reset() {
+	*x = EventUpdateProjectMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventUpdateProjectMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventUpdateProjectMetadata) ProtoMessage() {}
+
+// Deprecated: Use EventUpdateProjectMetadata.ProtoReflect.Descriptor instead.
+func (*EventUpdateProjectMetadata) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EventUpdateProjectMetadata) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+// EventUpdateBatchMetadata is emitted when the credit batch metadata is
+// changed.
+type EventUpdateBatchMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// batch_denom is the unique identifier of the credit batch that was updated.
+	BatchDenom string `protobuf:"bytes,1,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+}
+
+func (x *EventUpdateBatchMetadata) Reset() {
+	*x = EventUpdateBatchMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventUpdateBatchMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventUpdateBatchMetadata) ProtoMessage() {}
+
+// Deprecated: Use EventUpdateBatchMetadata.ProtoReflect.Descriptor instead.
+func (*EventUpdateBatchMetadata) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *EventUpdateBatchMetadata) GetBatchDenom() string {
+	if x != nil {
+		return x.BatchDenom
+	}
+	return ""
+}
+
+// EventSealBatch is emitted when a batch is sealed.
+type EventSealBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// batch_denom is the denom of the batch that was sealed.
+	BatchDenom string `protobuf:"bytes,1,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+}
+
+func (x *EventSealBatch) Reset() {
+	*x = EventSealBatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventSealBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventSealBatch) ProtoMessage() {}
+
+// Deprecated: Use EventSealBatch.ProtoReflect.Descriptor instead.
+func (*EventSealBatch) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *EventSealBatch) GetBatchDenom() string {
+	if x != nil {
+		return x.BatchDenom
+	}
+	return ""
+}
+
+// EventAddCreditType is emitted when governance approves a new credit type.
+type EventAddCreditType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// abbreviation is the abbreviation of the credit type.
+	Abbreviation string `protobuf:"bytes,1,opt,name=abbreviation,proto3" json:"abbreviation,omitempty"`
+}
+
+func (x *EventAddCreditType) Reset() {
+	*x = EventAddCreditType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventAddCreditType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventAddCreditType) ProtoMessage() {}
+
+// Deprecated: Use EventAddCreditType.ProtoReflect.Descriptor instead.
+func (*EventAddCreditType) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *EventAddCreditType) GetAbbreviation() string {
+	if x != nil {
+		return x.Abbreviation
+	}
+	return ""
+}
+
+// EventUpdateCreditTypePrecision is emitted when governance raises the
+// precision of a credit type.
+type EventUpdateCreditTypePrecision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// abbreviation is the abbreviation of the credit type.
+	Abbreviation string `protobuf:"bytes,1,opt,name=abbreviation,proto3" json:"abbreviation,omitempty"`
+	// previous_precision is the precision of the credit type before the update.
+	PreviousPrecision uint32 `protobuf:"varint,2,opt,name=previous_precision,json=previousPrecision,proto3" json:"previous_precision,omitempty"`
+	// precision is the precision of the credit type after the update.
+	Precision uint32 `protobuf:"varint,3,opt,name=precision,proto3" json:"precision,omitempty"`
+}
+
+func (x *EventUpdateCreditTypePrecision) Reset() {
+	*x = EventUpdateCreditTypePrecision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventUpdateCreditTypePrecision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventUpdateCreditTypePrecision) ProtoMessage() {}
+
+// Deprecated: Use EventUpdateCreditTypePrecision.ProtoReflect.Descriptor instead.
+func (*EventUpdateCreditTypePrecision) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *EventUpdateCreditTypePrecision) GetAbbreviation() string {
+	if x != nil {
+		return x.Abbreviation
+	}
+	return ""
+}
+
+func (x *EventUpdateCreditTypePrecision) GetPreviousPrecision() uint32 {
+	if x != nil {
+		return x.PreviousPrecision
+	}
+	return 0
+}
+
+func (x *EventUpdateCreditTypePrecision) GetPrecision() uint32 {
+	if x != nil {
+		return x.Precision
+	}
+	return 0
+}
+
+// EventBridge is emitted when credits are bridged to another chain.
+type EventBridge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// target is the target chain.
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// recipient is the recipient address.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// contract is the contract address.
+	Contract string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	// amount is the amount of credits.
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// request_id is the unique identifier of the outbound bridge request.
+	RequestId uint64 `protobuf:"varint,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
+
+func (x *EventBridge) Reset() {
+	*x = EventBridge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventBridge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventBridge) ProtoMessage() {}
+
+// Deprecated: Use EventBridge.ProtoReflect.Descriptor instead.
+func (*EventBridge) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *EventBridge) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *EventBridge) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *EventBridge) GetContract() string {
+	if x != nil {
+		return x.Contract
+	}
+	return ""
+}
+
+func (x *EventBridge) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *EventBridge) GetRequestId() uint64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+// EventBridgeReceive is emitted when credits are bridged from another chain.
+type EventBridgeReceive struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// project_id is the unique identifier of the project that was either created
+	// or the existing project within which the credit batch exists.
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// batch_denom is the unique identifier of the credit batch either created
+	// or within which the credits were dynamically minted.
+	BatchDenom string `protobuf:"bytes,2,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+}
+
+func (x *EventBridgeReceive) Reset() {
+	*x = EventBridgeReceive{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventCreateBatch) String() string {
+func (x *EventBridgeReceive) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventCreateBatch) ProtoMessage() {}
+func (*EventBridgeReceive) ProtoMessage() {}
 
-// Deprecated: Use EventCreateBatch.ProtoReflect.Descriptor instead.
-func (*EventCreateBatch) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use EventBridgeReceive.ProtoReflect.Descriptor instead.
+func (*EventBridgeReceive) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *EventCreateBatch) GetBatchDenom() string {
+func (x *EventBridgeReceive) GetProjectId() string {
 	if x != nil {
-		return x.BatchDenom
+		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *EventCreateBatch) GetOriginTx() *OriginTx {
+func (x *EventBridgeReceive) GetBatchDenom() string {
 	if x != nil {
-		return x.OriginTx
+		return x.BatchDenom
 	}
-	return nil
+	return ""
 }
 
-// EventMint is an event emitted when credits are minted either when creating a
-// credit batch or when bridging assets from another chain or registry.
-type EventMint struct {
+// EventApprove is emitted when an owner approves an operator to send or retire
+// credits on their behalf.
+type EventApprove struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// batch_denom is the unique identifier of the credit batch within which the
-	// credits were minted.
-	BatchDenom string `protobuf:"bytes,1,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
-	// tradable_amount is the amount of tradable credits minted.
-	TradableAmount string `protobuf:"bytes,2,opt,name=tradable_amount,json=tradableAmount,proto3" json:"tradable_amount,omitempty"`
-	// retired_amount is the amount of retired credits minted.
-	RetiredAmount string `protobuf:"bytes,3,opt,name=retired_amount,json=retiredAmount,proto3" json:"retired_amount,omitempty"`
+	// owner is the address of the account that owns the credits.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// operator is the address of the account approved to spend credits.
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// batch_denom is the unique identifier of the credit batch of the allowance,
+	// if the allowance is scoped to a credit batch.
+	BatchDenom string `protobuf:"bytes,3,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// class_id is the unique identifier of the credit class of the allowance, if
+	// the allowance is scoped to a credit class.
+	ClassId string `protobuf:"bytes,4,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// amount is the decimal number of credits the operator is approved to spend.
+	Amount string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *EventMint) Reset() {
-	*x = EventMint{}
+func (x *EventApprove) Reset() {
+	*x = EventApprove{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[3]
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventMint) String() string {
+func (x *EventApprove) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventMint) ProtoMessage() {}
+func (*EventApprove) ProtoMessage() {}
 
-// Deprecated: Use EventMint.ProtoReflect.Descriptor instead.
-func (*EventMint) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use EventApprove.ProtoReflect.Descriptor instead.
+func (*EventApprove) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *EventMint) GetBatchDenom() string {
+func (x *EventApprove) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *EventApprove) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *EventApprove) GetBatchDenom() string {
 	if x != nil {
 		return x.BatchDenom
 	}
 	return ""
 }
 
-func (x *EventMint) GetTradableAmount() string {
+func (x *EventApprove) GetClassId() string {
 	if x != nil {
-		return x.TradableAmount
+		return x.ClassId
 	}
 	return ""
 }
 
-func (x *EventMint) GetRetiredAmount() string {
+func (x *EventApprove) GetAmount() string {
 	if x != nil {
-		return x.RetiredAmount
+		return x.Amount
 	}
 	return ""
 }
 
-// EventMintBatchCredits is an event emitted when credits are minted to an
-// existing open credit batch.
-type EventMintBatchCredits struct {
+// EventRevokeApproval is emitted when an owner revokes an allowance previously
+// granted to an operator.
+type EventRevokeApproval struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// batch_denom is the unique identifier of the credit batch within which the
-	// credits were minted.
-	BatchDenom string `protobuf:"bytes,1,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
-	// origin_tx is the transaction from another chain or registry that triggered
-	// the minting of credits within the credit batch.
-	OriginTx *OriginTx `protobuf:"bytes,2,opt,name=origin_tx,json=originTx,proto3" json:"origin_tx,omitempty"`
+	// owner is the address of the account that owns the credits.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// operator is the address of the account whose allowance was revoked.
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// batch_denom is the unique identifier of the credit batch of the allowance,
+	// if the allowance was scoped to a credit batch.
+	BatchDenom string `protobuf:"bytes,3,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// class_id is the unique identifier of the credit class of the allowance, if
+	// the allowance was scoped to a credit class.
+	ClassId string `protobuf:"bytes,4,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
 }
 
-func (x *EventMintBatchCredits) Reset() {
-	*x = EventMintBatchCredits{}
+func (x *EventRevokeApproval) Reset() {
+	*x = EventRevokeApproval{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[4]
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventMintBatchCredits) String() string {
+func (x *EventRevokeApproval) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventMintBatchCredits) ProtoMessage() {}
+func (*EventRevokeApproval) ProtoMessage() {}
 
-// Deprecated: Use EventMintBatchCredits.ProtoReflect.Descriptor instead.
-func (*EventMintBatchCredits) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use EventRevokeApproval.ProtoReflect.Descriptor instead.
+func (*EventRevokeApproval) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *EventMintBatchCredits) GetBatchDenom() string {
+func (x *EventRevokeApproval) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *EventRevokeApproval) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *EventRevokeApproval) GetBatchDenom() string {
 	if x != nil {
 		return x.BatchDenom
 	}
 	return ""
 }
 
-func (x *EventMintBatchCredits) GetOriginTx() *OriginTx {
+func (x *EventRevokeApproval) GetClassId() string {
 	if x != nil {
-		return x.OriginTx
+		return x.ClassId
 	}
-	return nil
+	return ""
 }
 
-// EventTransfer is an event emitted when credits are transferred from one
-// account to another including transfers to or from a module account.
-type EventTransfer struct {
+// EventLock is emitted when credits are locked for an account.
+type EventLock struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// sender is the sender of the credits. In the case that the credits were
-	// transferred from a base account, this will be the account address. In the
-	// case that the credits were transferred from a module, this will be the
-	// module address (i.e. either the ecocredit module or basket submodule).
-	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	// recipient is the recipient of the credits. In the case that the credits
-	// were transferred to a base account, this will be the account address. In
-	// the case that the credits were transferred to a module, this will be the
-	// module address (i.e. either the ecocredit module or basket submodule).
-	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// lock_id is the unique identifier of the lock.
+	LockId uint64 `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	// owner is the address of the account that owns the locked credits.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
 	// batch_denom is the unique identifier of the credit batch.
 	BatchDenom string `protobuf:"bytes,3,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
-	// tradable_amount is the decimal number of tradable credits received.
-	TradableAmount string `protobuf:"bytes,4,opt,name=tradable_amount,json=tradableAmount,proto3" json:"tradable_amount,omitempty"`
-	// retired_amount is the decimal number of retired credits received.
-	RetiredAmount string `protobuf:"bytes,5,opt,name=retired_amount,json=retiredAmount,proto3" json:"retired_amount,omitempty"`
+	// amount is the number of credits locked.
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *EventTransfer) Reset() {
-	*x = EventTransfer{}
+func (x *EventLock) Reset() {
+	*x = EventLock{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[5]
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventLock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventLock) ProtoMessage() {}
+
+// Deprecated: Use EventLock.ProtoReflect.Descriptor instead.
+func (*EventLock) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *EventLock) GetLockId() uint64 {
+	if x != nil {
+		return x.LockId
+	}
+	return 0
+}
+
+func (x *EventLock) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *EventLock) GetBatchDenom() string {
+	if x != nil {
+		return x.BatchDenom
+	}
+	return ""
+}
+
+func (x *EventLock) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+// EventUnlock is emitted when locked credits are released into the tradable
+// balance of an account.
+type EventUnlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// lock_id is the unique identifier of the lock.
+	LockId uint64 `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	// owner is the address of the account that owns the released credits.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// batch_denom is the unique identifier of the credit batch.
+	BatchDenom string `protobuf:"bytes,3,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// amount is the number of credits released.
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *EventUnlock) Reset() {
+	*x = EventUnlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventUnlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventUnlock) ProtoMessage() {}
+
+// Deprecated: Use EventUnlock.ProtoReflect.Descriptor instead.
+func (*EventUnlock) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *EventUnlock) GetLockId() uint64 {
+	if x != nil {
+		return x.LockId
+	}
+	return 0
+}
+
+func (x *EventUnlock) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *EventUnlock) GetBatchDenom() string {
+	if x != nil {
+		return x.BatchDenom
+	}
+	return ""
+}
+
+func (x *EventUnlock) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+// EventUpdateClassBuffer is emitted when the buffer percentage of a credit class
+// is updated.
+type EventUpdateClassBuffer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// class_id is the unique identifier of the credit class.
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// percentage is the new buffer percentage of the credit class.
+	Percentage string `protobuf:"bytes,2,opt,name=percentage,proto3" json:"percentage,omitempty"`
+}
+
+func (x *EventUpdateClassBuffer) Reset() {
+	*x = EventUpdateClassBuffer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventUpdateClassBuffer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventUpdateClassBuffer) ProtoMessage() {}
+
+// Deprecated: Use EventUpdateClassBuffer.ProtoReflect.Descriptor instead.
+func (*EventUpdateClassBuffer) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *EventUpdateClassBuffer) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *EventUpdateClassBuffer) GetPercentage() string {
+	if x != nil {
+		return x.Percentage
+	}
+	return ""
+}
+
+// EventReversal is emitted when buffer pool credits are cancelled to account
+// for a reversal reported against a project.
+type EventReversal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// reversal_id is the unique identifier of the reversal record.
+	ReversalId uint64 `protobuf:"varint,1,opt,name=reversal_id,json=reversalId,proto3" json:"reversal_id,omitempty"`
+	// project_id is the unique identifier of the project against which the
+	// reversal was reported.
+	ProjectId string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// batch_denom is the unique identifier of the credit batch from which buffer
+	// pool credits were cancelled.
+	BatchDenom string `protobuf:"bytes,3,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// amount is the decimal number of buffer pool credits that were cancelled.
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// evidence is the IRI of the evidence supporting the reversal.
+	Evidence string `protobuf:"bytes,5,opt,name=evidence,proto3" json:"evidence,omitempty"`
+}
+
+func (x *EventReversal) Reset() {
+	*x = EventReversal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventTransfer) String() string {
+func (x *EventReversal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventTransfer) ProtoMessage() {}
+func (*EventReversal) ProtoMessage() {}
 
-// Deprecated: Use EventTransfer.ProtoReflect.Descriptor instead.
-func (*EventTransfer) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use EventReversal.ProtoReflect.Descriptor instead.
+func (*EventReversal) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *EventTransfer) GetSender() string {
+func (x *EventReversal) GetReversalId() uint64 {
 	if x != nil {
-		return x.Sender
+		return x.ReversalId
 	}
-	return ""
+	return 0
 }
 
-func (x *EventTransfer) GetRecipient() string {
+func (x *EventReversal) GetProjectId() string {
 	if x != nil {
-		return x.Recipient
+		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *EventTransfer) GetBatchDenom() string {
+func (x *EventReversal) GetBatchDenom() string {
 	if x != nil {
 		return x.BatchDenom
 	}
 	return ""
 }
 
-func (x *EventTransfer) GetTradableAmount() string {
+func (x *EventReversal) GetAmount() string {
 	if x != nil {
-		return x.TradableAmount
+		return x.Amount
 	}
 	return ""
 }
 
-func (x *EventTransfer) GetRetiredAmount() string {
+func (x *EventReversal) GetEvidence() string {
 	if x != nil {
-		return x.RetiredAmount
+		return x.Evidence
 	}
 	return ""
 }
 
-// EventRetire is an event emitted when credits are retired. When credits are
-// retired from multiple batches in the same transaction, a separate event is
-// emitted for each batch_denom. This allows for easier indexing.
-type EventRetire struct {
+// EventFreezeBatch is emitted when a credit batch is frozen.
+type EventFreezeBatch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// owner is the address of the account that owns the retired credits. This
-	// will be the account receiving credits in the case that credits were retired
-	// upon issuance using Msg/CreateBatch, retired upon transfer using Msg/Send,
-	// retired upon taking from a basket using basket.Msg/Take, or retired upon
-	// purchase using marketplace.Msg/BuyDirect.
-	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	// batch_denom is the unique identifier of the credit batch within which the
-	// credits were retired.
-	BatchDenom string `protobuf:"bytes,2,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
-	// amount is the decimal number of credits that have been retired.
-	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	// jurisdiction is the jurisdiction of the beneficiary or buyer of the retired
-	// credits. It is a string of the form
-	// <country-code>[-<sub-national-code>[ <postal-code>]], with the first two
-	// fields conforming to ISO 3166-2, and postal-code being up to 64
-	// alphanumeric characters.
-	Jurisdiction string `protobuf:"bytes,4,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"`
+	// batch_denom is the unique identifier of the credit batch that was frozen.
+	BatchDenom string `protobuf:"bytes,1,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// evidence is the IRI of the evidence supporting the freeze.
+	Evidence string `protobuf:"bytes,2,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	// reason is the reason the credit batch was frozen.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *EventRetire) Reset() {
-	*x = EventRetire{}
+func (x *EventFreezeBatch) Reset() {
+	*x = EventFreezeBatch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[6]
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventRetire) String() string {
+func (x *EventFreezeBatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventRetire) ProtoMessage() {}
-
-// Deprecated: Use EventRetire.ProtoReflect.Descriptor instead.
-func (*EventRetire) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{6}
-}
+func (*EventFreezeBatch) ProtoMessage() {}
 
-func (x *EventRetire) GetOwner() string {
-	if x != nil {
-		return x.Owner
-	}
-	return ""
+// Deprecated: Use EventFreezeBatch.ProtoReflect.Descriptor instead.
+func (*EventFreezeBatch) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *EventRetire) GetBatchDenom() string {
+func (x *EventFreezeBatch) GetBatchDenom() string {
 	if x != nil {
 		return x.BatchDenom
 	}
 	return ""
 }
 
-func (x *EventRetire) GetAmount() string {
+func (x *EventFreezeBatch) GetEvidence() string {
 	if x != nil {
-		return x.Amount
+		return x.Evidence
 	}
 	return ""
 }
 
-func (x *EventRetire) GetJurisdiction() string {
+func (x *EventFreezeBatch) GetReason() string {
 	if x != nil {
-		return x.Jurisdiction
+		return x.Reason
 	}
 	return ""
 }
 
-// EventCancel is an event emitted when credits are cancelled. When credits are
-// cancelled from multiple batches in the same transaction, a separate event is
-// emitted for each batch_denom. This allows for easier indexing.
-type EventCancel struct {
+// EventUnfreezeBatch is emitted when a credit batch is unfrozen.
+type EventUnfreezeBatch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// owner is the address of the account that cancelled the credits.
-	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	// batch_denom is the unique identifier of the credit batch within which the
-	// credits were cancelled.
-	BatchDenom string `protobuf:"bytes,2,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
-	// amount is the decimal number of credits that have been cancelled.
-	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	// reason is the reason the credits were cancelled.
-	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// batch_denom is the unique identifier of the credit batch that was
+	// unfrozen.
+	BatchDenom string `protobuf:"bytes,1,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// evidence is the IRI of the evidence supporting the unfreeze.
+	Evidence string `protobuf:"bytes,2,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	// reason is the reason the credit batch was unfrozen.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *EventCancel) Reset() {
-	*x = EventCancel{}
+func (x *EventUnfreezeBatch) Reset() {
+	*x = EventUnfreezeBatch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[7]
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventCancel) String() string {
+func (x *EventUnfreezeBatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventCancel) ProtoMessage() {}
-
-// Deprecated: Use EventCancel.ProtoReflect.Descriptor instead.
-func (*EventCancel) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{7}
-}
+func (*EventUnfreezeBatch) ProtoMessage() {}
 
-func (x *EventCancel) GetOwner() string {
-	if x != nil {
-		return x.Owner
-	}
-	return ""
+// Deprecated: Use EventUnfreezeBatch.ProtoReflect.Descriptor instead.
+func (*EventUnfreezeBatch) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *EventCancel) GetBatchDenom() string {
+func (x *EventUnfreezeBatch) GetBatchDenom() string {
 	if x != nil {
 		return x.BatchDenom
 	}
 	return ""
 }
 
-func (x *EventCancel) GetAmount() string {
+func (x *EventUnfreezeBatch) GetEvidence() string {
 	if x != nil {
-		return x.Amount
+		return x.Evidence
 	}
 	return ""
 }
 
-func (x *EventCancel) GetReason() string {
+func (x *EventUnfreezeBatch) GetReason() string {
 	if x != nil {
 		return x.Reason
 	}
 	return ""
 }
 
-// EventUpdateClassAdmin is emitted when the admin address of a credit class is
-// changed.
-type EventUpdateClassAdmin struct {
+// EventUpdateProjectStatus is emitted when the status of a project is updated.
+type EventUpdateProjectStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// class_id is the unique identifier of the class that was updated.
-	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// project_id is the unique identifier of the project.
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// previous_status is the status of the project before the update.
+	PreviousStatus ProjectStatus `protobuf:"varint,2,opt,name=previous_status,json=previousStatus,proto3,enum=regen.ecocredit.v1.ProjectStatus" json:"previous_status,omitempty"`
+	// status is the status of the project after the update.
+	Status ProjectStatus `protobuf:"varint,3,opt,name=status,proto3,enum=regen.ecocredit.v1.ProjectStatus" json:"status,omitempty"`
+	// reason is the IRI of the document describing the reason for the update.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *EventUpdateClassAdmin) Reset() {
-	*x = EventUpdateClassAdmin{}
+func (x *EventUpdateProjectStatus) Reset() {
+	*x = EventUpdateProjectStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[8]
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventUpdateClassAdmin) String() string {
+func (x *EventUpdateProjectStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventUpdateClassAdmin) ProtoMessage() {}
+func (*EventUpdateProjectStatus) ProtoMessage() {}
 
-// Deprecated: Use EventUpdateClassAdmin.ProtoReflect.Descriptor instead.
-func (*EventUpdateClassAdmin) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use EventUpdateProjectStatus.ProtoReflect.Descriptor instead.
+func (*EventUpdateProjectStatus) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *EventUpdateClassAdmin) GetClassId() string {
+func (x *EventUpdateProjectStatus) GetProjectId() string {
 	if x != nil {
-		return x.ClassId
+		return x.ProjectId
 	}
 	return ""
 }
 
-// EventUpdateClassIssuers is emitted when the issuer list for a credit class
-// is updated.
-type EventUpdateClassIssuers struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// class_id is the unique identifier of the class that was updated.
-	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
-}
-
-func (x *EventUpdateClassIssuers) Reset() {
-	*x = EventUpdateClassIssuers{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *EventUpdateProjectStatus) GetPreviousStatus() ProjectStatus {
+	if x != nil {
+		return x.PreviousStatus
 	}
+	return ProjectStatus_PROJECT_STATUS_UNSPECIFIED
 }
 
-func (x *EventUpdateClassIssuers) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*EventUpdateClassIssuers) ProtoMessage() {}
-
-// Deprecated: Use EventUpdateClassIssuers.ProtoReflect.Descriptor instead.
-func (*EventUpdateClassIssuers) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{9}
+func (x *EventUpdateProjectStatus) GetStatus() ProjectStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProjectStatus_PROJECT_STATUS_UNSPECIFIED
 }
 
-func (x *EventUpdateClassIssuers) GetClassId() string {
+func (x *EventUpdateProjectStatus) GetReason() string {
 	if x != nil {
-		return x.ClassId
+		return x.Reason
 	}
 	return ""
 }
 
-// EventUpdateClassMetadata is emitted when the credit class metadata is
-// changed.
-type EventUpdateClassMetadata struct {
+// EventUpdateBridgeOperators is emitted when the bridge operator set of a
+// credit class is updated.
+type EventUpdateBridgeOperators struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// class_id is the unique identifier of the class that was updated.
+	// class_id is the unique identifier of the credit class.
 	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// source is the source chain or registry of the bridge operator set.
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
 }
 
-func (x *EventUpdateClassMetadata) Reset() {
-	*x = EventUpdateClassMetadata{}
+func (x *EventUpdateBridgeOperators) Reset() {
+	*x = EventUpdateBridgeOperators{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[10]
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventUpdateClassMetadata) String() string {
+func (x *EventUpdateBridgeOperators) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventUpdateClassMetadata) ProtoMessage() {}
+func (*EventUpdateBridgeOperators) ProtoMessage() {}
 
-// Deprecated: Use EventUpdateClassMetadata.ProtoReflect.Descriptor instead.
-func (*EventUpdateClassMetadata) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use EventUpdateBridgeOperators.ProtoReflect.Descriptor instead.
+func (*EventUpdateBridgeOperators) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *EventUpdateClassMetadata) GetClassId() string {
+func (x *EventUpdateBridgeOperators) GetClassId() string {
 	if x != nil {
 		return x.ClassId
 	}
 	return ""
 }
 
-// EventUpdateProjectAdmin is emitted when the project admin is changed.
-type EventUpdateProjectAdmin struct {
+func (x *EventUpdateBridgeOperators) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+// EventAttestBridgeReceive is emitted when a bridge operator attests to
+// credits being bridged from another chain.
+type EventAttestBridgeReceive struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// project_id is the unique identifier of the project that was updated.
-	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-}
-
-func (x *EventUpdateProjectAdmin) Reset() {
-	*x = EventUpdateProjectAdmin{}
+	// class_id is the unique identifier of the credit class.
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// operator is the address of the account of the bridge operator.
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// origin_tx_id is the transaction ID of the originating transaction.
+	OriginTxId string `protobuf:"bytes,3,opt,name=origin_tx_id,json=originTxId,proto3" json:"origin_tx_id,omitempty"`
+	// origin_tx_source is the source chain or registry of the originating
+	// transaction.
+	OriginTxSource string `protobuf:"bytes,4,opt,name=origin_tx_source,json=originTxSource,proto3" json:"origin_tx_source,omitempty"`
+	// attestations is the number of bridge operators that have attested to the
+	// origin transaction.
+	Attestations uint32 `protobuf:"varint,5,opt,name=attestations,proto3" json:"attestations,omitempty"`
+	// threshold is the threshold of the bridge operator set.
+	Threshold uint32 `protobuf:"varint,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (x *EventAttestBridgeReceive) Reset() {
+	*x = EventAttestBridgeReceive{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[11]
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventUpdateProjectAdmin) String() string {
+func (x *EventAttestBridgeReceive) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventUpdateProjectAdmin) ProtoMessage() {}
+func (*EventAttestBridgeReceive) ProtoMessage() {}
 
-// Deprecated: Use EventUpdateProjectAdmin.ProtoReflect.Descriptor instead.
-func (*EventUpdateProjectAdmin) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use EventAttestBridgeReceive.ProtoReflect.Descriptor instead.
+func (*EventAttestBridgeReceive) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *EventUpdateProjectAdmin) GetProjectId() string {
+func (x *EventAttestBridgeReceive) GetClassId() string {
 	if x != nil {
-		return x.ProjectId
+		return x.ClassId
 	}
 	return ""
 }
 
-// EventUpdateProjectMetadata is emitted when the project metadata is changed.
-type EventUpdateProjectMetadata struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// project_id is the unique identifier of the project that was updated.
-	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+func (x *EventAttestBridgeReceive) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
 }
 
-func (x *EventUpdateProjectMetadata) Reset() {
-	*x = EventUpdateProjectMetadata{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *EventAttestBridgeReceive) GetOriginTxId() string {
+	if x != nil {
+		return x.OriginTxId
 	}
+	return ""
 }
 
-func (x *EventUpdateProjectMetadata) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *EventAttestBridgeReceive) GetOriginTxSource() string {
+	if x != nil {
+		return x.OriginTxSource
+	}
+	return ""
 }
 
-func (*EventUpdateProjectMetadata) ProtoMessage() {}
-
-// Deprecated: Use EventUpdateProjectMetadata.ProtoReflect.Descriptor instead.
-func (*EventUpdateProjectMetadata) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{12}
+func (x *EventAttestBridgeReceive) GetAttestations() uint32 {
+	if x != nil {
+		return x.Attestations
+	}
+	return 0
 }
 
-func (x *EventUpdateProjectMetadata) GetProjectId() string {
+func (x *EventAttestBridgeReceive) GetThreshold() uint32 {
 	if x != nil {
-		return x.ProjectId
+		return x.Threshold
 	}
-	return ""
+	return 0
 }
 
-// EventSealBatch is emitted when a batch is sealed.
-type EventSealBatch struct {
+// EventBridgeAttestationExpired is emitted when a pending bridge attestation
+// expires before reaching the threshold and is removed from state.
+type EventBridgeAttestationExpired struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// batch_denom is the denom of the batch that was sealed.
-	BatchDenom string `protobuf:"bytes,1,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// class_id is the unique identifier of the credit class.
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// origin_tx_id is the transaction ID of the originating transaction.
+	OriginTxId string `protobuf:"bytes,2,opt,name=origin_tx_id,json=originTxId,proto3" json:"origin_tx_id,omitempty"`
+	// origin_tx_source is the source chain or registry of the originating
+	// transaction.
+	OriginTxSource string `protobuf:"bytes,3,opt,name=origin_tx_source,json=originTxSource,proto3" json:"origin_tx_source,omitempty"`
 }
 
-func (x *EventSealBatch) Reset() {
-	*x = EventSealBatch{}
+func (x *EventBridgeAttestationExpired) Reset() {
+	*x = EventBridgeAttestationExpired{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[13]
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventSealBatch) String() string {
+func (x *EventBridgeAttestationExpired) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventSealBatch) ProtoMessage() {}
+func (*EventBridgeAttestationExpired) ProtoMessage() {}
 
-// Deprecated: Use EventSealBatch.ProtoReflect.Descriptor instead.
-func (*EventSealBatch) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use EventBridgeAttestationExpired.ProtoReflect.Descriptor instead.
+func (*EventBridgeAttestationExpired) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *EventSealBatch) GetBatchDenom() string {
+func (x *EventBridgeAttestationExpired) GetClassId() string {
 	if x != nil {
-		return x.BatchDenom
+		return x.ClassId
 	}
 	return ""
 }
 
-// EventAddCreditType is emitted when governance approves a new credit type.
-type EventAddCreditType struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// abbreviation is the abbreviation of the credit type.
-	Abbreviation string `protobuf:"bytes,1,opt,name=abbreviation,proto3" json:"abbreviation,omitempty"`
-}
-
-func (x *EventAddCreditType) Reset() {
-	*x = EventAddCreditType{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *EventAddCreditType) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*EventAddCreditType) ProtoMessage() {}
-
-// Deprecated: Use EventAddCreditType.ProtoReflect.Descriptor instead.
-func (*EventAddCreditType) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{14}
+func (x *EventBridgeAttestationExpired) GetOriginTxId() string {
+	if x != nil {
+		return x.OriginTxId
+	}
+	return ""
 }
 
-func (x *EventAddCreditType) GetAbbreviation() string {
+func (x *EventBridgeAttestationExpired) GetOriginTxSource() string {
 	if x != nil {
-		return x.Abbreviation
+		return x.OriginTxSource
 	}
 	return ""
 }
 
-// EventBridge is emitted when credits are bridged to another chain.
-type EventBridge struct {
+// EventBridgeAck is emitted when a bridge operator acknowledges an outbound
+// bridge request.
+type EventBridgeAck struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// target is the target chain.
-	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
-	// recipient is the recipient address.
-	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
-	// contract is the contract address.
-	Contract string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
-	// amount is the amount of credits.
-	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
-}
-
-func (x *EventBridge) Reset() {
-	*x = EventBridge{}
+	// request_id is the unique identifier of the outbound bridge request.
+	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// operator is the address of the account of the bridge operator.
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// status is the status with which the operator acknowledged the outbound
+	// bridge request.
+	Status OutboundBridgeStatus `protobuf:"varint,3,opt,name=status,proto3,enum=regen.ecocredit.v1.OutboundBridgeStatus" json:"status,omitempty"`
+	// target_tx_hash is the hash of the transaction on the target chain that
+	// completed the transfer.
+	TargetTxHash string `protobuf:"bytes,4,opt,name=target_tx_hash,json=targetTxHash,proto3" json:"target_tx_hash,omitempty"`
+	// attestations is the number of bridge operators that have acknowledged the
+	// outbound bridge request as failed. Only set for failed acknowledgements.
+	Attestations uint32 `protobuf:"varint,5,opt,name=attestations,proto3" json:"attestations,omitempty"`
+	// threshold is the threshold of the bridge operator set. Only set for failed
+	// acknowledgements.
+	Threshold uint32 `protobuf:"varint,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (x *EventBridgeAck) Reset() {
+	*x = EventBridgeAck{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[15]
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventBridge) String() string {
+func (x *EventBridgeAck) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventBridge) ProtoMessage() {}
+func (*EventBridgeAck) ProtoMessage() {}
 
-// Deprecated: Use EventBridge.ProtoReflect.Descriptor instead.
-func (*EventBridge) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use EventBridgeAck.ProtoReflect.Descriptor instead.
+func (*EventBridgeAck) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *EventBridge) GetTarget() string {
+func (x *EventBridgeAck) GetRequestId() uint64 {
 	if x != nil {
-		return x.Target
+		return x.RequestId
 	}
-	return ""
+	return 0
 }
 
-func (x *EventBridge) GetRecipient() string {
+func (x *EventBridgeAck) GetOperator() string {
 	if x != nil {
-		return x.Recipient
+		return x.Operator
 	}
 	return ""
 }
 
-func (x *EventBridge) GetContract() string {
+func (x *EventBridgeAck) GetStatus() OutboundBridgeStatus {
 	if x != nil {
-		return x.Contract
+		return x.Status
 	}
-	return ""
+	return OutboundBridgeStatus_OUTBOUND_BRIDGE_STATUS_UNSPECIFIED
 }
 
-func (x *EventBridge) GetAmount() string {
+func (x *EventBridgeAck) GetTargetTxHash() string {
 	if x != nil {
-		return x.Amount
+		return x.TargetTxHash
 	}
 	return ""
 }
 
-// EventBridgeReceive is emitted when credits are bridged from another chain.
-type EventBridgeReceive struct {
+func (x *EventBridgeAck) GetAttestations() uint32 {
+	if x != nil {
+		return x.Attestations
+	}
+	return 0
+}
+
+func (x *EventBridgeAck) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+// EventBridgeRefund is emitted when the credits of a failed or timed out
+// outbound bridge request are refunded to the owner.
+type EventBridgeRefund struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// project_id is the unique identifier of the project that was either created
-	// or the existing project within which the credit batch exists.
-	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	// batch_denom is the unique identifier of the credit batch either created
-	// or within which the credits were dynamically minted.
-	BatchDenom string `protobuf:"bytes,2,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// request_id is the unique identifier of the outbound bridge request.
+	RequestId uint64 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// owner is the address of the account to which the credits were refunded.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// batch_denom is the unique identifier of the credit batch.
+	BatchDenom string `protobuf:"bytes,3,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// amount is the number of credits refunded.
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *EventBridgeReceive) Reset() {
-	*x = EventBridgeReceive{}
+func (x *EventBridgeRefund) Reset() {
+	*x = EventBridgeRefund{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[16]
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventBridgeReceive) String() string {
+func (x *EventBridgeRefund) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventBridgeReceive) ProtoMessage() {}
+func (*EventBridgeRefund) ProtoMessage() {}
 
-// Deprecated: Use EventBridgeReceive.ProtoReflect.Descriptor instead.
-func (*EventBridgeReceive) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use EventBridgeRefund.ProtoReflect.Descriptor instead.
+func (*EventBridgeRefund) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *EventBridgeReceive) GetProjectId() string {
+func (x *EventBridgeRefund) GetRequestId() uint64 {
 	if x != nil {
-		return x.ProjectId
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *EventBridgeRefund) GetOwner() string {
+	if x != nil {
+		return x.Owner
 	}
 	return ""
 }
 
-func (x *EventBridgeReceive) GetBatchDenom() string {
+func (x *EventBridgeRefund) GetBatchDenom() string {
 	if x != nil {
 		return x.BatchDenom
 	}
 	return ""
 }
 
+func (x *EventBridgeRefund) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
 var File_regen_ecocredit_v1_events_proto protoreflect.FileDescriptor
 
 var file_regen_ecocredit_v1_events_proto_rawDesc = []byte{
@@ -9177,6 +19843,8 @@ var file_regen_ecocredit_v1_events_proto_rawDesc = []byte{
 	0x74, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x12, 0x12, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64,
 	0x69, 0x74, 0x2e, 0x76, 0x31, 0x1a, 0x1e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x63, 0x6f,
+	0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x63, 0x6f,
 	0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2d, 0x0a, 0x10, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x72,
 	0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61,
@@ -9206,7 +19874,7 @@ var file_regen_ecocredit_v1_events_proto_rawDesc = []byte{
 	0x6d, 0x12, 0x39, 0x0a, 0x09, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x74, 0x78, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f,
 	0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e,
-	0x54, 0x78, 0x52, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x54, 0x78, 0x22, 0xb6, 0x01, 0x0a,
+	0x54, 0x78, 0x52, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x54, 0x78, 0x22, 0xdb, 0x01, 0x0a,
 	0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x16,
 	0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
 	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69,
@@ -9218,74 +19886,228 @@ var file_regen_ecocredit_v1_events_proto_rawDesc = []byte{
 	0x74, 0x72, 0x61, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25,
 	0x0a, 0x0e, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
 	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x64, 0x41,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x80, 0x01, 0x0a, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x74, 0x69, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x99, 0x02, 0x0a, 0x0b, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x74, 0x69, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6e, 0x6f,
+	0x6d, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6a, 0x75, 0x72,
+	0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x6a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x72, 0x65,
+	0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x62, 0x65,
+	0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63,
+	0x69, 0x61, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x62,
+	0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61,
+	0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x74, 0x0a, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x62,
 	0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06,
 	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6a, 0x75, 0x72, 0x69,
-	0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x74, 0x0a, 0x0b, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1f, 0x0a,
-	0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x32,
-	0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x61,
-	0x73, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73,
-	0x49, 0x64, 0x22, 0x34, 0x0a, 0x17, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x73, 0x12, 0x19, 0x0a,
-	0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x22, 0x35, 0x0a, 0x18, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x22,
-	0x38, 0x0a, 0x17, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0x3b, 0x0a, 0x1a, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0x31, 0x0a, 0x0e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53,
-	0x65, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x74, 0x63,
-	0x68, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62,
-	0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x38, 0x0a, 0x12, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x41, 0x64, 0x64, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x22, 0x0a, 0x0c, 0x61, 0x62, 0x62, 0x72, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x62, 0x62, 0x72, 0x65, 0x76, 0x69, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x77, 0x0a, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x72, 0x69, 0x64,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x32, 0x0a, 0x15,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64,
+	0x22, 0x34, 0x0a, 0x17, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x22, 0x35, 0x0a, 0x18, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x22, 0x38, 0x0a,
+	0x17, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0x3b, 0x0a, 0x1a, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x49, 0x64, 0x22, 0x3b, 0x0a, 0x18, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6e, 0x6f,
+	0x6d, 0x22, 0x31, 0x0a, 0x0e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x61, 0x6c, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44,
+	0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x38, 0x0a, 0x12, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64,
+	0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x62,
+	0x62, 0x72, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x61, 0x62, 0x62, 0x72, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x91,
+	0x01, 0x0a, 0x1e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x62, 0x62, 0x72, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x62, 0x62, 0x72, 0x65, 0x76, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x5f, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x72, 0x65, 0x63, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x96, 0x01, 0x0a, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x72, 0x69, 0x64,
 	0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
 	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
 	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74,
 	0x72, 0x61, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74,
 	0x72, 0x61, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x54, 0x0a, 0x12,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69,
-	0x76, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x54, 0x0a, 0x12, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6e, 0x6f,
+	0x6d, 0x22, 0x94, 0x01, 0x0a, 0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x83, 0x01, 0x0a, 0x13, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65,
+	0x6e, 0x6f, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x22, 0x73,
+	0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x17, 0x0a, 0x07, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x6f,
+	0x63, 0x6b, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x75, 0x0a, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6e,
+	0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x53, 0x0a, 0x16, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x42, 0x75,
+	0x66, 0x66, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x12,
+	0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22,
+	0xa4, 0x01, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49,
 	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6e,
-	0x6f, 0x6d, 0x42, 0xd9, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e,
-	0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x48, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6c, 0x65, 0x64,
-	0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x63,
-	0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x63, 0x6f, 0x63, 0x72,
-	0x65, 0x64, 0x69, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x52, 0x45, 0x58, 0xaa, 0x02, 0x12, 0x52,
-	0x65, 0x67, 0x65, 0x6e, 0x2e, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x56,
-	0x31, 0xca, 0x02, 0x12, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65,
-	0x64, 0x69, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45,
-	0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x3a,
-	0x3a, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6e,
+	0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76,
+	0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76,
+	0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x67, 0x0a, 0x10, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46,
+	0x72, 0x65, 0x65, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x65,
+	0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65,
+	0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
+	0x69, 0x0a, 0x12, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xd8, 0x01, 0x0a, 0x18, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x4a, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x21, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x21, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x4f, 0x0a, 0x1a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xdf, 0x01, 0x0a, 0x18, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0c, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x54, 0x78, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x74, 0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x54, 0x78,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x61, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x86, 0x01, 0x0a, 0x1d, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f,
+	0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x54, 0x78, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x5f, 0x74, 0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x54, 0x78, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x22, 0xf5, 0x01, 0x0a, 0x0e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x41, 0x63, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x40, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x28, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x78, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x61,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x81, 0x01, 0x0a, 0x11, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0xd9, 0x01,
+	0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x48, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x76,
+	0x31, 0xa2, 0x02, 0x03, 0x52, 0x45, 0x58, 0xaa, 0x02, 0x12, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x2e,
+	0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x12, 0x52,
+	0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5c, 0x56,
+	0x31, 0xe2, 0x02, 0x1e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x14, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x3a, 0x3a, 0x45, 0x63, 0x6f, 0x63,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -9300,35 +20122,56 @@ func file_regen_ecocredit_v1_events_proto_rawDescGZIP() []byte {
 	return file_regen_ecocredit_v1_events_proto_rawDescData
 }
 
-var file_regen_ecocredit_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_regen_ecocredit_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_regen_ecocredit_v1_events_proto_goTypes = []interface{}{
-	(*EventCreateClass)(nil),           // 0: regen.ecocredit.v1.EventCreateClass
-	(*EventCreateProject)(nil),         // 1: regen.ecocredit.v1.EventCreateProject
-	(*EventCreateBatch)(nil),           // 2: regen.ecocredit.v1.EventCreateBatch
-	(*EventMint)(nil),                  // 3: regen.ecocredit.v1.EventMint
-	(*EventMintBatchCredits)(nil),      // 4: regen.ecocredit.v1.EventMintBatchCredits
-	(*EventTransfer)(nil),              // 5: regen.ecocredit.v1.EventTransfer
-	(*EventRetire)(nil),                // 6: regen.ecocredit.v1.EventRetire
-	(*EventCancel)(nil),                // 7: regen.ecocredit.v1.EventCancel
-	(*EventUpdateClassAdmin)(nil),      // 8: regen.ecocredit.v1.EventUpdateClassAdmin
-	(*EventUpdateClassIssuers)(nil),    // 9: regen.ecocredit.v1.EventUpdateClassIssuers
-	(*EventUpdateClassMetadata)(nil),   // 10: regen.ecocredit.v1.EventUpdateClassMetadata
-	(*EventUpdateProjectAdmin)(nil),    // 11: regen.ecocredit.v1.EventUpdateProjectAdmin
-	(*EventUpdateProjectMetadata)(nil), // 12: regen.ecocredit.v1.EventUpdateProjectMetadata
-	(*EventSealBatch)(nil),             // 13: regen.ecocredit.v1.EventSealBatch
-	(*EventAddCreditType)(nil),         // 14: regen.ecocredit.v1.EventAddCreditType
-	(*EventBridge)(nil),                // 15: regen.ecocredit.v1.EventBridge
-	(*EventBridgeReceive)(nil),         // 16: regen.ecocredit.v1.EventBridgeReceive
-	(*OriginTx)(nil),                   // 17: regen.ecocredit.v1.OriginTx
+	(*EventCreateClass)(nil),               // 0: regen.ecocredit.v1.EventCreateClass
+	(*EventCreateProject)(nil),             // 1: regen.ecocredit.v1.EventCreateProject
+	(*EventCreateBatch)(nil),               // 2: regen.ecocredit.v1.EventCreateBatch
+	(*EventMint)(nil),                      // 3: regen.ecocredit.v1.EventMint
+	(*EventMintBatchCredits)(nil),          // 4: regen.ecocredit.v1.EventMintBatchCredits
+	(*EventTransfer)(nil),                  // 5: regen.ecocredit.v1.EventTransfer
+	(*EventRetire)(nil),                    // 6: regen.ecocredit.v1.EventRetire
+	(*EventCancel)(nil),                    // 7: regen.ecocredit.v1.EventCancel
+	(*EventUpdateClassAdmin)(nil),          // 8: regen.ecocredit.v1.EventUpdateClassAdmin
+	(*EventUpdateClassIssuers)(nil),        // 9: regen.ecocredit.v1.EventUpdateClassIssuers
+	(*EventUpdateClassMetadata)(nil),       // 10: regen.ecocredit.v1.EventUpdateClassMetadata
+	(*EventUpdateProjectAdmin)(nil),        // 11: regen.ecocredit.v1.EventUpdateProjectAdmin
+	(*EventUpdateProjectMetadata)(nil),     // 12: regen.ecocredit.v1.EventUpdateProjectMetadata
+	(*EventUpdateBatchMetadata)(nil),       // 13: regen.ecocredit.v1.EventUpdateBatchMetadata
+	(*EventSealBatch)(nil),                 // 14: regen.ecocredit.v1.EventSealBatch
+	(*EventAddCreditType)(nil),             // 15: regen.ecocredit.v1.EventAddCreditType
+	(*EventUpdateCreditTypePrecision)(nil), // 16: regen.ecocredit.v1.EventUpdateCreditTypePrecision
+	(*EventBridge)(nil),                    // 17: regen.ecocredit.v1.EventBridge
+	(*EventBridgeReceive)(nil),             // 18: regen.ecocredit.v1.EventBridgeReceive
+	(*EventApprove)(nil),                   // 19: regen.ecocredit.v1.EventApprove
+	(*EventRevokeApproval)(nil),            // 20: regen.ecocredit.v1.EventRevokeApproval
+	(*EventLock)(nil),                      // 21: regen.ecocredit.v1.EventLock
+	(*EventUnlock)(nil),                    // 22: regen.ecocredit.v1.EventUnlock
+	(*EventUpdateClassBuffer)(nil),         // 23: regen.ecocredit.v1.EventUpdateClassBuffer
+	(*EventReversal)(nil),                  // 24: regen.ecocredit.v1.EventReversal
+	(*EventFreezeBatch)(nil),               // 25: regen.ecocredit.v1.EventFreezeBatch
+	(*EventUnfreezeBatch)(nil),             // 26: regen.ecocredit.v1.EventUnfreezeBatch
+	(*EventUpdateProjectStatus)(nil),       // 27: regen.ecocredit.v1.EventUpdateProjectStatus
+	(*EventUpdateBridgeOperators)(nil),     // 28: regen.ecocredit.v1.EventUpdateBridgeOperators
+	(*EventAttestBridgeReceive)(nil),       // 29: regen.ecocredit.v1.EventAttestBridgeReceive
+	(*EventBridgeAttestationExpired)(nil),  // 30: regen.ecocredit.v1.EventBridgeAttestationExpired
+	(*EventBridgeAck)(nil),                 // 31: regen.ecocredit.v1.EventBridgeAck
+	(*EventBridgeRefund)(nil),              // 32: regen.ecocredit.v1.EventBridgeRefund
+	(*OriginTx)(nil),                       // 33: regen.ecocredit.v1.OriginTx
+	(ProjectStatus)(0),                     // 34: regen.ecocredit.v1.ProjectStatus
+	(OutboundBridgeStatus)(0),              // 35: regen.ecocredit.v1.OutboundBridgeStatus
 }
 var file_regen_ecocredit_v1_events_proto_depIdxs = []int32{
-	17, // 0: regen.ecocredit.v1.EventCreateBatch.origin_tx:type_name -> regen.ecocredit.v1.OriginTx
-	17, // 1: regen.ecocredit.v1.EventMintBatchCredits.origin_tx:type_name -> regen.ecocredit.v1.OriginTx
-	2,  // [2:2] is the sub-list for method output_type
-	2,  // [2:2] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	33, // 0: regen.ecocredit.v1.EventCreateBatch.origin_tx:type_name -> regen.ecocredit.v1.OriginTx
+	33, // 1: regen.ecocredit.v1.EventMintBatchCredits.origin_tx:type_name -> regen.ecocredit.v1.OriginTx
+	34, // 2: regen.ecocredit.v1.EventUpdateProjectStatus.previous_status:type_name -> regen.ecocredit.v1.ProjectStatus
+	34, // 3: regen.ecocredit.v1.EventUpdateProjectStatus.status:type_name -> regen.ecocredit.v1.ProjectStatus
+	35, // 4: regen.ecocredit.v1.EventBridgeAck.status:type_name -> regen.ecocredit.v1.OutboundBridgeStatus
+	5,  // [5:5] is the sub-list for method output_type
+	5,  // [5:5] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_regen_ecocredit_v1_events_proto_init() }
@@ -9336,6 +20179,7 @@ func file_regen_ecocredit_v1_events_proto_init() {
 	if File_regen_ecocredit_v1_events_proto != nil {
 		return
 	}
+	file_regen_ecocredit_v1_state_proto_init()
 	file_regen_ecocredit_v1_types_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_regen_ecocredit_v1_events_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
@@ -9495,7 +20339,7 @@ func file_regen_ecocredit_v1_events_proto_init() {
 			}
 		}
 		file_regen_ecocredit_v1_events_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventSealBatch); i {
+			switch v := v.(*EventUpdateBatchMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9507,7 +20351,7 @@ func file_regen_ecocredit_v1_events_proto_init() {
 			}
 		}
 		file_regen_ecocredit_v1_events_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventAddCreditType); i {
+			switch v := v.(*EventSealBatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9519,7 +20363,7 @@ func file_regen_ecocredit_v1_events_proto_init() {
 			}
 		}
 		file_regen_ecocredit_v1_events_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventBridge); i {
+			switch v := v.(*EventAddCreditType); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9531,6 +20375,30 @@ func file_regen_ecocredit_v1_events_proto_init() {
 			}
 		}
 		file_regen_ecocredit_v1_events_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventUpdateCreditTypePrecision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_v1_events_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventBridge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_v1_events_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventBridgeReceive); i {
 			case 0:
 				return &v.state
@@ -9542,6 +20410,174 @@ func file_regen_ecocredit_v1_events_proto_init() {
 				return nil
 			}
 		}
+		file_regen_ecocredit_v1_events_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventApprove); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_v1_events_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventRevokeApproval); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_v1_events_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventLock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_v1_events_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventUnlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_v1_events_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventUpdateClassBuffer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_v1_events_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventReversal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_v1_events_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventFreezeBatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_v1_events_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventUnfreezeBatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_v1_events_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventUpdateProjectStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_v1_events_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventUpdateBridgeOperators); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_v1_events_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventAttestBridgeReceive); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_v1_events_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventBridgeAttestationExpired); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_v1_events_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventBridgeAck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_v1_events_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventBridgeRefund); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -9549,7 +20585,7 @@ func file_regen_ecocredit_v1_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_regen_ecocredit_v1_events_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -68,6 +68,56 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// CreditType queries credit type information by abbreviation.
 	CreditType(ctx context.Context, in *QueryCreditTypeRequest, opts ...grpc.CallOption) (*QueryCreditTypeResponse, error)
+	// Retirement queries a retirement record by its unique identifier.
+	Retirement(ctx context.Context, in *QueryRetirementRequest, opts ...grpc.CallOption) (*QueryRetirementResponse, error)
+	// RetirementsByOwner queries all retirement records of credits retired by a
+	// given account address.
+	RetirementsByOwner(ctx context.Context, in *QueryRetirementsByOwnerRequest, opts ...grpc.CallOption) (*QueryRetirementsByOwnerResponse, error)
+	// RetirementsByBatch queries all retirement records of credits retired from
+	// a given credit batch.
+	RetirementsByBatch(ctx context.Context, in *QueryRetirementsByBatchRequest, opts ...grpc.CallOption) (*QueryRetirementsByBatchResponse, error)
+	// RetirementsByProject queries all retirement records of credits retired
+	// from credit batches issued within a given project.
+	RetirementsByProject(ctx context.Context, in *QueryRetirementsByProjectRequest, opts ...grpc.CallOption) (*QueryRetirementsByProjectResponse, error)
+	// Allowance queries the allowance an owner has granted an operator for a
+	// given credit batch or credit class.
+	Allowance(ctx context.Context, in *QueryAllowanceRequest, opts ...grpc.CallOption) (*QueryAllowanceResponse, error)
+	// LocksByAddress queries all pending locks of credits owned by a given
+	// account.
+	LocksByAddress(ctx context.Context, in *QueryLocksByAddressRequest, opts ...grpc.CallOption) (*QueryLocksByAddressResponse, error)
+	// AllowancesByOwner queries all allowances a given owner has granted.
+	AllowancesByOwner(ctx context.Context, in *QueryAllowancesByOwnerRequest, opts ...grpc.CallOption) (*QueryAllowancesByOwnerResponse, error)
+	// ClassBuffer queries the buffer percentage and buffer pool account of a
+	// given credit class.
+	ClassBuffer(ctx context.Context, in *QueryClassBufferRequest, opts ...grpc.CallOption) (*QueryClassBufferResponse, error)
+	// ReversalsByProject queries all reversal records of a given project.
+	ReversalsByProject(ctx context.Context, in *QueryReversalsByProjectRequest, opts ...grpc.CallOption) (*QueryReversalsByProjectResponse, error)
+	// ProjectsByStatus queries for all projects with a given lifecycle status
+	// with pagination.
+	ProjectsByStatus(ctx context.Context, in *QueryProjectsByStatusRequest, opts ...grpc.CallOption) (*QueryProjectsByStatusResponse, error)
+	// ProjectStatusHistory queries the status changes of a given project with
+	// pagination.
+	ProjectStatusHistory(ctx context.Context, in *QueryProjectStatusHistoryRequest, opts ...grpc.CallOption) (*QueryProjectStatusHistoryResponse, error)
+	// ClassMetadataHistory queries the metadata changes of a given credit class
+	// with pagination.
+	ClassMetadataHistory(ctx context.Context, in *QueryClassMetadataHistoryRequest, opts ...grpc.CallOption) (*QueryClassMetadataHistoryResponse, error)
+	// ProjectMetadataHistory queries the metadata changes of a given project
+	// with pagination.
+	ProjectMetadataHistory(ctx context.Context, in *QueryProjectMetadataHistoryRequest, opts ...grpc.CallOption) (*QueryProjectMetadataHistoryResponse, error)
+	// BatchMetadataHistory queries the metadata changes of a given credit batch
+	// with pagination.
+	BatchMetadataHistory(ctx context.Context, in *QueryBatchMetadataHistoryRequest, opts ...grpc.CallOption) (*QueryBatchMetadataHistoryResponse, error)
+	// BridgeOperators queries the bridge operator sets of a given credit class.
+	BridgeOperators(ctx context.Context, in *QueryBridgeOperatorsRequest, opts ...grpc.CallOption) (*QueryBridgeOperatorsResponse, error)
+	// BridgeAttestations queries the pending bridge attestations of a given
+	// credit class with pagination.
+	BridgeAttestations(ctx context.Context, in *QueryBridgeAttestationsRequest, opts ...grpc.CallOption) (*QueryBridgeAttestationsResponse, error)
+	// OutboundBridgeRequestsByOwner queries all outbound bridge requests of a
+	// given owner with pagination.
+	OutboundBridgeRequestsByOwner(ctx context.Context, in *QueryOutboundBridgeRequestsByOwnerRequest, opts ...grpc.CallOption) (*QueryOutboundBridgeRequestsByOwnerResponse, error)
+	// OutboundBridgeRequestsByStatus queries all outbound bridge requests with a
+	// given status with pagination.
+	OutboundBridgeRequestsByStatus(ctx context.Context, in *QueryOutboundBridgeRequestsByStatusRequest, opts ...grpc.CallOption) (*QueryOutboundBridgeRequestsByStatusResponse, error)
 }
 
 type queryClient struct {
@@ -258,6 +308,168 @@ func (c *queryClient) CreditType(ctx context.Context, in *QueryCreditTypeRequest
 	return out, nil
 }
 
+func (c *queryClient) Retirement(ctx context.Context, in *QueryRetirementRequest, opts ...grpc.CallOption) (*QueryRetirementResponse, error) {
+	out := new(QueryRetirementResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/Retirement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RetirementsByOwner(ctx context.Context, in *QueryRetirementsByOwnerRequest, opts ...grpc.CallOption) (*QueryRetirementsByOwnerResponse, error) {
+	out := new(QueryRetirementsByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/RetirementsByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RetirementsByBatch(ctx context.Context, in *QueryRetirementsByBatchRequest, opts ...grpc.CallOption) (*QueryRetirementsByBatchResponse, error) {
+	out := new(QueryRetirementsByBatchResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/RetirementsByBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RetirementsByProject(ctx context.Context, in *QueryRetirementsByProjectRequest, opts ...grpc.CallOption) (*QueryRetirementsByProjectResponse, error) {
+	out := new(QueryRetirementsByProjectResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/RetirementsByProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Allowance(ctx context.Context, in *QueryAllowanceRequest, opts ...grpc.CallOption) (*QueryAllowanceResponse, error) {
+	out := new(QueryAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/Allowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LocksByAddress(ctx context.Context, in *QueryLocksByAddressRequest, opts ...grpc.CallOption) (*QueryLocksByAddressResponse, error) {
+	out := new(QueryLocksByAddressResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/LocksByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllowancesByOwner(ctx context.Context, in *QueryAllowancesByOwnerRequest, opts ...grpc.CallOption) (*QueryAllowancesByOwnerResponse, error) {
+	out := new(QueryAllowancesByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/AllowancesByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClassBuffer(ctx context.Context, in *QueryClassBufferRequest, opts ...grpc.CallOption) (*QueryClassBufferResponse, error) {
+	out := new(QueryClassBufferResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/ClassBuffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReversalsByProject(ctx context.Context, in *QueryReversalsByProjectRequest, opts ...grpc.CallOption) (*QueryReversalsByProjectResponse, error) {
+	out := new(QueryReversalsByProjectResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/ReversalsByProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProjectsByStatus(ctx context.Context, in *QueryProjectsByStatusRequest, opts ...grpc.CallOption) (*QueryProjectsByStatusResponse, error) {
+	out := new(QueryProjectsByStatusResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/ProjectsByStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProjectStatusHistory(ctx context.Context, in *QueryProjectStatusHistoryRequest, opts ...grpc.CallOption) (*QueryProjectStatusHistoryResponse, error) {
+	out := new(QueryProjectStatusHistoryResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/ProjectStatusHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClassMetadataHistory(ctx context.Context, in *QueryClassMetadataHistoryRequest, opts ...grpc.CallOption) (*QueryClassMetadataHistoryResponse, error) {
+	out := new(QueryClassMetadataHistoryResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/ClassMetadataHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProjectMetadataHistory(ctx context.Context, in *QueryProjectMetadataHistoryRequest, opts ...grpc.CallOption) (*QueryProjectMetadataHistoryResponse, error) {
+	out := new(QueryProjectMetadataHistoryResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/ProjectMetadataHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BatchMetadataHistory(ctx context.Context, in *QueryBatchMetadataHistoryRequest, opts ...grpc.CallOption) (*QueryBatchMetadataHistoryResponse, error) {
+	out := new(QueryBatchMetadataHistoryResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/BatchMetadataHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BridgeOperators(ctx context.Context, in *QueryBridgeOperatorsRequest, opts ...grpc.CallOption) (*QueryBridgeOperatorsResponse, error) {
+	out := new(QueryBridgeOperatorsResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/BridgeOperators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BridgeAttestations(ctx context.Context, in *QueryBridgeAttestationsRequest, opts ...grpc.CallOption) (*QueryBridgeAttestationsResponse, error) {
+	out := new(QueryBridgeAttestationsResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/BridgeAttestations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OutboundBridgeRequestsByOwner(ctx context.Context, in *QueryOutboundBridgeRequestsByOwnerRequest, opts ...grpc.CallOption) (*QueryOutboundBridgeRequestsByOwnerResponse, error) {
+	out := new(QueryOutboundBridgeRequestsByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/OutboundBridgeRequestsByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OutboundBridgeRequestsByStatus(ctx context.Context, in *QueryOutboundBridgeRequestsByStatusRequest, opts ...grpc.CallOption) (*QueryOutboundBridgeRequestsByStatusResponse, error) {
+	out := new(QueryOutboundBridgeRequestsByStatusResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/OutboundBridgeRequestsByStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -308,6 +520,56 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// CreditType queries credit type information by abbreviation.
 	CreditType(context.Context, *QueryCreditTypeRequest) (*QueryCreditTypeResponse, error)
+	// Retirement queries a retirement record by its unique identifier.
+	Retirement(context.Context, *QueryRetirementRequest) (*QueryRetirementResponse, error)
+	// RetirementsByOwner queries all retirement records of credits retired by a
+	// given account address.
+	RetirementsByOwner(context.Context, *QueryRetirementsByOwnerRequest) (*QueryRetirementsByOwnerResponse, error)
+	// RetirementsByBatch queries all retirement records of credits retired from
+	// a given credit batch.
+	RetirementsByBatch(context.Context, *QueryRetirementsByBatchRequest) (*QueryRetirementsByBatchResponse, error)
+	// RetirementsByProject queries all retirement records of credits retired
+	// from credit batches issued within a given project.
+	RetirementsByProject(context.Context, *QueryRetirementsByProjectRequest) (*QueryRetirementsByProjectResponse, error)
+	// Allowance queries the allowance an owner has granted an operator for a
+	// given credit batch or credit class.
+	Allowance(context.Context, *QueryAllowanceRequest) (*QueryAllowanceResponse, error)
+	// LocksByAddress queries all pending locks of credits owned by a given
+	// account.
+	LocksByAddress(context.Context, *QueryLocksByAddressRequest) (*QueryLocksByAddressResponse, error)
+	// AllowancesByOwner queries all allowances a given owner has granted.
+	AllowancesByOwner(context.Context, *QueryAllowancesByOwnerRequest) (*QueryAllowancesByOwnerResponse, error)
+	// ClassBuffer queries the buffer percentage and buffer pool account of a
+	// given credit class.
+	ClassBuffer(context.Context, *QueryClassBufferRequest) (*QueryClassBufferResponse, error)
+	// ReversalsByProject queries all reversal records of a given project.
+	ReversalsByProject(context.Context, *QueryReversalsByProjectRequest) (*QueryReversalsByProjectResponse, error)
+	// ProjectsByStatus queries for all projects with a given lifecycle status
+	// with pagination.
+	ProjectsByStatus(context.Context, *QueryProjectsByStatusRequest) (*QueryProjectsByStatusResponse, error)
+	// ProjectStatusHistory queries the status changes of a given project with
+	// pagination.
+	ProjectStatusHistory(context.Context, *QueryProjectStatusHistoryRequest) (*QueryProjectStatusHistoryResponse, error)
+	// ClassMetadataHistory queries the metadata changes of a given credit class
+	// with pagination.
+	ClassMetadataHistory(context.Context, *QueryClassMetadataHistoryRequest) (*QueryClassMetadataHistoryResponse, error)
+	// ProjectMetadataHistory queries the metadata changes of a given project
+	// with pagination.
+	ProjectMetadataHistory(context.Context, *QueryProjectMetadataHistoryRequest) (*QueryProjectMetadataHistoryResponse, error)
+	// BatchMetadataHistory queries the metadata changes of a given credit batch
+	// with pagination.
+	BatchMetadataHistory(context.Context, *QueryBatchMetadataHistoryRequest) (*QueryBatchMetadataHistoryResponse, error)
+	// BridgeOperators queries the bridge operator sets of a given credit class.
+	BridgeOperators(context.Context, *QueryBridgeOperatorsRequest) (*QueryBridgeOperatorsResponse, error)
+	// BridgeAttestations queries the pending bridge attestations of a given
+	// credit class with pagination.
+	BridgeAttestations(context.Context, *QueryBridgeAttestationsRequest) (*QueryBridgeAttestationsResponse, error)
+	// OutboundBridgeRequestsByOwner queries all outbound bridge requests of a
+	// given owner with pagination.
+	OutboundBridgeRequestsByOwner(context.Context, *QueryOutboundBridgeRequestsByOwnerRequest) (*QueryOutboundBridgeRequestsByOwnerResponse, error)
+	// OutboundBridgeRequestsByStatus queries all outbound bridge requests with a
+	// given status with pagination.
+	OutboundBridgeRequestsByStatus(context.Context, *QueryOutboundBridgeRequestsByStatusRequest) (*QueryOutboundBridgeRequestsByStatusResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -375,6 +637,60 @@ func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*Q
 func (UnimplementedQueryServer) CreditType(context.Context, *QueryCreditTypeRequest) (*QueryCreditTypeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreditType not implemented")
 }
+func (UnimplementedQueryServer) Retirement(context.Context, *QueryRetirementRequest) (*QueryRetirementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Retirement not implemented")
+}
+func (UnimplementedQueryServer) RetirementsByOwner(context.Context, *QueryRetirementsByOwnerRequest) (*QueryRetirementsByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetirementsByOwner not implemented")
+}
+func (UnimplementedQueryServer) RetirementsByBatch(context.Context, *QueryRetirementsByBatchRequest) (*QueryRetirementsByBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetirementsByBatch not implemented")
+}
+func (UnimplementedQueryServer) RetirementsByProject(context.Context, *QueryRetirementsByProjectRequest) (*QueryRetirementsByProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetirementsByProject not implemented")
+}
+func (UnimplementedQueryServer) Allowance(context.Context, *QueryAllowanceRequest) (*QueryAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Allowance not implemented")
+}
+func (UnimplementedQueryServer) LocksByAddress(context.Context, *QueryLocksByAddressRequest) (*QueryLocksByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LocksByAddress not implemented")
+}
+func (UnimplementedQueryServer) AllowancesByOwner(context.Context, *QueryAllowancesByOwnerRequest) (*QueryAllowancesByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowancesByOwner not implemented")
+}
+func (UnimplementedQueryServer) ClassBuffer(context.Context, *QueryClassBufferRequest) (*QueryClassBufferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassBuffer not implemented")
+}
+func (UnimplementedQueryServer) ReversalsByProject(context.Context, *QueryReversalsByProjectRequest) (*QueryReversalsByProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReversalsByProject not implemented")
+}
+func (UnimplementedQueryServer) ProjectsByStatus(context.Context, *QueryProjectsByStatusRequest) (*QueryProjectsByStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectsByStatus not implemented")
+}
+func (UnimplementedQueryServer) ProjectStatusHistory(context.Context, *QueryProjectStatusHistoryRequest) (*QueryProjectStatusHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectStatusHistory not implemented")
+}
+func (UnimplementedQueryServer) ClassMetadataHistory(context.Context, *QueryClassMetadataHistoryRequest) (*QueryClassMetadataHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassMetadataHistory not implemented")
+}
+func (UnimplementedQueryServer) ProjectMetadataHistory(context.Context, *QueryProjectMetadataHistoryRequest) (*QueryProjectMetadataHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectMetadataHistory not implemented")
+}
+func (UnimplementedQueryServer) BatchMetadataHistory(context.Context, *QueryBatchMetadataHistoryRequest) (*QueryBatchMetadataHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchMetadataHistory not implemented")
+}
+func (UnimplementedQueryServer) BridgeOperators(context.Context, *QueryBridgeOperatorsRequest) (*QueryBridgeOperatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeOperators not implemented")
+}
+func (UnimplementedQueryServer) BridgeAttestations(context.Context, *QueryBridgeAttestationsRequest) (*QueryBridgeAttestationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeAttestations not implemented")
+}
+func (UnimplementedQueryServer) OutboundBridgeRequestsByOwner(context.Context, *QueryOutboundBridgeRequestsByOwnerRequest) (*QueryOutboundBridgeRequestsByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutboundBridgeRequestsByOwner not implemented")
+}
+func (UnimplementedQueryServer) OutboundBridgeRequestsByStatus(context.Context, *QueryOutboundBridgeRequestsByStatusRequest) (*QueryOutboundBridgeRequestsByStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutboundBridgeRequestsByStatus not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -748,6 +1064,330 @@ func _Query_CreditType_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Retirement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRetirementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Retirement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/Retirement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Retirement(ctx, req.(*QueryRetirementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RetirementsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRetirementsByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RetirementsByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/RetirementsByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RetirementsByOwner(ctx, req.(*QueryRetirementsByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RetirementsByBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRetirementsByBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RetirementsByBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/RetirementsByBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RetirementsByBatch(ctx, req.(*QueryRetirementsByBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RetirementsByProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRetirementsByProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RetirementsByProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/RetirementsByProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RetirementsByProject(ctx, req.(*QueryRetirementsByProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Allowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Allowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/Allowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Allowance(ctx, req.(*QueryAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LocksByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLocksByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LocksByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/LocksByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LocksByAddress(ctx, req.(*QueryLocksByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowancesByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowancesByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowancesByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/AllowancesByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowancesByOwner(ctx, req.(*QueryAllowancesByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClassBuffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassBufferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClassBuffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/ClassBuffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassBuffer(ctx, req.(*QueryClassBufferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReversalsByProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReversalsByProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReversalsByProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/ReversalsByProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReversalsByProject(ctx, req.(*QueryReversalsByProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProjectsByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectsByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectsByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/ProjectsByStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectsByStatus(ctx, req.(*QueryProjectsByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProjectStatusHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectStatusHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectStatusHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/ProjectStatusHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectStatusHistory(ctx, req.(*QueryProjectStatusHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClassMetadataHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassMetadataHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClassMetadataHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/ClassMetadataHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassMetadataHistory(ctx, req.(*QueryClassMetadataHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProjectMetadataHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectMetadataHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectMetadataHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/ProjectMetadataHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectMetadataHistory(ctx, req.(*QueryProjectMetadataHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BatchMetadataHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBatchMetadataHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BatchMetadataHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/BatchMetadataHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchMetadataHistory(ctx, req.(*QueryBatchMetadataHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BridgeOperators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgeOperatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeOperators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/BridgeOperators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeOperators(ctx, req.(*QueryBridgeOperatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BridgeAttestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgeAttestationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeAttestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/BridgeAttestations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeAttestations(ctx, req.(*QueryBridgeAttestationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OutboundBridgeRequestsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutboundBridgeRequestsByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OutboundBridgeRequestsByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/OutboundBridgeRequestsByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutboundBridgeRequestsByOwner(ctx, req.(*QueryOutboundBridgeRequestsByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OutboundBridgeRequestsByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutboundBridgeRequestsByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OutboundBridgeRequestsByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/OutboundBridgeRequestsByStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutboundBridgeRequestsByStatus(ctx, req.(*QueryOutboundBridgeRequestsByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -835,6 +1475,78 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreditType",
 			Handler:    _Query_CreditType_Handler,
 		},
+		{
+			MethodName: "Retirement",
+			Handler:    _Query_Retirement_Handler,
+		},
+		{
+			MethodName: "RetirementsByOwner",
+			Handler:    _Query_RetirementsByOwner_Handler,
+		},
+		{
+			MethodName: "RetirementsByBatch",
+			Handler:    _Query_RetirementsByBatch_Handler,
+		},
+		{
+			MethodName: "RetirementsByProject",
+			Handler:    _Query_RetirementsByProject_Handler,
+		},
+		{
+			MethodName: "Allowance",
+			Handler:    _Query_Allowance_Handler,
+		},
+		{
+			MethodName: "LocksByAddress",
+			Handler:    _Query_LocksByAddress_Handler,
+		},
+		{
+			MethodName: "AllowancesByOwner",
+			Handler:    _Query_AllowancesByOwner_Handler,
+		},
+		{
+			MethodName: "ClassBuffer",
+			Handler:    _Query_ClassBuffer_Handler,
+		},
+		{
+			MethodName: "ReversalsByProject",
+			Handler:    _Query_ReversalsByProject_Handler,
+		},
+		{
+			MethodName: "ProjectsByStatus",
+			Handler:    _Query_ProjectsByStatus_Handler,
+		},
+		{
+			MethodName: "ProjectStatusHistory",
+			Handler:    _Query_ProjectStatusHistory_Handler,
+		},
+		{
+			MethodName: "ClassMetadataHistory",
+			Handler:    _Query_ClassMetadataHistory_Handler,
+		},
+		{
+			MethodName: "ProjectMetadataHistory",
+			Handler:    _Query_ProjectMetadataHistory_Handler,
+		},
+		{
+			MethodName: "BatchMetadataHistory",
+			Handler:    _Query_BatchMetadataHistory_Handler,
+		},
+		{
+			MethodName: "BridgeOperators",
+			Handler:    _Query_BridgeOperators_Handler,
+		},
+		{
+			MethodName: "BridgeAttestations",
+			Handler:    _Query_BridgeAttestations_Handler,
+		},
+		{
+			MethodName: "OutboundBridgeRequestsByOwner",
+			Handler:    _Query_OutboundBridgeRequestsByOwner_Handler,
+		},
+		{
+			MethodName: "OutboundBridgeRequestsByStatus",
+			Handler:    _Query_OutboundBridgeRequestsByStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "regen/ecocredit/v1/query.proto",
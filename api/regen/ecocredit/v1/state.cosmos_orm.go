@@ -1770,6 +1770,1683 @@ func NewBatchContractTable(db ormtable.Schema) (BatchContractTable, error) {
 	return batchContractTable{table}, nil
 }
 
+type RetirementTable interface {
+	Insert(ctx context.Context, retirement *Retirement) error
+	InsertReturningID(ctx context.Context, retirement *Retirement) (uint64, error)
+	Update(ctx context.Context, retirement *Retirement) error
+	Save(ctx context.Context, retirement *Retirement) error
+	Delete(ctx context.Context, retirement *Retirement) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*Retirement, error)
+	List(ctx context.Context, prefixKey RetirementIndexKey, opts ...ormlist.Option) (RetirementIterator, error)
+	ListRange(ctx context.Context, from, to RetirementIndexKey, opts ...ormlist.Option) (RetirementIterator, error)
+	DeleteBy(ctx context.Context, prefixKey RetirementIndexKey) error
+	DeleteRange(ctx context.Context, from, to RetirementIndexKey) error
+
+	doNotImplement()
+}
+
+type RetirementIterator struct {
+	ormtable.Iterator
+}
+
+func (i RetirementIterator) Value() (*Retirement, error) {
+	var retirement Retirement
+	err := i.UnmarshalMessage(&retirement)
+	return &retirement, err
+}
+
+type RetirementIndexKey interface {
+	id() uint32
+	values() []interface{}
+	retirementIndexKey()
+}
+
+// primary key starting index..
+type RetirementPrimaryKey = RetirementIdIndexKey
+
+type RetirementIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x RetirementIdIndexKey) id() uint32            { return 0 }
+func (x RetirementIdIndexKey) values() []interface{} { return x.vs }
+func (x RetirementIdIndexKey) retirementIndexKey()   {}
+
+func (this RetirementIdIndexKey) WithId(id uint64) RetirementIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type RetirementOwnerIndexKey struct {
+	vs []interface{}
+}
+
+func (x RetirementOwnerIndexKey) id() uint32            { return 1 }
+func (x RetirementOwnerIndexKey) values() []interface{} { return x.vs }
+func (x RetirementOwnerIndexKey) retirementIndexKey()   {}
+
+func (this RetirementOwnerIndexKey) WithOwner(owner []byte) RetirementOwnerIndexKey {
+	this.vs = []interface{}{owner}
+	return this
+}
+
+type RetirementBatchKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x RetirementBatchKeyIndexKey) id() uint32            { return 2 }
+func (x RetirementBatchKeyIndexKey) values() []interface{} { return x.vs }
+func (x RetirementBatchKeyIndexKey) retirementIndexKey()   {}
+
+func (this RetirementBatchKeyIndexKey) WithBatchKey(batch_key uint64) RetirementBatchKeyIndexKey {
+	this.vs = []interface{}{batch_key}
+	return this
+}
+
+type RetirementProjectKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x RetirementProjectKeyIndexKey) id() uint32            { return 3 }
+func (x RetirementProjectKeyIndexKey) values() []interface{} { return x.vs }
+func (x RetirementProjectKeyIndexKey) retirementIndexKey()   {}
+
+func (this RetirementProjectKeyIndexKey) WithProjectKey(project_key uint64) RetirementProjectKeyIndexKey {
+	this.vs = []interface{}{project_key}
+	return this
+}
+
+type retirementTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this retirementTable) Insert(ctx context.Context, retirement *Retirement) error {
+	return this.table.Insert(ctx, retirement)
+}
+
+func (this retirementTable) Update(ctx context.Context, retirement *Retirement) error {
+	return this.table.Update(ctx, retirement)
+}
+
+func (this retirementTable) Save(ctx context.Context, retirement *Retirement) error {
+	return this.table.Save(ctx, retirement)
+}
+
+func (this retirementTable) Delete(ctx context.Context, retirement *Retirement) error {
+	return this.table.Delete(ctx, retirement)
+}
+
+func (this retirementTable) InsertReturningID(ctx context.Context, retirement *Retirement) (uint64, error) {
+	return this.table.InsertReturningID(ctx, retirement)
+}
+
+func (this retirementTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this retirementTable) Get(ctx context.Context, id uint64) (*Retirement, error) {
+	var retirement Retirement
+	found, err := this.table.PrimaryKey().Get(ctx, &retirement, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &retirement, nil
+}
+
+func (this retirementTable) List(ctx context.Context, prefixKey RetirementIndexKey, opts ...ormlist.Option) (RetirementIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return RetirementIterator{it}, err
+}
+
+func (this retirementTable) ListRange(ctx context.Context, from, to RetirementIndexKey, opts ...ormlist.Option) (RetirementIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return RetirementIterator{it}, err
+}
+
+func (this retirementTable) DeleteBy(ctx context.Context, prefixKey RetirementIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this retirementTable) DeleteRange(ctx context.Context, from, to RetirementIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this retirementTable) doNotImplement() {}
+
+var _ RetirementTable = retirementTable{}
+
+func NewRetirementTable(db ormtable.Schema) (RetirementTable, error) {
+	table := db.GetTable(&Retirement{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&Retirement{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return retirementTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type BatchAllowanceTable interface {
+	Insert(ctx context.Context, batchAllowance *BatchAllowance) error
+	Update(ctx context.Context, batchAllowance *BatchAllowance) error
+	Save(ctx context.Context, batchAllowance *BatchAllowance) error
+	Delete(ctx context.Context, batchAllowance *BatchAllowance) error
+	Has(ctx context.Context, owner []byte, operator []byte, class_key uint64, batch_key uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, owner []byte, operator []byte, class_key uint64, batch_key uint64) (*BatchAllowance, error)
+	List(ctx context.Context, prefixKey BatchAllowanceIndexKey, opts ...ormlist.Option) (BatchAllowanceIterator, error)
+	ListRange(ctx context.Context, from, to BatchAllowanceIndexKey, opts ...ormlist.Option) (BatchAllowanceIterator, error)
+	DeleteBy(ctx context.Context, prefixKey BatchAllowanceIndexKey) error
+	DeleteRange(ctx context.Context, from, to BatchAllowanceIndexKey) error
+
+	doNotImplement()
+}
+
+type BatchAllowanceIterator struct {
+	ormtable.Iterator
+}
+
+func (i BatchAllowanceIterator) Value() (*BatchAllowance, error) {
+	var batchAllowance BatchAllowance
+	err := i.UnmarshalMessage(&batchAllowance)
+	return &batchAllowance, err
+}
+
+type BatchAllowanceIndexKey interface {
+	id() uint32
+	values() []interface{}
+	batchAllowanceIndexKey()
+}
+
+// primary key starting index..
+type BatchAllowancePrimaryKey = BatchAllowanceOwnerOperatorClassKeyBatchKeyIndexKey
+
+type BatchAllowanceOwnerOperatorClassKeyBatchKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x BatchAllowanceOwnerOperatorClassKeyBatchKeyIndexKey) id() uint32              { return 0 }
+func (x BatchAllowanceOwnerOperatorClassKeyBatchKeyIndexKey) values() []interface{}   { return x.vs }
+func (x BatchAllowanceOwnerOperatorClassKeyBatchKeyIndexKey) batchAllowanceIndexKey() {}
+
+func (this BatchAllowanceOwnerOperatorClassKeyBatchKeyIndexKey) WithOwner(owner []byte) BatchAllowanceOwnerOperatorClassKeyBatchKeyIndexKey {
+	this.vs = []interface{}{owner}
+	return this
+}
+
+func (this BatchAllowanceOwnerOperatorClassKeyBatchKeyIndexKey) WithOwnerOperator(owner []byte, operator []byte) BatchAllowanceOwnerOperatorClassKeyBatchKeyIndexKey {
+	this.vs = []interface{}{owner, operator}
+	return this
+}
+
+func (this BatchAllowanceOwnerOperatorClassKeyBatchKeyIndexKey) WithOwnerOperatorClassKey(owner []byte, operator []byte, class_key uint64) BatchAllowanceOwnerOperatorClassKeyBatchKeyIndexKey {
+	this.vs = []interface{}{owner, operator, class_key}
+	return this
+}
+
+func (this BatchAllowanceOwnerOperatorClassKeyBatchKeyIndexKey) WithOwnerOperatorClassKeyBatchKey(owner []byte, operator []byte, class_key uint64, batch_key uint64) BatchAllowanceOwnerOperatorClassKeyBatchKeyIndexKey {
+	this.vs = []interface{}{owner, operator, class_key, batch_key}
+	return this
+}
+
+type BatchAllowanceOperatorIndexKey struct {
+	vs []interface{}
+}
+
+func (x BatchAllowanceOperatorIndexKey) id() uint32              { return 1 }
+func (x BatchAllowanceOperatorIndexKey) values() []interface{}   { return x.vs }
+func (x BatchAllowanceOperatorIndexKey) batchAllowanceIndexKey() {}
+
+func (this BatchAllowanceOperatorIndexKey) WithOperator(operator []byte) BatchAllowanceOperatorIndexKey {
+	this.vs = []interface{}{operator}
+	return this
+}
+
+type BatchAllowanceClassKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x BatchAllowanceClassKeyIndexKey) id() uint32              { return 2 }
+func (x BatchAllowanceClassKeyIndexKey) values() []interface{}   { return x.vs }
+func (x BatchAllowanceClassKeyIndexKey) batchAllowanceIndexKey() {}
+
+func (this BatchAllowanceClassKeyIndexKey) WithClassKey(class_key uint64) BatchAllowanceClassKeyIndexKey {
+	this.vs = []interface{}{class_key}
+	return this
+}
+
+type BatchAllowanceBatchKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x BatchAllowanceBatchKeyIndexKey) id() uint32              { return 3 }
+func (x BatchAllowanceBatchKeyIndexKey) values() []interface{}   { return x.vs }
+func (x BatchAllowanceBatchKeyIndexKey) batchAllowanceIndexKey() {}
+
+func (this BatchAllowanceBatchKeyIndexKey) WithBatchKey(batch_key uint64) BatchAllowanceBatchKeyIndexKey {
+	this.vs = []interface{}{batch_key}
+	return this
+}
+
+type batchAllowanceTable struct {
+	table ormtable.Table
+}
+
+func (this batchAllowanceTable) Insert(ctx context.Context, batchAllowance *BatchAllowance) error {
+	return this.table.Insert(ctx, batchAllowance)
+}
+
+func (this batchAllowanceTable) Update(ctx context.Context, batchAllowance *BatchAllowance) error {
+	return this.table.Update(ctx, batchAllowance)
+}
+
+func (this batchAllowanceTable) Save(ctx context.Context, batchAllowance *BatchAllowance) error {
+	return this.table.Save(ctx, batchAllowance)
+}
+
+func (this batchAllowanceTable) Delete(ctx context.Context, batchAllowance *BatchAllowance) error {
+	return this.table.Delete(ctx, batchAllowance)
+}
+
+func (this batchAllowanceTable) Has(ctx context.Context, owner []byte, operator []byte, class_key uint64, batch_key uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, owner, operator, class_key, batch_key)
+}
+
+func (this batchAllowanceTable) Get(ctx context.Context, owner []byte, operator []byte, class_key uint64, batch_key uint64) (*BatchAllowance, error) {
+	var batchAllowance BatchAllowance
+	found, err := this.table.PrimaryKey().Get(ctx, &batchAllowance, owner, operator, class_key, batch_key)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &batchAllowance, nil
+}
+
+func (this batchAllowanceTable) List(ctx context.Context, prefixKey BatchAllowanceIndexKey, opts ...ormlist.Option) (BatchAllowanceIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return BatchAllowanceIterator{it}, err
+}
+
+func (this batchAllowanceTable) ListRange(ctx context.Context, from, to BatchAllowanceIndexKey, opts ...ormlist.Option) (BatchAllowanceIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return BatchAllowanceIterator{it}, err
+}
+
+func (this batchAllowanceTable) DeleteBy(ctx context.Context, prefixKey BatchAllowanceIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this batchAllowanceTable) DeleteRange(ctx context.Context, from, to BatchAllowanceIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this batchAllowanceTable) doNotImplement() {}
+
+var _ BatchAllowanceTable = batchAllowanceTable{}
+
+func NewBatchAllowanceTable(db ormtable.Schema) (BatchAllowanceTable, error) {
+	table := db.GetTable(&BatchAllowance{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&BatchAllowance{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return batchAllowanceTable{table}, nil
+}
+
+type BatchLockTable interface {
+	Insert(ctx context.Context, batchLock *BatchLock) error
+	InsertReturningID(ctx context.Context, batchLock *BatchLock) (uint64, error)
+	Update(ctx context.Context, batchLock *BatchLock) error
+	Save(ctx context.Context, batchLock *BatchLock) error
+	Delete(ctx context.Context, batchLock *BatchLock) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*BatchLock, error)
+	List(ctx context.Context, prefixKey BatchLockIndexKey, opts ...ormlist.Option) (BatchLockIterator, error)
+	ListRange(ctx context.Context, from, to BatchLockIndexKey, opts ...ormlist.Option) (BatchLockIterator, error)
+	DeleteBy(ctx context.Context, prefixKey BatchLockIndexKey) error
+	DeleteRange(ctx context.Context, from, to BatchLockIndexKey) error
+
+	doNotImplement()
+}
+
+type BatchLockIterator struct {
+	ormtable.Iterator
+}
+
+func (i BatchLockIterator) Value() (*BatchLock, error) {
+	var batchLock BatchLock
+	err := i.UnmarshalMessage(&batchLock)
+	return &batchLock, err
+}
+
+type BatchLockIndexKey interface {
+	id() uint32
+	values() []interface{}
+	batchLockIndexKey()
+}
+
+// primary key starting index..
+type BatchLockPrimaryKey = BatchLockIdIndexKey
+
+type BatchLockIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x BatchLockIdIndexKey) id() uint32            { return 0 }
+func (x BatchLockIdIndexKey) values() []interface{} { return x.vs }
+func (x BatchLockIdIndexKey) batchLockIndexKey()    {}
+
+func (this BatchLockIdIndexKey) WithId(id uint64) BatchLockIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type BatchLockAddressBatchKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x BatchLockAddressBatchKeyIndexKey) id() uint32            { return 1 }
+func (x BatchLockAddressBatchKeyIndexKey) values() []interface{} { return x.vs }
+func (x BatchLockAddressBatchKeyIndexKey) batchLockIndexKey()    {}
+
+func (this BatchLockAddressBatchKeyIndexKey) WithAddress(address []byte) BatchLockAddressBatchKeyIndexKey {
+	this.vs = []interface{}{address}
+	return this
+}
+
+func (this BatchLockAddressBatchKeyIndexKey) WithAddressBatchKey(address []byte, batch_key uint64) BatchLockAddressBatchKeyIndexKey {
+	this.vs = []interface{}{address, batch_key}
+	return this
+}
+
+type BatchLockNextReleaseTimeIndexKey struct {
+	vs []interface{}
+}
+
+func (x BatchLockNextReleaseTimeIndexKey) id() uint32            { return 3 }
+func (x BatchLockNextReleaseTimeIndexKey) values() []interface{} { return x.vs }
+func (x BatchLockNextReleaseTimeIndexKey) batchLockIndexKey()    {}
+
+func (this BatchLockNextReleaseTimeIndexKey) WithNextReleaseTime(next_release_time *timestamppb.Timestamp) BatchLockNextReleaseTimeIndexKey {
+	this.vs = []interface{}{next_release_time}
+	return this
+}
+
+type BatchLockBatchKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x BatchLockBatchKeyIndexKey) id() uint32            { return 4 }
+func (x BatchLockBatchKeyIndexKey) values() []interface{} { return x.vs }
+func (x BatchLockBatchKeyIndexKey) batchLockIndexKey()    {}
+
+func (this BatchLockBatchKeyIndexKey) WithBatchKey(batch_key uint64) BatchLockBatchKeyIndexKey {
+	this.vs = []interface{}{batch_key}
+	return this
+}
+
+type batchLockTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this batchLockTable) Insert(ctx context.Context, batchLock *BatchLock) error {
+	return this.table.Insert(ctx, batchLock)
+}
+
+func (this batchLockTable) Update(ctx context.Context, batchLock *BatchLock) error {
+	return this.table.Update(ctx, batchLock)
+}
+
+func (this batchLockTable) Save(ctx context.Context, batchLock *BatchLock) error {
+	return this.table.Save(ctx, batchLock)
+}
+
+func (this batchLockTable) Delete(ctx context.Context, batchLock *BatchLock) error {
+	return this.table.Delete(ctx, batchLock)
+}
+
+func (this batchLockTable) InsertReturningID(ctx context.Context, batchLock *BatchLock) (uint64, error) {
+	return this.table.InsertReturningID(ctx, batchLock)
+}
+
+func (this batchLockTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this batchLockTable) Get(ctx context.Context, id uint64) (*BatchLock, error) {
+	var batchLock BatchLock
+	found, err := this.table.PrimaryKey().Get(ctx, &batchLock, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &batchLock, nil
+}
+
+func (this batchLockTable) List(ctx context.Context, prefixKey BatchLockIndexKey, opts ...ormlist.Option) (BatchLockIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return BatchLockIterator{it}, err
+}
+
+func (this batchLockTable) ListRange(ctx context.Context, from, to BatchLockIndexKey, opts ...ormlist.Option) (BatchLockIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return BatchLockIterator{it}, err
+}
+
+func (this batchLockTable) DeleteBy(ctx context.Context, prefixKey BatchLockIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this batchLockTable) DeleteRange(ctx context.Context, from, to BatchLockIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this batchLockTable) doNotImplement() {}
+
+var _ BatchLockTable = batchLockTable{}
+
+func NewBatchLockTable(db ormtable.Schema) (BatchLockTable, error) {
+	table := db.GetTable(&BatchLock{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&BatchLock{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return batchLockTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type ClassBufferTable interface {
+	Insert(ctx context.Context, classBuffer *ClassBuffer) error
+	Update(ctx context.Context, classBuffer *ClassBuffer) error
+	Save(ctx context.Context, classBuffer *ClassBuffer) error
+	Delete(ctx context.Context, classBuffer *ClassBuffer) error
+	Has(ctx context.Context, class_key uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, class_key uint64) (*ClassBuffer, error)
+	List(ctx context.Context, prefixKey ClassBufferIndexKey, opts ...ormlist.Option) (ClassBufferIterator, error)
+	ListRange(ctx context.Context, from, to ClassBufferIndexKey, opts ...ormlist.Option) (ClassBufferIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ClassBufferIndexKey) error
+	DeleteRange(ctx context.Context, from, to ClassBufferIndexKey) error
+
+	doNotImplement()
+}
+
+type ClassBufferIterator struct {
+	ormtable.Iterator
+}
+
+func (i ClassBufferIterator) Value() (*ClassBuffer, error) {
+	var classBuffer ClassBuffer
+	err := i.UnmarshalMessage(&classBuffer)
+	return &classBuffer, err
+}
+
+type ClassBufferIndexKey interface {
+	id() uint32
+	values() []interface{}
+	classBufferIndexKey()
+}
+
+// primary key starting index..
+type ClassBufferPrimaryKey = ClassBufferClassKeyIndexKey
+
+type ClassBufferClassKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x ClassBufferClassKeyIndexKey) id() uint32            { return 0 }
+func (x ClassBufferClassKeyIndexKey) values() []interface{} { return x.vs }
+func (x ClassBufferClassKeyIndexKey) classBufferIndexKey()  {}
+
+func (this ClassBufferClassKeyIndexKey) WithClassKey(class_key uint64) ClassBufferClassKeyIndexKey {
+	this.vs = []interface{}{class_key}
+	return this
+}
+
+type classBufferTable struct {
+	table ormtable.Table
+}
+
+func (this classBufferTable) Insert(ctx context.Context, classBuffer *ClassBuffer) error {
+	return this.table.Insert(ctx, classBuffer)
+}
+
+func (this classBufferTable) Update(ctx context.Context, classBuffer *ClassBuffer) error {
+	return this.table.Update(ctx, classBuffer)
+}
+
+func (this classBufferTable) Save(ctx context.Context, classBuffer *ClassBuffer) error {
+	return this.table.Save(ctx, classBuffer)
+}
+
+func (this classBufferTable) Delete(ctx context.Context, classBuffer *ClassBuffer) error {
+	return this.table.Delete(ctx, classBuffer)
+}
+
+func (this classBufferTable) Has(ctx context.Context, class_key uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, class_key)
+}
+
+func (this classBufferTable) Get(ctx context.Context, class_key uint64) (*ClassBuffer, error) {
+	var classBuffer ClassBuffer
+	found, err := this.table.PrimaryKey().Get(ctx, &classBuffer, class_key)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &classBuffer, nil
+}
+
+func (this classBufferTable) List(ctx context.Context, prefixKey ClassBufferIndexKey, opts ...ormlist.Option) (ClassBufferIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ClassBufferIterator{it}, err
+}
+
+func (this classBufferTable) ListRange(ctx context.Context, from, to ClassBufferIndexKey, opts ...ormlist.Option) (ClassBufferIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ClassBufferIterator{it}, err
+}
+
+func (this classBufferTable) DeleteBy(ctx context.Context, prefixKey ClassBufferIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this classBufferTable) DeleteRange(ctx context.Context, from, to ClassBufferIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this classBufferTable) doNotImplement() {}
+
+var _ ClassBufferTable = classBufferTable{}
+
+func NewClassBufferTable(db ormtable.Schema) (ClassBufferTable, error) {
+	table := db.GetTable(&ClassBuffer{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&ClassBuffer{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return classBufferTable{table}, nil
+}
+
+type ReversalTable interface {
+	Insert(ctx context.Context, reversal *Reversal) error
+	InsertReturningID(ctx context.Context, reversal *Reversal) (uint64, error)
+	Update(ctx context.Context, reversal *Reversal) error
+	Save(ctx context.Context, reversal *Reversal) error
+	Delete(ctx context.Context, reversal *Reversal) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*Reversal, error)
+	List(ctx context.Context, prefixKey ReversalIndexKey, opts ...ormlist.Option) (ReversalIterator, error)
+	ListRange(ctx context.Context, from, to ReversalIndexKey, opts ...ormlist.Option) (ReversalIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ReversalIndexKey) error
+	DeleteRange(ctx context.Context, from, to ReversalIndexKey) error
+
+	doNotImplement()
+}
+
+type ReversalIterator struct {
+	ormtable.Iterator
+}
+
+func (i ReversalIterator) Value() (*Reversal, error) {
+	var reversal Reversal
+	err := i.UnmarshalMessage(&reversal)
+	return &reversal, err
+}
+
+type ReversalIndexKey interface {
+	id() uint32
+	values() []interface{}
+	reversalIndexKey()
+}
+
+// primary key starting index..
+type ReversalPrimaryKey = ReversalIdIndexKey
+
+type ReversalIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x ReversalIdIndexKey) id() uint32            { return 0 }
+func (x ReversalIdIndexKey) values() []interface{} { return x.vs }
+func (x ReversalIdIndexKey) reversalIndexKey()     {}
+
+func (this ReversalIdIndexKey) WithId(id uint64) ReversalIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type ReversalProjectKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x ReversalProjectKeyIndexKey) id() uint32            { return 1 }
+func (x ReversalProjectKeyIndexKey) values() []interface{} { return x.vs }
+func (x ReversalProjectKeyIndexKey) reversalIndexKey()     {}
+
+func (this ReversalProjectKeyIndexKey) WithProjectKey(project_key uint64) ReversalProjectKeyIndexKey {
+	this.vs = []interface{}{project_key}
+	return this
+}
+
+type reversalTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this reversalTable) Insert(ctx context.Context, reversal *Reversal) error {
+	return this.table.Insert(ctx, reversal)
+}
+
+func (this reversalTable) Update(ctx context.Context, reversal *Reversal) error {
+	return this.table.Update(ctx, reversal)
+}
+
+func (this reversalTable) Save(ctx context.Context, reversal *Reversal) error {
+	return this.table.Save(ctx, reversal)
+}
+
+func (this reversalTable) Delete(ctx context.Context, reversal *Reversal) error {
+	return this.table.Delete(ctx, reversal)
+}
+
+func (this reversalTable) InsertReturningID(ctx context.Context, reversal *Reversal) (uint64, error) {
+	return this.table.InsertReturningID(ctx, reversal)
+}
+
+func (this reversalTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this reversalTable) Get(ctx context.Context, id uint64) (*Reversal, error) {
+	var reversal Reversal
+	found, err := this.table.PrimaryKey().Get(ctx, &reversal, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &reversal, nil
+}
+
+func (this reversalTable) List(ctx context.Context, prefixKey ReversalIndexKey, opts ...ormlist.Option) (ReversalIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ReversalIterator{it}, err
+}
+
+func (this reversalTable) ListRange(ctx context.Context, from, to ReversalIndexKey, opts ...ormlist.Option) (ReversalIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ReversalIterator{it}, err
+}
+
+func (this reversalTable) DeleteBy(ctx context.Context, prefixKey ReversalIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this reversalTable) DeleteRange(ctx context.Context, from, to ReversalIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this reversalTable) doNotImplement() {}
+
+var _ ReversalTable = reversalTable{}
+
+func NewReversalTable(db ormtable.Schema) (ReversalTable, error) {
+	table := db.GetTable(&Reversal{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&Reversal{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return reversalTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type ProjectStatusChangeTable interface {
+	Insert(ctx context.Context, projectStatusChange *ProjectStatusChange) error
+	InsertReturningID(ctx context.Context, projectStatusChange *ProjectStatusChange) (uint64, error)
+	Update(ctx context.Context, projectStatusChange *ProjectStatusChange) error
+	Save(ctx context.Context, projectStatusChange *ProjectStatusChange) error
+	Delete(ctx context.Context, projectStatusChange *ProjectStatusChange) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*ProjectStatusChange, error)
+	List(ctx context.Context, prefixKey ProjectStatusChangeIndexKey, opts ...ormlist.Option) (ProjectStatusChangeIterator, error)
+	ListRange(ctx context.Context, from, to ProjectStatusChangeIndexKey, opts ...ormlist.Option) (ProjectStatusChangeIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ProjectStatusChangeIndexKey) error
+	DeleteRange(ctx context.Context, from, to ProjectStatusChangeIndexKey) error
+
+	doNotImplement()
+}
+
+type ProjectStatusChangeIterator struct {
+	ormtable.Iterator
+}
+
+func (i ProjectStatusChangeIterator) Value() (*ProjectStatusChange, error) {
+	var projectStatusChange ProjectStatusChange
+	err := i.UnmarshalMessage(&projectStatusChange)
+	return &projectStatusChange, err
+}
+
+type ProjectStatusChangeIndexKey interface {
+	id() uint32
+	values() []interface{}
+	projectStatusChangeIndexKey()
+}
+
+// primary key starting index..
+type ProjectStatusChangePrimaryKey = ProjectStatusChangeIdIndexKey
+
+type ProjectStatusChangeIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x ProjectStatusChangeIdIndexKey) id() uint32                   { return 0 }
+func (x ProjectStatusChangeIdIndexKey) values() []interface{}        { return x.vs }
+func (x ProjectStatusChangeIdIndexKey) projectStatusChangeIndexKey() {}
+
+func (this ProjectStatusChangeIdIndexKey) WithId(id uint64) ProjectStatusChangeIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type ProjectStatusChangeProjectKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x ProjectStatusChangeProjectKeyIndexKey) id() uint32                   { return 1 }
+func (x ProjectStatusChangeProjectKeyIndexKey) values() []interface{}        { return x.vs }
+func (x ProjectStatusChangeProjectKeyIndexKey) projectStatusChangeIndexKey() {}
+
+func (this ProjectStatusChangeProjectKeyIndexKey) WithProjectKey(project_key uint64) ProjectStatusChangeProjectKeyIndexKey {
+	this.vs = []interface{}{project_key}
+	return this
+}
+
+type projectStatusChangeTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this projectStatusChangeTable) Insert(ctx context.Context, projectStatusChange *ProjectStatusChange) error {
+	return this.table.Insert(ctx, projectStatusChange)
+}
+
+func (this projectStatusChangeTable) Update(ctx context.Context, projectStatusChange *ProjectStatusChange) error {
+	return this.table.Update(ctx, projectStatusChange)
+}
+
+func (this projectStatusChangeTable) Save(ctx context.Context, projectStatusChange *ProjectStatusChange) error {
+	return this.table.Save(ctx, projectStatusChange)
+}
+
+func (this projectStatusChangeTable) Delete(ctx context.Context, projectStatusChange *ProjectStatusChange) error {
+	return this.table.Delete(ctx, projectStatusChange)
+}
+
+func (this projectStatusChangeTable) InsertReturningID(ctx context.Context, projectStatusChange *ProjectStatusChange) (uint64, error) {
+	return this.table.InsertReturningID(ctx, projectStatusChange)
+}
+
+func (this projectStatusChangeTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this projectStatusChangeTable) Get(ctx context.Context, id uint64) (*ProjectStatusChange, error) {
+	var projectStatusChange ProjectStatusChange
+	found, err := this.table.PrimaryKey().Get(ctx, &projectStatusChange, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &projectStatusChange, nil
+}
+
+func (this projectStatusChangeTable) List(ctx context.Context, prefixKey ProjectStatusChangeIndexKey, opts ...ormlist.Option) (ProjectStatusChangeIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ProjectStatusChangeIterator{it}, err
+}
+
+func (this projectStatusChangeTable) ListRange(ctx context.Context, from, to ProjectStatusChangeIndexKey, opts ...ormlist.Option) (ProjectStatusChangeIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ProjectStatusChangeIterator{it}, err
+}
+
+func (this projectStatusChangeTable) DeleteBy(ctx context.Context, prefixKey ProjectStatusChangeIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this projectStatusChangeTable) DeleteRange(ctx context.Context, from, to ProjectStatusChangeIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this projectStatusChangeTable) doNotImplement() {}
+
+var _ ProjectStatusChangeTable = projectStatusChangeTable{}
+
+func NewProjectStatusChangeTable(db ormtable.Schema) (ProjectStatusChangeTable, error) {
+	table := db.GetTable(&ProjectStatusChange{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&ProjectStatusChange{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return projectStatusChangeTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type ClassMetadataChangeTable interface {
+	Insert(ctx context.Context, classMetadataChange *ClassMetadataChange) error
+	InsertReturningID(ctx context.Context, classMetadataChange *ClassMetadataChange) (uint64, error)
+	Update(ctx context.Context, classMetadataChange *ClassMetadataChange) error
+	Save(ctx context.Context, classMetadataChange *ClassMetadataChange) error
+	Delete(ctx context.Context, classMetadataChange *ClassMetadataChange) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*ClassMetadataChange, error)
+	List(ctx context.Context, prefixKey ClassMetadataChangeIndexKey, opts ...ormlist.Option) (ClassMetadataChangeIterator, error)
+	ListRange(ctx context.Context, from, to ClassMetadataChangeIndexKey, opts ...ormlist.Option) (ClassMetadataChangeIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ClassMetadataChangeIndexKey) error
+	DeleteRange(ctx context.Context, from, to ClassMetadataChangeIndexKey) error
+
+	doNotImplement()
+}
+
+type ClassMetadataChangeIterator struct {
+	ormtable.Iterator
+}
+
+func (i ClassMetadataChangeIterator) Value() (*ClassMetadataChange, error) {
+	var classMetadataChange ClassMetadataChange
+	err := i.UnmarshalMessage(&classMetadataChange)
+	return &classMetadataChange, err
+}
+
+type ClassMetadataChangeIndexKey interface {
+	id() uint32
+	values() []interface{}
+	classMetadataChangeIndexKey()
+}
+
+// primary key starting index..
+type ClassMetadataChangePrimaryKey = ClassMetadataChangeIdIndexKey
+
+type ClassMetadataChangeIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x ClassMetadataChangeIdIndexKey) id() uint32                   { return 0 }
+func (x ClassMetadataChangeIdIndexKey) values() []interface{}        { return x.vs }
+func (x ClassMetadataChangeIdIndexKey) classMetadataChangeIndexKey() {}
+
+func (this ClassMetadataChangeIdIndexKey) WithId(id uint64) ClassMetadataChangeIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type ClassMetadataChangeClassKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x ClassMetadataChangeClassKeyIndexKey) id() uint32                   { return 1 }
+func (x ClassMetadataChangeClassKeyIndexKey) values() []interface{}        { return x.vs }
+func (x ClassMetadataChangeClassKeyIndexKey) classMetadataChangeIndexKey() {}
+
+func (this ClassMetadataChangeClassKeyIndexKey) WithClassKey(class_key uint64) ClassMetadataChangeClassKeyIndexKey {
+	this.vs = []interface{}{class_key}
+	return this
+}
+
+type classMetadataChangeTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this classMetadataChangeTable) Insert(ctx context.Context, classMetadataChange *ClassMetadataChange) error {
+	return this.table.Insert(ctx, classMetadataChange)
+}
+
+func (this classMetadataChangeTable) Update(ctx context.Context, classMetadataChange *ClassMetadataChange) error {
+	return this.table.Update(ctx, classMetadataChange)
+}
+
+func (this classMetadataChangeTable) Save(ctx context.Context, classMetadataChange *ClassMetadataChange) error {
+	return this.table.Save(ctx, classMetadataChange)
+}
+
+func (this classMetadataChangeTable) Delete(ctx context.Context, classMetadataChange *ClassMetadataChange) error {
+	return this.table.Delete(ctx, classMetadataChange)
+}
+
+func (this classMetadataChangeTable) InsertReturningID(ctx context.Context, classMetadataChange *ClassMetadataChange) (uint64, error) {
+	return this.table.InsertReturningID(ctx, classMetadataChange)
+}
+
+func (this classMetadataChangeTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this classMetadataChangeTable) Get(ctx context.Context, id uint64) (*ClassMetadataChange, error) {
+	var classMetadataChange ClassMetadataChange
+	found, err := this.table.PrimaryKey().Get(ctx, &classMetadataChange, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &classMetadataChange, nil
+}
+
+func (this classMetadataChangeTable) List(ctx context.Context, prefixKey ClassMetadataChangeIndexKey, opts ...ormlist.Option) (ClassMetadataChangeIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ClassMetadataChangeIterator{it}, err
+}
+
+func (this classMetadataChangeTable) ListRange(ctx context.Context, from, to ClassMetadataChangeIndexKey, opts ...ormlist.Option) (ClassMetadataChangeIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ClassMetadataChangeIterator{it}, err
+}
+
+func (this classMetadataChangeTable) DeleteBy(ctx context.Context, prefixKey ClassMetadataChangeIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this classMetadataChangeTable) DeleteRange(ctx context.Context, from, to ClassMetadataChangeIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this classMetadataChangeTable) doNotImplement() {}
+
+var _ ClassMetadataChangeTable = classMetadataChangeTable{}
+
+func NewClassMetadataChangeTable(db ormtable.Schema) (ClassMetadataChangeTable, error) {
+	table := db.GetTable(&ClassMetadataChange{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&ClassMetadataChange{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return classMetadataChangeTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type ProjectMetadataChangeTable interface {
+	Insert(ctx context.Context, projectMetadataChange *ProjectMetadataChange) error
+	InsertReturningID(ctx context.Context, projectMetadataChange *ProjectMetadataChange) (uint64, error)
+	Update(ctx context.Context, projectMetadataChange *ProjectMetadataChange) error
+	Save(ctx context.Context, projectMetadataChange *ProjectMetadataChange) error
+	Delete(ctx context.Context, projectMetadataChange *ProjectMetadataChange) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*ProjectMetadataChange, error)
+	List(ctx context.Context, prefixKey ProjectMetadataChangeIndexKey, opts ...ormlist.Option) (ProjectMetadataChangeIterator, error)
+	ListRange(ctx context.Context, from, to ProjectMetadataChangeIndexKey, opts ...ormlist.Option) (ProjectMetadataChangeIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ProjectMetadataChangeIndexKey) error
+	DeleteRange(ctx context.Context, from, to ProjectMetadataChangeIndexKey) error
+
+	doNotImplement()
+}
+
+type ProjectMetadataChangeIterator struct {
+	ormtable.Iterator
+}
+
+func (i ProjectMetadataChangeIterator) Value() (*ProjectMetadataChange, error) {
+	var projectMetadataChange ProjectMetadataChange
+	err := i.UnmarshalMessage(&projectMetadataChange)
+	return &projectMetadataChange, err
+}
+
+type ProjectMetadataChangeIndexKey interface {
+	id() uint32
+	values() []interface{}
+	projectMetadataChangeIndexKey()
+}
+
+// primary key starting index..
+type ProjectMetadataChangePrimaryKey = ProjectMetadataChangeIdIndexKey
+
+type ProjectMetadataChangeIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x ProjectMetadataChangeIdIndexKey) id() uint32                     { return 0 }
+func (x ProjectMetadataChangeIdIndexKey) values() []interface{}          { return x.vs }
+func (x ProjectMetadataChangeIdIndexKey) projectMetadataChangeIndexKey() {}
+
+func (this ProjectMetadataChangeIdIndexKey) WithId(id uint64) ProjectMetadataChangeIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type ProjectMetadataChangeProjectKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x ProjectMetadataChangeProjectKeyIndexKey) id() uint32                     { return 1 }
+func (x ProjectMetadataChangeProjectKeyIndexKey) values() []interface{}          { return x.vs }
+func (x ProjectMetadataChangeProjectKeyIndexKey) projectMetadataChangeIndexKey() {}
+
+func (this ProjectMetadataChangeProjectKeyIndexKey) WithProjectKey(project_key uint64) ProjectMetadataChangeProjectKeyIndexKey {
+	this.vs = []interface{}{project_key}
+	return this
+}
+
+type projectMetadataChangeTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this projectMetadataChangeTable) Insert(ctx context.Context, projectMetadataChange *ProjectMetadataChange) error {
+	return this.table.Insert(ctx, projectMetadataChange)
+}
+
+func (this projectMetadataChangeTable) Update(ctx context.Context, projectMetadataChange *ProjectMetadataChange) error {
+	return this.table.Update(ctx, projectMetadataChange)
+}
+
+func (this projectMetadataChangeTable) Save(ctx context.Context, projectMetadataChange *ProjectMetadataChange) error {
+	return this.table.Save(ctx, projectMetadataChange)
+}
+
+func (this projectMetadataChangeTable) Delete(ctx context.Context, projectMetadataChange *ProjectMetadataChange) error {
+	return this.table.Delete(ctx, projectMetadataChange)
+}
+
+func (this projectMetadataChangeTable) InsertReturningID(ctx context.Context, projectMetadataChange *ProjectMetadataChange) (uint64, error) {
+	return this.table.InsertReturningID(ctx, projectMetadataChange)
+}
+
+func (this projectMetadataChangeTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this projectMetadataChangeTable) Get(ctx context.Context, id uint64) (*ProjectMetadataChange, error) {
+	var projectMetadataChange ProjectMetadataChange
+	found, err := this.table.PrimaryKey().Get(ctx, &projectMetadataChange, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &projectMetadataChange, nil
+}
+
+func (this projectMetadataChangeTable) List(ctx context.Context, prefixKey ProjectMetadataChangeIndexKey, opts ...ormlist.Option) (ProjectMetadataChangeIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ProjectMetadataChangeIterator{it}, err
+}
+
+func (this projectMetadataChangeTable) ListRange(ctx context.Context, from, to ProjectMetadataChangeIndexKey, opts ...ormlist.Option) (ProjectMetadataChangeIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ProjectMetadataChangeIterator{it}, err
+}
+
+func (this projectMetadataChangeTable) DeleteBy(ctx context.Context, prefixKey ProjectMetadataChangeIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this projectMetadataChangeTable) DeleteRange(ctx context.Context, from, to ProjectMetadataChangeIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this projectMetadataChangeTable) doNotImplement() {}
+
+var _ ProjectMetadataChangeTable = projectMetadataChangeTable{}
+
+func NewProjectMetadataChangeTable(db ormtable.Schema) (ProjectMetadataChangeTable, error) {
+	table := db.GetTable(&ProjectMetadataChange{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&ProjectMetadataChange{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return projectMetadataChangeTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type BatchMetadataChangeTable interface {
+	Insert(ctx context.Context, batchMetadataChange *BatchMetadataChange) error
+	InsertReturningID(ctx context.Context, batchMetadataChange *BatchMetadataChange) (uint64, error)
+	Update(ctx context.Context, batchMetadataChange *BatchMetadataChange) error
+	Save(ctx context.Context, batchMetadataChange *BatchMetadataChange) error
+	Delete(ctx context.Context, batchMetadataChange *BatchMetadataChange) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*BatchMetadataChange, error)
+	List(ctx context.Context, prefixKey BatchMetadataChangeIndexKey, opts ...ormlist.Option) (BatchMetadataChangeIterator, error)
+	ListRange(ctx context.Context, from, to BatchMetadataChangeIndexKey, opts ...ormlist.Option) (BatchMetadataChangeIterator, error)
+	DeleteBy(ctx context.Context, prefixKey BatchMetadataChangeIndexKey) error
+	DeleteRange(ctx context.Context, from, to BatchMetadataChangeIndexKey) error
+
+	doNotImplement()
+}
+
+type BatchMetadataChangeIterator struct {
+	ormtable.Iterator
+}
+
+func (i BatchMetadataChangeIterator) Value() (*BatchMetadataChange, error) {
+	var batchMetadataChange BatchMetadataChange
+	err := i.UnmarshalMessage(&batchMetadataChange)
+	return &batchMetadataChange, err
+}
+
+type BatchMetadataChangeIndexKey interface {
+	id() uint32
+	values() []interface{}
+	batchMetadataChangeIndexKey()
+}
+
+// primary key starting index..
+type BatchMetadataChangePrimaryKey = BatchMetadataChangeIdIndexKey
+
+type BatchMetadataChangeIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x BatchMetadataChangeIdIndexKey) id() uint32                   { return 0 }
+func (x BatchMetadataChangeIdIndexKey) values() []interface{}        { return x.vs }
+func (x BatchMetadataChangeIdIndexKey) batchMetadataChangeIndexKey() {}
+
+func (this BatchMetadataChangeIdIndexKey) WithId(id uint64) BatchMetadataChangeIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type BatchMetadataChangeBatchKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x BatchMetadataChangeBatchKeyIndexKey) id() uint32                   { return 1 }
+func (x BatchMetadataChangeBatchKeyIndexKey) values() []interface{}        { return x.vs }
+func (x BatchMetadataChangeBatchKeyIndexKey) batchMetadataChangeIndexKey() {}
+
+func (this BatchMetadataChangeBatchKeyIndexKey) WithBatchKey(batch_key uint64) BatchMetadataChangeBatchKeyIndexKey {
+	this.vs = []interface{}{batch_key}
+	return this
+}
+
+type batchMetadataChangeTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this batchMetadataChangeTable) Insert(ctx context.Context, batchMetadataChange *BatchMetadataChange) error {
+	return this.table.Insert(ctx, batchMetadataChange)
+}
+
+func (this batchMetadataChangeTable) Update(ctx context.Context, batchMetadataChange *BatchMetadataChange) error {
+	return this.table.Update(ctx, batchMetadataChange)
+}
+
+func (this batchMetadataChangeTable) Save(ctx context.Context, batchMetadataChange *BatchMetadataChange) error {
+	return this.table.Save(ctx, batchMetadataChange)
+}
+
+func (this batchMetadataChangeTable) Delete(ctx context.Context, batchMetadataChange *BatchMetadataChange) error {
+	return this.table.Delete(ctx, batchMetadataChange)
+}
+
+func (this batchMetadataChangeTable) InsertReturningID(ctx context.Context, batchMetadataChange *BatchMetadataChange) (uint64, error) {
+	return this.table.InsertReturningID(ctx, batchMetadataChange)
+}
+
+func (this batchMetadataChangeTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this batchMetadataChangeTable) Get(ctx context.Context, id uint64) (*BatchMetadataChange, error) {
+	var batchMetadataChange BatchMetadataChange
+	found, err := this.table.PrimaryKey().Get(ctx, &batchMetadataChange, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &batchMetadataChange, nil
+}
+
+func (this batchMetadataChangeTable) List(ctx context.Context, prefixKey BatchMetadataChangeIndexKey, opts ...ormlist.Option) (BatchMetadataChangeIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return BatchMetadataChangeIterator{it}, err
+}
+
+func (this batchMetadataChangeTable) ListRange(ctx context.Context, from, to BatchMetadataChangeIndexKey, opts ...ormlist.Option) (BatchMetadataChangeIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return BatchMetadataChangeIterator{it}, err
+}
+
+func (this batchMetadataChangeTable) DeleteBy(ctx context.Context, prefixKey BatchMetadataChangeIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this batchMetadataChangeTable) DeleteRange(ctx context.Context, from, to BatchMetadataChangeIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this batchMetadataChangeTable) doNotImplement() {}
+
+var _ BatchMetadataChangeTable = batchMetadataChangeTable{}
+
+func NewBatchMetadataChangeTable(db ormtable.Schema) (BatchMetadataChangeTable, error) {
+	table := db.GetTable(&BatchMetadataChange{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&BatchMetadataChange{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return batchMetadataChangeTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type BridgeOperatorSetTable interface {
+	Insert(ctx context.Context, bridgeOperatorSet *BridgeOperatorSet) error
+	Update(ctx context.Context, bridgeOperatorSet *BridgeOperatorSet) error
+	Save(ctx context.Context, bridgeOperatorSet *BridgeOperatorSet) error
+	Delete(ctx context.Context, bridgeOperatorSet *BridgeOperatorSet) error
+	Has(ctx context.Context, class_key uint64, source string) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, class_key uint64, source string) (*BridgeOperatorSet, error)
+	List(ctx context.Context, prefixKey BridgeOperatorSetIndexKey, opts ...ormlist.Option) (BridgeOperatorSetIterator, error)
+	ListRange(ctx context.Context, from, to BridgeOperatorSetIndexKey, opts ...ormlist.Option) (BridgeOperatorSetIterator, error)
+	DeleteBy(ctx context.Context, prefixKey BridgeOperatorSetIndexKey) error
+	DeleteRange(ctx context.Context, from, to BridgeOperatorSetIndexKey) error
+
+	doNotImplement()
+}
+
+type BridgeOperatorSetIterator struct {
+	ormtable.Iterator
+}
+
+func (i BridgeOperatorSetIterator) Value() (*BridgeOperatorSet, error) {
+	var bridgeOperatorSet BridgeOperatorSet
+	err := i.UnmarshalMessage(&bridgeOperatorSet)
+	return &bridgeOperatorSet, err
+}
+
+type BridgeOperatorSetIndexKey interface {
+	id() uint32
+	values() []interface{}
+	bridgeOperatorSetIndexKey()
+}
+
+// primary key starting index..
+type BridgeOperatorSetPrimaryKey = BridgeOperatorSetClassKeySourceIndexKey
+
+type BridgeOperatorSetClassKeySourceIndexKey struct {
+	vs []interface{}
+}
+
+func (x BridgeOperatorSetClassKeySourceIndexKey) id() uint32                 { return 0 }
+func (x BridgeOperatorSetClassKeySourceIndexKey) values() []interface{}      { return x.vs }
+func (x BridgeOperatorSetClassKeySourceIndexKey) bridgeOperatorSetIndexKey() {}
+
+func (this BridgeOperatorSetClassKeySourceIndexKey) WithClassKey(class_key uint64) BridgeOperatorSetClassKeySourceIndexKey {
+	this.vs = []interface{}{class_key}
+	return this
+}
+
+func (this BridgeOperatorSetClassKeySourceIndexKey) WithClassKeySource(class_key uint64, source string) BridgeOperatorSetClassKeySourceIndexKey {
+	this.vs = []interface{}{class_key, source}
+	return this
+}
+
+type bridgeOperatorSetTable struct {
+	table ormtable.Table
+}
+
+func (this bridgeOperatorSetTable) Insert(ctx context.Context, bridgeOperatorSet *BridgeOperatorSet) error {
+	return this.table.Insert(ctx, bridgeOperatorSet)
+}
+
+func (this bridgeOperatorSetTable) Update(ctx context.Context, bridgeOperatorSet *BridgeOperatorSet) error {
+	return this.table.Update(ctx, bridgeOperatorSet)
+}
+
+func (this bridgeOperatorSetTable) Save(ctx context.Context, bridgeOperatorSet *BridgeOperatorSet) error {
+	return this.table.Save(ctx, bridgeOperatorSet)
+}
+
+func (this bridgeOperatorSetTable) Delete(ctx context.Context, bridgeOperatorSet *BridgeOperatorSet) error {
+	return this.table.Delete(ctx, bridgeOperatorSet)
+}
+
+func (this bridgeOperatorSetTable) Has(ctx context.Context, class_key uint64, source string) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, class_key, source)
+}
+
+func (this bridgeOperatorSetTable) Get(ctx context.Context, class_key uint64, source string) (*BridgeOperatorSet, error) {
+	var bridgeOperatorSet BridgeOperatorSet
+	found, err := this.table.PrimaryKey().Get(ctx, &bridgeOperatorSet, class_key, source)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &bridgeOperatorSet, nil
+}
+
+func (this bridgeOperatorSetTable) List(ctx context.Context, prefixKey BridgeOperatorSetIndexKey, opts ...ormlist.Option) (BridgeOperatorSetIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return BridgeOperatorSetIterator{it}, err
+}
+
+func (this bridgeOperatorSetTable) ListRange(ctx context.Context, from, to BridgeOperatorSetIndexKey, opts ...ormlist.Option) (BridgeOperatorSetIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return BridgeOperatorSetIterator{it}, err
+}
+
+func (this bridgeOperatorSetTable) DeleteBy(ctx context.Context, prefixKey BridgeOperatorSetIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this bridgeOperatorSetTable) DeleteRange(ctx context.Context, from, to BridgeOperatorSetIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this bridgeOperatorSetTable) doNotImplement() {}
+
+var _ BridgeOperatorSetTable = bridgeOperatorSetTable{}
+
+func NewBridgeOperatorSetTable(db ormtable.Schema) (BridgeOperatorSetTable, error) {
+	table := db.GetTable(&BridgeOperatorSet{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&BridgeOperatorSet{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return bridgeOperatorSetTable{table}, nil
+}
+
+type BridgeAttestationTable interface {
+	Insert(ctx context.Context, bridgeAttestation *BridgeAttestation) error
+	Update(ctx context.Context, bridgeAttestation *BridgeAttestation) error
+	Save(ctx context.Context, bridgeAttestation *BridgeAttestation) error
+	Delete(ctx context.Context, bridgeAttestation *BridgeAttestation) error
+	Has(ctx context.Context, class_key uint64, id string, source string) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, class_key uint64, id string, source string) (*BridgeAttestation, error)
+	List(ctx context.Context, prefixKey BridgeAttestationIndexKey, opts ...ormlist.Option) (BridgeAttestationIterator, error)
+	ListRange(ctx context.Context, from, to BridgeAttestationIndexKey, opts ...ormlist.Option) (BridgeAttestationIterator, error)
+	DeleteBy(ctx context.Context, prefixKey BridgeAttestationIndexKey) error
+	DeleteRange(ctx context.Context, from, to BridgeAttestationIndexKey) error
+
+	doNotImplement()
+}
+
+type BridgeAttestationIterator struct {
+	ormtable.Iterator
+}
+
+func (i BridgeAttestationIterator) Value() (*BridgeAttestation, error) {
+	var bridgeAttestation BridgeAttestation
+	err := i.UnmarshalMessage(&bridgeAttestation)
+	return &bridgeAttestation, err
+}
+
+type BridgeAttestationIndexKey interface {
+	id() uint32
+	values() []interface{}
+	bridgeAttestationIndexKey()
+}
+
+// primary key starting index..
+type BridgeAttestationPrimaryKey = BridgeAttestationClassKeyIdSourceIndexKey
+
+type BridgeAttestationClassKeyIdSourceIndexKey struct {
+	vs []interface{}
+}
+
+func (x BridgeAttestationClassKeyIdSourceIndexKey) id() uint32                 { return 0 }
+func (x BridgeAttestationClassKeyIdSourceIndexKey) values() []interface{}      { return x.vs }
+func (x BridgeAttestationClassKeyIdSourceIndexKey) bridgeAttestationIndexKey() {}
+
+func (this BridgeAttestationClassKeyIdSourceIndexKey) WithClassKey(class_key uint64) BridgeAttestationClassKeyIdSourceIndexKey {
+	this.vs = []interface{}{class_key}
+	return this
+}
+
+func (this BridgeAttestationClassKeyIdSourceIndexKey) WithClassKeyId(class_key uint64, id string) BridgeAttestationClassKeyIdSourceIndexKey {
+	this.vs = []interface{}{class_key, id}
+	return this
+}
+
+func (this BridgeAttestationClassKeyIdSourceIndexKey) WithClassKeyIdSource(class_key uint64, id string, source string) BridgeAttestationClassKeyIdSourceIndexKey {
+	this.vs = []interface{}{class_key, id, source}
+	return this
+}
+
+type BridgeAttestationExpirationIndexKey struct {
+	vs []interface{}
+}
+
+func (x BridgeAttestationExpirationIndexKey) id() uint32                 { return 1 }
+func (x BridgeAttestationExpirationIndexKey) values() []interface{}      { return x.vs }
+func (x BridgeAttestationExpirationIndexKey) bridgeAttestationIndexKey() {}
+
+func (this BridgeAttestationExpirationIndexKey) WithExpiration(expiration *timestamppb.Timestamp) BridgeAttestationExpirationIndexKey {
+	this.vs = []interface{}{expiration}
+	return this
+}
+
+type bridgeAttestationTable struct {
+	table ormtable.Table
+}
+
+func (this bridgeAttestationTable) Insert(ctx context.Context, bridgeAttestation *BridgeAttestation) error {
+	return this.table.Insert(ctx, bridgeAttestation)
+}
+
+func (this bridgeAttestationTable) Update(ctx context.Context, bridgeAttestation *BridgeAttestation) error {
+	return this.table.Update(ctx, bridgeAttestation)
+}
+
+func (this bridgeAttestationTable) Save(ctx context.Context, bridgeAttestation *BridgeAttestation) error {
+	return this.table.Save(ctx, bridgeAttestation)
+}
+
+func (this bridgeAttestationTable) Delete(ctx context.Context, bridgeAttestation *BridgeAttestation) error {
+	return this.table.Delete(ctx, bridgeAttestation)
+}
+
+func (this bridgeAttestationTable) Has(ctx context.Context, class_key uint64, id string, source string) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, class_key, id, source)
+}
+
+func (this bridgeAttestationTable) Get(ctx context.Context, class_key uint64, id string, source string) (*BridgeAttestation, error) {
+	var bridgeAttestation BridgeAttestation
+	found, err := this.table.PrimaryKey().Get(ctx, &bridgeAttestation, class_key, id, source)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &bridgeAttestation, nil
+}
+
+func (this bridgeAttestationTable) List(ctx context.Context, prefixKey BridgeAttestationIndexKey, opts ...ormlist.Option) (BridgeAttestationIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return BridgeAttestationIterator{it}, err
+}
+
+func (this bridgeAttestationTable) ListRange(ctx context.Context, from, to BridgeAttestationIndexKey, opts ...ormlist.Option) (BridgeAttestationIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return BridgeAttestationIterator{it}, err
+}
+
+func (this bridgeAttestationTable) DeleteBy(ctx context.Context, prefixKey BridgeAttestationIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this bridgeAttestationTable) DeleteRange(ctx context.Context, from, to BridgeAttestationIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this bridgeAttestationTable) doNotImplement() {}
+
+var _ BridgeAttestationTable = bridgeAttestationTable{}
+
+func NewBridgeAttestationTable(db ormtable.Schema) (BridgeAttestationTable, error) {
+	table := db.GetTable(&BridgeAttestation{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&BridgeAttestation{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return bridgeAttestationTable{table}, nil
+}
+
+type OutboundBridgeRequestTable interface {
+	Insert(ctx context.Context, outboundBridgeRequest *OutboundBridgeRequest) error
+	InsertReturningID(ctx context.Context, outboundBridgeRequest *OutboundBridgeRequest) (uint64, error)
+	Update(ctx context.Context, outboundBridgeRequest *OutboundBridgeRequest) error
+	Save(ctx context.Context, outboundBridgeRequest *OutboundBridgeRequest) error
+	Delete(ctx context.Context, outboundBridgeRequest *OutboundBridgeRequest) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*OutboundBridgeRequest, error)
+	List(ctx context.Context, prefixKey OutboundBridgeRequestIndexKey, opts ...ormlist.Option) (OutboundBridgeRequestIterator, error)
+	ListRange(ctx context.Context, from, to OutboundBridgeRequestIndexKey, opts ...ormlist.Option) (OutboundBridgeRequestIterator, error)
+	DeleteBy(ctx context.Context, prefixKey OutboundBridgeRequestIndexKey) error
+	DeleteRange(ctx context.Context, from, to OutboundBridgeRequestIndexKey) error
+
+	doNotImplement()
+}
+
+type OutboundBridgeRequestIterator struct {
+	ormtable.Iterator
+}
+
+func (i OutboundBridgeRequestIterator) Value() (*OutboundBridgeRequest, error) {
+	var outboundBridgeRequest OutboundBridgeRequest
+	err := i.UnmarshalMessage(&outboundBridgeRequest)
+	return &outboundBridgeRequest, err
+}
+
+type OutboundBridgeRequestIndexKey interface {
+	id() uint32
+	values() []interface{}
+	outboundBridgeRequestIndexKey()
+}
+
+// primary key starting index..
+type OutboundBridgeRequestPrimaryKey = OutboundBridgeRequestIdIndexKey
+
+type OutboundBridgeRequestIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x OutboundBridgeRequestIdIndexKey) id() uint32                     { return 0 }
+func (x OutboundBridgeRequestIdIndexKey) values() []interface{}          { return x.vs }
+func (x OutboundBridgeRequestIdIndexKey) outboundBridgeRequestIndexKey() {}
+
+func (this OutboundBridgeRequestIdIndexKey) WithId(id uint64) OutboundBridgeRequestIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type OutboundBridgeRequestOwnerIndexKey struct {
+	vs []interface{}
+}
+
+func (x OutboundBridgeRequestOwnerIndexKey) id() uint32                     { return 1 }
+func (x OutboundBridgeRequestOwnerIndexKey) values() []interface{}          { return x.vs }
+func (x OutboundBridgeRequestOwnerIndexKey) outboundBridgeRequestIndexKey() {}
+
+func (this OutboundBridgeRequestOwnerIndexKey) WithOwner(owner []byte) OutboundBridgeRequestOwnerIndexKey {
+	this.vs = []interface{}{owner}
+	return this
+}
+
+type OutboundBridgeRequestExpirationIndexKey struct {
+	vs []interface{}
+}
+
+func (x OutboundBridgeRequestExpirationIndexKey) id() uint32                     { return 2 }
+func (x OutboundBridgeRequestExpirationIndexKey) values() []interface{}          { return x.vs }
+func (x OutboundBridgeRequestExpirationIndexKey) outboundBridgeRequestIndexKey() {}
+
+func (this OutboundBridgeRequestExpirationIndexKey) WithExpiration(expiration *timestamppb.Timestamp) OutboundBridgeRequestExpirationIndexKey {
+	this.vs = []interface{}{expiration}
+	return this
+}
+
+type OutboundBridgeRequestBatchKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x OutboundBridgeRequestBatchKeyIndexKey) id() uint32                     { return 3 }
+func (x OutboundBridgeRequestBatchKeyIndexKey) values() []interface{}          { return x.vs }
+func (x OutboundBridgeRequestBatchKeyIndexKey) outboundBridgeRequestIndexKey() {}
+
+func (this OutboundBridgeRequestBatchKeyIndexKey) WithBatchKey(batch_key uint64) OutboundBridgeRequestBatchKeyIndexKey {
+	this.vs = []interface{}{batch_key}
+	return this
+}
+
+type outboundBridgeRequestTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this outboundBridgeRequestTable) Insert(ctx context.Context, outboundBridgeRequest *OutboundBridgeRequest) error {
+	return this.table.Insert(ctx, outboundBridgeRequest)
+}
+
+func (this outboundBridgeRequestTable) Update(ctx context.Context, outboundBridgeRequest *OutboundBridgeRequest) error {
+	return this.table.Update(ctx, outboundBridgeRequest)
+}
+
+func (this outboundBridgeRequestTable) Save(ctx context.Context, outboundBridgeRequest *OutboundBridgeRequest) error {
+	return this.table.Save(ctx, outboundBridgeRequest)
+}
+
+func (this outboundBridgeRequestTable) Delete(ctx context.Context, outboundBridgeRequest *OutboundBridgeRequest) error {
+	return this.table.Delete(ctx, outboundBridgeRequest)
+}
+
+func (this outboundBridgeRequestTable) InsertReturningID(ctx context.Context, outboundBridgeRequest *OutboundBridgeRequest) (uint64, error) {
+	return this.table.InsertReturningID(ctx, outboundBridgeRequest)
+}
+
+func (this outboundBridgeRequestTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this outboundBridgeRequestTable) Get(ctx context.Context, id uint64) (*OutboundBridgeRequest, error) {
+	var outboundBridgeRequest OutboundBridgeRequest
+	found, err := this.table.PrimaryKey().Get(ctx, &outboundBridgeRequest, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &outboundBridgeRequest, nil
+}
+
+func (this outboundBridgeRequestTable) List(ctx context.Context, prefixKey OutboundBridgeRequestIndexKey, opts ...ormlist.Option) (OutboundBridgeRequestIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return OutboundBridgeRequestIterator{it}, err
+}
+
+func (this outboundBridgeRequestTable) ListRange(ctx context.Context, from, to OutboundBridgeRequestIndexKey, opts ...ormlist.Option) (OutboundBridgeRequestIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return OutboundBridgeRequestIterator{it}, err
+}
+
+func (this outboundBridgeRequestTable) DeleteBy(ctx context.Context, prefixKey OutboundBridgeRequestIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this outboundBridgeRequestTable) DeleteRange(ctx context.Context, from, to OutboundBridgeRequestIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this outboundBridgeRequestTable) doNotImplement() {}
+
+var _ OutboundBridgeRequestTable = outboundBridgeRequestTable{}
+
+func NewOutboundBridgeRequestTable(db ormtable.Schema) (OutboundBridgeRequestTable, error) {
+	table := db.GetTable(&OutboundBridgeRequest{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&OutboundBridgeRequest{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return outboundBridgeRequestTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
 type StateStore interface {
 	CreditTypeTable() CreditTypeTable
 	ClassTable() ClassTable
@@ -1783,23 +3460,47 @@ type StateStore interface {
 	BatchSupplyTable() BatchSupplyTable
 	OriginTxIndexTable() OriginTxIndexTable
 	BatchContractTable() BatchContractTable
+	RetirementTable() RetirementTable
+	BatchAllowanceTable() BatchAllowanceTable
+	BatchLockTable() BatchLockTable
+	ClassBufferTable() ClassBufferTable
+	ReversalTable() ReversalTable
+	ProjectStatusChangeTable() ProjectStatusChangeTable
+	ClassMetadataChangeTable() ClassMetadataChangeTable
+	ProjectMetadataChangeTable() ProjectMetadataChangeTable
+	BatchMetadataChangeTable() BatchMetadataChangeTable
+	BridgeOperatorSetTable() BridgeOperatorSetTable
+	BridgeAttestationTable() BridgeAttestationTable
+	OutboundBridgeRequestTable() OutboundBridgeRequestTable
 
 	doNotImplement()
 }
 
 type stateStore struct {
-	creditType      CreditTypeTable
-	class           ClassTable
-	classIssuer     ClassIssuerTable
-	project         ProjectTable
-	batch           BatchTable
-	classSequence   ClassSequenceTable
-	projectSequence ProjectSequenceTable
-	batchSequence   BatchSequenceTable
-	batchBalance    BatchBalanceTable
-	batchSupply     BatchSupplyTable
-	originTxIndex   OriginTxIndexTable
-	batchContract   BatchContractTable
+	creditType            CreditTypeTable
+	class                 ClassTable
+	classIssuer           ClassIssuerTable
+	project               ProjectTable
+	batch                 BatchTable
+	classSequence         ClassSequenceTable
+	projectSequence       ProjectSequenceTable
+	batchSequence         BatchSequenceTable
+	batchBalance          BatchBalanceTable
+	batchSupply           BatchSupplyTable
+	originTxIndex         OriginTxIndexTable
+	batchContract         BatchContractTable
+	retirement            RetirementTable
+	batchAllowance        BatchAllowanceTable
+	batchLock             BatchLockTable
+	classBuffer           ClassBufferTable
+	reversal              ReversalTable
+	projectStatusChange   ProjectStatusChangeTable
+	classMetadataChange   ClassMetadataChangeTable
+	projectMetadataChange ProjectMetadataChangeTable
+	batchMetadataChange   BatchMetadataChangeTable
+	bridgeOperatorSet     BridgeOperatorSetTable
+	bridgeAttestation     BridgeAttestationTable
+	outboundBridgeRequest OutboundBridgeRequestTable
 }
 
 func (x stateStore) CreditTypeTable() CreditTypeTable {
@@ -1850,6 +3551,54 @@ func (x stateStore) BatchContractTable() BatchContractTable {
 	return x.batchContract
 }
 
+func (x stateStore) RetirementTable() RetirementTable {
+	return x.retirement
+}
+
+func (x stateStore) BatchAllowanceTable() BatchAllowanceTable {
+	return x.batchAllowance
+}
+
+func (x stateStore) BatchLockTable() BatchLockTable {
+	return x.batchLock
+}
+
+func (x stateStore) ClassBufferTable() ClassBufferTable {
+	return x.classBuffer
+}
+
+func (x stateStore) ReversalTable() ReversalTable {
+	return x.reversal
+}
+
+func (x stateStore) ProjectStatusChangeTable() ProjectStatusChangeTable {
+	return x.projectStatusChange
+}
+
+func (x stateStore) ClassMetadataChangeTable() ClassMetadataChangeTable {
+	return x.classMetadataChange
+}
+
+func (x stateStore) ProjectMetadataChangeTable() ProjectMetadataChangeTable {
+	return x.projectMetadataChange
+}
+
+func (x stateStore) BatchMetadataChangeTable() BatchMetadataChangeTable {
+	return x.batchMetadataChange
+}
+
+func (x stateStore) BridgeOperatorSetTable() BridgeOperatorSetTable {
+	return x.bridgeOperatorSet
+}
+
+func (x stateStore) BridgeAttestationTable() BridgeAttestationTable {
+	return x.bridgeAttestation
+}
+
+func (x stateStore) OutboundBridgeRequestTable() OutboundBridgeRequestTable {
+	return x.outboundBridgeRequest
+}
+
 func (stateStore) doNotImplement() {}
 
 var _ StateStore = stateStore{}
@@ -1915,6 +3664,66 @@ func NewStateStore(db ormtable.Schema) (StateStore, error) {
 		return nil, err
 	}
 
+	retirementTable, err := NewRetirementTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	batchAllowanceTable, err := NewBatchAllowanceTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	batchLockTable, err := NewBatchLockTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	classBufferTable, err := NewClassBufferTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	reversalTable, err := NewReversalTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	projectStatusChangeTable, err := NewProjectStatusChangeTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	classMetadataChangeTable, err := NewClassMetadataChangeTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	projectMetadataChangeTable, err := NewProjectMetadataChangeTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	batchMetadataChangeTable, err := NewBatchMetadataChangeTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	bridgeOperatorSetTable, err := NewBridgeOperatorSetTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	bridgeAttestationTable, err := NewBridgeAttestationTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	outboundBridgeRequestTable, err := NewOutboundBridgeRequestTable(db)
+	if err != nil {
+		return nil, err
+	}
+
 	return stateStore{
 		creditTypeTable,
 		classTable,
@@ -1928,5 +3737,17 @@ func NewStateStore(db ormtable.Schema) (StateStore, error) {
 		batchSupplyTable,
 		originTxIndexTable,
 		batchContractTable,
+		retirementTable,
+		batchAllowanceTable,
+		batchLockTable,
+		classBufferTable,
+		reversalTable,
+		projectStatusChangeTable,
+		classMetadataChangeTable,
+		projectMetadataChangeTable,
+		batchMetadataChangeTable,
+		bridgeOperatorSetTable,
+		bridgeAttestationTable,
+		outboundBridgeRequestTable,
 	}, nil
 }
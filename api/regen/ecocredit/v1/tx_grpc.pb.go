@@ -101,6 +101,65 @@ type MsgClient interface {
 	// project with a matching reference id already exists within the scope of the
 	// credit class, otherwise a new project will be created.
 	BridgeReceive(ctx context.Context, in *MsgBridgeReceive, opts ...grpc.CallOption) (*MsgBridgeReceiveResponse, error)
+	// Approve sets the amount of credits an operator is approved to send or
+	// retire on behalf of the owner from a credit batch or from any credit batch
+	// within a credit class. An existing allowance with the same scope is
+	// replaced.
+	Approve(ctx context.Context, in *MsgApprove, opts ...grpc.CallOption) (*MsgApproveResponse, error)
+	// RevokeApproval removes an allowance previously granted to an operator.
+	RevokeApproval(ctx context.Context, in *MsgRevokeApproval, opts ...grpc.CallOption) (*MsgRevokeApprovalResponse, error)
+	// SendFrom sends credits from the account of the owner to a recipient on
+	// behalf of the owner, spending against the allowances the owner has granted
+	// the operator. Sent credits can either remain tradable or be retired upon
+	// receipt.
+	SendFrom(ctx context.Context, in *MsgSendFrom, opts ...grpc.CallOption) (*MsgSendFromResponse, error)
+	// RetireFrom retires credits from the account of the owner on behalf of the
+	// owner, spending against the allowances the owner has granted the operator.
+	RetireFrom(ctx context.Context, in *MsgRetireFrom, opts ...grpc.CallOption) (*MsgRetireFromResponse, error)
+	// SendLocked sends tradable credits from the sender to the recipient where
+	// the credits are locked and released into the tradable balance of the
+	// recipient according to the lock schedule.
+	SendLocked(ctx context.Context, in *MsgSendLocked, opts ...grpc.CallOption) (*MsgSendLockedResponse, error)
+	// UpdateClassBuffer updates the percentage of each issuance of credits within
+	// a credit class that is withheld into the buffer pool of the credit class.
+	// Only the admin of the credit class can update the buffer percentage.
+	UpdateClassBuffer(ctx context.Context, in *MsgUpdateClassBuffer, opts ...grpc.CallOption) (*MsgUpdateClassBufferResponse, error)
+	// ReportReversal reports a reversal against a project and cancels credits
+	// held in the buffer pool of the credit class to account for the reversal.
+	// Only the admin of the credit class can report a reversal.
+	ReportReversal(ctx context.Context, in *MsgReportReversal, opts ...grpc.CallOption) (*MsgReportReversalResponse, error)
+	// FreezeBatch freezes a credit batch. Credits from a frozen credit batch
+	// cannot be sent, retired, sold, bought or put into a basket, and all sell
+	// orders of the credit batch are cancelled. Only the admin of the credit
+	// class can freeze a credit batch.
+	FreezeBatch(ctx context.Context, in *MsgFreezeBatch, opts ...grpc.CallOption) (*MsgFreezeBatchResponse, error)
+	// UnfreezeBatch unfreezes a frozen credit batch. Only the admin of the
+	// credit class can unfreeze a credit batch.
+	UnfreezeBatch(ctx context.Context, in *MsgUnfreezeBatch, opts ...grpc.CallOption) (*MsgUnfreezeBatchResponse, error)
+	// UpdateProjectStatus updates the lifecycle status of a project. Credits can
+	// only be issued to a project with an active status. Only the admin of the
+	// credit class of the project can update the status of the project.
+	UpdateProjectStatus(ctx context.Context, in *MsgUpdateProjectStatus, opts ...grpc.CallOption) (*MsgUpdateProjectStatusResponse, error)
+	// UpdateBatchMetadata updates the metadata of an open credit batch. Only the
+	// issuer of the credit batch can update the metadata.
+	UpdateBatchMetadata(ctx context.Context, in *MsgUpdateBatchMetadata, opts ...grpc.CallOption) (*MsgUpdateBatchMetadataResponse, error)
+	// UpdateBridgeOperators sets or removes the bridge operator set of a credit
+	// class for a given source chain or registry. Only the admin of the credit
+	// class can update the bridge operator set.
+	UpdateBridgeOperators(ctx context.Context, in *MsgUpdateBridgeOperators, opts ...grpc.CallOption) (*MsgUpdateBridgeOperatorsResponse, error)
+	// AttestBridgeReceive attests to credits being sent from another chain. Each
+	// bridge operator of the credit class attests to the same origin transaction
+	// and the credits are bridged following the same rules as BridgeReceive once
+	// the threshold of the bridge operator set has been reached.
+	AttestBridgeReceive(ctx context.Context, in *MsgAttestBridgeReceive, opts ...grpc.CallOption) (*MsgAttestBridgeReceiveResponse, error)
+	// BridgeAck acknowledges an outbound bridge request as either completed on
+	// the target chain or failed. Only a bridge operator of the credit class, or
+	// a credit class issuer if the credit class has no bridge operators, can
+	// acknowledge a request as completed. A request can only be acknowledged as
+	// failed by the bridge operators of the credit class, and the credits are
+	// refunded to the owner once the threshold of the bridge operator set has
+	// been reached.
+	BridgeAck(ctx context.Context, in *MsgBridgeAck, opts ...grpc.CallOption) (*MsgBridgeAckResponse, error)
 }
 
 type msgClient struct {
@@ -246,6 +305,132 @@ func (c *msgClient) BridgeReceive(ctx context.Context, in *MsgBridgeReceive, opt
 	return out, nil
 }
 
+func (c *msgClient) Approve(ctx context.Context, in *MsgApprove, opts ...grpc.CallOption) (*MsgApproveResponse, error) {
+	out := new(MsgApproveResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/Approve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeApproval(ctx context.Context, in *MsgRevokeApproval, opts ...grpc.CallOption) (*MsgRevokeApprovalResponse, error) {
+	out := new(MsgRevokeApprovalResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/RevokeApproval", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SendFrom(ctx context.Context, in *MsgSendFrom, opts ...grpc.CallOption) (*MsgSendFromResponse, error) {
+	out := new(MsgSendFromResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/SendFrom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RetireFrom(ctx context.Context, in *MsgRetireFrom, opts ...grpc.CallOption) (*MsgRetireFromResponse, error) {
+	out := new(MsgRetireFromResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/RetireFrom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SendLocked(ctx context.Context, in *MsgSendLocked, opts ...grpc.CallOption) (*MsgSendLockedResponse, error) {
+	out := new(MsgSendLockedResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/SendLocked", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateClassBuffer(ctx context.Context, in *MsgUpdateClassBuffer, opts ...grpc.CallOption) (*MsgUpdateClassBufferResponse, error) {
+	out := new(MsgUpdateClassBufferResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/UpdateClassBuffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReportReversal(ctx context.Context, in *MsgReportReversal, opts ...grpc.CallOption) (*MsgReportReversalResponse, error) {
+	out := new(MsgReportReversalResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/ReportReversal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FreezeBatch(ctx context.Context, in *MsgFreezeBatch, opts ...grpc.CallOption) (*MsgFreezeBatchResponse, error) {
+	out := new(MsgFreezeBatchResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/FreezeBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnfreezeBatch(ctx context.Context, in *MsgUnfreezeBatch, opts ...grpc.CallOption) (*MsgUnfreezeBatchResponse, error) {
+	out := new(MsgUnfreezeBatchResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/UnfreezeBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateProjectStatus(ctx context.Context, in *MsgUpdateProjectStatus, opts ...grpc.CallOption) (*MsgUpdateProjectStatusResponse, error) {
+	out := new(MsgUpdateProjectStatusResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/UpdateProjectStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateBatchMetadata(ctx context.Context, in *MsgUpdateBatchMetadata, opts ...grpc.CallOption) (*MsgUpdateBatchMetadataResponse, error) {
+	out := new(MsgUpdateBatchMetadataResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/UpdateBatchMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateBridgeOperators(ctx context.Context, in *MsgUpdateBridgeOperators, opts ...grpc.CallOption) (*MsgUpdateBridgeOperatorsResponse, error) {
+	out := new(MsgUpdateBridgeOperatorsResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/UpdateBridgeOperators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AttestBridgeReceive(ctx context.Context, in *MsgAttestBridgeReceive, opts ...grpc.CallOption) (*MsgAttestBridgeReceiveResponse, error) {
+	out := new(MsgAttestBridgeReceiveResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/AttestBridgeReceive", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BridgeAck(ctx context.Context, in *MsgBridgeAck, opts ...grpc.CallOption) (*MsgBridgeAckResponse, error) {
+	out := new(MsgBridgeAckResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/BridgeAck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -329,6 +514,65 @@ type MsgServer interface {
 	// project with a matching reference id already exists within the scope of the
 	// credit class, otherwise a new project will be created.
 	BridgeReceive(context.Context, *MsgBridgeReceive) (*MsgBridgeReceiveResponse, error)
+	// Approve sets the amount of credits an operator is approved to send or
+	// retire on behalf of the owner from a credit batch or from any credit batch
+	// within a credit class. An existing allowance with the same scope is
+	// replaced.
+	Approve(context.Context, *MsgApprove) (*MsgApproveResponse, error)
+	// RevokeApproval removes an allowance previously granted to an operator.
+	RevokeApproval(context.Context, *MsgRevokeApproval) (*MsgRevokeApprovalResponse, error)
+	// SendFrom sends credits from the account of the owner to a recipient on
+	// behalf of the owner, spending against the allowances the owner has granted
+	// the operator. Sent credits can either remain tradable or be retired upon
+	// receipt.
+	SendFrom(context.Context, *MsgSendFrom) (*MsgSendFromResponse, error)
+	// RetireFrom retires credits from the account of the owner on behalf of the
+	// owner, spending against the allowances the owner has granted the operator.
+	RetireFrom(context.Context, *MsgRetireFrom) (*MsgRetireFromResponse, error)
+	// SendLocked sends tradable credits from the sender to the recipient where
+	// the credits are locked and released into the tradable balance of the
+	// recipient according to the lock schedule.
+	SendLocked(context.Context, *MsgSendLocked) (*MsgSendLockedResponse, error)
+	// UpdateClassBuffer updates the percentage of each issuance of credits within
+	// a credit class that is withheld into the buffer pool of the credit class.
+	// Only the admin of the credit class can update the buffer percentage.
+	UpdateClassBuffer(context.Context, *MsgUpdateClassBuffer) (*MsgUpdateClassBufferResponse, error)
+	// ReportReversal reports a reversal against a project and cancels credits
+	// held in the buffer pool of the credit class to account for the reversal.
+	// Only the admin of the credit class can report a reversal.
+	ReportReversal(context.Context, *MsgReportReversal) (*MsgReportReversalResponse, error)
+	// FreezeBatch freezes a credit batch. Credits from a frozen credit batch
+	// cannot be sent, retired, sold, bought or put into a basket, and all sell
+	// orders of the credit batch are cancelled. Only the admin of the credit
+	// class can freeze a credit batch.
+	FreezeBatch(context.Context, *MsgFreezeBatch) (*MsgFreezeBatchResponse, error)
+	// UnfreezeBatch unfreezes a frozen credit batch. Only the admin of the
+	// credit class can unfreeze a credit batch.
+	UnfreezeBatch(context.Context, *MsgUnfreezeBatch) (*MsgUnfreezeBatchResponse, error)
+	// UpdateProjectStatus updates the lifecycle status of a project. Credits can
+	// only be issued to a project with an active status. Only the admin of the
+	// credit class of the project can update the status of the project.
+	UpdateProjectStatus(context.Context, *MsgUpdateProjectStatus) (*MsgUpdateProjectStatusResponse, error)
+	// UpdateBatchMetadata updates the metadata of an open credit batch. Only the
+	// issuer of the credit batch can update the metadata.
+	UpdateBatchMetadata(context.Context, *MsgUpdateBatchMetadata) (*MsgUpdateBatchMetadataResponse, error)
+	// UpdateBridgeOperators sets or removes the bridge operator set of a credit
+	// class for a given source chain or registry. Only the admin of the credit
+	// class can update the bridge operator set.
+	UpdateBridgeOperators(context.Context, *MsgUpdateBridgeOperators) (*MsgUpdateBridgeOperatorsResponse, error)
+	// AttestBridgeReceive attests to credits being sent from another chain. Each
+	// bridge operator of the credit class attests to the same origin transaction
+	// and the credits are bridged following the same rules as BridgeReceive once
+	// the threshold of the bridge operator set has been reached.
+	AttestBridgeReceive(context.Context, *MsgAttestBridgeReceive) (*MsgAttestBridgeReceiveResponse, error)
+	// BridgeAck acknowledges an outbound bridge request as either completed on
+	// the target chain or failed. Only a bridge operator of the credit class, or
+	// a credit class issuer if the credit class has no bridge operators, can
+	// acknowledge a request as completed. A request can only be acknowledged as
+	// failed by the bridge operators of the credit class, and the credits are
+	// refunded to the owner once the threshold of the bridge operator set has
+	// been reached.
+	BridgeAck(context.Context, *MsgBridgeAck) (*MsgBridgeAckResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -381,6 +625,48 @@ func (UnimplementedMsgServer) Bridge(context.Context, *MsgBridge) (*MsgBridgeRes
 func (UnimplementedMsgServer) BridgeReceive(context.Context, *MsgBridgeReceive) (*MsgBridgeReceiveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BridgeReceive not implemented")
 }
+func (UnimplementedMsgServer) Approve(context.Context, *MsgApprove) (*MsgApproveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Approve not implemented")
+}
+func (UnimplementedMsgServer) RevokeApproval(context.Context, *MsgRevokeApproval) (*MsgRevokeApprovalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeApproval not implemented")
+}
+func (UnimplementedMsgServer) SendFrom(context.Context, *MsgSendFrom) (*MsgSendFromResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendFrom not implemented")
+}
+func (UnimplementedMsgServer) RetireFrom(context.Context, *MsgRetireFrom) (*MsgRetireFromResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetireFrom not implemented")
+}
+func (UnimplementedMsgServer) SendLocked(context.Context, *MsgSendLocked) (*MsgSendLockedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendLocked not implemented")
+}
+func (UnimplementedMsgServer) UpdateClassBuffer(context.Context, *MsgUpdateClassBuffer) (*MsgUpdateClassBufferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClassBuffer not implemented")
+}
+func (UnimplementedMsgServer) ReportReversal(context.Context, *MsgReportReversal) (*MsgReportReversalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportReversal not implemented")
+}
+func (UnimplementedMsgServer) FreezeBatch(context.Context, *MsgFreezeBatch) (*MsgFreezeBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeBatch not implemented")
+}
+func (UnimplementedMsgServer) UnfreezeBatch(context.Context, *MsgUnfreezeBatch) (*MsgUnfreezeBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeBatch not implemented")
+}
+func (UnimplementedMsgServer) UpdateProjectStatus(context.Context, *MsgUpdateProjectStatus) (*MsgUpdateProjectStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProjectStatus not implemented")
+}
+func (UnimplementedMsgServer) UpdateBatchMetadata(context.Context, *MsgUpdateBatchMetadata) (*MsgUpdateBatchMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBatchMetadata not implemented")
+}
+func (UnimplementedMsgServer) UpdateBridgeOperators(context.Context, *MsgUpdateBridgeOperators) (*MsgUpdateBridgeOperatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBridgeOperators not implemented")
+}
+func (UnimplementedMsgServer) AttestBridgeReceive(context.Context, *MsgAttestBridgeReceive) (*MsgAttestBridgeReceiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestBridgeReceive not implemented")
+}
+func (UnimplementedMsgServer) BridgeAck(context.Context, *MsgBridgeAck) (*MsgBridgeAckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeAck not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -664,6 +950,258 @@ func _Msg_BridgeReceive_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Approve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApprove)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Approve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/Approve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Approve(ctx, req.(*MsgApprove))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeApproval)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeApproval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/RevokeApproval",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeApproval(ctx, req.(*MsgRevokeApproval))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendFrom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendFrom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendFrom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/SendFrom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendFrom(ctx, req.(*MsgSendFrom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RetireFrom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetireFrom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetireFrom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/RetireFrom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetireFrom(ctx, req.(*MsgRetireFrom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendLocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendLocked)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendLocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/SendLocked",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendLocked(ctx, req.(*MsgSendLocked))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateClassBuffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateClassBuffer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateClassBuffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/UpdateClassBuffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateClassBuffer(ctx, req.(*MsgUpdateClassBuffer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReportReversal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReportReversal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReportReversal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/ReportReversal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReportReversal(ctx, req.(*MsgReportReversal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FreezeBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreezeBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FreezeBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/FreezeBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FreezeBatch(ctx, req.(*MsgFreezeBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnfreezeBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnfreezeBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnfreezeBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/UnfreezeBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnfreezeBatch(ctx, req.(*MsgUnfreezeBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateProjectStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateProjectStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateProjectStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/UpdateProjectStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateProjectStatus(ctx, req.(*MsgUpdateProjectStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateBatchMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBatchMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBatchMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/UpdateBatchMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBatchMetadata(ctx, req.(*MsgUpdateBatchMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateBridgeOperators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBridgeOperators)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBridgeOperators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/UpdateBridgeOperators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBridgeOperators(ctx, req.(*MsgUpdateBridgeOperators))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AttestBridgeReceive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAttestBridgeReceive)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AttestBridgeReceive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/AttestBridgeReceive",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AttestBridgeReceive(ctx, req.(*MsgAttestBridgeReceive))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BridgeAck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBridgeAck)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BridgeAck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/BridgeAck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BridgeAck(ctx, req.(*MsgBridgeAck))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -731,6 +1269,62 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BridgeReceive",
 			Handler:    _Msg_BridgeReceive_Handler,
 		},
+		{
+			MethodName: "Approve",
+			Handler:    _Msg_Approve_Handler,
+		},
+		{
+			MethodName: "RevokeApproval",
+			Handler:    _Msg_RevokeApproval_Handler,
+		},
+		{
+			MethodName: "SendFrom",
+			Handler:    _Msg_SendFrom_Handler,
+		},
+		{
+			MethodName: "RetireFrom",
+			Handler:    _Msg_RetireFrom_Handler,
+		},
+		{
+			MethodName: "SendLocked",
+			Handler:    _Msg_SendLocked_Handler,
+		},
+		{
+			MethodName: "UpdateClassBuffer",
+			Handler:    _Msg_UpdateClassBuffer_Handler,
+		},
+		{
+			MethodName: "ReportReversal",
+			Handler:    _Msg_ReportReversal_Handler,
+		},
+		{
+			MethodName: "FreezeBatch",
+			Handler:    _Msg_FreezeBatch_Handler,
+		},
+		{
+			MethodName: "UnfreezeBatch",
+			Handler:    _Msg_UnfreezeBatch_Handler,
+		},
+		{
+			MethodName: "UpdateProjectStatus",
+			Handler:    _Msg_UpdateProjectStatus_Handler,
+		},
+		{
+			MethodName: "UpdateBatchMetadata",
+			Handler:    _Msg_UpdateBatchMetadata_Handler,
+		},
+		{
+			MethodName: "UpdateBridgeOperators",
+			Handler:    _Msg_UpdateBridgeOperators_Handler,
+		},
+		{
+			MethodName: "AttestBridgeReceive",
+			Handler:    _Msg_AttestBridgeReceive_Handler,
+		},
+		{
+			MethodName: "BridgeAck",
+			Handler:    _Msg_BridgeAck_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "regen/ecocredit/v1/tx.proto",
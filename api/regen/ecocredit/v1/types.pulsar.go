@@ -9,6 +9,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
@@ -1314,6 +1315,8 @@ var (
 	fd_BatchIssuance_tradable_amount         protoreflect.FieldDescriptor
 	fd_BatchIssuance_retired_amount          protoreflect.FieldDescriptor
 	fd_BatchIssuance_retirement_jurisdiction protoreflect.FieldDescriptor
+	fd_BatchIssuance_locked_amount           protoreflect.FieldDescriptor
+	fd_BatchIssuance_lock_schedule           protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1323,6 +1326,8 @@ func init() {
 	fd_BatchIssuance_tradable_amount = md_BatchIssuance.Fields().ByName("tradable_amount")
 	fd_BatchIssuance_retired_amount = md_BatchIssuance.Fields().ByName("retired_amount")
 	fd_BatchIssuance_retirement_jurisdiction = md_BatchIssuance.Fields().ByName("retirement_jurisdiction")
+	fd_BatchIssuance_locked_amount = md_BatchIssuance.Fields().ByName("locked_amount")
+	fd_BatchIssuance_lock_schedule = md_BatchIssuance.Fields().ByName("lock_schedule")
 }
 
 var _ protoreflect.Message = (*fastReflection_BatchIssuance)(nil)
@@ -1414,6 +1419,18 @@ func (x *fastReflection_BatchIssuance) Range(f func(protoreflect.FieldDescriptor
 			return
 		}
 	}
+	if x.LockedAmount != "" {
+		value := protoreflect.ValueOfString(x.LockedAmount)
+		if !f(fd_BatchIssuance_locked_amount, value) {
+			return
+		}
+	}
+	if x.LockSchedule != nil {
+		value := protoreflect.ValueOfMessage(x.LockSchedule.ProtoReflect())
+		if !f(fd_BatchIssuance_lock_schedule, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1437,6 +1454,10 @@ func (x *fastReflection_BatchIssuance) Has(fd protoreflect.FieldDescriptor) bool
 		return x.RetiredAmount != ""
 	case "regen.ecocredit.v1.BatchIssuance.retirement_jurisdiction":
 		return x.RetirementJurisdiction != ""
+	case "regen.ecocredit.v1.BatchIssuance.locked_amount":
+		return x.LockedAmount != ""
+	case "regen.ecocredit.v1.BatchIssuance.lock_schedule":
+		return x.LockSchedule != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.BatchIssuance"))
@@ -1461,6 +1482,10 @@ func (x *fastReflection_BatchIssuance) Clear(fd protoreflect.FieldDescriptor) {
 		x.RetiredAmount = ""
 	case "regen.ecocredit.v1.BatchIssuance.retirement_jurisdiction":
 		x.RetirementJurisdiction = ""
+	case "regen.ecocredit.v1.BatchIssuance.locked_amount":
+		x.LockedAmount = ""
+	case "regen.ecocredit.v1.BatchIssuance.lock_schedule":
+		x.LockSchedule = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.BatchIssuance"))
@@ -1489,6 +1514,12 @@ func (x *fastReflection_BatchIssuance) Get(descriptor protoreflect.FieldDescript
 	case "regen.ecocredit.v1.BatchIssuance.retirement_jurisdiction":
 		value := x.RetirementJurisdiction
 		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.BatchIssuance.locked_amount":
+		value := x.LockedAmount
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.BatchIssuance.lock_schedule":
+		value := x.LockSchedule
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.BatchIssuance"))
@@ -1517,6 +1548,10 @@ func (x *fastReflection_BatchIssuance) Set(fd protoreflect.FieldDescriptor, valu
 		x.RetiredAmount = value.Interface().(string)
 	case "regen.ecocredit.v1.BatchIssuance.retirement_jurisdiction":
 		x.RetirementJurisdiction = value.Interface().(string)
+	case "regen.ecocredit.v1.BatchIssuance.locked_amount":
+		x.LockedAmount = value.Interface().(string)
+	case "regen.ecocredit.v1.BatchIssuance.lock_schedule":
+		x.LockSchedule = value.Message().Interface().(*LockSchedule)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.BatchIssuance"))
@@ -1537,6 +1572,11 @@ func (x *fastReflection_BatchIssuance) Set(fd protoreflect.FieldDescriptor, valu
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_BatchIssuance) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "regen.ecocredit.v1.BatchIssuance.lock_schedule":
+		if x.LockSchedule == nil {
+			x.LockSchedule = new(LockSchedule)
+		}
+		return protoreflect.ValueOfMessage(x.LockSchedule.ProtoReflect())
 	case "regen.ecocredit.v1.BatchIssuance.recipient":
 		panic(fmt.Errorf("field recipient of message regen.ecocredit.v1.BatchIssuance is not mutable"))
 	case "regen.ecocredit.v1.BatchIssuance.tradable_amount":
@@ -1545,6 +1585,8 @@ func (x *fastReflection_BatchIssuance) Mutable(fd protoreflect.FieldDescriptor)
 		panic(fmt.Errorf("field retired_amount of message regen.ecocredit.v1.BatchIssuance is not mutable"))
 	case "regen.ecocredit.v1.BatchIssuance.retirement_jurisdiction":
 		panic(fmt.Errorf("field retirement_jurisdiction of message regen.ecocredit.v1.BatchIssuance is not mutable"))
+	case "regen.ecocredit.v1.BatchIssuance.locked_amount":
+		panic(fmt.Errorf("field locked_amount of message regen.ecocredit.v1.BatchIssuance is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.BatchIssuance"))
@@ -1566,6 +1608,11 @@ func (x *fastReflection_BatchIssuance) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfString("")
 	case "regen.ecocredit.v1.BatchIssuance.retirement_jurisdiction":
 		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.BatchIssuance.locked_amount":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.BatchIssuance.lock_schedule":
+		m := new(LockSchedule)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.BatchIssuance"))
@@ -1651,6 +1698,14 @@ func (x *fastReflection_BatchIssuance) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.LockedAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LockSchedule != nil {
+			l = options.Size(x.LockSchedule)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1680,6 +1735,27 @@ func (x *fastReflection_BatchIssuance) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.LockSchedule != nil {
+			encoded, err := options.Marshal(x.LockSchedule)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.LockedAmount) > 0 {
+			i -= len(x.LockedAmount)
+			copy(dAtA[i:], x.LockedAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LockedAmount)))
+			i--
+			dAtA[i] = 0x2a
+		}
 		if len(x.RetirementJurisdiction) > 0 {
 			i -= len(x.RetirementJurisdiction)
 			copy(dAtA[i:], x.RetirementJurisdiction)
@@ -1885,6 +1961,74 @@ func (x *fastReflection_BatchIssuance) ProtoMethods() *protoiface.Methods {
 				}
 				x.RetirementJurisdiction = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockedAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LockedAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockSchedule", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.LockSchedule == nil {
+					x.LockSchedule = &LockSchedule{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LockSchedule); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1921,31 +2065,27 @@ func (x *fastReflection_BatchIssuance) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_OriginTx          protoreflect.MessageDescriptor
-	fd_OriginTx_id       protoreflect.FieldDescriptor
-	fd_OriginTx_source   protoreflect.FieldDescriptor
-	fd_OriginTx_contract protoreflect.FieldDescriptor
-	fd_OriginTx_note     protoreflect.FieldDescriptor
+	md_LockSchedule            protoreflect.MessageDescriptor
+	fd_LockSchedule_start_time protoreflect.FieldDescriptor
+	fd_LockSchedule_end_time   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_regen_ecocredit_v1_types_proto_init()
-	md_OriginTx = File_regen_ecocredit_v1_types_proto.Messages().ByName("OriginTx")
-	fd_OriginTx_id = md_OriginTx.Fields().ByName("id")
-	fd_OriginTx_source = md_OriginTx.Fields().ByName("source")
-	fd_OriginTx_contract = md_OriginTx.Fields().ByName("contract")
-	fd_OriginTx_note = md_OriginTx.Fields().ByName("note")
+	md_LockSchedule = File_regen_ecocredit_v1_types_proto.Messages().ByName("LockSchedule")
+	fd_LockSchedule_start_time = md_LockSchedule.Fields().ByName("start_time")
+	fd_LockSchedule_end_time = md_LockSchedule.Fields().ByName("end_time")
 }
 
-var _ protoreflect.Message = (*fastReflection_OriginTx)(nil)
+var _ protoreflect.Message = (*fastReflection_LockSchedule)(nil)
 
-type fastReflection_OriginTx OriginTx
+type fastReflection_LockSchedule LockSchedule
 
-func (x *OriginTx) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_OriginTx)(x)
+func (x *LockSchedule) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LockSchedule)(x)
 }
 
-func (x *OriginTx) slowProtoReflect() protoreflect.Message {
+func (x *LockSchedule) slowProtoReflect() protoreflect.Message {
 	mi := &file_regen_ecocredit_v1_types_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1957,43 +2097,43 @@ func (x *OriginTx) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_OriginTx_messageType fastReflection_OriginTx_messageType
-var _ protoreflect.MessageType = fastReflection_OriginTx_messageType{}
+var _fastReflection_LockSchedule_messageType fastReflection_LockSchedule_messageType
+var _ protoreflect.MessageType = fastReflection_LockSchedule_messageType{}
 
-type fastReflection_OriginTx_messageType struct{}
+type fastReflection_LockSchedule_messageType struct{}
 
-func (x fastReflection_OriginTx_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_OriginTx)(nil)
+func (x fastReflection_LockSchedule_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LockSchedule)(nil)
 }
-func (x fastReflection_OriginTx_messageType) New() protoreflect.Message {
-	return new(fastReflection_OriginTx)
+func (x fastReflection_LockSchedule_messageType) New() protoreflect.Message {
+	return new(fastReflection_LockSchedule)
 }
-func (x fastReflection_OriginTx_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_OriginTx
+func (x fastReflection_LockSchedule_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LockSchedule
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_OriginTx) Descriptor() protoreflect.MessageDescriptor {
-	return md_OriginTx
+func (x *fastReflection_LockSchedule) Descriptor() protoreflect.MessageDescriptor {
+	return md_LockSchedule
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_OriginTx) Type() protoreflect.MessageType {
-	return _fastReflection_OriginTx_messageType
+func (x *fastReflection_LockSchedule) Type() protoreflect.MessageType {
+	return _fastReflection_LockSchedule_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_OriginTx) New() protoreflect.Message {
-	return new(fastReflection_OriginTx)
+func (x *fastReflection_LockSchedule) New() protoreflect.Message {
+	return new(fastReflection_LockSchedule)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_OriginTx) Interface() protoreflect.ProtoMessage {
-	return (*OriginTx)(x)
+func (x *fastReflection_LockSchedule) Interface() protoreflect.ProtoMessage {
+	return (*LockSchedule)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2001,28 +2141,16 @@ func (x *fastReflection_OriginTx) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_OriginTx) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Id != "" {
-		value := protoreflect.ValueOfString(x.Id)
-		if !f(fd_OriginTx_id, value) {
-			return
-		}
-	}
-	if x.Source != "" {
-		value := protoreflect.ValueOfString(x.Source)
-		if !f(fd_OriginTx_source, value) {
-			return
-		}
-	}
-	if x.Contract != "" {
-		value := protoreflect.ValueOfString(x.Contract)
-		if !f(fd_OriginTx_contract, value) {
+func (x *fastReflection_LockSchedule) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.StartTime != nil {
+		value := protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+		if !f(fd_LockSchedule_start_time, value) {
 			return
 		}
 	}
-	if x.Note != "" {
-		value := protoreflect.ValueOfString(x.Note)
-		if !f(fd_OriginTx_note, value) {
+	if x.EndTime != nil {
+		value := protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+		if !f(fd_LockSchedule_end_time, value) {
 			return
 		}
 	}
@@ -2039,21 +2167,17 @@ func (x *fastReflection_OriginTx) Range(f func(protoreflect.FieldDescriptor, pro
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_OriginTx) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_LockSchedule) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.OriginTx.id":
-		return x.Id != ""
-	case "regen.ecocredit.v1.OriginTx.source":
-		return x.Source != ""
-	case "regen.ecocredit.v1.OriginTx.contract":
-		return x.Contract != ""
-	case "regen.ecocredit.v1.OriginTx.note":
-		return x.Note != ""
+	case "regen.ecocredit.v1.LockSchedule.start_time":
+		return x.StartTime != nil
+	case "regen.ecocredit.v1.LockSchedule.end_time":
+		return x.EndTime != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.OriginTx"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.LockSchedule"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.OriginTx does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.LockSchedule does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2063,21 +2187,17 @@ func (x *fastReflection_OriginTx) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OriginTx) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_LockSchedule) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.OriginTx.id":
-		x.Id = ""
-	case "regen.ecocredit.v1.OriginTx.source":
-		x.Source = ""
-	case "regen.ecocredit.v1.OriginTx.contract":
-		x.Contract = ""
-	case "regen.ecocredit.v1.OriginTx.note":
-		x.Note = ""
+	case "regen.ecocredit.v1.LockSchedule.start_time":
+		x.StartTime = nil
+	case "regen.ecocredit.v1.LockSchedule.end_time":
+		x.EndTime = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.OriginTx"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.LockSchedule"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.OriginTx does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.LockSchedule does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2087,25 +2207,19 @@ func (x *fastReflection_OriginTx) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_OriginTx) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LockSchedule) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "regen.ecocredit.v1.OriginTx.id":
-		value := x.Id
-		return protoreflect.ValueOfString(value)
-	case "regen.ecocredit.v1.OriginTx.source":
-		value := x.Source
-		return protoreflect.ValueOfString(value)
-	case "regen.ecocredit.v1.OriginTx.contract":
-		value := x.Contract
-		return protoreflect.ValueOfString(value)
-	case "regen.ecocredit.v1.OriginTx.note":
-		value := x.Note
-		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.LockSchedule.start_time":
+		value := x.StartTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.v1.LockSchedule.end_time":
+		value := x.EndTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.OriginTx"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.LockSchedule"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.OriginTx does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.LockSchedule does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2119,21 +2233,17 @@ func (x *fastReflection_OriginTx) Get(descriptor protoreflect.FieldDescriptor) p
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OriginTx) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_LockSchedule) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.OriginTx.id":
-		x.Id = value.Interface().(string)
-	case "regen.ecocredit.v1.OriginTx.source":
-		x.Source = value.Interface().(string)
-	case "regen.ecocredit.v1.OriginTx.contract":
-		x.Contract = value.Interface().(string)
-	case "regen.ecocredit.v1.OriginTx.note":
-		x.Note = value.Interface().(string)
+	case "regen.ecocredit.v1.LockSchedule.start_time":
+		x.StartTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "regen.ecocredit.v1.LockSchedule.end_time":
+		x.EndTime = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.OriginTx"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.LockSchedule"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.OriginTx does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.LockSchedule does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2147,52 +2257,52 @@ func (x *fastReflection_OriginTx) Set(fd protoreflect.FieldDescriptor, value pro
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OriginTx) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LockSchedule) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.OriginTx.id":
-		panic(fmt.Errorf("field id of message regen.ecocredit.v1.OriginTx is not mutable"))
-	case "regen.ecocredit.v1.OriginTx.source":
-		panic(fmt.Errorf("field source of message regen.ecocredit.v1.OriginTx is not mutable"))
-	case "regen.ecocredit.v1.OriginTx.contract":
-		panic(fmt.Errorf("field contract of message regen.ecocredit.v1.OriginTx is not mutable"))
-	case "regen.ecocredit.v1.OriginTx.note":
-		panic(fmt.Errorf("field note of message regen.ecocredit.v1.OriginTx is not mutable"))
+	case "regen.ecocredit.v1.LockSchedule.start_time":
+		if x.StartTime == nil {
+			x.StartTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+	case "regen.ecocredit.v1.LockSchedule.end_time":
+		if x.EndTime == nil {
+			x.EndTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.OriginTx"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.LockSchedule"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.OriginTx does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.LockSchedule does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_OriginTx) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LockSchedule) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.OriginTx.id":
-		return protoreflect.ValueOfString("")
-	case "regen.ecocredit.v1.OriginTx.source":
-		return protoreflect.ValueOfString("")
-	case "regen.ecocredit.v1.OriginTx.contract":
-		return protoreflect.ValueOfString("")
-	case "regen.ecocredit.v1.OriginTx.note":
-		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.LockSchedule.start_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.ecocredit.v1.LockSchedule.end_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.OriginTx"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.LockSchedule"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.OriginTx does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.LockSchedule does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_OriginTx) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_LockSchedule) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.OriginTx", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.LockSchedule", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2200,7 +2310,7 @@ func (x *fastReflection_OriginTx) WhichOneof(d protoreflect.OneofDescriptor) pro
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_OriginTx) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_LockSchedule) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2211,7 +2321,7 @@ func (x *fastReflection_OriginTx) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OriginTx) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_LockSchedule) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2223,7 +2333,7 @@ func (x *fastReflection_OriginTx) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_OriginTx) IsValid() bool {
+func (x *fastReflection_LockSchedule) IsValid() bool {
 	return x != nil
 }
 
@@ -2233,9 +2343,9 @@ func (x *fastReflection_OriginTx) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_OriginTx) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_LockSchedule) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*OriginTx)
+		x := input.Message.Interface().(*LockSchedule)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2247,20 +2357,12 @@ func (x *fastReflection_OriginTx) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Id)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Source)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Contract)
-		if l > 0 {
+		if x.StartTime != nil {
+			l = options.Size(x.StartTime)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Note)
-		if l > 0 {
+		if x.EndTime != nil {
+			l = options.Size(x.EndTime)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -2273,7 +2375,7 @@ func (x *fastReflection_OriginTx) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*OriginTx)
+		x := input.Message.Interface().(*LockSchedule)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2292,31 +2394,1759 @@ func (x *fastReflection_OriginTx) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Note) > 0 {
-			i -= len(x.Note)
-			copy(dAtA[i:], x.Note)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Note)))
+		if x.EndTime != nil {
+			encoded, err := options.Marshal(x.EndTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x12
 		}
-		if len(x.Contract) > 0 {
-			i -= len(x.Contract)
-			copy(dAtA[i:], x.Contract)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Contract)))
-			i--
+		if x.StartTime != nil {
+			encoded, err := options.Marshal(x.StartTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*LockSchedule)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LockSchedule: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LockSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.StartTime == nil {
+					x.StartTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StartTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EndTime == nil {
+					x.EndTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EndTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_OriginTx          protoreflect.MessageDescriptor
+	fd_OriginTx_id       protoreflect.FieldDescriptor
+	fd_OriginTx_source   protoreflect.FieldDescriptor
+	fd_OriginTx_contract protoreflect.FieldDescriptor
+	fd_OriginTx_note     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_v1_types_proto_init()
+	md_OriginTx = File_regen_ecocredit_v1_types_proto.Messages().ByName("OriginTx")
+	fd_OriginTx_id = md_OriginTx.Fields().ByName("id")
+	fd_OriginTx_source = md_OriginTx.Fields().ByName("source")
+	fd_OriginTx_contract = md_OriginTx.Fields().ByName("contract")
+	fd_OriginTx_note = md_OriginTx.Fields().ByName("note")
+}
+
+var _ protoreflect.Message = (*fastReflection_OriginTx)(nil)
+
+type fastReflection_OriginTx OriginTx
+
+func (x *OriginTx) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OriginTx)(x)
+}
+
+func (x *OriginTx) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_v1_types_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_OriginTx_messageType fastReflection_OriginTx_messageType
+var _ protoreflect.MessageType = fastReflection_OriginTx_messageType{}
+
+type fastReflection_OriginTx_messageType struct{}
+
+func (x fastReflection_OriginTx_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OriginTx)(nil)
+}
+func (x fastReflection_OriginTx_messageType) New() protoreflect.Message {
+	return new(fastReflection_OriginTx)
+}
+func (x fastReflection_OriginTx_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OriginTx
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_OriginTx) Descriptor() protoreflect.MessageDescriptor {
+	return md_OriginTx
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_OriginTx) Type() protoreflect.MessageType {
+	return _fastReflection_OriginTx_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_OriginTx) New() protoreflect.Message {
+	return new(fastReflection_OriginTx)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_OriginTx) Interface() protoreflect.ProtoMessage {
+	return (*OriginTx)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_OriginTx) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != "" {
+		value := protoreflect.ValueOfString(x.Id)
+		if !f(fd_OriginTx_id, value) {
+			return
+		}
+	}
+	if x.Source != "" {
+		value := protoreflect.ValueOfString(x.Source)
+		if !f(fd_OriginTx_source, value) {
+			return
+		}
+	}
+	if x.Contract != "" {
+		value := protoreflect.ValueOfString(x.Contract)
+		if !f(fd_OriginTx_contract, value) {
+			return
+		}
+	}
+	if x.Note != "" {
+		value := protoreflect.ValueOfString(x.Note)
+		if !f(fd_OriginTx_note, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_OriginTx) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.OriginTx.id":
+		return x.Id != ""
+	case "regen.ecocredit.v1.OriginTx.source":
+		return x.Source != ""
+	case "regen.ecocredit.v1.OriginTx.contract":
+		return x.Contract != ""
+	case "regen.ecocredit.v1.OriginTx.note":
+		return x.Note != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.OriginTx"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.OriginTx does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OriginTx) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.OriginTx.id":
+		x.Id = ""
+	case "regen.ecocredit.v1.OriginTx.source":
+		x.Source = ""
+	case "regen.ecocredit.v1.OriginTx.contract":
+		x.Contract = ""
+	case "regen.ecocredit.v1.OriginTx.note":
+		x.Note = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.OriginTx"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.OriginTx does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_OriginTx) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.v1.OriginTx.id":
+		value := x.Id
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.OriginTx.source":
+		value := x.Source
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.OriginTx.contract":
+		value := x.Contract
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.OriginTx.note":
+		value := x.Note
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.OriginTx"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.OriginTx does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OriginTx) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.OriginTx.id":
+		x.Id = value.Interface().(string)
+	case "regen.ecocredit.v1.OriginTx.source":
+		x.Source = value.Interface().(string)
+	case "regen.ecocredit.v1.OriginTx.contract":
+		x.Contract = value.Interface().(string)
+	case "regen.ecocredit.v1.OriginTx.note":
+		x.Note = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.OriginTx"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.OriginTx does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OriginTx) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.OriginTx.id":
+		panic(fmt.Errorf("field id of message regen.ecocredit.v1.OriginTx is not mutable"))
+	case "regen.ecocredit.v1.OriginTx.source":
+		panic(fmt.Errorf("field source of message regen.ecocredit.v1.OriginTx is not mutable"))
+	case "regen.ecocredit.v1.OriginTx.contract":
+		panic(fmt.Errorf("field contract of message regen.ecocredit.v1.OriginTx is not mutable"))
+	case "regen.ecocredit.v1.OriginTx.note":
+		panic(fmt.Errorf("field note of message regen.ecocredit.v1.OriginTx is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.OriginTx"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.OriginTx does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_OriginTx) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.OriginTx.id":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.OriginTx.source":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.OriginTx.contract":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.OriginTx.note":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.OriginTx"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.OriginTx does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_OriginTx) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.OriginTx", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_OriginTx) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OriginTx) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_OriginTx) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_OriginTx) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*OriginTx)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Id)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Source)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Contract)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Note)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*OriginTx)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Note) > 0 {
+			i -= len(x.Note)
+			copy(dAtA[i:], x.Note)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Note)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Contract) > 0 {
+			i -= len(x.Contract)
+			copy(dAtA[i:], x.Contract)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Contract)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Source) > 0 {
+			i -= len(x.Source)
+			copy(dAtA[i:], x.Source)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Source)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Id) > 0 {
+			i -= len(x.Id)
+			copy(dAtA[i:], x.Id)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*OriginTx)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OriginTx: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OriginTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Id = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Source = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Contract = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Note", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Note = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_CreditTypeProposal             protoreflect.MessageDescriptor
+	fd_CreditTypeProposal_title       protoreflect.FieldDescriptor
+	fd_CreditTypeProposal_description protoreflect.FieldDescriptor
+	fd_CreditTypeProposal_credit_type protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_v1_types_proto_init()
+	md_CreditTypeProposal = File_regen_ecocredit_v1_types_proto.Messages().ByName("CreditTypeProposal")
+	fd_CreditTypeProposal_title = md_CreditTypeProposal.Fields().ByName("title")
+	fd_CreditTypeProposal_description = md_CreditTypeProposal.Fields().ByName("description")
+	fd_CreditTypeProposal_credit_type = md_CreditTypeProposal.Fields().ByName("credit_type")
+}
+
+var _ protoreflect.Message = (*fastReflection_CreditTypeProposal)(nil)
+
+type fastReflection_CreditTypeProposal CreditTypeProposal
+
+func (x *CreditTypeProposal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CreditTypeProposal)(x)
+}
+
+func (x *CreditTypeProposal) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_v1_types_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CreditTypeProposal_messageType fastReflection_CreditTypeProposal_messageType
+var _ protoreflect.MessageType = fastReflection_CreditTypeProposal_messageType{}
+
+type fastReflection_CreditTypeProposal_messageType struct{}
+
+func (x fastReflection_CreditTypeProposal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CreditTypeProposal)(nil)
+}
+func (x fastReflection_CreditTypeProposal_messageType) New() protoreflect.Message {
+	return new(fastReflection_CreditTypeProposal)
+}
+func (x fastReflection_CreditTypeProposal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CreditTypeProposal
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CreditTypeProposal) Descriptor() protoreflect.MessageDescriptor {
+	return md_CreditTypeProposal
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CreditTypeProposal) Type() protoreflect.MessageType {
+	return _fastReflection_CreditTypeProposal_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CreditTypeProposal) New() protoreflect.Message {
+	return new(fastReflection_CreditTypeProposal)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CreditTypeProposal) Interface() protoreflect.ProtoMessage {
+	return (*CreditTypeProposal)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CreditTypeProposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Title != "" {
+		value := protoreflect.ValueOfString(x.Title)
+		if !f(fd_CreditTypeProposal_title, value) {
+			return
+		}
+	}
+	if x.Description != "" {
+		value := protoreflect.ValueOfString(x.Description)
+		if !f(fd_CreditTypeProposal_description, value) {
+			return
+		}
+	}
+	if x.CreditType != nil {
+		value := protoreflect.ValueOfMessage(x.CreditType.ProtoReflect())
+		if !f(fd_CreditTypeProposal_credit_type, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CreditTypeProposal) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.CreditTypeProposal.title":
+		return x.Title != ""
+	case "regen.ecocredit.v1.CreditTypeProposal.description":
+		return x.Description != ""
+	case "regen.ecocredit.v1.CreditTypeProposal.credit_type":
+		return x.CreditType != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.CreditTypeProposal"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.CreditTypeProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CreditTypeProposal) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.CreditTypeProposal.title":
+		x.Title = ""
+	case "regen.ecocredit.v1.CreditTypeProposal.description":
+		x.Description = ""
+	case "regen.ecocredit.v1.CreditTypeProposal.credit_type":
+		x.CreditType = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.CreditTypeProposal"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.CreditTypeProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CreditTypeProposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.v1.CreditTypeProposal.title":
+		value := x.Title
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.CreditTypeProposal.description":
+		value := x.Description
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.CreditTypeProposal.credit_type":
+		value := x.CreditType
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.CreditTypeProposal"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.CreditTypeProposal does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CreditTypeProposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.CreditTypeProposal.title":
+		x.Title = value.Interface().(string)
+	case "regen.ecocredit.v1.CreditTypeProposal.description":
+		x.Description = value.Interface().(string)
+	case "regen.ecocredit.v1.CreditTypeProposal.credit_type":
+		x.CreditType = value.Message().Interface().(*CreditType)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.CreditTypeProposal"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.CreditTypeProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CreditTypeProposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.CreditTypeProposal.credit_type":
+		if x.CreditType == nil {
+			x.CreditType = new(CreditType)
+		}
+		return protoreflect.ValueOfMessage(x.CreditType.ProtoReflect())
+	case "regen.ecocredit.v1.CreditTypeProposal.title":
+		panic(fmt.Errorf("field title of message regen.ecocredit.v1.CreditTypeProposal is not mutable"))
+	case "regen.ecocredit.v1.CreditTypeProposal.description":
+		panic(fmt.Errorf("field description of message regen.ecocredit.v1.CreditTypeProposal is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.CreditTypeProposal"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.CreditTypeProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CreditTypeProposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.CreditTypeProposal.title":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.CreditTypeProposal.description":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.CreditTypeProposal.credit_type":
+		m := new(CreditType)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.CreditTypeProposal"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.CreditTypeProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CreditTypeProposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.CreditTypeProposal", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CreditTypeProposal) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CreditTypeProposal) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CreditTypeProposal) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CreditTypeProposal) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CreditTypeProposal)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Title)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Description)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CreditType != nil {
+			l = options.Size(x.CreditType)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CreditTypeProposal)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.CreditType != nil {
+			encoded, err := options.Marshal(x.CreditType)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Description) > 0 {
+			i -= len(x.Description)
+			copy(dAtA[i:], x.Description)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Description)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Title) > 0 {
+			i -= len(x.Title)
+			copy(dAtA[i:], x.Title)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Title)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CreditTypeProposal)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CreditTypeProposal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CreditTypeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Title = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Description = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreditType", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CreditType == nil {
+					x.CreditType = &CreditType{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CreditType); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_CreditTypePrecisionProposal                    protoreflect.MessageDescriptor
+	fd_CreditTypePrecisionProposal_title              protoreflect.FieldDescriptor
+	fd_CreditTypePrecisionProposal_description        protoreflect.FieldDescriptor
+	fd_CreditTypePrecisionProposal_credit_type_abbrev protoreflect.FieldDescriptor
+	fd_CreditTypePrecisionProposal_precision          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_v1_types_proto_init()
+	md_CreditTypePrecisionProposal = File_regen_ecocredit_v1_types_proto.Messages().ByName("CreditTypePrecisionProposal")
+	fd_CreditTypePrecisionProposal_title = md_CreditTypePrecisionProposal.Fields().ByName("title")
+	fd_CreditTypePrecisionProposal_description = md_CreditTypePrecisionProposal.Fields().ByName("description")
+	fd_CreditTypePrecisionProposal_credit_type_abbrev = md_CreditTypePrecisionProposal.Fields().ByName("credit_type_abbrev")
+	fd_CreditTypePrecisionProposal_precision = md_CreditTypePrecisionProposal.Fields().ByName("precision")
+}
+
+var _ protoreflect.Message = (*fastReflection_CreditTypePrecisionProposal)(nil)
+
+type fastReflection_CreditTypePrecisionProposal CreditTypePrecisionProposal
+
+func (x *CreditTypePrecisionProposal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CreditTypePrecisionProposal)(x)
+}
+
+func (x *CreditTypePrecisionProposal) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_v1_types_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CreditTypePrecisionProposal_messageType fastReflection_CreditTypePrecisionProposal_messageType
+var _ protoreflect.MessageType = fastReflection_CreditTypePrecisionProposal_messageType{}
+
+type fastReflection_CreditTypePrecisionProposal_messageType struct{}
+
+func (x fastReflection_CreditTypePrecisionProposal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CreditTypePrecisionProposal)(nil)
+}
+func (x fastReflection_CreditTypePrecisionProposal_messageType) New() protoreflect.Message {
+	return new(fastReflection_CreditTypePrecisionProposal)
+}
+func (x fastReflection_CreditTypePrecisionProposal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CreditTypePrecisionProposal
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CreditTypePrecisionProposal) Descriptor() protoreflect.MessageDescriptor {
+	return md_CreditTypePrecisionProposal
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CreditTypePrecisionProposal) Type() protoreflect.MessageType {
+	return _fastReflection_CreditTypePrecisionProposal_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CreditTypePrecisionProposal) New() protoreflect.Message {
+	return new(fastReflection_CreditTypePrecisionProposal)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CreditTypePrecisionProposal) Interface() protoreflect.ProtoMessage {
+	return (*CreditTypePrecisionProposal)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CreditTypePrecisionProposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Title != "" {
+		value := protoreflect.ValueOfString(x.Title)
+		if !f(fd_CreditTypePrecisionProposal_title, value) {
+			return
+		}
+	}
+	if x.Description != "" {
+		value := protoreflect.ValueOfString(x.Description)
+		if !f(fd_CreditTypePrecisionProposal_description, value) {
+			return
+		}
+	}
+	if x.CreditTypeAbbrev != "" {
+		value := protoreflect.ValueOfString(x.CreditTypeAbbrev)
+		if !f(fd_CreditTypePrecisionProposal_credit_type_abbrev, value) {
+			return
+		}
+	}
+	if x.Precision != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Precision)
+		if !f(fd_CreditTypePrecisionProposal_precision, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CreditTypePrecisionProposal) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.CreditTypePrecisionProposal.title":
+		return x.Title != ""
+	case "regen.ecocredit.v1.CreditTypePrecisionProposal.description":
+		return x.Description != ""
+	case "regen.ecocredit.v1.CreditTypePrecisionProposal.credit_type_abbrev":
+		return x.CreditTypeAbbrev != ""
+	case "regen.ecocredit.v1.CreditTypePrecisionProposal.precision":
+		return x.Precision != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.CreditTypePrecisionProposal"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.CreditTypePrecisionProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CreditTypePrecisionProposal) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.CreditTypePrecisionProposal.title":
+		x.Title = ""
+	case "regen.ecocredit.v1.CreditTypePrecisionProposal.description":
+		x.Description = ""
+	case "regen.ecocredit.v1.CreditTypePrecisionProposal.credit_type_abbrev":
+		x.CreditTypeAbbrev = ""
+	case "regen.ecocredit.v1.CreditTypePrecisionProposal.precision":
+		x.Precision = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.CreditTypePrecisionProposal"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.CreditTypePrecisionProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CreditTypePrecisionProposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.v1.CreditTypePrecisionProposal.title":
+		value := x.Title
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.CreditTypePrecisionProposal.description":
+		value := x.Description
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.CreditTypePrecisionProposal.credit_type_abbrev":
+		value := x.CreditTypeAbbrev
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.CreditTypePrecisionProposal.precision":
+		value := x.Precision
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.CreditTypePrecisionProposal"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.CreditTypePrecisionProposal does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CreditTypePrecisionProposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.CreditTypePrecisionProposal.title":
+		x.Title = value.Interface().(string)
+	case "regen.ecocredit.v1.CreditTypePrecisionProposal.description":
+		x.Description = value.Interface().(string)
+	case "regen.ecocredit.v1.CreditTypePrecisionProposal.credit_type_abbrev":
+		x.CreditTypeAbbrev = value.Interface().(string)
+	case "regen.ecocredit.v1.CreditTypePrecisionProposal.precision":
+		x.Precision = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.CreditTypePrecisionProposal"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.CreditTypePrecisionProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CreditTypePrecisionProposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.CreditTypePrecisionProposal.title":
+		panic(fmt.Errorf("field title of message regen.ecocredit.v1.CreditTypePrecisionProposal is not mutable"))
+	case "regen.ecocredit.v1.CreditTypePrecisionProposal.description":
+		panic(fmt.Errorf("field description of message regen.ecocredit.v1.CreditTypePrecisionProposal is not mutable"))
+	case "regen.ecocredit.v1.CreditTypePrecisionProposal.credit_type_abbrev":
+		panic(fmt.Errorf("field credit_type_abbrev of message regen.ecocredit.v1.CreditTypePrecisionProposal is not mutable"))
+	case "regen.ecocredit.v1.CreditTypePrecisionProposal.precision":
+		panic(fmt.Errorf("field precision of message regen.ecocredit.v1.CreditTypePrecisionProposal is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.CreditTypePrecisionProposal"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.CreditTypePrecisionProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CreditTypePrecisionProposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.CreditTypePrecisionProposal.title":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.CreditTypePrecisionProposal.description":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.CreditTypePrecisionProposal.credit_type_abbrev":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.CreditTypePrecisionProposal.precision":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.CreditTypePrecisionProposal"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.CreditTypePrecisionProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CreditTypePrecisionProposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.CreditTypePrecisionProposal", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CreditTypePrecisionProposal) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CreditTypePrecisionProposal) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CreditTypePrecisionProposal) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CreditTypePrecisionProposal) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CreditTypePrecisionProposal)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Title)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Description)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CreditTypeAbbrev)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Precision != 0 {
+			n += 1 + runtime.Sov(uint64(x.Precision))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CreditTypePrecisionProposal)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Precision != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Precision))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.CreditTypeAbbrev) > 0 {
+			i -= len(x.CreditTypeAbbrev)
+			copy(dAtA[i:], x.CreditTypeAbbrev)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CreditTypeAbbrev)))
+			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.Source) > 0 {
-			i -= len(x.Source)
-			copy(dAtA[i:], x.Source)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Source)))
+		if len(x.Description) > 0 {
+			i -= len(x.Description)
+			copy(dAtA[i:], x.Description)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Description)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Id) > 0 {
-			i -= len(x.Id)
-			copy(dAtA[i:], x.Id)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
+		if len(x.Title) > 0 {
+			i -= len(x.Title)
+			copy(dAtA[i:], x.Title)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Title)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -2331,7 +4161,7 @@ func (x *fastReflection_OriginTx) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*OriginTx)
+		x := input.Message.Interface().(*CreditTypePrecisionProposal)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2363,15 +4193,15 @@ func (x *fastReflection_OriginTx) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OriginTx: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CreditTypePrecisionProposal: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OriginTx: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CreditTypePrecisionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2399,11 +4229,11 @@ func (x *fastReflection_OriginTx) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Id = string(dAtA[iNdEx:postIndex])
+				x.Title = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2431,11 +4261,11 @@ func (x *fastReflection_OriginTx) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Source = string(dAtA[iNdEx:postIndex])
+				x.Description = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2463,13 +4293,13 @@ func (x *fastReflection_OriginTx) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Contract = string(dAtA[iNdEx:postIndex])
+				x.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Note", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
 				}
-				var stringLen uint64
+				x.Precision = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2479,24 +4309,11 @@ func (x *fastReflection_OriginTx) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.Precision |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Note = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2533,30 +4350,36 @@ func (x *fastReflection_OriginTx) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_CreditTypeProposal             protoreflect.MessageDescriptor
-	fd_CreditTypeProposal_title       protoreflect.FieldDescriptor
-	fd_CreditTypeProposal_description protoreflect.FieldDescriptor
-	fd_CreditTypeProposal_credit_type protoreflect.FieldDescriptor
+	md_BatchFreezeProposal             protoreflect.MessageDescriptor
+	fd_BatchFreezeProposal_title       protoreflect.FieldDescriptor
+	fd_BatchFreezeProposal_description protoreflect.FieldDescriptor
+	fd_BatchFreezeProposal_batch_denom protoreflect.FieldDescriptor
+	fd_BatchFreezeProposal_freeze      protoreflect.FieldDescriptor
+	fd_BatchFreezeProposal_evidence    protoreflect.FieldDescriptor
+	fd_BatchFreezeProposal_reason      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_regen_ecocredit_v1_types_proto_init()
-	md_CreditTypeProposal = File_regen_ecocredit_v1_types_proto.Messages().ByName("CreditTypeProposal")
-	fd_CreditTypeProposal_title = md_CreditTypeProposal.Fields().ByName("title")
-	fd_CreditTypeProposal_description = md_CreditTypeProposal.Fields().ByName("description")
-	fd_CreditTypeProposal_credit_type = md_CreditTypeProposal.Fields().ByName("credit_type")
+	md_BatchFreezeProposal = File_regen_ecocredit_v1_types_proto.Messages().ByName("BatchFreezeProposal")
+	fd_BatchFreezeProposal_title = md_BatchFreezeProposal.Fields().ByName("title")
+	fd_BatchFreezeProposal_description = md_BatchFreezeProposal.Fields().ByName("description")
+	fd_BatchFreezeProposal_batch_denom = md_BatchFreezeProposal.Fields().ByName("batch_denom")
+	fd_BatchFreezeProposal_freeze = md_BatchFreezeProposal.Fields().ByName("freeze")
+	fd_BatchFreezeProposal_evidence = md_BatchFreezeProposal.Fields().ByName("evidence")
+	fd_BatchFreezeProposal_reason = md_BatchFreezeProposal.Fields().ByName("reason")
 }
 
-var _ protoreflect.Message = (*fastReflection_CreditTypeProposal)(nil)
+var _ protoreflect.Message = (*fastReflection_BatchFreezeProposal)(nil)
 
-type fastReflection_CreditTypeProposal CreditTypeProposal
+type fastReflection_BatchFreezeProposal BatchFreezeProposal
 
-func (x *CreditTypeProposal) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_CreditTypeProposal)(x)
+func (x *BatchFreezeProposal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BatchFreezeProposal)(x)
 }
 
-func (x *CreditTypeProposal) slowProtoReflect() protoreflect.Message {
-	mi := &file_regen_ecocredit_v1_types_proto_msgTypes[4]
+func (x *BatchFreezeProposal) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_v1_types_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2567,43 +4390,43 @@ func (x *CreditTypeProposal) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_CreditTypeProposal_messageType fastReflection_CreditTypeProposal_messageType
-var _ protoreflect.MessageType = fastReflection_CreditTypeProposal_messageType{}
+var _fastReflection_BatchFreezeProposal_messageType fastReflection_BatchFreezeProposal_messageType
+var _ protoreflect.MessageType = fastReflection_BatchFreezeProposal_messageType{}
 
-type fastReflection_CreditTypeProposal_messageType struct{}
+type fastReflection_BatchFreezeProposal_messageType struct{}
 
-func (x fastReflection_CreditTypeProposal_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_CreditTypeProposal)(nil)
+func (x fastReflection_BatchFreezeProposal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BatchFreezeProposal)(nil)
 }
-func (x fastReflection_CreditTypeProposal_messageType) New() protoreflect.Message {
-	return new(fastReflection_CreditTypeProposal)
+func (x fastReflection_BatchFreezeProposal_messageType) New() protoreflect.Message {
+	return new(fastReflection_BatchFreezeProposal)
 }
-func (x fastReflection_CreditTypeProposal_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_CreditTypeProposal
+func (x fastReflection_BatchFreezeProposal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BatchFreezeProposal
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_CreditTypeProposal) Descriptor() protoreflect.MessageDescriptor {
-	return md_CreditTypeProposal
+func (x *fastReflection_BatchFreezeProposal) Descriptor() protoreflect.MessageDescriptor {
+	return md_BatchFreezeProposal
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_CreditTypeProposal) Type() protoreflect.MessageType {
-	return _fastReflection_CreditTypeProposal_messageType
+func (x *fastReflection_BatchFreezeProposal) Type() protoreflect.MessageType {
+	return _fastReflection_BatchFreezeProposal_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_CreditTypeProposal) New() protoreflect.Message {
-	return new(fastReflection_CreditTypeProposal)
+func (x *fastReflection_BatchFreezeProposal) New() protoreflect.Message {
+	return new(fastReflection_BatchFreezeProposal)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_CreditTypeProposal) Interface() protoreflect.ProtoMessage {
-	return (*CreditTypeProposal)(x)
+func (x *fastReflection_BatchFreezeProposal) Interface() protoreflect.ProtoMessage {
+	return (*BatchFreezeProposal)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2611,22 +4434,40 @@ func (x *fastReflection_CreditTypeProposal) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_CreditTypeProposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_BatchFreezeProposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Title != "" {
 		value := protoreflect.ValueOfString(x.Title)
-		if !f(fd_CreditTypeProposal_title, value) {
+		if !f(fd_BatchFreezeProposal_title, value) {
 			return
 		}
 	}
 	if x.Description != "" {
 		value := protoreflect.ValueOfString(x.Description)
-		if !f(fd_CreditTypeProposal_description, value) {
+		if !f(fd_BatchFreezeProposal_description, value) {
 			return
 		}
 	}
-	if x.CreditType != nil {
-		value := protoreflect.ValueOfMessage(x.CreditType.ProtoReflect())
-		if !f(fd_CreditTypeProposal_credit_type, value) {
+	if x.BatchDenom != "" {
+		value := protoreflect.ValueOfString(x.BatchDenom)
+		if !f(fd_BatchFreezeProposal_batch_denom, value) {
+			return
+		}
+	}
+	if x.Freeze != false {
+		value := protoreflect.ValueOfBool(x.Freeze)
+		if !f(fd_BatchFreezeProposal_freeze, value) {
+			return
+		}
+	}
+	if x.Evidence != "" {
+		value := protoreflect.ValueOfString(x.Evidence)
+		if !f(fd_BatchFreezeProposal_evidence, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_BatchFreezeProposal_reason, value) {
 			return
 		}
 	}
@@ -2643,19 +4484,25 @@ func (x *fastReflection_CreditTypeProposal) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_CreditTypeProposal) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_BatchFreezeProposal) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.CreditTypeProposal.title":
+	case "regen.ecocredit.v1.BatchFreezeProposal.title":
 		return x.Title != ""
-	case "regen.ecocredit.v1.CreditTypeProposal.description":
+	case "regen.ecocredit.v1.BatchFreezeProposal.description":
 		return x.Description != ""
-	case "regen.ecocredit.v1.CreditTypeProposal.credit_type":
-		return x.CreditType != nil
+	case "regen.ecocredit.v1.BatchFreezeProposal.batch_denom":
+		return x.BatchDenom != ""
+	case "regen.ecocredit.v1.BatchFreezeProposal.freeze":
+		return x.Freeze != false
+	case "regen.ecocredit.v1.BatchFreezeProposal.evidence":
+		return x.Evidence != ""
+	case "regen.ecocredit.v1.BatchFreezeProposal.reason":
+		return x.Reason != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.CreditTypeProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.BatchFreezeProposal"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.CreditTypeProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.BatchFreezeProposal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2665,19 +4512,25 @@ func (x *fastReflection_CreditTypeProposal) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CreditTypeProposal) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_BatchFreezeProposal) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.CreditTypeProposal.title":
+	case "regen.ecocredit.v1.BatchFreezeProposal.title":
 		x.Title = ""
-	case "regen.ecocredit.v1.CreditTypeProposal.description":
+	case "regen.ecocredit.v1.BatchFreezeProposal.description":
 		x.Description = ""
-	case "regen.ecocredit.v1.CreditTypeProposal.credit_type":
-		x.CreditType = nil
+	case "regen.ecocredit.v1.BatchFreezeProposal.batch_denom":
+		x.BatchDenom = ""
+	case "regen.ecocredit.v1.BatchFreezeProposal.freeze":
+		x.Freeze = false
+	case "regen.ecocredit.v1.BatchFreezeProposal.evidence":
+		x.Evidence = ""
+	case "regen.ecocredit.v1.BatchFreezeProposal.reason":
+		x.Reason = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.CreditTypeProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.BatchFreezeProposal"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.CreditTypeProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.BatchFreezeProposal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2687,22 +4540,31 @@ func (x *fastReflection_CreditTypeProposal) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_CreditTypeProposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BatchFreezeProposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "regen.ecocredit.v1.CreditTypeProposal.title":
+	case "regen.ecocredit.v1.BatchFreezeProposal.title":
 		value := x.Title
 		return protoreflect.ValueOfString(value)
-	case "regen.ecocredit.v1.CreditTypeProposal.description":
+	case "regen.ecocredit.v1.BatchFreezeProposal.description":
 		value := x.Description
 		return protoreflect.ValueOfString(value)
-	case "regen.ecocredit.v1.CreditTypeProposal.credit_type":
-		value := x.CreditType
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.v1.BatchFreezeProposal.batch_denom":
+		value := x.BatchDenom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.BatchFreezeProposal.freeze":
+		value := x.Freeze
+		return protoreflect.ValueOfBool(value)
+	case "regen.ecocredit.v1.BatchFreezeProposal.evidence":
+		value := x.Evidence
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.BatchFreezeProposal.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.CreditTypeProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.BatchFreezeProposal"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.CreditTypeProposal does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.BatchFreezeProposal does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2716,19 +4578,25 @@ func (x *fastReflection_CreditTypeProposal) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CreditTypeProposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_BatchFreezeProposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.CreditTypeProposal.title":
+	case "regen.ecocredit.v1.BatchFreezeProposal.title":
 		x.Title = value.Interface().(string)
-	case "regen.ecocredit.v1.CreditTypeProposal.description":
+	case "regen.ecocredit.v1.BatchFreezeProposal.description":
 		x.Description = value.Interface().(string)
-	case "regen.ecocredit.v1.CreditTypeProposal.credit_type":
-		x.CreditType = value.Message().Interface().(*CreditType)
+	case "regen.ecocredit.v1.BatchFreezeProposal.batch_denom":
+		x.BatchDenom = value.Interface().(string)
+	case "regen.ecocredit.v1.BatchFreezeProposal.freeze":
+		x.Freeze = value.Bool()
+	case "regen.ecocredit.v1.BatchFreezeProposal.evidence":
+		x.Evidence = value.Interface().(string)
+	case "regen.ecocredit.v1.BatchFreezeProposal.reason":
+		x.Reason = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.CreditTypeProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.BatchFreezeProposal"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.CreditTypeProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.BatchFreezeProposal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2742,52 +4610,60 @@ func (x *fastReflection_CreditTypeProposal) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CreditTypeProposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BatchFreezeProposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.CreditTypeProposal.credit_type":
-		if x.CreditType == nil {
-			x.CreditType = new(CreditType)
-		}
-		return protoreflect.ValueOfMessage(x.CreditType.ProtoReflect())
-	case "regen.ecocredit.v1.CreditTypeProposal.title":
-		panic(fmt.Errorf("field title of message regen.ecocredit.v1.CreditTypeProposal is not mutable"))
-	case "regen.ecocredit.v1.CreditTypeProposal.description":
-		panic(fmt.Errorf("field description of message regen.ecocredit.v1.CreditTypeProposal is not mutable"))
+	case "regen.ecocredit.v1.BatchFreezeProposal.title":
+		panic(fmt.Errorf("field title of message regen.ecocredit.v1.BatchFreezeProposal is not mutable"))
+	case "regen.ecocredit.v1.BatchFreezeProposal.description":
+		panic(fmt.Errorf("field description of message regen.ecocredit.v1.BatchFreezeProposal is not mutable"))
+	case "regen.ecocredit.v1.BatchFreezeProposal.batch_denom":
+		panic(fmt.Errorf("field batch_denom of message regen.ecocredit.v1.BatchFreezeProposal is not mutable"))
+	case "regen.ecocredit.v1.BatchFreezeProposal.freeze":
+		panic(fmt.Errorf("field freeze of message regen.ecocredit.v1.BatchFreezeProposal is not mutable"))
+	case "regen.ecocredit.v1.BatchFreezeProposal.evidence":
+		panic(fmt.Errorf("field evidence of message regen.ecocredit.v1.BatchFreezeProposal is not mutable"))
+	case "regen.ecocredit.v1.BatchFreezeProposal.reason":
+		panic(fmt.Errorf("field reason of message regen.ecocredit.v1.BatchFreezeProposal is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.CreditTypeProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.BatchFreezeProposal"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.CreditTypeProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.BatchFreezeProposal does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_CreditTypeProposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BatchFreezeProposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.CreditTypeProposal.title":
+	case "regen.ecocredit.v1.BatchFreezeProposal.title":
 		return protoreflect.ValueOfString("")
-	case "regen.ecocredit.v1.CreditTypeProposal.description":
+	case "regen.ecocredit.v1.BatchFreezeProposal.description":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.BatchFreezeProposal.batch_denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.BatchFreezeProposal.freeze":
+		return protoreflect.ValueOfBool(false)
+	case "regen.ecocredit.v1.BatchFreezeProposal.evidence":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.BatchFreezeProposal.reason":
 		return protoreflect.ValueOfString("")
-	case "regen.ecocredit.v1.CreditTypeProposal.credit_type":
-		m := new(CreditType)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.CreditTypeProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.BatchFreezeProposal"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.CreditTypeProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.BatchFreezeProposal does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_CreditTypeProposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_BatchFreezeProposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.CreditTypeProposal", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.BatchFreezeProposal", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2795,7 +4671,7 @@ func (x *fastReflection_CreditTypeProposal) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_CreditTypeProposal) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_BatchFreezeProposal) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2806,7 +4682,7 @@ func (x *fastReflection_CreditTypeProposal) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CreditTypeProposal) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_BatchFreezeProposal) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2818,7 +4694,7 @@ func (x *fastReflection_CreditTypeProposal) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_CreditTypeProposal) IsValid() bool {
+func (x *fastReflection_BatchFreezeProposal) IsValid() bool {
 	return x != nil
 }
 
@@ -2828,9 +4704,9 @@ func (x *fastReflection_CreditTypeProposal) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_CreditTypeProposal) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_BatchFreezeProposal) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*CreditTypeProposal)
+		x := input.Message.Interface().(*BatchFreezeProposal)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2850,8 +4726,19 @@ func (x *fastReflection_CreditTypeProposal) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.CreditType != nil {
-			l = options.Size(x.CreditType)
+		l = len(x.BatchDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Freeze {
+			n += 2
+		}
+		l = len(x.Evidence)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reason)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -2864,7 +4751,7 @@ func (x *fastReflection_CreditTypeProposal) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*CreditTypeProposal)
+		x := input.Message.Interface().(*BatchFreezeProposal)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2883,17 +4770,34 @@ func (x *fastReflection_CreditTypeProposal) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.CreditType != nil {
-			encoded, err := options.Marshal(x.CreditType)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Evidence) > 0 {
+			i -= len(x.Evidence)
+			copy(dAtA[i:], x.Evidence)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Evidence)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Freeze {
+			i--
+			if x.Freeze {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.BatchDenom) > 0 {
+			i -= len(x.BatchDenom)
+			copy(dAtA[i:], x.BatchDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BatchDenom)))
 			i--
 			dAtA[i] = 0x1a
 		}
@@ -2922,7 +4826,7 @@ func (x *fastReflection_CreditTypeProposal) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*CreditTypeProposal)
+		x := input.Message.Interface().(*BatchFreezeProposal)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2941,28 +4845,92 @@ func (x *fastReflection_CreditTypeProposal) ProtoMethods() *protoiface.Methods {
 				if shift >= 64 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BatchFreezeProposal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BatchFreezeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Title = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CreditTypeProposal: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CreditTypeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Description = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2990,11 +4958,31 @@ func (x *fastReflection_CreditTypeProposal) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Title = string(dAtA[iNdEx:postIndex])
+				x.BatchDenom = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Freeze", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Freeze = bool(v != 0)
+			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3022,13 +5010,13 @@ func (x *fastReflection_CreditTypeProposal) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Description = string(dAtA[iNdEx:postIndex])
+				x.Evidence = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
+			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreditType", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3038,27 +5026,23 @@ func (x *fastReflection_CreditTypeProposal) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.CreditType == nil {
-					x.CreditType = &CreditType{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CreditType); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -3253,6 +5237,14 @@ type BatchIssuance struct {
 	// sub-national-code and postal-code are optional and can be added for
 	// increased precision.
 	RetirementJurisdiction string `protobuf:"bytes,4,opt,name=retirement_jurisdiction,json=retirementJurisdiction,proto3" json:"retirement_jurisdiction,omitempty"`
+	// locked_amount is the amount of credits that the recipient will receive in
+	// a locked state. Locked credits are released into the tradable balance of
+	// the recipient according to the lock schedule. The number of decimal places
+	// must be less than or equal to the credit type precision.
+	LockedAmount string `protobuf:"bytes,5,opt,name=locked_amount,json=lockedAmount,proto3" json:"locked_amount,omitempty"`
+	// lock_schedule is the schedule by which locked credits are released and is
+	// only required if locked_amount is positive.
+	LockSchedule *LockSchedule `protobuf:"bytes,6,opt,name=lock_schedule,json=lockSchedule,proto3" json:"lock_schedule,omitempty"`
 }
 
 func (x *BatchIssuance) Reset() {
@@ -3303,6 +5295,70 @@ func (x *BatchIssuance) GetRetirementJurisdiction() string {
 	return ""
 }
 
+func (x *BatchIssuance) GetLockedAmount() string {
+	if x != nil {
+		return x.LockedAmount
+	}
+	return ""
+}
+
+func (x *BatchIssuance) GetLockSchedule() *LockSchedule {
+	if x != nil {
+		return x.LockSchedule
+	}
+	return nil
+}
+
+// LockSchedule defines when locked credits are released into the tradable
+// balance. Credits are either released all at once at the start time (cliff)
+// or linearly between the start time and the end time.
+type LockSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// start_time is the time at which the credits begin to unlock. If end_time is
+	// not set, all credits are released at start_time.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time is the optional time at which all credits are released. If set,
+	// credits are released linearly between start_time and end_time.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *LockSchedule) Reset() {
+	*x = LockSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_types_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LockSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockSchedule) ProtoMessage() {}
+
+// Deprecated: Use LockSchedule.ProtoReflect.Descriptor instead.
+func (*LockSchedule) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_types_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LockSchedule) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *LockSchedule) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
 // OriginTx is the transaction from another chain or registry that triggered
 // the minting of credits.
 type OriginTx struct {
@@ -3330,7 +5386,7 @@ type OriginTx struct {
 func (x *OriginTx) Reset() {
 	*x = OriginTx{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_v1_types_proto_msgTypes[3]
+		mi := &file_regen_ecocredit_v1_types_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3344,7 +5400,7 @@ func (*OriginTx) ProtoMessage() {}
 
 // Deprecated: Use OriginTx.ProtoReflect.Descriptor instead.
 func (*OriginTx) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_v1_types_proto_rawDescGZIP(), []int{3}
+	return file_regen_ecocredit_v1_types_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *OriginTx) GetId() string {
@@ -3393,7 +5449,7 @@ type CreditTypeProposal struct {
 func (x *CreditTypeProposal) Reset() {
 	*x = CreditTypeProposal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_v1_types_proto_msgTypes[4]
+		mi := &file_regen_ecocredit_v1_types_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3407,7 +5463,7 @@ func (*CreditTypeProposal) ProtoMessage() {}
 
 // Deprecated: Use CreditTypeProposal.ProtoReflect.Descriptor instead.
 func (*CreditTypeProposal) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_v1_types_proto_rawDescGZIP(), []int{4}
+	return file_regen_ecocredit_v1_types_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreditTypeProposal) GetTitle() string {
@@ -3431,6 +5487,158 @@ func (x *CreditTypeProposal) GetCreditType() *CreditType {
 	return nil
 }
 
+// CreditTypePrecisionProposal is a gov Content type for raising the precision
+// of an existing credit type.
+type CreditTypePrecisionProposal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// title is the title of the proposal.
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// description is the description of the proposal.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// credit_type_abbrev is the abbreviation of the credit type.
+	CreditTypeAbbrev string `protobuf:"bytes,3,opt,name=credit_type_abbrev,json=creditTypeAbbrev,proto3" json:"credit_type_abbrev,omitempty"`
+	// precision is the new precision of the credit type. The precision must be
+	// greater than the current precision of the credit type and must be one of
+	// the exponents supported by baskets (0, 1, 2, 3, 6, 9, 12, 15 or 18).
+	Precision uint32 `protobuf:"varint,4,opt,name=precision,proto3" json:"precision,omitempty"`
+}
+
+func (x *CreditTypePrecisionProposal) Reset() {
+	*x = CreditTypePrecisionProposal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_types_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreditTypePrecisionProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditTypePrecisionProposal) ProtoMessage() {}
+
+// Deprecated: Use CreditTypePrecisionProposal.ProtoReflect.Descriptor instead.
+func (*CreditTypePrecisionProposal) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_types_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreditTypePrecisionProposal) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreditTypePrecisionProposal) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreditTypePrecisionProposal) GetCreditTypeAbbrev() string {
+	if x != nil {
+		return x.CreditTypeAbbrev
+	}
+	return ""
+}
+
+func (x *CreditTypePrecisionProposal) GetPrecision() uint32 {
+	if x != nil {
+		return x.Precision
+	}
+	return 0
+}
+
+// BatchFreezeProposal is a gov Content type for freezing or unfreezing a credit
+// batch.
+type BatchFreezeProposal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// title is the title of the proposal.
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// description is the description of the proposal.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// batch_denom is the unique identifier of the credit batch.
+	BatchDenom string `protobuf:"bytes,3,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// freeze determines whether the credit batch is frozen (true) or unfrozen
+	// (false) if the proposal passes.
+	Freeze bool `protobuf:"varint,4,opt,name=freeze,proto3" json:"freeze,omitempty"`
+	// evidence is the IRI of the evidence supporting the proposal.
+	Evidence string `protobuf:"bytes,5,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	// reason is any arbitrary string that specifies the reason for freezing or
+	// unfreezing the credit batch.
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *BatchFreezeProposal) Reset() {
+	*x = BatchFreezeProposal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_types_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchFreezeProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchFreezeProposal) ProtoMessage() {}
+
+// Deprecated: Use BatchFreezeProposal.ProtoReflect.Descriptor instead.
+func (*BatchFreezeProposal) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_types_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BatchFreezeProposal) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *BatchFreezeProposal) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *BatchFreezeProposal) GetBatchDenom() string {
+	if x != nil {
+		return x.BatchDenom
+	}
+	return ""
+}
+
+func (x *BatchFreezeProposal) GetFreeze() bool {
+	if x != nil {
+		return x.Freeze
+	}
+	return false
+}
+
+func (x *BatchFreezeProposal) GetEvidence() string {
+	if x != nil {
+		return x.Evidence
+	}
+	return ""
+}
+
+func (x *BatchFreezeProposal) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 var File_regen_ecocredit_v1_types_proto protoreflect.FileDescriptor
 
 var file_regen_ecocredit_v1_types_proto_rawDesc = []byte{
@@ -3438,78 +5646,118 @@ var file_regen_ecocredit_v1_types_proto_rawDesc = []byte{
 	0x74, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x12, 0x12, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69,
 	0x74, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
-	0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x72, 0x65, 0x67, 0x65,
-	0x6e, 0x2f, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xce, 0x02, 0x0a, 0x06, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x75, 0x0a, 0x10, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f,
-	0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00,
-	0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
-	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0e, 0x63, 0x72,
-	0x65, 0x64, 0x69, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x46, 0x65, 0x65, 0x12, 0x6a, 0x0a, 0x0a,
-	0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x72, 0x65, 0x67,
+	0x65, 0x6e, 0x2f, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xce, 0x02, 0x0a, 0x06,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x75, 0x0a, 0x10, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
 	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
 	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f,
 	0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
-	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x09, 0x62,
-	0x61, 0x73, 0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x6c, 0x6c, 0x6f,
-	0x77, 0x65, 0x64, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
-	0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
-	0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x2b,
-	0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
-	0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x42, 0x0a, 0x07, 0x43,
-	0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f,
-	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x74,
-	0x63, 0x68, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22,
-	0xb6, 0x01, 0x0a, 0x0d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12,
-	0x27, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x64, 0x61, 0x62,
-	0x6c, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x74, 0x69,
-	0x72, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x37, 0x0a, 0x17, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6a, 0x75,
-	0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x16, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4a, 0x75, 0x72, 0x69,
-	0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x62, 0x0a, 0x08, 0x4f, 0x72, 0x69, 0x67,
-	0x69, 0x6e, 0x54, 0x78, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x93, 0x01, 0x0a,
-	0x12, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0b, 0x63,
-	0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1e, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64,
-	0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x3a, 0x04, 0x98, 0xa0,
-	0x1f, 0x00, 0x42, 0xd8, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e,
-	0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x54,
-	0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x48, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6c, 0x65, 0x64, 0x67,
-	0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x63, 0x6f,
-	0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65,
-	0x64, 0x69, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x52, 0x45, 0x58, 0xaa, 0x02, 0x12, 0x52, 0x65,
-	0x67, 0x65, 0x6e, 0x2e, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x56, 0x31,
-	0xca, 0x02, 0x12, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64,
-	0x69, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45, 0x63,
-	0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x3a, 0x3a,
-	0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0e, 0x63,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x46, 0x65, 0x65, 0x12, 0x6a, 0x0a,
+	0x0a, 0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde,
+	0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x09,
+	0x62, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12,
+	0x2b, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x42, 0x0a, 0x07,
+	0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0xa2, 0x02, 0x0a, 0x0d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x12, 0x27, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x64, 0x61,
+	0x62, 0x6c, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x74,
+	0x69, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x37, 0x0a, 0x17, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6a,
+	0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x16, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4a, 0x75, 0x72,
+	0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x45,
+	0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63,
+	0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x8c, 0x01, 0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x6b, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x3f, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x07, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0x62, 0x0a, 0x08, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x54, 0x78,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72,
+	0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x63, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x3a, 0x04, 0x98, 0xa0, 0x1f, 0x00, 0x22, 0xa7,
+	0x01, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x50, 0x72, 0x65,
+	0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x61, 0x62, 0x62, 0x72, 0x65, 0x76, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x41, 0x62,
+	0x62, 0x72, 0x65, 0x76, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x3a, 0x04, 0x98, 0xa0, 0x1f, 0x00, 0x22, 0xc0, 0x01, 0x0a, 0x13, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x72, 0x65,
+	0x65, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x72, 0x65, 0x65, 0x7a,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x3a, 0x04, 0x98, 0xa0, 0x1f, 0x00, 0x42, 0xd8, 0x01, 0x0a, 0x16,
+	0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x48, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x72,
+	0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2f,
+	0x76, 0x31, 0x3b, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x76, 0x31, 0xa2, 0x02,
+	0x03, 0x52, 0x45, 0x58, 0xaa, 0x02, 0x12, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x45, 0x63, 0x6f,
+	0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x12, 0x52, 0x65, 0x67, 0x65,
+	0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x1e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x14, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x3a, 0x3a, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3524,25 +5772,32 @@ func file_regen_ecocredit_v1_types_proto_rawDescGZIP() []byte {
 	return file_regen_ecocredit_v1_types_proto_rawDescData
 }
 
-var file_regen_ecocredit_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_regen_ecocredit_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_regen_ecocredit_v1_types_proto_goTypes = []interface{}{
-	(*Params)(nil),             // 0: regen.ecocredit.v1.Params
-	(*Credits)(nil),            // 1: regen.ecocredit.v1.Credits
-	(*BatchIssuance)(nil),      // 2: regen.ecocredit.v1.BatchIssuance
-	(*OriginTx)(nil),           // 3: regen.ecocredit.v1.OriginTx
-	(*CreditTypeProposal)(nil), // 4: regen.ecocredit.v1.CreditTypeProposal
-	(*v1beta1.Coin)(nil),       // 5: cosmos.base.v1beta1.Coin
-	(*CreditType)(nil),         // 6: regen.ecocredit.v1.CreditType
+	(*Params)(nil),                      // 0: regen.ecocredit.v1.Params
+	(*Credits)(nil),                     // 1: regen.ecocredit.v1.Credits
+	(*BatchIssuance)(nil),               // 2: regen.ecocredit.v1.BatchIssuance
+	(*LockSchedule)(nil),                // 3: regen.ecocredit.v1.LockSchedule
+	(*OriginTx)(nil),                    // 4: regen.ecocredit.v1.OriginTx
+	(*CreditTypeProposal)(nil),          // 5: regen.ecocredit.v1.CreditTypeProposal
+	(*CreditTypePrecisionProposal)(nil), // 6: regen.ecocredit.v1.CreditTypePrecisionProposal
+	(*BatchFreezeProposal)(nil),         // 7: regen.ecocredit.v1.BatchFreezeProposal
+	(*v1beta1.Coin)(nil),                // 8: cosmos.base.v1beta1.Coin
+	(*timestamppb.Timestamp)(nil),       // 9: google.protobuf.Timestamp
+	(*CreditType)(nil),                  // 10: regen.ecocredit.v1.CreditType
 }
 var file_regen_ecocredit_v1_types_proto_depIdxs = []int32{
-	5, // 0: regen.ecocredit.v1.Params.credit_class_fee:type_name -> cosmos.base.v1beta1.Coin
-	5, // 1: regen.ecocredit.v1.Params.basket_fee:type_name -> cosmos.base.v1beta1.Coin
-	6, // 2: regen.ecocredit.v1.CreditTypeProposal.credit_type:type_name -> regen.ecocredit.v1.CreditType
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	8,  // 0: regen.ecocredit.v1.Params.credit_class_fee:type_name -> cosmos.base.v1beta1.Coin
+	8,  // 1: regen.ecocredit.v1.Params.basket_fee:type_name -> cosmos.base.v1beta1.Coin
+	3,  // 2: regen.ecocredit.v1.BatchIssuance.lock_schedule:type_name -> regen.ecocredit.v1.LockSchedule
+	9,  // 3: regen.ecocredit.v1.LockSchedule.start_time:type_name -> google.protobuf.Timestamp
+	9,  // 4: regen.ecocredit.v1.LockSchedule.end_time:type_name -> google.protobuf.Timestamp
+	10, // 5: regen.ecocredit.v1.CreditTypeProposal.credit_type:type_name -> regen.ecocredit.v1.CreditType
+	6,  // [6:6] is the sub-list for method output_type
+	6,  // [6:6] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_regen_ecocredit_v1_types_proto_init() }
@@ -3589,7 +5844,7 @@ func file_regen_ecocredit_v1_types_proto_init() {
 			}
 		}
 		file_regen_ecocredit_v1_types_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OriginTx); i {
+			switch v := v.(*LockSchedule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3601,6 +5856,18 @@ func file_regen_ecocredit_v1_types_proto_init() {
 			}
 		}
 		file_regen_ecocredit_v1_types_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OriginTx); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_v1_types_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreditTypeProposal); i {
 			case 0:
 				return &v.state
@@ -3612,6 +5879,30 @@ func file_regen_ecocredit_v1_types_proto_init() {
 				return nil
 			}
 		}
+		file_regen_ecocredit_v1_types_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreditTypePrecisionProposal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_v1_types_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchFreezeProposal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -3619,7 +5910,7 @@ func file_regen_ecocredit_v1_types_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_regen_ecocredit_v1_types_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -94,7 +94,9 @@ import (
 	regentypes "github.com/regen-network/regen-ledger/types"
 	moduletypes "github.com/regen-network/regen-ledger/types/module"
 	"github.com/regen-network/regen-ledger/types/module/server"
+	datatypes "github.com/regen-network/regen-ledger/x/data"
 	data "github.com/regen-network/regen-ledger/x/data/module"
+	dataserver "github.com/regen-network/regen-ledger/x/data/server"
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 	"github.com/regen-network/regen-ledger/x/ecocredit/basket"
 	ecocreditmodule "github.com/regen-network/regen-ledger/x/ecocredit/module"
@@ -379,14 +381,24 @@ func NewRegenApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 
 	// register custom modules here
 	app.smm = setCustomModules(app, interfaceRegistry)
+
+	// the data module is registered first so that the data keeper can be built
+	// from the store key of the data module and passed to the ecocredit module
+	dataModule := data.NewModule(app.AccountKeeper, app.BankKeeper)
+	err := app.smm.RegisterModules([]moduletypes.Module{dataModule})
+	if err != nil {
+		panic(err)
+	}
+	dataKeeper := dataserver.NewKeeper(app.smm.GetModuleKey(datatypes.ModuleName), app.AccountKeeper, app.BankKeeper)
+
 	ecocreditModule := ecocreditmodule.NewModule(
 		app.GetSubspace(ecocredit.DefaultParamspace),
 		app.AccountKeeper,
 		app.BankKeeper,
+		app.DistrKeeper,
+		dataKeeper,
 	)
-	dataModule := data.NewModule(app.AccountKeeper, app.BankKeeper)
-	newModules := []moduletypes.Module{ecocreditModule, dataModule}
-	err := app.smm.RegisterModules(newModules)
+	err = app.smm.RegisterModules([]moduletypes.Module{ecocreditModule})
 	if err != nil {
 		panic(err)
 	}
@@ -540,8 +552,8 @@ func NewRegenApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 			// authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry), // enable after updating sdk version v0.46
 			ibc.NewAppModule(app.IBCKeeper),
 			transferModule,
-			ecocreditmodule.NewModule(app.GetSubspace(ecocredit.DefaultParamspace), app.AccountKeeper, app.BankKeeper),
-			data.NewModule(app.AccountKeeper, app.BankKeeper),
+			ecocreditmodule.NewModule(app.GetSubspace(ecocredit.DefaultParamspace), app.AccountKeeper, app.BankKeeper, app.DistrKeeper, dataKeeper),
+			dataModule,
 		}, app.setCustomSimulationManager()...)...,
 	)
 
@@ -40,7 +40,10 @@ func setCustomModuleBasics() []module.AppModuleBasic {
 				wasmclient.ProposalHandlers,
 				paramsclient.ProposalHandler, distrclient.ProposalHandler,
 				upgradeclient.ProposalHandler, upgradeclient.CancelProposalHandler,
-				core.CreditTypeProposalHandler, marketplace.AllowDenomProposalHandler,
+				core.CreditTypeProposalHandler, core.BatchFreezeProposalHandler,
+				core.CreditTypePrecisionProposalHandler, marketplace.AllowDenomProposalHandler,
+				marketplace.FeeScheduleProposalHandler, marketplace.FeeDestinationProposalHandler,
+				marketplace.MarketRegistrationProposalHandler, marketplace.DelistMarketProposalHandler,
 			)...,
 		),
 		wasm.AppModuleBasic{},
@@ -47,7 +47,10 @@ func setCustomModuleBasics() []module.AppModuleBasic {
 		gov.NewAppModuleBasic(
 			paramsclient.ProposalHandler, distrclient.ProposalHandler,
 			upgradeclient.ProposalHandler, upgradeclient.CancelProposalHandler,
-			ecocreditcore.CreditTypeProposalHandler, marketplace.AllowDenomProposalHandler,
+			ecocreditcore.CreditTypeProposalHandler, ecocreditcore.BatchFreezeProposalHandler,
+			ecocreditcore.CreditTypePrecisionProposalHandler, marketplace.AllowDenomProposalHandler,
+			marketplace.FeeScheduleProposalHandler, marketplace.FeeDestinationProposalHandler,
+			marketplace.MarketRegistrationProposalHandler, marketplace.DelistMarketProposalHandler,
 		),
 	}
 }
@@ -75,6 +78,7 @@ func setCustomOrderEndBlocker() []string {
 
 func (app *RegenApp) registerUpgradeHandlers() {
 	const upgradeName = "v4.0.0"
+	const v5UpgradeName = "v5.0.0"
 	const mainnet = "regen-1"
 	const redwood = "regen-redwood-1"
 
@@ -88,7 +92,7 @@ func (app *RegenApp) registerUpgradeHandlers() {
 		}
 
 		// run state migrations for x/ecocredit module
-		if err := app.smm.RunMigrations(ctx, app.AppCodec()); err != nil {
+		if err := app.smm.RunMigrations(ctx, app.AppCodec(), fromVM); err != nil {
 			return nil, err
 		}
 		toVersion[ecocredit.ModuleName] = ecocreditmodule.Module{}.ConsensusVersion()
@@ -125,6 +129,23 @@ func (app *RegenApp) registerUpgradeHandlers() {
 		return toVersion, nil
 	})
 
+	app.UpgradeKeeper.SetUpgradeHandler(v5UpgradeName, func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		// run state migrations for sdk modules
+		toVersion, err := app.mm.RunMigrations(ctx, app.configurator, fromVM)
+		if err != nil {
+			return nil, err
+		}
+
+		// run state migrations for x/ecocredit module (only the migrations that
+		// have not been applied at the consensus version in fromVM are run)
+		if err := app.smm.RunMigrations(ctx, app.AppCodec(), fromVM); err != nil {
+			return nil, err
+		}
+		toVersion[ecocredit.ModuleName] = ecocreditmodule.Module{}.ConsensusVersion()
+
+		return toVersion, nil
+	})
+
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
 	if err != nil {
 		panic(err)
@@ -69,6 +69,14 @@ func (mm *Manager) GetWeightedOperationsHandlers() []WeightedOperationsHandler {
 	return mm.weightedOperationsHandlers
 }
 
+// GetModuleKey returns the root module key of the registered module with the given name, which is
+// also the key of the store mounted for the module. Nil is returned if no module with the given
+// name has been registered.
+func (mm *Manager) GetModuleKey(name string) RootModuleKey {
+	key, _ := mm.keys[name].(RootModuleKey)
+	return key
+}
+
 // RegisterModules registers modules with the Manager and registers their services.
 func (mm *Manager) RegisterModules(modules []module.Module) error {
 	// First we register all interface types. This is done for all modules first before registering
@@ -206,8 +214,9 @@ func (mm *Manager) InitGenesis(ctx sdk.Context, genesisData map[string]json.RawM
 	return res
 }
 
-// RunMigrations performs state migrations for registered modules.
-func (mm *Manager) RunMigrations(ctx sdk.Context, cdc codec.Codec) error {
+// RunMigrations performs state migrations for registered modules. The
+// consensus version of each module prior to the upgrade is read from fromVM.
+func (mm *Manager) RunMigrations(ctx sdk.Context, cdc codec.Codec, fromVM sdkmodule.VersionMap) error {
 	// sorting migration handlers map to prevent non-determinism
 	keys := make([]string, 0, len(mm.migrationHandlers))
 	for k := range mm.migrationHandlers {
@@ -221,7 +230,7 @@ func (mm *Manager) RunMigrations(ctx sdk.Context, cdc codec.Codec) error {
 			continue
 		}
 
-		if err := h(ctx, cdc); err != nil {
+		if err := h(ctx, cdc, fromVM[k]); err != nil {
 			return err
 		}
 	}
@@ -309,7 +318,10 @@ func exportGenesis(ctx sdk.Context, cdc codec.Codec, exportGenesisHandlers map[s
 }
 
 type RegisterInvariantsHandler func(ir sdk.InvariantRegistry)
-type MigrationHandler func(ctx sdk.Context, cdc codec.Codec) error
+// MigrationHandler runs the state migrations of a module from the consensus
+// version of the module prior to the upgrade (zero if the module did not
+// report a consensus version) to the current consensus version of the module.
+type MigrationHandler func(ctx sdk.Context, cdc codec.Codec, fromVersion uint64) error
 
 type configurator struct {
 	sdkmodule.Configurator
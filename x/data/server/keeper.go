@@ -0,0 +1,38 @@
+package server
+
+import (
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/x/data"
+)
+
+// Keeper defines the methods of the data module available to other modules.
+type Keeper interface {
+	// HasAttestation returns whether the attestor has attested to the data
+	// with the given IRI.
+	HasAttestation(ctx sdk.Context, iri string, attestor sdk.AccAddress) (bool, error)
+}
+
+var _ Keeper = serverImpl{}
+
+// NewKeeper returns a new data module keeper reading from the store of the
+// given store key. The store key must be the key under which the data module
+// store is mounted.
+func NewKeeper(storeKey sdk.StoreKey, ak data.AccountKeeper, bk data.BankKeeper) Keeper {
+	return newServer(storeKey, ak, bk)
+}
+
+// HasAttestation returns whether the attestor has attested to the data with
+// the given IRI. If the data has not been anchored, false is returned.
+func (s serverImpl) HasAttestation(ctx sdk.Context, iri string, attestor sdk.AccAddress) (bool, error) {
+	dataId, err := s.stateStore.DataIDTable().GetByIri(sdk.WrapSDKContext(ctx), iri)
+	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return s.stateStore.DataAttestorTable().Has(sdk.WrapSDKContext(ctx), dataId.Id, attestor)
+}
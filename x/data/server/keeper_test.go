@@ -0,0 +1,50 @@
+package server
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/regen-network/regen-ledger/api/regen/data/v1"
+	"github.com/regen-network/regen-ledger/x/data"
+)
+
+func TestKeeper_HasAttestation(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	id := []byte{0}
+	ch := &data.ContentHash{Graph: &data.ContentHash_Graph{
+		Hash:                      bytes.Repeat([]byte{0}, 32),
+		DigestAlgorithm:           data.DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+		CanonicalizationAlgorithm: data.GraphCanonicalizationAlgorithm_GRAPH_CANONICALIZATION_ALGORITHM_URDNA2015,
+	}}
+	iri, err := ch.ToIRI()
+	require.NoError(t, err)
+
+	// data that has not been anchored
+	found, err := s.server.HasAttestation(s.sdkCtx, iri, s.addrs[0])
+	require.NoError(t, err)
+	require.False(t, found)
+
+	err = s.server.stateStore.DataIDTable().Insert(s.ctx, &api.DataID{Id: id, Iri: iri})
+	require.NoError(t, err)
+
+	err = s.server.stateStore.DataAttestorTable().Insert(s.ctx, &api.DataAttestor{
+		Id:        id,
+		Attestor:  s.addrs[0],
+		Timestamp: timestamppb.New(time.Now().UTC()),
+	})
+	require.NoError(t, err)
+
+	found, err = s.server.HasAttestation(s.sdkCtx, iri, s.addrs[0])
+	require.NoError(t, err)
+	require.True(t, found)
+
+	found, err = s.server.HasAttestation(s.sdkCtx, iri, s.addrs[1])
+	require.NoError(t, err)
+	require.False(t, found)
+}
@@ -0,0 +1,101 @@
+package basket
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// ToApi converts to pulsar based data structure
+func (a *AdmissionCriteria) ToApi() *api.AdmissionCriteria {
+	if a == nil {
+		return nil
+	}
+	res := &api.AdmissionCriteria{
+		AllowedProjectIds:           a.AllowedProjectIds,
+		DeniedProjectIds:            a.DeniedProjectIds,
+		AllowedJurisdictionPrefixes: a.AllowedJurisdictionPrefixes,
+		DeniedJurisdictionPrefixes:  a.DeniedJurisdictionPrefixes,
+		Attestor:                    a.Attestor,
+	}
+	if a.MaxBatchAge != nil {
+		res.MaxBatchAge = types.GogoToProtobufDuration(a.MaxBatchAge)
+	}
+	return res
+}
+
+// Validate validates AdmissionCriteria, ensuring at most one of allowed and
+// denied project ids is set, project ids are valid and not duplicated,
+// jurisdiction prefixes are not empty or duplicated, max batch age is at
+// least one day, and attestor is a valid address
+func (a *AdmissionCriteria) Validate() error {
+	if a == nil {
+		return nil
+	}
+
+	if len(a.AllowedProjectIds) > 0 && len(a.DeniedProjectIds) > 0 {
+		return fmt.Errorf("only one of allowed_project_ids or denied_project_ids must be set")
+	}
+
+	if err := validateProjectIds("allowed_project_ids", a.AllowedProjectIds); err != nil {
+		return err
+	}
+
+	if err := validateProjectIds("denied_project_ids", a.DeniedProjectIds); err != nil {
+		return err
+	}
+
+	if err := validateJurisdictionPrefixes("allowed_jurisdiction_prefixes", a.AllowedJurisdictionPrefixes); err != nil {
+		return err
+	}
+
+	if err := validateJurisdictionPrefixes("denied_jurisdiction_prefixes", a.DeniedJurisdictionPrefixes); err != nil {
+		return err
+	}
+
+	if a.MaxBatchAge != nil {
+		if a.MaxBatchAge.Seconds < 24*3600 {
+			return fmt.Errorf("max_batch_age must be at least 1 day")
+		}
+	}
+
+	if a.Attestor != "" {
+		if _, err := sdk.AccAddressFromBech32(a.Attestor); err != nil {
+			return fmt.Errorf("attestor: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func validateProjectIds(field string, projectIds []string) error {
+	seen := make(map[string]bool)
+	for i, projectId := range projectIds {
+		if err := core.ValidateProjectId(projectId); err != nil {
+			return fmt.Errorf("%s[%d]: %s", field, i, err)
+		}
+		if seen[projectId] {
+			return fmt.Errorf("%s[%d]: duplicate project id %s", field, i, projectId)
+		}
+		seen[projectId] = true
+	}
+	return nil
+}
+
+func validateJurisdictionPrefixes(field string, prefixes []string) error {
+	seen := make(map[string]bool)
+	for i, prefix := range prefixes {
+		if prefix == "" {
+			return fmt.Errorf("%s[%d]: jurisdiction prefix cannot be empty", field, i)
+		}
+		if seen[prefix] {
+			return fmt.Errorf("%s[%d]: duplicate jurisdiction prefix %s", field, i, prefix)
+		}
+		seen[prefix] = true
+	}
+	return nil
+}
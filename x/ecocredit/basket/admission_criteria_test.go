@@ -0,0 +1,112 @@
+package basket
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdmissionCriteriaToApi(t *testing.T) {
+	t.Parallel()
+
+	require := require.New(t)
+
+	var ac *AdmissionCriteria
+	require.Nil(ac.ToApi(), "handles nil")
+
+	ac = &AdmissionCriteria{
+		AllowedProjectIds:           []string{"C01-001"},
+		AllowedJurisdictionPrefixes: []string{"US-"},
+		DeniedJurisdictionPrefixes:  []string{"US-WA"},
+		Attestor:                    "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+	}
+	res := ac.ToApi()
+	require.Equal(ac.AllowedProjectIds, res.AllowedProjectIds)
+	require.Equal(ac.AllowedJurisdictionPrefixes, res.AllowedJurisdictionPrefixes)
+	require.Equal(ac.DeniedJurisdictionPrefixes, res.DeniedJurisdictionPrefixes)
+	require.Equal(ac.Attestor, res.Attestor)
+	require.Nil(res.MaxBatchAge, "handles nil max batch age")
+
+	dur := &types.Duration{Seconds: 3600 * 24 * 365}
+	ac = &AdmissionCriteria{DeniedProjectIds: []string{"C01-002"}, MaxBatchAge: dur}
+	durStd, err := types.DurationFromProto(dur)
+	require.NoError(err)
+	res = ac.ToApi()
+	require.Equal(ac.DeniedProjectIds, res.DeniedProjectIds)
+	require.Equal(durStd, res.MaxBatchAge.AsDuration(), "handles max batch age")
+}
+
+func TestValidateAdmissionCriteria(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		id  string
+		a   AdmissionCriteria
+		err string
+	}{
+		{
+			"both-allowed-denied-project_ids-set",
+			AdmissionCriteria{
+				AllowedProjectIds: []string{"C01-001"},
+				DeniedProjectIds:  []string{"C01-002"},
+			},
+			"only one of allowed_project_ids or denied_project_ids must be set",
+		},
+		{
+			"bad-allowed_project_ids",
+			AdmissionCriteria{AllowedProjectIds: []string{"foo"}},
+			"allowed_project_ids[0]: invalid project id: foo",
+		},
+		{
+			"duplicate-denied_project_ids",
+			AdmissionCriteria{DeniedProjectIds: []string{"C01-001", "C01-001"}},
+			"denied_project_ids[1]: duplicate project id C01-001",
+		},
+		{
+			"empty-allowed_jurisdiction_prefixes",
+			AdmissionCriteria{AllowedJurisdictionPrefixes: []string{""}},
+			"allowed_jurisdiction_prefixes[0]: jurisdiction prefix cannot be empty",
+		},
+		{
+			"duplicate-denied_jurisdiction_prefixes",
+			AdmissionCriteria{DeniedJurisdictionPrefixes: []string{"KE", "KE"}},
+			"denied_jurisdiction_prefixes[1]: duplicate jurisdiction prefix KE",
+		},
+		{
+			"bad-max_batch_age",
+			AdmissionCriteria{MaxBatchAge: &types.Duration{Seconds: 3600}},
+			"max_batch_age must be at least 1 day",
+		},
+		{
+			"bad-attestor",
+			AdmissionCriteria{Attestor: "foo"},
+			"attestor: decoding bech32 failed",
+		},
+		{
+			"good-empty",
+			AdmissionCriteria{},
+			"",
+		},
+		{
+			"good-all-fields",
+			AdmissionCriteria{
+				AllowedProjectIds:           []string{"C01-001", "C01-002"},
+				AllowedJurisdictionPrefixes: []string{"US-", "KE"},
+				DeniedJurisdictionPrefixes:  []string{"US-WA"},
+				MaxBatchAge:                 &types.Duration{Seconds: 3600 * 24 * 2},
+				Attestor:                    "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+			},
+			"",
+		},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.id, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.a.Validate()
+			errorMatches(t, err, tc.err)
+		})
+	}
+}
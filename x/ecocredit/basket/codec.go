@@ -14,6 +14,13 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreate{}, "regen.basket/MsgCreate", nil)
 	cdc.RegisterConcrete(&MsgPut{}, "regen.basket/MsgPut", nil)
 	cdc.RegisterConcrete(&MsgTake{}, "regen.basket/MsgTake", nil)
+	cdc.RegisterConcrete(&MsgUpdateBasketCurator{}, "regen.basket/MsgUpdateBasketCurator", nil)
+	cdc.RegisterConcrete(&MsgAddBasketClasses{}, "regen.basket/MsgAddBasketClasses", nil)
+	cdc.RegisterConcrete(&MsgRemoveBasketClasses{}, "regen.basket/MsgRemoveBasketClasses", nil)
+	cdc.RegisterConcrete(&MsgUpdateBasketDateCriteria{}, "regen.basket/MsgUpdateBasketDateCriteria", nil)
+	cdc.RegisterConcrete(&MsgUpdateBasketDescription{}, "regen.basket/MsgUpdateBasketDescription", nil)
+	cdc.RegisterConcrete(&MsgUpdateBasketTakePremium{}, "regen.basket/MsgUpdateBasketTakePremium", nil)
+	cdc.RegisterConcrete(&MsgUpdateBasketAdmissionCriteria{}, "regen.basket/MsgUpdateBasketAdmissionCriteria", nil)
 }
 
 var (
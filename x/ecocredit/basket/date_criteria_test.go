@@ -29,6 +29,9 @@ func TestDateCriteriaToApi(t *testing.T) {
 	dw := dc.ToApi().GetStartDateWindow()
 	require.NotNil(dw)
 	require.Equal(durStd, dw.AsDuration(), "handles window date")
+
+	dc = &DateCriteria{YearsInThePast: 10}
+	require.Equal(uint32(10), dc.ToApi().GetYearsInThePast(), "handles years in the past")
 }
 
 func TestValidateDateCriteria(t *testing.T) {
@@ -181,6 +181,11 @@ type EventTake struct {
 	// Deprecated (Since Revision 1): This field is still populated and will be
 	// removed in the next version.
 	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"` // Deprecated: Do not use.
+	// strategy is the strategy used to select the credits taken from the basket.
+	Strategy TakeStrategy `protobuf:"varint,5,opt,name=strategy,proto3,enum=regen.ecocredit.basket.v1.TakeStrategy" json:"strategy,omitempty"`
+	// premium is the integer number of basket tokens paid to the basket curator
+	// as the take premium. Empty if no premium was charged.
+	Premium string `protobuf:"bytes,6,opt,name=premium,proto3" json:"premium,omitempty"`
 }
 
 func (m *EventTake) Reset()         { *m = EventTake{} }
@@ -246,10 +251,414 @@ func (m *EventTake) GetAmount() string {
 	return ""
 }
 
+func (m *EventTake) GetStrategy() TakeStrategy {
+	if m != nil {
+		return m.Strategy
+	}
+	return TakeStrategy_TAKE_STRATEGY_UNSPECIFIED
+}
+
+func (m *EventTake) GetPremium() string {
+	if m != nil {
+		return m.Premium
+	}
+	return ""
+}
+
+// EventUpdateBasketCurator is an event emitted when the curator of a basket is
+// updated.
+type EventUpdateBasketCurator struct {
+	// basket_denom is the basket bank denom of the updated basket.
+	BasketDenom string `protobuf:"bytes,1,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// curator is the address of the new basket curator.
+	Curator string `protobuf:"bytes,2,opt,name=curator,proto3" json:"curator,omitempty"`
+}
+
+func (m *EventUpdateBasketCurator) Reset()         { *m = EventUpdateBasketCurator{} }
+func (m *EventUpdateBasketCurator) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateBasketCurator) ProtoMessage()    {}
+func (*EventUpdateBasketCurator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc7fc2fbcbd93cbc, []int{3}
+}
+func (m *EventUpdateBasketCurator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateBasketCurator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateBasketCurator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpdateBasketCurator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateBasketCurator.Merge(m, src)
+}
+func (m *EventUpdateBasketCurator) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateBasketCurator) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateBasketCurator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateBasketCurator proto.InternalMessageInfo
+
+func (m *EventUpdateBasketCurator) GetBasketDenom() string {
+	if m != nil {
+		return m.BasketDenom
+	}
+	return ""
+}
+
+func (m *EventUpdateBasketCurator) GetCurator() string {
+	if m != nil {
+		return m.Curator
+	}
+	return ""
+}
+
+// EventAddBasketClasses is an event emitted when credit classes are added to
+// the allowed classes of a basket.
+type EventAddBasketClasses struct {
+	// basket_denom is the basket bank denom of the updated basket.
+	BasketDenom string `protobuf:"bytes,1,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// class_ids are the credit classes that were added to the basket.
+	ClassIds []string `protobuf:"bytes,2,rep,name=class_ids,json=classIds,proto3" json:"class_ids,omitempty"`
+}
+
+func (m *EventAddBasketClasses) Reset()         { *m = EventAddBasketClasses{} }
+func (m *EventAddBasketClasses) String() string { return proto.CompactTextString(m) }
+func (*EventAddBasketClasses) ProtoMessage()    {}
+func (*EventAddBasketClasses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc7fc2fbcbd93cbc, []int{4}
+}
+func (m *EventAddBasketClasses) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAddBasketClasses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAddBasketClasses.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAddBasketClasses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAddBasketClasses.Merge(m, src)
+}
+func (m *EventAddBasketClasses) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAddBasketClasses) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAddBasketClasses.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAddBasketClasses proto.InternalMessageInfo
+
+func (m *EventAddBasketClasses) GetBasketDenom() string {
+	if m != nil {
+		return m.BasketDenom
+	}
+	return ""
+}
+
+func (m *EventAddBasketClasses) GetClassIds() []string {
+	if m != nil {
+		return m.ClassIds
+	}
+	return nil
+}
+
+// EventRemoveBasketClasses is an event emitted when credit classes are removed
+// from the allowed classes of a basket.
+type EventRemoveBasketClasses struct {
+	// basket_denom is the basket bank denom of the updated basket.
+	BasketDenom string `protobuf:"bytes,1,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// class_ids are the credit classes that were removed from the basket.
+	ClassIds []string `protobuf:"bytes,2,rep,name=class_ids,json=classIds,proto3" json:"class_ids,omitempty"`
+}
+
+func (m *EventRemoveBasketClasses) Reset()         { *m = EventRemoveBasketClasses{} }
+func (m *EventRemoveBasketClasses) String() string { return proto.CompactTextString(m) }
+func (*EventRemoveBasketClasses) ProtoMessage()    {}
+func (*EventRemoveBasketClasses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc7fc2fbcbd93cbc, []int{5}
+}
+func (m *EventRemoveBasketClasses) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRemoveBasketClasses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRemoveBasketClasses.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRemoveBasketClasses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRemoveBasketClasses.Merge(m, src)
+}
+func (m *EventRemoveBasketClasses) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRemoveBasketClasses) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRemoveBasketClasses.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRemoveBasketClasses proto.InternalMessageInfo
+
+func (m *EventRemoveBasketClasses) GetBasketDenom() string {
+	if m != nil {
+		return m.BasketDenom
+	}
+	return ""
+}
+
+func (m *EventRemoveBasketClasses) GetClassIds() []string {
+	if m != nil {
+		return m.ClassIds
+	}
+	return nil
+}
+
+// EventUpdateBasketDateCriteria is an event emitted when the date criteria of
+// a basket is updated.
+type EventUpdateBasketDateCriteria struct {
+	// basket_denom is the basket bank denom of the updated basket.
+	BasketDenom string `protobuf:"bytes,1,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// date_criteria is the new date criteria of the basket.
+	DateCriteria *DateCriteria `protobuf:"bytes,2,opt,name=date_criteria,json=dateCriteria,proto3" json:"date_criteria,omitempty"`
+}
+
+func (m *EventUpdateBasketDateCriteria) Reset()         { *m = EventUpdateBasketDateCriteria{} }
+func (m *EventUpdateBasketDateCriteria) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateBasketDateCriteria) ProtoMessage()    {}
+func (*EventUpdateBasketDateCriteria) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc7fc2fbcbd93cbc, []int{6}
+}
+func (m *EventUpdateBasketDateCriteria) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateBasketDateCriteria) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateBasketDateCriteria.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpdateBasketDateCriteria) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateBasketDateCriteria.Merge(m, src)
+}
+func (m *EventUpdateBasketDateCriteria) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateBasketDateCriteria) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateBasketDateCriteria.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateBasketDateCriteria proto.InternalMessageInfo
+
+func (m *EventUpdateBasketDateCriteria) GetBasketDenom() string {
+	if m != nil {
+		return m.BasketDenom
+	}
+	return ""
+}
+
+func (m *EventUpdateBasketDateCriteria) GetDateCriteria() *DateCriteria {
+	if m != nil {
+		return m.DateCriteria
+	}
+	return nil
+}
+
+// EventUpdateBasketDescription is an event emitted when the description of a
+// basket is updated.
+type EventUpdateBasketDescription struct {
+	// basket_denom is the basket bank denom of the updated basket.
+	BasketDenom string `protobuf:"bytes,1,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+}
+
+func (m *EventUpdateBasketDescription) Reset()         { *m = EventUpdateBasketDescription{} }
+func (m *EventUpdateBasketDescription) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateBasketDescription) ProtoMessage()    {}
+func (*EventUpdateBasketDescription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc7fc2fbcbd93cbc, []int{7}
+}
+func (m *EventUpdateBasketDescription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateBasketDescription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateBasketDescription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpdateBasketDescription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateBasketDescription.Merge(m, src)
+}
+func (m *EventUpdateBasketDescription) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateBasketDescription) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateBasketDescription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateBasketDescription proto.InternalMessageInfo
+
+func (m *EventUpdateBasketDescription) GetBasketDenom() string {
+	if m != nil {
+		return m.BasketDenom
+	}
+	return ""
+}
+
+// EventUpdateBasketTakePremium is an event emitted when the take premium of a
+// basket is updated.
+type EventUpdateBasketTakePremium struct {
+	// basket_denom is the basket bank denom of the updated basket.
+	BasketDenom string `protobuf:"bytes,1,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// take_premium_bps is the new take premium of the basket in basis points.
+	TakePremiumBps uint32 `protobuf:"varint,2,opt,name=take_premium_bps,json=takePremiumBps,proto3" json:"take_premium_bps,omitempty"`
+}
+
+func (m *EventUpdateBasketTakePremium) Reset()         { *m = EventUpdateBasketTakePremium{} }
+func (m *EventUpdateBasketTakePremium) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateBasketTakePremium) ProtoMessage()    {}
+func (*EventUpdateBasketTakePremium) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc7fc2fbcbd93cbc, []int{8}
+}
+func (m *EventUpdateBasketTakePremium) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateBasketTakePremium) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateBasketTakePremium.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpdateBasketTakePremium) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateBasketTakePremium.Merge(m, src)
+}
+func (m *EventUpdateBasketTakePremium) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateBasketTakePremium) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateBasketTakePremium.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateBasketTakePremium proto.InternalMessageInfo
+
+func (m *EventUpdateBasketTakePremium) GetBasketDenom() string {
+	if m != nil {
+		return m.BasketDenom
+	}
+	return ""
+}
+
+func (m *EventUpdateBasketTakePremium) GetTakePremiumBps() uint32 {
+	if m != nil {
+		return m.TakePremiumBps
+	}
+	return 0
+}
+
+// EventUpdateBasketAdmissionCriteria is an event emitted when the admission
+// criteria of a basket is updated.
+type EventUpdateBasketAdmissionCriteria struct {
+	// basket_denom is the basket bank denom of the updated basket.
+	BasketDenom string `protobuf:"bytes,1,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// admission_criteria is the new admission criteria of the basket.
+	AdmissionCriteria *AdmissionCriteria `protobuf:"bytes,2,opt,name=admission_criteria,json=admissionCriteria,proto3" json:"admission_criteria,omitempty"`
+}
+
+func (m *EventUpdateBasketAdmissionCriteria) Reset()         { *m = EventUpdateBasketAdmissionCriteria{} }
+func (m *EventUpdateBasketAdmissionCriteria) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateBasketAdmissionCriteria) ProtoMessage()    {}
+func (*EventUpdateBasketAdmissionCriteria) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc7fc2fbcbd93cbc, []int{9}
+}
+func (m *EventUpdateBasketAdmissionCriteria) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateBasketAdmissionCriteria) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateBasketAdmissionCriteria.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpdateBasketAdmissionCriteria) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateBasketAdmissionCriteria.Merge(m, src)
+}
+func (m *EventUpdateBasketAdmissionCriteria) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateBasketAdmissionCriteria) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateBasketAdmissionCriteria.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateBasketAdmissionCriteria proto.InternalMessageInfo
+
+func (m *EventUpdateBasketAdmissionCriteria) GetBasketDenom() string {
+	if m != nil {
+		return m.BasketDenom
+	}
+	return ""
+}
+
+func (m *EventUpdateBasketAdmissionCriteria) GetAdmissionCriteria() *AdmissionCriteria {
+	if m != nil {
+		return m.AdmissionCriteria
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EventCreate)(nil), "regen.ecocredit.basket.v1.EventCreate")
 	proto.RegisterType((*EventPut)(nil), "regen.ecocredit.basket.v1.EventPut")
 	proto.RegisterType((*EventTake)(nil), "regen.ecocredit.basket.v1.EventTake")
+	proto.RegisterType((*EventUpdateBasketCurator)(nil), "regen.ecocredit.basket.v1.EventUpdateBasketCurator")
+	proto.RegisterType((*EventAddBasketClasses)(nil), "regen.ecocredit.basket.v1.EventAddBasketClasses")
+	proto.RegisterType((*EventRemoveBasketClasses)(nil), "regen.ecocredit.basket.v1.EventRemoveBasketClasses")
+	proto.RegisterType((*EventUpdateBasketDateCriteria)(nil), "regen.ecocredit.basket.v1.EventUpdateBasketDateCriteria")
+	proto.RegisterType((*EventUpdateBasketDescription)(nil), "regen.ecocredit.basket.v1.EventUpdateBasketDescription")
+	proto.RegisterType((*EventUpdateBasketTakePremium)(nil), "regen.ecocredit.basket.v1.EventUpdateBasketTakePremium")
+	proto.RegisterType((*EventUpdateBasketAdmissionCriteria)(nil), "regen.ecocredit.basket.v1.EventUpdateBasketAdmissionCriteria")
 }
 
 func init() {
@@ -257,26 +666,41 @@ func init() {
 }
 
 var fileDescriptor_bc7fc2fbcbd93cbc = []byte{
-	// 302 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x92, 0x3f, 0x4e, 0xc3, 0x30,
-	0x18, 0xc5, 0xeb, 0x16, 0x5a, 0xea, 0x32, 0x45, 0x0c, 0xa1, 0x42, 0x56, 0x89, 0x04, 0x74, 0xc1,
-	0x56, 0x61, 0x61, 0x4e, 0xe9, 0x8a, 0x50, 0xc4, 0xc4, 0x82, 0xf2, 0xe7, 0x53, 0xa8, 0x42, 0xec,
-	0xca, 0x71, 0x52, 0xb8, 0x05, 0xa7, 0x80, 0xab, 0x30, 0x76, 0x64, 0x44, 0xc9, 0x45, 0x50, 0xec,
-	0xd0, 0x25, 0xea, 0xce, 0xe6, 0xef, 0xf9, 0xf9, 0xbd, 0x9f, 0x65, 0xe3, 0x73, 0x09, 0x31, 0x70,
-	0x06, 0xa1, 0x08, 0x25, 0x44, 0x4b, 0xc5, 0x02, 0x3f, 0x4b, 0x40, 0xb1, 0x62, 0xc6, 0xa0, 0x00,
-	0xae, 0x32, 0xba, 0x92, 0x42, 0x09, 0xeb, 0x58, 0xfb, 0xe8, 0xd6, 0x47, 0x8d, 0x8f, 0x16, 0xb3,
-	0xf1, 0xd9, 0xee, 0x08, 0xf5, 0xb6, 0x82, 0x26, 0xc1, 0xb9, 0xc3, 0xa3, 0x45, 0x9d, 0x38, 0x97,
-	0xe0, 0x2b, 0xb0, 0x4e, 0xf1, 0xa1, 0xf1, 0x3d, 0x45, 0xc0, 0x45, 0x6a, 0xa3, 0x09, 0x9a, 0x0e,
-	0xbd, 0x91, 0xd1, 0x6e, 0x6b, 0xc9, 0x3a, 0xc1, 0x83, 0x30, 0x97, 0xbe, 0x12, 0xd2, 0xee, 0xd6,
-	0xbb, 0x6e, 0xd7, 0x46, 0xde, 0x9f, 0xe4, 0x7c, 0x20, 0x7c, 0xa0, 0x03, 0xef, 0x73, 0x65, 0x1d,
-	0xe1, 0x7d, 0xb1, 0xe6, 0x20, 0x9b, 0x18, 0x33, 0xb4, 0x3a, 0xba, 0xed, 0x8e, 0x05, 0x1e, 0x18,
-	0xea, 0xcc, 0xee, 0x4d, 0x7a, 0xd3, 0xd1, 0xd5, 0x05, 0xdd, 0x79, 0x53, 0xea, 0xea, 0xd5, 0x5c,
-	0xcb, 0x0d, 0x8c, 0x39, 0x6b, 0x8d, 0x71, 0xdf, 0x4f, 0x45, 0xce, 0x95, 0xbd, 0xb7, 0x25, 0x6d,
-	0x14, 0xe7, 0x13, 0xe1, 0xa1, 0x06, 0x7d, 0xf0, 0x13, 0xf8, 0xcf, 0xa4, 0xae, 0xf7, 0x55, 0x12,
-	0xb4, 0x29, 0x09, 0xfa, 0x29, 0x09, 0x7a, 0xaf, 0x48, 0x67, 0x53, 0x91, 0xce, 0x77, 0x45, 0x3a,
-	0x8f, 0x37, 0xf1, 0x52, 0x3d, 0xe7, 0x01, 0x0d, 0x45, 0xca, 0x74, 0xeb, 0x25, 0x07, 0xb5, 0x16,
-	0x32, 0x69, 0xa6, 0x17, 0x88, 0x62, 0x90, 0xec, 0xb5, 0xf5, 0x0b, 0x82, 0xbe, 0x7e, 0xfd, 0xeb,
-	0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9d, 0x4f, 0x26, 0x0d, 0x69, 0x02, 0x00, 0x00,
+	// 535 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0x4f, 0x6f, 0xd3, 0x4e,
+	0x10, 0x8d, 0x93, 0x5f, 0xf3, 0x67, 0xd2, 0x56, 0x3f, 0x2c, 0x90, 0x96, 0x52, 0xac, 0x60, 0x09,
+	0xc8, 0x01, 0x6c, 0x15, 0x2e, 0x5c, 0x93, 0xb4, 0x07, 0x24, 0x84, 0x2a, 0x03, 0xaa, 0x54, 0x0e,
+	0xd1, 0xc6, 0x1e, 0x85, 0x55, 0x6a, 0xaf, 0xb5, 0xbb, 0x49, 0xe9, 0x17, 0xe0, 0xdc, 0x0f, 0x81,
+	0xf8, 0x2c, 0x1c, 0x7b, 0xe4, 0x88, 0x92, 0x2f, 0x82, 0xbc, 0xde, 0xa4, 0x55, 0x4c, 0x23, 0x23,
+	0x71, 0xe0, 0x96, 0x79, 0x99, 0x79, 0xef, 0xcd, 0xf8, 0x69, 0xe1, 0x89, 0xc0, 0x31, 0x26, 0x3e,
+	0x86, 0x3c, 0x14, 0x18, 0x31, 0xe5, 0x8f, 0xa8, 0x9c, 0xa0, 0xf2, 0x67, 0x07, 0x3e, 0xce, 0x30,
+	0x51, 0xd2, 0x4b, 0x05, 0x57, 0xdc, 0xbe, 0xaf, 0xfb, 0xbc, 0x55, 0x9f, 0x97, 0xf7, 0x79, 0xb3,
+	0x83, 0xbd, 0xc7, 0xb7, 0x53, 0xa8, 0x8b, 0x14, 0x0d, 0x83, 0xfb, 0x16, 0xda, 0x47, 0x19, 0xe3,
+	0x40, 0x20, 0x55, 0x68, 0x3f, 0x82, 0xed, 0xbc, 0x6f, 0x18, 0x61, 0xc2, 0x63, 0x62, 0x75, 0xac,
+	0x6e, 0x2b, 0x68, 0xe7, 0xd8, 0x61, 0x06, 0xd9, 0xfb, 0xd0, 0x08, 0xa7, 0x82, 0x2a, 0x2e, 0x48,
+	0x35, 0xfb, 0xb7, 0x5f, 0x25, 0x56, 0xb0, 0x84, 0xdc, 0x6f, 0x16, 0x34, 0x35, 0xe1, 0xf1, 0x54,
+	0xd9, 0x77, 0x61, 0x8b, 0x9f, 0x27, 0x28, 0x0c, 0x4d, 0x5e, 0x14, 0x34, 0xaa, 0x45, 0x8d, 0x23,
+	0x68, 0xe4, 0xae, 0x25, 0xa9, 0x75, 0x6a, 0xdd, 0xf6, 0x8b, 0xa7, 0xde, 0xad, 0x9b, 0x7a, 0x7d,
+	0xfd, 0x6b, 0xa0, 0x61, 0x63, 0x26, 0x9f, 0xb5, 0xf7, 0xa0, 0x4e, 0x63, 0x3e, 0x4d, 0x14, 0xf9,
+	0x6f, 0xe5, 0xd4, 0x20, 0xee, 0x97, 0x2a, 0xb4, 0xb4, 0xd1, 0xf7, 0x74, 0x82, 0xff, 0xb2, 0x53,
+	0x7b, 0x00, 0x4d, 0xa9, 0x04, 0x55, 0x38, 0xbe, 0x20, 0x5b, 0x1d, 0xab, 0xbb, 0xbb, 0x51, 0x23,
+	0x5b, 0xe7, 0x9d, 0x69, 0x0f, 0x56, 0x83, 0x36, 0x81, 0x46, 0x2a, 0x30, 0x66, 0xd3, 0x98, 0xd4,
+	0xf5, 0x16, 0xcb, 0xd2, 0x3d, 0x01, 0xa2, 0xef, 0xf0, 0x21, 0x8d, 0xa8, 0x42, 0x63, 0x31, 0xff,
+	0x9a, 0x65, 0xe2, 0x40, 0xd6, 0xe2, 0x70, 0x1d, 0x85, 0x13, 0xb8, 0xa7, 0x89, 0x7b, 0x51, 0x64,
+	0x58, 0xcf, 0xa8, 0x94, 0x28, 0xcb, 0xb0, 0x3e, 0x80, 0x56, 0x98, 0x75, 0x0f, 0x59, 0x24, 0x49,
+	0xb5, 0x53, 0xeb, 0xb6, 0x82, 0xa6, 0x06, 0x5e, 0x47, 0xd2, 0x3d, 0x35, 0x8e, 0x03, 0x8c, 0xf9,
+	0x0c, 0xff, 0x2e, 0xf7, 0xa5, 0x05, 0x0f, 0x0b, 0xe7, 0x38, 0xa4, 0x0a, 0x07, 0x82, 0x29, 0x14,
+	0x8c, 0x96, 0x51, 0x78, 0x03, 0x3b, 0xd9, 0xf0, 0x30, 0x34, 0x33, 0xfa, 0x32, 0x9b, 0xa3, 0x71,
+	0x53, 0x22, 0xd8, 0x8e, 0x6e, 0x54, 0x6e, 0x0f, 0xf6, 0x8b, 0x8e, 0x50, 0x86, 0x82, 0xa5, 0x8a,
+	0xf1, 0xa4, 0x84, 0x21, 0x77, 0xf2, 0x1b, 0x8a, 0x2c, 0x28, 0xc7, 0x79, 0x06, 0xca, 0xec, 0xd4,
+	0x85, 0xff, 0x15, 0x9d, 0xe0, 0xd0, 0xc4, 0x66, 0x38, 0x4a, 0xa5, 0x5e, 0x6b, 0x27, 0xd8, 0x55,
+	0xd7, 0x4c, 0xfd, 0x54, 0xba, 0x5f, 0x2d, 0x70, 0x0b, 0x6a, 0xbd, 0x28, 0x66, 0x52, 0x32, 0x9e,
+	0xfc, 0xc9, 0x1d, 0x3f, 0x82, 0x4d, 0x97, 0x73, 0xeb, 0xc7, 0x7c, 0xb6, 0xe1, 0x98, 0x05, 0xb1,
+	0xe0, 0x0e, 0x5d, 0x87, 0xfa, 0xc1, 0xf7, 0xb9, 0x63, 0x5d, 0xcd, 0x1d, 0xeb, 0xe7, 0xdc, 0xb1,
+	0x2e, 0x17, 0x4e, 0xe5, 0x6a, 0xe1, 0x54, 0x7e, 0x2c, 0x9c, 0xca, 0xe9, 0xab, 0x31, 0x53, 0x9f,
+	0xa6, 0x23, 0x2f, 0xe4, 0xb1, 0xaf, 0x45, 0x9e, 0x27, 0xa8, 0xce, 0xb9, 0x98, 0x98, 0xea, 0x0c,
+	0xa3, 0x31, 0x0a, 0xff, 0x73, 0xe1, 0x71, 0x1d, 0xd5, 0xf5, 0xa3, 0xfa, 0xf2, 0xd7, 0x00, 0xac,
+	0x21, 0xba, 0x09, 0xc0, 0x05, 0x00, 0x00,
 }
 
 func (m *EventCreate) Marshal() (dAtA []byte, err error) {
@@ -394,6 +818,18 @@ func (m *EventTake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Premium) > 0 {
+		i -= len(m.Premium)
+		copy(dAtA[i:], m.Premium)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Premium)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Strategy != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Strategy))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Amount) > 0 {
 		i -= len(m.Amount)
 		copy(dAtA[i:], m.Amount)
@@ -432,19 +868,283 @@ func (m *EventTake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventUpdateBasketCurator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventCreate) Size() (n int) {
-	if m == nil {
+
+func (m *EventUpdateBasketCurator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateBasketCurator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Curator) > 0 {
+		i -= len(m.Curator)
+		copy(dAtA[i:], m.Curator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Curator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BasketDenom) > 0 {
+		i -= len(m.BasketDenom)
+		copy(dAtA[i:], m.BasketDenom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BasketDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAddBasketClasses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAddBasketClasses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAddBasketClasses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClassIds) > 0 {
+		for iNdEx := len(m.ClassIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ClassIds[iNdEx])
+			copy(dAtA[i:], m.ClassIds[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.ClassIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.BasketDenom) > 0 {
+		i -= len(m.BasketDenom)
+		copy(dAtA[i:], m.BasketDenom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BasketDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRemoveBasketClasses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRemoveBasketClasses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRemoveBasketClasses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClassIds) > 0 {
+		for iNdEx := len(m.ClassIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ClassIds[iNdEx])
+			copy(dAtA[i:], m.ClassIds[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.ClassIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.BasketDenom) > 0 {
+		i -= len(m.BasketDenom)
+		copy(dAtA[i:], m.BasketDenom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BasketDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateBasketDateCriteria) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateBasketDateCriteria) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateBasketDateCriteria) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DateCriteria != nil {
+		{
+			size, err := m.DateCriteria.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BasketDenom) > 0 {
+		i -= len(m.BasketDenom)
+		copy(dAtA[i:], m.BasketDenom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BasketDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateBasketDescription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateBasketDescription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateBasketDescription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BasketDenom) > 0 {
+		i -= len(m.BasketDenom)
+		copy(dAtA[i:], m.BasketDenom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BasketDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateBasketTakePremium) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateBasketTakePremium) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateBasketTakePremium) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TakePremiumBps != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TakePremiumBps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BasketDenom) > 0 {
+		i -= len(m.BasketDenom)
+		copy(dAtA[i:], m.BasketDenom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BasketDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateBasketAdmissionCriteria) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateBasketAdmissionCriteria) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateBasketAdmissionCriteria) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AdmissionCriteria != nil {
+		{
+			size, err := m.AdmissionCriteria.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BasketDenom) > 0 {
+		i -= len(m.BasketDenom)
+		copy(dAtA[i:], m.BasketDenom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BasketDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventCreate) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -511,16 +1211,780 @@ func (m *EventTake) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	return n
-}
+	if m.Strategy != 0 {
+		n += 1 + sovEvents(uint64(m.Strategy))
+	}
+	l = len(m.Premium)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventUpdateBasketCurator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BasketDenom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Curator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAddBasketClasses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BasketDenom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.ClassIds) > 0 {
+		for _, s := range m.ClassIds {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventRemoveBasketClasses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BasketDenom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.ClassIds) > 0 {
+		for _, s := range m.ClassIds {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventUpdateBasketDateCriteria) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BasketDenom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.DateCriteria != nil {
+		l = m.DateCriteria.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventUpdateBasketDescription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BasketDenom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventUpdateBasketTakePremium) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BasketDenom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.TakePremiumBps != 0 {
+		n += 1 + sovEvents(uint64(m.TakePremiumBps))
+	}
+	return n
+}
+
+func (m *EventUpdateBasketAdmissionCriteria) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BasketDenom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.AdmissionCriteria != nil {
+		l = m.AdmissionCriteria.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventCreate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BasketDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Curator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPut) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPut: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPut: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BasketDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Credits = append(m.Credits, &BasketCredit{})
+			if err := m.Credits[len(m.Credits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BasketDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Credits = append(m.Credits, &BasketCredit{})
+			if err := m.Credits[len(m.Credits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			m.Strategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Strategy |= TakeStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Premium", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Premium = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateBasketCurator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateBasketCurator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateBasketCurator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BasketDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Curator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EventCreate) Unmarshal(dAtA []byte) error {
+func (m *EventAddBasketClasses) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -543,10 +2007,10 @@ func (m *EventCreate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCreate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAddBasketClasses: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCreate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAddBasketClasses: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -583,7 +2047,7 @@ func (m *EventCreate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -611,7 +2075,7 @@ func (m *EventCreate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Curator = string(dAtA[iNdEx:postIndex])
+			m.ClassIds = append(m.ClassIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -634,7 +2098,7 @@ func (m *EventCreate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventPut) Unmarshal(dAtA []byte) error {
+func (m *EventRemoveBasketClasses) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -657,15 +2121,15 @@ func (m *EventPut) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventPut: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRemoveBasketClasses: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPut: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRemoveBasketClasses: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -693,9 +2157,91 @@ func (m *EventPut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.BasketDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassIds = append(m.ClassIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateBasketDateCriteria) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateBasketDateCriteria: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateBasketDateCriteria: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
 			}
@@ -727,9 +2273,9 @@ func (m *EventPut) Unmarshal(dAtA []byte) error {
 			}
 			m.BasketDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DateCriteria", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -756,14 +2302,66 @@ func (m *EventPut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Credits = append(m.Credits, &BasketCredit{})
-			if err := m.Credits[len(m.Credits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.DateCriteria == nil {
+				m.DateCriteria = &DateCriteria{}
+			}
+			if err := m.DateCriteria.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateBasketDescription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateBasketDescription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateBasketDescription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -791,7 +2389,7 @@ func (m *EventPut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.BasketDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -814,7 +2412,7 @@ func (m *EventPut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventTake) Unmarshal(dAtA []byte) error {
+func (m *EventUpdateBasketTakePremium) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -837,15 +2435,15 @@ func (m *EventTake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventTake: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUpdateBasketTakePremium: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventTake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUpdateBasketTakePremium: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -873,13 +2471,13 @@ func (m *EventTake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.BasketDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakePremiumBps", wireType)
 			}
-			var stringLen uint64
+			m.TakePremiumBps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -889,29 +2487,66 @@ func (m *EventTake) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TakePremiumBps |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BasketDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateBasketAdmissionCriteria) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateBasketAdmissionCriteria: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateBasketAdmissionCriteria: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -921,31 +2556,29 @@ func (m *EventTake) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Credits = append(m.Credits, &BasketCredit{})
-			if err := m.Credits[len(m.Credits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BasketDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdmissionCriteria", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -955,23 +2588,27 @@ func (m *EventTake) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			if m.AdmissionCriteria == nil {
+				m.AdmissionCriteria = &AdmissionCriteria{}
+			}
+			if err := m.AdmissionCriteria.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
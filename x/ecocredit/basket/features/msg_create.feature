@@ -284,6 +284,25 @@ Feature: MsgCreate
     When the message is validated
     Then expect the error "invalid date criteria: min_start_date must be after 1900-01-01: invalid request"
 
+  Scenario: an error is returned if both allowed and denied project ids are provided
+    Given the message
+    """
+    {
+      "curator": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "name": "NCT",
+      "credit_type_abbrev": "C",
+      "allowed_classes": [
+        "C01"
+      ],
+      "admission_criteria": {
+        "allowed_project_ids": ["C01-001"],
+        "denied_project_ids": ["C01-002"]
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "invalid admission criteria: only one of allowed_project_ids or denied_project_ids must be set: invalid request"
+
   Scenario: an error is returned if start date window is less than one day
     Given the message
     """
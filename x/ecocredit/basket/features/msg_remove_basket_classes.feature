@@ -0,0 +1,87 @@
+Feature: MsgRemoveBasketClasses
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "curator": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "basket_denom": "eco.uC.NCT",
+      "class_ids": ["C01", "C02"]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if curator is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "curator: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if curator is not a bech32 address
+    Given the message
+    """
+    {
+      "curator": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "curator: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if basket denom is empty
+    Given the message
+    """
+    {
+      "curator": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+    }
+    """
+    When the message is validated
+    Then expect the error "basket denom cannot be empty: invalid request"
+
+  Scenario: an error is returned if basket denom is not formatted
+    Given the message
+    """
+    {
+      "curator": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "basket_denom": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "foo is not a valid basket denom: invalid request"
+
+  Scenario: an error is returned if class ids is empty
+    Given the message
+    """
+    {
+      "curator": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "basket_denom": "eco.uC.NCT"
+    }
+    """
+    When the message is validated
+    Then expect the error "class ids cannot be empty: invalid request"
+
+  Scenario: an error is returned if a class id is not formatted
+    Given the message
+    """
+    {
+      "curator": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "basket_denom": "eco.uC.NCT",
+      "class_ids": ["C01", "foo"]
+    }
+    """
+    When the message is validated
+    Then expect the error "class_ids[1] is not a valid class ID: class ID didn't match the format: expected A00, got foo: parse error: invalid request"
+
+  Scenario: an error is returned if a class id is duplicated
+    Given the message
+    """
+    {
+      "curator": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "basket_denom": "eco.uC.NCT",
+      "class_ids": ["C01", "C01"]
+    }
+    """
+    When the message is validated
+    Then expect the error "duplicate class id C01: invalid request"
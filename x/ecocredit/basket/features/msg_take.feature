@@ -140,3 +140,150 @@ Feature: MsgTake
     """
     When the message is validated
     Then expect no error
+
+  Scenario: an error is returned if retire on take is true and beneficiary address is not a bech32 address
+    Given the message
+    """
+    {
+      "owner": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "basket_denom": "eco.uC.NCT",
+      "amount": "100",
+      "retirement_jurisdiction": "US-WA",
+      "retire_on_take": true,
+      "beneficiary_address": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "beneficiary address: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: a valid message with newest first strategy
+    Given the message
+    """
+    {
+      "owner": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "basket_denom": "eco.uC.NCT",
+      "amount": "100",
+      "strategy": "TAKE_STRATEGY_NEWEST_FIRST",
+      "max_premium": "5"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with selected batches strategy
+    Given the message
+    """
+    {
+      "owner": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "basket_denom": "eco.uC.NCT",
+      "amount": "100",
+      "strategy": "TAKE_STRATEGY_SELECTED_BATCHES",
+      "batch_denoms": [
+        "C01-001-20200101-20210101-001",
+        "C01-001-20200101-20210101-002"
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if strategy is not a valid take strategy
+    Given the message
+    """
+    {
+      "owner": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "basket_denom": "eco.uC.NCT",
+      "amount": "100",
+      "strategy": 5
+    }
+    """
+    When the message is validated
+    Then expect the error "invalid take strategy 5: invalid request"
+
+  Scenario: an error is returned if batch denoms is empty with selected batches strategy
+    Given the message
+    """
+    {
+      "owner": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "basket_denom": "eco.uC.NCT",
+      "amount": "100",
+      "strategy": "TAKE_STRATEGY_SELECTED_BATCHES"
+    }
+    """
+    When the message is validated
+    Then expect the error "batch denoms cannot be empty with selected batches strategy: invalid request"
+
+  Scenario: an error is returned if a batch denom is not formatted
+    Given the message
+    """
+    {
+      "owner": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "basket_denom": "eco.uC.NCT",
+      "amount": "100",
+      "strategy": "TAKE_STRATEGY_SELECTED_BATCHES",
+      "batch_denoms": [
+        "foo"
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "batch_denoms[0]: invalid batch denom: expected format A00-000-00000000-00000000-000: parse error: invalid request"
+
+  Scenario: an error is returned if a batch denom is duplicated
+    Given the message
+    """
+    {
+      "owner": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "basket_denom": "eco.uC.NCT",
+      "amount": "100",
+      "strategy": "TAKE_STRATEGY_SELECTED_BATCHES",
+      "batch_denoms": [
+        "C01-001-20200101-20210101-001",
+        "C01-001-20200101-20210101-001"
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "duplicate batch denom C01-001-20200101-20210101-001: invalid request"
+
+  Scenario: an error is returned if batch denoms are set without selected batches strategy
+    Given the message
+    """
+    {
+      "owner": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "basket_denom": "eco.uC.NCT",
+      "amount": "100",
+      "strategy": "TAKE_STRATEGY_PROPORTIONAL",
+      "batch_denoms": [
+        "C01-001-20200101-20210101-001"
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "batch denoms can only be set with selected batches strategy: invalid request"
+
+  Scenario: an error is returned if max premium is not an integer
+    Given the message
+    """
+    {
+      "owner": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "basket_denom": "eco.uC.NCT",
+      "amount": "100",
+      "max_premium": "1.5"
+    }
+    """
+    When the message is validated
+    Then expect the error "max premium 1.5 is not a valid integer: invalid request"
+
+  Scenario: an error is returned if max premium is negative
+    Given the message
+    """
+    {
+      "owner": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "basket_denom": "eco.uC.NCT",
+      "amount": "100",
+      "max_premium": "-1"
+    }
+    """
+    When the message is validated
+    Then expect the error "max premium cannot be negative, got -1: invalid request"
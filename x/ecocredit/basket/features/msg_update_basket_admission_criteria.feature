@@ -0,0 +1,97 @@
+Feature: MsgUpdateBasketAdmissionCriteria
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "curator": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "basket_denom": "eco.uC.NCT",
+      "admission_criteria": {
+        "allowed_project_ids": ["C01-001"],
+        "allowed_jurisdiction_prefixes": ["US-", "KE"],
+        "max_batch_age": "31536000s",
+        "attestor": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+      }
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message without admission criteria
+    Given the message
+    """
+    {
+      "curator": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "basket_denom": "eco.uC.NCT"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if curator is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "curator: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if curator is not a bech32 address
+    Given the message
+    """
+    {
+      "curator": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "curator: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if basket denom is empty
+    Given the message
+    """
+    {
+      "curator": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+    }
+    """
+    When the message is validated
+    Then expect the error "basket denom cannot be empty: invalid request"
+
+  Scenario: an error is returned if basket denom is not formatted
+    Given the message
+    """
+    {
+      "curator": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "basket_denom": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "foo is not a valid basket denom: invalid request"
+
+  Scenario: an error is returned if both allowed and denied project ids are set
+    Given the message
+    """
+    {
+      "curator": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "basket_denom": "eco.uC.NCT",
+      "admission_criteria": {
+        "allowed_project_ids": ["C01-001"],
+        "denied_project_ids": ["C01-002"]
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "invalid admission criteria: only one of allowed_project_ids or denied_project_ids must be set: invalid request"
+
+  Scenario: an error is returned if max batch age is less than one day
+    Given the message
+    """
+    {
+      "curator": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "basket_denom": "eco.uC.NCT",
+      "admission_criteria": {
+        "max_batch_age": "3600s"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "invalid admission criteria: max_batch_age must be at least 1 day: invalid request"
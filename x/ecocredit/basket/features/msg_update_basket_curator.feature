@@ -0,0 +1,87 @@
+Feature: MsgUpdateBasketCurator
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "curator": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "basket_denom": "eco.uC.NCT",
+      "new_curator": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if curator is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "curator: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if curator is not a bech32 address
+    Given the message
+    """
+    {
+      "curator": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "curator: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if basket denom is empty
+    Given the message
+    """
+    {
+      "curator": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+    }
+    """
+    When the message is validated
+    Then expect the error "basket denom cannot be empty: invalid request"
+
+  Scenario: an error is returned if basket denom is not formatted
+    Given the message
+    """
+    {
+      "curator": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "basket_denom": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "foo is not a valid basket denom: invalid request"
+
+  Scenario: an error is returned if new curator is empty
+    Given the message
+    """
+    {
+      "curator": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "basket_denom": "eco.uC.NCT"
+    }
+    """
+    When the message is validated
+    Then expect the error "new curator: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if new curator is not a bech32 address
+    Given the message
+    """
+    {
+      "curator": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "basket_denom": "eco.uC.NCT",
+      "new_curator": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "new curator: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if curator and new curator are the same
+    Given the message
+    """
+    {
+      "curator": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "basket_denom": "eco.uC.NCT",
+      "new_curator": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+    }
+    """
+    When the message is validated
+    Then expect the error "curator and new curator cannot be the same: invalid request"
@@ -0,0 +1,94 @@
+Feature: MsgUpdateBasketDateCriteria
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "curator": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "basket_denom": "eco.uC.NCT",
+      "date_criteria": {
+        "years_in_the_past": 10
+      }
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message without date criteria
+    Given the message
+    """
+    {
+      "curator": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "basket_denom": "eco.uC.NCT"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if curator is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "curator: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if curator is not a bech32 address
+    Given the message
+    """
+    {
+      "curator": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "curator: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if basket denom is empty
+    Given the message
+    """
+    {
+      "curator": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+    }
+    """
+    When the message is validated
+    Then expect the error "basket denom cannot be empty: invalid request"
+
+  Scenario: an error is returned if basket denom is not formatted
+    Given the message
+    """
+    {
+      "curator": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "basket_denom": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "foo is not a valid basket denom: invalid request"
+
+  Scenario: an error is returned if more than one date criteria is set
+    Given the message
+    """
+    {
+      "curator": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "basket_denom": "eco.uC.NCT",
+      "date_criteria": {
+        "start_date_window": "864000s",
+        "years_in_the_past": 10
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "invalid date criteria: only one of min_start_date, start_date_window, or years_in_the_past must be set: invalid request"
+
+  Scenario: an error is returned if start date window is less than one day
+    Given the message
+    """
+    {
+      "curator": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "basket_denom": "eco.uC.NCT",
+      "date_criteria": {
+        "start_date_window": "3600s"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "invalid date criteria: start_date_window must be at least 1 day: invalid request"
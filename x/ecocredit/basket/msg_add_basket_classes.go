@@ -0,0 +1,41 @@
+package basket
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var _ legacytx.LegacyMsg = &MsgAddBasketClasses{}
+
+// Route implements LegacyMsg.
+func (m MsgAddBasketClasses) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements LegacyMsg.
+func (m MsgAddBasketClasses) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements LegacyMsg.
+func (m MsgAddBasketClasses) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a stateless sanity check on the provided data.
+func (m MsgAddBasketClasses) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Curator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("curator: %s", err)
+	}
+
+	if err := validateBasketDenom(m.BasketDenom); err != nil {
+		return err
+	}
+
+	return validateBasketClassIds(m.ClassIds)
+}
+
+// GetSigners returns the expected signers for MsgAddBasketClasses.
+func (m MsgAddBasketClasses) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Curator)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,41 @@
+package basket
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgAddBasketClassesSuite struct {
+	t   gocuke.TestingT
+	msg *MsgAddBasketClasses
+	err error
+}
+
+func TestMsgAddBasketClasses(t *testing.T) {
+	gocuke.NewRunner(t, &msgAddBasketClassesSuite{}).Path("./features/msg_add_basket_classes.feature").Run()
+}
+
+func (s *msgAddBasketClassesSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgAddBasketClassesSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgAddBasketClasses{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgAddBasketClassesSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgAddBasketClassesSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgAddBasketClassesSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
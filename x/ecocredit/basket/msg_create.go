@@ -60,6 +60,10 @@ func (m MsgCreate) ValidateBasic() error {
 		return sdkerrors.ErrInvalidRequest.Wrapf("invalid date criteria: %s", err)
 	}
 
+	if err := m.AdmissionCriteria.Validate(); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid admission criteria: %s", err)
+	}
+
 	// In the next version of the basket package, this field will be updated to
 	// a single Coin rather than a list of Coins. In the meantime, the message
 	// will fail basic validation if more than one Coin is provided.
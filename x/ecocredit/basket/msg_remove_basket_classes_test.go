@@ -0,0 +1,41 @@
+package basket
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgRemoveBasketClassesSuite struct {
+	t   gocuke.TestingT
+	msg *MsgRemoveBasketClasses
+	err error
+}
+
+func TestMsgRemoveBasketClasses(t *testing.T) {
+	gocuke.NewRunner(t, &msgRemoveBasketClassesSuite{}).Path("./features/msg_remove_basket_classes.feature").Run()
+}
+
+func (s *msgRemoveBasketClassesSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgRemoveBasketClassesSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgRemoveBasketClasses{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgRemoveBasketClassesSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgRemoveBasketClassesSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgRemoveBasketClassesSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -67,6 +67,45 @@ func (m MsgTake) ValidateBasic() error {
 				return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 			}
 		}
+
+		if err := core.ValidateRetirementBeneficiary(m.BeneficiaryAddress, m.BeneficiaryName, m.Reason); err != nil {
+			return err
+		}
+	}
+
+	if _, ok := TakeStrategy_name[int32(m.Strategy)]; !ok {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid take strategy %d", m.Strategy)
+	}
+
+	if m.Strategy == TakeStrategy_TAKE_STRATEGY_SELECTED_BATCHES {
+		if len(m.BatchDenoms) == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("batch denoms cannot be empty with selected batches strategy")
+		}
+
+		seen := make(map[string]bool, len(m.BatchDenoms))
+		for i, batchDenom := range m.BatchDenoms {
+			if err := core.ValidateBatchDenom(batchDenom); err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("batch_denoms[%d]: %s", i, err)
+			}
+
+			if seen[batchDenom] {
+				return sdkerrors.ErrInvalidRequest.Wrapf("duplicate batch denom %s", batchDenom)
+			}
+			seen[batchDenom] = true
+		}
+	} else if len(m.BatchDenoms) != 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("batch denoms can only be set with selected batches strategy")
+	}
+
+	if len(m.MaxPremium) != 0 {
+		maxPremium, ok := sdk.NewIntFromString(m.MaxPremium)
+		if !ok {
+			return sdkerrors.ErrInvalidRequest.Wrapf("max premium %s is not a valid integer", m.MaxPremium)
+		}
+
+		if maxPremium.IsNegative() {
+			return sdkerrors.ErrInvalidRequest.Wrapf("max premium cannot be negative, got %s", m.MaxPremium)
+		}
 	}
 
 	return nil
@@ -0,0 +1,45 @@
+package basket
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var _ legacytx.LegacyMsg = &MsgUpdateBasketAdmissionCriteria{}
+
+// Route implements LegacyMsg.
+func (m MsgUpdateBasketAdmissionCriteria) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements LegacyMsg.
+func (m MsgUpdateBasketAdmissionCriteria) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements LegacyMsg.
+func (m MsgUpdateBasketAdmissionCriteria) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a stateless sanity check on the provided data.
+func (m MsgUpdateBasketAdmissionCriteria) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Curator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("curator: %s", err)
+	}
+
+	if err := validateBasketDenom(m.BasketDenom); err != nil {
+		return err
+	}
+
+	if err := m.AdmissionCriteria.Validate(); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid admission criteria: %s", err)
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgUpdateBasketAdmissionCriteria.
+func (m MsgUpdateBasketAdmissionCriteria) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Curator)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,41 @@
+package basket
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgUpdateBasketAdmissionCriteriaSuite struct {
+	t   gocuke.TestingT
+	msg *MsgUpdateBasketAdmissionCriteria
+	err error
+}
+
+func TestMsgUpdateBasketAdmissionCriteria(t *testing.T) {
+	gocuke.NewRunner(t, &msgUpdateBasketAdmissionCriteriaSuite{}).Path("./features/msg_update_basket_admission_criteria.feature").Run()
+}
+
+func (s *msgUpdateBasketAdmissionCriteriaSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgUpdateBasketAdmissionCriteriaSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgUpdateBasketAdmissionCriteria{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgUpdateBasketAdmissionCriteriaSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgUpdateBasketAdmissionCriteriaSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgUpdateBasketAdmissionCriteriaSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -0,0 +1,49 @@
+package basket
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var _ legacytx.LegacyMsg = &MsgUpdateBasketCurator{}
+
+// Route implements LegacyMsg.
+func (m MsgUpdateBasketCurator) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements LegacyMsg.
+func (m MsgUpdateBasketCurator) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements LegacyMsg.
+func (m MsgUpdateBasketCurator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a stateless sanity check on the provided data.
+func (m MsgUpdateBasketCurator) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Curator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("curator: %s", err)
+	}
+
+	if err := validateBasketDenom(m.BasketDenom); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.NewCurator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("new curator: %s", err)
+	}
+
+	if m.Curator == m.NewCurator {
+		return sdkerrors.ErrInvalidRequest.Wrap("curator and new curator cannot be the same")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgUpdateBasketCurator.
+func (m MsgUpdateBasketCurator) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Curator)
+	return []sdk.AccAddress{addr}
+}
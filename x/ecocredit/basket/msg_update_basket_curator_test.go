@@ -0,0 +1,41 @@
+package basket
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgUpdateBasketCuratorSuite struct {
+	t   gocuke.TestingT
+	msg *MsgUpdateBasketCurator
+	err error
+}
+
+func TestMsgUpdateBasketCurator(t *testing.T) {
+	gocuke.NewRunner(t, &msgUpdateBasketCuratorSuite{}).Path("./features/msg_update_basket_curator.feature").Run()
+}
+
+func (s *msgUpdateBasketCuratorSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgUpdateBasketCuratorSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgUpdateBasketCurator{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgUpdateBasketCuratorSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgUpdateBasketCuratorSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgUpdateBasketCuratorSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -0,0 +1,45 @@
+package basket
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var _ legacytx.LegacyMsg = &MsgUpdateBasketDateCriteria{}
+
+// Route implements LegacyMsg.
+func (m MsgUpdateBasketDateCriteria) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements LegacyMsg.
+func (m MsgUpdateBasketDateCriteria) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements LegacyMsg.
+func (m MsgUpdateBasketDateCriteria) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a stateless sanity check on the provided data.
+func (m MsgUpdateBasketDateCriteria) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Curator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("curator: %s", err)
+	}
+
+	if err := validateBasketDenom(m.BasketDenom); err != nil {
+		return err
+	}
+
+	if err := m.DateCriteria.Validate(); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid date criteria: %s", err)
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgUpdateBasketDateCriteria.
+func (m MsgUpdateBasketDateCriteria) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Curator)
+	return []sdk.AccAddress{addr}
+}
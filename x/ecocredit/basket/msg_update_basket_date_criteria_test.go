@@ -0,0 +1,41 @@
+package basket
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgUpdateBasketDateCriteriaSuite struct {
+	t   gocuke.TestingT
+	msg *MsgUpdateBasketDateCriteria
+	err error
+}
+
+func TestMsgUpdateBasketDateCriteria(t *testing.T) {
+	gocuke.NewRunner(t, &msgUpdateBasketDateCriteriaSuite{}).Path("./features/msg_update_basket_date_criteria.feature").Run()
+}
+
+func (s *msgUpdateBasketDateCriteriaSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgUpdateBasketDateCriteriaSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgUpdateBasketDateCriteria{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgUpdateBasketDateCriteriaSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgUpdateBasketDateCriteriaSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgUpdateBasketDateCriteriaSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
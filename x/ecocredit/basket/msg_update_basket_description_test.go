@@ -0,0 +1,41 @@
+package basket
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgUpdateBasketDescriptionSuite struct {
+	t   gocuke.TestingT
+	msg *MsgUpdateBasketDescription
+	err error
+}
+
+func TestMsgUpdateBasketDescription(t *testing.T) {
+	gocuke.NewRunner(t, &msgUpdateBasketDescriptionSuite{}).Path("./features/msg_update_basket_description.feature").Run()
+}
+
+func (s *msgUpdateBasketDescriptionSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgUpdateBasketDescriptionSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgUpdateBasketDescription{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgUpdateBasketDescriptionSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgUpdateBasketDescriptionSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgUpdateBasketDescriptionSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
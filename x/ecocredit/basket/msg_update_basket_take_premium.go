@@ -0,0 +1,48 @@
+package basket
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// MaxTakePremiumBps is the maximum take premium in basis points.
+const MaxTakePremiumBps uint32 = 10000
+
+var _ legacytx.LegacyMsg = &MsgUpdateBasketTakePremium{}
+
+// Route implements LegacyMsg.
+func (m MsgUpdateBasketTakePremium) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements LegacyMsg.
+func (m MsgUpdateBasketTakePremium) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements LegacyMsg.
+func (m MsgUpdateBasketTakePremium) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a stateless sanity check on the provided data.
+func (m MsgUpdateBasketTakePremium) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Curator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("curator: %s", err)
+	}
+
+	if err := validateBasketDenom(m.BasketDenom); err != nil {
+		return err
+	}
+
+	if m.TakePremiumBps > MaxTakePremiumBps {
+		return sdkerrors.ErrInvalidRequest.Wrapf("take premium cannot exceed %d basis points", MaxTakePremiumBps)
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgUpdateBasketTakePremium.
+func (m MsgUpdateBasketTakePremium) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Curator)
+	return []sdk.AccAddress{addr}
+}
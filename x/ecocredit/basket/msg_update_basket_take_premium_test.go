@@ -0,0 +1,41 @@
+package basket
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgUpdateBasketTakePremiumSuite struct {
+	t   gocuke.TestingT
+	msg *MsgUpdateBasketTakePremium
+	err error
+}
+
+func TestMsgUpdateBasketTakePremium(t *testing.T) {
+	gocuke.NewRunner(t, &msgUpdateBasketTakePremiumSuite{}).Path("./features/msg_update_basket_take_premium.feature").Run()
+}
+
+func (s *msgUpdateBasketTakePremiumSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgUpdateBasketTakePremiumSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgUpdateBasketTakePremium{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgUpdateBasketTakePremiumSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgUpdateBasketTakePremiumSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgUpdateBasketTakePremiumSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -511,6 +511,13 @@ type BasketInfo struct {
 	// curator is the address of the basket curator who is able to change certain
 	// basket settings.
 	Curator string `protobuf:"bytes,7,opt,name=curator,proto3" json:"curator,omitempty"`
+	// take_premium_bps is the premium in basis points of the basket tokens being
+	// converted that is paid to the curator when credits are taken from the
+	// basket with a strategy other than oldest first.
+	TakePremiumBps uint32 `protobuf:"varint,8,opt,name=take_premium_bps,json=takePremiumBps,proto3" json:"take_premium_bps,omitempty"`
+	// admission_criteria is the additional criteria for batches admitted to the
+	// basket.
+	AdmissionCriteria *AdmissionCriteria `protobuf:"bytes,9,opt,name=admission_criteria,json=admissionCriteria,proto3" json:"admission_criteria,omitempty"`
 }
 
 func (m *BasketInfo) Reset()         { *m = BasketInfo{} }
@@ -595,6 +602,134 @@ func (m *BasketInfo) GetCurator() string {
 	return ""
 }
 
+func (m *BasketInfo) GetTakePremiumBps() uint32 {
+	if m != nil {
+		return m.TakePremiumBps
+	}
+	return 0
+}
+
+func (m *BasketInfo) GetAdmissionCriteria() *AdmissionCriteria {
+	if m != nil {
+		return m.AdmissionCriteria
+	}
+	return nil
+}
+
+// QueryBasketAdmissibilityRequest is the Query/BasketAdmissibility request
+// type.
+type QueryBasketAdmissibilityRequest struct {
+	// basket_denom is the denom of the basket.
+	BasketDenom string `protobuf:"bytes,1,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// batch_denom is the denom of the credit batch.
+	BatchDenom string `protobuf:"bytes,2,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+}
+
+func (m *QueryBasketAdmissibilityRequest) Reset()         { *m = QueryBasketAdmissibilityRequest{} }
+func (m *QueryBasketAdmissibilityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBasketAdmissibilityRequest) ProtoMessage()    {}
+func (*QueryBasketAdmissibilityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a83a50529e6be723, []int{9}
+}
+func (m *QueryBasketAdmissibilityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBasketAdmissibilityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBasketAdmissibilityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBasketAdmissibilityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBasketAdmissibilityRequest.Merge(m, src)
+}
+func (m *QueryBasketAdmissibilityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBasketAdmissibilityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBasketAdmissibilityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBasketAdmissibilityRequest proto.InternalMessageInfo
+
+func (m *QueryBasketAdmissibilityRequest) GetBasketDenom() string {
+	if m != nil {
+		return m.BasketDenom
+	}
+	return ""
+}
+
+func (m *QueryBasketAdmissibilityRequest) GetBatchDenom() string {
+	if m != nil {
+		return m.BatchDenom
+	}
+	return ""
+}
+
+// QueryBasketAdmissibilityResponse is the Query/BasketAdmissibility response
+// type.
+type QueryBasketAdmissibilityResponse struct {
+	// admissible is whether credits from the credit batch can be put into the
+	// basket.
+	Admissible bool `protobuf:"varint,1,opt,name=admissible,proto3" json:"admissible,omitempty"`
+	// reasons are the reasons why credits from the credit batch cannot be put
+	// into the basket. Empty if the credit batch is admissible.
+	Reasons []string `protobuf:"bytes,2,rep,name=reasons,proto3" json:"reasons,omitempty"`
+}
+
+func (m *QueryBasketAdmissibilityResponse) Reset()         { *m = QueryBasketAdmissibilityResponse{} }
+func (m *QueryBasketAdmissibilityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBasketAdmissibilityResponse) ProtoMessage()    {}
+func (*QueryBasketAdmissibilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a83a50529e6be723, []int{10}
+}
+func (m *QueryBasketAdmissibilityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBasketAdmissibilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBasketAdmissibilityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBasketAdmissibilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBasketAdmissibilityResponse.Merge(m, src)
+}
+func (m *QueryBasketAdmissibilityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBasketAdmissibilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBasketAdmissibilityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBasketAdmissibilityResponse proto.InternalMessageInfo
+
+func (m *QueryBasketAdmissibilityResponse) GetAdmissible() bool {
+	if m != nil {
+		return m.Admissible
+	}
+	return false
+}
+
+func (m *QueryBasketAdmissibilityResponse) GetReasons() []string {
+	if m != nil {
+		return m.Reasons
+	}
+	return nil
+}
+
 // BasketBalanceInfo is the human-readable basket balance information.
 type BasketBalanceInfo struct {
 	// batch_denom is the denom of the credit batch
@@ -607,7 +742,7 @@ func (m *BasketBalanceInfo) Reset()         { *m = BasketBalanceInfo{} }
 func (m *BasketBalanceInfo) String() string { return proto.CompactTextString(m) }
 func (*BasketBalanceInfo) ProtoMessage()    {}
 func (*BasketBalanceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a83a50529e6be723, []int{9}
+	return fileDescriptor_a83a50529e6be723, []int{11}
 }
 func (m *BasketBalanceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -660,6 +795,8 @@ func init() {
 	proto.RegisterType((*QueryBasketBalanceRequest)(nil), "regen.ecocredit.basket.v1.QueryBasketBalanceRequest")
 	proto.RegisterType((*QueryBasketBalanceResponse)(nil), "regen.ecocredit.basket.v1.QueryBasketBalanceResponse")
 	proto.RegisterType((*BasketInfo)(nil), "regen.ecocredit.basket.v1.BasketInfo")
+	proto.RegisterType((*QueryBasketAdmissibilityRequest)(nil), "regen.ecocredit.basket.v1.QueryBasketAdmissibilityRequest")
+	proto.RegisterType((*QueryBasketAdmissibilityResponse)(nil), "regen.ecocredit.basket.v1.QueryBasketAdmissibilityResponse")
 	proto.RegisterType((*BasketBalanceInfo)(nil), "regen.ecocredit.basket.v1.BasketBalanceInfo")
 }
 
@@ -668,61 +805,69 @@ func init() {
 }
 
 var fileDescriptor_a83a50529e6be723 = []byte{
-	// 857 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
-	0x18, 0xed, 0x38, 0xbb, 0x49, 0x77, 0xb6, 0x41, 0xec, 0x2c, 0x07, 0x6f, 0x40, 0x21, 0x6b, 0xb1,
-	0x10, 0xad, 0xb6, 0x36, 0xd9, 0x65, 0xcb, 0x0f, 0x81, 0x50, 0x43, 0x55, 0x0a, 0x42, 0x88, 0x5a,
-	0x9c, 0x2a, 0x21, 0x6b, 0xec, 0x7c, 0x75, 0xad, 0x26, 0x9e, 0xd4, 0x33, 0x09, 0x8d, 0xaa, 0x0a,
-	0x04, 0x07, 0xae, 0x48, 0x20, 0x24, 0xe0, 0xcc, 0xbf, 0xc0, 0xdf, 0xc0, 0xb1, 0x12, 0x12, 0xe2,
-	0x88, 0x5a, 0x4e, 0xfc, 0x15, 0x28, 0x33, 0xe3, 0xd4, 0x4e, 0xda, 0xc6, 0xa9, 0xf6, 0xe6, 0x19,
-	0x7f, 0xef, 0xcd, 0xfb, 0xde, 0x1b, 0x7f, 0x09, 0x7e, 0x90, 0x40, 0x08, 0xb1, 0x03, 0x01, 0x0b,
-	0x12, 0xe8, 0x44, 0xc2, 0xf1, 0x29, 0xdf, 0x07, 0xe1, 0x0c, 0x5b, 0xce, 0xc1, 0x00, 0x92, 0x91,
-	0xdd, 0x4f, 0x98, 0x60, 0xe4, 0x9e, 0x2c, 0xb3, 0x27, 0x65, 0xb6, 0x2a, 0xb3, 0x87, 0xad, 0xda,
-	0x4b, 0x21, 0x63, 0x61, 0x17, 0x1c, 0xda, 0x8f, 0x1c, 0x1a, 0xc7, 0x4c, 0x50, 0x11, 0xb1, 0x98,
-	0x2b, 0x60, 0xed, 0x0a, 0x7e, 0x2e, 0xa8, 0x00, 0x5d, 0xf6, 0x30, 0x60, 0xbc, 0xc7, 0xf8, 0xf8,
-	0x2d, 0xa8, 0x83, 0x9d, 0x61, 0xcb, 0x07, 0x41, 0x5b, 0x4e, 0x9f, 0x86, 0x51, 0x2c, 0x39, 0xe7,
-	0x53, 0x8a, 0x51, 0x1f, 0xf4, 0xc9, 0xd6, 0x9b, 0x98, 0x6c, 0x8f, 0x89, 0xda, 0xf2, 0xad, 0x0b,
-	0x07, 0x03, 0xe0, 0x82, 0xdc, 0xc7, 0x2b, 0xaa, 0xdc, 0xeb, 0x40, 0xcc, 0x7a, 0x26, 0x6a, 0xa0,
-	0xe6, 0x2d, 0xf7, 0xb6, 0xda, 0xdb, 0x18, 0x6f, 0x59, 0xbf, 0x23, 0x7c, 0x37, 0x87, 0xe4, 0x7d,
-	0x16, 0x73, 0x20, 0xef, 0xe1, 0xb2, 0x2a, 0x93, 0xa0, 0xdb, 0x8f, 0xef, 0xdb, 0x97, 0x9a, 0x62,
-	0x2b, 0x68, 0xdb, 0x30, 0x91, 0xab, 0x41, 0xc4, 0xc4, 0x95, 0xa0, 0x4b, 0x39, 0x07, 0x6e, 0x1a,
-	0x8d, 0x52, 0xf3, 0x96, 0x9b, 0x2e, 0xc9, 0x26, 0xd6, 0xe7, 0x7b, 0x51, 0xbc, 0xcb, 0xcc, 0x92,
-	0x64, 0x7f, 0x30, 0x97, 0xfd, 0xa3, 0x78, 0x97, 0xb9, 0xd8, 0x9f, 0x3c, 0x5b, 0x5f, 0xe4, 0x74,
-	0xf3, 0xb4, 0xe5, 0x4d, 0x8c, 0xcf, 0x3d, 0xd4, 0xda, 0x5f, 0xb5, 0x95, 0xe1, 0x63, 0x52, 0xb0,
-	0x55, 0xd2, 0xda, 0x70, 0xfb, 0x33, 0x1a, 0x82, 0xc6, 0xba, 0x19, 0xa4, 0xf5, 0x1f, 0xc2, 0x2f,
-	0xe4, 0xf9, 0xb5, 0x31, 0xef, 0xe3, 0x8a, 0x52, 0xc1, 0x4d, 0xd4, 0x28, 0x15, 0x77, 0x26, 0x45,
-	0x91, 0x0f, 0x73, 0x0a, 0x0d, 0xa9, 0xf0, 0xb5, 0xb9, 0x0a, 0xd5, 0xe9, 0x59, 0x89, 0x64, 0x2b,
-	0x4d, 0x97, 0xa7, 0x56, 0x96, 0x8a, 0x5b, 0xa9, 0x43, 0xe0, 0xd2, 0xcb, 0xef, 0x10, 0xae, 0x65,
-	0x9a, 0x6d, 0xd3, 0x2e, 0x8d, 0x03, 0xe0, 0xc5, 0xaf, 0xd1, 0x94, 0xed, 0xc6, 0xb5, 0x6d, 0xff,
-	0xd6, 0xc0, 0x2f, 0x5e, 0xa8, 0x44, 0xbb, 0xbf, 0x85, 0x97, 0x7d, 0xbd, 0xa7, 0xed, 0x6f, 0xce,
-	0xb7, 0x5f, 0x01, 0x64, 0x0a, 0x13, 0xf4, 0xb3, 0x8b, 0x61, 0x1b, 0x57, 0x53, 0xd2, 0x6c, 0x0e,
-	0x8f, 0x8a, 0xea, 0x92, 0x71, 0xac, 0xa4, 0x14, 0x32, 0x0f, 0x0f, 0xdf, 0x9b, 0x35, 0x61, 0x81,
-	0x34, 0x5e, 0x1e, 0x7f, 0x63, 0x22, 0xd8, 0xd3, 0x15, 0x86, 0xac, 0xc0, 0x72, 0x4b, 0x7d, 0xf5,
-	0x6b, 0x17, 0xe5, 0x3d, 0x31, 0xd9, 0x1c, 0x5f, 0x71, 0xb9, 0xa5, 0xc9, 0xd3, 0xa5, 0xf5, 0x9b,
-	0x81, 0xf1, 0xf9, 0x25, 0x2a, 0x22, 0x85, 0xe0, 0x1b, 0x31, 0xed, 0x81, 0xd6, 0x20, 0x9f, 0x89,
-	0x8d, 0xef, 0x76, 0x22, 0x4e, 0xfd, 0x2e, 0x78, 0x74, 0x20, 0x98, 0x97, 0x80, 0x88, 0x12, 0x90,
-	0xa3, 0x60, 0xd9, 0xbd, 0xa3, 0x5f, 0xad, 0x0f, 0x04, 0x73, 0xe5, 0x0b, 0xf2, 0x08, 0x13, 0x65,
-	0xa1, 0x37, 0x1e, 0x79, 0x1e, 0xf5, 0xfd, 0x04, 0x86, 0xe6, 0x0d, 0xc9, 0xf8, 0xbc, 0x7a, 0xf3,
-	0xf9, 0xa8, 0x0f, 0xeb, 0x72, 0x9f, 0x7c, 0x82, 0xab, 0x1d, 0x2a, 0xc0, 0x0b, 0x92, 0x48, 0x40,
-	0x12, 0x51, 0xf3, 0xa6, 0xce, 0xf6, 0xf2, 0x3c, 0x36, 0xa8, 0x80, 0x0f, 0x74, 0xb9, 0xbb, 0xd2,
-	0xc9, 0xac, 0x48, 0x0d, 0x2f, 0xc3, 0x61, 0x9f, 0xc5, 0x10, 0x0b, 0xb3, 0xdc, 0x40, 0xcd, 0xaa,
-	0x3b, 0x59, 0xcb, 0x21, 0x37, 0x48, 0xa8, 0x60, 0x89, 0x59, 0x51, 0x3e, 0xe9, 0xa5, 0xf5, 0x29,
-	0xbe, 0x33, 0x93, 0xf1, 0x74, 0x2a, 0x68, 0x3a, 0x95, 0xac, 0xef, 0x46, 0xce, 0xf7, 0xc7, 0x3f,
-	0x57, 0xf0, 0x4d, 0x19, 0x18, 0xf9, 0x0b, 0xe1, 0xb2, 0xa2, 0x26, 0xab, 0x57, 0x74, 0x34, 0xfb,
-	0x63, 0x50, 0xb3, 0x8b, 0x96, 0xab, 0x5b, 0x60, 0xf5, 0xbe, 0xf9, 0xf3, 0xdf, 0x1f, 0x8c, 0x90,
-	0xbc, 0xee, 0x5c, 0xfe, 0x13, 0xa4, 0x9f, 0x8e, 0xb2, 0xb7, 0xe0, 0x78, 0xe7, 0x09, 0x69, 0xcd,
-	0xc5, 0xf0, 0x29, 0x10, 0xf9, 0x09, 0xe1, 0x8a, 0x9e, 0xb5, 0xa4, 0xa0, 0xd4, 0x74, 0x40, 0xd5,
-	0x9c, 0xc2, 0xf5, 0xba, 0xb7, 0x87, 0xb2, 0xb7, 0x57, 0x88, 0x35, 0x5f, 0x27, 0xf9, 0xda, 0xc0,
-	0xcf, 0xe5, 0xa7, 0x11, 0x79, 0x5a, 0xec, 0xbc, 0xa9, 0x39, 0x5a, 0x5b, 0x5b, 0x14, 0xa6, 0xd5,
-	0x7e, 0x25, 0xd5, 0x8e, 0xc8, 0xdb, 0x73, 0xd5, 0xae, 0xa6, 0x63, 0x64, 0x3a, 0x92, 0x77, 0xc9,
-	0x3b, 0x0b, 0x47, 0xe2, 0x4c, 0x66, 0xe5, 0x2f, 0x06, 0xae, 0xe6, 0xb4, 0x91, 0x37, 0x16, 0x6a,
-	0x25, 0x35, 0xe0, 0xe9, 0x82, 0x28, 0xdd, 0xff, 0xaf, 0x48, 0x1a, 0xf0, 0x23, 0x22, 0x9b, 0x85,
-	0x1d, 0x98, 0xee, 0xe5, 0x28, 0xf3, 0xe9, 0x1d, 0xef, 0x7c, 0x4c, 0xb6, 0xae, 0x6f, 0x47, 0x9e,
-	0xab, 0xed, 0xfe, 0x71, 0x5a, 0x47, 0x27, 0xa7, 0x75, 0xf4, 0xcf, 0x69, 0x1d, 0x7d, 0x7f, 0x56,
-	0x5f, 0x3a, 0x39, 0xab, 0x2f, 0xfd, 0x7d, 0x56, 0x5f, 0xda, 0x79, 0x2b, 0x8c, 0xc4, 0xde, 0xc0,
-	0xb7, 0x03, 0xd6, 0x53, 0xa7, 0xad, 0xc6, 0x20, 0xbe, 0x64, 0xc9, 0xbe, 0x5e, 0x75, 0xa1, 0x13,
-	0x42, 0xe2, 0x1c, 0xce, 0x88, 0xf0, 0xcb, 0xf2, 0x5f, 0xdd, 0x93, 0xff, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0x7e, 0xe6, 0x7b, 0xac, 0xb1, 0x0a, 0x00, 0x00,
+	// 985 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xd8, 0x6d, 0xe2, 0xbc, 0x26, 0x55, 0x33, 0xe1, 0xb0, 0x35, 0xc8, 0x75, 0x2d, 0x0a,
+	0x56, 0xd5, 0xec, 0xe2, 0x96, 0x16, 0x28, 0x20, 0x14, 0x53, 0x85, 0x80, 0xf8, 0xd1, 0xae, 0xe0,
+	0x12, 0x40, 0xab, 0x59, 0xfb, 0xd5, 0x5d, 0xc5, 0xde, 0xd9, 0xee, 0x8c, 0x43, 0xad, 0xaa, 0xe2,
+	0xd7, 0x81, 0x2b, 0x12, 0x88, 0x03, 0xfc, 0x0b, 0x9c, 0xf9, 0x1b, 0x38, 0x56, 0x42, 0x42, 0x1c,
+	0x51, 0x02, 0x17, 0xfe, 0x0a, 0xb4, 0x33, 0xb3, 0xce, 0xae, 0xe3, 0xd4, 0xeb, 0xa8, 0x37, 0xcf,
+	0x9b, 0xf7, 0xbd, 0xf9, 0xde, 0xf7, 0xbd, 0x9d, 0x91, 0xe1, 0x52, 0x8c, 0x3d, 0x0c, 0x1d, 0xec,
+	0xf0, 0x4e, 0x8c, 0xdd, 0x40, 0x3a, 0x3e, 0x13, 0xbb, 0x28, 0x9d, 0xbd, 0x96, 0x73, 0x7f, 0x88,
+	0xf1, 0xc8, 0x8e, 0x62, 0x2e, 0x39, 0x3d, 0xaf, 0xd2, 0xec, 0x71, 0x9a, 0xad, 0xd3, 0xec, 0xbd,
+	0x56, 0xf5, 0xb9, 0x1e, 0xe7, 0xbd, 0x3e, 0x3a, 0x2c, 0x0a, 0x1c, 0x16, 0x86, 0x5c, 0x32, 0x19,
+	0xf0, 0x50, 0x68, 0x60, 0xf5, 0x09, 0xf5, 0x85, 0x64, 0x12, 0x4d, 0xda, 0xe5, 0x0e, 0x17, 0x03,
+	0x2e, 0x92, 0x5d, 0xd4, 0x07, 0x3b, 0x7b, 0x2d, 0x1f, 0x25, 0x6b, 0x39, 0x11, 0xeb, 0x05, 0xa1,
+	0xaa, 0x39, 0xbb, 0xa4, 0x1c, 0x45, 0x68, 0x4e, 0x6e, 0xbc, 0x02, 0xf4, 0x4e, 0x52, 0xa8, 0xad,
+	0x76, 0x5d, 0xbc, 0x3f, 0x44, 0x21, 0xe9, 0x45, 0x58, 0xd1, 0xe9, 0x5e, 0x17, 0x43, 0x3e, 0xb0,
+	0x48, 0x9d, 0x34, 0x97, 0xdd, 0x33, 0x3a, 0x76, 0x2b, 0x09, 0x35, 0x7e, 0x23, 0xb0, 0x9e, 0x43,
+	0x8a, 0x88, 0x87, 0x02, 0xe9, 0x9b, 0xb0, 0xa8, 0xd3, 0x14, 0xe8, 0xcc, 0xd5, 0x8b, 0xf6, 0xb1,
+	0xa2, 0xd8, 0x1a, 0xda, 0x2e, 0x59, 0xc4, 0x35, 0x20, 0x6a, 0xc1, 0x52, 0xa7, 0xcf, 0x84, 0x40,
+	0x61, 0x95, 0xea, 0xe5, 0xe6, 0xb2, 0x9b, 0x2e, 0xe9, 0x16, 0x98, 0xf3, 0xbd, 0x20, 0xbc, 0xcb,
+	0xad, 0xb2, 0xaa, 0x7e, 0x69, 0x66, 0xf5, 0x77, 0xc3, 0xbb, 0xdc, 0x05, 0x7f, 0xfc, 0xbb, 0xf1,
+	0x79, 0x8e, 0xb7, 0x48, 0x5b, 0xde, 0x02, 0x38, 0xd4, 0xd0, 0x70, 0x7f, 0xc1, 0xd6, 0x82, 0x27,
+	0x45, 0xd1, 0xd6, 0x4e, 0x1b, 0xc1, 0xed, 0xdb, 0xac, 0x87, 0x06, 0xeb, 0x66, 0x90, 0x8d, 0xff,
+	0x08, 0x3c, 0x93, 0xaf, 0x6f, 0x84, 0x79, 0x0b, 0x96, 0x34, 0x0b, 0x61, 0x91, 0x7a, 0xb9, 0xb8,
+	0x32, 0x29, 0x8a, 0xbe, 0x93, 0x63, 0x58, 0x52, 0x0c, 0x5f, 0x9c, 0xc9, 0x50, 0x9f, 0x9e, 0xa5,
+	0x48, 0xb7, 0x53, 0x77, 0x45, 0x2a, 0x65, 0xb9, 0xb8, 0x94, 0xc6, 0x04, 0xa1, 0xb4, 0xfc, 0x8e,
+	0x40, 0x35, 0xd3, 0x6c, 0x9b, 0xf5, 0x59, 0xd8, 0x41, 0x51, 0x7c, 0x8c, 0xe8, 0xd6, 0x94, 0xa6,
+	0x4e, 0x22, 0xfb, 0xb7, 0x25, 0x78, 0x76, 0x2a, 0x13, 0xa3, 0xfe, 0x36, 0x54, 0x7c, 0x13, 0x33,
+	0xf2, 0x37, 0x67, 0xcb, 0xaf, 0x01, 0xca, 0x85, 0x31, 0xfa, 0xe9, 0xd9, 0x70, 0x07, 0x56, 0xd3,
+	0xa2, 0x59, 0x1f, 0xae, 0x14, 0xe5, 0xa5, 0xec, 0x58, 0x49, 0x4b, 0x28, 0x3f, 0x3c, 0x38, 0x7f,
+	0x54, 0x84, 0x39, 0xdc, 0xb8, 0x90, 0x7c, 0x63, 0xb2, 0x73, 0xcf, 0x64, 0x94, 0x54, 0x06, 0xa8,
+	0x90, 0xfe, 0xea, 0x6f, 0x4c, 0xf3, 0x7b, 0x2c, 0xb2, 0x95, 0x8c, 0xb8, 0x0a, 0x99, 0xe2, 0xe9,
+	0xb2, 0xf1, 0x6b, 0x19, 0xe0, 0x70, 0x88, 0x8a, 0x50, 0xa1, 0x70, 0x2a, 0x64, 0x03, 0x34, 0x1c,
+	0xd4, 0x6f, 0x6a, 0xc3, 0x7a, 0x37, 0x10, 0xcc, 0xef, 0xa3, 0xc7, 0x86, 0x92, 0x7b, 0x31, 0xca,
+	0x20, 0x46, 0x75, 0x15, 0x54, 0xdc, 0x35, 0xb3, 0xb5, 0x39, 0x94, 0xdc, 0x55, 0x1b, 0xf4, 0x0a,
+	0x50, 0x2d, 0xa1, 0x97, 0x5c, 0x79, 0x1e, 0xf3, 0xfd, 0x18, 0xf7, 0xac, 0x53, 0xaa, 0xe2, 0x39,
+	0xbd, 0xf3, 0xf1, 0x28, 0xc2, 0x4d, 0x15, 0xa7, 0xef, 0xc3, 0x6a, 0x97, 0x49, 0xf4, 0x3a, 0x71,
+	0x20, 0x31, 0x0e, 0x98, 0x75, 0xda, 0x78, 0x7b, 0xbc, 0x1f, 0xb7, 0x98, 0xc4, 0xb7, 0x4d, 0xba,
+	0xbb, 0xd2, 0xcd, 0xac, 0x68, 0x15, 0x2a, 0xf8, 0x20, 0xe2, 0x21, 0x86, 0xd2, 0x5a, 0xac, 0x93,
+	0xe6, 0xaa, 0x3b, 0x5e, 0xab, 0x4b, 0x6e, 0x18, 0x33, 0xc9, 0x63, 0x6b, 0x49, 0xeb, 0x64, 0x96,
+	0xb4, 0x09, 0xe7, 0x24, 0xdb, 0x45, 0x2f, 0x8a, 0x71, 0x10, 0x0c, 0x07, 0x9e, 0x1f, 0x09, 0xab,
+	0xa2, 0xd0, 0x67, 0x93, 0xf8, 0x6d, 0x1d, 0x6e, 0x47, 0x82, 0x7e, 0x0a, 0x94, 0x75, 0x07, 0x81,
+	0x10, 0x01, 0x0f, 0x0f, 0x29, 0x2f, 0xd7, 0xc9, 0x8c, 0x11, 0xda, 0x4c, 0x41, 0x63, 0xde, 0x6b,
+	0x6c, 0x32, 0xd4, 0x40, 0xb8, 0x90, 0xb1, 0xd9, 0x40, 0xfc, 0xa0, 0x1f, 0xc8, 0xd1, 0xd3, 0x9c,
+	0xa6, 0xcf, 0xa0, 0x7e, 0xfc, 0x31, 0x66, 0xa6, 0x6a, 0x00, 0x86, 0x9f, 0xdf, 0xd7, 0x63, 0x55,
+	0x71, 0x33, 0x91, 0x44, 0xcb, 0x18, 0x99, 0xe0, 0xe1, 0xf8, 0xc1, 0x30, 0xcb, 0xc6, 0x87, 0xb0,
+	0x76, 0xe4, 0x7b, 0x99, 0xe4, 0x44, 0x26, 0x39, 0x65, 0x67, 0xb8, 0x94, 0x9b, 0xe1, 0xab, 0x5f,
+	0x2f, 0xc3, 0x69, 0x45, 0x97, 0xfe, 0x49, 0x60, 0x51, 0x97, 0xa6, 0x1b, 0x4f, 0x90, 0xfa, 0xe8,
+	0xc3, 0x5a, 0xb5, 0x8b, 0xa6, 0xeb, 0xee, 0x1b, 0x83, 0x6f, 0xfe, 0xf8, 0xe7, 0x87, 0x52, 0x6f,
+	0xe7, 0x1a, 0x6d, 0x39, 0xc7, 0x3f, 0xe8, 0xfa, 0x97, 0x70, 0x1e, 0x66, 0x1d, 0x79, 0x44, 0x5f,
+	0x9a, 0x09, 0x99, 0x44, 0xfc, 0x44, 0x60, 0xc9, 0xbc, 0x5b, 0xb4, 0x20, 0xd5, 0xf4, 0xb2, 0xaf,
+	0x3a, 0x85, 0xf3, 0x4d, 0x6f, 0x97, 0x55, 0x6f, 0xcf, 0xd3, 0xc6, 0xec, 0xce, 0xe8, 0x57, 0x25,
+	0x38, 0x9b, 0xbf, 0xd9, 0xe9, 0xf5, 0x62, 0xe7, 0x4d, 0xbc, 0x49, 0xd5, 0x1b, 0xf3, 0xc2, 0x0c,
+	0xdb, 0x2f, 0x15, 0xdb, 0xd1, 0xce, 0x1b, 0xf4, 0xe6, 0xdc, 0x4e, 0x38, 0xe3, 0xc7, 0xe3, 0xb5,
+	0x99, 0xd8, 0x8d, 0x34, 0x77, 0xd2, 0x9b, 0x9f, 0x4b, 0xb0, 0x9a, 0xe3, 0x46, 0x5f, 0x9e, 0xab,
+	0x95, 0x54, 0x80, 0xeb, 0x73, 0xa2, 0x4c, 0xff, 0xbf, 0x10, 0x25, 0xc0, 0x8f, 0x64, 0xe7, 0x3d,
+	0xba, 0x7d, 0x72, 0x05, 0x9c, 0x87, 0x99, 0xcf, 0xef, 0x11, 0xdd, 0x2a, 0xac, 0xc7, 0x64, 0xc1,
+	0x7c, 0x9d, 0x7f, 0x09, 0xac, 0x4f, 0xb9, 0x45, 0xe8, 0xcd, 0x62, 0xcd, 0x4e, 0xbb, 0xe1, 0xaa,
+	0xaf, 0x9f, 0x08, 0x6b, 0xe4, 0xfa, 0x44, 0xa9, 0xf5, 0x11, 0xfd, 0x60, 0x7e, 0xa9, 0x58, 0xb6,
+	0x60, 0xbe, 0xcf, 0xb6, 0xfb, 0xfb, 0x7e, 0x8d, 0x3c, 0xde, 0xaf, 0x91, 0xbf, 0xf7, 0x6b, 0xe4,
+	0xfb, 0x83, 0xda, 0xc2, 0xe3, 0x83, 0xda, 0xc2, 0x5f, 0x07, 0xb5, 0x85, 0x9d, 0x57, 0x7b, 0x81,
+	0xbc, 0x37, 0xf4, 0xed, 0x0e, 0x1f, 0xe8, 0x23, 0x37, 0x42, 0x94, 0x5f, 0xf0, 0x78, 0xd7, 0xac,
+	0xfa, 0xd8, 0xed, 0x61, 0xec, 0x3c, 0x38, 0xc2, 0xc4, 0x5f, 0x54, 0xff, 0x04, 0xae, 0xfd, 0x3f,
+	0x00, 0x3d, 0xf6, 0x77, 0xb1, 0xe5, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -745,6 +890,10 @@ type QueryClient interface {
 	BasketBalances(ctx context.Context, in *QueryBasketBalancesRequest, opts ...grpc.CallOption) (*QueryBasketBalancesResponse, error)
 	// BasketBalance queries the balance of a specific credit batch in the basket.
 	BasketBalance(ctx context.Context, in *QueryBasketBalanceRequest, opts ...grpc.CallOption) (*QueryBasketBalanceResponse, error)
+	// BasketAdmissibility queries whether credits from a credit batch can be put
+	// into the basket and the reasons why they cannot if they are not
+	// admissible.
+	BasketAdmissibility(ctx context.Context, in *QueryBasketAdmissibilityRequest, opts ...grpc.CallOption) (*QueryBasketAdmissibilityResponse, error)
 }
 
 type queryClient struct {
@@ -791,6 +940,15 @@ func (c *queryClient) BasketBalance(ctx context.Context, in *QueryBasketBalanceR
 	return out, nil
 }
 
+func (c *queryClient) BasketAdmissibility(ctx context.Context, in *QueryBasketAdmissibilityRequest, opts ...grpc.CallOption) (*QueryBasketAdmissibilityResponse, error) {
+	out := new(QueryBasketAdmissibilityResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.basket.v1.Query/BasketAdmissibility", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Basket queries one basket by denom.
@@ -801,6 +959,10 @@ type QueryServer interface {
 	BasketBalances(context.Context, *QueryBasketBalancesRequest) (*QueryBasketBalancesResponse, error)
 	// BasketBalance queries the balance of a specific credit batch in the basket.
 	BasketBalance(context.Context, *QueryBasketBalanceRequest) (*QueryBasketBalanceResponse, error)
+	// BasketAdmissibility queries whether credits from a credit batch can be put
+	// into the basket and the reasons why they cannot if they are not
+	// admissible.
+	BasketAdmissibility(context.Context, *QueryBasketAdmissibilityRequest) (*QueryBasketAdmissibilityResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -819,6 +981,9 @@ func (*UnimplementedQueryServer) BasketBalances(ctx context.Context, req *QueryB
 func (*UnimplementedQueryServer) BasketBalance(ctx context.Context, req *QueryBasketBalanceRequest) (*QueryBasketBalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BasketBalance not implemented")
 }
+func (*UnimplementedQueryServer) BasketAdmissibility(ctx context.Context, req *QueryBasketAdmissibilityRequest) (*QueryBasketAdmissibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BasketAdmissibility not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -896,6 +1061,24 @@ func _Query_BasketBalance_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BasketAdmissibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBasketAdmissibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BasketAdmissibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.basket.v1.Query/BasketAdmissibility",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BasketAdmissibility(ctx, req.(*QueryBasketAdmissibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "regen.ecocredit.basket.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -916,6 +1099,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BasketBalance",
 			Handler:    _Query_BasketBalance_Handler,
 		},
+		{
+			MethodName: "BasketAdmissibility",
+			Handler:    _Query_BasketAdmissibility_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "regen/ecocredit/basket/v1/query.proto",
@@ -1297,6 +1484,23 @@ func (m *BasketInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AdmissionCriteria != nil {
+		{
+			size, err := m.AdmissionCriteria.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.TakePremiumBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TakePremiumBps))
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.Curator) > 0 {
 		i -= len(m.Curator)
 		copy(dAtA[i:], m.Curator)
@@ -1355,6 +1559,85 @@ func (m *BasketInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBasketAdmissibilityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBasketAdmissibilityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBasketAdmissibilityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BatchDenom) > 0 {
+		i -= len(m.BatchDenom)
+		copy(dAtA[i:], m.BatchDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BatchDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BasketDenom) > 0 {
+		i -= len(m.BasketDenom)
+		copy(dAtA[i:], m.BasketDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BasketDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBasketAdmissibilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBasketAdmissibilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBasketAdmissibilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reasons) > 0 {
+		for iNdEx := len(m.Reasons) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Reasons[iNdEx])
+			copy(dAtA[i:], m.Reasons[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Reasons[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Admissible {
+		i--
+		if m.Admissible {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *BasketBalanceInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1581,6 +1864,48 @@ func (m *BasketInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.TakePremiumBps != 0 {
+		n += 1 + sovQuery(uint64(m.TakePremiumBps))
+	}
+	if m.AdmissionCriteria != nil {
+		l = m.AdmissionCriteria.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBasketAdmissibilityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BasketDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BatchDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBasketAdmissibilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Admissible {
+		n += 2
+	}
+	if len(m.Reasons) > 0 {
+		for _, s := range m.Reasons {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2783,6 +3108,277 @@ func (m *BasketInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.Curator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakePremiumBps", wireType)
+			}
+			m.TakePremiumBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TakePremiumBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdmissionCriteria", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AdmissionCriteria == nil {
+				m.AdmissionCriteria = &AdmissionCriteria{}
+			}
+			if err := m.AdmissionCriteria.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBasketAdmissibilityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBasketAdmissibilityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBasketAdmissibilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BasketDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBasketAdmissibilityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBasketAdmissibilityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBasketAdmissibilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admissible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Admissible = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reasons", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reasons = append(m.Reasons, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -59,16 +59,23 @@ type Basket struct {
 	CreditTypeAbbrev string `protobuf:"bytes,5,opt,name=credit_type_abbrev,json=creditTypeAbbrev,proto3" json:"credit_type_abbrev,omitempty"`
 	// date_criteria is the date criteria for batches admitted to the basket.
 	DateCriteria *DateCriteria `protobuf:"bytes,6,opt,name=date_criteria,json=dateCriteria,proto3" json:"date_criteria,omitempty"`
-	// Deprecated (Since Revision 1): This field is no longer used and will be
-	// removed in the next version. The value of credit type precision is always
-	// used as the exponent when converting credits to/from basket tokens. This
-	// field will be set to the value of credit type precision until removed.
-	Exponent uint32 `protobuf:"varint,7,opt,name=exponent,proto3" json:"exponent,omitempty"` // Deprecated: Do not use.
+	// exponent is the exponent used when converting credits to/from basket
+	// tokens. The exponent is set to the precision of the credit type when the
+	// basket is created and remains fixed when the precision of the credit type
+	// is raised so that existing basket tokens keep their value.
+	Exponent uint32 `protobuf:"varint,7,opt,name=exponent,proto3" json:"exponent,omitempty"`
 	// curator is the address of the basket curator who is able to change certain
 	// basket settings.
 	//
 	// Since Revision 1
 	Curator []byte `protobuf:"bytes,8,opt,name=curator,proto3" json:"curator,omitempty"`
+	// take_premium_bps is the premium in basis points of the basket tokens being
+	// converted that is paid to the curator when credits are taken from the
+	// basket with a strategy other than oldest first.
+	TakePremiumBps uint32 `protobuf:"varint,9,opt,name=take_premium_bps,json=takePremiumBps,proto3" json:"take_premium_bps,omitempty"`
+	// admission_criteria is the additional criteria for batches admitted to the
+	// basket.
+	AdmissionCriteria *AdmissionCriteria `protobuf:"bytes,10,opt,name=admission_criteria,json=admissionCriteria,proto3" json:"admission_criteria,omitempty"`
 }
 
 func (m *Basket) Reset()         { *m = Basket{} }
@@ -146,7 +153,6 @@ func (m *Basket) GetDateCriteria() *DateCriteria {
 	return nil
 }
 
-// Deprecated: Do not use.
 func (m *Basket) GetExponent() uint32 {
 	if m != nil {
 		return m.Exponent
@@ -161,6 +167,20 @@ func (m *Basket) GetCurator() []byte {
 	return nil
 }
 
+func (m *Basket) GetTakePremiumBps() uint32 {
+	if m != nil {
+		return m.TakePremiumBps
+	}
+	return 0
+}
+
+func (m *Basket) GetAdmissionCriteria() *AdmissionCriteria {
+	if m != nil {
+		return m.AdmissionCriteria
+	}
+	return nil
+}
+
 // BasketClass describes a credit class that can be deposited in a basket.
 type BasketClass struct {
 	// basket_id is the ID of the basket
@@ -302,43 +322,46 @@ func init() {
 }
 
 var fileDescriptor_c416a19075224f85 = []byte{
-	// 567 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xed, 0xba, 0x25, 0x49, 0x27, 0x6d, 0x65, 0x16, 0x10, 0xdb, 0x20, 0x5c, 0x53, 0x09, 0x61,
-	0xa1, 0x62, 0x93, 0x72, 0x41, 0xe5, 0xd4, 0xb4, 0x97, 0x4a, 0x9c, 0x4c, 0x4f, 0x5c, 0xac, 0xb5,
-	0x3d, 0xa4, 0x56, 0x6d, 0xaf, 0xb5, 0xde, 0x84, 0xf6, 0x27, 0x10, 0x5f, 0xc0, 0xf7, 0x70, 0xac,
-	0xc4, 0x85, 0x23, 0x6a, 0x0f, 0x5c, 0x11, 0x5f, 0x80, 0xbc, 0xeb, 0x24, 0x2d, 0x88, 0xde, 0xf2,
-	0xe6, 0xbd, 0xe7, 0x99, 0x7d, 0x33, 0x81, 0xa7, 0x12, 0xc7, 0x58, 0x06, 0x98, 0x88, 0x44, 0x62,
-	0x9a, 0xa9, 0x20, 0xe6, 0xf5, 0x29, 0xaa, 0x60, 0x3a, 0x0c, 0x6a, 0xc5, 0x15, 0xfa, 0x95, 0x14,
-	0x4a, 0xd0, 0x4d, 0x2d, 0xf3, 0xe7, 0x32, 0xdf, 0xc8, 0xfc, 0xe9, 0x70, 0xf0, 0x38, 0x11, 0x75,
-	0x21, 0xea, 0x40, 0xc8, 0x22, 0x98, 0x0e, 0x79, 0x5e, 0x9d, 0xf0, 0x61, 0x03, 0x8c, 0x73, 0xb0,
-	0x35, 0x16, 0x62, 0x9c, 0x63, 0xa0, 0x51, 0x3c, 0xf9, 0x10, 0xa8, 0xac, 0xc0, 0x5a, 0xf1, 0xa2,
-	0x6a, 0x05, 0xb7, 0x4c, 0xa0, 0xce, 0x2b, 0xac, 0x8d, 0x6c, 0xfb, 0xa7, 0x05, 0x9d, 0x91, 0x66,
-	0xe8, 0x06, 0x58, 0x59, 0xca, 0x88, 0x4b, 0xbc, 0x95, 0xd0, 0xca, 0x52, 0xfa, 0x04, 0xd6, 0x8c,
-	0x27, 0x4a, 0xb1, 0x14, 0x05, 0xb3, 0x5c, 0xe2, 0xad, 0x86, 0x7d, 0x53, 0x3b, 0x6c, 0x4a, 0x94,
-	0xc2, 0x4a, 0xc9, 0x0b, 0x64, 0xcb, 0x9a, 0xd2, 0xbf, 0xa9, 0x0f, 0xf7, 0xd2, 0xac, 0xe6, 0x71,
-	0x8e, 0x11, 0x9f, 0x28, 0x11, 0x49, 0x54, 0x99, 0x44, 0xb6, 0xe2, 0x12, 0xaf, 0x17, 0xde, 0x6d,
-	0xa9, 0xfd, 0x89, 0x12, 0xa1, 0x26, 0xe8, 0x0e, 0x50, 0x33, 0x61, 0xd4, 0xcc, 0x15, 0xf1, 0x38,
-	0x96, 0x38, 0x65, 0x77, 0xf4, 0x17, 0x6d, 0xc3, 0x1c, 0x9f, 0x57, 0xb8, 0xaf, 0xeb, 0xf4, 0x2d,
-	0xac, 0xa7, 0x5c, 0x61, 0x94, 0xc8, 0x4c, 0xa1, 0xcc, 0x38, 0xeb, 0xb8, 0xc4, 0xeb, 0xef, 0x3e,
-	0xf3, 0xff, 0x9b, 0xa4, 0x7f, 0xc8, 0x15, 0x1e, 0xb4, 0xf2, 0x70, 0x2d, 0xbd, 0x86, 0xa8, 0x03,
-	0x3d, 0x3c, 0xab, 0x44, 0x89, 0xa5, 0x62, 0x5d, 0x97, 0x78, 0xeb, 0x23, 0x8b, 0x91, 0x70, 0x5e,
-	0xa3, 0x0c, 0xba, 0xc9, 0x44, 0x72, 0x25, 0x24, 0xeb, 0xb9, 0xc4, 0x5b, 0x0b, 0x67, 0x70, 0xef,
-	0xe5, 0xef, 0x2f, 0xdf, 0x3e, 0x2d, 0x3f, 0x87, 0x4e, 0x13, 0x9a, 0x4d, 0x28, 0xbd, 0x19, 0x96,
-	0x4d, 0x18, 0xa1, 0x60, 0xd2, 0xb1, 0x2d, 0x46, 0x18, 0xd9, 0x46, 0xe8, 0x9b, 0xa0, 0x0f, 0x72,
-	0x5e, 0xd7, 0xf4, 0x11, 0xac, 0xb6, 0x86, 0x79, 0xe8, 0x3d, 0x53, 0x38, 0x4a, 0xe9, 0x26, 0xf4,
-	0x92, 0x46, 0xd5, 0x70, 0x26, 0xf6, 0xae, 0xc6, 0x47, 0xe9, 0x9e, 0xa3, 0x1b, 0x33, 0xb8, 0x0f,
-	0x74, 0xee, 0xdf, 0x59, 0x88, 0xb7, 0x7f, 0x11, 0x58, 0x37, 0x7d, 0x46, 0x3c, 0xe7, 0x65, 0x82,
-	0xb7, 0x77, 0xda, 0x82, 0x7e, 0xcc, 0x55, 0x72, 0x72, 0x63, 0xc7, 0xa0, 0x4b, 0x66, 0xc5, 0x0c,
-	0xba, 0xb1, 0xf9, 0x50, 0xbb, 0xe5, 0x19, 0xa4, 0x87, 0x60, 0x1b, 0x6b, 0xad, 0xb8, 0x54, 0x51,
-	0x13, 0xac, 0xde, 0x72, 0x7f, 0x77, 0xe0, 0x9b, 0xeb, 0xf4, 0x67, 0xd7, 0xe9, 0x1f, 0xcf, 0xae,
-	0x33, 0xdc, 0xd0, 0x9e, 0x77, 0x8d, 0xa5, 0x59, 0xcc, 0xde, 0xbe, 0x7e, 0xcf, 0x1b, 0x78, 0x08,
-	0x0f, 0x16, 0xef, 0xb9, 0x36, 0x12, 0x75, 0x60, 0xf0, 0x37, 0xb1, 0x68, 0x68, 0x13, 0xb6, 0x3c,
-	0x0a, 0xbf, 0x5e, 0x3a, 0xe4, 0xe2, 0xd2, 0x21, 0x3f, 0x2e, 0x1d, 0xf2, 0xf9, 0xca, 0x59, 0xba,
-	0xb8, 0x72, 0x96, 0xbe, 0x5f, 0x39, 0x4b, 0xef, 0x5f, 0x8f, 0x33, 0x75, 0x32, 0x89, 0xfd, 0x44,
-	0x14, 0x81, 0x3e, 0x90, 0x17, 0x25, 0xaa, 0x8f, 0x42, 0x9e, 0xb6, 0x28, 0xc7, 0x74, 0x8c, 0x32,
-	0x38, 0xfb, 0xe7, 0x6f, 0x12, 0x77, 0xf4, 0xe8, 0xaf, 0xfe, 0x04, 0x00, 0x00, 0xff, 0xff, 0x51,
-	0xb2, 0x58, 0xb2, 0xc9, 0x03, 0x00, 0x00,
+	// 613 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x18, 0xec, 0x26, 0x25, 0x49, 0xbf, 0xb4, 0x95, 0xbb, 0x80, 0xd8, 0x06, 0xe1, 0x86, 0x4a, 0x08,
+	0x0b, 0x15, 0x9b, 0x94, 0x0b, 0x2a, 0xa7, 0xa4, 0xbd, 0x54, 0xe2, 0x80, 0x4c, 0x4f, 0x70, 0xb0,
+	0xd6, 0xf6, 0x47, 0x6a, 0x35, 0xf6, 0x5a, 0xbb, 0x9b, 0xd0, 0xbe, 0x04, 0xe2, 0x09, 0x78, 0x1e,
+	0x8e, 0x95, 0xb8, 0x70, 0x44, 0xed, 0x8d, 0x13, 0xe2, 0x09, 0x90, 0x77, 0xf3, 0xd3, 0x16, 0xd1,
+	0x5b, 0x66, 0xbe, 0x19, 0xef, 0x6c, 0xe6, 0x5b, 0x78, 0x22, 0x71, 0x88, 0x45, 0x80, 0x89, 0x48,
+	0x24, 0xa6, 0x99, 0x0e, 0x62, 0xae, 0x4e, 0x50, 0x07, 0x93, 0x5e, 0xa0, 0x34, 0xd7, 0xe8, 0x97,
+	0x52, 0x68, 0x41, 0x37, 0x8d, 0xcc, 0x9f, 0xcb, 0x7c, 0x2b, 0xf3, 0x27, 0xbd, 0xce, 0xa3, 0x44,
+	0xa8, 0x5c, 0xa8, 0x40, 0xc8, 0x3c, 0x98, 0xf4, 0xf8, 0xa8, 0x3c, 0xe6, 0xbd, 0x0a, 0x58, 0x67,
+	0x67, 0x6b, 0x28, 0xc4, 0x70, 0x84, 0x81, 0x41, 0xf1, 0xf8, 0x63, 0xa0, 0xb3, 0x1c, 0x95, 0xe6,
+	0x79, 0x39, 0x15, 0xdc, 0x92, 0x40, 0x9f, 0x95, 0xa8, 0xac, 0x6c, 0xfb, 0x57, 0x1d, 0x1a, 0x03,
+	0x33, 0xa1, 0xeb, 0x50, 0xcb, 0x52, 0x46, 0xba, 0xc4, 0x5b, 0x0e, 0x6b, 0x59, 0x4a, 0x1f, 0xc3,
+	0xaa, 0xf5, 0x44, 0x29, 0x16, 0x22, 0x67, 0xb5, 0x2e, 0xf1, 0x56, 0xc2, 0xb6, 0xe5, 0x0e, 0x2a,
+	0x8a, 0x52, 0x58, 0x2e, 0x78, 0x8e, 0xac, 0x6e, 0x46, 0xe6, 0x37, 0xf5, 0xe1, 0x6e, 0x9a, 0x29,
+	0x1e, 0x8f, 0x30, 0xe2, 0x63, 0x2d, 0x22, 0x89, 0x3a, 0x93, 0xc8, 0x96, 0xbb, 0xc4, 0x6b, 0x85,
+	0x1b, 0xd3, 0x51, 0x7f, 0xac, 0x45, 0x68, 0x06, 0x74, 0x07, 0xa8, 0x4d, 0x18, 0x55, 0xb9, 0x22,
+	0x1e, 0xc7, 0x12, 0x27, 0xec, 0x8e, 0xf9, 0xa2, 0x63, 0x27, 0x47, 0x67, 0x25, 0xf6, 0x0d, 0x4f,
+	0xdf, 0xc0, 0x5a, 0xca, 0x35, 0x46, 0x89, 0xcc, 0x34, 0xca, 0x8c, 0xb3, 0x46, 0x97, 0x78, 0xed,
+	0xdd, 0xa7, 0xfe, 0x7f, 0xff, 0x49, 0xff, 0x80, 0x6b, 0xdc, 0x9f, 0xca, 0xc3, 0xd5, 0xf4, 0x0a,
+	0xa2, 0x1d, 0x68, 0xe1, 0x69, 0x29, 0x0a, 0x2c, 0x34, 0x6b, 0x76, 0x89, 0xb7, 0x16, 0xce, 0x31,
+	0x65, 0xd0, 0x4c, 0xc6, 0x92, 0x6b, 0x21, 0x59, 0xab, 0x4b, 0xbc, 0xd5, 0x70, 0x06, 0xa9, 0x07,
+	0x8e, 0xe6, 0x27, 0x18, 0x95, 0x12, 0xf3, 0x6c, 0x9c, 0x47, 0x71, 0xa9, 0xd8, 0x8a, 0x71, 0xaf,
+	0x57, 0xfc, 0x5b, 0x4b, 0x0f, 0x4a, 0x45, 0x3f, 0x00, 0xe5, 0x69, 0x9e, 0x29, 0x95, 0x89, 0x62,
+	0x11, 0x19, 0x4c, 0xe4, 0x9d, 0x5b, 0x22, 0xf7, 0x67, 0xa6, 0x79, 0xee, 0x0d, 0x7e, 0x93, 0xda,
+	0x7b, 0xf1, 0xe7, 0xeb, 0xf7, 0xcf, 0xf5, 0x67, 0xd0, 0xa8, 0x7a, 0x73, 0x08, 0xa5, 0xd7, 0xfb,
+	0x72, 0x08, 0x23, 0x14, 0x6c, 0x41, 0x4e, 0x8d, 0x11, 0x46, 0xb6, 0x11, 0xda, 0xb6, 0xeb, 0xfd,
+	0x11, 0x57, 0x8a, 0x3e, 0x84, 0x95, 0xa9, 0x61, 0xde, 0x7b, 0xcb, 0x12, 0x87, 0x29, 0xdd, 0x84,
+	0x56, 0x52, 0xa9, 0xaa, 0x99, 0x6d, 0xbe, 0x69, 0xf0, 0x61, 0xba, 0xe7, 0x9a, 0x83, 0x19, 0xdc,
+	0x03, 0x3a, 0xf7, 0xef, 0x2c, 0xc4, 0xdb, 0xbf, 0x09, 0xac, 0xd9, 0x73, 0x06, 0x7c, 0xc4, 0x8b,
+	0x04, 0x6f, 0x3f, 0x69, 0x0b, 0xda, 0x31, 0xd7, 0xc9, 0xf1, 0xb5, 0x35, 0x03, 0x43, 0xd9, 0x2d,
+	0x63, 0xd0, 0x8c, 0xed, 0x87, 0xa6, 0x8b, 0x36, 0x83, 0xf4, 0x00, 0x1c, 0x6b, 0x55, 0x9a, 0x4b,
+	0x1d, 0x55, 0xdd, 0x9a, 0x45, 0x6b, 0xef, 0x76, 0x7c, 0xfb, 0x40, 0xfc, 0xd9, 0x03, 0xf1, 0x8f,
+	0x66, 0x0f, 0x24, 0x5c, 0x37, 0x9e, 0x77, 0x95, 0xa5, 0xda, 0x8d, 0xbd, 0xbe, 0xb9, 0xcf, 0x6b,
+	0x78, 0x00, 0xf7, 0x17, 0xf7, 0xb9, 0x12, 0x89, 0xba, 0xd0, 0xb9, 0x39, 0x58, 0x1c, 0xe8, 0x10,
+	0x56, 0x1f, 0x84, 0xdf, 0x2e, 0x5c, 0x72, 0x7e, 0xe1, 0x92, 0x9f, 0x17, 0x2e, 0xf9, 0x72, 0xe9,
+	0x2e, 0x9d, 0x5f, 0xba, 0x4b, 0x3f, 0x2e, 0xdd, 0xa5, 0xf7, 0xaf, 0x86, 0x99, 0x3e, 0x1e, 0xc7,
+	0x7e, 0x22, 0xf2, 0xc0, 0x14, 0xfe, 0xbc, 0x40, 0xfd, 0x49, 0xc8, 0x93, 0x29, 0x1a, 0x61, 0x3a,
+	0x44, 0x19, 0x9c, 0xfe, 0xf3, 0x52, 0xe3, 0x86, 0x89, 0xfe, 0xf2, 0xef, 0x00, 0x50, 0xa6, 0x7c,
+	0x15, 0x4c, 0x04, 0x00, 0x00,
 }
 
 func (m *Basket) Marshal() (dAtA []byte, err error) {
@@ -361,6 +384,23 @@ func (m *Basket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AdmissionCriteria != nil {
+		{
+			size, err := m.AdmissionCriteria.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.TakePremiumBps != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.TakePremiumBps))
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.Curator) > 0 {
 		i -= len(m.Curator)
 		copy(dAtA[i:], m.Curator)
@@ -559,6 +599,13 @@ func (m *Basket) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovState(uint64(l))
 	}
+	if m.TakePremiumBps != 0 {
+		n += 1 + sovState(uint64(m.TakePremiumBps))
+	}
+	if m.AdmissionCriteria != nil {
+		l = m.AdmissionCriteria.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
 	return n
 }
 
@@ -861,6 +908,61 @@ func (m *Basket) Unmarshal(dAtA []byte) error {
 				m.Curator = []byte{}
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakePremiumBps", wireType)
+			}
+			m.TakePremiumBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TakePremiumBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdmissionCriteria", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AdmissionCriteria == nil {
+				m.AdmissionCriteria = &AdmissionCriteria{}
+			}
+			if err := m.AdmissionCriteria.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
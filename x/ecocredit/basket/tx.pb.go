@@ -77,6 +77,9 @@ type MsgCreate struct {
 	// This field will be updated to a single fee rather than a list of fees in
 	// the next version to reflect these requirements.
 	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	// admission_criteria is the optional additional criteria for batches
+	// admitted to the basket.
+	AdmissionCriteria *AdmissionCriteria `protobuf:"bytes,10,opt,name=admission_criteria,json=admissionCriteria,proto3" json:"admission_criteria,omitempty"`
 }
 
 func (m *MsgCreate) Reset()         { *m = MsgCreate{} }
@@ -176,6 +179,13 @@ func (m *MsgCreate) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *MsgCreate) GetAdmissionCriteria() *AdmissionCriteria {
+	if m != nil {
+		return m.AdmissionCriteria
+	}
+	return nil
+}
+
 // MsgCreateBasketResponse is the Msg/CreateBasket response type.
 type MsgCreateResponse struct {
 	// basket_denom is the unique denomination ID of the newly created basket.
@@ -359,6 +369,30 @@ type MsgTake struct {
 	//
 	// Since Revision 1
 	RetirementJurisdiction string `protobuf:"bytes,6,opt,name=retirement_jurisdiction,json=retirementJurisdiction,proto3" json:"retirement_jurisdiction,omitempty"`
+	// beneficiary_address is the optional address of the account on whose
+	// behalf the credits are retired, which will be used only if retire_on_take
+	// is true. If set, the retired credits are added to the retired balance of
+	// the beneficiary rather than the owner.
+	BeneficiaryAddress string `protobuf:"bytes,7,opt,name=beneficiary_address,json=beneficiaryAddress,proto3" json:"beneficiary_address,omitempty"`
+	// beneficiary_name is the optional name of the individual or organization
+	// on whose behalf the credits are retired, which will be used only if
+	// retire_on_take is true.
+	BeneficiaryName string `protobuf:"bytes,8,opt,name=beneficiary_name,json=beneficiaryName,proto3" json:"beneficiary_name,omitempty"`
+	// reason is the optional reason for retiring the credits, which will be used
+	// only if retire_on_take is true.
+	Reason string `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	// strategy is the strategy used to select the credits taken from the basket.
+	// If unspecified, the oldest credits are taken first. Strategies other than
+	// oldest first are charged the take premium of the basket.
+	Strategy TakeStrategy `protobuf:"varint,10,opt,name=strategy,proto3,enum=regen.ecocredit.basket.v1.TakeStrategy" json:"strategy,omitempty"`
+	// batch_denoms are the batches to take credits from in the order they are
+	// listed, which must be set if and only if strategy is
+	// TAKE_STRATEGY_SELECTED_BATCHES.
+	BatchDenoms []string `protobuf:"bytes,11,rep,name=batch_denoms,json=batchDenoms,proto3" json:"batch_denoms,omitempty"`
+	// max_premium is the optional maximum integer number of basket tokens the
+	// owner is willing to pay as the take premium of the basket. If set and the
+	// premium exceeds the maximum, the take fails.
+	MaxPremium string `protobuf:"bytes,12,opt,name=max_premium,json=maxPremium,proto3" json:"max_premium,omitempty"`
 }
 
 func (m *MsgTake) Reset()         { *m = MsgTake{} }
@@ -437,10 +471,55 @@ func (m *MsgTake) GetRetirementJurisdiction() string {
 	return ""
 }
 
+func (m *MsgTake) GetBeneficiaryAddress() string {
+	if m != nil {
+		return m.BeneficiaryAddress
+	}
+	return ""
+}
+
+func (m *MsgTake) GetBeneficiaryName() string {
+	if m != nil {
+		return m.BeneficiaryName
+	}
+	return ""
+}
+
+func (m *MsgTake) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *MsgTake) GetStrategy() TakeStrategy {
+	if m != nil {
+		return m.Strategy
+	}
+	return TakeStrategy_TAKE_STRATEGY_UNSPECIFIED
+}
+
+func (m *MsgTake) GetBatchDenoms() []string {
+	if m != nil {
+		return m.BatchDenoms
+	}
+	return nil
+}
+
+func (m *MsgTake) GetMaxPremium() string {
+	if m != nil {
+		return m.MaxPremium
+	}
+	return ""
+}
+
 // MsgTakeFromBasketResponse is the Msg/TakeFromBasket response type.
 type MsgTakeResponse struct {
 	// credits are the credits taken out of the basket.
 	Credits []*BasketCredit `protobuf:"bytes,1,rep,name=credits,proto3" json:"credits,omitempty"`
+	// premium is the integer number of basket tokens paid to the basket curator
+	// as the take premium. Empty if no premium was charged.
+	Premium string `protobuf:"bytes,2,opt,name=premium,proto3" json:"premium,omitempty"`
 }
 
 func (m *MsgTakeResponse) Reset()         { *m = MsgTakeResponse{} }
@@ -483,706 +562,4346 @@ func (m *MsgTakeResponse) GetCredits() []*BasketCredit {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*MsgCreate)(nil), "regen.ecocredit.basket.v1.MsgCreate")
-	proto.RegisterType((*MsgCreateResponse)(nil), "regen.ecocredit.basket.v1.MsgCreateResponse")
-	proto.RegisterType((*MsgPut)(nil), "regen.ecocredit.basket.v1.MsgPut")
-	proto.RegisterType((*MsgPutResponse)(nil), "regen.ecocredit.basket.v1.MsgPutResponse")
-	proto.RegisterType((*MsgTake)(nil), "regen.ecocredit.basket.v1.MsgTake")
-	proto.RegisterType((*MsgTakeResponse)(nil), "regen.ecocredit.basket.v1.MsgTakeResponse")
+func (m *MsgTakeResponse) GetPremium() string {
+	if m != nil {
+		return m.Premium
+	}
+	return ""
 }
 
-func init() {
-	proto.RegisterFile("regen/ecocredit/basket/v1/tx.proto", fileDescriptor_a60f962a3c61f018)
+// MsgUpdateBasketCurator is the Msg/UpdateBasketCurator request type.
+type MsgUpdateBasketCurator struct {
+	// curator is the address of the current basket curator.
+	Curator string `protobuf:"bytes,1,opt,name=curator,proto3" json:"curator,omitempty"`
+	// basket_denom is the basket bank denom of the basket to update.
+	BasketDenom string `protobuf:"bytes,2,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// new_curator is the address of the new basket curator.
+	NewCurator string `protobuf:"bytes,3,opt,name=new_curator,json=newCurator,proto3" json:"new_curator,omitempty"`
 }
 
-var fileDescriptor_a60f962a3c61f018 = []byte{
-	// 714 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x4f, 0xeb, 0x46,
-	0x14, 0x8d, 0x63, 0x48, 0xc8, 0x04, 0x42, 0x19, 0x10, 0x35, 0x59, 0x98, 0x60, 0x51, 0x91, 0x56,
-	0x60, 0x37, 0x20, 0xf5, 0x63, 0x49, 0xc2, 0xaa, 0x22, 0x2a, 0x72, 0x51, 0x17, 0x55, 0x2b, 0x6b,
-	0x62, 0xdf, 0xba, 0x6e, 0x12, 0x4f, 0x34, 0x33, 0x0e, 0xb0, 0xef, 0x0f, 0xe0, 0x77, 0x74, 0xf3,
-	0xfe, 0x06, 0x4b, 0x96, 0x6f, 0xf5, 0xde, 0x13, 0xec, 0xdf, 0x6f, 0x78, 0xf2, 0xcc, 0xc4, 0x44,
-	0x42, 0x2f, 0x3c, 0xb1, 0x8a, 0xe7, 0x9c, 0x73, 0x4f, 0xee, 0x9d, 0x73, 0x6d, 0xe4, 0x30, 0x88,
-	0x21, 0xf5, 0x20, 0xa4, 0x21, 0x83, 0x28, 0x11, 0xde, 0x80, 0xf0, 0x21, 0x08, 0x6f, 0xda, 0xf1,
-	0xc4, 0xb5, 0x3b, 0x61, 0x54, 0x50, 0xbc, 0x23, 0x35, 0x6e, 0xa1, 0x71, 0x95, 0xc6, 0x9d, 0x76,
-	0x9a, 0x5b, 0x31, 0x8d, 0xa9, 0x54, 0x79, 0xf9, 0x93, 0x2a, 0x68, 0x7e, 0xb3, 0xc0, 0xf4, 0x66,
-	0x02, 0x5c, 0xcb, 0xec, 0x90, 0xf2, 0x31, 0xe5, 0x39, 0x0b, 0xde, 0xb4, 0x33, 0x00, 0x41, 0x3a,
-	0x5e, 0x48, 0x93, 0x54, 0xf1, 0xce, 0x1b, 0x13, 0xd5, 0xfa, 0x3c, 0xee, 0x31, 0x20, 0x02, 0xb0,
-	0x85, 0xaa, 0x61, 0xc6, 0x88, 0xa0, 0xcc, 0x32, 0x5a, 0x46, 0xbb, 0xe6, 0xcf, 0x8e, 0x18, 0xa3,
-	0xa5, 0x94, 0x8c, 0xc1, 0x2a, 0x4b, 0x58, 0x3e, 0xe3, 0x16, 0xaa, 0x47, 0xc0, 0x43, 0x96, 0x4c,
-	0x44, 0x42, 0x53, 0xcb, 0x94, 0xd4, 0x3c, 0x84, 0x6d, 0xb4, 0x02, 0xd7, 0x13, 0x9a, 0x42, 0x2a,
-	0xac, 0xa5, 0x96, 0xd1, 0x5e, 0xeb, 0x96, 0x2d, 0xc3, 0x2f, 0x30, 0xec, 0xa2, 0xcd, 0x28, 0xe1,
-	0x64, 0x30, 0x82, 0x80, 0x64, 0x82, 0x06, 0x0c, 0x44, 0xc2, 0xc0, 0x5a, 0x6e, 0x19, 0xed, 0x15,
-	0x7f, 0x43, 0x53, 0xa7, 0x99, 0xa0, 0xbe, 0x24, 0xf0, 0x21, 0xc2, 0x6a, 0xda, 0x20, 0x9f, 0x31,
-	0x20, 0x83, 0x01, 0x83, 0xa9, 0x55, 0x91, 0x7f, 0xfc, 0x95, 0x62, 0x2e, 0x6f, 0x26, 0x70, 0x2a,
-	0x71, 0x7c, 0x80, 0xd6, 0xc9, 0x68, 0x44, 0xaf, 0x20, 0x0a, 0xc2, 0x11, 0xe1, 0x1c, 0xb8, 0x55,
-	0x6d, 0x99, 0xed, 0x9a, 0xdf, 0xd0, 0x70, 0x4f, 0xa1, 0xf8, 0x1c, 0xad, 0x45, 0x44, 0x40, 0x10,
-	0xb2, 0x44, 0x00, 0x4b, 0x88, 0xb5, 0xd2, 0x32, 0xda, 0xf5, 0xe3, 0x03, 0xf7, 0xb3, 0xa1, 0xb8,
-	0x67, 0x44, 0x40, 0x4f, 0xcb, 0xfd, 0xd5, 0x68, 0xee, 0x84, 0xff, 0x42, 0xe6, 0xdf, 0x00, 0x56,
-	0xad, 0x65, 0xb6, 0xeb, 0xc7, 0x3b, 0xae, 0x0a, 0x20, 0x2f, 0x05, 0x57, 0x07, 0xe0, 0xf6, 0x68,
-	0x92, 0x76, 0xbf, 0xbf, 0x7b, 0xb7, 0x5b, 0xfa, 0xff, 0xfd, 0x6e, 0x3b, 0x4e, 0xc4, 0x3f, 0xd9,
-	0xc0, 0x0d, 0xe9, 0xd8, 0xd3, 0x69, 0xa9, 0x9f, 0x23, 0x1e, 0x0d, 0x75, 0x98, 0x79, 0x01, 0xf7,
-	0x73, 0x5f, 0xe7, 0x07, 0xb4, 0x51, 0x04, 0xe6, 0x03, 0x9f, 0xd0, 0x94, 0x03, 0xde, 0x43, 0xab,
-	0xaa, 0xb7, 0x20, 0x82, 0x94, 0x8e, 0x75, 0x7a, 0x75, 0x85, 0x9d, 0xe5, 0x90, 0xf3, 0x9f, 0x81,
-	0x2a, 0x7d, 0x1e, 0x5f, 0x64, 0x02, 0x6f, 0xa1, 0x65, 0x7a, 0x95, 0xc2, 0x2c, 0x64, 0x75, 0x78,
-	0xe6, 0x51, 0x7e, 0xe6, 0x81, 0x4f, 0x51, 0x55, 0xdd, 0x04, 0xb7, 0x4c, 0x39, 0xde, 0xa2, 0x2b,
-	0xea, 0xca, 0xa7, 0x9e, 0x84, 0xfd, 0x59, 0x9d, 0xf3, 0x33, 0x6a, 0xa8, 0x2e, 0x8a, 0xde, 0xf3,
-	0x98, 0xc6, 0x34, 0x4b, 0x45, 0xc0, 0x20, 0x84, 0x64, 0x0a, 0x91, 0xee, 0xab, 0xa1, 0x60, 0x5f,
-	0xa3, 0xce, 0x47, 0x03, 0x55, 0xfb, 0x3c, 0xbe, 0x24, 0x43, 0x78, 0xfd, 0x08, 0xdb, 0xa8, 0xa2,
-	0x6c, 0xf5, 0xbe, 0xea, 0x13, 0x3e, 0x41, 0x9b, 0x6a, 0xfb, 0xc6, 0x90, 0x8a, 0x60, 0x44, 0x43,
-	0x22, 0x97, 0x3a, 0xdf, 0xda, 0x9a, 0xdc, 0x5a, 0xfc, 0x44, 0x9f, 0x6b, 0x16, 0xef, 0xa3, 0x86,
-	0x42, 0x03, 0x9a, 0x06, 0x82, 0x0c, 0x67, 0xab, 0xbb, 0xaa, 0xd0, 0x5f, 0x53, 0xd9, 0xeb, 0x8f,
-	0xe8, 0xeb, 0x39, 0xeb, 0x7f, 0x33, 0x96, 0xf0, 0x28, 0x09, 0xa5, 0xbd, 0x5a, 0xdd, 0xed, 0x27,
-	0xfa, 0x97, 0x39, 0xd6, 0xb9, 0x44, 0xeb, 0x7a, 0xde, 0xe2, 0xb2, 0xe6, 0x12, 0x30, 0x5e, 0x97,
-	0xc0, 0xf1, 0x6d, 0x19, 0x99, 0x7d, 0x1e, 0xe3, 0x3f, 0x51, 0x45, 0xbf, 0xf6, 0xfb, 0x0b, 0x3c,
-	0x8a, 0x5d, 0x6b, 0x1e, 0x7e, 0x89, 0xaa, 0x68, 0xf4, 0x37, 0x64, 0xe6, 0xab, 0xb6, 0xb7, 0xb8,
-	0xe8, 0x22, 0x13, 0xcd, 0x6f, 0x5f, 0x94, 0x14, 0xa6, 0xbf, 0xa3, 0x25, 0x79, 0xa3, 0xce, 0xe2,
-	0x92, 0x5c, 0xd3, 0xfc, 0xee, 0x65, 0xcd, 0xcc, 0xb7, 0xeb, 0xdf, 0x3d, 0xd8, 0xc6, 0xfd, 0x83,
-	0x6d, 0x7c, 0x78, 0xb0, 0x8d, 0xdb, 0x47, 0xbb, 0x74, 0xff, 0x68, 0x97, 0xde, 0x3e, 0xda, 0xa5,
-	0x3f, 0x7e, 0x9a, 0x7b, 0x39, 0xa5, 0xdf, 0x51, 0x0a, 0xe2, 0x8a, 0xb2, 0xa1, 0x3e, 0x8d, 0x20,
-	0x8a, 0x81, 0x79, 0xd7, 0xcf, 0x3e, 0xc4, 0x83, 0x8a, 0xfc, 0xc0, 0x9e, 0x7c, 0x0a, 0x00, 0x00,
-	0xff, 0xff, 0xa0, 0x1d, 0xb1, 0x16, 0xfe, 0x05, 0x00, 0x00,
+func (m *MsgUpdateBasketCurator) Reset()         { *m = MsgUpdateBasketCurator{} }
+func (m *MsgUpdateBasketCurator) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBasketCurator) ProtoMessage()    {}
+func (*MsgUpdateBasketCurator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a60f962a3c61f018, []int{6}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// Create creates a bank denom which wraps credits.
-	Create(ctx context.Context, in *MsgCreate, opts ...grpc.CallOption) (*MsgCreateResponse, error)
-	// Put puts credits into a basket in return for basket tokens.
-	Put(ctx context.Context, in *MsgPut, opts ...grpc.CallOption) (*MsgPutResponse, error)
-	// Take takes credits from a basket starting from the oldest
-	// credits first.
-	Take(ctx context.Context, in *MsgTake, opts ...grpc.CallOption) (*MsgTakeResponse, error)
+func (m *MsgUpdateBasketCurator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgUpdateBasketCurator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBasketCurator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgUpdateBasketCurator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBasketCurator.Merge(m, src)
+}
+func (m *MsgUpdateBasketCurator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateBasketCurator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBasketCurator.DiscardUnknown(m)
 }
 
-func (c *msgClient) Create(ctx context.Context, in *MsgCreate, opts ...grpc.CallOption) (*MsgCreateResponse, error) {
-	out := new(MsgCreateResponse)
-	err := c.cc.Invoke(ctx, "/regen.ecocredit.basket.v1.Msg/Create", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateBasketCurator proto.InternalMessageInfo
+
+func (m *MsgUpdateBasketCurator) GetCurator() string {
+	if m != nil {
+		return m.Curator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) Put(ctx context.Context, in *MsgPut, opts ...grpc.CallOption) (*MsgPutResponse, error) {
-	out := new(MsgPutResponse)
-	err := c.cc.Invoke(ctx, "/regen.ecocredit.basket.v1.Msg/Put", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateBasketCurator) GetBasketDenom() string {
+	if m != nil {
+		return m.BasketDenom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) Take(ctx context.Context, in *MsgTake, opts ...grpc.CallOption) (*MsgTakeResponse, error) {
-	out := new(MsgTakeResponse)
-	err := c.cc.Invoke(ctx, "/regen.ecocredit.basket.v1.Msg/Take", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateBasketCurator) GetNewCurator() string {
+	if m != nil {
+		return m.NewCurator
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// Create creates a bank denom which wraps credits.
-	Create(context.Context, *MsgCreate) (*MsgCreateResponse, error)
-	// Put puts credits into a basket in return for basket tokens.
-	Put(context.Context, *MsgPut) (*MsgPutResponse, error)
-	// Take takes credits from a basket starting from the oldest
-	// credits first.
-	Take(context.Context, *MsgTake) (*MsgTakeResponse, error)
+// MsgUpdateBasketCuratorResponse is the Msg/UpdateBasketCurator response type.
+type MsgUpdateBasketCuratorResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgUpdateBasketCuratorResponse) Reset()         { *m = MsgUpdateBasketCuratorResponse{} }
+func (m *MsgUpdateBasketCuratorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBasketCuratorResponse) ProtoMessage()    {}
+func (*MsgUpdateBasketCuratorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a60f962a3c61f018, []int{7}
 }
-
-func (*UnimplementedMsgServer) Create(ctx context.Context, req *MsgCreate) (*MsgCreateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+func (m *MsgUpdateBasketCuratorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) Put(ctx context.Context, req *MsgPut) (*MsgPutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Put not implemented")
+func (m *MsgUpdateBasketCuratorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBasketCuratorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) Take(ctx context.Context, req *MsgTake) (*MsgTakeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Take not implemented")
+func (m *MsgUpdateBasketCuratorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBasketCuratorResponse.Merge(m, src)
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgUpdateBasketCuratorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateBasketCuratorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBasketCuratorResponse.DiscardUnknown(m)
 }
 
-func _Msg_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreate)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Create(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/regen.ecocredit.basket.v1.Msg/Create",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Create(ctx, req.(*MsgCreate))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgUpdateBasketCuratorResponse proto.InternalMessageInfo
+
+// MsgAddBasketClasses is the Msg/AddBasketClasses request type.
+type MsgAddBasketClasses struct {
+	// curator is the address of the basket curator.
+	Curator string `protobuf:"bytes,1,opt,name=curator,proto3" json:"curator,omitempty"`
+	// basket_denom is the basket bank denom of the basket to update.
+	BasketDenom string `protobuf:"bytes,2,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// class_ids are the credit classes to add to the allowed classes of the
+	// basket. The credit classes must exist and have the same credit type as
+	// the basket.
+	ClassIds []string `protobuf:"bytes,3,rep,name=class_ids,json=classIds,proto3" json:"class_ids,omitempty"`
 }
 
-func _Msg_Put_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPut)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Put(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/regen.ecocredit.basket.v1.Msg/Put",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Put(ctx, req.(*MsgPut))
+func (m *MsgAddBasketClasses) Reset()         { *m = MsgAddBasketClasses{} }
+func (m *MsgAddBasketClasses) String() string { return proto.CompactTextString(m) }
+func (*MsgAddBasketClasses) ProtoMessage()    {}
+func (*MsgAddBasketClasses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a60f962a3c61f018, []int{8}
+}
+func (m *MsgAddBasketClasses) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddBasketClasses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddBasketClasses.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgAddBasketClasses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddBasketClasses.Merge(m, src)
+}
+func (m *MsgAddBasketClasses) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddBasketClasses) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddBasketClasses.DiscardUnknown(m)
 }
 
-func _Msg_Take_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgTake)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Take(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/regen.ecocredit.basket.v1.Msg/Take",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Take(ctx, req.(*MsgTake))
+var xxx_messageInfo_MsgAddBasketClasses proto.InternalMessageInfo
+
+func (m *MsgAddBasketClasses) GetCurator() string {
+	if m != nil {
+		return m.Curator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "regen.ecocredit.basket.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Create",
-			Handler:    _Msg_Create_Handler,
-		},
-		{
-			MethodName: "Put",
-			Handler:    _Msg_Put_Handler,
-		},
-		{
-			MethodName: "Take",
-			Handler:    _Msg_Take_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "regen/ecocredit/basket/v1/tx.proto",
+func (m *MsgAddBasketClasses) GetBasketDenom() string {
+	if m != nil {
+		return m.BasketDenom
+	}
+	return ""
 }
 
-func (m *MsgCreate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAddBasketClasses) GetClassIds() []string {
+	if m != nil {
+		return m.ClassIds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgCreate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgAddBasketClassesResponse is the Msg/AddBasketClasses response type.
+type MsgAddBasketClassesResponse struct {
 }
 
-func (m *MsgCreate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fee) > 0 {
-		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if m.DateCriteria != nil {
-		{
-			size, err := m.DateCriteria.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.AllowedClasses) > 0 {
-		for iNdEx := len(m.AllowedClasses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AllowedClasses[iNdEx])
-			copy(dAtA[i:], m.AllowedClasses[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.AllowedClasses[iNdEx])))
-			i--
-			dAtA[i] = 0x3a
+func (m *MsgAddBasketClassesResponse) Reset()         { *m = MsgAddBasketClassesResponse{} }
+func (m *MsgAddBasketClassesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddBasketClassesResponse) ProtoMessage()    {}
+func (*MsgAddBasketClassesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a60f962a3c61f018, []int{9}
+}
+func (m *MsgAddBasketClassesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddBasketClassesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddBasketClassesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.CreditTypeAbbrev) > 0 {
-		i -= len(m.CreditTypeAbbrev)
-		copy(dAtA[i:], m.CreditTypeAbbrev)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CreditTypeAbbrev)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.DisableAutoRetire {
-		i--
-		if m.DisableAutoRetire {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+}
+func (m *MsgAddBasketClassesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddBasketClassesResponse.Merge(m, src)
+}
+func (m *MsgAddBasketClassesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddBasketClassesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddBasketClassesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddBasketClassesResponse proto.InternalMessageInfo
+
+// MsgRemoveBasketClasses is the Msg/RemoveBasketClasses request type.
+type MsgRemoveBasketClasses struct {
+	// curator is the address of the basket curator.
+	Curator string `protobuf:"bytes,1,opt,name=curator,proto3" json:"curator,omitempty"`
+	// basket_denom is the basket bank denom of the basket to update.
+	BasketDenom string `protobuf:"bytes,2,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// class_ids are the credit classes to remove from the allowed classes of the
+	// basket. At least one allowed class must remain after the removal.
+	ClassIds []string `protobuf:"bytes,3,rep,name=class_ids,json=classIds,proto3" json:"class_ids,omitempty"`
+}
+
+func (m *MsgRemoveBasketClasses) Reset()         { *m = MsgRemoveBasketClasses{} }
+func (m *MsgRemoveBasketClasses) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveBasketClasses) ProtoMessage()    {}
+func (*MsgRemoveBasketClasses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a60f962a3c61f018, []int{10}
+}
+func (m *MsgRemoveBasketClasses) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveBasketClasses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveBasketClasses.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Exponent != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Exponent))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Curator) > 0 {
-		i -= len(m.Curator)
-		copy(dAtA[i:], m.Curator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Curator)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRemoveBasketClasses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveBasketClasses.Merge(m, src)
+}
+func (m *MsgRemoveBasketClasses) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveBasketClasses) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveBasketClasses.DiscardUnknown(m)
 }
 
-func (m *MsgCreateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRemoveBasketClasses proto.InternalMessageInfo
+
+func (m *MsgRemoveBasketClasses) GetCurator() string {
+	if m != nil {
+		return m.Curator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveBasketClasses) GetBasketDenom() string {
+	if m != nil {
+		return m.BasketDenom
+	}
+	return ""
 }
 
-func (m *MsgCreateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BasketDenom) > 0 {
-		i -= len(m.BasketDenom)
-		copy(dAtA[i:], m.BasketDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BasketDenom)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgRemoveBasketClasses) GetClassIds() []string {
+	if m != nil {
+		return m.ClassIds
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgPut) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// MsgRemoveBasketClassesResponse is the Msg/RemoveBasketClasses response type.
+type MsgRemoveBasketClassesResponse struct {
+}
+
+func (m *MsgRemoveBasketClassesResponse) Reset()         { *m = MsgRemoveBasketClassesResponse{} }
+func (m *MsgRemoveBasketClassesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveBasketClassesResponse) ProtoMessage()    {}
+func (*MsgRemoveBasketClassesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a60f962a3c61f018, []int{11}
+}
+func (m *MsgRemoveBasketClassesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveBasketClassesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveBasketClassesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRemoveBasketClassesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveBasketClassesResponse.Merge(m, src)
+}
+func (m *MsgRemoveBasketClassesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveBasketClassesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveBasketClassesResponse.DiscardUnknown(m)
 }
 
-func (m *MsgPut) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRemoveBasketClassesResponse proto.InternalMessageInfo
+
+// MsgUpdateBasketDateCriteria is the Msg/UpdateBasketDateCriteria request
+// type.
+type MsgUpdateBasketDateCriteria struct {
+	// curator is the address of the basket curator.
+	Curator string `protobuf:"bytes,1,opt,name=curator,proto3" json:"curator,omitempty"`
+	// basket_denom is the basket bank denom of the basket to update.
+	BasketDenom string `protobuf:"bytes,2,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// date_criteria is the new date criteria for batches admitted to the basket.
+	// At most, only one of the fields in the date_criteria should be set. If
+	// empty, the date criteria of the basket will be removed.
+	DateCriteria *DateCriteria `protobuf:"bytes,3,opt,name=date_criteria,json=dateCriteria,proto3" json:"date_criteria,omitempty"`
 }
 
-func (m *MsgPut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Credits) > 0 {
-		for iNdEx := len(m.Credits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Credits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgUpdateBasketDateCriteria) Reset()         { *m = MsgUpdateBasketDateCriteria{} }
+func (m *MsgUpdateBasketDateCriteria) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBasketDateCriteria) ProtoMessage()    {}
+func (*MsgUpdateBasketDateCriteria) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a60f962a3c61f018, []int{12}
+}
+func (m *MsgUpdateBasketDateCriteria) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBasketDateCriteria) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBasketDateCriteria.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.BasketDenom) > 0 {
-		i -= len(m.BasketDenom)
-		copy(dAtA[i:], m.BasketDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BasketDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUpdateBasketDateCriteria) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBasketDateCriteria.Merge(m, src)
+}
+func (m *MsgUpdateBasketDateCriteria) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateBasketDateCriteria) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBasketDateCriteria.DiscardUnknown(m)
 }
 
-func (m *MsgPutResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateBasketDateCriteria proto.InternalMessageInfo
+
+func (m *MsgUpdateBasketDateCriteria) GetCurator() string {
+	if m != nil {
+		return m.Curator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgPutResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateBasketDateCriteria) GetBasketDenom() string {
+	if m != nil {
+		return m.BasketDenom
+	}
+	return ""
 }
 
-func (m *MsgPutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AmountReceived) > 0 {
-		i -= len(m.AmountReceived)
-		copy(dAtA[i:], m.AmountReceived)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AmountReceived)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgUpdateBasketDateCriteria) GetDateCriteria() *DateCriteria {
+	if m != nil {
+		return m.DateCriteria
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgTake) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// MsgUpdateBasketDateCriteriaResponse is the Msg/UpdateBasketDateCriteria
+// response type.
+type MsgUpdateBasketDateCriteriaResponse struct {
 }
 
-func (m *MsgTake) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateBasketDateCriteriaResponse) Reset()         { *m = MsgUpdateBasketDateCriteriaResponse{} }
+func (m *MsgUpdateBasketDateCriteriaResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBasketDateCriteriaResponse) ProtoMessage()    {}
+func (*MsgUpdateBasketDateCriteriaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a60f962a3c61f018, []int{13}
 }
-
-func (m *MsgTake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RetirementJurisdiction) > 0 {
-		i -= len(m.RetirementJurisdiction)
-		copy(dAtA[i:], m.RetirementJurisdiction)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RetirementJurisdiction)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.RetireOnTake {
-		i--
-		if m.RetireOnTake {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgUpdateBasketDateCriteriaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBasketDateCriteriaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBasketDateCriteriaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.RetirementLocation) > 0 {
-		i -= len(m.RetirementLocation)
-		copy(dAtA[i:], m.RetirementLocation)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RetirementLocation)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BasketDenom) > 0 {
-		i -= len(m.BasketDenom)
-		copy(dAtA[i:], m.BasketDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BasketDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgTakeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateBasketDateCriteriaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBasketDateCriteriaResponse.Merge(m, src)
+}
+func (m *MsgUpdateBasketDateCriteriaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateBasketDateCriteriaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBasketDateCriteriaResponse.DiscardUnknown(m)
 }
 
-func (m *MsgTakeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateBasketDateCriteriaResponse proto.InternalMessageInfo
+
+// MsgUpdateBasketDescription is the Msg/UpdateBasketDescription request type.
+type MsgUpdateBasketDescription struct {
+	// curator is the address of the basket curator.
+	Curator string `protobuf:"bytes,1,opt,name=curator,proto3" json:"curator,omitempty"`
+	// basket_denom is the basket bank denom of the basket to update.
+	BasketDenom string `protobuf:"bytes,2,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// description is the new human-readable description of the basket denom
+	// that should be at most 256 characters.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 }
 
-func (m *MsgTakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Credits) > 0 {
-		for iNdEx := len(m.Credits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Credits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *MsgUpdateBasketDescription) Reset()         { *m = MsgUpdateBasketDescription{} }
+func (m *MsgUpdateBasketDescription) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBasketDescription) ProtoMessage()    {}
+func (*MsgUpdateBasketDescription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a60f962a3c61f018, []int{14}
+}
+func (m *MsgUpdateBasketDescription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBasketDescription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBasketDescription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUpdateBasketDescription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBasketDescription.Merge(m, src)
+}
+func (m *MsgUpdateBasketDescription) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateBasketDescription) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBasketDescription.DiscardUnknown(m)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_MsgUpdateBasketDescription proto.InternalMessageInfo
+
+func (m *MsgUpdateBasketDescription) GetCurator() string {
+	if m != nil {
+		return m.Curator
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *MsgCreate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Curator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Exponent != 0 {
-		n += 1 + sovTx(uint64(m.Exponent))
-	}
-	if m.DisableAutoRetire {
-		n += 2
-	}
-	l = len(m.CreditTypeAbbrev)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.AllowedClasses) > 0 {
-		for _, s := range m.AllowedClasses {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+
+func (m *MsgUpdateBasketDescription) GetBasketDenom() string {
+	if m != nil {
+		return m.BasketDenom
 	}
-	if m.DateCriteria != nil {
-		l = m.DateCriteria.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgUpdateBasketDescription) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	if len(m.Fee) > 0 {
-		for _, e := range m.Fee {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+// MsgUpdateBasketDescriptionResponse is the Msg/UpdateBasketDescription
+// response type.
+type MsgUpdateBasketDescriptionResponse struct {
+}
+
+func (m *MsgUpdateBasketDescriptionResponse) Reset()         { *m = MsgUpdateBasketDescriptionResponse{} }
+func (m *MsgUpdateBasketDescriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBasketDescriptionResponse) ProtoMessage()    {}
+func (*MsgUpdateBasketDescriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a60f962a3c61f018, []int{15}
+}
+func (m *MsgUpdateBasketDescriptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBasketDescriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBasketDescriptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgUpdateBasketDescriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBasketDescriptionResponse.Merge(m, src)
+}
+func (m *MsgUpdateBasketDescriptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateBasketDescriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBasketDescriptionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BasketDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+var xxx_messageInfo_MsgUpdateBasketDescriptionResponse proto.InternalMessageInfo
+
+// MsgUpdateBasketTakePremium is the Msg/UpdateBasketTakePremium request type.
+type MsgUpdateBasketTakePremium struct {
+	// curator is the address of the basket curator.
+	Curator string `protobuf:"bytes,1,opt,name=curator,proto3" json:"curator,omitempty"`
+	// basket_denom is the basket bank denom of the basket to update.
+	BasketDenom string `protobuf:"bytes,2,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// take_premium_bps is the premium in basis points of the basket tokens being
+	// converted that is paid to the curator when credits are taken from the
+	// basket with a strategy other than oldest first. The premium cannot exceed
+	// 10000 basis points.
+	TakePremiumBps uint32 `protobuf:"varint,3,opt,name=take_premium_bps,json=takePremiumBps,proto3" json:"take_premium_bps,omitempty"`
 }
 
-func (m *MsgPut) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BasketDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Credits) > 0 {
-		for _, e := range m.Credits {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdateBasketTakePremium) Reset()         { *m = MsgUpdateBasketTakePremium{} }
+func (m *MsgUpdateBasketTakePremium) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBasketTakePremium) ProtoMessage()    {}
+func (*MsgUpdateBasketTakePremium) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a60f962a3c61f018, []int{16}
+}
+func (m *MsgUpdateBasketTakePremium) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBasketTakePremium) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBasketTakePremium.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgUpdateBasketTakePremium) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBasketTakePremium.Merge(m, src)
+}
+func (m *MsgUpdateBasketTakePremium) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateBasketTakePremium) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBasketTakePremium.DiscardUnknown(m)
 }
 
-func (m *MsgPutResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.AmountReceived)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+var xxx_messageInfo_MsgUpdateBasketTakePremium proto.InternalMessageInfo
+
+func (m *MsgUpdateBasketTakePremium) GetCurator() string {
+	if m != nil {
+		return m.Curator
 	}
-	return n
+	return ""
 }
 
-func (m *MsgTake) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateBasketTakePremium) GetBasketDenom() string {
+	if m != nil {
+		return m.BasketDenom
 	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgUpdateBasketTakePremium) GetTakePremiumBps() uint32 {
+	if m != nil {
+		return m.TakePremiumBps
 	}
-	l = len(m.BasketDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+// MsgUpdateBasketTakePremiumResponse is the Msg/UpdateBasketTakePremium
+// response type.
+type MsgUpdateBasketTakePremiumResponse struct {
+}
+
+func (m *MsgUpdateBasketTakePremiumResponse) Reset()         { *m = MsgUpdateBasketTakePremiumResponse{} }
+func (m *MsgUpdateBasketTakePremiumResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBasketTakePremiumResponse) ProtoMessage()    {}
+func (*MsgUpdateBasketTakePremiumResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a60f962a3c61f018, []int{17}
+}
+func (m *MsgUpdateBasketTakePremiumResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBasketTakePremiumResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBasketTakePremiumResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgUpdateBasketTakePremiumResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBasketTakePremiumResponse.Merge(m, src)
+}
+func (m *MsgUpdateBasketTakePremiumResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateBasketTakePremiumResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBasketTakePremiumResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateBasketTakePremiumResponse proto.InternalMessageInfo
+
+// MsgUpdateBasketAdmissionCriteria is the Msg/UpdateBasketAdmissionCriteria
+// request type.
+type MsgUpdateBasketAdmissionCriteria struct {
+	// curator is the address of the basket curator.
+	Curator string `protobuf:"bytes,1,opt,name=curator,proto3" json:"curator,omitempty"`
+	// basket_denom is the basket bank denom of the basket to update.
+	BasketDenom string `protobuf:"bytes,2,opt,name=basket_denom,json=basketDenom,proto3" json:"basket_denom,omitempty"`
+	// admission_criteria is the new additional criteria for batches admitted to
+	// the basket. If empty, the admission criteria of the basket will be
+	// removed.
+	AdmissionCriteria *AdmissionCriteria `protobuf:"bytes,3,opt,name=admission_criteria,json=admissionCriteria,proto3" json:"admission_criteria,omitempty"`
+}
+
+func (m *MsgUpdateBasketAdmissionCriteria) Reset()         { *m = MsgUpdateBasketAdmissionCriteria{} }
+func (m *MsgUpdateBasketAdmissionCriteria) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBasketAdmissionCriteria) ProtoMessage()    {}
+func (*MsgUpdateBasketAdmissionCriteria) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a60f962a3c61f018, []int{18}
+}
+func (m *MsgUpdateBasketAdmissionCriteria) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBasketAdmissionCriteria) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBasketAdmissionCriteria.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.RetirementLocation)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgUpdateBasketAdmissionCriteria) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBasketAdmissionCriteria.Merge(m, src)
+}
+func (m *MsgUpdateBasketAdmissionCriteria) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateBasketAdmissionCriteria) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBasketAdmissionCriteria.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateBasketAdmissionCriteria proto.InternalMessageInfo
+
+func (m *MsgUpdateBasketAdmissionCriteria) GetCurator() string {
+	if m != nil {
+		return m.Curator
 	}
-	if m.RetireOnTake {
-		n += 2
+	return ""
+}
+
+func (m *MsgUpdateBasketAdmissionCriteria) GetBasketDenom() string {
+	if m != nil {
+		return m.BasketDenom
 	}
-	l = len(m.RetirementJurisdiction)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgUpdateBasketAdmissionCriteria) GetAdmissionCriteria() *AdmissionCriteria {
+	if m != nil {
+		return m.AdmissionCriteria
 	}
-	return n
+	return nil
 }
 
-func (m *MsgTakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// MsgUpdateBasketAdmissionCriteriaResponse is the
+// Msg/UpdateBasketAdmissionCriteria response type.
+type MsgUpdateBasketAdmissionCriteriaResponse struct {
+}
+
+func (m *MsgUpdateBasketAdmissionCriteriaResponse) Reset() {
+	*m = MsgUpdateBasketAdmissionCriteriaResponse{}
+}
+func (m *MsgUpdateBasketAdmissionCriteriaResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBasketAdmissionCriteriaResponse) ProtoMessage()    {}
+func (*MsgUpdateBasketAdmissionCriteriaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a60f962a3c61f018, []int{19}
+}
+func (m *MsgUpdateBasketAdmissionCriteriaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBasketAdmissionCriteriaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBasketAdmissionCriteriaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.Credits) > 0 {
-		for _, e := range m.Credits {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgUpdateBasketAdmissionCriteriaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBasketAdmissionCriteriaResponse.Merge(m, src)
+}
+func (m *MsgUpdateBasketAdmissionCriteriaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateBasketAdmissionCriteriaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBasketAdmissionCriteriaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateBasketAdmissionCriteriaResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreate)(nil), "regen.ecocredit.basket.v1.MsgCreate")
+	proto.RegisterType((*MsgCreateResponse)(nil), "regen.ecocredit.basket.v1.MsgCreateResponse")
+	proto.RegisterType((*MsgPut)(nil), "regen.ecocredit.basket.v1.MsgPut")
+	proto.RegisterType((*MsgPutResponse)(nil), "regen.ecocredit.basket.v1.MsgPutResponse")
+	proto.RegisterType((*MsgTake)(nil), "regen.ecocredit.basket.v1.MsgTake")
+	proto.RegisterType((*MsgTakeResponse)(nil), "regen.ecocredit.basket.v1.MsgTakeResponse")
+	proto.RegisterType((*MsgUpdateBasketCurator)(nil), "regen.ecocredit.basket.v1.MsgUpdateBasketCurator")
+	proto.RegisterType((*MsgUpdateBasketCuratorResponse)(nil), "regen.ecocredit.basket.v1.MsgUpdateBasketCuratorResponse")
+	proto.RegisterType((*MsgAddBasketClasses)(nil), "regen.ecocredit.basket.v1.MsgAddBasketClasses")
+	proto.RegisterType((*MsgAddBasketClassesResponse)(nil), "regen.ecocredit.basket.v1.MsgAddBasketClassesResponse")
+	proto.RegisterType((*MsgRemoveBasketClasses)(nil), "regen.ecocredit.basket.v1.MsgRemoveBasketClasses")
+	proto.RegisterType((*MsgRemoveBasketClassesResponse)(nil), "regen.ecocredit.basket.v1.MsgRemoveBasketClassesResponse")
+	proto.RegisterType((*MsgUpdateBasketDateCriteria)(nil), "regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteria")
+	proto.RegisterType((*MsgUpdateBasketDateCriteriaResponse)(nil), "regen.ecocredit.basket.v1.MsgUpdateBasketDateCriteriaResponse")
+	proto.RegisterType((*MsgUpdateBasketDescription)(nil), "regen.ecocredit.basket.v1.MsgUpdateBasketDescription")
+	proto.RegisterType((*MsgUpdateBasketDescriptionResponse)(nil), "regen.ecocredit.basket.v1.MsgUpdateBasketDescriptionResponse")
+	proto.RegisterType((*MsgUpdateBasketTakePremium)(nil), "regen.ecocredit.basket.v1.MsgUpdateBasketTakePremium")
+	proto.RegisterType((*MsgUpdateBasketTakePremiumResponse)(nil), "regen.ecocredit.basket.v1.MsgUpdateBasketTakePremiumResponse")
+	proto.RegisterType((*MsgUpdateBasketAdmissionCriteria)(nil), "regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteria")
+	proto.RegisterType((*MsgUpdateBasketAdmissionCriteriaResponse)(nil), "regen.ecocredit.basket.v1.MsgUpdateBasketAdmissionCriteriaResponse")
+}
+
+func init() {
+	proto.RegisterFile("regen/ecocredit/basket/v1/tx.proto", fileDescriptor_a60f962a3c61f018)
+}
+
+var fileDescriptor_a60f962a3c61f018 = []byte{
+	// 1203 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0x36, 0x23, 0xc7, 0xb6, 0x46, 0x8e, 0xe3, 0xac, 0x03, 0x87, 0x61, 0x10, 0x59, 0xe1, 0x9b,
+	0x20, 0x4a, 0x90, 0x50, 0xaf, 0x13, 0x34, 0x6d, 0x50, 0xa4, 0x80, 0xac, 0x5c, 0x5a, 0x44, 0x6d,
+	0xc0, 0xa4, 0x3d, 0xf4, 0x03, 0xc4, 0x8a, 0x9c, 0x30, 0xac, 0x44, 0xae, 0xc0, 0x5d, 0xc9, 0x76,
+	0x7b, 0x4c, 0x8f, 0x05, 0x5a, 0xf4, 0x1f, 0xf4, 0x56, 0xf4, 0x37, 0xf4, 0x07, 0xe4, 0x98, 0x63,
+	0x4f, 0x6d, 0x91, 0x1c, 0x7b, 0xee, 0xbd, 0xe0, 0x2e, 0x49, 0xb3, 0xd6, 0x97, 0x6d, 0x15, 0x3d,
+	0x49, 0x3b, 0x33, 0xcf, 0xcc, 0xb3, 0x3b, 0x1f, 0xbb, 0x04, 0x33, 0x46, 0x1f, 0xa3, 0x06, 0xba,
+	0xcc, 0x8d, 0xd1, 0x0b, 0x44, 0xa3, 0x43, 0x79, 0x17, 0x45, 0x63, 0xb8, 0xdd, 0x10, 0x7b, 0x56,
+	0x3f, 0x66, 0x82, 0x91, 0x8b, 0xd2, 0xc6, 0xca, 0x6d, 0x2c, 0x65, 0x63, 0x0d, 0xb7, 0x8d, 0xf3,
+	0x3e, 0xf3, 0x99, 0xb4, 0x6a, 0x24, 0xff, 0x14, 0xc0, 0xb8, 0x36, 0xc5, 0xe9, 0x7e, 0x1f, 0x79,
+	0x6a, 0x56, 0x75, 0x19, 0x0f, 0x19, 0x4f, 0xb4, 0xd8, 0x18, 0x6e, 0x77, 0x50, 0xd0, 0xed, 0x86,
+	0xcb, 0x82, 0x48, 0xe9, 0xcd, 0x6f, 0x17, 0xa1, 0xdc, 0xe6, 0x7e, 0x2b, 0x46, 0x2a, 0x90, 0xe8,
+	0xb0, 0xec, 0x0e, 0x62, 0x2a, 0x58, 0xac, 0x6b, 0x35, 0xad, 0x5e, 0xb6, 0xb3, 0x25, 0x21, 0xb0,
+	0x18, 0xd1, 0x10, 0xf5, 0x53, 0x52, 0x2c, 0xff, 0x93, 0x1a, 0x54, 0x3c, 0xe4, 0x6e, 0x1c, 0xf4,
+	0x45, 0xc0, 0x22, 0xbd, 0x24, 0x55, 0x45, 0x11, 0xa9, 0xc2, 0x0a, 0xee, 0xf5, 0x59, 0x84, 0x91,
+	0xd0, 0x17, 0x6b, 0x5a, 0xfd, 0xcc, 0xce, 0x29, 0x5d, 0xb3, 0x73, 0x19, 0xb1, 0x60, 0xc3, 0x0b,
+	0x38, 0xed, 0xf4, 0xd0, 0xa1, 0x03, 0xc1, 0x9c, 0x18, 0x45, 0x10, 0xa3, 0x7e, 0xba, 0xa6, 0xd5,
+	0x57, 0xec, 0x73, 0xa9, 0xaa, 0x39, 0x10, 0xcc, 0x96, 0x0a, 0x72, 0x0b, 0x88, 0xda, 0xad, 0x93,
+	0xec, 0xd1, 0xa1, 0x9d, 0x4e, 0x8c, 0x43, 0x7d, 0x49, 0x06, 0x5e, 0x57, 0x9a, 0xa7, 0xfb, 0x7d,
+	0x6c, 0x4a, 0x39, 0xb9, 0x0e, 0x67, 0x69, 0xaf, 0xc7, 0x76, 0xd1, 0x73, 0xdc, 0x1e, 0xe5, 0x1c,
+	0xb9, 0xbe, 0x5c, 0x2b, 0xd5, 0xcb, 0xf6, 0x5a, 0x2a, 0x6e, 0x29, 0x29, 0x79, 0x04, 0x67, 0x3c,
+	0x2a, 0xd0, 0x71, 0xe3, 0x40, 0x60, 0x1c, 0x50, 0x7d, 0xa5, 0xa6, 0xd5, 0x2b, 0x77, 0xae, 0x5b,
+	0x13, 0x93, 0x62, 0x3d, 0xa4, 0x02, 0x5b, 0xa9, 0xb9, 0xbd, 0xea, 0x15, 0x56, 0xe4, 0x0b, 0x28,
+	0x3d, 0x43, 0xd4, 0xcb, 0xb5, 0x52, 0xbd, 0x72, 0xe7, 0xa2, 0xa5, 0x12, 0x90, 0x40, 0xd1, 0x4a,
+	0x13, 0x60, 0xb5, 0x58, 0x10, 0xed, 0xfc, 0xff, 0xe5, 0x6f, 0x5b, 0x0b, 0x3f, 0xff, 0xbe, 0x55,
+	0xf7, 0x03, 0xf1, 0x7c, 0xd0, 0xb1, 0x5c, 0x16, 0x36, 0xd2, 0x6c, 0xa9, 0x9f, 0xdb, 0xdc, 0xeb,
+	0xa6, 0xc9, 0x4c, 0x00, 0xdc, 0x4e, 0xfc, 0x92, 0xcf, 0x80, 0x50, 0x2f, 0x0c, 0x38, 0x0f, 0x58,
+	0x74, 0xc0, 0x18, 0x24, 0xe3, 0x5b, 0x53, 0x18, 0x37, 0x33, 0x50, 0x4e, 0xfb, 0x1c, 0x3d, 0x2c,
+	0x32, 0xef, 0xc1, 0xb9, 0xbc, 0x1a, 0x6c, 0xe4, 0x7d, 0x16, 0x71, 0x24, 0x57, 0x60, 0x55, 0xb9,
+	0x71, 0x3c, 0x8c, 0x58, 0x98, 0x96, 0x46, 0x45, 0xc9, 0x1e, 0x26, 0x22, 0xf3, 0x1b, 0x0d, 0x96,
+	0xda, 0xdc, 0x7f, 0x3c, 0x10, 0xe4, 0x3c, 0x9c, 0x66, 0xbb, 0x11, 0x66, 0x15, 0xa4, 0x16, 0x23,
+	0x3e, 0x4e, 0x8d, 0xf8, 0x20, 0x4d, 0x58, 0x56, 0xa4, 0xb9, 0x5e, 0xaa, 0x95, 0x66, 0x9c, 0xff,
+	0x8e, 0xfc, 0xd7, 0x92, 0x62, 0x3b, 0xc3, 0x99, 0xf7, 0x61, 0x4d, 0xb1, 0xc8, 0xb9, 0x27, 0x35,
+	0x10, 0xb2, 0x41, 0x24, 0x9c, 0x18, 0x5d, 0x0c, 0x86, 0xe8, 0xa5, 0xbc, 0xd6, 0x94, 0xd8, 0x4e,
+	0xa5, 0xe6, 0x9f, 0x25, 0x58, 0x6e, 0x73, 0xff, 0x29, 0xed, 0xe2, 0xc9, 0xb7, 0xb0, 0x09, 0x4b,
+	0xca, 0x6d, 0xda, 0x0c, 0xe9, 0x8a, 0xdc, 0x85, 0x0d, 0x55, 0xda, 0x21, 0x46, 0xc2, 0xe9, 0x31,
+	0x97, 0xca, 0x8e, 0x49, 0x5a, 0xa2, 0x2c, 0x5b, 0x82, 0x1c, 0xa8, 0x1f, 0xa5, 0x5a, 0x72, 0x15,
+	0xd6, 0x94, 0xd4, 0x61, 0x91, 0x23, 0x68, 0x37, 0xeb, 0x8b, 0x55, 0x25, 0xfd, 0x28, 0x92, 0x5c,
+	0xdf, 0x86, 0x0b, 0x05, 0xd7, 0x5f, 0x0e, 0xe2, 0x80, 0x7b, 0x81, 0x2b, 0xdd, 0xab, 0xbe, 0xd8,
+	0x3c, 0x50, 0x7f, 0x50, 0xd0, 0x92, 0x06, 0x6c, 0x74, 0x30, 0xc2, 0x67, 0x81, 0x1b, 0xd0, 0x78,
+	0xdf, 0xa1, 0x9e, 0x17, 0x23, 0x4f, 0x3a, 0x24, 0x01, 0x91, 0x82, 0xaa, 0xa9, 0x34, 0xe4, 0x06,
+	0xac, 0x17, 0x01, 0x72, 0x1c, 0xac, 0x48, 0xeb, 0xb3, 0x05, 0xf9, 0x87, 0xc9, 0x64, 0xd8, 0x84,
+	0xa5, 0x18, 0x29, 0x67, 0x91, 0x5e, 0x56, 0xe7, 0xa0, 0x56, 0xa4, 0x05, 0x2b, 0x5c, 0xc4, 0x54,
+	0xa0, 0xbf, 0x2f, 0x2b, 0x76, 0x6d, 0x6a, 0x8e, 0x93, 0xfd, 0x3d, 0x49, 0xcd, 0xed, 0x1c, 0xa8,
+	0xf2, 0x20, 0xdc, 0xe7, 0x2a, 0x0d, 0x5c, 0xaf, 0xc8, 0x9e, 0xae, 0x48, 0x99, 0x4c, 0x03, 0x27,
+	0x5b, 0x50, 0x09, 0xe9, 0x9e, 0xd3, 0x8f, 0x31, 0x0c, 0x06, 0xa1, 0xbe, 0x2a, 0x49, 0x40, 0x48,
+	0xf7, 0x1e, 0x2b, 0x89, 0x19, 0xc1, 0xd9, 0x34, 0xd9, 0x79, 0xa5, 0x14, 0xca, 0x4f, 0x3b, 0x59,
+	0xf9, 0x25, 0xe3, 0x33, 0x0b, 0xa9, 0x8a, 0x23, 0x5b, 0x9a, 0x43, 0xd8, 0x6c, 0x73, 0xff, 0xe3,
+	0xbe, 0x47, 0x05, 0xa6, 0xd8, 0x74, 0xb0, 0x4e, 0x1e, 0xb9, 0x47, 0xa8, 0xb7, 0x2d, 0xa8, 0x44,
+	0xb8, 0xeb, 0x64, 0x0e, 0x54, 0xd1, 0x41, 0x84, 0xbb, 0xa9, 0x77, 0xb3, 0x06, 0xd5, 0xf1, 0x71,
+	0xb3, 0x6d, 0x9b, 0x0c, 0x36, 0xda, 0xdc, 0x6f, 0x7a, 0x5e, 0xaa, 0x4e, 0x47, 0xe2, 0x5c, 0xb4,
+	0x2e, 0x41, 0x59, 0x0e, 0x5c, 0x27, 0xf0, 0x54, 0x2f, 0x97, 0xed, 0x15, 0x29, 0x78, 0xdf, 0xe3,
+	0xe6, 0x65, 0xb8, 0x34, 0x26, 0x60, 0xce, 0x27, 0x96, 0x27, 0x65, 0x63, 0xc8, 0x86, 0xf8, 0x5f,
+	0x51, 0x52, 0xa7, 0x34, 0x26, 0x66, 0xce, 0xea, 0x27, 0x0d, 0x2e, 0x1d, 0x3a, 0xc8, 0xe2, 0x0d,
+	0x30, 0x1f, 0xb7, 0x91, 0xeb, 0xa7, 0x34, 0xc7, 0xf5, 0x63, 0x5e, 0x83, 0xff, 0x4d, 0x61, 0x9a,
+	0xef, 0xe8, 0x6b, 0x30, 0x0e, 0x9b, 0x15, 0x2e, 0xee, 0xb9, 0xf6, 0x33, 0xf3, 0x5d, 0x60, 0x5e,
+	0x05, 0x73, 0x72, 0xf0, 0x9c, 0xe2, 0x0b, 0x6d, 0x84, 0x63, 0xd2, 0xb1, 0x69, 0x0f, 0xcf, 0xc7,
+	0xb1, 0x0e, 0xeb, 0x82, 0x76, 0x31, 0x1b, 0x11, 0x4e, 0xa7, 0xcf, 0x25, 0xd1, 0x33, 0xf6, 0x9a,
+	0x38, 0x88, 0xb1, 0xd3, 0xe7, 0x63, 0xb8, 0x16, 0x48, 0xe4, 0x5c, 0x7f, 0xd1, 0xa0, 0x76, 0xc8,
+	0x6c, 0xe4, 0xc2, 0x9d, 0x8f, 0xf1, 0xf8, 0x7b, 0xbf, 0xf4, 0xef, 0xdc, 0xfb, 0x37, 0xa1, 0x3e,
+	0x8b, 0x7d, 0xb6, 0xd5, 0x3b, 0x7f, 0x95, 0xa1, 0xd4, 0xe6, 0x3e, 0xf9, 0x1c, 0x96, 0xd2, 0x67,
+	0xe3, 0xd5, 0x29, 0xe1, 0xf3, 0xe7, 0x84, 0x71, 0xeb, 0x28, 0x56, 0xf9, 0x38, 0x7e, 0x02, 0xa5,
+	0xe4, 0x35, 0x71, 0x65, 0x3a, 0xe8, 0xf1, 0x40, 0x18, 0x37, 0x66, 0x9a, 0xe4, 0x4e, 0x3f, 0x81,
+	0x45, 0x79, 0x69, 0x9a, 0xd3, 0x21, 0x89, 0x8d, 0x71, 0x73, 0xb6, 0x4d, 0xee, 0xf7, 0x85, 0x06,
+	0x1b, 0xe3, 0x86, 0xfb, 0xf6, 0x74, 0x1f, 0x63, 0x20, 0xc6, 0xfd, 0x63, 0x43, 0x72, 0x16, 0x5f,
+	0xc1, 0xfa, 0xc8, 0x1c, 0xb7, 0xa6, 0xbb, 0x3b, 0x6c, 0x6f, 0xdc, 0x3b, 0x9e, 0xfd, 0x3f, 0x4e,
+	0x60, 0xdc, 0xd0, 0x9e, 0x71, 0x02, 0x63, 0x20, 0xc6, 0xfd, 0x63, 0x43, 0x72, 0x16, 0x3f, 0x68,
+	0xa0, 0x4f, 0x9c, 0xd1, 0xf7, 0x8e, 0x7e, 0xb2, 0x45, 0x9c, 0xf1, 0xde, 0xc9, 0x70, 0x39, 0xa9,
+	0xef, 0x34, 0xb8, 0x30, 0x69, 0xce, 0xbe, 0x75, 0x0c, 0xdf, 0x07, 0x30, 0xe3, 0xc1, 0x89, 0x60,
+	0x13, 0x19, 0x15, 0xa7, 0xea, 0x31, 0x18, 0x15, 0x60, 0xc6, 0x83, 0x13, 0xc1, 0x72, 0x46, 0x3f,
+	0x6a, 0x70, 0x79, 0xfa, 0xec, 0x7c, 0xf7, 0xe8, 0x01, 0x46, 0xc0, 0x46, 0x6b, 0x0e, 0x70, 0xc6,
+	0x71, 0xc7, 0x7e, 0xf9, 0xba, 0xaa, 0xbd, 0x7a, 0x5d, 0xd5, 0xfe, 0x78, 0x5d, 0xd5, 0xbe, 0x7f,
+	0x53, 0x5d, 0x78, 0xf5, 0xa6, 0xba, 0xf0, 0xeb, 0x9b, 0xea, 0xc2, 0xa7, 0xef, 0x14, 0xbe, 0xe0,
+	0x64, 0xa0, 0xdb, 0x11, 0x8a, 0x5d, 0x16, 0x77, 0xd3, 0x55, 0x0f, 0x3d, 0x1f, 0xe3, 0xc6, 0xde,
+	0xc8, 0xd7, 0x7a, 0x67, 0x49, 0x7e, 0x85, 0xdf, 0xfd, 0x7b, 0x00, 0x86, 0x42, 0x2f, 0xc0, 0x23,
+	0x10, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Create creates a bank denom which wraps credits.
+	Create(ctx context.Context, in *MsgCreate, opts ...grpc.CallOption) (*MsgCreateResponse, error)
+	// Put puts credits into a basket in return for basket tokens.
+	Put(ctx context.Context, in *MsgPut, opts ...grpc.CallOption) (*MsgPutResponse, error)
+	// Take takes credits from a basket starting from the oldest credits first
+	// unless a different take strategy is specified.
+	Take(ctx context.Context, in *MsgTake, opts ...grpc.CallOption) (*MsgTakeResponse, error)
+	// UpdateBasketCurator transfers the curator role of a basket to a new
+	// address. Only the current curator can update the curator.
+	UpdateBasketCurator(ctx context.Context, in *MsgUpdateBasketCurator, opts ...grpc.CallOption) (*MsgUpdateBasketCuratorResponse, error)
+	// AddBasketClasses adds credit classes to the list of classes allowed to be
+	// put into a basket. Only the basket curator can add classes.
+	AddBasketClasses(ctx context.Context, in *MsgAddBasketClasses, opts ...grpc.CallOption) (*MsgAddBasketClassesResponse, error)
+	// RemoveBasketClasses removes credit classes from the list of classes
+	// allowed to be put into a basket. Credits from removed classes that are
+	// already in the basket remain in the basket and can still be taken. Only
+	// the basket curator can remove classes.
+	RemoveBasketClasses(ctx context.Context, in *MsgRemoveBasketClasses, opts ...grpc.CallOption) (*MsgRemoveBasketClassesResponse, error)
+	// UpdateBasketDateCriteria updates the date criteria for batches admitted to
+	// a basket. The date criteria only applies to credits put into the basket
+	// after the update. Only the basket curator can update the date criteria.
+	UpdateBasketDateCriteria(ctx context.Context, in *MsgUpdateBasketDateCriteria, opts ...grpc.CallOption) (*MsgUpdateBasketDateCriteriaResponse, error)
+	// UpdateBasketDescription updates the description of a basket stored in the
+	// bank denom metadata of the basket token. Only the basket curator can
+	// update the description.
+	UpdateBasketDescription(ctx context.Context, in *MsgUpdateBasketDescription, opts ...grpc.CallOption) (*MsgUpdateBasketDescriptionResponse, error)
+	// UpdateBasketTakePremium updates the premium paid to the curator when
+	// credits are taken from a basket with a strategy other than oldest first.
+	// Only the basket curator can update the take premium.
+	UpdateBasketTakePremium(ctx context.Context, in *MsgUpdateBasketTakePremium, opts ...grpc.CallOption) (*MsgUpdateBasketTakePremiumResponse, error)
+	// UpdateBasketAdmissionCriteria updates the additional criteria for batches
+	// admitted to a basket. The admission criteria only applies to credits put
+	// into the basket after the update. Only the basket curator can update the
+	// admission criteria.
+	UpdateBasketAdmissionCriteria(ctx context.Context, in *MsgUpdateBasketAdmissionCriteria, opts ...grpc.CallOption) (*MsgUpdateBasketAdmissionCriteriaResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) Create(ctx context.Context, in *MsgCreate, opts ...grpc.CallOption) (*MsgCreateResponse, error) {
+	out := new(MsgCreateResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.basket.v1.Msg/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Put(ctx context.Context, in *MsgPut, opts ...grpc.CallOption) (*MsgPutResponse, error) {
+	out := new(MsgPutResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.basket.v1.Msg/Put", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Take(ctx context.Context, in *MsgTake, opts ...grpc.CallOption) (*MsgTakeResponse, error) {
+	out := new(MsgTakeResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.basket.v1.Msg/Take", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateBasketCurator(ctx context.Context, in *MsgUpdateBasketCurator, opts ...grpc.CallOption) (*MsgUpdateBasketCuratorResponse, error) {
+	out := new(MsgUpdateBasketCuratorResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.basket.v1.Msg/UpdateBasketCurator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddBasketClasses(ctx context.Context, in *MsgAddBasketClasses, opts ...grpc.CallOption) (*MsgAddBasketClassesResponse, error) {
+	out := new(MsgAddBasketClassesResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.basket.v1.Msg/AddBasketClasses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveBasketClasses(ctx context.Context, in *MsgRemoveBasketClasses, opts ...grpc.CallOption) (*MsgRemoveBasketClassesResponse, error) {
+	out := new(MsgRemoveBasketClassesResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.basket.v1.Msg/RemoveBasketClasses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateBasketDateCriteria(ctx context.Context, in *MsgUpdateBasketDateCriteria, opts ...grpc.CallOption) (*MsgUpdateBasketDateCriteriaResponse, error) {
+	out := new(MsgUpdateBasketDateCriteriaResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.basket.v1.Msg/UpdateBasketDateCriteria", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateBasketDescription(ctx context.Context, in *MsgUpdateBasketDescription, opts ...grpc.CallOption) (*MsgUpdateBasketDescriptionResponse, error) {
+	out := new(MsgUpdateBasketDescriptionResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.basket.v1.Msg/UpdateBasketDescription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateBasketTakePremium(ctx context.Context, in *MsgUpdateBasketTakePremium, opts ...grpc.CallOption) (*MsgUpdateBasketTakePremiumResponse, error) {
+	out := new(MsgUpdateBasketTakePremiumResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.basket.v1.Msg/UpdateBasketTakePremium", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateBasketAdmissionCriteria(ctx context.Context, in *MsgUpdateBasketAdmissionCriteria, opts ...grpc.CallOption) (*MsgUpdateBasketAdmissionCriteriaResponse, error) {
+	out := new(MsgUpdateBasketAdmissionCriteriaResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.basket.v1.Msg/UpdateBasketAdmissionCriteria", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Create creates a bank denom which wraps credits.
+	Create(context.Context, *MsgCreate) (*MsgCreateResponse, error)
+	// Put puts credits into a basket in return for basket tokens.
+	Put(context.Context, *MsgPut) (*MsgPutResponse, error)
+	// Take takes credits from a basket starting from the oldest credits first
+	// unless a different take strategy is specified.
+	Take(context.Context, *MsgTake) (*MsgTakeResponse, error)
+	// UpdateBasketCurator transfers the curator role of a basket to a new
+	// address. Only the current curator can update the curator.
+	UpdateBasketCurator(context.Context, *MsgUpdateBasketCurator) (*MsgUpdateBasketCuratorResponse, error)
+	// AddBasketClasses adds credit classes to the list of classes allowed to be
+	// put into a basket. Only the basket curator can add classes.
+	AddBasketClasses(context.Context, *MsgAddBasketClasses) (*MsgAddBasketClassesResponse, error)
+	// RemoveBasketClasses removes credit classes from the list of classes
+	// allowed to be put into a basket. Credits from removed classes that are
+	// already in the basket remain in the basket and can still be taken. Only
+	// the basket curator can remove classes.
+	RemoveBasketClasses(context.Context, *MsgRemoveBasketClasses) (*MsgRemoveBasketClassesResponse, error)
+	// UpdateBasketDateCriteria updates the date criteria for batches admitted to
+	// a basket. The date criteria only applies to credits put into the basket
+	// after the update. Only the basket curator can update the date criteria.
+	UpdateBasketDateCriteria(context.Context, *MsgUpdateBasketDateCriteria) (*MsgUpdateBasketDateCriteriaResponse, error)
+	// UpdateBasketDescription updates the description of a basket stored in the
+	// bank denom metadata of the basket token. Only the basket curator can
+	// update the description.
+	UpdateBasketDescription(context.Context, *MsgUpdateBasketDescription) (*MsgUpdateBasketDescriptionResponse, error)
+	// UpdateBasketTakePremium updates the premium paid to the curator when
+	// credits are taken from a basket with a strategy other than oldest first.
+	// Only the basket curator can update the take premium.
+	UpdateBasketTakePremium(context.Context, *MsgUpdateBasketTakePremium) (*MsgUpdateBasketTakePremiumResponse, error)
+	// UpdateBasketAdmissionCriteria updates the additional criteria for batches
+	// admitted to a basket. The admission criteria only applies to credits put
+	// into the basket after the update. Only the basket curator can update the
+	// admission criteria.
+	UpdateBasketAdmissionCriteria(context.Context, *MsgUpdateBasketAdmissionCriteria) (*MsgUpdateBasketAdmissionCriteriaResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) Create(ctx context.Context, req *MsgCreate) (*MsgCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (*UnimplementedMsgServer) Put(ctx context.Context, req *MsgPut) (*MsgPutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Put not implemented")
+}
+func (*UnimplementedMsgServer) Take(ctx context.Context, req *MsgTake) (*MsgTakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Take not implemented")
+}
+func (*UnimplementedMsgServer) UpdateBasketCurator(ctx context.Context, req *MsgUpdateBasketCurator) (*MsgUpdateBasketCuratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBasketCurator not implemented")
+}
+func (*UnimplementedMsgServer) AddBasketClasses(ctx context.Context, req *MsgAddBasketClasses) (*MsgAddBasketClassesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBasketClasses not implemented")
+}
+func (*UnimplementedMsgServer) RemoveBasketClasses(ctx context.Context, req *MsgRemoveBasketClasses) (*MsgRemoveBasketClassesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBasketClasses not implemented")
+}
+func (*UnimplementedMsgServer) UpdateBasketDateCriteria(ctx context.Context, req *MsgUpdateBasketDateCriteria) (*MsgUpdateBasketDateCriteriaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBasketDateCriteria not implemented")
+}
+func (*UnimplementedMsgServer) UpdateBasketDescription(ctx context.Context, req *MsgUpdateBasketDescription) (*MsgUpdateBasketDescriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBasketDescription not implemented")
+}
+func (*UnimplementedMsgServer) UpdateBasketTakePremium(ctx context.Context, req *MsgUpdateBasketTakePremium) (*MsgUpdateBasketTakePremiumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBasketTakePremium not implemented")
+}
+func (*UnimplementedMsgServer) UpdateBasketAdmissionCriteria(ctx context.Context, req *MsgUpdateBasketAdmissionCriteria) (*MsgUpdateBasketAdmissionCriteriaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBasketAdmissionCriteria not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.basket.v1.Msg/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Create(ctx, req.(*MsgCreate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Put_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPut)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Put(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.basket.v1.Msg/Put",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Put(ctx, req.(*MsgPut))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Take_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Take(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.basket.v1.Msg/Take",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Take(ctx, req.(*MsgTake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateBasketCurator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBasketCurator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBasketCurator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.basket.v1.Msg/UpdateBasketCurator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBasketCurator(ctx, req.(*MsgUpdateBasketCurator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddBasketClasses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddBasketClasses)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddBasketClasses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.basket.v1.Msg/AddBasketClasses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddBasketClasses(ctx, req.(*MsgAddBasketClasses))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveBasketClasses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveBasketClasses)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveBasketClasses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.basket.v1.Msg/RemoveBasketClasses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveBasketClasses(ctx, req.(*MsgRemoveBasketClasses))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateBasketDateCriteria_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBasketDateCriteria)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBasketDateCriteria(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.basket.v1.Msg/UpdateBasketDateCriteria",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBasketDateCriteria(ctx, req.(*MsgUpdateBasketDateCriteria))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateBasketDescription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBasketDescription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBasketDescription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.basket.v1.Msg/UpdateBasketDescription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBasketDescription(ctx, req.(*MsgUpdateBasketDescription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateBasketTakePremium_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBasketTakePremium)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBasketTakePremium(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.basket.v1.Msg/UpdateBasketTakePremium",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBasketTakePremium(ctx, req.(*MsgUpdateBasketTakePremium))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateBasketAdmissionCriteria_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBasketAdmissionCriteria)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBasketAdmissionCriteria(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.basket.v1.Msg/UpdateBasketAdmissionCriteria",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBasketAdmissionCriteria(ctx, req.(*MsgUpdateBasketAdmissionCriteria))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "regen.ecocredit.basket.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _Msg_Create_Handler,
+		},
+		{
+			MethodName: "Put",
+			Handler:    _Msg_Put_Handler,
+		},
+		{
+			MethodName: "Take",
+			Handler:    _Msg_Take_Handler,
+		},
+		{
+			MethodName: "UpdateBasketCurator",
+			Handler:    _Msg_UpdateBasketCurator_Handler,
+		},
+		{
+			MethodName: "AddBasketClasses",
+			Handler:    _Msg_AddBasketClasses_Handler,
+		},
+		{
+			MethodName: "RemoveBasketClasses",
+			Handler:    _Msg_RemoveBasketClasses_Handler,
+		},
+		{
+			MethodName: "UpdateBasketDateCriteria",
+			Handler:    _Msg_UpdateBasketDateCriteria_Handler,
+		},
+		{
+			MethodName: "UpdateBasketDescription",
+			Handler:    _Msg_UpdateBasketDescription_Handler,
+		},
+		{
+			MethodName: "UpdateBasketTakePremium",
+			Handler:    _Msg_UpdateBasketTakePremium_Handler,
+		},
+		{
+			MethodName: "UpdateBasketAdmissionCriteria",
+			Handler:    _Msg_UpdateBasketAdmissionCriteria_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "regen/ecocredit/basket/v1/tx.proto",
+}
+
+func (m *MsgCreate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AdmissionCriteria != nil {
+		{
+			size, err := m.AdmissionCriteria.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.DateCriteria != nil {
+		{
+			size, err := m.DateCriteria.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.AllowedClasses) > 0 {
+		for iNdEx := len(m.AllowedClasses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedClasses[iNdEx])
+			copy(dAtA[i:], m.AllowedClasses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AllowedClasses[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.CreditTypeAbbrev) > 0 {
+		i -= len(m.CreditTypeAbbrev)
+		copy(dAtA[i:], m.CreditTypeAbbrev)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CreditTypeAbbrev)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.DisableAutoRetire {
+		i--
+		if m.DisableAutoRetire {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Exponent != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Exponent))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Curator) > 0 {
+		i -= len(m.Curator)
+		copy(dAtA[i:], m.Curator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Curator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BasketDenom) > 0 {
+		i -= len(m.BasketDenom)
+		copy(dAtA[i:], m.BasketDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BasketDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPut) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPut) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Credits) > 0 {
+		for iNdEx := len(m.Credits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Credits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BasketDenom) > 0 {
+		i -= len(m.BasketDenom)
+		copy(dAtA[i:], m.BasketDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BasketDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AmountReceived) > 0 {
+		i -= len(m.AmountReceived)
+		copy(dAtA[i:], m.AmountReceived)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AmountReceived)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxPremium) > 0 {
+		i -= len(m.MaxPremium)
+		copy(dAtA[i:], m.MaxPremium)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MaxPremium)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.BatchDenoms) > 0 {
+		for iNdEx := len(m.BatchDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BatchDenoms[iNdEx])
+			copy(dAtA[i:], m.BatchDenoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.BatchDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.Strategy != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Strategy))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.BeneficiaryName) > 0 {
+		i -= len(m.BeneficiaryName)
+		copy(dAtA[i:], m.BeneficiaryName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BeneficiaryName)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.BeneficiaryAddress) > 0 {
+		i -= len(m.BeneficiaryAddress)
+		copy(dAtA[i:], m.BeneficiaryAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BeneficiaryAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.RetirementJurisdiction) > 0 {
+		i -= len(m.RetirementJurisdiction)
+		copy(dAtA[i:], m.RetirementJurisdiction)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RetirementJurisdiction)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.RetireOnTake {
+		i--
+		if m.RetireOnTake {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.RetirementLocation) > 0 {
+		i -= len(m.RetirementLocation)
+		copy(dAtA[i:], m.RetirementLocation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RetirementLocation)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BasketDenom) > 0 {
+		i -= len(m.BasketDenom)
+		copy(dAtA[i:], m.BasketDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BasketDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Premium) > 0 {
+		i -= len(m.Premium)
+		copy(dAtA[i:], m.Premium)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Premium)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Credits) > 0 {
+		for iNdEx := len(m.Credits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Credits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateBasketCurator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBasketCurator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBasketCurator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewCurator) > 0 {
+		i -= len(m.NewCurator)
+		copy(dAtA[i:], m.NewCurator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewCurator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BasketDenom) > 0 {
+		i -= len(m.BasketDenom)
+		copy(dAtA[i:], m.BasketDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BasketDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Curator) > 0 {
+		i -= len(m.Curator)
+		copy(dAtA[i:], m.Curator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Curator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateBasketCuratorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBasketCuratorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBasketCuratorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddBasketClasses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddBasketClasses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddBasketClasses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClassIds) > 0 {
+		for iNdEx := len(m.ClassIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ClassIds[iNdEx])
+			copy(dAtA[i:], m.ClassIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ClassIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BasketDenom) > 0 {
+		i -= len(m.BasketDenom)
+		copy(dAtA[i:], m.BasketDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BasketDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Curator) > 0 {
+		i -= len(m.Curator)
+		copy(dAtA[i:], m.Curator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Curator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddBasketClassesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddBasketClassesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddBasketClassesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveBasketClasses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveBasketClasses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveBasketClasses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClassIds) > 0 {
+		for iNdEx := len(m.ClassIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ClassIds[iNdEx])
+			copy(dAtA[i:], m.ClassIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ClassIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BasketDenom) > 0 {
+		i -= len(m.BasketDenom)
+		copy(dAtA[i:], m.BasketDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BasketDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Curator) > 0 {
+		i -= len(m.Curator)
+		copy(dAtA[i:], m.Curator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Curator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveBasketClassesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveBasketClassesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveBasketClassesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateBasketDateCriteria) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBasketDateCriteria) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBasketDateCriteria) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DateCriteria != nil {
+		{
+			size, err := m.DateCriteria.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BasketDenom) > 0 {
+		i -= len(m.BasketDenom)
+		copy(dAtA[i:], m.BasketDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BasketDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Curator) > 0 {
+		i -= len(m.Curator)
+		copy(dAtA[i:], m.Curator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Curator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateBasketDateCriteriaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBasketDateCriteriaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBasketDateCriteriaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateBasketDescription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBasketDescription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBasketDescription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BasketDenom) > 0 {
+		i -= len(m.BasketDenom)
+		copy(dAtA[i:], m.BasketDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BasketDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Curator) > 0 {
+		i -= len(m.Curator)
+		copy(dAtA[i:], m.Curator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Curator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateBasketDescriptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBasketDescriptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBasketDescriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateBasketTakePremium) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBasketTakePremium) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBasketTakePremium) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TakePremiumBps != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TakePremiumBps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BasketDenom) > 0 {
+		i -= len(m.BasketDenom)
+		copy(dAtA[i:], m.BasketDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BasketDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Curator) > 0 {
+		i -= len(m.Curator)
+		copy(dAtA[i:], m.Curator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Curator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateBasketTakePremiumResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBasketTakePremiumResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBasketTakePremiumResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateBasketAdmissionCriteria) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBasketAdmissionCriteria) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBasketAdmissionCriteria) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AdmissionCriteria != nil {
+		{
+			size, err := m.AdmissionCriteria.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BasketDenom) > 0 {
+		i -= len(m.BasketDenom)
+		copy(dAtA[i:], m.BasketDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BasketDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Curator) > 0 {
+		i -= len(m.Curator)
+		copy(dAtA[i:], m.Curator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Curator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateBasketAdmissionCriteriaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBasketAdmissionCriteriaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBasketAdmissionCriteriaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Curator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Exponent != 0 {
+		n += 1 + sovTx(uint64(m.Exponent))
+	}
+	if m.DisableAutoRetire {
+		n += 2
+	}
+	l = len(m.CreditTypeAbbrev)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AllowedClasses) > 0 {
+		for _, s := range m.AllowedClasses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.DateCriteria != nil {
+		l = m.DateCriteria.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.AdmissionCriteria != nil {
+		l = m.AdmissionCriteria.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BasketDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BasketDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Credits) > 0 {
+		for _, e := range m.Credits {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgPutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AmountReceived)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BasketDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RetirementLocation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RetireOnTake {
+		n += 2
+	}
+	l = len(m.RetirementJurisdiction)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BeneficiaryAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BeneficiaryName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Strategy != 0 {
+		n += 1 + sovTx(uint64(m.Strategy))
+	}
+	if len(m.BatchDenoms) > 0 {
+		for _, s := range m.BatchDenoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.MaxPremium)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Credits) > 0 {
+		for _, e := range m.Credits {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Premium)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateBasketCurator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Curator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BasketDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewCurator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateBasketCuratorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddBasketClasses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Curator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BasketDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ClassIds) > 0 {
+		for _, s := range m.ClassIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddBasketClassesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveBasketClasses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Curator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BasketDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ClassIds) > 0 {
+		for _, s := range m.ClassIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRemoveBasketClassesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateBasketDateCriteria) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Curator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BasketDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DateCriteria != nil {
+		l = m.DateCriteria.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateBasketDateCriteriaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateBasketDescription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Curator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BasketDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateBasketDescriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateBasketTakePremium) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Curator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BasketDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TakePremiumBps != 0 {
+		n += 1 + sovTx(uint64(m.TakePremiumBps))
+	}
+	return n
+}
+
+func (m *MsgUpdateBasketTakePremiumResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateBasketAdmissionCriteria) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Curator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BasketDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AdmissionCriteria != nil {
+		l = m.AdmissionCriteria.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateBasketAdmissionCriteriaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Curator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			}
+			m.Exponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableAutoRetire = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedClasses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedClasses = append(m.AllowedClasses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DateCriteria", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DateCriteria == nil {
+				m.DateCriteria = &DateCriteria{}
+			}
+			if err := m.DateCriteria.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdmissionCriteria", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AdmissionCriteria == nil {
+				m.AdmissionCriteria = &AdmissionCriteria{}
+			}
+			if err := m.AdmissionCriteria.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BasketDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPut) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPut: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPut: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BasketDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Credits = append(m.Credits, &BasketCredit{})
+			if err := m.Credits[len(m.Credits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountReceived", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountReceived = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BasketDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementLocation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetirementLocation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetireOnTake", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RetireOnTake = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementJurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetirementJurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeneficiaryAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeneficiaryAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeneficiaryName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeneficiaryName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			m.Strategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Strategy |= TakeStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchDenoms = append(m.BatchDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPremium", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxPremium = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Credits = append(m.Credits, &BasketCredit{})
+			if err := m.Credits[len(m.Credits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Premium", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Premium = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateBasketCurator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateBasketCurator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateBasketCurator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Curator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BasketDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewCurator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewCurator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateBasketCuratorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateBasketCuratorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateBasketCuratorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddBasketClasses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddBasketClasses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddBasketClasses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Curator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BasketDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassIds = append(m.ClassIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddBasketClassesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddBasketClassesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddBasketClassesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveBasketClasses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveBasketClasses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveBasketClasses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Curator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BasketDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassIds = append(m.ClassIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgRemoveBasketClassesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveBasketClassesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveBasketClassesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgCreate) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateBasketDateCriteria) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1205,10 +4924,10 @@ func (m *MsgCreate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateBasketDateCriteria: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateBasketDateCriteria: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1245,7 +4964,7 @@ func (m *MsgCreate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1273,13 +4992,13 @@ func (m *MsgCreate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.BasketDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DateCriteria", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1289,98 +5008,131 @@ func (m *MsgCreate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if m.DateCriteria == nil {
+				m.DateCriteria = &DateCriteria{}
+			}
+			if err := m.DateCriteria.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Exponent = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Exponent |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateBasketDateCriteriaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			m.DisableAutoRetire = bool(v != 0)
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateBasketDateCriteriaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateBasketDateCriteriaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateBasketDescription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateBasketDescription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateBasketDescription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedClasses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1408,13 +5160,13 @@ func (m *MsgCreate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowedClasses = append(m.AllowedClasses, string(dAtA[iNdEx:postIndex]))
+			m.Curator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DateCriteria", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1424,33 +5176,29 @@ func (m *MsgCreate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DateCriteria == nil {
-				m.DateCriteria = &DateCriteria{}
-			}
-			if err := m.DateCriteria.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.BasketDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1460,25 +5208,23 @@ func (m *MsgCreate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fee = append(m.Fee, types.Coin{})
-			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1501,7 +5247,7 @@ func (m *MsgCreate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateBasketDescriptionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1524,44 +5270,12 @@ func (m *MsgCreateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateBasketDescriptionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateBasketDescriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BasketDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BasketDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1583,7 +5297,7 @@ func (m *MsgCreateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPut) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateBasketTakePremium) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1606,15 +5320,15 @@ func (m *MsgPut) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPut: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateBasketTakePremium: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPut: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateBasketTakePremium: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1642,7 +5356,7 @@ func (m *MsgPut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Curator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1677,10 +5391,10 @@ func (m *MsgPut) Unmarshal(dAtA []byte) error {
 			m.BasketDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakePremiumBps", wireType)
 			}
-			var msglen int
+			m.TakePremiumBps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1690,26 +5404,11 @@ func (m *MsgPut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TakePremiumBps |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Credits = append(m.Credits, &BasketCredit{})
-			if err := m.Credits[len(m.Credits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1731,7 +5430,7 @@ func (m *MsgPut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateBasketTakePremiumResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1754,44 +5453,12 @@ func (m *MsgPutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateBasketTakePremiumResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateBasketTakePremiumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountReceived", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AmountReceived = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1813,7 +5480,7 @@ func (m *MsgPutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTake) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateBasketAdmissionCriteria) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1836,15 +5503,15 @@ func (m *MsgTake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateBasketAdmissionCriteria: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateBasketAdmissionCriteria: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Curator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1872,7 +5539,7 @@ func (m *MsgTake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Curator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1908,41 +5575,9 @@ func (m *MsgTake) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetirementLocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdmissionCriteria", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1952,75 +5587,27 @@ func (m *MsgTake) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RetirementLocation = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetireOnTake", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.RetireOnTake = bool(v != 0)
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetirementJurisdiction", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if m.AdmissionCriteria == nil {
+				m.AdmissionCriteria = &AdmissionCriteria{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.AdmissionCriteria.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.RetirementJurisdiction = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2043,7 +5630,7 @@ func (m *MsgTake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateBasketAdmissionCriteriaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2066,46 +5653,12 @@ func (m *MsgTakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateBasketAdmissionCriteriaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateBasketAdmissionCriteriaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Credits = append(m.Credits, &BasketCredit{})
-			if err := m.Credits[len(m.Credits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
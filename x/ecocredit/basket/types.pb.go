@@ -25,6 +25,50 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TakeStrategy is the strategy used to select the credits taken from a basket.
+type TakeStrategy int32
+
+const (
+	// TAKE_STRATEGY_UNSPECIFIED defaults to taking the oldest credits first.
+	TakeStrategy_TAKE_STRATEGY_UNSPECIFIED TakeStrategy = 0
+	// TAKE_STRATEGY_OLDEST_FIRST takes credits from the batches with the oldest
+	// start date first.
+	TakeStrategy_TAKE_STRATEGY_OLDEST_FIRST TakeStrategy = 1
+	// TAKE_STRATEGY_NEWEST_FIRST takes credits from the batches with the newest
+	// start date first.
+	TakeStrategy_TAKE_STRATEGY_NEWEST_FIRST TakeStrategy = 2
+	// TAKE_STRATEGY_PROPORTIONAL takes credits from all batches in the basket
+	// proportionally to the balance of each batch in the basket.
+	TakeStrategy_TAKE_STRATEGY_PROPORTIONAL TakeStrategy = 3
+	// TAKE_STRATEGY_SELECTED_BATCHES takes credits from the batches listed in
+	// the request in the order they are listed.
+	TakeStrategy_TAKE_STRATEGY_SELECTED_BATCHES TakeStrategy = 4
+)
+
+var TakeStrategy_name = map[int32]string{
+	0: "TAKE_STRATEGY_UNSPECIFIED",
+	1: "TAKE_STRATEGY_OLDEST_FIRST",
+	2: "TAKE_STRATEGY_NEWEST_FIRST",
+	3: "TAKE_STRATEGY_PROPORTIONAL",
+	4: "TAKE_STRATEGY_SELECTED_BATCHES",
+}
+
+var TakeStrategy_value = map[string]int32{
+	"TAKE_STRATEGY_UNSPECIFIED":      0,
+	"TAKE_STRATEGY_OLDEST_FIRST":     1,
+	"TAKE_STRATEGY_NEWEST_FIRST":     2,
+	"TAKE_STRATEGY_PROPORTIONAL":     3,
+	"TAKE_STRATEGY_SELECTED_BATCHES": 4,
+}
+
+func (x TakeStrategy) String() string {
+	return proto.EnumName(TakeStrategy_name, int32(x))
+}
+
+func (TakeStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e6c256e957c69c4d, []int{0}
+}
+
 // BasketCredit represents the information for a credit batch inside a basket.
 type BasketCredit struct {
 	// batch_denom is the unique ID of the credit batch.
@@ -161,9 +205,117 @@ func (m *DateCriteria) GetYearsInThePast() uint32 {
 	return 0
 }
 
+// AdmissionCriteria represents additional criteria for batches admitted to a
+// basket beyond the credit type, allowed classes and date criteria of the
+// basket. All of the fields are optional and only the fields that are set are
+// checked when credits are put into the basket.
+type AdmissionCriteria struct {
+	// allowed_project_ids (optional) are the only projects from which batches
+	// are allowed into the basket. At most only one of `allowed_project_ids` and
+	// `denied_project_ids` can be set for a basket.
+	AllowedProjectIds []string `protobuf:"bytes,1,rep,name=allowed_project_ids,json=allowedProjectIds,proto3" json:"allowed_project_ids,omitempty"`
+	// denied_project_ids (optional) are projects from which batches are not
+	// allowed into the basket. At most only one of `allowed_project_ids` and
+	// `denied_project_ids` can be set for a basket.
+	DeniedProjectIds []string `protobuf:"bytes,2,rep,name=denied_project_ids,json=deniedProjectIds,proto3" json:"denied_project_ids,omitempty"`
+	// allowed_jurisdiction_prefixes (optional) are jurisdiction prefixes (e.g.
+	// "US-" or "KE") of which the project jurisdiction of a batch must start with
+	// at least one to be allowed into the basket.
+	AllowedJurisdictionPrefixes []string `protobuf:"bytes,3,rep,name=allowed_jurisdiction_prefixes,json=allowedJurisdictionPrefixes,proto3" json:"allowed_jurisdiction_prefixes,omitempty"`
+	// denied_jurisdiction_prefixes (optional) are jurisdiction prefixes (e.g.
+	// "US-" or "KE") with which the project jurisdiction of a batch must not
+	// start to be allowed into the basket.
+	DeniedJurisdictionPrefixes []string `protobuf:"bytes,4,rep,name=denied_jurisdiction_prefixes,json=deniedJurisdictionPrefixes,proto3" json:"denied_jurisdiction_prefixes,omitempty"`
+	// max_batch_age (optional) is the maximum duration of time between the
+	// issuance date of a batch and the block timestamp at which credits from the
+	// batch can be put into the basket.
+	MaxBatchAge *types.Duration `protobuf:"bytes,5,opt,name=max_batch_age,json=maxBatchAge,proto3" json:"max_batch_age,omitempty"`
+	// attestor (optional) is the address of an attestor that must have attested
+	// to the metadata IRI of a batch in the data module for credits from the
+	// batch to be allowed into the basket.
+	Attestor string `protobuf:"bytes,6,opt,name=attestor,proto3" json:"attestor,omitempty"`
+}
+
+func (m *AdmissionCriteria) Reset()         { *m = AdmissionCriteria{} }
+func (m *AdmissionCriteria) String() string { return proto.CompactTextString(m) }
+func (*AdmissionCriteria) ProtoMessage()    {}
+func (*AdmissionCriteria) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6c256e957c69c4d, []int{2}
+}
+func (m *AdmissionCriteria) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdmissionCriteria) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdmissionCriteria.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdmissionCriteria) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdmissionCriteria.Merge(m, src)
+}
+func (m *AdmissionCriteria) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdmissionCriteria) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdmissionCriteria.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdmissionCriteria proto.InternalMessageInfo
+
+func (m *AdmissionCriteria) GetAllowedProjectIds() []string {
+	if m != nil {
+		return m.AllowedProjectIds
+	}
+	return nil
+}
+
+func (m *AdmissionCriteria) GetDeniedProjectIds() []string {
+	if m != nil {
+		return m.DeniedProjectIds
+	}
+	return nil
+}
+
+func (m *AdmissionCriteria) GetAllowedJurisdictionPrefixes() []string {
+	if m != nil {
+		return m.AllowedJurisdictionPrefixes
+	}
+	return nil
+}
+
+func (m *AdmissionCriteria) GetDeniedJurisdictionPrefixes() []string {
+	if m != nil {
+		return m.DeniedJurisdictionPrefixes
+	}
+	return nil
+}
+
+func (m *AdmissionCriteria) GetMaxBatchAge() *types.Duration {
+	if m != nil {
+		return m.MaxBatchAge
+	}
+	return nil
+}
+
+func (m *AdmissionCriteria) GetAttestor() string {
+	if m != nil {
+		return m.Attestor
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("regen.ecocredit.basket.v1.TakeStrategy", TakeStrategy_name, TakeStrategy_value)
 	proto.RegisterType((*BasketCredit)(nil), "regen.ecocredit.basket.v1.BasketCredit")
 	proto.RegisterType((*DateCriteria)(nil), "regen.ecocredit.basket.v1.DateCriteria")
+	proto.RegisterType((*AdmissionCriteria)(nil), "regen.ecocredit.basket.v1.AdmissionCriteria")
 }
 
 func init() {
@@ -171,30 +323,45 @@ func init() {
 }
 
 var fileDescriptor_e6c256e957c69c4d = []byte{
-	// 353 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xc1, 0x6b, 0xe2, 0x40,
-	0x14, 0xc6, 0xcd, 0x2e, 0x08, 0x3b, 0xba, 0x2e, 0xe6, 0xb0, 0xa8, 0x87, 0x28, 0xc2, 0x82, 0x7b,
-	0xe8, 0x0c, 0xda, 0x4b, 0x8f, 0x45, 0x2d, 0xa5, 0xb7, 0x92, 0x0a, 0x85, 0x5e, 0xc2, 0x24, 0x79,
-	0x4d, 0x06, 0xcd, 0x4c, 0x98, 0x79, 0xd1, 0xfa, 0x5f, 0xf4, 0x6f, 0xea, 0xa9, 0x47, 0x8f, 0x3d,
-	0x16, 0xfd, 0x47, 0x8a, 0x13, 0x6d, 0xa1, 0x1e, 0xdf, 0xfb, 0xbe, 0xef, 0x37, 0xdf, 0xf0, 0xc8,
-	0x3f, 0x0d, 0x09, 0x48, 0x06, 0x91, 0x8a, 0x34, 0xc4, 0x02, 0x59, 0xc8, 0xcd, 0x1c, 0x90, 0x2d,
-	0x87, 0x0c, 0xd7, 0x39, 0x18, 0x9a, 0x6b, 0x85, 0xca, 0x6d, 0x5b, 0x1b, 0xfd, 0xb4, 0xd1, 0xd2,
-	0x46, 0x97, 0xc3, 0x4e, 0x37, 0x51, 0x2a, 0x59, 0x00, 0xb3, 0xc6, 0xb0, 0x78, 0x64, 0x28, 0x32,
-	0x30, 0xc8, 0xb3, 0xbc, 0xcc, 0x76, 0xbc, 0xef, 0x86, 0xb8, 0xd0, 0x1c, 0x85, 0x92, 0xa5, 0xde,
-	0xbf, 0x26, 0xf5, 0xb1, 0xa5, 0x4d, 0x2c, 0xda, 0xed, 0x92, 0x5a, 0xc8, 0x31, 0x4a, 0x83, 0x18,
-	0xa4, 0xca, 0x5a, 0x4e, 0xcf, 0x19, 0xfc, 0xf2, 0x89, 0x5d, 0x4d, 0xf7, 0x1b, 0xf7, 0x2f, 0xa9,
-	0xf2, 0x4c, 0x15, 0x12, 0x5b, 0x3f, 0xac, 0x76, 0x98, 0xfa, 0x2f, 0x0e, 0xa9, 0x4f, 0x39, 0xc2,
-	0x44, 0x0b, 0x04, 0x2d, 0xb8, 0x7b, 0x49, 0x1a, 0x99, 0x90, 0x81, 0x41, 0xae, 0x31, 0x88, 0x39,
-	0x82, 0x85, 0xd5, 0x46, 0x1d, 0x5a, 0x56, 0xa2, 0xc7, 0x4a, 0x74, 0x76, 0xec, 0xec, 0xd7, 0x33,
-	0x21, 0xef, 0xf6, 0x81, 0x3d, 0xc9, 0xbd, 0x22, 0xcd, 0xaf, 0x74, 0xb0, 0x12, 0x32, 0x56, 0x2b,
-	0xfb, 0x6a, 0x6d, 0xd4, 0x3e, 0x81, 0x4c, 0x0f, 0xff, 0xf2, 0xff, 0x98, 0x23, 0xe0, 0xde, 0x26,
-	0xdc, 0xff, 0xa4, 0xb9, 0x06, 0xae, 0x4d, 0x20, 0x64, 0x80, 0x29, 0x04, 0x39, 0x37, 0xd8, 0xfa,
-	0xd9, 0x73, 0x06, 0xbf, 0xfd, 0x86, 0x15, 0x6e, 0xe4, 0x2c, 0x85, 0x5b, 0x6e, 0x70, 0xec, 0xbf,
-	0x6e, 0x3d, 0x67, 0xb3, 0xf5, 0x9c, 0xf7, 0xad, 0xe7, 0x3c, 0xef, 0xbc, 0xca, 0x66, 0xe7, 0x55,
-	0xde, 0x76, 0x5e, 0xe5, 0xe1, 0x22, 0x11, 0x98, 0x16, 0x21, 0x8d, 0x54, 0xc6, 0xec, 0x39, 0xce,
-	0x24, 0xe0, 0x4a, 0xe9, 0xf9, 0x61, 0x5a, 0x40, 0x9c, 0x80, 0x66, 0x4f, 0x27, 0xc7, 0x0c, 0xab,
-	0xb6, 0xe2, 0xf9, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb6, 0xf9, 0xb4, 0xee, 0xed, 0x01, 0x00,
-	0x00,
+	// 603 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0xc7, 0xe3, 0x84, 0x8b, 0x2e, 0x93, 0xc0, 0x4d, 0xe6, 0x4a, 0x95, 0x49, 0x8b, 0x41, 0x91,
+	0x2a, 0xd1, 0xaa, 0xb5, 0x05, 0xdd, 0x74, 0x53, 0x89, 0x7c, 0x18, 0x9a, 0x16, 0x91, 0xc8, 0x76,
+	0x85, 0xda, 0xcd, 0x68, 0x12, 0x1f, 0x9c, 0x81, 0xd8, 0x13, 0xcd, 0x4c, 0x48, 0x78, 0x8b, 0xbe,
+	0x4a, 0x5f, 0xa1, 0xab, 0x2e, 0x51, 0x57, 0x5d, 0x56, 0xf0, 0x22, 0x55, 0xc6, 0x0e, 0x5f, 0x41,
+	0xea, 0x72, 0xe6, 0xf7, 0x3b, 0xff, 0x39, 0x67, 0xec, 0x41, 0xcf, 0x05, 0x44, 0x90, 0x38, 0xd0,
+	0xe7, 0x7d, 0x01, 0x21, 0x53, 0x4e, 0x8f, 0xca, 0x33, 0x50, 0xce, 0xf9, 0x8e, 0xa3, 0x2e, 0x46,
+	0x20, 0xed, 0x91, 0xe0, 0x8a, 0xe3, 0x75, 0xad, 0xd9, 0x37, 0x9a, 0x9d, 0x6a, 0xf6, 0xf9, 0x4e,
+	0x75, 0x33, 0xe2, 0x3c, 0x1a, 0x82, 0xa3, 0xc5, 0xde, 0xf8, 0xc4, 0x51, 0x2c, 0x06, 0xa9, 0x68,
+	0x3c, 0x4a, 0x6b, 0xab, 0xd6, 0x43, 0x21, 0x1c, 0x0b, 0xaa, 0x18, 0x4f, 0x52, 0x5e, 0x3b, 0x40,
+	0xa5, 0x86, 0x4e, 0x6b, 0xea, 0x68, 0xbc, 0x89, 0x8a, 0x3d, 0xaa, 0xfa, 0x03, 0x12, 0x42, 0xc2,
+	0x63, 0xd3, 0xd8, 0x32, 0xb6, 0x57, 0x3c, 0xa4, 0xb7, 0x5a, 0xb3, 0x1d, 0xfc, 0x04, 0x2d, 0xd3,
+	0x98, 0x8f, 0x13, 0x65, 0xe6, 0x35, 0xcb, 0x56, 0xb5, 0xef, 0x06, 0x2a, 0xb5, 0xa8, 0x82, 0xa6,
+	0x60, 0x0a, 0x04, 0xa3, 0x78, 0x0f, 0xad, 0xc5, 0x2c, 0x21, 0x52, 0x51, 0xa1, 0x48, 0x48, 0x15,
+	0xe8, 0xb0, 0xe2, 0x6e, 0xd5, 0x4e, 0x5b, 0xb2, 0xe7, 0x2d, 0xd9, 0xc1, 0xbc, 0x67, 0xaf, 0x14,
+	0xb3, 0xc4, 0x9f, 0x15, 0xcc, 0x92, 0xb0, 0x8b, 0x2a, 0xb7, 0xd5, 0x64, 0xc2, 0x92, 0x90, 0x4f,
+	0xf4, 0xa9, 0xc5, 0xdd, 0xf5, 0x85, 0x90, 0x56, 0x36, 0x97, 0xf7, 0x9f, 0x9c, 0x07, 0x1c, 0xeb,
+	0x0a, 0xfc, 0x02, 0x55, 0x2e, 0x80, 0x0a, 0x49, 0x58, 0x42, 0xd4, 0x00, 0xc8, 0x88, 0x4a, 0x65,
+	0x16, 0xb6, 0x8c, 0xed, 0x55, 0x6f, 0x4d, 0x83, 0x76, 0x12, 0x0c, 0xa0, 0x4b, 0xa5, 0xaa, 0xfd,
+	0xcc, 0xa3, 0x4a, 0x3d, 0x8c, 0x99, 0x94, 0x8c, 0x27, 0x37, 0x93, 0xd8, 0xe8, 0x7f, 0x3a, 0x1c,
+	0xf2, 0x09, 0x84, 0x64, 0x24, 0xf8, 0x29, 0xf4, 0x15, 0x61, 0xa1, 0x34, 0x8d, 0xad, 0xc2, 0xf6,
+	0x8a, 0x57, 0xc9, 0x50, 0x37, 0x25, 0xed, 0x50, 0xe2, 0x57, 0x08, 0x87, 0x90, 0xb0, 0x07, 0x7a,
+	0x5e, 0xeb, 0xe5, 0x94, 0xdc, 0xb1, 0x1b, 0x68, 0x63, 0x9e, 0x7e, 0x3a, 0x16, 0x4c, 0x86, 0xac,
+	0x3f, 0x9b, 0x83, 0x8c, 0x04, 0x9c, 0xb0, 0x29, 0x48, 0xb3, 0xa0, 0x0b, 0x9f, 0x66, 0xd2, 0x87,
+	0x3b, 0x4e, 0x37, 0x53, 0xf0, 0x1e, 0x7a, 0x96, 0x9d, 0xf8, 0x78, 0xc4, 0x92, 0x8e, 0xa8, 0xa6,
+	0xce, 0xa3, 0x09, 0xef, 0xd0, 0x6a, 0x4c, 0xa7, 0x24, 0xfd, 0xf6, 0x34, 0x02, 0xf3, 0x9f, 0xbf,
+	0xdd, 0x73, 0x31, 0xa6, 0xd3, 0xc6, 0x4c, 0xaf, 0x47, 0x80, 0xab, 0xe8, 0x5f, 0xaa, 0x14, 0x48,
+	0xc5, 0x85, 0xb9, 0xac, 0xff, 0x8b, 0x9b, 0xf5, 0xcb, 0x6f, 0x06, 0x2a, 0x05, 0xf4, 0x0c, 0x7c,
+	0x25, 0xa8, 0x82, 0xe8, 0x02, 0x6f, 0xa0, 0xf5, 0xa0, 0xfe, 0xd1, 0x25, 0x7e, 0xe0, 0xd5, 0x03,
+	0xf7, 0xe0, 0x33, 0xf9, 0x74, 0xe4, 0x77, 0xdd, 0x66, 0x7b, 0xbf, 0xed, 0xb6, 0xca, 0x39, 0x6c,
+	0xa1, 0xea, 0x7d, 0xdc, 0x39, 0x6c, 0xb9, 0x7e, 0x40, 0xf6, 0xdb, 0x9e, 0x1f, 0x94, 0x8d, 0x45,
+	0x7e, 0xe4, 0x1e, 0xdf, 0xf2, 0xfc, 0x22, 0xef, 0x7a, 0x9d, 0x6e, 0xc7, 0x0b, 0xda, 0x9d, 0xa3,
+	0xfa, 0x61, 0xb9, 0x80, 0x6b, 0xc8, 0xba, 0xcf, 0x7d, 0xf7, 0xd0, 0x6d, 0x06, 0x6e, 0x8b, 0x34,
+	0xea, 0x41, 0xf3, 0xbd, 0xeb, 0x97, 0x97, 0x1a, 0xde, 0x8f, 0x2b, 0xcb, 0xb8, 0xbc, 0xb2, 0x8c,
+	0xdf, 0x57, 0x96, 0xf1, 0xf5, 0xda, 0xca, 0x5d, 0x5e, 0x5b, 0xb9, 0x5f, 0xd7, 0x56, 0xee, 0xcb,
+	0xdb, 0x88, 0xa9, 0xc1, 0xb8, 0x67, 0xf7, 0x79, 0xec, 0xe8, 0x77, 0xf9, 0x3a, 0x01, 0x35, 0xe1,
+	0xe2, 0x2c, 0x5b, 0x0d, 0x21, 0x8c, 0x40, 0x38, 0xd3, 0x85, 0x57, 0xdd, 0x5b, 0xd6, 0x77, 0xf8,
+	0xe6, 0xcf, 0x00, 0x70, 0x0b, 0xe7, 0x29, 0xf6, 0x03, 0x00, 0x00,
 }
 
 func (m *BasketCredit) Marshal() (dAtA []byte, err error) {
@@ -286,6 +453,84 @@ func (m *DateCriteria) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AdmissionCriteria) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdmissionCriteria) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdmissionCriteria) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attestor) > 0 {
+		i -= len(m.Attestor)
+		copy(dAtA[i:], m.Attestor)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Attestor)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MaxBatchAge != nil {
+		{
+			size, err := m.MaxBatchAge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DeniedJurisdictionPrefixes) > 0 {
+		for iNdEx := len(m.DeniedJurisdictionPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedJurisdictionPrefixes[iNdEx])
+			copy(dAtA[i:], m.DeniedJurisdictionPrefixes[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.DeniedJurisdictionPrefixes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AllowedJurisdictionPrefixes) > 0 {
+		for iNdEx := len(m.AllowedJurisdictionPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedJurisdictionPrefixes[iNdEx])
+			copy(dAtA[i:], m.AllowedJurisdictionPrefixes[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.AllowedJurisdictionPrefixes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DeniedProjectIds) > 0 {
+		for iNdEx := len(m.DeniedProjectIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedProjectIds[iNdEx])
+			copy(dAtA[i:], m.DeniedProjectIds[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.DeniedProjectIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AllowedProjectIds) > 0 {
+		for iNdEx := len(m.AllowedProjectIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedProjectIds[iNdEx])
+			copy(dAtA[i:], m.AllowedProjectIds[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.AllowedProjectIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -334,6 +579,47 @@ func (m *DateCriteria) Size() (n int) {
 	return n
 }
 
+func (m *AdmissionCriteria) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedProjectIds) > 0 {
+		for _, s := range m.AllowedProjectIds {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.DeniedProjectIds) > 0 {
+		for _, s := range m.DeniedProjectIds {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.AllowedJurisdictionPrefixes) > 0 {
+		for _, s := range m.AllowedJurisdictionPrefixes {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.DeniedJurisdictionPrefixes) > 0 {
+		for _, s := range m.DeniedJurisdictionPrefixes {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.MaxBatchAge != nil {
+		l = m.MaxBatchAge.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Attestor)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -595,6 +881,252 @@ func (m *DateCriteria) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AdmissionCriteria) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdmissionCriteria: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdmissionCriteria: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedProjectIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedProjectIds = append(m.AllowedProjectIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedProjectIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedProjectIds = append(m.DeniedProjectIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedJurisdictionPrefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedJurisdictionPrefixes = append(m.AllowedJurisdictionPrefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedJurisdictionPrefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedJurisdictionPrefixes = append(m.DeniedJurisdictionPrefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxBatchAge == nil {
+				m.MaxBatchAge = &types.Duration{}
+			}
+			if err := m.MaxBatchAge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"regexp"
 
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
 )
 
@@ -60,3 +62,37 @@ func ValidateBasketDenom(denom string) error {
 	}
 	return nil
 }
+
+// validateBasketDenom validates a basket denom provided in a curator message.
+func validateBasketDenom(denom string) error {
+	if len(denom) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("basket denom cannot be empty")
+	}
+
+	if err := ValidateBasketDenom(denom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// validateBasketClassIds validates a non-empty list of unique credit class IDs.
+func validateBasketClassIds(classIds []string) error {
+	if len(classIds) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("class ids cannot be empty")
+	}
+
+	seen := make(map[string]bool, len(classIds))
+	for i, classId := range classIds {
+		if err := core.ValidateClassId(classId); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("class_ids[%d] is not a valid class ID: %s", i, err)
+		}
+
+		if seen[classId] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate class id %s", classId)
+		}
+		seen[classId] = true
+	}
+
+	return nil
+}
@@ -109,6 +109,41 @@ regen q ecocredit basket-balance eco.uC.NCT C01-001-20210101-20220101-001
 	return cmd
 }
 
+// QueryBasketAdmissibilityCmd returns a query command that retrieves whether credits
+// from a credit batch can be put into the basket and the reasons why they cannot.
+func QueryBasketAdmissibilityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "basket-admissibility [basket-denom] [batch-denom]",
+		Short: "Retrieves whether credits from a credit batch can be put into the basket",
+		Long:  "Retrieves whether credits from a credit batch can be put into the basket and the reasons why they cannot if they are not admissible",
+		Example: `
+regen q ecocredit basket-admissibility eco.uC.NCT C01-001-20210101-20220101-001
+		`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			client := basket.NewQueryClient(ctx)
+
+			res, err := client.BasketAdmissibility(cmd.Context(), &basket.QueryBasketAdmissibilityRequest{
+				BasketDenom: args[0],
+				BatchDenom:  args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // QueryBasketBalancesCmd returns a query command that retrieves the balance of each credit batch for the given basket denom.
 func QueryBasketBalancesCmd() *cobra.Command {
 	cmd := &cobra.Command{
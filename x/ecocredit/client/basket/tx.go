@@ -2,10 +2,9 @@ package basketclient
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
-	"time"
 
-	"github.com/gogo/protobuf/types"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -13,7 +12,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
-	regentypes "github.com/regen-network/regen-ledger/types"
 	"github.com/regen-network/regen-ledger/x/ecocredit/basket"
 )
 
@@ -23,10 +21,23 @@ const (
 	FlagAllowedClasses         = "allowed-classes"
 	FlagMinimumStartDate       = "minimum-start-date"
 	FlagStartDateWindow        = "start-date-window"
+	FlagYearsInThePast         = "years-in-the-past"
 	FlagBasketFee              = "basket-fee"
 	FlagDenomDescription       = "description"
 	FlagRetirementJurisdiction = "retirement-jurisdiction"
 	FlagRetireOnTake           = "retire-on-take"
+	FlagBeneficiaryAddress     = "beneficiary-address"
+	FlagBeneficiaryName        = "beneficiary-name"
+	FlagReason                 = "reason"
+	FlagStrategy               = "strategy"
+	FlagBatchDenoms            = "batch-denoms"
+	FlagMaxPremium             = "max-premium"
+	FlagAllowedProjectIds      = "allowed-project-ids"
+	FlagDeniedProjectIds       = "denied-project-ids"
+	FlagAllowedJurisdictions   = "allowed-jurisdiction-prefixes"
+	FlagDeniedJurisdictions    = "denied-jurisdiction-prefixes"
+	FlagMaxBatchAge            = "max-batch-age"
+	FlagAttestor               = "attestor"
 )
 
 func TxCreateBasketCmd() *cobra.Command {
@@ -51,11 +62,25 @@ Flags:
 - min-start-date: the earliest start date for batches of credits allowed into the basket.
 - start-date-window: the duration of time (in seconds) measured into the past which sets a
     cutoff for batch start dates when adding new credits to the basket.
+- years-in-the-past: the number of years into the past which sets a cutoff for batch start
+    date years when adding new credits to the basket.
 - basket-fee: the fee that the curator will pay to create the basket. It must be >= the
     required Params.basket_creation_fee. We include the fee explicitly here so that the
     curator explicitly acknowledges paying this fee and is not surprised to learn that they
     paid a big fee and didn't know beforehand.
-- description: the description to be used in the basket coin's bank denom metadata.`),
+- description: the description to be used in the basket coin's bank denom metadata.
+- allowed-project-ids: comma separated (no spaces) list of the only projects from which
+    batches are allowed into the basket (e.g. "C01-001,C01-002").
+- denied-project-ids: comma separated (no spaces) list of projects from which batches are
+    not allowed into the basket. Cannot be set with allowed-project-ids.
+- allowed-jurisdiction-prefixes: comma separated (no spaces) list of jurisdiction prefixes
+    of which the project jurisdiction of a batch must start with one (e.g. "US-,KE").
+- denied-jurisdiction-prefixes: comma separated (no spaces) list of jurisdiction prefixes
+    with which the project jurisdiction of a batch must not start (e.g. "US-WA").
+- max-batch-age: the maximum duration of time between the issuance date of a batch and
+    the time credits from the batch are put into the basket (e.g. "8760h").
+- attestor: the address of an attestor that must have attested to the metadata of a batch
+    in the data module.`),
 		Example: `regen tx ecocredit create-basket NCT --credit-type-abbrev C --allowed_classes C01,C02 basket-fee 100000000uregen description "NCT basket"`,
 		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -82,44 +107,21 @@ Flags:
 				allowedClasses[i] = strings.TrimSpace(allowedClasses[i])
 			}
 
-			minStartDateString, err := cmd.Flags().GetString(FlagMinimumStartDate)
+			denomDescription, err := cmd.Flags().GetString(FlagDenomDescription)
 			if err != nil {
 				return err
 			}
-			startDateWindow, err := cmd.Flags().GetUint64(FlagStartDateWindow)
+
+			dateCriteria, err := parseDateCriteriaFlags(cmd)
 			if err != nil {
 				return err
 			}
 
-			denomDescription, err := cmd.Flags().GetString(FlagDenomDescription)
+			admissionCriteria, err := parseAdmissionCriteriaFlags(cmd)
 			if err != nil {
 				return err
 			}
 
-			if minStartDateString != "" && startDateWindow != 0 {
-				return fmt.Errorf("both %s and %s cannot be set", FlagStartDateWindow, FlagMinimumStartDate)
-			}
-
-			var dateCriteria *basket.DateCriteria
-
-			if minStartDateString != "" {
-				minStartDateTime, err := regentypes.ParseDate("min-start-date", minStartDateString)
-				if err != nil {
-					return err
-				}
-				minStartDate, err := types.TimestampProto(minStartDateTime)
-				if err != nil {
-					return fmt.Errorf("failed to parse min_start_date: %w", err)
-				}
-				dateCriteria = &basket.DateCriteria{MinStartDate: minStartDate}
-			}
-
-			if startDateWindow != 0 {
-				startDateWindowDuration := time.Duration(startDateWindow)
-				startDateWindow := types.DurationProto(startDateWindowDuration)
-				dateCriteria = &basket.DateCriteria{StartDateWindow: startDateWindow}
-			}
-
 			fee := sdk.Coins{}
 			feeString, err := cmd.Flags().GetString(FlagBasketFee)
 			if err != nil {
@@ -140,6 +142,7 @@ Flags:
 				CreditTypeAbbrev:  creditTypeName,
 				AllowedClasses:    allowedClasses,
 				DateCriteria:      dateCriteria,
+				AdmissionCriteria: admissionCriteria,
 				Fee:               fee,
 			}
 
@@ -157,8 +160,10 @@ Flags:
 	cmd.Flags().StringSlice(FlagAllowedClasses, []string{}, "comma separated (no spaces) list of credit classes allowed to be put in the basket (e.g. \"C01,C02\")")
 	cmd.Flags().String(FlagMinimumStartDate, "", "the earliest start date for batches of credits allowed into the basket (e.g. \"2012-01-01\")")
 	cmd.Flags().Uint64(FlagStartDateWindow, 0, "sets a cutoff for batch start dates when adding new credits to the basket (e.g. 1325404800)")
+	cmd.Flags().Uint32(FlagYearsInThePast, 0, "sets a cutoff for batch start date years when adding new credits to the basket (e.g. 10)")
 	cmd.Flags().String(FlagBasketFee, "", "the fee that the curator will pay to create the basket (e.g. \"20regen\")")
 	cmd.Flags().String(FlagDenomDescription, "", "the description to be used in the bank denom metadata.")
+	addAdmissionCriteriaFlags(cmd)
 
 	// required flags
 	cmd.MarkFlagRequired(FlagCreditTypeAbbrev)
@@ -237,10 +242,23 @@ Flags:
     for the credits which will be used only if --retire-on-take flag is true.
 - retire-on-take: boolean that dictates whether the ecocredits received
     in exchange for the basket tokens will be received as retired or tradable credits.
+- beneficiary-address: optional address of the account on whose behalf the
+    credits are retired which will be used only if --retire-on-take flag is true.
+- beneficiary-name: optional name of the individual or organization on whose
+    behalf the credits are retired which will be used only if --retire-on-take flag is true.
+- reason: optional reason for retiring the credits which will be used only if
+    --retire-on-take flag is true.
+- strategy: optional strategy used to select the credits taken from the basket, one of
+    oldest-first (default), newest-first, proportional or selected-batches. Strategies
+    other than oldest-first are charged the take premium of the basket.
+- batch-denoms: comma separated (no spaces) list of batches to take credits from in the
+    order they are listed, required if --strategy is selected-batches.
+- max-premium: optional maximum number of basket tokens to pay as the take premium.
 		
 		`),
 		Example: `regen tx ecocredit take-from-basket eco.uC.NCT 1000
-regen tx ecocredit take-from-basket eco.uC.NCT 1000 --retire-on-take=true --retirement-jurisdiction "US-WA 98225"`,
+regen tx ecocredit take-from-basket eco.uC.NCT 1000 --retire-on-take=true --retirement-jurisdiction "US-WA 98225"
+regen tx ecocredit take-from-basket eco.uC.NCT 1000 --strategy newest-first --max-premium 10`,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -258,12 +276,56 @@ regen tx ecocredit take-from-basket eco.uC.NCT 1000 --retire-on-take=true --reti
 				return err
 			}
 
+			beneficiaryAddress, err := cmd.Flags().GetString(FlagBeneficiaryAddress)
+			if err != nil {
+				return err
+			}
+
+			beneficiaryName, err := cmd.Flags().GetString(FlagBeneficiaryName)
+			if err != nil {
+				return err
+			}
+
+			reason, err := cmd.Flags().GetString(FlagReason)
+			if err != nil {
+				return err
+			}
+
+			strategyString, err := cmd.Flags().GetString(FlagStrategy)
+			if err != nil {
+				return err
+			}
+
+			var strategy basket.TakeStrategy
+			if strategyString != "" {
+				strategy, err = parseTakeStrategy(strategyString)
+				if err != nil {
+					return err
+				}
+			}
+
+			batchDenoms, err := cmd.Flags().GetStringSlice(FlagBatchDenoms)
+			if err != nil {
+				return err
+			}
+
+			maxPremium, err := cmd.Flags().GetString(FlagMaxPremium)
+			if err != nil {
+				return err
+			}
+
 			msg := basket.MsgTake{
 				Owner:                  clientCtx.FromAddress.String(),
 				BasketDenom:            args[0],
 				Amount:                 args[1],
 				RetirementJurisdiction: retirementJurisdiction,
 				RetireOnTake:           retireOnTake,
+				BeneficiaryAddress:     beneficiaryAddress,
+				BeneficiaryName:        beneficiaryName,
+				Reason:                 reason,
+				Strategy:               strategy,
+				BatchDenoms:            batchDenoms,
+				MaxPremium:             maxPremium,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -276,6 +338,320 @@ regen tx ecocredit take-from-basket eco.uC.NCT 1000 --retire-on-take=true --reti
 
 	cmd.Flags().String(FlagRetirementJurisdiction, "", "jurisdiction for the credits which will be used only if --retire-on-take flag is true")
 	cmd.Flags().Bool(FlagRetireOnTake, false, "dictates whether the ecocredits received in exchange for the basket tokens will be received as retired or tradable credits")
+	cmd.Flags().String(FlagBeneficiaryAddress, "", "address of the account on whose behalf the credits are retired which will be used only if --retire-on-take flag is true")
+	cmd.Flags().String(FlagBeneficiaryName, "", "name of the individual or organization on whose behalf the credits are retired which will be used only if --retire-on-take flag is true")
+	cmd.Flags().String(FlagReason, "", "reason for retiring the credits which will be used only if --retire-on-take flag is true")
+	cmd.Flags().String(FlagStrategy, "", "strategy used to select the credits taken from the basket (oldest-first, newest-first, proportional or selected-batches)")
+	cmd.Flags().StringSlice(FlagBatchDenoms, []string{}, "comma separated (no spaces) list of batches to take credits from when --strategy is selected-batches")
+	cmd.Flags().String(FlagMaxPremium, "", "maximum number of basket tokens to pay as the take premium")
+
+	return txFlags(cmd)
+}
+
+func TxUpdateBasketCuratorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-basket-curator [basket-denom] [new-curator]",
+		Short: "Updates the curator of a basket",
+		Long: strings.TrimSpace(`Updates the curator of a basket.
+
+WARNING: Updating the curator forfeits control of the basket settings to the new curator.
+
+Parameters:
+
+- basket-denom:  denom identifying the basket to update
+- new-curator:   the address of the new basket curator`),
+		Example: `regen tx ecocredit update-basket-curator eco.uC.NCT regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := basket.MsgUpdateBasketCurator{
+				Curator:     clientCtx.FromAddress.String(),
+				BasketDenom: args[0],
+				NewCurator:  args[1],
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+func TxAddBasketClassesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-basket-classes [basket-denom] [class-ids]",
+		Short: "Adds credit classes to the allowed classes of a basket",
+		Long: strings.TrimSpace(`Adds credit classes to the allowed classes of a basket.
+
+Parameters:
+
+- basket-denom:  denom identifying the basket to update
+- class-ids:     comma separated (no spaces) list of credit classes to allow in the basket`),
+		Example: `regen tx ecocredit add-basket-classes eco.uC.NCT C03,C04`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := basket.MsgAddBasketClasses{
+				Curator:     clientCtx.FromAddress.String(),
+				BasketDenom: args[0],
+				ClassIds:    strings.Split(args[1], ","),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+func TxRemoveBasketClassesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-basket-classes [basket-denom] [class-ids]",
+		Short: "Removes credit classes from the allowed classes of a basket",
+		Long: strings.TrimSpace(`Removes credit classes from the allowed classes of a basket.
+
+Credits from the removed classes that are already in the basket remain in the basket
+and can still be taken from the basket.
+
+Parameters:
+
+- basket-denom:  denom identifying the basket to update
+- class-ids:     comma separated (no spaces) list of credit classes to remove from the basket`),
+		Example: `regen tx ecocredit remove-basket-classes eco.uC.NCT C03,C04`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := basket.MsgRemoveBasketClasses{
+				Curator:     clientCtx.FromAddress.String(),
+				BasketDenom: args[0],
+				ClassIds:    strings.Split(args[1], ","),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+func TxUpdateBasketDateCriteriaCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-basket-date-criteria [basket-denom]",
+		Short: "Updates the date criteria of a basket",
+		Long: strings.TrimSpace(`Updates the date criteria for batches admitted to a basket.
+
+The date criteria only applies to credits put into the basket after the update. If
+none of the date criteria flags are set, the date criteria of the basket is removed.
+
+Parameters:
+
+- basket-denom:  denom identifying the basket to update
+
+Flags:
+
+- min-start-date: the earliest start date for batches of credits allowed into the basket.
+- start-date-window: the duration of time (in seconds) measured into the past which sets a
+    cutoff for batch start dates when adding new credits to the basket.
+- years-in-the-past: the number of years into the past which sets a cutoff for batch start
+    date years when adding new credits to the basket.`),
+		Example: `regen tx ecocredit update-basket-date-criteria eco.uC.NCT --years-in-the-past 10`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			dateCriteria, err := parseDateCriteriaFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := basket.MsgUpdateBasketDateCriteria{
+				Curator:      clientCtx.FromAddress.String(),
+				BasketDenom:  args[0],
+				DateCriteria: dateCriteria,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMinimumStartDate, "", "the earliest start date for batches of credits allowed into the basket (e.g. \"2012-01-01\")")
+	cmd.Flags().Uint64(FlagStartDateWindow, 0, "sets a cutoff for batch start dates when adding new credits to the basket (e.g. 1325404800)")
+	cmd.Flags().Uint32(FlagYearsInThePast, 0, "sets a cutoff for batch start date years when adding new credits to the basket (e.g. 10)")
+
+	return txFlags(cmd)
+}
+
+func TxUpdateBasketDescriptionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-basket-description [basket-denom] [description]",
+		Short: "Updates the description of a basket",
+		Long: strings.TrimSpace(`Updates the description of a basket in the bank denom metadata of the basket token.
+
+Parameters:
+
+- basket-denom:  denom identifying the basket to update
+- description:   the new description of the basket (at most 256 characters)`),
+		Example: `regen tx ecocredit update-basket-description eco.uC.NCT "Nature-Based Carbon Token"`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := basket.MsgUpdateBasketDescription{
+				Curator:     clientCtx.FromAddress.String(),
+				BasketDenom: args[0],
+				Description: args[1],
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
 
 	return txFlags(cmd)
 }
+
+func TxUpdateBasketTakePremiumCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-basket-take-premium [basket-denom] [take-premium-bps]",
+		Short: "Updates the take premium of a basket",
+		Long: strings.TrimSpace(`Updates the premium paid to the curator when credits are taken from a basket
+with a strategy other than oldest first.
+
+Parameters:
+
+- basket-denom:      denom identifying the basket to update
+- take-premium-bps:  the premium in basis points of the basket tokens being converted (at most 10000)`),
+		Example: `regen tx ecocredit update-basket-take-premium eco.uC.NCT 100`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			takePremiumBps, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return fmt.Errorf("failed to parse take premium: %w", err)
+			}
+
+			msg := basket.MsgUpdateBasketTakePremium{
+				Curator:        clientCtx.FromAddress.String(),
+				BasketDenom:    args[0],
+				TakePremiumBps: uint32(takePremiumBps),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+func TxUpdateBasketAdmissionCriteriaCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-basket-admission-criteria [basket-denom]",
+		Short: "Updates the admission criteria of a basket",
+		Long: strings.TrimSpace(`Updates the additional criteria for batches admitted to a basket.
+
+The admission criteria only applies to credits put into the basket after the update. If
+none of the admission criteria flags are set, the admission criteria of the basket is removed.
+
+Parameters:
+
+- basket-denom:  denom identifying the basket to update
+
+Flags:
+
+- allowed-project-ids: comma separated (no spaces) list of the only projects from which
+    batches are allowed into the basket (e.g. "C01-001,C01-002").
+- denied-project-ids: comma separated (no spaces) list of projects from which batches are
+    not allowed into the basket. Cannot be set with allowed-project-ids.
+- allowed-jurisdiction-prefixes: comma separated (no spaces) list of jurisdiction prefixes
+    of which the project jurisdiction of a batch must start with one (e.g. "US-,KE").
+- denied-jurisdiction-prefixes: comma separated (no spaces) list of jurisdiction prefixes
+    with which the project jurisdiction of a batch must not start (e.g. "US-WA").
+- max-batch-age: the maximum duration of time between the issuance date of a batch and
+    the time credits from the batch are put into the basket (e.g. "8760h").
+- attestor: the address of an attestor that must have attested to the metadata of a batch
+    in the data module.`),
+		Example: `regen tx ecocredit update-basket-admission-criteria eco.uC.NCT --allowed-jurisdiction-prefixes US-,KE --max-batch-age 8760h`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			admissionCriteria, err := parseAdmissionCriteriaFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := basket.MsgUpdateBasketAdmissionCriteria{
+				Curator:           clientCtx.FromAddress.String(),
+				BasketDenom:       args[0],
+				AdmissionCriteria: admissionCriteria,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	addAdmissionCriteriaFlags(cmd)
+
+	return txFlags(cmd)
+}
+
+func addAdmissionCriteriaFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice(FlagAllowedProjectIds, []string{}, "comma separated (no spaces) list of the only projects from which batches are allowed into the basket (e.g. \"C01-001,C01-002\")")
+	cmd.Flags().StringSlice(FlagDeniedProjectIds, []string{}, "comma separated (no spaces) list of projects from which batches are not allowed into the basket (e.g. \"C01-001,C01-002\")")
+	cmd.Flags().StringSlice(FlagAllowedJurisdictions, []string{}, "comma separated (no spaces) list of jurisdiction prefixes of which the project jurisdiction of a batch must start with one (e.g. \"US-,KE\")")
+	cmd.Flags().StringSlice(FlagDeniedJurisdictions, []string{}, "comma separated (no spaces) list of jurisdiction prefixes with which the project jurisdiction of a batch must not start (e.g. \"US-WA\")")
+	cmd.Flags().Duration(FlagMaxBatchAge, 0, "the maximum age of a batch measured from its issuance date when adding new credits to the basket (e.g. 8760h)")
+	cmd.Flags().String(FlagAttestor, "", "the address of an attestor that must have attested to the metadata of a batch in the data module")
+}
@@ -3,8 +3,12 @@ package basketclient
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
+	"strings"
+	"time"
 
+	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -12,6 +16,8 @@ import (
 	"github.com/regen-network/regen-ledger/x/ecocredit/basket"
 )
 
+const takeStrategyPrefix = "TAKE_STRATEGY_"
+
 func txFlags(cmd *cobra.Command) *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.MarkFlagRequired(flags.FlagFrom)
@@ -37,3 +43,127 @@ func parseBasketCredits(creditsFile string) ([]*basket.BasketCredit, error) {
 
 	return credits, nil
 }
+
+// parseDateCriteriaFlags builds the basket date criteria from the
+// minimum-start-date, start-date-window and years-in-the-past flags. At most
+// one of the flags can be set and nil is returned if none are set.
+func parseDateCriteriaFlags(cmd *cobra.Command) (*basket.DateCriteria, error) {
+	minStartDateString, err := cmd.Flags().GetString(FlagMinimumStartDate)
+	if err != nil {
+		return nil, err
+	}
+	startDateWindow, err := cmd.Flags().GetUint64(FlagStartDateWindow)
+	if err != nil {
+		return nil, err
+	}
+	yearsInThePast, err := cmd.Flags().GetUint32(FlagYearsInThePast)
+	if err != nil {
+		return nil, err
+	}
+
+	set := 0
+	for _, isSet := range []bool{minStartDateString != "", startDateWindow != 0, yearsInThePast != 0} {
+		if isSet {
+			set++
+		}
+	}
+	if set > 1 {
+		return nil, fmt.Errorf("only one of %s, %s or %s can be set",
+			FlagMinimumStartDate, FlagStartDateWindow, FlagYearsInThePast)
+	}
+
+	switch {
+	case minStartDateString != "":
+		minStartDateTime, err := types.ParseDate("min-start-date", minStartDateString)
+		if err != nil {
+			return nil, err
+		}
+		minStartDate, err := gogotypes.TimestampProto(minStartDateTime)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse min_start_date: %w", err)
+		}
+		return &basket.DateCriteria{MinStartDate: minStartDate}, nil
+	case startDateWindow != 0:
+		startDateWindowDuration := time.Duration(startDateWindow)
+		return &basket.DateCriteria{StartDateWindow: gogotypes.DurationProto(startDateWindowDuration)}, nil
+	case yearsInThePast != 0:
+		return &basket.DateCriteria{YearsInThePast: yearsInThePast}, nil
+	}
+
+	return nil, nil
+}
+
+// parseAdmissionCriteriaFlags builds the basket admission criteria from the
+// admission criteria flags. Nil is returned if none of the flags are set.
+func parseAdmissionCriteriaFlags(cmd *cobra.Command) (*basket.AdmissionCriteria, error) {
+	allowedProjectIds, err := cmd.Flags().GetStringSlice(FlagAllowedProjectIds)
+	if err != nil {
+		return nil, err
+	}
+	deniedProjectIds, err := cmd.Flags().GetStringSlice(FlagDeniedProjectIds)
+	if err != nil {
+		return nil, err
+	}
+	allowedJurisdictions, err := cmd.Flags().GetStringSlice(FlagAllowedJurisdictions)
+	if err != nil {
+		return nil, err
+	}
+	deniedJurisdictions, err := cmd.Flags().GetStringSlice(FlagDeniedJurisdictions)
+	if err != nil {
+		return nil, err
+	}
+	maxBatchAge, err := cmd.Flags().GetDuration(FlagMaxBatchAge)
+	if err != nil {
+		return nil, err
+	}
+	attestor, err := cmd.Flags().GetString(FlagAttestor)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(allowedProjectIds) == 0 && len(deniedProjectIds) == 0 &&
+		len(allowedJurisdictions) == 0 && len(deniedJurisdictions) == 0 &&
+		maxBatchAge == 0 && attestor == "" {
+		return nil, nil
+	}
+
+	criteria := &basket.AdmissionCriteria{
+		AllowedProjectIds:           trimAll(allowedProjectIds),
+		DeniedProjectIds:            trimAll(deniedProjectIds),
+		AllowedJurisdictionPrefixes: trimAll(allowedJurisdictions),
+		DeniedJurisdictionPrefixes:  trimAll(deniedJurisdictions),
+		Attestor:                    attestor,
+	}
+	if maxBatchAge != 0 {
+		criteria.MaxBatchAge = gogotypes.DurationProto(maxBatchAge)
+	}
+
+	return criteria, nil
+}
+
+func trimAll(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	for i := range values {
+		values[i] = strings.TrimSpace(values[i])
+	}
+	return values
+}
+
+// parseTakeStrategy parses a take strategy from either its full name (e.g.
+// TAKE_STRATEGY_NEWEST_FIRST) or its short name in any case with either dashes
+// or underscores (e.g. newest-first).
+func parseTakeStrategy(strategy string) (basket.TakeStrategy, error) {
+	name := strings.ToUpper(strings.ReplaceAll(strategy, "-", "_"))
+	if !strings.HasPrefix(name, takeStrategyPrefix) {
+		name = takeStrategyPrefix + name
+	}
+
+	value, ok := basket.TakeStrategy_value[name]
+	if !ok {
+		return 0, fmt.Errorf("invalid take strategy: %s", strategy)
+	}
+
+	return basket.TakeStrategy(value), nil
+}
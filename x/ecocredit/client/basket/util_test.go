@@ -3,6 +3,7 @@ package basketclient
 import (
 	"testing"
 
+	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/testutil"
@@ -85,3 +86,100 @@ func TestParseBasketCredits(t *testing.T) {
 		})
 	}
 }
+
+func TestParseDateCriteriaFlags(t *testing.T) {
+	testCases := []struct {
+		name      string
+		args      []string
+		expected  *basket.DateCriteria
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:     "no flags",
+			args:     []string{},
+			expected: nil,
+		},
+		{
+			name:     "years in the past",
+			args:     []string{"--years-in-the-past=10"},
+			expected: &basket.DateCriteria{YearsInThePast: 10},
+		},
+		{
+			name:      "more than one flag",
+			args:      []string{"--years-in-the-past=10", "--start-date-window=86400"},
+			expErr:    true,
+			expErrMsg: "only one of minimum-start-date, start-date-window or years-in-the-past can be set",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := TxUpdateBasketDateCriteriaCmd()
+			require.NoError(t, cmd.ParseFlags(tc.args))
+
+			dateCriteria, err := parseDateCriteriaFlags(cmd)
+			if tc.expErr {
+				require.EqualError(t, err, tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, dateCriteria)
+			}
+		})
+	}
+}
+
+func TestParseAdmissionCriteriaFlags(t *testing.T) {
+	testCases := []struct {
+		name     string
+		args     []string
+		expected *basket.AdmissionCriteria
+	}{
+		{
+			name:     "no flags",
+			args:     []string{},
+			expected: nil,
+		},
+		{
+			name: "all flags",
+			args: []string{
+				"--denied-project-ids=C01-001,C01-002",
+				"--allowed-jurisdiction-prefixes=US-,KE",
+				"--denied-jurisdiction-prefixes=US-WA",
+				"--max-batch-age=24h",
+				"--attestor=regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+			},
+			expected: &basket.AdmissionCriteria{
+				DeniedProjectIds:            []string{"C01-001", "C01-002"},
+				AllowedJurisdictionPrefixes: []string{"US-", "KE"},
+				DeniedJurisdictionPrefixes:  []string{"US-WA"},
+				MaxBatchAge:                 &gogotypes.Duration{Seconds: 86400},
+				Attestor:                    "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := TxUpdateBasketAdmissionCriteriaCmd()
+			require.NoError(t, cmd.ParseFlags(tc.args))
+
+			admissionCriteria, err := parseAdmissionCriteriaFlags(cmd)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, admissionCriteria)
+		})
+	}
+}
+
+func TestParseTakeStrategy(t *testing.T) {
+	strategy, err := parseTakeStrategy("TAKE_STRATEGY_PROPORTIONAL")
+	require.NoError(t, err)
+	require.Equal(t, basket.TakeStrategy_TAKE_STRATEGY_PROPORTIONAL, strategy)
+
+	strategy, err = parseTakeStrategy("newest-first")
+	require.NoError(t, err)
+	require.Equal(t, basket.TakeStrategy_TAKE_STRATEGY_NEWEST_FIRST, strategy)
+
+	_, err = parseTakeStrategy("foo")
+	require.EqualError(t, err, "invalid take strategy: foo")
+}
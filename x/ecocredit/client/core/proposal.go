@@ -84,3 +84,130 @@ The credit type abbreviation MUST be unique, else the proposal will fail upon ex
 	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
 	return cmd
 }
+
+var BatchFreezeProposalHandler = govclient.NewProposalHandler(TxBatchFreezeProposalCmd, func(context client.Context) rest.ProposalRESTHandler {
+	return rest.ProposalRESTHandler{
+		SubRoute: "",
+		Handler:  nil,
+	}
+})
+
+func TxBatchFreezeProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-freeze-proposal [path_to_file.json] [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to freeze or unfreeze a credit batch",
+		Long: strings.TrimSpace(`Submit a proposal to freeze or unfreeze a credit batch.
+The json file MUST take the following form:
+{
+	"title": "some title",
+	"description": "some description",
+	"batch_denom": "C01-001-20200101-20210101-001",
+	"freeze": true,
+	"evidence": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf",
+	"reason": "double counting"
+}
+Credits from a frozen credit batch cannot be sent, retired, or sold, and all sell orders of the
+credit batch are cancelled when the credit batch is frozen.`),
+		Example: `regen tx gov submit-proposal batch-freeze-proposal my_file.json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposalFile, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var proposal core.BatchFreezeProposal
+			err = json.Unmarshal(proposalFile, &proposal)
+			if err != nil {
+				return err
+			}
+			if err := proposal.ValidateBasic(); err != nil {
+				return fmt.Errorf("invalid proposal: %w", err)
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+			var content types.Content = &proposal
+			msg, err := types.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
+	return cmd
+}
+
+var CreditTypePrecisionProposalHandler = govclient.NewProposalHandler(TxCreditTypePrecisionProposalCmd, func(context client.Context) rest.ProposalRESTHandler {
+	return rest.ProposalRESTHandler{
+		SubRoute: "",
+		Handler:  nil,
+	}
+})
+
+func TxCreditTypePrecisionProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "credit-type-precision-proposal [path_to_file.json] [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to raise the precision of a credit type",
+		Long: strings.TrimSpace(`Submit a proposal to raise the precision of a credit type.
+The json file MUST take the following form:
+{
+	"title": "some title",
+	"description": "some description",
+	"credit_type_abbrev": "C",
+	"precision": 9
+}
+The precision must be greater than the current precision of the credit type and must not
+exceed 18. Credit balances, supplies, and sell orders of the credit type are migrated to the
+new precision. The exponent of existing baskets remains unchanged.`),
+		Example: `regen tx gov submit-proposal credit-type-precision-proposal my_file.json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposalFile, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var proposal core.CreditTypePrecisionProposal
+			err = json.Unmarshal(proposalFile, &proposal)
+			if err != nil {
+				return err
+			}
+			if err := proposal.ValidateBasic(); err != nil {
+				return fmt.Errorf("invalid proposal: %w", err)
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+			var content types.Content = &proposal
+			msg, err := types.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
+	return cmd
+}
@@ -84,3 +84,258 @@ relate the bank_denom to the display_denom and is informational`),
 	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
 	return cmd
 }
+
+var FeeScheduleProposalHandler = govclient.NewProposalHandler(TxFeeScheduleProposal, func(context client.Context) rest.ProposalRESTHandler {
+	return rest.ProposalRESTHandler{
+		SubRoute: "",
+		Handler:  nil,
+	}
+})
+
+func TxFeeScheduleProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-schedule-proposal [path_to_file.json] [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to set the maker and taker fees of marketplace trades",
+		Long: strings.TrimSpace(`Submit a proposal to set the maker and taker fees of marketplace trades.
+The json file MUST take the following form:
+{
+    "title": "some title",
+    "description": "some description",
+    "credit_type_abbrev": "C",
+    "bank_denom": "uregen",
+    "maker_fee_bps": 50,
+    "taker_fee_bps": 100
+}
+Fees are in basis points and cannot exceed 10000. If bank_denom is set, the fees apply to the market of the
+credit type and bank denom. If bank_denom is empty, the fees apply to all markets of the credit type without
+market specific fees.`),
+		Example: `regen tx gov submit-proposal fee-schedule-proposal my_file.json --deposit=100regen`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposalFile, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var proposal marketplace.FeeScheduleProposal
+			err = json.Unmarshal(proposalFile, &proposal)
+			if err != nil {
+				return err
+			}
+			if err := proposal.ValidateBasic(); err != nil {
+				return fmt.Errorf("invalid proposal: %w", err)
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+			var content types.Content = &proposal
+			msg, err := types.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
+	return cmd
+}
+
+var FeeDestinationProposalHandler = govclient.NewProposalHandler(TxFeeDestinationProposal, func(context client.Context) rest.ProposalRESTHandler {
+	return rest.ProposalRESTHandler{
+		SubRoute: "",
+		Handler:  nil,
+	}
+})
+
+func TxFeeDestinationProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-destination-proposal [path_to_file.json] [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to set the destination of marketplace fees",
+		Long: strings.TrimSpace(`Submit a proposal to set the destination of marketplace fees.
+The json file MUST take the following form:
+{
+    "title": "some title",
+    "description": "some description",
+    "destination": "FEE_DESTINATION_TYPE_ACCOUNT",
+    "address": "regen1..."
+}
+The destination is one of FEE_DESTINATION_TYPE_COMMUNITY_POOL, FEE_DESTINATION_TYPE_BURN or
+FEE_DESTINATION_TYPE_ACCOUNT. The address is required for FEE_DESTINATION_TYPE_ACCOUNT and must be
+empty otherwise.`),
+		Example: `regen tx gov submit-proposal fee-destination-proposal my_file.json --deposit=100regen`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposalFile, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			// the codec is used to support enum names in the json file
+			var proposal marketplace.FeeDestinationProposal
+			err = clientCtx.Codec.UnmarshalJSON(proposalFile, &proposal)
+			if err != nil {
+				return err
+			}
+			if err := proposal.ValidateBasic(); err != nil {
+				return fmt.Errorf("invalid proposal: %w", err)
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+			var content types.Content = &proposal
+			msg, err := types.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
+	return cmd
+}
+
+var MarketRegistrationProposalHandler = govclient.NewProposalHandler(TxMarketRegistrationProposal, func(context client.Context) rest.ProposalRESTHandler {
+	return rest.ProposalRESTHandler{
+		SubRoute: "",
+		Handler:  nil,
+	}
+})
+
+func TxMarketRegistrationProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "market-registration-proposal [path_to_file.json] [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to enable or disable permissionless market registration",
+		Long: strings.TrimSpace(`Submit a proposal to enable or disable permissionless market registration and set the
+deposit paid to register a market. When market registration is enabled, any account can register a market
+for a credit type and a bank denom with denom metadata by paying the deposit.
+The json file MUST take the following form:
+{
+    "title": "some title",
+    "description": "some description",
+    "enabled": true,
+    "deposit": {
+        "denom": "uregen",
+        "amount": "1000000000"
+    }
+}
+The deposit is required if market registration is enabled and must be empty otherwise.`),
+		Example: `regen tx gov submit-proposal market-registration-proposal my_file.json --deposit=100regen`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposalFile, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var proposal marketplace.MarketRegistrationProposal
+			err = clientCtx.Codec.UnmarshalJSON(proposalFile, &proposal)
+			if err != nil {
+				return err
+			}
+			if err := proposal.ValidateBasic(); err != nil {
+				return fmt.Errorf("invalid proposal: %w", err)
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+			var content types.Content = &proposal
+			msg, err := types.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
+	return cmd
+}
+
+var DelistMarketProposalHandler = govclient.NewProposalHandler(TxDelistMarketProposal, func(context client.Context) rest.ProposalRESTHandler {
+	return rest.ProposalRESTHandler{
+		SubRoute: "",
+		Handler:  nil,
+	}
+})
+
+func TxDelistMarketProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delist-market-proposal [path_to_file.json] [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to delist a market",
+		Long: strings.TrimSpace(`Submit a proposal to delist a market. New orders cannot be created and existing orders
+cannot be filled in a delisted market.
+The json file MUST take the following form:
+{
+    "title": "some title",
+    "description": "some description",
+    "market_id": 1
+}`),
+		Example: `regen tx gov submit-proposal delist-market-proposal my_file.json --deposit=100regen`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposalFile, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var proposal marketplace.DelistMarketProposal
+			err = clientCtx.Codec.UnmarshalJSON(proposalFile, &proposal)
+			if err != nil {
+				return err
+			}
+			if err := proposal.ValidateBasic(); err != nil {
+				return fmt.Errorf("invalid proposal: %w", err)
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+			var content types.Content = &proposal
+			msg, err := types.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
+	return cmd
+}
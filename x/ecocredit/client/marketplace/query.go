@@ -1,7 +1,9 @@
 package marketplace
 
 import (
+	"fmt"
 	"strconv"
+	"time"
 
 	sdkclient "github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -50,7 +52,10 @@ func QuerySellOrdersCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "sell-orders",
 		Short: "List all sell orders",
-		Long:  `List all sell orders with optional pagination flags.`,
+		Long: `List all sell orders with optional pagination flags.
+
+Private sell orders (sell orders restricted to an allowlist of buyers or a group policy) are
+only included when the --include-private flag is set.`,
 		Example: `regen q sell-orders
 regen q sell-orders --limit 10 --offset 10`,
 		Args: cobra.ExactArgs(0),
@@ -65,8 +70,13 @@ regen q sell-orders --limit 10 --offset 10`,
 			if err != nil {
 				return err
 			}
+			includePrivate, err := cmd.Flags().GetBool(FlagIncludePrivate)
+			if err != nil {
+				return err
+			}
 			res, err := client.SellOrders(cmd.Context(), &marketplace.QuerySellOrdersRequest{
-				Pagination: pagination,
+				Pagination:     pagination,
+				IncludePrivate: includePrivate,
 			})
 			if err != nil {
 				return err
@@ -77,6 +87,7 @@ regen q sell-orders --limit 10 --offset 10`,
 	}
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "sell-orders")
+	cmd.Flags().Bool(FlagIncludePrivate, false, "include private sell orders")
 
 	return cmd
 }
@@ -86,7 +97,10 @@ func QuerySellOrdersBySellerCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "sell-orders-by-seller [seller]",
 		Short: "List all sell orders by seller",
-		Long:  `List all sell orders by seller with optional pagination flags.`,
+		Long: `List all sell orders by seller with optional pagination flags.
+
+Private sell orders (sell orders restricted to an allowlist of buyers or a group policy) are
+only included when the --include-private flag is set.`,
 		Example: `regen q ecocredit sell-orders-by-seller regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf
 regen q ecocredit sell-orders-by-seller regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf --limit 10 --offset 10`,
 		Args: cobra.ExactArgs(1),
@@ -101,9 +115,14 @@ regen q ecocredit sell-orders-by-seller regen18xvpj53vaupyfejpws5sktv5lnas5xj2ph
 			if err != nil {
 				return err
 			}
+			includePrivate, err := cmd.Flags().GetBool(FlagIncludePrivate)
+			if err != nil {
+				return err
+			}
 			res, err := client.SellOrdersBySeller(cmd.Context(), &marketplace.QuerySellOrdersBySellerRequest{
-				Seller:     args[0],
-				Pagination: pagination,
+				Seller:         args[0],
+				Pagination:     pagination,
+				IncludePrivate: includePrivate,
 			})
 			if err != nil {
 				return err
@@ -114,6 +133,7 @@ regen q ecocredit sell-orders-by-seller regen18xvpj53vaupyfejpws5sktv5lnas5xj2ph
 	}
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "sell-orders-by-seller")
+	cmd.Flags().Bool(FlagIncludePrivate, false, "include private sell orders")
 
 	return cmd
 }
@@ -123,7 +143,10 @@ func QuerySellOrdersByBatchCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "sell-orders-by-batch [batch_denom]",
 		Short: "List all sell orders by batch denom",
-		Long:  "List all sell orders by batch by denom with optional pagination flags.",
+		Long: `List all sell orders by batch by denom with optional pagination flags.
+
+Private sell orders (sell orders restricted to an allowlist of buyers or a group policy) are
+only included when the --include-private flag is set.`,
 		Example: `regen q ecocredit sell-orders-by-batch C01-001-20200101-20210101-001
 regen q ecocredit sell-orders-by-batch C01-001-20200101-20210101-001 --limit 10 --offset 10`,
 		Args: cobra.ExactArgs(1),
@@ -138,9 +161,14 @@ regen q ecocredit sell-orders-by-batch C01-001-20200101-20210101-001 --limit 10
 			if err != nil {
 				return err
 			}
+			includePrivate, err := cmd.Flags().GetBool(FlagIncludePrivate)
+			if err != nil {
+				return err
+			}
 			res, err := client.SellOrdersByBatch(cmd.Context(), &marketplace.QuerySellOrdersByBatchRequest{
-				BatchDenom: args[0],
-				Pagination: pagination,
+				BatchDenom:     args[0],
+				Pagination:     pagination,
+				IncludePrivate: includePrivate,
 			})
 			if err != nil {
 				return err
@@ -151,6 +179,235 @@ regen q ecocredit sell-orders-by-batch C01-001-20200101-20210101-001 --limit 10
 	}
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "sell-orders-by-batch")
+	cmd.Flags().Bool(FlagIncludePrivate, false, "include private sell orders")
+	return cmd
+}
+
+// QuerySellOrdersByClassCmd returns a query command that retrieves all sell orders for a given credit class
+// with optional filters and pagination.
+func QuerySellOrdersByClassCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sell-orders-by-class [class-id]",
+		Short: "List all sell orders by credit class",
+		Long: `List all sell orders by credit class with optional filters and pagination flags.
+
+Sell orders are listed by the start date of the credit batch. The --project-jurisdiction flag
+filters sell orders by a prefix of the project jurisdiction and the --min-batch-start-date and
+--max-batch-start-date flags filter sell orders by the start date of the credit batch.
+
+Private sell orders (sell orders restricted to an allowlist of buyers or a group policy) are
+only included when the --include-private flag is set.`,
+		Example: `regen q ecocredit sell-orders-by-class C01
+regen q ecocredit sell-orders-by-class C01 --project-jurisdiction US-WA --min-batch-start-date 2020-01-01
+regen q ecocredit sell-orders-by-class C01 --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			includePrivate, err := cmd.Flags().GetBool(FlagIncludePrivate)
+			if err != nil {
+				return err
+			}
+			jurisdiction, err := cmd.Flags().GetString(FlagProjectJurisdiction)
+			if err != nil {
+				return err
+			}
+			minStartDate, maxStartDate, err := readBatchStartDateFlags(cmd)
+			if err != nil {
+				return err
+			}
+			res, err := client.SellOrdersByClass(cmd.Context(), &marketplace.QuerySellOrdersByClassRequest{
+				ClassId:             args[0],
+				Pagination:          pagination,
+				IncludePrivate:      includePrivate,
+				ProjectJurisdiction: jurisdiction,
+				MinBatchStartDate:   minStartDate,
+				MaxBatchStartDate:   maxStartDate,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "sell-orders-by-class")
+	cmd.Flags().Bool(FlagIncludePrivate, false, "include private sell orders")
+	cmd.Flags().String(FlagProjectJurisdiction, "", "only include sell orders from projects with a jurisdiction starting with the prefix")
+	addBatchStartDateFlags(cmd)
+	return cmd
+}
+
+// QuerySellOrdersByProjectCmd returns a query command that retrieves all sell orders for a given project
+// with optional filters and pagination.
+func QuerySellOrdersByProjectCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sell-orders-by-project [project-id]",
+		Short: "List all sell orders by project",
+		Long: `List all sell orders by project with optional filters and pagination flags.
+
+Sell orders are listed by the start date of the credit batch. The --min-batch-start-date and
+--max-batch-start-date flags filter sell orders by the start date of the credit batch.
+
+Private sell orders (sell orders restricted to an allowlist of buyers or a group policy) are
+only included when the --include-private flag is set.`,
+		Example: `regen q ecocredit sell-orders-by-project C01-001
+regen q ecocredit sell-orders-by-project C01-001 --min-batch-start-date 2020-01-01 --max-batch-start-date 2020-12-31
+regen q ecocredit sell-orders-by-project C01-001 --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			includePrivate, err := cmd.Flags().GetBool(FlagIncludePrivate)
+			if err != nil {
+				return err
+			}
+			minStartDate, maxStartDate, err := readBatchStartDateFlags(cmd)
+			if err != nil {
+				return err
+			}
+			res, err := client.SellOrdersByProject(cmd.Context(), &marketplace.QuerySellOrdersByProjectRequest{
+				ProjectId:         args[0],
+				Pagination:        pagination,
+				IncludePrivate:    includePrivate,
+				MinBatchStartDate: minStartDate,
+				MaxBatchStartDate: maxStartDate,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "sell-orders-by-project")
+	cmd.Flags().Bool(FlagIncludePrivate, false, "include private sell orders")
+	addBatchStartDateFlags(cmd)
+	return cmd
+}
+
+// QuerySellOrdersByMarketCmd returns a query command that retrieves all sell orders within a given market
+// sorted by ask price with pagination.
+func QuerySellOrdersByMarketCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sell-orders-by-market [market-id]",
+		Short: "List all sell orders within a market sorted by ask price",
+		Long: `List all sell orders within a market sorted by ask price with optional pagination flags.
+
+Sell orders are listed with the lowest ask price first, or with the highest ask price first
+when the --reverse flag is set.
+
+Private sell orders (sell orders restricted to an allowlist of buyers or a group policy) are
+only included when the --include-private flag is set.`,
+		Example: `regen q ecocredit sell-orders-by-market 1
+regen q ecocredit sell-orders-by-market 1 --limit 10 --reverse`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			marketId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid market id: %s", err)
+			}
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			includePrivate, err := cmd.Flags().GetBool(FlagIncludePrivate)
+			if err != nil {
+				return err
+			}
+			res, err := client.SellOrdersByMarket(cmd.Context(), &marketplace.QuerySellOrdersByMarketRequest{
+				MarketId:       marketId,
+				Pagination:     pagination,
+				IncludePrivate: includePrivate,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "sell-orders-by-market")
+	cmd.Flags().Bool(FlagIncludePrivate, false, "include private sell orders")
+	return cmd
+}
+
+// QuerySellOrdersPendingExpiryCmd returns a query command that retrieves all sell orders with an expiration
+// sorted by expiration with pagination.
+func QuerySellOrdersPendingExpiryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sell-orders-pending-expiry",
+		Short: "List all sell orders with an expiration sorted by expiration",
+		Long: `List all sell orders with an expiration sorted by expiration (soonest first) with optional
+pagination flags. Sell orders that have expired but have not yet been pruned are listed first.
+
+The --expires-before flag only includes sell orders that expire on or before the date.
+
+Private sell orders (sell orders restricted to an allowlist of buyers or a group policy) are
+only included when the --include-private flag is set.`,
+		Example: `regen q ecocredit sell-orders-pending-expiry
+regen q ecocredit sell-orders-pending-expiry --expires-before 2024-01-01 --limit 10`,
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			includePrivate, err := cmd.Flags().GetBool(FlagIncludePrivate)
+			if err != nil {
+				return err
+			}
+			expiresBefore, err := readDateFlag(cmd, FlagExpiresBefore)
+			if err != nil {
+				return err
+			}
+			res, err := client.SellOrdersPendingExpiry(cmd.Context(), &marketplace.QuerySellOrdersPendingExpiryRequest{
+				ExpiresBefore:  expiresBefore,
+				Pagination:     pagination,
+				IncludePrivate: includePrivate,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "sell-orders-pending-expiry")
+	cmd.Flags().Bool(FlagIncludePrivate, false, "include private sell orders")
+	cmd.Flags().String(FlagExpiresBefore, "", "only include sell orders that expire on or before the date (format: YYYY-MM-DD)")
 	return cmd
 }
 
@@ -189,3 +446,912 @@ regen q ecocredit allowed-denoms --limit 10 --offset 10`,
 
 	return cmd
 }
+
+// QueryBuyOrderCmd returns a query command that retrieves information for a given buy order.
+func QueryBuyOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "buy-order [buy-order-id]",
+		Short:   "Retrieve information for a given buy order",
+		Long:    "Retrieve information for a given buy order.",
+		Example: "regen q ecocredit buy-order 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			buyOrderId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return ecocredit.ErrInvalidBuyOrder.Wrap(err.Error())
+			}
+			res, err := client.BuyOrder(cmd.Context(), &marketplace.QueryBuyOrderRequest{
+				BuyOrderId: buyOrderId,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryBuyOrdersCmd returns a query command that retrieves all buy orders with pagination.
+func QueryBuyOrdersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "buy-orders",
+		Short: "List all buy orders",
+		Long:  `List all buy orders with optional pagination flags.`,
+		Example: `regen q ecocredit buy-orders
+regen q ecocredit buy-orders --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := client.BuyOrders(cmd.Context(), &marketplace.QueryBuyOrdersRequest{
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "buy-orders")
+
+	return cmd
+}
+
+// QueryBuyOrdersByBuyerCmd returns a query command that retrieves all buy orders by address with pagination.
+func QueryBuyOrdersByBuyerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "buy-orders-by-buyer [buyer]",
+		Short: "List all buy orders by buyer",
+		Long:  `List all buy orders by buyer with optional pagination flags.`,
+		Example: `regen q ecocredit buy-orders-by-buyer regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf
+regen q ecocredit buy-orders-by-buyer regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := client.BuyOrdersByBuyer(cmd.Context(), &marketplace.QueryBuyOrdersByBuyerRequest{
+				Buyer:      args[0],
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "buy-orders-by-buyer")
+
+	return cmd
+}
+
+// QueryOfferCmd returns a query command that retrieves information for a given offer.
+func QueryOfferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "offer [offer-id]",
+		Short:   "Retrieve information for a given offer",
+		Long:    "Retrieve information for a given offer.",
+		Example: "regen q ecocredit offer 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			offerId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid offer id: %s", err)
+			}
+			res, err := client.Offer(cmd.Context(), &marketplace.QueryOfferRequest{
+				OfferId: offerId,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryOffersByBatchCmd returns a query command that retrieves all offers for a given batch with pagination.
+func QueryOffersByBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "offers-by-batch [batch-denom]",
+		Short: "List all offers for a given batch",
+		Long:  `List all offers for a given batch with optional pagination flags.`,
+		Example: `regen q ecocredit offers-by-batch C01-001-20200101-20210101-001
+regen q ecocredit offers-by-batch C01-001-20200101-20210101-001 --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := client.OffersByBatch(cmd.Context(), &marketplace.QueryOffersByBatchRequest{
+				BatchDenom: args[0],
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "offers-by-batch")
+
+	return cmd
+}
+
+// QueryOffersByBuyerCmd returns a query command that retrieves all offers made by a given buyer with pagination.
+func QueryOffersByBuyerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "offers-by-buyer [buyer]",
+		Short: "List all offers made by a given buyer",
+		Long:  `List all offers made by a given buyer with optional pagination flags.`,
+		Example: `regen q ecocredit offers-by-buyer regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf
+regen q ecocredit offers-by-buyer regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := client.OffersByBuyer(cmd.Context(), &marketplace.QueryOffersByBuyerRequest{
+				Buyer:      args[0],
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "offers-by-buyer")
+
+	return cmd
+}
+
+// QueryOffersByHolderCmd returns a query command that retrieves all offers made to a given holder with pagination.
+func QueryOffersByHolderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "offers-by-holder [holder]",
+		Short: "List all offers made to a given holder",
+		Long:  `List all offers made to a given holder with optional pagination flags.`,
+		Example: `regen q ecocredit offers-by-holder regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf
+regen q ecocredit offers-by-holder regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := client.OffersByHolder(cmd.Context(), &marketplace.QueryOffersByHolderRequest{
+				Holder:     args[0],
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "offers-by-holder")
+
+	return cmd
+}
+
+// QueryMarketFeesCmd returns a query command that retrieves the fees and the fees collected in a given market.
+func QueryMarketFeesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "market-fees [market-id]",
+		Short:   "Retrieve the fees and the fees collected in a given market",
+		Long:    "Retrieve the maker and taker fees and the total amount of fees collected in a given market.",
+		Example: "regen q ecocredit market-fees 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			marketId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			res, err := client.MarketFees(cmd.Context(), &marketplace.QueryMarketFeesRequest{
+				MarketId: marketId,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryFeeDestinationCmd returns a query command that retrieves the destination of marketplace fees.
+func QueryFeeDestinationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fee-destination",
+		Short:   "Retrieve the destination of marketplace fees",
+		Long:    "Retrieve the destination of marketplace fees.",
+		Example: "regen q ecocredit fee-destination",
+		Args:    cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			res, err := client.FeeDestination(cmd.Context(), &marketplace.QueryFeeDestinationRequest{})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryTradesByBatchCmd returns a query command that retrieves all trades by batch denom with pagination.
+func QueryTradesByBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trades-by-batch [batch_denom]",
+		Short: "List all trades by batch denom",
+		Long:  "List all trades by batch denom with optional pagination flags.",
+		Example: `regen q ecocredit trades-by-batch C01-001-20200101-20210101-001
+regen q ecocredit trades-by-batch C01-001-20200101-20210101-001 --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := client.TradesByBatch(cmd.Context(), &marketplace.QueryTradesByBatchRequest{
+				BatchDenom: args[0],
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "trades-by-batch")
+
+	return cmd
+}
+
+// QueryTradesByMarketCmd returns a query command that retrieves all trades in a market with pagination.
+func QueryTradesByMarketCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trades-by-market [market-id]",
+		Short: "List all trades in a market",
+		Long:  "List all trades in a market ordered by time with optional pagination flags.",
+		Example: `regen q ecocredit trades-by-market 1
+regen q ecocredit trades-by-market 1 --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			marketId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := client.TradesByMarket(cmd.Context(), &marketplace.QueryTradesByMarketRequest{
+				MarketId:   marketId,
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "trades-by-market")
+
+	return cmd
+}
+
+// QueryTradesByAddressCmd returns a query command that retrieves all trades of an account with pagination.
+func QueryTradesByAddressCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trades-by-address [address]",
+		Short: "List all trades in which an account was the buyer or the seller",
+		Long:  "List all trades in which an account was the buyer or the seller with optional pagination flags.",
+		Example: `regen q ecocredit trades-by-address regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf
+regen q ecocredit trades-by-address regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := client.TradesByAddress(cmd.Context(), &marketplace.QueryTradesByAddressRequest{
+				Address:    args[0],
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "trades-by-address")
+
+	return cmd
+}
+
+// QueryMarketStatsCmd returns a query command that retrieves price statistics of a market.
+func QueryMarketStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "market-stats [market-id]",
+		Short: "Retrieve price statistics of a market",
+		Long: `Retrieve the last price of a market and the volume, volume weighted average price, and high and low
+prices of trades in the market within a window of time ending at the current block time.
+
+Flags:
+  window: the length of the window (e.g. 24h), defaults to 24h and cannot exceed 720h (30 days)`,
+		Example: `regen q ecocredit market-stats 1
+regen q ecocredit market-stats 1 --window 24h`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			marketId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			window, err := cmd.Flags().GetDuration(FlagWindow)
+			if err != nil {
+				return err
+			}
+			res, err := client.MarketStats(cmd.Context(), &marketplace.QueryMarketStatsRequest{
+				MarketId:      marketId,
+				WindowSeconds: uint64(window.Seconds()),
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Duration(FlagWindow, 24*time.Hour, "the length of the window of time (e.g. 24h)")
+
+	return cmd
+}
+
+// QueryDutchAuctionCmd returns a query command that retrieves information for a given dutch auction.
+func QueryDutchAuctionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "dutch-auction [auction-id]",
+		Short:   "Retrieve information for a given dutch auction",
+		Long:    "Retrieve information for a given dutch auction, including the current ask price.",
+		Example: "regen q ecocredit dutch-auction 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			auctionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid auction id: %s", err)
+			}
+			res, err := client.DutchAuction(cmd.Context(), &marketplace.QueryDutchAuctionRequest{
+				AuctionId: auctionId,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryDutchAuctionsCmd returns a query command that retrieves all dutch auctions with pagination.
+func QueryDutchAuctionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dutch-auctions",
+		Short: "List all dutch auctions",
+		Long:  "List all dutch auctions with optional pagination flags.",
+		Example: `regen q ecocredit dutch-auctions
+regen q ecocredit dutch-auctions --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := client.DutchAuctions(cmd.Context(), &marketplace.QueryDutchAuctionsRequest{
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "dutch-auctions")
+
+	return cmd
+}
+
+// QuerySealedBidAuctionCmd returns a query command that retrieves information for a given sealed-bid auction.
+func QuerySealedBidAuctionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "sealed-bid-auction [auction-id]",
+		Short:   "Retrieve information for a given sealed-bid auction",
+		Long:    "Retrieve information for a given sealed-bid auction.",
+		Example: "regen q ecocredit sealed-bid-auction 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			auctionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid auction id: %s", err)
+			}
+			res, err := client.SealedBidAuction(cmd.Context(), &marketplace.QuerySealedBidAuctionRequest{
+				AuctionId: auctionId,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QuerySealedBidAuctionsCmd returns a query command that retrieves all sealed-bid auctions with pagination.
+func QuerySealedBidAuctionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sealed-bid-auctions",
+		Short: "List all sealed-bid auctions",
+		Long:  "List all sealed-bid auctions with optional pagination flags.",
+		Example: `regen q ecocredit sealed-bid-auctions
+regen q ecocredit sealed-bid-auctions --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := client.SealedBidAuctions(cmd.Context(), &marketplace.QuerySealedBidAuctionsRequest{
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "sealed-bid-auctions")
+
+	return cmd
+}
+
+// QuerySealedBidsCmd returns a query command that retrieves all bids for a given sealed-bid auction with pagination.
+func QuerySealedBidsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sealed-bids [auction-id]",
+		Short: "List all bids for a given sealed-bid auction",
+		Long: `List all bids for a given sealed-bid auction with optional pagination flags.
+
+The quantity and bid amount of a bid are only included once the bid has been revealed.`,
+		Example: `regen q ecocredit sealed-bids 1
+regen q ecocredit sealed-bids 1 --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			auctionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid auction id: %s", err)
+			}
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := client.SealedBids(cmd.Context(), &marketplace.QuerySealedBidsRequest{
+				AuctionId:  auctionId,
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "sealed-bids")
+
+	return cmd
+}
+
+// QueryForwardSaleCmd returns a query command that retrieves information for a given forward sale.
+func QueryForwardSaleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "forward-sale [forward-sale-id]",
+		Short:   "Retrieve information for a given forward sale",
+		Long:    "Retrieve information for a given forward sale.",
+		Example: "regen q ecocredit forward-sale 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			forwardSaleId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid forward sale id: %s", err)
+			}
+			res, err := client.ForwardSale(cmd.Context(), &marketplace.QueryForwardSaleRequest{
+				ForwardSaleId: forwardSaleId,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryForwardSalesByProjectCmd returns a query command that retrieves all forward sales for a given project with pagination.
+func QueryForwardSalesByProjectCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "forward-sales-by-project [project-id]",
+		Short: "List all forward sales for a given project",
+		Long:  `List all forward sales for a given project with optional pagination flags.`,
+		Example: `regen q ecocredit forward-sales-by-project C01-001
+regen q ecocredit forward-sales-by-project C01-001 --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := client.ForwardSalesByProject(cmd.Context(), &marketplace.QueryForwardSalesByProjectRequest{
+				ProjectId:  args[0],
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "forward-sales-by-project")
+
+	return cmd
+}
+
+// QueryForwardContractCmd returns a query command that retrieves information for a given forward contract.
+func QueryForwardContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "forward-contract [forward-contract-id]",
+		Short:   "Retrieve information for a given forward contract",
+		Long:    "Retrieve information for a given forward contract.",
+		Example: "regen q ecocredit forward-contract 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			forwardContractId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid forward contract id: %s", err)
+			}
+			res, err := client.ForwardContract(cmd.Context(), &marketplace.QueryForwardContractRequest{
+				ForwardContractId: forwardContractId,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryForwardContractsBySaleCmd returns a query command that retrieves all forward contracts for a given forward
+// sale in order of delivery priority with pagination.
+func QueryForwardContractsBySaleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "forward-contracts-by-sale [forward-sale-id]",
+		Short: "List all forward contracts for a given forward sale",
+		Long: `List all forward contracts for a given forward sale with optional pagination flags.
+
+Forward contracts are listed in the order in which they are delivered.`,
+		Example: `regen q ecocredit forward-contracts-by-sale 1
+regen q ecocredit forward-contracts-by-sale 1 --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			forwardSaleId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid forward sale id: %s", err)
+			}
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := client.ForwardContractsBySale(cmd.Context(), &marketplace.QueryForwardContractsBySaleRequest{
+				ForwardSaleId: forwardSaleId,
+				Pagination:    pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "forward-contracts-by-sale")
+
+	return cmd
+}
+
+// QueryForwardContractsByBuyerCmd returns a query command that retrieves all forward contracts for a given buyer
+// with pagination.
+func QueryForwardContractsByBuyerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "forward-contracts-by-buyer [buyer]",
+		Short: "List all forward contracts for a given buyer",
+		Long:  `List all forward contracts for a given buyer with optional pagination flags.`,
+		Example: `regen q ecocredit forward-contracts-by-buyer regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf
+regen q ecocredit forward-contracts-by-buyer regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := client.ForwardContractsByBuyer(cmd.Context(), &marketplace.QueryForwardContractsByBuyerRequest{
+				Buyer:      args[0],
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "forward-contracts-by-buyer")
+
+	return cmd
+}
+
+// QueryMarketsCmd returns a query command that retrieves all markets with pagination.
+func QueryMarketsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "markets",
+		Short: "List all markets",
+		Long:  "List all markets with optional pagination flags.",
+		Example: `regen q ecocredit markets
+regen q ecocredit markets --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := client.Markets(cmd.Context(), &marketplace.QueryMarketsRequest{
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "markets")
+
+	return cmd
+}
+
+// QueryMarketRegistrationCmd returns a query command that retrieves whether market registration is
+// permissionless and the deposit paid to register a market.
+func QueryMarketRegistrationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "market-registration",
+		Short:   "Retrieve whether market registration is permissionless",
+		Long:    "Retrieve whether market registration is permissionless and the deposit paid to register a market.",
+		Example: "regen q ecocredit market-registration",
+		Args:    cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			res, err := client.MarketRegistration(cmd.Context(), &marketplace.QueryMarketRegistrationRequest{})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -3,6 +3,7 @@ package marketplace
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -11,11 +12,24 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	"github.com/regen-network/regen-ledger/types"
 	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
 )
 
 const (
 	FlagRetirementJurisdiction = "retirement-jurisdiction"
+	FlagBeneficiaryAddress     = "beneficiary-address"
+	FlagBeneficiaryName        = "beneficiary-name"
+	FlagReason                 = "reason"
+	FlagWindow                 = "window"
+	FlagIncludePrivate         = "include-private"
+	FlagHolder                 = "holder"
+	FlagExpiration             = "expiration"
+	FlagProjectJurisdiction    = "project-jurisdiction"
+	FlagMinBatchStartDate      = "min-batch-start-date"
+	FlagMaxBatchStartDate      = "max-batch-start-date"
+	FlagExpiresBefore          = "expires-before"
+	FlagMaxTotalCost           = "max-total-cost"
 )
 
 // TxSellCmd returns a transaction command that creates sell orders.
@@ -50,8 +64,43 @@ Example JSON:
 	},
     "disable_auto_retire": false,
     "expiration": "2024-01-01T00:00:00Z"
+  },
+  {
+    "batch_denom": "C01-001-20200101-20210101-003",
+    "quantity": "10",
+    "ask_price": {
+      "denom": "uregen",
+      "amount" "100000000"
+	},
+    "allowed_buyers": ["regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf"],
+    "buyer_group_policy": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+  },
+  {
+    "batch_denom": "C01-001-20200101-20210101-004",
+    "quantity": "100",
+    "ask_price": {
+      "denom": "uregen",
+      "amount" "100000000"
+	},
+    "min_fill_quantity": "10"
+  },
+  {
+    "batch_denom": "C01-001-20200101-20210101-005",
+    "quantity": "100",
+    "ask_price": {
+      "denom": "uregen",
+      "amount" "100000000"
+	},
+    "all_or_none": true
   }
-]`,
+]
+
+Sell orders with "allowed_buyers" or "buyer_group_policy" are private and can only be
+filled by the listed addresses.
+
+Sell orders with "min_fill_quantity" can only be filled with at least the minimum quantity
+(unless the entire remaining quantity is purchased) and sell orders with "all_or_none" can
+only be filled with the entire remaining quantity.`,
 		Args:    cobra.ExactArgs(1),
 		Example: "regen tx ecocredit sell orders.json",
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -181,8 +230,29 @@ NOTE: The bid price is the price paid PER credit. The total cost will be quantit
 				return err
 			}
 
+			beneficiaryAddress, err := cmd.Flags().GetString(FlagBeneficiaryAddress)
+			if err != nil {
+				return err
+			}
+
+			beneficiaryName, err := cmd.Flags().GetString(FlagBeneficiaryName)
+			if err != nil {
+				return err
+			}
+
+			reason, err := cmd.Flags().GetString(FlagReason)
+			if err != nil {
+				return err
+			}
+
+			maxTotalCost, err := readMaxTotalCostFlag(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := marketplace.MsgBuyDirect{
-				Buyer: clientCtx.GetFromAddress().String(),
+				Buyer:        clientCtx.GetFromAddress().String(),
+				MaxTotalCost: maxTotalCost,
 				Orders: []*marketplace.MsgBuyDirect_Order{
 					{
 						SellOrderId:            sellOrderId,
@@ -190,6 +260,9 @@ NOTE: The bid price is the price paid PER credit. The total cost will be quantit
 						BidPrice:               &bidPrice,
 						DisableAutoRetire:      disableAutoRetire,
 						RetirementJurisdiction: retireJurisdiction,
+						BeneficiaryAddress:     beneficiaryAddress,
+						BeneficiaryName:        beneficiaryName,
+						Reason:                 reason,
 					},
 				},
 			}
@@ -199,6 +272,10 @@ NOTE: The bid price is the price paid PER credit. The total cost will be quantit
 	}
 
 	cmd.Flags().String(FlagRetirementJurisdiction, "", "the jurisdiction to use for retirement when auto retire is true.")
+	cmd.Flags().String(FlagBeneficiaryAddress, "", "the address of the account on whose behalf the credits are retired when auto retire is true.")
+	cmd.Flags().String(FlagBeneficiaryName, "", "the name of the individual or organization on whose behalf the credits are retired when auto retire is true.")
+	cmd.Flags().String(FlagReason, "", "the reason for retiring the credits when auto retire is true.")
+	cmd.Flags().String(FlagMaxTotalCost, "", "the maximum total cost of the purchase including the taker fee.")
 
 	return txFlags(cmd)
 }
@@ -214,8 +291,13 @@ DisableAutoRetire can be set to false to retire the credits immediately
 upon purchase. When set to true, credits will be received in a tradable
 state, IF AND ONLY IF the sell order also has auto retire disabled.
 
+All orders are filled with the requested quantity or the transaction fails. When the
+--max-total-cost flag is set, the transaction also fails if the total cost of the orders
+including taker fees exceeds the maximum total cost.
+
 NOTE: The bid price is the price paid PER credit. The total cost will be quantity * bid_price.`,
 		Example: `regen tx ecocredit buy-direct-bulk orders.json
+regen tx ecocredit buy-direct-bulk orders.json --max-total-cost 2100000000uregen
 
 Example JSON:
 [
@@ -227,7 +309,10 @@ Example JSON:
       "amount": "32000000"
     },
     "disable_auto_retire": false,
-    "retirement_jurisdiction": "US-NY"
+    "retirement_jurisdiction": "US-NY",
+    "beneficiary_address": "regen1r9pl9gvr56kmclgkpjg3ynh4rm5am66f2a6y38",
+    "beneficiary_name": "Acme Corporation",
+    "reason": "offsetting emissions from business travel"
   },
   {
     "sell_order_id": 2,
@@ -252,15 +337,23 @@ Example JSON:
 				return sdkerrors.ErrInvalidRequest.Wrapf("failed to parse json: %s", err)
 			}
 
+			maxTotalCost, err := readMaxTotalCostFlag(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := marketplace.MsgBuyDirect{
-				Buyer:  clientCtx.GetFromAddress().String(),
-				Orders: orders,
+				Buyer:        clientCtx.GetFromAddress().String(),
+				Orders:       orders,
+				MaxTotalCost: maxTotalCost,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
 
+	cmd.Flags().String(FlagMaxTotalCost, "", "the maximum total cost of all orders including taker fees (e.g. 1000uregen,500uatom).")
+
 	return txFlags(cmd)
 }
 
@@ -294,3 +387,708 @@ func TxCancelSellOrderCmd() *cobra.Command {
 
 	return txFlags(cmd)
 }
+
+// TxBuyCmd returns a transaction command that creates buy orders.
+func TxBuyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "buy [orders-json]",
+		Short: "Creates new buy orders with transaction author (--from) as buyer",
+		Long: `Creates new buy orders with transaction author (--from) as buyer.
+
+Buy orders select credit batches using a filter and are filled at the end of
+each block with sell orders for the selected credit batches that have an ask
+price less than or equal to the bid price. The total bid amount of each buy
+order is held in escrow until the buy order is filled, cancelled, or expires.
+
+DisableAutoRetire can be set to false to retire the credits immediately
+upon purchase. When set to true, buy orders are only filled with sell orders
+that also have auto retire disabled.
+
+NOTE: The bid price is the price paid PER credit. The total cost will be quantity * bid_price.
+
+Parameters:
+
+- orders-json:  path to JSON file containing orders to create
+
+Example JSON:
+
+[
+  {
+    "filter": {
+      "or": [
+        {
+          "class_selector": {
+            "class_id": "C01",
+            "project_jurisdiction": "US-WA",
+            "min_start_date": "2020-01-01T00:00:00Z"
+          }
+        },
+        {
+          "batch_selector": {
+            "batch_denom": "C02-001-20200101-20210101-001"
+          }
+        }
+      ]
+    },
+    "quantity": "5",
+    "bid_price": {
+      "denom": "uregen",
+      "amount": "100000000"
+    },
+    "disable_auto_retire": false,
+    "retirement_jurisdiction": "US-NY",
+    "expiration": "2024-01-01T00:00:00Z"
+  }
+]`,
+		Args:    cobra.ExactArgs(1),
+		Example: "regen tx ecocredit buy orders.json",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			orders, err := parseFilteredBuyOrders(args[0])
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("failed to parse json: %s", err)
+			}
+
+			msg := marketplace.MsgBuy{
+				Buyer:  clientCtx.GetFromAddress().String(),
+				Orders: orders,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxCancelBuyOrderCmd returns a transaction command that cancels a buy order.
+func TxCancelBuyOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-buy-order [order-id]",
+		Short:   "Cancel an existing buy order with transaction author (--from) as buyer",
+		Long:    "Cancel an existing buy order with transaction author (--from) as buyer. The escrowed funds are returned to the buyer.",
+		Example: "regen tx ecocredit cancel-buy-order 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid order id: %s", err)
+			}
+
+			msg := marketplace.MsgCancelBuyOrder{
+				Buyer:      clientCtx.GetFromAddress().String(),
+				BuyOrderId: id,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxMakeOfferCmd returns a transaction command that makes an offer to buy credits from a credit batch.
+func TxMakeOfferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "make-offer [batch-denom] [quantity] [bid-price] [disable-auto-retire] [flags]",
+		Short: "Makes an offer to buy credits with transaction author (--from) as buyer",
+		Long: `Makes an offer to buy credits from a credit batch with transaction author (--from) as buyer.
+
+The offer can be accepted by any holder of credits from the credit batch, or only by
+the holder set with --holder. The total bid amount of the offer is held in escrow until
+the offer is accepted, cancelled, or expires.
+
+DisableAutoRetire can be set to false to retire the credits immediately
+upon acceptance. When set to true, credits will be received in a tradable state.
+
+NOTE: The bid price is the price paid PER credit. The total cost will be quantity * bid_price.`,
+		Example: `regen tx ecocredit make-offer C01-001-20200101-20210101-001 300 10000000uregen false --retirement-jurisdiction "US-WA 98225"
+regen tx ecocredit make-offer C01-001-20200101-20210101-001 300 10000000uregen true --holder regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf --expiration 2024-01-01`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bidPrice, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			disableAutoRetire, err := strconv.ParseBool(args[3])
+			if err != nil {
+				return err
+			}
+
+			holder, err := cmd.Flags().GetString(FlagHolder)
+			if err != nil {
+				return err
+			}
+
+			retireJurisdiction, err := cmd.Flags().GetString(FlagRetirementJurisdiction)
+			if err != nil {
+				return err
+			}
+
+			msg := marketplace.MsgMakeOffer{
+				Buyer:                  clientCtx.GetFromAddress().String(),
+				BatchDenom:             args[0],
+				Holder:                 holder,
+				Quantity:               args[1],
+				BidPrice:               &bidPrice,
+				DisableAutoRetire:      disableAutoRetire,
+				RetirementJurisdiction: retireJurisdiction,
+			}
+
+			expirationStr, err := cmd.Flags().GetString(FlagExpiration)
+			if err != nil {
+				return err
+			}
+			if expirationStr != "" {
+				expiration, err := types.ParseDate("expiration", expirationStr)
+				if err != nil {
+					return err
+				}
+				msg.Expiration = &expiration
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagHolder, "", "the address of the only account allowed to accept the offer.")
+	cmd.Flags().String(FlagRetirementJurisdiction, "", "the jurisdiction to use for retirement when auto retire is true.")
+	cmd.Flags().String(FlagExpiration, "", "the expiration date of the offer (format: YYYY-MM-DD).")
+
+	return txFlags(cmd)
+}
+
+// TxAcceptOfferCmd returns a transaction command that accepts an offer to buy credits.
+func TxAcceptOfferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-offer [offer-id] [quantity]",
+		Short: "Accept an offer with transaction author (--from) as holder",
+		Long: `Accept an offer with transaction author (--from) as holder.
+
+The quantity of credits is transferred from the tradable balance of the holder to
+the buyer and the bid price for the credits is released from escrow to the holder.`,
+		Example: "regen tx ecocredit accept-offer 1 100",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid offer id: %s", err)
+			}
+
+			msg := marketplace.MsgAcceptOffer{
+				Holder:   clientCtx.GetFromAddress().String(),
+				OfferId:  id,
+				Quantity: args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxCancelOfferCmd returns a transaction command that cancels an offer.
+func TxCancelOfferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-offer [offer-id]",
+		Short:   "Cancel an existing offer with transaction author (--from) as buyer",
+		Long:    "Cancel an existing offer with transaction author (--from) as buyer. The escrowed funds are returned to the buyer.",
+		Example: "regen tx ecocredit cancel-offer 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid offer id: %s", err)
+			}
+
+			msg := marketplace.MsgCancelOffer{
+				Buyer:   clientCtx.GetFromAddress().String(),
+				OfferId: id,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxCreateDutchAuctionCmd returns a transaction command that creates a dutch auction.
+func TxCreateDutchAuctionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-dutch-auction [batch-denom] [quantity] [start-price] [floor-price] [duration] [disable-auto-retire]",
+		Short: "Creates a dutch auction with transaction author (--from) as seller",
+		Long: `Creates a dutch auction with transaction author (--from) as seller.
+
+The ask price of the auction decreases linearly from the start price to the floor price
+over the given duration and remains at the floor price until all credits are sold or the
+auction is cancelled. The credits are held in escrow until they are sold or the auction
+is cancelled.
+
+NOTE: The start price and the floor price are prices PER credit.`,
+		Example: "regen tx ecocredit create-dutch-auction C01-001-20200101-20210101-001 100 100uregen 40uregen 24h false",
+		Args:    cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			startPrice, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			floorPrice, err := sdk.ParseCoinNormalized(args[3])
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(args[4])
+			if err != nil {
+				return fmt.Errorf("invalid duration: %s", err)
+			}
+
+			disableAutoRetire, err := strconv.ParseBool(args[5])
+			if err != nil {
+				return err
+			}
+
+			msg := marketplace.MsgCreateDutchAuction{
+				Seller:            clientCtx.GetFromAddress().String(),
+				BatchDenom:        args[0],
+				Quantity:          args[1],
+				StartPrice:        &startPrice,
+				FloorPrice:        &floorPrice,
+				Duration:          duration,
+				DisableAutoRetire: disableAutoRetire,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxBuyDutchAuctionCmd returns a transaction command that buys credits from a dutch auction.
+func TxBuyDutchAuctionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "buy-dutch-auction [auction-id] [quantity] [max-price] [disable-auto-retire] [flags]",
+		Short: "Buy credits from a dutch auction with transaction author (--from) as buyer",
+		Long: `Buy credits from a dutch auction with transaction author (--from) as buyer.
+
+The credits are bought at the current ask price of the auction, which must not
+exceed the max price.
+
+NOTE: The max price is the price PER credit.`,
+		Example: `regen tx ecocredit buy-dutch-auction 1 10 80uregen false --retirement-jurisdiction "US-WA 98225"
+regen tx ecocredit buy-dutch-auction 1 10 80uregen true`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid auction id: %s", err)
+			}
+
+			maxPrice, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			disableAutoRetire, err := strconv.ParseBool(args[3])
+			if err != nil {
+				return err
+			}
+
+			retireJurisdiction, err := cmd.Flags().GetString(FlagRetirementJurisdiction)
+			if err != nil {
+				return err
+			}
+
+			msg := marketplace.MsgBuyDutchAuction{
+				Buyer:                  clientCtx.GetFromAddress().String(),
+				AuctionId:              id,
+				Quantity:               args[1],
+				MaxPrice:               &maxPrice,
+				DisableAutoRetire:      disableAutoRetire,
+				RetirementJurisdiction: retireJurisdiction,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagRetirementJurisdiction, "", "the jurisdiction to use for retirement when auto retire is true.")
+
+	return txFlags(cmd)
+}
+
+// TxCancelDutchAuctionCmd returns a transaction command that cancels a dutch auction.
+func TxCancelDutchAuctionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-dutch-auction [auction-id]",
+		Short:   "Cancel a dutch auction with transaction author (--from) as seller",
+		Long:    "Cancel a dutch auction with transaction author (--from) as seller. The escrowed credits are returned to the seller.",
+		Example: "regen tx ecocredit cancel-dutch-auction 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid auction id: %s", err)
+			}
+
+			msg := marketplace.MsgCancelDutchAuction{
+				Seller:    clientCtx.GetFromAddress().String(),
+				AuctionId: id,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxCreateSealedBidAuctionCmd returns a transaction command that creates a sealed-bid auction.
+func TxCreateSealedBidAuctionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-sealed-bid-auction [batch-denom] [quantity] [reserve-price] [commit-end-height] [reveal-end-height] [disable-auto-retire]",
+		Short: "Creates a sealed-bid auction with transaction author (--from) as seller",
+		Long: `Creates a sealed-bid auction with transaction author (--from) as seller.
+
+Bids are committed until the commit end height and revealed until the reveal end height,
+at which point the auction is settled at a uniform clearing price. The credits are held
+in escrow until the auction is settled.
+
+NOTE: The reserve price is the price PER credit.`,
+		Example: "regen tx ecocredit create-sealed-bid-auction C01-001-20200101-20210101-001 100 10uregen 1000 2000 false",
+		Args:    cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			reservePrice, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			commitEndHeight, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid commit end height: %s", err)
+			}
+
+			revealEndHeight, err := strconv.ParseInt(args[4], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid reveal end height: %s", err)
+			}
+
+			disableAutoRetire, err := strconv.ParseBool(args[5])
+			if err != nil {
+				return err
+			}
+
+			msg := marketplace.MsgCreateSealedBidAuction{
+				Seller:            clientCtx.GetFromAddress().String(),
+				BatchDenom:        args[0],
+				Quantity:          args[1],
+				ReservePrice:      &reservePrice,
+				CommitEndHeight:   commitEndHeight,
+				RevealEndHeight:   revealEndHeight,
+				DisableAutoRetire: disableAutoRetire,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxCommitSealedBidCmd returns a transaction command that commits a sealed bid to a sealed-bid auction.
+func TxCommitSealedBidCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-sealed-bid [auction-id] [quantity] [bid-amount] [salt] [deposit]",
+		Short: "Commit a sealed bid with transaction author (--from) as bidder",
+		Long: `Commit a sealed bid with transaction author (--from) as bidder.
+
+Only the commitment of the bid is submitted, the quantity, bid amount and salt must
+be provided again when revealing the bid. The deposit is held in escrow until the
+auction is settled and must cover the total cost of the bid and the taker fee.
+
+NOTE: The bid amount is the amount PER credit in the denom of the deposit.`,
+		Example: "regen tx ecocredit commit-sealed-bid 1 10 15 mysecretsalt 200uregen",
+		Args:    cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid auction id: %s", err)
+			}
+
+			deposit, err := sdk.ParseCoinNormalized(args[4])
+			if err != nil {
+				return err
+			}
+
+			bidder := clientCtx.GetFromAddress().String()
+			msg := marketplace.MsgCommitSealedBid{
+				Bidder:     bidder,
+				AuctionId:  id,
+				Commitment: marketplace.SealedBidCommitment(id, bidder, args[1], args[2], args[3]),
+				Deposit:    &deposit,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxRevealSealedBidCmd returns a transaction command that reveals a sealed bid.
+func TxRevealSealedBidCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reveal-sealed-bid [auction-id] [quantity] [bid-amount] [salt] [disable-auto-retire] [flags]",
+		Short: "Reveal a sealed bid with transaction author (--from) as bidder",
+		Long: `Reveal a sealed bid with transaction author (--from) as bidder.
+
+The quantity, bid amount and salt must match the values used to commit the bid.
+Bids that are not revealed before the reveal end height are not filled.`,
+		Example: `regen tx ecocredit reveal-sealed-bid 1 10 15 mysecretsalt false --retirement-jurisdiction "US-WA 98225"
+regen tx ecocredit reveal-sealed-bid 1 10 15 mysecretsalt true`,
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid auction id: %s", err)
+			}
+
+			disableAutoRetire, err := strconv.ParseBool(args[4])
+			if err != nil {
+				return err
+			}
+
+			retireJurisdiction, err := cmd.Flags().GetString(FlagRetirementJurisdiction)
+			if err != nil {
+				return err
+			}
+
+			msg := marketplace.MsgRevealSealedBid{
+				Bidder:                 clientCtx.GetFromAddress().String(),
+				AuctionId:              id,
+				Quantity:               args[1],
+				BidAmount:              args[2],
+				Salt:                   args[3],
+				DisableAutoRetire:      disableAutoRetire,
+				RetirementJurisdiction: retireJurisdiction,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagRetirementJurisdiction, "", "the jurisdiction to use for retirement when auto retire is true.")
+
+	return txFlags(cmd)
+}
+
+// TxCreateForwardSaleCmd returns a transaction command that creates a forward sale.
+func TxCreateForwardSaleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-forward-sale [project-id] [quantity] [ask-price] [vintage-start] [vintage-end] [delivery-deadline] [disable-auto-retire]",
+		Short: "Creates a forward sale of future credits with transaction author (--from) as seller",
+		Long: `Creates a forward sale of future credits with transaction author (--from) as seller.
+
+The seller must be the admin of the project. Credits are delivered to buyers when a
+credit batch with a vintage within the vintage start and vintage end dates is issued
+or minted to the seller. Forward contracts that are not fully delivered by the delivery
+deadline are refunded.
+
+NOTE: The ask price is the price PER credit. Dates must be in the format YYYY-MM-DD.`,
+		Example: "regen tx ecocredit create-forward-sale C01-001 100 10uregen 2024-01-01 2024-12-31 2025-06-30 false",
+		Args:    cobra.ExactArgs(7),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			askPrice, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			vintageStart, err := types.ParseDate("vintage start", args[3])
+			if err != nil {
+				return err
+			}
+
+			vintageEnd, err := types.ParseDate("vintage end", args[4])
+			if err != nil {
+				return err
+			}
+
+			deliveryDeadline, err := types.ParseDate("delivery deadline", args[5])
+			if err != nil {
+				return err
+			}
+
+			disableAutoRetire, err := strconv.ParseBool(args[6])
+			if err != nil {
+				return err
+			}
+
+			msg := marketplace.MsgCreateForwardSale{
+				Seller:            clientCtx.GetFromAddress().String(),
+				ProjectId:         args[0],
+				Quantity:          args[1],
+				AskPrice:          &askPrice,
+				VintageStart:      &vintageStart,
+				VintageEnd:        &vintageEnd,
+				DeliveryDeadline:  &deliveryDeadline,
+				DisableAutoRetire: disableAutoRetire,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxBuyForwardContractCmd returns a transaction command that buys a forward contract from a forward sale.
+func TxBuyForwardContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "buy-forward-contract [forward-sale-id] [quantity] [max-price] [disable-auto-retire] [flags]",
+		Short: "Buys a forward contract with transaction author (--from) as buyer",
+		Long: `Buys a forward contract from a forward sale with transaction author (--from) as buyer.
+
+The total cost of the contract and the taker fee are held in escrow until the credits
+are delivered or the forward sale reaches its delivery deadline, at which point any
+undelivered quantity is refunded.
+
+NOTE: The max price is the max price PER credit.`,
+		Example: `regen tx ecocredit buy-forward-contract 1 10 10uregen false --retirement-jurisdiction "US-WA 98225"
+regen tx ecocredit buy-forward-contract 1 10 10uregen true`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid forward sale id: %s", err)
+			}
+
+			maxPrice, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			disableAutoRetire, err := strconv.ParseBool(args[3])
+			if err != nil {
+				return err
+			}
+
+			retireJurisdiction, err := cmd.Flags().GetString(FlagRetirementJurisdiction)
+			if err != nil {
+				return err
+			}
+
+			msg := marketplace.MsgBuyForwardContract{
+				Buyer:                  clientCtx.GetFromAddress().String(),
+				ForwardSaleId:          id,
+				Quantity:               args[1],
+				MaxPrice:               &maxPrice,
+				DisableAutoRetire:      disableAutoRetire,
+				RetirementJurisdiction: retireJurisdiction,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagRetirementJurisdiction, "", "the jurisdiction to use for retirement when auto retire is true.")
+
+	return txFlags(cmd)
+}
+
+// TxRegisterMarketCmd returns a transaction command that registers a market for a credit type and a bank denom.
+func TxRegisterMarketCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-market [credit-type-abbrev] [bank-denom] [flags]",
+		Short: "Registers a market with transaction author (--from) as registrant",
+		Long: `Registers a market for a credit type and a bank denom with transaction author (--from) as registrant.
+
+Market registration must be enabled by governance. The bank denom must have denom metadata in the
+bank module and the registrant pays the registration deposit.`,
+		Example: `regen tx ecocredit register-market C ibc/D189335C6E4A68B513C10AB227BF1C1D38C746766278BA3EEB4FB14124F1D858`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := marketplace.MsgRegisterMarket{
+				Registrant:       clientCtx.GetFromAddress().String(),
+				CreditTypeAbbrev: args[0],
+				BankDenom:        args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
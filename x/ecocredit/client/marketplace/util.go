@@ -6,6 +6,9 @@ import (
 	"io/ioutil"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/jsonpb"
+	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/regen-network/regen-ledger/types"
 	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
 	"github.com/spf13/cobra"
@@ -79,3 +82,69 @@ func parseBuyOrders(jsonFile string) ([]*marketplace.MsgBuyDirect_Order, error)
 
 	return orders, nil
 }
+
+func parseFilteredBuyOrders(jsonFile string) ([]*marketplace.MsgBuy_Order, error) {
+	bz, err := ioutil.ReadFile(jsonFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := types.CheckDuplicateKey(json.NewDecoder(bytes.NewReader(bz)), nil); err != nil {
+		return nil, err
+	}
+
+	var rawOrders []json.RawMessage
+
+	// using json package because array is not a proto message
+	err = json.Unmarshal(bz, &rawOrders)
+	if err != nil {
+		return nil, err
+	}
+
+	// using jsonpb package for each order because the filter criteria use oneof
+	orders := make([]*marketplace.MsgBuy_Order, len(rawOrders))
+	for i, rawOrder := range rawOrders {
+		orders[i] = &marketplace.MsgBuy_Order{}
+		err = jsonpb.Unmarshal(bytes.NewReader(rawOrder), orders[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return orders, nil
+}
+
+func addBatchStartDateFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagMinBatchStartDate, "", "only include sell orders from credit batches with a start date on or after the date (format: YYYY-MM-DD)")
+	cmd.Flags().String(FlagMaxBatchStartDate, "", "only include sell orders from credit batches with a start date on or before the date (format: YYYY-MM-DD)")
+}
+
+func readBatchStartDateFlags(cmd *cobra.Command) (min, max *gogotypes.Timestamp, err error) {
+	if min, err = readDateFlag(cmd, FlagMinBatchStartDate); err != nil {
+		return nil, nil, err
+	}
+	if max, err = readDateFlag(cmd, FlagMaxBatchStartDate); err != nil {
+		return nil, nil, err
+	}
+	return min, max, nil
+}
+
+func readDateFlag(cmd *cobra.Command, flag string) (*gogotypes.Timestamp, error) {
+	dateStr, err := cmd.Flags().GetString(flag)
+	if err != nil || dateStr == "" {
+		return nil, err
+	}
+	date, err := types.ParseDate(flag, dateStr)
+	if err != nil {
+		return nil, err
+	}
+	return gogotypes.TimestampProto(date)
+}
+
+func readMaxTotalCostFlag(cmd *cobra.Command) (sdk.Coins, error) {
+	maxTotalCost, err := cmd.Flags().GetString(FlagMaxTotalCost)
+	if err != nil || maxTotalCost == "" {
+		return nil, err
+	}
+	return sdk.ParseCoinsNormalized(maxTotalCost)
+}
@@ -285,3 +285,110 @@ func TestParseBuyOrders(t *testing.T) {
 		})
 	}
 }
+
+func TestParseFilteredBuyOrders(t *testing.T) {
+	emptyJson := testutil.WriteToNewTempFile(t, `{}`).Name()
+	invalidJson := testutil.WriteToNewTempFile(t, `{foo:bar}`).Name()
+	duplicateJson := testutil.WriteToNewTempFile(t, `{"foo":"bar","foo":"baz"}`).Name()
+	validJson := testutil.WriteToNewTempFile(t, `[
+		{
+			"filter": {
+				"or": [
+					{
+						"class_selector": {
+							"class_id": "C01",
+							"project_jurisdiction": "US-WA"
+						}
+					},
+					{
+						"batch_selector": {
+							"batch_denom": "C02-001-20200101-20210101-001"
+						}
+					}
+				]
+			},
+			"quantity": "10",
+			"bid_price": {
+				"denom": "regen",
+				"amount": "10"
+			},
+			"disable_auto_retire": true,
+			"expiration": "2022-01-01T00:00:00Z"
+		}
+	]`).Name()
+
+	expiration := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name      string
+		file      string
+		expErr    bool
+		expErrMsg string
+		expRes    []*marketplace.MsgBuy_Order
+	}{
+		{
+			name:      "empty file path",
+			file:      "",
+			expErr:    true,
+			expErrMsg: "no such file or directory",
+		},
+		{
+			name:      "empty json object",
+			file:      emptyJson,
+			expErr:    true,
+			expErrMsg: "cannot unmarshal object",
+		},
+		{
+			name:      "invalid json format",
+			file:      invalidJson,
+			expErr:    true,
+			expErrMsg: "invalid character",
+		},
+		{
+			name:      "duplicate json keys",
+			file:      duplicateJson,
+			expErr:    true,
+			expErrMsg: "duplicate key",
+		},
+		{
+			name: "valid",
+			file: validJson,
+			expRes: []*marketplace.MsgBuy_Order{
+				{
+					Filter: &marketplace.BuyOrder_Filter{
+						Or: []*marketplace.BuyOrder_Filter_Criteria{
+							{Sum: &marketplace.BuyOrder_Filter_Criteria_ClassSelector{
+								ClassSelector: &marketplace.BuyOrder_ClassSelector{
+									ClassId:             "C01",
+									ProjectJurisdiction: "US-WA",
+								},
+							}},
+							{Sum: &marketplace.BuyOrder_Filter_Criteria_BatchSelector{
+								BatchSelector: &marketplace.BuyOrder_BatchSelector{
+									BatchDenom: "C02-001-20200101-20210101-001",
+								},
+							}},
+						},
+					},
+					Quantity:          "10",
+					BidPrice:          &sdk.Coin{Denom: "regen", Amount: sdk.NewInt(10)},
+					DisableAutoRetire: true,
+					Expiration:        &expiration,
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := parseFilteredBuyOrders(tc.file)
+			if tc.expErr {
+				require.Error(t, err)
+				require.ErrorContains(t, err, tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expRes, res)
+			}
+		})
+	}
+}
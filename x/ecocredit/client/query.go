@@ -1,10 +1,13 @@
 package client
 
 import (
+	"strconv"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	basketcli "github.com/regen-network/regen-ledger/x/ecocredit/client/basket"
 	marketplacecli "github.com/regen-network/regen-ledger/x/ecocredit/client/marketplace"
@@ -40,15 +43,63 @@ func QueryCmd(name string) *cobra.Command {
 		QueryProjectCmd(),
 		QueryParamsCmd(),
 		QueryCreditTypeCmd(),
+		QueryRetirementCmd(),
+		QueryRetirementsByOwnerCmd(),
+		QueryRetirementsByBatchCmd(),
+		QueryRetirementsByProjectCmd(),
+		QueryAllowanceCmd(),
+		QueryAllowancesByOwnerCmd(),
+		QueryLocksByAddressCmd(),
+		QueryClassBufferCmd(),
+		QueryReversalsByProjectCmd(),
+		QueryProjectsByStatusCmd(),
+		QueryProjectStatusHistoryCmd(),
+		QueryClassMetadataHistoryCmd(),
+		QueryProjectMetadataHistoryCmd(),
+		QueryBatchMetadataHistoryCmd(),
+		QueryBridgeOperatorsCmd(),
+		QueryBridgeAttestationsCmd(),
+		QueryOutboundBridgeRequestsByOwnerCmd(),
+		QueryOutboundBridgeRequestsByStatusCmd(),
 		basketcli.QueryBasketCmd(),
 		basketcli.QueryBasketsCmd(),
 		basketcli.QueryBasketBalanceCmd(),
 		basketcli.QueryBasketBalancesCmd(),
+		basketcli.QueryBasketAdmissibilityCmd(),
 		marketplacecli.QuerySellOrderCmd(),
 		marketplacecli.QuerySellOrdersCmd(),
 		marketplacecli.QuerySellOrdersBySellerCmd(),
+		marketplacecli.QuerySellOrdersByClassCmd(),
+		marketplacecli.QuerySellOrdersByProjectCmd(),
+		marketplacecli.QuerySellOrdersByMarketCmd(),
+		marketplacecli.QuerySellOrdersPendingExpiryCmd(),
 		marketplacecli.QuerySellOrdersByBatchCmd(),
 		marketplacecli.QueryAllowedDenomsCmd(),
+		marketplacecli.QueryBuyOrderCmd(),
+		marketplacecli.QueryBuyOrdersCmd(),
+		marketplacecli.QueryBuyOrdersByBuyerCmd(),
+		marketplacecli.QueryOfferCmd(),
+		marketplacecli.QueryOffersByBatchCmd(),
+		marketplacecli.QueryOffersByBuyerCmd(),
+		marketplacecli.QueryOffersByHolderCmd(),
+		marketplacecli.QueryDutchAuctionCmd(),
+		marketplacecli.QueryDutchAuctionsCmd(),
+		marketplacecli.QuerySealedBidAuctionCmd(),
+		marketplacecli.QuerySealedBidAuctionsCmd(),
+		marketplacecli.QuerySealedBidsCmd(),
+		marketplacecli.QueryForwardSaleCmd(),
+		marketplacecli.QueryForwardSalesByProjectCmd(),
+		marketplacecli.QueryForwardContractCmd(),
+		marketplacecli.QueryForwardContractsBySaleCmd(),
+		marketplacecli.QueryForwardContractsByBuyerCmd(),
+		marketplacecli.QueryMarketsCmd(),
+		marketplacecli.QueryMarketRegistrationCmd(),
+		marketplacecli.QueryTradesByBatchCmd(),
+		marketplacecli.QueryTradesByMarketCmd(),
+		marketplacecli.QueryTradesByAddressCmd(),
+		marketplacecli.QueryMarketStatsCmd(),
+		marketplacecli.QueryMarketFeesCmd(),
+		marketplacecli.QueryFeeDestinationCmd(),
 	)
 	return cmd
 }
@@ -560,3 +611,604 @@ func QueryCreditTypeCmd() *cobra.Command {
 		},
 	})
 }
+
+// QueryRetirementCmd returns a query command that retrieves a retirement record.
+func QueryRetirementCmd() *cobra.Command {
+	return qflags(&cobra.Command{
+		Use:     "retirement [retirement-id]",
+		Short:   "Retrieve a retirement record",
+		Long:    "Retrieve a retirement record by its unique identifier.",
+		Example: "regen q ecocredit retirement 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid retirement id: %s", err)
+			}
+			res, err := c.Retirement(cmd.Context(), &core.QueryRetirementRequest{
+				Id: id,
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	})
+}
+
+// QueryRetirementsByOwnerCmd returns a query command that retrieves all
+// retirement records of credits retired by a given account.
+func QueryRetirementsByOwnerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retirements-by-owner [owner]",
+		Short: "List all retirement records by owner",
+		Long:  "List all retirement records of credits retired by an account with optional pagination flags.",
+		Example: `regen q ecocredit retirements-by-owner regen1r9pl9gvr56kmclgkpjg3ynh4rm5am66f2a6y38
+regen q ecocredit retirements-by-owner regen1r9pl9gvr56kmclgkpjg3ynh4rm5am66f2a6y38 --limit 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.RetirementsByOwner(cmd.Context(), &core.QueryRetirementsByOwnerRequest{
+				Owner:      args[0],
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "retirements-by-owner")
+
+	return qflags(cmd)
+}
+
+// QueryRetirementsByBatchCmd returns a query command that retrieves all
+// retirement records of credits retired from a given credit batch.
+func QueryRetirementsByBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retirements-by-batch [batch-denom]",
+		Short: "List all retirement records by credit batch",
+		Long:  "List all retirement records of credits retired from a credit batch with optional pagination flags.",
+		Example: `regen q ecocredit retirements-by-batch C01-001-20200101-20210101-001
+regen q ecocredit retirements-by-batch C01-001-20200101-20210101-001 --limit 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.RetirementsByBatch(cmd.Context(), &core.QueryRetirementsByBatchRequest{
+				BatchDenom: args[0],
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "retirements-by-batch")
+
+	return qflags(cmd)
+}
+
+// QueryRetirementsByProjectCmd returns a query command that retrieves all
+// retirement records of credits retired from credit batches within a given
+// project.
+func QueryRetirementsByProjectCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retirements-by-project [project-id]",
+		Short: "List all retirement records by project",
+		Long:  "List all retirement records of credits retired from credit batches within a project with optional pagination flags.",
+		Example: `regen q ecocredit retirements-by-project C01-001
+regen q ecocredit retirements-by-project C01-001 --limit 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.RetirementsByProject(cmd.Context(), &core.QueryRetirementsByProjectRequest{
+				ProjectId:  args[0],
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "retirements-by-project")
+
+	return qflags(cmd)
+}
+
+// QueryAllowanceCmd returns a query command that retrieves the allowance an
+// owner has granted an operator.
+func QueryAllowanceCmd() *cobra.Command {
+	return qflags(&cobra.Command{
+		Use:   "allowance [owner] [operator] [batch-denom-or-class-id]",
+		Short: "Retrieve the allowance an owner has granted an operator",
+		Long:  "Retrieve the allowance an owner has granted an operator for a credit batch or a credit class.",
+		Example: `regen q ecocredit allowance regen1r9pl9gvr56kmclgkpjg3ynh4rm5am66f2a6y38 regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf C01
+regen q ecocredit allowance regen1r9pl9gvr56kmclgkpjg3ynh4rm5am66f2a6y38 regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf C01-001-20200101-20210101-001`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+			batchDenom, classId := parseAllowanceScope(args[2])
+			res, err := c.Allowance(cmd.Context(), &core.QueryAllowanceRequest{
+				Owner:      args[0],
+				Operator:   args[1],
+				BatchDenom: batchDenom,
+				ClassId:    classId,
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	})
+}
+
+// QueryAllowancesByOwnerCmd returns a query command that retrieves all
+// allowances granted by a given owner.
+func QueryAllowancesByOwnerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allowances-by-owner [owner]",
+		Short: "List all allowances granted by an owner",
+		Long:  "List all allowances granted by an owner with optional pagination flags.",
+		Example: `regen q ecocredit allowances-by-owner regen1r9pl9gvr56kmclgkpjg3ynh4rm5am66f2a6y38
+regen q ecocredit allowances-by-owner regen1r9pl9gvr56kmclgkpjg3ynh4rm5am66f2a6y38 --limit 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.AllowancesByOwner(cmd.Context(), &core.QueryAllowancesByOwnerRequest{
+				Owner:      args[0],
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "allowances-by-owner")
+
+	return qflags(cmd)
+}
+
+// QueryLocksByAddressCmd returns a query command that retrieves all credit
+// locks held by a given address.
+func QueryLocksByAddressCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "locks-by-address [address]",
+		Short: "List all credit locks held by an address",
+		Long:  "List all credit locks held by an address with optional pagination flags.",
+		Example: `regen q ecocredit locks-by-address regen1r9pl9gvr56kmclgkpjg3ynh4rm5am66f2a6y38
+regen q ecocredit locks-by-address regen1r9pl9gvr56kmclgkpjg3ynh4rm5am66f2a6y38 --limit 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.LocksByAddress(cmd.Context(), &core.QueryLocksByAddressRequest{
+				Address:    args[0],
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "locks-by-address")
+
+	return qflags(cmd)
+}
+
+// QueryClassBufferCmd returns a query command that retrieves the buffer
+// percentage and buffer pool account of a given credit class.
+func QueryClassBufferCmd() *cobra.Command {
+	return qflags(&cobra.Command{
+		Use:     "class-buffer [class-id]",
+		Short:   "Retrieve the buffer percentage and buffer pool account of a credit class",
+		Long:    "Retrieve the buffer percentage and buffer pool account of a credit class.",
+		Example: "regen q ecocredit class-buffer C01",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+			res, err := c.ClassBuffer(cmd.Context(), &core.QueryClassBufferRequest{
+				ClassId: args[0],
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	})
+}
+
+// QueryReversalsByProjectCmd returns a query command that retrieves all
+// reversal records of a given project.
+func QueryReversalsByProjectCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reversals-by-project [project-id]",
+		Short: "List all reversal records by project",
+		Long:  "List all reversal records reported against a project with optional pagination flags.",
+		Example: `regen q ecocredit reversals-by-project C01-001
+regen q ecocredit reversals-by-project C01-001 --limit 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.ReversalsByProject(cmd.Context(), &core.QueryReversalsByProjectRequest{
+				ProjectId:  args[0],
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "reversals-by-project")
+
+	return qflags(cmd)
+}
+
+// QueryProjectsByStatusCmd returns a query command that retrieves all projects
+// with a given lifecycle status.
+func QueryProjectsByStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projects-by-status [status]",
+		Short: "List all projects by status",
+		Long: `List all projects with a given lifecycle status with optional pagination flags.
+
+The status is one of proposed, accepted, active, suspended or retired.`,
+		Example: `regen q ecocredit projects-by-status suspended
+regen q ecocredit projects-by-status active --limit 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			status, err := parseProjectStatus(args[0])
+			if err != nil {
+				return err
+			}
+
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.ProjectsByStatus(cmd.Context(), &core.QueryProjectsByStatusRequest{
+				Status:     status,
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "projects-by-status")
+
+	return qflags(cmd)
+}
+
+// QueryProjectStatusHistoryCmd returns a query command that retrieves the
+// status changes of a given project.
+func QueryProjectStatusHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "project-status-history [project-id]",
+		Short: "List the status changes of a project",
+		Long:  "List the lifecycle status changes of a project with optional pagination flags.",
+		Example: `regen q ecocredit project-status-history C01-001
+regen q ecocredit project-status-history C01-001 --limit 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.ProjectStatusHistory(cmd.Context(), &core.QueryProjectStatusHistoryRequest{
+				ProjectId:  args[0],
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "project-status-history")
+
+	return qflags(cmd)
+}
+
+// QueryClassMetadataHistoryCmd returns a query command that retrieves the
+// metadata changes of a credit class.
+func QueryClassMetadataHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class-metadata-history [class-id]",
+		Short: "List the metadata changes of a credit class",
+		Long:  "List the metadata changes of a credit class with optional pagination flags.",
+		Example: `regen q ecocredit class-metadata-history C01
+regen q ecocredit class-metadata-history C01 --limit 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.ClassMetadataHistory(cmd.Context(), &core.QueryClassMetadataHistoryRequest{
+				ClassId:    args[0],
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "class-metadata-history")
+
+	return qflags(cmd)
+}
+
+// QueryProjectMetadataHistoryCmd returns a query command that retrieves the
+// metadata changes of a project.
+func QueryProjectMetadataHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "project-metadata-history [project-id]",
+		Short: "List the metadata changes of a project",
+		Long:  "List the metadata changes of a project with optional pagination flags.",
+		Example: `regen q ecocredit project-metadata-history C01-001
+regen q ecocredit project-metadata-history C01-001 --limit 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.ProjectMetadataHistory(cmd.Context(), &core.QueryProjectMetadataHistoryRequest{
+				ProjectId:  args[0],
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "project-metadata-history")
+
+	return qflags(cmd)
+}
+
+// QueryBatchMetadataHistoryCmd returns a query command that retrieves the
+// metadata changes of a credit batch.
+func QueryBatchMetadataHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-metadata-history [batch-denom]",
+		Short: "List the metadata changes of a credit batch",
+		Long:  "List the metadata changes of a credit batch with optional pagination flags.",
+		Example: `regen q ecocredit batch-metadata-history C01-001-20200101-20210101-001
+regen q ecocredit batch-metadata-history C01-001-20200101-20210101-001 --limit 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.BatchMetadataHistory(cmd.Context(), &core.QueryBatchMetadataHistoryRequest{
+				BatchDenom: args[0],
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "batch-metadata-history")
+
+	return qflags(cmd)
+}
+
+// QueryBridgeOperatorsCmd returns a query command that retrieves the bridge
+// operator sets of a given credit class.
+func QueryBridgeOperatorsCmd() *cobra.Command {
+	return qflags(&cobra.Command{
+		Use:     "bridge-operators [class-id]",
+		Short:   "Retrieve the bridge operators of a credit class",
+		Long:    "Retrieve the bridge operator sets of a credit class for each source chain or registry.",
+		Example: "regen q ecocredit bridge-operators C01",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+			res, err := c.BridgeOperators(cmd.Context(), &core.QueryBridgeOperatorsRequest{
+				ClassId: args[0],
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	})
+}
+
+// QueryBridgeAttestationsCmd returns a query command that retrieves the pending
+// bridge attestations of a given credit class.
+func QueryBridgeAttestationsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridge-attestations [class-id]",
+		Short: "List all pending bridge attestations by credit class",
+		Long:  "List all pending bridge attestations of a credit class with optional pagination flags.",
+		Example: `regen q ecocredit bridge-attestations C01
+regen q ecocredit bridge-attestations C01 --limit 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.BridgeAttestations(cmd.Context(), &core.QueryBridgeAttestationsRequest{
+				ClassId:    args[0],
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "bridge-attestations")
+
+	return qflags(cmd)
+}
+
+// QueryOutboundBridgeRequestsByOwnerCmd returns a query command that retrieves
+// all outbound bridge requests of a given owner.
+func QueryOutboundBridgeRequestsByOwnerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "outbound-bridge-requests-by-owner [owner]",
+		Short: "List all outbound bridge requests by owner",
+		Long:  "List all outbound bridge requests of an owner with optional pagination flags.",
+		Example: `regen q ecocredit outbound-bridge-requests-by-owner regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6
+regen q ecocredit outbound-bridge-requests-by-owner regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6 --limit 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.OutboundBridgeRequestsByOwner(cmd.Context(), &core.QueryOutboundBridgeRequestsByOwnerRequest{
+				Owner:      args[0],
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "outbound-bridge-requests-by-owner")
+
+	return qflags(cmd)
+}
+
+// QueryOutboundBridgeRequestsByStatusCmd returns a query command that retrieves
+// all outbound bridge requests with a given status.
+func QueryOutboundBridgeRequestsByStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "outbound-bridge-requests-by-status [status]",
+		Short: "List all outbound bridge requests by status",
+		Long: `List all outbound bridge requests with a given status with optional pagination flags.
+
+The status is one of pending, completed or failed.`,
+		Example: `regen q ecocredit outbound-bridge-requests-by-status pending
+regen q ecocredit outbound-bridge-requests-by-status failed --limit 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			status, err := parseOutboundBridgeStatus(args[0])
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.OutboundBridgeRequestsByStatus(cmd.Context(), &core.QueryOutboundBridgeRequestsByStatusRequest{
+				Status:     status,
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "outbound-bridge-requests-by-status")
+
+	return qflags(cmd)
+}
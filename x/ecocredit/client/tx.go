@@ -25,6 +25,16 @@ const (
 	FlagRemoveIssuers          string = "remove-issuers"
 	FlagReferenceId            string = "reference-id"
 	FlagRetirementJurisdiction string = "retirement-jurisdiction"
+	FlagReason                 string = "reason"
+	FlagBeneficiaryAddress     string = "beneficiary-address"
+	FlagBeneficiaryName        string = "beneficiary-name"
+	FlagExpiration             string = "expiration"
+	FlagUnlockEnd              string = "unlock-end"
+	FlagOperators              string = "operators"
+	FlagThreshold              string = "threshold"
+	FlagTimeout                string = "timeout"
+	FlagSource                 string = "source"
+	FlagOutboundTimeout        string = "outbound-timeout"
 )
 
 // TxCmd returns a root CLI command handler for all x/ecocredit transaction commands.
@@ -51,14 +61,49 @@ func TxCmd(name string) *cobra.Command {
 		TxUpdateClassAdminCmd(),
 		TxUpdateProjectAdminCmd(),
 		TxUpdateProjectMetadataCmd(),
+		TxUpdateBatchMetadataCmd(),
+		TxApproveCmd(),
+		TxRevokeApprovalCmd(),
+		TxSendFromCmd(),
+		TxRetireFromCmd(),
+		TxSendLockedCmd(),
+		TxUpdateClassBufferCmd(),
+		TxReportReversalCmd(),
+		TxFreezeBatchCmd(),
+		TxUnfreezeBatchCmd(),
+		TxUpdateProjectStatusCmd(),
+		TxUpdateBridgeOperatorsCmd(),
+		TxAttestBridgeReceiveCmd(),
+		TxBridgeAckCmd(),
 		basketcli.TxCreateBasketCmd(),
 		basketcli.TxPutInBasketCmd(),
 		basketcli.TxTakeFromBasketCmd(),
+		basketcli.TxUpdateBasketCuratorCmd(),
+		basketcli.TxAddBasketClassesCmd(),
+		basketcli.TxRemoveBasketClassesCmd(),
+		basketcli.TxUpdateBasketDateCriteriaCmd(),
+		basketcli.TxUpdateBasketDescriptionCmd(),
+		basketcli.TxUpdateBasketTakePremiumCmd(),
+		basketcli.TxUpdateBasketAdmissionCriteriaCmd(),
 		marketplacecli.TxSellCmd(),
 		marketplacecli.TxUpdateSellOrdersCmd(),
 		marketplacecli.TxBuyDirectCmd(),
 		marketplacecli.TxBuyDirectBulkCmd(),
 		marketplacecli.TxCancelSellOrderCmd(),
+		marketplacecli.TxBuyCmd(),
+		marketplacecli.TxCancelBuyOrderCmd(),
+		marketplacecli.TxMakeOfferCmd(),
+		marketplacecli.TxAcceptOfferCmd(),
+		marketplacecli.TxCancelOfferCmd(),
+		marketplacecli.TxCreateDutchAuctionCmd(),
+		marketplacecli.TxBuyDutchAuctionCmd(),
+		marketplacecli.TxCancelDutchAuctionCmd(),
+		marketplacecli.TxCreateSealedBidAuctionCmd(),
+		marketplacecli.TxCommitSealedBidCmd(),
+		marketplacecli.TxRevealSealedBidCmd(),
+		marketplacecli.TxCreateForwardSaleCmd(),
+		marketplacecli.TxBuyForwardContractCmd(),
+		marketplacecli.TxRegisterMarketCmd(),
 	)
 	return cmd
 }
@@ -417,6 +462,8 @@ Parameters:
 - retirement-jurisdiction:  the jurisdiction in which the credit will be retired`,
 		Example: `
 regen tx ecocredit retire credits.json "US-WA 98225"
+regen tx ecocredit retire credits.json "US-WA 98225" --reason "offsetting emissions from business travel"
+regen tx ecocredit retire credits.json "US-WA 98225" --beneficiary-address regen1r9pl9gvr56kmclgkpjg3ynh4rm5am66f2a6y38 --beneficiary-name "Acme Corporation"
 
 Example JSON:
 [
@@ -442,16 +489,38 @@ Example JSON:
 				return sdkerrors.ErrInvalidRequest.Wrapf("failed to parse json: %s", err)
 			}
 
+			reason, err := cmd.Flags().GetString(FlagReason)
+			if err != nil {
+				return err
+			}
+
+			beneficiaryAddress, err := cmd.Flags().GetString(FlagBeneficiaryAddress)
+			if err != nil {
+				return err
+			}
+
+			beneficiaryName, err := cmd.Flags().GetString(FlagBeneficiaryName)
+			if err != nil {
+				return err
+			}
+
 			msg := core.MsgRetire{
-				Owner:        clientCtx.GetFromAddress().String(),
-				Credits:      credits,
-				Jurisdiction: args[1],
+				Owner:              clientCtx.GetFromAddress().String(),
+				Credits:            credits,
+				Jurisdiction:       args[1],
+				Reason:             reason,
+				BeneficiaryAddress: beneficiaryAddress,
+				BeneficiaryName:    beneficiaryName,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
 
+	cmd.Flags().String(FlagReason, "", "the reason for retiring the credits")
+	cmd.Flags().String(FlagBeneficiaryAddress, "", "the address of the account on whose behalf the credits are retired")
+	cmd.Flags().String(FlagBeneficiaryName, "", "the name of the individual or organization on whose behalf the credits are retired")
+
 	return txFlags(cmd)
 }
 
@@ -695,3 +764,742 @@ func TxUpdateProjectMetadataCmd() *cobra.Command {
 
 	return txFlags(cmd)
 }
+
+// TxUpdateBatchMetadataCmd returns a transaction command that updates the
+// metadata of an open credit batch.
+func TxUpdateBatchMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-batch-metadata [batch-denom] [new-metadata]",
+		Short:   "Update the credit batch metadata",
+		Long:    "Update the metadata of an open credit batch, overwriting the credit batch's current metadata. Only the issuer of the credit batch can update the metadata.",
+		Example: "regen tx ecocredit update-batch-metadata C01-001-20200101-20210101-001 regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGxGREWGKjEdXYG.rdf",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := core.MsgUpdateBatchMetadata{
+				Issuer:      clientCtx.GetFromAddress().String(),
+				BatchDenom:  args[0],
+				NewMetadata: args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxApproveCmd returns a transaction command that approves an operator to send or
+// retire credits on behalf of the transaction author.
+func TxApproveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve [operator] [batch-denom-or-class-id] [amount] [flags]",
+		Short: "Approves an operator to send or retire credits on behalf of the transaction author (--from)",
+		Long: `Approves an operator to send or retire credits on behalf of the transaction author (--from).
+
+The allowance is scoped to either a single credit batch or all credit batches within a credit class.
+An existing allowance with the same scope is replaced.
+
+Parameters:
+
+- operator:                 the address of the account being approved
+- batch-denom-or-class-id:  the denomination of the credit batch or the ID of the credit class
+- amount:                   the amount of credits the operator is approved to spend
+
+Optional Flags:
+
+- expiration:  the date after which the allowance can no longer be spent (yyyy-mm-dd)`,
+		Example: `regen tx ecocredit approve regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf C01-001-20200101-20210101-001 100 --from myKey
+regen tx ecocredit approve regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf C01 100 --expiration 2030-01-01 --from myKey`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			batchDenom, classId := parseAllowanceScope(args[1])
+
+			msg := core.MsgApprove{
+				Owner:      clientCtx.GetFromAddress().String(),
+				Operator:   args[0],
+				BatchDenom: batchDenom,
+				ClassId:    classId,
+				Amount:     args[2],
+			}
+
+			expiration, err := cmd.Flags().GetString(FlagExpiration)
+			if err != nil {
+				return err
+			}
+			if expiration != "" {
+				t, err := types.ParseDate("expiration", expiration)
+				if err != nil {
+					return err
+				}
+				msg.Expiration = &t
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagExpiration, "", "the date after which the allowance can no longer be spent (yyyy-mm-dd)")
+
+	return txFlags(cmd)
+}
+
+// TxRevokeApprovalCmd returns a transaction command that revokes an allowance
+// previously granted to an operator.
+func TxRevokeApprovalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-approval [operator] [batch-denom-or-class-id]",
+		Short: "Revokes an allowance granted to an operator by the transaction author (--from)",
+		Long: `Revokes an allowance granted to an operator by the transaction author (--from).
+
+Parameters:
+
+- operator:                 the address of the account whose allowance is revoked
+- batch-denom-or-class-id:  the denomination of the credit batch or the ID of the credit class`,
+		Example: "regen tx ecocredit revoke-approval regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf C01 --from myKey",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			batchDenom, classId := parseAllowanceScope(args[1])
+
+			msg := core.MsgRevokeApproval{
+				Owner:      clientCtx.GetFromAddress().String(),
+				Operator:   args[0],
+				BatchDenom: batchDenom,
+				ClassId:    classId,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxSendFromCmd returns a transaction command that sends credits on behalf of an owner.
+func TxSendFromCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-from [owner] [recipient] [credits-json]",
+		Short: "Sends credits from the owner to the recipient on behalf of the owner",
+		Long: `Sends credits from the owner to the recipient on behalf of the owner.
+
+The transaction author (--from) must have been approved by the owner to spend the credits.
+
+Parameters:
+
+- owner:         the address of the account that owns the credits
+- recipient:     the recipient account address
+- credits-json:  path to JSON file containing credits to send`,
+		Example: `regen tx ecocredit send-from regen1r9pl9gvr56kmclgkpjg3ynh4rm5am66f2a6y38 regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf credits.json --from myKey
+
+Example JSON:
+
+[
+  {
+    "batch_denom": "C01-001-20200101-20210101-001",
+    "tradable_amount": "500"
+  }
+]`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Parse the JSON file representing the credits
+			credits, err := parseSendCredits(args[2])
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("failed to parse json: %s", err)
+			}
+
+			msg := core.MsgSendFrom{
+				Operator:  clientCtx.GetFromAddress().String(),
+				Owner:     args[0],
+				Recipient: args[1],
+				Credits:   credits,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxRetireFromCmd returns a transaction command that retires credits on behalf of an owner.
+func TxRetireFromCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retire-from [owner] [credits-json] [retirement-jurisdiction]",
+		Short: "Retires credits from the account of the owner on behalf of the owner",
+		Long: `Retires credits from the account of the owner on behalf of the owner.
+
+The transaction author (--from) must have been approved by the owner to spend the credits.
+
+Parameters:
+
+- owner:                    the address of the account that owns the credits
+- credits-json:             path to JSON file containing credits to retire
+- retirement-jurisdiction:  the jurisdiction in which the credit will be retired`,
+		Example: `regen tx ecocredit retire-from regen1r9pl9gvr56kmclgkpjg3ynh4rm5am66f2a6y38 credits.json "US-WA 98225" --from myKey
+
+Example JSON:
+
+[
+  {
+    "batch_denom": "C01-001-20200101-20210101-001",
+    "amount": "5"
+  }
+]`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Parse the JSON file representing the credits
+			credits, err := parseCredits(args[1])
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("failed to parse json: %s", err)
+			}
+
+			reason, err := cmd.Flags().GetString(FlagReason)
+			if err != nil {
+				return err
+			}
+
+			msg := core.MsgRetireFrom{
+				Operator:     clientCtx.GetFromAddress().String(),
+				Owner:        args[0],
+				Credits:      credits,
+				Jurisdiction: args[2],
+				Reason:       reason,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagReason, "", "the reason for retiring the credits")
+
+	return txFlags(cmd)
+}
+
+// TxSendLockedCmd returns a transaction command that sends tradable credits to a
+// recipient and locks them in the recipient's account until they are released.
+func TxSendLockedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-locked [recipient] [batch-denom] [amount] [unlock-start] [flags]",
+		Short: "Sends tradable credits from the transaction author (--from) to the recipient and locks them",
+		Long: `Sends tradable credits from the transaction author (--from) to the recipient and locks them.
+
+Locked credits are held in the recipient's locked balance and cannot be sent, retired, or
+cancelled until they are released into the recipient's tradable balance. If no unlock end
+date is provided, all credits are released at the unlock start date. Otherwise, credits are
+released linearly between the unlock start date and the unlock end date.
+
+Parameters:
+
+- recipient:     the address of the account receiving the locked credits
+- batch-denom:   the denomination of the credit batch
+- amount:        the amount of tradable credits to send and lock
+- unlock-start:  the date at which the credits begin to unlock (yyyy-mm-dd)
+
+Optional Flags:
+
+- unlock-end:  the date at which all credits are released (yyyy-mm-dd)`,
+		Example: `regen tx ecocredit send-locked regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf C01-001-20200101-20210101-001 100 2030-01-01 --from myKey
+regen tx ecocredit send-locked regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf C01-001-20200101-20210101-001 100 2030-01-01 --unlock-end 2032-01-01 --from myKey`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			startTime, err := types.ParseDate("unlock start", args[3])
+			if err != nil {
+				return err
+			}
+
+			schedule := &core.LockSchedule{StartTime: &startTime}
+
+			unlockEnd, err := cmd.Flags().GetString(FlagUnlockEnd)
+			if err != nil {
+				return err
+			}
+			if unlockEnd != "" {
+				endTime, err := types.ParseDate("unlock end", unlockEnd)
+				if err != nil {
+					return err
+				}
+				schedule.EndTime = &endTime
+			}
+
+			msg := core.MsgSendLocked{
+				Sender:       clientCtx.GetFromAddress().String(),
+				Recipient:    args[0],
+				BatchDenom:   args[1],
+				Amount:       args[2],
+				LockSchedule: schedule,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagUnlockEnd, "", "the date at which all credits are released (yyyy-mm-dd)")
+
+	return txFlags(cmd)
+}
+
+// TxUpdateClassBufferCmd returns a transaction command that updates the buffer
+// percentage of a credit class.
+func TxUpdateClassBufferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-class-buffer [class-id] [percentage]",
+		Short: "Updates the buffer percentage of a credit class",
+		Long: `Updates the buffer percentage of a credit class.
+
+The buffer percentage is the share of the tradable credits of each issuance within the credit
+class that is withheld into the buffer pool of the credit class. A percentage of zero removes
+the buffer percentage. Only the admin of the credit class (--from) can update the buffer
+percentage.
+
+Parameters:
+
+- class-id:    the ID of the credit class
+- percentage:  the decimal percentage (between 0 and 100) withheld from each issuance`,
+		Example: `regen tx ecocredit update-class-buffer C01 10 --from myKey`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := core.MsgUpdateClassBuffer{
+				Admin:      clientCtx.GetFromAddress().String(),
+				ClassId:    args[0],
+				Percentage: args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxReportReversalCmd returns a transaction command that reports a reversal
+// against a project and cancels buffer pool credits.
+func TxReportReversalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report-reversal [project-id] [credits-json] [evidence]",
+		Short: "Reports a reversal against a project and cancels credits from the buffer pool",
+		Long: `Reports a reversal against a project and cancels credits from the buffer pool.
+
+The credits are cancelled from the buffer pool of the credit class of the project and can be
+from any credit batch within the credit class. Only the admin of the credit class (--from) can
+report a reversal.
+
+Parameters:
+
+- project-id:    the ID of the project against which the reversal is reported
+- credits-json:  path to JSON file containing buffer pool credits to cancel
+- evidence:      the IRI of the evidence supporting the reversal`,
+		Example: `regen tx ecocredit report-reversal C01-001 credits.json regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf --from myKey
+
+Example JSON:
+
+[
+  {
+    "batch_denom": "C01-001-20200101-20210101-001",
+    "amount": "5"
+  }
+]`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Parse the JSON file representing the credits
+			credits, err := parseCredits(args[1])
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("failed to parse json: %s", err)
+			}
+
+			msg := core.MsgReportReversal{
+				Admin:     clientCtx.GetFromAddress().String(),
+				ProjectId: args[0],
+				Credits:   credits,
+				Evidence:  args[2],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxFreezeBatchCmd returns a transaction command that freezes a credit batch.
+func TxFreezeBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze-batch [batch-denom] [evidence]",
+		Short: "Freezes a credit batch",
+		Long: `Freezes a credit batch.
+
+Credits from a frozen credit batch cannot be sent, retired, sold, or deposited into a basket,
+and all sell orders of the credit batch are cancelled. Only the admin of the credit class of
+the credit batch (--from) can freeze the credit batch.
+
+Parameters:
+
+- batch-denom:  the denom of the credit batch
+- evidence:     the IRI of the evidence supporting the freeze
+
+Flags:
+
+- reason:  the reason for freezing the credit batch`,
+		Example: `regen tx ecocredit freeze-batch C01-001-20200101-20210101-001 regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf --reason "double counting" --from myKey`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			reason, err := cmd.Flags().GetString(FlagReason)
+			if err != nil {
+				return err
+			}
+
+			msg := core.MsgFreezeBatch{
+				Admin:      clientCtx.GetFromAddress().String(),
+				BatchDenom: args[0],
+				Evidence:   args[1],
+				Reason:     reason,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagReason, "", "the reason for freezing the credit batch")
+
+	return txFlags(cmd)
+}
+
+// TxUnfreezeBatchCmd returns a transaction command that unfreezes a credit batch.
+func TxUnfreezeBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze-batch [batch-denom] [evidence]",
+		Short: "Unfreezes a credit batch",
+		Long: `Unfreezes a credit batch.
+
+Only the admin of the credit class of the credit batch (--from) can unfreeze the credit batch.
+Sell orders cancelled when the credit batch was frozen are not restored.
+
+Parameters:
+
+- batch-denom:  the denom of the credit batch
+- evidence:     the IRI of the evidence supporting the unfreeze
+
+Flags:
+
+- reason:  the reason for unfreezing the credit batch`,
+		Example: `regen tx ecocredit unfreeze-batch C01-001-20200101-20210101-001 regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf --reason "resolved" --from myKey`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			reason, err := cmd.Flags().GetString(FlagReason)
+			if err != nil {
+				return err
+			}
+
+			msg := core.MsgUnfreezeBatch{
+				Admin:      clientCtx.GetFromAddress().String(),
+				BatchDenom: args[0],
+				Evidence:   args[1],
+				Reason:     reason,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagReason, "", "the reason for unfreezing the credit batch")
+
+	return txFlags(cmd)
+}
+
+// TxUpdateProjectStatusCmd returns a transaction command that updates the
+// lifecycle status of a project.
+func TxUpdateProjectStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-project-status [project-id] [status] [reason]",
+		Short: "Updates the status of a project",
+		Long: `Updates the lifecycle status of a project.
+
+Credits can only be issued to a project with an active status. A retired project cannot
+change status. Only the admin of the credit class of the project (--from) can update the
+status of the project.
+
+Parameters:
+
+- project-id:  the ID of the project
+- status:      the new status (proposed, accepted, active, suspended or retired)
+- reason:      the IRI of the document describing the reason for the status change`,
+		Example: `regen tx ecocredit update-project-status C01-001 suspended regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf --from myKey`,
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			status, err := parseProjectStatus(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := core.MsgUpdateProjectStatus{
+				Admin:     clientCtx.GetFromAddress().String(),
+				ProjectId: args[0],
+				Status:    status,
+				Reason:    args[2],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxUpdateBridgeOperatorsCmd returns a transaction command that sets or removes
+// the bridge operator set of a credit class.
+func TxUpdateBridgeOperatorsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-bridge-operators [class-id]",
+		Short: "Sets or removes the bridge operators of a credit class",
+		Long: `Sets or removes the bridge operators of a credit class.
+
+When a credit class has bridge operators, credits bridged from another chain or registry
+are only issued once the threshold of bridge operators have attested to the same origin
+transaction within the timeout. Omitting the operators removes the bridge operators. Only
+the admin of the credit class (--from) can update the bridge operators.
+
+Parameters:
+
+- class-id:  the ID of the credit class
+
+Flags:
+
+- operators:         comma separated (no spaces) list of bridge operator addresses
+- threshold:         the number of bridge operators that must attest to an origin transaction
+- timeout:           the duration after the first attestation within which the threshold must be reached
+- source:            the source chain or registry of the bridge operators (empty applies to any source)
+- outbound-timeout:  the optional duration after credits are bridged back within which a bridge operator
+                     must acknowledge the transfer before the credits are refunded`,
+		Example: `regen tx ecocredit update-bridge-operators C01 --operators addr1,addr2,addr3 --threshold 2 --timeout 24h --source polygon --from myKey
+regen tx ecocredit update-bridge-operators C01 --operators addr1,addr2,addr3 --threshold 2 --timeout 24h --outbound-timeout 168h --source polygon --from myKey
+regen tx ecocredit update-bridge-operators C01 --source polygon --from myKey`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			operators, err := cmd.Flags().GetStringSlice(FlagOperators)
+			if err != nil {
+				return err
+			}
+			for i := range operators {
+				operators[i] = strings.TrimSpace(operators[i])
+			}
+
+			threshold, err := cmd.Flags().GetUint32(FlagThreshold)
+			if err != nil {
+				return err
+			}
+
+			timeout, err := cmd.Flags().GetDuration(FlagTimeout)
+			if err != nil {
+				return err
+			}
+
+			source, err := cmd.Flags().GetString(FlagSource)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := core.MsgUpdateBridgeOperators{
+				Admin:     clientCtx.GetFromAddress().String(),
+				ClassId:   args[0],
+				Source:    source,
+				Operators: operators,
+				Threshold: threshold,
+				Timeout:   &timeout,
+			}
+
+			if cmd.Flags().Changed(FlagOutboundTimeout) {
+				outboundTimeout, err := cmd.Flags().GetDuration(FlagOutboundTimeout)
+				if err != nil {
+					return err
+				}
+				msg.OutboundTimeout = &outboundTimeout
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagOperators, []string{}, "comma separated (no spaces) list of addresses")
+	cmd.Flags().Uint32(FlagThreshold, 0, "the number of bridge operators that must attest to an origin transaction")
+	cmd.Flags().Duration(FlagTimeout, 0, "the duration within which the threshold must be reached (e.g. 24h)")
+	cmd.Flags().String(FlagSource, "", "the source chain or registry of the bridge operators")
+	cmd.Flags().Duration(FlagOutboundTimeout, 0, "the duration within which outbound bridge requests must be acknowledged (e.g. 168h)")
+
+	return txFlags(cmd)
+}
+
+// TxAttestBridgeReceiveCmd returns a transaction command that attests to credits
+// being bridged from another chain or registry.
+func TxAttestBridgeReceiveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attest-bridge-receive [attestation-json]",
+		Short: "Attests to credits bridged from another chain or registry",
+		Long: `Attests to credits bridged from another chain or registry.
+
+The credits are bridged once the threshold of bridge operators of the credit class have
+attested to the same information for the origin transaction. The bridge operator is the
+account signing the transaction (--from).
+
+Parameters:
+
+- attestation-json:  path to JSON file containing the bridged credit information`,
+		Example: `regen tx ecocredit attest-bridge-receive attestation.json --from myKey
+
+Example JSON:
+
+{
+  "issuer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+  "class_id": "C01",
+  "project": {
+    "reference_id": "VCS-001",
+    "jurisdiction": "US-WA",
+    "metadata": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGxGREWGKjEdXYG.rdf"
+  },
+  "batch": {
+    "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+    "amount": "1000",
+    "start_date": "2020-01-01T00:00:00Z",
+    "end_date": "2021-01-01T00:00:00Z",
+    "metadata": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGxGREWGKjEdXYG.rdf"
+  },
+  "origin_tx": {
+    "id": "0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e",
+    "source": "polygon",
+    "contract": "0x0e65079a29d7793ab5ca500c2d88e60ee99ba606"
+  }
+}`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Parse the JSON file representing the request
+			msg, err := parseMsgAttestBridgeReceive(clientCtx, args[0])
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("failed to parse json: %s", err)
+			}
+
+			// Get the bridge operator from the --from flag
+			msg.Operator = clientCtx.GetFromAddress().String()
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxBridgeAckCmd returns a transaction command that acknowledges an outbound
+// bridge request as either completed or failed.
+func TxBridgeAckCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridge-ack [request-id] [status] [target-tx-hash]",
+		Short: "Acknowledges an outbound bridge request as completed or failed",
+		Long: `Acknowledges an outbound bridge request as completed or failed.
+
+When the request is completed, the hash of the transaction on the target chain must be provided.
+When the request has failed, the credits are refunded to the owner once the threshold of bridge
+operators of the credit class has acknowledged the request as failed. Only a bridge operator of the
+credit class (--from) can acknowledge the request as failed, and only a bridge operator, or a credit
+class issuer if the credit class has no bridge operators, can acknowledge the request as completed.
+
+Parameters:
+
+- request-id:      the ID of the outbound bridge request
+- status:          the outcome of the request (completed or failed)
+- target-tx-hash:  the hash of the transaction on the target chain (required when completed)`,
+		Example: `regen tx ecocredit bridge-ack 1 completed 0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e --from myKey
+regen tx ecocredit bridge-ack 1 failed --from myKey`,
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			requestId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid request id: %s", err)
+			}
+
+			status, err := parseOutboundBridgeStatus(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := core.MsgBridgeAck{
+				Operator:  clientCtx.GetFromAddress().String(),
+				RequestId: requestId,
+				Status:    status,
+			}
+
+			if len(args) == 3 {
+				msg.TargetTxHash = args[2]
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
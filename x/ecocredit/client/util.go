@@ -3,7 +3,9 @@ package client
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
+	"strings"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/spf13/cobra"
@@ -15,6 +17,11 @@ import (
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
 )
 
+const (
+	projectStatusPrefix        = "PROJECT_STATUS_"
+	outboundBridgeStatusPrefix = "OUTBOUND_BRIDGE_STATUS_"
+)
+
 func txFlags(cmd *cobra.Command) *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.MarkFlagRequired(flags.FlagFrom)
@@ -60,6 +67,25 @@ func parseMsgCreateBatch(clientCtx sdkclient.Context, jsonFile string) (*core.Ms
 	return &msg, nil
 }
 
+func parseMsgAttestBridgeReceive(clientCtx sdkclient.Context, jsonFile string) (*core.MsgAttestBridgeReceive, error) {
+	bz, err := ioutil.ReadFile(jsonFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := types.CheckDuplicateKey(json.NewDecoder(bytes.NewReader(bz)), nil); err != nil {
+		return nil, err
+	}
+
+	var msg core.MsgAttestBridgeReceive
+	err = clientCtx.Codec.UnmarshalJSON(bz, &msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &msg, nil
+}
+
 func parseCredits(jsonFile string) ([]*core.Credits, error) {
 	bz, err := ioutil.ReadFile(jsonFile)
 	if err != nil {
@@ -101,3 +127,45 @@ func parseSendCredits(jsonFile string) ([]*core.MsgSend_SendCredits, error) {
 
 	return sendCredits, nil
 }
+
+// parseAllowanceScope returns the scope argument of an allowance as a batch
+// denom if it is formatted as one, otherwise as a class id.
+func parseAllowanceScope(scope string) (batchDenom, classId string) {
+	if err := core.ValidateBatchDenom(scope); err == nil {
+		return scope, ""
+	}
+	return "", scope
+}
+
+// parseProjectStatus parses a project status from either its full name (e.g.
+// PROJECT_STATUS_ACTIVE) or its short name in any case (e.g. active).
+func parseProjectStatus(status string) (core.ProjectStatus, error) {
+	name := strings.ToUpper(status)
+	if !strings.HasPrefix(name, projectStatusPrefix) {
+		name = projectStatusPrefix + name
+	}
+
+	value, ok := core.ProjectStatus_value[name]
+	if !ok {
+		return 0, fmt.Errorf("invalid project status: %s", status)
+	}
+
+	return core.ProjectStatus(value), nil
+}
+
+// parseOutboundBridgeStatus parses an outbound bridge status from either its
+// full name (e.g. OUTBOUND_BRIDGE_STATUS_COMPLETED) or its short name in any
+// case (e.g. completed).
+func parseOutboundBridgeStatus(status string) (core.OutboundBridgeStatus, error) {
+	name := strings.ToUpper(status)
+	if !strings.HasPrefix(name, outboundBridgeStatusPrefix) {
+		name = outboundBridgeStatusPrefix + name
+	}
+
+	value, ok := core.OutboundBridgeStatus_value[name]
+	if !ok {
+		return 0, fmt.Errorf("invalid outbound bridge status: %s", status)
+	}
+
+	return core.OutboundBridgeStatus(value), nil
+}
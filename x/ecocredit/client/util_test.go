@@ -250,3 +250,39 @@ func TestParseCredits(t *testing.T) {
 		})
 	}
 }
+
+func TestParseAllowanceScope(t *testing.T) {
+	batchDenom, classId := parseAllowanceScope("C01-001-20210101-20210101-001")
+	require.Equal(t, "C01-001-20210101-20210101-001", batchDenom)
+	require.Equal(t, "", classId)
+
+	batchDenom, classId = parseAllowanceScope("C01")
+	require.Equal(t, "", batchDenom)
+	require.Equal(t, "C01", classId)
+}
+
+func TestParseProjectStatus(t *testing.T) {
+	status, err := parseProjectStatus("PROJECT_STATUS_SUSPENDED")
+	require.NoError(t, err)
+	require.Equal(t, core.ProjectStatus_PROJECT_STATUS_SUSPENDED, status)
+
+	status, err = parseProjectStatus("active")
+	require.NoError(t, err)
+	require.Equal(t, core.ProjectStatus_PROJECT_STATUS_ACTIVE, status)
+
+	_, err = parseProjectStatus("foo")
+	require.EqualError(t, err, "invalid project status: foo")
+}
+
+func TestParseOutboundBridgeStatus(t *testing.T) {
+	status, err := parseOutboundBridgeStatus("OUTBOUND_BRIDGE_STATUS_COMPLETED")
+	require.NoError(t, err)
+	require.Equal(t, core.OutboundBridgeStatus_OUTBOUND_BRIDGE_STATUS_COMPLETED, status)
+
+	status, err = parseOutboundBridgeStatus("failed")
+	require.NoError(t, err)
+	require.Equal(t, core.OutboundBridgeStatus_OUTBOUND_BRIDGE_STATUS_FAILED, status)
+
+	_, err = parseOutboundBridgeStatus("foo")
+	require.EqualError(t, err, "invalid outbound bridge status: foo")
+}
@@ -9,6 +9,8 @@ import (
 
 func RegisterTypes(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*govtypes.Content)(nil), &CreditTypeProposal{})
+	registry.RegisterImplementations((*govtypes.Content)(nil), &BatchFreezeProposal{})
+	registry.RegisterImplementations((*govtypes.Content)(nil), &CreditTypePrecisionProposal{})
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
@@ -28,6 +30,20 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateProjectMetadata{}, "regen.core/MsgUpdateProjectMetadata", nil)
 	cdc.RegisterConcrete(&CreditTypeProposal{}, "regen.core/CreditTypeProposal", nil)
 	cdc.RegisterConcrete(&MsgBridgeReceive{}, "regen.core/MsgBridgeReceive", nil)
+	cdc.RegisterConcrete(&MsgApprove{}, "regen.core/MsgApprove", nil)
+	cdc.RegisterConcrete(&MsgRevokeApproval{}, "regen.core/MsgRevokeApproval", nil)
+	cdc.RegisterConcrete(&MsgSendFrom{}, "regen.core/MsgSendFrom", nil)
+	cdc.RegisterConcrete(&MsgRetireFrom{}, "regen.core/MsgRetireFrom", nil)
+	cdc.RegisterConcrete(&MsgSendLocked{}, "regen.core/MsgSendLocked", nil)
+	cdc.RegisterConcrete(&MsgUpdateClassBuffer{}, "regen.core/MsgUpdateClassBuffer", nil)
+	cdc.RegisterConcrete(&MsgReportReversal{}, "regen.core/MsgReportReversal", nil)
+	cdc.RegisterConcrete(&MsgFreezeBatch{}, "regen.core/MsgFreezeBatch", nil)
+	cdc.RegisterConcrete(&MsgUnfreezeBatch{}, "regen.core/MsgUnfreezeBatch", nil)
+	cdc.RegisterConcrete(&MsgUpdateProjectStatus{}, "regen.core/MsgUpdateProjectStatus", nil)
+	cdc.RegisterConcrete(&MsgUpdateBatchMetadata{}, "regen.core/MsgUpdateBatchMetadata", nil)
+	cdc.RegisterConcrete(&MsgUpdateBridgeOperators{}, "regen.core/MsgUpdateBridgeOperators", nil)
+	cdc.RegisterConcrete(&MsgAttestBridgeReceive{}, "regen.core/MsgAttestBridgeReceive", nil)
+	cdc.RegisterConcrete(&MsgBridgeAck{}, "regen.core/MsgBridgeAck", nil)
 }
 
 var (
@@ -19,7 +19,7 @@ func DefaultCreditTypes() []CreditType {
 			Name:         "carbon",
 			Abbreviation: "C",
 			Unit:         "metric ton CO2 equivalent",
-			Precision:    PRECISION,
+			Precision:    DefaultCreditTypePrecision,
 		},
 	}
 }
@@ -0,0 +1,106 @@
+Feature: MsgApprove
+
+  Scenario: a valid message with a batch denom
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "operator": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "amount": "100"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with a class id and expiration
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "operator": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "class_id": "C01",
+      "amount": "100.5",
+      "expiration": "2030-01-01T00:00:00Z"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if owner is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "owner: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if operator is empty
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "operator: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if owner and operator are the same
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "operator": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "owner and operator cannot be the same account: invalid request"
+
+  Scenario: an error is returned if batch denom and class id are empty
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "operator": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+    }
+    """
+    When the message is validated
+    Then expect the error "batch denom or class id required: invalid request"
+
+  Scenario: an error is returned if batch denom and class id are both set
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "operator": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "class_id": "C01"
+    }
+    """
+    When the message is validated
+    Then expect the error "only one of batch denom or class id can be set: invalid request"
+
+  Scenario: an error is returned if class id is not formatted
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "operator": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "class_id": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "class ID didn't match the format: expected A00, got foo: parse error: invalid request"
+
+  Scenario: an error is returned if amount is not positive
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "operator": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "class_id": "C01",
+      "amount": "0"
+    }
+    """
+    When the message is validated
+    Then expect the error "amount: expected a positive decimal, got 0: invalid decimal string: invalid request"
@@ -0,0 +1,150 @@
+Feature: MsgAttestBridgeReceive
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "operator": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "project": {
+        "reference_id": "VCS-001",
+        "jurisdiction": "US-WA",
+        "metadata": "regen:13toVgf5aZqSVSeJQv562xkkeoe3rr3bJWa29PHVKVf77VAkVMcDvVd.rdf"
+      },
+      "batch": {
+        "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+        "amount": "100",
+        "start_date": "2020-01-01T00:00:00Z",
+        "end_date": "2021-01-01T00:00:00Z",
+        "metadata": "regen:13toVgf5aZqSVSeJQv562xkkeoe3rr3bJWa29PHVKVf77VAkVMcDvVd.rdf"
+      },
+      "origin_tx": {
+        "id": "0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e",
+        "source": "polygon",
+        "contract": "0x0e65079a29d7793ab5ca500c2d88e60ee99ba606"
+      }
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with a source other than polygon
+    Given the message
+    """
+    {
+      "operator": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "project": {
+        "reference_id": "VCS-001",
+        "jurisdiction": "US-WA",
+        "metadata": "regen:13toVgf5aZqSVSeJQv562xkkeoe3rr3bJWa29PHVKVf77VAkVMcDvVd.rdf"
+      },
+      "batch": {
+        "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+        "amount": "100",
+        "start_date": "2020-01-01T00:00:00Z",
+        "end_date": "2021-01-01T00:00:00Z",
+        "metadata": "regen:13toVgf5aZqSVSeJQv562xkkeoe3rr3bJWa29PHVKVf77VAkVMcDvVd.rdf"
+      },
+      "origin_tx": {
+        "id": "VCS-1234",
+        "source": "verra"
+      }
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if operator is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "operator: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if issuer is empty
+    Given the message
+    """
+    {
+      "operator": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+    }
+    """
+    When the message is validated
+    Then expect the error "issuer: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if class id is empty
+    Given the message
+    """
+    {
+      "operator": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "class id cannot be empty: parse error: invalid request"
+
+  Scenario: an error is returned if project is empty
+    Given the message
+    """
+    {
+      "operator": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01"
+    }
+    """
+    When the message is validated
+    Then expect the error "project cannot be empty: invalid request"
+
+  Scenario: an error is returned if origin tx is empty
+    Given the message
+    """
+    {
+      "operator": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "project": {
+        "reference_id": "VCS-001",
+        "jurisdiction": "US-WA",
+        "metadata": "regen:13toVgf5aZqSVSeJQv562xkkeoe3rr3bJWa29PHVKVf77VAkVMcDvVd.rdf"
+      },
+      "batch": {
+        "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+        "amount": "100",
+        "start_date": "2020-01-01T00:00:00Z",
+        "end_date": "2021-01-01T00:00:00Z",
+        "metadata": "regen:13toVgf5aZqSVSeJQv562xkkeoe3rr3bJWa29PHVKVf77VAkVMcDvVd.rdf"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "origin tx cannot be empty: invalid request"
+
+  Scenario: an error is returned if origin tx source is empty
+    Given the message
+    """
+    {
+      "operator": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "project": {
+        "reference_id": "VCS-001",
+        "jurisdiction": "US-WA",
+        "metadata": "regen:13toVgf5aZqSVSeJQv562xkkeoe3rr3bJWa29PHVKVf77VAkVMcDvVd.rdf"
+      },
+      "batch": {
+        "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+        "amount": "100",
+        "start_date": "2020-01-01T00:00:00Z",
+        "end_date": "2021-01-01T00:00:00Z",
+        "metadata": "regen:13toVgf5aZqSVSeJQv562xkkeoe3rr3bJWa29PHVKVf77VAkVMcDvVd.rdf"
+      },
+      "origin_tx": {
+        "id": "VCS-1234"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "origin_tx.source cannot be empty: invalid request"
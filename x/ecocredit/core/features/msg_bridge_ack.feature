@@ -0,0 +1,81 @@
+Feature: MsgBridgeAck
+
+  Scenario: a valid message with completed status
+    Given the message
+    """
+    {
+      "operator": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "request_id": 1,
+      "status": "OUTBOUND_BRIDGE_STATUS_COMPLETED",
+      "target_tx_hash": "0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with failed status
+    Given the message
+    """
+    {
+      "operator": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "request_id": 1,
+      "status": "OUTBOUND_BRIDGE_STATUS_FAILED"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if operator is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "operator: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if request id is empty
+    Given the message
+    """
+    {
+      "operator": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "request id cannot be empty: invalid request"
+
+  Scenario: an error is returned if status is pending
+    Given the message
+    """
+    {
+      "operator": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "request_id": 1,
+      "status": "OUTBOUND_BRIDGE_STATUS_PENDING"
+    }
+    """
+    When the message is validated
+    Then expect the error "status must be completed or failed, got OUTBOUND_BRIDGE_STATUS_PENDING: invalid request"
+
+  Scenario: an error is returned if target tx hash is empty when completed
+    Given the message
+    """
+    {
+      "operator": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "request_id": 1,
+      "status": "OUTBOUND_BRIDGE_STATUS_COMPLETED"
+    }
+    """
+    When the message is validated
+    Then expect the error "target tx hash cannot be empty when the request is completed: invalid request"
+
+  Scenario: an error is returned if target tx hash is not a valid ethereum transaction hash
+    Given the message
+    """
+    {
+      "operator": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "request_id": 1,
+      "status": "OUTBOUND_BRIDGE_STATUS_COMPLETED",
+      "target_tx_hash": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "target tx hash must be a valid ethereum transaction hash: invalid request"
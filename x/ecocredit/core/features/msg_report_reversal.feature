@@ -0,0 +1,81 @@
+Feature: MsgReportReversal
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "100"
+        }
+      ],
+      "evidence": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if admin is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "admin: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if project id is empty
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "project id cannot be empty: parse error: invalid request"
+
+  Scenario: an error is returned if credits is empty
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001"
+    }
+    """
+    When the message is validated
+    Then expect the error "credits cannot be empty: invalid request"
+
+  Scenario: an error is returned if credits amount is empty
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "credits[0]: amount cannot be empty: invalid request"
+
+  Scenario: an error is returned if evidence is empty
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "100"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "evidence cannot be empty: invalid request"
@@ -11,7 +11,8 @@ Feature: MsgRetire
           "amount": "100"
         }
       ],
-      "jurisdiction": "US-WA"
+      "jurisdiction": "US-WA",
+      "reason": "offsetting emissions from business travel"
     }
     """
     When the message is validated
@@ -128,3 +129,77 @@ Feature: MsgRetire
     """
     When the message is validated
     Then expect the error "invalid jurisdiction: foo, expected format <country-code>[-<region-code>[ <postal-code>]]: parse error: invalid request"
+
+  Scenario: an error is returned if reason exceeds 512 characters
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "100"
+        }
+      ],
+      "jurisdiction": "US-WA"
+    }
+    """
+    And a reason with length "513"
+    When the message is validated
+    Then expect the error "reason: max length 512: limit exceeded"
+
+  Scenario: a valid message with a beneficiary
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "100"
+        }
+      ],
+      "jurisdiction": "US-WA",
+      "reason": "offsetting emissions from business travel",
+      "beneficiary_address": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "beneficiary_name": "Acme Corporation"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if beneficiary address is not a bech32 address
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "100"
+        }
+      ],
+      "jurisdiction": "US-WA",
+      "beneficiary_address": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "beneficiary address: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if beneficiary name exceeds 128 characters
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "100"
+        }
+      ],
+      "jurisdiction": "US-WA"
+    }
+    """
+    And a beneficiary name with length "129"
+    When the message is validated
+    Then expect the error "beneficiary name: max length 128: limit exceeded"
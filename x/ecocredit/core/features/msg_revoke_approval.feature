@@ -0,0 +1,54 @@
+Feature: MsgRevokeApproval
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "operator": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "batch_denom": "C01-001-20200101-20210101-001"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if owner is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "owner: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if operator is empty
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "operator: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if batch denom and class id are empty
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "operator": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+    }
+    """
+    When the message is validated
+    Then expect the error "batch denom or class id required: invalid request"
+
+  Scenario: an error is returned if batch denom is not formatted
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "operator": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "batch_denom": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "invalid batch denom: expected format A00-000-00000000-00000000-000: parse error: invalid request"
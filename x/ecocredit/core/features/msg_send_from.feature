@@ -0,0 +1,62 @@
+Feature: MsgSendFrom
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "operator": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "recipient": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "tradable_amount": "100",
+          "retired_amount": "100",
+          "retirement_jurisdiction": "US-WA"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if operator is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "operator: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if owner is empty
+    Given the message
+    """
+    {
+      "operator": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+    }
+    """
+    When the message is validated
+    Then expect the error "owner: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if recipient is empty
+    Given the message
+    """
+    {
+      "operator": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "recipient: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if credits are empty
+    Given the message
+    """
+    {
+      "operator": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "recipient": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+    }
+    """
+    When the message is validated
+    Then expect the error "credits cannot be empty: invalid request"
@@ -0,0 +1,106 @@
+Feature: MsgSendLocked
+
+  Scenario: a valid message with a cliff schedule
+    Given the message
+    """
+    {
+      "sender": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "recipient": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "amount": "100",
+      "lock_schedule": {
+        "start_time": "2030-01-01T00:00:00Z"
+      }
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with a linear schedule
+    Given the message
+    """
+    {
+      "sender": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "recipient": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "amount": "100",
+      "lock_schedule": {
+        "start_time": "2030-01-01T00:00:00Z",
+        "end_time": "2031-01-01T00:00:00Z"
+      }
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if sender is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "sender: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if recipient is empty
+    Given the message
+    """
+    {
+      "sender": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "recipient: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if batch denom is empty
+    Given the message
+    """
+    {
+      "sender": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "recipient": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+    }
+    """
+    When the message is validated
+    Then expect the error "batch denom cannot be empty: parse error: invalid request"
+
+  Scenario: an error is returned if amount is not positive
+    Given the message
+    """
+    {
+      "sender": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "recipient": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "amount": "0"
+    }
+    """
+    When the message is validated
+    Then expect the error "amount: expected a positive decimal, got 0: invalid decimal string: invalid request"
+
+  Scenario: an error is returned if lock schedule is empty
+    Given the message
+    """
+    {
+      "sender": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "recipient": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "amount": "100"
+    }
+    """
+    When the message is validated
+    Then expect the error "lock schedule cannot be empty: invalid request"
+
+  Scenario: an error is returned if end time is not after start time
+    Given the message
+    """
+    {
+      "sender": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "recipient": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "amount": "100",
+      "lock_schedule": {
+        "start_time": "2030-01-01T00:00:00Z",
+        "end_time": "2030-01-01T00:00:00Z"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "lock schedule: end time must be after start time: invalid request"
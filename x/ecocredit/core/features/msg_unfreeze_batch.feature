@@ -0,0 +1,55 @@
+Feature: MsgUnfreezeBatch
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "evidence": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf",
+      "reason": "verification report"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message without reason
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "evidence": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if admin is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "admin: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if batch denom is empty
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "batch denom cannot be empty: parse error: invalid request"
+
+  Scenario: an error is returned if evidence is empty
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20210101-001"
+    }
+    """
+    When the message is validated
+    Then expect the error "evidence cannot be empty: invalid request"
@@ -0,0 +1,75 @@
+Feature: MsgUpdateBatchMetadata
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "new_metadata": "regen:13toVgf5aZqSVSeJQv562xkkeoe3rr3bJWa29PHVKVf77VAkVMcDvVd.rdf"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message removing metadata
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20210101-001"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if issuer is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "issuer: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if issuer is not a bech32 address
+    Given the message
+    """
+    {
+      "issuer": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "issuer: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if batch denom is empty
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "batch denom cannot be empty: parse error: invalid request"
+
+  Scenario: an error is returned if batch denom is not formatted
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "invalid batch denom: expected format A00-000-00000000-00000000-000: parse error: invalid request"
+
+  Scenario: an error is returned if new metadata exceeds 256 characters
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20210101-001"
+    }
+    """
+    And new metadata with length "257"
+    When the message is validated
+    Then expect the error "metadata: max length is 256: limit exceeded"
@@ -0,0 +1,180 @@
+Feature: MsgUpdateBridgeOperators
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "source": "polygon",
+      "operators": [
+        "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+        "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+      ],
+      "threshold": 2,
+      "timeout": "86400s",
+      "outbound_timeout": "604800s"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message without source
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "operators": [
+        "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+      ],
+      "threshold": 1,
+      "timeout": "3600s"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message removing the bridge operators
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "source": "polygon"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if admin is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "admin: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if class id is empty
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "class id cannot be empty: parse error: invalid request"
+
+  Scenario: an error is returned if source is not formatted
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "source": "foo!"
+    }
+    """
+    When the message is validated
+    Then expect the error "source must be at most 32 characters long, valid characters: alpha-numberic, space, '-' or '_': invalid request"
+
+  Scenario: an error is returned if threshold is set when removing the bridge operators
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "threshold": 1
+    }
+    """
+    When the message is validated
+    Then expect the error "threshold must be zero when removing bridge operators: invalid request"
+
+  Scenario: an error is returned if an operator is not a valid bech32 address
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "operators": [
+        "foo"
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "operators[0]: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if an operator is duplicated
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "operators": [
+        "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+        "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "operators[1]: duplicate operator: invalid request"
+
+  Scenario: an error is returned if threshold is zero
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "operators": [
+        "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "threshold must be positive: invalid request"
+
+  Scenario: an error is returned if threshold is greater than the number of operators
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "operators": [
+        "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+      ],
+      "threshold": 2
+    }
+    """
+    When the message is validated
+    Then expect the error "threshold 2 cannot be greater than the number of operators 1: invalid request"
+
+  Scenario: an error is returned if timeout is empty
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "operators": [
+        "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+      ],
+      "threshold": 1
+    }
+    """
+    When the message is validated
+    Then expect the error "timeout must be positive: invalid request"
+
+  Scenario: an error is returned if outbound timeout is not positive
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "operators": [
+        "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+      ],
+      "threshold": 1,
+      "timeout": "3600s",
+      "outbound_timeout": "0s"
+    }
+    """
+    When the message is validated
+    Then expect the error "outbound timeout must be positive: invalid request"
@@ -0,0 +1,78 @@
+Feature: MsgUpdateClassBuffer
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "percentage": "10.5"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with zero percentage
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "percentage": "0"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if admin is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "admin: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if class id is empty
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "class id cannot be empty: parse error: invalid request"
+
+  Scenario: an error is returned if percentage is empty
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01"
+    }
+    """
+    When the message is validated
+    Then expect the error "percentage cannot be empty: invalid request"
+
+  Scenario: an error is returned if percentage is negative
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "percentage": "-1"
+    }
+    """
+    When the message is validated
+    Then expect the error "percentage: expected a non-negative decimal, got -1: invalid decimal string: invalid request"
+
+  Scenario: an error is returned if percentage is not less than 100
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "percentage": "100"
+    }
+    """
+    When the message is validated
+    Then expect the error "percentage: must be less than 100, got 100: invalid request"
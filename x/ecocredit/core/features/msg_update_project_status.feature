@@ -0,0 +1,67 @@
+Feature: MsgUpdateProjectStatus
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "status": "PROJECT_STATUS_SUSPENDED",
+      "reason": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if admin is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "admin: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if project id is empty
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "project id cannot be empty: parse error: invalid request"
+
+  Scenario: an error is returned if status is unspecified
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001"
+    }
+    """
+    When the message is validated
+    Then expect the error "project status cannot be unspecified: invalid request"
+
+  Scenario: an error is returned if status is unknown
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "status": 9
+    }
+    """
+    When the message is validated
+    Then expect the error "invalid project status 9: invalid request"
+
+  Scenario: an error is returned if reason is empty
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "status": "PROJECT_STATUS_ACTIVE"
+    }
+    """
+    When the message is validated
+    Then expect the error "reason cannot be empty: invalid request"
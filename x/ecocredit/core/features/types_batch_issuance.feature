@@ -108,3 +108,52 @@ Feature: BatchIssuance
     """
     When the batch issuance is validated
     Then expect the error "retirement jurisdiction: invalid jurisdiction: foo, expected format <country-code>[-<region-code>[ <postal-code>]]: parse error: invalid request"
+
+  Scenario: a valid batch issuance with locked amount
+    Given the batch issuance
+    """
+    {
+      "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "locked_amount": "100",
+      "lock_schedule": {
+        "start_time": "2030-01-01T00:00:00Z",
+        "end_time": "2031-01-01T00:00:00Z"
+      }
+    }
+    """
+    When the batch issuance is validated
+    Then expect no error
+
+  Scenario: an error is returned if issuance locked amount is negative
+    Given the batch issuance
+    """
+    {
+      "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "locked_amount": "-100"
+    }
+    """
+    When the batch issuance is validated
+    Then expect the error "locked amount: expected a non-negative decimal, got -100: invalid decimal string"
+
+  Scenario: an error is returned if issuance locked amount is positive and lock schedule is empty
+    Given the batch issuance
+    """
+    {
+      "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "locked_amount": "100"
+    }
+    """
+    When the batch issuance is validated
+    Then expect the error "lock schedule required: invalid request"
+
+  Scenario: an error is returned if issuance lock schedule start time is empty
+    Given the batch issuance
+    """
+    {
+      "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "locked_amount": "100",
+      "lock_schedule": {}
+    }
+    """
+    When the batch issuance is validated
+    Then expect the error "lock schedule: start time cannot be empty: invalid request"
@@ -0,0 +1,56 @@
+package core
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var _ legacytx.LegacyMsg = &MsgApprove{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgApprove) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgApprove) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgApprove) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgApprove) ValidateBasic() error {
+	owner, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("owner: %s", err)
+	}
+
+	operator, err := sdk.AccAddressFromBech32(m.Operator)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("operator: %s", err)
+	}
+
+	if owner.Equals(operator) {
+		return sdkerrors.ErrInvalidRequest.Wrap("owner and operator cannot be the same account")
+	}
+
+	if err := ValidateAllowanceScope(m.BatchDenom, m.ClassId); err != nil {
+		return err
+	}
+
+	if _, err := math.NewPositiveDecFromString(m.Amount); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("amount: %s", err)
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgApprove.
+func (m *MsgApprove) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{addr}
+}
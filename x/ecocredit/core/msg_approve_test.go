@@ -0,0 +1,41 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgApprove struct {
+	t   gocuke.TestingT
+	msg *MsgApprove
+	err error
+}
+
+func TestMsgApprove(t *testing.T) {
+	gocuke.NewRunner(t, &msgApprove{}).Path("./features/msg_approve.feature").Run()
+}
+
+func (s *msgApprove) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgApprove) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgApprove{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgApprove) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgApprove) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgApprove) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
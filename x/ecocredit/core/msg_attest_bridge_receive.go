@@ -0,0 +1,69 @@
+package core
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var _ legacytx.LegacyMsg = &MsgAttestBridgeReceive{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgAttestBridgeReceive) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgAttestBridgeReceive) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgAttestBridgeReceive) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgAttestBridgeReceive) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("operator: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Issuer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("issuer: %s", err)
+	}
+
+	if err := ValidateClassId(m.ClassId); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err := validateBridgeReceiveContent(m.Project, m.Batch); err != nil {
+		return err
+	}
+
+	if m.OriginTx == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("origin tx cannot be empty")
+	}
+
+	// basic origin tx validation (includes valid ethereum contract address if contract is not empty)
+	if err := m.OriginTx.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgAttestBridgeReceive.
+func (m *MsgAttestBridgeReceive) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Operator)
+	return []sdk.AccAddress{addr}
+}
+
+// ToBridgeReceive returns the bridge receive request attested to by the bridge operator.
+func (m *MsgAttestBridgeReceive) ToBridgeReceive() *MsgBridgeReceive {
+	return &MsgBridgeReceive{
+		Issuer:   m.Issuer,
+		ClassId:  m.ClassId,
+		Project:  m.Project,
+		Batch:    m.Batch,
+		OriginTx: m.OriginTx,
+	}
+}
@@ -0,0 +1,41 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgAttestBridgeReceive struct {
+	t   gocuke.TestingT
+	msg *MsgAttestBridgeReceive
+	err error
+}
+
+func TestMsgAttestBridgeReceive(t *testing.T) {
+	gocuke.NewRunner(t, &msgAttestBridgeReceive{}).Path("./features/msg_attest_bridge_receive.feature").Run()
+}
+
+func (s *msgAttestBridgeReceive) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgAttestBridgeReceive) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgAttestBridgeReceive{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgAttestBridgeReceive) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgAttestBridgeReceive) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgAttestBridgeReceive) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
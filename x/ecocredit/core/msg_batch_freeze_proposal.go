@@ -0,0 +1,42 @@
+package core
+
+import (
+	"fmt"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var _ govtypes.Content = &BatchFreezeProposal{}
+
+const (
+	BatchFreezeProposalType = "BatchFreezeProposal"
+)
+
+func init() {
+	govtypes.RegisterProposalType(BatchFreezeProposalType)
+	govtypes.RegisterProposalTypeCodec(&BatchFreezeProposal{}, "regen/BatchFreezeProposal")
+}
+
+func (m *BatchFreezeProposal) ProposalRoute() string { return ecocredit.RouterKey }
+
+func (m *BatchFreezeProposal) ProposalType() string { return BatchFreezeProposalType }
+
+func (m *BatchFreezeProposal) ValidateBasic() error {
+	if err := ValidateBatchFreeze(m.BatchDenom, m.Evidence, m.Reason); err != nil {
+		return err
+	}
+	return govtypes.ValidateAbstract(m)
+}
+
+func (m *BatchFreezeProposal) String() string {
+	return fmt.Sprintf(`Batch Freeze Proposal:
+  Title:       %s
+  Description: %s
+  Batch Denom: %s
+  Freeze:      %t
+  Evidence:    %s
+  Reason:      %s
+`, m.Title, m.Description, m.BatchDenom, m.Freeze, m.Evidence, m.Reason)
+}
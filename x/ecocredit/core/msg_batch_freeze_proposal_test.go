@@ -0,0 +1,64 @@
+package core
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestBatchFreezeProposal_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name     string
+		proposal BatchFreezeProposal
+		errMsg   string
+	}{
+		{
+			name: "valid",
+			proposal: BatchFreezeProposal{
+				Title:       "hello",
+				Description: "world",
+				BatchDenom:  "C01-001-20200101-20210101-001",
+				Freeze:      true,
+				Evidence:    "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf",
+			},
+		},
+		{
+			name: "invalid: batch denom",
+			proposal: BatchFreezeProposal{
+				Title:       "hello",
+				Description: "world",
+				BatchDenom:  "foo",
+				Evidence:    "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf",
+			},
+			errMsg: "invalid batch denom",
+		},
+		{
+			name: "invalid: empty evidence",
+			proposal: BatchFreezeProposal{
+				Title:       "hello",
+				Description: "world",
+				BatchDenom:  "C01-001-20200101-20210101-001",
+			},
+			errMsg: "evidence cannot be empty",
+		},
+		{
+			name: "invalid: empty title",
+			proposal: BatchFreezeProposal{
+				Description: "world",
+				BatchDenom:  "C01-001-20200101-20210101-001",
+				Evidence:    "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf",
+			},
+			errMsg: "proposal title cannot be blank",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.proposal.ValidateBasic()
+			if len(tt.errMsg) != 0 {
+				assert.ErrorContains(t, err, tt.errMsg)
+			} else {
+				assert.NilError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package core
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+
+	"github.com/regen-network/regen-ledger/types/eth"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var _ legacytx.LegacyMsg = &MsgBridgeAck{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgBridgeAck) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgBridgeAck) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgBridgeAck) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgBridgeAck) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("operator: %s", err)
+	}
+
+	if m.RequestId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("request id cannot be empty")
+	}
+
+	switch m.Status {
+	case OutboundBridgeStatus_OUTBOUND_BRIDGE_STATUS_COMPLETED:
+		if m.TargetTxHash == "" {
+			return sdkerrors.ErrInvalidRequest.Wrap("target tx hash cannot be empty when the request is completed")
+		}
+	case OutboundBridgeStatus_OUTBOUND_BRIDGE_STATUS_FAILED:
+	default:
+		return sdkerrors.ErrInvalidRequest.Wrapf("status must be completed or failed, got %s", m.Status)
+	}
+
+	if m.TargetTxHash != "" && !eth.IsValidTxHash(m.TargetTxHash) {
+		return sdkerrors.ErrInvalidRequest.Wrap("target tx hash must be a valid ethereum transaction hash")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgBridgeAck.
+func (m *MsgBridgeAck) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Operator)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,41 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgBridgeAck struct {
+	t   gocuke.TestingT
+	msg *MsgBridgeAck
+	err error
+}
+
+func TestMsgBridgeAck(t *testing.T) {
+	gocuke.NewRunner(t, &msgBridgeAck{}).Path("./features/msg_bridge_ack.feature").Run()
+}
+
+func (s *msgBridgeAck) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgBridgeAck) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgBridgeAck{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgBridgeAck) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgBridgeAck) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgBridgeAck) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -33,101 +33,111 @@ func (m *MsgBridgeReceive) ValidateBasic() error {
 		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
+	if err := validateBridgeReceiveContent(m.Project, m.Batch); err != nil {
+		return err
+	}
+
+	// origin tx validation
+
+	if m.OriginTx == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("origin tx cannot be empty")
+	}
+
+	// specific to MsgBridgeReceive
+	if !eth.IsValidTxHash(m.OriginTx.Id) {
+		return sdkerrors.ErrInvalidRequest.Wrap("origin tx id must be a valid ethereum transaction hash")
+	}
+
+	// specific to MsgBridgeReceive
+	if m.OriginTx.Source != BridgePolygon {
+		return sdkerrors.ErrInvalidRequest.Wrap("origin tx source must be polygon")
+	}
+
+	// specific to MsgBridgeReceive
+	if m.OriginTx.Contract == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("origin tx contract cannot be empty")
+	}
+
+	// basic origin tx validation (includes valid ethereum contract address if contract is not empty)
+	if err := m.OriginTx.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgCancel.
+func (m *MsgBridgeReceive) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Issuer)
+	return []sdk.AccAddress{addr}
+}
+
+// validateBridgeReceiveContent validates the project and credit batch information of credits
+// being bridged from another chain or registry.
+func validateBridgeReceiveContent(project *MsgBridgeReceive_Project, batch *MsgBridgeReceive_Batch) error {
 	// project validation
 
-	if m.Project == nil {
+	if project == nil {
 		return sdkerrors.ErrInvalidRequest.Wrap("project cannot be empty")
 	}
 
-	if m.Project.ReferenceId == "" {
+	if project.ReferenceId == "" {
 		return sdkerrors.ErrInvalidRequest.Wrap("project reference id cannot be empty")
 	}
 
-	if len(m.Project.ReferenceId) > MaxReferenceIdLength {
+	if len(project.ReferenceId) > MaxReferenceIdLength {
 		return ecocredit.ErrMaxLimit.Wrapf("project reference id: max length %d", MaxReferenceIdLength)
 	}
 
-	if err := ValidateJurisdiction(m.Project.Jurisdiction); err != nil {
+	if err := ValidateJurisdiction(project.Jurisdiction); err != nil {
 		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
-	if m.Project.Metadata == "" {
+	if project.Metadata == "" {
 		return sdkerrors.ErrInvalidRequest.Wrap("project metadata cannot be empty")
 	}
 
-	if len(m.Project.Metadata) > MaxMetadataLength {
+	if len(project.Metadata) > MaxMetadataLength {
 		return ecocredit.ErrMaxLimit.Wrapf("project metadata: max length %d", MaxMetadataLength)
 	}
 
 	// batch validation
 
-	if m.Batch == nil {
+	if batch == nil {
 		return sdkerrors.ErrInvalidRequest.Wrapf("batch cannot be empty")
 	}
 
-	if _, err := sdk.AccAddressFromBech32(m.Batch.Recipient); err != nil {
+	if _, err := sdk.AccAddressFromBech32(batch.Recipient); err != nil {
 		return sdkerrors.ErrInvalidAddress.Wrapf("batch recipient: %s", err)
 	}
 
-	if m.Batch.Amount == "" {
+	if batch.Amount == "" {
 		return sdkerrors.ErrInvalidRequest.Wrap("batch amount cannot be empty")
 	}
 
-	if _, err := math.NewPositiveDecFromString(m.Batch.Amount); err != nil {
+	if _, err := math.NewPositiveDecFromString(batch.Amount); err != nil {
 		return sdkerrors.Wrap(err, "batch amount")
 	}
 
-	if m.Batch.StartDate == nil {
+	if batch.StartDate == nil {
 		return sdkerrors.ErrInvalidRequest.Wrap("batch start date cannot be empty")
 	}
 
-	if m.Batch.EndDate == nil {
+	if batch.EndDate == nil {
 		return sdkerrors.ErrInvalidRequest.Wrap("batch end date cannot be empty")
 	}
 
-	if m.Batch.StartDate.After(*m.Batch.EndDate) {
+	if batch.StartDate.After(*batch.EndDate) {
 		return sdkerrors.ErrInvalidRequest.Wrap("batch start date cannot be after batch end date")
 	}
 
-	if m.Batch.Metadata == "" {
+	if batch.Metadata == "" {
 		return sdkerrors.ErrInvalidRequest.Wrap("batch metadata cannot be empty")
 	}
 
-	if len(m.Batch.Metadata) > MaxMetadataLength {
+	if len(batch.Metadata) > MaxMetadataLength {
 		return ecocredit.ErrMaxLimit.Wrapf("batch metadata: max length %d", MaxMetadataLength)
 	}
 
-	// origin tx validation
-
-	if m.OriginTx == nil {
-		return sdkerrors.ErrInvalidRequest.Wrap("origin tx cannot be empty")
-	}
-
-	// specific to MsgBridgeReceive
-	if !eth.IsValidTxHash(m.OriginTx.Id) {
-		return sdkerrors.ErrInvalidRequest.Wrap("origin tx id must be a valid ethereum transaction hash")
-	}
-
-	// specific to MsgBridgeReceive
-	if m.OriginTx.Source != BridgePolygon {
-		return sdkerrors.ErrInvalidRequest.Wrap("origin tx source must be polygon")
-	}
-
-	// specific to MsgBridgeReceive
-	if m.OriginTx.Contract == "" {
-		return sdkerrors.ErrInvalidRequest.Wrap("origin tx contract cannot be empty")
-	}
-
-	// basic origin tx validation (includes valid ethereum contract address if contract is not empty)
-	if err := m.OriginTx.Validate(); err != nil {
-		return err
-	}
-
 	return nil
 }
-
-// GetSigners returns the expected signers for MsgCancel.
-func (m *MsgBridgeReceive) GetSigners() []sdk.AccAddress {
-	addr, _ := sdk.AccAddressFromBech32(m.Issuer)
-	return []sdk.AccAddress{addr}
-}
@@ -0,0 +1,43 @@
+package core
+
+import (
+	"fmt"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var _ govtypes.Content = &CreditTypePrecisionProposal{}
+
+const (
+	CreditTypePrecisionProposalType = "CreditTypePrecisionProposal"
+)
+
+func init() {
+	govtypes.RegisterProposalType(CreditTypePrecisionProposalType)
+	govtypes.RegisterProposalTypeCodec(&CreditTypePrecisionProposal{}, "regen/CreditTypePrecisionProposal")
+}
+
+func (m *CreditTypePrecisionProposal) ProposalRoute() string { return ecocredit.RouterKey }
+
+func (m *CreditTypePrecisionProposal) ProposalType() string { return CreditTypePrecisionProposalType }
+
+func (m *CreditTypePrecisionProposal) ValidateBasic() error {
+	if err := ValidateCreditTypeAbbreviation(m.CreditTypeAbbrev); err != nil {
+		return err
+	}
+	if err := ValidateCreditTypePrecision(m.Precision); err != nil {
+		return err
+	}
+	return govtypes.ValidateAbstract(m)
+}
+
+func (m *CreditTypePrecisionProposal) String() string {
+	return fmt.Sprintf(`Credit Type Precision Proposal:
+  Title:              %s
+  Description:        %s
+  Credit Type Abbrev: %s
+  Precision:          %d
+`, m.Title, m.Description, m.CreditTypeAbbrev, m.Precision)
+}
@@ -0,0 +1,64 @@
+package core
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestCreditTypePrecisionProposal_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name   string
+		msg    CreditTypePrecisionProposal
+		errMsg string
+	}{
+		{
+			name: "valid",
+			msg: CreditTypePrecisionProposal{
+				Title:            "hello",
+				Description:      "world",
+				CreditTypeAbbrev: "C",
+				Precision:        9,
+			},
+		},
+		{
+			name: "invalid: credit type abbreviation",
+			msg: CreditTypePrecisionProposal{
+				Title:            "hello",
+				Description:      "world",
+				CreditTypeAbbrev: "c",
+				Precision:        9,
+			},
+			errMsg: "credit type abbreviation must be 1-3 uppercase latin letters",
+		},
+		{
+			name: "invalid: precision",
+			msg: CreditTypePrecisionProposal{
+				Title:            "hello",
+				Description:      "world",
+				CreditTypeAbbrev: "C",
+				Precision:        19,
+			},
+			errMsg: "credit type precision cannot exceed 18",
+		},
+		{
+			name: "invalid: title",
+			msg: CreditTypePrecisionProposal{
+				Description:      "world",
+				CreditTypeAbbrev: "C",
+				Precision:        9,
+			},
+			errMsg: "proposal title cannot be blank",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if len(tt.errMsg) != 0 {
+				assert.ErrorContains(t, err, tt.errMsg)
+			} else {
+				assert.NilError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgFreezeBatch struct {
+	t   gocuke.TestingT
+	msg *MsgFreezeBatch
+	err error
+}
+
+func TestMsgFreezeBatch(t *testing.T) {
+	gocuke.NewRunner(t, &msgFreezeBatch{}).Path("./features/msg_freeze_batch.feature").Run()
+}
+
+func (s *msgFreezeBatch) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgFreezeBatch) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgFreezeBatch{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgFreezeBatch) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgFreezeBatch) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgFreezeBatch) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
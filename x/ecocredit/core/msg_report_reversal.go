@@ -0,0 +1,59 @@
+package core
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var _ legacytx.LegacyMsg = &MsgReportReversal{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgReportReversal) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgReportReversal) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgReportReversal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgReportReversal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("admin: %s", err)
+	}
+
+	if err := ValidateProjectId(m.ProjectId); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if len(m.Credits) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("credits cannot be empty")
+	}
+
+	for i, credits := range m.Credits {
+		if err := credits.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "credits[%d]", i)
+		}
+	}
+
+	if len(m.Evidence) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("evidence cannot be empty")
+	}
+
+	if len(m.Evidence) > MaxMetadataLength {
+		return ecocredit.ErrMaxLimit.Wrapf("evidence: max length %d", MaxMetadataLength)
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgReportReversal.
+func (m *MsgReportReversal) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Admin)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,41 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgReportReversal struct {
+	t   gocuke.TestingT
+	msg *MsgReportReversal
+	err error
+}
+
+func TestMsgReportReversal(t *testing.T) {
+	gocuke.NewRunner(t, &msgReportReversal{}).Path("./features/msg_report_reversal.feature").Run()
+}
+
+func (s *msgReportReversal) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgReportReversal) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgReportReversal{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgReportReversal) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgReportReversal) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgReportReversal) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -41,6 +41,10 @@ func (m *MsgRetire) ValidateBasic() error {
 		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
+	if err := ValidateRetirementBeneficiary(m.BeneficiaryAddress, m.BeneficiaryName, m.Reason); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -0,0 +1,41 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgRetireFrom struct {
+	t   gocuke.TestingT
+	msg *MsgRetireFrom
+	err error
+}
+
+func TestMsgRetireFrom(t *testing.T) {
+	gocuke.NewRunner(t, &msgRetireFrom{}).Path("./features/msg_retire_from.feature").Run()
+}
+
+func (s *msgRetireFrom) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgRetireFrom) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgRetireFrom{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgRetireFrom) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgRetireFrom) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgRetireFrom) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
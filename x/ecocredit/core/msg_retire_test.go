@@ -1,6 +1,8 @@
 package core
 
 import (
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/gogo/protobuf/jsonpb"
@@ -28,6 +30,20 @@ func (s *msgRetire) TheMessage(a gocuke.DocString) {
 	require.NoError(s.t, err)
 }
 
+func (s *msgRetire) AReasonWithLength(a string) {
+	length, err := strconv.ParseInt(a, 10, 64)
+	require.NoError(s.t, err)
+
+	s.msg.Reason = strings.Repeat("x", int(length))
+}
+
+func (s *msgRetire) ABeneficiaryNameWithLength(a string) {
+	length, err := strconv.ParseInt(a, 10, 64)
+	require.NoError(s.t, err)
+
+	s.msg.BeneficiaryName = strings.Repeat("x", int(length))
+}
+
 func (s *msgRetire) TheMessageIsValidated() {
 	s.err = s.msg.ValidateBasic()
 }
@@ -0,0 +1,41 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgRevokeApproval struct {
+	t   gocuke.TestingT
+	msg *MsgRevokeApproval
+	err error
+}
+
+func TestMsgRevokeApproval(t *testing.T) {
+	gocuke.NewRunner(t, &msgRevokeApproval{}).Path("./features/msg_revoke_approval.feature").Run()
+}
+
+func (s *msgRevokeApproval) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgRevokeApproval) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgRevokeApproval{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgRevokeApproval) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgRevokeApproval) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgRevokeApproval) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -32,11 +32,22 @@ func (m *MsgSend) ValidateBasic() error {
 		return sdkerrors.ErrInvalidAddress.Wrapf("recipient: %s", err)
 	}
 
-	if len(m.Credits) == 0 {
+	return validateSendCredits(m.Credits)
+}
+
+// GetSigners returns the expected signers for MsgSend.
+func (m *MsgSend) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// validateSendCredits validates the credits of MsgSend and MsgSendFrom.
+func validateSendCredits(sendCredits []*MsgSend_SendCredits) error {
+	if len(sendCredits) == 0 {
 		return sdkerrors.ErrInvalidRequest.Wrap("credits cannot be empty")
 	}
 
-	for _, credits := range m.Credits {
+	for _, credits := range sendCredits {
 		if err := ValidateBatchDenom(credits.BatchDenom); err != nil {
 			return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 		}
@@ -63,9 +74,3 @@ func (m *MsgSend) ValidateBasic() error {
 
 	return nil
 }
-
-// GetSigners returns the expected signers for MsgSend.
-func (m *MsgSend) GetSigners() []sdk.AccAddress {
-	addr, _ := sdk.AccAddressFromBech32(m.Sender)
-	return []sdk.AccAddress{addr}
-}
@@ -0,0 +1,58 @@
+package core
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var _ legacytx.LegacyMsg = &MsgSendLocked{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgSendLocked) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgSendLocked) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSendLocked) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSendLocked) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("sender: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("recipient: %s", err)
+	}
+
+	if err := ValidateBatchDenom(m.BatchDenom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if _, err := math.NewPositiveDecFromString(m.Amount); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("amount: %s", err)
+	}
+
+	if m.LockSchedule == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("lock schedule cannot be empty")
+	}
+
+	if err := m.LockSchedule.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "lock schedule")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgSendLocked.
+func (m *MsgSendLocked) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,41 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgSendLocked struct {
+	t   gocuke.TestingT
+	msg *MsgSendLocked
+	err error
+}
+
+func TestMsgSendLocked(t *testing.T) {
+	gocuke.NewRunner(t, &msgSendLocked{}).Path("./features/msg_send_locked.feature").Run()
+}
+
+func (s *msgSendLocked) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgSendLocked) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgSendLocked{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgSendLocked) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgSendLocked) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgSendLocked) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
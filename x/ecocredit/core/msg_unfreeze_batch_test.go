@@ -0,0 +1,41 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgUnfreezeBatch struct {
+	t   gocuke.TestingT
+	msg *MsgUnfreezeBatch
+	err error
+}
+
+func TestMsgUnfreezeBatch(t *testing.T) {
+	gocuke.NewRunner(t, &msgUnfreezeBatch{}).Path("./features/msg_unfreeze_batch.feature").Run()
+}
+
+func (s *msgUnfreezeBatch) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgUnfreezeBatch) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgUnfreezeBatch{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgUnfreezeBatch) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgUnfreezeBatch) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgUnfreezeBatch) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
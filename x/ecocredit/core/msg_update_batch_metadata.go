@@ -0,0 +1,40 @@
+package core
+
+import (
+	"github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var _ legacytx.LegacyMsg = &MsgUpdateBatchMetadata{}
+
+func (m MsgUpdateBatchMetadata) Route() string { return types.MsgTypeURL(&m) }
+
+func (m MsgUpdateBatchMetadata) Type() string { return types.MsgTypeURL(&m) }
+
+func (m MsgUpdateBatchMetadata) GetSignBytes() []byte {
+	return types.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgUpdateBatchMetadata) ValidateBasic() error {
+	if _, err := types.AccAddressFromBech32(m.Issuer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("issuer: %s", err)
+	}
+
+	if err := ValidateBatchDenom(m.BatchDenom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if len(m.NewMetadata) > MaxMetadataLength {
+		return ecocredit.ErrMaxLimit.Wrapf("metadata: max length is %d", MaxMetadataLength)
+	}
+
+	return nil
+}
+
+func (m MsgUpdateBatchMetadata) GetSigners() []types.AccAddress {
+	addr, _ := types.AccAddressFromBech32(m.Issuer)
+	return []types.AccAddress{addr}
+}
@@ -0,0 +1,88 @@
+package core
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var _ legacytx.LegacyMsg = &MsgUpdateBridgeOperators{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgUpdateBridgeOperators) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgUpdateBridgeOperators) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateBridgeOperators) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUpdateBridgeOperators) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("admin: %s", err)
+	}
+
+	if err := ValidateClassId(m.ClassId); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if m.Source != "" && !reOriginTxSource.MatchString(m.Source) {
+		return sdkerrors.ErrInvalidRequest.Wrap("source must be at most 32 characters long, valid characters: alpha-numberic, space, '-' or '_'")
+	}
+
+	// an empty list of operators removes the bridge operator set
+	if len(m.Operators) == 0 {
+		if m.Threshold != 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("threshold must be zero when removing bridge operators")
+		}
+		return nil
+	}
+
+	duplicateMap := make(map[string]bool)
+	for i, operator := range m.Operators {
+		operatorIndex := fmt.Sprintf("operators[%d]", i)
+
+		if _, err := sdk.AccAddressFromBech32(operator); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("%s: %s", operatorIndex, err)
+		}
+
+		if _, ok := duplicateMap[operator]; ok {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: duplicate operator", operatorIndex)
+		}
+
+		duplicateMap[operator] = true
+	}
+
+	if m.Threshold == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("threshold must be positive")
+	}
+
+	if int(m.Threshold) > len(m.Operators) {
+		return sdkerrors.ErrInvalidRequest.Wrapf(
+			"threshold %d cannot be greater than the number of operators %d", m.Threshold, len(m.Operators),
+		)
+	}
+
+	if m.Timeout == nil || *m.Timeout <= 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("timeout must be positive")
+	}
+
+	if m.OutboundTimeout != nil && *m.OutboundTimeout <= 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("outbound timeout must be positive")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgUpdateBridgeOperators.
+func (m *MsgUpdateBridgeOperators) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Admin)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,41 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgUpdateBridgeOperators struct {
+	t   gocuke.TestingT
+	msg *MsgUpdateBridgeOperators
+	err error
+}
+
+func TestMsgUpdateBridgeOperators(t *testing.T) {
+	gocuke.NewRunner(t, &msgUpdateBridgeOperators{}).Path("./features/msg_update_bridge_operators.feature").Run()
+}
+
+func (s *msgUpdateBridgeOperators) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgUpdateBridgeOperators) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgUpdateBridgeOperators{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgUpdateBridgeOperators) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgUpdateBridgeOperators) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgUpdateBridgeOperators) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
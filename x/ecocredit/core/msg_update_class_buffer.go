@@ -0,0 +1,49 @@
+package core
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var _ legacytx.LegacyMsg = &MsgUpdateClassBuffer{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgUpdateClassBuffer) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgUpdateClassBuffer) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateClassBuffer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUpdateClassBuffer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("admin: %s", err)
+	}
+
+	if err := ValidateClassId(m.ClassId); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if m.Percentage == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("percentage cannot be empty")
+	}
+
+	if err := ValidateBufferPercentage(m.Percentage); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("percentage: %s", err)
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgUpdateClassBuffer.
+func (m *MsgUpdateClassBuffer) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Admin)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,53 @@
+package core
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var _ legacytx.LegacyMsg = &MsgUpdateProjectStatus{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgUpdateProjectStatus) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgUpdateProjectStatus) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateProjectStatus) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUpdateProjectStatus) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("admin: %s", err)
+	}
+
+	if err := ValidateProjectId(m.ProjectId); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err := ValidateProjectStatus(m.Status); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if len(m.Reason) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("reason cannot be empty")
+	}
+
+	if len(m.Reason) > MaxMetadataLength {
+		return ecocredit.ErrMaxLimit.Wrapf("reason: max length %d", MaxMetadataLength)
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgUpdateProjectStatus.
+func (m *MsgUpdateProjectStatus) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Admin)
+	return []sdk.AccAddress{addr}
+}
@@ -16,10 +16,12 @@ var (
 	KeyBasketFee            = []byte("BasketFee")
 )
 
-// TODO: remove after we allow standard SI units for precision
-
 const (
-	PRECISION uint32 = 6
+	// DefaultCreditTypePrecision is the precision of the default credit types.
+	DefaultCreditTypePrecision uint32 = 6
+
+	// MaxCreditTypePrecision is the maximum precision of a credit type.
+	MaxCreditTypePrecision uint32 = 18
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -24,6 +24,94 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ProjectStatus is the lifecycle status of a project.
+type ProjectStatus int32
+
+const (
+	// PROJECT_STATUS_UNSPECIFIED is the default value and is not a valid status.
+	ProjectStatus_PROJECT_STATUS_UNSPECIFIED ProjectStatus = 0
+	// PROJECT_STATUS_PROPOSED is the status of a project that has been proposed
+	// but not yet accepted by the credit class admin.
+	ProjectStatus_PROJECT_STATUS_PROPOSED ProjectStatus = 1
+	// PROJECT_STATUS_ACCEPTED is the status of a project that has been accepted
+	// by the credit class admin but is not yet issuing credits.
+	ProjectStatus_PROJECT_STATUS_ACCEPTED ProjectStatus = 2
+	// PROJECT_STATUS_ACTIVE is the status of a project that can issue credits.
+	ProjectStatus_PROJECT_STATUS_ACTIVE ProjectStatus = 3
+	// PROJECT_STATUS_SUSPENDED is the status of a project that has been
+	// temporarily suspended and cannot issue credits.
+	ProjectStatus_PROJECT_STATUS_SUSPENDED ProjectStatus = 4
+	// PROJECT_STATUS_RETIRED is the status of a project that has permanently
+	// ended. A retired project cannot change status.
+	ProjectStatus_PROJECT_STATUS_RETIRED ProjectStatus = 5
+)
+
+var ProjectStatus_name = map[int32]string{
+	0: "PROJECT_STATUS_UNSPECIFIED",
+	1: "PROJECT_STATUS_PROPOSED",
+	2: "PROJECT_STATUS_ACCEPTED",
+	3: "PROJECT_STATUS_ACTIVE",
+	4: "PROJECT_STATUS_SUSPENDED",
+	5: "PROJECT_STATUS_RETIRED",
+}
+
+var ProjectStatus_value = map[string]int32{
+	"PROJECT_STATUS_UNSPECIFIED": 0,
+	"PROJECT_STATUS_PROPOSED":    1,
+	"PROJECT_STATUS_ACCEPTED":    2,
+	"PROJECT_STATUS_ACTIVE":      3,
+	"PROJECT_STATUS_SUSPENDED":   4,
+	"PROJECT_STATUS_RETIRED":     5,
+}
+
+func (x ProjectStatus) String() string {
+	return proto.EnumName(ProjectStatus_name, int32(x))
+}
+
+func (ProjectStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6cfdca0a4aaabb36, []int{0}
+}
+
+// OutboundBridgeStatus is the status of an outbound bridge request.
+type OutboundBridgeStatus int32
+
+const (
+	// OUTBOUND_BRIDGE_STATUS_UNSPECIFIED is the default value and is not a valid
+	// status.
+	OutboundBridgeStatus_OUTBOUND_BRIDGE_STATUS_UNSPECIFIED OutboundBridgeStatus = 0
+	// OUTBOUND_BRIDGE_STATUS_PENDING is the status of an outbound bridge request
+	// that has not yet been acknowledged by a bridge operator.
+	OutboundBridgeStatus_OUTBOUND_BRIDGE_STATUS_PENDING OutboundBridgeStatus = 1
+	// OUTBOUND_BRIDGE_STATUS_COMPLETED is the status of an outbound bridge
+	// request that a bridge operator confirmed was completed on the target chain.
+	OutboundBridgeStatus_OUTBOUND_BRIDGE_STATUS_COMPLETED OutboundBridgeStatus = 2
+	// OUTBOUND_BRIDGE_STATUS_FAILED is the status of an outbound bridge request
+	// that failed or timed out and was refunded to the owner.
+	OutboundBridgeStatus_OUTBOUND_BRIDGE_STATUS_FAILED OutboundBridgeStatus = 3
+)
+
+var OutboundBridgeStatus_name = map[int32]string{
+	0: "OUTBOUND_BRIDGE_STATUS_UNSPECIFIED",
+	1: "OUTBOUND_BRIDGE_STATUS_PENDING",
+	2: "OUTBOUND_BRIDGE_STATUS_COMPLETED",
+	3: "OUTBOUND_BRIDGE_STATUS_FAILED",
+}
+
+var OutboundBridgeStatus_value = map[string]int32{
+	"OUTBOUND_BRIDGE_STATUS_UNSPECIFIED": 0,
+	"OUTBOUND_BRIDGE_STATUS_PENDING":     1,
+	"OUTBOUND_BRIDGE_STATUS_COMPLETED":   2,
+	"OUTBOUND_BRIDGE_STATUS_FAILED":      3,
+}
+
+func (x OutboundBridgeStatus) String() string {
+	return proto.EnumName(OutboundBridgeStatus_name, int32(x))
+}
+
+func (OutboundBridgeStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6cfdca0a4aaabb36, []int{1}
+}
+
 // CreditType defines the measurement unit/precision of a certain credit type
 // (e.g. carbon, biodiversity...)
 type CreditType struct {
@@ -260,6 +348,9 @@ type Project struct {
 	Metadata string `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	// reference_id is any arbitrary string used to reference the project.
 	ReferenceId string `protobuf:"bytes,7,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	// status is the lifecycle status of the project. Credits can only be issued
+	// to a project with an active status.
+	Status ProjectStatus `protobuf:"varint,8,opt,name=status,proto3,enum=regen.ecocredit.v1.ProjectStatus" json:"status,omitempty"`
 }
 
 func (m *Project) Reset()         { *m = Project{} }
@@ -344,6 +435,13 @@ func (m *Project) GetReferenceId() string {
 	return ""
 }
 
+func (m *Project) GetStatus() ProjectStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ProjectStatus_PROJECT_STATUS_UNSPECIFIED
+}
+
 // Batch represents the high-level on-chain information for a credit batch.
 type Batch struct {
 	// key is the table row identifier of the credit batch used internally for
@@ -372,6 +470,9 @@ type Batch struct {
 	// open tells if it's possible to mint new credits in the future.
 	// Once `open` is set to false, it can't be toggled any more.
 	Open bool `protobuf:"varint,9,opt,name=open,proto3" json:"open,omitempty"`
+	// frozen tells if the credit batch is frozen. Credits from a frozen credit
+	// batch cannot be sent, retired, sold, bought or put into a basket.
+	Frozen bool `protobuf:"varint,10,opt,name=frozen,proto3" json:"frozen,omitempty"`
 }
 
 func (m *Batch) Reset()         { *m = Batch{} }
@@ -470,6 +571,13 @@ func (m *Batch) GetOpen() bool {
 	return false
 }
 
+func (m *Batch) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
 // ClassSequence stores and increments the sequence number for credit classes
 // within a credit type.
 type ClassSequence struct {
@@ -660,6 +768,10 @@ type BatchBalance struct {
 	// sell order is created and taken out of escrow when the sell order is either
 	// cancelled, updated with a reduced quantity, or processed.
 	EscrowedAmount string `protobuf:"bytes,5,opt,name=escrowed_amount,json=escrowedAmount,proto3" json:"escrowed_amount,omitempty"`
+	// locked_amount is the total number of locked credits owned by address.
+	// Locked credits are released into the tradable balance according to the
+	// unlock schedule of each lock.
+	LockedAmount string `protobuf:"bytes,6,opt,name=locked_amount,json=lockedAmount,proto3" json:"locked_amount,omitempty"`
 }
 
 func (m *BatchBalance) Reset()         { *m = BatchBalance{} }
@@ -730,6 +842,13 @@ func (m *BatchBalance) GetEscrowedAmount() string {
 	return ""
 }
 
+func (m *BatchBalance) GetLockedAmount() string {
+	if m != nil {
+		return m.LockedAmount
+	}
+	return ""
+}
+
 // BatchSupply stores the supply of credits for a credit batch.
 type BatchSupply struct {
 	// batch_key is the table row identifier of the credit batch used internally
@@ -960,988 +1079,6969 @@ func (m *BatchContract) GetContract() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*CreditType)(nil), "regen.ecocredit.v1.CreditType")
-	proto.RegisterType((*Class)(nil), "regen.ecocredit.v1.Class")
-	proto.RegisterType((*ClassIssuer)(nil), "regen.ecocredit.v1.ClassIssuer")
-	proto.RegisterType((*Project)(nil), "regen.ecocredit.v1.Project")
-	proto.RegisterType((*Batch)(nil), "regen.ecocredit.v1.Batch")
-	proto.RegisterType((*ClassSequence)(nil), "regen.ecocredit.v1.ClassSequence")
-	proto.RegisterType((*ProjectSequence)(nil), "regen.ecocredit.v1.ProjectSequence")
-	proto.RegisterType((*BatchSequence)(nil), "regen.ecocredit.v1.BatchSequence")
-	proto.RegisterType((*BatchBalance)(nil), "regen.ecocredit.v1.BatchBalance")
-	proto.RegisterType((*BatchSupply)(nil), "regen.ecocredit.v1.BatchSupply")
-	proto.RegisterType((*OriginTxIndex)(nil), "regen.ecocredit.v1.OriginTxIndex")
-	proto.RegisterType((*BatchContract)(nil), "regen.ecocredit.v1.BatchContract")
+// Retirement stores a record of credits retired by an account. A retirement
+// record is created each time credits are retired, either directly using
+// Msg/Retire or upon issuance, transfer, purchase, or taking from a basket.
+type Retirement struct {
+	// id is the unique identifier of the retirement record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address of the account that retired the credits.
+	Owner []byte `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// batch_key is the table row identifier of the credit batch within which
+	// the credits were retired.
+	BatchKey uint64 `protobuf:"varint,3,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// project_key is the table row identifier of the project within which the
+	// credit batch was issued. It is stored alongside the batch key for
+	// efficient lookups of retirements by project.
+	ProjectKey uint64 `protobuf:"varint,4,opt,name=project_key,json=projectKey,proto3" json:"project_key,omitempty"`
+	// amount is the decimal number of credits that were retired.
+	Amount string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// jurisdiction is the jurisdiction of the account that retired the credits.
+	Jurisdiction string `protobuf:"bytes,6,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"`
+	// reason is any arbitrary string that specifies the reason for retiring
+	// the credits.
+	Reason string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	// block_height is the height of the block in which the credits were retired.
+	BlockHeight int64 `protobuf:"varint,8,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// timestamp is the time of the block in which the credits were retired.
+	Timestamp *types.Timestamp `protobuf:"bytes,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// beneficiary_address is the address of the account on whose behalf the
+	// credits were retired and to which the retired credits were attributed.
+	BeneficiaryAddress []byte `protobuf:"bytes,10,opt,name=beneficiary_address,json=beneficiaryAddress,proto3" json:"beneficiary_address,omitempty"`
+	// beneficiary_name is the name of the individual or organization on whose
+	// behalf the credits were retired.
+	BeneficiaryName string `protobuf:"bytes,11,opt,name=beneficiary_name,json=beneficiaryName,proto3" json:"beneficiary_name,omitempty"`
+}
+
+func (m *Retirement) Reset()         { *m = Retirement{} }
+func (m *Retirement) String() string { return proto.CompactTextString(m) }
+func (*Retirement) ProtoMessage()    {}
+func (*Retirement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cfdca0a4aaabb36, []int{12}
+}
+func (m *Retirement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Retirement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Retirement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Retirement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Retirement.Merge(m, src)
+}
+func (m *Retirement) XXX_Size() int {
+	return m.Size()
+}
+func (m *Retirement) XXX_DiscardUnknown() {
+	xxx_messageInfo_Retirement.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("regen/ecocredit/v1/state.proto", fileDescriptor_6cfdca0a4aaabb36) }
+var xxx_messageInfo_Retirement proto.InternalMessageInfo
 
-var fileDescriptor_6cfdca0a4aaabb36 = []byte{
-	// 1025 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x6e, 0xe3, 0x54,
-	0x14, 0x9e, 0x9b, 0x9f, 0x26, 0x39, 0xf9, 0x73, 0xef, 0xb4, 0xc5, 0x84, 0x21, 0x2d, 0x06, 0x34,
-	0x1d, 0xa9, 0x24, 0x2a, 0x7f, 0x82, 0x2c, 0x40, 0xd3, 0xc2, 0xa2, 0x9a, 0x05, 0xa3, 0xd0, 0x15,
-	0x9b, 0x70, 0x63, 0x9f, 0x49, 0xdd, 0x49, 0x6c, 0x73, 0x7d, 0xdd, 0x69, 0xb6, 0x3c, 0x00, 0x62,
-	0x85, 0x58, 0x20, 0x5e, 0x80, 0x57, 0xe0, 0x01, 0x60, 0x37, 0x12, 0x1b, 0x96, 0xa8, 0x7d, 0x03,
-	0xc4, 0x03, 0x20, 0x1f, 0x5f, 0x3b, 0x71, 0xc8, 0x74, 0x2a, 0x76, 0xf7, 0x9c, 0x7b, 0x7e, 0xbe,
-	0xef, 0xbb, 0xe7, 0x24, 0x86, 0xae, 0xc4, 0x09, 0x7a, 0x7d, 0xb4, 0x7d, 0x5b, 0xa2, 0xe3, 0xaa,
-	0xfe, 0xc5, 0x61, 0x3f, 0x54, 0x42, 0x61, 0x2f, 0x90, 0xbe, 0xf2, 0x39, 0xa7, 0xfb, 0x5e, 0x76,
-	0xdf, 0xbb, 0x38, 0xec, 0xbc, 0x6e, 0xfb, 0xe1, 0xcc, 0x0f, 0xfb, 0xbe, 0x9c, 0xf5, 0x2f, 0x0e,
-	0xc5, 0x34, 0x38, 0x13, 0x87, 0xb1, 0x91, 0xa4, 0x74, 0x76, 0x27, 0xbe, 0x3f, 0x99, 0x62, 0x9f,
-	0xac, 0x71, 0xf4, 0xa4, 0xaf, 0xdc, 0x19, 0x86, 0x4a, 0xcc, 0x82, 0x24, 0xc0, 0xfa, 0x89, 0x01,
-	0x1c, 0x53, 0xb5, 0xd3, 0x79, 0x80, 0xdc, 0x82, 0x86, 0x18, 0x8f, 0x25, 0x5e, 0xb8, 0x42, 0xb9,
-	0xbe, 0x67, 0xb2, 0x3d, 0xb6, 0x5f, 0x1b, 0xe6, 0x7c, 0x9c, 0x43, 0xc9, 0x13, 0x33, 0x34, 0x0b,
-	0x74, 0x47, 0xe7, 0xd8, 0x17, 0x79, 0xae, 0x32, 0x8b, 0x89, 0x2f, 0x3e, 0xf3, 0x7b, 0x50, 0x0b,
-	0x24, 0xda, 0x6e, 0x18, 0x17, 0x2a, 0xed, 0xb1, 0xfd, 0xe6, 0x70, 0xe1, 0x18, 0xbc, 0xf5, 0xf7,
-	0xcf, 0x7f, 0x7c, 0x57, 0xec, 0x42, 0x2b, 0xdf, 0x91, 0x43, 0x52, 0xdd, 0x60, 0x26, 0x33, 0x99,
-	0xf5, 0x3b, 0x83, 0xf2, 0xf1, 0x54, 0x84, 0x21, 0x37, 0xa0, 0xf8, 0x14, 0xe7, 0x04, 0xa8, 0x34,
-	0x8c, 0x8f, 0xbc, 0x05, 0x05, 0xd7, 0xd1, 0x28, 0x0a, 0xae, 0xc3, 0xb7, 0xa0, 0x2c, 0x9c, 0x99,
-	0xeb, 0x11, 0x88, 0xc6, 0x30, 0x31, 0x78, 0x07, 0xaa, 0x33, 0x54, 0xc2, 0x11, 0x4a, 0x10, 0x88,
-	0xda, 0x30, 0xb3, 0xf9, 0x01, 0xf0, 0x44, 0xc9, 0x91, 0x9a, 0x07, 0x38, 0x4a, 0x70, 0x98, 0x65,
-	0x8a, 0x32, 0xec, 0x4c, 0x95, 0x87, 0xe4, 0x1f, 0x7c, 0x42, 0x88, 0x3f, 0x82, 0x0a, 0x21, 0x31,
-	0x18, 0xaf, 0xc6, 0x00, 0x62, 0xa0, 0xbc, 0xa6, 0x5b, 0x1b, 0x05, 0xbe, 0xb3, 0xae, 0xa6, 0x51,
-	0x34, 0x0b, 0xd6, 0xd7, 0x50, 0x27, 0x2a, 0x27, 0x61, 0x18, 0xa1, 0xe4, 0xaf, 0x41, 0xcd, 0x8e,
-	0xcd, 0xd1, 0x82, 0x56, 0x95, 0x1c, 0x8f, 0x70, 0xce, 0x77, 0x60, 0xc3, 0xa5, 0x30, 0xe2, 0xd7,
-	0x18, 0x6a, 0x6b, 0x70, 0x8f, 0x30, 0xec, 0x00, 0x07, 0x23, 0x4b, 0x3e, 0xd0, 0x91, 0x45, 0xeb,
-	0x97, 0x02, 0x54, 0x1e, 0x4b, 0xff, 0x1c, 0x6d, 0xf5, 0xbf, 0xf5, 0xca, 0xc1, 0x2a, 0xad, 0xc0,
-	0xb2, 0xa0, 0x71, 0x1e, 0x49, 0x37, 0x74, 0x5c, 0x9b, 0xc6, 0x23, 0x91, 0x2a, 0xe7, 0xcb, 0x09,
-	0xbe, 0xb1, 0x22, 0xf8, 0x1b, 0xd0, 0x90, 0xf8, 0x04, 0x25, 0x7a, 0x36, 0x8e, 0x5c, 0xc7, 0xac,
-	0xd0, 0x7d, 0x3d, 0xf3, 0x9d, 0x38, 0x83, 0x33, 0x62, 0x38, 0x5e, 0xa7, 0x32, 0x87, 0xc6, 0x12,
-	0x69, 0xc7, 0x28, 0x2c, 0x2b, 0x5f, 0xe4, 0x46, 0xbe, 0xb8, 0x51, 0xe2, 0x1d, 0xd8, 0x59, 0x24,
-	0xe4, 0xee, 0xca, 0x66, 0xc9, 0xfa, 0xb1, 0x08, 0xe5, 0x23, 0xa1, 0xec, 0xb3, 0x35, 0x5a, 0xbd,
-	0x40, 0x7f, 0xbe, 0x0b, 0xf5, 0x20, 0x11, 0x98, 0xf4, 0x29, 0x52, 0x06, 0x68, 0x57, 0xac, 0xd0,
-	0x16, 0x94, 0x1d, 0xf4, 0xfc, 0x99, 0x9e, 0xb5, 0xc4, 0xc8, 0x69, 0x52, 0x5e, 0xd1, 0xe4, 0x63,
-	0x80, 0x50, 0x09, 0xa9, 0x46, 0x8e, 0x50, 0x48, 0x8a, 0xd5, 0xdf, 0xed, 0xf4, 0x92, 0xbd, 0xed,
-	0xa5, 0x7b, 0xdb, 0x3b, 0x4d, 0xf7, 0x76, 0x58, 0xa3, 0xe8, 0xcf, 0x84, 0x42, 0xfe, 0x01, 0x54,
-	0xd1, 0x73, 0x92, 0xc4, 0xca, 0x4b, 0x13, 0x2b, 0xe8, 0x39, 0x94, 0xf6, 0x29, 0x34, 0x63, 0x3a,
-	0x22, 0xd6, 0x82, 0x72, 0xab, 0x2f, 0xcd, 0x6d, 0xa4, 0x09, 0x54, 0x80, 0x43, 0xc9, 0x0f, 0xd0,
-	0x33, 0x6b, 0x7b, 0x6c, 0xbf, 0x3a, 0xa4, 0xf3, 0xe0, 0x11, 0xbd, 0xdb, 0xe7, 0x8b, 0x77, 0xab,
-	0x6b, 0x25, 0xe8, 0xe9, 0xda, 0x39, 0xdd, 0x8c, 0x02, 0x6f, 0x2d, 0xb3, 0x36, 0x8a, 0x1c, 0x52,
-	0xc1, 0x8d, 0x92, 0x59, 0xb6, 0xbe, 0x65, 0xd0, 0xa4, 0x5d, 0xf9, 0x12, 0xbf, 0x89, 0xe2, 0x37,
-	0x7b, 0xc1, 0xaa, 0xb2, 0xf5, 0xab, 0xca, 0xdf, 0x84, 0xa6, 0x87, 0x97, 0x6a, 0x14, 0xea, 0x74,
-	0x7a, 0xc5, 0xd2, 0xb0, 0x11, 0x3b, 0xd3, 0x92, 0x83, 0x2e, 0x21, 0x36, 0x61, 0x6b, 0x6d, 0xe9,
-	0x0d, 0xeb, 0x1c, 0xda, 0x7a, 0x99, 0x32, 0x14, 0x37, 0xee, 0xec, 0xad, 0x9a, 0x6e, 0x53, 0xd3,
-	0x36, 0xd4, 0x97, 0x2b, 0x55, 0x2c, 0x0f, 0x9a, 0x34, 0x8a, 0x59, 0xa7, 0x95, 0x41, 0x63, 0xff,
-	0x19, 0xb4, 0x5b, 0x75, 0x7b, 0x85, 0xba, 0x6d, 0x42, 0x33, 0x5f, 0xad, 0x6a, 0xfd, 0xc3, 0xa0,
-	0x41, 0x0d, 0x8f, 0xc4, 0x54, 0x68, 0x66, 0xe3, 0xd8, 0x5e, 0x66, 0x46, 0x8e, 0xb8, 0x97, 0x09,
-	0x15, 0xe1, 0x38, 0x12, 0xc3, 0x50, 0xaf, 0x43, 0x6a, 0xf2, 0xfb, 0xd0, 0x56, 0x52, 0x38, 0x62,
-	0x3c, 0xc5, 0x91, 0x98, 0xf9, 0x91, 0x97, 0xfe, 0x05, 0xb4, 0x52, 0xf7, 0x43, 0xf2, 0xf2, 0xb7,
-	0xa1, 0x25, 0x51, 0xb9, 0x12, 0x9d, 0x34, 0x2e, 0x59, 0x90, 0xa6, 0xf6, 0xea, 0xb0, 0xfb, 0xd0,
-	0xc6, 0xd0, 0x96, 0xfe, 0xb3, 0x45, 0x5c, 0xb2, 0x2f, 0xad, 0xd4, 0x9d, 0x04, 0x0e, 0xde, 0x27,
-	0x66, 0x3d, 0xb8, 0x0b, 0x9b, 0x1a, 0xcb, 0x41, 0x86, 0x9f, 0x6f, 0xc3, 0x66, 0x66, 0x1c, 0xe8,
-	0x6b, 0x83, 0x99, 0x35, 0xeb, 0x57, 0x06, 0xf5, 0x44, 0xe7, 0x28, 0x08, 0xa6, 0xf3, 0x9b, 0x59,
-	0xaf, 0xe1, 0x56, 0xb8, 0x25, 0xb7, 0xe2, 0x3a, 0x6e, 0x0f, 0xc0, 0xb0, 0x63, 0xad, 0xa7, 0xd3,
-	0x55, 0x11, 0xda, 0x99, 0x5f, 0xb3, 0x5b, 0x9a, 0x92, 0x05, 0x3e, 0xb0, 0x22, 0x68, 0x7e, 0x21,
-	0xdd, 0x89, 0xeb, 0x9d, 0x5e, 0x9e, 0x78, 0x0e, 0x5e, 0xde, 0x3c, 0x8f, 0xab, 0xbf, 0xf7, 0x3b,
-	0xb0, 0x11, 0xfa, 0x91, 0xb4, 0x51, 0xc3, 0xd3, 0xd6, 0x60, 0x97, 0x9a, 0xbd, 0x0a, 0xdb, 0x70,
-	0x77, 0xf9, 0xe7, 0xf5, 0x40, 0x07, 0xd7, 0xad, 0x1f, 0x98, 0x9e, 0xce, 0x63, 0xdf, 0x53, 0x52,
-	0xd8, 0xea, 0x66, 0xdd, 0x72, 0xa0, 0x0a, 0x2b, 0xa0, 0x3a, 0x50, 0xb5, 0x75, 0x15, 0x0d, 0x23,
-	0xb3, 0x07, 0x7d, 0x02, 0xf2, 0x20, 0xc7, 0x9a, 0x9b, 0xc0, 0x17, 0xa8, 0xd2, 0x50, 0xfa, 0x3a,
-	0x68, 0x1c, 0x3d, 0xfe, 0xed, 0xaa, 0xcb, 0x9e, 0x5f, 0x75, 0xd9, 0x5f, 0x57, 0x5d, 0xf6, 0xfd,
-	0x75, 0xf7, 0xce, 0xf3, 0xeb, 0xee, 0x9d, 0x3f, 0xaf, 0xbb, 0x77, 0xbe, 0xfa, 0x70, 0xe2, 0xaa,
-	0xb3, 0x68, 0xdc, 0xb3, 0xfd, 0x59, 0x9f, 0xbe, 0x9a, 0xde, 0xf1, 0x50, 0x3d, 0xf3, 0xe5, 0x53,
-	0x6d, 0x4d, 0xd1, 0x99, 0xa0, 0xec, 0x5f, 0x2e, 0x7d, 0x6c, 0xd9, 0xbe, 0xc4, 0xf1, 0x06, 0xfd,
-	0xf6, 0xbd, 0xf7, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2d, 0xe0, 0x0f, 0xb7, 0x8b, 0x09, 0x00,
-	0x00,
+func (m *Retirement) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *CreditType) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Retirement) GetOwner() []byte {
+	if m != nil {
+		return m.Owner
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CreditType) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Retirement) GetBatchKey() uint64 {
+	if m != nil {
+		return m.BatchKey
+	}
+	return 0
 }
 
-func (m *CreditType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Precision != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.Precision))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Unit) > 0 {
-		i -= len(m.Unit)
-		copy(dAtA[i:], m.Unit)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Unit)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Abbreviation) > 0 {
-		i -= len(m.Abbreviation)
-		copy(dAtA[i:], m.Abbreviation)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Abbreviation)))
-		i--
-		dAtA[i] = 0xa
+func (m *Retirement) GetProjectKey() uint64 {
+	if m != nil {
+		return m.ProjectKey
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Class) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Retirement) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Class) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Retirement) GetJurisdiction() string {
+	if m != nil {
+		return m.Jurisdiction
+	}
+	return ""
 }
 
-func (m *Class) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CreditTypeAbbrev) > 0 {
-		i -= len(m.CreditTypeAbbrev)
-		copy(dAtA[i:], m.CreditTypeAbbrev)
-		i = encodeVarintState(dAtA, i, uint64(len(m.CreditTypeAbbrev)))
-		i--
-		dAtA[i] = 0x2a
+func (m *Retirement) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *Retirement) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *Retirement) GetTimestamp() *types.Timestamp {
+	if m != nil {
+		return m.Timestamp
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *Retirement) GetBeneficiaryAddress() []byte {
+	if m != nil {
+		return m.BeneficiaryAddress
 	}
-	if m.Key != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.Key))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *Retirement) GetBeneficiaryName() string {
+	if m != nil {
+		return m.BeneficiaryName
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ClassIssuer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// BatchAllowance stores the amount of credits an operator is approved to send
+// or retire on behalf of an owner. An allowance is scoped to either a single
+// credit batch or all credit batches within a credit class.
+type BatchAllowance struct {
+	// owner is the address of the account that owns the credits.
+	Owner []byte `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// operator is the address of the account approved to send or retire credits
+	// on behalf of the owner.
+	Operator []byte `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// class_key is the table row identifier of the credit class within which the
+	// operator is approved to spend credits. The class_key is only set when the
+	// allowance is scoped to a credit class.
+	ClassKey uint64 `protobuf:"varint,3,opt,name=class_key,json=classKey,proto3" json:"class_key,omitempty"`
+	// batch_key is the table row identifier of the credit batch from which the
+	// operator is approved to spend credits. The batch_key is only set when the
+	// allowance is scoped to a credit batch.
+	BatchKey uint64 `protobuf:"varint,4,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// amount is the remaining decimal number of credits the operator is approved
+	// to spend on behalf of the owner.
+	Amount string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// expiration is the optional time after which the allowance can no longer be
+	// spent.
+	Expiration *types.Timestamp `protobuf:"bytes,6,opt,name=expiration,proto3" json:"expiration,omitempty"`
+}
+
+func (m *BatchAllowance) Reset()         { *m = BatchAllowance{} }
+func (m *BatchAllowance) String() string { return proto.CompactTextString(m) }
+func (*BatchAllowance) ProtoMessage()    {}
+func (*BatchAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cfdca0a4aaabb36, []int{13}
+}
+func (m *BatchAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *BatchAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchAllowance.Merge(m, src)
+}
+func (m *BatchAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchAllowance.DiscardUnknown(m)
 }
 
-func (m *ClassIssuer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_BatchAllowance proto.InternalMessageInfo
+
+func (m *BatchAllowance) GetOwner() []byte {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
 }
 
-func (m *ClassIssuer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Issuer) > 0 {
-		i -= len(m.Issuer)
-		copy(dAtA[i:], m.Issuer)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Issuer)))
-		i--
-		dAtA[i] = 0x12
+func (m *BatchAllowance) GetOperator() []byte {
+	if m != nil {
+		return m.Operator
 	}
-	if m.ClassKey != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *BatchAllowance) GetClassKey() uint64 {
+	if m != nil {
+		return m.ClassKey
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Project) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BatchAllowance) GetBatchKey() uint64 {
+	if m != nil {
+		return m.BatchKey
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Project) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BatchAllowance) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
 }
 
-func (m *Project) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ReferenceId) > 0 {
-		i -= len(m.ReferenceId)
-		copy(dAtA[i:], m.ReferenceId)
-		i = encodeVarintState(dAtA, i, uint64(len(m.ReferenceId)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Jurisdiction) > 0 {
-		i -= len(m.Jurisdiction)
-		copy(dAtA[i:], m.Jurisdiction)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Jurisdiction)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.ClassKey != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Key != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.Key))
-		i--
-		dAtA[i] = 0x8
+func (m *BatchAllowance) GetExpiration() *types.Timestamp {
+	if m != nil {
+		return m.Expiration
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Batch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// BatchLock stores credits that are locked for an account and released into the
+// tradable balance of the account according to an unlock schedule.
+type BatchLock struct {
+	// id is the table row identifier of the lock.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// address is the address of the account that owns the locked credits.
+	Address []byte `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// batch_key is the table row identifier of the credit batch of the locked
+	// credits.
+	BatchKey uint64 `protobuf:"varint,3,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// amount is the total number of credits locked.
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// released_amount is the number of credits that have been released into the
+	// tradable balance of the account.
+	ReleasedAmount string `protobuf:"bytes,5,opt,name=released_amount,json=releasedAmount,proto3" json:"released_amount,omitempty"`
+	// start_time is the time at which the credits begin to unlock. If end_time is
+	// not set, all credits are released at start_time.
+	StartTime *types.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time is the optional time at which all credits are released. If set,
+	// credits are released linearly between start_time and end_time.
+	EndTime *types.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// next_release_time is the time at which the next release of credits is
+	// processed. It is set to start_time when the lock is created and, for locks
+	// with an end_time, it is moved forward each time credits are released.
+	NextReleaseTime *types.Timestamp `protobuf:"bytes,8,opt,name=next_release_time,json=nextReleaseTime,proto3" json:"next_release_time,omitempty"`
+}
+
+func (m *BatchLock) Reset()         { *m = BatchLock{} }
+func (m *BatchLock) String() string { return proto.CompactTextString(m) }
+func (*BatchLock) ProtoMessage()    {}
+func (*BatchLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cfdca0a4aaabb36, []int{14}
+}
+func (m *BatchLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *Batch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BatchLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchLock.Merge(m, src)
+}
+func (m *BatchLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchLock.DiscardUnknown(m)
 }
 
-func (m *Batch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Open {
-		i--
-		if m.Open {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.IssuanceDate != nil {
-		{
-			size, err := m.IssuanceDate.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintState(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.EndDate != nil {
-		{
-			size, err := m.EndDate.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintState(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.StartDate != nil {
-		{
-			size, err := m.StartDate.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintState(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.ProjectKey != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.ProjectKey))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Issuer) > 0 {
-		i -= len(m.Issuer)
-		copy(dAtA[i:], m.Issuer)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Issuer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Key != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.Key))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_BatchLock proto.InternalMessageInfo
+
+func (m *BatchLock) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ClassSequence) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BatchLock) GetAddress() []byte {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ClassSequence) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BatchLock) GetBatchKey() uint64 {
+	if m != nil {
+		return m.BatchKey
+	}
+	return 0
 }
 
-func (m *ClassSequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NextSequence != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.NextSequence))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.CreditTypeAbbrev) > 0 {
-		i -= len(m.CreditTypeAbbrev)
-		copy(dAtA[i:], m.CreditTypeAbbrev)
-		i = encodeVarintState(dAtA, i, uint64(len(m.CreditTypeAbbrev)))
-		i--
-		dAtA[i] = 0xa
+func (m *BatchLock) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ProjectSequence) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BatchLock) GetReleasedAmount() string {
+	if m != nil {
+		return m.ReleasedAmount
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ProjectSequence) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BatchLock) GetStartTime() *types.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
 }
 
-func (m *ProjectSequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NextSequence != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.NextSequence))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ClassKey != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
-		i--
-		dAtA[i] = 0x8
+func (m *BatchLock) GetEndTime() *types.Timestamp {
+	if m != nil {
+		return m.EndTime
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *BatchSequence) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BatchLock) GetNextReleaseTime() *types.Timestamp {
+	if m != nil {
+		return m.NextReleaseTime
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *BatchSequence) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ClassBuffer stores the percentage of each issuance of credits within a
+// credit class that is withheld into the buffer pool of the credit class.
+type ClassBuffer struct {
+	// class_key is the table row identifier of the credit class.
+	ClassKey uint64 `protobuf:"varint,1,opt,name=class_key,json=classKey,proto3" json:"class_key,omitempty"`
+	// percentage is the decimal percentage (between 0 and 100) of the tradable
+	// credits of each issuance that is withheld into the buffer pool.
+	Percentage string `protobuf:"bytes,2,opt,name=percentage,proto3" json:"percentage,omitempty"`
 }
 
-func (m *BatchSequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NextSequence != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.NextSequence))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ProjectKey != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.ProjectKey))
-		i--
-		dAtA[i] = 0x8
+func (m *ClassBuffer) Reset()         { *m = ClassBuffer{} }
+func (m *ClassBuffer) String() string { return proto.CompactTextString(m) }
+func (*ClassBuffer) ProtoMessage()    {}
+func (*ClassBuffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cfdca0a4aaabb36, []int{15}
+}
+func (m *ClassBuffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClassBuffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClassBuffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ClassBuffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClassBuffer.Merge(m, src)
+}
+func (m *ClassBuffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClassBuffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClassBuffer.DiscardUnknown(m)
 }
 
-func (m *BatchBalance) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ClassBuffer proto.InternalMessageInfo
+
+func (m *ClassBuffer) GetClassKey() uint64 {
+	if m != nil {
+		return m.ClassKey
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *BatchBalance) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ClassBuffer) GetPercentage() string {
+	if m != nil {
+		return m.Percentage
+	}
+	return ""
 }
 
-func (m *BatchBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EscrowedAmount) > 0 {
-		i -= len(m.EscrowedAmount)
-		copy(dAtA[i:], m.EscrowedAmount)
-		i = encodeVarintState(dAtA, i, uint64(len(m.EscrowedAmount)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.RetiredAmount) > 0 {
-		i -= len(m.RetiredAmount)
-		copy(dAtA[i:], m.RetiredAmount)
-		i = encodeVarintState(dAtA, i, uint64(len(m.RetiredAmount)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.TradableAmount) > 0 {
-		i -= len(m.TradableAmount)
-		copy(dAtA[i:], m.TradableAmount)
-		i = encodeVarintState(dAtA, i, uint64(len(m.TradableAmount)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.BatchKey != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
-		i--
-		dAtA[i] = 0x8
+// Reversal stores a reversal reported against a project and the buffer pool
+// credits that were cancelled to account for the reversal.
+type Reversal struct {
+	// id is the unique identifier of the reversal record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// project_key is the table row identifier of the project against which the
+	// reversal was reported.
+	ProjectKey uint64 `protobuf:"varint,2,opt,name=project_key,json=projectKey,proto3" json:"project_key,omitempty"`
+	// batch_key is the table row identifier of the credit batch from which
+	// buffer pool credits were cancelled.
+	BatchKey uint64 `protobuf:"varint,3,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// amount is the decimal number of buffer pool credits that were cancelled.
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// evidence is the IRI of the evidence supporting the reversal.
+	Evidence string `protobuf:"bytes,5,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	// reporter is the address of the account that reported the reversal.
+	Reporter []byte `protobuf:"bytes,6,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	// block_height is the height of the block in which the reversal was
+	// reported.
+	BlockHeight int64 `protobuf:"varint,7,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// timestamp is the time of the block in which the reversal was reported.
+	Timestamp *types.Timestamp `protobuf:"bytes,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *Reversal) Reset()         { *m = Reversal{} }
+func (m *Reversal) String() string { return proto.CompactTextString(m) }
+func (*Reversal) ProtoMessage()    {}
+func (*Reversal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cfdca0a4aaabb36, []int{16}
+}
+func (m *Reversal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Reversal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Reversal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *Reversal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Reversal.Merge(m, src)
+}
+func (m *Reversal) XXX_Size() int {
+	return m.Size()
+}
+func (m *Reversal) XXX_DiscardUnknown() {
+	xxx_messageInfo_Reversal.DiscardUnknown(m)
 }
 
-func (m *BatchSupply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Reversal proto.InternalMessageInfo
+
+func (m *Reversal) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *BatchSupply) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Reversal) GetProjectKey() uint64 {
+	if m != nil {
+		return m.ProjectKey
+	}
+	return 0
 }
 
-func (m *BatchSupply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CancelledAmount) > 0 {
-		i -= len(m.CancelledAmount)
-		copy(dAtA[i:], m.CancelledAmount)
-		i = encodeVarintState(dAtA, i, uint64(len(m.CancelledAmount)))
-		i--
-		dAtA[i] = 0x22
+func (m *Reversal) GetBatchKey() uint64 {
+	if m != nil {
+		return m.BatchKey
 	}
-	if len(m.RetiredAmount) > 0 {
-		i -= len(m.RetiredAmount)
-		copy(dAtA[i:], m.RetiredAmount)
-		i = encodeVarintState(dAtA, i, uint64(len(m.RetiredAmount)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *Reversal) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	if len(m.TradableAmount) > 0 {
-		i -= len(m.TradableAmount)
-		copy(dAtA[i:], m.TradableAmount)
-		i = encodeVarintState(dAtA, i, uint64(len(m.TradableAmount)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *Reversal) GetEvidence() string {
+	if m != nil {
+		return m.Evidence
 	}
-	if m.BatchKey != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *Reversal) GetReporter() []byte {
+	if m != nil {
+		return m.Reporter
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *OriginTxIndex) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Reversal) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *OriginTxIndex) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Reversal) GetTimestamp() *types.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
 }
 
-func (m *OriginTxIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0x1a
+// ProjectStatusChange stores a change of the lifecycle status of a project.
+type ProjectStatusChange struct {
+	// id is the unique identifier of the status change.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// project_key is the table row identifier of the project.
+	ProjectKey uint64 `protobuf:"varint,2,opt,name=project_key,json=projectKey,proto3" json:"project_key,omitempty"`
+	// previous_status is the status of the project before the change.
+	PreviousStatus ProjectStatus `protobuf:"varint,3,opt,name=previous_status,json=previousStatus,proto3,enum=regen.ecocredit.v1.ProjectStatus" json:"previous_status,omitempty"`
+	// status is the status of the project after the change.
+	Status ProjectStatus `protobuf:"varint,4,opt,name=status,proto3,enum=regen.ecocredit.v1.ProjectStatus" json:"status,omitempty"`
+	// reason is the IRI of the document describing the reason for the change.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// admin is the address of the credit class admin that changed the status.
+	Admin []byte `protobuf:"bytes,6,opt,name=admin,proto3" json:"admin,omitempty"`
+	// block_height is the height of the block in which the status changed.
+	BlockHeight int64 `protobuf:"varint,7,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// timestamp is the time of the block in which the status changed.
+	Timestamp *types.Timestamp `protobuf:"bytes,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *ProjectStatusChange) Reset()         { *m = ProjectStatusChange{} }
+func (m *ProjectStatusChange) String() string { return proto.CompactTextString(m) }
+func (*ProjectStatusChange) ProtoMessage()    {}
+func (*ProjectStatusChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cfdca0a4aaabb36, []int{17}
+}
+func (m *ProjectStatusChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectStatusChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectStatusChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *ProjectStatusChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectStatusChange.Merge(m, src)
+}
+func (m *ProjectStatusChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectStatusChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectStatusChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectStatusChange proto.InternalMessageInfo
+
+func (m *ProjectStatusChange) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if m.ClassKey != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *ProjectStatusChange) GetProjectKey() uint64 {
+	if m != nil {
+		return m.ProjectKey
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *BatchContract) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ProjectStatusChange) GetPreviousStatus() ProjectStatus {
+	if m != nil {
+		return m.PreviousStatus
 	}
-	return dAtA[:n], nil
+	return ProjectStatus_PROJECT_STATUS_UNSPECIFIED
 }
 
-func (m *BatchContract) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ProjectStatusChange) GetStatus() ProjectStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ProjectStatus_PROJECT_STATUS_UNSPECIFIED
 }
 
-func (m *BatchContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Contract)))
-		i--
-		dAtA[i] = 0x1a
+func (m *ProjectStatusChange) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	if m.ClassKey != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *ProjectStatusChange) GetAdmin() []byte {
+	if m != nil {
+		return m.Admin
 	}
-	if m.BatchKey != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *ProjectStatusChange) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintState(dAtA []byte, offset int, v uint64) int {
-	offset -= sovState(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ProjectStatusChange) GetTimestamp() *types.Timestamp {
+	if m != nil {
+		return m.Timestamp
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *CreditType) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Abbreviation)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+
+// ClassMetadataChange stores a change of the metadata of a credit class.
+type ClassMetadataChange struct {
+	// id is the unique identifier of the metadata change.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// class_key is the table row identifier of the credit class.
+	ClassKey uint64 `protobuf:"varint,2,opt,name=class_key,json=classKey,proto3" json:"class_key,omitempty"`
+	// previous_metadata is the metadata of the credit class before the change.
+	PreviousMetadata string `protobuf:"bytes,3,opt,name=previous_metadata,json=previousMetadata,proto3" json:"previous_metadata,omitempty"`
+	// metadata is the metadata of the credit class after the change.
+	Metadata string `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// signer is the address of the account that changed the metadata.
+	Signer []byte `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+	// block_height is the height of the block in which the metadata changed.
+	BlockHeight int64 `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// timestamp is the time of the block in which the metadata changed.
+	Timestamp *types.Timestamp `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *ClassMetadataChange) Reset()         { *m = ClassMetadataChange{} }
+func (m *ClassMetadataChange) String() string { return proto.CompactTextString(m) }
+func (*ClassMetadataChange) ProtoMessage()    {}
+func (*ClassMetadataChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cfdca0a4aaabb36, []int{18}
+}
+func (m *ClassMetadataChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClassMetadataChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClassMetadataChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+}
+func (m *ClassMetadataChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClassMetadataChange.Merge(m, src)
+}
+func (m *ClassMetadataChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClassMetadataChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClassMetadataChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClassMetadataChange proto.InternalMessageInfo
+
+func (m *ClassMetadataChange) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	l = len(m.Unit)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	return 0
+}
+
+func (m *ClassMetadataChange) GetClassKey() uint64 {
+	if m != nil {
+		return m.ClassKey
 	}
-	if m.Precision != 0 {
-		n += 1 + sovState(uint64(m.Precision))
+	return 0
+}
+
+func (m *ClassMetadataChange) GetPreviousMetadata() string {
+	if m != nil {
+		return m.PreviousMetadata
 	}
-	return n
+	return ""
 }
 
-func (m *Class) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ClassMetadataChange) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
 	}
-	var l int
-	_ = l
-	if m.Key != 0 {
-		n += 1 + sovState(uint64(m.Key))
+	return ""
+}
+
+func (m *ClassMetadataChange) GetSigner() []byte {
+	if m != nil {
+		return m.Signer
 	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	return nil
+}
+
+func (m *ClassMetadataChange) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	return 0
+}
+
+func (m *ClassMetadataChange) GetTimestamp() *types.Timestamp {
+	if m != nil {
+		return m.Timestamp
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	return nil
+}
+
+// ProjectMetadataChange stores a change of the metadata of a project.
+type ProjectMetadataChange struct {
+	// id is the unique identifier of the metadata change.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// project_key is the table row identifier of the project.
+	ProjectKey uint64 `protobuf:"varint,2,opt,name=project_key,json=projectKey,proto3" json:"project_key,omitempty"`
+	// previous_metadata is the metadata of the project before the change.
+	PreviousMetadata string `protobuf:"bytes,3,opt,name=previous_metadata,json=previousMetadata,proto3" json:"previous_metadata,omitempty"`
+	// metadata is the metadata of the project after the change.
+	Metadata string `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// signer is the address of the account that changed the metadata.
+	Signer []byte `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+	// block_height is the height of the block in which the metadata changed.
+	BlockHeight int64 `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// timestamp is the time of the block in which the metadata changed.
+	Timestamp *types.Timestamp `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *ProjectMetadataChange) Reset()         { *m = ProjectMetadataChange{} }
+func (m *ProjectMetadataChange) String() string { return proto.CompactTextString(m) }
+func (*ProjectMetadataChange) ProtoMessage()    {}
+func (*ProjectMetadataChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cfdca0a4aaabb36, []int{19}
+}
+func (m *ProjectMetadataChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectMetadataChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectMetadataChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.CreditTypeAbbrev)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+}
+func (m *ProjectMetadataChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectMetadataChange.Merge(m, src)
+}
+func (m *ProjectMetadataChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectMetadataChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectMetadataChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectMetadataChange proto.InternalMessageInfo
+
+func (m *ProjectMetadataChange) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return n
+	return 0
 }
 
-func (m *ClassIssuer) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProjectMetadataChange) GetProjectKey() uint64 {
+	if m != nil {
+		return m.ProjectKey
 	}
-	var l int
-	_ = l
-	if m.ClassKey != 0 {
-		n += 1 + sovState(uint64(m.ClassKey))
+	return 0
+}
+
+func (m *ProjectMetadataChange) GetPreviousMetadata() string {
+	if m != nil {
+		return m.PreviousMetadata
 	}
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	return ""
+}
+
+func (m *ProjectMetadataChange) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
 	}
-	return n
+	return ""
 }
 
-func (m *Project) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProjectMetadataChange) GetSigner() []byte {
+	if m != nil {
+		return m.Signer
 	}
-	var l int
-	_ = l
-	if m.Key != 0 {
-		n += 1 + sovState(uint64(m.Key))
+	return nil
+}
+
+func (m *ProjectMetadataChange) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	return 0
+}
+
+func (m *ProjectMetadataChange) GetTimestamp() *types.Timestamp {
+	if m != nil {
+		return m.Timestamp
 	}
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	return nil
+}
+
+// BatchMetadataChange stores a change of the metadata of a credit batch.
+type BatchMetadataChange struct {
+	// id is the unique identifier of the metadata change.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// batch_key is the table row identifier of the credit batch.
+	BatchKey uint64 `protobuf:"varint,2,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// previous_metadata is the metadata of the credit batch before the change.
+	PreviousMetadata string `protobuf:"bytes,3,opt,name=previous_metadata,json=previousMetadata,proto3" json:"previous_metadata,omitempty"`
+	// metadata is the metadata of the credit batch after the change.
+	Metadata string `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// signer is the address of the account that changed the metadata.
+	Signer []byte `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+	// block_height is the height of the block in which the metadata changed.
+	BlockHeight int64 `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// timestamp is the time of the block in which the metadata changed.
+	Timestamp *types.Timestamp `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *BatchMetadataChange) Reset()         { *m = BatchMetadataChange{} }
+func (m *BatchMetadataChange) String() string { return proto.CompactTextString(m) }
+func (*BatchMetadataChange) ProtoMessage()    {}
+func (*BatchMetadataChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cfdca0a4aaabb36, []int{20}
+}
+func (m *BatchMetadataChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchMetadataChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchMetadataChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.ClassKey != 0 {
-		n += 1 + sovState(uint64(m.ClassKey))
+}
+func (m *BatchMetadataChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchMetadataChange.Merge(m, src)
+}
+func (m *BatchMetadataChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchMetadataChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchMetadataChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchMetadataChange proto.InternalMessageInfo
+
+func (m *BatchMetadataChange) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	l = len(m.Jurisdiction)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	return 0
+}
+
+func (m *BatchMetadataChange) GetBatchKey() uint64 {
+	if m != nil {
+		return m.BatchKey
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	return 0
+}
+
+func (m *BatchMetadataChange) GetPreviousMetadata() string {
+	if m != nil {
+		return m.PreviousMetadata
 	}
-	l = len(m.ReferenceId)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	return ""
+}
+
+func (m *BatchMetadataChange) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
 	}
-	return n
+	return ""
 }
 
-func (m *Batch) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BatchMetadataChange) GetSigner() []byte {
+	if m != nil {
+		return m.Signer
 	}
-	var l int
-	_ = l
-	if m.Key != 0 {
-		n += 1 + sovState(uint64(m.Key))
+	return nil
+}
+
+func (m *BatchMetadataChange) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	return 0
+}
+
+func (m *BatchMetadataChange) GetTimestamp() *types.Timestamp {
+	if m != nil {
+		return m.Timestamp
 	}
-	if m.ProjectKey != 0 {
-		n += 1 + sovState(uint64(m.ProjectKey))
+	return nil
+}
+
+// BridgeOperatorSet stores the set of bridge operators that must attest to
+// credits being bridged from another chain or registry before the credits are
+// issued within a credit class. When a bridge operator set exists for a credit
+// class, credits can no longer be bridged into the credit class with
+// Msg/BridgeReceive and must instead be attested with Msg/AttestBridgeReceive.
+type BridgeOperatorSet struct {
+	// class_key is the table row identifier of the credit class.
+	ClassKey uint64 `protobuf:"varint,1,opt,name=class_key,json=classKey,proto3" json:"class_key,omitempty"`
+	// source is the source chain or registry to which the bridge operator set
+	// applies. An empty source applies to any source chain or registry without
+	// a bridge operator set of its own.
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// operators are the addresses of the accounts of the bridge operators.
+	Operators [][]byte `protobuf:"bytes,3,rep,name=operators,proto3" json:"operators,omitempty"`
+	// threshold is the number of bridge operators that must attest to the same
+	// origin transaction before credits are issued.
+	Threshold uint32 `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// timeout is the duration after the first attestation within which the
+	// threshold must be reached, otherwise the pending attestation expires.
+	Timeout *types.Duration `protobuf:"bytes,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// outbound_timeout is the duration after credits are bridged to the source
+	// chain or registry within which a bridge operator must acknowledge the
+	// outbound bridge request, otherwise the credits are refunded. If not set,
+	// outbound bridge requests do not time out.
+	OutboundTimeout *types.Duration `protobuf:"bytes,6,opt,name=outbound_timeout,json=outboundTimeout,proto3" json:"outbound_timeout,omitempty"`
+}
+
+func (m *BridgeOperatorSet) Reset()         { *m = BridgeOperatorSet{} }
+func (m *BridgeOperatorSet) String() string { return proto.CompactTextString(m) }
+func (*BridgeOperatorSet) ProtoMessage()    {}
+func (*BridgeOperatorSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cfdca0a4aaabb36, []int{21}
+}
+func (m *BridgeOperatorSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeOperatorSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeOperatorSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
-	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+}
+func (m *BridgeOperatorSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeOperatorSet.Merge(m, src)
+}
+func (m *BridgeOperatorSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeOperatorSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeOperatorSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeOperatorSet proto.InternalMessageInfo
+
+func (m *BridgeOperatorSet) GetClassKey() uint64 {
+	if m != nil {
+		return m.ClassKey
 	}
-	if m.StartDate != nil {
-		l = m.StartDate.Size()
-		n += 1 + l + sovState(uint64(l))
+	return 0
+}
+
+func (m *BridgeOperatorSet) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	if m.EndDate != nil {
-		l = m.EndDate.Size()
-		n += 1 + l + sovState(uint64(l))
+	return ""
+}
+
+func (m *BridgeOperatorSet) GetOperators() [][]byte {
+	if m != nil {
+		return m.Operators
 	}
-	if m.IssuanceDate != nil {
-		l = m.IssuanceDate.Size()
-		n += 1 + l + sovState(uint64(l))
+	return nil
+}
+
+func (m *BridgeOperatorSet) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
 	}
-	if m.Open {
-		n += 2
+	return 0
+}
+
+func (m *BridgeOperatorSet) GetTimeout() *types.Duration {
+	if m != nil {
+		return m.Timeout
 	}
-	return n
+	return nil
 }
 
-func (m *ClassSequence) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BridgeOperatorSet) GetOutboundTimeout() *types.Duration {
+	if m != nil {
+		return m.OutboundTimeout
 	}
-	var l int
-	_ = l
-	l = len(m.CreditTypeAbbrev)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	return nil
+}
+
+// BridgeAttestation stores a pending attestation of credits being bridged from
+// another chain or registry that has not yet reached the threshold of the
+// bridge operator set.
+type BridgeAttestation struct {
+	// class_key is the table row identifier of the credit class within which the
+	// credits will be issued.
+	ClassKey uint64 `protobuf:"varint,1,opt,name=class_key,json=classKey,proto3" json:"class_key,omitempty"`
+	// id is the transaction ID of the originating transaction.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// source is the source chain or registry of the originating transaction.
+	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	// content_hash is the hash of the attested bridge receive content. Each
+	// bridge operator must attest to the same content.
+	ContentHash []byte `protobuf:"bytes,4,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	// attestors are the addresses of the accounts of the bridge operators that
+	// have attested.
+	Attestors [][]byte `protobuf:"bytes,5,rep,name=attestors,proto3" json:"attestors,omitempty"`
+	// expiration is the time at which the pending attestation expires.
+	Expiration *types.Timestamp `protobuf:"bytes,6,opt,name=expiration,proto3" json:"expiration,omitempty"`
+}
+
+func (m *BridgeAttestation) Reset()         { *m = BridgeAttestation{} }
+func (m *BridgeAttestation) String() string { return proto.CompactTextString(m) }
+func (*BridgeAttestation) ProtoMessage()    {}
+func (*BridgeAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cfdca0a4aaabb36, []int{22}
+}
+func (m *BridgeAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.NextSequence != 0 {
-		n += 1 + sovState(uint64(m.NextSequence))
+}
+func (m *BridgeAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeAttestation.Merge(m, src)
+}
+func (m *BridgeAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeAttestation proto.InternalMessageInfo
+
+func (m *BridgeAttestation) GetClassKey() uint64 {
+	if m != nil {
+		return m.ClassKey
 	}
-	return n
+	return 0
 }
 
-func (m *ProjectSequence) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BridgeAttestation) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	var l int
-	_ = l
-	if m.ClassKey != 0 {
-		n += 1 + sovState(uint64(m.ClassKey))
+	return ""
+}
+
+func (m *BridgeAttestation) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	if m.NextSequence != 0 {
-		n += 1 + sovState(uint64(m.NextSequence))
+	return ""
+}
+
+func (m *BridgeAttestation) GetContentHash() []byte {
+	if m != nil {
+		return m.ContentHash
 	}
-	return n
+	return nil
 }
 
-func (m *BatchSequence) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BridgeAttestation) GetAttestors() [][]byte {
+	if m != nil {
+		return m.Attestors
 	}
-	var l int
-	_ = l
-	if m.ProjectKey != 0 {
-		n += 1 + sovState(uint64(m.ProjectKey))
+	return nil
+}
+
+func (m *BridgeAttestation) GetExpiration() *types.Timestamp {
+	if m != nil {
+		return m.Expiration
 	}
-	if m.NextSequence != 0 {
-		n += 1 + sovState(uint64(m.NextSequence))
+	return nil
+}
+
+// OutboundBridgeRequest stores credits bridged to another chain or registry and
+// tracks whether the transfer has been completed on the target chain. Failed or
+// timed out requests are refunded to the owner.
+type OutboundBridgeRequest struct {
+	// id is the unique identifier of the outbound bridge request.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address of the account that bridged the credits.
+	Owner []byte `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// batch_key is the table row identifier of the credit batch of the bridged
+	// credits.
+	BatchKey uint64 `protobuf:"varint,3,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// amount is the number of credits bridged.
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// target is the name of the target chain or registry.
+	Target string `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty"`
+	// recipient is the address of the account receiving the bridged credits on
+	// the target chain or registry.
+	Recipient string `protobuf:"bytes,6,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// contract is the contract address on the target chain to which the credits
+	// are bridged.
+	Contract string `protobuf:"bytes,7,opt,name=contract,proto3" json:"contract,omitempty"`
+	// status is the status of the outbound bridge request.
+	Status OutboundBridgeStatus `protobuf:"varint,8,opt,name=status,proto3,enum=regen.ecocredit.v1.OutboundBridgeStatus" json:"status,omitempty"`
+	// target_tx_hash is the hash of the transaction on the target chain that
+	// completed the transfer.
+	TargetTxHash string `protobuf:"bytes,9,opt,name=target_tx_hash,json=targetTxHash,proto3" json:"target_tx_hash,omitempty"`
+	// timestamp is the time of the block in which the credits were bridged.
+	Timestamp *types.Timestamp `protobuf:"bytes,10,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// expiration is the time at which a pending outbound bridge request is
+	// refunded. The expiration is removed once the request is no longer pending.
+	Expiration *types.Timestamp `protobuf:"bytes,11,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// failure_attestors are the addresses of the accounts of the bridge
+	// operators that have acknowledged the request as failed. The credits are
+	// refunded once the number of failure attestors reaches the threshold of
+	// the bridge operator set.
+	FailureAttestors [][]byte `protobuf:"bytes,12,rep,name=failure_attestors,json=failureAttestors,proto3" json:"failure_attestors,omitempty"`
+}
+
+func (m *OutboundBridgeRequest) Reset()         { *m = OutboundBridgeRequest{} }
+func (m *OutboundBridgeRequest) String() string { return proto.CompactTextString(m) }
+func (*OutboundBridgeRequest) ProtoMessage()    {}
+func (*OutboundBridgeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cfdca0a4aaabb36, []int{23}
+}
+func (m *OutboundBridgeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutboundBridgeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutboundBridgeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *OutboundBridgeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutboundBridgeRequest.Merge(m, src)
+}
+func (m *OutboundBridgeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutboundBridgeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutboundBridgeRequest.DiscardUnknown(m)
 }
 
-func (m *BatchBalance) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_OutboundBridgeRequest proto.InternalMessageInfo
+
+func (m *OutboundBridgeRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	var l int
-	_ = l
-	if m.BatchKey != 0 {
-		n += 1 + sovState(uint64(m.BatchKey))
+	return 0
+}
+
+func (m *OutboundBridgeRequest) GetOwner() []byte {
+	if m != nil {
+		return m.Owner
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	return nil
+}
+
+func (m *OutboundBridgeRequest) GetBatchKey() uint64 {
+	if m != nil {
+		return m.BatchKey
 	}
-	l = len(m.TradableAmount)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	return 0
+}
+
+func (m *OutboundBridgeRequest) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	l = len(m.RetiredAmount)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	return ""
+}
+
+func (m *OutboundBridgeRequest) GetTarget() string {
+	if m != nil {
+		return m.Target
 	}
-	l = len(m.EscrowedAmount)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	return ""
+}
+
+func (m *OutboundBridgeRequest) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	return n
+	return ""
 }
 
-func (m *BatchSupply) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OutboundBridgeRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
 	}
-	var l int
-	_ = l
-	if m.BatchKey != 0 {
-		n += 1 + sovState(uint64(m.BatchKey))
+	return ""
+}
+
+func (m *OutboundBridgeRequest) GetStatus() OutboundBridgeStatus {
+	if m != nil {
+		return m.Status
 	}
-	l = len(m.TradableAmount)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	return OutboundBridgeStatus_OUTBOUND_BRIDGE_STATUS_UNSPECIFIED
+}
+
+func (m *OutboundBridgeRequest) GetTargetTxHash() string {
+	if m != nil {
+		return m.TargetTxHash
 	}
-	l = len(m.RetiredAmount)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	return ""
+}
+
+func (m *OutboundBridgeRequest) GetTimestamp() *types.Timestamp {
+	if m != nil {
+		return m.Timestamp
 	}
-	l = len(m.CancelledAmount)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	return nil
+}
+
+func (m *OutboundBridgeRequest) GetExpiration() *types.Timestamp {
+	if m != nil {
+		return m.Expiration
 	}
-	return n
+	return nil
 }
 
-func (m *OriginTxIndex) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OutboundBridgeRequest) GetFailureAttestors() [][]byte {
+	if m != nil {
+		return m.FailureAttestors
 	}
-	var l int
-	_ = l
-	if m.ClassKey != 0 {
-		n += 1 + sovState(uint64(m.ClassKey))
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("regen.ecocredit.v1.ProjectStatus", ProjectStatus_name, ProjectStatus_value)
+	proto.RegisterEnum("regen.ecocredit.v1.OutboundBridgeStatus", OutboundBridgeStatus_name, OutboundBridgeStatus_value)
+	proto.RegisterType((*CreditType)(nil), "regen.ecocredit.v1.CreditType")
+	proto.RegisterType((*Class)(nil), "regen.ecocredit.v1.Class")
+	proto.RegisterType((*ClassIssuer)(nil), "regen.ecocredit.v1.ClassIssuer")
+	proto.RegisterType((*Project)(nil), "regen.ecocredit.v1.Project")
+	proto.RegisterType((*Batch)(nil), "regen.ecocredit.v1.Batch")
+	proto.RegisterType((*ClassSequence)(nil), "regen.ecocredit.v1.ClassSequence")
+	proto.RegisterType((*ProjectSequence)(nil), "regen.ecocredit.v1.ProjectSequence")
+	proto.RegisterType((*BatchSequence)(nil), "regen.ecocredit.v1.BatchSequence")
+	proto.RegisterType((*BatchBalance)(nil), "regen.ecocredit.v1.BatchBalance")
+	proto.RegisterType((*BatchSupply)(nil), "regen.ecocredit.v1.BatchSupply")
+	proto.RegisterType((*OriginTxIndex)(nil), "regen.ecocredit.v1.OriginTxIndex")
+	proto.RegisterType((*BatchContract)(nil), "regen.ecocredit.v1.BatchContract")
+	proto.RegisterType((*Retirement)(nil), "regen.ecocredit.v1.Retirement")
+	proto.RegisterType((*BatchAllowance)(nil), "regen.ecocredit.v1.BatchAllowance")
+	proto.RegisterType((*BatchLock)(nil), "regen.ecocredit.v1.BatchLock")
+	proto.RegisterType((*ClassBuffer)(nil), "regen.ecocredit.v1.ClassBuffer")
+	proto.RegisterType((*Reversal)(nil), "regen.ecocredit.v1.Reversal")
+	proto.RegisterType((*ProjectStatusChange)(nil), "regen.ecocredit.v1.ProjectStatusChange")
+	proto.RegisterType((*ClassMetadataChange)(nil), "regen.ecocredit.v1.ClassMetadataChange")
+	proto.RegisterType((*ProjectMetadataChange)(nil), "regen.ecocredit.v1.ProjectMetadataChange")
+	proto.RegisterType((*BatchMetadataChange)(nil), "regen.ecocredit.v1.BatchMetadataChange")
+	proto.RegisterType((*BridgeOperatorSet)(nil), "regen.ecocredit.v1.BridgeOperatorSet")
+	proto.RegisterType((*BridgeAttestation)(nil), "regen.ecocredit.v1.BridgeAttestation")
+	proto.RegisterType((*OutboundBridgeRequest)(nil), "regen.ecocredit.v1.OutboundBridgeRequest")
+}
+
+func init() { proto.RegisterFile("regen/ecocredit/v1/state.proto", fileDescriptor_6cfdca0a4aaabb36) }
+
+var fileDescriptor_6cfdca0a4aaabb36 = []byte{
+	// 2149 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xcd, 0x6f, 0x23, 0x49,
+	0x15, 0x9f, 0xee, 0xf6, 0xe7, 0xf3, 0x57, 0xa7, 0x32, 0xc9, 0xf6, 0x64, 0x66, 0x3d, 0x33, 0xbd,
+	0x03, 0x93, 0x5d, 0x42, 0xac, 0x99, 0xdd, 0x45, 0xbb, 0x46, 0x02, 0x92, 0xd8, 0x33, 0xeb, 0xdd,
+	0x99, 0x49, 0xd4, 0x71, 0x38, 0xec, 0xc5, 0xb4, 0xbb, 0x2b, 0x76, 0xcf, 0xd8, 0xdd, 0xa6, 0xba,
+	0x9d, 0x49, 0x38, 0x72, 0xe0, 0x82, 0x84, 0x38, 0x21, 0x21, 0x2d, 0x48, 0xdc, 0x40, 0x70, 0xe0,
+	0x8c, 0x10, 0x67, 0xb8, 0xad, 0xc4, 0x85, 0x23, 0x9a, 0x91, 0xf8, 0x03, 0xb8, 0x72, 0x41, 0xf5,
+	0xd1, 0xed, 0xee, 0xb6, 0x13, 0x27, 0xcb, 0x4a, 0x08, 0x6e, 0x7e, 0xaf, 0xde, 0xab, 0x7a, 0xf5,
+	0x7b, 0xaf, 0xde, 0x47, 0x1b, 0xea, 0x04, 0x0f, 0xb0, 0xdb, 0xc0, 0x96, 0x67, 0x11, 0x6c, 0x3b,
+	0x41, 0xe3, 0xe4, 0x41, 0xc3, 0x0f, 0xcc, 0x00, 0x6f, 0x4f, 0x88, 0x17, 0x78, 0x08, 0xb1, 0xf5,
+	0xed, 0x68, 0x7d, 0xfb, 0xe4, 0xc1, 0xc6, 0x9b, 0x96, 0xe7, 0x8f, 0x3d, 0xbf, 0xe1, 0x91, 0x71,
+	0xe3, 0xe4, 0x81, 0x39, 0x9a, 0x0c, 0xcd, 0x07, 0x94, 0xe0, 0x2a, 0x1b, 0xf5, 0x81, 0xe7, 0x0d,
+	0x46, 0xb8, 0xc1, 0xa8, 0xfe, 0xf4, 0xb8, 0x61, 0x4f, 0x89, 0x19, 0x38, 0x9e, 0x2b, 0xd6, 0x6f,
+	0xa7, 0xd7, 0x03, 0x67, 0x8c, 0xfd, 0xc0, 0x1c, 0x4f, 0xb8, 0x80, 0xfe, 0x99, 0x04, 0xb0, 0xc7,
+	0x4e, 0xeb, 0x9e, 0x4d, 0x30, 0xd2, 0xa1, 0x6c, 0xf6, 0xfb, 0x04, 0x9f, 0x38, 0x6c, 0x17, 0x4d,
+	0xba, 0x23, 0x6d, 0x16, 0x8d, 0x04, 0x0f, 0x21, 0xc8, 0xb8, 0xe6, 0x18, 0x6b, 0x32, 0x5b, 0x63,
+	0xbf, 0x29, 0x6f, 0xea, 0x3a, 0x81, 0xa6, 0x70, 0x1e, 0xfd, 0x8d, 0x6e, 0x41, 0x71, 0x42, 0xb0,
+	0xe5, 0xf8, 0x74, 0xa3, 0xcc, 0x1d, 0x69, 0xb3, 0x62, 0xcc, 0x18, 0xcd, 0x7b, 0xff, 0xfc, 0xe5,
+	0x5f, 0x7f, 0xa2, 0xd4, 0xa1, 0x9a, 0x3c, 0x11, 0x01, 0xdf, 0x5d, 0x95, 0x34, 0x49, 0x93, 0xf4,
+	0xbf, 0x48, 0x90, 0xdd, 0x1b, 0x99, 0xbe, 0x8f, 0x54, 0x50, 0x5e, 0xe0, 0x33, 0x66, 0x50, 0xc6,
+	0xa0, 0x3f, 0x51, 0x15, 0x64, 0xc7, 0x16, 0x56, 0xc8, 0x8e, 0x8d, 0xae, 0x43, 0xd6, 0xb4, 0xc7,
+	0x8e, 0xcb, 0x8c, 0x28, 0x1b, 0x9c, 0x40, 0x1b, 0x50, 0x18, 0xe3, 0xc0, 0xb4, 0xcd, 0xc0, 0x64,
+	0x46, 0x14, 0x8d, 0x88, 0x46, 0x5b, 0x80, 0x38, 0xd2, 0xbd, 0xe0, 0x6c, 0x82, 0x7b, 0xdc, 0x0e,
+	0x2d, 0xcb, 0xa4, 0x54, 0x2b, 0x42, 0x65, 0x87, 0xf1, 0x9b, 0xdf, 0x62, 0x16, 0x7f, 0x00, 0x79,
+	0x66, 0x89, 0x2a, 0xa1, 0x02, 0x35, 0x80, 0x1a, 0x8a, 0x8a, 0xe2, 0x68, 0x55, 0x46, 0xeb, 0x8b,
+	0xf6, 0x54, 0x15, 0x4d, 0xd6, 0xbf, 0x07, 0x25, 0x76, 0x95, 0x8e, 0xef, 0x4f, 0x31, 0x41, 0x37,
+	0xa1, 0x68, 0x51, 0xb2, 0x37, 0xbb, 0x56, 0x81, 0x31, 0x3e, 0xc1, 0x67, 0x68, 0x1d, 0x72, 0x0e,
+	0x13, 0x63, 0xf7, 0x2b, 0x1b, 0x82, 0x6a, 0xde, 0x62, 0x36, 0xac, 0x03, 0x02, 0x35, 0x52, 0xde,
+	0x12, 0x92, 0x8a, 0xfe, 0x0f, 0x19, 0xf2, 0x07, 0xc4, 0x7b, 0x8e, 0xad, 0xe0, 0x0b, 0xe3, 0x95,
+	0x30, 0x2b, 0x93, 0x32, 0x4b, 0x87, 0xf2, 0xf3, 0x29, 0x71, 0x7c, 0xdb, 0xb1, 0x58, 0x78, 0x70,
+	0xa8, 0x12, 0xbc, 0x04, 0xe0, 0xb9, 0x14, 0xe0, 0x77, 0xa1, 0x4c, 0xf0, 0x31, 0x26, 0xd8, 0xb5,
+	0x70, 0xcf, 0xb1, 0xb5, 0x3c, 0x5b, 0x2f, 0x45, 0xbc, 0x8e, 0x8d, 0x3e, 0x84, 0x1c, 0x7d, 0x13,
+	0x53, 0x5f, 0x2b, 0xdc, 0x91, 0x36, 0xab, 0x0f, 0xef, 0x6e, 0xcf, 0xbf, 0x8a, 0x6d, 0x71, 0xc9,
+	0x43, 0x26, 0x68, 0x08, 0x85, 0xe6, 0x90, 0x81, 0xd3, 0x5f, 0xe4, 0x20, 0x04, 0xe5, 0x18, 0x5e,
+	0xb6, 0x2a, 0xc7, 0x9d, 0xa6, 0x20, 0x35, 0x69, 0x97, 0x9a, 0x41, 0x1b, 0xb0, 0x3e, 0x53, 0x48,
+	0xac, 0x65, 0xb5, 0x8c, 0xfe, 0x7b, 0x05, 0xb2, 0xbb, 0x66, 0x60, 0x0d, 0x17, 0xc0, 0x7c, 0x8e,
+	0xeb, 0xd0, 0x6d, 0x28, 0x4d, 0xb8, 0xd9, 0x0c, 0x5a, 0x85, 0x69, 0x80, 0x60, 0x51, 0x70, 0xaf,
+	0x43, 0xd6, 0xc6, 0xae, 0x37, 0x16, 0x61, 0xca, 0x89, 0x04, 0x9c, 0xd9, 0x14, 0x9c, 0x1f, 0x02,
+	0xf8, 0x81, 0x49, 0x82, 0x9e, 0x6d, 0x06, 0x98, 0x81, 0x5d, 0x7a, 0xb8, 0xb1, 0xcd, 0x9f, 0xfc,
+	0x76, 0xf8, 0xe4, 0xb7, 0xbb, 0xe1, 0x93, 0x37, 0x8a, 0x4c, 0xba, 0x65, 0x06, 0x18, 0xbd, 0x0f,
+	0x05, 0xec, 0xda, 0x5c, 0x31, 0xbf, 0x54, 0x31, 0x8f, 0x5d, 0x9b, 0xa9, 0x7d, 0x1b, 0x2a, 0xf4,
+	0x3a, 0x26, 0xc5, 0x82, 0xe9, 0x16, 0x96, 0xea, 0x96, 0x43, 0x05, 0xb6, 0x01, 0x82, 0x8c, 0x37,
+	0xc1, 0xae, 0x56, 0xbc, 0x23, 0x6d, 0x16, 0x0c, 0xf6, 0x9b, 0x22, 0x76, 0x4c, 0xbc, 0x1f, 0x60,
+	0x57, 0x03, 0xc6, 0x15, 0x54, 0xf3, 0x13, 0xe6, 0xcf, 0xf6, 0xcc, 0x9f, 0x25, 0x81, 0x10, 0x73,
+	0x69, 0x2d, 0x81, 0xa7, 0x2a, 0xa3, 0x6a, 0x1c, 0x0d, 0x55, 0x41, 0x10, 0x3a, 0x42, 0xcd, 0x68,
+	0x59, 0xfd, 0x87, 0x12, 0x54, 0xd8, 0xf3, 0x3b, 0xc4, 0xdf, 0x9f, 0x52, 0x5f, 0x9e, 0xf3, 0xfa,
+	0xa5, 0xc5, 0xaf, 0x1f, 0xbd, 0x05, 0x15, 0x17, 0x9f, 0x06, 0x3d, 0x5f, 0xa8, 0x33, 0xef, 0x66,
+	0x8c, 0x32, 0x65, 0x86, 0x5b, 0x36, 0xeb, 0xcc, 0x62, 0x0d, 0xae, 0x2f, 0xdc, 0x3a, 0xa7, 0x3f,
+	0x87, 0x5a, 0x18, 0xba, 0xa1, 0x15, 0x17, 0xa6, 0x81, 0x4b, 0x1d, 0xba, 0xc6, 0x0e, 0xad, 0x41,
+	0x29, 0xbe, 0x53, 0x5e, 0x77, 0xa1, 0xc2, 0x42, 0x34, 0x3a, 0x29, 0x15, 0x80, 0xd2, 0x5c, 0x00,
+	0x5e, 0xea, 0xb4, 0x37, 0xd8, 0x69, 0x2b, 0x50, 0x49, 0xee, 0x56, 0xd0, 0x7f, 0x2e, 0x43, 0x99,
+	0x1d, 0xb8, 0x6b, 0x8e, 0x4c, 0x71, 0xb3, 0x3e, 0xa5, 0xe3, 0x37, 0x63, 0x0c, 0x7a, 0x96, 0x06,
+	0x79, 0xd3, 0xb6, 0x09, 0xf6, 0x7d, 0xf1, 0x4c, 0x42, 0x12, 0xdd, 0x87, 0x5a, 0x40, 0x4c, 0xdb,
+	0xec, 0x8f, 0x70, 0xcf, 0x1c, 0x7b, 0x53, 0x37, 0xac, 0x2a, 0xd5, 0x90, 0xbd, 0xc3, 0xb8, 0xe8,
+	0x2b, 0x50, 0x25, 0x38, 0x70, 0x08, 0xb6, 0x43, 0x39, 0xfe, 0x70, 0x2a, 0x82, 0x2b, 0xc4, 0xee,
+	0x43, 0x0d, 0xfb, 0x16, 0xf1, 0x5e, 0xce, 0xe4, 0xf8, 0x3b, 0xaa, 0x86, 0x6c, 0x21, 0xf8, 0x16,
+	0x54, 0x46, 0x9e, 0xf5, 0x62, 0x26, 0xc6, 0xb3, 0x57, 0x99, 0x33, 0xb9, 0x50, 0xf3, 0x3d, 0x76,
+	0xfd, 0x6d, 0x58, 0x85, 0x15, 0x61, 0xf0, 0x56, 0x74, 0x49, 0xb4, 0x06, 0x2b, 0x11, 0xb1, 0x25,
+	0x96, 0x55, 0x49, 0x2b, 0xea, 0x7f, 0x94, 0xa0, 0xc4, 0x9d, 0x31, 0x9d, 0x4c, 0x46, 0x67, 0x17,
+	0x43, 0xb3, 0x00, 0x00, 0xf9, 0x92, 0x00, 0x28, 0x8b, 0x00, 0x78, 0x1b, 0x54, 0x8b, 0x3a, 0x64,
+	0x34, 0x4a, 0x23, 0x55, 0x8b, 0xf8, 0xe2, 0x76, 0xb1, 0x50, 0x9a, 0xd9, 0x07, 0xfa, 0x14, 0x2a,
+	0xfb, 0xc4, 0x19, 0x38, 0x6e, 0xf7, 0xb4, 0xe3, 0xda, 0xf8, 0xf4, 0xe2, 0xa0, 0x4d, 0xd7, 0x99,
+	0x75, 0xc8, 0xf9, 0xde, 0x94, 0x58, 0x58, 0x98, 0x27, 0xa8, 0xe6, 0x6d, 0x76, 0xd8, 0x0d, 0x58,
+	0x83, 0xd5, 0x78, 0x6e, 0xde, 0x12, 0xc2, 0x25, 0xfd, 0x67, 0x92, 0x08, 0xe1, 0x3d, 0xcf, 0x0d,
+	0x88, 0x69, 0x05, 0x17, 0xe3, 0x96, 0x30, 0x4a, 0x4e, 0x19, 0xb5, 0x01, 0x05, 0x4b, 0xec, 0x22,
+	0xcc, 0x88, 0xe8, 0x66, 0x83, 0x19, 0xf2, 0x76, 0xe2, 0xd6, 0x48, 0x03, 0x34, 0xb3, 0x2a, 0x14,
+	0x65, 0x5d, 0x49, 0x59, 0xff, 0x83, 0x02, 0x60, 0x30, 0x8c, 0xc7, 0xd8, 0x0d, 0xc4, 0x85, 0xb9,
+	0x39, 0xa2, 0xb0, 0x7a, 0x2f, 0xdd, 0xa8, 0x00, 0x70, 0x22, 0x69, 0xbb, 0x92, 0xb2, 0x3d, 0xf5,
+	0x36, 0x33, 0x73, 0x6f, 0x73, 0x1d, 0x72, 0x89, 0xe0, 0x15, 0xd4, 0x5c, 0x45, 0xce, 0x2d, 0xa8,
+	0xc8, 0xeb, 0x90, 0x23, 0xd8, 0xf4, 0x3d, 0x57, 0xd4, 0x5b, 0x41, 0xd1, 0x6a, 0xdc, 0xa7, 0xc1,
+	0xdd, 0x1b, 0x62, 0x67, 0x30, 0x0c, 0x58, 0x2e, 0x57, 0x8c, 0x12, 0xe3, 0x7d, 0xc4, 0x58, 0xe8,
+	0x03, 0x28, 0x46, 0x1d, 0xa3, 0x56, 0x5c, 0x9a, 0xeb, 0x67, 0xc2, 0xa8, 0x01, 0xab, 0x7d, 0xec,
+	0xe2, 0x63, 0xc7, 0x72, 0x4c, 0x72, 0xd6, 0x0b, 0x1f, 0x3b, 0x30, 0x48, 0x50, 0x6c, 0x69, 0x87,
+	0xaf, 0xd0, 0x30, 0x8d, 0x2b, 0xb0, 0x16, 0xb3, 0xc4, 0xc3, 0x34, 0xc6, 0x7f, 0x66, 0x8e, 0x71,
+	0xf3, 0x9b, 0xcc, 0x61, 0xef, 0x43, 0x8e, 0xd7, 0x77, 0x5a, 0xc9, 0x19, 0xc6, 0xaa, 0x84, 0x2a,
+	0x31, 0x94, 0x55, 0x39, 0x5d, 0x24, 0x14, 0xad, 0xa2, 0xff, 0x4e, 0x86, 0x2a, 0x8b, 0xaa, 0x9d,
+	0xd1, 0xc8, 0x7b, 0xc9, 0x32, 0x55, 0xe4, 0x30, 0x29, 0xee, 0xb0, 0x0d, 0x28, 0x78, 0x13, 0x4c,
+	0xcc, 0xc0, 0x0b, 0x3d, 0x19, 0xd1, 0xc9, 0x58, 0x53, 0x52, 0xb1, 0x96, 0xf0, 0x74, 0x26, 0xe5,
+	0xe9, 0xf3, 0x1c, 0xd9, 0x04, 0xc0, 0xa7, 0x13, 0x87, 0x98, 0x91, 0x1b, 0x2f, 0x86, 0x3a, 0x26,
+	0xdd, 0xfc, 0x94, 0xe1, 0xd1, 0x85, 0x7b, 0xa0, 0x33, 0xd3, 0xb7, 0x42, 0x3b, 0xb7, 0x66, 0x21,
+	0x3c, 0x8b, 0xec, 0xf2, 0xec, 0x5e, 0x1c, 0xb0, 0x48, 0x48, 0x95, 0x93, 0xf8, 0x29, 0x5a, 0x55,
+	0xff, 0x4c, 0x81, 0x22, 0x83, 0xeb, 0x89, 0x67, 0xbd, 0x98, 0x0b, 0xf5, 0xf3, 0xd3, 0xf8, 0x85,
+	0xe1, 0x3e, 0x03, 0x21, 0x93, 0x00, 0xe1, 0x3e, 0xd4, 0x08, 0x1e, 0x61, 0xd3, 0x9f, 0xcb, 0xd5,
+	0x21, 0x5b, 0xe4, 0xb4, 0xa8, 0xf3, 0xa1, 0x01, 0x77, 0xe9, 0xce, 0x87, 0xd2, 0x61, 0xe7, 0xc3,
+	0x14, 0x2f, 0xd7, 0xf9, 0x30, 0xb5, 0x47, 0xb0, 0xc2, 0x8a, 0xa3, 0x30, 0x84, 0xeb, 0x2f, 0xef,
+	0x7e, 0x6a, 0x54, 0xc9, 0xe0, 0x3a, 0x94, 0xdb, 0x7c, 0xca, 0x7c, 0xf5, 0x38, 0x8a, 0xdd, 0xb5,
+	0x05, 0x85, 0x84, 0xb3, 0xe7, 0x8e, 0x53, 0x95, 0xa4, 0x7b, 0x32, 0x5a, 0x4d, 0x37, 0xc5, 0x50,
+	0xb1, 0x3b, 0x3d, 0x3e, 0x5e, 0x36, 0x54, 0xd4, 0x01, 0x26, 0x98, 0x58, 0xd8, 0x0d, 0xcc, 0x41,
+	0x38, 0xbe, 0xc5, 0x38, 0x8b, 0x1b, 0x09, 0x55, 0xff, 0xb5, 0x0c, 0x05, 0x03, 0x9f, 0x60, 0xe2,
+	0x9b, 0xa3, 0xb9, 0x00, 0x48, 0x25, 0x2e, 0x79, 0x2e, 0x71, 0x7d, 0xa1, 0x38, 0xd8, 0x80, 0x02,
+	0x3e, 0x71, 0x6c, 0xd6, 0x84, 0x88, 0xa6, 0x37, 0xa4, 0xe9, 0x1a, 0xc1, 0x13, 0x8f, 0x04, 0x98,
+	0x30, 0xc7, 0x97, 0x8d, 0x88, 0x9e, 0xcb, 0x68, 0xf9, 0x25, 0x19, 0xad, 0x70, 0x85, 0x8c, 0xd6,
+	0xbc, 0xcb, 0xe0, 0xb9, 0x19, 0x79, 0x2e, 0x95, 0x5b, 0x24, 0x6d, 0x45, 0xff, 0x97, 0x0c, 0xab,
+	0x89, 0xd9, 0x64, 0x6f, 0x68, 0xba, 0x03, 0x7c, 0x75, 0xd4, 0x3e, 0x86, 0xda, 0x84, 0xce, 0xc4,
+	0xde, 0xd4, 0xef, 0x89, 0x71, 0x48, 0xb9, 0xec, 0x38, 0x54, 0x0d, 0x35, 0x39, 0x1d, 0x9b, 0xa8,
+	0x32, 0x57, 0x9c, 0xa8, 0x62, 0x95, 0x23, 0x9b, 0xa8, 0x1c, 0xd1, 0xe8, 0x98, 0x8b, 0x8f, 0x8e,
+	0xff, 0x5d, 0xf4, 0x91, 0xfe, 0x2b, 0x19, 0x56, 0xd9, 0x63, 0x78, 0x2a, 0x06, 0xa4, 0x73, 0xd0,
+	0xbf, 0xb0, 0x51, 0xf8, 0x1a, 0xac, 0x44, 0xc8, 0x47, 0x83, 0x17, 0xef, 0x18, 0xd4, 0x70, 0x21,
+	0xdc, 0xff, 0xc2, 0x8f, 0x0b, 0xb4, 0xed, 0x71, 0x06, 0xb4, 0xaa, 0x64, 0xf9, 0x1c, 0xc8, 0xa9,
+	0x39, 0x94, 0x72, 0x4b, 0x50, 0xca, 0x5f, 0x05, 0xa5, 0xb0, 0xa7, 0x0a, 0x51, 0x4a, 0x64, 0x77,
+	0x49, 0x5b, 0xd5, 0x7f, 0x23, 0xc3, 0x9a, 0xf0, 0xf5, 0x12, 0x94, 0x96, 0xc6, 0xe8, 0xff, 0x36,
+	0x52, 0x4b, 0xe3, 0xe9, 0x3a, 0x8b, 0x27, 0x56, 0xfa, 0x96, 0xc7, 0xd3, 0x2c, 0xc5, 0xc9, 0xa9,
+	0x14, 0xf7, 0x7f, 0x17, 0x4f, 0xb1, 0x2a, 0xa5, 0xad, 0xe9, 0xbf, 0x90, 0x61, 0x65, 0x97, 0x38,
+	0xf6, 0x00, 0xef, 0x8b, 0x9e, 0xe2, 0x10, 0x07, 0x4b, 0xbf, 0x6d, 0x89, 0x16, 0x5f, 0x8e, 0xcf,
+	0x03, 0xf4, 0x7b, 0x61, 0xd8, 0x97, 0xd0, 0x6c, 0xa7, 0x6c, 0x96, 0x8d, 0x19, 0x83, 0xae, 0x06,
+	0x43, 0x82, 0xfd, 0xa1, 0x37, 0xb2, 0xc3, 0xaf, 0x89, 0x11, 0x03, 0xbd, 0x0b, 0x79, 0x6a, 0xb4,
+	0x37, 0xe5, 0x0d, 0x43, 0xe9, 0xe1, 0x8d, 0xb9, 0xfb, 0xb5, 0xc4, 0x97, 0x51, 0x23, 0x94, 0x44,
+	0x2d, 0x50, 0xbd, 0x69, 0xd0, 0xf7, 0xa6, 0xa2, 0x1d, 0xf0, 0xa6, 0x1c, 0xbd, 0x0b, 0xb5, 0x6b,
+	0xa1, 0x4a, 0x97, 0x6b, 0x2c, 0xfe, 0x24, 0x27, 0x2e, 0xb8, 0xae, 0xff, 0x28, 0xc2, 0x67, 0x27,
+	0x08, 0x28, 0xa8, 0x74, 0x93, 0x2f, 0x65, 0x7e, 0xa2, 0x8e, 0xa7, 0x73, 0x09, 0x76, 0x83, 0xde,
+	0xd0, 0xf4, 0x87, 0x0c, 0x94, 0xb2, 0x51, 0x12, 0xbc, 0x8f, 0x4c, 0x7f, 0x48, 0x41, 0x33, 0xd9,
+	0xb1, 0x14, 0xd2, 0x2c, 0x87, 0x34, 0x62, 0xfc, 0x47, 0x2d, 0x67, 0x38, 0x33, 0x2d, 0x1e, 0xde,
+	0xe8, 0xb7, 0x98, 0x99, 0xb0, 0x2a, 0x69, 0x6f, 0xe8, 0x3f, 0xce, 0xc0, 0xda, 0xbe, 0x80, 0x8e,
+	0x03, 0x62, 0xd0, 0x4f, 0x0a, 0xfe, 0x97, 0x32, 0x3e, 0x9d, 0xd7, 0x47, 0xac, 0x43, 0x2e, 0x30,
+	0xc9, 0x00, 0x47, 0xcd, 0x36, 0xa7, 0x28, 0x2e, 0xf4, 0x43, 0xf4, 0xc4, 0xc1, 0xd1, 0x98, 0x3f,
+	0x63, 0x24, 0x66, 0xc5, 0x7c, 0x72, 0x56, 0x44, 0xdf, 0x49, 0x7d, 0x9e, 0xdc, 0x5c, 0x54, 0x4c,
+	0x93, 0xf7, 0x4c, 0xd5, 0xd4, 0x7b, 0x50, 0xe5, 0x56, 0xf4, 0x82, 0x53, 0xee, 0xb8, 0x22, 0x9f,
+	0xd9, 0x38, 0xb7, 0x7b, 0xca, 0x3c, 0x97, 0x78, 0xb2, 0x70, 0x95, 0xc1, 0x2b, 0xe9, 0xd5, 0xd2,
+	0x55, 0xbc, 0x4a, 0x93, 0xd5, 0xb1, 0xe9, 0x8c, 0xa6, 0x04, 0xf7, 0x66, 0x71, 0x53, 0x66, 0x71,
+	0xa3, 0x8a, 0x85, 0x9d, 0x90, 0xdf, 0x6c, 0xb2, 0x10, 0x78, 0x6f, 0xd1, 0x14, 0x96, 0x74, 0xff,
+	0xdc, 0x54, 0xa1, 0xbd, 0xf3, 0x27, 0x09, 0x2a, 0x89, 0x96, 0x03, 0xd5, 0x61, 0xe3, 0xc0, 0xd8,
+	0xff, 0xb8, 0xbd, 0xd7, 0xed, 0x1d, 0x76, 0x77, 0xba, 0x47, 0x87, 0xbd, 0xa3, 0x67, 0x87, 0x07,
+	0xed, 0xbd, 0xce, 0xa3, 0x4e, 0xbb, 0xa5, 0x5e, 0x43, 0x37, 0xe1, 0x8d, 0xd4, 0xfa, 0x81, 0xb1,
+	0x7f, 0xb0, 0x7f, 0xd8, 0x6e, 0xa9, 0xd2, 0x82, 0xc5, 0x9d, 0xbd, 0xbd, 0xf6, 0x41, 0xb7, 0xdd,
+	0x52, 0x65, 0x74, 0x03, 0xd6, 0xe6, 0x16, 0xbb, 0x9d, 0xef, 0xb6, 0x55, 0x05, 0xdd, 0x02, 0x2d,
+	0xb5, 0x74, 0x78, 0x74, 0x78, 0xd0, 0x7e, 0xd6, 0x6a, 0xb7, 0xf8, 0x37, 0xe0, 0xd4, 0xaa, 0xd1,
+	0xee, 0x76, 0x8c, 0x76, 0x4b, 0xcd, 0xbe, 0xf3, 0x5b, 0x09, 0xae, 0x2f, 0x72, 0x33, 0xfa, 0x2a,
+	0xe8, 0xfb, 0x47, 0xdd, 0xdd, 0xfd, 0xa3, 0x67, 0xad, 0xde, 0xae, 0xd1, 0x69, 0x3d, 0x6e, 0x2f,
+	0xbe, 0x8f, 0x0e, 0xf5, 0x73, 0xe4, 0xe8, 0xf9, 0x9d, 0x67, 0x8f, 0x55, 0x09, 0xdd, 0x83, 0x3b,
+	0xe7, 0xc8, 0xec, 0xed, 0x3f, 0x3d, 0x78, 0xd2, 0xe6, 0xf7, 0xbb, 0x0b, 0x6f, 0x9e, 0x23, 0xf5,
+	0x68, 0xa7, 0xf3, 0xa4, 0xdd, 0x52, 0x95, 0xdd, 0x83, 0x3f, 0xbf, 0xaa, 0x4b, 0x9f, 0xbf, 0xaa,
+	0x4b, 0x7f, 0x7f, 0x55, 0x97, 0x7e, 0xfa, 0xba, 0x7e, 0xed, 0xf3, 0xd7, 0xf5, 0x6b, 0x7f, 0x7b,
+	0x5d, 0xbf, 0xf6, 0xe9, 0x37, 0x06, 0x4e, 0x30, 0x9c, 0xf6, 0xb7, 0x2d, 0x6f, 0xdc, 0x60, 0x91,
+	0xfc, 0x75, 0x17, 0x07, 0x2f, 0x3d, 0xf2, 0x42, 0x50, 0x23, 0x6c, 0x0f, 0x30, 0x69, 0x9c, 0xc6,
+	0xfe, 0xb5, 0xb2, 0x3c, 0x82, 0xfb, 0x39, 0x16, 0x49, 0xef, 0xfe, 0x7b, 0x00, 0x2f, 0xc7, 0xae,
+	0x90, 0xd4, 0x1a, 0x00, 0x00,
+}
+
+func (m *CreditType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreditType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreditType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Precision != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Precision))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Unit) > 0 {
+		i -= len(m.Unit)
+		copy(dAtA[i:], m.Unit)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Unit)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Abbreviation) > 0 {
+		i -= len(m.Abbreviation)
+		copy(dAtA[i:], m.Abbreviation)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Abbreviation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Class) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Class) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Class) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CreditTypeAbbrev) > 0 {
+		i -= len(m.CreditTypeAbbrev)
+		copy(dAtA[i:], m.CreditTypeAbbrev)
+		i = encodeVarintState(dAtA, i, uint64(len(m.CreditTypeAbbrev)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Key != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Key))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClassIssuer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassIssuer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassIssuer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ClassKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Project) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Project) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Project) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ReferenceId) > 0 {
+		i -= len(m.ReferenceId)
+		copy(dAtA[i:], m.ReferenceId)
+		i = encodeVarintState(dAtA, i, uint64(len(m.ReferenceId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Jurisdiction) > 0 {
+		i -= len(m.Jurisdiction)
+		copy(dAtA[i:], m.Jurisdiction)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Jurisdiction)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ClassKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Key != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Key))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Batch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Batch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Batch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Open {
+		i--
+		if m.Open {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.IssuanceDate != nil {
+		{
+			size, err := m.IssuanceDate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.EndDate != nil {
+		{
+			size, err := m.EndDate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.StartDate != nil {
+		{
+			size, err := m.StartDate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ProjectKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ProjectKey))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Key != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Key))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClassSequence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassSequence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassSequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextSequence != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.NextSequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CreditTypeAbbrev) > 0 {
+		i -= len(m.CreditTypeAbbrev)
+		copy(dAtA[i:], m.CreditTypeAbbrev)
+		i = encodeVarintState(dAtA, i, uint64(len(m.CreditTypeAbbrev)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectSequence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectSequence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectSequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextSequence != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.NextSequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClassKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchSequence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchSequence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchSequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextSequence != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.NextSequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProjectKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ProjectKey))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LockedAmount) > 0 {
+		i -= len(m.LockedAmount)
+		copy(dAtA[i:], m.LockedAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.LockedAmount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.EscrowedAmount) > 0 {
+		i -= len(m.EscrowedAmount)
+		copy(dAtA[i:], m.EscrowedAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.EscrowedAmount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RetiredAmount) > 0 {
+		i -= len(m.RetiredAmount)
+		copy(dAtA[i:], m.RetiredAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.RetiredAmount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TradableAmount) > 0 {
+		i -= len(m.TradableAmount)
+		copy(dAtA[i:], m.TradableAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.TradableAmount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BatchKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchSupply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchSupply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchSupply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CancelledAmount) > 0 {
+		i -= len(m.CancelledAmount)
+		copy(dAtA[i:], m.CancelledAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.CancelledAmount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RetiredAmount) > 0 {
+		i -= len(m.RetiredAmount)
+		copy(dAtA[i:], m.RetiredAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.RetiredAmount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TradableAmount) > 0 {
+		i -= len(m.TradableAmount)
+		copy(dAtA[i:], m.TradableAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.TradableAmount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BatchKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OriginTxIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OriginTxIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OriginTxIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ClassKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ClassKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BatchKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Retirement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Retirement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Retirement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BeneficiaryName) > 0 {
+		i -= len(m.BeneficiaryName)
+		copy(dAtA[i:], m.BeneficiaryName)
+		i = encodeVarintState(dAtA, i, uint64(len(m.BeneficiaryName)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.BeneficiaryAddress) > 0 {
+		i -= len(m.BeneficiaryAddress)
+		copy(dAtA[i:], m.BeneficiaryAddress)
+		i = encodeVarintState(dAtA, i, uint64(len(m.BeneficiaryAddress)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Timestamp != nil {
+		{
+			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Jurisdiction) > 0 {
+		i -= len(m.Jurisdiction)
+		copy(dAtA[i:], m.Jurisdiction)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Jurisdiction)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ProjectKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ProjectKey))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BatchKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		{
+			size, err := m.Expiration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BatchKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ClassKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextReleaseTime != nil {
+		{
+			size, err := m.NextReleaseTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.EndTime != nil {
+		{
+			size, err := m.EndTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.StartTime != nil {
+		{
+			size, err := m.StartTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ReleasedAmount) > 0 {
+		i -= len(m.ReleasedAmount)
+		copy(dAtA[i:], m.ReleasedAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.ReleasedAmount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BatchKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClassBuffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassBuffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassBuffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Percentage) > 0 {
+		i -= len(m.Percentage)
+		copy(dAtA[i:], m.Percentage)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Percentage)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ClassKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Reversal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Reversal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Reversal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != nil {
+		{
+			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Evidence) > 0 {
+		i -= len(m.Evidence)
+		copy(dAtA[i:], m.Evidence)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Evidence)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BatchKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ProjectKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ProjectKey))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectStatusChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectStatusChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectStatusChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != nil {
+		{
+			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Status != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PreviousStatus != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.PreviousStatus))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ProjectKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ProjectKey))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClassMetadataChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassMetadataChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassMetadataChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != nil {
+		{
+			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PreviousMetadata) > 0 {
+		i -= len(m.PreviousMetadata)
+		copy(dAtA[i:], m.PreviousMetadata)
+		i = encodeVarintState(dAtA, i, uint64(len(m.PreviousMetadata)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ClassKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectMetadataChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectMetadataChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectMetadataChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != nil {
+		{
+			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PreviousMetadata) > 0 {
+		i -= len(m.PreviousMetadata)
+		copy(dAtA[i:], m.PreviousMetadata)
+		i = encodeVarintState(dAtA, i, uint64(len(m.PreviousMetadata)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProjectKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ProjectKey))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchMetadataChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchMetadataChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchMetadataChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != nil {
+		{
+			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PreviousMetadata) > 0 {
+		i -= len(m.PreviousMetadata)
+		copy(dAtA[i:], m.PreviousMetadata)
+		i = encodeVarintState(dAtA, i, uint64(len(m.PreviousMetadata)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BatchKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeOperatorSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeOperatorSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeOperatorSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OutboundTimeout != nil {
+		{
+			size, err := m.OutboundTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Timeout != nil {
+		{
+			size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Operators) > 0 {
+		for iNdEx := len(m.Operators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Operators[iNdEx])
+			copy(dAtA[i:], m.Operators[iNdEx])
+			i = encodeVarintState(dAtA, i, uint64(len(m.Operators[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ClassKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		{
+			size, err := m.Expiration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Attestors) > 0 {
+		for iNdEx := len(m.Attestors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Attestors[iNdEx])
+			copy(dAtA[i:], m.Attestors[iNdEx])
+			i = encodeVarintState(dAtA, i, uint64(len(m.Attestors[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ContentHash) > 0 {
+		i -= len(m.ContentHash)
+		copy(dAtA[i:], m.ContentHash)
+		i = encodeVarintState(dAtA, i, uint64(len(m.ContentHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ClassKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutboundBridgeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutboundBridgeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutboundBridgeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FailureAttestors) > 0 {
+		for iNdEx := len(m.FailureAttestors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FailureAttestors[iNdEx])
+			copy(dAtA[i:], m.FailureAttestors[iNdEx])
+			i = encodeVarintState(dAtA, i, uint64(len(m.FailureAttestors[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.Expiration != nil {
+		{
+			size, err := m.Expiration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Timestamp != nil {
+		{
+			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.TargetTxHash) > 0 {
+		i -= len(m.TargetTxHash)
+		copy(dAtA[i:], m.TargetTxHash)
+		i = encodeVarintState(dAtA, i, uint64(len(m.TargetTxHash)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Status != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BatchKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintState(dAtA []byte, offset int, v uint64) int {
+	offset -= sovState(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CreditType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Abbreviation)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Unit)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Precision != 0 {
+		n += 1 + sovState(uint64(m.Precision))
+	}
+	return n
+}
+
+func (m *Class) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Key != 0 {
+		n += 1 + sovState(uint64(m.Key))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.CreditTypeAbbrev)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *ClassIssuer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClassKey != 0 {
+		n += 1 + sovState(uint64(m.ClassKey))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *Project) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Key != 0 {
+		n += 1 + sovState(uint64(m.Key))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.ClassKey != 0 {
+		n += 1 + sovState(uint64(m.ClassKey))
+	}
+	l = len(m.Jurisdiction)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.ReferenceId)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovState(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *Batch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Key != 0 {
+		n += 1 + sovState(uint64(m.Key))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.ProjectKey != 0 {
+		n += 1 + sovState(uint64(m.ProjectKey))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.StartDate != nil {
+		l = m.StartDate.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.EndDate != nil {
+		l = m.EndDate.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.IssuanceDate != nil {
+		l = m.IssuanceDate.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Open {
+		n += 2
+	}
+	if m.Frozen {
+		n += 2
+	}
+	return n
+}
+
+func (m *ClassSequence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CreditTypeAbbrev)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.NextSequence != 0 {
+		n += 1 + sovState(uint64(m.NextSequence))
+	}
+	return n
+}
+
+func (m *ProjectSequence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClassKey != 0 {
+		n += 1 + sovState(uint64(m.ClassKey))
+	}
+	if m.NextSequence != 0 {
+		n += 1 + sovState(uint64(m.NextSequence))
+	}
+	return n
+}
+
+func (m *BatchSequence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProjectKey != 0 {
+		n += 1 + sovState(uint64(m.ProjectKey))
+	}
+	if m.NextSequence != 0 {
+		n += 1 + sovState(uint64(m.NextSequence))
+	}
+	return n
+}
+
+func (m *BatchBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchKey != 0 {
+		n += 1 + sovState(uint64(m.BatchKey))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.TradableAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.RetiredAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.EscrowedAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.LockedAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchSupply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchKey != 0 {
+		n += 1 + sovState(uint64(m.BatchKey))
+	}
+	l = len(m.TradableAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.RetiredAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.CancelledAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *OriginTxIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClassKey != 0 {
+		n += 1 + sovState(uint64(m.ClassKey))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchKey != 0 {
+		n += 1 + sovState(uint64(m.BatchKey))
+	}
+	if m.ClassKey != 0 {
+		n += 1 + sovState(uint64(m.ClassKey))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *Retirement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.BatchKey != 0 {
+		n += 1 + sovState(uint64(m.BatchKey))
+	}
+	if m.ProjectKey != 0 {
+		n += 1 + sovState(uint64(m.ProjectKey))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Jurisdiction)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovState(uint64(m.BlockHeight))
+	}
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.BeneficiaryAddress)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.BeneficiaryName)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.ClassKey != 0 {
+		n += 1 + sovState(uint64(m.ClassKey))
+	}
+	if m.BatchKey != 0 {
+		n += 1 + sovState(uint64(m.BatchKey))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = m.Expiration.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.BatchKey != 0 {
+		n += 1 + sovState(uint64(m.BatchKey))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.ReleasedAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.StartTime != nil {
+		l = m.StartTime.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = m.EndTime.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.NextReleaseTime != nil {
+		l = m.NextReleaseTime.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *ClassBuffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClassKey != 0 {
+		n += 1 + sovState(uint64(m.ClassKey))
+	}
+	l = len(m.Percentage)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *Reversal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	if m.ProjectKey != 0 {
+		n += 1 + sovState(uint64(m.ProjectKey))
+	}
+	if m.BatchKey != 0 {
+		n += 1 + sovState(uint64(m.BatchKey))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Evidence)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovState(uint64(m.BlockHeight))
+	}
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *ProjectStatusChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	if m.ProjectKey != 0 {
+		n += 1 + sovState(uint64(m.ProjectKey))
+	}
+	if m.PreviousStatus != 0 {
+		n += 1 + sovState(uint64(m.PreviousStatus))
+	}
+	if m.Status != 0 {
+		n += 1 + sovState(uint64(m.Status))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovState(uint64(m.BlockHeight))
+	}
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *ClassMetadataChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	if m.ClassKey != 0 {
+		n += 1 + sovState(uint64(m.ClassKey))
+	}
+	l = len(m.PreviousMetadata)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovState(uint64(m.BlockHeight))
+	}
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *ProjectMetadataChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	if m.ProjectKey != 0 {
+		n += 1 + sovState(uint64(m.ProjectKey))
+	}
+	l = len(m.PreviousMetadata)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovState(uint64(m.BlockHeight))
+	}
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchMetadataChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	if m.BatchKey != 0 {
+		n += 1 + sovState(uint64(m.BatchKey))
+	}
+	l = len(m.PreviousMetadata)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovState(uint64(m.BlockHeight))
+	}
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *BridgeOperatorSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClassKey != 0 {
+		n += 1 + sovState(uint64(m.ClassKey))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if len(m.Operators) > 0 {
+		for _, b := range m.Operators {
+			l = len(b)
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovState(uint64(m.Threshold))
+	}
+	if m.Timeout != nil {
+		l = m.Timeout.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.OutboundTimeout != nil {
+		l = m.OutboundTimeout.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *BridgeAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClassKey != 0 {
+		n += 1 + sovState(uint64(m.ClassKey))
 	}
 	l = len(m.Id)
 	if l > 0 {
 		n += 1 + l + sovState(uint64(l))
 	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.ContentHash)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if len(m.Attestors) > 0 {
+		for _, b := range m.Attestors {
+			l = len(b)
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	if m.Expiration != nil {
+		l = m.Expiration.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *OutboundBridgeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.BatchKey != 0 {
+		n += 1 + sovState(uint64(m.BatchKey))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovState(uint64(m.Status))
+	}
+	l = len(m.TargetTxHash)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = m.Expiration.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if len(m.FailureAttestors) > 0 {
+		for _, b := range m.FailureAttestors {
+			l = len(b)
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovState(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozState(x uint64) (n int) {
+	return sovState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CreditType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreditType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreditType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abbreviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Abbreviation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
+			}
+			m.Precision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Precision |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Class) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Class: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Class: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			m.Key = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Key |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
+			if m.Admin == nil {
+				m.Admin = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClassIssuer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassIssuer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassIssuer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
+			}
+			m.ClassKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClassKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = append(m.Issuer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Issuer == nil {
+				m.Issuer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Project) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Project: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Project: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			m.Key = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Key |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
+			if m.Admin == nil {
+				m.Admin = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
+			}
+			m.ClassKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClassKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProjectStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Batch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Batch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Batch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			m.Key = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Key |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = append(m.Issuer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Issuer == nil {
+				m.Issuer = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
+			}
+			m.ProjectKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProjectKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartDate == nil {
+				m.StartDate = &types.Timestamp{}
+			}
+			if err := m.StartDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndDate == nil {
+				m.EndDate = &types.Timestamp{}
+			}
+			if err := m.EndDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IssuanceDate == nil {
+				m.IssuanceDate = &types.Timestamp{}
+			}
+			if err := m.IssuanceDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Open = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClassSequence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassSequence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassSequence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequence", wireType)
+			}
+			m.NextSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectSequence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectSequence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectSequence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
+			}
+			m.ClassKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClassKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequence", wireType)
+			}
+			m.NextSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchSequence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchSequence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchSequence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
+			}
+			m.ProjectKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProjectKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequence", wireType)
+			}
+			m.NextSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+			}
+			m.BatchKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradableAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TradableAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetiredAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetiredAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowedAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockedAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchSupply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchSupply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchSupply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+			}
+			m.BatchKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradableAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TradableAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetiredAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetiredAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CancelledAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OriginTxIndex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OriginTxIndex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OriginTxIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
+			}
+			m.ClassKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClassKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+			}
+			m.BatchKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
+			}
+			m.ClassKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClassKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Retirement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Retirement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Retirement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+			}
+			m.BatchKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
+			}
+			m.ProjectKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProjectKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &types.Timestamp{}
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeneficiaryAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeneficiaryAddress = append(m.BeneficiaryAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.BeneficiaryAddress == nil {
+				m.BeneficiaryAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeneficiaryName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeneficiaryName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = append(m.Operator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Operator == nil {
+				m.Operator = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
+			}
+			m.ClassKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClassKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+			}
+			m.BatchKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = &types.Timestamp{}
+			}
+			if err := m.Expiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchLock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+			}
+			m.BatchKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReleasedAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = &types.Timestamp{}
+			}
+			if err := m.StartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = &types.Timestamp{}
+			}
+			if err := m.EndTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextReleaseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextReleaseTime == nil {
+				m.NextReleaseTime = &types.Timestamp{}
+			}
+			if err := m.NextReleaseTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClassBuffer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassBuffer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassBuffer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
+			}
+			m.ClassKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClassKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Percentage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Reversal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Reversal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Reversal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
+			}
+			m.ProjectKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProjectKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+			}
+			m.BatchKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidence = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = append(m.Reporter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Reporter == nil {
+				m.Reporter = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &types.Timestamp{}
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *BatchContract) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BatchKey != 0 {
-		n += 1 + sovState(uint64(m.BatchKey))
-	}
-	if m.ClassKey != 0 {
-		n += 1 + sovState(uint64(m.ClassKey))
-	}
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovState(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozState(x uint64) (n int) {
-	return sovState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *CreditType) Unmarshal(dAtA []byte) error {
+func (m *ProjectStatusChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1964,15 +8064,91 @@ func (m *CreditType) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreditType: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectStatusChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreditType: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectStatusChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
+			}
+			m.ProjectKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProjectKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStatus", wireType)
+			}
+			m.PreviousStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousStatus |= ProjectStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProjectStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Abbreviation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2000,13 +8176,13 @@ func (m *CreditType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Abbreviation = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2016,29 +8192,31 @@ func (m *CreditType) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
+			if m.Admin == nil {
+				m.Admin = []byte{}
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2048,29 +8226,16 @@ func (m *CreditType) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Unit = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			m.Precision = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2080,11 +8245,28 @@ func (m *CreditType) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Precision |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &types.Timestamp{}
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -2106,7 +8288,7 @@ func (m *CreditType) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Class) Unmarshal(dAtA []byte) error {
+func (m *ClassMetadataChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2129,17 +8311,17 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Class: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClassMetadataChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Class: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClassMetadataChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Key = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2149,16 +8331,16 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Key |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
 			}
-			var stringLen uint64
+			m.ClassKey = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2168,29 +8350,16 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ClassKey |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousMetadata", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2200,25 +8369,23 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
-			if m.Admin == nil {
-				m.Admin = []byte{}
-			}
+			m.PreviousMetadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
@@ -2254,9 +8421,9 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2266,79 +8433,31 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipState(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthState
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClassIssuer) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowState
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClassIssuer: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClassIssuer: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.ClassKey = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2348,16 +8467,16 @@ func (m *ClassIssuer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClassKey |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2367,24 +8486,26 @@ func (m *ClassIssuer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Issuer = append(m.Issuer[:0], dAtA[iNdEx:postIndex]...)
-			if m.Issuer == nil {
-				m.Issuer = []byte{}
+			if m.Timestamp == nil {
+				m.Timestamp = &types.Timestamp{}
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -2408,7 +8529,7 @@ func (m *ClassIssuer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Project) Unmarshal(dAtA []byte) error {
+func (m *ProjectMetadataChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2431,17 +8552,17 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Project: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectMetadataChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Project: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectMetadataChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Key = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2451,16 +8572,16 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Key |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
 			}
-			var stringLen uint64
+			m.ProjectKey = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2470,29 +8591,16 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProjectKey |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousMetadata", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2502,48 +8610,27 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthState
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
-			if m.Admin == nil {
-				m.Admin = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
-			}
-			m.ClassKey = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ClassKey |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
 			}
-		case 5:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousMetadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jurisdiction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2571,13 +8658,13 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Jurisdiction = string(dAtA[iNdEx:postIndex])
+			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2587,29 +8674,50 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2619,23 +8727,27 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReferenceId = string(dAtA[iNdEx:postIndex])
+			if m.Timestamp == nil {
+				m.Timestamp = &types.Timestamp{}
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2658,7 +8770,7 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Batch) Unmarshal(dAtA []byte) error {
+func (m *BatchMetadataChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2681,17 +8793,17 @@ func (m *Batch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Batch: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchMetadataChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Batch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchMetadataChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Key = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2701,50 +8813,16 @@ func (m *Batch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Key |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Issuer = append(m.Issuer[:0], dAtA[iNdEx:postIndex]...)
-			if m.Issuer == nil {
-				m.Issuer = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
 			}
-			m.ProjectKey = 0
+			m.BatchKey = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2754,14 +8832,14 @@ func (m *Batch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProjectKey |= uint64(b&0x7F) << shift
+				m.BatchKey |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousMetadata", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2789,9 +8867,9 @@ func (m *Batch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.PreviousMetadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
@@ -2823,83 +8901,11 @@ func (m *Batch) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.StartDate == nil {
-				m.StartDate = &types.Timestamp{}
-			}
-			if err := m.StartDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.EndDate == nil {
-				m.EndDate = &types.Timestamp{}
-			}
-			if err := m.EndDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2909,33 +8915,31 @@ func (m *Batch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.IssuanceDate == nil {
-				m.IssuanceDate = &types.Timestamp{}
-			}
-			if err := m.IssuanceDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
 			}
 			iNdEx = postIndex
-		case 9:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var v int
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2945,67 +8949,16 @@ func (m *Batch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
-				}
-			}
-			m.Open = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipState(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthState
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClassSequence) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowState
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClassSequence: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClassSequence: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3015,43 +8968,28 @@ func (m *ClassSequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextSequence", wireType)
+			if m.Timestamp == nil {
+				m.Timestamp = &types.Timestamp{}
 			}
-			m.NextSequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NextSequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -3073,7 +9011,7 @@ func (m *ClassSequence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectSequence) Unmarshal(dAtA []byte) error {
+func (m *BridgeOperatorSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3096,10 +9034,10 @@ func (m *ProjectSequence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectSequence: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgeOperatorSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectSequence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgeOperatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3122,10 +9060,10 @@ func (m *ProjectSequence) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextSequence", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			m.NextSequence = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3135,66 +9073,61 @@ func (m *ProjectSequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextSequence |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipState(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BatchSequence) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowState
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operators", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if byteLen < 0 {
+				return ErrInvalidLengthState
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BatchSequence: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchSequence: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operators = append(m.Operators, make([]byte, postIndex-iNdEx))
+			copy(m.Operators[len(m.Operators)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
-			m.ProjectKey = 0
+			m.Threshold = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3204,16 +9137,16 @@ func (m *BatchSequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProjectKey |= uint64(b&0x7F) << shift
+				m.Threshold |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextSequence", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
-			m.NextSequence = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3223,11 +9156,64 @@ func (m *BatchSequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextSequence |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeout == nil {
+				m.Timeout = &types.Duration{}
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OutboundTimeout == nil {
+				m.OutboundTimeout = &types.Duration{}
+			}
+			if err := m.OutboundTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -3249,7 +9235,7 @@ func (m *BatchSequence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchBalance) Unmarshal(dAtA []byte) error {
+func (m *BridgeAttestation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3272,17 +9258,17 @@ func (m *BatchBalance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchBalance: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgeAttestation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgeAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
 			}
-			m.BatchKey = 0
+			m.ClassKey = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3292,16 +9278,16 @@ func (m *BatchBalance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchKey |= uint64(b&0x7F) << shift
+				m.ClassKey |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3311,29 +9297,27 @@ func (m *BatchBalance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TradableAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3361,13 +9345,13 @@ func (m *BatchBalance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TradableAmount = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetiredAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentHash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3377,29 +9361,31 @@ func (m *BatchBalance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RetiredAmount = string(dAtA[iNdEx:postIndex])
+			m.ContentHash = append(m.ContentHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ContentHash == nil {
+				m.ContentHash = []byte{}
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowedAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestors", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3409,23 +9395,59 @@ func (m *BatchBalance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestors = append(m.Attestors, make([]byte, postIndex-iNdEx))
+			copy(m.Attestors[len(m.Attestors)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EscrowedAmount = string(dAtA[iNdEx:postIndex])
+			if m.Expiration == nil {
+				m.Expiration = &types.Timestamp{}
+			}
+			if err := m.Expiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3448,7 +9470,7 @@ func (m *BatchBalance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchSupply) Unmarshal(dAtA []byte) error {
+func (m *OutboundBridgeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3471,17 +9493,17 @@ func (m *BatchSupply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchSupply: wiretype end group for non-group")
+			return fmt.Errorf("proto: OutboundBridgeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchSupply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OutboundBridgeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.BatchKey = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3491,16 +9513,16 @@ func (m *BatchSupply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchKey |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TradableAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3510,27 +9532,48 @@ func (m *BatchSupply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TradableAmount = string(dAtA[iNdEx:postIndex])
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+			}
+			m.BatchKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetiredAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3558,11 +9601,11 @@ func (m *BatchSupply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RetiredAmount = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelledAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3590,63 +9633,13 @@ func (m *BatchSupply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CancelledAmount = string(dAtA[iNdEx:postIndex])
+			m.Target = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipState(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthState
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OriginTxIndex) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowState
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OriginTxIndex: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OriginTxIndex: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
-			m.ClassKey = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3656,14 +9649,27 @@ func (m *OriginTxIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClassKey |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3691,11 +9697,30 @@ func (m *OriginTxIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= OutboundBridgeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetTxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3723,63 +9748,13 @@ func (m *OriginTxIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			m.TargetTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipState(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthState
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BatchContract) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowState
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BatchContract: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchContract: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			m.BatchKey = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3789,16 +9764,33 @@ func (m *BatchContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchKey |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthState
 			}
-			m.ClassKey = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &types.Timestamp{}
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3808,16 +9800,33 @@ func (m *BatchContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClassKey |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = &types.Timestamp{}
+			}
+			if err := m.Expiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureAttestors", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3827,23 +9836,23 @@ func (m *BatchContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			m.FailureAttestors = append(m.FailureAttestors, make([]byte, postIndex-iNdEx))
+			copy(m.FailureAttestors[len(m.FailureAttestors)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
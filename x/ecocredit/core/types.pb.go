@@ -9,15 +9,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -184,6 +188,14 @@ type BatchIssuance struct {
 	// sub-national-code and postal-code are optional and can be added for
 	// increased precision.
 	RetirementJurisdiction string `protobuf:"bytes,4,opt,name=retirement_jurisdiction,json=retirementJurisdiction,proto3" json:"retirement_jurisdiction,omitempty"`
+	// locked_amount is the amount of credits that the recipient will receive in
+	// a locked state. Locked credits are released into the tradable balance of
+	// the recipient according to the lock schedule. The number of decimal places
+	// must be less than or equal to the credit type precision.
+	LockedAmount string `protobuf:"bytes,5,opt,name=locked_amount,json=lockedAmount,proto3" json:"locked_amount,omitempty"`
+	// lock_schedule is the schedule by which locked credits are released and is
+	// only required if locked_amount is positive.
+	LockSchedule *LockSchedule `protobuf:"bytes,6,opt,name=lock_schedule,json=lockSchedule,proto3" json:"lock_schedule,omitempty"`
 }
 
 func (m *BatchIssuance) Reset()         { *m = BatchIssuance{} }
@@ -247,6 +259,79 @@ func (m *BatchIssuance) GetRetirementJurisdiction() string {
 	return ""
 }
 
+func (m *BatchIssuance) GetLockedAmount() string {
+	if m != nil {
+		return m.LockedAmount
+	}
+	return ""
+}
+
+func (m *BatchIssuance) GetLockSchedule() *LockSchedule {
+	if m != nil {
+		return m.LockSchedule
+	}
+	return nil
+}
+
+// LockSchedule defines when locked credits are released into the tradable
+// balance. Credits are either released all at once at the start time (cliff)
+// or linearly between the start time and the end time.
+type LockSchedule struct {
+	// start_time is the time at which the credits begin to unlock. If end_time is
+	// not set, all credits are released at start_time.
+	StartTime *time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	// end_time is the optional time at which all credits are released. If set,
+	// credits are released linearly between start_time and end_time.
+	EndTime *time.Time `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
+}
+
+func (m *LockSchedule) Reset()         { *m = LockSchedule{} }
+func (m *LockSchedule) String() string { return proto.CompactTextString(m) }
+func (*LockSchedule) ProtoMessage()    {}
+func (*LockSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b044b6b740b984f, []int{3}
+}
+func (m *LockSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockSchedule.Merge(m, src)
+}
+func (m *LockSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockSchedule proto.InternalMessageInfo
+
+func (m *LockSchedule) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *LockSchedule) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
 // OriginTx is the transaction from another chain or registry that triggered
 // the minting of credits.
 type OriginTx struct {
@@ -271,7 +356,7 @@ func (m *OriginTx) Reset()         { *m = OriginTx{} }
 func (m *OriginTx) String() string { return proto.CompactTextString(m) }
 func (*OriginTx) ProtoMessage()    {}
 func (*OriginTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7b044b6b740b984f, []int{3}
+	return fileDescriptor_7b044b6b740b984f, []int{4}
 }
 func (m *OriginTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -342,7 +427,7 @@ type CreditTypeProposal struct {
 func (m *CreditTypeProposal) Reset()      { *m = CreditTypeProposal{} }
 func (*CreditTypeProposal) ProtoMessage() {}
 func (*CreditTypeProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7b044b6b740b984f, []int{4}
+	return fileDescriptor_7b044b6b740b984f, []int{5}
 }
 func (m *CreditTypeProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -392,56 +477,241 @@ func (m *CreditTypeProposal) GetCreditType() *CreditType {
 	return nil
 }
 
+// CreditTypePrecisionProposal is a gov Content type for raising the precision
+// of an existing credit type.
+type CreditTypePrecisionProposal struct {
+	// title is the title of the proposal.
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// description is the description of the proposal.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// credit_type_abbrev is the abbreviation of the credit type.
+	CreditTypeAbbrev string `protobuf:"bytes,3,opt,name=credit_type_abbrev,json=creditTypeAbbrev,proto3" json:"credit_type_abbrev,omitempty"`
+	// precision is the new precision of the credit type. The precision must be
+	// greater than the current precision of the credit type and must be one of
+	// the exponents supported by baskets (0, 1, 2, 3, 6, 9, 12, 15 or 18).
+	Precision uint32 `protobuf:"varint,4,opt,name=precision,proto3" json:"precision,omitempty"`
+}
+
+func (m *CreditTypePrecisionProposal) Reset()      { *m = CreditTypePrecisionProposal{} }
+func (*CreditTypePrecisionProposal) ProtoMessage() {}
+func (*CreditTypePrecisionProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b044b6b740b984f, []int{6}
+}
+func (m *CreditTypePrecisionProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreditTypePrecisionProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreditTypePrecisionProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreditTypePrecisionProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreditTypePrecisionProposal.Merge(m, src)
+}
+func (m *CreditTypePrecisionProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreditTypePrecisionProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreditTypePrecisionProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreditTypePrecisionProposal proto.InternalMessageInfo
+
+func (m *CreditTypePrecisionProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *CreditTypePrecisionProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *CreditTypePrecisionProposal) GetCreditTypeAbbrev() string {
+	if m != nil {
+		return m.CreditTypeAbbrev
+	}
+	return ""
+}
+
+func (m *CreditTypePrecisionProposal) GetPrecision() uint32 {
+	if m != nil {
+		return m.Precision
+	}
+	return 0
+}
+
+// BatchFreezeProposal is a gov Content type for freezing or unfreezing a credit
+// batch.
+type BatchFreezeProposal struct {
+	// title is the title of the proposal.
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// description is the description of the proposal.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// batch_denom is the unique identifier of the credit batch.
+	BatchDenom string `protobuf:"bytes,3,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// freeze determines whether the credit batch is frozen (true) or unfrozen
+	// (false) if the proposal passes.
+	Freeze bool `protobuf:"varint,4,opt,name=freeze,proto3" json:"freeze,omitempty"`
+	// evidence is the IRI of the evidence supporting the proposal.
+	Evidence string `protobuf:"bytes,5,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	// reason is any arbitrary string that specifies the reason for freezing or
+	// unfreezing the credit batch.
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *BatchFreezeProposal) Reset()      { *m = BatchFreezeProposal{} }
+func (*BatchFreezeProposal) ProtoMessage() {}
+func (*BatchFreezeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b044b6b740b984f, []int{7}
+}
+func (m *BatchFreezeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchFreezeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchFreezeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchFreezeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchFreezeProposal.Merge(m, src)
+}
+func (m *BatchFreezeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchFreezeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchFreezeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchFreezeProposal proto.InternalMessageInfo
+
+func (m *BatchFreezeProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *BatchFreezeProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *BatchFreezeProposal) GetBatchDenom() string {
+	if m != nil {
+		return m.BatchDenom
+	}
+	return ""
+}
+
+func (m *BatchFreezeProposal) GetFreeze() bool {
+	if m != nil {
+		return m.Freeze
+	}
+	return false
+}
+
+func (m *BatchFreezeProposal) GetEvidence() string {
+	if m != nil {
+		return m.Evidence
+	}
+	return ""
+}
+
+func (m *BatchFreezeProposal) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "regen.ecocredit.v1.Params")
 	proto.RegisterType((*Credits)(nil), "regen.ecocredit.v1.Credits")
 	proto.RegisterType((*BatchIssuance)(nil), "regen.ecocredit.v1.BatchIssuance")
+	proto.RegisterType((*LockSchedule)(nil), "regen.ecocredit.v1.LockSchedule")
 	proto.RegisterType((*OriginTx)(nil), "regen.ecocredit.v1.OriginTx")
 	proto.RegisterType((*CreditTypeProposal)(nil), "regen.ecocredit.v1.CreditTypeProposal")
+	proto.RegisterType((*CreditTypePrecisionProposal)(nil), "regen.ecocredit.v1.CreditTypePrecisionProposal")
+	proto.RegisterType((*BatchFreezeProposal)(nil), "regen.ecocredit.v1.BatchFreezeProposal")
 }
 
 func init() { proto.RegisterFile("regen/ecocredit/v1/types.proto", fileDescriptor_7b044b6b740b984f) }
 
 var fileDescriptor_7b044b6b740b984f = []byte{
-	// 598 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xcf, 0x6e, 0x13, 0x31,
-	0x10, 0xc6, 0xb3, 0x49, 0x29, 0xcd, 0x44, 0x0d, 0xc5, 0xaa, 0x4a, 0xa8, 0xd0, 0x26, 0x8a, 0x84,
-	0x88, 0x84, 0xba, 0x4b, 0x0a, 0x02, 0x89, 0x0b, 0x22, 0x01, 0x24, 0xb8, 0x50, 0x45, 0x3d, 0x71,
-	0x89, 0xbc, 0xf6, 0x90, 0xba, 0xdd, 0xd8, 0x91, 0xed, 0xf4, 0xcf, 0x5b, 0x20, 0x71, 0xe1, 0xc8,
-	0x99, 0x07, 0xe0, 0x19, 0x7a, 0x42, 0x3d, 0x72, 0x02, 0xd4, 0xbe, 0x08, 0x5a, 0xdb, 0xf9, 0x83,
-	0xe0, 0xc8, 0x29, 0x9e, 0xef, 0x9b, 0xcc, 0xef, 0x9b, 0x95, 0x0d, 0xb1, 0xc6, 0x11, 0xca, 0x14,
-	0x99, 0x62, 0x1a, 0xb9, 0xb0, 0xe9, 0x71, 0x37, 0xb5, 0x67, 0x13, 0x34, 0xc9, 0x44, 0x2b, 0xab,
-	0x08, 0x71, 0x7e, 0x32, 0xf7, 0x93, 0xe3, 0xee, 0xf6, 0xe6, 0x48, 0x8d, 0x94, 0xb3, 0xd3, 0xe2,
-	0xe4, 0x3b, 0xb7, 0x63, 0xa6, 0xcc, 0x58, 0x99, 0x34, 0xa3, 0x06, 0xd3, 0xe3, 0x6e, 0x86, 0x96,
-	0x76, 0x53, 0xa6, 0x84, 0x9c, 0xf9, 0xff, 0x20, 0x19, 0x4b, 0x2d, 0x7a, 0xbf, 0xfd, 0xad, 0x0c,
-	0xab, 0x7b, 0x54, 0xd3, 0xb1, 0x21, 0x53, 0xd8, 0xf0, 0x3d, 0x43, 0x96, 0x53, 0x63, 0x86, 0xef,
-	0x11, 0x1b, 0x51, 0xab, 0xd2, 0xa9, 0xed, 0xde, 0x4e, 0x3c, 0x25, 0x29, 0x28, 0x49, 0xa0, 0x24,
-	0x7d, 0x25, 0x64, 0xef, 0xc1, 0xf9, 0x8f, 0x66, 0xe9, 0xcb, 0xcf, 0x66, 0x67, 0x24, 0xec, 0xc1,
-	0x34, 0x4b, 0x98, 0x1a, 0xa7, 0x21, 0x92, 0xff, 0xd9, 0x31, 0xfc, 0x28, 0xec, 0x56, 0xfc, 0xc1,
-	0x0c, 0xea, 0x1e, 0xd2, 0x2f, 0x18, 0xaf, 0x10, 0xc9, 0x21, 0x40, 0x46, 0xcd, 0x11, 0x5a, 0x07,
-	0x2c, 0xff, 0x7f, 0x60, 0xd5, 0x8f, 0x2f, 0x58, 0x8f, 0x60, 0x8b, 0xe6, 0xb9, 0x3a, 0x41, 0x1e,
-	0x76, 0x64, 0x1a, 0xa9, 0x55, 0xda, 0x34, 0x2a, 0xad, 0x4a, 0xa7, 0x3a, 0xd8, 0x0c, 0xae, 0x0b,
-	0xd7, 0x0f, 0x1e, 0xb9, 0x0f, 0x37, 0x9d, 0x9e, 0x0b, 0x63, 0x87, 0x28, 0x69, 0x96, 0x23, 0x6f,
-	0xac, 0xb4, 0xa2, 0xce, 0xda, 0x60, 0x63, 0x6e, 0xbc, 0xf4, 0x7a, 0xbb, 0x07, 0xd7, 0xfb, 0x6e,
-	0x41, 0x43, 0x9a, 0x50, 0xcb, 0xa8, 0x65, 0x07, 0x43, 0x8e, 0x52, 0x8d, 0x1b, 0x51, 0x2b, 0xea,
-	0x54, 0x07, 0xe0, 0xa4, 0x17, 0x85, 0x42, 0xb6, 0x60, 0x95, 0x8e, 0xd5, 0x54, 0xda, 0x46, 0xd9,
-	0x79, 0xa1, 0x6a, 0x7f, 0x8d, 0x60, 0xbd, 0x57, 0xb4, 0xbd, 0x36, 0x66, 0x4a, 0x25, 0x43, 0x72,
-	0x07, 0xaa, 0x1a, 0x99, 0x98, 0x08, 0x94, 0x36, 0x0c, 0x5a, 0x08, 0xe4, 0x1e, 0xdc, 0xb0, 0x9a,
-	0xf2, 0x22, 0xc0, 0xf0, 0x8f, 0x81, 0xf5, 0x99, 0xfc, 0xdc, 0xa9, 0xe4, 0x2e, 0xd4, 0x35, 0x5a,
-	0xa1, 0x91, 0xcf, 0xfa, 0x2a, 0xae, 0x6f, 0x3d, 0xa8, 0xa1, 0xed, 0x09, 0xdc, 0xf2, 0xc2, 0x18,
-	0xa5, 0x1d, 0x1e, 0x4e, 0xb5, 0x30, 0x5c, 0x30, 0x2b, 0x94, 0x74, 0x6b, 0x57, 0x07, 0x5b, 0x0b,
-	0xfb, 0xcd, 0x92, 0xdb, 0xce, 0x60, 0xed, 0xad, 0x16, 0x23, 0x21, 0xf7, 0x4f, 0x49, 0x1d, 0xca,
-	0x82, 0x87, 0xac, 0x65, 0xc1, 0x8b, 0x65, 0x8d, 0x9a, 0x6a, 0x86, 0xb3, 0x65, 0x7d, 0x45, 0xb6,
-	0x61, 0x8d, 0x29, 0x69, 0x35, 0x65, 0xb3, 0x34, 0xf3, 0x9a, 0x10, 0x58, 0x91, 0xca, 0x62, 0xa0,
-	0xba, 0x73, 0xfb, 0x63, 0x04, 0xc4, 0x7f, 0xe1, 0xfd, 0xb3, 0x09, 0xee, 0x69, 0x35, 0x51, 0x86,
-	0xe6, 0x64, 0x13, 0xae, 0x59, 0x61, 0x73, 0x0c, 0x44, 0x5f, 0x90, 0x16, 0xd4, 0x38, 0x1a, 0xa6,
-	0xc5, 0xc4, 0xa5, 0xf7, 0xe4, 0x65, 0x89, 0x3c, 0x83, 0x5a, 0xb8, 0xf5, 0xc5, 0x9d, 0x71, 0x09,
-	0x6a, 0xbb, 0x71, 0xf2, 0xf7, 0x03, 0x4c, 0x16, 0xd0, 0x01, 0xb0, 0xf9, 0xf9, 0xe9, 0xca, 0xa7,
-	0xcf, 0xcd, 0x52, 0x6f, 0xef, 0xfc, 0x32, 0x8e, 0x2e, 0x2e, 0xe3, 0xe8, 0xd7, 0x65, 0x1c, 0x7d,
-	0xb8, 0x8a, 0x4b, 0x17, 0x57, 0x71, 0xe9, 0xfb, 0x55, 0x5c, 0x7a, 0xf7, 0x78, 0xe9, 0xa2, 0xba,
-	0xa9, 0x3b, 0x12, 0xed, 0x89, 0xd2, 0x47, 0xa1, 0xca, 0x91, 0x8f, 0x50, 0xa7, 0xa7, 0x4b, 0x6f,
-	0x94, 0x29, 0x8d, 0xd9, 0xaa, 0x7b, 0xa0, 0x0f, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x71, 0x9b,
-	0x65, 0x9f, 0x2c, 0x04, 0x00, 0x00,
+	// 821 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xda, 0x69, 0x1a, 0x3f, 0x37, 0x21, 0x0c, 0x51, 0x30, 0x01, 0xad, 0x2d, 0x23, 0x84,
+	0x25, 0xe8, 0x2e, 0x09, 0x08, 0x24, 0x38, 0x54, 0xb5, 0x69, 0x25, 0x10, 0x12, 0xd1, 0x92, 0x13,
+	0x97, 0xd5, 0xec, 0xec, 0x8b, 0x33, 0xf5, 0x7a, 0xc6, 0x9a, 0x19, 0xbb, 0x2d, 0x7f, 0x03, 0x87,
+	0x4a, 0x5c, 0x38, 0x22, 0x2e, 0x48, 0xfc, 0x15, 0x1c, 0x7b, 0x42, 0x3d, 0x72, 0xa2, 0x28, 0xf9,
+	0x47, 0xd0, 0xfc, 0x58, 0xdb, 0x40, 0x0e, 0x48, 0xed, 0xc9, 0xfb, 0xbe, 0xef, 0xcd, 0xfb, 0xde,
+	0xbc, 0xb7, 0xdf, 0x1a, 0x62, 0x85, 0x13, 0x14, 0x29, 0x32, 0xc9, 0x14, 0x96, 0xdc, 0xa4, 0xcb,
+	0xe3, 0xd4, 0x3c, 0x9e, 0xa3, 0x4e, 0xe6, 0x4a, 0x1a, 0x49, 0x88, 0xe3, 0x93, 0x15, 0x9f, 0x2c,
+	0x8f, 0x8f, 0x0e, 0x26, 0x72, 0x22, 0x1d, 0x9d, 0xda, 0x27, 0x9f, 0x79, 0xd4, 0x9b, 0x48, 0x39,
+	0xa9, 0x30, 0x75, 0x51, 0xb1, 0x38, 0x4f, 0x0d, 0x9f, 0xa1, 0x36, 0x74, 0x36, 0x0f, 0x09, 0x31,
+	0x93, 0x7a, 0x26, 0x75, 0x5a, 0x50, 0x8d, 0xe9, 0xf2, 0xb8, 0x40, 0x43, 0x8f, 0x53, 0x26, 0xb9,
+	0xa8, 0xf9, 0x6b, 0x5a, 0xd1, 0x86, 0x1a, 0xf4, 0xfc, 0xe0, 0xf7, 0x26, 0x6c, 0x9f, 0x52, 0x45,
+	0x67, 0x9a, 0x2c, 0x60, 0xdf, 0xe7, 0xe4, 0xac, 0xa2, 0x5a, 0xe7, 0xe7, 0x88, 0xdd, 0xa8, 0xdf,
+	0x1a, 0x76, 0x4e, 0xde, 0x48, 0xbc, 0x4a, 0x62, 0x55, 0x92, 0xa0, 0x92, 0x8c, 0x25, 0x17, 0xa3,
+	0x0f, 0x9e, 0xfe, 0xd9, 0x6b, 0xfc, 0xfa, 0xbc, 0x37, 0x9c, 0x70, 0x73, 0xb1, 0x28, 0x12, 0x26,
+	0x67, 0x69, 0x68, 0xc9, 0xff, 0xdc, 0xd6, 0xe5, 0x34, 0x5c, 0xde, 0x1e, 0xd0, 0xd9, 0x9e, 0x17,
+	0x19, 0x5b, 0x8d, 0xfb, 0x88, 0xe4, 0x01, 0x40, 0x41, 0xf5, 0x14, 0x8d, 0x13, 0x6c, 0xbe, 0x7c,
+	0xc1, 0xb6, 0x2f, 0x6f, 0xb5, 0x3e, 0x82, 0x43, 0x5a, 0x55, 0xf2, 0x21, 0x96, 0xe1, 0x8e, 0x4c,
+	0x21, 0x35, 0x52, 0xe9, 0x6e, 0xab, 0xdf, 0x1a, 0xb6, 0xb3, 0x83, 0xc0, 0xba, 0xe6, 0xc6, 0x81,
+	0x23, 0xef, 0xc1, 0xab, 0x0e, 0xaf, 0xb8, 0x36, 0x39, 0x0a, 0x5a, 0x54, 0x58, 0x76, 0xb7, 0xfa,
+	0xd1, 0x70, 0x27, 0xdb, 0x5f, 0x11, 0xf7, 0x3c, 0x3e, 0x18, 0xc1, 0xcd, 0xb1, 0xbb, 0xa0, 0x26,
+	0x3d, 0xe8, 0x14, 0xd4, 0xb0, 0x8b, 0xbc, 0x44, 0x21, 0x67, 0xdd, 0xa8, 0x1f, 0x0d, 0xdb, 0x19,
+	0x38, 0xe8, 0x73, 0x8b, 0x90, 0x43, 0xd8, 0xa6, 0x33, 0xb9, 0x10, 0xa6, 0xdb, 0x74, 0x5c, 0x88,
+	0x06, 0x3f, 0x37, 0x61, 0x77, 0x64, 0xd3, 0xbe, 0xd0, 0x7a, 0x41, 0x05, 0x43, 0xf2, 0x16, 0xb4,
+	0x15, 0x32, 0x3e, 0xe7, 0x28, 0x4c, 0x28, 0xb4, 0x06, 0xc8, 0xbb, 0xf0, 0x8a, 0x51, 0xb4, 0xb4,
+	0x0d, 0xe4, 0xff, 0x28, 0xb8, 0x57, 0xc3, 0x77, 0x1d, 0x4a, 0xde, 0x81, 0x3d, 0x85, 0x86, 0x2b,
+	0x2c, 0xeb, 0xbc, 0x96, 0xcb, 0xdb, 0x0d, 0x68, 0x48, 0xfb, 0x04, 0x5e, 0xf7, 0xc0, 0x0c, 0x85,
+	0xc9, 0x1f, 0x2c, 0x14, 0xd7, 0x25, 0x67, 0x86, 0x4b, 0xe1, 0xae, 0xdd, 0xce, 0x0e, 0xd7, 0xf4,
+	0x97, 0x1b, 0x2c, 0x79, 0x1b, 0x76, 0x2b, 0xc9, 0xa6, 0xeb, 0xf2, 0x37, 0x5c, 0xfa, 0x2d, 0x0f,
+	0x86, 0xea, 0xf7, 0x7c, 0x52, 0xae, 0xd9, 0x05, 0x96, 0x8b, 0x0a, 0xbb, 0xdb, 0xfd, 0x68, 0xd8,
+	0x39, 0xe9, 0x27, 0xff, 0x75, 0x45, 0xf2, 0x95, 0x64, 0xd3, 0x6f, 0x42, 0x9e, 0x2f, 0x53, 0x47,
+	0x83, 0xef, 0x23, 0xb8, 0xb5, 0x49, 0x93, 0x3b, 0x00, 0xda, 0x50, 0x65, 0x72, 0xeb, 0x11, 0x37,
+	0xa4, 0xce, 0xc9, 0x51, 0xe2, 0x0d, 0x94, 0xd4, 0x06, 0x4a, 0xce, 0x6a, 0x03, 0x8d, 0xb6, 0x9e,
+	0x3c, 0xef, 0x45, 0x59, 0xdb, 0x9d, 0xb1, 0x28, 0xf9, 0x0c, 0x76, 0x50, 0x94, 0xfe, 0x78, 0xf3,
+	0x7f, 0x1e, 0xbf, 0x89, 0xa2, 0xb4, 0xd8, 0xa0, 0x80, 0x9d, 0xaf, 0x15, 0x9f, 0x70, 0x71, 0xf6,
+	0x88, 0xec, 0x41, 0x93, 0x97, 0x61, 0x4d, 0x4d, 0x5e, 0xda, 0x3d, 0x6b, 0xb9, 0x50, 0x0c, 0xeb,
+	0x3d, 0xfb, 0x88, 0x1c, 0xc1, 0x0e, 0x93, 0xc2, 0x28, 0xca, 0xea, 0x45, 0xac, 0x62, 0x42, 0x60,
+	0x4b, 0x48, 0x83, 0x61, 0xe0, 0xee, 0x79, 0xf0, 0x43, 0x04, 0xc4, 0xbf, 0x5c, 0x67, 0x8f, 0xe7,
+	0x78, 0xaa, 0xe4, 0x5c, 0x6a, 0x5a, 0x91, 0x03, 0xb8, 0x61, 0xb8, 0xa9, 0x30, 0x28, 0xfa, 0x80,
+	0xf4, 0xa1, 0x53, 0xa2, 0x66, 0x8a, 0xcf, 0xdd, 0xe2, 0xbc, 0xf2, 0x26, 0x44, 0xee, 0x40, 0x27,
+	0x18, 0xde, 0xda, 0xc5, 0x75, 0xd0, 0x39, 0x89, 0xaf, 0x5b, 0xc3, 0x5a, 0x34, 0x03, 0xb6, 0x7a,
+	0xfe, 0x74, 0xeb, 0xc7, 0x9f, 0x7a, 0x8d, 0xc1, 0x2f, 0x11, 0xbc, 0xb9, 0xd9, 0x15, 0x32, 0xae,
+	0xb9, 0x14, 0x2f, 0xdc, 0xde, 0xfb, 0x40, 0x36, 0xda, 0xcb, 0x69, 0x51, 0x28, 0x5c, 0x86, 0x39,
+	0xed, 0xaf, 0xbb, 0xb8, 0xeb, 0x70, 0xeb, 0x90, 0x79, 0x2d, 0xed, 0x86, 0xb6, 0x9b, 0xad, 0x81,
+	0xd0, 0xe9, 0x6f, 0x11, 0xbc, 0xe6, 0x7c, 0x75, 0x5f, 0x21, 0x7e, 0xf7, 0xe2, 0x03, 0xfc, 0x97,
+	0xc1, 0x5b, 0xd7, 0x19, 0xfc, 0xdc, 0x49, 0x85, 0xcf, 0x45, 0x88, 0xec, 0xe2, 0x71, 0xc9, 0x4b,
+	0x14, 0x0c, 0x83, 0x45, 0x56, 0xb1, 0x3d, 0xa3, 0x90, 0x6a, 0x29, 0x9c, 0x2f, 0xda, 0x59, 0x88,
+	0xfc, 0x15, 0x46, 0xa7, 0x4f, 0x2f, 0xe3, 0xe8, 0xd9, 0x65, 0x1c, 0xfd, 0x75, 0x19, 0x47, 0x4f,
+	0xae, 0xe2, 0xc6, 0xb3, 0xab, 0xb8, 0xf1, 0xc7, 0x55, 0xdc, 0xf8, 0xf6, 0xe3, 0x8d, 0x0f, 0xa2,
+	0x5b, 0xe1, 0x6d, 0x81, 0xe6, 0xa1, 0x54, 0xd3, 0x10, 0x55, 0x58, 0x4e, 0x50, 0xa5, 0x8f, 0x36,
+	0xfe, 0x0b, 0x98, 0x54, 0x58, 0x6c, 0xbb, 0x77, 0xfb, 0xc3, 0xbf, 0x07, 0x00, 0xa9, 0x0e, 0x9b,
+	0xad, 0xb5, 0x06, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -571,6 +841,25 @@ func (m *BatchIssuance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LockSchedule != nil {
+		{
+			size, err := m.LockSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.LockedAmount) > 0 {
+		i -= len(m.LockedAmount)
+		copy(dAtA[i:], m.LockedAmount)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.LockedAmount)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.RetirementJurisdiction) > 0 {
 		i -= len(m.RetirementJurisdiction)
 		copy(dAtA[i:], m.RetirementJurisdiction)
@@ -602,6 +891,49 @@ func (m *BatchIssuance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LockSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintTypes(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StartTime != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintTypes(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *OriginTx) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -702,53 +1034,170 @@ func (m *CreditTypeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *CreditTypePrecisionProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *CreditTypePrecisionProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreditTypePrecisionProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.CreditClassFee) > 0 {
-		for _, e := range m.CreditClassFee {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
-		}
+	if m.Precision != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Precision))
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.BasketFee) > 0 {
-		for _, e := range m.BasketFee {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
-		}
+	if len(m.CreditTypeAbbrev) > 0 {
+		i -= len(m.CreditTypeAbbrev)
+		copy(dAtA[i:], m.CreditTypeAbbrev)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CreditTypeAbbrev)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.AllowedClassCreators) > 0 {
-		for _, s := range m.AllowedClassCreators {
-			l = len(s)
-			n += 1 + l + sovTypes(uint64(l))
-		}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.AllowlistEnabled {
-		n += 2
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Credits) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BatchFreezeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
+	return dAtA[:n], nil
+}
+
+func (m *BatchFreezeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchFreezeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Evidence) > 0 {
+		i -= len(m.Evidence)
+		copy(dAtA[i:], m.Evidence)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Evidence)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Freeze {
+		i--
+		if m.Freeze {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BatchDenom) > 0 {
+		i -= len(m.BatchDenom)
+		copy(dAtA[i:], m.BatchDenom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BatchDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CreditClassFee) > 0 {
+		for _, e := range m.CreditClassFee {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.BasketFee) > 0 {
+		for _, e := range m.BasketFee {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.AllowedClassCreators) > 0 {
+		for _, s := range m.AllowedClassCreators {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.AllowlistEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *Credits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = len(m.BatchDenom)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
@@ -782,6 +1231,31 @@ func (m *BatchIssuance) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.LockedAmount)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.LockSchedule != nil {
+		l = m.LockSchedule.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *LockSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -831,6 +1305,62 @@ func (m *CreditTypeProposal) Size() (n int) {
 	return n
 }
 
+func (m *CreditTypePrecisionProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.CreditTypeAbbrev)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Precision != 0 {
+		n += 1 + sovTypes(uint64(m.Precision))
+	}
+	return n
+}
+
+func (m *BatchFreezeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.BatchDenom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Freeze {
+		n += 2
+	}
+	l = len(m.Evidence)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -895,16 +1425,666 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreditClassFee = append(m.CreditClassFee, types.Coin{})
-			if err := m.CreditClassFee[len(m.CreditClassFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CreditClassFee = append(m.CreditClassFee, types.Coin{})
+			if err := m.CreditClassFee[len(m.CreditClassFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BasketFee = append(m.BasketFee, types.Coin{})
+			if err := m.BasketFee[len(m.BasketFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedClassCreators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedClassCreators = append(m.AllowedClassCreators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowlistEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Credits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Credits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Credits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchIssuance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchIssuance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchIssuance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradableAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TradableAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetiredAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetiredAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementJurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetirementJurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockedAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LockSchedule == nil {
+				m.LockSchedule = &LockSchedule{}
+			}
+			if err := m.LockSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OriginTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OriginTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OriginTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BasketFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -914,29 +2094,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BasketFee = append(m.BasketFee, types.Coin{})
-			if err := m.BasketFee[len(m.BasketFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedClassCreators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -964,13 +2142,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowedClassCreators = append(m.AllowedClassCreators, string(dAtA[iNdEx:postIndex]))
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistEnabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Note", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -980,12 +2158,24 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowlistEnabled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Note = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1007,7 +2197,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Credits) Unmarshal(dAtA []byte) error {
+func (m *CreditTypeProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1030,15 +2220,15 @@ func (m *Credits) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Credits: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreditTypeProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Credits: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreditTypeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1066,11 +2256,11 @@ func (m *Credits) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BatchDenom = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1098,7 +2288,43 @@ func (m *Credits) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreditType == nil {
+				m.CreditType = &CreditType{}
+			}
+			if err := m.CreditType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1121,7 +2347,7 @@ func (m *Credits) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchIssuance) Unmarshal(dAtA []byte) error {
+func (m *CreditTypePrecisionProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1144,15 +2370,15 @@ func (m *BatchIssuance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchIssuance: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreditTypePrecisionProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchIssuance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreditTypePrecisionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1180,11 +2406,11 @@ func (m *BatchIssuance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TradableAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1212,11 +2438,11 @@ func (m *BatchIssuance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TradableAmount = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetiredAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1244,13 +2470,13 @@ func (m *BatchIssuance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RetiredAmount = string(dAtA[iNdEx:postIndex])
+			m.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetirementJurisdiction", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
 			}
-			var stringLen uint64
+			m.Precision = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1260,24 +2486,11 @@ func (m *BatchIssuance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Precision |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RetirementJurisdiction = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1299,7 +2512,7 @@ func (m *BatchIssuance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OriginTx) Unmarshal(dAtA []byte) error {
+func (m *BatchFreezeProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1322,15 +2535,15 @@ func (m *OriginTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OriginTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchFreezeProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OriginTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchFreezeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1358,11 +2571,11 @@ func (m *OriginTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1390,11 +2603,11 @@ func (m *OriginTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1422,13 +2635,13 @@ func (m *OriginTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			m.BatchDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Note", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Freeze", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1438,77 +2651,15 @@ func (m *OriginTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Note = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CreditTypeProposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CreditTypeProposal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreditTypeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Freeze = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1536,11 +2687,11 @@ func (m *CreditTypeProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Evidence = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1568,43 +2719,7 @@ func (m *CreditTypeProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreditType", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CreditType == nil {
-				m.CreditType = &CreditType{}
-			}
-			if err := m.CreditType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13,7 +13,7 @@ func (i *BatchIssuance) Validate() error {
 		return sdkerrors.ErrInvalidAddress.Wrapf("recipient: %s", err)
 	}
 
-	if i.TradableAmount == "" && i.RetiredAmount == "" {
+	if i.TradableAmount == "" && i.RetiredAmount == "" && i.LockedAmount == "" {
 		return sdkerrors.ErrInvalidRequest.Wrap("tradable amount or retired amount required")
 	}
 
@@ -36,5 +36,22 @@ func (i *BatchIssuance) Validate() error {
 		}
 	}
 
+	if i.LockedAmount != "" {
+		lockedAmount, err := math.NewNonNegativeDecFromString(i.LockedAmount)
+		if err != nil {
+			return sdkerrors.Wrap(err, "locked amount")
+		}
+
+		if !lockedAmount.IsZero() {
+			if i.LockSchedule == nil {
+				return sdkerrors.ErrInvalidRequest.Wrap("lock schedule required")
+			}
+
+			if err = i.LockSchedule.Validate(); err != nil {
+				return sdkerrors.Wrap(err, "lock schedule")
+			}
+		}
+	}
+
 	return nil
 }
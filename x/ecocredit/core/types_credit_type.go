@@ -21,8 +21,21 @@ func (m CreditType) Validate() error {
 	if len(m.Unit) == 0 {
 		return sdkerrors.ErrInvalidRequest.Wrap("unit cannot be empty")
 	}
-	if m.Precision != PRECISION {
-		return sdkerrors.ErrInvalidRequest.Wrapf("credit type precision is currently locked to %d", PRECISION)
+	if err := ValidateCreditTypePrecision(m.Precision); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ValidateCreditTypePrecision validates the precision of a credit type. The
+// precision is used as the exponent of baskets of the credit type and must
+// therefore be an exponent supported by ExponentToPrefix.
+func ValidateCreditTypePrecision(precision uint32) error {
+	if precision > MaxCreditTypePrecision {
+		return sdkerrors.ErrInvalidRequest.Wrapf("credit type precision cannot exceed %d", MaxCreditTypePrecision)
+	}
+	if _, err := ExponentToPrefix(precision); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("credit type precision %d is not a supported basket exponent", precision)
 	}
 	return nil
 }
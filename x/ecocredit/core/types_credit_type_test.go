@@ -73,9 +73,28 @@ func TestCreditType_Validate(t *testing.T) {
 				Abbreviation: "C",
 				Name:         "carbon",
 				Unit:         "ton",
-				Precision:    3,
+				Precision:    19,
+			},
+			errMsg: "credit type precision cannot exceed 18",
+		},
+		{
+			name: "invalid precision not a basket exponent",
+			fields: fields{
+				Abbreviation: "C",
+				Name:         "carbon",
+				Unit:         "ton",
+				Precision:    4,
+			},
+			errMsg: "credit type precision 4 is not a supported basket exponent",
+		},
+		{
+			name: "valid zero precision",
+			fields: fields{
+				Abbreviation: "C",
+				Name:         "carbon",
+				Unit:         "ton",
+				Precision:    0,
 			},
-			errMsg: "credit type precision is currently locked to 6",
 		},
 	}
 	for _, tt := range tests {
@@ -0,0 +1,18 @@
+package core
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate checks if a LockSchedule is valid.
+func (s *LockSchedule) Validate() error {
+	if s.StartTime == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("start time cannot be empty")
+	}
+
+	if s.EndTime != nil && !s.EndTime.After(*s.StartTime) {
+		return sdkerrors.ErrInvalidRequest.Wrap("end time must be after start time")
+	}
+
+	return nil
+}
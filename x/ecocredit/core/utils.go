@@ -8,8 +8,11 @@ import (
 	"time"
 	"unicode"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	"github.com/regen-network/regen-ledger/types/math"
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 )
 
@@ -32,6 +35,14 @@ const (
 
 	// MaxNoteLength defines the max length for note fields.
 	MaxNoteLength = 512
+
+	// MaxBeneficiaryNameLength defines the max length of the name of the
+	// beneficiary on whose behalf credits are retired.
+	MaxBeneficiaryNameLength = 128
+
+	// bufferAddressPrefix is the prefix of the key used to derive the address
+	// of the buffer pool account of a credit class.
+	bufferAddressPrefix = "buffer/"
 )
 
 var (
@@ -178,6 +189,106 @@ func ValidateJurisdiction(jurisdiction string) error {
 	return nil
 }
 
+// ValidateRetirementBeneficiary checks that the optional beneficiary address is a
+// valid bech32 address, the beneficiary name does not exceed the max length, and
+// the reason for retiring credits does not exceed the max length.
+func ValidateRetirementBeneficiary(address, name, reason string) error {
+	if len(address) != 0 {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("beneficiary address: %s", err)
+		}
+	}
+
+	if len(name) > MaxBeneficiaryNameLength {
+		return ecocredit.ErrMaxLimit.Wrapf("beneficiary name: max length %d", MaxBeneficiaryNameLength)
+	}
+
+	if len(reason) > MaxNoteLength {
+		return ecocredit.ErrMaxLimit.Wrapf("reason: max length %d", MaxNoteLength)
+	}
+
+	return nil
+}
+
+// ValidateAllowanceScope checks that exactly one of the batch denom or the
+// class id of an allowance is set and that the one set is valid.
+func ValidateAllowanceScope(batchDenom, classId string) error {
+	switch {
+	case batchDenom == "" && classId == "":
+		return sdkerrors.ErrInvalidRequest.Wrap("batch denom or class id required")
+	case batchDenom != "" && classId != "":
+		return sdkerrors.ErrInvalidRequest.Wrap("only one of batch denom or class id can be set")
+	case batchDenom != "":
+		if err := ValidateBatchDenom(batchDenom); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+	default:
+		if err := ValidateClassId(classId); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+	}
+
+	return nil
+}
+
+// ValidateBufferPercentage validates that a buffer percentage is a
+// non-negative decimal less than 100.
+func ValidateBufferPercentage(percentage string) error {
+	dec, err := math.NewNonNegativeDecFromString(percentage)
+	if err != nil {
+		return err
+	}
+
+	if dec.Cmp(math.NewDecFromInt64(100)) >= 0 {
+		return fmt.Errorf("must be less than 100, got %s", percentage)
+	}
+
+	return nil
+}
+
+// BufferAddress returns the address of the account that holds the buffer pool
+// credits of a credit class. The address is derived from the module name and
+// the credit class ID and is not controlled by any private key.
+func BufferAddress(classId string) sdk.AccAddress {
+	return address.Module(ecocredit.ModuleName, []byte(bufferAddressPrefix+classId))
+}
+
+// ValidateProjectStatus checks that the project status is a known status other
+// than the unspecified status.
+func ValidateProjectStatus(status ProjectStatus) error {
+	if status == ProjectStatus_PROJECT_STATUS_UNSPECIFIED {
+		return fmt.Errorf("project status cannot be unspecified")
+	}
+
+	if _, ok := ProjectStatus_name[int32(status)]; !ok {
+		return fmt.Errorf("invalid project status %d", status)
+	}
+
+	return nil
+}
+
+// ValidateBatchFreeze checks that the batch denom is valid, that the evidence
+// is not empty, and that the evidence and the reason do not exceed the max length.
+func ValidateBatchFreeze(batchDenom, evidence, reason string) error {
+	if err := ValidateBatchDenom(batchDenom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if len(evidence) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("evidence cannot be empty")
+	}
+
+	if len(evidence) > MaxMetadataLength {
+		return ecocredit.ErrMaxLimit.Wrapf("evidence: max length %d", MaxMetadataLength)
+	}
+
+	if len(reason) > MaxNoteLength {
+		return ecocredit.ErrMaxLimit.Wrapf("reason: max length %d", MaxNoteLength)
+	}
+
+	return nil
+}
+
 // GetClassIdFromProjectId returns the credit class ID in a project ID.
 func GetClassIdFromProjectId(projectId string) string {
 	var s strings.Builder
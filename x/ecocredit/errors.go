@@ -8,4 +8,5 @@ var (
 	ErrMaxLimit            = sdkerrors.Register(ModuleName, 4, "limit exceeded")
 	ErrInvalidSellOrder    = sdkerrors.Register(ModuleName, 5, "invalid sell order")
 	ErrInvalidBuyOrder     = sdkerrors.Register(ModuleName, 6, "invalid buy order")
+	ErrFrozenBatch         = sdkerrors.Register(ModuleName, 7, "credit batch is frozen")
 )
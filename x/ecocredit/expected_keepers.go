@@ -35,6 +35,18 @@ type BankKeeper interface {
 	SetDenomMetaData(ctx sdk.Context, denomMetaData banktypes.Metadata)
 	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
+}
+
+// DistributionKeeper defines the expected interface needed to fund the community pool.
+type DistributionKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// DataKeeper defines the expected interface needed to verify data attestations.
+type DataKeeper interface {
+	// HasAttestation returns whether the attestor has attested to the data with the given IRI.
+	HasAttestation(ctx sdk.Context, iri string, attestor sdk.AccAddress) (bool, error)
 }
 
 type ParamKeeper interface {
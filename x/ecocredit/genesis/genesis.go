@@ -295,6 +295,7 @@ func calculateSupply(ctx context.Context, batchIdToPrecision map[uint64]uint32,
 		tradable := math.NewDecFromInt64(0)
 		retired := math.NewDecFromInt64(0)
 		escrowed := math.NewDecFromInt64(0)
+		locked := math.NewDecFromInt64(0)
 
 		balance, err := bbItr.Value()
 		if err != nil {
@@ -327,6 +328,13 @@ func calculateSupply(ctx context.Context, batchIdToPrecision map[uint64]uint32,
 			}
 		}
 
+		if balance.LockedAmount != "" {
+			locked, err = math.NewNonNegativeFixedDecFromString(balance.LockedAmount, precision)
+			if err != nil {
+				return err
+			}
+		}
+
 		total, err := math.Add(tradable, retired)
 		if err != nil {
 			return err
@@ -337,6 +345,11 @@ func calculateSupply(ctx context.Context, batchIdToPrecision map[uint64]uint32,
 			return err
 		}
 
+		total, err = math.Add(total, locked)
+		if err != nil {
+			return err
+		}
+
 		if supply, ok := batchIdToSupply[balance.BatchKey]; ok {
 			result, err := math.SafeAddBalance(supply, total)
 			if err != nil {
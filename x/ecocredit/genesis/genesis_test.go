@@ -180,14 +180,14 @@ func TestGenesisValidate(t *testing.T) {
 					Abbreviation: "C",
 					Name:         "carbon",
 					Unit:         "kg",
-					Precision:    7,
+					Precision:    19,
 				}))
 			},
 			func() core.Params {
 				return defaultParams
 			}(),
 			true,
-			"credit type precision is currently locked to 6",
+			"credit type precision cannot exceed 18",
 		},
 		{
 			"invalid: bad addresses in allowlist",
@@ -5,6 +5,7 @@ import (
 
 	basketapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
 	marketApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	orderbookApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/orderbook/v1alpha1"
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 )
 
@@ -22,6 +23,11 @@ var ModuleSchema = ormapi.ModuleSchemaDescriptor{
 		{Id: 1, ProtoFileName: basketapi.File_regen_ecocredit_basket_v1_state_proto.Path()},
 		{Id: 2, ProtoFileName: api.File_regen_ecocredit_v1_state_proto.Path()},
 		{Id: 3, ProtoFileName: marketApi.File_regen_ecocredit_marketplace_v1_state_proto.Path()},
+		// the orderbook tables are consensus state: they are written in the
+		// end blocker and by marketplace messages, are derived from the buy
+		// orders and sell orders in the marketplace state, and are rebuilt with
+		// OrderBook.Reload in InitGenesis and in store migrations
+		{Id: 4, ProtoFileName: orderbookApi.File_regen_ecocredit_orderbook_v1alpha1_memory_proto.Path()},
 	},
 	Prefix: []byte{ORMPrefix},
 }
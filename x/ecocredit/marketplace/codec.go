@@ -8,7 +8,13 @@ import (
 )
 
 func RegisterTypes(registry codectypes.InterfaceRegistry) {
-	registry.RegisterImplementations((*govtypes.Content)(nil), &AllowDenomProposal{})
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&AllowDenomProposal{},
+		&FeeScheduleProposal{},
+		&FeeDestinationProposal{},
+		&MarketRegistrationProposal{},
+		&DelistMarketProposal{},
+	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
@@ -17,6 +23,20 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSell{}, "regen.marketplace/MsgSell", nil)
 	cdc.RegisterConcrete(&MsgCancelSellOrder{}, "regen.marketplace/MsgCancelSellOrder", nil)
 	cdc.RegisterConcrete(&MsgUpdateSellOrders{}, "regen.marketplace/MsgUpdateSellOrders", nil)
+	cdc.RegisterConcrete(&MsgBuy{}, "regen.marketplace/MsgBuy", nil)
+	cdc.RegisterConcrete(&MsgCancelBuyOrder{}, "regen.marketplace/MsgCancelBuyOrder", nil)
+	cdc.RegisterConcrete(&MsgMakeOffer{}, "regen.marketplace/MsgMakeOffer", nil)
+	cdc.RegisterConcrete(&MsgAcceptOffer{}, "regen.marketplace/MsgAcceptOffer", nil)
+	cdc.RegisterConcrete(&MsgCancelOffer{}, "regen.marketplace/MsgCancelOffer", nil)
+	cdc.RegisterConcrete(&MsgCreateDutchAuction{}, "regen.marketplace/MsgCreateDutchAuction", nil)
+	cdc.RegisterConcrete(&MsgBuyDutchAuction{}, "regen.marketplace/MsgBuyDutchAuction", nil)
+	cdc.RegisterConcrete(&MsgCancelDutchAuction{}, "regen.marketplace/MsgCancelDutchAuction", nil)
+	cdc.RegisterConcrete(&MsgCreateSealedBidAuction{}, "regen.marketplace/MsgCreateSealedBidAuction", nil)
+	cdc.RegisterConcrete(&MsgCommitSealedBid{}, "regen.marketplace/MsgCommitSealedBid", nil)
+	cdc.RegisterConcrete(&MsgRevealSealedBid{}, "regen.marketplace/MsgRevealSealedBid", nil)
+	cdc.RegisterConcrete(&MsgCreateForwardSale{}, "regen.marketplace/MsgCreateForwardSale", nil)
+	cdc.RegisterConcrete(&MsgBuyForwardContract{}, "regen.marketplace/MsgBuyForwardContract", nil)
+	cdc.RegisterConcrete(&MsgRegisterMarket{}, "regen.marketplace/MsgRegisterMarket", nil)
 }
 
 var (
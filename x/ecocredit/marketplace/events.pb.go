@@ -5,6 +5,7 @@ package marketplace
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -73,6 +74,12 @@ type EventBuyDirect struct {
 	// sell_order_id is the unique identifier of the sell order that credits were
 	// purchased from.
 	SellOrderId uint64 `protobuf:"varint,1,opt,name=sell_order_id,json=sellOrderId,proto3" json:"sell_order_id,omitempty"`
+	// maker_fee is the fee paid by the seller.
+	MakerFee *types.Coin `protobuf:"bytes,2,opt,name=maker_fee,json=makerFee,proto3" json:"maker_fee,omitempty"`
+	// taker_fee is the fee paid by the buyer.
+	TakerFee *types.Coin `protobuf:"bytes,3,opt,name=taker_fee,json=takerFee,proto3" json:"taker_fee,omitempty"`
+	// trade_id is the unique identifier of the trade.
+	TradeId uint64 `protobuf:"varint,4,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
 }
 
 func (m *EventBuyDirect) Reset()         { *m = EventBuyDirect{} }
@@ -115,6 +122,27 @@ func (m *EventBuyDirect) GetSellOrderId() uint64 {
 	return 0
 }
 
+func (m *EventBuyDirect) GetMakerFee() *types.Coin {
+	if m != nil {
+		return m.MakerFee
+	}
+	return nil
+}
+
+func (m *EventBuyDirect) GetTakerFee() *types.Coin {
+	if m != nil {
+		return m.TakerFee
+	}
+	return nil
+}
+
+func (m *EventBuyDirect) GetTradeId() uint64 {
+	if m != nil {
+		return m.TradeId
+	}
+	return 0
+}
+
 // EventUpdateSellOrder is an event emitted when a sell order is updated.
 type EventUpdateSellOrder struct {
 	//  sell_order_id is the unique identifier of the sell order that was updated.
@@ -208,6 +236,81 @@ func (m *EventCancelSellOrder) GetSellOrderId() uint64 {
 	return 0
 }
 
+// EventExpireSellOrder is an event emitted when an expired sell order is
+// pruned and the remaining credits are returned to the seller.
+type EventExpireSellOrder struct {
+	// sell_order_id is the unique identifier of the sell order that expired.
+	SellOrderId uint64 `protobuf:"varint,1,opt,name=sell_order_id,json=sellOrderId,proto3" json:"sell_order_id,omitempty"`
+	// seller is the address of the account that created the sell order.
+	Seller string `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// batch_denom is the denom of the credit batch of the sell order.
+	BatchDenom string `protobuf:"bytes,3,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// quantity is the quantity of credits returned from escrow to the tradable
+	// balance of the seller.
+	Quantity string `protobuf:"bytes,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+}
+
+func (m *EventExpireSellOrder) Reset()         { *m = EventExpireSellOrder{} }
+func (m *EventExpireSellOrder) String() string { return proto.CompactTextString(m) }
+func (*EventExpireSellOrder) ProtoMessage()    {}
+func (*EventExpireSellOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{4}
+}
+func (m *EventExpireSellOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventExpireSellOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventExpireSellOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventExpireSellOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventExpireSellOrder.Merge(m, src)
+}
+func (m *EventExpireSellOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventExpireSellOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventExpireSellOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventExpireSellOrder proto.InternalMessageInfo
+
+func (m *EventExpireSellOrder) GetSellOrderId() uint64 {
+	if m != nil {
+		return m.SellOrderId
+	}
+	return 0
+}
+
+func (m *EventExpireSellOrder) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *EventExpireSellOrder) GetBatchDenom() string {
+	if m != nil {
+		return m.BatchDenom
+	}
+	return ""
+}
+
+func (m *EventExpireSellOrder) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
+	}
+	return ""
+}
+
 // EventAllowDenom is an event emitted when a new denom is added for use in the
 // marketplace.
 type EventAllowDenom struct {
@@ -220,7 +323,7 @@ func (m *EventAllowDenom) Reset()         { *m = EventAllowDenom{} }
 func (m *EventAllowDenom) String() string { return proto.CompactTextString(m) }
 func (*EventAllowDenom) ProtoMessage()    {}
 func (*EventAllowDenom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_68b71b54d42cf1d9, []int{4}
+	return fileDescriptor_68b71b54d42cf1d9, []int{5}
 }
 func (m *EventAllowDenom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -256,293 +359,6067 @@ func (m *EventAllowDenom) GetDenom() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*EventSell)(nil), "regen.ecocredit.marketplace.v1.EventSell")
-	proto.RegisterType((*EventBuyDirect)(nil), "regen.ecocredit.marketplace.v1.EventBuyDirect")
-	proto.RegisterType((*EventUpdateSellOrder)(nil), "regen.ecocredit.marketplace.v1.EventUpdateSellOrder")
-	proto.RegisterType((*EventCancelSellOrder)(nil), "regen.ecocredit.marketplace.v1.EventCancelSellOrder")
-	proto.RegisterType((*EventAllowDenom)(nil), "regen.ecocredit.marketplace.v1.EventAllowDenom")
+// EventBuy is an event emitted when a buy order is created.
+type EventBuy struct {
+	// buy_order_id is the unique identifier of the buy order that was created.
+	BuyOrderId uint64 `protobuf:"varint,1,opt,name=buy_order_id,json=buyOrderId,proto3" json:"buy_order_id,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("regen/ecocredit/marketplace/v1/events.proto", fileDescriptor_68b71b54d42cf1d9)
+func (m *EventBuy) Reset()         { *m = EventBuy{} }
+func (m *EventBuy) String() string { return proto.CompactTextString(m) }
+func (*EventBuy) ProtoMessage()    {}
+func (*EventBuy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{6}
 }
-
-var fileDescriptor_68b71b54d42cf1d9 = []byte{
-	// 263 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x2e, 0x4a, 0x4d, 0x4f,
-	0xcd, 0xd3, 0x4f, 0x4d, 0xce, 0x4f, 0x2e, 0x4a, 0x4d, 0xc9, 0x2c, 0xd1, 0xcf, 0x4d, 0x2c, 0xca,
-	0x4e, 0x2d, 0x29, 0xc8, 0x49, 0x4c, 0x4e, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b,
-	0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x03, 0x2b, 0xd6, 0x83, 0x2b, 0xd6, 0x43,
-	0x52, 0xac, 0x57, 0x66, 0xa8, 0xa4, 0xcf, 0xc5, 0xe9, 0x0a, 0x52, 0x1f, 0x9c, 0x9a, 0x93, 0x23,
-	0xa4, 0xc4, 0xc5, 0x5b, 0x9c, 0x9a, 0x93, 0x13, 0x9f, 0x5f, 0x94, 0x92, 0x5a, 0x14, 0x9f, 0x99,
-	0x22, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x12, 0xc4, 0x0d, 0x12, 0xf4, 0x07, 0x89, 0x79, 0xa6, 0x28,
-	0x99, 0x70, 0xf1, 0x81, 0x35, 0x38, 0x95, 0x56, 0xba, 0x64, 0x16, 0xa5, 0x26, 0x97, 0x10, 0xa5,
-	0xcb, 0x8a, 0x4b, 0x04, 0xac, 0x2b, 0xb4, 0x20, 0x25, 0xb1, 0x24, 0x35, 0x18, 0x26, 0x43, 0x92,
-	0x5e, 0xe7, 0xc4, 0xbc, 0xe4, 0xd4, 0x1c, 0xd2, 0xf4, 0xaa, 0x73, 0xf1, 0x83, 0xf5, 0x3a, 0xe6,
-	0xe4, 0xe4, 0x97, 0xbb, 0xa4, 0xe6, 0xe5, 0xe7, 0x0a, 0x89, 0x70, 0xb1, 0xa6, 0x80, 0x18, 0x60,
-	0xe5, 0x9c, 0x41, 0x10, 0x8e, 0x53, 0xf8, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e,
-	0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31,
-	0x44, 0xd9, 0xa6, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x83, 0x03, 0x53,
-	0x37, 0x2f, 0xb5, 0xa4, 0x3c, 0xbf, 0x28, 0x1b, 0xca, 0xcb, 0x49, 0x4d, 0x49, 0x4f, 0x2d, 0xd2,
-	0xaf, 0xc0, 0x1e, 0x21, 0x49, 0x6c, 0xe0, 0x78, 0x30, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xee,
-	0x7d, 0xb4, 0x67, 0xb6, 0x01, 0x00, 0x00,
+func (m *EventBuy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBuy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBuy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBuy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBuy.Merge(m, src)
+}
+func (m *EventBuy) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBuy) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBuy.DiscardUnknown(m)
 }
 
-func (m *EventSell) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventBuy proto.InternalMessageInfo
+
+func (m *EventBuy) GetBuyOrderId() uint64 {
+	if m != nil {
+		return m.BuyOrderId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventSell) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventCancelBuyOrder is an event emitted when a buy order is cancelled.
+type EventCancelBuyOrder struct {
+	// buy_order_id is the unique identifier of the buy order that was
+	// cancelled.
+	BuyOrderId uint64 `protobuf:"varint,1,opt,name=buy_order_id,json=buyOrderId,proto3" json:"buy_order_id,omitempty"`
 }
 
-func (m *EventSell) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SellOrderId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SellOrderId))
-		i--
-		dAtA[i] = 0x8
+func (m *EventCancelBuyOrder) Reset()         { *m = EventCancelBuyOrder{} }
+func (m *EventCancelBuyOrder) String() string { return proto.CompactTextString(m) }
+func (*EventCancelBuyOrder) ProtoMessage()    {}
+func (*EventCancelBuyOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{7}
+}
+func (m *EventCancelBuyOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCancelBuyOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCancelBuyOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventCancelBuyOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancelBuyOrder.Merge(m, src)
+}
+func (m *EventCancelBuyOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCancelBuyOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancelBuyOrder.DiscardUnknown(m)
 }
 
-func (m *EventBuyDirect) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventCancelBuyOrder proto.InternalMessageInfo
+
+func (m *EventCancelBuyOrder) GetBuyOrderId() uint64 {
+	if m != nil {
+		return m.BuyOrderId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventBuyDirect) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventExpireBuyOrder is an event emitted when an expired buy order is pruned
+// and the escrowed funds for the remaining quantity are returned to the buyer.
+type EventExpireBuyOrder struct {
+	// buy_order_id is the unique identifier of the buy order that expired.
+	BuyOrderId uint64 `protobuf:"varint,1,opt,name=buy_order_id,json=buyOrderId,proto3" json:"buy_order_id,omitempty"`
+	// buyer is the address of the account that created the buy order.
+	Buyer string `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// quantity is the remaining quantity of credits of the buy order for which
+	// the escrowed funds are returned to the buyer.
+	Quantity string `protobuf:"bytes,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
 }
 
-func (m *EventBuyDirect) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SellOrderId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SellOrderId))
-		i--
-		dAtA[i] = 0x8
+func (m *EventExpireBuyOrder) Reset()         { *m = EventExpireBuyOrder{} }
+func (m *EventExpireBuyOrder) String() string { return proto.CompactTextString(m) }
+func (*EventExpireBuyOrder) ProtoMessage()    {}
+func (*EventExpireBuyOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{8}
+}
+func (m *EventExpireBuyOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventExpireBuyOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventExpireBuyOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventExpireBuyOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventExpireBuyOrder.Merge(m, src)
+}
+func (m *EventExpireBuyOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventExpireBuyOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventExpireBuyOrder.DiscardUnknown(m)
 }
 
-func (m *EventUpdateSellOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventExpireBuyOrder proto.InternalMessageInfo
+
+func (m *EventExpireBuyOrder) GetBuyOrderId() uint64 {
+	if m != nil {
+		return m.BuyOrderId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventUpdateSellOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventExpireBuyOrder) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
 }
 
-func (m *EventUpdateSellOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SellOrderId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SellOrderId))
-		i--
-		dAtA[i] = 0x8
+func (m *EventExpireBuyOrder) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventCancelSellOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// EventFillBuyOrder is an event emitted when a buy order is matched with a
+// sell order and filled, either partially or completely, in the order book.
+type EventFillBuyOrder struct {
+	// buy_order_id is the unique identifier of the buy order that was filled.
+	BuyOrderId uint64 `protobuf:"varint,1,opt,name=buy_order_id,json=buyOrderId,proto3" json:"buy_order_id,omitempty"`
+	// sell_order_id is the unique identifier of the sell order that credits were
+	// purchased from.
+	SellOrderId uint64 `protobuf:"varint,2,opt,name=sell_order_id,json=sellOrderId,proto3" json:"sell_order_id,omitempty"`
+	// quantity is the quantity of credits that were purchased.
+	Quantity string `protobuf:"bytes,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// maker_fee is the fee paid by the seller.
+	MakerFee *types.Coin `protobuf:"bytes,4,opt,name=maker_fee,json=makerFee,proto3" json:"maker_fee,omitempty"`
+	// taker_fee is the fee paid by the buyer.
+	TakerFee *types.Coin `protobuf:"bytes,5,opt,name=taker_fee,json=takerFee,proto3" json:"taker_fee,omitempty"`
+	// trade_id is the unique identifier of the trade.
+	TradeId uint64 `protobuf:"varint,6,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+}
+
+func (m *EventFillBuyOrder) Reset()         { *m = EventFillBuyOrder{} }
+func (m *EventFillBuyOrder) String() string { return proto.CompactTextString(m) }
+func (*EventFillBuyOrder) ProtoMessage()    {}
+func (*EventFillBuyOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{9}
+}
+func (m *EventFillBuyOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFillBuyOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFillBuyOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *EventFillBuyOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFillBuyOrder.Merge(m, src)
+}
+func (m *EventFillBuyOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFillBuyOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFillBuyOrder.DiscardUnknown(m)
 }
 
-func (m *EventCancelSellOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_EventFillBuyOrder proto.InternalMessageInfo
+
+func (m *EventFillBuyOrder) GetBuyOrderId() uint64 {
+	if m != nil {
+		return m.BuyOrderId
+	}
+	return 0
 }
 
-func (m *EventCancelSellOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SellOrderId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SellOrderId))
-		i--
-		dAtA[i] = 0x8
+func (m *EventFillBuyOrder) GetSellOrderId() uint64 {
+	if m != nil {
+		return m.SellOrderId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventAllowDenom) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventFillBuyOrder) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventAllowDenom) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventFillBuyOrder) GetMakerFee() *types.Coin {
+	if m != nil {
+		return m.MakerFee
+	}
+	return nil
 }
 
-func (m *EventAllowDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventFillBuyOrder) GetTakerFee() *types.Coin {
+	if m != nil {
+		return m.TakerFee
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventFillBuyOrder) GetTradeId() uint64 {
+	if m != nil {
+		return m.TradeId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *EventSell) Size() (n int) {
-	if m == nil {
-		return 0
+
+// EventSetFeeSchedule is emitted when the fees of a market or of a credit type
+// are set.
+type EventSetFeeSchedule struct {
+	// credit_type_abbrev is the abbreviation of the credit type.
+	CreditTypeAbbrev string `protobuf:"bytes,1,opt,name=credit_type_abbrev,json=creditTypeAbbrev,proto3" json:"credit_type_abbrev,omitempty"`
+	// bank_denom is the bank denom of the market. The bank denom is empty if the
+	// fees were set for the credit type.
+	BankDenom string `protobuf:"bytes,2,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
+	// maker_fee_bps is the maker fee in basis points.
+	MakerFeeBps uint32 `protobuf:"varint,3,opt,name=maker_fee_bps,json=makerFeeBps,proto3" json:"maker_fee_bps,omitempty"`
+	// taker_fee_bps is the taker fee in basis points.
+	TakerFeeBps uint32 `protobuf:"varint,4,opt,name=taker_fee_bps,json=takerFeeBps,proto3" json:"taker_fee_bps,omitempty"`
+}
+
+func (m *EventSetFeeSchedule) Reset()         { *m = EventSetFeeSchedule{} }
+func (m *EventSetFeeSchedule) String() string { return proto.CompactTextString(m) }
+func (*EventSetFeeSchedule) ProtoMessage()    {}
+func (*EventSetFeeSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{10}
+}
+func (m *EventSetFeeSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetFeeSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetFeeSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.SellOrderId != 0 {
-		n += 1 + sovEvents(uint64(m.SellOrderId))
+}
+func (m *EventSetFeeSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetFeeSchedule.Merge(m, src)
+}
+func (m *EventSetFeeSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetFeeSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetFeeSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetFeeSchedule proto.InternalMessageInfo
+
+func (m *EventSetFeeSchedule) GetCreditTypeAbbrev() string {
+	if m != nil {
+		return m.CreditTypeAbbrev
 	}
-	return n
+	return ""
 }
 
-func (m *EventBuyDirect) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSetFeeSchedule) GetBankDenom() string {
+	if m != nil {
+		return m.BankDenom
 	}
-	var l int
-	_ = l
-	if m.SellOrderId != 0 {
-		n += 1 + sovEvents(uint64(m.SellOrderId))
+	return ""
+}
+
+func (m *EventSetFeeSchedule) GetMakerFeeBps() uint32 {
+	if m != nil {
+		return m.MakerFeeBps
 	}
-	return n
+	return 0
 }
 
-func (m *EventUpdateSellOrder) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventSetFeeSchedule) GetTakerFeeBps() uint32 {
+	if m != nil {
+		return m.TakerFeeBps
 	}
-	var l int
-	_ = l
-	if m.SellOrderId != 0 {
-		n += 1 + sovEvents(uint64(m.SellOrderId))
+	return 0
+}
+
+// EventSetFeeDestination is emitted when the destination of marketplace fees
+// is set.
+type EventSetFeeDestination struct {
+	// destination is the type of the fee destination.
+	Destination FeeDestinationType `protobuf:"varint,1,opt,name=destination,proto3,enum=regen.ecocredit.marketplace.v1.FeeDestinationType" json:"destination,omitempty"`
+	// address is the address of the account receiving fees.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *EventSetFeeDestination) Reset()         { *m = EventSetFeeDestination{} }
+func (m *EventSetFeeDestination) String() string { return proto.CompactTextString(m) }
+func (*EventSetFeeDestination) ProtoMessage()    {}
+func (*EventSetFeeDestination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{11}
+}
+func (m *EventSetFeeDestination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetFeeDestination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetFeeDestination.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *EventSetFeeDestination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetFeeDestination.Merge(m, src)
+}
+func (m *EventSetFeeDestination) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetFeeDestination) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetFeeDestination.DiscardUnknown(m)
 }
 
-func (m *EventCancelSellOrder) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_EventSetFeeDestination proto.InternalMessageInfo
+
+func (m *EventSetFeeDestination) GetDestination() FeeDestinationType {
+	if m != nil {
+		return m.Destination
 	}
-	var l int
-	_ = l
-	if m.SellOrderId != 0 {
-		n += 1 + sovEvents(uint64(m.SellOrderId))
+	return FeeDestinationType_FEE_DESTINATION_TYPE_UNSPECIFIED
+}
+
+func (m *EventSetFeeDestination) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return n
+	return ""
 }
 
-func (m *EventAllowDenom) Size() (n int) {
-	if m == nil {
-		return 0
+// EventMakeOffer is an event emitted when an offer is created.
+type EventMakeOffer struct {
+	// offer_id is the unique identifier of the offer that was created.
+	OfferId uint64 `protobuf:"varint,1,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+}
+
+func (m *EventMakeOffer) Reset()         { *m = EventMakeOffer{} }
+func (m *EventMakeOffer) String() string { return proto.CompactTextString(m) }
+func (*EventMakeOffer) ProtoMessage()    {}
+func (*EventMakeOffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{12}
+}
+func (m *EventMakeOffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMakeOffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMakeOffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+}
+func (m *EventMakeOffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMakeOffer.Merge(m, src)
+}
+func (m *EventMakeOffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMakeOffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMakeOffer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMakeOffer proto.InternalMessageInfo
+
+func (m *EventMakeOffer) GetOfferId() uint64 {
+	if m != nil {
+		return m.OfferId
 	}
-	return n
+	return 0
 }
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+// EventAcceptOffer is an event emitted when an offer is accepted, either
+// partially or completely.
+type EventAcceptOffer struct {
+	// offer_id is the unique identifier of the offer that was accepted.
+	OfferId uint64 `protobuf:"varint,1,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+	// holder is the address of the account that sold credits to the buyer.
+	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	// quantity is the quantity of credits that were sold.
+	Quantity string `protobuf:"bytes,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// maker_fee is the fee paid by the buyer.
+	MakerFee *types.Coin `protobuf:"bytes,4,opt,name=maker_fee,json=makerFee,proto3" json:"maker_fee,omitempty"`
+	// taker_fee is the fee paid by the holder.
+	TakerFee *types.Coin `protobuf:"bytes,5,opt,name=taker_fee,json=takerFee,proto3" json:"taker_fee,omitempty"`
+	// trade_id is the unique identifier of the trade that was recorded.
+	TradeId uint64 `protobuf:"varint,6,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
 }
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *EventAcceptOffer) Reset()         { *m = EventAcceptOffer{} }
+func (m *EventAcceptOffer) String() string { return proto.CompactTextString(m) }
+func (*EventAcceptOffer) ProtoMessage()    {}
+func (*EventAcceptOffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{13}
 }
-func (m *EventSell) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+func (m *EventAcceptOffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAcceptOffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAcceptOffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAcceptOffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAcceptOffer.Merge(m, src)
+}
+func (m *EventAcceptOffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAcceptOffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAcceptOffer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAcceptOffer proto.InternalMessageInfo
+
+func (m *EventAcceptOffer) GetOfferId() uint64 {
+	if m != nil {
+		return m.OfferId
+	}
+	return 0
+}
+
+func (m *EventAcceptOffer) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+func (m *EventAcceptOffer) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
+	}
+	return ""
+}
+
+func (m *EventAcceptOffer) GetMakerFee() *types.Coin {
+	if m != nil {
+		return m.MakerFee
+	}
+	return nil
+}
+
+func (m *EventAcceptOffer) GetTakerFee() *types.Coin {
+	if m != nil {
+		return m.TakerFee
+	}
+	return nil
+}
+
+func (m *EventAcceptOffer) GetTradeId() uint64 {
+	if m != nil {
+		return m.TradeId
+	}
+	return 0
+}
+
+// EventCancelOffer is an event emitted when an offer is cancelled.
+type EventCancelOffer struct {
+	// offer_id is the unique identifier of the offer that was cancelled.
+	OfferId uint64 `protobuf:"varint,1,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+}
+
+func (m *EventCancelOffer) Reset()         { *m = EventCancelOffer{} }
+func (m *EventCancelOffer) String() string { return proto.CompactTextString(m) }
+func (*EventCancelOffer) ProtoMessage()    {}
+func (*EventCancelOffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{14}
+}
+func (m *EventCancelOffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCancelOffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCancelOffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCancelOffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancelOffer.Merge(m, src)
+}
+func (m *EventCancelOffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCancelOffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancelOffer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCancelOffer proto.InternalMessageInfo
+
+func (m *EventCancelOffer) GetOfferId() uint64 {
+	if m != nil {
+		return m.OfferId
+	}
+	return 0
+}
+
+// EventCreateDutchAuction is an event emitted when a dutch auction is
+// created.
+type EventCreateDutchAuction struct {
+	// auction_id is the unique identifier of the dutch auction that was created.
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+}
+
+func (m *EventCreateDutchAuction) Reset()         { *m = EventCreateDutchAuction{} }
+func (m *EventCreateDutchAuction) String() string { return proto.CompactTextString(m) }
+func (*EventCreateDutchAuction) ProtoMessage()    {}
+func (*EventCreateDutchAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{15}
+}
+func (m *EventCreateDutchAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCreateDutchAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCreateDutchAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCreateDutchAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCreateDutchAuction.Merge(m, src)
+}
+func (m *EventCreateDutchAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCreateDutchAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCreateDutchAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCreateDutchAuction proto.InternalMessageInfo
+
+func (m *EventCreateDutchAuction) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
+	}
+	return 0
+}
+
+// EventBuyDutchAuction is an event emitted when credits are bought from a
+// dutch auction.
+type EventBuyDutchAuction struct {
+	// auction_id is the unique identifier of the dutch auction.
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// quantity is the quantity of credits that were bought.
+	Quantity string `protobuf:"bytes,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// price is the ask price per credit at the time of the purchase.
+	Price *types.Coin `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	// maker_fee is the fee paid by the seller.
+	MakerFee *types.Coin `protobuf:"bytes,4,opt,name=maker_fee,json=makerFee,proto3" json:"maker_fee,omitempty"`
+	// taker_fee is the fee paid by the buyer.
+	TakerFee *types.Coin `protobuf:"bytes,5,opt,name=taker_fee,json=takerFee,proto3" json:"taker_fee,omitempty"`
+	// trade_id is the unique identifier of the trade that was recorded.
+	TradeId uint64 `protobuf:"varint,6,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+}
+
+func (m *EventBuyDutchAuction) Reset()         { *m = EventBuyDutchAuction{} }
+func (m *EventBuyDutchAuction) String() string { return proto.CompactTextString(m) }
+func (*EventBuyDutchAuction) ProtoMessage()    {}
+func (*EventBuyDutchAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{16}
+}
+func (m *EventBuyDutchAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBuyDutchAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBuyDutchAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBuyDutchAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBuyDutchAuction.Merge(m, src)
+}
+func (m *EventBuyDutchAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBuyDutchAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBuyDutchAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBuyDutchAuction proto.InternalMessageInfo
+
+func (m *EventBuyDutchAuction) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
+	}
+	return 0
+}
+
+func (m *EventBuyDutchAuction) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
+	}
+	return ""
+}
+
+func (m *EventBuyDutchAuction) GetPrice() *types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return nil
+}
+
+func (m *EventBuyDutchAuction) GetMakerFee() *types.Coin {
+	if m != nil {
+		return m.MakerFee
+	}
+	return nil
+}
+
+func (m *EventBuyDutchAuction) GetTakerFee() *types.Coin {
+	if m != nil {
+		return m.TakerFee
+	}
+	return nil
+}
+
+func (m *EventBuyDutchAuction) GetTradeId() uint64 {
+	if m != nil {
+		return m.TradeId
+	}
+	return 0
+}
+
+// EventCancelDutchAuction is an event emitted when a dutch auction is
+// cancelled.
+type EventCancelDutchAuction struct {
+	// auction_id is the unique identifier of the dutch auction that was
+	// cancelled.
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+}
+
+func (m *EventCancelDutchAuction) Reset()         { *m = EventCancelDutchAuction{} }
+func (m *EventCancelDutchAuction) String() string { return proto.CompactTextString(m) }
+func (*EventCancelDutchAuction) ProtoMessage()    {}
+func (*EventCancelDutchAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{17}
+}
+func (m *EventCancelDutchAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCancelDutchAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCancelDutchAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCancelDutchAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancelDutchAuction.Merge(m, src)
+}
+func (m *EventCancelDutchAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCancelDutchAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancelDutchAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCancelDutchAuction proto.InternalMessageInfo
+
+func (m *EventCancelDutchAuction) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
+	}
+	return 0
+}
+
+// EventCreateSealedBidAuction is an event emitted when a sealed-bid auction is
+// created.
+type EventCreateSealedBidAuction struct {
+	// auction_id is the unique identifier of the sealed-bid auction that was
+	// created.
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+}
+
+func (m *EventCreateSealedBidAuction) Reset()         { *m = EventCreateSealedBidAuction{} }
+func (m *EventCreateSealedBidAuction) String() string { return proto.CompactTextString(m) }
+func (*EventCreateSealedBidAuction) ProtoMessage()    {}
+func (*EventCreateSealedBidAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{18}
+}
+func (m *EventCreateSealedBidAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCreateSealedBidAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCreateSealedBidAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCreateSealedBidAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCreateSealedBidAuction.Merge(m, src)
+}
+func (m *EventCreateSealedBidAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCreateSealedBidAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCreateSealedBidAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCreateSealedBidAuction proto.InternalMessageInfo
+
+func (m *EventCreateSealedBidAuction) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
+	}
+	return 0
+}
+
+// EventCommitSealedBid is an event emitted when a bid is committed to a
+// sealed-bid auction.
+type EventCommitSealedBid struct {
+	// auction_id is the unique identifier of the sealed-bid auction.
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// bidder is the address of the account that committed the bid.
+	Bidder string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+}
+
+func (m *EventCommitSealedBid) Reset()         { *m = EventCommitSealedBid{} }
+func (m *EventCommitSealedBid) String() string { return proto.CompactTextString(m) }
+func (*EventCommitSealedBid) ProtoMessage()    {}
+func (*EventCommitSealedBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{19}
+}
+func (m *EventCommitSealedBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCommitSealedBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCommitSealedBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCommitSealedBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCommitSealedBid.Merge(m, src)
+}
+func (m *EventCommitSealedBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCommitSealedBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCommitSealedBid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCommitSealedBid proto.InternalMessageInfo
+
+func (m *EventCommitSealedBid) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
+	}
+	return 0
+}
+
+func (m *EventCommitSealedBid) GetBidder() string {
+	if m != nil {
+		return m.Bidder
+	}
+	return ""
+}
+
+// EventRevealSealedBid is an event emitted when a bid is revealed in a
+// sealed-bid auction.
+type EventRevealSealedBid struct {
+	// auction_id is the unique identifier of the sealed-bid auction.
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// bidder is the address of the account that revealed the bid.
+	Bidder string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+}
+
+func (m *EventRevealSealedBid) Reset()         { *m = EventRevealSealedBid{} }
+func (m *EventRevealSealedBid) String() string { return proto.CompactTextString(m) }
+func (*EventRevealSealedBid) ProtoMessage()    {}
+func (*EventRevealSealedBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{20}
+}
+func (m *EventRevealSealedBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRevealSealedBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRevealSealedBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRevealSealedBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRevealSealedBid.Merge(m, src)
+}
+func (m *EventRevealSealedBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRevealSealedBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRevealSealedBid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRevealSealedBid proto.InternalMessageInfo
+
+func (m *EventRevealSealedBid) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
+	}
+	return 0
+}
+
+func (m *EventRevealSealedBid) GetBidder() string {
+	if m != nil {
+		return m.Bidder
+	}
+	return ""
+}
+
+// EventSettleSealedBidAuction is an event emitted when a sealed-bid auction is
+// settled.
+type EventSettleSealedBidAuction struct {
+	// auction_id is the unique identifier of the sealed-bid auction.
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// quantity_sold is the quantity of credits that were sold. Credits that were
+	// not sold are returned to the tradable balance of the seller.
+	QuantitySold string `protobuf:"bytes,2,opt,name=quantity_sold,json=quantitySold,proto3" json:"quantity_sold,omitempty"`
+	// clearing_price is the uniform price per credit paid by all winning
+	// bidders. The clearing price is empty if no credits were sold.
+	ClearingPrice *types.Coin `protobuf:"bytes,3,opt,name=clearing_price,json=clearingPrice,proto3" json:"clearing_price,omitempty"`
+}
+
+func (m *EventSettleSealedBidAuction) Reset()         { *m = EventSettleSealedBidAuction{} }
+func (m *EventSettleSealedBidAuction) String() string { return proto.CompactTextString(m) }
+func (*EventSettleSealedBidAuction) ProtoMessage()    {}
+func (*EventSettleSealedBidAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{21}
+}
+func (m *EventSettleSealedBidAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSettleSealedBidAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSettleSealedBidAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSettleSealedBidAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSettleSealedBidAuction.Merge(m, src)
+}
+func (m *EventSettleSealedBidAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSettleSealedBidAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSettleSealedBidAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSettleSealedBidAuction proto.InternalMessageInfo
+
+func (m *EventSettleSealedBidAuction) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
+	}
+	return 0
+}
+
+func (m *EventSettleSealedBidAuction) GetQuantitySold() string {
+	if m != nil {
+		return m.QuantitySold
+	}
+	return ""
+}
+
+func (m *EventSettleSealedBidAuction) GetClearingPrice() *types.Coin {
+	if m != nil {
+		return m.ClearingPrice
+	}
+	return nil
+}
+
+// EventCancelSealedBidAuction is an event emitted when a sealed-bid auction is
+// cancelled because the credit batch was frozen or the auction could not be
+// settled. The deposits are returned to the bidders and the credits are
+// returned to the tradable balance of the seller.
+type EventCancelSealedBidAuction struct {
+	// auction_id is the unique identifier of the sealed-bid auction that was
+	// cancelled.
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+}
+
+func (m *EventCancelSealedBidAuction) Reset()         { *m = EventCancelSealedBidAuction{} }
+func (m *EventCancelSealedBidAuction) String() string { return proto.CompactTextString(m) }
+func (*EventCancelSealedBidAuction) ProtoMessage()    {}
+func (*EventCancelSealedBidAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{22}
+}
+func (m *EventCancelSealedBidAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCancelSealedBidAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCancelSealedBidAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCancelSealedBidAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancelSealedBidAuction.Merge(m, src)
+}
+func (m *EventCancelSealedBidAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCancelSealedBidAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancelSealedBidAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCancelSealedBidAuction proto.InternalMessageInfo
+
+func (m *EventCancelSealedBidAuction) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
+	}
+	return 0
+}
+
+// EventCreateForwardSale is an event emitted when a forward sale is created.
+type EventCreateForwardSale struct {
+	// forward_sale_id is the unique identifier of the forward sale.
+	ForwardSaleId uint64 `protobuf:"varint,1,opt,name=forward_sale_id,json=forwardSaleId,proto3" json:"forward_sale_id,omitempty"`
+}
+
+func (m *EventCreateForwardSale) Reset()         { *m = EventCreateForwardSale{} }
+func (m *EventCreateForwardSale) String() string { return proto.CompactTextString(m) }
+func (*EventCreateForwardSale) ProtoMessage()    {}
+func (*EventCreateForwardSale) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{23}
+}
+func (m *EventCreateForwardSale) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCreateForwardSale) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCreateForwardSale.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCreateForwardSale) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCreateForwardSale.Merge(m, src)
+}
+func (m *EventCreateForwardSale) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCreateForwardSale) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCreateForwardSale.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCreateForwardSale proto.InternalMessageInfo
+
+func (m *EventCreateForwardSale) GetForwardSaleId() uint64 {
+	if m != nil {
+		return m.ForwardSaleId
+	}
+	return 0
+}
+
+// EventBuyForwardContract is an event emitted when a forward contract is
+// bought.
+type EventBuyForwardContract struct {
+	// forward_contract_id is the unique identifier of the forward contract.
+	ForwardContractId uint64 `protobuf:"varint,1,opt,name=forward_contract_id,json=forwardContractId,proto3" json:"forward_contract_id,omitempty"`
+}
+
+func (m *EventBuyForwardContract) Reset()         { *m = EventBuyForwardContract{} }
+func (m *EventBuyForwardContract) String() string { return proto.CompactTextString(m) }
+func (*EventBuyForwardContract) ProtoMessage()    {}
+func (*EventBuyForwardContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{24}
+}
+func (m *EventBuyForwardContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBuyForwardContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBuyForwardContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBuyForwardContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBuyForwardContract.Merge(m, src)
+}
+func (m *EventBuyForwardContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBuyForwardContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBuyForwardContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBuyForwardContract proto.InternalMessageInfo
+
+func (m *EventBuyForwardContract) GetForwardContractId() uint64 {
+	if m != nil {
+		return m.ForwardContractId
+	}
+	return 0
+}
+
+// EventDeliverForwardContract is an event emitted when credits are delivered
+// to fill a forward contract.
+type EventDeliverForwardContract struct {
+	// forward_contract_id is the unique identifier of the forward contract.
+	ForwardContractId uint64 `protobuf:"varint,1,opt,name=forward_contract_id,json=forwardContractId,proto3" json:"forward_contract_id,omitempty"`
+	// batch_denom is the denom of the credit batch the credits were delivered
+	// from.
+	BatchDenom string `protobuf:"bytes,2,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// quantity is the quantity of credits delivered.
+	Quantity string `protobuf:"bytes,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// trade_id is the ID of the trade recorded for the delivery.
+	TradeId uint64 `protobuf:"varint,4,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+}
+
+func (m *EventDeliverForwardContract) Reset()         { *m = EventDeliverForwardContract{} }
+func (m *EventDeliverForwardContract) String() string { return proto.CompactTextString(m) }
+func (*EventDeliverForwardContract) ProtoMessage()    {}
+func (*EventDeliverForwardContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{25}
+}
+func (m *EventDeliverForwardContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDeliverForwardContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDeliverForwardContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDeliverForwardContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDeliverForwardContract.Merge(m, src)
+}
+func (m *EventDeliverForwardContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDeliverForwardContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDeliverForwardContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDeliverForwardContract proto.InternalMessageInfo
+
+func (m *EventDeliverForwardContract) GetForwardContractId() uint64 {
+	if m != nil {
+		return m.ForwardContractId
+	}
+	return 0
+}
+
+func (m *EventDeliverForwardContract) GetBatchDenom() string {
+	if m != nil {
+		return m.BatchDenom
+	}
+	return ""
+}
+
+func (m *EventDeliverForwardContract) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
+	}
+	return ""
+}
+
+func (m *EventDeliverForwardContract) GetTradeId() uint64 {
+	if m != nil {
+		return m.TradeId
+	}
+	return 0
+}
+
+// EventRefundForwardContract is an event emitted when the delivery deadline of
+// a forward sale is reached and the escrowed funds for credits that were not
+// delivered are returned to the buyer of a forward contract.
+type EventRefundForwardContract struct {
+	// forward_contract_id is the unique identifier of the forward contract.
+	ForwardContractId uint64 `protobuf:"varint,1,opt,name=forward_contract_id,json=forwardContractId,proto3" json:"forward_contract_id,omitempty"`
+	// quantity is the quantity of credits that were not delivered.
+	Quantity string `protobuf:"bytes,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// refund is the amount returned to the buyer.
+	Refund *types.Coin `protobuf:"bytes,3,opt,name=refund,proto3" json:"refund,omitempty"`
+}
+
+func (m *EventRefundForwardContract) Reset()         { *m = EventRefundForwardContract{} }
+func (m *EventRefundForwardContract) String() string { return proto.CompactTextString(m) }
+func (*EventRefundForwardContract) ProtoMessage()    {}
+func (*EventRefundForwardContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{26}
+}
+func (m *EventRefundForwardContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRefundForwardContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRefundForwardContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRefundForwardContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRefundForwardContract.Merge(m, src)
+}
+func (m *EventRefundForwardContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRefundForwardContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRefundForwardContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRefundForwardContract proto.InternalMessageInfo
+
+func (m *EventRefundForwardContract) GetForwardContractId() uint64 {
+	if m != nil {
+		return m.ForwardContractId
+	}
+	return 0
+}
+
+func (m *EventRefundForwardContract) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
+	}
+	return ""
+}
+
+func (m *EventRefundForwardContract) GetRefund() *types.Coin {
+	if m != nil {
+		return m.Refund
+	}
+	return nil
+}
+
+// EventRegisterMarket is an event emitted when a market is registered.
+type EventRegisterMarket struct {
+	// market_id is the unique ID of the market.
+	MarketId uint64 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// credit_type_abbrev is the abbreviation of the credit type of the market.
+	CreditTypeAbbrev string `protobuf:"bytes,2,opt,name=credit_type_abbrev,json=creditTypeAbbrev,proto3" json:"credit_type_abbrev,omitempty"`
+	// bank_denom is the bank denom of the market.
+	BankDenom string `protobuf:"bytes,3,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
+	// registrant is the address of the account that registered the market.
+	Registrant string `protobuf:"bytes,4,opt,name=registrant,proto3" json:"registrant,omitempty"`
+}
+
+func (m *EventRegisterMarket) Reset()         { *m = EventRegisterMarket{} }
+func (m *EventRegisterMarket) String() string { return proto.CompactTextString(m) }
+func (*EventRegisterMarket) ProtoMessage()    {}
+func (*EventRegisterMarket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{27}
+}
+func (m *EventRegisterMarket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRegisterMarket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRegisterMarket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRegisterMarket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRegisterMarket.Merge(m, src)
+}
+func (m *EventRegisterMarket) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRegisterMarket) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRegisterMarket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRegisterMarket proto.InternalMessageInfo
+
+func (m *EventRegisterMarket) GetMarketId() uint64 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *EventRegisterMarket) GetCreditTypeAbbrev() string {
+	if m != nil {
+		return m.CreditTypeAbbrev
+	}
+	return ""
+}
+
+func (m *EventRegisterMarket) GetBankDenom() string {
+	if m != nil {
+		return m.BankDenom
+	}
+	return ""
+}
+
+func (m *EventRegisterMarket) GetRegistrant() string {
+	if m != nil {
+		return m.Registrant
+	}
+	return ""
+}
+
+// EventSetMarketRegistration is an event emitted when market registration is
+// enabled or disabled.
+type EventSetMarketRegistration struct {
+	// enabled is true if market registration is permissionless.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// deposit is the deposit paid to register a market.
+	Deposit *types.Coin `protobuf:"bytes,2,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (m *EventSetMarketRegistration) Reset()         { *m = EventSetMarketRegistration{} }
+func (m *EventSetMarketRegistration) String() string { return proto.CompactTextString(m) }
+func (*EventSetMarketRegistration) ProtoMessage()    {}
+func (*EventSetMarketRegistration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{28}
+}
+func (m *EventSetMarketRegistration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetMarketRegistration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetMarketRegistration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSetMarketRegistration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetMarketRegistration.Merge(m, src)
+}
+func (m *EventSetMarketRegistration) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetMarketRegistration) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetMarketRegistration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetMarketRegistration proto.InternalMessageInfo
+
+func (m *EventSetMarketRegistration) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *EventSetMarketRegistration) GetDeposit() *types.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+// EventDelistMarket is an event emitted when a market is delisted.
+type EventDelistMarket struct {
+	// market_id is the unique ID of the market.
+	MarketId uint64 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+}
+
+func (m *EventDelistMarket) Reset()         { *m = EventDelistMarket{} }
+func (m *EventDelistMarket) String() string { return proto.CompactTextString(m) }
+func (*EventDelistMarket) ProtoMessage()    {}
+func (*EventDelistMarket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68b71b54d42cf1d9, []int{29}
+}
+func (m *EventDelistMarket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDelistMarket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDelistMarket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDelistMarket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDelistMarket.Merge(m, src)
+}
+func (m *EventDelistMarket) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDelistMarket) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDelistMarket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDelistMarket proto.InternalMessageInfo
+
+func (m *EventDelistMarket) GetMarketId() uint64 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EventSell)(nil), "regen.ecocredit.marketplace.v1.EventSell")
+	proto.RegisterType((*EventBuyDirect)(nil), "regen.ecocredit.marketplace.v1.EventBuyDirect")
+	proto.RegisterType((*EventUpdateSellOrder)(nil), "regen.ecocredit.marketplace.v1.EventUpdateSellOrder")
+	proto.RegisterType((*EventCancelSellOrder)(nil), "regen.ecocredit.marketplace.v1.EventCancelSellOrder")
+	proto.RegisterType((*EventExpireSellOrder)(nil), "regen.ecocredit.marketplace.v1.EventExpireSellOrder")
+	proto.RegisterType((*EventAllowDenom)(nil), "regen.ecocredit.marketplace.v1.EventAllowDenom")
+	proto.RegisterType((*EventBuy)(nil), "regen.ecocredit.marketplace.v1.EventBuy")
+	proto.RegisterType((*EventCancelBuyOrder)(nil), "regen.ecocredit.marketplace.v1.EventCancelBuyOrder")
+	proto.RegisterType((*EventExpireBuyOrder)(nil), "regen.ecocredit.marketplace.v1.EventExpireBuyOrder")
+	proto.RegisterType((*EventFillBuyOrder)(nil), "regen.ecocredit.marketplace.v1.EventFillBuyOrder")
+	proto.RegisterType((*EventSetFeeSchedule)(nil), "regen.ecocredit.marketplace.v1.EventSetFeeSchedule")
+	proto.RegisterType((*EventSetFeeDestination)(nil), "regen.ecocredit.marketplace.v1.EventSetFeeDestination")
+	proto.RegisterType((*EventMakeOffer)(nil), "regen.ecocredit.marketplace.v1.EventMakeOffer")
+	proto.RegisterType((*EventAcceptOffer)(nil), "regen.ecocredit.marketplace.v1.EventAcceptOffer")
+	proto.RegisterType((*EventCancelOffer)(nil), "regen.ecocredit.marketplace.v1.EventCancelOffer")
+	proto.RegisterType((*EventCreateDutchAuction)(nil), "regen.ecocredit.marketplace.v1.EventCreateDutchAuction")
+	proto.RegisterType((*EventBuyDutchAuction)(nil), "regen.ecocredit.marketplace.v1.EventBuyDutchAuction")
+	proto.RegisterType((*EventCancelDutchAuction)(nil), "regen.ecocredit.marketplace.v1.EventCancelDutchAuction")
+	proto.RegisterType((*EventCreateSealedBidAuction)(nil), "regen.ecocredit.marketplace.v1.EventCreateSealedBidAuction")
+	proto.RegisterType((*EventCommitSealedBid)(nil), "regen.ecocredit.marketplace.v1.EventCommitSealedBid")
+	proto.RegisterType((*EventRevealSealedBid)(nil), "regen.ecocredit.marketplace.v1.EventRevealSealedBid")
+	proto.RegisterType((*EventSettleSealedBidAuction)(nil), "regen.ecocredit.marketplace.v1.EventSettleSealedBidAuction")
+	proto.RegisterType((*EventCancelSealedBidAuction)(nil), "regen.ecocredit.marketplace.v1.EventCancelSealedBidAuction")
+	proto.RegisterType((*EventCreateForwardSale)(nil), "regen.ecocredit.marketplace.v1.EventCreateForwardSale")
+	proto.RegisterType((*EventBuyForwardContract)(nil), "regen.ecocredit.marketplace.v1.EventBuyForwardContract")
+	proto.RegisterType((*EventDeliverForwardContract)(nil), "regen.ecocredit.marketplace.v1.EventDeliverForwardContract")
+	proto.RegisterType((*EventRefundForwardContract)(nil), "regen.ecocredit.marketplace.v1.EventRefundForwardContract")
+	proto.RegisterType((*EventRegisterMarket)(nil), "regen.ecocredit.marketplace.v1.EventRegisterMarket")
+	proto.RegisterType((*EventSetMarketRegistration)(nil), "regen.ecocredit.marketplace.v1.EventSetMarketRegistration")
+	proto.RegisterType((*EventDelistMarket)(nil), "regen.ecocredit.marketplace.v1.EventDelistMarket")
+}
+
+func init() {
+	proto.RegisterFile("regen/ecocredit/marketplace/v1/events.proto", fileDescriptor_68b71b54d42cf1d9)
+}
+
+var fileDescriptor_68b71b54d42cf1d9 = []byte{
+	// 1059 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xee, 0x3a, 0xbf, 0xec, 0x97, 0x3a, 0x4d, 0x36, 0x51, 0x48, 0x53, 0xd5, 0x44, 0x8b, 0x04,
+	0x15, 0x6d, 0xd7, 0x24, 0x95, 0x00, 0x21, 0x90, 0x1a, 0x27, 0x8d, 0xe4, 0x43, 0x54, 0xb4, 0x2e,
+	0x42, 0xe2, 0x62, 0xcd, 0xee, 0x3c, 0x27, 0x2b, 0x8f, 0x77, 0x96, 0xd9, 0x59, 0xa7, 0xbe, 0x71,
+	0xe4, 0x82, 0xe0, 0xce, 0x81, 0x03, 0xe2, 0xc2, 0x3f, 0xc1, 0x95, 0x63, 0x8f, 0x1c, 0x51, 0x72,
+	0xe5, 0x8f, 0x40, 0x3b, 0x33, 0xeb, 0x5f, 0x85, 0x78, 0xdd, 0x5c, 0xe0, 0xe6, 0xf7, 0xfc, 0x7d,
+	0x6f, 0xde, 0xfb, 0xe6, 0xcd, 0xbc, 0x59, 0x78, 0x28, 0xf0, 0x0c, 0xa3, 0x3a, 0x06, 0x3c, 0x10,
+	0x48, 0x43, 0x59, 0xef, 0x11, 0xd1, 0x45, 0x19, 0x33, 0x12, 0x60, 0xbd, 0xbf, 0x5f, 0xc7, 0x3e,
+	0x46, 0x32, 0x71, 0x63, 0xc1, 0x25, 0xb7, 0x6b, 0x0a, 0xec, 0x0e, 0xc1, 0xee, 0x18, 0xd8, 0xed,
+	0xef, 0xef, 0xd6, 0x02, 0x9e, 0xf4, 0x78, 0x52, 0xf7, 0x49, 0x92, 0x91, 0x7d, 0x94, 0x64, 0xbf,
+	0x1e, 0xf0, 0x30, 0xd2, 0xfc, 0xdd, 0xf7, 0x67, 0x2c, 0x96, 0x48, 0x22, 0x51, 0x63, 0x9d, 0x3a,
+	0x54, 0x9e, 0x65, 0x6b, 0xb7, 0x90, 0x31, 0xdb, 0x81, 0x6a, 0x82, 0x8c, 0xb5, 0xb9, 0xa0, 0x28,
+	0xda, 0x21, 0xdd, 0xb1, 0xf6, 0xac, 0x07, 0x8b, 0xde, 0x6a, 0xe6, 0x7c, 0x9e, 0xf9, 0x9a, 0xd4,
+	0xf9, 0xcd, 0x82, 0x35, 0xc5, 0x68, 0xa4, 0x83, 0xe3, 0x50, 0x60, 0x20, 0x8b, 0xd0, 0xec, 0x0f,
+	0xa1, 0xd2, 0x23, 0x5d, 0x14, 0xed, 0x0e, 0xe2, 0x4e, 0x69, 0xcf, 0x7a, 0xb0, 0x7a, 0x70, 0xd7,
+	0xd5, 0x75, 0xb8, 0x59, 0x1d, 0xae, 0xa9, 0xc3, 0x3d, 0xe2, 0x61, 0xe4, 0x95, 0x15, 0xf6, 0x04,
+	0x31, 0xe3, 0xc9, 0x21, 0x6f, 0x61, 0x26, 0x4f, 0xe6, 0xbc, 0xbb, 0x50, 0x96, 0x82, 0x50, 0xcc,
+	0xd2, 0x59, 0x54, 0xe9, 0xac, 0x28, 0xbb, 0x49, 0x9d, 0x4f, 0x60, 0x4b, 0x15, 0xf0, 0x45, 0x4c,
+	0x89, 0xc4, 0x56, 0x9e, 0x64, 0xa1, 0xea, 0x73, 0xee, 0x11, 0x89, 0x02, 0x64, 0xf3, 0x71, 0xbf,
+	0xb7, 0x0c, 0xf9, 0xd9, 0xcb, 0x38, 0x14, 0xf3, 0x2d, 0x6c, 0x6f, 0xc3, 0x72, 0x66, 0xa2, 0x50,
+	0xe2, 0x55, 0x3c, 0x63, 0xd9, 0x6f, 0xc3, 0xaa, 0x4f, 0x64, 0x70, 0xde, 0xa6, 0x18, 0xf1, 0x9e,
+	0x52, 0xa8, 0xe2, 0x81, 0x72, 0x1d, 0x67, 0x1e, 0x7b, 0x17, 0xca, 0x5f, 0xa7, 0x24, 0x92, 0xa1,
+	0x1c, 0x28, 0x21, 0x2a, 0xde, 0xd0, 0x76, 0xde, 0x83, 0x3b, 0x2a, 0xa1, 0x43, 0xc6, 0xf8, 0x85,
+	0x86, 0x6f, 0xc1, 0x92, 0x8e, 0x64, 0x29, 0xac, 0x36, 0x9c, 0x47, 0x50, 0xce, 0xf7, 0xdc, 0xde,
+	0x83, 0xdb, 0x7e, 0x3a, 0x98, 0x4e, 0x16, 0xfc, 0x74, 0x90, 0x17, 0xfa, 0x11, 0x6c, 0x8e, 0x89,
+	0xd4, 0x30, 0x7f, 0x14, 0x20, 0x86, 0xb0, 0x39, 0x26, 0x50, 0x71, 0x62, 0x96, 0xb5, 0x9f, 0x0e,
+	0x86, 0xe2, 0x68, 0x63, 0xa2, 0xf4, 0x85, 0xa9, 0xd2, 0xbf, 0x29, 0xc1, 0x86, 0x5a, 0xeb, 0x24,
+	0x64, 0x73, 0xa4, 0xf8, 0xfa, 0x5e, 0x95, 0x5e, 0xdf, 0xab, 0x6b, 0xd6, 0x9d, 0x3c, 0x07, 0x8b,
+	0x6f, 0x78, 0x0e, 0x96, 0xde, 0xec, 0x1c, 0x2c, 0x4f, 0x9e, 0x83, 0x5f, 0x2d, 0x23, 0x77, 0x0b,
+	0xe5, 0x09, 0x62, 0x2b, 0x38, 0x47, 0x9a, 0x32, 0xb4, 0x1f, 0x81, 0xad, 0xef, 0x8d, 0xb6, 0x1c,
+	0xc4, 0xd8, 0x26, 0xbe, 0x2f, 0xb0, 0x6f, 0xfa, 0x61, 0x5d, 0xff, 0xf3, 0x62, 0x10, 0xe3, 0xa1,
+	0xf2, 0xdb, 0xf7, 0x01, 0x7c, 0x12, 0x75, 0x4d, 0xff, 0x69, 0xfd, 0x2b, 0x99, 0x47, 0xf7, 0x93,
+	0x03, 0xd5, 0x61, 0xbd, 0x6d, 0x3f, 0x4e, 0x94, 0x20, 0x55, 0x6f, 0x35, 0x2f, 0xac, 0x11, 0x27,
+	0x19, 0x46, 0x4e, 0x60, 0x16, 0x35, 0x46, 0x8e, 0x30, 0xce, 0xb7, 0x16, 0x6c, 0x8f, 0x25, 0x7b,
+	0x8c, 0x89, 0x0c, 0x23, 0x22, 0x43, 0x1e, 0xd9, 0x2f, 0x60, 0x95, 0x8e, 0x4c, 0x95, 0xe8, 0xda,
+	0xc1, 0x81, 0x7b, 0xfd, 0x25, 0xea, 0x4e, 0x06, 0xc9, 0x0a, 0xf2, 0xc6, 0xc3, 0xd8, 0x3b, 0xb0,
+	0x42, 0x28, 0x15, 0x98, 0x24, 0xa6, 0xa8, 0xdc, 0x74, 0x1e, 0x9a, 0x0b, 0xf0, 0x94, 0x74, 0xf1,
+	0x79, 0xa7, 0x83, 0x22, 0x13, 0x99, 0x67, 0x3f, 0x46, 0x2d, 0xb3, 0xa2, 0xec, 0x26, 0x75, 0xfe,
+	0xb2, 0x60, 0x5d, 0x9f, 0xb1, 0x20, 0xc0, 0x58, 0xce, 0xc2, 0x67, 0xe7, 0xfc, 0x9c, 0x33, 0x3a,
+	0x3a, 0xe7, 0xda, 0xfa, 0xbf, 0xf4, 0xd4, 0x63, 0x58, 0x1f, 0x3b, 0xfa, 0x33, 0xd5, 0xf9, 0x18,
+	0xde, 0xd2, 0x70, 0x81, 0x44, 0xe2, 0x71, 0x2a, 0x83, 0xf3, 0xc3, 0x34, 0x50, 0xfa, 0xdf, 0x07,
+	0x20, 0xfa, 0xe7, 0x88, 0x57, 0x31, 0x9e, 0x26, 0x75, 0xbe, 0x2b, 0xc1, 0xd6, 0x70, 0x0c, 0x15,
+	0xe7, 0x4d, 0xe8, 0x58, 0x9a, 0xd2, 0xb1, 0x0e, 0x4b, 0xb1, 0x08, 0x83, 0x02, 0x73, 0x46, 0xe3,
+	0xfe, 0x4b, 0xc2, 0x0f, 0x95, 0x54, 0xc2, 0xcf, 0xa3, 0xe4, 0xa7, 0x70, 0x6f, 0x6c, 0x0f, 0x5a,
+	0x48, 0x18, 0xd2, 0x46, 0x48, 0x0b, 0xb2, 0x4f, 0xf3, 0x81, 0xc8, 0x7b, 0xbd, 0x50, 0x0e, 0xd9,
+	0xb3, 0xb6, 0x61, 0x1b, 0x96, 0xfd, 0x90, 0x8e, 0xb5, 0xb9, 0xb6, 0x86, 0xe1, 0x3c, 0xec, 0x23,
+	0x61, 0x37, 0x0e, 0xf7, 0xb3, 0x65, 0x8a, 0x6b, 0xa1, 0x94, 0x6c, 0xde, 0xe2, 0xec, 0x77, 0xa0,
+	0x9a, 0x37, 0x47, 0x3b, 0xe1, 0x8c, 0x9a, 0xe8, 0xb7, 0x73, 0x67, 0x8b, 0x33, 0x6a, 0x3f, 0x85,
+	0xb5, 0x80, 0x21, 0x11, 0x61, 0x74, 0xd6, 0x2e, 0xd8, 0x3e, 0xd5, 0x9c, 0xf0, 0x79, 0x86, 0x1f,
+	0xed, 0x80, 0x79, 0x54, 0xcc, 0xb7, 0x03, 0x4f, 0x61, 0x7b, 0x6c, 0xff, 0x4e, 0xb8, 0xb8, 0x20,
+	0x82, 0xb6, 0x08, 0x43, 0xfb, 0x5d, 0xb8, 0xd3, 0xd1, 0x66, 0x3b, 0x21, 0x0c, 0x47, 0xec, 0x6a,
+	0x67, 0x84, 0x6a, 0x52, 0xa7, 0x69, 0x7a, 0xa7, 0x91, 0x0e, 0x0c, 0xfd, 0x88, 0x47, 0x52, 0x90,
+	0x40, 0xda, 0x2e, 0x6c, 0xe6, 0x21, 0x02, 0xe3, 0x1b, 0x85, 0xd9, 0xe8, 0x4c, 0xa2, 0x9b, 0xd4,
+	0xf9, 0x25, 0x17, 0xfc, 0x18, 0x59, 0xd8, 0x47, 0x71, 0xc3, 0x78, 0xd3, 0xcf, 0x9b, 0xd2, 0xb5,
+	0xcf, 0x9b, 0xe9, 0x7b, 0xf1, 0x9a, 0x37, 0xe0, 0x8f, 0x16, 0xec, 0x9a, 0x46, 0xeb, 0xa4, 0x11,
+	0xbd, 0x69, 0x9a, 0xd7, 0xdd, 0x2a, 0xfb, 0xb0, 0x2c, 0xd4, 0x22, 0xb3, 0xfb, 0xc2, 0x00, 0x9d,
+	0x9f, 0xf2, 0xc9, 0xec, 0xe1, 0x59, 0x98, 0x48, 0x14, 0xa7, 0x6a, 0x76, 0xd9, 0xf7, 0xb2, 0xfb,
+	0x26, 0xfb, 0x35, 0x4a, 0xa6, 0xac, 0x1d, 0x4d, 0xfa, 0x2f, 0x63, 0xbb, 0x54, 0x68, 0x6c, 0x2f,
+	0x4c, 0x8f, 0xed, 0x1a, 0x80, 0x50, 0x6b, 0x0b, 0x12, 0x49, 0xf3, 0x6e, 0x1c, 0xf3, 0x38, 0x5d,
+	0x23, 0x5f, 0x0b, 0xa5, 0xce, 0xcd, 0x33, 0xff, 0xe5, 0xb3, 0x13, 0x23, 0xe2, 0x33, 0xd4, 0x59,
+	0x96, 0xbd, 0xdc, 0xb4, 0x9f, 0xc0, 0x0a, 0xc5, 0x98, 0x27, 0xa1, 0x9c, 0xfd, 0x11, 0x90, 0x23,
+	0x9d, 0x0f, 0x60, 0x63, 0xd8, 0x53, 0x89, 0x2c, 0xa0, 0x45, 0xe3, 0xcb, 0xdf, 0x2f, 0x6b, 0xd6,
+	0xab, 0xcb, 0x9a, 0xf5, 0xe7, 0x65, 0xcd, 0xfa, 0xe1, 0xaa, 0x76, 0xeb, 0xd5, 0x55, 0xed, 0xd6,
+	0x1f, 0x57, 0xb5, 0x5b, 0x5f, 0x7d, 0x76, 0x16, 0xca, 0xf3, 0xd4, 0x77, 0x03, 0xde, 0xab, 0xab,
+	0x17, 0xc2, 0xe3, 0x08, 0xe5, 0x05, 0x17, 0x5d, 0x63, 0x31, 0xa4, 0x67, 0x28, 0xea, 0x2f, 0xff,
+	0xf9, 0xeb, 0xc9, 0x5f, 0x56, 0x5f, 0x4d, 0x4f, 0xfe, 0x1e, 0x00, 0x17, 0xfe, 0xb7, 0x72, 0xd0,
+	0x0d, 0x00, 0x00,
+}
+
+func (m *EventSell) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSell) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSell) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SellOrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SellOrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBuyDirect) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBuyDirect) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBuyDirect) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TradeId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TradeId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TakerFee != nil {
+		{
+			size, err := m.TakerFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MakerFee != nil {
+		{
+			size, err := m.MakerFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SellOrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SellOrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateSellOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateSellOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateSellOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SellOrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SellOrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCancelSellOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelSellOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelSellOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SellOrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SellOrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventExpireSellOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventExpireSellOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventExpireSellOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BatchDenom) > 0 {
+		i -= len(m.BatchDenom)
+		copy(dAtA[i:], m.BatchDenom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BatchDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SellOrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SellOrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAllowDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAllowDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAllowDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBuy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBuy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBuy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BuyOrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BuyOrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCancelBuyOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelBuyOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelBuyOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BuyOrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BuyOrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventExpireBuyOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventExpireBuyOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventExpireBuyOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BuyOrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BuyOrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFillBuyOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFillBuyOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFillBuyOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TradeId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TradeId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TakerFee != nil {
+		{
+			size, err := m.TakerFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MakerFee != nil {
+		{
+			size, err := m.MakerFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SellOrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SellOrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BuyOrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BuyOrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSetFeeSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetFeeSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetFeeSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TakerFeeBps != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TakerFeeBps))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MakerFeeBps != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MakerFeeBps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BankDenom) > 0 {
+		i -= len(m.BankDenom)
+		copy(dAtA[i:], m.BankDenom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BankDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CreditTypeAbbrev) > 0 {
+		i -= len(m.CreditTypeAbbrev)
+		copy(dAtA[i:], m.CreditTypeAbbrev)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CreditTypeAbbrev)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSetFeeDestination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetFeeDestination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetFeeDestination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Destination != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMakeOffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMakeOffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMakeOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OfferId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OfferId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAcceptOffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAcceptOffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAcceptOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TradeId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TradeId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TakerFee != nil {
+		{
+			size, err := m.TakerFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MakerFee != nil {
+		{
+			size, err := m.MakerFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OfferId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OfferId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCancelOffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelOffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OfferId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OfferId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCreateDutchAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCreateDutchAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCreateDutchAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBuyDutchAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBuyDutchAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBuyDutchAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TradeId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TradeId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TakerFee != nil {
+		{
+			size, err := m.TakerFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MakerFee != nil {
+		{
+			size, err := m.MakerFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Price != nil {
+		{
+			size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCancelDutchAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelDutchAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelDutchAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCreateSealedBidAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCreateSealedBidAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCreateSealedBidAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCommitSealedBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCommitSealedBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCommitSealedBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRevealSealedBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRevealSealedBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRevealSealedBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSettleSealedBidAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSettleSealedBidAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSettleSealedBidAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClearingPrice != nil {
+		{
+			size, err := m.ClearingPrice.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.QuantitySold) > 0 {
+		i -= len(m.QuantitySold)
+		copy(dAtA[i:], m.QuantitySold)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.QuantitySold)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCancelSealedBidAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelSealedBidAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelSealedBidAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCreateForwardSale) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCreateForwardSale) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCreateForwardSale) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ForwardSaleId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ForwardSaleId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBuyForwardContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBuyForwardContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBuyForwardContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ForwardContractId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ForwardContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDeliverForwardContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDeliverForwardContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDeliverForwardContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TradeId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TradeId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BatchDenom) > 0 {
+		i -= len(m.BatchDenom)
+		copy(dAtA[i:], m.BatchDenom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BatchDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ForwardContractId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ForwardContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRefundForwardContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRefundForwardContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRefundForwardContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Refund != nil {
+		{
+			size, err := m.Refund.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ForwardContractId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ForwardContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRegisterMarket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRegisterMarket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRegisterMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Registrant) > 0 {
+		i -= len(m.Registrant)
+		copy(dAtA[i:], m.Registrant)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Registrant)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BankDenom) > 0 {
+		i -= len(m.BankDenom)
+		copy(dAtA[i:], m.BankDenom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BankDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CreditTypeAbbrev) > 0 {
+		i -= len(m.CreditTypeAbbrev)
+		copy(dAtA[i:], m.CreditTypeAbbrev)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CreditTypeAbbrev)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSetMarketRegistration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetMarketRegistration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetMarketRegistration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deposit != nil {
+		{
+			size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDelistMarket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDelistMarket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDelistMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventSell) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SellOrderId != 0 {
+		n += 1 + sovEvents(uint64(m.SellOrderId))
+	}
+	return n
+}
+
+func (m *EventBuyDirect) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SellOrderId != 0 {
+		n += 1 + sovEvents(uint64(m.SellOrderId))
+	}
+	if m.MakerFee != nil {
+		l = m.MakerFee.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.TakerFee != nil {
+		l = m.TakerFee.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.TradeId != 0 {
+		n += 1 + sovEvents(uint64(m.TradeId))
+	}
+	return n
+}
+
+func (m *EventUpdateSellOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SellOrderId != 0 {
+		n += 1 + sovEvents(uint64(m.SellOrderId))
+	}
+	return n
+}
+
+func (m *EventCancelSellOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SellOrderId != 0 {
+		n += 1 + sovEvents(uint64(m.SellOrderId))
+	}
+	return n
+}
+
+func (m *EventExpireSellOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SellOrderId != 0 {
+		n += 1 + sovEvents(uint64(m.SellOrderId))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BatchDenom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAllowDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventBuy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BuyOrderId != 0 {
+		n += 1 + sovEvents(uint64(m.BuyOrderId))
+	}
+	return n
+}
+
+func (m *EventCancelBuyOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BuyOrderId != 0 {
+		n += 1 + sovEvents(uint64(m.BuyOrderId))
+	}
+	return n
+}
+
+func (m *EventExpireBuyOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BuyOrderId != 0 {
+		n += 1 + sovEvents(uint64(m.BuyOrderId))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventFillBuyOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BuyOrderId != 0 {
+		n += 1 + sovEvents(uint64(m.BuyOrderId))
+	}
+	if m.SellOrderId != 0 {
+		n += 1 + sovEvents(uint64(m.SellOrderId))
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MakerFee != nil {
+		l = m.MakerFee.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.TakerFee != nil {
+		l = m.TakerFee.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.TradeId != 0 {
+		n += 1 + sovEvents(uint64(m.TradeId))
+	}
+	return n
+}
+
+func (m *EventSetFeeSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CreditTypeAbbrev)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BankDenom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MakerFeeBps != 0 {
+		n += 1 + sovEvents(uint64(m.MakerFeeBps))
+	}
+	if m.TakerFeeBps != 0 {
+		n += 1 + sovEvents(uint64(m.TakerFeeBps))
+	}
+	return n
+}
+
+func (m *EventSetFeeDestination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Destination != 0 {
+		n += 1 + sovEvents(uint64(m.Destination))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMakeOffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OfferId != 0 {
+		n += 1 + sovEvents(uint64(m.OfferId))
+	}
+	return n
+}
+
+func (m *EventAcceptOffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OfferId != 0 {
+		n += 1 + sovEvents(uint64(m.OfferId))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MakerFee != nil {
+		l = m.MakerFee.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.TakerFee != nil {
+		l = m.TakerFee.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.TradeId != 0 {
+		n += 1 + sovEvents(uint64(m.TradeId))
+	}
+	return n
+}
+
+func (m *EventCancelOffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OfferId != 0 {
+		n += 1 + sovEvents(uint64(m.OfferId))
+	}
+	return n
+}
+
+func (m *EventCreateDutchAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovEvents(uint64(m.AuctionId))
+	}
+	return n
+}
+
+func (m *EventBuyDutchAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovEvents(uint64(m.AuctionId))
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Price != nil {
+		l = m.Price.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MakerFee != nil {
+		l = m.MakerFee.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.TakerFee != nil {
+		l = m.TakerFee.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.TradeId != 0 {
+		n += 1 + sovEvents(uint64(m.TradeId))
+	}
+	return n
+}
+
+func (m *EventCancelDutchAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovEvents(uint64(m.AuctionId))
+	}
+	return n
+}
+
+func (m *EventCreateSealedBidAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovEvents(uint64(m.AuctionId))
+	}
+	return n
+}
+
+func (m *EventCommitSealedBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovEvents(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRevealSealedBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovEvents(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSettleSealedBidAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovEvents(uint64(m.AuctionId))
+	}
+	l = len(m.QuantitySold)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ClearingPrice != nil {
+		l = m.ClearingPrice.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCancelSealedBidAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovEvents(uint64(m.AuctionId))
+	}
+	return n
+}
+
+func (m *EventCreateForwardSale) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ForwardSaleId != 0 {
+		n += 1 + sovEvents(uint64(m.ForwardSaleId))
+	}
+	return n
+}
+
+func (m *EventBuyForwardContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ForwardContractId != 0 {
+		n += 1 + sovEvents(uint64(m.ForwardContractId))
+	}
+	return n
+}
+
+func (m *EventDeliverForwardContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ForwardContractId != 0 {
+		n += 1 + sovEvents(uint64(m.ForwardContractId))
+	}
+	l = len(m.BatchDenom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.TradeId != 0 {
+		n += 1 + sovEvents(uint64(m.TradeId))
+	}
+	return n
+}
+
+func (m *EventRefundForwardContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ForwardContractId != 0 {
+		n += 1 + sovEvents(uint64(m.ForwardContractId))
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Refund != nil {
+		l = m.Refund.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRegisterMarket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.CreditTypeAbbrev)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BankDenom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Registrant)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSetMarketRegistration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.Deposit != nil {
+		l = m.Deposit.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDelistMarket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventSell) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSell: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSell: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellOrderId", wireType)
+			}
+			m.SellOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SellOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBuyDirect) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBuyDirect: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBuyDirect: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellOrderId", wireType)
+			}
+			m.SellOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SellOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MakerFee == nil {
+				m.MakerFee = &types.Coin{}
+			}
+			if err := m.MakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TakerFee == nil {
+				m.TakerFee = &types.Coin{}
+			}
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeId", wireType)
+			}
+			m.TradeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateSellOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateSellOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateSellOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellOrderId", wireType)
+			}
+			m.SellOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SellOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCancelSellOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCancelSellOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCancelSellOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellOrderId", wireType)
+			}
+			m.SellOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SellOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventExpireSellOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventExpireSellOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventExpireSellOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellOrderId", wireType)
+			}
+			m.SellOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SellOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAllowDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAllowDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAllowDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBuy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBuy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBuy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyOrderId", wireType)
+			}
+			m.BuyOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BuyOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCancelBuyOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCancelBuyOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCancelBuyOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyOrderId", wireType)
+			}
+			m.BuyOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BuyOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventExpireBuyOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventExpireBuyOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventExpireBuyOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyOrderId", wireType)
+			}
+			m.BuyOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BuyOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFillBuyOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFillBuyOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFillBuyOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyOrderId", wireType)
+			}
+			m.BuyOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BuyOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellOrderId", wireType)
+			}
+			m.SellOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SellOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MakerFee == nil {
+				m.MakerFee = &types.Coin{}
+			}
+			if err := m.MakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TakerFee == nil {
+				m.TakerFee = &types.Coin{}
+			}
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeId", wireType)
+			}
+			m.TradeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSetFeeSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSetFeeSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSetFeeSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BankDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeBps", wireType)
+			}
+			m.MakerFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MakerFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeBps", wireType)
+			}
+			m.TakerFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TakerFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSetFeeDestination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSetFeeDestination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSetFeeDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			m.Destination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Destination |= FeeDestinationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMakeOffer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMakeOffer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMakeOffer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferId", wireType)
+			}
+			m.OfferId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OfferId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAcceptOffer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAcceptOffer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAcceptOffer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferId", wireType)
+			}
+			m.OfferId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OfferId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MakerFee == nil {
+				m.MakerFee = &types.Coin{}
+			}
+			if err := m.MakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TakerFee == nil {
+				m.TakerFee = &types.Coin{}
+			}
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeId", wireType)
+			}
+			m.TradeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCancelOffer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCancelOffer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCancelOffer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferId", wireType)
+			}
+			m.OfferId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OfferId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCreateDutchAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreateDutchAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreateDutchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBuyDutchAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBuyDutchAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBuyDutchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Price == nil {
+				m.Price = &types.Coin{}
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MakerFee == nil {
+				m.MakerFee = &types.Coin{}
+			}
+			if err := m.MakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TakerFee == nil {
+				m.TakerFee = &types.Coin{}
+			}
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeId", wireType)
+			}
+			m.TradeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCancelDutchAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCancelDutchAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCancelDutchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCreateSealedBidAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreateSealedBidAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreateSealedBidAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCommitSealedBid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCommitSealedBid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCommitSealedBid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRevealSealedBid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRevealSealedBid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRevealSealedBid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSettleSealedBidAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSettleSealedBidAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSettleSealedBidAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuantitySold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuantitySold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearingPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClearingPrice == nil {
+				m.ClearingPrice = &types.Coin{}
+			}
+			if err := m.ClearingPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCancelSealedBidAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCancelSealedBidAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCancelSealedBidAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCreateForwardSale) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreateForwardSale: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreateForwardSale: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardSaleId", wireType)
+			}
+			m.ForwardSaleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwardSaleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBuyForwardContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBuyForwardContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBuyForwardContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardContractId", wireType)
+			}
+			m.ForwardContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwardContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDeliverForwardContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDeliverForwardContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDeliverForwardContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardContractId", wireType)
+			}
+			m.ForwardContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwardContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventSell: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSell: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SellOrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeId", wireType)
 			}
-			m.SellOrderId = 0
+			m.TradeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -552,7 +6429,7 @@ func (m *EventSell) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SellOrderId |= uint64(b&0x7F) << shift
+				m.TradeId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -578,7 +6455,7 @@ func (m *EventSell) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBuyDirect) Unmarshal(dAtA []byte) error {
+func (m *EventRefundForwardContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -601,17 +6478,17 @@ func (m *EventBuyDirect) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBuyDirect: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRefundForwardContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBuyDirect: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRefundForwardContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SellOrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardContractId", wireType)
 			}
-			m.SellOrderId = 0
+			m.ForwardContractId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -621,11 +6498,79 @@ func (m *EventBuyDirect) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SellOrderId |= uint64(b&0x7F) << shift
+				m.ForwardContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Refund == nil {
+				m.Refund = &types.Coin{}
+			}
+			if err := m.Refund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -647,7 +6592,7 @@ func (m *EventBuyDirect) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateSellOrder) Unmarshal(dAtA []byte) error {
+func (m *EventRegisterMarket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -670,17 +6615,17 @@ func (m *EventUpdateSellOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateSellOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRegisterMarket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateSellOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRegisterMarket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SellOrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			m.SellOrderId = 0
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -690,11 +6635,107 @@ func (m *EventUpdateSellOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SellOrderId |= uint64(b&0x7F) << shift
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BankDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Registrant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Registrant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -716,7 +6757,7 @@ func (m *EventUpdateSellOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCancelSellOrder) Unmarshal(dAtA []byte) error {
+func (m *EventSetMarketRegistration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -739,17 +6780,17 @@ func (m *EventCancelSellOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCancelSellOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSetMarketRegistration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCancelSellOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSetMarketRegistration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SellOrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			m.SellOrderId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -759,11 +6800,48 @@ func (m *EventCancelSellOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SellOrderId |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deposit == nil {
+				m.Deposit = &types.Coin{}
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -785,7 +6863,7 @@ func (m *EventCancelSellOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAllowDenom) Unmarshal(dAtA []byte) error {
+func (m *EventDelistMarket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -808,17 +6886,17 @@ func (m *EventAllowDenom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAllowDenom: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDelistMarket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAllowDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDelistMarket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var stringLen uint64
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -828,24 +6906,11 @@ func (m *EventAllowDenom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MarketId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -0,0 +1,64 @@
+Feature: MsgAcceptOffer
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "holder": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "offer_id": 1,
+      "quantity": "10"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if holder is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "holder cannot be empty: invalid request"
+
+  Scenario: an error is returned if holder is not a valid bech32 address
+    Given the message
+    """
+    {
+      "holder": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "holder is not a valid address: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if offer id is empty
+    Given the message
+    """
+    {
+      "holder": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+    }
+    """
+    When the message is validated
+    Then expect the error "offer id cannot be empty: invalid request"
+
+  Scenario: an error is returned if quantity is empty
+    Given the message
+    """
+    {
+      "holder": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "offer_id": 1
+    }
+    """
+    When the message is validated
+    Then expect the error "quantity cannot be empty: invalid request"
+
+  Scenario: an error is returned if quantity is not a positive decimal
+    Given the message
+    """
+    {
+      "holder": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "offer_id": 1,
+      "quantity": "-10"
+    }
+    """
+    When the message is validated
+    Then expect the error "quantity must be a positive decimal: invalid request"
@@ -0,0 +1,406 @@
+Feature: MsgBuy
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "filter": {
+            "or": [
+              {
+                "batch_selector": {
+                  "batch_denom": "C01-001-20200101-20210101-001"
+                }
+              }
+            ]
+          },
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "US-WA"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with multiple criteria
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "filter": {
+            "or": [
+              {
+                "class_selector": {
+                  "class_id": "C01",
+                  "project_jurisdiction": "US",
+                  "min_start_date": "2020-01-01T00:00:00Z",
+                  "max_end_date": "2021-01-01T00:00:00Z"
+                }
+              },
+              {
+                "project_selector": {
+                  "project_id": "C02-001"
+                }
+              },
+              {
+                "batch_selector": {
+                  "batch_denom": "C01-001-20200101-20210101-001"
+                }
+              }
+            ]
+          },
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "disable_auto_retire": true
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if buyer is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "buyer cannot be empty: invalid request"
+
+  Scenario: an error is returned if buyer is not a valid bech32 address
+    Given the message
+    """
+    {
+      "buyer": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "buyer is not a valid address: decoding bech32 failed: invalid bech32 string length 3: invalid request"
+
+  Scenario: an error is returned if orders is empty
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+    }
+    """
+    When the message is validated
+    Then expect the error "orders cannot be empty: invalid request"
+
+  Scenario: an error is returned if filter is empty
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {}
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: filter cannot be empty: invalid request"
+
+  Scenario: an error is returned if a selector is empty
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "filter": {
+            "or": [
+              {}
+            ]
+          }
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: filter.or[0]: selector cannot be empty: invalid request"
+
+  Scenario: an error is returned if class selector class id is not formatted
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "filter": {
+            "or": [
+              {
+                "class_selector": {
+                  "class_id": "foo"
+                }
+              }
+            ]
+          }
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: filter.or[0]: class selector: class ID didn't match the format: expected A00, got foo: parse error: invalid request"
+
+  Scenario: an error is returned if class selector project jurisdiction is not formatted
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "filter": {
+            "or": [
+              {
+                "class_selector": {
+                  "class_id": "C01",
+                  "project_jurisdiction": "foo"
+                }
+              }
+            ]
+          }
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: filter.or[0]: class selector: invalid jurisdiction: foo, expected format <country-code>[-<region-code>[ <postal-code>]]: parse error: invalid request"
+
+  Scenario: an error is returned if project selector project id is not formatted
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "filter": {
+            "or": [
+              {
+                "project_selector": {
+                  "project_id": "foo"
+                }
+              }
+            ]
+          }
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: filter.or[0]: project selector: invalid project id: foo: parse error: invalid request"
+
+  Scenario: an error is returned if batch selector batch denom is not formatted
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "filter": {
+            "or": [
+              {
+                "batch_selector": {
+                  "batch_denom": "foo"
+                }
+              }
+            ]
+          }
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: filter.or[0]: batch selector: invalid batch denom: expected format A00-000-00000000-00000000-000: parse error: invalid request"
+
+  Scenario: an error is returned if a selector is duplicated
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "filter": {
+            "or": [
+              {
+                "class_selector": {
+                  "class_id": "C01"
+                }
+              },
+              {
+                "class_selector": {
+                  "class_id": "C01",
+                  "project_jurisdiction": "US"
+                }
+              }
+            ]
+          }
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: filter.or[1]: duplicate selector for class C01: invalid request"
+
+  Scenario: an error is returned if quantity is empty
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "filter": {
+            "or": [
+              {
+                "batch_selector": {
+                  "batch_denom": "C01-001-20200101-20210101-001"
+                }
+              }
+            ]
+          }
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: quantity cannot be empty: invalid request"
+
+  Scenario: an error is returned if quantity is not a positive decimal
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "filter": {
+            "or": [
+              {
+                "batch_selector": {
+                  "batch_denom": "C01-001-20200101-20210101-001"
+                }
+              }
+            ]
+          },
+          "quantity": "-100"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: quantity must be a positive decimal: invalid request"
+
+  Scenario: an error is returned if bid price is empty
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "filter": {
+            "or": [
+              {
+                "batch_selector": {
+                  "batch_denom": "C01-001-20200101-20210101-001"
+                }
+              }
+            ]
+          },
+          "quantity": "100"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: bid price cannot be empty: invalid request"
+
+  Scenario: an error is returned if bid price denom is empty
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "filter": {
+            "or": [
+              {
+                "batch_selector": {
+                  "batch_denom": "C01-001-20200101-20210101-001"
+                }
+              }
+            ]
+          },
+          "quantity": "100",
+          "bid_price": {}
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: bid price: denom cannot be empty: invalid request"
+
+  Scenario: an error is returned if bid price amount is not positive
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "filter": {
+            "or": [
+              {
+                "batch_selector": {
+                  "batch_denom": "C01-001-20200101-20210101-001"
+                }
+              }
+            ]
+          },
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "-100"
+          }
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: bid price: amount must be a positive integer: invalid request"
+
+  Scenario: an error is returned if retirement jurisdiction is empty and auto-retire is enabled
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "filter": {
+            "or": [
+              {
+                "batch_selector": {
+                  "batch_denom": "C01-001-20200101-20210101-001"
+                }
+              }
+            ]
+          },
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          }
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: jurisdiction cannot be empty, expected format <country-code>[-<region-code>[ <postal-code>]]: parse error: invalid request"
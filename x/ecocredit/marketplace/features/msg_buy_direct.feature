@@ -72,6 +72,33 @@ Feature: MsgBuyDirect
     When the message is validated
     Then expect no error
 
+  Scenario: a valid message with max total cost
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "sell_order_id": "1",
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "US-WA"
+        }
+      ],
+      "max_total_cost": [
+        {
+          "denom": "regen",
+          "amount": "10000"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
   Scenario: an error is returned if buyer is empty
     Given the message
     """
@@ -275,3 +302,52 @@ Feature: MsgBuyDirect
     """
     When the message is validated
     Then expect the error "orders[0]: invalid jurisdiction: foo, expected format <country-code>[-<region-code>[ <postal-code>]]: parse error: invalid request"
+
+  Scenario: an error is returned if disable auto-retire is false and beneficiary address is not a bech32 address
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "sell_order_id": 1,
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "US-WA",
+          "beneficiary_address": "foo"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: beneficiary address: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if max total cost is not valid
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "sell_order_id": "1",
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "US-WA"
+        }
+      ],
+      "max_total_cost": [
+        {
+          "denom": "regen",
+          "amount": "0"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "max total cost: coin 0regen amount is not positive: invalid request"
@@ -0,0 +1,158 @@
+Feature: MsgBuyForwardContract
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "forward_sale_id": "1",
+      "quantity": "100",
+      "max_price": {
+        "denom": "regen",
+        "amount": "100"
+      },
+      "retirement_jurisdiction": "US-WA"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with auto-retire disabled
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "forward_sale_id": "1",
+      "quantity": "100",
+      "max_price": {
+        "denom": "regen",
+        "amount": "100"
+      },
+      "disable_auto_retire": true
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if buyer is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "buyer cannot be empty: invalid request"
+
+  Scenario: an error is returned if buyer is not a valid bech32 address
+    Given the message
+    """
+    {
+      "buyer": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "buyer is not a valid address: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if forward sale id is empty
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+    }
+    """
+    When the message is validated
+    Then expect the error "forward sale id cannot be empty: invalid request"
+
+  Scenario: an error is returned if quantity is empty
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "forward_sale_id": "1"
+    }
+    """
+    When the message is validated
+    Then expect the error "quantity cannot be empty: invalid request"
+
+  Scenario: an error is returned if quantity is not a positive decimal
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "forward_sale_id": "1",
+      "quantity": "-100"
+    }
+    """
+    When the message is validated
+    Then expect the error "quantity must be a positive decimal: invalid request"
+
+  Scenario: an error is returned if max price is empty
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "forward_sale_id": "1",
+      "quantity": "100"
+    }
+    """
+    When the message is validated
+    Then expect the error "max price cannot be empty: invalid request"
+
+  Scenario: an error is returned if max price denom is empty
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "forward_sale_id": "1",
+      "quantity": "100",
+      "max_price": {}
+    }
+    """
+    When the message is validated
+    Then expect the error "max price: denom cannot be empty: invalid request"
+
+  Scenario: an error is returned if max price amount is empty
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "forward_sale_id": "1",
+      "quantity": "100",
+      "max_price": {
+        "denom": "regen"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "max price: amount cannot be empty: invalid request"
+
+  Scenario: an error is returned if max price amount is not positive
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "forward_sale_id": "1",
+      "quantity": "100",
+      "max_price": {
+        "denom": "regen",
+        "amount": "0"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "max price: amount must be a positive integer: invalid request"
+
+  Scenario: an error is returned if retirement jurisdiction is empty and auto-retire is enabled
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "forward_sale_id": "1",
+      "quantity": "100",
+      "max_price": {
+        "denom": "regen",
+        "amount": "100"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "jurisdiction cannot be empty, expected format <country-code>[-<region-code>[ <postal-code>]]: parse error: invalid request"
@@ -0,0 +1,40 @@
+Feature: MsgCancelDutchAuction
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "auction_id": 1
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if seller is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "seller cannot be empty: invalid request"
+
+  Scenario: an error is returned if seller is not a valid bech32 address
+    Given the message
+    """
+    {
+      "seller": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "seller is not a valid address: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if auction id is empty
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+    }
+    """
+    When the message is validated
+    Then expect the error "auction id cannot be empty: invalid request"
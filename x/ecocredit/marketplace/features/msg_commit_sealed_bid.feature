@@ -0,0 +1,96 @@
+Feature: MsgCommitSealedBid
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "bidder": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "auction_id": 1,
+      "commitment": "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=",
+      "deposit": {
+        "denom": "regen",
+        "amount": "1000"
+      }
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if bidder is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "bidder cannot be empty: invalid request"
+
+  Scenario: an error is returned if bidder is not a valid bech32 address
+    Given the message
+    """
+    {
+      "bidder": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "bidder is not a valid address: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if auction id is empty
+    Given the message
+    """
+    {
+      "bidder": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+    }
+    """
+    When the message is validated
+    Then expect the error "auction id cannot be empty: invalid request"
+
+  Scenario: an error is returned if commitment is empty
+    Given the message
+    """
+    {
+      "bidder": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "auction_id": 1
+    }
+    """
+    When the message is validated
+    Then expect the error "commitment must be 32 bytes: invalid request"
+
+  Scenario: an error is returned if commitment is not 32 bytes
+    Given the message
+    """
+    {
+      "bidder": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "auction_id": 1,
+      "commitment": "Zm9v"
+    }
+    """
+    When the message is validated
+    Then expect the error "commitment must be 32 bytes: invalid request"
+
+  Scenario: an error is returned if deposit is empty
+    Given the message
+    """
+    {
+      "bidder": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "auction_id": 1,
+      "commitment": "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA="
+    }
+    """
+    When the message is validated
+    Then expect the error "deposit cannot be empty: invalid request"
+
+  Scenario: an error is returned if deposit amount is not positive
+    Given the message
+    """
+    {
+      "bidder": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "auction_id": 1,
+      "commitment": "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=",
+      "deposit": {
+        "denom": "regen",
+        "amount": "0"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "deposit: amount must be a positive integer: invalid request"
@@ -0,0 +1,200 @@
+Feature: MsgCreateDutchAuction
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "start_price": {
+        "denom": "regen",
+        "amount": "100"
+      },
+      "floor_price": {
+        "denom": "regen",
+        "amount": "10"
+      },
+      "duration": "3600s"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with auto-retire disabled
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "start_price": {
+        "denom": "regen",
+        "amount": "100"
+      },
+      "floor_price": {
+        "denom": "regen",
+        "amount": "10"
+      },
+      "duration": "3600s",
+      "disable_auto_retire": true
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if seller is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "seller cannot be empty: invalid request"
+
+  Scenario: an error is returned if seller is not a valid bech32 address
+    Given the message
+    """
+    {
+      "seller": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "seller is not a valid address: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if batch denom is not formatted
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "invalid batch denom: expected format A00-000-00000000-00000000-000: parse error: invalid request"
+
+  Scenario: an error is returned if quantity is empty
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001"
+    }
+    """
+    When the message is validated
+    Then expect the error "quantity cannot be empty: invalid request"
+
+  Scenario: an error is returned if quantity is not a positive decimal
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "-100"
+    }
+    """
+    When the message is validated
+    Then expect the error "quantity must be a positive decimal: invalid request"
+
+  Scenario: an error is returned if start price is empty
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100"
+    }
+    """
+    When the message is validated
+    Then expect the error "start price cannot be empty: invalid request"
+
+  Scenario: an error is returned if start price amount is not positive
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "start_price": {
+        "denom": "regen",
+        "amount": "0"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "start price: amount must be a positive integer: invalid request"
+
+  Scenario: an error is returned if floor price is empty
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "start_price": {
+        "denom": "regen",
+        "amount": "100"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "floor price cannot be empty: invalid request"
+
+  Scenario: an error is returned if floor price denom does not match start price denom
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "start_price": {
+        "denom": "regen",
+        "amount": "100"
+      },
+      "floor_price": {
+        "denom": "uatom",
+        "amount": "10"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "floor price: denom must match start price denom: invalid request"
+
+  Scenario: an error is returned if floor price is not less than start price
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "start_price": {
+        "denom": "regen",
+        "amount": "100"
+      },
+      "floor_price": {
+        "denom": "regen",
+        "amount": "100"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "floor price: amount must be less than start price amount: invalid request"
+
+  Scenario: an error is returned if duration is empty
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "start_price": {
+        "denom": "regen",
+        "amount": "100"
+      },
+      "floor_price": {
+        "denom": "regen",
+        "amount": "10"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "duration must be positive: invalid request"
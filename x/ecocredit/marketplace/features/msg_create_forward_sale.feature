@@ -0,0 +1,222 @@
+Feature: MsgCreateForwardSale
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "project_id": "C01-001",
+      "quantity": "100",
+      "ask_price": {
+        "denom": "regen",
+        "amount": "100"
+      },
+      "vintage_start": "2030-01-01T00:00:00Z",
+      "vintage_end": "2031-01-01T00:00:00Z",
+      "delivery_deadline": "2032-01-01T00:00:00Z"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if seller is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "seller cannot be empty: invalid request"
+
+  Scenario: an error is returned if seller is not a valid bech32 address
+    Given the message
+    """
+    {
+      "seller": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "seller is not a valid address: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if project id is empty
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+    }
+    """
+    When the message is validated
+    Then expect the error "project id cannot be empty: parse error: invalid request"
+
+  Scenario: an error is returned if project id is not formatted
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "project_id": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "invalid project id: foo: parse error: invalid request"
+
+  Scenario: an error is returned if quantity is empty
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "project_id": "C01-001"
+    }
+    """
+    When the message is validated
+    Then expect the error "quantity cannot be empty: invalid request"
+
+  Scenario: an error is returned if quantity is not a positive decimal
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "project_id": "C01-001",
+      "quantity": "-100"
+    }
+    """
+    When the message is validated
+    Then expect the error "quantity must be a positive decimal: invalid request"
+
+  Scenario: an error is returned if ask price is empty
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "project_id": "C01-001",
+      "quantity": "100"
+    }
+    """
+    When the message is validated
+    Then expect the error "ask price cannot be empty: invalid request"
+
+  Scenario: an error is returned if ask price denom is empty
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "project_id": "C01-001",
+      "quantity": "100",
+      "ask_price": {}
+    }
+    """
+    When the message is validated
+    Then expect the error "ask price: denom cannot be empty: invalid request"
+
+  Scenario: an error is returned if ask price denom is not formatted
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "project_id": "C01-001",
+      "quantity": "100",
+      "ask_price": {
+        "denom": "A+B"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "ask price: invalid denom: A+B: invalid request"
+
+  Scenario: an error is returned if ask price amount is empty
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "project_id": "C01-001",
+      "quantity": "100",
+      "ask_price": {
+        "denom": "regen"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "ask price: amount cannot be empty: invalid request"
+
+  Scenario: an error is returned if ask price amount is not positive
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "project_id": "C01-001",
+      "quantity": "100",
+      "ask_price": {
+        "denom": "regen",
+        "amount": "0"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "ask price: amount must be a positive integer: invalid request"
+
+  Scenario: an error is returned if vintage start is empty
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "project_id": "C01-001",
+      "quantity": "100",
+      "ask_price": {
+        "denom": "regen",
+        "amount": "100"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "vintage start cannot be empty: invalid request"
+
+  Scenario: an error is returned if vintage end is empty
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "project_id": "C01-001",
+      "quantity": "100",
+      "ask_price": {
+        "denom": "regen",
+        "amount": "100"
+      },
+      "vintage_start": "2030-01-01T00:00:00Z"
+    }
+    """
+    When the message is validated
+    Then expect the error "vintage end cannot be empty: invalid request"
+
+  Scenario: an error is returned if vintage start is after vintage end
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "project_id": "C01-001",
+      "quantity": "100",
+      "ask_price": {
+        "denom": "regen",
+        "amount": "100"
+      },
+      "vintage_start": "2031-01-01T00:00:00Z",
+      "vintage_end": "2030-01-01T00:00:00Z"
+    }
+    """
+    When the message is validated
+    Then expect the error "vintage start cannot be after vintage end: invalid request"
+
+  Scenario: an error is returned if delivery deadline is empty
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "project_id": "C01-001",
+      "quantity": "100",
+      "ask_price": {
+        "denom": "regen",
+        "amount": "100"
+      },
+      "vintage_start": "2030-01-01T00:00:00Z",
+      "vintage_end": "2031-01-01T00:00:00Z"
+    }
+    """
+    When the message is validated
+    Then expect the error "delivery deadline cannot be empty: invalid request"
@@ -0,0 +1,121 @@
+Feature: MsgCreateSealedBidAuction
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "reserve_price": {
+        "denom": "regen",
+        "amount": "10"
+      },
+      "commit_end_height": "100",
+      "reveal_end_height": "200"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if seller is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "seller cannot be empty: invalid request"
+
+  Scenario: an error is returned if seller is not a valid bech32 address
+    Given the message
+    """
+    {
+      "seller": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "seller is not a valid address: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if batch denom is not formatted
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "invalid batch denom: expected format A00-000-00000000-00000000-000: parse error: invalid request"
+
+  Scenario: an error is returned if quantity is empty
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001"
+    }
+    """
+    When the message is validated
+    Then expect the error "quantity cannot be empty: invalid request"
+
+  Scenario: an error is returned if reserve price is empty
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100"
+    }
+    """
+    When the message is validated
+    Then expect the error "reserve price cannot be empty: invalid request"
+
+  Scenario: an error is returned if reserve price amount is not positive
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "reserve_price": {
+        "denom": "regen",
+        "amount": "0"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "reserve price: amount must be a positive integer: invalid request"
+
+  Scenario: an error is returned if commit end height is empty
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "reserve_price": {
+        "denom": "regen",
+        "amount": "10"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "commit end height must be positive: invalid request"
+
+  Scenario: an error is returned if reveal end height is not greater than commit end height
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "reserve_price": {
+        "denom": "regen",
+        "amount": "10"
+      },
+      "commit_end_height": "100",
+      "reveal_end_height": "100"
+    }
+    """
+    When the message is validated
+    Then expect the error "reveal end height must be greater than commit end height: invalid request"
@@ -0,0 +1,186 @@
+Feature: MsgMakeOffer
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "bid_price": {
+        "denom": "regen",
+        "amount": "100"
+      },
+      "retirement_jurisdiction": "US-WA"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with holder and expiration
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "holder": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "quantity": "100",
+      "bid_price": {
+        "denom": "regen",
+        "amount": "100"
+      },
+      "disable_auto_retire": true,
+      "expiration": "2030-01-01T00:00:00Z"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if buyer is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "buyer cannot be empty: invalid request"
+
+  Scenario: an error is returned if buyer is not a valid bech32 address
+    Given the message
+    """
+    {
+      "buyer": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "buyer is not a valid address: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if batch denom is not formatted
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "invalid batch denom: expected format A00-000-00000000-00000000-000: parse error: invalid request"
+
+  Scenario: an error is returned if holder is not a valid bech32 address
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "holder": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "holder is not a valid address: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if holder is the buyer
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "holder": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+    }
+    """
+    When the message is validated
+    Then expect the error "holder cannot be the buyer: invalid request"
+
+  Scenario: an error is returned if quantity is empty
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001"
+    }
+    """
+    When the message is validated
+    Then expect the error "quantity cannot be empty: invalid request"
+
+  Scenario: an error is returned if quantity is not a positive decimal
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "-100"
+    }
+    """
+    When the message is validated
+    Then expect the error "quantity must be a positive decimal: invalid request"
+
+  Scenario: an error is returned if bid price is empty
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100"
+    }
+    """
+    When the message is validated
+    Then expect the error "bid price cannot be empty: invalid request"
+
+  Scenario: an error is returned if bid price denom is empty
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "bid_price": {}
+    }
+    """
+    When the message is validated
+    Then expect the error "bid price: denom cannot be empty: invalid request"
+
+  Scenario: an error is returned if bid price amount is empty
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "bid_price": {
+        "denom": "regen"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "bid price: amount cannot be empty: invalid request"
+
+  Scenario: an error is returned if bid price amount is not a positive integer
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "bid_price": {
+        "denom": "regen",
+        "amount": "-100"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "bid price: amount must be a positive integer: invalid request"
+
+  Scenario: an error is returned if retirement jurisdiction is not formatted
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "bid_price": {
+        "denom": "regen",
+        "amount": "100"
+      },
+      "retirement_jurisdiction": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "invalid jurisdiction: foo, expected format <country-code>[-<region-code>[ <postal-code>]]: parse error: invalid request"
@@ -0,0 +1,75 @@
+Feature: MsgRegisterMarket
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "registrant": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "credit_type_abbrev": "C",
+      "bank_denom": "ibc/D189335C6E4A68B513C10AB227BF1C1D38C746766278BA3EEB4FB14124F1D858"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if registrant is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "registrant cannot be empty: invalid request"
+
+  Scenario: an error is returned if registrant is not a valid bech32 address
+    Given the message
+    """
+    {
+      "registrant": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "registrant is not a valid address: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if credit type abbrev is empty
+    Given the message
+    """
+    {
+      "registrant": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+    }
+    """
+    When the message is validated
+    Then expect the error "credit type abbrev cannot be empty: invalid request"
+
+  Scenario: an error is returned if credit type abbrev is not formatted
+    Given the message
+    """
+    {
+      "registrant": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "credit_type_abbrev": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "credit type abbreviation must be 1-3 uppercase latin letters: got foo: parse error"
+
+  Scenario: an error is returned if bank denom is empty
+    Given the message
+    """
+    {
+      "registrant": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "credit_type_abbrev": "C"
+    }
+    """
+    When the message is validated
+    Then expect the error "bank denom cannot be empty: invalid request"
+
+  Scenario: an error is returned if bank denom is not formatted
+    Given the message
+    """
+    {
+      "registrant": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "credit_type_abbrev": "C",
+      "bank_denom": "1"
+    }
+    """
+    When the message is validated
+    Then expect the error "bank denom: invalid denom: 1: invalid request"
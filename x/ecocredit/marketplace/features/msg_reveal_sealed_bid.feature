@@ -0,0 +1,122 @@
+Feature: MsgRevealSealedBid
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "bidder": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "auction_id": 1,
+      "quantity": "100",
+      "bid_amount": "10",
+      "salt": "secret",
+      "retirement_jurisdiction": "US-WA"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with auto-retire disabled
+    Given the message
+    """
+    {
+      "bidder": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "auction_id": 1,
+      "quantity": "100",
+      "bid_amount": "10",
+      "salt": "secret",
+      "disable_auto_retire": true
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if bidder is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "bidder cannot be empty: invalid request"
+
+  Scenario: an error is returned if bidder is not a valid bech32 address
+    Given the message
+    """
+    {
+      "bidder": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "bidder is not a valid address: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if auction id is empty
+    Given the message
+    """
+    {
+      "bidder": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+    }
+    """
+    When the message is validated
+    Then expect the error "auction id cannot be empty: invalid request"
+
+  Scenario: an error is returned if quantity is empty
+    Given the message
+    """
+    {
+      "bidder": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "auction_id": 1
+    }
+    """
+    When the message is validated
+    Then expect the error "quantity cannot be empty: invalid request"
+
+  Scenario: an error is returned if bid amount is empty
+    Given the message
+    """
+    {
+      "bidder": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "auction_id": 1,
+      "quantity": "100"
+    }
+    """
+    When the message is validated
+    Then expect the error "bid amount cannot be empty: invalid request"
+
+  Scenario: an error is returned if bid amount is not a positive integer
+    Given the message
+    """
+    {
+      "bidder": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "auction_id": 1,
+      "quantity": "100",
+      "bid_amount": "1.5"
+    }
+    """
+    When the message is validated
+    Then expect the error "bid amount must be a positive integer: invalid request"
+
+  Scenario: an error is returned if salt is empty
+    Given the message
+    """
+    {
+      "bidder": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "auction_id": 1,
+      "quantity": "100",
+      "bid_amount": "10"
+    }
+    """
+    When the message is validated
+    Then expect the error "salt cannot be empty: invalid request"
+
+  Scenario: an error is returned if retirement jurisdiction is empty and auto-retire is enabled
+    Given the message
+    """
+    {
+      "bidder": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "auction_id": 1,
+      "quantity": "100",
+      "bid_amount": "10",
+      "salt": "secret"
+    }
+    """
+    When the message is validated
+    Then expect the error "jurisdiction cannot be empty, expected format <country-code>[-<region-code>[ <postal-code>]]: parse error: invalid request"
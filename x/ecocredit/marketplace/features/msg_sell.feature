@@ -267,3 +267,224 @@ Feature: MsgSell
     """
     When the message is validated
     Then expect the error "orders[0]: ask price: amount must be a positive integer: invalid request"
+
+  Scenario: a valid message with allowed buyers and buyer group policy
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "allowed_buyers": [
+            "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+          ],
+          "buyer_group_policy": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if an allowed buyer is not a valid bech32 address
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "allowed_buyers": [
+            "foo"
+          ]
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: allowed buyers[0]: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if an allowed buyer is the seller
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "allowed_buyers": [
+            "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+          ]
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: allowed buyers[0]: seller cannot be an allowed buyer: invalid request"
+
+  Scenario: an error is returned if allowed buyers includes a duplicate address
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "allowed_buyers": [
+            "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+            "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+          ]
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: allowed buyers[1]: duplicate address regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6: invalid request"
+
+  Scenario: an error is returned if buyer group policy is not a valid bech32 address
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "buyer_group_policy": "foo"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: buyer group policy: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: a valid message with min fill quantity
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "min_fill_quantity": "10.5"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with all-or-none
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "all_or_none": true
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if min fill quantity is not a positive decimal
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "min_fill_quantity": "-10"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: min fill quantity must be a positive decimal: invalid request"
+
+  Scenario: an error is returned if min fill quantity is greater than quantity
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "min_fill_quantity": "101"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: min fill quantity cannot be greater than quantity: invalid request"
+
+  Scenario: an error is returned if min fill quantity is set for an all-or-none sell order
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "min_fill_quantity": "10",
+          "all_or_none": true
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: min fill quantity cannot be set for all-or-none sell orders: invalid request"
@@ -0,0 +1,54 @@
+package marketplace
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var _ legacytx.LegacyMsg = &MsgAcceptOffer{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgAcceptOffer) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgAcceptOffer) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgAcceptOffer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgAcceptOffer) ValidateBasic() error {
+	if len(m.Holder) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("holder cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Holder); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("holder is not a valid address: %s", err)
+	}
+
+	if m.OfferId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("offer id cannot be empty")
+	}
+
+	if len(m.Quantity) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("quantity cannot be empty")
+	}
+
+	if _, err := math.NewPositiveDecFromString(m.Quantity); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("quantity must be a positive decimal")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgAcceptOffer.
+func (m *MsgAcceptOffer) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Holder)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,148 @@
+package marketplace
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+var _ legacytx.LegacyMsg = &MsgBuy{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgBuy) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgBuy) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgBuy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgBuy) ValidateBasic() error {
+	if len(m.Buyer) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("buyer cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Buyer); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("buyer is not a valid address: %s", err)
+	}
+
+	if len(m.Orders) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("orders cannot be empty")
+	}
+
+	for i, order := range m.Orders {
+		// orderIndex is used for more granular error messages when
+		// an individual order in a list of orders fails to process
+		orderIndex := fmt.Sprintf("orders[%d]", i)
+
+		if err := order.Filter.Validate(); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: %s", orderIndex, err)
+		}
+
+		if len(order.Quantity) == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: quantity cannot be empty", orderIndex)
+		}
+
+		if _, err := math.NewPositiveDecFromString(order.Quantity); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: quantity must be a positive decimal", orderIndex)
+		}
+
+		// sdk.Coin.Validate panics if coin is nil
+		if order.BidPrice == nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: bid price cannot be empty", orderIndex)
+		}
+
+		// sdk.Coin.Validate provides inadequate error if coin denom is empty
+		if len(order.BidPrice.Denom) == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: bid price: denom cannot be empty", orderIndex)
+		}
+
+		if err := sdk.ValidateDenom(order.BidPrice.Denom); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: bid price: %s", orderIndex, err)
+		}
+
+		// sdk.Coin.Validate panics if coin amount is nil
+		if order.BidPrice.Amount.IsNil() {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: bid price: amount cannot be empty", orderIndex)
+		}
+
+		// sdk.Coin.Validate provides inadequate error if coin amount is not a positive integer
+		if !order.BidPrice.Amount.IsPositive() {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: bid price: amount must be a positive integer", orderIndex)
+		}
+
+		if !order.DisableAutoRetire {
+			if err := core.ValidateJurisdiction(order.RetirementJurisdiction); err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("%s: %s", orderIndex, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgBuy.
+func (m *MsgBuy) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Buyer)
+	return []sdk.AccAddress{addr}
+}
+
+// Validate performs a basic validation of the filter. Each credit class, project,
+// and credit batch can only be selected by one criteria within the filter.
+func (f *BuyOrder_Filter) Validate() error {
+	if f == nil || len(f.Or) == 0 {
+		return fmt.Errorf("filter cannot be empty")
+	}
+
+	seen := make(map[string]bool)
+	for i, criteria := range f.Or {
+		// criteriaIndex is used for more granular error messages when
+		// an individual criteria in a list of criteria fails validation
+		criteriaIndex := fmt.Sprintf("filter.or[%d]", i)
+
+		var key string
+		switch {
+		case criteria.GetClassSelector() != nil:
+			selector := criteria.GetClassSelector()
+			if err := core.ValidateClassId(selector.ClassId); err != nil {
+				return fmt.Errorf("%s: class selector: %s", criteriaIndex, err)
+			}
+			if selector.ProjectJurisdiction != "" {
+				if err := core.ValidateJurisdiction(selector.ProjectJurisdiction); err != nil {
+					return fmt.Errorf("%s: class selector: %s", criteriaIndex, err)
+				}
+			}
+			key = "class " + selector.ClassId
+		case criteria.GetProjectSelector() != nil:
+			selector := criteria.GetProjectSelector()
+			if err := core.ValidateProjectId(selector.ProjectId); err != nil {
+				return fmt.Errorf("%s: project selector: %s", criteriaIndex, err)
+			}
+			key = "project " + selector.ProjectId
+		case criteria.GetBatchSelector() != nil:
+			selector := criteria.GetBatchSelector()
+			if err := core.ValidateBatchDenom(selector.BatchDenom); err != nil {
+				return fmt.Errorf("%s: batch selector: %s", criteriaIndex, err)
+			}
+			key = "batch " + selector.BatchDenom
+		default:
+			return fmt.Errorf("%s: selector cannot be empty", criteriaIndex)
+		}
+
+		if seen[key] {
+			return fmt.Errorf("%s: duplicate selector for %s", criteriaIndex, key)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
@@ -27,6 +27,10 @@ func (m MsgBuyDirect) ValidateBasic() error {
 		return sdkerrors.ErrInvalidRequest.Wrap("orders cannot be empty")
 	}
 
+	if err := m.MaxTotalCost.Validate(); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("max total cost: %s", err)
+	}
+
 	for i, order := range m.Orders {
 		// orderIndex is used for more granular error messages when
 		// an individual order in a list of orders fails to process
@@ -72,6 +76,10 @@ func (m MsgBuyDirect) ValidateBasic() error {
 			if err := core.ValidateJurisdiction(order.RetirementJurisdiction); err != nil {
 				return sdkerrors.ErrInvalidRequest.Wrapf("%s: %s", orderIndex, err)
 			}
+
+			if err := core.ValidateRetirementBeneficiary(order.BeneficiaryAddress, order.BeneficiaryName, order.Reason); err != nil {
+				return sdkerrors.Wrap(err, orderIndex)
+			}
 		}
 	}
 
@@ -0,0 +1,85 @@
+package marketplace
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+var _ legacytx.LegacyMsg = &MsgBuyDutchAuction{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgBuyDutchAuction) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgBuyDutchAuction) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgBuyDutchAuction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgBuyDutchAuction) ValidateBasic() error {
+	if len(m.Buyer) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("buyer cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Buyer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("buyer is not a valid address: %s", err)
+	}
+
+	if m.AuctionId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("auction id cannot be empty")
+	}
+
+	if len(m.Quantity) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("quantity cannot be empty")
+	}
+
+	if _, err := math.NewPositiveDecFromString(m.Quantity); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("quantity must be a positive decimal")
+	}
+
+	// sdk.Coin.Validate panics if coin is nil
+	if m.MaxPrice == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("max price cannot be empty")
+	}
+
+	// sdk.Coin.Validate provides inadequate error if coin denom is empty
+	if len(m.MaxPrice.Denom) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("max price: denom cannot be empty")
+	}
+
+	if err := sdk.ValidateDenom(m.MaxPrice.Denom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("max price: %s", err)
+	}
+
+	// sdk.Coin.Validate panics if coin amount is nil
+	if m.MaxPrice.Amount.IsNil() {
+		return sdkerrors.ErrInvalidRequest.Wrap("max price: amount cannot be empty")
+	}
+
+	// sdk.Coin.Validate provides inadequate error if coin amount is not a positive integer
+	if !m.MaxPrice.Amount.IsPositive() {
+		return sdkerrors.ErrInvalidRequest.Wrap("max price: amount must be a positive integer")
+	}
+
+	if !m.DisableAutoRetire {
+		if err := core.ValidateJurisdiction(m.RetirementJurisdiction); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgBuyDutchAuction.
+func (m *MsgBuyDutchAuction) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Buyer)
+	return []sdk.AccAddress{addr}
+}
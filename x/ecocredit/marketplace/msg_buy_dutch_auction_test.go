@@ -0,0 +1,41 @@
+package marketplace
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgBuyDutchAuctionSuite struct {
+	t   gocuke.TestingT
+	msg *MsgBuyDutchAuction
+	err error
+}
+
+func TestMsgBuyDutchAuction(t *testing.T) {
+	gocuke.NewRunner(t, &msgBuyDutchAuctionSuite{}).Path("./features/msg_buy_dutch_auction.feature").Run()
+}
+
+func (s *msgBuyDutchAuctionSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgBuyDutchAuctionSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgBuyDutchAuction{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgBuyDutchAuctionSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgBuyDutchAuctionSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgBuyDutchAuctionSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
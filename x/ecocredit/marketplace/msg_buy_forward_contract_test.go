@@ -0,0 +1,41 @@
+package marketplace
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgBuyForwardContractSuite struct {
+	t   gocuke.TestingT
+	msg *MsgBuyForwardContract
+	err error
+}
+
+func TestMsgBuyForwardContract(t *testing.T) {
+	gocuke.NewRunner(t, &msgBuyForwardContractSuite{}).Path("./features/msg_buy_forward_contract.feature").Run()
+}
+
+func (s *msgBuyForwardContractSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgBuyForwardContractSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgBuyForwardContract{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgBuyForwardContractSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgBuyForwardContractSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgBuyForwardContractSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
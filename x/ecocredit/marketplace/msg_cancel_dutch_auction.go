@@ -0,0 +1,45 @@
+package marketplace
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var _ legacytx.LegacyMsg = &MsgCancelDutchAuction{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgCancelDutchAuction) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgCancelDutchAuction) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCancelDutchAuction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgCancelDutchAuction) ValidateBasic() error {
+	if len(m.Seller) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("seller cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Seller); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("seller is not a valid address: %s", err)
+	}
+
+	if m.AuctionId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("auction id cannot be empty")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgCancelDutchAuction.
+func (m *MsgCancelDutchAuction) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Seller)
+	return []sdk.AccAddress{addr}
+}
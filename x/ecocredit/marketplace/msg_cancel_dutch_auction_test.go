@@ -0,0 +1,41 @@
+package marketplace
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgCancelDutchAuctionSuite struct {
+	t   gocuke.TestingT
+	msg *MsgCancelDutchAuction
+	err error
+}
+
+func TestMsgCancelDutchAuction(t *testing.T) {
+	gocuke.NewRunner(t, &msgCancelDutchAuctionSuite{}).Path("./features/msg_cancel_dutch_auction.feature").Run()
+}
+
+func (s *msgCancelDutchAuctionSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgCancelDutchAuctionSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgCancelDutchAuction{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgCancelDutchAuctionSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgCancelDutchAuctionSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgCancelDutchAuctionSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -0,0 +1,45 @@
+package marketplace
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var _ legacytx.LegacyMsg = &MsgCancelOffer{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgCancelOffer) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgCancelOffer) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCancelOffer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgCancelOffer) ValidateBasic() error {
+	if len(m.Buyer) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("buyer cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Buyer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("buyer is not a valid address: %s", err)
+	}
+
+	if m.OfferId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("offer id cannot be empty")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgCancelOffer.
+func (m *MsgCancelOffer) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Buyer)
+	return []sdk.AccAddress{addr}
+}
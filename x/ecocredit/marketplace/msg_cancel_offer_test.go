@@ -0,0 +1,41 @@
+package marketplace
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgCancelOfferSuite struct {
+	t   gocuke.TestingT
+	msg *MsgCancelOffer
+	err error
+}
+
+func TestMsgCancelOffer(t *testing.T) {
+	gocuke.NewRunner(t, &msgCancelOfferSuite{}).Path("./features/msg_cancel_offer.feature").Run()
+}
+
+func (s *msgCancelOfferSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgCancelOfferSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgCancelOffer{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgCancelOfferSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgCancelOfferSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgCancelOfferSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
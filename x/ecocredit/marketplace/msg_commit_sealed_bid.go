@@ -0,0 +1,75 @@
+package marketplace
+
+import (
+	"crypto/sha256"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var _ legacytx.LegacyMsg = &MsgCommitSealedBid{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgCommitSealedBid) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgCommitSealedBid) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCommitSealedBid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgCommitSealedBid) ValidateBasic() error {
+	if len(m.Bidder) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("bidder cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Bidder); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("bidder is not a valid address: %s", err)
+	}
+
+	if m.AuctionId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("auction id cannot be empty")
+	}
+
+	if len(m.Commitment) != sha256.Size {
+		return sdkerrors.ErrInvalidRequest.Wrapf("commitment must be %d bytes", sha256.Size)
+	}
+
+	// sdk.Coin.Validate panics if coin is nil
+	if m.Deposit == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("deposit cannot be empty")
+	}
+
+	// sdk.Coin.Validate provides inadequate error if coin denom is empty
+	if len(m.Deposit.Denom) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("deposit: denom cannot be empty")
+	}
+
+	if err := sdk.ValidateDenom(m.Deposit.Denom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("deposit: %s", err)
+	}
+
+	// sdk.Coin.Validate panics if coin amount is nil
+	if m.Deposit.Amount.IsNil() {
+		return sdkerrors.ErrInvalidRequest.Wrap("deposit: amount cannot be empty")
+	}
+
+	// sdk.Coin.Validate provides inadequate error if coin amount is not a positive integer
+	if !m.Deposit.Amount.IsPositive() {
+		return sdkerrors.ErrInvalidRequest.Wrap("deposit: amount must be a positive integer")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgCommitSealedBid.
+func (m *MsgCommitSealedBid) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Bidder)
+	return []sdk.AccAddress{addr}
+}
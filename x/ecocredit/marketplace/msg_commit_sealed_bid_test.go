@@ -0,0 +1,41 @@
+package marketplace
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgCommitSealedBidSuite struct {
+	t   gocuke.TestingT
+	msg *MsgCommitSealedBid
+	err error
+}
+
+func TestMsgCommitSealedBid(t *testing.T) {
+	gocuke.NewRunner(t, &msgCommitSealedBidSuite{}).Path("./features/msg_commit_sealed_bid.feature").Run()
+}
+
+func (s *msgCommitSealedBidSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgCommitSealedBidSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgCommitSealedBid{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgCommitSealedBidSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgCommitSealedBidSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgCommitSealedBidSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
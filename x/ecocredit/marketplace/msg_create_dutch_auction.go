@@ -0,0 +1,115 @@
+package marketplace
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+var _ legacytx.LegacyMsg = &MsgCreateDutchAuction{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgCreateDutchAuction) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgCreateDutchAuction) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCreateDutchAuction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgCreateDutchAuction) ValidateBasic() error {
+	if len(m.Seller) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("seller cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Seller); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("seller is not a valid address: %s", err)
+	}
+
+	if err := core.ValidateBatchDenom(m.BatchDenom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if len(m.Quantity) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("quantity cannot be empty")
+	}
+
+	if _, err := math.NewPositiveDecFromString(m.Quantity); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("quantity must be a positive decimal")
+	}
+
+	// sdk.Coin.Validate panics if coin is nil
+	if m.StartPrice == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("start price cannot be empty")
+	}
+
+	// sdk.Coin.Validate provides inadequate error if coin denom is empty
+	if len(m.StartPrice.Denom) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("start price: denom cannot be empty")
+	}
+
+	if err := sdk.ValidateDenom(m.StartPrice.Denom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("start price: %s", err)
+	}
+
+	// sdk.Coin.Validate panics if coin amount is nil
+	if m.StartPrice.Amount.IsNil() {
+		return sdkerrors.ErrInvalidRequest.Wrap("start price: amount cannot be empty")
+	}
+
+	// sdk.Coin.Validate provides inadequate error if coin amount is not a positive integer
+	if !m.StartPrice.Amount.IsPositive() {
+		return sdkerrors.ErrInvalidRequest.Wrap("start price: amount must be a positive integer")
+	}
+
+	// sdk.Coin.Validate panics if coin is nil
+	if m.FloorPrice == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("floor price cannot be empty")
+	}
+
+	// sdk.Coin.Validate provides inadequate error if coin denom is empty
+	if len(m.FloorPrice.Denom) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("floor price: denom cannot be empty")
+	}
+
+	if err := sdk.ValidateDenom(m.FloorPrice.Denom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("floor price: %s", err)
+	}
+
+	// sdk.Coin.Validate panics if coin amount is nil
+	if m.FloorPrice.Amount.IsNil() {
+		return sdkerrors.ErrInvalidRequest.Wrap("floor price: amount cannot be empty")
+	}
+
+	// sdk.Coin.Validate provides inadequate error if coin amount is not a positive integer
+	if !m.FloorPrice.Amount.IsPositive() {
+		return sdkerrors.ErrInvalidRequest.Wrap("floor price: amount must be a positive integer")
+	}
+
+	if m.FloorPrice.Denom != m.StartPrice.Denom {
+		return sdkerrors.ErrInvalidRequest.Wrap("floor price: denom must match start price denom")
+	}
+
+	if !m.FloorPrice.Amount.LT(m.StartPrice.Amount) {
+		return sdkerrors.ErrInvalidRequest.Wrap("floor price: amount must be less than start price amount")
+	}
+
+	if m.Duration <= 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("duration must be positive")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgCreateDutchAuction.
+func (m *MsgCreateDutchAuction) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Seller)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,41 @@
+package marketplace
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgCreateDutchAuctionSuite struct {
+	t   gocuke.TestingT
+	msg *MsgCreateDutchAuction
+	err error
+}
+
+func TestMsgCreateDutchAuction(t *testing.T) {
+	gocuke.NewRunner(t, &msgCreateDutchAuctionSuite{}).Path("./features/msg_create_dutch_auction.feature").Run()
+}
+
+func (s *msgCreateDutchAuctionSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgCreateDutchAuctionSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgCreateDutchAuction{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgCreateDutchAuctionSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgCreateDutchAuctionSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgCreateDutchAuctionSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
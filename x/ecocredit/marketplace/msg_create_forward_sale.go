@@ -0,0 +1,95 @@
+package marketplace
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+var _ legacytx.LegacyMsg = &MsgCreateForwardSale{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgCreateForwardSale) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgCreateForwardSale) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCreateForwardSale) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgCreateForwardSale) ValidateBasic() error {
+	if len(m.Seller) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("seller cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Seller); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("seller is not a valid address: %s", err)
+	}
+
+	if err := core.ValidateProjectId(m.ProjectId); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if len(m.Quantity) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("quantity cannot be empty")
+	}
+
+	if _, err := math.NewPositiveDecFromString(m.Quantity); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("quantity must be a positive decimal")
+	}
+
+	// sdk.Coin.Validate panics if coin is nil
+	if m.AskPrice == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("ask price cannot be empty")
+	}
+
+	// sdk.Coin.Validate provides inadequate error if coin denom is empty
+	if len(m.AskPrice.Denom) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("ask price: denom cannot be empty")
+	}
+
+	if err := sdk.ValidateDenom(m.AskPrice.Denom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("ask price: %s", err)
+	}
+
+	// sdk.Coin.Validate panics if coin amount is nil
+	if m.AskPrice.Amount.IsNil() {
+		return sdkerrors.ErrInvalidRequest.Wrap("ask price: amount cannot be empty")
+	}
+
+	// sdk.Coin.Validate provides inadequate error if coin amount is not a positive integer
+	if !m.AskPrice.Amount.IsPositive() {
+		return sdkerrors.ErrInvalidRequest.Wrap("ask price: amount must be a positive integer")
+	}
+
+	if m.VintageStart == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("vintage start cannot be empty")
+	}
+
+	if m.VintageEnd == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("vintage end cannot be empty")
+	}
+
+	if m.VintageStart.After(*m.VintageEnd) {
+		return sdkerrors.ErrInvalidRequest.Wrap("vintage start cannot be after vintage end")
+	}
+
+	if m.DeliveryDeadline == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("delivery deadline cannot be empty")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgCreateForwardSale.
+func (m *MsgCreateForwardSale) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Seller)
+	return []sdk.AccAddress{addr}
+}
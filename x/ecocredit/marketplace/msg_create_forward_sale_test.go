@@ -0,0 +1,41 @@
+package marketplace
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgCreateForwardSaleSuite struct {
+	t   gocuke.TestingT
+	msg *MsgCreateForwardSale
+	err error
+}
+
+func TestMsgCreateForwardSale(t *testing.T) {
+	gocuke.NewRunner(t, &msgCreateForwardSaleSuite{}).Path("./features/msg_create_forward_sale.feature").Run()
+}
+
+func (s *msgCreateForwardSaleSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgCreateForwardSaleSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgCreateForwardSale{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgCreateForwardSaleSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgCreateForwardSaleSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgCreateForwardSaleSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -0,0 +1,87 @@
+package marketplace
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+var _ legacytx.LegacyMsg = &MsgCreateSealedBidAuction{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgCreateSealedBidAuction) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgCreateSealedBidAuction) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCreateSealedBidAuction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgCreateSealedBidAuction) ValidateBasic() error {
+	if len(m.Seller) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("seller cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Seller); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("seller is not a valid address: %s", err)
+	}
+
+	if err := core.ValidateBatchDenom(m.BatchDenom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if len(m.Quantity) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("quantity cannot be empty")
+	}
+
+	if _, err := math.NewPositiveDecFromString(m.Quantity); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("quantity must be a positive decimal")
+	}
+
+	// sdk.Coin.Validate panics if coin is nil
+	if m.ReservePrice == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("reserve price cannot be empty")
+	}
+
+	// sdk.Coin.Validate provides inadequate error if coin denom is empty
+	if len(m.ReservePrice.Denom) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("reserve price: denom cannot be empty")
+	}
+
+	if err := sdk.ValidateDenom(m.ReservePrice.Denom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("reserve price: %s", err)
+	}
+
+	// sdk.Coin.Validate panics if coin amount is nil
+	if m.ReservePrice.Amount.IsNil() {
+		return sdkerrors.ErrInvalidRequest.Wrap("reserve price: amount cannot be empty")
+	}
+
+	// sdk.Coin.Validate provides inadequate error if coin amount is not a positive integer
+	if !m.ReservePrice.Amount.IsPositive() {
+		return sdkerrors.ErrInvalidRequest.Wrap("reserve price: amount must be a positive integer")
+	}
+
+	if m.CommitEndHeight <= 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("commit end height must be positive")
+	}
+
+	if m.RevealEndHeight <= m.CommitEndHeight {
+		return sdkerrors.ErrInvalidRequest.Wrap("reveal end height must be greater than commit end height")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgCreateSealedBidAuction.
+func (m *MsgCreateSealedBidAuction) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Seller)
+	return []sdk.AccAddress{addr}
+}
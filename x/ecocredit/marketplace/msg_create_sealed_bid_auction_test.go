@@ -0,0 +1,41 @@
+package marketplace
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgCreateSealedBidAuctionSuite struct {
+	t   gocuke.TestingT
+	msg *MsgCreateSealedBidAuction
+	err error
+}
+
+func TestMsgCreateSealedBidAuction(t *testing.T) {
+	gocuke.NewRunner(t, &msgCreateSealedBidAuctionSuite{}).Path("./features/msg_create_sealed_bid_auction.feature").Run()
+}
+
+func (s *msgCreateSealedBidAuctionSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgCreateSealedBidAuctionSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgCreateSealedBidAuction{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgCreateSealedBidAuctionSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgCreateSealedBidAuctionSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgCreateSealedBidAuctionSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -0,0 +1,38 @@
+package marketplace
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var _ govtypes.Content = &DelistMarketProposal{}
+
+const DelistMarketProposalType = "DelistMarketProposal"
+
+func init() {
+	govtypes.RegisterProposalType(DelistMarketProposalType)
+	govtypes.RegisterProposalTypeCodec(&DelistMarketProposal{}, "regen/DelistMarketProposal")
+}
+
+func (m DelistMarketProposal) ProposalRoute() string { return ecocredit.RouterKey }
+
+func (m DelistMarketProposal) ProposalType() string { return DelistMarketProposalType }
+
+func (m DelistMarketProposal) ValidateBasic() error {
+	if m.MarketId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("market id cannot be empty")
+	}
+	return govtypes.ValidateAbstract(&m)
+}
+
+func (m DelistMarketProposal) String() string {
+	return fmt.Sprintf(`Delist Market Proposal:
+  Title:       %s
+  Description: %s
+  Market Id:   %d
+`, m.Title, m.Description, m.MarketId)
+}
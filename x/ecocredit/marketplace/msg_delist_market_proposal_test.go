@@ -0,0 +1,45 @@
+package marketplace
+
+import (
+	"testing"
+)
+
+func TestDelistMarketProposal_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name    string
+		p       DelistMarketProposal
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			p: DelistMarketProposal{
+				Title:       "foo",
+				Description: "bar",
+				MarketId:    1,
+			},
+		},
+		{
+			name: "no title",
+			p: DelistMarketProposal{
+				Description: "bar",
+				MarketId:    1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "no market id",
+			p: DelistMarketProposal{
+				Title:       "foo",
+				Description: "bar",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.p.ValidateBasic(); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package marketplace
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var _ govtypes.Content = &FeeDestinationProposal{}
+
+const (
+	FeeDestinationProposalType = "FeeDestinationProposal"
+)
+
+func init() {
+	govtypes.RegisterProposalType(FeeDestinationProposalType)
+	govtypes.RegisterProposalTypeCodec(&FeeDestinationProposal{}, "regen/FeeDestinationProposal")
+}
+
+func (m FeeDestinationProposal) ProposalRoute() string { return ecocredit.RouterKey }
+
+func (m FeeDestinationProposal) ProposalType() string { return FeeDestinationProposalType }
+
+func (m FeeDestinationProposal) ValidateBasic() error {
+	if _, ok := FeeDestinationType_name[int32(m.Destination)]; !ok {
+		return sdkerrors.ErrInvalidRequest.Wrapf("unknown fee destination %d", m.Destination)
+	}
+	if m.Destination == FeeDestinationType_FEE_DESTINATION_TYPE_ACCOUNT {
+		if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("fee destination address: %s", err)
+		}
+	} else if m.Address != "" {
+		return sdkerrors.ErrInvalidRequest.Wrapf("address must be empty for fee destination %s", m.Destination)
+	}
+	return govtypes.ValidateAbstract(&m)
+}
+
+func (m FeeDestinationProposal) String() string {
+	return fmt.Sprintf(`Fee Destination Proposal:
+  Title:       %s
+  Description: %s
+  Destination: %s
+  Address:     %s
+`, m.Title, m.Description, m.Destination, m.Address)
+}
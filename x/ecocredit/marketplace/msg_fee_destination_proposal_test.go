@@ -0,0 +1,86 @@
+package marketplace
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+)
+
+func TestFeeDestinationProposal_ValidateBasic(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	tests := []struct {
+		name    string
+		p       FeeDestinationProposal
+		wantErr bool
+	}{
+		{
+			name: "valid community pool",
+			p: FeeDestinationProposal{
+				Title:       "foo",
+				Description: "bar",
+				Destination: FeeDestinationType_FEE_DESTINATION_TYPE_COMMUNITY_POOL,
+			},
+		},
+		{
+			name: "valid burn",
+			p: FeeDestinationProposal{
+				Title:       "foo",
+				Description: "bar",
+				Destination: FeeDestinationType_FEE_DESTINATION_TYPE_BURN,
+			},
+		},
+		{
+			name: "valid account",
+			p: FeeDestinationProposal{
+				Title:       "foo",
+				Description: "bar",
+				Destination: FeeDestinationType_FEE_DESTINATION_TYPE_ACCOUNT,
+				Address:     addr.String(),
+			},
+		},
+		{
+			name: "no description",
+			p: FeeDestinationProposal{
+				Title:       "foo",
+				Destination: FeeDestinationType_FEE_DESTINATION_TYPE_BURN,
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown destination",
+			p: FeeDestinationProposal{
+				Title:       "foo",
+				Description: "bar",
+				Destination: 10,
+			},
+			wantErr: true,
+		},
+		{
+			name: "account without address",
+			p: FeeDestinationProposal{
+				Title:       "foo",
+				Description: "bar",
+				Destination: FeeDestinationType_FEE_DESTINATION_TYPE_ACCOUNT,
+			},
+			wantErr: true,
+		},
+		{
+			name: "burn with address",
+			p: FeeDestinationProposal{
+				Title:       "foo",
+				Description: "bar",
+				Destination: FeeDestinationType_FEE_DESTINATION_TYPE_BURN,
+				Address:     addr.String(),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.p.ValidateBasic(); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
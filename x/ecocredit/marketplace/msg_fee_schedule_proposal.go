@@ -0,0 +1,67 @@
+package marketplace
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+var _ govtypes.Content = &FeeScheduleProposal{}
+
+const (
+	FeeScheduleProposalType = "FeeScheduleProposal"
+
+	// MaxFeeBps is the maximum fee in basis points.
+	MaxFeeBps uint32 = 10000
+)
+
+func init() {
+	govtypes.RegisterProposalType(FeeScheduleProposalType)
+	govtypes.RegisterProposalTypeCodec(&FeeScheduleProposal{}, "regen/FeeScheduleProposal")
+}
+
+func (m FeeScheduleProposal) ProposalRoute() string { return ecocredit.RouterKey }
+
+func (m FeeScheduleProposal) ProposalType() string { return FeeScheduleProposalType }
+
+func (m FeeScheduleProposal) ValidateBasic() error {
+	if err := core.ValidateCreditTypeAbbreviation(m.CreditTypeAbbrev); err != nil {
+		return err
+	}
+	if m.BankDenom != "" {
+		if err := sdk.ValidateDenom(m.BankDenom); err != nil {
+			return err
+		}
+	}
+	if err := ValidateFeeBps(m.MakerFeeBps); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("maker fee: %s", err)
+	}
+	if err := ValidateFeeBps(m.TakerFeeBps); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("taker fee: %s", err)
+	}
+	return govtypes.ValidateAbstract(&m)
+}
+
+func (m FeeScheduleProposal) String() string {
+	return fmt.Sprintf(`Fee Schedule Proposal:
+  Title:              %s
+  Description:        %s
+  Credit Type Abbrev: %s
+  Bank Denom:         %s
+  Maker Fee Bps:      %d
+  Taker Fee Bps:      %d
+`, m.Title, m.Description, m.CreditTypeAbbrev, m.BankDenom, m.MakerFeeBps, m.TakerFeeBps)
+}
+
+// ValidateFeeBps checks that the fee in basis points does not exceed MaxFeeBps.
+func ValidateFeeBps(bps uint32) error {
+	if bps > MaxFeeBps {
+		return fmt.Errorf("fee cannot exceed %d basis points", MaxFeeBps)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package marketplace
+
+import (
+	"testing"
+)
+
+func TestFeeScheduleProposal_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name    string
+		p       FeeScheduleProposal
+		wantErr bool
+	}{
+		{
+			name: "valid credit type fees",
+			p: FeeScheduleProposal{
+				Title:            "foo",
+				Description:      "bar",
+				CreditTypeAbbrev: "C",
+				MakerFeeBps:      50,
+				TakerFeeBps:      100,
+			},
+		},
+		{
+			name: "valid market fees",
+			p: FeeScheduleProposal{
+				Title:            "foo",
+				Description:      "bar",
+				CreditTypeAbbrev: "C",
+				BankDenom:        "uregen",
+				MakerFeeBps:      0,
+				TakerFeeBps:      MaxFeeBps,
+			},
+		},
+		{
+			name: "no title",
+			p: FeeScheduleProposal{
+				Description:      "bar",
+				CreditTypeAbbrev: "C",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid credit type",
+			p: FeeScheduleProposal{
+				Title:            "foo",
+				Description:      "bar",
+				CreditTypeAbbrev: "c",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid bank denom",
+			p: FeeScheduleProposal{
+				Title:            "foo",
+				Description:      "bar",
+				CreditTypeAbbrev: "C",
+				BankDenom:        "1",
+			},
+			wantErr: true,
+		},
+		{
+			name: "maker fee too high",
+			p: FeeScheduleProposal{
+				Title:            "foo",
+				Description:      "bar",
+				CreditTypeAbbrev: "C",
+				MakerFeeBps:      MaxFeeBps + 1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "taker fee too high",
+			p: FeeScheduleProposal{
+				Title:            "foo",
+				Description:      "bar",
+				CreditTypeAbbrev: "C",
+				TakerFeeBps:      MaxFeeBps + 1,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.p.ValidateBasic(); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
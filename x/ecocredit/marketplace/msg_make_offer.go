@@ -0,0 +1,95 @@
+package marketplace
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+var _ legacytx.LegacyMsg = &MsgMakeOffer{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgMakeOffer) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgMakeOffer) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgMakeOffer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgMakeOffer) ValidateBasic() error {
+	if len(m.Buyer) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("buyer cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Buyer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("buyer is not a valid address: %s", err)
+	}
+
+	if err := core.ValidateBatchDenom(m.BatchDenom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if len(m.Holder) != 0 {
+		if _, err := sdk.AccAddressFromBech32(m.Holder); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("holder is not a valid address: %s", err)
+		}
+
+		if m.Holder == m.Buyer {
+			return sdkerrors.ErrInvalidRequest.Wrap("holder cannot be the buyer")
+		}
+	}
+
+	if len(m.Quantity) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("quantity cannot be empty")
+	}
+
+	if _, err := math.NewPositiveDecFromString(m.Quantity); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("quantity must be a positive decimal")
+	}
+
+	// sdk.Coin.Validate panics if coin is nil
+	if m.BidPrice == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("bid price cannot be empty")
+	}
+
+	// sdk.Coin.Validate provides inadequate error if coin denom is empty
+	if len(m.BidPrice.Denom) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("bid price: denom cannot be empty")
+	}
+
+	if err := sdk.ValidateDenom(m.BidPrice.Denom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("bid price: %s", err)
+	}
+
+	// sdk.Coin.Validate panics if coin amount is nil
+	if m.BidPrice.Amount.IsNil() {
+		return sdkerrors.ErrInvalidRequest.Wrap("bid price: amount cannot be empty")
+	}
+
+	// sdk.Coin.Validate provides inadequate error if coin amount is not a positive integer
+	if !m.BidPrice.Amount.IsPositive() {
+		return sdkerrors.ErrInvalidRequest.Wrap("bid price: amount must be a positive integer")
+	}
+
+	if !m.DisableAutoRetire {
+		if err := core.ValidateJurisdiction(m.RetirementJurisdiction); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgMakeOffer.
+func (m *MsgMakeOffer) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Buyer)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,41 @@
+package marketplace
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgMakeOfferSuite struct {
+	t   gocuke.TestingT
+	msg *MsgMakeOffer
+	err error
+}
+
+func TestMsgMakeOffer(t *testing.T) {
+	gocuke.NewRunner(t, &msgMakeOfferSuite{}).Path("./features/msg_make_offer.feature").Run()
+}
+
+func (s *msgMakeOfferSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgMakeOfferSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgMakeOffer{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgMakeOfferSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgMakeOfferSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgMakeOfferSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -0,0 +1,49 @@
+package marketplace
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var _ govtypes.Content = &MarketRegistrationProposal{}
+
+const MarketRegistrationProposalType = "MarketRegistrationProposal"
+
+func init() {
+	govtypes.RegisterProposalType(MarketRegistrationProposalType)
+	govtypes.RegisterProposalTypeCodec(&MarketRegistrationProposal{}, "regen/MarketRegistrationProposal")
+}
+
+func (m MarketRegistrationProposal) ProposalRoute() string { return ecocredit.RouterKey }
+
+func (m MarketRegistrationProposal) ProposalType() string { return MarketRegistrationProposalType }
+
+func (m MarketRegistrationProposal) ValidateBasic() error {
+	if m.Enabled {
+		if m.Deposit == nil {
+			return sdkerrors.ErrInvalidRequest.Wrap("deposit cannot be empty if market registration is enabled")
+		}
+		if err := m.Deposit.Validate(); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("deposit: %s", err)
+		}
+		if !m.Deposit.IsPositive() {
+			return sdkerrors.ErrInvalidRequest.Wrap("deposit: amount must be a positive integer")
+		}
+	} else if m.Deposit != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("deposit must be empty if market registration is disabled")
+	}
+	return govtypes.ValidateAbstract(&m)
+}
+
+func (m MarketRegistrationProposal) String() string {
+	return fmt.Sprintf(`Market Registration Proposal:
+  Title:       %s
+  Description: %s
+  Enabled:     %t
+  Deposit:     %s
+`, m.Title, m.Description, m.Enabled, m.Deposit)
+}
@@ -0,0 +1,76 @@
+package marketplace
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMarketRegistrationProposal_ValidateBasic(t *testing.T) {
+	deposit := sdk.NewInt64Coin("uregen", 1000000)
+	zero := sdk.NewInt64Coin("uregen", 0)
+	tests := []struct {
+		name    string
+		p       MarketRegistrationProposal
+		wantErr bool
+	}{
+		{
+			name: "valid enabled",
+			p: MarketRegistrationProposal{
+				Title:       "foo",
+				Description: "bar",
+				Enabled:     true,
+				Deposit:     &deposit,
+			},
+		},
+		{
+			name: "valid disabled",
+			p: MarketRegistrationProposal{
+				Title:       "foo",
+				Description: "bar",
+			},
+		},
+		{
+			name: "no title",
+			p: MarketRegistrationProposal{
+				Description: "bar",
+			},
+			wantErr: true,
+		},
+		{
+			name: "enabled without deposit",
+			p: MarketRegistrationProposal{
+				Title:       "foo",
+				Description: "bar",
+				Enabled:     true,
+			},
+			wantErr: true,
+		},
+		{
+			name: "enabled with zero deposit",
+			p: MarketRegistrationProposal{
+				Title:       "foo",
+				Description: "bar",
+				Enabled:     true,
+				Deposit:     &zero,
+			},
+			wantErr: true,
+		},
+		{
+			name: "disabled with deposit",
+			p: MarketRegistrationProposal{
+				Title:       "foo",
+				Description: "bar",
+				Deposit:     &deposit,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.p.ValidateBasic(); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package marketplace
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+var _ legacytx.LegacyMsg = &MsgRegisterMarket{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgRegisterMarket) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgRegisterMarket) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRegisterMarket) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgRegisterMarket) ValidateBasic() error {
+	if len(m.Registrant) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("registrant cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Registrant); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("registrant is not a valid address: %s", err)
+	}
+
+	if len(m.CreditTypeAbbrev) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("credit type abbrev cannot be empty")
+	}
+
+	if err := core.ValidateCreditTypeAbbreviation(m.CreditTypeAbbrev); err != nil {
+		return err
+	}
+
+	if len(m.BankDenom) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("bank denom cannot be empty")
+	}
+
+	if err := sdk.ValidateDenom(m.BankDenom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("bank denom: %s", err)
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgRegisterMarket.
+func (m *MsgRegisterMarket) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Registrant)
+	return []sdk.AccAddress{addr}
+}
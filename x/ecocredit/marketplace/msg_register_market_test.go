@@ -0,0 +1,41 @@
+package marketplace
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgRegisterMarketSuite struct {
+	t   gocuke.TestingT
+	msg *MsgRegisterMarket
+	err error
+}
+
+func TestMsgRegisterMarket(t *testing.T) {
+	gocuke.NewRunner(t, &msgRegisterMarketSuite{}).Path("./features/msg_register_market.feature").Run()
+}
+
+func (s *msgRegisterMarketSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgRegisterMarketSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgRegisterMarket{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgRegisterMarketSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgRegisterMarketSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgRegisterMarketSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
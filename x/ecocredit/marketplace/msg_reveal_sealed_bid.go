@@ -0,0 +1,84 @@
+package marketplace
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+var _ legacytx.LegacyMsg = &MsgRevealSealedBid{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgRevealSealedBid) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgRevealSealedBid) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRevealSealedBid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgRevealSealedBid) ValidateBasic() error {
+	if len(m.Bidder) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("bidder cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Bidder); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("bidder is not a valid address: %s", err)
+	}
+
+	if m.AuctionId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("auction id cannot be empty")
+	}
+
+	if len(m.Quantity) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("quantity cannot be empty")
+	}
+
+	if _, err := math.NewPositiveDecFromString(m.Quantity); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("quantity must be a positive decimal")
+	}
+
+	if len(m.BidAmount) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("bid amount cannot be empty")
+	}
+
+	if amount, ok := sdk.NewIntFromString(m.BidAmount); !ok || !amount.IsPositive() {
+		return sdkerrors.ErrInvalidRequest.Wrap("bid amount must be a positive integer")
+	}
+
+	if len(m.Salt) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("salt cannot be empty")
+	}
+
+	if !m.DisableAutoRetire {
+		if err := core.ValidateJurisdiction(m.RetirementJurisdiction); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgRevealSealedBid.
+func (m *MsgRevealSealedBid) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Bidder)
+	return []sdk.AccAddress{addr}
+}
+
+// SealedBidCommitment returns the commitment of a hidden bid in a sealed-bid auction. The commitment is the
+// sha256 hash of the auction id, the bidder address, the quantity, the bid amount and a secret salt chosen
+// by the bidder.
+func SealedBidCommitment(auctionId uint64, bidder, quantity, bidAmount, salt string) []byte {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%d/%s/%s/%s/%s", auctionId, bidder, quantity, bidAmount, salt)))
+	return hash[:]
+}
@@ -0,0 +1,41 @@
+package marketplace
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgRevealSealedBidSuite struct {
+	t   gocuke.TestingT
+	msg *MsgRevealSealedBid
+	err error
+}
+
+func TestMsgRevealSealedBid(t *testing.T) {
+	gocuke.NewRunner(t, &msgRevealSealedBidSuite{}).Path("./features/msg_reveal_sealed_bid.feature").Run()
+}
+
+func (s *msgRevealSealedBidSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgRevealSealedBidSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgRevealSealedBid{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgRevealSealedBidSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgRevealSealedBidSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgRevealSealedBidSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
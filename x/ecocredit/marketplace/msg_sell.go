@@ -52,10 +52,26 @@ func (m *MsgSell) ValidateBasic() error {
 			return sdkerrors.ErrInvalidRequest.Wrapf("%s: quantity cannot be empty", orderIndex)
 		}
 
-		if _, err := math.NewPositiveDecFromString(order.Quantity); err != nil {
+		quantity, err := math.NewPositiveDecFromString(order.Quantity)
+		if err != nil {
 			return sdkerrors.ErrInvalidRequest.Wrapf("%s: quantity must be a positive decimal", orderIndex)
 		}
 
+		if len(order.MinFillQuantity) != 0 {
+			if order.AllOrNone {
+				return sdkerrors.ErrInvalidRequest.Wrapf("%s: min fill quantity cannot be set for all-or-none sell orders", orderIndex)
+			}
+
+			minFillQuantity, err := math.NewPositiveDecFromString(order.MinFillQuantity)
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("%s: min fill quantity must be a positive decimal", orderIndex)
+			}
+
+			if minFillQuantity.Cmp(quantity) == math.GreaterThan {
+				return sdkerrors.ErrInvalidRequest.Wrapf("%s: min fill quantity cannot be greater than quantity", orderIndex)
+			}
+		}
+
 		// sdk.Coin.Validate panics if coin is nil
 		if order.AskPrice == nil {
 			return sdkerrors.ErrInvalidRequest.Wrapf("%s: ask price: cannot be empty", orderIndex)
@@ -79,6 +95,33 @@ func (m *MsgSell) ValidateBasic() error {
 		if !order.AskPrice.Amount.IsPositive() {
 			return sdkerrors.ErrInvalidRequest.Wrapf("%s: ask price: amount must be a positive integer", orderIndex)
 		}
+
+		allowedBuyers := make(map[string]bool, len(order.AllowedBuyers))
+		for j, buyer := range order.AllowedBuyers {
+			if _, err := sdk.AccAddressFromBech32(buyer); err != nil {
+				return sdkerrors.ErrInvalidAddress.Wrapf("%s: allowed buyers[%d]: %s", orderIndex, j, err)
+			}
+
+			if buyer == m.Seller {
+				return sdkerrors.ErrInvalidRequest.Wrapf("%s: allowed buyers[%d]: seller cannot be an allowed buyer", orderIndex, j)
+			}
+
+			if allowedBuyers[buyer] {
+				return sdkerrors.ErrInvalidRequest.Wrapf("%s: allowed buyers[%d]: duplicate address %s", orderIndex, j, buyer)
+			}
+
+			allowedBuyers[buyer] = true
+		}
+
+		if len(order.BuyerGroupPolicy) != 0 {
+			if _, err := sdk.AccAddressFromBech32(order.BuyerGroupPolicy); err != nil {
+				return sdkerrors.ErrInvalidAddress.Wrapf("%s: buyer group policy: %s", orderIndex, err)
+			}
+
+			if order.BuyerGroupPolicy == m.Seller {
+				return sdkerrors.ErrInvalidRequest.Wrapf("%s: buyer group policy: seller cannot be the buyer group policy", orderIndex)
+			}
+		}
 	}
 
 	return nil
@@ -6,6 +6,7 @@ package marketplace
 import (
 	context "context"
 	fmt "fmt"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -126,6 +127,9 @@ func (m *QuerySellOrderResponse) GetSellOrder() *SellOrderInfo {
 type QuerySellOrdersRequest struct {
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// include_private includes private sell orders (sell orders restricted to
+	// an allowlist of buyers or a group policy) in the response.
+	IncludePrivate bool `protobuf:"varint,2,opt,name=include_private,json=includePrivate,proto3" json:"include_private,omitempty"`
 }
 
 func (m *QuerySellOrdersRequest) Reset()         { *m = QuerySellOrdersRequest{} }
@@ -168,6 +172,13 @@ func (m *QuerySellOrdersRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QuerySellOrdersRequest) GetIncludePrivate() bool {
+	if m != nil {
+		return m.IncludePrivate
+	}
+	return false
+}
+
 // QuerySellOrdersResponse is the Query/SellOrders response type.
 type QuerySellOrdersResponse struct {
 	// sell_orders is a list of sell orders.
@@ -230,6 +241,9 @@ type QuerySellOrdersByBatchRequest struct {
 	BatchDenom string `protobuf:"bytes,1,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// include_private includes private sell orders (sell orders restricted to
+	// an allowlist of buyers or a group policy) in the response.
+	IncludePrivate bool `protobuf:"varint,3,opt,name=include_private,json=includePrivate,proto3" json:"include_private,omitempty"`
 }
 
 func (m *QuerySellOrdersByBatchRequest) Reset()         { *m = QuerySellOrdersByBatchRequest{} }
@@ -279,6 +293,13 @@ func (m *QuerySellOrdersByBatchRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QuerySellOrdersByBatchRequest) GetIncludePrivate() bool {
+	if m != nil {
+		return m.IncludePrivate
+	}
+	return false
+}
+
 // QuerySellOrdersByBatchResponse is the Query/SellOrdersByBatch
 // response type.
 type QuerySellOrdersByBatchResponse struct {
@@ -342,6 +363,9 @@ type QuerySellOrdersBySellerRequest struct {
 	Seller string `protobuf:"bytes,1,opt,name=seller,proto3" json:"seller,omitempty"`
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// include_private includes private sell orders (sell orders restricted to
+	// an allowlist of buyers or a group policy) in the response.
+	IncludePrivate bool `protobuf:"varint,3,opt,name=include_private,json=includePrivate,proto3" json:"include_private,omitempty"`
 }
 
 func (m *QuerySellOrdersBySellerRequest) Reset()         { *m = QuerySellOrdersBySellerRequest{} }
@@ -391,6 +415,13 @@ func (m *QuerySellOrdersBySellerRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QuerySellOrdersBySellerRequest) GetIncludePrivate() bool {
+	if m != nil {
+		return m.IncludePrivate
+	}
+	return false
+}
+
 // QuerySellOrdersBySellerResponse is the Query/SellOrdersBySellerResponse
 // response type.
 type QuerySellOrdersBySellerResponse struct {
@@ -447,24 +478,31 @@ func (m *QuerySellOrdersBySellerResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-// QueryAllowedDenomsRequest is the Query/AllowedDenoms request type.
-type QueryAllowedDenomsRequest struct {
+// QuerySellOrdersPendingExpiryRequest is the Query/SellOrdersPendingExpiry
+// request type.
+type QuerySellOrdersPendingExpiryRequest struct {
+	// expires_before is an optional timestamp. When set, only sell orders that
+	// expire at or before the timestamp are included in the response.
+	ExpiresBefore *types.Timestamp `protobuf:"bytes,1,opt,name=expires_before,json=expiresBefore,proto3" json:"expires_before,omitempty"`
 	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// include_private includes private sell orders (sell orders restricted to
+	// an allowlist of buyers or a group policy) in the response.
+	IncludePrivate bool `protobuf:"varint,3,opt,name=include_private,json=includePrivate,proto3" json:"include_private,omitempty"`
 }
 
-func (m *QueryAllowedDenomsRequest) Reset()         { *m = QueryAllowedDenomsRequest{} }
-func (m *QueryAllowedDenomsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllowedDenomsRequest) ProtoMessage()    {}
-func (*QueryAllowedDenomsRequest) Descriptor() ([]byte, []int) {
+func (m *QuerySellOrdersPendingExpiryRequest) Reset()         { *m = QuerySellOrdersPendingExpiryRequest{} }
+func (m *QuerySellOrdersPendingExpiryRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySellOrdersPendingExpiryRequest) ProtoMessage()    {}
+func (*QuerySellOrdersPendingExpiryRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1f2df4a89dfae849, []int{8}
 }
-func (m *QueryAllowedDenomsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QuerySellOrdersPendingExpiryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllowedDenomsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySellOrdersPendingExpiryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllowedDenomsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySellOrdersPendingExpiryRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -474,46 +512,60 @@ func (m *QueryAllowedDenomsRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryAllowedDenomsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllowedDenomsRequest.Merge(m, src)
+func (m *QuerySellOrdersPendingExpiryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySellOrdersPendingExpiryRequest.Merge(m, src)
 }
-func (m *QueryAllowedDenomsRequest) XXX_Size() int {
+func (m *QuerySellOrdersPendingExpiryRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllowedDenomsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllowedDenomsRequest.DiscardUnknown(m)
+func (m *QuerySellOrdersPendingExpiryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySellOrdersPendingExpiryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllowedDenomsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QuerySellOrdersPendingExpiryRequest proto.InternalMessageInfo
 
-func (m *QueryAllowedDenomsRequest) GetPagination() *query.PageRequest {
+func (m *QuerySellOrdersPendingExpiryRequest) GetExpiresBefore() *types.Timestamp {
+	if m != nil {
+		return m.ExpiresBefore
+	}
+	return nil
+}
+
+func (m *QuerySellOrdersPendingExpiryRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryAllowedDenomsResponse is the Query/AllowedDenoms response type.
-type QueryAllowedDenomsResponse struct {
-	// allowed_denoms is a list of coin denoms allowed to use in the ask price of
-	// sell orders.
-	AllowedDenoms []*AllowedDenom `protobuf:"bytes,1,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+func (m *QuerySellOrdersPendingExpiryRequest) GetIncludePrivate() bool {
+	if m != nil {
+		return m.IncludePrivate
+	}
+	return false
+}
+
+// QuerySellOrdersPendingExpiryResponse is the Query/SellOrdersPendingExpiry
+// response type.
+type QuerySellOrdersPendingExpiryResponse struct {
+	// sell_orders is a list of sell orders sorted by expiration.
+	SellOrders []*SellOrderInfo `protobuf:"bytes,1,rep,name=sell_orders,json=sellOrders,proto3" json:"sell_orders,omitempty"`
 	// pagination defines an optional pagination for the response.
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllowedDenomsResponse) Reset()         { *m = QueryAllowedDenomsResponse{} }
-func (m *QueryAllowedDenomsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAllowedDenomsResponse) ProtoMessage()    {}
-func (*QueryAllowedDenomsResponse) Descriptor() ([]byte, []int) {
+func (m *QuerySellOrdersPendingExpiryResponse) Reset()         { *m = QuerySellOrdersPendingExpiryResponse{} }
+func (m *QuerySellOrdersPendingExpiryResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySellOrdersPendingExpiryResponse) ProtoMessage()    {}
+func (*QuerySellOrdersPendingExpiryResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1f2df4a89dfae849, []int{9}
 }
-func (m *QueryAllowedDenomsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QuerySellOrdersPendingExpiryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllowedDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySellOrdersPendingExpiryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllowedDenomsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySellOrdersPendingExpiryResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -523,69 +575,65 @@ func (m *QueryAllowedDenomsResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryAllowedDenomsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllowedDenomsResponse.Merge(m, src)
+func (m *QuerySellOrdersPendingExpiryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySellOrdersPendingExpiryResponse.Merge(m, src)
 }
-func (m *QueryAllowedDenomsResponse) XXX_Size() int {
+func (m *QuerySellOrdersPendingExpiryResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllowedDenomsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllowedDenomsResponse.DiscardUnknown(m)
+func (m *QuerySellOrdersPendingExpiryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySellOrdersPendingExpiryResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllowedDenomsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QuerySellOrdersPendingExpiryResponse proto.InternalMessageInfo
 
-func (m *QueryAllowedDenomsResponse) GetAllowedDenoms() []*AllowedDenom {
+func (m *QuerySellOrdersPendingExpiryResponse) GetSellOrders() []*SellOrderInfo {
 	if m != nil {
-		return m.AllowedDenoms
+		return m.SellOrders
 	}
 	return nil
 }
 
-func (m *QueryAllowedDenomsResponse) GetPagination() *query.PageResponse {
+func (m *QuerySellOrdersPendingExpiryResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// SellOrderInfo is the human-readable sell order information.
-type SellOrderInfo struct {
-	// id is the unique ID of sell order.
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// seller is the address of the account that is selling credits.
-	Seller string `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
-	// batch_denom is denom of the credit batch being sold.
-	BatchDenom string `protobuf:"bytes,3,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
-	// quantity is the decimal quantity of credits being sold.
-	Quantity string `protobuf:"bytes,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	// ask_denom is the denom used in the ask price of the sell order.
-	AskDenom string `protobuf:"bytes,5,opt,name=ask_denom,json=askDenom,proto3" json:"ask_denom,omitempty"`
-	// ask_amount is the amount that the seller is asking for each credit unit of
-	// the batch. Each credit unit of the batch will be sold for at least the
-	// ask_amount.
-	AskAmount string `protobuf:"bytes,6,opt,name=ask_amount,json=askAmount,proto3" json:"ask_amount,omitempty"`
-	// disable_auto_retire disables auto-retirement of credits which allows a
-	// buyer to disable auto-retirement in their buy order enabling them to
-	// resell the credits to another buyer.
-	DisableAutoRetire bool `protobuf:"varint,7,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
-	// expiration is an optional timestamp when the sell order expires. When the
-	// expiration time is reached, the sell order is removed from state.
-	Expiration *types.Timestamp `protobuf:"bytes,9,opt,name=expiration,proto3" json:"expiration,omitempty"`
+// QuerySellOrdersByClassRequest is the Query/SellOrdersByClass request type.
+type QuerySellOrdersByClassRequest struct {
+	// class_id is the unique identifier of the credit class.
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// include_private includes private sell orders (sell orders restricted to
+	// an allowlist of buyers or a group policy) in the response.
+	IncludePrivate bool `protobuf:"varint,3,opt,name=include_private,json=includePrivate,proto3" json:"include_private,omitempty"`
+	// project_jurisdiction is an optional jurisdiction prefix. When set, only
+	// sell orders for credits from projects with a jurisdiction starting with
+	// the prefix are included in the response (e.g. "US" or "US-WA").
+	ProjectJurisdiction string `protobuf:"bytes,4,opt,name=project_jurisdiction,json=projectJurisdiction,proto3" json:"project_jurisdiction,omitempty"`
+	// min_batch_start_date is an optional minimum start date (inclusive) of the
+	// credit batch of the credits being sold.
+	MinBatchStartDate *types.Timestamp `protobuf:"bytes,5,opt,name=min_batch_start_date,json=minBatchStartDate,proto3" json:"min_batch_start_date,omitempty"`
+	// max_batch_start_date is an optional maximum start date (inclusive) of the
+	// credit batch of the credits being sold.
+	MaxBatchStartDate *types.Timestamp `protobuf:"bytes,6,opt,name=max_batch_start_date,json=maxBatchStartDate,proto3" json:"max_batch_start_date,omitempty"`
 }
 
-func (m *SellOrderInfo) Reset()         { *m = SellOrderInfo{} }
-func (m *SellOrderInfo) String() string { return proto.CompactTextString(m) }
-func (*SellOrderInfo) ProtoMessage()    {}
-func (*SellOrderInfo) Descriptor() ([]byte, []int) {
+func (m *QuerySellOrdersByClassRequest) Reset()         { *m = QuerySellOrdersByClassRequest{} }
+func (m *QuerySellOrdersByClassRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySellOrdersByClassRequest) ProtoMessage()    {}
+func (*QuerySellOrdersByClassRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1f2df4a89dfae849, []int{10}
 }
-func (m *SellOrderInfo) XXX_Unmarshal(b []byte) error {
+func (m *QuerySellOrdersByClassRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SellOrderInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySellOrdersByClassRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SellOrderInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySellOrdersByClassRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -595,1140 +643,21603 @@ func (m *SellOrderInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *SellOrderInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SellOrderInfo.Merge(m, src)
+func (m *QuerySellOrdersByClassRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySellOrdersByClassRequest.Merge(m, src)
 }
-func (m *SellOrderInfo) XXX_Size() int {
+func (m *QuerySellOrdersByClassRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *SellOrderInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_SellOrderInfo.DiscardUnknown(m)
+func (m *QuerySellOrdersByClassRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySellOrdersByClassRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SellOrderInfo proto.InternalMessageInfo
+var xxx_messageInfo_QuerySellOrdersByClassRequest proto.InternalMessageInfo
 
-func (m *SellOrderInfo) GetId() uint64 {
+func (m *QuerySellOrdersByClassRequest) GetClassId() string {
 	if m != nil {
-		return m.Id
+		return m.ClassId
 	}
-	return 0
+	return ""
 }
 
-func (m *SellOrderInfo) GetSeller() string {
+func (m *QuerySellOrdersByClassRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.Seller
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-func (m *SellOrderInfo) GetBatchDenom() string {
+func (m *QuerySellOrdersByClassRequest) GetIncludePrivate() bool {
 	if m != nil {
-		return m.BatchDenom
+		return m.IncludePrivate
 	}
-	return ""
+	return false
 }
 
-func (m *SellOrderInfo) GetQuantity() string {
+func (m *QuerySellOrdersByClassRequest) GetProjectJurisdiction() string {
 	if m != nil {
-		return m.Quantity
+		return m.ProjectJurisdiction
 	}
 	return ""
 }
 
-func (m *SellOrderInfo) GetAskDenom() string {
+func (m *QuerySellOrdersByClassRequest) GetMinBatchStartDate() *types.Timestamp {
 	if m != nil {
-		return m.AskDenom
+		return m.MinBatchStartDate
 	}
-	return ""
+	return nil
 }
 
-func (m *SellOrderInfo) GetAskAmount() string {
+func (m *QuerySellOrdersByClassRequest) GetMaxBatchStartDate() *types.Timestamp {
 	if m != nil {
-		return m.AskAmount
+		return m.MaxBatchStartDate
 	}
-	return ""
+	return nil
 }
 
-func (m *SellOrderInfo) GetDisableAutoRetire() bool {
-	if m != nil {
-		return m.DisableAutoRetire
+// QuerySellOrdersByClassResponse is the Query/SellOrdersByClass response type.
+type QuerySellOrdersByClassResponse struct {
+	// sell_orders is a list of sell orders.
+	SellOrders []*SellOrderInfo `protobuf:"bytes,1,rep,name=sell_orders,json=sellOrders,proto3" json:"sell_orders,omitempty"`
+	// pagination defines an optional pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySellOrdersByClassResponse) Reset()         { *m = QuerySellOrdersByClassResponse{} }
+func (m *QuerySellOrdersByClassResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySellOrdersByClassResponse) ProtoMessage()    {}
+func (*QuerySellOrdersByClassResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{11}
+}
+func (m *QuerySellOrdersByClassResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySellOrdersByClassResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySellOrdersByClassResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return false
+}
+func (m *QuerySellOrdersByClassResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySellOrdersByClassResponse.Merge(m, src)
+}
+func (m *QuerySellOrdersByClassResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySellOrdersByClassResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySellOrdersByClassResponse.DiscardUnknown(m)
 }
 
-func (m *SellOrderInfo) GetExpiration() *types.Timestamp {
+var xxx_messageInfo_QuerySellOrdersByClassResponse proto.InternalMessageInfo
+
+func (m *QuerySellOrdersByClassResponse) GetSellOrders() []*SellOrderInfo {
 	if m != nil {
-		return m.Expiration
+		return m.SellOrders
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QuerySellOrderRequest)(nil), "regen.ecocredit.marketplace.v1.QuerySellOrderRequest")
-	proto.RegisterType((*QuerySellOrderResponse)(nil), "regen.ecocredit.marketplace.v1.QuerySellOrderResponse")
-	proto.RegisterType((*QuerySellOrdersRequest)(nil), "regen.ecocredit.marketplace.v1.QuerySellOrdersRequest")
-	proto.RegisterType((*QuerySellOrdersResponse)(nil), "regen.ecocredit.marketplace.v1.QuerySellOrdersResponse")
-	proto.RegisterType((*QuerySellOrdersByBatchRequest)(nil), "regen.ecocredit.marketplace.v1.QuerySellOrdersByBatchRequest")
-	proto.RegisterType((*QuerySellOrdersByBatchResponse)(nil), "regen.ecocredit.marketplace.v1.QuerySellOrdersByBatchResponse")
-	proto.RegisterType((*QuerySellOrdersBySellerRequest)(nil), "regen.ecocredit.marketplace.v1.QuerySellOrdersBySellerRequest")
-	proto.RegisterType((*QuerySellOrdersBySellerResponse)(nil), "regen.ecocredit.marketplace.v1.QuerySellOrdersBySellerResponse")
-	proto.RegisterType((*QueryAllowedDenomsRequest)(nil), "regen.ecocredit.marketplace.v1.QueryAllowedDenomsRequest")
-	proto.RegisterType((*QueryAllowedDenomsResponse)(nil), "regen.ecocredit.marketplace.v1.QueryAllowedDenomsResponse")
-	proto.RegisterType((*SellOrderInfo)(nil), "regen.ecocredit.marketplace.v1.SellOrderInfo")
+func (m *QuerySellOrdersByClassResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("regen/ecocredit/marketplace/v1/query.proto", fileDescriptor_1f2df4a89dfae849)
+// QuerySellOrdersByProjectRequest is the Query/SellOrdersByProject request
+// type.
+type QuerySellOrdersByProjectRequest struct {
+	// project_id is the unique identifier of the project.
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// include_private includes private sell orders (sell orders restricted to
+	// an allowlist of buyers or a group policy) in the response.
+	IncludePrivate bool `protobuf:"varint,3,opt,name=include_private,json=includePrivate,proto3" json:"include_private,omitempty"`
+	// min_batch_start_date is an optional minimum start date (inclusive) of the
+	// credit batch of the credits being sold.
+	MinBatchStartDate *types.Timestamp `protobuf:"bytes,4,opt,name=min_batch_start_date,json=minBatchStartDate,proto3" json:"min_batch_start_date,omitempty"`
+	// max_batch_start_date is an optional maximum start date (inclusive) of the
+	// credit batch of the credits being sold.
+	MaxBatchStartDate *types.Timestamp `protobuf:"bytes,5,opt,name=max_batch_start_date,json=maxBatchStartDate,proto3" json:"max_batch_start_date,omitempty"`
 }
 
-var fileDescriptor_1f2df4a89dfae849 = []byte{
-	// 875 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xcf, 0x8f, 0xdb, 0x44,
-	0x14, 0xde, 0x71, 0xdb, 0xa5, 0x79, 0xab, 0x54, 0xea, 0x20, 0x4a, 0x30, 0xd4, 0xbb, 0xca, 0x01,
-	0x2a, 0x20, 0x63, 0xd2, 0x8a, 0x22, 0xb6, 0xda, 0x56, 0x89, 0x10, 0xa8, 0x12, 0xe2, 0x87, 0x8b,
-	0x84, 0xb4, 0x97, 0x30, 0x8e, 0x67, 0x53, 0x2b, 0x8e, 0x27, 0xeb, 0x19, 0x6f, 0x1b, 0xad, 0x2a,
-	0x7e, 0x48, 0x08, 0x4e, 0x08, 0x89, 0x2b, 0x9c, 0xf8, 0x0f, 0x7a, 0xa3, 0x07, 0x4e, 0x1c, 0x38,
-	0x56, 0xe2, 0xc2, 0x11, 0xed, 0xf2, 0x77, 0x20, 0xe4, 0x99, 0x71, 0x12, 0x27, 0x69, 0x53, 0x47,
-	0x7b, 0xd8, 0x9b, 0x3d, 0xef, 0x7d, 0x33, 0xdf, 0xf7, 0xcd, 0xbc, 0xf7, 0xe0, 0xf5, 0x84, 0xf5,
-	0x58, 0xec, 0xb2, 0x2e, 0xef, 0x26, 0x2c, 0x08, 0xa5, 0x3b, 0xa0, 0x49, 0x9f, 0xc9, 0x61, 0x44,
-	0xbb, 0xcc, 0x3d, 0x68, 0xba, 0xfb, 0x29, 0x4b, 0x46, 0x64, 0x98, 0x70, 0xc9, 0xb1, 0xa3, 0x72,
-	0xc9, 0x38, 0x97, 0x4c, 0xe5, 0x92, 0x83, 0xa6, 0xfd, 0x4a, 0x8f, 0xf3, 0x5e, 0xc4, 0x5c, 0x3a,
-	0x0c, 0x5d, 0x1a, 0xc7, 0x5c, 0x52, 0x19, 0xf2, 0x58, 0x68, 0xb4, 0xbd, 0x69, 0xa2, 0xea, 0xcf,
-	0x4f, 0xf7, 0x5c, 0x19, 0x0e, 0x98, 0x90, 0x74, 0x30, 0x34, 0x09, 0xcb, 0xa8, 0x08, 0x49, 0x25,
-	0xcb, 0x73, 0xbb, 0x5c, 0x0c, 0xb8, 0x70, 0x7d, 0x2a, 0x98, 0xe6, 0xe8, 0x1e, 0x34, 0x7d, 0x26,
-	0x69, 0xd3, 0x1d, 0xd2, 0x5e, 0x18, 0xab, 0x93, 0x75, 0x6e, 0xfd, 0x06, 0xbc, 0xf0, 0x69, 0x96,
-	0x71, 0x87, 0x45, 0xd1, 0xc7, 0x49, 0xc0, 0x12, 0x8f, 0xed, 0xa7, 0x4c, 0x48, 0x5c, 0x87, 0xaa,
-	0x60, 0x51, 0xd4, 0xe1, 0xd9, 0x62, 0x27, 0x0c, 0x6a, 0x68, 0x0b, 0x5d, 0x39, 0xeb, 0x6d, 0x88,
-	0x3c, 0xf1, 0x76, 0x50, 0xdf, 0x83, 0x4b, 0xb3, 0x60, 0x31, 0xe4, 0xb1, 0x60, 0xf8, 0x43, 0x80,
-	0x09, 0x5a, 0x41, 0x37, 0xae, 0x36, 0xc8, 0xd3, 0x2d, 0x22, 0xe3, 0x6d, 0x6e, 0xc7, 0x7b, 0xdc,
-	0xab, 0x8c, 0x4f, 0xaa, 0x7f, 0x31, 0x7b, 0x8e, 0xc8, 0x59, 0xbe, 0x0f, 0x30, 0x91, 0x64, 0xce,
-	0x79, 0x95, 0x68, 0xfd, 0x24, 0xd3, 0x4f, 0xf4, 0x1d, 0x19, 0xfd, 0xe4, 0x13, 0xda, 0x63, 0x06,
-	0xeb, 0x4d, 0x21, 0xeb, 0x0f, 0x11, 0xbc, 0x38, 0x77, 0x84, 0xd1, 0xf2, 0x11, 0x6c, 0x4c, 0xb4,
-	0x88, 0x1a, 0xda, 0x3a, 0x53, 0x5e, 0x0c, 0x8c, 0xc5, 0x08, 0xfc, 0x41, 0x81, 0xb3, 0xa5, 0x38,
-	0xbf, 0xb6, 0x94, 0xb3, 0x26, 0x53, 0x20, 0xfd, 0x3d, 0x82, 0xcb, 0x33, 0xa4, 0xdb, 0xa3, 0x36,
-	0x95, 0xdd, 0xbb, 0xb9, 0x3d, 0x9b, 0xb0, 0xe1, 0x67, 0xff, 0x9d, 0x80, 0xc5, 0x7c, 0xa0, 0xfc,
-	0xa9, 0x78, 0xa0, 0x96, 0xde, 0xcb, 0x56, 0x66, 0xfc, 0xb3, 0x56, 0xf6, 0xef, 0x37, 0x04, 0xce,
-	0x93, 0xa8, 0x9c, 0x76, 0x1b, 0xbf, 0x5a, 0xc4, 0x3d, 0xfb, 0x9e, 0x14, 0xc3, 0x25, 0x58, 0x17,
-	0x6a, 0xc1, 0x58, 0x68, 0xfe, 0x4e, 0xcc, 0xbe, 0x47, 0x08, 0x36, 0x9f, 0x48, 0xe1, 0xb4, 0xfb,
-	0xd7, 0x85, 0x97, 0x14, 0xf7, 0x56, 0x14, 0xf1, 0x7b, 0x2c, 0x50, 0x0f, 0xeb, 0xc4, 0x0b, 0xf4,
-	0x11, 0x02, 0x7b, 0xd1, 0x29, 0xc6, 0x9c, 0x3b, 0x70, 0x81, 0xea, 0x80, 0x7e, 0xea, 0xb9, 0x3f,
-	0x6f, 0x2e, 0xf3, 0x67, 0x7a, 0x3b, 0xaf, 0x4a, 0xa7, 0x37, 0x3f, 0x39, 0x87, 0x7e, 0xb6, 0xa0,
-	0x5a, 0xb8, 0x08, 0x7c, 0x01, 0xac, 0x71, 0x4b, 0xb5, 0xc2, 0x60, 0xea, 0x81, 0x59, 0x85, 0x07,
-	0x36, 0x53, 0xc0, 0x67, 0xe6, 0x0a, 0xd8, 0x86, 0xf3, 0xfb, 0x29, 0x8d, 0x65, 0x28, 0x47, 0xb5,
-	0xb3, 0x2a, 0x3a, 0xfe, 0xc7, 0x2f, 0x43, 0x85, 0x8a, 0xbe, 0x81, 0x9e, 0xd3, 0x41, 0x2a, 0xfa,
-	0x1a, 0x78, 0x19, 0x20, 0x0b, 0xd2, 0x01, 0x4f, 0x63, 0x59, 0x5b, 0x57, 0xd1, 0x2c, 0xbd, 0xa5,
-	0x16, 0x30, 0x81, 0xe7, 0x83, 0x50, 0x50, 0x3f, 0x62, 0x1d, 0x9a, 0x4a, 0xde, 0x49, 0x98, 0x0c,
-	0x13, 0x56, 0x7b, 0x6e, 0x0b, 0x5d, 0x39, 0xef, 0x5d, 0x34, 0xa1, 0x56, 0x2a, 0xb9, 0xa7, 0x02,
-	0x78, 0x1b, 0x80, 0xdd, 0x1f, 0x86, 0x89, 0xf6, 0xaa, 0xa2, 0xbc, 0xb2, 0x89, 0x9e, 0x6a, 0x24,
-	0x9f, 0x6a, 0xe4, 0xb3, 0x7c, 0xaa, 0x79, 0x53, 0xd9, 0x57, 0xff, 0xa8, 0xc0, 0x39, 0x75, 0xb7,
-	0xf8, 0x3f, 0x04, 0x95, 0xb1, 0x51, 0xf8, 0xed, 0x65, 0x97, 0xb7, 0x70, 0x72, 0xd9, 0xd7, 0xcb,
-	0xc2, 0xf4, 0x8d, 0xd5, 0xbf, 0x46, 0xdf, 0xfc, 0xf5, 0xef, 0x4f, 0xd6, 0x21, 0xde, 0x76, 0x97,
-	0x0d, 0x5b, 0x16, 0x45, 0x0d, 0x55, 0x87, 0xee, 0x61, 0x61, 0x48, 0x3e, 0xd8, 0xdd, 0xc1, 0x37,
-	0x9e, 0x1d, 0x2d, 0x66, 0xe1, 0xf8, 0x21, 0x02, 0x98, 0xf4, 0x00, 0x5c, 0x52, 0x4a, 0x5e, 0x75,
-	0xf6, 0x3b, 0xa5, 0x71, 0xc6, 0x83, 0x6b, 0xca, 0x82, 0x06, 0x7e, 0xa3, 0x84, 0x08, 0xfc, 0xab,
-	0x05, 0x17, 0xe7, 0xfa, 0x3e, 0xde, 0x29, 0xc9, 0xa1, 0x38, 0xba, 0xec, 0x9b, 0xab, 0xc2, 0x8d,
-	0x92, 0x1f, 0xf4, 0x6d, 0x7e, 0x87, 0x70, 0xbb, 0x84, 0x96, 0x86, 0x3f, 0x6a, 0xa8, 0xea, 0x72,
-	0x0f, 0xa7, 0xea, 0xee, 0xc1, 0x6e, 0x0b, 0xdf, 0x2a, 0x73, 0xad, 0x0b, 0xb6, 0xc0, 0xbf, 0x58,
-	0x80, 0xe7, 0xdb, 0x3b, 0x2e, 0xaf, 0xb3, 0x30, 0x9a, 0xec, 0x5b, 0x2b, 0xe3, 0x8d, 0x51, 0xdf,
-	0x6a, 0xa3, 0xbe, 0xc4, 0x37, 0x4b, 0xfa, 0xa4, 0x5b, 0x94, 0x7e, 0xc2, 0x2c, 0x29, 0xfd, 0xf4,
-	0x67, 0xe0, 0xf8, 0x77, 0x04, 0xd5, 0x42, 0x73, 0xc7, 0xef, 0x3e, 0x93, 0xb4, 0x45, 0x63, 0xc7,
-	0xde, 0x5e, 0x05, 0x6a, 0x0c, 0xb9, 0xae, 0xfc, 0x78, 0x0b, 0x93, 0x65, 0x6a, 0xcc, 0xb4, 0x68,
-	0xe8, 0x89, 0xd3, 0xfe, 0xfc, 0xcf, 0x23, 0x07, 0x3d, 0x3e, 0x72, 0xd0, 0x3f, 0x47, 0x0e, 0xfa,
-	0xf1, 0xd8, 0x59, 0x7b, 0x7c, 0xec, 0xac, 0xfd, 0x7d, 0xec, 0xac, 0xed, 0xee, 0xf4, 0x42, 0x79,
-	0x37, 0xf5, 0x49, 0x97, 0x0f, 0xf4, 0x9e, 0x8d, 0x98, 0xc9, 0x7b, 0x3c, 0xe9, 0x9b, 0xbf, 0x88,
-	0x05, 0x3d, 0x96, 0xb8, 0xf7, 0x17, 0x1f, 0xe5, 0xaf, 0xab, 0xfe, 0x79, 0xed, 0xff, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x5b, 0xf1, 0x59, 0xc6, 0x8f, 0x0c, 0x00, 0x00,
+func (m *QuerySellOrdersByProjectRequest) Reset()         { *m = QuerySellOrdersByProjectRequest{} }
+func (m *QuerySellOrdersByProjectRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySellOrdersByProjectRequest) ProtoMessage()    {}
+func (*QuerySellOrdersByProjectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{12}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// SellOrder queries a sell order by its unique identifier.
-	SellOrder(ctx context.Context, in *QuerySellOrderRequest, opts ...grpc.CallOption) (*QuerySellOrderResponse, error)
-	// SellOrders queries a paginated list of all sell orders.
-	SellOrders(ctx context.Context, in *QuerySellOrdersRequest, opts ...grpc.CallOption) (*QuerySellOrdersResponse, error)
-	// SellOrdersByBatch queries a paginated list of all sell orders based on
-	// the batch denom of the credits being sold.
-	SellOrdersByBatch(ctx context.Context, in *QuerySellOrdersByBatchRequest, opts ...grpc.CallOption) (*QuerySellOrdersByBatchResponse, error)
-	// SellOrdersBySeller queries a paginated list of all sell orders based on the
-	// account address of the seller.
-	SellOrdersBySeller(ctx context.Context, in *QuerySellOrdersBySellerRequest, opts ...grpc.CallOption) (*QuerySellOrdersBySellerResponse, error)
-	// AllowedDenoms queries a paginated list of all bank denoms allowed to be
-	// used in the marketplace.
-	AllowedDenoms(ctx context.Context, in *QueryAllowedDenomsRequest, opts ...grpc.CallOption) (*QueryAllowedDenomsResponse, error)
+func (m *QuerySellOrdersByProjectRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QuerySellOrdersByProjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySellOrdersByProjectRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySellOrdersByProjectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySellOrdersByProjectRequest.Merge(m, src)
+}
+func (m *QuerySellOrdersByProjectRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySellOrdersByProjectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySellOrdersByProjectRequest.DiscardUnknown(m)
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+var xxx_messageInfo_QuerySellOrdersByProjectRequest proto.InternalMessageInfo
+
+func (m *QuerySellOrdersByProjectRequest) GetProjectId() string {
+	if m != nil {
+		return m.ProjectId
+	}
+	return ""
 }
 
-func (c *queryClient) SellOrder(ctx context.Context, in *QuerySellOrderRequest, opts ...grpc.CallOption) (*QuerySellOrderResponse, error) {
-	out := new(QuerySellOrderResponse)
-	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/SellOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySellOrdersByProjectRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) SellOrders(ctx context.Context, in *QuerySellOrdersRequest, opts ...grpc.CallOption) (*QuerySellOrdersResponse, error) {
-	out := new(QuerySellOrdersResponse)
-	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/SellOrders", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySellOrdersByProjectRequest) GetIncludePrivate() bool {
+	if m != nil {
+		return m.IncludePrivate
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) SellOrdersByBatch(ctx context.Context, in *QuerySellOrdersByBatchRequest, opts ...grpc.CallOption) (*QuerySellOrdersByBatchResponse, error) {
-	out := new(QuerySellOrdersByBatchResponse)
-	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/SellOrdersByBatch", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySellOrdersByProjectRequest) GetMinBatchStartDate() *types.Timestamp {
+	if m != nil {
+		return m.MinBatchStartDate
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) SellOrdersBySeller(ctx context.Context, in *QuerySellOrdersBySellerRequest, opts ...grpc.CallOption) (*QuerySellOrdersBySellerResponse, error) {
-	out := new(QuerySellOrdersBySellerResponse)
-	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/SellOrdersBySeller", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *queryClient) AllowedDenoms(ctx context.Context, in *QueryAllowedDenomsRequest, opts ...grpc.CallOption) (*QueryAllowedDenomsResponse, error) {
-	out := new(QueryAllowedDenomsResponse)
-	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/AllowedDenoms", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySellOrdersByProjectRequest) GetMaxBatchStartDate() *types.Timestamp {
+	if m != nil {
+		return m.MaxBatchStartDate
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// SellOrder queries a sell order by its unique identifier.
-	SellOrder(context.Context, *QuerySellOrderRequest) (*QuerySellOrderResponse, error)
-	// SellOrders queries a paginated list of all sell orders.
-	SellOrders(context.Context, *QuerySellOrdersRequest) (*QuerySellOrdersResponse, error)
-	// SellOrdersByBatch queries a paginated list of all sell orders based on
-	// the batch denom of the credits being sold.
-	SellOrdersByBatch(context.Context, *QuerySellOrdersByBatchRequest) (*QuerySellOrdersByBatchResponse, error)
-	// SellOrdersBySeller queries a paginated list of all sell orders based on the
-	// account address of the seller.
-	SellOrdersBySeller(context.Context, *QuerySellOrdersBySellerRequest) (*QuerySellOrdersBySellerResponse, error)
-	// AllowedDenoms queries a paginated list of all bank denoms allowed to be
-	// used in the marketplace.
-	AllowedDenoms(context.Context, *QueryAllowedDenomsRequest) (*QueryAllowedDenomsResponse, error)
+// QuerySellOrdersByProjectResponse is the Query/SellOrdersByProject response
+// type.
+type QuerySellOrdersByProjectResponse struct {
+	// sell_orders is a list of sell orders.
+	SellOrders []*SellOrderInfo `protobuf:"bytes,1,rep,name=sell_orders,json=sellOrders,proto3" json:"sell_orders,omitempty"`
+	// pagination defines an optional pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QuerySellOrdersByProjectResponse) Reset()         { *m = QuerySellOrdersByProjectResponse{} }
+func (m *QuerySellOrdersByProjectResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySellOrdersByProjectResponse) ProtoMessage()    {}
+func (*QuerySellOrdersByProjectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{13}
 }
-
-func (*UnimplementedQueryServer) SellOrder(ctx context.Context, req *QuerySellOrderRequest) (*QuerySellOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SellOrder not implemented")
+func (m *QuerySellOrdersByProjectResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) SellOrders(ctx context.Context, req *QuerySellOrdersRequest) (*QuerySellOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SellOrders not implemented")
+func (m *QuerySellOrdersByProjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySellOrdersByProjectResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) SellOrdersByBatch(ctx context.Context, req *QuerySellOrdersByBatchRequest) (*QuerySellOrdersByBatchResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SellOrdersByBatch not implemented")
+func (m *QuerySellOrdersByProjectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySellOrdersByProjectResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) SellOrdersBySeller(ctx context.Context, req *QuerySellOrdersBySellerRequest) (*QuerySellOrdersBySellerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SellOrdersBySeller not implemented")
+func (m *QuerySellOrdersByProjectResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) AllowedDenoms(ctx context.Context, req *QueryAllowedDenomsRequest) (*QueryAllowedDenomsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllowedDenoms not implemented")
+func (m *QuerySellOrdersByProjectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySellOrdersByProjectResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QuerySellOrdersByProjectResponse proto.InternalMessageInfo
 
-func _Query_SellOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySellOrderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SellOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/regen.ecocredit.marketplace.v1.Query/SellOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SellOrder(ctx, req.(*QuerySellOrderRequest))
+func (m *QuerySellOrdersByProjectResponse) GetSellOrders() []*SellOrderInfo {
+	if m != nil {
+		return m.SellOrders
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_SellOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySellOrdersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SellOrders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/regen.ecocredit.marketplace.v1.Query/SellOrders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SellOrders(ctx, req.(*QuerySellOrdersRequest))
+func (m *QuerySellOrdersByProjectResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_SellOrdersByBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySellOrdersByBatchRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SellOrdersByBatch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/regen.ecocredit.marketplace.v1.Query/SellOrdersByBatch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SellOrdersByBatch(ctx, req.(*QuerySellOrdersByBatchRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QuerySellOrdersByMarketRequest is the Query/SellOrdersByMarket request type.
+type QuerySellOrdersByMarketRequest struct {
+	// market_id is the unique ID of the market.
+	MarketId uint64 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// include_private includes private sell orders (sell orders restricted to
+	// an allowlist of buyers or a group policy) in the response.
+	IncludePrivate bool `protobuf:"varint,3,opt,name=include_private,json=includePrivate,proto3" json:"include_private,omitempty"`
 }
 
-func _Query_SellOrdersBySeller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySellOrdersBySellerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SellOrdersBySeller(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/regen.ecocredit.marketplace.v1.Query/SellOrdersBySeller",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SellOrdersBySeller(ctx, req.(*QuerySellOrdersBySellerRequest))
+func (m *QuerySellOrdersByMarketRequest) Reset()         { *m = QuerySellOrdersByMarketRequest{} }
+func (m *QuerySellOrdersByMarketRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySellOrdersByMarketRequest) ProtoMessage()    {}
+func (*QuerySellOrdersByMarketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{14}
+}
+func (m *QuerySellOrdersByMarketRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySellOrdersByMarketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySellOrdersByMarketRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QuerySellOrdersByMarketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySellOrdersByMarketRequest.Merge(m, src)
+}
+func (m *QuerySellOrdersByMarketRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySellOrdersByMarketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySellOrdersByMarketRequest.DiscardUnknown(m)
 }
 
-func _Query_AllowedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllowedDenomsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllowedDenoms(ctx, in)
+var xxx_messageInfo_QuerySellOrdersByMarketRequest proto.InternalMessageInfo
+
+func (m *QuerySellOrdersByMarketRequest) GetMarketId() uint64 {
+	if m != nil {
+		return m.MarketId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/regen.ecocredit.marketplace.v1.Query/AllowedDenoms",
+	return 0
+}
+
+func (m *QuerySellOrdersByMarketRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllowedDenoms(ctx, req.(*QueryAllowedDenomsRequest))
+	return nil
+}
+
+func (m *QuerySellOrdersByMarketRequest) GetIncludePrivate() bool {
+	if m != nil {
+		return m.IncludePrivate
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "regen.ecocredit.marketplace.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SellOrder",
-			Handler:    _Query_SellOrder_Handler,
-		},
-		{
-			MethodName: "SellOrders",
-			Handler:    _Query_SellOrders_Handler,
-		},
-		{
-			MethodName: "SellOrdersByBatch",
-			Handler:    _Query_SellOrdersByBatch_Handler,
-		},
-		{
-			MethodName: "SellOrdersBySeller",
-			Handler:    _Query_SellOrdersBySeller_Handler,
-		},
-		{
-			MethodName: "AllowedDenoms",
-			Handler:    _Query_AllowedDenoms_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "regen/ecocredit/marketplace/v1/query.proto",
+// QuerySellOrdersByMarketResponse is the Query/SellOrdersByMarket response
+// type.
+type QuerySellOrdersByMarketResponse struct {
+	// sell_orders is a list of sell orders sorted by ask price.
+	SellOrders []*SellOrderInfo `protobuf:"bytes,1,rep,name=sell_orders,json=sellOrders,proto3" json:"sell_orders,omitempty"`
+	// pagination defines an optional pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QuerySellOrderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySellOrdersByMarketResponse) Reset()         { *m = QuerySellOrdersByMarketResponse{} }
+func (m *QuerySellOrdersByMarketResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySellOrdersByMarketResponse) ProtoMessage()    {}
+func (*QuerySellOrdersByMarketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{15}
+}
+func (m *QuerySellOrdersByMarketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySellOrdersByMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySellOrdersByMarketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QuerySellOrderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySellOrdersByMarketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySellOrdersByMarketResponse.Merge(m, src)
+}
+func (m *QuerySellOrdersByMarketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySellOrdersByMarketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySellOrdersByMarketResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySellOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SellOrderId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SellOrderId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QuerySellOrdersByMarketResponse proto.InternalMessageInfo
+
+func (m *QuerySellOrdersByMarketResponse) GetSellOrders() []*SellOrderInfo {
+	if m != nil {
+		return m.SellOrders
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QuerySellOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySellOrdersByMarketResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QuerySellOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBuyOrderRequest is the Query/BuyOrder request type.
+type QueryBuyOrderRequest struct {
+	// buy_order_id is the id of the requested buy order.
+	BuyOrderId uint64 `protobuf:"varint,1,opt,name=buy_order_id,json=buyOrderId,proto3" json:"buy_order_id,omitempty"`
 }
 
-func (m *QuerySellOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SellOrder != nil {
-		{
-			size, err := m.SellOrder.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryBuyOrderRequest) Reset()         { *m = QueryBuyOrderRequest{} }
+func (m *QueryBuyOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBuyOrderRequest) ProtoMessage()    {}
+func (*QueryBuyOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{16}
+}
+func (m *QueryBuyOrderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBuyOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBuyOrderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryBuyOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBuyOrderRequest.Merge(m, src)
+}
+func (m *QueryBuyOrderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBuyOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBuyOrderRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySellOrdersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBuyOrderRequest proto.InternalMessageInfo
+
+func (m *QueryBuyOrderRequest) GetBuyOrderId() uint64 {
+	if m != nil {
+		return m.BuyOrderId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QuerySellOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBuyOrderResponse is the Query/BuyOrder response type.
+type QueryBuyOrderResponse struct {
+	// buy_order contains all information related to a buy order.
+	BuyOrder *BuyOrderInfo `protobuf:"bytes,1,opt,name=buy_order,json=buyOrder,proto3" json:"buy_order,omitempty"`
 }
 
-func (m *QuerySellOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryBuyOrderResponse) Reset()         { *m = QueryBuyOrderResponse{} }
+func (m *QueryBuyOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBuyOrderResponse) ProtoMessage()    {}
+func (*QueryBuyOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{17}
+}
+func (m *QueryBuyOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBuyOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBuyOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryBuyOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBuyOrderResponse.Merge(m, src)
+}
+func (m *QueryBuyOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBuyOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBuyOrderResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySellOrdersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBuyOrderResponse proto.InternalMessageInfo
+
+func (m *QueryBuyOrderResponse) GetBuyOrder() *BuyOrderInfo {
+	if m != nil {
+		return m.BuyOrder
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QuerySellOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBuyOrdersRequest is the Query/BuyOrders request type.
+type QueryBuyOrdersRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QuerySellOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SellOrders) > 0 {
-		for iNdEx := len(m.SellOrders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SellOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryBuyOrdersRequest) Reset()         { *m = QueryBuyOrdersRequest{} }
+func (m *QueryBuyOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBuyOrdersRequest) ProtoMessage()    {}
+func (*QueryBuyOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{18}
+}
+func (m *QueryBuyOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBuyOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBuyOrdersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryBuyOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBuyOrdersRequest.Merge(m, src)
+}
+func (m *QueryBuyOrdersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBuyOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBuyOrdersRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySellOrdersByBatchRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBuyOrdersRequest proto.InternalMessageInfo
+
+func (m *QueryBuyOrdersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QuerySellOrdersByBatchRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBuyOrdersResponse is the Query/BuyOrders response type.
+type QueryBuyOrdersResponse struct {
+	// buy_orders is a list of buy orders.
+	BuyOrders []*BuyOrderInfo `protobuf:"bytes,1,rep,name=buy_orders,json=buyOrders,proto3" json:"buy_orders,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QuerySellOrdersByBatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryBuyOrdersResponse) Reset()         { *m = QueryBuyOrdersResponse{} }
+func (m *QueryBuyOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBuyOrdersResponse) ProtoMessage()    {}
+func (*QueryBuyOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{19}
+}
+func (m *QueryBuyOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBuyOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBuyOrdersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.BatchDenom) > 0 {
-		i -= len(m.BatchDenom)
-		copy(dAtA[i:], m.BatchDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BatchDenom)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryBuyOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBuyOrdersResponse.Merge(m, src)
+}
+func (m *QueryBuyOrdersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBuyOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBuyOrdersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBuyOrdersResponse proto.InternalMessageInfo
+
+func (m *QueryBuyOrdersResponse) GetBuyOrders() []*BuyOrderInfo {
+	if m != nil {
+		return m.BuyOrders
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QuerySellOrdersByBatchResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryBuyOrdersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QuerySellOrdersByBatchResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBuyOrdersByBuyerRequest is the Query/BuyOrdersByBuyer request type.
+type QueryBuyOrdersByBuyerRequest struct {
+	// buyer is the address of the account that is buying credits.
+	Buyer string `protobuf:"bytes,1,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QuerySellOrdersByBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SellOrders) > 0 {
-		for iNdEx := len(m.SellOrders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SellOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryBuyOrdersByBuyerRequest) Reset()         { *m = QueryBuyOrdersByBuyerRequest{} }
+func (m *QueryBuyOrdersByBuyerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBuyOrdersByBuyerRequest) ProtoMessage()    {}
+func (*QueryBuyOrdersByBuyerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{20}
+}
+func (m *QueryBuyOrdersByBuyerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBuyOrdersByBuyerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBuyOrdersByBuyerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryBuyOrdersByBuyerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBuyOrdersByBuyerRequest.Merge(m, src)
+}
+func (m *QueryBuyOrdersByBuyerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBuyOrdersByBuyerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBuyOrdersByBuyerRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySellOrdersBySellerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBuyOrdersByBuyerRequest proto.InternalMessageInfo
+
+func (m *QueryBuyOrdersByBuyerRequest) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QuerySellOrdersBySellerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBuyOrdersByBuyerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QuerySellOrdersBySellerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+// QueryBuyOrdersByBuyerResponse is the Query/BuyOrdersByBuyer response type.
+type QueryBuyOrdersByBuyerResponse struct {
+	// buy_orders is a list of buy orders.
+	BuyOrders []*BuyOrderInfo `protobuf:"bytes,1,rep,name=buy_orders,json=buyOrders,proto3" json:"buy_orders,omitempty"`
+	// pagination defines an optional pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBuyOrdersByBuyerResponse) Reset()         { *m = QueryBuyOrdersByBuyerResponse{} }
+func (m *QueryBuyOrdersByBuyerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBuyOrdersByBuyerResponse) ProtoMessage()    {}
+func (*QueryBuyOrdersByBuyerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{21}
+}
+func (m *QueryBuyOrdersByBuyerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBuyOrdersByBuyerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBuyOrdersByBuyerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Seller) > 0 {
-		i -= len(m.Seller)
-		copy(dAtA[i:], m.Seller)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Seller)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryBuyOrdersByBuyerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBuyOrdersByBuyerResponse.Merge(m, src)
+}
+func (m *QueryBuyOrdersByBuyerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBuyOrdersByBuyerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBuyOrdersByBuyerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBuyOrdersByBuyerResponse proto.InternalMessageInfo
+
+func (m *QueryBuyOrdersByBuyerResponse) GetBuyOrders() []*BuyOrderInfo {
+	if m != nil {
+		return m.BuyOrders
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QuerySellOrdersBySellerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryBuyOrdersByBuyerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QuerySellOrdersBySellerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryOfferRequest is the Query/Offer request type.
+type QueryOfferRequest struct {
+	// offer_id is the id of the requested offer.
+	OfferId uint64 `protobuf:"varint,1,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
 }
 
-func (m *QuerySellOrdersBySellerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SellOrders) > 0 {
-		for iNdEx := len(m.SellOrders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SellOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryOfferRequest) Reset()         { *m = QueryOfferRequest{} }
+func (m *QueryOfferRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOfferRequest) ProtoMessage()    {}
+func (*QueryOfferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{22}
+}
+func (m *QueryOfferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOfferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOfferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryOfferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOfferRequest.Merge(m, src)
+}
+func (m *QueryOfferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOfferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOfferRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllowedDenomsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOfferRequest proto.InternalMessageInfo
+
+func (m *QueryOfferRequest) GetOfferId() uint64 {
+	if m != nil {
+		return m.OfferId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAllowedDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryOfferResponse is the Query/Offer response type.
+type QueryOfferResponse struct {
+	// offer contains all information related to an offer.
+	Offer *OfferInfo `protobuf:"bytes,1,opt,name=offer,proto3" json:"offer,omitempty"`
 }
 
-func (m *QueryAllowedDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryOfferResponse) Reset()         { *m = QueryOfferResponse{} }
+func (m *QueryOfferResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOfferResponse) ProtoMessage()    {}
+func (*QueryOfferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{23}
+}
+func (m *QueryOfferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOfferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOfferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryOfferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOfferResponse.Merge(m, src)
+}
+func (m *QueryOfferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOfferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOfferResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllowedDenomsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOfferResponse proto.InternalMessageInfo
+
+func (m *QueryOfferResponse) GetOffer() *OfferInfo {
+	if m != nil {
+		return m.Offer
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllowedDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryOffersByBatchRequest is the Query/OffersByBatch request type.
+type QueryOffersByBatchRequest struct {
+	// batch_denom is the denom of the credit batch being bought.
+	BatchDenom string `protobuf:"bytes,1,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllowedDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryOffersByBatchRequest) Reset()         { *m = QueryOffersByBatchRequest{} }
+func (m *QueryOffersByBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOffersByBatchRequest) ProtoMessage()    {}
+func (*QueryOffersByBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{24}
+}
+func (m *QueryOffersByBatchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOffersByBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOffersByBatchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.AllowedDenoms) > 0 {
-		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AllowedDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryOffersByBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOffersByBatchRequest.Merge(m, src)
+}
+func (m *QueryOffersByBatchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOffersByBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOffersByBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOffersByBatchRequest proto.InternalMessageInfo
+
+func (m *QueryOffersByBatchRequest) GetBatchDenom() string {
+	if m != nil {
+		return m.BatchDenom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *SellOrderInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryOffersByBatchRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SellOrderInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryOffersByBatchResponse is the Query/OffersByBatch response type.
+type QueryOffersByBatchResponse struct {
+	// offers is a list of offers.
+	Offers []*OfferInfo `protobuf:"bytes,1,rep,name=offers,proto3" json:"offers,omitempty"`
+	// pagination defines an optional pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *SellOrderInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Expiration != nil {
-		{
-			size, err := m.Expiration.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryOffersByBatchResponse) Reset()         { *m = QueryOffersByBatchResponse{} }
+func (m *QueryOffersByBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOffersByBatchResponse) ProtoMessage()    {}
+func (*QueryOffersByBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{25}
+}
+func (m *QueryOffersByBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOffersByBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOffersByBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.DisableAutoRetire {
-		i--
-		if m.DisableAutoRetire {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.AskAmount) > 0 {
-		i -= len(m.AskAmount)
-		copy(dAtA[i:], m.AskAmount)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AskAmount)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.AskDenom) > 0 {
-		i -= len(m.AskDenom)
-		copy(dAtA[i:], m.AskDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AskDenom)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Quantity) > 0 {
-		i -= len(m.Quantity)
-		copy(dAtA[i:], m.Quantity)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Quantity)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.BatchDenom) > 0 {
-		i -= len(m.BatchDenom)
-		copy(dAtA[i:], m.BatchDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BatchDenom)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Seller) > 0 {
-		i -= len(m.Seller)
-		copy(dAtA[i:], m.Seller)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Seller)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryOffersByBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOffersByBatchResponse.Merge(m, src)
 }
-func (m *QuerySellOrderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SellOrderId != 0 {
-		n += 1 + sovQuery(uint64(m.SellOrderId))
-	}
-	return n
+func (m *QueryOffersByBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOffersByBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOffersByBatchResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySellOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SellOrder != nil {
-		l = m.SellOrder.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryOffersByBatchResponse proto.InternalMessageInfo
+
+func (m *QueryOffersByBatchResponse) GetOffers() []*OfferInfo {
+	if m != nil {
+		return m.Offers
 	}
-	return n
+	return nil
 }
 
-func (m *QuerySellOrdersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryOffersByBatchResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QuerySellOrdersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.SellOrders) > 0 {
-		for _, e := range m.SellOrders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+// QueryOffersByBuyerRequest is the Query/OffersByBuyer request type.
+type QueryOffersByBuyerRequest struct {
+	// buyer is the address of the account that made the offers.
+	Buyer string `protobuf:"bytes,1,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOffersByBuyerRequest) Reset()         { *m = QueryOffersByBuyerRequest{} }
+func (m *QueryOffersByBuyerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOffersByBuyerRequest) ProtoMessage()    {}
+func (*QueryOffersByBuyerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{26}
+}
+func (m *QueryOffersByBuyerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOffersByBuyerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOffersByBuyerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryOffersByBuyerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOffersByBuyerRequest.Merge(m, src)
+}
+func (m *QueryOffersByBuyerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOffersByBuyerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOffersByBuyerRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySellOrdersByBatchRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BatchDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryOffersByBuyerRequest proto.InternalMessageInfo
+
+func (m *QueryOffersByBuyerRequest) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
 	}
-	return n
+	return ""
 }
 
-func (m *QuerySellOrdersByBatchResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryOffersByBuyerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if len(m.SellOrders) > 0 {
-		for _, e := range m.SellOrders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryOffersByBuyerResponse is the Query/OffersByBuyer response type.
+type QueryOffersByBuyerResponse struct {
+	// offers is a list of offers.
+	Offers []*OfferInfo `protobuf:"bytes,1,rep,name=offers,proto3" json:"offers,omitempty"`
+	// pagination defines an optional pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOffersByBuyerResponse) Reset()         { *m = QueryOffersByBuyerResponse{} }
+func (m *QueryOffersByBuyerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOffersByBuyerResponse) ProtoMessage()    {}
+func (*QueryOffersByBuyerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{27}
+}
+func (m *QueryOffersByBuyerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOffersByBuyerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOffersByBuyerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryOffersByBuyerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOffersByBuyerResponse.Merge(m, src)
+}
+func (m *QueryOffersByBuyerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOffersByBuyerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOffersByBuyerResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySellOrdersBySellerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Seller)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryOffersByBuyerResponse proto.InternalMessageInfo
+
+func (m *QueryOffersByBuyerResponse) GetOffers() []*OfferInfo {
+	if m != nil {
+		return m.Offers
 	}
-	return n
+	return nil
 }
 
-func (m *QuerySellOrdersBySellerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryOffersByBuyerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if len(m.SellOrders) > 0 {
-		for _, e := range m.SellOrders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryOffersByHolderRequest is the Query/OffersByHolder request type.
+type QueryOffersByHolderRequest struct {
+	// holder is the address of the credit holder the offers are made to.
+	Holder string `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOffersByHolderRequest) Reset()         { *m = QueryOffersByHolderRequest{} }
+func (m *QueryOffersByHolderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOffersByHolderRequest) ProtoMessage()    {}
+func (*QueryOffersByHolderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{28}
+}
+func (m *QueryOffersByHolderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOffersByHolderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOffersByHolderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryOffersByHolderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOffersByHolderRequest.Merge(m, src)
+}
+func (m *QueryOffersByHolderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOffersByHolderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOffersByHolderRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllowedDenomsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryOffersByHolderRequest proto.InternalMessageInfo
+
+func (m *QueryOffersByHolderRequest) GetHolder() string {
+	if m != nil {
+		return m.Holder
 	}
-	return n
+	return ""
 }
 
-func (m *QueryAllowedDenomsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryOffersByHolderRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if len(m.AllowedDenoms) > 0 {
-		for _, e := range m.AllowedDenoms {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryOffersByHolderResponse is the Query/OffersByHolder response type.
+type QueryOffersByHolderResponse struct {
+	// offers is a list of offers.
+	Offers []*OfferInfo `protobuf:"bytes,1,rep,name=offers,proto3" json:"offers,omitempty"`
+	// pagination defines an optional pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOffersByHolderResponse) Reset()         { *m = QueryOffersByHolderResponse{} }
+func (m *QueryOffersByHolderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOffersByHolderResponse) ProtoMessage()    {}
+func (*QueryOffersByHolderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{29}
+}
+func (m *QueryOffersByHolderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOffersByHolderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOffersByHolderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryOffersByHolderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOffersByHolderResponse.Merge(m, src)
+}
+func (m *QueryOffersByHolderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOffersByHolderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOffersByHolderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOffersByHolderResponse proto.InternalMessageInfo
+
+func (m *QueryOffersByHolderResponse) GetOffers() []*OfferInfo {
+	if m != nil {
+		return m.Offers
 	}
-	return n
+	return nil
 }
 
-func (m *SellOrderInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryOffersByHolderResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	return nil
+}
+
+// QueryDutchAuctionRequest is the Query/DutchAuction request type.
+type QueryDutchAuctionRequest struct {
+	// auction_id is the id of the requested dutch auction.
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+}
+
+func (m *QueryDutchAuctionRequest) Reset()         { *m = QueryDutchAuctionRequest{} }
+func (m *QueryDutchAuctionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDutchAuctionRequest) ProtoMessage()    {}
+func (*QueryDutchAuctionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{30}
+}
+func (m *QueryDutchAuctionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDutchAuctionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDutchAuctionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Seller)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryDutchAuctionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDutchAuctionRequest.Merge(m, src)
+}
+func (m *QueryDutchAuctionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDutchAuctionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDutchAuctionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDutchAuctionRequest proto.InternalMessageInfo
+
+func (m *QueryDutchAuctionRequest) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
 	}
-	l = len(m.BatchDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+// QueryDutchAuctionResponse is the Query/DutchAuction response type.
+type QueryDutchAuctionResponse struct {
+	// auction contains all information related to a dutch auction.
+	Auction *DutchAuctionInfo `protobuf:"bytes,1,opt,name=auction,proto3" json:"auction,omitempty"`
+}
+
+func (m *QueryDutchAuctionResponse) Reset()         { *m = QueryDutchAuctionResponse{} }
+func (m *QueryDutchAuctionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDutchAuctionResponse) ProtoMessage()    {}
+func (*QueryDutchAuctionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{31}
+}
+func (m *QueryDutchAuctionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDutchAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDutchAuctionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Quantity)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryDutchAuctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDutchAuctionResponse.Merge(m, src)
+}
+func (m *QueryDutchAuctionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDutchAuctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDutchAuctionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDutchAuctionResponse proto.InternalMessageInfo
+
+func (m *QueryDutchAuctionResponse) GetAuction() *DutchAuctionInfo {
+	if m != nil {
+		return m.Auction
 	}
-	l = len(m.AskDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryDutchAuctionsRequest is the Query/DutchAuctions request type.
+type QueryDutchAuctionsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDutchAuctionsRequest) Reset()         { *m = QueryDutchAuctionsRequest{} }
+func (m *QueryDutchAuctionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDutchAuctionsRequest) ProtoMessage()    {}
+func (*QueryDutchAuctionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{32}
+}
+func (m *QueryDutchAuctionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDutchAuctionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDutchAuctionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.AskAmount)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryDutchAuctionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDutchAuctionsRequest.Merge(m, src)
+}
+func (m *QueryDutchAuctionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDutchAuctionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDutchAuctionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDutchAuctionsRequest proto.InternalMessageInfo
+
+func (m *QueryDutchAuctionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if m.DisableAutoRetire {
-		n += 2
+	return nil
+}
+
+// QueryDutchAuctionsResponse is the Query/DutchAuctions response type.
+type QueryDutchAuctionsResponse struct {
+	// auctions is a list of dutch auctions.
+	Auctions []*DutchAuctionInfo `protobuf:"bytes,1,rep,name=auctions,proto3" json:"auctions,omitempty"`
+	// pagination defines an optional pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDutchAuctionsResponse) Reset()         { *m = QueryDutchAuctionsResponse{} }
+func (m *QueryDutchAuctionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDutchAuctionsResponse) ProtoMessage()    {}
+func (*QueryDutchAuctionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{33}
+}
+func (m *QueryDutchAuctionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDutchAuctionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDutchAuctionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Expiration != nil {
-		l = m.Expiration.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryDutchAuctionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDutchAuctionsResponse.Merge(m, src)
+}
+func (m *QueryDutchAuctionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDutchAuctionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDutchAuctionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDutchAuctionsResponse proto.InternalMessageInfo
+
+func (m *QueryDutchAuctionsResponse) GetAuctions() []*DutchAuctionInfo {
+	if m != nil {
+		return m.Auctions
 	}
-	return n
+	return nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryDutchAuctionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+// QuerySealedBidAuctionRequest is the Query/SealedBidAuction request type.
+type QuerySealedBidAuctionRequest struct {
+	// auction_id is the id of the requested sealed-bid auction.
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
 }
-func (m *QuerySellOrderRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+
+func (m *QuerySealedBidAuctionRequest) Reset()         { *m = QuerySealedBidAuctionRequest{} }
+func (m *QuerySealedBidAuctionRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySealedBidAuctionRequest) ProtoMessage()    {}
+func (*QuerySealedBidAuctionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{34}
+}
+func (m *QuerySealedBidAuctionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySealedBidAuctionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySealedBidAuctionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySealedBidAuctionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySealedBidAuctionRequest.Merge(m, src)
+}
+func (m *QuerySealedBidAuctionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySealedBidAuctionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySealedBidAuctionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySealedBidAuctionRequest proto.InternalMessageInfo
+
+func (m *QuerySealedBidAuctionRequest) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
+	}
+	return 0
+}
+
+// QuerySealedBidAuctionResponse is the Query/SealedBidAuction response type.
+type QuerySealedBidAuctionResponse struct {
+	// auction contains all information related to a sealed-bid auction.
+	Auction *SealedBidAuctionInfo `protobuf:"bytes,1,opt,name=auction,proto3" json:"auction,omitempty"`
+}
+
+func (m *QuerySealedBidAuctionResponse) Reset()         { *m = QuerySealedBidAuctionResponse{} }
+func (m *QuerySealedBidAuctionResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySealedBidAuctionResponse) ProtoMessage()    {}
+func (*QuerySealedBidAuctionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{35}
+}
+func (m *QuerySealedBidAuctionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySealedBidAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySealedBidAuctionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySealedBidAuctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySealedBidAuctionResponse.Merge(m, src)
+}
+func (m *QuerySealedBidAuctionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySealedBidAuctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySealedBidAuctionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySealedBidAuctionResponse proto.InternalMessageInfo
+
+func (m *QuerySealedBidAuctionResponse) GetAuction() *SealedBidAuctionInfo {
+	if m != nil {
+		return m.Auction
+	}
+	return nil
+}
+
+// QuerySealedBidAuctionsRequest is the Query/SealedBidAuctions request type.
+type QuerySealedBidAuctionsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySealedBidAuctionsRequest) Reset()         { *m = QuerySealedBidAuctionsRequest{} }
+func (m *QuerySealedBidAuctionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySealedBidAuctionsRequest) ProtoMessage()    {}
+func (*QuerySealedBidAuctionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{36}
+}
+func (m *QuerySealedBidAuctionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySealedBidAuctionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySealedBidAuctionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySealedBidAuctionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySealedBidAuctionsRequest.Merge(m, src)
+}
+func (m *QuerySealedBidAuctionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySealedBidAuctionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySealedBidAuctionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySealedBidAuctionsRequest proto.InternalMessageInfo
+
+func (m *QuerySealedBidAuctionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySealedBidAuctionsResponse is the Query/SealedBidAuctions response type.
+type QuerySealedBidAuctionsResponse struct {
+	// auctions is a list of sealed-bid auctions.
+	Auctions []*SealedBidAuctionInfo `protobuf:"bytes,1,rep,name=auctions,proto3" json:"auctions,omitempty"`
+	// pagination defines an optional pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySealedBidAuctionsResponse) Reset()         { *m = QuerySealedBidAuctionsResponse{} }
+func (m *QuerySealedBidAuctionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySealedBidAuctionsResponse) ProtoMessage()    {}
+func (*QuerySealedBidAuctionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{37}
+}
+func (m *QuerySealedBidAuctionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySealedBidAuctionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySealedBidAuctionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySealedBidAuctionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySealedBidAuctionsResponse.Merge(m, src)
+}
+func (m *QuerySealedBidAuctionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySealedBidAuctionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySealedBidAuctionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySealedBidAuctionsResponse proto.InternalMessageInfo
+
+func (m *QuerySealedBidAuctionsResponse) GetAuctions() []*SealedBidAuctionInfo {
+	if m != nil {
+		return m.Auctions
+	}
+	return nil
+}
+
+func (m *QuerySealedBidAuctionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySealedBidsRequest is the Query/SealedBids request type.
+type QuerySealedBidsRequest struct {
+	// auction_id is the id of the sealed-bid auction.
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySealedBidsRequest) Reset()         { *m = QuerySealedBidsRequest{} }
+func (m *QuerySealedBidsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySealedBidsRequest) ProtoMessage()    {}
+func (*QuerySealedBidsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{38}
+}
+func (m *QuerySealedBidsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySealedBidsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySealedBidsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySealedBidsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySealedBidsRequest.Merge(m, src)
+}
+func (m *QuerySealedBidsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySealedBidsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySealedBidsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySealedBidsRequest proto.InternalMessageInfo
+
+func (m *QuerySealedBidsRequest) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
+	}
+	return 0
+}
+
+func (m *QuerySealedBidsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySealedBidsResponse is the Query/SealedBids response type.
+type QuerySealedBidsResponse struct {
+	// bids is a list of bids in the sealed-bid auction.
+	Bids []*SealedBidInfo `protobuf:"bytes,1,rep,name=bids,proto3" json:"bids,omitempty"`
+	// pagination defines an optional pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySealedBidsResponse) Reset()         { *m = QuerySealedBidsResponse{} }
+func (m *QuerySealedBidsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySealedBidsResponse) ProtoMessage()    {}
+func (*QuerySealedBidsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{39}
+}
+func (m *QuerySealedBidsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySealedBidsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySealedBidsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySealedBidsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySealedBidsResponse.Merge(m, src)
+}
+func (m *QuerySealedBidsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySealedBidsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySealedBidsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySealedBidsResponse proto.InternalMessageInfo
+
+func (m *QuerySealedBidsResponse) GetBids() []*SealedBidInfo {
+	if m != nil {
+		return m.Bids
+	}
+	return nil
+}
+
+func (m *QuerySealedBidsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryForwardSaleRequest is the Query/ForwardSale request type.
+type QueryForwardSaleRequest struct {
+	// forward_sale_id is the id of the requested forward sale.
+	ForwardSaleId uint64 `protobuf:"varint,1,opt,name=forward_sale_id,json=forwardSaleId,proto3" json:"forward_sale_id,omitempty"`
+}
+
+func (m *QueryForwardSaleRequest) Reset()         { *m = QueryForwardSaleRequest{} }
+func (m *QueryForwardSaleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryForwardSaleRequest) ProtoMessage()    {}
+func (*QueryForwardSaleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{40}
+}
+func (m *QueryForwardSaleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryForwardSaleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryForwardSaleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryForwardSaleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryForwardSaleRequest.Merge(m, src)
+}
+func (m *QueryForwardSaleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryForwardSaleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryForwardSaleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryForwardSaleRequest proto.InternalMessageInfo
+
+func (m *QueryForwardSaleRequest) GetForwardSaleId() uint64 {
+	if m != nil {
+		return m.ForwardSaleId
+	}
+	return 0
+}
+
+// QueryForwardSaleResponse is the Query/ForwardSale response type.
+type QueryForwardSaleResponse struct {
+	// forward_sale contains all information related to a forward sale.
+	ForwardSale *ForwardSaleInfo `protobuf:"bytes,1,opt,name=forward_sale,json=forwardSale,proto3" json:"forward_sale,omitempty"`
+}
+
+func (m *QueryForwardSaleResponse) Reset()         { *m = QueryForwardSaleResponse{} }
+func (m *QueryForwardSaleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryForwardSaleResponse) ProtoMessage()    {}
+func (*QueryForwardSaleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{41}
+}
+func (m *QueryForwardSaleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryForwardSaleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryForwardSaleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryForwardSaleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryForwardSaleResponse.Merge(m, src)
+}
+func (m *QueryForwardSaleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryForwardSaleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryForwardSaleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryForwardSaleResponse proto.InternalMessageInfo
+
+func (m *QueryForwardSaleResponse) GetForwardSale() *ForwardSaleInfo {
+	if m != nil {
+		return m.ForwardSale
+	}
+	return nil
+}
+
+// QueryForwardSalesByProjectRequest is the Query/ForwardSalesByProject request
+// type.
+type QueryForwardSalesByProjectRequest struct {
+	// project_id is the unique identifier of the project.
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryForwardSalesByProjectRequest) Reset()         { *m = QueryForwardSalesByProjectRequest{} }
+func (m *QueryForwardSalesByProjectRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryForwardSalesByProjectRequest) ProtoMessage()    {}
+func (*QueryForwardSalesByProjectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{42}
+}
+func (m *QueryForwardSalesByProjectRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryForwardSalesByProjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryForwardSalesByProjectRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryForwardSalesByProjectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryForwardSalesByProjectRequest.Merge(m, src)
+}
+func (m *QueryForwardSalesByProjectRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryForwardSalesByProjectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryForwardSalesByProjectRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryForwardSalesByProjectRequest proto.InternalMessageInfo
+
+func (m *QueryForwardSalesByProjectRequest) GetProjectId() string {
+	if m != nil {
+		return m.ProjectId
+	}
+	return ""
+}
+
+func (m *QueryForwardSalesByProjectRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryForwardSalesByProjectResponse is the Query/ForwardSalesByProject
+// response type.
+type QueryForwardSalesByProjectResponse struct {
+	// forward_sales is a list of forward sales.
+	ForwardSales []*ForwardSaleInfo `protobuf:"bytes,1,rep,name=forward_sales,json=forwardSales,proto3" json:"forward_sales,omitempty"`
+	// pagination defines an optional pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryForwardSalesByProjectResponse) Reset()         { *m = QueryForwardSalesByProjectResponse{} }
+func (m *QueryForwardSalesByProjectResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryForwardSalesByProjectResponse) ProtoMessage()    {}
+func (*QueryForwardSalesByProjectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{43}
+}
+func (m *QueryForwardSalesByProjectResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryForwardSalesByProjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryForwardSalesByProjectResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryForwardSalesByProjectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryForwardSalesByProjectResponse.Merge(m, src)
+}
+func (m *QueryForwardSalesByProjectResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryForwardSalesByProjectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryForwardSalesByProjectResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryForwardSalesByProjectResponse proto.InternalMessageInfo
+
+func (m *QueryForwardSalesByProjectResponse) GetForwardSales() []*ForwardSaleInfo {
+	if m != nil {
+		return m.ForwardSales
+	}
+	return nil
+}
+
+func (m *QueryForwardSalesByProjectResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryForwardContractRequest is the Query/ForwardContract request type.
+type QueryForwardContractRequest struct {
+	// forward_contract_id is the id of the requested forward contract.
+	ForwardContractId uint64 `protobuf:"varint,1,opt,name=forward_contract_id,json=forwardContractId,proto3" json:"forward_contract_id,omitempty"`
+}
+
+func (m *QueryForwardContractRequest) Reset()         { *m = QueryForwardContractRequest{} }
+func (m *QueryForwardContractRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryForwardContractRequest) ProtoMessage()    {}
+func (*QueryForwardContractRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{44}
+}
+func (m *QueryForwardContractRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryForwardContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryForwardContractRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryForwardContractRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryForwardContractRequest.Merge(m, src)
+}
+func (m *QueryForwardContractRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryForwardContractRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryForwardContractRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryForwardContractRequest proto.InternalMessageInfo
+
+func (m *QueryForwardContractRequest) GetForwardContractId() uint64 {
+	if m != nil {
+		return m.ForwardContractId
+	}
+	return 0
+}
+
+// QueryForwardContractResponse is the Query/ForwardContract response type.
+type QueryForwardContractResponse struct {
+	// forward_contract contains all information related to a forward contract.
+	ForwardContract *ForwardContractInfo `protobuf:"bytes,1,opt,name=forward_contract,json=forwardContract,proto3" json:"forward_contract,omitempty"`
+}
+
+func (m *QueryForwardContractResponse) Reset()         { *m = QueryForwardContractResponse{} }
+func (m *QueryForwardContractResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryForwardContractResponse) ProtoMessage()    {}
+func (*QueryForwardContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{45}
+}
+func (m *QueryForwardContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryForwardContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryForwardContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryForwardContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryForwardContractResponse.Merge(m, src)
+}
+func (m *QueryForwardContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryForwardContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryForwardContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryForwardContractResponse proto.InternalMessageInfo
+
+func (m *QueryForwardContractResponse) GetForwardContract() *ForwardContractInfo {
+	if m != nil {
+		return m.ForwardContract
+	}
+	return nil
+}
+
+// QueryForwardContractsBySaleRequest is the Query/ForwardContractsBySale
+// request type.
+type QueryForwardContractsBySaleRequest struct {
+	// forward_sale_id is the id of the forward sale.
+	ForwardSaleId uint64 `protobuf:"varint,1,opt,name=forward_sale_id,json=forwardSaleId,proto3" json:"forward_sale_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryForwardContractsBySaleRequest) Reset()         { *m = QueryForwardContractsBySaleRequest{} }
+func (m *QueryForwardContractsBySaleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryForwardContractsBySaleRequest) ProtoMessage()    {}
+func (*QueryForwardContractsBySaleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{46}
+}
+func (m *QueryForwardContractsBySaleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryForwardContractsBySaleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryForwardContractsBySaleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryForwardContractsBySaleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryForwardContractsBySaleRequest.Merge(m, src)
+}
+func (m *QueryForwardContractsBySaleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryForwardContractsBySaleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryForwardContractsBySaleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryForwardContractsBySaleRequest proto.InternalMessageInfo
+
+func (m *QueryForwardContractsBySaleRequest) GetForwardSaleId() uint64 {
+	if m != nil {
+		return m.ForwardSaleId
+	}
+	return 0
+}
+
+func (m *QueryForwardContractsBySaleRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryForwardContractsBySaleResponse is the Query/ForwardContractsBySale
+// response type.
+type QueryForwardContractsBySaleResponse struct {
+	// forward_contracts is a list of forward contracts.
+	ForwardContracts []*ForwardContractInfo `protobuf:"bytes,1,rep,name=forward_contracts,json=forwardContracts,proto3" json:"forward_contracts,omitempty"`
+	// pagination defines an optional pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryForwardContractsBySaleResponse) Reset()         { *m = QueryForwardContractsBySaleResponse{} }
+func (m *QueryForwardContractsBySaleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryForwardContractsBySaleResponse) ProtoMessage()    {}
+func (*QueryForwardContractsBySaleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{47}
+}
+func (m *QueryForwardContractsBySaleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryForwardContractsBySaleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryForwardContractsBySaleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryForwardContractsBySaleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryForwardContractsBySaleResponse.Merge(m, src)
+}
+func (m *QueryForwardContractsBySaleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryForwardContractsBySaleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryForwardContractsBySaleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryForwardContractsBySaleResponse proto.InternalMessageInfo
+
+func (m *QueryForwardContractsBySaleResponse) GetForwardContracts() []*ForwardContractInfo {
+	if m != nil {
+		return m.ForwardContracts
+	}
+	return nil
+}
+
+func (m *QueryForwardContractsBySaleResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryForwardContractsByBuyerRequest is the Query/ForwardContractsByBuyer
+// request type.
+type QueryForwardContractsByBuyerRequest struct {
+	// buyer is the address of the account that bought the forward contracts.
+	Buyer string `protobuf:"bytes,1,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryForwardContractsByBuyerRequest) Reset()         { *m = QueryForwardContractsByBuyerRequest{} }
+func (m *QueryForwardContractsByBuyerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryForwardContractsByBuyerRequest) ProtoMessage()    {}
+func (*QueryForwardContractsByBuyerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{48}
+}
+func (m *QueryForwardContractsByBuyerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryForwardContractsByBuyerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryForwardContractsByBuyerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryForwardContractsByBuyerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryForwardContractsByBuyerRequest.Merge(m, src)
+}
+func (m *QueryForwardContractsByBuyerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryForwardContractsByBuyerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryForwardContractsByBuyerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryForwardContractsByBuyerRequest proto.InternalMessageInfo
+
+func (m *QueryForwardContractsByBuyerRequest) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+func (m *QueryForwardContractsByBuyerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryForwardContractsByBuyerResponse is the Query/ForwardContractsByBuyer
+// response type.
+type QueryForwardContractsByBuyerResponse struct {
+	// forward_contracts is a list of forward contracts.
+	ForwardContracts []*ForwardContractInfo `protobuf:"bytes,1,rep,name=forward_contracts,json=forwardContracts,proto3" json:"forward_contracts,omitempty"`
+	// pagination defines an optional pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryForwardContractsByBuyerResponse) Reset()         { *m = QueryForwardContractsByBuyerResponse{} }
+func (m *QueryForwardContractsByBuyerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryForwardContractsByBuyerResponse) ProtoMessage()    {}
+func (*QueryForwardContractsByBuyerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{49}
+}
+func (m *QueryForwardContractsByBuyerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryForwardContractsByBuyerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryForwardContractsByBuyerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryForwardContractsByBuyerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryForwardContractsByBuyerResponse.Merge(m, src)
+}
+func (m *QueryForwardContractsByBuyerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryForwardContractsByBuyerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryForwardContractsByBuyerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryForwardContractsByBuyerResponse proto.InternalMessageInfo
+
+func (m *QueryForwardContractsByBuyerResponse) GetForwardContracts() []*ForwardContractInfo {
+	if m != nil {
+		return m.ForwardContracts
+	}
+	return nil
+}
+
+func (m *QueryForwardContractsByBuyerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllowedDenomsRequest is the Query/AllowedDenoms request type.
+type QueryAllowedDenomsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllowedDenomsRequest) Reset()         { *m = QueryAllowedDenomsRequest{} }
+func (m *QueryAllowedDenomsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedDenomsRequest) ProtoMessage()    {}
+func (*QueryAllowedDenomsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{50}
+}
+func (m *QueryAllowedDenomsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedDenomsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedDenomsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowedDenomsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedDenomsRequest.Merge(m, src)
+}
+func (m *QueryAllowedDenomsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowedDenomsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedDenomsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowedDenomsRequest proto.InternalMessageInfo
+
+func (m *QueryAllowedDenomsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllowedDenomsResponse is the Query/AllowedDenoms response type.
+type QueryAllowedDenomsResponse struct {
+	// allowed_denoms is a list of coin denoms allowed to use in the ask price of
+	// sell orders.
+	AllowedDenoms []*AllowedDenom `protobuf:"bytes,1,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	// pagination defines an optional pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllowedDenomsResponse) Reset()         { *m = QueryAllowedDenomsResponse{} }
+func (m *QueryAllowedDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedDenomsResponse) ProtoMessage()    {}
+func (*QueryAllowedDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{51}
+}
+func (m *QueryAllowedDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowedDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedDenomsResponse.Merge(m, src)
+}
+func (m *QueryAllowedDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowedDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedDenomsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowedDenomsResponse proto.InternalMessageInfo
+
+func (m *QueryAllowedDenomsResponse) GetAllowedDenoms() []*AllowedDenom {
+	if m != nil {
+		return m.AllowedDenoms
+	}
+	return nil
+}
+
+func (m *QueryAllowedDenomsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMarketFeesRequest is the Query/MarketFees request type.
+type QueryMarketFeesRequest struct {
+	// market_id is the unique ID of the market.
+	MarketId uint64 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+}
+
+func (m *QueryMarketFeesRequest) Reset()         { *m = QueryMarketFeesRequest{} }
+func (m *QueryMarketFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMarketFeesRequest) ProtoMessage()    {}
+func (*QueryMarketFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{52}
+}
+func (m *QueryMarketFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarketFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarketFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMarketFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarketFeesRequest.Merge(m, src)
+}
+func (m *QueryMarketFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarketFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarketFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMarketFeesRequest proto.InternalMessageInfo
+
+func (m *QueryMarketFeesRequest) GetMarketId() uint64 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+// QueryMarketFeesResponse is the Query/MarketFees response type.
+type QueryMarketFeesResponse struct {
+	// market_id is the unique ID of the market.
+	MarketId uint64 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// credit_type_abbrev is the abbreviation of the credit type of the market.
+	CreditTypeAbbrev string `protobuf:"bytes,2,opt,name=credit_type_abbrev,json=creditTypeAbbrev,proto3" json:"credit_type_abbrev,omitempty"`
+	// maker_fee_bps is the maker fee in basis points that applies to the market.
+	MakerFeeBps uint32 `protobuf:"varint,3,opt,name=maker_fee_bps,json=makerFeeBps,proto3" json:"maker_fee_bps,omitempty"`
+	// taker_fee_bps is the taker fee in basis points that applies to the market.
+	TakerFeeBps uint32 `protobuf:"varint,4,opt,name=taker_fee_bps,json=takerFeeBps,proto3" json:"taker_fee_bps,omitempty"`
+	// collected is the total amount of fees collected in the market.
+	Collected *types1.Coin `protobuf:"bytes,5,opt,name=collected,proto3" json:"collected,omitempty"`
+}
+
+func (m *QueryMarketFeesResponse) Reset()         { *m = QueryMarketFeesResponse{} }
+func (m *QueryMarketFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMarketFeesResponse) ProtoMessage()    {}
+func (*QueryMarketFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{53}
+}
+func (m *QueryMarketFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarketFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarketFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMarketFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarketFeesResponse.Merge(m, src)
+}
+func (m *QueryMarketFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarketFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarketFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMarketFeesResponse proto.InternalMessageInfo
+
+func (m *QueryMarketFeesResponse) GetMarketId() uint64 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *QueryMarketFeesResponse) GetCreditTypeAbbrev() string {
+	if m != nil {
+		return m.CreditTypeAbbrev
+	}
+	return ""
+}
+
+func (m *QueryMarketFeesResponse) GetMakerFeeBps() uint32 {
+	if m != nil {
+		return m.MakerFeeBps
+	}
+	return 0
+}
+
+func (m *QueryMarketFeesResponse) GetTakerFeeBps() uint32 {
+	if m != nil {
+		return m.TakerFeeBps
+	}
+	return 0
+}
+
+func (m *QueryMarketFeesResponse) GetCollected() *types1.Coin {
+	if m != nil {
+		return m.Collected
+	}
+	return nil
+}
+
+// QueryFeeDestinationRequest is the Query/FeeDestination request type.
+type QueryFeeDestinationRequest struct {
+}
+
+func (m *QueryFeeDestinationRequest) Reset()         { *m = QueryFeeDestinationRequest{} }
+func (m *QueryFeeDestinationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeDestinationRequest) ProtoMessage()    {}
+func (*QueryFeeDestinationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{54}
+}
+func (m *QueryFeeDestinationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeDestinationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeDestinationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeDestinationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeDestinationRequest.Merge(m, src)
+}
+func (m *QueryFeeDestinationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeDestinationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeDestinationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeDestinationRequest proto.InternalMessageInfo
+
+// QueryFeeDestinationResponse is the Query/FeeDestination response type.
+type QueryFeeDestinationResponse struct {
+	// destination is the type of the fee destination.
+	Destination FeeDestinationType `protobuf:"varint,1,opt,name=destination,proto3,enum=regen.ecocredit.marketplace.v1.FeeDestinationType" json:"destination,omitempty"`
+	// address is the address of the account receiving fees.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryFeeDestinationResponse) Reset()         { *m = QueryFeeDestinationResponse{} }
+func (m *QueryFeeDestinationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeDestinationResponse) ProtoMessage()    {}
+func (*QueryFeeDestinationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{55}
+}
+func (m *QueryFeeDestinationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeDestinationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeDestinationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeDestinationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeDestinationResponse.Merge(m, src)
+}
+func (m *QueryFeeDestinationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeDestinationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeDestinationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeDestinationResponse proto.InternalMessageInfo
+
+func (m *QueryFeeDestinationResponse) GetDestination() FeeDestinationType {
+	if m != nil {
+		return m.Destination
+	}
+	return FeeDestinationType_FEE_DESTINATION_TYPE_UNSPECIFIED
+}
+
+func (m *QueryFeeDestinationResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryTradesByBatchRequest is the Query/TradesByBatch request type.
+type QueryTradesByBatchRequest struct {
+	// batch_denom is the denom of the credit batch.
+	BatchDenom string `protobuf:"bytes,1,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTradesByBatchRequest) Reset()         { *m = QueryTradesByBatchRequest{} }
+func (m *QueryTradesByBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTradesByBatchRequest) ProtoMessage()    {}
+func (*QueryTradesByBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{56}
+}
+func (m *QueryTradesByBatchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTradesByBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTradesByBatchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTradesByBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTradesByBatchRequest.Merge(m, src)
+}
+func (m *QueryTradesByBatchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTradesByBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTradesByBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTradesByBatchRequest proto.InternalMessageInfo
+
+func (m *QueryTradesByBatchRequest) GetBatchDenom() string {
+	if m != nil {
+		return m.BatchDenom
+	}
+	return ""
+}
+
+func (m *QueryTradesByBatchRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTradesByBatchResponse is the Query/TradesByBatch response type.
+type QueryTradesByBatchResponse struct {
+	// trades is a list of trades.
+	Trades []*TradeInfo `protobuf:"bytes,1,rep,name=trades,proto3" json:"trades,omitempty"`
+	// pagination defines an optional pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTradesByBatchResponse) Reset()         { *m = QueryTradesByBatchResponse{} }
+func (m *QueryTradesByBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTradesByBatchResponse) ProtoMessage()    {}
+func (*QueryTradesByBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{57}
+}
+func (m *QueryTradesByBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTradesByBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTradesByBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTradesByBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTradesByBatchResponse.Merge(m, src)
+}
+func (m *QueryTradesByBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTradesByBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTradesByBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTradesByBatchResponse proto.InternalMessageInfo
+
+func (m *QueryTradesByBatchResponse) GetTrades() []*TradeInfo {
+	if m != nil {
+		return m.Trades
+	}
+	return nil
+}
+
+func (m *QueryTradesByBatchResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTradesByMarketRequest is the Query/TradesByMarket request type.
+type QueryTradesByMarketRequest struct {
+	// market_id is the unique ID of the market.
+	MarketId uint64 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTradesByMarketRequest) Reset()         { *m = QueryTradesByMarketRequest{} }
+func (m *QueryTradesByMarketRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTradesByMarketRequest) ProtoMessage()    {}
+func (*QueryTradesByMarketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{58}
+}
+func (m *QueryTradesByMarketRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTradesByMarketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTradesByMarketRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTradesByMarketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTradesByMarketRequest.Merge(m, src)
+}
+func (m *QueryTradesByMarketRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTradesByMarketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTradesByMarketRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTradesByMarketRequest proto.InternalMessageInfo
+
+func (m *QueryTradesByMarketRequest) GetMarketId() uint64 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *QueryTradesByMarketRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTradesByMarketResponse is the Query/TradesByMarket response type.
+type QueryTradesByMarketResponse struct {
+	// trades is a list of trades.
+	Trades []*TradeInfo `protobuf:"bytes,1,rep,name=trades,proto3" json:"trades,omitempty"`
+	// pagination defines an optional pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTradesByMarketResponse) Reset()         { *m = QueryTradesByMarketResponse{} }
+func (m *QueryTradesByMarketResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTradesByMarketResponse) ProtoMessage()    {}
+func (*QueryTradesByMarketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{59}
+}
+func (m *QueryTradesByMarketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTradesByMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTradesByMarketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTradesByMarketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTradesByMarketResponse.Merge(m, src)
+}
+func (m *QueryTradesByMarketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTradesByMarketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTradesByMarketResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTradesByMarketResponse proto.InternalMessageInfo
+
+func (m *QueryTradesByMarketResponse) GetTrades() []*TradeInfo {
+	if m != nil {
+		return m.Trades
+	}
+	return nil
+}
+
+func (m *QueryTradesByMarketResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTradesByAddressRequest is the Query/TradesByAddress request type.
+type QueryTradesByAddressRequest struct {
+	// address is the address of the buyer or the seller.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTradesByAddressRequest) Reset()         { *m = QueryTradesByAddressRequest{} }
+func (m *QueryTradesByAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTradesByAddressRequest) ProtoMessage()    {}
+func (*QueryTradesByAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{60}
+}
+func (m *QueryTradesByAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTradesByAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTradesByAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTradesByAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTradesByAddressRequest.Merge(m, src)
+}
+func (m *QueryTradesByAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTradesByAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTradesByAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTradesByAddressRequest proto.InternalMessageInfo
+
+func (m *QueryTradesByAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryTradesByAddressRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTradesByAddressResponse is the Query/TradesByAddress response type.
+type QueryTradesByAddressResponse struct {
+	// trades is a list of trades.
+	Trades []*TradeInfo `protobuf:"bytes,1,rep,name=trades,proto3" json:"trades,omitempty"`
+	// pagination defines an optional pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTradesByAddressResponse) Reset()         { *m = QueryTradesByAddressResponse{} }
+func (m *QueryTradesByAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTradesByAddressResponse) ProtoMessage()    {}
+func (*QueryTradesByAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{61}
+}
+func (m *QueryTradesByAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTradesByAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTradesByAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTradesByAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTradesByAddressResponse.Merge(m, src)
+}
+func (m *QueryTradesByAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTradesByAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTradesByAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTradesByAddressResponse proto.InternalMessageInfo
+
+func (m *QueryTradesByAddressResponse) GetTrades() []*TradeInfo {
+	if m != nil {
+		return m.Trades
+	}
+	return nil
+}
+
+func (m *QueryTradesByAddressResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMarketStatsRequest is the Query/MarketStats request type.
+type QueryMarketStatsRequest struct {
+	// market_id is the unique ID of the market.
+	MarketId uint64 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// window_seconds is the length of the window of time ending at the current
+	// block time over which statistics are calculated. The window must be
+	// positive and cannot exceed 30 days (2592000 seconds).
+	WindowSeconds uint64 `protobuf:"varint,2,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+}
+
+func (m *QueryMarketStatsRequest) Reset()         { *m = QueryMarketStatsRequest{} }
+func (m *QueryMarketStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMarketStatsRequest) ProtoMessage()    {}
+func (*QueryMarketStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{62}
+}
+func (m *QueryMarketStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarketStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarketStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMarketStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarketStatsRequest.Merge(m, src)
+}
+func (m *QueryMarketStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarketStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarketStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMarketStatsRequest proto.InternalMessageInfo
+
+func (m *QueryMarketStatsRequest) GetMarketId() uint64 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *QueryMarketStatsRequest) GetWindowSeconds() uint64 {
+	if m != nil {
+		return m.WindowSeconds
+	}
+	return 0
+}
+
+// QueryMarketStatsResponse is the Query/MarketStats response type.
+type QueryMarketStatsResponse struct {
+	// market_id is the unique ID of the market.
+	MarketId uint64 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// credit_type_abbrev is the abbreviation of the credit type of the market.
+	CreditTypeAbbrev string `protobuf:"bytes,2,opt,name=credit_type_abbrev,json=creditTypeAbbrev,proto3" json:"credit_type_abbrev,omitempty"`
+	// bank_denom is the bank denom of the market.
+	BankDenom string `protobuf:"bytes,3,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
+	// last_price is the unit price of the most recent trade in the market,
+	// regardless of the window. The last price is empty if the market has no
+	// trades.
+	LastPrice string `protobuf:"bytes,4,opt,name=last_price,json=lastPrice,proto3" json:"last_price,omitempty"`
+	// last_trade_time is the time of the most recent trade in the market.
+	LastTradeTime *types.Timestamp `protobuf:"bytes,5,opt,name=last_trade_time,json=lastTradeTime,proto3" json:"last_trade_time,omitempty"`
+	// trade_count is the number of trades within the window.
+	TradeCount uint64 `protobuf:"varint,6,opt,name=trade_count,json=tradeCount,proto3" json:"trade_count,omitempty"`
+	// volume is the decimal quantity of credits traded within the window.
+	Volume string `protobuf:"bytes,7,opt,name=volume,proto3" json:"volume,omitempty"`
+	// notional is the total value (unit price multiplied by quantity) of trades
+	// within the window in the bank denom of the market.
+	Notional string `protobuf:"bytes,8,opt,name=notional,proto3" json:"notional,omitempty"`
+	// vwap is the volume weighted average unit price of trades within the
+	// window. The vwap is empty if there are no trades within the window.
+	Vwap string `protobuf:"bytes,9,opt,name=vwap,proto3" json:"vwap,omitempty"`
+	// high is the highest unit price of trades within the window.
+	High string `protobuf:"bytes,10,opt,name=high,proto3" json:"high,omitempty"`
+	// low is the lowest unit price of trades within the window.
+	Low string `protobuf:"bytes,11,opt,name=low,proto3" json:"low,omitempty"`
+	// window_start is the start of the window. The window start is empty if
+	// statistics are calculated over all trades.
+	WindowStart *types.Timestamp `protobuf:"bytes,12,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+}
+
+func (m *QueryMarketStatsResponse) Reset()         { *m = QueryMarketStatsResponse{} }
+func (m *QueryMarketStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMarketStatsResponse) ProtoMessage()    {}
+func (*QueryMarketStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{63}
+}
+func (m *QueryMarketStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarketStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarketStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMarketStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarketStatsResponse.Merge(m, src)
+}
+func (m *QueryMarketStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarketStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarketStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMarketStatsResponse proto.InternalMessageInfo
+
+func (m *QueryMarketStatsResponse) GetMarketId() uint64 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *QueryMarketStatsResponse) GetCreditTypeAbbrev() string {
+	if m != nil {
+		return m.CreditTypeAbbrev
+	}
+	return ""
+}
+
+func (m *QueryMarketStatsResponse) GetBankDenom() string {
+	if m != nil {
+		return m.BankDenom
+	}
+	return ""
+}
+
+func (m *QueryMarketStatsResponse) GetLastPrice() string {
+	if m != nil {
+		return m.LastPrice
+	}
+	return ""
+}
+
+func (m *QueryMarketStatsResponse) GetLastTradeTime() *types.Timestamp {
+	if m != nil {
+		return m.LastTradeTime
+	}
+	return nil
+}
+
+func (m *QueryMarketStatsResponse) GetTradeCount() uint64 {
+	if m != nil {
+		return m.TradeCount
+	}
+	return 0
+}
+
+func (m *QueryMarketStatsResponse) GetVolume() string {
+	if m != nil {
+		return m.Volume
+	}
+	return ""
+}
+
+func (m *QueryMarketStatsResponse) GetNotional() string {
+	if m != nil {
+		return m.Notional
+	}
+	return ""
+}
+
+func (m *QueryMarketStatsResponse) GetVwap() string {
+	if m != nil {
+		return m.Vwap
+	}
+	return ""
+}
+
+func (m *QueryMarketStatsResponse) GetHigh() string {
+	if m != nil {
+		return m.High
+	}
+	return ""
+}
+
+func (m *QueryMarketStatsResponse) GetLow() string {
+	if m != nil {
+		return m.Low
+	}
+	return ""
+}
+
+func (m *QueryMarketStatsResponse) GetWindowStart() *types.Timestamp {
+	if m != nil {
+		return m.WindowStart
+	}
+	return nil
+}
+
+// TradeInfo is the human-readable trade information.
+type TradeInfo struct {
+	// id is the unique ID of the trade.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// buyer is the address of the account that bought the credits.
+	Buyer string `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// seller is the address of the account that sold the credits.
+	Seller string `protobuf:"bytes,3,opt,name=seller,proto3" json:"seller,omitempty"`
+	// batch_denom is the denom of the credit batch of the credits.
+	BatchDenom string `protobuf:"bytes,4,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// market_id is the market in which the trade occurred.
+	MarketId uint64 `protobuf:"varint,5,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// sell_order_id is the ID of the sell order that was filled. The sell order
+	// ID is 0 if the credits were sold by accepting an offer.
+	SellOrderId uint64 `protobuf:"varint,6,opt,name=sell_order_id,json=sellOrderId,proto3" json:"sell_order_id,omitempty"`
+	// buy_order_id is the ID of the buy order that was filled. The buy order ID
+	// is 0 if the credits were bought directly.
+	BuyOrderId uint64 `protobuf:"varint,7,opt,name=buy_order_id,json=buyOrderId,proto3" json:"buy_order_id,omitempty"`
+	// quantity is the decimal quantity of credits traded.
+	Quantity string `protobuf:"bytes,8,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// bank_denom is the bank denom of the unit price.
+	BankDenom string `protobuf:"bytes,9,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
+	// unit_price is the integer price per credit (encoded as a string).
+	UnitPrice string `protobuf:"bytes,10,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	// timestamp is the block time of the trade.
+	Timestamp *types.Timestamp `protobuf:"bytes,11,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// offer_id is the ID of the offer that was accepted. The offer ID is 0 if
+	// the credits were not sold by accepting an offer.
+	OfferId uint64 `protobuf:"varint,12,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+	// dutch_auction_id is the ID of the dutch auction the credits were bought
+	// from. The dutch auction ID is 0 if the credits were not bought from a
+	// dutch auction.
+	DutchAuctionId uint64 `protobuf:"varint,13,opt,name=dutch_auction_id,json=dutchAuctionId,proto3" json:"dutch_auction_id,omitempty"`
+	// sealed_bid_auction_id is the ID of the sealed-bid auction that was
+	// settled. The sealed-bid auction ID is 0 if the credits were not sold in a
+	// sealed-bid auction.
+	SealedBidAuctionId uint64 `protobuf:"varint,14,opt,name=sealed_bid_auction_id,json=sealedBidAuctionId,proto3" json:"sealed_bid_auction_id,omitempty"`
+	// forward_contract_id is the ID of the forward contract that was delivered.
+	// The forward contract ID is 0 if the credits were not delivered to fill a
+	// forward contract.
+	ForwardContractId uint64 `protobuf:"varint,15,opt,name=forward_contract_id,json=forwardContractId,proto3" json:"forward_contract_id,omitempty"`
+}
+
+func (m *TradeInfo) Reset()         { *m = TradeInfo{} }
+func (m *TradeInfo) String() string { return proto.CompactTextString(m) }
+func (*TradeInfo) ProtoMessage()    {}
+func (*TradeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{64}
+}
+func (m *TradeInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TradeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TradeInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TradeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradeInfo.Merge(m, src)
+}
+func (m *TradeInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *TradeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradeInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradeInfo proto.InternalMessageInfo
+
+func (m *TradeInfo) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TradeInfo) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+func (m *TradeInfo) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *TradeInfo) GetBatchDenom() string {
+	if m != nil {
+		return m.BatchDenom
+	}
+	return ""
+}
+
+func (m *TradeInfo) GetMarketId() uint64 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *TradeInfo) GetSellOrderId() uint64 {
+	if m != nil {
+		return m.SellOrderId
+	}
+	return 0
+}
+
+func (m *TradeInfo) GetBuyOrderId() uint64 {
+	if m != nil {
+		return m.BuyOrderId
+	}
+	return 0
+}
+
+func (m *TradeInfo) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
+	}
+	return ""
+}
+
+func (m *TradeInfo) GetBankDenom() string {
+	if m != nil {
+		return m.BankDenom
+	}
+	return ""
+}
+
+func (m *TradeInfo) GetUnitPrice() string {
+	if m != nil {
+		return m.UnitPrice
+	}
+	return ""
+}
+
+func (m *TradeInfo) GetTimestamp() *types.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *TradeInfo) GetOfferId() uint64 {
+	if m != nil {
+		return m.OfferId
+	}
+	return 0
+}
+
+func (m *TradeInfo) GetDutchAuctionId() uint64 {
+	if m != nil {
+		return m.DutchAuctionId
+	}
+	return 0
+}
+
+func (m *TradeInfo) GetSealedBidAuctionId() uint64 {
+	if m != nil {
+		return m.SealedBidAuctionId
+	}
+	return 0
+}
+
+func (m *TradeInfo) GetForwardContractId() uint64 {
+	if m != nil {
+		return m.ForwardContractId
+	}
+	return 0
+}
+
+// SellOrderInfo is the human-readable sell order information.
+type SellOrderInfo struct {
+	// id is the unique ID of sell order.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// seller is the address of the account that is selling credits.
+	Seller string `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// batch_denom is denom of the credit batch being sold.
+	BatchDenom string `protobuf:"bytes,3,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// quantity is the decimal quantity of credits being sold.
+	Quantity string `protobuf:"bytes,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// ask_denom is the denom used in the ask price of the sell order.
+	AskDenom string `protobuf:"bytes,5,opt,name=ask_denom,json=askDenom,proto3" json:"ask_denom,omitempty"`
+	// ask_amount is the amount that the seller is asking for each credit unit of
+	// the batch. Each credit unit of the batch will be sold for at least the
+	// ask_amount.
+	AskAmount string `protobuf:"bytes,6,opt,name=ask_amount,json=askAmount,proto3" json:"ask_amount,omitempty"`
+	// disable_auto_retire disables auto-retirement of credits which allows a
+	// buyer to disable auto-retirement in their buy order enabling them to
+	// resell the credits to another buyer.
+	DisableAutoRetire bool `protobuf:"varint,7,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
+	// expiration is an optional timestamp when the sell order expires. When the
+	// expiration time is reached, the sell order is removed from state.
+	Expiration *types.Timestamp `protobuf:"bytes,9,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// allowed_buyers is the list of addresses that are allowed to buy credits
+	// from the sell order. An empty list (with no buyer_group_policy) indicates
+	// that the sell order is public.
+	AllowedBuyers []string `protobuf:"bytes,10,rep,name=allowed_buyers,json=allowedBuyers,proto3" json:"allowed_buyers,omitempty"`
+	// buyer_group_policy is the group policy address that is allowed to buy
+	// credits from the sell order.
+	BuyerGroupPolicy string `protobuf:"bytes,11,opt,name=buyer_group_policy,json=buyerGroupPolicy,proto3" json:"buyer_group_policy,omitempty"`
+	// min_fill_quantity is the minimum quantity of credits that must be
+	// purchased in a single fill unless the entire remaining quantity of the
+	// sell order is purchased.
+	MinFillQuantity string `protobuf:"bytes,12,opt,name=min_fill_quantity,json=minFillQuantity,proto3" json:"min_fill_quantity,omitempty"`
+	// all_or_none requires the entire remaining quantity of the sell order to be
+	// purchased in a single fill.
+	AllOrNone bool `protobuf:"varint,13,opt,name=all_or_none,json=allOrNone,proto3" json:"all_or_none,omitempty"`
+}
+
+func (m *SellOrderInfo) Reset()         { *m = SellOrderInfo{} }
+func (m *SellOrderInfo) String() string { return proto.CompactTextString(m) }
+func (*SellOrderInfo) ProtoMessage()    {}
+func (*SellOrderInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{65}
+}
+func (m *SellOrderInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SellOrderInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SellOrderInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SellOrderInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SellOrderInfo.Merge(m, src)
+}
+func (m *SellOrderInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *SellOrderInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SellOrderInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SellOrderInfo proto.InternalMessageInfo
+
+func (m *SellOrderInfo) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *SellOrderInfo) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *SellOrderInfo) GetBatchDenom() string {
+	if m != nil {
+		return m.BatchDenom
+	}
+	return ""
+}
+
+func (m *SellOrderInfo) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
+	}
+	return ""
+}
+
+func (m *SellOrderInfo) GetAskDenom() string {
+	if m != nil {
+		return m.AskDenom
+	}
+	return ""
+}
+
+func (m *SellOrderInfo) GetAskAmount() string {
+	if m != nil {
+		return m.AskAmount
+	}
+	return ""
+}
+
+func (m *SellOrderInfo) GetDisableAutoRetire() bool {
+	if m != nil {
+		return m.DisableAutoRetire
+	}
+	return false
+}
+
+func (m *SellOrderInfo) GetExpiration() *types.Timestamp {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func (m *SellOrderInfo) GetAllowedBuyers() []string {
+	if m != nil {
+		return m.AllowedBuyers
+	}
+	return nil
+}
+
+func (m *SellOrderInfo) GetBuyerGroupPolicy() string {
+	if m != nil {
+		return m.BuyerGroupPolicy
+	}
+	return ""
+}
+
+func (m *SellOrderInfo) GetMinFillQuantity() string {
+	if m != nil {
+		return m.MinFillQuantity
+	}
+	return ""
+}
+
+func (m *SellOrderInfo) GetAllOrNone() bool {
+	if m != nil {
+		return m.AllOrNone
+	}
+	return false
+}
+
+// BuyOrderInfo is the human-readable buy order information.
+type BuyOrderInfo struct {
+	// id is the unique ID of buy order.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// buyer is the address of the account that is buying credits.
+	Buyer string `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// filter selects the credit batches that the buy order can be matched
+	// against.
+	Filter *BuyOrder_Filter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// quantity is the decimal quantity of credits remaining to be bought.
+	Quantity string `protobuf:"bytes,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// bid_denom is the denom used in the bid price of the buy order.
+	BidDenom string `protobuf:"bytes,5,opt,name=bid_denom,json=bidDenom,proto3" json:"bid_denom,omitempty"`
+	// bid_amount is the amount that the buyer is willing to pay for each credit
+	// unit.
+	BidAmount string `protobuf:"bytes,6,opt,name=bid_amount,json=bidAmount,proto3" json:"bid_amount,omitempty"`
+	// disable_auto_retire disables auto-retirement of the purchased credits.
+	DisableAutoRetire bool `protobuf:"varint,7,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
+	// retirement_jurisdiction is the retirement jurisdiction for the credits
+	// which will be used only if disable_auto_retire is false.
+	RetirementJurisdiction string `protobuf:"bytes,8,opt,name=retirement_jurisdiction,json=retirementJurisdiction,proto3" json:"retirement_jurisdiction,omitempty"`
+	// expiration is an optional timestamp when the buy order expires.
+	Expiration *types.Timestamp `protobuf:"bytes,9,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// taker_fee_bps is the taker fee in basis points that applies to the buy
+	// order.
+	TakerFeeBps uint32 `protobuf:"varint,10,opt,name=taker_fee_bps,json=takerFeeBps,proto3" json:"taker_fee_bps,omitempty"`
+}
+
+func (m *BuyOrderInfo) Reset()         { *m = BuyOrderInfo{} }
+func (m *BuyOrderInfo) String() string { return proto.CompactTextString(m) }
+func (*BuyOrderInfo) ProtoMessage()    {}
+func (*BuyOrderInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{66}
+}
+func (m *BuyOrderInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BuyOrderInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BuyOrderInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BuyOrderInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuyOrderInfo.Merge(m, src)
+}
+func (m *BuyOrderInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *BuyOrderInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuyOrderInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuyOrderInfo proto.InternalMessageInfo
+
+func (m *BuyOrderInfo) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *BuyOrderInfo) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+func (m *BuyOrderInfo) GetFilter() *BuyOrder_Filter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *BuyOrderInfo) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
+	}
+	return ""
+}
+
+func (m *BuyOrderInfo) GetBidDenom() string {
+	if m != nil {
+		return m.BidDenom
+	}
+	return ""
+}
+
+func (m *BuyOrderInfo) GetBidAmount() string {
+	if m != nil {
+		return m.BidAmount
+	}
+	return ""
+}
+
+func (m *BuyOrderInfo) GetDisableAutoRetire() bool {
+	if m != nil {
+		return m.DisableAutoRetire
+	}
+	return false
+}
+
+func (m *BuyOrderInfo) GetRetirementJurisdiction() string {
+	if m != nil {
+		return m.RetirementJurisdiction
+	}
+	return ""
+}
+
+func (m *BuyOrderInfo) GetExpiration() *types.Timestamp {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func (m *BuyOrderInfo) GetTakerFeeBps() uint32 {
+	if m != nil {
+		return m.TakerFeeBps
+	}
+	return 0
+}
+
+// OfferInfo is the human-readable offer information.
+type OfferInfo struct {
+	// id is the unique ID of the offer.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// buyer is the address of the account that made the offer.
+	Buyer string `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// batch_denom is the denom of the credit batch being bought.
+	BatchDenom string `protobuf:"bytes,3,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// holder is the address of the credit holder the offer is made to. The
+	// holder is empty if any holder can accept the offer.
+	Holder string `protobuf:"bytes,4,opt,name=holder,proto3" json:"holder,omitempty"`
+	// quantity is the decimal quantity of credits remaining to be bought.
+	Quantity string `protobuf:"bytes,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// bid_denom is the denom used in the bid price of the offer.
+	BidDenom string `protobuf:"bytes,6,opt,name=bid_denom,json=bidDenom,proto3" json:"bid_denom,omitempty"`
+	// bid_amount is the amount that the buyer is paying for each credit unit.
+	BidAmount string `protobuf:"bytes,7,opt,name=bid_amount,json=bidAmount,proto3" json:"bid_amount,omitempty"`
+	// disable_auto_retire disables auto-retirement of the purchased credits.
+	DisableAutoRetire bool `protobuf:"varint,8,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
+	// retirement_jurisdiction is the retirement jurisdiction for the credits
+	// which will be used only if disable_auto_retire is false.
+	RetirementJurisdiction string `protobuf:"bytes,9,opt,name=retirement_jurisdiction,json=retirementJurisdiction,proto3" json:"retirement_jurisdiction,omitempty"`
+	// expiration is an optional timestamp when the offer expires.
+	Expiration *types.Timestamp `protobuf:"bytes,10,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// maker_fee_bps is the maker fee in basis points that applies to the offer.
+	MakerFeeBps uint32 `protobuf:"varint,11,opt,name=maker_fee_bps,json=makerFeeBps,proto3" json:"maker_fee_bps,omitempty"`
+}
+
+func (m *OfferInfo) Reset()         { *m = OfferInfo{} }
+func (m *OfferInfo) String() string { return proto.CompactTextString(m) }
+func (*OfferInfo) ProtoMessage()    {}
+func (*OfferInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{67}
+}
+func (m *OfferInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OfferInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OfferInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OfferInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OfferInfo.Merge(m, src)
+}
+func (m *OfferInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *OfferInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_OfferInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OfferInfo proto.InternalMessageInfo
+
+func (m *OfferInfo) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *OfferInfo) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+func (m *OfferInfo) GetBatchDenom() string {
+	if m != nil {
+		return m.BatchDenom
+	}
+	return ""
+}
+
+func (m *OfferInfo) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+func (m *OfferInfo) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
+	}
+	return ""
+}
+
+func (m *OfferInfo) GetBidDenom() string {
+	if m != nil {
+		return m.BidDenom
+	}
+	return ""
+}
+
+func (m *OfferInfo) GetBidAmount() string {
+	if m != nil {
+		return m.BidAmount
+	}
+	return ""
+}
+
+func (m *OfferInfo) GetDisableAutoRetire() bool {
+	if m != nil {
+		return m.DisableAutoRetire
+	}
+	return false
+}
+
+func (m *OfferInfo) GetRetirementJurisdiction() string {
+	if m != nil {
+		return m.RetirementJurisdiction
+	}
+	return ""
+}
+
+func (m *OfferInfo) GetExpiration() *types.Timestamp {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func (m *OfferInfo) GetMakerFeeBps() uint32 {
+	if m != nil {
+		return m.MakerFeeBps
+	}
+	return 0
+}
+
+// DutchAuctionInfo is the human-readable dutch auction information.
+type DutchAuctionInfo struct {
+	// id is the unique ID of the dutch auction.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// seller is the address of the account that is selling credits.
+	Seller string `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// batch_denom is the denom of the credit batch being sold.
+	BatchDenom string `protobuf:"bytes,3,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// quantity is the decimal quantity of credits remaining to be sold.
+	Quantity string `protobuf:"bytes,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// ask_denom is the denom used in the ask price of the auction.
+	AskDenom string `protobuf:"bytes,5,opt,name=ask_denom,json=askDenom,proto3" json:"ask_denom,omitempty"`
+	// start_amount is the ask price per credit at the start time.
+	StartAmount string `protobuf:"bytes,6,opt,name=start_amount,json=startAmount,proto3" json:"start_amount,omitempty"`
+	// floor_amount is the ask price per credit at and after the end time.
+	FloorAmount string `protobuf:"bytes,7,opt,name=floor_amount,json=floorAmount,proto3" json:"floor_amount,omitempty"`
+	// current_amount is the ask price per credit at the current block time.
+	CurrentAmount string `protobuf:"bytes,8,opt,name=current_amount,json=currentAmount,proto3" json:"current_amount,omitempty"`
+	// start_time is the time when the auction was created.
+	StartTime *types.Timestamp `protobuf:"bytes,9,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time is the time when the ask price reaches the floor amount.
+	EndTime *types.Timestamp `protobuf:"bytes,10,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// disable_auto_retire disables auto-retirement of credits.
+	DisableAutoRetire bool `protobuf:"varint,11,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
+}
+
+func (m *DutchAuctionInfo) Reset()         { *m = DutchAuctionInfo{} }
+func (m *DutchAuctionInfo) String() string { return proto.CompactTextString(m) }
+func (*DutchAuctionInfo) ProtoMessage()    {}
+func (*DutchAuctionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{68}
+}
+func (m *DutchAuctionInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DutchAuctionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DutchAuctionInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DutchAuctionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DutchAuctionInfo.Merge(m, src)
+}
+func (m *DutchAuctionInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *DutchAuctionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DutchAuctionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DutchAuctionInfo proto.InternalMessageInfo
+
+func (m *DutchAuctionInfo) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DutchAuctionInfo) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *DutchAuctionInfo) GetBatchDenom() string {
+	if m != nil {
+		return m.BatchDenom
+	}
+	return ""
+}
+
+func (m *DutchAuctionInfo) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
+	}
+	return ""
+}
+
+func (m *DutchAuctionInfo) GetAskDenom() string {
+	if m != nil {
+		return m.AskDenom
+	}
+	return ""
+}
+
+func (m *DutchAuctionInfo) GetStartAmount() string {
+	if m != nil {
+		return m.StartAmount
+	}
+	return ""
+}
+
+func (m *DutchAuctionInfo) GetFloorAmount() string {
+	if m != nil {
+		return m.FloorAmount
+	}
+	return ""
+}
+
+func (m *DutchAuctionInfo) GetCurrentAmount() string {
+	if m != nil {
+		return m.CurrentAmount
+	}
+	return ""
+}
+
+func (m *DutchAuctionInfo) GetStartTime() *types.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *DutchAuctionInfo) GetEndTime() *types.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *DutchAuctionInfo) GetDisableAutoRetire() bool {
+	if m != nil {
+		return m.DisableAutoRetire
+	}
+	return false
+}
+
+// SealedBidAuctionInfo is the human-readable sealed-bid auction information.
+type SealedBidAuctionInfo struct {
+	// id is the unique ID of the sealed-bid auction.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// seller is the address of the account that is selling credits.
+	Seller string `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// batch_denom is the denom of the credit batch being sold.
+	BatchDenom string `protobuf:"bytes,3,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// quantity is the decimal quantity of credits being sold.
+	Quantity string `protobuf:"bytes,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// bid_denom is the denom of the reserve price and of all bids.
+	BidDenom string `protobuf:"bytes,5,opt,name=bid_denom,json=bidDenom,proto3" json:"bid_denom,omitempty"`
+	// reserve_amount is the minimum price per credit the seller will accept.
+	ReserveAmount string `protobuf:"bytes,6,opt,name=reserve_amount,json=reserveAmount,proto3" json:"reserve_amount,omitempty"`
+	// commit_end_height is the last block height at which bids can be
+	// committed.
+	CommitEndHeight int64 `protobuf:"varint,7,opt,name=commit_end_height,json=commitEndHeight,proto3" json:"commit_end_height,omitempty"`
+	// reveal_end_height is the last block height at which bids can be revealed.
+	RevealEndHeight int64 `protobuf:"varint,8,opt,name=reveal_end_height,json=revealEndHeight,proto3" json:"reveal_end_height,omitempty"`
+	// disable_auto_retire disables auto-retirement of credits.
+	DisableAutoRetire bool `protobuf:"varint,9,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
+	// maker_fee_bps is the maker fee in basis points paid by the seller.
+	MakerFeeBps uint32 `protobuf:"varint,10,opt,name=maker_fee_bps,json=makerFeeBps,proto3" json:"maker_fee_bps,omitempty"`
+	// taker_fee_bps is the taker fee in basis points paid by winning bidders.
+	TakerFeeBps uint32 `protobuf:"varint,11,opt,name=taker_fee_bps,json=takerFeeBps,proto3" json:"taker_fee_bps,omitempty"`
+}
+
+func (m *SealedBidAuctionInfo) Reset()         { *m = SealedBidAuctionInfo{} }
+func (m *SealedBidAuctionInfo) String() string { return proto.CompactTextString(m) }
+func (*SealedBidAuctionInfo) ProtoMessage()    {}
+func (*SealedBidAuctionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{69}
+}
+func (m *SealedBidAuctionInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SealedBidAuctionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SealedBidAuctionInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SealedBidAuctionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SealedBidAuctionInfo.Merge(m, src)
+}
+func (m *SealedBidAuctionInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *SealedBidAuctionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SealedBidAuctionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SealedBidAuctionInfo proto.InternalMessageInfo
+
+func (m *SealedBidAuctionInfo) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *SealedBidAuctionInfo) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *SealedBidAuctionInfo) GetBatchDenom() string {
+	if m != nil {
+		return m.BatchDenom
+	}
+	return ""
+}
+
+func (m *SealedBidAuctionInfo) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
+	}
+	return ""
+}
+
+func (m *SealedBidAuctionInfo) GetBidDenom() string {
+	if m != nil {
+		return m.BidDenom
+	}
+	return ""
+}
+
+func (m *SealedBidAuctionInfo) GetReserveAmount() string {
+	if m != nil {
+		return m.ReserveAmount
+	}
+	return ""
+}
+
+func (m *SealedBidAuctionInfo) GetCommitEndHeight() int64 {
+	if m != nil {
+		return m.CommitEndHeight
+	}
+	return 0
+}
+
+func (m *SealedBidAuctionInfo) GetRevealEndHeight() int64 {
+	if m != nil {
+		return m.RevealEndHeight
+	}
+	return 0
+}
+
+func (m *SealedBidAuctionInfo) GetDisableAutoRetire() bool {
+	if m != nil {
+		return m.DisableAutoRetire
+	}
+	return false
+}
+
+func (m *SealedBidAuctionInfo) GetMakerFeeBps() uint32 {
+	if m != nil {
+		return m.MakerFeeBps
+	}
+	return 0
+}
+
+func (m *SealedBidAuctionInfo) GetTakerFeeBps() uint32 {
+	if m != nil {
+		return m.TakerFeeBps
+	}
+	return 0
+}
+
+// SealedBidInfo is the human-readable sealed bid information.
+type SealedBidInfo struct {
+	// auction_id is the ID of the sealed-bid auction.
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// bidder is the address of the account that committed the bid.
+	Bidder string `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// deposit_amount is the amount escrowed by the bidder.
+	DepositAmount string `protobuf:"bytes,3,opt,name=deposit_amount,json=depositAmount,proto3" json:"deposit_amount,omitempty"`
+	// revealed is whether the bid has been revealed.
+	Revealed bool `protobuf:"varint,4,opt,name=revealed,proto3" json:"revealed,omitempty"`
+	// quantity is the quantity of credits bid for. The quantity is empty until
+	// the bid is revealed.
+	Quantity string `protobuf:"bytes,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// bid_amount is the price per credit of the bid. The bid amount is empty
+	// until the bid is revealed.
+	BidAmount string `protobuf:"bytes,6,opt,name=bid_amount,json=bidAmount,proto3" json:"bid_amount,omitempty"`
+}
+
+func (m *SealedBidInfo) Reset()         { *m = SealedBidInfo{} }
+func (m *SealedBidInfo) String() string { return proto.CompactTextString(m) }
+func (*SealedBidInfo) ProtoMessage()    {}
+func (*SealedBidInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{70}
+}
+func (m *SealedBidInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SealedBidInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SealedBidInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SealedBidInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SealedBidInfo.Merge(m, src)
+}
+func (m *SealedBidInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *SealedBidInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SealedBidInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SealedBidInfo proto.InternalMessageInfo
+
+func (m *SealedBidInfo) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
+	}
+	return 0
+}
+
+func (m *SealedBidInfo) GetBidder() string {
+	if m != nil {
+		return m.Bidder
+	}
+	return ""
+}
+
+func (m *SealedBidInfo) GetDepositAmount() string {
+	if m != nil {
+		return m.DepositAmount
+	}
+	return ""
+}
+
+func (m *SealedBidInfo) GetRevealed() bool {
+	if m != nil {
+		return m.Revealed
+	}
+	return false
+}
+
+func (m *SealedBidInfo) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
+	}
+	return ""
+}
+
+func (m *SealedBidInfo) GetBidAmount() string {
+	if m != nil {
+		return m.BidAmount
+	}
+	return ""
+}
+
+// ForwardSaleInfo is the human-readable forward sale information.
+type ForwardSaleInfo struct {
+	// id is the unique ID of the forward sale.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// seller is the address of the project admin that created the forward sale.
+	Seller string `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// project_id is the unique identifier of the project of the future credits.
+	ProjectId string `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// vintage_start is the earliest start date of a credit batch that can be
+	// delivered to fill forward contracts.
+	VintageStart *types.Timestamp `protobuf:"bytes,4,opt,name=vintage_start,json=vintageStart,proto3" json:"vintage_start,omitempty"`
+	// vintage_end is the latest end date of a credit batch that can be delivered
+	// to fill forward contracts.
+	VintageEnd *types.Timestamp `protobuf:"bytes,5,opt,name=vintage_end,json=vintageEnd,proto3" json:"vintage_end,omitempty"`
+	// quantity is the decimal quantity of future credits that remain available
+	// to be bought.
+	Quantity string `protobuf:"bytes,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// ask_denom is the denom used in the ask price of the forward sale.
+	AskDenom string `protobuf:"bytes,7,opt,name=ask_denom,json=askDenom,proto3" json:"ask_denom,omitempty"`
+	// ask_amount is the amount that the seller is asking for each credit unit.
+	AskAmount string `protobuf:"bytes,8,opt,name=ask_amount,json=askAmount,proto3" json:"ask_amount,omitempty"`
+	// delivery_deadline is the timestamp when credits that have not been
+	// delivered are refunded to the buyers.
+	DeliveryDeadline *types.Timestamp `protobuf:"bytes,9,opt,name=delivery_deadline,json=deliveryDeadline,proto3" json:"delivery_deadline,omitempty"`
+	// disable_auto_retire disables auto-retirement of credits.
+	DisableAutoRetire bool `protobuf:"varint,10,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
+}
+
+func (m *ForwardSaleInfo) Reset()         { *m = ForwardSaleInfo{} }
+func (m *ForwardSaleInfo) String() string { return proto.CompactTextString(m) }
+func (*ForwardSaleInfo) ProtoMessage()    {}
+func (*ForwardSaleInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{71}
+}
+func (m *ForwardSaleInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardSaleInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardSaleInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForwardSaleInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardSaleInfo.Merge(m, src)
+}
+func (m *ForwardSaleInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardSaleInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardSaleInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardSaleInfo proto.InternalMessageInfo
+
+func (m *ForwardSaleInfo) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ForwardSaleInfo) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *ForwardSaleInfo) GetProjectId() string {
+	if m != nil {
+		return m.ProjectId
+	}
+	return ""
+}
+
+func (m *ForwardSaleInfo) GetVintageStart() *types.Timestamp {
+	if m != nil {
+		return m.VintageStart
+	}
+	return nil
+}
+
+func (m *ForwardSaleInfo) GetVintageEnd() *types.Timestamp {
+	if m != nil {
+		return m.VintageEnd
+	}
+	return nil
+}
+
+func (m *ForwardSaleInfo) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
+	}
+	return ""
+}
+
+func (m *ForwardSaleInfo) GetAskDenom() string {
+	if m != nil {
+		return m.AskDenom
+	}
+	return ""
+}
+
+func (m *ForwardSaleInfo) GetAskAmount() string {
+	if m != nil {
+		return m.AskAmount
+	}
+	return ""
+}
+
+func (m *ForwardSaleInfo) GetDeliveryDeadline() *types.Timestamp {
+	if m != nil {
+		return m.DeliveryDeadline
+	}
+	return nil
+}
+
+func (m *ForwardSaleInfo) GetDisableAutoRetire() bool {
+	if m != nil {
+		return m.DisableAutoRetire
+	}
+	return false
+}
+
+// ForwardContractInfo is the human-readable forward contract information.
+type ForwardContractInfo struct {
+	// id is the unique ID of the forward contract.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// forward_sale_id is the ID of the forward sale.
+	ForwardSaleId uint64 `protobuf:"varint,2,opt,name=forward_sale_id,json=forwardSaleId,proto3" json:"forward_sale_id,omitempty"`
+	// buyer is the address of the account that bought the forward contract.
+	Buyer string `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// project_id is the unique identifier of the project of the future credits.
+	ProjectId string `protobuf:"bytes,4,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// quantity is the decimal quantity of credits remaining to be delivered.
+	Quantity string `protobuf:"bytes,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// escrow_denom is the denom of the escrowed funds.
+	EscrowDenom string `protobuf:"bytes,6,opt,name=escrow_denom,json=escrowDenom,proto3" json:"escrow_denom,omitempty"`
+	// escrow_amount is the amount remaining in escrow for the credits that have
+	// not been delivered.
+	EscrowAmount string `protobuf:"bytes,7,opt,name=escrow_amount,json=escrowAmount,proto3" json:"escrow_amount,omitempty"`
+	// disable_auto_retire disables auto-retirement of the delivered credits.
+	DisableAutoRetire bool `protobuf:"varint,8,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
+	// retirement_jurisdiction is the retirement jurisdiction for the credits
+	// which will be used only if disable_auto_retire is false.
+	RetirementJurisdiction string `protobuf:"bytes,9,opt,name=retirement_jurisdiction,json=retirementJurisdiction,proto3" json:"retirement_jurisdiction,omitempty"`
+	// maker_fee_bps is the maker fee in basis points that applies to the
+	// forward contract.
+	MakerFeeBps uint32 `protobuf:"varint,10,opt,name=maker_fee_bps,json=makerFeeBps,proto3" json:"maker_fee_bps,omitempty"`
+	// taker_fee_bps is the taker fee in basis points that applies to the
+	// forward contract.
+	TakerFeeBps uint32 `protobuf:"varint,11,opt,name=taker_fee_bps,json=takerFeeBps,proto3" json:"taker_fee_bps,omitempty"`
+}
+
+func (m *ForwardContractInfo) Reset()         { *m = ForwardContractInfo{} }
+func (m *ForwardContractInfo) String() string { return proto.CompactTextString(m) }
+func (*ForwardContractInfo) ProtoMessage()    {}
+func (*ForwardContractInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{72}
+}
+func (m *ForwardContractInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardContractInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardContractInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForwardContractInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardContractInfo.Merge(m, src)
+}
+func (m *ForwardContractInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardContractInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardContractInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardContractInfo proto.InternalMessageInfo
+
+func (m *ForwardContractInfo) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ForwardContractInfo) GetForwardSaleId() uint64 {
+	if m != nil {
+		return m.ForwardSaleId
+	}
+	return 0
+}
+
+func (m *ForwardContractInfo) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+func (m *ForwardContractInfo) GetProjectId() string {
+	if m != nil {
+		return m.ProjectId
+	}
+	return ""
+}
+
+func (m *ForwardContractInfo) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
+	}
+	return ""
+}
+
+func (m *ForwardContractInfo) GetEscrowDenom() string {
+	if m != nil {
+		return m.EscrowDenom
+	}
+	return ""
+}
+
+func (m *ForwardContractInfo) GetEscrowAmount() string {
+	if m != nil {
+		return m.EscrowAmount
+	}
+	return ""
+}
+
+func (m *ForwardContractInfo) GetDisableAutoRetire() bool {
+	if m != nil {
+		return m.DisableAutoRetire
+	}
+	return false
+}
+
+func (m *ForwardContractInfo) GetRetirementJurisdiction() string {
+	if m != nil {
+		return m.RetirementJurisdiction
+	}
+	return ""
+}
+
+func (m *ForwardContractInfo) GetMakerFeeBps() uint32 {
+	if m != nil {
+		return m.MakerFeeBps
+	}
+	return 0
+}
+
+func (m *ForwardContractInfo) GetTakerFeeBps() uint32 {
+	if m != nil {
+		return m.TakerFeeBps
+	}
+	return 0
+}
+
+// QueryMarketsRequest is the Query/Markets request type.
+type QueryMarketsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMarketsRequest) Reset()         { *m = QueryMarketsRequest{} }
+func (m *QueryMarketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMarketsRequest) ProtoMessage()    {}
+func (*QueryMarketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{73}
+}
+func (m *QueryMarketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMarketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarketsRequest.Merge(m, src)
+}
+func (m *QueryMarketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarketsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMarketsRequest proto.InternalMessageInfo
+
+func (m *QueryMarketsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMarketsResponse is the Query/Markets response type.
+type QueryMarketsResponse struct {
+	// markets is a list of markets.
+	Markets []*MarketInfo `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets,omitempty"`
+	// pagination defines an optional pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMarketsResponse) Reset()         { *m = QueryMarketsResponse{} }
+func (m *QueryMarketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMarketsResponse) ProtoMessage()    {}
+func (*QueryMarketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{74}
+}
+func (m *QueryMarketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMarketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarketsResponse.Merge(m, src)
+}
+func (m *QueryMarketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMarketsResponse proto.InternalMessageInfo
+
+func (m *QueryMarketsResponse) GetMarkets() []*MarketInfo {
+	if m != nil {
+		return m.Markets
+	}
+	return nil
+}
+
+func (m *QueryMarketsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMarketRegistrationRequest is the Query/MarketRegistration request type.
+type QueryMarketRegistrationRequest struct {
+}
+
+func (m *QueryMarketRegistrationRequest) Reset()         { *m = QueryMarketRegistrationRequest{} }
+func (m *QueryMarketRegistrationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMarketRegistrationRequest) ProtoMessage()    {}
+func (*QueryMarketRegistrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{75}
+}
+func (m *QueryMarketRegistrationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarketRegistrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarketRegistrationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMarketRegistrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarketRegistrationRequest.Merge(m, src)
+}
+func (m *QueryMarketRegistrationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarketRegistrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarketRegistrationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMarketRegistrationRequest proto.InternalMessageInfo
+
+// QueryMarketRegistrationResponse is the Query/MarketRegistration response
+// type.
+type QueryMarketRegistrationResponse struct {
+	// enabled is true if market registration is permissionless.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// deposit is the deposit paid to register a market.
+	Deposit *types1.Coin `protobuf:"bytes,2,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (m *QueryMarketRegistrationResponse) Reset()         { *m = QueryMarketRegistrationResponse{} }
+func (m *QueryMarketRegistrationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMarketRegistrationResponse) ProtoMessage()    {}
+func (*QueryMarketRegistrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{76}
+}
+func (m *QueryMarketRegistrationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarketRegistrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarketRegistrationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMarketRegistrationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarketRegistrationResponse.Merge(m, src)
+}
+func (m *QueryMarketRegistrationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarketRegistrationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarketRegistrationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMarketRegistrationResponse proto.InternalMessageInfo
+
+func (m *QueryMarketRegistrationResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *QueryMarketRegistrationResponse) GetDeposit() *types1.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+// MarketInfo is the human-readable market information.
+type MarketInfo struct {
+	// id is the unique ID of the market.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// credit_type_abbrev is the abbreviation of the credit type.
+	CreditTypeAbbrev string `protobuf:"bytes,2,opt,name=credit_type_abbrev,json=creditTypeAbbrev,proto3" json:"credit_type_abbrev,omitempty"`
+	// bank_denom is the bank denom of the market.
+	BankDenom string `protobuf:"bytes,3,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
+	// display_denom is the display denom of the bank denom of a registered
+	// market.
+	DisplayDenom string `protobuf:"bytes,4,opt,name=display_denom,json=displayDenom,proto3" json:"display_denom,omitempty"`
+	// exponent is the exponent that relates the bank denom to the display denom
+	// of a registered market.
+	Exponent uint32 `protobuf:"varint,5,opt,name=exponent,proto3" json:"exponent,omitempty"`
+	// registrant is the address of the account that registered the market.
+	Registrant string `protobuf:"bytes,6,opt,name=registrant,proto3" json:"registrant,omitempty"`
+	// delisted is true if the market has been delisted by governance.
+	Delisted bool `protobuf:"varint,7,opt,name=delisted,proto3" json:"delisted,omitempty"`
+}
+
+func (m *MarketInfo) Reset()         { *m = MarketInfo{} }
+func (m *MarketInfo) String() string { return proto.CompactTextString(m) }
+func (*MarketInfo) ProtoMessage()    {}
+func (*MarketInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f2df4a89dfae849, []int{77}
+}
+func (m *MarketInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketInfo.Merge(m, src)
+}
+func (m *MarketInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketInfo proto.InternalMessageInfo
+
+func (m *MarketInfo) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MarketInfo) GetCreditTypeAbbrev() string {
+	if m != nil {
+		return m.CreditTypeAbbrev
+	}
+	return ""
+}
+
+func (m *MarketInfo) GetBankDenom() string {
+	if m != nil {
+		return m.BankDenom
+	}
+	return ""
+}
+
+func (m *MarketInfo) GetDisplayDenom() string {
+	if m != nil {
+		return m.DisplayDenom
+	}
+	return ""
+}
+
+func (m *MarketInfo) GetExponent() uint32 {
+	if m != nil {
+		return m.Exponent
+	}
+	return 0
+}
+
+func (m *MarketInfo) GetRegistrant() string {
+	if m != nil {
+		return m.Registrant
+	}
+	return ""
+}
+
+func (m *MarketInfo) GetDelisted() bool {
+	if m != nil {
+		return m.Delisted
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*QuerySellOrderRequest)(nil), "regen.ecocredit.marketplace.v1.QuerySellOrderRequest")
+	proto.RegisterType((*QuerySellOrderResponse)(nil), "regen.ecocredit.marketplace.v1.QuerySellOrderResponse")
+	proto.RegisterType((*QuerySellOrdersRequest)(nil), "regen.ecocredit.marketplace.v1.QuerySellOrdersRequest")
+	proto.RegisterType((*QuerySellOrdersResponse)(nil), "regen.ecocredit.marketplace.v1.QuerySellOrdersResponse")
+	proto.RegisterType((*QuerySellOrdersByBatchRequest)(nil), "regen.ecocredit.marketplace.v1.QuerySellOrdersByBatchRequest")
+	proto.RegisterType((*QuerySellOrdersByBatchResponse)(nil), "regen.ecocredit.marketplace.v1.QuerySellOrdersByBatchResponse")
+	proto.RegisterType((*QuerySellOrdersBySellerRequest)(nil), "regen.ecocredit.marketplace.v1.QuerySellOrdersBySellerRequest")
+	proto.RegisterType((*QuerySellOrdersBySellerResponse)(nil), "regen.ecocredit.marketplace.v1.QuerySellOrdersBySellerResponse")
+	proto.RegisterType((*QuerySellOrdersPendingExpiryRequest)(nil), "regen.ecocredit.marketplace.v1.QuerySellOrdersPendingExpiryRequest")
+	proto.RegisterType((*QuerySellOrdersPendingExpiryResponse)(nil), "regen.ecocredit.marketplace.v1.QuerySellOrdersPendingExpiryResponse")
+	proto.RegisterType((*QuerySellOrdersByClassRequest)(nil), "regen.ecocredit.marketplace.v1.QuerySellOrdersByClassRequest")
+	proto.RegisterType((*QuerySellOrdersByClassResponse)(nil), "regen.ecocredit.marketplace.v1.QuerySellOrdersByClassResponse")
+	proto.RegisterType((*QuerySellOrdersByProjectRequest)(nil), "regen.ecocredit.marketplace.v1.QuerySellOrdersByProjectRequest")
+	proto.RegisterType((*QuerySellOrdersByProjectResponse)(nil), "regen.ecocredit.marketplace.v1.QuerySellOrdersByProjectResponse")
+	proto.RegisterType((*QuerySellOrdersByMarketRequest)(nil), "regen.ecocredit.marketplace.v1.QuerySellOrdersByMarketRequest")
+	proto.RegisterType((*QuerySellOrdersByMarketResponse)(nil), "regen.ecocredit.marketplace.v1.QuerySellOrdersByMarketResponse")
+	proto.RegisterType((*QueryBuyOrderRequest)(nil), "regen.ecocredit.marketplace.v1.QueryBuyOrderRequest")
+	proto.RegisterType((*QueryBuyOrderResponse)(nil), "regen.ecocredit.marketplace.v1.QueryBuyOrderResponse")
+	proto.RegisterType((*QueryBuyOrdersRequest)(nil), "regen.ecocredit.marketplace.v1.QueryBuyOrdersRequest")
+	proto.RegisterType((*QueryBuyOrdersResponse)(nil), "regen.ecocredit.marketplace.v1.QueryBuyOrdersResponse")
+	proto.RegisterType((*QueryBuyOrdersByBuyerRequest)(nil), "regen.ecocredit.marketplace.v1.QueryBuyOrdersByBuyerRequest")
+	proto.RegisterType((*QueryBuyOrdersByBuyerResponse)(nil), "regen.ecocredit.marketplace.v1.QueryBuyOrdersByBuyerResponse")
+	proto.RegisterType((*QueryOfferRequest)(nil), "regen.ecocredit.marketplace.v1.QueryOfferRequest")
+	proto.RegisterType((*QueryOfferResponse)(nil), "regen.ecocredit.marketplace.v1.QueryOfferResponse")
+	proto.RegisterType((*QueryOffersByBatchRequest)(nil), "regen.ecocredit.marketplace.v1.QueryOffersByBatchRequest")
+	proto.RegisterType((*QueryOffersByBatchResponse)(nil), "regen.ecocredit.marketplace.v1.QueryOffersByBatchResponse")
+	proto.RegisterType((*QueryOffersByBuyerRequest)(nil), "regen.ecocredit.marketplace.v1.QueryOffersByBuyerRequest")
+	proto.RegisterType((*QueryOffersByBuyerResponse)(nil), "regen.ecocredit.marketplace.v1.QueryOffersByBuyerResponse")
+	proto.RegisterType((*QueryOffersByHolderRequest)(nil), "regen.ecocredit.marketplace.v1.QueryOffersByHolderRequest")
+	proto.RegisterType((*QueryOffersByHolderResponse)(nil), "regen.ecocredit.marketplace.v1.QueryOffersByHolderResponse")
+	proto.RegisterType((*QueryDutchAuctionRequest)(nil), "regen.ecocredit.marketplace.v1.QueryDutchAuctionRequest")
+	proto.RegisterType((*QueryDutchAuctionResponse)(nil), "regen.ecocredit.marketplace.v1.QueryDutchAuctionResponse")
+	proto.RegisterType((*QueryDutchAuctionsRequest)(nil), "regen.ecocredit.marketplace.v1.QueryDutchAuctionsRequest")
+	proto.RegisterType((*QueryDutchAuctionsResponse)(nil), "regen.ecocredit.marketplace.v1.QueryDutchAuctionsResponse")
+	proto.RegisterType((*QuerySealedBidAuctionRequest)(nil), "regen.ecocredit.marketplace.v1.QuerySealedBidAuctionRequest")
+	proto.RegisterType((*QuerySealedBidAuctionResponse)(nil), "regen.ecocredit.marketplace.v1.QuerySealedBidAuctionResponse")
+	proto.RegisterType((*QuerySealedBidAuctionsRequest)(nil), "regen.ecocredit.marketplace.v1.QuerySealedBidAuctionsRequest")
+	proto.RegisterType((*QuerySealedBidAuctionsResponse)(nil), "regen.ecocredit.marketplace.v1.QuerySealedBidAuctionsResponse")
+	proto.RegisterType((*QuerySealedBidsRequest)(nil), "regen.ecocredit.marketplace.v1.QuerySealedBidsRequest")
+	proto.RegisterType((*QuerySealedBidsResponse)(nil), "regen.ecocredit.marketplace.v1.QuerySealedBidsResponse")
+	proto.RegisterType((*QueryForwardSaleRequest)(nil), "regen.ecocredit.marketplace.v1.QueryForwardSaleRequest")
+	proto.RegisterType((*QueryForwardSaleResponse)(nil), "regen.ecocredit.marketplace.v1.QueryForwardSaleResponse")
+	proto.RegisterType((*QueryForwardSalesByProjectRequest)(nil), "regen.ecocredit.marketplace.v1.QueryForwardSalesByProjectRequest")
+	proto.RegisterType((*QueryForwardSalesByProjectResponse)(nil), "regen.ecocredit.marketplace.v1.QueryForwardSalesByProjectResponse")
+	proto.RegisterType((*QueryForwardContractRequest)(nil), "regen.ecocredit.marketplace.v1.QueryForwardContractRequest")
+	proto.RegisterType((*QueryForwardContractResponse)(nil), "regen.ecocredit.marketplace.v1.QueryForwardContractResponse")
+	proto.RegisterType((*QueryForwardContractsBySaleRequest)(nil), "regen.ecocredit.marketplace.v1.QueryForwardContractsBySaleRequest")
+	proto.RegisterType((*QueryForwardContractsBySaleResponse)(nil), "regen.ecocredit.marketplace.v1.QueryForwardContractsBySaleResponse")
+	proto.RegisterType((*QueryForwardContractsByBuyerRequest)(nil), "regen.ecocredit.marketplace.v1.QueryForwardContractsByBuyerRequest")
+	proto.RegisterType((*QueryForwardContractsByBuyerResponse)(nil), "regen.ecocredit.marketplace.v1.QueryForwardContractsByBuyerResponse")
+	proto.RegisterType((*QueryAllowedDenomsRequest)(nil), "regen.ecocredit.marketplace.v1.QueryAllowedDenomsRequest")
+	proto.RegisterType((*QueryAllowedDenomsResponse)(nil), "regen.ecocredit.marketplace.v1.QueryAllowedDenomsResponse")
+	proto.RegisterType((*QueryMarketFeesRequest)(nil), "regen.ecocredit.marketplace.v1.QueryMarketFeesRequest")
+	proto.RegisterType((*QueryMarketFeesResponse)(nil), "regen.ecocredit.marketplace.v1.QueryMarketFeesResponse")
+	proto.RegisterType((*QueryFeeDestinationRequest)(nil), "regen.ecocredit.marketplace.v1.QueryFeeDestinationRequest")
+	proto.RegisterType((*QueryFeeDestinationResponse)(nil), "regen.ecocredit.marketplace.v1.QueryFeeDestinationResponse")
+	proto.RegisterType((*QueryTradesByBatchRequest)(nil), "regen.ecocredit.marketplace.v1.QueryTradesByBatchRequest")
+	proto.RegisterType((*QueryTradesByBatchResponse)(nil), "regen.ecocredit.marketplace.v1.QueryTradesByBatchResponse")
+	proto.RegisterType((*QueryTradesByMarketRequest)(nil), "regen.ecocredit.marketplace.v1.QueryTradesByMarketRequest")
+	proto.RegisterType((*QueryTradesByMarketResponse)(nil), "regen.ecocredit.marketplace.v1.QueryTradesByMarketResponse")
+	proto.RegisterType((*QueryTradesByAddressRequest)(nil), "regen.ecocredit.marketplace.v1.QueryTradesByAddressRequest")
+	proto.RegisterType((*QueryTradesByAddressResponse)(nil), "regen.ecocredit.marketplace.v1.QueryTradesByAddressResponse")
+	proto.RegisterType((*QueryMarketStatsRequest)(nil), "regen.ecocredit.marketplace.v1.QueryMarketStatsRequest")
+	proto.RegisterType((*QueryMarketStatsResponse)(nil), "regen.ecocredit.marketplace.v1.QueryMarketStatsResponse")
+	proto.RegisterType((*TradeInfo)(nil), "regen.ecocredit.marketplace.v1.TradeInfo")
+	proto.RegisterType((*SellOrderInfo)(nil), "regen.ecocredit.marketplace.v1.SellOrderInfo")
+	proto.RegisterType((*BuyOrderInfo)(nil), "regen.ecocredit.marketplace.v1.BuyOrderInfo")
+	proto.RegisterType((*OfferInfo)(nil), "regen.ecocredit.marketplace.v1.OfferInfo")
+	proto.RegisterType((*DutchAuctionInfo)(nil), "regen.ecocredit.marketplace.v1.DutchAuctionInfo")
+	proto.RegisterType((*SealedBidAuctionInfo)(nil), "regen.ecocredit.marketplace.v1.SealedBidAuctionInfo")
+	proto.RegisterType((*SealedBidInfo)(nil), "regen.ecocredit.marketplace.v1.SealedBidInfo")
+	proto.RegisterType((*ForwardSaleInfo)(nil), "regen.ecocredit.marketplace.v1.ForwardSaleInfo")
+	proto.RegisterType((*ForwardContractInfo)(nil), "regen.ecocredit.marketplace.v1.ForwardContractInfo")
+	proto.RegisterType((*QueryMarketsRequest)(nil), "regen.ecocredit.marketplace.v1.QueryMarketsRequest")
+	proto.RegisterType((*QueryMarketsResponse)(nil), "regen.ecocredit.marketplace.v1.QueryMarketsResponse")
+	proto.RegisterType((*QueryMarketRegistrationRequest)(nil), "regen.ecocredit.marketplace.v1.QueryMarketRegistrationRequest")
+	proto.RegisterType((*QueryMarketRegistrationResponse)(nil), "regen.ecocredit.marketplace.v1.QueryMarketRegistrationResponse")
+	proto.RegisterType((*MarketInfo)(nil), "regen.ecocredit.marketplace.v1.MarketInfo")
+}
+
+func init() {
+	proto.RegisterFile("regen/ecocredit/marketplace/v1/query.proto", fileDescriptor_1f2df4a89dfae849)
+}
+
+var fileDescriptor_1f2df4a89dfae849 = []byte{
+	// 3989 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5b, 0x5d, 0x6c, 0x1c, 0x57,
+	0xf5, 0xef, 0xec, 0xfa, 0x63, 0xf7, 0xac, 0xd7, 0x1f, 0x37, 0x5f, 0x9b, 0x69, 0xe2, 0x24, 0xd3,
+	0x7f, 0xdb, 0x34, 0xff, 0x7a, 0xb7, 0xce, 0x77, 0xec, 0x38, 0x89, 0x3f, 0xe2, 0xd4, 0x2d, 0x6d,
+	0xd3, 0x75, 0x10, 0x92, 0xa5, 0xb2, 0xcc, 0xee, 0xdc, 0xb5, 0xa7, 0x99, 0x9d, 0xd9, 0xce, 0xcc,
+	0xda, 0xb1, 0x5c, 0x13, 0x95, 0x52, 0x41, 0xa1, 0x85, 0x4a, 0x20, 0xf1, 0x54, 0x5e, 0x4a, 0x05,
+	0x05, 0xa9, 0x12, 0x45, 0x7d, 0xa0, 0x15, 0x54, 0xa2, 0x7d, 0x68, 0x01, 0x89, 0x16, 0x5e, 0xe8,
+	0x03, 0x12, 0x6a, 0x01, 0x21, 0x24, 0xde, 0xfa, 0xc0, 0x13, 0x42, 0x73, 0xef, 0x9d, 0xcf, 0x9d,
+	0xf5, 0xce, 0x6c, 0x16, 0xc7, 0x3c, 0xed, 0xde, 0x8f, 0x73, 0xef, 0x39, 0xbf, 0x73, 0xee, 0x99,
+	0x73, 0xcf, 0xbd, 0x17, 0x8e, 0xe9, 0x78, 0x19, 0xab, 0x05, 0x5c, 0xd1, 0x2a, 0x3a, 0x96, 0x64,
+	0xb3, 0x50, 0x13, 0xf5, 0xeb, 0xd8, 0xac, 0x2b, 0x62, 0x05, 0x17, 0x56, 0xc7, 0x0b, 0x4f, 0x35,
+	0xb0, 0xbe, 0x9e, 0xaf, 0xeb, 0x9a, 0xa9, 0xa1, 0x51, 0xd2, 0x37, 0xef, 0xf4, 0xcd, 0x7b, 0xfa,
+	0xe6, 0x57, 0xc7, 0xf9, 0x03, 0xcb, 0x9a, 0xb6, 0xac, 0xe0, 0x82, 0x58, 0x97, 0x0b, 0xa2, 0xaa,
+	0x6a, 0xa6, 0x68, 0xca, 0x9a, 0x6a, 0x50, 0x6a, 0xfe, 0x10, 0x6b, 0x25, 0xa5, 0x72, 0xa3, 0x5a,
+	0x30, 0xe5, 0x1a, 0x36, 0x4c, 0xb1, 0x56, 0x67, 0x1d, 0xda, 0xb1, 0x62, 0x98, 0xa2, 0x89, 0xed,
+	0xbe, 0x15, 0xcd, 0xa8, 0x69, 0x46, 0xa1, 0x2c, 0x1a, 0x98, 0xf2, 0x58, 0x58, 0x1d, 0x2f, 0x63,
+	0x53, 0x1c, 0x2f, 0xd4, 0xc5, 0x65, 0x59, 0x25, 0x33, 0xb3, 0xbe, 0xa3, 0xde, 0xbe, 0x76, 0xaf,
+	0x8a, 0x26, 0xb3, 0x76, 0x61, 0x12, 0xf6, 0x3c, 0x6e, 0x8d, 0xb0, 0x88, 0x15, 0xe5, 0x31, 0x5d,
+	0xc2, 0x7a, 0x11, 0x3f, 0xd5, 0xc0, 0x86, 0x89, 0x04, 0xc8, 0x1a, 0x58, 0x51, 0x4a, 0x9a, 0x55,
+	0x59, 0x92, 0xa5, 0x1c, 0x77, 0x98, 0x3b, 0xda, 0x53, 0xcc, 0x18, 0x76, 0xc7, 0x05, 0x49, 0xa8,
+	0xc2, 0xde, 0x20, 0xb1, 0x51, 0xd7, 0x54, 0x03, 0xa3, 0xcf, 0x01, 0xb8, 0xd4, 0x84, 0x34, 0x73,
+	0x7c, 0x2c, 0xbf, 0x35, 0x84, 0x79, 0x67, 0x98, 0x05, 0xb5, 0xaa, 0x15, 0xd3, 0xce, 0x4c, 0xc2,
+	0xf3, 0x5c, 0x70, 0x22, 0xc3, 0x66, 0x73, 0x1e, 0xc0, 0x95, 0x99, 0x4d, 0x74, 0x4f, 0x9e, 0x0a,
+	0x9d, 0xb7, 0x84, 0xce, 0x53, 0x25, 0x32, 0xd1, 0xf3, 0x57, 0xc5, 0x65, 0xcc, 0x68, 0x8b, 0x1e,
+	0x4a, 0x74, 0x2f, 0x0c, 0xc9, 0x6a, 0x45, 0x69, 0x48, 0xb8, 0x54, 0xd7, 0xe5, 0x55, 0xd1, 0xc4,
+	0xb9, 0xc4, 0x61, 0xee, 0x68, 0xaa, 0x38, 0xc8, 0xaa, 0xaf, 0xd2, 0x5a, 0xe1, 0x0d, 0x0e, 0xf6,
+	0x35, 0xf1, 0xc2, 0xa4, 0x7e, 0x14, 0x32, 0xae, 0xd4, 0x46, 0x8e, 0x3b, 0x9c, 0x8c, 0x2f, 0x36,
+	0x38, 0x62, 0x1b, 0xe8, 0x8a, 0x4f, 0xb8, 0x04, 0x11, 0xee, 0xde, 0xb6, 0xc2, 0x51, 0x66, 0xbc,
+	0xd2, 0x09, 0x3f, 0xe5, 0xe0, 0x60, 0x80, 0xe9, 0x99, 0xf5, 0x19, 0xd1, 0xac, 0xac, 0xd8, 0x38,
+	0x1e, 0x82, 0x4c, 0xd9, 0x2a, 0x97, 0x24, 0xac, 0x6a, 0x35, 0x02, 0x64, 0xba, 0x08, 0xa4, 0x6a,
+	0xce, 0xaa, 0x41, 0xf3, 0x21, 0xbc, 0x74, 0x09, 0xe8, 0x64, 0x28, 0xd0, 0x3f, 0xe7, 0x60, 0xb4,
+	0x15, 0xcf, 0x3b, 0x1d, 0xef, 0xd7, 0xc2, 0x78, 0xb7, 0xfe, 0xbb, 0xeb, 0x6b, 0x2f, 0xf4, 0x19,
+	0xa4, 0x82, 0x61, 0xcd, 0x4a, 0xdb, 0x8f, 0xf3, 0x5b, 0x1c, 0x1c, 0x6a, 0xc9, 0xeb, 0x4e, 0x07,
+	0xfa, 0x63, 0x0e, 0xee, 0x0a, 0x30, 0x7f, 0x15, 0xab, 0x92, 0xac, 0x2e, 0x5f, 0xbe, 0x51, 0x97,
+	0xf5, 0x75, 0x1b, 0xed, 0x69, 0x18, 0xc4, 0x56, 0x05, 0x36, 0x4a, 0x65, 0x5c, 0xd5, 0x74, 0xcc,
+	0x5c, 0x05, 0x9f, 0xa7, 0x8e, 0x39, 0x6f, 0x3b, 0xe6, 0xfc, 0x35, 0xdb, 0x31, 0x17, 0xb3, 0x8c,
+	0x62, 0x86, 0x10, 0x6c, 0xbf, 0x62, 0xde, 0xe1, 0xe0, 0xff, 0xb6, 0x96, 0x6d, 0xa7, 0x6b, 0xe7,
+	0x5f, 0x89, 0x10, 0xb7, 0x33, 0xab, 0x88, 0x86, 0xe3, 0xbe, 0xf7, 0x43, 0xaa, 0x62, 0x95, 0xed,
+	0x0f, 0x4c, 0xba, 0xd8, 0x4f, 0xca, 0x0b, 0xd2, 0xb6, 0xe3, 0x8d, 0xc6, 0x61, 0x77, 0x5d, 0xd7,
+	0x9e, 0xc4, 0x15, 0xb3, 0xf4, 0x64, 0x43, 0x97, 0x0d, 0x49, 0xae, 0x90, 0xa9, 0x7b, 0x08, 0x5f,
+	0xbb, 0x58, 0xdb, 0x43, 0x9e, 0x26, 0xf4, 0x30, 0xec, 0xae, 0xc9, 0x6a, 0x89, 0x7a, 0x4e, 0xc3,
+	0x14, 0x75, 0xb3, 0x24, 0x59, 0x13, 0xf4, 0xb6, 0x35, 0xae, 0x91, 0x9a, 0xac, 0x12, 0x67, 0xb6,
+	0x68, 0x51, 0xcd, 0x59, 0xf3, 0x5b, 0x83, 0x89, 0x37, 0x9a, 0x07, 0xeb, 0x8b, 0x30, 0x98, 0x78,
+	0xc3, 0x3f, 0x58, 0xb8, 0xf7, 0x64, 0xd0, 0xef, 0x74, 0xb3, 0xf9, 0x4d, 0x22, 0xc4, 0x23, 0x5d,
+	0xa5, 0xf0, 0xdb, 0x86, 0x73, 0x10, 0xc0, 0x56, 0x96, 0x63, 0x3a, 0x69, 0x56, 0x73, 0x3b, 0x8c,
+	0xa7, 0x95, 0x25, 0xf4, 0x74, 0xd3, 0x12, 0x7a, 0x3b, 0xb1, 0x84, 0xb7, 0x39, 0x38, 0xdc, 0x1a,
+	0xcd, 0x9d, 0x6e, 0x0b, 0xaf, 0x87, 0xd9, 0xf1, 0x23, 0x84, 0x0d, 0xdb, 0x14, 0xee, 0x84, 0x34,
+	0xe5, 0xcb, 0x8d, 0x52, 0x53, 0xb4, 0x62, 0x41, 0xda, 0x21, 0x9f, 0x53, 0x9b, 0xe1, 0x9d, 0x8e,
+	0xf6, 0x59, 0xd8, 0x4d, 0x78, 0x9f, 0x69, 0xac, 0xfb, 0x36, 0x03, 0x87, 0x61, 0xa0, 0xdc, 0x58,
+	0x0f, 0xee, 0x05, 0xa0, 0xcc, 0xba, 0x2d, 0x48, 0x42, 0x19, 0xf6, 0x04, 0x28, 0x99, 0xac, 0x0b,
+	0x90, 0x76, 0x48, 0xd9, 0x47, 0xf7, 0xfe, 0x76, 0x92, 0xda, 0x83, 0x10, 0x41, 0x53, 0xf6, 0x2c,
+	0x42, 0x29, 0x30, 0x47, 0xb7, 0x37, 0x01, 0xc2, 0xeb, 0xf6, 0x3e, 0xc3, 0x33, 0x03, 0x13, 0xe3,
+	0x61, 0x00, 0x47, 0x0c, 0x5b, 0x63, 0xf1, 0xe4, 0x48, 0xdb, 0x72, 0x74, 0x51, 0x5f, 0x4f, 0xc3,
+	0x01, 0x3f, 0xbf, 0x33, 0xd6, 0x5f, 0x57, 0x6f, 0xbb, 0xa1, 0xb7, 0x6c, 0x95, 0x99, 0x83, 0xa4,
+	0x85, 0x6e, 0xad, 0x09, 0xe1, 0x4d, 0x7b, 0x57, 0xd1, 0x3c, 0xfd, 0x8e, 0x46, 0x2d, 0x0f, 0x23,
+	0x84, 0xed, 0xc7, 0xaa, 0x55, 0x17, 0xaa, 0xfd, 0x90, 0xd2, 0xaa, 0x55, 0xaf, 0x79, 0xf7, 0x93,
+	0xf2, 0x82, 0x24, 0x7c, 0x1e, 0x90, 0xb7, 0x3f, 0x93, 0xed, 0x22, 0xf4, 0x92, 0x0e, 0xcc, 0xde,
+	0xee, 0x6b, 0x27, 0x16, 0xa1, 0x26, 0x32, 0x51, 0x3a, 0xe1, 0xab, 0x1c, 0xec, 0x77, 0xc7, 0xbd,
+	0x5d, 0x1b, 0x32, 0xe1, 0x47, 0x1c, 0xf0, 0x61, 0x6c, 0x30, 0x31, 0xa7, 0xa1, 0x8f, 0xb0, 0x6b,
+	0xab, 0x2f, 0x86, 0x9c, 0x8c, 0xb0, 0x7b, 0x8a, 0x5b, 0x0f, 0x02, 0xb6, 0x7d, 0xb6, 0xde, 0x8c,
+	0x92, 0xcf, 0xd0, 0x77, 0x12, 0x4a, 0x4f, 0x07, 0x38, 0x7d, 0x50, 0x53, 0x24, 0xdf, 0xbe, 0x73,
+	0x85, 0x54, 0xd8, 0xfb, 0x4e, 0x5a, 0xea, 0x1a, 0x50, 0xaf, 0x71, 0x70, 0x67, 0xe8, 0xf4, 0x3b,
+	0x10, 0xa9, 0x73, 0x90, 0x23, 0xac, 0xce, 0x35, 0xcc, 0xca, 0xca, 0x74, 0x83, 0xc4, 0xf4, 0x9e,
+	0x00, 0x53, 0xa4, 0x35, 0xae, 0x47, 0x48, 0xb3, 0x9a, 0x05, 0x49, 0x58, 0x86, 0xfd, 0x21, 0xa4,
+	0x4c, 0xc6, 0x87, 0xa0, 0x9f, 0xf5, 0x64, 0xce, 0xe1, 0x81, 0x76, 0x42, 0x7a, 0x87, 0x21, 0xb2,
+	0xda, 0x03, 0x08, 0x95, 0x90, 0x89, 0xba, 0xfe, 0xe1, 0xfb, 0x99, 0x6d, 0xdd, 0x81, 0x59, 0x9c,
+	0x6c, 0x5e, 0x8a, 0xb1, 0x63, 0x6b, 0x2d, 0xbe, 0x40, 0xce, 0x08, 0xdd, 0x53, 0xdf, 0x14, 0xfb,
+	0xfa, 0x2d, 0x62, 0x51, 0xc1, 0xd2, 0x8c, 0x2c, 0xc5, 0x53, 0xa1, 0x06, 0x07, 0x5b, 0x90, 0x3b,
+	0x61, 0x5a, 0x40, 0x8d, 0x27, 0xdb, 0x87, 0x68, 0xfe, 0xa1, 0xfc, 0xaa, 0x5c, 0x6e, 0x31, 0x61,
+	0xd7, 0xd5, 0xf9, 0xb6, 0x1b, 0x34, 0x37, 0xcd, 0xc4, 0x64, 0xbb, 0xda, 0xa4, 0xd2, 0xce, 0x84,
+	0xfb, 0x2f, 0xa8, 0xf5, 0xa6, 0x93, 0xec, 0x65, 0xf3, 0x19, 0xd1, 0x14, 0xda, 0x35, 0x17, 0xf6,
+	0xaa, 0x9b, 0xe2, 0x75, 0x39, 0x70, 0xdc, 0x57, 0x4f, 0x59, 0x96, 0x62, 0xc4, 0xec, 0x6c, 0x04,
+	0x02, 0x16, 0x21, 0xed, 0x1e, 0x50, 0xd3, 0x8c, 0xcd, 0x79, 0x4d, 0x5f, 0x13, 0x75, 0x69, 0x51,
+	0x54, 0x6c, 0x71, 0xd0, 0x3d, 0x30, 0x54, 0xa5, 0xb5, 0x25, 0x43, 0x54, 0xb0, 0x0b, 0x57, 0xb6,
+	0xea, 0x76, 0x5e, 0x90, 0x04, 0x15, 0x72, 0xcd, 0x43, 0x30, 0x51, 0x8b, 0x30, 0xe0, 0x1d, 0x83,
+	0xd9, 0x63, 0xa1, 0x9d, 0xc8, 0x9e, 0xa1, 0x88, 0xd0, 0x19, 0xcf, 0x8c, 0xc2, 0x37, 0x38, 0x38,
+	0x12, 0x9c, 0xf0, 0x76, 0x6d, 0xee, 0x85, 0x77, 0x39, 0x10, 0xb6, 0x62, 0x86, 0xe1, 0x70, 0x0d,
+	0xb2, 0x5e, 0x1c, 0x6c, 0xdd, 0xc7, 0x06, 0x62, 0xc0, 0x03, 0x44, 0x17, 0xad, 0xe0, 0x11, 0xf6,
+	0xbd, 0x65, 0xd3, 0xcd, 0x6a, 0xaa, 0xa9, 0x8b, 0x2e, 0x96, 0x79, 0xd8, 0x65, 0x73, 0x5f, 0x61,
+	0x4d, 0xae, 0x35, 0x8c, 0x54, 0xfd, 0x44, 0x0b, 0x92, 0xf0, 0x65, 0x38, 0x10, 0x3e, 0x1c, 0x43,
+	0xe3, 0x8b, 0x30, 0x1c, 0x1c, 0x8f, 0x59, 0xc6, 0x89, 0x88, 0x80, 0x38, 0x93, 0x59, 0xa0, 0x0c,
+	0x05, 0x38, 0x10, 0xbe, 0x1b, 0x50, 0x8a, 0xdd, 0x60, 0x25, 0xa5, 0xe3, 0x1b, 0x78, 0xd7, 0x6c,
+	0xe5, 0x43, 0x3b, 0xd1, 0xdc, 0x8a, 0x2d, 0x06, 0xcf, 0x97, 0x60, 0x24, 0x08, 0x8f, 0x6d, 0x30,
+	0x1d, 0xe1, 0x33, 0x1c, 0xc0, 0xa7, 0x8b, 0x86, 0xf3, 0x6c, 0x6b, 0x91, 0xb6, 0x31, 0xb0, 0xfe,
+	0xc8, 0xce, 0x72, 0xb7, 0xe4, 0xe2, 0x7f, 0x0f, 0x59, 0x3b, 0x66, 0x9b, 0x56, 0x14, 0x6d, 0x0d,
+	0x4b, 0x64, 0xbf, 0xd6, 0xf5, 0x8f, 0xfc, 0x5b, 0x76, 0xcc, 0x16, 0x98, 0x85, 0xc1, 0xb5, 0x08,
+	0x83, 0x22, 0x6d, 0xa0, 0x3b, 0xc8, 0xc8, 0xdb, 0x6f, 0xef, 0x70, 0xc5, 0xac, 0xe8, 0x1d, 0xbc,
+	0x7b, 0x08, 0x9d, 0x62, 0xdf, 0x78, 0x9a, 0x18, 0x9b, 0xc7, 0xd8, 0x88, 0x92, 0xcd, 0x13, 0xfe,
+	0x6a, 0x7f, 0x99, 0xbd, 0x74, 0x4c, 0xe0, 0xad, 0x08, 0xd1, 0xfd, 0x80, 0xa8, 0xb4, 0x25, 0x73,
+	0xbd, 0x8e, 0x4b, 0x62, 0xb9, 0xac, 0xe3, 0x55, 0x22, 0x40, 0xba, 0x38, 0x4c, 0x5b, 0xae, 0xad,
+	0xd7, 0xf1, 0x34, 0xa9, 0xb7, 0xce, 0xbe, 0x6b, 0xe2, 0x75, 0xac, 0x97, 0xaa, 0x18, 0x97, 0xca,
+	0x75, 0x83, 0xa4, 0xfa, 0xb2, 0xc5, 0x0c, 0xa9, 0x9c, 0xc7, 0x78, 0xa6, 0x6e, 0x58, 0x7d, 0x4c,
+	0x5f, 0x9f, 0x1e, 0xda, 0xc7, 0xf4, 0xf4, 0x39, 0x03, 0xe9, 0x8a, 0xa6, 0x28, 0xb8, 0x62, 0x62,
+	0x89, 0x25, 0x6f, 0xf7, 0xfb, 0xd0, 0xb2, 0x71, 0x9a, 0xd5, 0x64, 0xb5, 0xe8, 0xf6, 0x15, 0x0e,
+	0x30, 0xd5, 0xce, 0x63, 0x3c, 0x87, 0x0d, 0x93, 0xa1, 0xc6, 0x20, 0x12, 0x5e, 0xb4, 0xb7, 0x58,
+	0xc1, 0x66, 0xe7, 0x83, 0x95, 0x91, 0xdc, 0x6a, 0x82, 0xc5, 0xe0, 0xf1, 0xe3, 0x6d, 0xd7, 0x88,
+	0x6f, 0x30, 0x0b, 0x8d, 0xa2, 0x77, 0x18, 0x94, 0x83, 0x7e, 0x51, 0x92, 0x74, 0x6c, 0x18, 0x0c,
+	0x37, 0xbb, 0xe8, 0x26, 0x32, 0xae, 0xe9, 0xa2, 0x84, 0x6f, 0x7f, 0x22, 0x23, 0xc0, 0x86, 0xbb,
+	0xf1, 0x34, 0x49, 0x43, 0xd4, 0x8d, 0x27, 0x19, 0x86, 0x6e, 0x3c, 0x29, 0x61, 0xf7, 0xcc, 0xff,
+	0x99, 0x20, 0xab, 0xdb, 0x9f, 0xd1, 0x76, 0x37, 0xea, 0x41, 0x1e, 0x76, 0x20, 0x5e, 0x37, 0x03,
+	0xac, 0x4e, 0x53, 0xcb, 0xb3, 0xf1, 0xf2, 0x98, 0x26, 0xe7, 0x33, 0xcd, 0xae, 0x81, 0xf5, 0x63,
+	0x0e, 0x0e, 0x84, 0x73, 0xb0, 0x03, 0xd1, 0x7a, 0xc2, 0xe7, 0x24, 0x17, 0x4d, 0xd1, 0x8c, 0xe4,
+	0x5d, 0xd1, 0xdd, 0x30, 0xb8, 0x26, 0xab, 0x92, 0xb6, 0x56, 0x32, 0x70, 0x45, 0x53, 0x25, 0xba,
+	0xd0, 0x7b, 0x8a, 0x59, 0x5a, 0xbb, 0x48, 0x2b, 0x85, 0x37, 0x93, 0x90, 0x6b, 0x1e, 0xbf, 0xfb,
+	0x5e, 0xf8, 0x20, 0x40, 0x59, 0x54, 0xaf, 0x33, 0xbf, 0x91, 0xa4, 0xbb, 0x00, 0xab, 0x86, 0xba,
+	0x8d, 0x83, 0x00, 0x8a, 0x68, 0x98, 0xd6, 0x71, 0x4c, 0x05, 0xb3, 0x43, 0xda, 0xb4, 0x55, 0x73,
+	0xd5, 0xaa, 0x40, 0x33, 0x30, 0x44, 0x9a, 0x09, 0xb8, 0x25, 0x53, 0xae, 0x45, 0x39, 0x3e, 0xcb,
+	0x5a, 0x24, 0x44, 0x33, 0x56, 0x9d, 0xe5, 0xba, 0x28, 0x79, 0x45, 0x6b, 0xa8, 0x26, 0x39, 0x88,
+	0xed, 0x29, 0x02, 0xa9, 0x9a, 0xb5, 0x6a, 0xac, 0x64, 0xda, 0xaa, 0xa6, 0x34, 0x6a, 0x38, 0xd7,
+	0x4f, 0x93, 0x69, 0xb4, 0x84, 0x78, 0x48, 0xa9, 0x9a, 0xa5, 0x0b, 0x51, 0xc9, 0xa5, 0x48, 0x8b,
+	0x53, 0x46, 0x08, 0x7a, 0x56, 0xd7, 0xc4, 0x7a, 0x2e, 0x4d, 0xea, 0xc9, 0x7f, 0xab, 0x6e, 0x45,
+	0x5e, 0x5e, 0xc9, 0x01, 0xad, 0xb3, 0xfe, 0xa3, 0x61, 0x48, 0x2a, 0xda, 0x5a, 0x2e, 0x43, 0xaa,
+	0xac, 0xbf, 0x68, 0x0a, 0x06, 0x6c, 0xfd, 0x98, 0xa2, 0x6e, 0xe6, 0x06, 0xda, 0xca, 0x93, 0x61,
+	0x9a, 0xb3, 0xba, 0x0b, 0xcf, 0xf7, 0x40, 0xda, 0xb1, 0x3a, 0x34, 0x08, 0x09, 0x47, 0x43, 0x09,
+	0x59, 0x72, 0xa3, 0xbc, 0x84, 0x37, 0xca, 0x73, 0x6f, 0xa9, 0x24, 0x7d, 0xb7, 0x54, 0x02, 0x4e,
+	0xbd, 0xa7, 0xc9, 0xa9, 0xfb, 0xec, 0xa0, 0x37, 0x60, 0x07, 0x4d, 0x77, 0xcb, 0xfa, 0x9a, 0xee,
+	0x96, 0x35, 0x1d, 0x39, 0xf5, 0x07, 0x8f, 0x9c, 0x2c, 0x90, 0x9f, 0x6a, 0x88, 0xaa, 0x29, 0x9b,
+	0xeb, 0x36, 0xc8, 0x76, 0x39, 0x60, 0x3b, 0xe9, 0x10, 0xdb, 0x69, 0xa8, 0xb2, 0x6d, 0x3b, 0x14,
+	0xf5, 0xb4, 0x55, 0x43, 0x6d, 0xe7, 0x2c, 0xa4, 0x9d, 0xfb, 0x79, 0xb9, 0x4c, 0x5b, 0x94, 0xdd,
+	0xce, 0xbe, 0x53, 0x84, 0x01, 0xdf, 0x29, 0x02, 0x3a, 0x0a, 0xc3, 0x92, 0x95, 0x14, 0x2b, 0x79,
+	0x52, 0x18, 0x59, 0xd2, 0x65, 0x50, 0xf2, 0x26, 0xcb, 0x24, 0x34, 0x0e, 0x7b, 0x0c, 0x92, 0x37,
+	0x28, 0x95, 0x65, 0xc9, 0xdb, 0x7d, 0x90, 0x74, 0x47, 0x46, 0x30, 0x11, 0x23, 0xb5, 0xda, 0xe5,
+	0x0d, 0xb5, 0xda, 0xe5, 0xfd, 0x22, 0x09, 0x59, 0xdf, 0x79, 0x62, 0x93, 0x3d, 0xb8, 0x9a, 0x4f,
+	0x6c, 0xa5, 0xf9, 0x64, 0x93, 0xe6, 0xbd, 0x6a, 0xe9, 0x09, 0xa8, 0xe5, 0x4e, 0x48, 0x8b, 0x86,
+	0xad, 0x95, 0x5e, 0xda, 0x28, 0x1a, 0xae, 0x52, 0xac, 0x46, 0xb1, 0xe6, 0x2c, 0xb6, 0x74, 0xd1,
+	0xea, 0x3e, 0x4d, 0x2a, 0x2c, 0x11, 0x25, 0xd9, 0x10, 0xcb, 0x0a, 0x2e, 0x89, 0x0d, 0x53, 0x2b,
+	0xe9, 0xd8, 0x94, 0x75, 0xba, 0xf0, 0x52, 0xc5, 0x11, 0xd6, 0x34, 0xdd, 0x30, 0xb5, 0x22, 0x69,
+	0x40, 0x13, 0x00, 0xe4, 0x02, 0x0f, 0x75, 0xa7, 0xe9, 0xb6, 0x5a, 0xf4, 0xf4, 0xb6, 0x3c, 0xa1,
+	0x1d, 0x3c, 0x93, 0x75, 0x60, 0xe4, 0xe0, 0x70, 0xf2, 0x68, 0xda, 0x09, 0x87, 0xc9, 0xce, 0xc4,
+	0xb0, 0xfc, 0x19, 0x69, 0x2e, 0x2d, 0xeb, 0x5a, 0xa3, 0x5e, 0xaa, 0x6b, 0x8a, 0x5c, 0x59, 0x67,
+	0x2b, 0x76, 0x98, 0xb4, 0x5c, 0xb1, 0x1a, 0xae, 0x92, 0x7a, 0x74, 0x0c, 0xac, 0xa3, 0xfe, 0x52,
+	0x55, 0x56, 0x94, 0x92, 0x83, 0xd0, 0x00, 0xe9, 0x3c, 0x54, 0x93, 0xd5, 0x79, 0x59, 0x51, 0x1e,
+	0xb7, 0x81, 0x1a, 0x85, 0x8c, 0x48, 0x16, 0x48, 0x49, 0xd5, 0x54, 0x4c, 0xec, 0x24, 0x55, 0x4c,
+	0x8b, 0x96, 0xc2, 0x1e, 0xd5, 0x54, 0x2c, 0x7c, 0x3f, 0x09, 0x03, 0xde, 0x73, 0xb2, 0x88, 0xcb,
+	0xf9, 0x0a, 0xf4, 0x55, 0x65, 0xc5, 0x64, 0xcb, 0x39, 0x42, 0x0e, 0xc3, 0x9e, 0x23, 0x3f, 0x4f,
+	0xc8, 0x8a, 0x8c, 0xbc, 0x9d, 0x92, 0x2d, 0xbb, 0xf5, 0x29, 0xb9, 0x2c, 0x4b, 0x8e, 0x92, 0xad,
+	0x46, 0xbf, 0x92, 0xcb, 0xb2, 0xd4, 0xa1, 0x92, 0xcf, 0xc0, 0x3e, 0xda, 0xa5, 0x86, 0xd5, 0xc0,
+	0xb5, 0x1d, 0xea, 0x12, 0xf6, 0xba, 0xcd, 0xbe, 0x9b, 0x3b, 0xb7, 0x62, 0x1d, 0x4d, 0xa1, 0x3f,
+	0x34, 0x85, 0xfe, 0xc2, 0x37, 0x93, 0x90, 0x76, 0x4e, 0x2e, 0x22, 0x6a, 0xa7, 0xed, 0xd2, 0x72,
+	0xcf, 0x6e, 0x7a, 0x7c, 0x67, 0x37, 0x5e, 0x6d, 0xf4, 0x6e, 0xa5, 0x8d, 0xbe, 0x2d, 0xb5, 0xd1,
+	0x1f, 0x51, 0x1b, 0xa9, 0x0e, 0xb4, 0x91, 0x8e, 0xa1, 0x0d, 0x88, 0xab, 0x0d, 0xff, 0x66, 0x2d,
+	0xd3, 0xb4, 0x59, 0x13, 0x5e, 0x49, 0xc2, 0x70, 0xf0, 0x44, 0x62, 0x07, 0x78, 0xbc, 0x23, 0x30,
+	0x40, 0x6f, 0xf7, 0xf8, 0x96, 0x43, 0x86, 0xd4, 0x31, 0x15, 0x1c, 0x81, 0x81, 0xaa, 0xa2, 0x69,
+	0xba, 0x5f, 0x47, 0x19, 0x52, 0xc7, 0xba, 0xdc, 0x0d, 0x83, 0x95, 0x86, 0xae, 0x5b, 0x90, 0xb3,
+	0x4e, 0xd4, 0xf4, 0xb3, 0xac, 0x96, 0x75, 0x3b, 0x07, 0x40, 0x27, 0x23, 0xb1, 0x50, 0x7b, 0x8b,
+	0x4f, 0x93, 0xde, 0x56, 0x19, 0x9d, 0x82, 0x14, 0x56, 0x25, 0x4a, 0xd8, 0x5e, 0x39, 0xfd, 0x58,
+	0x95, 0x08, 0x59, 0x0b, 0xf3, 0xc9, 0xb4, 0x30, 0x1f, 0xe1, 0xdb, 0x49, 0xd8, 0x1d, 0x76, 0xc8,
+	0xb0, 0x6d, 0x9a, 0x6a, 0xed, 0xb6, 0xee, 0x86, 0x41, 0x1d, 0x1b, 0x58, 0x5f, 0xc5, 0x7e, 0x5d,
+	0x65, 0x59, 0x2d, 0xc3, 0xf8, 0x18, 0x8c, 0x54, 0xb4, 0x5a, 0x4d, 0x36, 0x4b, 0x16, 0x5e, 0x2b,
+	0x58, 0x5e, 0x5e, 0xa1, 0x2a, 0x4b, 0x16, 0x87, 0x68, 0xc3, 0x65, 0x55, 0x7a, 0x90, 0x54, 0x5b,
+	0x7d, 0x75, 0xbc, 0x8a, 0x45, 0xc5, 0xdb, 0x37, 0x45, 0xfb, 0xd2, 0x06, 0xb7, 0x6f, 0x0b, 0x24,
+	0xd3, 0xad, 0x16, 0x62, 0xd3, 0x9a, 0x80, 0x08, 0x09, 0x8c, 0x4c, 0xb3, 0x17, 0x7b, 0x8f, 0x83,
+	0xac, 0xa3, 0x11, 0xa2, 0x8a, 0x36, 0x47, 0x30, 0x7b, 0xa1, 0xaf, 0x2c, 0x4b, 0x92, 0xab, 0x19,
+	0x5a, 0xb2, 0xf0, 0x93, 0x70, 0x5d, 0x33, 0x64, 0xc7, 0x46, 0xa9, 0x72, 0xb2, 0xac, 0x96, 0xe1,
+	0xc7, 0x43, 0x8a, 0x8a, 0x8e, 0x25, 0xa2, 0x9f, 0x54, 0xd1, 0x29, 0x6f, 0xe9, 0xe4, 0xb6, 0xfe,
+	0xaa, 0x08, 0x3f, 0x4c, 0xc2, 0x50, 0x20, 0x1b, 0x1f, 0xd9, 0xa6, 0xfc, 0x67, 0x11, 0xc9, 0xe0,
+	0x59, 0xc4, 0x45, 0xc8, 0xae, 0xca, 0xaa, 0x29, 0x2e, 0x63, 0x16, 0x94, 0xb7, 0xbf, 0xf0, 0x37,
+	0xc0, 0x08, 0x48, 0x54, 0x8e, 0x26, 0x21, 0x63, 0x0f, 0x80, 0x55, 0x29, 0xc2, 0x1e, 0x05, 0x58,
+	0xf7, 0xcb, 0xaa, 0x1f, 0x93, 0xbe, 0xad, 0x3c, 0x4f, 0xff, 0x96, 0xb1, 0x56, 0x2a, 0x18, 0x6b,
+	0x5d, 0x81, 0x11, 0x09, 0x2b, 0xf2, 0x2a, 0xd6, 0xd7, 0x4b, 0x12, 0x16, 0x25, 0x45, 0x56, 0xa3,
+	0xb8, 0x8c, 0x61, 0x9b, 0x68, 0x8e, 0xd1, 0xb4, 0x32, 0x5c, 0x68, 0xe5, 0x02, 0xbe, 0x97, 0x84,
+	0x5d, 0x21, 0xc9, 0xda, 0x26, 0x6d, 0x85, 0xa4, 0xff, 0x13, 0x61, 0xe9, 0x7f, 0xe7, 0x43, 0x9b,
+	0xf4, 0x7e, 0x68, 0xfd, 0x3a, 0xed, 0x09, 0xea, 0x74, 0x2b, 0x4b, 0x3b, 0x02, 0x03, 0xd8, 0xa8,
+	0xe8, 0xda, 0x9a, 0xef, 0x8b, 0x9a, 0xa1, 0x75, 0x14, 0xdb, 0xbb, 0x20, 0xcb, 0xba, 0xf8, 0x7c,
+	0x36, 0xa3, 0xdb, 0xee, 0x4f, 0x6b, 0xb7, 0x5c, 0xc1, 0x13, 0xb0, 0xcb, 0xb3, 0xe9, 0xef, 0x7a,
+	0x36, 0xfb, 0x55, 0x0e, 0x76, 0xfb, 0xc7, 0x67, 0x09, 0x85, 0x39, 0xe8, 0xa7, 0x31, 0xa9, 0x9d,
+	0x59, 0x39, 0xd6, 0x2e, 0x66, 0xa5, 0x23, 0xd0, 0xa3, 0x77, 0x46, 0xda, 0xbd, 0xdc, 0xca, 0x61,
+	0x76, 0xb2, 0x6e, 0x27, 0xcb, 0x96, 0x65, 0xc3, 0xd4, 0x7d, 0xd9, 0xd9, 0x3a, 0x1c, 0x6a, 0xd9,
+	0x83, 0xc9, 0x94, 0x83, 0x7e, 0xac, 0x5a, 0x0a, 0xa6, 0x26, 0x9d, 0x2a, 0xda, 0x45, 0x74, 0x02,
+	0xfa, 0x99, 0x47, 0xcc, 0x25, 0xda, 0xe5, 0x8b, 0xed, 0x9e, 0xc2, 0xdf, 0x38, 0x00, 0x57, 0xe8,
+	0xa6, 0xb5, 0xd2, 0xd5, 0xac, 0xcb, 0x5d, 0x90, 0x95, 0x64, 0xa3, 0xae, 0x88, 0xeb, 0xbe, 0xad,
+	0xff, 0x00, 0xab, 0x74, 0x3e, 0xb3, 0xf8, 0x46, 0x5d, 0x53, 0xb1, 0x6a, 0x92, 0x05, 0x94, 0x2d,
+	0x3a, 0x65, 0x34, 0x0a, 0xa0, 0x33, 0x4c, 0x1c, 0x57, 0xed, 0xa9, 0xb1, 0x68, 0x2d, 0x2f, 0x62,
+	0x58, 0x29, 0x73, 0x1a, 0xf6, 0x3b, 0xe5, 0xe3, 0x9f, 0x4d, 0x42, 0x2f, 0xc1, 0x16, 0xfd, 0x9b,
+	0x83, 0xb4, 0xb3, 0x7f, 0x45, 0xa7, 0xda, 0x99, 0x44, 0xe8, 0x13, 0x37, 0xfe, 0x74, 0x5c, 0x32,
+	0xaa, 0x3e, 0xe1, 0x19, 0xee, 0x2b, 0x7f, 0xf8, 0xcb, 0x77, 0x12, 0x1b, 0x4b, 0x53, 0x68, 0xb2,
+	0xd0, 0xee, 0xdd, 0x1e, 0x56, 0x94, 0x31, 0x7a, 0xff, 0xb1, 0xb0, 0xe1, 0x4b, 0x7a, 0x6c, 0xa2,
+	0x89, 0xe8, 0xc4, 0x4d, 0xb4, 0x6f, 0x70, 0x00, 0x8b, 0xee, 0x0d, 0xe0, 0x98, 0xa2, 0xd8, 0xcb,
+	0x97, 0x3f, 0x13, 0x9b, 0x8e, 0x61, 0x70, 0x82, 0x40, 0x30, 0x86, 0xfe, 0x3f, 0x06, 0x00, 0xe8,
+	0x95, 0x04, 0x8c, 0x34, 0xbd, 0xe6, 0x42, 0x53, 0x31, 0x79, 0xf0, 0x9f, 0x2f, 0xf0, 0x17, 0x3a,
+	0x25, 0x67, 0x92, 0x7c, 0x8b, 0x6a, 0xf3, 0x6b, 0xdc, 0xd2, 0x34, 0xba, 0x18, 0x47, 0x9d, 0x24,
+	0xae, 0x2c, 0x6c, 0x78, 0x22, 0xce, 0x4d, 0x34, 0x13, 0x63, 0x80, 0xb1, 0xf2, 0xfa, 0x58, 0xd8,
+	0x18, 0x2f, 0x27, 0x00, 0x35, 0xbf, 0xc5, 0x42, 0xf1, 0xe5, 0xf4, 0x3d, 0x38, 0xe3, 0x2f, 0x76,
+	0x4c, 0xcf, 0x80, 0x7a, 0x8e, 0x02, 0x75, 0x33, 0xae, 0xd9, 0x1b, 0x64, 0x18, 0x6a, 0xc1, 0x58,
+	0xdf, 0x44, 0x17, 0x62, 0x62, 0x14, 0xa4, 0xff, 0x3b, 0x07, 0xfb, 0x5a, 0x3c, 0x89, 0x42, 0xb3,
+	0x31, 0x85, 0x0c, 0x7b, 0x2c, 0xc6, 0xcf, 0xdd, 0xda, 0x20, 0x0c, 0xae, 0x19, 0x82, 0xd6, 0xf9,
+	0x38, 0xab, 0xdc, 0x18, 0xab, 0xd3, 0xa1, 0xc6, 0x30, 0x15, 0xe7, 0xe5, 0xc0, 0x82, 0x21, 0x0f,
+	0x78, 0x3a, 0x58, 0x30, 0xde, 0x37, 0x57, 0xfc, 0x85, 0x4e, 0xc9, 0x99, 0x60, 0x5f, 0xa7, 0x76,
+	0xf0, 0x0c, 0xb7, 0x74, 0x01, 0x9d, 0x8f, 0x63, 0x08, 0xe4, 0x65, 0x57, 0x61, 0xc3, 0x7e, 0xf0,
+	0xb5, 0x89, 0x2e, 0xc6, 0xb4, 0x84, 0xa6, 0x01, 0x7e, 0x92, 0x80, 0x5d, 0x21, 0xcf, 0x5a, 0x50,
+	0x7c, 0x5b, 0xf7, 0xdf, 0x40, 0xe2, 0x2f, 0x75, 0x3e, 0x00, 0x43, 0xe9, 0x25, 0x8a, 0xd2, 0xf3,
+	0xdc, 0xd2, 0x0c, 0xba, 0x14, 0x07, 0x25, 0x16, 0x87, 0x16, 0x36, 0xdc, 0x10, 0x75, 0x13, 0xcd,
+	0xc6, 0x44, 0x2a, 0x74, 0x90, 0x1f, 0x04, 0x1c, 0x0b, 0x8d, 0x19, 0x3a, 0x70, 0x2c, 0xbe, 0xd3,
+	0x4a, 0xfe, 0x62, 0xc7, 0xf4, 0x0c, 0xaa, 0x17, 0x28, 0x54, 0xcf, 0x71, 0x4b, 0x97, 0x62, 0x39,
+	0x07, 0xd6, 0x54, 0xd8, 0x70, 0x4e, 0x1a, 0x36, 0xd1, 0x74, 0x4c, 0xa0, 0x42, 0x86, 0x78, 0x8b,
+	0x83, 0x94, 0x9d, 0xf7, 0x44, 0x27, 0x23, 0x09, 0x17, 0x78, 0x2f, 0xc3, 0x9f, 0x8a, 0x49, 0xc5,
+	0x80, 0x98, 0x26, 0x38, 0x4c, 0xa2, 0x73, 0xed, 0x84, 0x28, 0x37, 0xd6, 0x9d, 0xa0, 0xc2, 0x7b,
+	0x4a, 0xb2, 0x89, 0x5e, 0xe7, 0x20, 0x3d, 0xe3, 0x3c, 0x99, 0x88, 0xc7, 0x87, 0x11, 0x2f, 0x30,
+	0x6a, 0x7a, 0x24, 0x23, 0x1c, 0x27, 0xfc, 0xdf, 0x8f, 0x8e, 0x45, 0xe7, 0x1f, 0x7d, 0xc4, 0xc1,
+	0x70, 0xf0, 0xfd, 0x08, 0x3a, 0x1f, 0x8f, 0x01, 0xff, 0x85, 0x25, 0x7e, 0xaa, 0x43, 0x6a, 0x26,
+	0xc5, 0x25, 0x22, 0xc5, 0x04, 0x3a, 0x1b, 0x43, 0x0b, 0x64, 0x5f, 0x49, 0x74, 0x61, 0x7d, 0xa1,
+	0x7e, 0xc9, 0x41, 0xd6, 0x77, 0x2b, 0x07, 0x9d, 0x8b, 0xc4, 0x52, 0xd8, 0x7d, 0x21, 0x7e, 0xa2,
+	0x13, 0x52, 0x26, 0xca, 0x69, 0x22, 0xca, 0x03, 0x28, 0xdf, 0x4e, 0x14, 0x76, 0xae, 0x31, 0x46,
+	0xaf, 0x0a, 0xa1, 0x77, 0x9c, 0x1d, 0xc5, 0x3c, 0xc6, 0x51, 0xa3, 0xcb, 0xa6, 0xbb, 0x3c, 0xfc,
+	0x99, 0xd8, 0x74, 0x71, 0x55, 0x40, 0x8b, 0x86, 0x77, 0xfd, 0x16, 0xaa, 0x16, 0xcb, 0x2f, 0x24,
+	0x20, 0xeb, 0xbb, 0x07, 0x12, 0x51, 0x05, 0x61, 0x57, 0x58, 0xf8, 0x89, 0x4e, 0x48, 0x99, 0x28,
+	0xcf, 0x52, 0xe7, 0xb6, 0xb9, 0x14, 0x21, 0x12, 0xa0, 0x17, 0x01, 0x42, 0x03, 0xcb, 0xa9, 0x68,
+	0xb4, 0xad, 0x62, 0xca, 0x17, 0x13, 0x30, 0xe8, 0xbf, 0xe7, 0x81, 0xe2, 0x09, 0xe5, 0x77, 0xf9,
+	0x93, 0x1d, 0xd1, 0x06, 0xb7, 0x4f, 0x11, 0x1c, 0x1d, 0x43, 0x24, 0xc4, 0x4b, 0x9f, 0x8f, 0x0e,
+	0x48, 0x08, 0xf5, 0x8b, 0x09, 0x18, 0x0a, 0x5c, 0xe5, 0x40, 0xf1, 0x84, 0xf2, 0x5f, 0x41, 0xe1,
+	0xcf, 0x77, 0x46, 0xcc, 0x20, 0xb9, 0x49, 0x10, 0x59, 0x5f, 0x8a, 0x60, 0xf1, 0x0c, 0x11, 0x76,
+	0xc1, 0xa5, 0xb0, 0xc1, 0xfe, 0x6c, 0xa2, 0xc9, 0x68, 0x94, 0x16, 0x20, 0xcd, 0xc4, 0xef, 0x72,
+	0x90, 0xf1, 0x5c, 0xe7, 0x40, 0x71, 0x56, 0xae, 0xf7, 0x82, 0x09, 0x7f, 0x36, 0x3e, 0x61, 0xdc,
+	0x8f, 0x5f, 0xd8, 0x9a, 0x37, 0x08, 0xd7, 0xbf, 0xe2, 0x60, 0xd0, 0x7f, 0x8d, 0x2d, 0xa2, 0x95,
+	0x87, 0xde, 0xb3, 0xe3, 0x27, 0x3b, 0xa2, 0x65, 0xe2, 0x9c, 0x21, 0xe2, 0x8c, 0xa3, 0x42, 0x3b,
+	0x71, 0xaa, 0x18, 0x8f, 0x79, 0xef, 0xd9, 0xbd, 0xc6, 0x41, 0x2f, 0x39, 0x39, 0x44, 0xe3, 0x91,
+	0xe6, 0xf7, 0xbe, 0x62, 0xe4, 0x8f, 0xc7, 0x21, 0x61, 0x9c, 0x9e, 0x23, 0x9c, 0x9e, 0x40, 0xe3,
+	0xed, 0x38, 0xa5, 0xcf, 0xaf, 0x0a, 0x1b, 0xf6, 0x0d, 0x87, 0x4d, 0xf4, 0x6b, 0x0e, 0xb2, 0xbe,
+	0x67, 0x83, 0x11, 0xbd, 0x6c, 0xd8, 0x8b, 0x47, 0x7e, 0xa2, 0x13, 0xd2, 0xb8, 0x9b, 0x2d, 0x26,
+	0x43, 0x98, 0x8f, 0x7c, 0xcf, 0x2b, 0x0c, 0x09, 0x43, 0x62, 0x0a, 0xe3, 0x8d, 0x41, 0x26, 0x3a,
+	0x21, 0x65, 0xc2, 0x9c, 0x27, 0xc2, 0x9c, 0x46, 0x27, 0xa3, 0x0a, 0xe3, 0x0b, 0x3e, 0x3e, 0xe0,
+	0x60, 0xd0, 0xff, 0xf6, 0x0e, 0xc5, 0x63, 0xc6, 0xf7, 0x5e, 0x90, 0x9f, 0xec, 0x88, 0x96, 0x49,
+	0x72, 0x81, 0x48, 0x72, 0x16, 0x9d, 0x8e, 0x28, 0x09, 0x3d, 0xcf, 0x2e, 0x6c, 0xd0, 0xdf, 0x4d,
+	0xf4, 0x3e, 0x07, 0x03, 0xde, 0x73, 0x5b, 0x14, 0xcd, 0xbd, 0x84, 0xbc, 0xe7, 0xe3, 0xcf, 0x75,
+	0x40, 0xc9, 0xa4, 0x98, 0x25, 0x52, 0x44, 0xc8, 0x7a, 0x90, 0x7b, 0x3c, 0x63, 0xf6, 0x8b, 0xa8,
+	0xc2, 0x86, 0x7b, 0x52, 0x46, 0x63, 0x42, 0xef, 0xe8, 0x51, 0x63, 0xc2, 0xb0, 0x77, 0x7f, 0xfc,
+	0x44, 0x27, 0xa4, 0x71, 0x63, 0x42, 0xbf, 0x34, 0xe8, 0x63, 0x0e, 0x86, 0x83, 0xa7, 0xb3, 0x11,
+	0x03, 0xf5, 0x16, 0x0f, 0xf4, 0xf8, 0xa9, 0x0e, 0xa9, 0x99, 0x24, 0x0f, 0x12, 0x49, 0x22, 0x6d,
+	0xaf, 0xad, 0x11, 0xc6, 0xca, 0xb2, 0xd4, 0x42, 0x39, 0xbf, 0xe3, 0xac, 0x3c, 0x8b, 0x7f, 0x9a,
+	0xe8, 0x79, 0x96, 0xf0, 0xd7, 0x7c, 0xfc, 0x85, 0x4e, 0xc9, 0x99, 0x78, 0x93, 0x44, 0xbc, 0x53,
+	0xe8, 0x44, 0x07, 0xe2, 0xa1, 0xdf, 0x92, 0xfc, 0x30, 0x1b, 0x3a, 0x7a, 0x7e, 0x38, 0xf0, 0xe2,
+	0x8e, 0x3f, 0x13, 0x9b, 0x8e, 0x31, 0xff, 0x08, 0x61, 0xfe, 0x0a, 0xba, 0x7c, 0xab, 0xba, 0x29,
+	0x90, 0x37, 0x73, 0x1f, 0x70, 0x90, 0xf1, 0x9c, 0xe0, 0x46, 0x8c, 0x4f, 0x9a, 0x1f, 0xc6, 0xf1,
+	0x67, 0xe3, 0x13, 0x32, 0x89, 0xae, 0x10, 0x89, 0x22, 0xe4, 0x88, 0xd9, 0x09, 0xe4, 0x18, 0x79,
+	0x2c, 0x56, 0xd8, 0x08, 0x9c, 0x53, 0x6e, 0xa2, 0x7f, 0x70, 0xb0, 0x27, 0xf4, 0xc5, 0x19, 0x9a,
+	0x8e, 0xcb, 0x5c, 0x73, 0xe2, 0x6a, 0xe6, 0x56, 0x86, 0x60, 0x92, 0x3e, 0x44, 0x24, 0x9d, 0x6b,
+	0x9f, 0xcc, 0xf6, 0x4b, 0x1a, 0x9a, 0x73, 0xfa, 0x13, 0x07, 0x43, 0x81, 0x03, 0xdd, 0x88, 0x81,
+	0x76, 0xf8, 0x7b, 0x36, 0xfe, 0x7c, 0x67, 0xc4, 0x4c, 0xb4, 0xc7, 0x88, 0x68, 0x0b, 0xe8, 0x4a,
+	0x54, 0xd1, 0x9c, 0xa7, 0x46, 0xae, 0x22, 0x3d, 0x17, 0x2c, 0x37, 0xd1, 0x67, 0x1c, 0xec, 0x0d,
+	0x7f, 0x12, 0x86, 0x66, 0x3a, 0xe1, 0xd4, 0xff, 0xcc, 0x8d, 0x9f, 0xbd, 0xa5, 0x31, 0x98, 0xd0,
+	0x8f, 0x13, 0xa1, 0x1f, 0x46, 0x0b, 0xb7, 0x68, 0xb9, 0x05, 0x07, 0x0c, 0xf4, 0x4f, 0x0e, 0xf6,
+	0xb5, 0x78, 0xb0, 0x85, 0x3a, 0xe5, 0xd9, 0x17, 0x3f, 0xcd, 0xdd, 0xda, 0x20, 0x9d, 0xae, 0x59,
+	0x57, 0xdd, 0xfe, 0xa0, 0xea, 0x55, 0x0e, 0xfa, 0xd9, 0xc9, 0x34, 0x3a, 0x11, 0x63, 0x8b, 0xe3,
+	0x38, 0xd2, 0x93, 0xf1, 0x88, 0x18, 0xff, 0x05, 0xc2, 0xff, 0x7d, 0xe8, 0xde, 0x88, 0x7b, 0x22,
+	0xf4, 0x7b, 0x0e, 0x50, 0xf3, 0xc1, 0x73, 0xc4, 0x14, 0x6f, 0xcb, 0x33, 0x6d, 0xfe, 0x62, 0xc7,
+	0xf4, 0x71, 0xbf, 0x65, 0xb4, 0x38, 0xa6, 0x7b, 0x06, 0x99, 0xf9, 0xc2, 0xfb, 0x9f, 0x8c, 0x72,
+	0x1f, 0x7e, 0x32, 0xca, 0xfd, 0xf9, 0x93, 0x51, 0xee, 0xa5, 0x4f, 0x47, 0xef, 0xf8, 0xf0, 0xd3,
+	0xd1, 0x3b, 0xfe, 0xf8, 0xe9, 0xe8, 0x1d, 0x4b, 0x53, 0xcb, 0xb2, 0xb9, 0xd2, 0x28, 0xe7, 0x2b,
+	0x5a, 0x8d, 0x0e, 0x3c, 0xa6, 0x62, 0x73, 0x4d, 0xd3, 0xaf, 0xb3, 0x92, 0x82, 0xa5, 0x65, 0xac,
+	0x17, 0x6e, 0x84, 0xcf, 0x57, 0xee, 0x23, 0x77, 0x58, 0x4e, 0xfc, 0x67, 0x00, 0xfb, 0x6e, 0x2e,
+	0x5c, 0x08, 0x56, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// SellOrder queries a sell order by its unique identifier.
+	SellOrder(ctx context.Context, in *QuerySellOrderRequest, opts ...grpc.CallOption) (*QuerySellOrderResponse, error)
+	// SellOrders queries a paginated list of all sell orders.
+	SellOrders(ctx context.Context, in *QuerySellOrdersRequest, opts ...grpc.CallOption) (*QuerySellOrdersResponse, error)
+	// SellOrdersByBatch queries a paginated list of all sell orders based on
+	// the batch denom of the credits being sold.
+	SellOrdersByBatch(ctx context.Context, in *QuerySellOrdersByBatchRequest, opts ...grpc.CallOption) (*QuerySellOrdersByBatchResponse, error)
+	// SellOrdersBySeller queries a paginated list of all sell orders based on the
+	// account address of the seller.
+	SellOrdersBySeller(ctx context.Context, in *QuerySellOrdersBySellerRequest, opts ...grpc.CallOption) (*QuerySellOrdersBySellerResponse, error)
+	// SellOrdersPendingExpiry queries a paginated list of all sell orders with
+	// an expiration sorted by expiration (soonest first). Sell orders that have
+	// expired but have not yet been pruned are included first.
+	SellOrdersPendingExpiry(ctx context.Context, in *QuerySellOrdersPendingExpiryRequest, opts ...grpc.CallOption) (*QuerySellOrdersPendingExpiryResponse, error)
+	// SellOrdersByClass queries a paginated list of all sell orders based on the
+	// credit class of the credits being sold with optional filters for the
+	// jurisdiction of the project and the start date of the credit batch.
+	SellOrdersByClass(ctx context.Context, in *QuerySellOrdersByClassRequest, opts ...grpc.CallOption) (*QuerySellOrdersByClassResponse, error)
+	// SellOrdersByProject queries a paginated list of all sell orders based on
+	// the project of the credits being sold with an optional filter for the
+	// start date of the credit batch.
+	SellOrdersByProject(ctx context.Context, in *QuerySellOrdersByProjectRequest, opts ...grpc.CallOption) (*QuerySellOrdersByProjectResponse, error)
+	// SellOrdersByMarket queries a paginated list of all sell orders within a
+	// market sorted by ask price (lowest ask price first, or highest ask price
+	// first when pagination.reverse is set).
+	SellOrdersByMarket(ctx context.Context, in *QuerySellOrdersByMarketRequest, opts ...grpc.CallOption) (*QuerySellOrdersByMarketResponse, error)
+	// BuyOrder queries a buy order by its unique identifier.
+	BuyOrder(ctx context.Context, in *QueryBuyOrderRequest, opts ...grpc.CallOption) (*QueryBuyOrderResponse, error)
+	// BuyOrders queries a paginated list of all buy orders.
+	BuyOrders(ctx context.Context, in *QueryBuyOrdersRequest, opts ...grpc.CallOption) (*QueryBuyOrdersResponse, error)
+	// BuyOrdersByBuyer queries a paginated list of all buy orders based on the
+	// account address of the buyer.
+	BuyOrdersByBuyer(ctx context.Context, in *QueryBuyOrdersByBuyerRequest, opts ...grpc.CallOption) (*QueryBuyOrdersByBuyerResponse, error)
+	// AllowedDenoms queries a paginated list of all bank denoms allowed to be
+	// used in the marketplace.
+	AllowedDenoms(ctx context.Context, in *QueryAllowedDenomsRequest, opts ...grpc.CallOption) (*QueryAllowedDenomsResponse, error)
+	// MarketFees queries the fees and the total amount of fees collected in a
+	// market.
+	MarketFees(ctx context.Context, in *QueryMarketFeesRequest, opts ...grpc.CallOption) (*QueryMarketFeesResponse, error)
+	// TradesByBatch queries a paginated list of all trades based on the batch
+	// denom of the credits traded.
+	TradesByBatch(ctx context.Context, in *QueryTradesByBatchRequest, opts ...grpc.CallOption) (*QueryTradesByBatchResponse, error)
+	// TradesByMarket queries a paginated list of all trades in a market ordered
+	// by the time of the trade.
+	TradesByMarket(ctx context.Context, in *QueryTradesByMarketRequest, opts ...grpc.CallOption) (*QueryTradesByMarketResponse, error)
+	// TradesByAddress queries a paginated list of all trades in which the
+	// account address was the buyer or the seller.
+	TradesByAddress(ctx context.Context, in *QueryTradesByAddressRequest, opts ...grpc.CallOption) (*QueryTradesByAddressResponse, error)
+	// MarketStats queries price statistics of a market over a window of time.
+	MarketStats(ctx context.Context, in *QueryMarketStatsRequest, opts ...grpc.CallOption) (*QueryMarketStatsResponse, error)
+	// FeeDestination queries the destination of marketplace fees.
+	FeeDestination(ctx context.Context, in *QueryFeeDestinationRequest, opts ...grpc.CallOption) (*QueryFeeDestinationResponse, error)
+	// Offer queries an offer by its unique identifier.
+	Offer(ctx context.Context, in *QueryOfferRequest, opts ...grpc.CallOption) (*QueryOfferResponse, error)
+	// OffersByBatch queries a paginated list of all offers based on the batch
+	// denom of the credits being bought.
+	OffersByBatch(ctx context.Context, in *QueryOffersByBatchRequest, opts ...grpc.CallOption) (*QueryOffersByBatchResponse, error)
+	// OffersByBuyer queries a paginated list of all offers based on the account
+	// address of the buyer.
+	OffersByBuyer(ctx context.Context, in *QueryOffersByBuyerRequest, opts ...grpc.CallOption) (*QueryOffersByBuyerResponse, error)
+	// OffersByHolder queries a paginated list of all offers made to a specific
+	// credit holder. Offers that any holder can accept are not included.
+	OffersByHolder(ctx context.Context, in *QueryOffersByHolderRequest, opts ...grpc.CallOption) (*QueryOffersByHolderResponse, error)
+	// DutchAuction queries a dutch auction by its unique identifier.
+	DutchAuction(ctx context.Context, in *QueryDutchAuctionRequest, opts ...grpc.CallOption) (*QueryDutchAuctionResponse, error)
+	// DutchAuctions queries a paginated list of all dutch auctions.
+	DutchAuctions(ctx context.Context, in *QueryDutchAuctionsRequest, opts ...grpc.CallOption) (*QueryDutchAuctionsResponse, error)
+	// SealedBidAuction queries a sealed-bid auction by its unique identifier.
+	SealedBidAuction(ctx context.Context, in *QuerySealedBidAuctionRequest, opts ...grpc.CallOption) (*QuerySealedBidAuctionResponse, error)
+	// SealedBidAuctions queries a paginated list of all sealed-bid auctions.
+	SealedBidAuctions(ctx context.Context, in *QuerySealedBidAuctionsRequest, opts ...grpc.CallOption) (*QuerySealedBidAuctionsResponse, error)
+	// SealedBids queries a paginated list of all bids in a sealed-bid auction.
+	SealedBids(ctx context.Context, in *QuerySealedBidsRequest, opts ...grpc.CallOption) (*QuerySealedBidsResponse, error)
+	// ForwardSale queries a forward sale by its unique identifier.
+	ForwardSale(ctx context.Context, in *QueryForwardSaleRequest, opts ...grpc.CallOption) (*QueryForwardSaleResponse, error)
+	// ForwardSalesByProject queries a paginated list of all forward sales of a
+	// project.
+	ForwardSalesByProject(ctx context.Context, in *QueryForwardSalesByProjectRequest, opts ...grpc.CallOption) (*QueryForwardSalesByProjectResponse, error)
+	// ForwardContract queries a forward contract by its unique identifier.
+	ForwardContract(ctx context.Context, in *QueryForwardContractRequest, opts ...grpc.CallOption) (*QueryForwardContractResponse, error)
+	// ForwardContractsBySale queries a paginated list of all forward contracts
+	// of a forward sale in delivery priority order.
+	ForwardContractsBySale(ctx context.Context, in *QueryForwardContractsBySaleRequest, opts ...grpc.CallOption) (*QueryForwardContractsBySaleResponse, error)
+	// ForwardContractsByBuyer queries a paginated list of all forward contracts
+	// bought by a specific buyer.
+	ForwardContractsByBuyer(ctx context.Context, in *QueryForwardContractsByBuyerRequest, opts ...grpc.CallOption) (*QueryForwardContractsByBuyerResponse, error)
+	// Markets queries a paginated list of all markets.
+	Markets(ctx context.Context, in *QueryMarketsRequest, opts ...grpc.CallOption) (*QueryMarketsResponse, error)
+	// MarketRegistration queries whether market registration is permissionless
+	// and the deposit paid to register a market.
+	MarketRegistration(ctx context.Context, in *QueryMarketRegistrationRequest, opts ...grpc.CallOption) (*QueryMarketRegistrationResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) SellOrder(ctx context.Context, in *QuerySellOrderRequest, opts ...grpc.CallOption) (*QuerySellOrderResponse, error) {
+	out := new(QuerySellOrderResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/SellOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SellOrders(ctx context.Context, in *QuerySellOrdersRequest, opts ...grpc.CallOption) (*QuerySellOrdersResponse, error) {
+	out := new(QuerySellOrdersResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/SellOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SellOrdersByBatch(ctx context.Context, in *QuerySellOrdersByBatchRequest, opts ...grpc.CallOption) (*QuerySellOrdersByBatchResponse, error) {
+	out := new(QuerySellOrdersByBatchResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/SellOrdersByBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SellOrdersBySeller(ctx context.Context, in *QuerySellOrdersBySellerRequest, opts ...grpc.CallOption) (*QuerySellOrdersBySellerResponse, error) {
+	out := new(QuerySellOrdersBySellerResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/SellOrdersBySeller", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SellOrdersPendingExpiry(ctx context.Context, in *QuerySellOrdersPendingExpiryRequest, opts ...grpc.CallOption) (*QuerySellOrdersPendingExpiryResponse, error) {
+	out := new(QuerySellOrdersPendingExpiryResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/SellOrdersPendingExpiry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SellOrdersByClass(ctx context.Context, in *QuerySellOrdersByClassRequest, opts ...grpc.CallOption) (*QuerySellOrdersByClassResponse, error) {
+	out := new(QuerySellOrdersByClassResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/SellOrdersByClass", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SellOrdersByProject(ctx context.Context, in *QuerySellOrdersByProjectRequest, opts ...grpc.CallOption) (*QuerySellOrdersByProjectResponse, error) {
+	out := new(QuerySellOrdersByProjectResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/SellOrdersByProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SellOrdersByMarket(ctx context.Context, in *QuerySellOrdersByMarketRequest, opts ...grpc.CallOption) (*QuerySellOrdersByMarketResponse, error) {
+	out := new(QuerySellOrdersByMarketResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/SellOrdersByMarket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BuyOrder(ctx context.Context, in *QueryBuyOrderRequest, opts ...grpc.CallOption) (*QueryBuyOrderResponse, error) {
+	out := new(QueryBuyOrderResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/BuyOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BuyOrders(ctx context.Context, in *QueryBuyOrdersRequest, opts ...grpc.CallOption) (*QueryBuyOrdersResponse, error) {
+	out := new(QueryBuyOrdersResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/BuyOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BuyOrdersByBuyer(ctx context.Context, in *QueryBuyOrdersByBuyerRequest, opts ...grpc.CallOption) (*QueryBuyOrdersByBuyerResponse, error) {
+	out := new(QueryBuyOrdersByBuyerResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/BuyOrdersByBuyer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllowedDenoms(ctx context.Context, in *QueryAllowedDenomsRequest, opts ...grpc.CallOption) (*QueryAllowedDenomsResponse, error) {
+	out := new(QueryAllowedDenomsResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/AllowedDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketFees(ctx context.Context, in *QueryMarketFeesRequest, opts ...grpc.CallOption) (*QueryMarketFeesResponse, error) {
+	out := new(QueryMarketFeesResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/MarketFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TradesByBatch(ctx context.Context, in *QueryTradesByBatchRequest, opts ...grpc.CallOption) (*QueryTradesByBatchResponse, error) {
+	out := new(QueryTradesByBatchResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/TradesByBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TradesByMarket(ctx context.Context, in *QueryTradesByMarketRequest, opts ...grpc.CallOption) (*QueryTradesByMarketResponse, error) {
+	out := new(QueryTradesByMarketResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/TradesByMarket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TradesByAddress(ctx context.Context, in *QueryTradesByAddressRequest, opts ...grpc.CallOption) (*QueryTradesByAddressResponse, error) {
+	out := new(QueryTradesByAddressResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/TradesByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketStats(ctx context.Context, in *QueryMarketStatsRequest, opts ...grpc.CallOption) (*QueryMarketStatsResponse, error) {
+	out := new(QueryMarketStatsResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/MarketStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeDestination(ctx context.Context, in *QueryFeeDestinationRequest, opts ...grpc.CallOption) (*QueryFeeDestinationResponse, error) {
+	out := new(QueryFeeDestinationResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/FeeDestination", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Offer(ctx context.Context, in *QueryOfferRequest, opts ...grpc.CallOption) (*QueryOfferResponse, error) {
+	out := new(QueryOfferResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/Offer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OffersByBatch(ctx context.Context, in *QueryOffersByBatchRequest, opts ...grpc.CallOption) (*QueryOffersByBatchResponse, error) {
+	out := new(QueryOffersByBatchResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/OffersByBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OffersByBuyer(ctx context.Context, in *QueryOffersByBuyerRequest, opts ...grpc.CallOption) (*QueryOffersByBuyerResponse, error) {
+	out := new(QueryOffersByBuyerResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/OffersByBuyer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OffersByHolder(ctx context.Context, in *QueryOffersByHolderRequest, opts ...grpc.CallOption) (*QueryOffersByHolderResponse, error) {
+	out := new(QueryOffersByHolderResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/OffersByHolder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DutchAuction(ctx context.Context, in *QueryDutchAuctionRequest, opts ...grpc.CallOption) (*QueryDutchAuctionResponse, error) {
+	out := new(QueryDutchAuctionResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/DutchAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DutchAuctions(ctx context.Context, in *QueryDutchAuctionsRequest, opts ...grpc.CallOption) (*QueryDutchAuctionsResponse, error) {
+	out := new(QueryDutchAuctionsResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/DutchAuctions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SealedBidAuction(ctx context.Context, in *QuerySealedBidAuctionRequest, opts ...grpc.CallOption) (*QuerySealedBidAuctionResponse, error) {
+	out := new(QuerySealedBidAuctionResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/SealedBidAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SealedBidAuctions(ctx context.Context, in *QuerySealedBidAuctionsRequest, opts ...grpc.CallOption) (*QuerySealedBidAuctionsResponse, error) {
+	out := new(QuerySealedBidAuctionsResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/SealedBidAuctions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SealedBids(ctx context.Context, in *QuerySealedBidsRequest, opts ...grpc.CallOption) (*QuerySealedBidsResponse, error) {
+	out := new(QuerySealedBidsResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/SealedBids", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ForwardSale(ctx context.Context, in *QueryForwardSaleRequest, opts ...grpc.CallOption) (*QueryForwardSaleResponse, error) {
+	out := new(QueryForwardSaleResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/ForwardSale", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ForwardSalesByProject(ctx context.Context, in *QueryForwardSalesByProjectRequest, opts ...grpc.CallOption) (*QueryForwardSalesByProjectResponse, error) {
+	out := new(QueryForwardSalesByProjectResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/ForwardSalesByProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ForwardContract(ctx context.Context, in *QueryForwardContractRequest, opts ...grpc.CallOption) (*QueryForwardContractResponse, error) {
+	out := new(QueryForwardContractResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/ForwardContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ForwardContractsBySale(ctx context.Context, in *QueryForwardContractsBySaleRequest, opts ...grpc.CallOption) (*QueryForwardContractsBySaleResponse, error) {
+	out := new(QueryForwardContractsBySaleResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/ForwardContractsBySale", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ForwardContractsByBuyer(ctx context.Context, in *QueryForwardContractsByBuyerRequest, opts ...grpc.CallOption) (*QueryForwardContractsByBuyerResponse, error) {
+	out := new(QueryForwardContractsByBuyerResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/ForwardContractsByBuyer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Markets(ctx context.Context, in *QueryMarketsRequest, opts ...grpc.CallOption) (*QueryMarketsResponse, error) {
+	out := new(QueryMarketsResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/Markets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketRegistration(ctx context.Context, in *QueryMarketRegistrationRequest, opts ...grpc.CallOption) (*QueryMarketRegistrationResponse, error) {
+	out := new(QueryMarketRegistrationResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/MarketRegistration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// SellOrder queries a sell order by its unique identifier.
+	SellOrder(context.Context, *QuerySellOrderRequest) (*QuerySellOrderResponse, error)
+	// SellOrders queries a paginated list of all sell orders.
+	SellOrders(context.Context, *QuerySellOrdersRequest) (*QuerySellOrdersResponse, error)
+	// SellOrdersByBatch queries a paginated list of all sell orders based on
+	// the batch denom of the credits being sold.
+	SellOrdersByBatch(context.Context, *QuerySellOrdersByBatchRequest) (*QuerySellOrdersByBatchResponse, error)
+	// SellOrdersBySeller queries a paginated list of all sell orders based on the
+	// account address of the seller.
+	SellOrdersBySeller(context.Context, *QuerySellOrdersBySellerRequest) (*QuerySellOrdersBySellerResponse, error)
+	// SellOrdersPendingExpiry queries a paginated list of all sell orders with
+	// an expiration sorted by expiration (soonest first). Sell orders that have
+	// expired but have not yet been pruned are included first.
+	SellOrdersPendingExpiry(context.Context, *QuerySellOrdersPendingExpiryRequest) (*QuerySellOrdersPendingExpiryResponse, error)
+	// SellOrdersByClass queries a paginated list of all sell orders based on the
+	// credit class of the credits being sold with optional filters for the
+	// jurisdiction of the project and the start date of the credit batch.
+	SellOrdersByClass(context.Context, *QuerySellOrdersByClassRequest) (*QuerySellOrdersByClassResponse, error)
+	// SellOrdersByProject queries a paginated list of all sell orders based on
+	// the project of the credits being sold with an optional filter for the
+	// start date of the credit batch.
+	SellOrdersByProject(context.Context, *QuerySellOrdersByProjectRequest) (*QuerySellOrdersByProjectResponse, error)
+	// SellOrdersByMarket queries a paginated list of all sell orders within a
+	// market sorted by ask price (lowest ask price first, or highest ask price
+	// first when pagination.reverse is set).
+	SellOrdersByMarket(context.Context, *QuerySellOrdersByMarketRequest) (*QuerySellOrdersByMarketResponse, error)
+	// BuyOrder queries a buy order by its unique identifier.
+	BuyOrder(context.Context, *QueryBuyOrderRequest) (*QueryBuyOrderResponse, error)
+	// BuyOrders queries a paginated list of all buy orders.
+	BuyOrders(context.Context, *QueryBuyOrdersRequest) (*QueryBuyOrdersResponse, error)
+	// BuyOrdersByBuyer queries a paginated list of all buy orders based on the
+	// account address of the buyer.
+	BuyOrdersByBuyer(context.Context, *QueryBuyOrdersByBuyerRequest) (*QueryBuyOrdersByBuyerResponse, error)
+	// AllowedDenoms queries a paginated list of all bank denoms allowed to be
+	// used in the marketplace.
+	AllowedDenoms(context.Context, *QueryAllowedDenomsRequest) (*QueryAllowedDenomsResponse, error)
+	// MarketFees queries the fees and the total amount of fees collected in a
+	// market.
+	MarketFees(context.Context, *QueryMarketFeesRequest) (*QueryMarketFeesResponse, error)
+	// TradesByBatch queries a paginated list of all trades based on the batch
+	// denom of the credits traded.
+	TradesByBatch(context.Context, *QueryTradesByBatchRequest) (*QueryTradesByBatchResponse, error)
+	// TradesByMarket queries a paginated list of all trades in a market ordered
+	// by the time of the trade.
+	TradesByMarket(context.Context, *QueryTradesByMarketRequest) (*QueryTradesByMarketResponse, error)
+	// TradesByAddress queries a paginated list of all trades in which the
+	// account address was the buyer or the seller.
+	TradesByAddress(context.Context, *QueryTradesByAddressRequest) (*QueryTradesByAddressResponse, error)
+	// MarketStats queries price statistics of a market over a window of time.
+	MarketStats(context.Context, *QueryMarketStatsRequest) (*QueryMarketStatsResponse, error)
+	// FeeDestination queries the destination of marketplace fees.
+	FeeDestination(context.Context, *QueryFeeDestinationRequest) (*QueryFeeDestinationResponse, error)
+	// Offer queries an offer by its unique identifier.
+	Offer(context.Context, *QueryOfferRequest) (*QueryOfferResponse, error)
+	// OffersByBatch queries a paginated list of all offers based on the batch
+	// denom of the credits being bought.
+	OffersByBatch(context.Context, *QueryOffersByBatchRequest) (*QueryOffersByBatchResponse, error)
+	// OffersByBuyer queries a paginated list of all offers based on the account
+	// address of the buyer.
+	OffersByBuyer(context.Context, *QueryOffersByBuyerRequest) (*QueryOffersByBuyerResponse, error)
+	// OffersByHolder queries a paginated list of all offers made to a specific
+	// credit holder. Offers that any holder can accept are not included.
+	OffersByHolder(context.Context, *QueryOffersByHolderRequest) (*QueryOffersByHolderResponse, error)
+	// DutchAuction queries a dutch auction by its unique identifier.
+	DutchAuction(context.Context, *QueryDutchAuctionRequest) (*QueryDutchAuctionResponse, error)
+	// DutchAuctions queries a paginated list of all dutch auctions.
+	DutchAuctions(context.Context, *QueryDutchAuctionsRequest) (*QueryDutchAuctionsResponse, error)
+	// SealedBidAuction queries a sealed-bid auction by its unique identifier.
+	SealedBidAuction(context.Context, *QuerySealedBidAuctionRequest) (*QuerySealedBidAuctionResponse, error)
+	// SealedBidAuctions queries a paginated list of all sealed-bid auctions.
+	SealedBidAuctions(context.Context, *QuerySealedBidAuctionsRequest) (*QuerySealedBidAuctionsResponse, error)
+	// SealedBids queries a paginated list of all bids in a sealed-bid auction.
+	SealedBids(context.Context, *QuerySealedBidsRequest) (*QuerySealedBidsResponse, error)
+	// ForwardSale queries a forward sale by its unique identifier.
+	ForwardSale(context.Context, *QueryForwardSaleRequest) (*QueryForwardSaleResponse, error)
+	// ForwardSalesByProject queries a paginated list of all forward sales of a
+	// project.
+	ForwardSalesByProject(context.Context, *QueryForwardSalesByProjectRequest) (*QueryForwardSalesByProjectResponse, error)
+	// ForwardContract queries a forward contract by its unique identifier.
+	ForwardContract(context.Context, *QueryForwardContractRequest) (*QueryForwardContractResponse, error)
+	// ForwardContractsBySale queries a paginated list of all forward contracts
+	// of a forward sale in delivery priority order.
+	ForwardContractsBySale(context.Context, *QueryForwardContractsBySaleRequest) (*QueryForwardContractsBySaleResponse, error)
+	// ForwardContractsByBuyer queries a paginated list of all forward contracts
+	// bought by a specific buyer.
+	ForwardContractsByBuyer(context.Context, *QueryForwardContractsByBuyerRequest) (*QueryForwardContractsByBuyerResponse, error)
+	// Markets queries a paginated list of all markets.
+	Markets(context.Context, *QueryMarketsRequest) (*QueryMarketsResponse, error)
+	// MarketRegistration queries whether market registration is permissionless
+	// and the deposit paid to register a market.
+	MarketRegistration(context.Context, *QueryMarketRegistrationRequest) (*QueryMarketRegistrationResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) SellOrder(ctx context.Context, req *QuerySellOrderRequest) (*QuerySellOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SellOrder not implemented")
+}
+func (*UnimplementedQueryServer) SellOrders(ctx context.Context, req *QuerySellOrdersRequest) (*QuerySellOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SellOrders not implemented")
+}
+func (*UnimplementedQueryServer) SellOrdersByBatch(ctx context.Context, req *QuerySellOrdersByBatchRequest) (*QuerySellOrdersByBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SellOrdersByBatch not implemented")
+}
+func (*UnimplementedQueryServer) SellOrdersBySeller(ctx context.Context, req *QuerySellOrdersBySellerRequest) (*QuerySellOrdersBySellerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SellOrdersBySeller not implemented")
+}
+func (*UnimplementedQueryServer) SellOrdersPendingExpiry(ctx context.Context, req *QuerySellOrdersPendingExpiryRequest) (*QuerySellOrdersPendingExpiryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SellOrdersPendingExpiry not implemented")
+}
+func (*UnimplementedQueryServer) SellOrdersByClass(ctx context.Context, req *QuerySellOrdersByClassRequest) (*QuerySellOrdersByClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SellOrdersByClass not implemented")
+}
+func (*UnimplementedQueryServer) SellOrdersByProject(ctx context.Context, req *QuerySellOrdersByProjectRequest) (*QuerySellOrdersByProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SellOrdersByProject not implemented")
+}
+func (*UnimplementedQueryServer) SellOrdersByMarket(ctx context.Context, req *QuerySellOrdersByMarketRequest) (*QuerySellOrdersByMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SellOrdersByMarket not implemented")
+}
+func (*UnimplementedQueryServer) BuyOrder(ctx context.Context, req *QueryBuyOrderRequest) (*QueryBuyOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyOrder not implemented")
+}
+func (*UnimplementedQueryServer) BuyOrders(ctx context.Context, req *QueryBuyOrdersRequest) (*QueryBuyOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyOrders not implemented")
+}
+func (*UnimplementedQueryServer) BuyOrdersByBuyer(ctx context.Context, req *QueryBuyOrdersByBuyerRequest) (*QueryBuyOrdersByBuyerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyOrdersByBuyer not implemented")
+}
+func (*UnimplementedQueryServer) AllowedDenoms(ctx context.Context, req *QueryAllowedDenomsRequest) (*QueryAllowedDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedDenoms not implemented")
+}
+func (*UnimplementedQueryServer) MarketFees(ctx context.Context, req *QueryMarketFeesRequest) (*QueryMarketFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketFees not implemented")
+}
+func (*UnimplementedQueryServer) TradesByBatch(ctx context.Context, req *QueryTradesByBatchRequest) (*QueryTradesByBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TradesByBatch not implemented")
+}
+func (*UnimplementedQueryServer) TradesByMarket(ctx context.Context, req *QueryTradesByMarketRequest) (*QueryTradesByMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TradesByMarket not implemented")
+}
+func (*UnimplementedQueryServer) TradesByAddress(ctx context.Context, req *QueryTradesByAddressRequest) (*QueryTradesByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TradesByAddress not implemented")
+}
+func (*UnimplementedQueryServer) MarketStats(ctx context.Context, req *QueryMarketStatsRequest) (*QueryMarketStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketStats not implemented")
+}
+func (*UnimplementedQueryServer) FeeDestination(ctx context.Context, req *QueryFeeDestinationRequest) (*QueryFeeDestinationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeDestination not implemented")
+}
+func (*UnimplementedQueryServer) Offer(ctx context.Context, req *QueryOfferRequest) (*QueryOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Offer not implemented")
+}
+func (*UnimplementedQueryServer) OffersByBatch(ctx context.Context, req *QueryOffersByBatchRequest) (*QueryOffersByBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OffersByBatch not implemented")
+}
+func (*UnimplementedQueryServer) OffersByBuyer(ctx context.Context, req *QueryOffersByBuyerRequest) (*QueryOffersByBuyerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OffersByBuyer not implemented")
+}
+func (*UnimplementedQueryServer) OffersByHolder(ctx context.Context, req *QueryOffersByHolderRequest) (*QueryOffersByHolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OffersByHolder not implemented")
+}
+func (*UnimplementedQueryServer) DutchAuction(ctx context.Context, req *QueryDutchAuctionRequest) (*QueryDutchAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DutchAuction not implemented")
+}
+func (*UnimplementedQueryServer) DutchAuctions(ctx context.Context, req *QueryDutchAuctionsRequest) (*QueryDutchAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DutchAuctions not implemented")
+}
+func (*UnimplementedQueryServer) SealedBidAuction(ctx context.Context, req *QuerySealedBidAuctionRequest) (*QuerySealedBidAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SealedBidAuction not implemented")
+}
+func (*UnimplementedQueryServer) SealedBidAuctions(ctx context.Context, req *QuerySealedBidAuctionsRequest) (*QuerySealedBidAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SealedBidAuctions not implemented")
+}
+func (*UnimplementedQueryServer) SealedBids(ctx context.Context, req *QuerySealedBidsRequest) (*QuerySealedBidsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SealedBids not implemented")
+}
+func (*UnimplementedQueryServer) ForwardSale(ctx context.Context, req *QueryForwardSaleRequest) (*QueryForwardSaleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForwardSale not implemented")
+}
+func (*UnimplementedQueryServer) ForwardSalesByProject(ctx context.Context, req *QueryForwardSalesByProjectRequest) (*QueryForwardSalesByProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForwardSalesByProject not implemented")
+}
+func (*UnimplementedQueryServer) ForwardContract(ctx context.Context, req *QueryForwardContractRequest) (*QueryForwardContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForwardContract not implemented")
+}
+func (*UnimplementedQueryServer) ForwardContractsBySale(ctx context.Context, req *QueryForwardContractsBySaleRequest) (*QueryForwardContractsBySaleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForwardContractsBySale not implemented")
+}
+func (*UnimplementedQueryServer) ForwardContractsByBuyer(ctx context.Context, req *QueryForwardContractsByBuyerRequest) (*QueryForwardContractsByBuyerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForwardContractsByBuyer not implemented")
+}
+func (*UnimplementedQueryServer) Markets(ctx context.Context, req *QueryMarketsRequest) (*QueryMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Markets not implemented")
+}
+func (*UnimplementedQueryServer) MarketRegistration(ctx context.Context, req *QueryMarketRegistrationRequest) (*QueryMarketRegistrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketRegistration not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_SellOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySellOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SellOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/SellOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SellOrder(ctx, req.(*QuerySellOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SellOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySellOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SellOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/SellOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SellOrders(ctx, req.(*QuerySellOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SellOrdersByBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySellOrdersByBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SellOrdersByBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/SellOrdersByBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SellOrdersByBatch(ctx, req.(*QuerySellOrdersByBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SellOrdersBySeller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySellOrdersBySellerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SellOrdersBySeller(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/SellOrdersBySeller",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SellOrdersBySeller(ctx, req.(*QuerySellOrdersBySellerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SellOrdersPendingExpiry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySellOrdersPendingExpiryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SellOrdersPendingExpiry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/SellOrdersPendingExpiry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SellOrdersPendingExpiry(ctx, req.(*QuerySellOrdersPendingExpiryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SellOrdersByClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySellOrdersByClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SellOrdersByClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/SellOrdersByClass",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SellOrdersByClass(ctx, req.(*QuerySellOrdersByClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SellOrdersByProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySellOrdersByProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SellOrdersByProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/SellOrdersByProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SellOrdersByProject(ctx, req.(*QuerySellOrdersByProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SellOrdersByMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySellOrdersByMarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SellOrdersByMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/SellOrdersByMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SellOrdersByMarket(ctx, req.(*QuerySellOrdersByMarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BuyOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBuyOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BuyOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/BuyOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BuyOrder(ctx, req.(*QueryBuyOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BuyOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBuyOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BuyOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/BuyOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BuyOrders(ctx, req.(*QueryBuyOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BuyOrdersByBuyer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBuyOrdersByBuyerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BuyOrdersByBuyer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/BuyOrdersByBuyer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BuyOrdersByBuyer(ctx, req.(*QueryBuyOrdersByBuyerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowedDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/AllowedDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedDenoms(ctx, req.(*QueryAllowedDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarketFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/MarketFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketFees(ctx, req.(*QueryMarketFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TradesByBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTradesByBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TradesByBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/TradesByBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TradesByBatch(ctx, req.(*QueryTradesByBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TradesByMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTradesByMarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TradesByMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/TradesByMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TradesByMarket(ctx, req.(*QueryTradesByMarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TradesByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTradesByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TradesByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/TradesByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TradesByAddress(ctx, req.(*QueryTradesByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarketStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/MarketStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketStats(ctx, req.(*QueryMarketStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeDestination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeDestinationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeDestination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/FeeDestination",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeDestination(ctx, req.(*QueryFeeDestinationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Offer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Offer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/Offer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Offer(ctx, req.(*QueryOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OffersByBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOffersByBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OffersByBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/OffersByBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OffersByBatch(ctx, req.(*QueryOffersByBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OffersByBuyer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOffersByBuyerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OffersByBuyer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/OffersByBuyer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OffersByBuyer(ctx, req.(*QueryOffersByBuyerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OffersByHolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOffersByHolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OffersByHolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/OffersByHolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OffersByHolder(ctx, req.(*QueryOffersByHolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DutchAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDutchAuctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DutchAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/DutchAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DutchAuction(ctx, req.(*QueryDutchAuctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DutchAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDutchAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DutchAuctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/DutchAuctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DutchAuctions(ctx, req.(*QueryDutchAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SealedBidAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySealedBidAuctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SealedBidAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/SealedBidAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SealedBidAuction(ctx, req.(*QuerySealedBidAuctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SealedBidAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySealedBidAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SealedBidAuctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/SealedBidAuctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SealedBidAuctions(ctx, req.(*QuerySealedBidAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SealedBids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySealedBidsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SealedBids(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/SealedBids",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SealedBids(ctx, req.(*QuerySealedBidsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ForwardSale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryForwardSaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ForwardSale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/ForwardSale",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ForwardSale(ctx, req.(*QueryForwardSaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ForwardSalesByProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryForwardSalesByProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ForwardSalesByProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/ForwardSalesByProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ForwardSalesByProject(ctx, req.(*QueryForwardSalesByProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ForwardContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryForwardContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ForwardContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/ForwardContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ForwardContract(ctx, req.(*QueryForwardContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ForwardContractsBySale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryForwardContractsBySaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ForwardContractsBySale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/ForwardContractsBySale",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ForwardContractsBySale(ctx, req.(*QueryForwardContractsBySaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ForwardContractsByBuyer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryForwardContractsByBuyerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ForwardContractsByBuyer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/ForwardContractsByBuyer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ForwardContractsByBuyer(ctx, req.(*QueryForwardContractsByBuyerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Markets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Markets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/Markets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Markets(ctx, req.(*QueryMarketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarketRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/MarketRegistration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketRegistration(ctx, req.(*QueryMarketRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "regen.ecocredit.marketplace.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SellOrder",
+			Handler:    _Query_SellOrder_Handler,
+		},
+		{
+			MethodName: "SellOrders",
+			Handler:    _Query_SellOrders_Handler,
+		},
+		{
+			MethodName: "SellOrdersByBatch",
+			Handler:    _Query_SellOrdersByBatch_Handler,
+		},
+		{
+			MethodName: "SellOrdersBySeller",
+			Handler:    _Query_SellOrdersBySeller_Handler,
+		},
+		{
+			MethodName: "SellOrdersPendingExpiry",
+			Handler:    _Query_SellOrdersPendingExpiry_Handler,
+		},
+		{
+			MethodName: "SellOrdersByClass",
+			Handler:    _Query_SellOrdersByClass_Handler,
+		},
+		{
+			MethodName: "SellOrdersByProject",
+			Handler:    _Query_SellOrdersByProject_Handler,
+		},
+		{
+			MethodName: "SellOrdersByMarket",
+			Handler:    _Query_SellOrdersByMarket_Handler,
+		},
+		{
+			MethodName: "BuyOrder",
+			Handler:    _Query_BuyOrder_Handler,
+		},
+		{
+			MethodName: "BuyOrders",
+			Handler:    _Query_BuyOrders_Handler,
+		},
+		{
+			MethodName: "BuyOrdersByBuyer",
+			Handler:    _Query_BuyOrdersByBuyer_Handler,
+		},
+		{
+			MethodName: "AllowedDenoms",
+			Handler:    _Query_AllowedDenoms_Handler,
+		},
+		{
+			MethodName: "MarketFees",
+			Handler:    _Query_MarketFees_Handler,
+		},
+		{
+			MethodName: "TradesByBatch",
+			Handler:    _Query_TradesByBatch_Handler,
+		},
+		{
+			MethodName: "TradesByMarket",
+			Handler:    _Query_TradesByMarket_Handler,
+		},
+		{
+			MethodName: "TradesByAddress",
+			Handler:    _Query_TradesByAddress_Handler,
+		},
+		{
+			MethodName: "MarketStats",
+			Handler:    _Query_MarketStats_Handler,
+		},
+		{
+			MethodName: "FeeDestination",
+			Handler:    _Query_FeeDestination_Handler,
+		},
+		{
+			MethodName: "Offer",
+			Handler:    _Query_Offer_Handler,
+		},
+		{
+			MethodName: "OffersByBatch",
+			Handler:    _Query_OffersByBatch_Handler,
+		},
+		{
+			MethodName: "OffersByBuyer",
+			Handler:    _Query_OffersByBuyer_Handler,
+		},
+		{
+			MethodName: "OffersByHolder",
+			Handler:    _Query_OffersByHolder_Handler,
+		},
+		{
+			MethodName: "DutchAuction",
+			Handler:    _Query_DutchAuction_Handler,
+		},
+		{
+			MethodName: "DutchAuctions",
+			Handler:    _Query_DutchAuctions_Handler,
+		},
+		{
+			MethodName: "SealedBidAuction",
+			Handler:    _Query_SealedBidAuction_Handler,
+		},
+		{
+			MethodName: "SealedBidAuctions",
+			Handler:    _Query_SealedBidAuctions_Handler,
+		},
+		{
+			MethodName: "SealedBids",
+			Handler:    _Query_SealedBids_Handler,
+		},
+		{
+			MethodName: "ForwardSale",
+			Handler:    _Query_ForwardSale_Handler,
+		},
+		{
+			MethodName: "ForwardSalesByProject",
+			Handler:    _Query_ForwardSalesByProject_Handler,
+		},
+		{
+			MethodName: "ForwardContract",
+			Handler:    _Query_ForwardContract_Handler,
+		},
+		{
+			MethodName: "ForwardContractsBySale",
+			Handler:    _Query_ForwardContractsBySale_Handler,
+		},
+		{
+			MethodName: "ForwardContractsByBuyer",
+			Handler:    _Query_ForwardContractsByBuyer_Handler,
+		},
+		{
+			MethodName: "Markets",
+			Handler:    _Query_Markets_Handler,
+		},
+		{
+			MethodName: "MarketRegistration",
+			Handler:    _Query_MarketRegistration_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "regen/ecocredit/marketplace/v1/query.proto",
+}
+
+func (m *QuerySellOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySellOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySellOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SellOrderId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SellOrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySellOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySellOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySellOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SellOrder != nil {
+		{
+			size, err := m.SellOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySellOrdersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySellOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySellOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludePrivate {
+		i--
+		if m.IncludePrivate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySellOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySellOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySellOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SellOrders) > 0 {
+		for iNdEx := len(m.SellOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SellOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySellOrdersByBatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySellOrdersByBatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySellOrdersByBatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludePrivate {
+		i--
+		if m.IncludePrivate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BatchDenom) > 0 {
+		i -= len(m.BatchDenom)
+		copy(dAtA[i:], m.BatchDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BatchDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySellOrdersByBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySellOrdersByBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySellOrdersByBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SellOrders) > 0 {
+		for iNdEx := len(m.SellOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SellOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySellOrdersBySellerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySellOrdersBySellerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySellOrdersBySellerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludePrivate {
+		i--
+		if m.IncludePrivate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySellOrdersBySellerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySellOrdersBySellerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySellOrdersBySellerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SellOrders) > 0 {
+		for iNdEx := len(m.SellOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SellOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySellOrdersPendingExpiryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySellOrdersPendingExpiryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySellOrdersPendingExpiryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludePrivate {
+		i--
+		if m.IncludePrivate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ExpiresBefore != nil {
+		{
+			size, err := m.ExpiresBefore.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySellOrdersPendingExpiryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySellOrdersPendingExpiryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySellOrdersPendingExpiryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SellOrders) > 0 {
+		for iNdEx := len(m.SellOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SellOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySellOrdersByClassRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySellOrdersByClassRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySellOrdersByClassRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxBatchStartDate != nil {
+		{
+			size, err := m.MaxBatchStartDate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MinBatchStartDate != nil {
+		{
+			size, err := m.MinBatchStartDate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ProjectJurisdiction) > 0 {
+		i -= len(m.ProjectJurisdiction)
+		copy(dAtA[i:], m.ProjectJurisdiction)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProjectJurisdiction)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.IncludePrivate {
+		i--
+		if m.IncludePrivate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySellOrdersByClassResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySellOrdersByClassResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySellOrdersByClassResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SellOrders) > 0 {
+		for iNdEx := len(m.SellOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SellOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySellOrdersByProjectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySellOrdersByProjectRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySellOrdersByProjectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxBatchStartDate != nil {
+		{
+			size, err := m.MaxBatchStartDate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MinBatchStartDate != nil {
+		{
+			size, err := m.MinBatchStartDate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.IncludePrivate {
+		i--
+		if m.IncludePrivate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProjectId) > 0 {
+		i -= len(m.ProjectId)
+		copy(dAtA[i:], m.ProjectId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProjectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySellOrdersByProjectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySellOrdersByProjectResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySellOrdersByProjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SellOrders) > 0 {
+		for iNdEx := len(m.SellOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SellOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySellOrdersByMarketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySellOrdersByMarketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySellOrdersByMarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludePrivate {
+		i--
+		if m.IncludePrivate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySellOrdersByMarketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySellOrdersByMarketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySellOrdersByMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SellOrders) > 0 {
+		for iNdEx := len(m.SellOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SellOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBuyOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBuyOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBuyOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BuyOrderId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BuyOrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBuyOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBuyOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBuyOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BuyOrder != nil {
+		{
+			size, err := m.BuyOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBuyOrdersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBuyOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBuyOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBuyOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBuyOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBuyOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BuyOrders) > 0 {
+		for iNdEx := len(m.BuyOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BuyOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBuyOrdersByBuyerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBuyOrdersByBuyerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBuyOrdersByBuyerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBuyOrdersByBuyerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBuyOrdersByBuyerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBuyOrdersByBuyerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BuyOrders) > 0 {
+		for iNdEx := len(m.BuyOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BuyOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOfferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOfferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOfferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OfferId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OfferId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOfferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOfferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOfferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Offer != nil {
+		{
+			size, err := m.Offer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOffersByBatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOffersByBatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOffersByBatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BatchDenom) > 0 {
+		i -= len(m.BatchDenom)
+		copy(dAtA[i:], m.BatchDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BatchDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOffersByBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOffersByBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOffersByBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Offers) > 0 {
+		for iNdEx := len(m.Offers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Offers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOffersByBuyerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOffersByBuyerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOffersByBuyerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOffersByBuyerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOffersByBuyerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOffersByBuyerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Offers) > 0 {
+		for iNdEx := len(m.Offers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Offers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOffersByHolderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOffersByHolderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOffersByHolderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOffersByHolderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOffersByHolderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOffersByHolderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Offers) > 0 {
+		for iNdEx := len(m.Offers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Offers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDutchAuctionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDutchAuctionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDutchAuctionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDutchAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDutchAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDutchAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Auction != nil {
+		{
+			size, err := m.Auction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDutchAuctionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDutchAuctionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDutchAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDutchAuctionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDutchAuctionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDutchAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Auctions) > 0 {
+		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Auctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySealedBidAuctionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySealedBidAuctionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySealedBidAuctionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySealedBidAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySealedBidAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySealedBidAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Auction != nil {
+		{
+			size, err := m.Auction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySealedBidAuctionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySealedBidAuctionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySealedBidAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySealedBidAuctionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySealedBidAuctionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySealedBidAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Auctions) > 0 {
+		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Auctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySealedBidsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySealedBidsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySealedBidsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySealedBidsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySealedBidsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySealedBidsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryForwardSaleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryForwardSaleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryForwardSaleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ForwardSaleId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ForwardSaleId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryForwardSaleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryForwardSaleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryForwardSaleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ForwardSale != nil {
+		{
+			size, err := m.ForwardSale.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryForwardSalesByProjectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryForwardSalesByProjectRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryForwardSalesByProjectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProjectId) > 0 {
+		i -= len(m.ProjectId)
+		copy(dAtA[i:], m.ProjectId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProjectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryForwardSalesByProjectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryForwardSalesByProjectResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryForwardSalesByProjectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ForwardSales) > 0 {
+		for iNdEx := len(m.ForwardSales) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForwardSales[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryForwardContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryForwardContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryForwardContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ForwardContractId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ForwardContractId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryForwardContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryForwardContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryForwardContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ForwardContract != nil {
+		{
+			size, err := m.ForwardContract.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryForwardContractsBySaleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryForwardContractsBySaleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryForwardContractsBySaleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ForwardSaleId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ForwardSaleId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryForwardContractsBySaleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryForwardContractsBySaleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryForwardContractsBySaleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ForwardContracts) > 0 {
+		for iNdEx := len(m.ForwardContracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForwardContracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryForwardContractsByBuyerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryForwardContractsByBuyerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryForwardContractsByBuyerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryForwardContractsByBuyerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryForwardContractsByBuyerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryForwardContractsByBuyerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ForwardContracts) > 0 {
+		for iNdEx := len(m.ForwardContracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForwardContracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedDenomsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Collected != nil {
+		{
+			size, err := m.Collected.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TakerFeeBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TakerFeeBps))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MakerFeeBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MakerFeeBps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CreditTypeAbbrev) > 0 {
+		i -= len(m.CreditTypeAbbrev)
+		copy(dAtA[i:], m.CreditTypeAbbrev)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CreditTypeAbbrev)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeDestinationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeDestinationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeDestinationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeDestinationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeDestinationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeDestinationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Destination != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTradesByBatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTradesByBatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTradesByBatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BatchDenom) > 0 {
+		i -= len(m.BatchDenom)
+		copy(dAtA[i:], m.BatchDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BatchDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTradesByBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTradesByBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTradesByBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Trades) > 0 {
+		for iNdEx := len(m.Trades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Trades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTradesByMarketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTradesByMarketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTradesByMarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTradesByMarketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTradesByMarketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTradesByMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Trades) > 0 {
+		for iNdEx := len(m.Trades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Trades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTradesByAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTradesByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTradesByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTradesByAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTradesByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTradesByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Trades) > 0 {
+		for iNdEx := len(m.Trades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Trades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowStart != nil {
+		{
+			size, err := m.WindowStart.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Low) > 0 {
+		i -= len(m.Low)
+		copy(dAtA[i:], m.Low)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Low)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.High) > 0 {
+		i -= len(m.High)
+		copy(dAtA[i:], m.High)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.High)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Vwap) > 0 {
+		i -= len(m.Vwap)
+		copy(dAtA[i:], m.Vwap)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Vwap)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Notional) > 0 {
+		i -= len(m.Notional)
+		copy(dAtA[i:], m.Notional)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Notional)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Volume) > 0 {
+		i -= len(m.Volume)
+		copy(dAtA[i:], m.Volume)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Volume)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.TradeCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TradeCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LastTradeTime != nil {
+		{
+			size, err := m.LastTradeTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.LastPrice) > 0 {
+		i -= len(m.LastPrice)
+		copy(dAtA[i:], m.LastPrice)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LastPrice)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BankDenom) > 0 {
+		i -= len(m.BankDenom)
+		copy(dAtA[i:], m.BankDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BankDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CreditTypeAbbrev) > 0 {
+		i -= len(m.CreditTypeAbbrev)
+		copy(dAtA[i:], m.CreditTypeAbbrev)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CreditTypeAbbrev)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TradeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TradeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TradeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ForwardContractId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ForwardContractId))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.SealedBidAuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SealedBidAuctionId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.DutchAuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DutchAuctionId))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.OfferId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OfferId))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Timestamp != nil {
+		{
+			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.UnitPrice) > 0 {
+		i -= len(m.UnitPrice)
+		copy(dAtA[i:], m.UnitPrice)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UnitPrice)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.BankDenom) > 0 {
+		i -= len(m.BankDenom)
+		copy(dAtA[i:], m.BankDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BankDenom)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.BuyOrderId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BuyOrderId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SellOrderId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SellOrderId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.BatchDenom) > 0 {
+		i -= len(m.BatchDenom)
+		copy(dAtA[i:], m.BatchDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BatchDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SellOrderInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SellOrderInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SellOrderInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AllOrNone {
+		i--
+		if m.AllOrNone {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.MinFillQuantity) > 0 {
+		i -= len(m.MinFillQuantity)
+		copy(dAtA[i:], m.MinFillQuantity)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MinFillQuantity)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.BuyerGroupPolicy) > 0 {
+		i -= len(m.BuyerGroupPolicy)
+		copy(dAtA[i:], m.BuyerGroupPolicy)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BuyerGroupPolicy)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.AllowedBuyers) > 0 {
+		for iNdEx := len(m.AllowedBuyers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedBuyers[iNdEx])
+			copy(dAtA[i:], m.AllowedBuyers[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.AllowedBuyers[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Expiration != nil {
+		{
+			size, err := m.Expiration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.DisableAutoRetire {
+		i--
+		if m.DisableAutoRetire {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.AskAmount) > 0 {
+		i -= len(m.AskAmount)
+		copy(dAtA[i:], m.AskAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AskAmount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.AskDenom) > 0 {
+		i -= len(m.AskDenom)
+		copy(dAtA[i:], m.AskDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AskDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BatchDenom) > 0 {
+		i -= len(m.BatchDenom)
+		copy(dAtA[i:], m.BatchDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BatchDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BuyOrderInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BuyOrderInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuyOrderInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TakerFeeBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TakerFeeBps))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Expiration != nil {
+		{
+			size, err := m.Expiration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.RetirementJurisdiction) > 0 {
+		i -= len(m.RetirementJurisdiction)
+		copy(dAtA[i:], m.RetirementJurisdiction)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RetirementJurisdiction)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.DisableAutoRetire {
+		i--
+		if m.DisableAutoRetire {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.BidAmount) > 0 {
+		i -= len(m.BidAmount)
+		copy(dAtA[i:], m.BidAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BidAmount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.BidDenom) > 0 {
+		i -= len(m.BidDenom)
+		copy(dAtA[i:], m.BidDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BidDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OfferInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OfferInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OfferInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MakerFeeBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MakerFeeBps))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Expiration != nil {
+		{
+			size, err := m.Expiration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.RetirementJurisdiction) > 0 {
+		i -= len(m.RetirementJurisdiction)
+		copy(dAtA[i:], m.RetirementJurisdiction)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RetirementJurisdiction)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.DisableAutoRetire {
+		i--
+		if m.DisableAutoRetire {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.BidAmount) > 0 {
+		i -= len(m.BidAmount)
+		copy(dAtA[i:], m.BidAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BidAmount)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.BidDenom) > 0 {
+		i -= len(m.BidDenom)
+		copy(dAtA[i:], m.BidDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BidDenom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BatchDenom) > 0 {
+		i -= len(m.BatchDenom)
+		copy(dAtA[i:], m.BatchDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BatchDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DutchAuctionInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DutchAuctionInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DutchAuctionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DisableAutoRetire {
+		i--
+		if m.DisableAutoRetire {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.EndTime != nil {
+		{
+			size, err := m.EndTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.StartTime != nil {
+		{
+			size, err := m.StartTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.CurrentAmount) > 0 {
+		i -= len(m.CurrentAmount)
+		copy(dAtA[i:], m.CurrentAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CurrentAmount)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.FloorAmount) > 0 {
+		i -= len(m.FloorAmount)
+		copy(dAtA[i:], m.FloorAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FloorAmount)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.StartAmount) > 0 {
+		i -= len(m.StartAmount)
+		copy(dAtA[i:], m.StartAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StartAmount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.AskDenom) > 0 {
+		i -= len(m.AskDenom)
+		copy(dAtA[i:], m.AskDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AskDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BatchDenom) > 0 {
+		i -= len(m.BatchDenom)
+		copy(dAtA[i:], m.BatchDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BatchDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SealedBidAuctionInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SealedBidAuctionInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SealedBidAuctionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TakerFeeBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TakerFeeBps))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MakerFeeBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MakerFeeBps))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.DisableAutoRetire {
+		i--
+		if m.DisableAutoRetire {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.RevealEndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RevealEndHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CommitEndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CommitEndHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ReserveAmount) > 0 {
+		i -= len(m.ReserveAmount)
+		copy(dAtA[i:], m.ReserveAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReserveAmount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.BidDenom) > 0 {
+		i -= len(m.BidDenom)
+		copy(dAtA[i:], m.BidDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BidDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BatchDenom) > 0 {
+		i -= len(m.BatchDenom)
+		copy(dAtA[i:], m.BatchDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BatchDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SealedBidInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SealedBidInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SealedBidInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BidAmount) > 0 {
+		i -= len(m.BidAmount)
+		copy(dAtA[i:], m.BidAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BidAmount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Revealed {
+		i--
+		if m.Revealed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DepositAmount) > 0 {
+		i -= len(m.DepositAmount)
+		copy(dAtA[i:], m.DepositAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DepositAmount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForwardSaleInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardSaleInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardSaleInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DisableAutoRetire {
+		i--
+		if m.DisableAutoRetire {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.DeliveryDeadline != nil {
+		{
+			size, err := m.DeliveryDeadline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.AskAmount) > 0 {
+		i -= len(m.AskAmount)
+		copy(dAtA[i:], m.AskAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AskAmount)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.AskDenom) > 0 {
+		i -= len(m.AskDenom)
+		copy(dAtA[i:], m.AskDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AskDenom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.VintageEnd != nil {
+		{
+			size, err := m.VintageEnd.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.VintageStart != nil {
+		{
+			size, err := m.VintageStart.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ProjectId) > 0 {
+		i -= len(m.ProjectId)
+		copy(dAtA[i:], m.ProjectId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProjectId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForwardContractInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardContractInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardContractInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TakerFeeBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TakerFeeBps))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MakerFeeBps != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MakerFeeBps))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.RetirementJurisdiction) > 0 {
+		i -= len(m.RetirementJurisdiction)
+		copy(dAtA[i:], m.RetirementJurisdiction)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RetirementJurisdiction)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.DisableAutoRetire {
+		i--
+		if m.DisableAutoRetire {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.EscrowAmount) > 0 {
+		i -= len(m.EscrowAmount)
+		copy(dAtA[i:], m.EscrowAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EscrowAmount)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.EscrowDenom) > 0 {
+		i -= len(m.EscrowDenom)
+		copy(dAtA[i:], m.EscrowDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EscrowDenom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ProjectId) > 0 {
+		i -= len(m.ProjectId)
+		copy(dAtA[i:], m.ProjectId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProjectId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ForwardSaleId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ForwardSaleId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketRegistrationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketRegistrationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketRegistrationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketRegistrationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketRegistrationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketRegistrationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deposit != nil {
+		{
+			size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Delisted {
+		i--
+		if m.Delisted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Registrant) > 0 {
+		i -= len(m.Registrant)
+		copy(dAtA[i:], m.Registrant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Registrant)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Exponent != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Exponent))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.DisplayDenom) > 0 {
+		i -= len(m.DisplayDenom)
+		copy(dAtA[i:], m.DisplayDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DisplayDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BankDenom) > 0 {
+		i -= len(m.BankDenom)
+		copy(dAtA[i:], m.BankDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BankDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CreditTypeAbbrev) > 0 {
+		i -= len(m.CreditTypeAbbrev)
+		copy(dAtA[i:], m.CreditTypeAbbrev)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CreditTypeAbbrev)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QuerySellOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SellOrderId != 0 {
+		n += 1 + sovQuery(uint64(m.SellOrderId))
+	}
+	return n
+}
+
+func (m *QuerySellOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SellOrder != nil {
+		l = m.SellOrder.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySellOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludePrivate {
+		n += 2
+	}
+	return n
+}
+
+func (m *QuerySellOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SellOrders) > 0 {
+		for _, e := range m.SellOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySellOrdersByBatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BatchDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludePrivate {
+		n += 2
+	}
+	return n
+}
+
+func (m *QuerySellOrdersByBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SellOrders) > 0 {
+		for _, e := range m.SellOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySellOrdersBySellerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludePrivate {
+		n += 2
+	}
+	return n
+}
+
+func (m *QuerySellOrdersBySellerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SellOrders) > 0 {
+		for _, e := range m.SellOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySellOrdersPendingExpiryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExpiresBefore != nil {
+		l = m.ExpiresBefore.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludePrivate {
+		n += 2
+	}
+	return n
+}
+
+func (m *QuerySellOrdersPendingExpiryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SellOrders) > 0 {
+		for _, e := range m.SellOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySellOrdersByClassRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludePrivate {
+		n += 2
+	}
+	l = len(m.ProjectJurisdiction)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MinBatchStartDate != nil {
+		l = m.MinBatchStartDate.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxBatchStartDate != nil {
+		l = m.MaxBatchStartDate.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySellOrdersByClassResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SellOrders) > 0 {
+		for _, e := range m.SellOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySellOrdersByProjectRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProjectId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludePrivate {
+		n += 2
+	}
+	if m.MinBatchStartDate != nil {
+		l = m.MinBatchStartDate.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxBatchStartDate != nil {
+		l = m.MaxBatchStartDate.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySellOrdersByProjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SellOrders) > 0 {
+		for _, e := range m.SellOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySellOrdersByMarketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludePrivate {
+		n += 2
+	}
+	return n
+}
+
+func (m *QuerySellOrdersByMarketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SellOrders) > 0 {
+		for _, e := range m.SellOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBuyOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BuyOrderId != 0 {
+		n += 1 + sovQuery(uint64(m.BuyOrderId))
+	}
+	return n
+}
+
+func (m *QueryBuyOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BuyOrder != nil {
+		l = m.BuyOrder.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBuyOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBuyOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BuyOrders) > 0 {
+		for _, e := range m.BuyOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBuyOrdersByBuyerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBuyOrdersByBuyerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BuyOrders) > 0 {
+		for _, e := range m.BuyOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOfferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OfferId != 0 {
+		n += 1 + sovQuery(uint64(m.OfferId))
+	}
+	return n
+}
+
+func (m *QueryOfferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Offer != nil {
+		l = m.Offer.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOffersByBatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BatchDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOffersByBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Offers) > 0 {
+		for _, e := range m.Offers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOffersByBuyerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOffersByBuyerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Offers) > 0 {
+		for _, e := range m.Offers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOffersByHolderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOffersByHolderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Offers) > 0 {
+		for _, e := range m.Offers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDutchAuctionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	return n
+}
+
+func (m *QueryDutchAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Auction != nil {
+		l = m.Auction.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDutchAuctionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDutchAuctionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Auctions) > 0 {
+		for _, e := range m.Auctions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySealedBidAuctionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	return n
+}
+
+func (m *QuerySealedBidAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Auction != nil {
+		l = m.Auction.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySealedBidAuctionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySealedBidAuctionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Auctions) > 0 {
+		for _, e := range m.Auctions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySealedBidsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySealedBidsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryForwardSaleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ForwardSaleId != 0 {
+		n += 1 + sovQuery(uint64(m.ForwardSaleId))
+	}
+	return n
+}
+
+func (m *QueryForwardSaleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ForwardSale != nil {
+		l = m.ForwardSale.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryForwardSalesByProjectRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProjectId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryForwardSalesByProjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ForwardSales) > 0 {
+		for _, e := range m.ForwardSales {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryForwardContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ForwardContractId != 0 {
+		n += 1 + sovQuery(uint64(m.ForwardContractId))
+	}
+	return n
+}
+
+func (m *QueryForwardContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ForwardContract != nil {
+		l = m.ForwardContract.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryForwardContractsBySaleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ForwardSaleId != 0 {
+		n += 1 + sovQuery(uint64(m.ForwardSaleId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryForwardContractsBySaleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ForwardContracts) > 0 {
+		for _, e := range m.ForwardContracts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryForwardContractsByBuyerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryForwardContractsByBuyerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ForwardContracts) > 0 {
+		for _, e := range m.ForwardContracts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowedDenomsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowedDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedDenoms) > 0 {
+		for _, e := range m.AllowedDenoms {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMarketFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	return n
+}
+
+func (m *QueryMarketFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	l = len(m.CreditTypeAbbrev)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MakerFeeBps != 0 {
+		n += 1 + sovQuery(uint64(m.MakerFeeBps))
+	}
+	if m.TakerFeeBps != 0 {
+		n += 1 + sovQuery(uint64(m.TakerFeeBps))
+	}
+	if m.Collected != nil {
+		l = m.Collected.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeDestinationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeDestinationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Destination != 0 {
+		n += 1 + sovQuery(uint64(m.Destination))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTradesByBatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BatchDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTradesByBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Trades) > 0 {
+		for _, e := range m.Trades {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTradesByMarketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTradesByMarketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Trades) > 0 {
+		for _, e := range m.Trades {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTradesByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTradesByAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Trades) > 0 {
+		for _, e := range m.Trades {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMarketStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	if m.WindowSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.WindowSeconds))
+	}
+	return n
+}
+
+func (m *QueryMarketStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	l = len(m.CreditTypeAbbrev)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BankDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.LastPrice)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LastTradeTime != nil {
+		l = m.LastTradeTime.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TradeCount != 0 {
+		n += 1 + sovQuery(uint64(m.TradeCount))
+	}
+	l = len(m.Volume)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Notional)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Vwap)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.High)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Low)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.WindowStart != nil {
+		l = m.WindowStart.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TradeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BatchDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	if m.SellOrderId != 0 {
+		n += 1 + sovQuery(uint64(m.SellOrderId))
+	}
+	if m.BuyOrderId != 0 {
+		n += 1 + sovQuery(uint64(m.BuyOrderId))
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BankDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.UnitPrice)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OfferId != 0 {
+		n += 1 + sovQuery(uint64(m.OfferId))
+	}
+	if m.DutchAuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.DutchAuctionId))
+	}
+	if m.SealedBidAuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.SealedBidAuctionId))
+	}
+	if m.ForwardContractId != 0 {
+		n += 1 + sovQuery(uint64(m.ForwardContractId))
+	}
+	return n
+}
+
+func (m *SellOrderInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BatchDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AskDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AskAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DisableAutoRetire {
+		n += 2
+	}
+	if m.Expiration != nil {
+		l = m.Expiration.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.AllowedBuyers) > 0 {
+		for _, s := range m.AllowedBuyers {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.BuyerGroupPolicy)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MinFillQuantity)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AllOrNone {
+		n += 2
+	}
+	return n
+}
+
+func (m *BuyOrderInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BidDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BidAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DisableAutoRetire {
+		n += 2
+	}
+	l = len(m.RetirementJurisdiction)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = m.Expiration.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TakerFeeBps != 0 {
+		n += 1 + sovQuery(uint64(m.TakerFeeBps))
+	}
+	return n
+}
+
+func (m *OfferInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BatchDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BidDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BidAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DisableAutoRetire {
+		n += 2
+	}
+	l = len(m.RetirementJurisdiction)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = m.Expiration.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MakerFeeBps != 0 {
+		n += 1 + sovQuery(uint64(m.MakerFeeBps))
+	}
+	return n
+}
+
+func (m *DutchAuctionInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BatchDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AskDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StartAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.FloorAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CurrentAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartTime != nil {
+		l = m.StartTime.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = m.EndTime.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DisableAutoRetire {
+		n += 2
+	}
+	return n
+}
+
+func (m *SealedBidAuctionInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BatchDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BidDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ReserveAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CommitEndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.CommitEndHeight))
+	}
+	if m.RevealEndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.RevealEndHeight))
+	}
+	if m.DisableAutoRetire {
+		n += 2
+	}
+	if m.MakerFeeBps != 0 {
+		n += 1 + sovQuery(uint64(m.MakerFeeBps))
+	}
+	if m.TakerFeeBps != 0 {
+		n += 1 + sovQuery(uint64(m.TakerFeeBps))
+	}
+	return n
+}
+
+func (m *SealedBidInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DepositAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Revealed {
+		n += 2
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BidAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ForwardSaleInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProjectId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.VintageStart != nil {
+		l = m.VintageStart.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.VintageEnd != nil {
+		l = m.VintageEnd.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AskDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AskAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DeliveryDeadline != nil {
+		l = m.DeliveryDeadline.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DisableAutoRetire {
+		n += 2
+	}
+	return n
+}
+
+func (m *ForwardContractInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.ForwardSaleId != 0 {
+		n += 1 + sovQuery(uint64(m.ForwardSaleId))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProjectId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EscrowDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EscrowAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DisableAutoRetire {
+		n += 2
+	}
+	l = len(m.RetirementJurisdiction)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MakerFeeBps != 0 {
+		n += 1 + sovQuery(uint64(m.MakerFeeBps))
+	}
+	if m.TakerFeeBps != 0 {
+		n += 1 + sovQuery(uint64(m.TakerFeeBps))
+	}
+	return n
+}
+
+func (m *QueryMarketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMarketRegistrationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMarketRegistrationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.Deposit != nil {
+		l = m.Deposit.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *MarketInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	l = len(m.CreditTypeAbbrev)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BankDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DisplayDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Exponent != 0 {
+		n += 1 + sovQuery(uint64(m.Exponent))
+	}
+	l = len(m.Registrant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Delisted {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QuerySellOrderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySellOrderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySellOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellOrderId", wireType)
+			}
+			m.SellOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SellOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySellOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySellOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySellOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SellOrder == nil {
+				m.SellOrder = &SellOrderInfo{}
+			}
+			if err := m.SellOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySellOrdersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySellOrdersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySellOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludePrivate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludePrivate = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySellOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySellOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySellOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SellOrders = append(m.SellOrders, &SellOrderInfo{})
+			if err := m.SellOrders[len(m.SellOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySellOrdersByBatchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySellOrdersByBatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySellOrdersByBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludePrivate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludePrivate = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySellOrdersByBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySellOrdersByBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySellOrdersByBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SellOrders = append(m.SellOrders, &SellOrderInfo{})
+			if err := m.SellOrders[len(m.SellOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySellOrdersBySellerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySellOrdersBySellerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySellOrdersBySellerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludePrivate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludePrivate = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySellOrdersBySellerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySellOrdersBySellerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySellOrdersBySellerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SellOrders = append(m.SellOrders, &SellOrderInfo{})
+			if err := m.SellOrders[len(m.SellOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySellOrdersPendingExpiryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySellOrdersPendingExpiryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySellOrdersPendingExpiryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresBefore == nil {
+				m.ExpiresBefore = &types.Timestamp{}
+			}
+			if err := m.ExpiresBefore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludePrivate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludePrivate = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySellOrdersPendingExpiryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySellOrdersPendingExpiryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySellOrdersPendingExpiryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SellOrders = append(m.SellOrders, &SellOrderInfo{})
+			if err := m.SellOrders[len(m.SellOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySellOrdersByClassRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySellOrdersByClassRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySellOrdersByClassRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludePrivate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludePrivate = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectJurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectJurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBatchStartDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MinBatchStartDate == nil {
+				m.MinBatchStartDate = &types.Timestamp{}
+			}
+			if err := m.MinBatchStartDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchStartDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxBatchStartDate == nil {
+				m.MaxBatchStartDate = &types.Timestamp{}
+			}
+			if err := m.MaxBatchStartDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySellOrdersByClassResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySellOrdersByClassResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySellOrdersByClassResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SellOrders = append(m.SellOrders, &SellOrderInfo{})
+			if err := m.SellOrders[len(m.SellOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySellOrdersByProjectRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySellOrdersByProjectRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySellOrdersByProjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludePrivate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludePrivate = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBatchStartDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MinBatchStartDate == nil {
+				m.MinBatchStartDate = &types.Timestamp{}
+			}
+			if err := m.MinBatchStartDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchStartDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxBatchStartDate == nil {
+				m.MaxBatchStartDate = &types.Timestamp{}
+			}
+			if err := m.MaxBatchStartDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySellOrdersByProjectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySellOrdersByProjectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySellOrdersByProjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SellOrders = append(m.SellOrders, &SellOrderInfo{})
+			if err := m.SellOrders[len(m.SellOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySellOrdersByMarketRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySellOrdersByMarketRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySellOrdersByMarketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludePrivate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludePrivate = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySellOrdersByMarketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySellOrdersByMarketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySellOrdersByMarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SellOrders = append(m.SellOrders, &SellOrderInfo{})
+			if err := m.SellOrders[len(m.SellOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBuyOrderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBuyOrderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBuyOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyOrderId", wireType)
+			}
+			m.BuyOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BuyOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBuyOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBuyOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBuyOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BuyOrder == nil {
+				m.BuyOrder = &BuyOrderInfo{}
+			}
+			if err := m.BuyOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBuyOrdersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBuyOrdersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBuyOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBuyOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBuyOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBuyOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuyOrders = append(m.BuyOrders, &BuyOrderInfo{})
+			if err := m.BuyOrders[len(m.BuyOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBuyOrdersByBuyerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBuyOrdersByBuyerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBuyOrdersByBuyerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBuyOrdersByBuyerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBuyOrdersByBuyerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBuyOrdersByBuyerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuyOrders = append(m.BuyOrders, &BuyOrderInfo{})
+			if err := m.BuyOrders[len(m.BuyOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOfferRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOfferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOfferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferId", wireType)
+			}
+			m.OfferId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OfferId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOfferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOfferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOfferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Offer == nil {
+				m.Offer = &OfferInfo{}
+			}
+			if err := m.Offer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOffersByBatchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOffersByBatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOffersByBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOffersByBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOffersByBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOffersByBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Offers = append(m.Offers, &OfferInfo{})
+			if err := m.Offers[len(m.Offers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOffersByBuyerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOffersByBuyerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOffersByBuyerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOffersByBuyerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOffersByBuyerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOffersByBuyerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Offers = append(m.Offers, &OfferInfo{})
+			if err := m.Offers[len(m.Offers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOffersByHolderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOffersByHolderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOffersByHolderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOffersByHolderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOffersByHolderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOffersByHolderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Offers = append(m.Offers, &OfferInfo{})
+			if err := m.Offers[len(m.Offers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDutchAuctionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDutchAuctionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDutchAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDutchAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDutchAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDutchAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Auction == nil {
+				m.Auction = &DutchAuctionInfo{}
+			}
+			if err := m.Auction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDutchAuctionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDutchAuctionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDutchAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDutchAuctionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDutchAuctionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDutchAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctions = append(m.Auctions, &DutchAuctionInfo{})
+			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySealedBidAuctionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySealedBidAuctionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySealedBidAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySealedBidAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySealedBidAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySealedBidAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Auction == nil {
+				m.Auction = &SealedBidAuctionInfo{}
+			}
+			if err := m.Auction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySealedBidAuctionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySealedBidAuctionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySealedBidAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySealedBidAuctionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySealedBidAuctionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySealedBidAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctions = append(m.Auctions, &SealedBidAuctionInfo{})
+			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySealedBidsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySealedBidsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySealedBidsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySealedBidsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySealedBidsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySealedBidsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bids = append(m.Bids, &SealedBidInfo{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryForwardSaleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryForwardSaleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryForwardSaleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardSaleId", wireType)
+			}
+			m.ForwardSaleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwardSaleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryForwardSaleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryForwardSaleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryForwardSaleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardSale", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ForwardSale == nil {
+				m.ForwardSale = &ForwardSaleInfo{}
+			}
+			if err := m.ForwardSale.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryForwardSalesByProjectRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryForwardSalesByProjectRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryForwardSalesByProjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryForwardSalesByProjectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryForwardSalesByProjectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryForwardSalesByProjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardSales", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardSales = append(m.ForwardSales, &ForwardSaleInfo{})
+			if err := m.ForwardSales[len(m.ForwardSales)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryForwardContractRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryForwardContractRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryForwardContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardContractId", wireType)
+			}
+			m.ForwardContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwardContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryForwardContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryForwardContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryForwardContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardContract", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ForwardContract == nil {
+				m.ForwardContract = &ForwardContractInfo{}
+			}
+			if err := m.ForwardContract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryForwardContractsBySaleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryForwardContractsBySaleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryForwardContractsBySaleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardSaleId", wireType)
+			}
+			m.ForwardSaleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwardSaleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryForwardContractsBySaleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryForwardContractsBySaleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryForwardContractsBySaleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardContracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardContracts = append(m.ForwardContracts, &ForwardContractInfo{})
+			if err := m.ForwardContracts[len(m.ForwardContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryForwardContractsByBuyerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryForwardContractsByBuyerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryForwardContractsByBuyerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryForwardContractsByBuyerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryForwardContractsByBuyerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryForwardContractsByBuyerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardContracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardContracts = append(m.ForwardContracts, &ForwardContractInfo{})
+			if err := m.ForwardContracts[len(m.ForwardContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowedDenomsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowedDenomsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowedDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowedDenomsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowedDenomsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowedDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, &AllowedDenom{})
+			if err := m.AllowedDenoms[len(m.AllowedDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeBps", wireType)
+			}
+			m.MakerFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MakerFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeBps", wireType)
+			}
+			m.TakerFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TakerFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collected", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Collected == nil {
+				m.Collected = &types1.Coin{}
+			}
+			if err := m.Collected.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeDestinationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeDestinationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeDestinationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeDestinationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeDestinationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeDestinationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			m.Destination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Destination |= FeeDestinationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTradesByBatchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTradesByBatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTradesByBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTradesByBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTradesByBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTradesByBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trades = append(m.Trades, &TradeInfo{})
+			if err := m.Trades[len(m.Trades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTradesByMarketRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTradesByMarketRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTradesByMarketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTradesByMarketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTradesByMarketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTradesByMarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trades = append(m.Trades, &TradeInfo{})
+			if err := m.Trades[len(m.Trades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTradesByAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTradesByAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTradesByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTradesByAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTradesByAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTradesByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trades = append(m.Trades, &TradeInfo{})
+			if err := m.Trades[len(m.Trades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSeconds", wireType)
+			}
+			m.WindowSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BankDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTradeTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastTradeTime == nil {
+				m.LastTradeTime = &types.Timestamp{}
+			}
+			if err := m.LastTradeTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeCount", wireType)
+			}
+			m.TradeCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Volume = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Notional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Notional = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vwap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vwap = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.High = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Low = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WindowStart == nil {
+				m.WindowStart = &types.Timestamp{}
+			}
+			if err := m.WindowStart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TradeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TradeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TradeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellOrderId", wireType)
+			}
+			m.SellOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SellOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyOrderId", wireType)
+			}
+			m.BuyOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BuyOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BankDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnitPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &types.Timestamp{}
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferId", wireType)
+			}
+			m.OfferId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OfferId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutchAuctionId", wireType)
+			}
+			m.DutchAuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DutchAuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealedBidAuctionId", wireType)
+			}
+			m.SealedBidAuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SealedBidAuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardContractId", wireType)
+			}
+			m.ForwardContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwardContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SellOrderInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SellOrderInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SellOrderInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AskDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AskAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableAutoRetire = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = &types.Timestamp{}
+			}
+			if err := m.Expiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedBuyers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedBuyers = append(m.AllowedBuyers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyerGroupPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuyerGroupPolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFillQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinFillQuantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllOrNone", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllOrNone = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BuyOrderInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BuyOrderInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BuyOrderInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &BuyOrder_Filter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableAutoRetire = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementJurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetirementJurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = &types.Timestamp{}
+			}
+			if err := m.Expiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeBps", wireType)
+			}
+			m.TakerFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TakerFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OfferInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OfferInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OfferInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableAutoRetire = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementJurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetirementJurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = &types.Timestamp{}
+			}
+			if err := m.Expiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeBps", wireType)
+			}
+			m.MakerFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MakerFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DutchAuctionInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DutchAuctionInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DutchAuctionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AskDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloorAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FloorAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = &types.Timestamp{}
+			}
+			if err := m.StartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = &types.Timestamp{}
+			}
+			if err := m.EndTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableAutoRetire = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SealedBidAuctionInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SealedBidAuctionInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SealedBidAuctionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitEndHeight", wireType)
+			}
+			m.CommitEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealEndHeight", wireType)
+			}
+			m.RevealEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableAutoRetire = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeBps", wireType)
+			}
+			m.MakerFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MakerFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeBps", wireType)
+			}
+			m.TakerFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TakerFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SealedBidInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SealedBidInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SealedBidInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revealed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revealed = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySellOrderRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySellOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SellOrderId", wireType)
+			m.Quantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidAmount", wireType)
 			}
-			m.SellOrderId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1738,11 +22249,24 @@ func (m *QuerySellOrderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SellOrderId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1764,7 +22288,7 @@ func (m *QuerySellOrderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySellOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *ForwardSaleInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1787,15 +22311,98 @@ func (m *QuerySellOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySellOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ForwardSaleInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySellOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ForwardSaleInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SellOrder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VintageStart", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1822,66 +22429,148 @@ func (m *QuerySellOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SellOrder == nil {
-				m.SellOrder = &SellOrderInfo{}
+			if m.VintageStart == nil {
+				m.VintageStart = &types.Timestamp{}
 			}
-			if err := m.SellOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VintageStart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VintageEnd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VintageEnd == nil {
+				m.VintageEnd = &types.Timestamp{}
+			}
+			if err := m.VintageEnd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QuerySellOrdersRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Quantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AskDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySellOrdersRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySellOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AskAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryDeadline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1908,13 +22597,33 @@ func (m *QuerySellOrdersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.DeliveryDeadline == nil {
+				m.DeliveryDeadline = &types.Timestamp{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DeliveryDeadline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableAutoRetire = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1936,7 +22645,7 @@ func (m *QuerySellOrdersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySellOrdersResponse) Unmarshal(dAtA []byte) error {
+func (m *ForwardContractInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1959,17 +22668,55 @@ func (m *QuerySellOrdersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySellOrdersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ForwardContractInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySellOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ForwardContractInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardSaleId", wireType)
+			}
+			m.ForwardSaleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwardSaleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SellOrders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1979,31 +22726,29 @@ func (m *QuerySellOrdersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SellOrders = append(m.SellOrders, &SellOrderInfo{})
-			if err := m.SellOrders[len(m.SellOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2013,81 +22758,27 @@ func (m *QuerySellOrdersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ProjectId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QuerySellOrdersByBatchRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySellOrdersByBatchRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySellOrdersByBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2115,13 +22806,13 @@ func (m *QuerySellOrdersByBatchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BatchDenom = string(dAtA[iNdEx:postIndex])
+			m.Quantity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2131,83 +22822,29 @@ func (m *QuerySellOrdersByBatchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EscrowDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QuerySellOrdersByBatchResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySellOrdersByBatchResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySellOrdersByBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SellOrders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2217,31 +22854,49 @@ func (m *QuerySellOrdersByBatchResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SellOrders = append(m.SellOrders, &SellOrderInfo{})
-			if err := m.SellOrders[len(m.SellOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EscrowAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableAutoRetire = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementJurisdiction", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2251,28 +22906,62 @@ func (m *QuerySellOrdersByBatchResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			m.RetirementJurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeBps", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MakerFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MakerFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeBps", wireType)
+			}
+			m.TakerFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TakerFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2294,7 +22983,7 @@ func (m *QuerySellOrdersByBatchResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySellOrdersBySellerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMarketsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2317,45 +23006,13 @@ func (m *QuerySellOrdersBySellerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySellOrdersBySellerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMarketsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySellOrdersBySellerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMarketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Seller = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2412,7 +23069,7 @@ func (m *QuerySellOrdersBySellerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySellOrdersBySellerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMarketsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2435,15 +23092,15 @@ func (m *QuerySellOrdersBySellerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySellOrdersBySellerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMarketsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySellOrdersBySellerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SellOrders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2470,8 +23127,8 @@ func (m *QuerySellOrdersBySellerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SellOrders = append(m.SellOrders, &SellOrderInfo{})
-			if err := m.SellOrders[len(m.SellOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Markets = append(m.Markets, &MarketInfo{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2532,7 +23189,7 @@ func (m *QuerySellOrdersBySellerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowedDenomsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMarketRegistrationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2555,48 +23212,12 @@ func (m *QueryAllowedDenomsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowedDenomsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMarketRegistrationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowedDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMarketRegistrationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2618,7 +23239,7 @@ func (m *QueryAllowedDenomsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowedDenomsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMarketRegistrationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2641,17 +23262,17 @@ func (m *QueryAllowedDenomsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowedDenomsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMarketRegistrationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowedDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMarketRegistrationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2661,29 +23282,15 @@ func (m *QueryAllowedDenomsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AllowedDenoms = append(m.AllowedDenoms, &AllowedDenom{})
-			if err := m.AllowedDenoms[len(m.AllowedDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2710,10 +23317,10 @@ func (m *QueryAllowedDenomsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Deposit == nil {
+				m.Deposit = &types1.Coin{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2738,7 +23345,7 @@ func (m *QueryAllowedDenomsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SellOrderInfo) Unmarshal(dAtA []byte) error {
+func (m *MarketInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2761,10 +23368,10 @@ func (m *SellOrderInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SellOrderInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SellOrderInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2788,7 +23395,7 @@ func (m *SellOrderInfo) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2816,11 +23423,11 @@ func (m *SellOrderInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Seller = string(dAtA[iNdEx:postIndex])
+			m.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2848,11 +23455,11 @@ func (m *SellOrderInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BatchDenom = string(dAtA[iNdEx:postIndex])
+			m.BankDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2880,13 +23487,13 @@ func (m *SellOrderInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Quantity = string(dAtA[iNdEx:postIndex])
+			m.DisplayDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskDenom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
 			}
-			var stringLen uint64
+			m.Exponent = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2896,27 +23503,14 @@ func (m *SellOrderInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Exponent |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AskDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Registrant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2944,11 +23538,11 @@ func (m *SellOrderInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AskAmount = string(dAtA[iNdEx:postIndex])
+			m.Registrant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delisted", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -2965,43 +23559,7 @@ func (m *SellOrderInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.DisableAutoRetire = bool(v != 0)
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Expiration == nil {
-				m.Expiration = &types.Timestamp{}
-			}
-			if err := m.Expiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Delisted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -24,6 +24,43 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// FeeDestinationType is the type of destination of marketplace fees.
+type FeeDestinationType int32
+
+const (
+	// FEE_DESTINATION_TYPE_UNSPECIFIED defaults to the community pool.
+	FeeDestinationType_FEE_DESTINATION_TYPE_UNSPECIFIED FeeDestinationType = 0
+	// FEE_DESTINATION_TYPE_COMMUNITY_POOL sends fees to the community pool.
+	FeeDestinationType_FEE_DESTINATION_TYPE_COMMUNITY_POOL FeeDestinationType = 1
+	// FEE_DESTINATION_TYPE_BURN burns fees.
+	FeeDestinationType_FEE_DESTINATION_TYPE_BURN FeeDestinationType = 2
+	// FEE_DESTINATION_TYPE_ACCOUNT sends fees to an account such as a fee
+	// module account.
+	FeeDestinationType_FEE_DESTINATION_TYPE_ACCOUNT FeeDestinationType = 3
+)
+
+var FeeDestinationType_name = map[int32]string{
+	0: "FEE_DESTINATION_TYPE_UNSPECIFIED",
+	1: "FEE_DESTINATION_TYPE_COMMUNITY_POOL",
+	2: "FEE_DESTINATION_TYPE_BURN",
+	3: "FEE_DESTINATION_TYPE_ACCOUNT",
+}
+
+var FeeDestinationType_value = map[string]int32{
+	"FEE_DESTINATION_TYPE_UNSPECIFIED":    0,
+	"FEE_DESTINATION_TYPE_COMMUNITY_POOL": 1,
+	"FEE_DESTINATION_TYPE_BURN":           2,
+	"FEE_DESTINATION_TYPE_ACCOUNT":        3,
+}
+
+func (x FeeDestinationType) String() string {
+	return proto.EnumName(FeeDestinationType_name, int32(x))
+}
+
+func (FeeDestinationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{0}
+}
+
 // SellOrder represents the information for a sell order.
 type SellOrder struct {
 	// id is the unique ID of sell order.
@@ -53,6 +90,33 @@ type SellOrder struct {
 	// maker indicates that this is a maker order, meaning that when it hit
 	// the order book, there were no matching buy orders.
 	Maker bool `protobuf:"varint,10,opt,name=maker,proto3" json:"maker,omitempty"`
+	// allowed_buyers is an optional list of addresses that are allowed to buy
+	// credits from the sell order. When set (or when buyer_group_policy is set),
+	// the sell order is private and can only be filled by the listed addresses.
+	AllowedBuyers [][]byte `protobuf:"bytes,11,rep,name=allowed_buyers,json=allowedBuyers,proto3" json:"allowed_buyers,omitempty"`
+	// buyer_group_policy is an optional group policy address that is allowed to
+	// buy credits from the sell order.
+	BuyerGroupPolicy []byte `protobuf:"bytes,12,opt,name=buyer_group_policy,json=buyerGroupPolicy,proto3" json:"buyer_group_policy,omitempty"`
+	// class_key is the table row identifier of the credit class of the credit
+	// batch used internally for efficient lookups of sell orders by class.
+	ClassKey uint64 `protobuf:"varint,13,opt,name=class_key,json=classKey,proto3" json:"class_key,omitempty"`
+	// project_key is the table row identifier of the project of the credit
+	// batch used internally for efficient lookups of sell orders by project.
+	ProjectKey uint64 `protobuf:"varint,14,opt,name=project_key,json=projectKey,proto3" json:"project_key,omitempty"`
+	// batch_start_date is the start date of the credit batch used internally
+	// for efficient lookups of sell orders by batch start date.
+	BatchStartDate *types.Timestamp `protobuf:"bytes,15,opt,name=batch_start_date,json=batchStartDate,proto3" json:"batch_start_date,omitempty"`
+	// ask_amount_key is the big-endian encoding of ask_amount used internally
+	// to order sell orders by ask price within a market.
+	AskAmountKey []byte `protobuf:"bytes,16,opt,name=ask_amount_key,json=askAmountKey,proto3" json:"ask_amount_key,omitempty"`
+	// min_fill_quantity is the optional minimum quantity of credits that must be
+	// purchased in a single fill. A fill of less than the minimum quantity is
+	// only allowed if it purchases the entire remaining quantity of the sell
+	// order.
+	MinFillQuantity string `protobuf:"bytes,17,opt,name=min_fill_quantity,json=minFillQuantity,proto3" json:"min_fill_quantity,omitempty"`
+	// all_or_none requires the entire remaining quantity of the sell order to be
+	// purchased in a single fill.
+	AllOrNone bool `protobuf:"varint,18,opt,name=all_or_none,json=allOrNone,proto3" json:"all_or_none,omitempty"`
 }
 
 func (m *SellOrder) Reset()         { *m = SellOrder{} }
@@ -151,31 +215,112 @@ func (m *SellOrder) GetMaker() bool {
 	return false
 }
 
-// AllowedDenom represents the information for an allowed ask/bid denom.
-type AllowedDenom struct {
-	// denom is the bank denom to allow (ex. ibc/GLKHDSG423SGS)
-	BankDenom string `protobuf:"bytes,1,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
-	// display_denom is the denom to display to the user and is informational.
-	// Because the denom is likely an IBC denom, this should be chosen by
-	// governance to represent the consensus trusted name of the denom.
-	DisplayDenom string `protobuf:"bytes,2,opt,name=display_denom,json=displayDenom,proto3" json:"display_denom,omitempty"`
-	// exponent is the exponent that relates the denom to the display_denom and is
-	// informational
-	Exponent uint32 `protobuf:"varint,3,opt,name=exponent,proto3" json:"exponent,omitempty"`
+func (m *SellOrder) GetAllowedBuyers() [][]byte {
+	if m != nil {
+		return m.AllowedBuyers
+	}
+	return nil
 }
 
-func (m *AllowedDenom) Reset()         { *m = AllowedDenom{} }
-func (m *AllowedDenom) String() string { return proto.CompactTextString(m) }
-func (*AllowedDenom) ProtoMessage()    {}
-func (*AllowedDenom) Descriptor() ([]byte, []int) {
+func (m *SellOrder) GetBuyerGroupPolicy() []byte {
+	if m != nil {
+		return m.BuyerGroupPolicy
+	}
+	return nil
+}
+
+func (m *SellOrder) GetClassKey() uint64 {
+	if m != nil {
+		return m.ClassKey
+	}
+	return 0
+}
+
+func (m *SellOrder) GetProjectKey() uint64 {
+	if m != nil {
+		return m.ProjectKey
+	}
+	return 0
+}
+
+func (m *SellOrder) GetBatchStartDate() *types.Timestamp {
+	if m != nil {
+		return m.BatchStartDate
+	}
+	return nil
+}
+
+func (m *SellOrder) GetAskAmountKey() []byte {
+	if m != nil {
+		return m.AskAmountKey
+	}
+	return nil
+}
+
+func (m *SellOrder) GetMinFillQuantity() string {
+	if m != nil {
+		return m.MinFillQuantity
+	}
+	return ""
+}
+
+func (m *SellOrder) GetAllOrNone() bool {
+	if m != nil {
+		return m.AllOrNone
+	}
+	return false
+}
+
+// BuyOrder represents the information for a buy order.
+type BuyOrder struct {
+	// id is the unique ID of buy order.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// buyer is the address of the account that is buying credits.
+	Buyer []byte `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// filter selects the credit batches that the buy order can be matched
+	// against. A sell order is matched when its credit batch satisfies at least
+	// one of the filter criteria.
+	Filter *BuyOrder_Filter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// quantity is the decimal quantity of credits remaining to be bought.
+	Quantity string `protobuf:"bytes,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// market_id is the market in which this buy order exists and specifies
+	// the bank_denom that bid_amount corresponds to forming the bid_price.
+	MarketId uint64 `protobuf:"varint,5,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// bid_amount is the integer amount (encoded as a string) that the buyer is
+	// willing to pay for each credit unit. The bid_amount corresponds to the
+	// Market.denom to form the bid price. The total bid amount for the remaining
+	// quantity is held in escrow by the ecocredit module account.
+	BidAmount string `protobuf:"bytes,6,opt,name=bid_amount,json=bidAmount,proto3" json:"bid_amount,omitempty"`
+	// disable_auto_retire disables auto-retirement of credits which allows a
+	// buyer to receive credits in a tradable state. A buy order with
+	// auto-retirement disabled is only matched with sell orders that also have
+	// auto-retirement disabled.
+	DisableAutoRetire bool `protobuf:"varint,7,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
+	// retirement_jurisdiction is the optional retirement jurisdiction for the
+	// credits which will be used only if disable_auto_retire is false.
+	RetirementJurisdiction string `protobuf:"bytes,8,opt,name=retirement_jurisdiction,json=retirementJurisdiction,proto3" json:"retirement_jurisdiction,omitempty"`
+	// expiration is an optional timestamp when the buy order expires. When the
+	// expiration time is reached, the buy order is removed from state and the
+	// escrowed funds are returned to the buyer.
+	Expiration *types.Timestamp `protobuf:"bytes,9,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// taker_fee_bps is the taker fee in basis points that applied to the market
+	// when the buy order was created. The taker fee for the total quantity of the
+	// buy order is held in escrow along with the bid amount.
+	TakerFeeBps uint32 `protobuf:"varint,10,opt,name=taker_fee_bps,json=takerFeeBps,proto3" json:"taker_fee_bps,omitempty"`
+}
+
+func (m *BuyOrder) Reset()         { *m = BuyOrder{} }
+func (m *BuyOrder) String() string { return proto.CompactTextString(m) }
+func (*BuyOrder) ProtoMessage()    {}
+func (*BuyOrder) Descriptor() ([]byte, []int) {
 	return fileDescriptor_718b9cb8f10a9f3c, []int{1}
 }
-func (m *AllowedDenom) XXX_Unmarshal(b []byte) error {
+func (m *BuyOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AllowedDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BuyOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AllowedDenom.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BuyOrder.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -185,95 +330,109 @@ func (m *AllowedDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *AllowedDenom) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AllowedDenom.Merge(m, src)
+func (m *BuyOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuyOrder.Merge(m, src)
 }
-func (m *AllowedDenom) XXX_Size() int {
+func (m *BuyOrder) XXX_Size() int {
 	return m.Size()
 }
-func (m *AllowedDenom) XXX_DiscardUnknown() {
-	xxx_messageInfo_AllowedDenom.DiscardUnknown(m)
+func (m *BuyOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuyOrder.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AllowedDenom proto.InternalMessageInfo
+var xxx_messageInfo_BuyOrder proto.InternalMessageInfo
 
-func (m *AllowedDenom) GetBankDenom() string {
+func (m *BuyOrder) GetId() uint64 {
 	if m != nil {
-		return m.BankDenom
+		return m.Id
+	}
+	return 0
+}
+
+func (m *BuyOrder) GetBuyer() []byte {
+	if m != nil {
+		return m.Buyer
+	}
+	return nil
+}
+
+func (m *BuyOrder) GetFilter() *BuyOrder_Filter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *BuyOrder) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
 	}
 	return ""
 }
 
-func (m *AllowedDenom) GetDisplayDenom() string {
+func (m *BuyOrder) GetMarketId() uint64 {
 	if m != nil {
-		return m.DisplayDenom
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *BuyOrder) GetBidAmount() string {
+	if m != nil {
+		return m.BidAmount
 	}
 	return ""
 }
 
-func (m *AllowedDenom) GetExponent() uint32 {
+func (m *BuyOrder) GetDisableAutoRetire() bool {
 	if m != nil {
-		return m.Exponent
+		return m.DisableAutoRetire
+	}
+	return false
+}
+
+func (m *BuyOrder) GetRetirementJurisdiction() string {
+	if m != nil {
+		return m.RetirementJurisdiction
+	}
+	return ""
+}
+
+func (m *BuyOrder) GetExpiration() *types.Timestamp {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func (m *BuyOrder) GetTakerFeeBps() uint32 {
+	if m != nil {
+		return m.TakerFeeBps
 	}
 	return 0
 }
 
-// Market describes a distinctly processed market between a credit type and
-// allowed bank denom. Each market has its own precision in the order book
-// and is processed independently of other markets. Governance must enable
-// markets one by one. Every additional enabled market potentially adds more
-// processing overhead to the blockchain and potentially weakens liquidity in
-// competing markets. For instance, enabling side by side USD/Carbon and
-// EUR/Carbon markets may have the end result that each market individually has
-// less liquidity and longer settlement times. Such decisions should be taken
-// with care.
-type Market struct {
-	// id is the unique ID of the market.
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// credit_type_abbrev is the abbreviation of the credit type.
-	CreditTypeAbbrev string `protobuf:"bytes,2,opt,name=credit_type_abbrev,json=creditTypeAbbrev,proto3" json:"credit_type_abbrev,omitempty"`
-	// bank_denom is an allowed bank denom.
-	BankDenom string `protobuf:"bytes,3,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
-	// precision_modifier is an optional modifier used to convert arbitrary
-	// precision integer bank amounts to uint32 values used for sorting in the
-	// order book. Given an arbitrary precision integer x, its uint32 conversion
-	// will be x / 10^precision_modifier using round half away from zero
-	// rounding.
-	//
-	// uint32 values range from 0 to 4,294,967,295.
-	// This allows for a full 9 digits of precision. In most real world markets
-	// this amount of precision is sufficient and most common downside -
-	// that some orders with very miniscule price differences may be ordered
-	// equivalently (because of rounding) - is acceptable.
-	// Note that this rounding will not affect settlement price which will
-	// always be done exactly.
-	//
-	// Given a USD stable coin with 6 decimal digits, a precision_modifier
-	// of 0 is probably acceptable as long as credits are always less than
-	// $4,294/unit. With precision down to $0.001 (a precision_modifier of 3
-	// in this case), prices can rise up to $4,294,000/unit. Either scenario
-	// is probably quite acceptable given that carbon prices are unlikely to
-	// rise above $1000/ton any time in the near future.
-	//
-	// If credit prices, exceed the maximum range of uint32 with this
-	// precision_modifier, orders with high prices will fail and governance
-	// will need to adjust precision_modifier to allow for higher prices in
-	// exchange for less precision at the lower end.
-	PrecisionModifier uint32 `protobuf:"varint,4,opt,name=precision_modifier,json=precisionModifier,proto3" json:"precision_modifier,omitempty"`
+// Filter is used to create filtered buy orders which match credit batch sell
+// orders based on selection criteria rather than matching individual sell
+// orders.
+type BuyOrder_Filter struct {
+	// or is a list of criteria for matching credit batches. A credit batch
+	// which matches this filter must match at least one of these criteria.
+	Or []*BuyOrder_Filter_Criteria `protobuf:"bytes,1,rep,name=or,proto3" json:"or,omitempty"`
 }
 
-func (m *Market) Reset()         { *m = Market{} }
-func (m *Market) String() string { return proto.CompactTextString(m) }
-func (*Market) ProtoMessage()    {}
-func (*Market) Descriptor() ([]byte, []int) {
-	return fileDescriptor_718b9cb8f10a9f3c, []int{2}
+func (m *BuyOrder_Filter) Reset()         { *m = BuyOrder_Filter{} }
+func (m *BuyOrder_Filter) String() string { return proto.CompactTextString(m) }
+func (*BuyOrder_Filter) ProtoMessage()    {}
+func (*BuyOrder_Filter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{1, 0}
 }
-func (m *Market) XXX_Unmarshal(b []byte) error {
+func (m *BuyOrder_Filter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Market) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BuyOrder_Filter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Market.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BuyOrder_Filter.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -283,378 +442,8475 @@ func (m *Market) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Market) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Market.Merge(m, src)
+func (m *BuyOrder_Filter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuyOrder_Filter.Merge(m, src)
 }
-func (m *Market) XXX_Size() int {
+func (m *BuyOrder_Filter) XXX_Size() int {
 	return m.Size()
 }
-func (m *Market) XXX_DiscardUnknown() {
-	xxx_messageInfo_Market.DiscardUnknown(m)
+func (m *BuyOrder_Filter) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuyOrder_Filter.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Market proto.InternalMessageInfo
+var xxx_messageInfo_BuyOrder_Filter proto.InternalMessageInfo
 
-func (m *Market) GetId() uint64 {
+func (m *BuyOrder_Filter) GetOr() []*BuyOrder_Filter_Criteria {
 	if m != nil {
-		return m.Id
+		return m.Or
 	}
-	return 0
+	return nil
 }
 
-func (m *Market) GetCreditTypeAbbrev() string {
-	if m != nil {
-		return m.CreditTypeAbbrev
-	}
-	return ""
+// Criteria is a simple filter criteria for matching a credit batch.
+type BuyOrder_Filter_Criteria struct {
+	// sum is the selector used by the criteria.
+	//
+	// Types that are valid to be assigned to Sum:
+	//	*BuyOrder_Filter_Criteria_ClassSelector
+	//	*BuyOrder_Filter_Criteria_ProjectSelector
+	//	*BuyOrder_Filter_Criteria_BatchSelector
+	Sum isBuyOrder_Filter_Criteria_Sum `protobuf_oneof:"sum"`
 }
 
-func (m *Market) GetBankDenom() string {
-	if m != nil {
-		return m.BankDenom
+func (m *BuyOrder_Filter_Criteria) Reset()         { *m = BuyOrder_Filter_Criteria{} }
+func (m *BuyOrder_Filter_Criteria) String() string { return proto.CompactTextString(m) }
+func (*BuyOrder_Filter_Criteria) ProtoMessage()    {}
+func (*BuyOrder_Filter_Criteria) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{1, 0, 0}
+}
+func (m *BuyOrder_Filter_Criteria) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BuyOrder_Filter_Criteria) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BuyOrder_Filter_Criteria.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *BuyOrder_Filter_Criteria) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuyOrder_Filter_Criteria.Merge(m, src)
+}
+func (m *BuyOrder_Filter_Criteria) XXX_Size() int {
+	return m.Size()
+}
+func (m *BuyOrder_Filter_Criteria) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuyOrder_Filter_Criteria.DiscardUnknown(m)
 }
 
-func (m *Market) GetPrecisionModifier() uint32 {
+var xxx_messageInfo_BuyOrder_Filter_Criteria proto.InternalMessageInfo
+
+type isBuyOrder_Filter_Criteria_Sum interface {
+	isBuyOrder_Filter_Criteria_Sum()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type BuyOrder_Filter_Criteria_ClassSelector struct {
+	ClassSelector *BuyOrder_ClassSelector `protobuf:"bytes,1,opt,name=class_selector,json=classSelector,proto3,oneof" json:"class_selector,omitempty"`
+}
+type BuyOrder_Filter_Criteria_ProjectSelector struct {
+	ProjectSelector *BuyOrder_ProjectSelector `protobuf:"bytes,2,opt,name=project_selector,json=projectSelector,proto3,oneof" json:"project_selector,omitempty"`
+}
+type BuyOrder_Filter_Criteria_BatchSelector struct {
+	BatchSelector *BuyOrder_BatchSelector `protobuf:"bytes,3,opt,name=batch_selector,json=batchSelector,proto3,oneof" json:"batch_selector,omitempty"`
+}
+
+func (*BuyOrder_Filter_Criteria_ClassSelector) isBuyOrder_Filter_Criteria_Sum()   {}
+func (*BuyOrder_Filter_Criteria_ProjectSelector) isBuyOrder_Filter_Criteria_Sum() {}
+func (*BuyOrder_Filter_Criteria_BatchSelector) isBuyOrder_Filter_Criteria_Sum()   {}
+
+func (m *BuyOrder_Filter_Criteria) GetSum() isBuyOrder_Filter_Criteria_Sum {
 	if m != nil {
-		return m.PrecisionModifier
+		return m.Sum
 	}
-	return 0
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*SellOrder)(nil), "regen.ecocredit.marketplace.v1.SellOrder")
-	proto.RegisterType((*AllowedDenom)(nil), "regen.ecocredit.marketplace.v1.AllowedDenom")
-	proto.RegisterType((*Market)(nil), "regen.ecocredit.marketplace.v1.Market")
+func (m *BuyOrder_Filter_Criteria) GetClassSelector() *BuyOrder_ClassSelector {
+	if x, ok := m.GetSum().(*BuyOrder_Filter_Criteria_ClassSelector); ok {
+		return x.ClassSelector
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("regen/ecocredit/marketplace/v1/state.proto", fileDescriptor_718b9cb8f10a9f3c)
+func (m *BuyOrder_Filter_Criteria) GetProjectSelector() *BuyOrder_ProjectSelector {
+	if x, ok := m.GetSum().(*BuyOrder_Filter_Criteria_ProjectSelector); ok {
+		return x.ProjectSelector
+	}
+	return nil
 }
 
-var fileDescriptor_718b9cb8f10a9f3c = []byte{
-	// 590 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x4d, 0x6b, 0x13, 0x41,
-	0x18, 0xee, 0x24, 0x6d, 0xcc, 0x8e, 0x49, 0x49, 0xa7, 0x22, 0x43, 0xa4, 0x6b, 0x68, 0x11, 0x82,
-	0xb6, 0xbb, 0x44, 0xe9, 0x25, 0xe2, 0x21, 0xe2, 0x45, 0xa4, 0x08, 0x6b, 0x41, 0xf0, 0xb2, 0xcc,
-	0xee, 0xbc, 0x4d, 0x87, 0x9d, 0xdd, 0x59, 0x67, 0x27, 0x69, 0xf3, 0x27, 0xc4, 0xbb, 0xe0, 0xef,
-	0x11, 0xbc, 0x14, 0xbc, 0x78, 0x94, 0xf6, 0xe0, 0xdd, 0x5f, 0x20, 0x3b, 0xbb, 0x8d, 0xa1, 0xf5,
-	0xf8, 0x7c, 0xcc, 0xc7, 0xf3, 0xcc, 0x3b, 0xf8, 0xb1, 0x86, 0x29, 0x64, 0x3e, 0xc4, 0x2a, 0xd6,
-	0xc0, 0x85, 0xf1, 0x53, 0xa6, 0x13, 0x30, 0xb9, 0x64, 0x31, 0xf8, 0xf3, 0x91, 0x5f, 0x18, 0x66,
-	0xc0, 0xcb, 0xb5, 0x32, 0x8a, 0xb8, 0xd6, 0xeb, 0x2d, 0xbd, 0xde, 0x8a, 0xd7, 0x9b, 0x8f, 0xfa,
-	0x0f, 0xa7, 0x4a, 0x4d, 0x25, 0xf8, 0xd6, 0x1d, 0xcd, 0x4e, 0x7c, 0x23, 0x52, 0x28, 0x0c, 0x4b,
-	0xf3, 0x6a, 0x83, 0xfe, 0x4e, 0xac, 0x8a, 0x54, 0x15, 0xbe, 0xd2, 0xa9, 0x3f, 0x1f, 0x31, 0x99,
-	0x9f, 0xb2, 0x51, 0x09, 0x2a, 0x79, 0xf7, 0x77, 0x03, 0x3b, 0xef, 0x40, 0xca, 0xb7, 0x9a, 0x83,
-	0x26, 0x9b, 0xb8, 0x21, 0x38, 0x45, 0x03, 0x34, 0x5c, 0x0f, 0x1a, 0x82, 0x93, 0xfb, 0xb8, 0x55,
-	0x80, 0x94, 0xa0, 0x69, 0x63, 0x80, 0x86, 0x9d, 0xa0, 0x46, 0xe4, 0x01, 0x76, 0x22, 0x66, 0xe2,
-	0xd3, 0x30, 0x81, 0x05, 0x6d, 0x5a, 0x7b, 0xdb, 0x12, 0x6f, 0x60, 0x41, 0xfa, 0xb8, 0xfd, 0x71,
-	0xc6, 0x32, 0x23, 0xcc, 0x82, 0xae, 0x0f, 0xd0, 0xd0, 0x09, 0x96, 0xb8, 0x5c, 0x58, 0x05, 0x08,
-	0x05, 0xa7, 0x1b, 0xd5, 0xc2, 0x8a, 0x78, 0xcd, 0xc9, 0x0e, 0xc6, 0xac, 0x48, 0x42, 0x96, 0xaa,
-	0x59, 0x66, 0x68, 0xcb, 0x2e, 0x75, 0x58, 0x91, 0x4c, 0x2c, 0x41, 0x3c, 0xbc, 0xcd, 0x45, 0xc1,
-	0x22, 0x09, 0x21, 0x9b, 0x19, 0x15, 0x6a, 0x30, 0x42, 0x03, 0xbd, 0x33, 0x40, 0xc3, 0x76, 0xb0,
-	0x55, 0x4b, 0x93, 0x99, 0x51, 0x81, 0x15, 0xc8, 0x18, 0x63, 0x38, 0xcf, 0x85, 0x66, 0x46, 0xa8,
-	0x8c, 0x3a, 0x03, 0x34, 0xbc, 0xfb, 0xb4, 0xef, 0x55, 0x7d, 0x79, 0xd7, 0x7d, 0x79, 0xc7, 0xd7,
-	0x7d, 0x05, 0x2b, 0x6e, 0x72, 0x0f, 0x6f, 0xa4, 0x2c, 0x01, 0x4d, 0xb1, 0xdd, 0xbd, 0x02, 0xe3,
-	0xe7, 0x7f, 0xbe, 0xfe, 0xf8, 0xd4, 0x3c, 0xc4, 0xad, 0xb2, 0xa6, 0x1e, 0x22, 0xdd, 0x95, 0x1a,
-	0x7a, 0x88, 0xe0, 0xeb, 0xb6, 0x7a, 0x0d, 0xb2, 0xb9, 0x7a, 0x78, 0xaf, 0x49, 0xd1, 0xee, 0x17,
-	0x84, 0x3b, 0x13, 0x29, 0xd5, 0x19, 0xf0, 0x57, 0x90, 0xa9, 0xb4, 0x8c, 0x1b, 0xb1, 0x2c, 0x09,
-	0x79, 0x89, 0x6c, 0xe9, 0x4e, 0xe0, 0x94, 0x4c, 0x25, 0xef, 0xe1, 0x2e, 0x17, 0x45, 0x2e, 0xd9,
-	0xa2, 0x76, 0x34, 0xac, 0xa3, 0x53, 0x93, 0x95, 0xa9, 0x8f, 0xdb, 0x70, 0x9e, 0xab, 0x0c, 0x32,
-	0x63, 0xdf, 0xa1, 0x1b, 0x2c, 0xf1, 0xf8, 0x89, 0xbd, 0xed, 0x23, 0xdc, 0x59, 0x3d, 0x87, 0x6c,
-	0xdf, 0xd8, 0xb6, 0x87, 0x28, 0xa2, 0xcd, 0xdd, 0xef, 0x08, 0xb7, 0x8e, 0xec, 0x43, 0xdc, 0x1a,
-	0x82, 0x7d, 0x4c, 0xaa, 0xd9, 0x0b, 0xcd, 0x22, 0x87, 0x90, 0x45, 0x91, 0x86, 0x79, 0x7d, 0x9b,
-	0x5e, 0xa5, 0x1c, 0x2f, 0x72, 0x98, 0x58, 0xfe, 0x46, 0xaa, 0xe6, 0xcd, 0x54, 0x07, 0x98, 0xe4,
-	0x1a, 0x62, 0x51, 0x08, 0x95, 0x85, 0xa9, 0xe2, 0xe2, 0x44, 0x80, 0xb6, 0x63, 0xd2, 0x0d, 0xb6,
-	0x96, 0xca, 0x51, 0x2d, 0x8c, 0x0f, 0x6d, 0x06, 0x7f, 0xd9, 0xf8, 0x1e, 0xde, 0xb9, 0x7d, 0x97,
-	0xfd, 0x7f, 0x07, 0xda, 0x34, 0xeb, 0x2f, 0xdf, 0x7f, 0xbb, 0x74, 0xd1, 0xc5, 0xa5, 0x8b, 0x7e,
-	0x5d, 0xba, 0xe8, 0xf3, 0x95, 0xbb, 0x76, 0x71, 0xe5, 0xae, 0xfd, 0xbc, 0x72, 0xd7, 0x3e, 0xbc,
-	0x98, 0x0a, 0x73, 0x3a, 0x8b, 0xbc, 0x58, 0xa5, 0xbe, 0xfd, 0x5a, 0x07, 0x19, 0x98, 0x33, 0xa5,
-	0x93, 0x1a, 0x49, 0xe0, 0x53, 0xd0, 0xfe, 0xf9, 0xff, 0x7f, 0x67, 0xd4, 0xb2, 0x73, 0xf3, 0xec,
-	0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe1, 0x10, 0x92, 0xf5, 0xc3, 0x03, 0x00, 0x00,
+func (m *BuyOrder_Filter_Criteria) GetBatchSelector() *BuyOrder_BatchSelector {
+	if x, ok := m.GetSum().(*BuyOrder_Filter_Criteria_BatchSelector); ok {
+		return x.BatchSelector
+	}
+	return nil
 }
 
-func (m *SellOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*BuyOrder_Filter_Criteria) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*BuyOrder_Filter_Criteria_ClassSelector)(nil),
+		(*BuyOrder_Filter_Criteria_ProjectSelector)(nil),
+		(*BuyOrder_Filter_Criteria_BatchSelector)(nil),
 	}
-	return dAtA[:n], nil
 }
 
-func (m *SellOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ClassSelector is a selector for a credit class.
+type BuyOrder_ClassSelector struct {
+	// class_id is the unique identifier of the credit class.
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// project_jurisdiction is an optional jurisdiction prefix that the project
+	// jurisdiction must match (e.g. "US" or "US-WA").
+	ProjectJurisdiction string `protobuf:"bytes,2,opt,name=project_jurisdiction,json=projectJurisdiction,proto3" json:"project_jurisdiction,omitempty"`
+	// min_start_date is an optional minimum start date of the credit batch.
+	MinStartDate *types.Timestamp `protobuf:"bytes,3,opt,name=min_start_date,json=minStartDate,proto3" json:"min_start_date,omitempty"`
+	// max_end_date is an optional maximum end date of the credit batch.
+	MaxEndDate *types.Timestamp `protobuf:"bytes,4,opt,name=max_end_date,json=maxEndDate,proto3" json:"max_end_date,omitempty"`
 }
 
-func (m *SellOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Maker {
-		i--
-		if m.Maker {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.Expiration != nil {
-		{
-			size, err := m.Expiration.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintState(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.DisableAutoRetire {
-		i--
-		if m.DisableAutoRetire {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *BuyOrder_ClassSelector) Reset()         { *m = BuyOrder_ClassSelector{} }
+func (m *BuyOrder_ClassSelector) String() string { return proto.CompactTextString(m) }
+func (*BuyOrder_ClassSelector) ProtoMessage()    {}
+func (*BuyOrder_ClassSelector) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{1, 1}
+}
+func (m *BuyOrder_ClassSelector) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BuyOrder_ClassSelector) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BuyOrder_ClassSelector.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.AskAmount) > 0 {
-		i -= len(m.AskAmount)
-		copy(dAtA[i:], m.AskAmount)
-		i = encodeVarintState(dAtA, i, uint64(len(m.AskAmount)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Quantity) > 0 {
-		i -= len(m.Quantity)
-		copy(dAtA[i:], m.Quantity)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Quantity)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.BatchKey != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Seller) > 0 {
-		i -= len(m.Seller)
-		copy(dAtA[i:], m.Seller)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Seller)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BuyOrder_ClassSelector) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuyOrder_ClassSelector.Merge(m, src)
+}
+func (m *BuyOrder_ClassSelector) XXX_Size() int {
+	return m.Size()
+}
+func (m *BuyOrder_ClassSelector) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuyOrder_ClassSelector.DiscardUnknown(m)
 }
 
-func (m *AllowedDenom) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BuyOrder_ClassSelector proto.InternalMessageInfo
+
+func (m *BuyOrder_ClassSelector) GetClassId() string {
+	if m != nil {
+		return m.ClassId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *AllowedDenom) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BuyOrder_ClassSelector) GetProjectJurisdiction() string {
+	if m != nil {
+		return m.ProjectJurisdiction
+	}
+	return ""
 }
 
-func (m *AllowedDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Exponent != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.Exponent))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.DisplayDenom) > 0 {
-		i -= len(m.DisplayDenom)
-		copy(dAtA[i:], m.DisplayDenom)
-		i = encodeVarintState(dAtA, i, uint64(len(m.DisplayDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.BankDenom) > 0 {
-		i -= len(m.BankDenom)
-		copy(dAtA[i:], m.BankDenom)
-		i = encodeVarintState(dAtA, i, uint64(len(m.BankDenom)))
-		i--
-		dAtA[i] = 0xa
+func (m *BuyOrder_ClassSelector) GetMinStartDate() *types.Timestamp {
+	if m != nil {
+		return m.MinStartDate
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Market) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BuyOrder_ClassSelector) GetMaxEndDate() *types.Timestamp {
+	if m != nil {
+		return m.MaxEndDate
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Market) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ProjectSelector is a selector for a project.
+type BuyOrder_ProjectSelector struct {
+	// project_id is the unique identifier of the project.
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// min_start_date is an optional minimum start date of the credit batch.
+	MinStartDate *types.Timestamp `protobuf:"bytes,2,opt,name=min_start_date,json=minStartDate,proto3" json:"min_start_date,omitempty"`
+	// max_end_date is an optional maximum end date of the credit batch.
+	MaxEndDate *types.Timestamp `protobuf:"bytes,3,opt,name=max_end_date,json=maxEndDate,proto3" json:"max_end_date,omitempty"`
 }
 
-func (m *Market) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PrecisionModifier != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.PrecisionModifier))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.BankDenom) > 0 {
-		i -= len(m.BankDenom)
-		copy(dAtA[i:], m.BankDenom)
-		i = encodeVarintState(dAtA, i, uint64(len(m.BankDenom)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.CreditTypeAbbrev) > 0 {
-		i -= len(m.CreditTypeAbbrev)
-		copy(dAtA[i:], m.CreditTypeAbbrev)
-		i = encodeVarintState(dAtA, i, uint64(len(m.CreditTypeAbbrev)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *BuyOrder_ProjectSelector) Reset()         { *m = BuyOrder_ProjectSelector{} }
+func (m *BuyOrder_ProjectSelector) String() string { return proto.CompactTextString(m) }
+func (*BuyOrder_ProjectSelector) ProtoMessage()    {}
+func (*BuyOrder_ProjectSelector) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{1, 2}
+}
+func (m *BuyOrder_ProjectSelector) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BuyOrder_ProjectSelector) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BuyOrder_ProjectSelector.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BuyOrder_ProjectSelector) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuyOrder_ProjectSelector.Merge(m, src)
+}
+func (m *BuyOrder_ProjectSelector) XXX_Size() int {
+	return m.Size()
+}
+func (m *BuyOrder_ProjectSelector) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuyOrder_ProjectSelector.DiscardUnknown(m)
 }
 
-func encodeVarintState(dAtA []byte, offset int, v uint64) int {
-	offset -= sovState(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_BuyOrder_ProjectSelector proto.InternalMessageInfo
+
+func (m *BuyOrder_ProjectSelector) GetProjectId() string {
+	if m != nil {
+		return m.ProjectId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *SellOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovState(uint64(m.Id))
-	}
-	l = len(m.Seller)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
-	}
-	if m.BatchKey != 0 {
-		n += 1 + sovState(uint64(m.BatchKey))
-	}
-	l = len(m.Quantity)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
-	}
-	if m.MarketId != 0 {
-		n += 1 + sovState(uint64(m.MarketId))
-	}
-	l = len(m.AskAmount)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
-	}
-	if m.DisableAutoRetire {
-		n += 2
+
+func (m *BuyOrder_ProjectSelector) GetMinStartDate() *types.Timestamp {
+	if m != nil {
+		return m.MinStartDate
 	}
-	if m.Expiration != nil {
-		l = m.Expiration.Size()
-		n += 1 + l + sovState(uint64(l))
+	return nil
+}
+
+func (m *BuyOrder_ProjectSelector) GetMaxEndDate() *types.Timestamp {
+	if m != nil {
+		return m.MaxEndDate
 	}
-	if m.Maker {
-		n += 2
+	return nil
+}
+
+// BatchSelector is a selector for a credit batch.
+type BuyOrder_BatchSelector struct {
+	// batch_denom is the unique identifier of the credit batch.
+	BatchDenom string `protobuf:"bytes,1,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+}
+
+func (m *BuyOrder_BatchSelector) Reset()         { *m = BuyOrder_BatchSelector{} }
+func (m *BuyOrder_BatchSelector) String() string { return proto.CompactTextString(m) }
+func (*BuyOrder_BatchSelector) ProtoMessage()    {}
+func (*BuyOrder_BatchSelector) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{1, 3}
+}
+func (m *BuyOrder_BatchSelector) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BuyOrder_BatchSelector) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BuyOrder_BatchSelector.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *BuyOrder_BatchSelector) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuyOrder_BatchSelector.Merge(m, src)
+}
+func (m *BuyOrder_BatchSelector) XXX_Size() int {
+	return m.Size()
+}
+func (m *BuyOrder_BatchSelector) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuyOrder_BatchSelector.DiscardUnknown(m)
 }
 
-func (m *AllowedDenom) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_BuyOrder_BatchSelector proto.InternalMessageInfo
+
+func (m *BuyOrder_BatchSelector) GetBatchDenom() string {
+	if m != nil {
+		return m.BatchDenom
+	}
+	return ""
+}
+
+// AllowedDenom represents the information for an allowed ask/bid denom.
+type AllowedDenom struct {
+	// denom is the bank denom to allow (ex. ibc/GLKHDSG423SGS)
+	BankDenom string `protobuf:"bytes,1,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
+	// display_denom is the denom to display to the user and is informational.
+	// Because the denom is likely an IBC denom, this should be chosen by
+	// governance to represent the consensus trusted name of the denom.
+	DisplayDenom string `protobuf:"bytes,2,opt,name=display_denom,json=displayDenom,proto3" json:"display_denom,omitempty"`
+	// exponent is the exponent that relates the denom to the display_denom and is
+	// informational
+	Exponent uint32 `protobuf:"varint,3,opt,name=exponent,proto3" json:"exponent,omitempty"`
+}
+
+func (m *AllowedDenom) Reset()         { *m = AllowedDenom{} }
+func (m *AllowedDenom) String() string { return proto.CompactTextString(m) }
+func (*AllowedDenom) ProtoMessage()    {}
+func (*AllowedDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{2}
+}
+func (m *AllowedDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowedDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowedDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowedDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowedDenom.Merge(m, src)
+}
+func (m *AllowedDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowedDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowedDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowedDenom proto.InternalMessageInfo
+
+func (m *AllowedDenom) GetBankDenom() string {
+	if m != nil {
+		return m.BankDenom
+	}
+	return ""
+}
+
+func (m *AllowedDenom) GetDisplayDenom() string {
+	if m != nil {
+		return m.DisplayDenom
+	}
+	return ""
+}
+
+func (m *AllowedDenom) GetExponent() uint32 {
+	if m != nil {
+		return m.Exponent
+	}
+	return 0
+}
+
+// Market describes a distinctly processed market between a credit type and
+// allowed bank denom. Each market has its own precision in the order book
+// and is processed independently of other markets. Governance must enable
+// markets one by one. Every additional enabled market potentially adds more
+// processing overhead to the blockchain and potentially weakens liquidity in
+// competing markets. For instance, enabling side by side USD/Carbon and
+// EUR/Carbon markets may have the end result that each market individually has
+// less liquidity and longer settlement times. Such decisions should be taken
+// with care.
+type Market struct {
+	// id is the unique ID of the market.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// credit_type_abbrev is the abbreviation of the credit type.
+	CreditTypeAbbrev string `protobuf:"bytes,2,opt,name=credit_type_abbrev,json=creditTypeAbbrev,proto3" json:"credit_type_abbrev,omitempty"`
+	// bank_denom is an allowed bank denom.
+	BankDenom string `protobuf:"bytes,3,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
+	// precision_modifier is an optional modifier used to convert arbitrary
+	// precision integer bank amounts to uint32 values used for sorting in the
+	// order book. Given an arbitrary precision integer x, its uint32 conversion
+	// will be x / 10^precision_modifier using round half away from zero
+	// rounding.
+	//
+	// uint32 values range from 0 to 4,294,967,295.
+	// This allows for a full 9 digits of precision. In most real world markets
+	// this amount of precision is sufficient and most common downside -
+	// that some orders with very miniscule price differences may be ordered
+	// equivalently (because of rounding) - is acceptable.
+	// Note that this rounding will not affect settlement price which will
+	// always be done exactly.
+	//
+	// Given a USD stable coin with 6 decimal digits, a precision_modifier
+	// of 0 is probably acceptable as long as credits are always less than
+	// $4,294/unit. With precision down to $0.001 (a precision_modifier of 3
+	// in this case), prices can rise up to $4,294,000/unit. Either scenario
+	// is probably quite acceptable given that carbon prices are unlikely to
+	// rise above $1000/ton any time in the near future.
+	//
+	// If credit prices, exceed the maximum range of uint32 with this
+	// precision_modifier, orders with high prices will fail and governance
+	// will need to adjust precision_modifier to allow for higher prices in
+	// exchange for less precision at the lower end.
+	PrecisionModifier uint32 `protobuf:"varint,4,opt,name=precision_modifier,json=precisionModifier,proto3" json:"precision_modifier,omitempty"`
+	// registrant is the address of the account that registered the market when
+	// market registration is permissionless. The registrant is empty for
+	// markets created for a bank denom allowed by governance.
+	Registrant []byte `protobuf:"bytes,5,opt,name=registrant,proto3" json:"registrant,omitempty"`
+	// display_denom is the display denom of the bank denom read from the bank
+	// denom metadata when the market was registered.
+	DisplayDenom string `protobuf:"bytes,6,opt,name=display_denom,json=displayDenom,proto3" json:"display_denom,omitempty"`
+	// exponent is the exponent that relates the bank denom to the display denom
+	// read from the bank denom metadata when the market was registered.
+	Exponent uint32 `protobuf:"varint,7,opt,name=exponent,proto3" json:"exponent,omitempty"`
+	// delisted is true if the market has been delisted by governance. New
+	// orders cannot be created and existing orders cannot be filled in a
+	// delisted market.
+	Delisted bool `protobuf:"varint,8,opt,name=delisted,proto3" json:"delisted,omitempty"`
+}
+
+func (m *Market) Reset()         { *m = Market{} }
+func (m *Market) String() string { return proto.CompactTextString(m) }
+func (*Market) ProtoMessage()    {}
+func (*Market) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{3}
+}
+func (m *Market) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Market) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Market.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Market) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Market.Merge(m, src)
+}
+func (m *Market) XXX_Size() int {
+	return m.Size()
+}
+func (m *Market) XXX_DiscardUnknown() {
+	xxx_messageInfo_Market.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Market proto.InternalMessageInfo
+
+func (m *Market) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Market) GetCreditTypeAbbrev() string {
+	if m != nil {
+		return m.CreditTypeAbbrev
+	}
+	return ""
+}
+
+func (m *Market) GetBankDenom() string {
+	if m != nil {
+		return m.BankDenom
+	}
+	return ""
+}
+
+func (m *Market) GetPrecisionModifier() uint32 {
+	if m != nil {
+		return m.PrecisionModifier
+	}
+	return 0
+}
+
+func (m *Market) GetRegistrant() []byte {
+	if m != nil {
+		return m.Registrant
+	}
+	return nil
+}
+
+func (m *Market) GetDisplayDenom() string {
+	if m != nil {
+		return m.DisplayDenom
+	}
+	return ""
+}
+
+func (m *Market) GetExponent() uint32 {
+	if m != nil {
+		return m.Exponent
+	}
+	return 0
+}
+
+func (m *Market) GetDelisted() bool {
+	if m != nil {
+		return m.Delisted
+	}
+	return false
+}
+
+// MarketFee defines the maker and taker fees of a market. The fees of a market
+// take precedence over the fees of the credit type of the market.
+type MarketFee struct {
+	// market_id is the unique ID of the market.
+	MarketId uint64 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// maker_fee_bps is the fee in basis points paid by the seller of a sell
+	// order resting in the order book when the sell order is filled.
+	MakerFeeBps uint32 `protobuf:"varint,2,opt,name=maker_fee_bps,json=makerFeeBps,proto3" json:"maker_fee_bps,omitempty"`
+	// taker_fee_bps is the fee in basis points paid by the buyer when a sell
+	// order is filled.
+	TakerFeeBps uint32 `protobuf:"varint,3,opt,name=taker_fee_bps,json=takerFeeBps,proto3" json:"taker_fee_bps,omitempty"`
+}
+
+func (m *MarketFee) Reset()         { *m = MarketFee{} }
+func (m *MarketFee) String() string { return proto.CompactTextString(m) }
+func (*MarketFee) ProtoMessage()    {}
+func (*MarketFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{4}
+}
+func (m *MarketFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketFee.Merge(m, src)
+}
+func (m *MarketFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketFee proto.InternalMessageInfo
+
+func (m *MarketFee) GetMarketId() uint64 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *MarketFee) GetMakerFeeBps() uint32 {
+	if m != nil {
+		return m.MakerFeeBps
+	}
+	return 0
+}
+
+func (m *MarketFee) GetTakerFeeBps() uint32 {
+	if m != nil {
+		return m.TakerFeeBps
+	}
+	return 0
+}
+
+// CreditTypeFee defines the maker and taker fees of all markets of a credit
+// type that do not have market specific fees.
+type CreditTypeFee struct {
+	// credit_type_abbrev is the abbreviation of the credit type.
+	CreditTypeAbbrev string `protobuf:"bytes,1,opt,name=credit_type_abbrev,json=creditTypeAbbrev,proto3" json:"credit_type_abbrev,omitempty"`
+	// maker_fee_bps is the fee in basis points paid by the seller of a sell
+	// order resting in the order book when the sell order is filled.
+	MakerFeeBps uint32 `protobuf:"varint,2,opt,name=maker_fee_bps,json=makerFeeBps,proto3" json:"maker_fee_bps,omitempty"`
+	// taker_fee_bps is the fee in basis points paid by the buyer when a sell
+	// order is filled.
+	TakerFeeBps uint32 `protobuf:"varint,3,opt,name=taker_fee_bps,json=takerFeeBps,proto3" json:"taker_fee_bps,omitempty"`
+}
+
+func (m *CreditTypeFee) Reset()         { *m = CreditTypeFee{} }
+func (m *CreditTypeFee) String() string { return proto.CompactTextString(m) }
+func (*CreditTypeFee) ProtoMessage()    {}
+func (*CreditTypeFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{5}
+}
+func (m *CreditTypeFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreditTypeFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreditTypeFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreditTypeFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreditTypeFee.Merge(m, src)
+}
+func (m *CreditTypeFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreditTypeFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreditTypeFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreditTypeFee proto.InternalMessageInfo
+
+func (m *CreditTypeFee) GetCreditTypeAbbrev() string {
+	if m != nil {
+		return m.CreditTypeAbbrev
+	}
+	return ""
+}
+
+func (m *CreditTypeFee) GetMakerFeeBps() uint32 {
+	if m != nil {
+		return m.MakerFeeBps
+	}
+	return 0
+}
+
+func (m *CreditTypeFee) GetTakerFeeBps() uint32 {
+	if m != nil {
+		return m.TakerFeeBps
+	}
+	return 0
+}
+
+// FeeDestination defines where marketplace fees are sent when collected.
+type FeeDestination struct {
+	// destination is the type of the fee destination. Fees are sent to the
+	// community pool when the destination is unspecified.
+	Destination FeeDestinationType `protobuf:"varint,1,opt,name=destination,proto3,enum=regen.ecocredit.marketplace.v1.FeeDestinationType" json:"destination,omitempty"`
+	// address is the address of the account receiving fees when the destination
+	// is FEE_DESTINATION_TYPE_ACCOUNT.
+	Address []byte `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *FeeDestination) Reset()         { *m = FeeDestination{} }
+func (m *FeeDestination) String() string { return proto.CompactTextString(m) }
+func (*FeeDestination) ProtoMessage()    {}
+func (*FeeDestination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{6}
+}
+func (m *FeeDestination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDestination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDestination.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDestination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDestination.Merge(m, src)
+}
+func (m *FeeDestination) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDestination) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDestination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDestination proto.InternalMessageInfo
+
+func (m *FeeDestination) GetDestination() FeeDestinationType {
+	if m != nil {
+		return m.Destination
+	}
+	return FeeDestinationType_FEE_DESTINATION_TYPE_UNSPECIFIED
+}
+
+func (m *FeeDestination) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+// MarketFeeTotal stores the total amount of fees collected in a market.
+type MarketFeeTotal struct {
+	// market_id is the unique ID of the market.
+	MarketId uint64 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// amount is the integer amount (encoded as a string) of fees collected in the
+	// bank denom of the market.
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *MarketFeeTotal) Reset()         { *m = MarketFeeTotal{} }
+func (m *MarketFeeTotal) String() string { return proto.CompactTextString(m) }
+func (*MarketFeeTotal) ProtoMessage()    {}
+func (*MarketFeeTotal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{7}
+}
+func (m *MarketFeeTotal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketFeeTotal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketFeeTotal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketFeeTotal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketFeeTotal.Merge(m, src)
+}
+func (m *MarketFeeTotal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketFeeTotal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketFeeTotal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketFeeTotal proto.InternalMessageInfo
+
+func (m *MarketFeeTotal) GetMarketId() uint64 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *MarketFeeTotal) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+// Trade stores a completed trade of credits in the marketplace.
+type Trade struct {
+	// id is the unique ID of the trade.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// buyer is the address of the account that bought the credits.
+	Buyer []byte `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// seller is the address of the account that sold the credits.
+	Seller []byte `protobuf:"bytes,3,opt,name=seller,proto3" json:"seller,omitempty"`
+	// batch_key is the table row ID of the credit batch of the credits.
+	BatchKey uint64 `protobuf:"varint,4,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// market_id is the market in which the trade occurred.
+	MarketId uint64 `protobuf:"varint,5,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// sell_order_id is the ID of the sell order that was filled. The sell order
+	// ID is 0 if the credits were sold by accepting an offer or in an auction.
+	SellOrderId uint64 `protobuf:"varint,6,opt,name=sell_order_id,json=sellOrderId,proto3" json:"sell_order_id,omitempty"`
+	// buy_order_id is the ID of the buy order that was filled. The buy order ID
+	// is 0 if the credits were bought directly.
+	BuyOrderId uint64 `protobuf:"varint,7,opt,name=buy_order_id,json=buyOrderId,proto3" json:"buy_order_id,omitempty"`
+	// quantity is the decimal quantity of credits traded.
+	Quantity string `protobuf:"bytes,8,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// unit_price is the integer price per credit (encoded as a string) in the
+	// bank denom of the market.
+	UnitPrice string `protobuf:"bytes,9,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	// timestamp is the block time of the trade.
+	Timestamp *types.Timestamp `protobuf:"bytes,10,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// offer_id is the ID of the offer that was accepted. The offer ID is 0 if
+	// the credits were not sold by accepting an offer.
+	OfferId uint64 `protobuf:"varint,11,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+	// dutch_auction_id is the ID of the dutch auction the credits were bought
+	// from. The dutch auction ID is 0 if the credits were not bought from a
+	// dutch auction.
+	DutchAuctionId uint64 `protobuf:"varint,12,opt,name=dutch_auction_id,json=dutchAuctionId,proto3" json:"dutch_auction_id,omitempty"`
+	// sealed_bid_auction_id is the ID of the sealed-bid auction that was
+	// settled. The sealed-bid auction ID is 0 if the credits were not sold in a
+	// sealed-bid auction.
+	SealedBidAuctionId uint64 `protobuf:"varint,13,opt,name=sealed_bid_auction_id,json=sealedBidAuctionId,proto3" json:"sealed_bid_auction_id,omitempty"`
+	// forward_contract_id is the ID of the forward contract that was delivered.
+	// The forward contract ID is 0 if the credits were not delivered to fill a
+	// forward contract.
+	ForwardContractId uint64 `protobuf:"varint,14,opt,name=forward_contract_id,json=forwardContractId,proto3" json:"forward_contract_id,omitempty"`
+}
+
+func (m *Trade) Reset()         { *m = Trade{} }
+func (m *Trade) String() string { return proto.CompactTextString(m) }
+func (*Trade) ProtoMessage()    {}
+func (*Trade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{8}
+}
+func (m *Trade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Trade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Trade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Trade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Trade.Merge(m, src)
+}
+func (m *Trade) XXX_Size() int {
+	return m.Size()
+}
+func (m *Trade) XXX_DiscardUnknown() {
+	xxx_messageInfo_Trade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Trade proto.InternalMessageInfo
+
+func (m *Trade) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Trade) GetBuyer() []byte {
+	if m != nil {
+		return m.Buyer
+	}
+	return nil
+}
+
+func (m *Trade) GetSeller() []byte {
+	if m != nil {
+		return m.Seller
+	}
+	return nil
+}
+
+func (m *Trade) GetBatchKey() uint64 {
+	if m != nil {
+		return m.BatchKey
+	}
+	return 0
+}
+
+func (m *Trade) GetMarketId() uint64 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *Trade) GetSellOrderId() uint64 {
+	if m != nil {
+		return m.SellOrderId
+	}
+	return 0
+}
+
+func (m *Trade) GetBuyOrderId() uint64 {
+	if m != nil {
+		return m.BuyOrderId
+	}
+	return 0
+}
+
+func (m *Trade) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
+	}
+	return ""
+}
+
+func (m *Trade) GetUnitPrice() string {
+	if m != nil {
+		return m.UnitPrice
+	}
+	return ""
+}
+
+func (m *Trade) GetTimestamp() *types.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *Trade) GetOfferId() uint64 {
+	if m != nil {
+		return m.OfferId
+	}
+	return 0
+}
+
+func (m *Trade) GetDutchAuctionId() uint64 {
+	if m != nil {
+		return m.DutchAuctionId
+	}
+	return 0
+}
+
+func (m *Trade) GetSealedBidAuctionId() uint64 {
+	if m != nil {
+		return m.SealedBidAuctionId
+	}
+	return 0
+}
+
+func (m *Trade) GetForwardContractId() uint64 {
+	if m != nil {
+		return m.ForwardContractId
+	}
+	return 0
+}
+
+// AddressTrade indexes trades by the address of the buyer and the seller.
+type AddressTrade struct {
+	// address is the address of the buyer or the seller of the trade.
+	Address []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// trade_id is the unique ID of the trade.
+	TradeId uint64 `protobuf:"varint,2,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+}
+
+func (m *AddressTrade) Reset()         { *m = AddressTrade{} }
+func (m *AddressTrade) String() string { return proto.CompactTextString(m) }
+func (*AddressTrade) ProtoMessage()    {}
+func (*AddressTrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{9}
+}
+func (m *AddressTrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressTrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddressTrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddressTrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressTrade.Merge(m, src)
+}
+func (m *AddressTrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressTrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressTrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressTrade proto.InternalMessageInfo
+
+func (m *AddressTrade) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *AddressTrade) GetTradeId() uint64 {
+	if m != nil {
+		return m.TradeId
+	}
+	return 0
+}
+
+// Offer represents a buyer-initiated offer to buy credits from a credit batch.
+// The bid amount for the remaining quantity of the offer and the maker fee are
+// held in escrow by the ecocredit module account until the offer is accepted,
+// cancelled or expired.
+type Offer struct {
+	// id is the unique ID of the offer.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// buyer is the address of the account that made the offer.
+	Buyer []byte `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// batch_key is the table row ID of the credit batch of the credits being
+	// bought.
+	BatchKey uint64 `protobuf:"varint,3,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// holder is the optional address of the credit holder the offer is made to.
+	// If empty, any holder of credits from the credit batch can accept the offer.
+	Holder []byte `protobuf:"bytes,4,opt,name=holder,proto3" json:"holder,omitempty"`
+	// quantity is the decimal quantity of credits remaining to be bought.
+	Quantity string `protobuf:"bytes,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// market_id is the market in which this offer exists and specifies the
+	// bank_denom that bid_amount corresponds to forming the bid_price.
+	MarketId uint64 `protobuf:"varint,6,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// bid_amount is the integer amount (encoded as a string) that the buyer is
+	// paying for each credit unit.
+	BidAmount string `protobuf:"bytes,7,opt,name=bid_amount,json=bidAmount,proto3" json:"bid_amount,omitempty"`
+	// disable_auto_retire disables auto-retirement of credits which allows a
+	// buyer to receive credits in a tradable state.
+	DisableAutoRetire bool `protobuf:"varint,8,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
+	// retirement_jurisdiction is the optional retirement jurisdiction for the
+	// credits which will be used only if disable_auto_retire is false.
+	RetirementJurisdiction string `protobuf:"bytes,9,opt,name=retirement_jurisdiction,json=retirementJurisdiction,proto3" json:"retirement_jurisdiction,omitempty"`
+	// expiration is an optional timestamp when the offer expires. When the
+	// expiration time is reached, the offer is removed from state and the
+	// escrowed funds are returned to the buyer.
+	Expiration *types.Timestamp `protobuf:"bytes,10,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// maker_fee_bps is the maker fee in basis points that applied to the market
+	// when the offer was created. The maker fee for the total quantity of the
+	// offer is held in escrow along with the bid amount.
+	MakerFeeBps uint32 `protobuf:"varint,11,opt,name=maker_fee_bps,json=makerFeeBps,proto3" json:"maker_fee_bps,omitempty"`
+}
+
+func (m *Offer) Reset()         { *m = Offer{} }
+func (m *Offer) String() string { return proto.CompactTextString(m) }
+func (*Offer) ProtoMessage()    {}
+func (*Offer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{10}
+}
+func (m *Offer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Offer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Offer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Offer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Offer.Merge(m, src)
+}
+func (m *Offer) XXX_Size() int {
+	return m.Size()
+}
+func (m *Offer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Offer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Offer proto.InternalMessageInfo
+
+func (m *Offer) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Offer) GetBuyer() []byte {
+	if m != nil {
+		return m.Buyer
+	}
+	return nil
+}
+
+func (m *Offer) GetBatchKey() uint64 {
+	if m != nil {
+		return m.BatchKey
+	}
+	return 0
+}
+
+func (m *Offer) GetHolder() []byte {
+	if m != nil {
+		return m.Holder
+	}
+	return nil
+}
+
+func (m *Offer) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
+	}
+	return ""
+}
+
+func (m *Offer) GetMarketId() uint64 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *Offer) GetBidAmount() string {
+	if m != nil {
+		return m.BidAmount
+	}
+	return ""
+}
+
+func (m *Offer) GetDisableAutoRetire() bool {
+	if m != nil {
+		return m.DisableAutoRetire
+	}
+	return false
+}
+
+func (m *Offer) GetRetirementJurisdiction() string {
+	if m != nil {
+		return m.RetirementJurisdiction
+	}
+	return ""
+}
+
+func (m *Offer) GetExpiration() *types.Timestamp {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func (m *Offer) GetMakerFeeBps() uint32 {
+	if m != nil {
+		return m.MakerFeeBps
+	}
+	return 0
+}
+
+// DutchAuction represents a descending-price auction of credits. The ask price
+// decays linearly from the start amount to the floor amount between the start
+// time and the end time and remains at the floor amount after the end time.
+// The credits being sold are held in the escrowed balance of the seller until
+// they are bought or the auction is cancelled.
+type DutchAuction struct {
+	// id is the unique ID of the dutch auction.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// seller is the address of the account that is selling credits.
+	Seller []byte `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// batch_key is the table row ID of the credit batch of the credits being
+	// sold.
+	BatchKey uint64 `protobuf:"varint,3,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// quantity is the decimal quantity of credits remaining to be sold.
+	Quantity string `protobuf:"bytes,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// market_id is the market in which this auction exists and specifies the
+	// bank_denom of the start amount and the floor amount.
+	MarketId uint64 `protobuf:"varint,5,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// start_amount is the integer amount (encoded as a string) of the ask price
+	// per credit at the start time of the auction.
+	StartAmount string `protobuf:"bytes,6,opt,name=start_amount,json=startAmount,proto3" json:"start_amount,omitempty"`
+	// floor_amount is the integer amount (encoded as a string) of the ask price
+	// per credit at and after the end time of the auction.
+	FloorAmount string `protobuf:"bytes,7,opt,name=floor_amount,json=floorAmount,proto3" json:"floor_amount,omitempty"`
+	// start_time is the block time when the auction was created.
+	StartTime *types.Timestamp `protobuf:"bytes,8,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time is the time when the ask price reaches the floor amount.
+	EndTime *types.Timestamp `protobuf:"bytes,9,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// disable_auto_retire disables auto-retirement of credits which allows a
+	// buyer to disable auto-retirement in their buy order enabling them to
+	// resell the credits to another buyer.
+	DisableAutoRetire bool `protobuf:"varint,10,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
+}
+
+func (m *DutchAuction) Reset()         { *m = DutchAuction{} }
+func (m *DutchAuction) String() string { return proto.CompactTextString(m) }
+func (*DutchAuction) ProtoMessage()    {}
+func (*DutchAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{11}
+}
+func (m *DutchAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DutchAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DutchAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DutchAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DutchAuction.Merge(m, src)
+}
+func (m *DutchAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *DutchAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_DutchAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DutchAuction proto.InternalMessageInfo
+
+func (m *DutchAuction) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DutchAuction) GetSeller() []byte {
+	if m != nil {
+		return m.Seller
+	}
+	return nil
+}
+
+func (m *DutchAuction) GetBatchKey() uint64 {
+	if m != nil {
+		return m.BatchKey
+	}
+	return 0
+}
+
+func (m *DutchAuction) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
+	}
+	return ""
+}
+
+func (m *DutchAuction) GetMarketId() uint64 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *DutchAuction) GetStartAmount() string {
+	if m != nil {
+		return m.StartAmount
+	}
+	return ""
+}
+
+func (m *DutchAuction) GetFloorAmount() string {
+	if m != nil {
+		return m.FloorAmount
+	}
+	return ""
+}
+
+func (m *DutchAuction) GetStartTime() *types.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *DutchAuction) GetEndTime() *types.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *DutchAuction) GetDisableAutoRetire() bool {
+	if m != nil {
+		return m.DisableAutoRetire
+	}
+	return false
+}
+
+// SealedBidAuction represents a sealed-bid auction of credits. Bidders commit
+// to hidden bids until the commit end height, reveal their bids until the
+// reveal end height, and the auction is settled at the reveal end height at a
+// uniform clearing price. The credits being sold are held in the escrowed
+// balance of the seller until the auction is settled.
+type SealedBidAuction struct {
+	// id is the unique ID of the sealed-bid auction.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// seller is the address of the account that is selling credits.
+	Seller []byte `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// batch_key is the table row ID of the credit batch of the credits being
+	// sold.
+	BatchKey uint64 `protobuf:"varint,3,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// quantity is the decimal quantity of credits being sold.
+	Quantity string `protobuf:"bytes,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// market_id is the market in which this auction exists and specifies the
+	// bank_denom of the reserve amount and of the bids.
+	MarketId uint64 `protobuf:"varint,5,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// reserve_amount is the integer amount (encoded as a string) of the minimum
+	// price per credit the seller is willing to accept.
+	ReserveAmount string `protobuf:"bytes,6,opt,name=reserve_amount,json=reserveAmount,proto3" json:"reserve_amount,omitempty"`
+	// commit_end_height is the last block height at which bids can be
+	// committed.
+	CommitEndHeight int64 `protobuf:"varint,7,opt,name=commit_end_height,json=commitEndHeight,proto3" json:"commit_end_height,omitempty"`
+	// reveal_end_height is the last block height at which bids can be revealed.
+	// The auction is settled at the end of this block.
+	RevealEndHeight int64 `protobuf:"varint,8,opt,name=reveal_end_height,json=revealEndHeight,proto3" json:"reveal_end_height,omitempty"`
+	// disable_auto_retire disables auto-retirement of credits which allows a
+	// bidder to disable auto-retirement in their bid enabling them to resell
+	// the credits to another buyer.
+	DisableAutoRetire bool `protobuf:"varint,9,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
+	// maker_fee_bps is the maker fee in basis points that applied to the market
+	// when the auction was created. The maker fee is paid by the seller.
+	MakerFeeBps uint32 `protobuf:"varint,10,opt,name=maker_fee_bps,json=makerFeeBps,proto3" json:"maker_fee_bps,omitempty"`
+	// taker_fee_bps is the taker fee in basis points that applied to the market
+	// when the auction was created. The taker fee is paid by the winning
+	// bidders.
+	TakerFeeBps uint32 `protobuf:"varint,11,opt,name=taker_fee_bps,json=takerFeeBps,proto3" json:"taker_fee_bps,omitempty"`
+}
+
+func (m *SealedBidAuction) Reset()         { *m = SealedBidAuction{} }
+func (m *SealedBidAuction) String() string { return proto.CompactTextString(m) }
+func (*SealedBidAuction) ProtoMessage()    {}
+func (*SealedBidAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{12}
+}
+func (m *SealedBidAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SealedBidAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SealedBidAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SealedBidAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SealedBidAuction.Merge(m, src)
+}
+func (m *SealedBidAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *SealedBidAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_SealedBidAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SealedBidAuction proto.InternalMessageInfo
+
+func (m *SealedBidAuction) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *SealedBidAuction) GetSeller() []byte {
+	if m != nil {
+		return m.Seller
+	}
+	return nil
+}
+
+func (m *SealedBidAuction) GetBatchKey() uint64 {
+	if m != nil {
+		return m.BatchKey
+	}
+	return 0
+}
+
+func (m *SealedBidAuction) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
+	}
+	return ""
+}
+
+func (m *SealedBidAuction) GetMarketId() uint64 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *SealedBidAuction) GetReserveAmount() string {
+	if m != nil {
+		return m.ReserveAmount
+	}
+	return ""
+}
+
+func (m *SealedBidAuction) GetCommitEndHeight() int64 {
+	if m != nil {
+		return m.CommitEndHeight
+	}
+	return 0
+}
+
+func (m *SealedBidAuction) GetRevealEndHeight() int64 {
+	if m != nil {
+		return m.RevealEndHeight
+	}
+	return 0
+}
+
+func (m *SealedBidAuction) GetDisableAutoRetire() bool {
+	if m != nil {
+		return m.DisableAutoRetire
+	}
+	return false
+}
+
+func (m *SealedBidAuction) GetMakerFeeBps() uint32 {
+	if m != nil {
+		return m.MakerFeeBps
+	}
+	return 0
+}
+
+func (m *SealedBidAuction) GetTakerFeeBps() uint32 {
+	if m != nil {
+		return m.TakerFeeBps
+	}
+	return 0
+}
+
+// SealedBid represents a bid in a sealed-bid auction. The deposit is held in
+// escrow by the ecocredit module account until the auction is settled.
+type SealedBid struct {
+	// auction_id is the ID of the sealed-bid auction.
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// bidder is the address of the account that committed the bid.
+	Bidder []byte `protobuf:"bytes,2,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// commitment is the hash of the hidden bid (see SealedBidCommitment).
+	Commitment []byte `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// deposit_amount is the integer amount (encoded as a string) escrowed by the
+	// bidder. The deposit must cover the bid amount for the quantity of the bid
+	// and the taker fee when the bid is revealed.
+	DepositAmount string `protobuf:"bytes,4,opt,name=deposit_amount,json=depositAmount,proto3" json:"deposit_amount,omitempty"`
+	// revealed is whether the bid has been revealed.
+	Revealed bool `protobuf:"varint,5,opt,name=revealed,proto3" json:"revealed,omitempty"`
+	// quantity is the decimal quantity of credits bid for once revealed.
+	Quantity string `protobuf:"bytes,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// bid_amount is the integer amount (encoded as a string) the bidder is
+	// willing to pay per credit once revealed.
+	BidAmount string `protobuf:"bytes,7,opt,name=bid_amount,json=bidAmount,proto3" json:"bid_amount,omitempty"`
+	// disable_auto_retire disables auto-retirement of the credits won by the
+	// bidder.
+	DisableAutoRetire bool `protobuf:"varint,8,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
+	// retirement_jurisdiction is the optional retirement jurisdiction for the
+	// credits which will be used only if disable_auto_retire is false.
+	RetirementJurisdiction string `protobuf:"bytes,9,opt,name=retirement_jurisdiction,json=retirementJurisdiction,proto3" json:"retirement_jurisdiction,omitempty"`
+}
+
+func (m *SealedBid) Reset()         { *m = SealedBid{} }
+func (m *SealedBid) String() string { return proto.CompactTextString(m) }
+func (*SealedBid) ProtoMessage()    {}
+func (*SealedBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{13}
+}
+func (m *SealedBid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SealedBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SealedBid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SealedBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SealedBid.Merge(m, src)
+}
+func (m *SealedBid) XXX_Size() int {
+	return m.Size()
+}
+func (m *SealedBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_SealedBid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SealedBid proto.InternalMessageInfo
+
+func (m *SealedBid) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
+	}
+	return 0
+}
+
+func (m *SealedBid) GetBidder() []byte {
+	if m != nil {
+		return m.Bidder
+	}
+	return nil
+}
+
+func (m *SealedBid) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+func (m *SealedBid) GetDepositAmount() string {
+	if m != nil {
+		return m.DepositAmount
+	}
+	return ""
+}
+
+func (m *SealedBid) GetRevealed() bool {
+	if m != nil {
+		return m.Revealed
+	}
+	return false
+}
+
+func (m *SealedBid) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
+	}
+	return ""
+}
+
+func (m *SealedBid) GetBidAmount() string {
+	if m != nil {
+		return m.BidAmount
+	}
+	return ""
+}
+
+func (m *SealedBid) GetDisableAutoRetire() bool {
+	if m != nil {
+		return m.DisableAutoRetire
+	}
+	return false
+}
+
+func (m *SealedBid) GetRetirementJurisdiction() string {
+	if m != nil {
+		return m.RetirementJurisdiction
+	}
+	return ""
+}
+
+// ForwardSale represents a listing of future credits from a project. The
+// project admin lists a quantity of credits from credit batches within a
+// vintage range at an ask price, and buyers buy forward contracts against the
+// listing that are delivered when a matching credit batch is issued.
+type ForwardSale struct {
+	// id is the unique ID of the forward sale.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// seller is the address of the project admin that created the forward sale.
+	Seller []byte `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// project_key is the table row ID of the project of the future credits.
+	ProjectKey uint64 `protobuf:"varint,3,opt,name=project_key,json=projectKey,proto3" json:"project_key,omitempty"`
+	// vintage_start is the earliest start date of a credit batch that can be
+	// delivered to fill forward contracts of the forward sale.
+	VintageStart *types.Timestamp `protobuf:"bytes,4,opt,name=vintage_start,json=vintageStart,proto3" json:"vintage_start,omitempty"`
+	// vintage_end is the latest end date of a credit batch that can be delivered
+	// to fill forward contracts of the forward sale.
+	VintageEnd *types.Timestamp `protobuf:"bytes,5,opt,name=vintage_end,json=vintageEnd,proto3" json:"vintage_end,omitempty"`
+	// quantity is the decimal quantity of future credits that remain available
+	// to be bought.
+	Quantity string `protobuf:"bytes,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// market_id is the market in which this forward sale exists and specifies
+	// the bank_denom that ask_amount corresponds to forming the ask_price.
+	MarketId uint64 `protobuf:"varint,7,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// ask_amount is the integer amount (encoded as a string) that the seller is
+	// asking for each credit unit.
+	AskAmount string `protobuf:"bytes,8,opt,name=ask_amount,json=askAmount,proto3" json:"ask_amount,omitempty"`
+	// delivery_deadline is the timestamp when the forward sale expires. When the
+	// delivery deadline is reached, the forward sale and its forward contracts
+	// are removed from state and the escrowed funds for credits that were not
+	// delivered are returned to the buyers.
+	DeliveryDeadline *types.Timestamp `protobuf:"bytes,9,opt,name=delivery_deadline,json=deliveryDeadline,proto3" json:"delivery_deadline,omitempty"`
+	// disable_auto_retire disables auto-retirement of credits which allows a
+	// buyer to disable auto-retirement in their forward contract and receive
+	// the credits in a tradable state.
+	DisableAutoRetire bool `protobuf:"varint,10,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
+}
+
+func (m *ForwardSale) Reset()         { *m = ForwardSale{} }
+func (m *ForwardSale) String() string { return proto.CompactTextString(m) }
+func (*ForwardSale) ProtoMessage()    {}
+func (*ForwardSale) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{14}
+}
+func (m *ForwardSale) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardSale) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardSale.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForwardSale) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardSale.Merge(m, src)
+}
+func (m *ForwardSale) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardSale) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardSale.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardSale proto.InternalMessageInfo
+
+func (m *ForwardSale) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ForwardSale) GetSeller() []byte {
+	if m != nil {
+		return m.Seller
+	}
+	return nil
+}
+
+func (m *ForwardSale) GetProjectKey() uint64 {
+	if m != nil {
+		return m.ProjectKey
+	}
+	return 0
+}
+
+func (m *ForwardSale) GetVintageStart() *types.Timestamp {
+	if m != nil {
+		return m.VintageStart
+	}
+	return nil
+}
+
+func (m *ForwardSale) GetVintageEnd() *types.Timestamp {
+	if m != nil {
+		return m.VintageEnd
+	}
+	return nil
+}
+
+func (m *ForwardSale) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
+	}
+	return ""
+}
+
+func (m *ForwardSale) GetMarketId() uint64 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *ForwardSale) GetAskAmount() string {
+	if m != nil {
+		return m.AskAmount
+	}
+	return ""
+}
+
+func (m *ForwardSale) GetDeliveryDeadline() *types.Timestamp {
+	if m != nil {
+		return m.DeliveryDeadline
+	}
+	return nil
+}
+
+func (m *ForwardSale) GetDisableAutoRetire() bool {
+	if m != nil {
+		return m.DisableAutoRetire
+	}
+	return false
+}
+
+// ForwardContract represents a purchase of future credits from a forward sale.
+// The total cost of the credits remaining to be delivered and the taker fee
+// are held in escrow by the ecocredit module account until the credits are
+// delivered or the delivery deadline of the forward sale is reached. Forward
+// contracts of a project are delivered in the order they were created.
+type ForwardContract struct {
+	// id is the unique ID of the forward contract. The ID determines the
+	// priority in which forward contracts of a project are delivered.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// forward_sale_id is the ID of the forward sale.
+	ForwardSaleId uint64 `protobuf:"varint,2,opt,name=forward_sale_id,json=forwardSaleId,proto3" json:"forward_sale_id,omitempty"`
+	// buyer is the address of the account that bought the forward contract.
+	Buyer []byte `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// project_key is the table row ID of the project of the forward sale.
+	ProjectKey uint64 `protobuf:"varint,4,opt,name=project_key,json=projectKey,proto3" json:"project_key,omitempty"`
+	// quantity is the decimal quantity of credits remaining to be delivered.
+	Quantity string `protobuf:"bytes,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// escrow_amount is the integer amount (encoded as a string) remaining in
+	// escrow for the credits that have not been delivered.
+	EscrowAmount string `protobuf:"bytes,6,opt,name=escrow_amount,json=escrowAmount,proto3" json:"escrow_amount,omitempty"`
+	// disable_auto_retire disables auto-retirement of credits which allows a
+	// buyer to receive credits in a tradable state.
+	DisableAutoRetire bool `protobuf:"varint,7,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
+	// retirement_jurisdiction is the optional retirement jurisdiction for the
+	// credits which will be used only if disable_auto_retire is false.
+	RetirementJurisdiction string `protobuf:"bytes,8,opt,name=retirement_jurisdiction,json=retirementJurisdiction,proto3" json:"retirement_jurisdiction,omitempty"`
+	// maker_fee_bps is the maker fee in basis points that applied to the market
+	// when the forward contract was created. The maker fee is paid by the
+	// seller when the credits are delivered.
+	MakerFeeBps uint32 `protobuf:"varint,9,opt,name=maker_fee_bps,json=makerFeeBps,proto3" json:"maker_fee_bps,omitempty"`
+	// taker_fee_bps is the taker fee in basis points that applied to the market
+	// when the forward contract was created. The taker fee is held in escrow
+	// along with the cost of the credits.
+	TakerFeeBps uint32 `protobuf:"varint,10,opt,name=taker_fee_bps,json=takerFeeBps,proto3" json:"taker_fee_bps,omitempty"`
+}
+
+func (m *ForwardContract) Reset()         { *m = ForwardContract{} }
+func (m *ForwardContract) String() string { return proto.CompactTextString(m) }
+func (*ForwardContract) ProtoMessage()    {}
+func (*ForwardContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{15}
+}
+func (m *ForwardContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForwardContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardContract.Merge(m, src)
+}
+func (m *ForwardContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardContract proto.InternalMessageInfo
+
+func (m *ForwardContract) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ForwardContract) GetForwardSaleId() uint64 {
+	if m != nil {
+		return m.ForwardSaleId
+	}
+	return 0
+}
+
+func (m *ForwardContract) GetBuyer() []byte {
+	if m != nil {
+		return m.Buyer
+	}
+	return nil
+}
+
+func (m *ForwardContract) GetProjectKey() uint64 {
+	if m != nil {
+		return m.ProjectKey
+	}
+	return 0
+}
+
+func (m *ForwardContract) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
+	}
+	return ""
+}
+
+func (m *ForwardContract) GetEscrowAmount() string {
+	if m != nil {
+		return m.EscrowAmount
+	}
+	return ""
+}
+
+func (m *ForwardContract) GetDisableAutoRetire() bool {
+	if m != nil {
+		return m.DisableAutoRetire
+	}
+	return false
+}
+
+func (m *ForwardContract) GetRetirementJurisdiction() string {
+	if m != nil {
+		return m.RetirementJurisdiction
+	}
+	return ""
+}
+
+func (m *ForwardContract) GetMakerFeeBps() uint32 {
+	if m != nil {
+		return m.MakerFeeBps
+	}
+	return 0
+}
+
+func (m *ForwardContract) GetTakerFeeBps() uint32 {
+	if m != nil {
+		return m.TakerFeeBps
+	}
+	return 0
+}
+
+// MarketRegistration defines whether any account can register a market for a
+// credit type and bank denom and the deposit paid to register a market.
+type MarketRegistration struct {
+	// enabled is true if market registration is permissionless.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// deposit_denom is the bank denom of the deposit paid to register a market.
+	DepositDenom string `protobuf:"bytes,2,opt,name=deposit_denom,json=depositDenom,proto3" json:"deposit_denom,omitempty"`
+	// deposit_amount is the amount of the deposit paid to register a market. The
+	// deposit is sent to the fee destination.
+	DepositAmount string `protobuf:"bytes,3,opt,name=deposit_amount,json=depositAmount,proto3" json:"deposit_amount,omitempty"`
+}
+
+func (m *MarketRegistration) Reset()         { *m = MarketRegistration{} }
+func (m *MarketRegistration) String() string { return proto.CompactTextString(m) }
+func (*MarketRegistration) ProtoMessage()    {}
+func (*MarketRegistration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{16}
+}
+func (m *MarketRegistration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketRegistration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketRegistration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketRegistration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketRegistration.Merge(m, src)
+}
+func (m *MarketRegistration) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketRegistration) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketRegistration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketRegistration proto.InternalMessageInfo
+
+func (m *MarketRegistration) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *MarketRegistration) GetDepositDenom() string {
+	if m != nil {
+		return m.DepositDenom
+	}
+	return ""
+}
+
+func (m *MarketRegistration) GetDepositAmount() string {
+	if m != nil {
+		return m.DepositAmount
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("regen.ecocredit.marketplace.v1.FeeDestinationType", FeeDestinationType_name, FeeDestinationType_value)
+	proto.RegisterType((*SellOrder)(nil), "regen.ecocredit.marketplace.v1.SellOrder")
+	proto.RegisterType((*BuyOrder)(nil), "regen.ecocredit.marketplace.v1.BuyOrder")
+	proto.RegisterType((*BuyOrder_Filter)(nil), "regen.ecocredit.marketplace.v1.BuyOrder.Filter")
+	proto.RegisterType((*BuyOrder_Filter_Criteria)(nil), "regen.ecocredit.marketplace.v1.BuyOrder.Filter.Criteria")
+	proto.RegisterType((*BuyOrder_ClassSelector)(nil), "regen.ecocredit.marketplace.v1.BuyOrder.ClassSelector")
+	proto.RegisterType((*BuyOrder_ProjectSelector)(nil), "regen.ecocredit.marketplace.v1.BuyOrder.ProjectSelector")
+	proto.RegisterType((*BuyOrder_BatchSelector)(nil), "regen.ecocredit.marketplace.v1.BuyOrder.BatchSelector")
+	proto.RegisterType((*AllowedDenom)(nil), "regen.ecocredit.marketplace.v1.AllowedDenom")
+	proto.RegisterType((*Market)(nil), "regen.ecocredit.marketplace.v1.Market")
+	proto.RegisterType((*MarketFee)(nil), "regen.ecocredit.marketplace.v1.MarketFee")
+	proto.RegisterType((*CreditTypeFee)(nil), "regen.ecocredit.marketplace.v1.CreditTypeFee")
+	proto.RegisterType((*FeeDestination)(nil), "regen.ecocredit.marketplace.v1.FeeDestination")
+	proto.RegisterType((*MarketFeeTotal)(nil), "regen.ecocredit.marketplace.v1.MarketFeeTotal")
+	proto.RegisterType((*Trade)(nil), "regen.ecocredit.marketplace.v1.Trade")
+	proto.RegisterType((*AddressTrade)(nil), "regen.ecocredit.marketplace.v1.AddressTrade")
+	proto.RegisterType((*Offer)(nil), "regen.ecocredit.marketplace.v1.Offer")
+	proto.RegisterType((*DutchAuction)(nil), "regen.ecocredit.marketplace.v1.DutchAuction")
+	proto.RegisterType((*SealedBidAuction)(nil), "regen.ecocredit.marketplace.v1.SealedBidAuction")
+	proto.RegisterType((*SealedBid)(nil), "regen.ecocredit.marketplace.v1.SealedBid")
+	proto.RegisterType((*ForwardSale)(nil), "regen.ecocredit.marketplace.v1.ForwardSale")
+	proto.RegisterType((*ForwardContract)(nil), "regen.ecocredit.marketplace.v1.ForwardContract")
+	proto.RegisterType((*MarketRegistration)(nil), "regen.ecocredit.marketplace.v1.MarketRegistration")
+}
+
+func init() {
+	proto.RegisterFile("regen/ecocredit/marketplace/v1/state.proto", fileDescriptor_718b9cb8f10a9f3c)
+}
+
+var fileDescriptor_718b9cb8f10a9f3c = []byte{
+	// 2269 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x6f, 0x23, 0x49,
+	0x15, 0x9f, 0xee, 0x4e, 0x1c, 0xf7, 0x73, 0xdb, 0xe9, 0x54, 0x66, 0xb3, 0xbd, 0x66, 0xc6, 0xeb,
+	0xf5, 0xec, 0x40, 0x18, 0xb2, 0x36, 0x13, 0x58, 0x18, 0xbc, 0x0b, 0x6c, 0x3e, 0x67, 0x0c, 0x4c,
+	0x12, 0x3a, 0x19, 0xa1, 0x45, 0x48, 0xad, 0xb2, 0xbb, 0x92, 0xd4, 0xa6, 0x3f, 0x4c, 0x75, 0x3b,
+	0x33, 0xb9, 0x23, 0x21, 0x71, 0x00, 0x0e, 0x70, 0x62, 0x85, 0x84, 0x04, 0x47, 0x24, 0x2e, 0x5c,
+	0xf8, 0x0b, 0x56, 0xe2, 0xb2, 0x12, 0x17, 0x2e, 0x48, 0x68, 0xe6, 0xc8, 0x0d, 0x71, 0xe2, 0x84,
+	0xaa, 0xaa, 0xdd, 0xee, 0xb6, 0x9d, 0xaf, 0x1d, 0xc4, 0x72, 0xac, 0xf7, 0x5e, 0x55, 0xbd, 0xf7,
+	0x7e, 0xef, 0xbd, 0x7a, 0xaf, 0x1b, 0xee, 0x31, 0x72, 0x44, 0x82, 0x16, 0xe9, 0x85, 0x3d, 0x46,
+	0x5c, 0x1a, 0xb7, 0x7c, 0xcc, 0x4e, 0x48, 0xdc, 0xf7, 0x70, 0x8f, 0xb4, 0x4e, 0xef, 0xb7, 0xa2,
+	0x18, 0xc7, 0xa4, 0xd9, 0x67, 0x61, 0x1c, 0xa2, 0x9a, 0x90, 0x6d, 0xa6, 0xb2, 0xcd, 0x8c, 0x6c,
+	0xf3, 0xf4, 0x7e, 0xf5, 0xf5, 0xa3, 0x30, 0x3c, 0xf2, 0x48, 0x4b, 0x48, 0x77, 0x07, 0x87, 0xad,
+	0x98, 0xfa, 0x24, 0x8a, 0xb1, 0xdf, 0x97, 0x07, 0x54, 0x6f, 0xf7, 0xc2, 0xc8, 0x0f, 0xa3, 0x56,
+	0xc8, 0xfc, 0xd6, 0xe9, 0x7d, 0xec, 0xf5, 0x8f, 0xf1, 0x7d, 0xbe, 0x90, 0xec, 0xc6, 0x2f, 0x0a,
+	0xa0, 0xef, 0x13, 0xcf, 0xdb, 0x65, 0x2e, 0x61, 0xa8, 0x02, 0x2a, 0x75, 0x2d, 0xa5, 0xae, 0x2c,
+	0xcf, 0xd8, 0x2a, 0x75, 0xd1, 0x12, 0x14, 0x22, 0xe2, 0x79, 0x84, 0x59, 0x6a, 0x5d, 0x59, 0x36,
+	0xec, 0x64, 0x85, 0x3e, 0x03, 0x7a, 0x17, 0xc7, 0xbd, 0x63, 0xe7, 0x84, 0x9c, 0x59, 0x9a, 0x10,
+	0x2f, 0x0a, 0xc2, 0xb7, 0xc9, 0x19, 0xaa, 0x42, 0xf1, 0x87, 0x03, 0x1c, 0xc4, 0x34, 0x3e, 0xb3,
+	0x66, 0xea, 0xca, 0xb2, 0x6e, 0xa7, 0x6b, 0xbe, 0x51, 0x1a, 0xe0, 0x50, 0xd7, 0x9a, 0x95, 0x1b,
+	0x25, 0xa1, 0xe3, 0xa2, 0xdb, 0x00, 0x38, 0x3a, 0x71, 0xb0, 0x1f, 0x0e, 0x82, 0xd8, 0x2a, 0x88,
+	0xad, 0x3a, 0x8e, 0x4e, 0xd6, 0x04, 0x01, 0x35, 0x61, 0xd1, 0xa5, 0x11, 0xee, 0x7a, 0xc4, 0xc1,
+	0x83, 0x38, 0x74, 0x18, 0x89, 0x29, 0x23, 0xd6, 0x5c, 0x5d, 0x59, 0x2e, 0xda, 0x0b, 0x09, 0x6b,
+	0x6d, 0x10, 0x87, 0xb6, 0x60, 0xa0, 0x36, 0x00, 0x79, 0xd6, 0xa7, 0x0c, 0xc7, 0x34, 0x0c, 0x2c,
+	0xbd, 0xae, 0x2c, 0x97, 0x56, 0xab, 0x4d, 0xe9, 0xaf, 0xe6, 0xd0, 0x5f, 0xcd, 0x83, 0xa1, 0xbf,
+	0xec, 0x8c, 0x34, 0xba, 0x09, 0xb3, 0x3e, 0x3e, 0x21, 0xcc, 0x02, 0x71, 0xba, 0x5c, 0xa0, 0xbb,
+	0x50, 0xc1, 0x9e, 0x17, 0x3e, 0x25, 0xae, 0xd3, 0x1d, 0x9c, 0x11, 0x16, 0x59, 0xa5, 0xba, 0xb6,
+	0x6c, 0xd8, 0xe5, 0x84, 0xba, 0x2e, 0x88, 0x68, 0x05, 0x90, 0x60, 0x3b, 0x47, 0x2c, 0x1c, 0xf4,
+	0x9d, 0x7e, 0xe8, 0xd1, 0xde, 0x99, 0x65, 0x08, 0x0f, 0x9a, 0x82, 0xf3, 0x90, 0x33, 0xf6, 0x04,
+	0x9d, 0xbb, 0xa4, 0xe7, 0xe1, 0x28, 0x12, 0xbe, 0x2c, 0x4b, 0x97, 0x08, 0x02, 0xf7, 0xe5, 0xeb,
+	0x50, 0xea, 0xb3, 0xf0, 0x03, 0xd2, 0x8b, 0x05, 0xbb, 0x22, 0xd8, 0x90, 0x90, 0xb8, 0xc0, 0x26,
+	0x98, 0x12, 0x89, 0x28, 0xc6, 0x2c, 0x76, 0x5c, 0x1c, 0x13, 0x6b, 0xfe, 0x52, 0x53, 0x2b, 0x62,
+	0xcf, 0x3e, 0xdf, 0xb2, 0x89, 0x63, 0x82, 0xde, 0x84, 0xca, 0xc8, 0xf3, 0xe2, 0x26, 0x53, 0x68,
+	0x6b, 0xa4, 0xde, 0xe7, 0x77, 0xdd, 0x83, 0x05, 0x9f, 0x06, 0xce, 0x21, 0xf5, 0x3c, 0x27, 0x45,
+	0x78, 0x41, 0xc0, 0x34, 0xef, 0xd3, 0x60, 0x9b, 0x7a, 0xde, 0x77, 0x87, 0x40, 0xd7, 0xa0, 0x84,
+	0x3d, 0xcf, 0x09, 0x99, 0x13, 0x84, 0x01, 0xb1, 0x90, 0x70, 0xa3, 0x8e, 0x79, 0xa0, 0xed, 0x84,
+	0x01, 0x69, 0x7f, 0xa8, 0xfc, 0xf3, 0xd7, 0x7f, 0xf9, 0xa9, 0xf6, 0x4b, 0x05, 0x0a, 0x3c, 0xe6,
+	0x4c, 0x05, 0x95, 0x33, 0x31, 0x65, 0x2a, 0x08, 0x86, 0xa1, 0x67, 0xaa, 0xa8, 0x92, 0x45, 0xd2,
+	0xd4, 0x50, 0x0d, 0xaa, 0xa9, 0xcb, 0x56, 0xc6, 0xcd, 0x37, 0x67, 0x50, 0x1d, 0x6e, 0x65, 0xbc,
+	0x36, 0x29, 0x31, 0x8b, 0x6e, 0x81, 0x95, 0xc6, 0xe1, 0x4a, 0xde, 0x74, 0xb3, 0x60, 0x29, 0x8d,
+	0xdf, 0x03, 0x14, 0xd7, 0x07, 0x67, 0xd3, 0xb3, 0xe2, 0x26, 0xcc, 0x0a, 0x14, 0x93, 0xa4, 0x90,
+	0x0b, 0xf4, 0x10, 0x0a, 0x87, 0xd4, 0x8b, 0x09, 0x13, 0x09, 0x51, 0x5a, 0x6d, 0x35, 0x2f, 0x4e,
+	0xdd, 0xe6, 0xf0, 0xfc, 0xe6, 0xb6, 0xd8, 0x66, 0x27, 0xdb, 0x5f, 0x2a, 0x7f, 0xba, 0xd4, 0x1d,
+	0xcb, 0x9f, 0x2e, 0x75, 0x3f, 0x61, 0xfe, 0x7c, 0x15, 0x5e, 0x95, 0x22, 0x3e, 0x09, 0x62, 0xe7,
+	0x83, 0x01, 0xa3, 0x91, 0x4b, 0x7b, 0x22, 0x99, 0x8a, 0xe2, 0xec, 0xa5, 0x11, 0xfb, 0x5b, 0x19,
+	0xee, 0x4b, 0x25, 0x5e, 0x03, 0xca, 0x31, 0xcf, 0x35, 0xe7, 0x90, 0x10, 0xa7, 0xdb, 0x8f, 0x44,
+	0x02, 0x96, 0xed, 0x92, 0x20, 0x6e, 0x13, 0xb2, 0xde, 0x8f, 0xaa, 0x3f, 0xd3, 0xa0, 0x20, 0x7d,
+	0x86, 0x1e, 0x81, 0x1a, 0x32, 0x4b, 0xa9, 0x6b, 0xcb, 0xa5, 0xd5, 0x07, 0xd7, 0x74, 0x78, 0x73,
+	0x83, 0xd1, 0x98, 0x30, 0x8a, 0x6d, 0x35, 0x64, 0xd5, 0x3f, 0xa9, 0x50, 0x1c, 0x12, 0x90, 0x03,
+	0x15, 0x19, 0x60, 0x11, 0xf1, 0x48, 0x2f, 0x16, 0x57, 0x70, 0x2b, 0xbe, 0x72, 0xe5, 0x2b, 0x36,
+	0xf8, 0xf6, 0xfd, 0x64, 0xf7, 0xa3, 0x1b, 0x76, 0xb9, 0x97, 0x25, 0x20, 0x02, 0xe6, 0x30, 0x42,
+	0xd3, 0x2b, 0xd4, 0xba, 0x72, 0x2d, 0x2b, 0xf6, 0xe4, 0x01, 0x99, 0x4b, 0xe6, 0xfb, 0x79, 0x12,
+	0xb7, 0x23, 0x09, 0xfe, 0xe1, 0x25, 0xda, 0x35, 0xed, 0x58, 0x17, 0x85, 0x22, 0x63, 0x47, 0x37,
+	0x4b, 0x58, 0x9f, 0x05, 0x2d, 0x1a, 0xf8, 0xd5, 0xbf, 0x29, 0x50, 0xce, 0x59, 0x8c, 0x5e, 0x03,
+	0x59, 0xc4, 0x9c, 0x24, 0x73, 0x74, 0x7b, 0x4e, 0xac, 0x3b, 0x2e, 0xba, 0x0f, 0x37, 0x87, 0xb6,
+	0xe7, 0x82, 0x4a, 0x15, 0x62, 0x8b, 0x09, 0x2f, 0x17, 0x51, 0xef, 0x41, 0x85, 0x57, 0x9e, 0x4c,
+	0x8d, 0xd3, 0x2e, 0x8d, 0x2a, 0xc3, 0xa7, 0xc1, 0xa8, 0xc2, 0xbd, 0x0b, 0x86, 0x8f, 0x9f, 0x39,
+	0x24, 0x70, 0xe5, 0xfe, 0x99, 0xcb, 0xa3, 0xd2, 0xc7, 0xcf, 0xb6, 0x02, 0x97, 0xef, 0xae, 0xfe,
+	0x41, 0x81, 0xf9, 0x31, 0x77, 0xf3, 0x6c, 0x1b, 0x9a, 0x91, 0xda, 0xa8, 0x27, 0x94, 0x8e, 0x3b,
+	0x45, 0x65, 0xf5, 0x25, 0x55, 0xd6, 0xae, 0xa5, 0xf2, 0x17, 0xa1, 0x9c, 0xc3, 0x8e, 0x3f, 0x25,
+	0x32, 0x16, 0x5c, 0x12, 0x84, 0x7e, 0xa2, 0x30, 0x08, 0xd2, 0x26, 0xa7, 0xb4, 0xdb, 0xa2, 0x22,
+	0x7f, 0x39, 0x2d, 0xc8, 0x7a, 0x52, 0xe6, 0x4c, 0x65, 0xac, 0x00, 0xab, 0xbc, 0x56, 0xa7, 0x65,
+	0xc8, 0xd4, 0x2c, 0xb5, 0xf1, 0x2b, 0x05, 0x8c, 0x35, 0xf9, 0x08, 0x8a, 0xc3, 0x44, 0x2d, 0xc2,
+	0xc1, 0x49, 0xee, 0x32, 0x9d, 0x53, 0x24, 0xfb, 0x0e, 0x94, 0x5d, 0x1a, 0xf5, 0x3d, 0x7c, 0x96,
+	0x48, 0x48, 0xf0, 0x8d, 0x84, 0x28, 0x85, 0xaa, 0x50, 0x24, 0xcf, 0xfa, 0x61, 0x40, 0x82, 0x58,
+	0x18, 0x5f, 0xb6, 0xd3, 0x75, 0xfb, 0x0b, 0x42, 0xd9, 0xbb, 0x60, 0x64, 0xef, 0x41, 0x8b, 0x63,
+	0xc7, 0x9a, 0x8a, 0xa5, 0x58, 0x5a, 0xe3, 0x23, 0x15, 0x0a, 0x8f, 0x85, 0xbe, 0x13, 0xb5, 0x7c,
+	0x05, 0x90, 0xcc, 0x00, 0x27, 0x3e, 0xeb, 0x13, 0x07, 0x77, 0xbb, 0x8c, 0x9c, 0x26, 0xda, 0x98,
+	0x92, 0x73, 0x70, 0xd6, 0x27, 0x6b, 0x82, 0x3e, 0x66, 0x95, 0x36, 0x6e, 0xd5, 0x5b, 0x80, 0xfa,
+	0x8c, 0xf4, 0x68, 0x44, 0xc3, 0xc0, 0xf1, 0x43, 0x97, 0x1e, 0x52, 0xc2, 0x44, 0xa8, 0x95, 0xed,
+	0x85, 0x94, 0xf3, 0x38, 0x61, 0xa0, 0x1a, 0x00, 0x23, 0x47, 0x34, 0x8a, 0x19, 0x0e, 0x62, 0x51,
+	0xcd, 0x0d, 0x3b, 0x43, 0x99, 0x74, 0x52, 0xe1, 0x12, 0x27, 0xcd, 0xe5, 0x9d, 0xc4, 0x79, 0x2e,
+	0xf1, 0x68, 0x14, 0x13, 0x57, 0x94, 0xec, 0xa2, 0x9d, 0xae, 0xdb, 0x6f, 0x0b, 0x07, 0xb6, 0x52,
+	0xb4, 0xef, 0xc0, 0xed, 0x49, 0x47, 0xac, 0x8c, 0xac, 0x15, 0xae, 0x9c, 0x69, 0xfc, 0x58, 0x01,
+	0x5d, 0xba, 0x72, 0x9b, 0x90, 0xfc, 0x73, 0xa4, 0x8c, 0x3d, 0x47, 0x0d, 0x28, 0xfb, 0xb9, 0x52,
+	0xae, 0xca, 0x52, 0xee, 0x8f, 0x4a, 0xf9, 0x64, 0xb9, 0xd7, 0x26, 0xca, 0x7d, 0xfb, 0x15, 0xa1,
+	0xe9, 0x3c, 0x94, 0x72, 0x6f, 0x5f, 0xe3, 0x77, 0xbc, 0xe6, 0xa4, 0x00, 0x71, 0x6d, 0xa6, 0x63,
+	0xa9, 0x9c, 0x83, 0xe5, 0x7f, 0x4b, 0xbd, 0x9a, 0x50, 0xcf, 0x82, 0x9b, 0x53, 0x6f, 0x2f, 0x34,
+	0x7e, 0xa2, 0x40, 0x65, 0x9b, 0x90, 0x4d, 0x12, 0xc5, 0x34, 0x90, 0x8f, 0xdc, 0x01, 0x94, 0xdc,
+	0xd1, 0x52, 0x68, 0x58, 0x59, 0x5d, 0xbd, 0xac, 0x26, 0xe7, 0x0f, 0xe1, 0x96, 0xd8, 0xd9, 0x63,
+	0x90, 0x05, 0x73, 0xd8, 0x75, 0x19, 0x89, 0xa2, 0xa4, 0x31, 0x19, 0x2e, 0xdb, 0xc5, 0x7f, 0x73,
+	0x15, 0xd5, 0xe2, 0x5c, 0xe3, 0x07, 0x50, 0x49, 0xd1, 0x3b, 0x08, 0x63, 0xec, 0x5d, 0x0c, 0xe1,
+	0x12, 0x14, 0x92, 0x6e, 0x42, 0x66, 0x44, 0xb2, 0x9a, 0x0e, 0x49, 0xb1, 0xf1, 0xe1, 0x0c, 0xcc,
+	0x1e, 0x30, 0xec, 0x92, 0x2b, 0xb6, 0x4c, 0xa3, 0xf1, 0x42, 0x3b, 0x7f, 0xbc, 0x98, 0x19, 0x1b,
+	0x2f, 0x2e, 0x6c, 0x81, 0x1a, 0x50, 0xe6, 0x67, 0x38, 0x21, 0x7f, 0xbc, 0xb8, 0x40, 0x41, 0x08,
+	0x94, 0xa2, 0xe1, 0x88, 0xd3, 0x71, 0x51, 0x1d, 0x8c, 0xee, 0xe0, 0x6c, 0x24, 0x32, 0x27, 0x44,
+	0xa0, 0x9b, 0x3c, 0x79, 0x1d, 0x37, 0xd7, 0x81, 0x15, 0xc7, 0x3a, 0xb0, 0xdb, 0x00, 0x83, 0x80,
+	0xc6, 0x4e, 0x9f, 0xd1, 0x1e, 0x11, 0xcd, 0x8d, 0x6e, 0xeb, 0x9c, 0xb2, 0xc7, 0x09, 0xe8, 0x01,
+	0xe8, 0xe9, 0x04, 0x66, 0xc1, 0xa5, 0x15, 0x7b, 0x24, 0xcc, 0x5f, 0xcc, 0xf0, 0xf0, 0x50, 0xaa,
+	0x54, 0x12, 0x2a, 0xcd, 0x89, 0x75, 0xc7, 0x45, 0xcb, 0x60, 0xba, 0x03, 0xee, 0x0f, 0x3c, 0x10,
+	0xef, 0x21, 0x17, 0x31, 0x84, 0x48, 0x45, 0xd0, 0xd7, 0x24, 0x59, 0xbc, 0xad, 0xaf, 0x44, 0x04,
+	0x7b, 0x7c, 0x40, 0xe1, 0x9d, 0xe0, 0x48, 0x5c, 0x0e, 0x16, 0x48, 0x32, 0xd7, 0xa9, 0x3b, 0xda,
+	0xd2, 0x84, 0xc5, 0xc3, 0x90, 0x3d, 0xc5, 0xcc, 0x75, 0x7a, 0x61, 0x10, 0x33, 0x2c, 0x1f, 0x34,
+	0x39, 0x6a, 0x2c, 0x24, 0xac, 0x8d, 0x84, 0xd3, 0x71, 0xdb, 0x0f, 0x04, 0xf6, 0xab, 0xe7, 0xf5,
+	0xed, 0xaf, 0xc2, 0xe2, 0xa8, 0xb3, 0x4e, 0xad, 0x33, 0x55, 0x4b, 0x6f, 0x60, 0x30, 0xd6, 0x64,
+	0x44, 0xca, 0x20, 0xc9, 0x04, 0xac, 0x92, 0x0b, 0x58, 0xee, 0x8b, 0x98, 0x8b, 0x70, 0x45, 0x54,
+	0xe9, 0x0b, 0xb1, 0xee, 0xb8, 0xed, 0x5b, 0xe2, 0xfa, 0x25, 0x40, 0x60, 0x26, 0xd2, 0x2b, 0xa9,
+	0x28, 0x34, 0xfe, 0xac, 0xc1, 0xec, 0x2e, 0xf7, 0xda, 0x15, 0x23, 0xf0, 0xc2, 0x41, 0x76, 0x09,
+	0x0a, 0xc7, 0xa1, 0xe7, 0x26, 0x25, 0xdc, 0xb0, 0x93, 0x55, 0x2e, 0x3c, 0x66, 0x2f, 0x6a, 0xd0,
+	0x0b, 0x17, 0x36, 0xe8, 0x73, 0x57, 0x6c, 0xd0, 0x8b, 0x9f, 0xa0, 0x41, 0xd7, 0xaf, 0xd1, 0xa0,
+	0xc3, 0x75, 0x1b, 0xf4, 0x7c, 0xd9, 0x2c, 0x4d, 0x94, 0xcd, 0xf6, 0xb6, 0xc0, 0xe8, 0xbd, 0x69,
+	0x9d, 0x44, 0x2e, 0x5a, 0x54, 0x3e, 0xe5, 0x49, 0xa7, 0x9a, 0xda, 0x58, 0x93, 0x31, 0x63, 0x95,
+	0x1a, 0xbf, 0xd1, 0xc0, 0xd8, 0xcc, 0x04, 0xf8, 0xa7, 0xfc, 0x7d, 0xe2, 0x0d, 0x30, 0x64, 0x3b,
+	0x97, 0x9b, 0xb0, 0x4a, 0x82, 0x96, 0x40, 0xf8, 0x06, 0x18, 0x87, 0x5e, 0x18, 0xb2, 0x3c, 0xc6,
+	0x25, 0x41, 0x4b, 0x44, 0xbe, 0x06, 0x20, 0x4f, 0xe1, 0xc9, 0x61, 0x15, 0x2f, 0x75, 0xbe, 0x2e,
+	0xa4, 0xf9, 0x1a, 0xbd, 0x0d, 0x45, 0xde, 0x0d, 0x8a, 0x8d, 0x97, 0x8f, 0x55, 0x73, 0x24, 0x70,
+	0xc5, 0xb6, 0x73, 0xe2, 0x0a, 0xce, 0x89, 0xab, 0xb4, 0xb7, 0x1a, 0xc2, 0x37, 0x1a, 0xc5, 0xc7,
+	0xf1, 0xb3, 0x0c, 0x9e, 0x71, 0xe6, 0xfe, 0x58, 0x55, 0xf9, 0x94, 0x71, 0xba, 0x0b, 0x15, 0x46,
+	0x22, 0xc2, 0x4e, 0x49, 0x1e, 0xa9, 0x72, 0x42, 0x4d, 0x80, 0xb8, 0x07, 0x0b, 0xbd, 0xd0, 0xf7,
+	0x69, 0x2c, 0x5a, 0xec, 0x63, 0x42, 0x8f, 0x8e, 0x25, 0x60, 0x9a, 0x3d, 0x2f, 0x19, 0x5b, 0x81,
+	0xfb, 0x48, 0x90, 0xb9, 0x2c, 0x23, 0xa7, 0x04, 0x7b, 0x59, 0xd9, 0xa2, 0x94, 0x95, 0x8c, 0x91,
+	0xec, 0x39, 0xee, 0xd6, 0xcf, 0x4b, 0xe3, 0x89, 0x8c, 0x82, 0x2b, 0x34, 0x22, 0xa5, 0xc9, 0x46,
+	0x64, 0x5d, 0xc0, 0xf6, 0xee, 0x15, 0x60, 0x43, 0xaf, 0x4c, 0x31, 0xc7, 0xd4, 0xac, 0x72, 0xe3,
+	0x5f, 0x2a, 0xe8, 0x29, 0x9a, 0xe2, 0x83, 0xdc, 0xe8, 0x09, 0x91, 0x70, 0xea, 0x38, 0x7d, 0x39,
+	0x96, 0xa0, 0xd0, 0xa5, 0xae, 0x3b, 0x42, 0x55, 0xae, 0x78, 0x5f, 0x2b, 0xfd, 0xe7, 0x0f, 0x3b,
+	0x77, 0xc3, 0xce, 0x50, 0x38, 0x3e, 0x2e, 0xe9, 0x87, 0x11, 0x4d, 0x33, 0x49, 0xc2, 0x5b, 0x4e,
+	0xa8, 0x09, 0x3e, 0x55, 0x28, 0x4a, 0x25, 0x89, 0x84, 0xb8, 0x68, 0xa7, 0xeb, 0x5c, 0x6c, 0x14,
+	0x26, 0x5f, 0xe8, 0xff, 0x87, 0x2a, 0xdb, 0xfe, 0xbc, 0xc0, 0xe3, 0x0e, 0x2c, 0xc2, 0xc2, 0xc8,
+	0x8b, 0x2b, 0x89, 0x8f, 0x60, 0xe8, 0x3b, 0x53, 0xb1, 0x2a, 0x8d, 0x7f, 0x68, 0x50, 0xda, 0x96,
+	0x2f, 0xed, 0x3e, 0xf6, 0xc8, 0x95, 0xf3, 0x67, 0xec, 0xf3, 0xa0, 0x36, 0xf1, 0x79, 0xf0, 0x9b,
+	0x50, 0x3e, 0xa5, 0x41, 0x8c, 0x8f, 0x88, 0x9c, 0x44, 0xaf, 0x30, 0xf7, 0x1a, 0xc9, 0x06, 0x31,
+	0x88, 0xa2, 0x77, 0xa0, 0x34, 0x3c, 0x80, 0x04, 0x12, 0x87, 0x8b, 0xb7, 0x43, 0x22, 0xbe, 0x15,
+	0x5c, 0x8c, 0x52, 0x2e, 0x83, 0xe7, 0x2e, 0xfc, 0x12, 0x5c, 0x1c, 0xff, 0x12, 0xfc, 0x10, 0x16,
+	0xf8, 0x1c, 0x73, 0x4a, 0x18, 0x9f, 0x8c, 0xb0, 0xeb, 0xd1, 0xe0, 0x2a, 0x05, 0xd1, 0x1c, 0x6e,
+	0xda, 0x4c, 0xf6, 0x5c, 0xbb, 0x32, 0x6e, 0x0a, 0x48, 0xbf, 0x31, 0x35, 0xc5, 0xe6, 0x73, 0x58,
+	0xc8, 0x24, 0x9b, 0xd0, 0xd2, 0xd4, 0xac, 0xf9, 0xc6, 0x1f, 0x35, 0x98, 0xdf, 0xce, 0xf7, 0x55,
+	0x13, 0x88, 0x7f, 0x16, 0xe6, 0x87, 0x5d, 0x59, 0x84, 0xbd, 0x4c, 0x23, 0x54, 0x3e, 0x1c, 0xc5,
+	0x49, 0x27, 0xd3, 0xd6, 0x68, 0xd9, 0xb6, 0x66, 0x2c, 0x2e, 0x66, 0x26, 0xe2, 0xe2, 0xa2, 0x16,
+	0xe6, 0x0e, 0x94, 0x49, 0xd4, 0x63, 0xe1, 0xd3, 0x7c, 0xf5, 0x34, 0x24, 0xf1, 0x7f, 0xfd, 0x31,
+	0x71, 0xa2, 0x3a, 0xea, 0x57, 0xa8, 0x8e, 0x93, 0x1f, 0x0d, 0xdb, 0x6b, 0x02, 0xba, 0x77, 0x52,
+	0xe8, 0x16, 0x27, 0x1c, 0x9c, 0x6d, 0x54, 0xd4, 0x71, 0x38, 0x35, 0xcb, 0x6c, 0xfc, 0x48, 0x01,
+	0x24, 0xa7, 0x27, 0x3b, 0x19, 0xd2, 0x87, 0x73, 0x17, 0x09, 0xb8, 0xb9, 0x12, 0xbf, 0xa2, 0x3d,
+	0x5c, 0x8a, 0x01, 0x3e, 0x29, 0x74, 0xf9, 0xaf, 0x1c, 0x92, 0x28, 0x07, 0xf8, 0xc9, 0x6a, 0xa8,
+	0x4d, 0xa9, 0x86, 0xe9, 0x0c, 0xb7, 0x70, 0xef, 0xb7, 0x0a, 0xa0, 0xc9, 0x59, 0x10, 0xbd, 0x09,
+	0xf5, 0xed, 0xad, 0x2d, 0x67, 0x73, 0x6b, 0xff, 0xa0, 0xb3, 0xb3, 0x76, 0xd0, 0xd9, 0xdd, 0x71,
+	0x0e, 0xde, 0xdf, 0xdb, 0x72, 0x9e, 0xec, 0xec, 0xef, 0x6d, 0x6d, 0x74, 0xb6, 0x3b, 0x5b, 0x9b,
+	0xe6, 0x0d, 0xf4, 0x39, 0xb8, 0x33, 0x55, 0x6a, 0x63, 0xf7, 0xf1, 0xe3, 0x27, 0x3b, 0x9d, 0x83,
+	0xf7, 0x9d, 0xbd, 0xdd, 0xdd, 0xef, 0x98, 0x0a, 0xba, 0x0d, 0xaf, 0x4d, 0x15, 0x5c, 0x7f, 0x62,
+	0xef, 0x98, 0x2a, 0xff, 0xc4, 0x3e, 0x95, 0xbd, 0xb6, 0xb1, 0xb1, 0xfb, 0x64, 0xe7, 0xc0, 0xd4,
+	0xd6, 0xbf, 0xf7, 0xd1, 0xf3, 0x9a, 0xf2, 0xf1, 0xf3, 0x9a, 0xf2, 0xf7, 0xe7, 0x35, 0xe5, 0xe7,
+	0x2f, 0x6a, 0x37, 0x3e, 0x7e, 0x51, 0xbb, 0xf1, 0xd7, 0x17, 0xb5, 0x1b, 0xdf, 0xff, 0xfa, 0x11,
+	0x8d, 0x8f, 0x07, 0xdd, 0x66, 0x2f, 0xf4, 0x5b, 0x62, 0xe6, 0x7d, 0x2b, 0x20, 0xf1, 0xd3, 0x90,
+	0x9d, 0x24, 0x2b, 0x8f, 0xb8, 0x47, 0x84, 0xb5, 0x9e, 0x4d, 0xff, 0x43, 0xd6, 0x2d, 0x88, 0xd4,
+	0xfe, 0xd2, 0x7f, 0x06, 0x00, 0x0f, 0x70, 0xf6, 0x21, 0x47, 0x1b, 0x00, 0x00,
+}
+
+func (m *SellOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SellOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SellOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AllOrNone {
+		i--
+		if m.AllOrNone {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.MinFillQuantity) > 0 {
+		i -= len(m.MinFillQuantity)
+		copy(dAtA[i:], m.MinFillQuantity)
+		i = encodeVarintState(dAtA, i, uint64(len(m.MinFillQuantity)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.AskAmountKey) > 0 {
+		i -= len(m.AskAmountKey)
+		copy(dAtA[i:], m.AskAmountKey)
+		i = encodeVarintState(dAtA, i, uint64(len(m.AskAmountKey)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.BatchStartDate != nil {
+		{
+			size, err := m.BatchStartDate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.ProjectKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ProjectKey))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.ClassKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.BuyerGroupPolicy) > 0 {
+		i -= len(m.BuyerGroupPolicy)
+		copy(dAtA[i:], m.BuyerGroupPolicy)
+		i = encodeVarintState(dAtA, i, uint64(len(m.BuyerGroupPolicy)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.AllowedBuyers) > 0 {
+		for iNdEx := len(m.AllowedBuyers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedBuyers[iNdEx])
+			copy(dAtA[i:], m.AllowedBuyers[iNdEx])
+			i = encodeVarintState(dAtA, i, uint64(len(m.AllowedBuyers[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.Maker {
+		i--
+		if m.Maker {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Expiration != nil {
+		{
+			size, err := m.Expiration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.DisableAutoRetire {
+		i--
+		if m.DisableAutoRetire {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.AskAmount) > 0 {
+		i -= len(m.AskAmount)
+		copy(dAtA[i:], m.AskAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.AskAmount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BatchKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BuyOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BuyOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuyOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TakerFeeBps != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.TakerFeeBps))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Expiration != nil {
+		{
+			size, err := m.Expiration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.RetirementJurisdiction) > 0 {
+		i -= len(m.RetirementJurisdiction)
+		copy(dAtA[i:], m.RetirementJurisdiction)
+		i = encodeVarintState(dAtA, i, uint64(len(m.RetirementJurisdiction)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.DisableAutoRetire {
+		i--
+		if m.DisableAutoRetire {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.BidAmount) > 0 {
+		i -= len(m.BidAmount)
+		copy(dAtA[i:], m.BidAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.BidAmount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BuyOrder_Filter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BuyOrder_Filter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuyOrder_Filter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Or) > 0 {
+		for iNdEx := len(m.Or) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Or[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BuyOrder_Filter_Criteria) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BuyOrder_Filter_Criteria) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuyOrder_Filter_Criteria) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sum != nil {
+		{
+			size := m.Sum.Size()
+			i -= size
+			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BuyOrder_Filter_Criteria_ClassSelector) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuyOrder_Filter_Criteria_ClassSelector) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ClassSelector != nil {
+		{
+			size, err := m.ClassSelector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BuyOrder_Filter_Criteria_ProjectSelector) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuyOrder_Filter_Criteria_ProjectSelector) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ProjectSelector != nil {
+		{
+			size, err := m.ProjectSelector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BuyOrder_Filter_Criteria_BatchSelector) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuyOrder_Filter_Criteria_BatchSelector) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BatchSelector != nil {
+		{
+			size, err := m.BatchSelector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BuyOrder_ClassSelector) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BuyOrder_ClassSelector) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuyOrder_ClassSelector) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxEndDate != nil {
+		{
+			size, err := m.MaxEndDate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MinStartDate != nil {
+		{
+			size, err := m.MinStartDate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ProjectJurisdiction) > 0 {
+		i -= len(m.ProjectJurisdiction)
+		copy(dAtA[i:], m.ProjectJurisdiction)
+		i = encodeVarintState(dAtA, i, uint64(len(m.ProjectJurisdiction)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintState(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BuyOrder_ProjectSelector) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BuyOrder_ProjectSelector) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuyOrder_ProjectSelector) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxEndDate != nil {
+		{
+			size, err := m.MaxEndDate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MinStartDate != nil {
+		{
+			size, err := m.MinStartDate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProjectId) > 0 {
+		i -= len(m.ProjectId)
+		copy(dAtA[i:], m.ProjectId)
+		i = encodeVarintState(dAtA, i, uint64(len(m.ProjectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BuyOrder_BatchSelector) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BuyOrder_BatchSelector) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuyOrder_BatchSelector) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BatchDenom) > 0 {
+		i -= len(m.BatchDenom)
+		copy(dAtA[i:], m.BatchDenom)
+		i = encodeVarintState(dAtA, i, uint64(len(m.BatchDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllowedDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exponent != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Exponent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DisplayDenom) > 0 {
+		i -= len(m.DisplayDenom)
+		copy(dAtA[i:], m.DisplayDenom)
+		i = encodeVarintState(dAtA, i, uint64(len(m.DisplayDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BankDenom) > 0 {
+		i -= len(m.BankDenom)
+		copy(dAtA[i:], m.BankDenom)
+		i = encodeVarintState(dAtA, i, uint64(len(m.BankDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Market) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Market) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Market) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Delisted {
+		i--
+		if m.Delisted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Exponent != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Exponent))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.DisplayDenom) > 0 {
+		i -= len(m.DisplayDenom)
+		copy(dAtA[i:], m.DisplayDenom)
+		i = encodeVarintState(dAtA, i, uint64(len(m.DisplayDenom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Registrant) > 0 {
+		i -= len(m.Registrant)
+		copy(dAtA[i:], m.Registrant)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Registrant)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PrecisionModifier != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.PrecisionModifier))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BankDenom) > 0 {
+		i -= len(m.BankDenom)
+		copy(dAtA[i:], m.BankDenom)
+		i = encodeVarintState(dAtA, i, uint64(len(m.BankDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CreditTypeAbbrev) > 0 {
+		i -= len(m.CreditTypeAbbrev)
+		copy(dAtA[i:], m.CreditTypeAbbrev)
+		i = encodeVarintState(dAtA, i, uint64(len(m.CreditTypeAbbrev)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TakerFeeBps != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.TakerFeeBps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MakerFeeBps != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MakerFeeBps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreditTypeFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreditTypeFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreditTypeFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TakerFeeBps != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.TakerFeeBps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MakerFeeBps != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MakerFeeBps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CreditTypeAbbrev) > 0 {
+		i -= len(m.CreditTypeAbbrev)
+		copy(dAtA[i:], m.CreditTypeAbbrev)
+		i = encodeVarintState(dAtA, i, uint64(len(m.CreditTypeAbbrev)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeDestination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDestination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDestination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Destination != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketFeeTotal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketFeeTotal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketFeeTotal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Trade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Trade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Trade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ForwardContractId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ForwardContractId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.SealedBidAuctionId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.SealedBidAuctionId))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.DutchAuctionId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.DutchAuctionId))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.OfferId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.OfferId))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Timestamp != nil {
+		{
+			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.UnitPrice) > 0 {
+		i -= len(m.UnitPrice)
+		copy(dAtA[i:], m.UnitPrice)
+		i = encodeVarintState(dAtA, i, uint64(len(m.UnitPrice)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.BuyOrderId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BuyOrderId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SellOrderId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.SellOrderId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BatchKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddressTrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressTrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressTrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TradeId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.TradeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Offer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Offer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Offer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MakerFeeBps != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MakerFeeBps))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Expiration != nil {
+		{
+			size, err := m.Expiration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.RetirementJurisdiction) > 0 {
+		i -= len(m.RetirementJurisdiction)
+		copy(dAtA[i:], m.RetirementJurisdiction)
+		i = encodeVarintState(dAtA, i, uint64(len(m.RetirementJurisdiction)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.DisableAutoRetire {
+		i--
+		if m.DisableAutoRetire {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.BidAmount) > 0 {
+		i -= len(m.BidAmount)
+		copy(dAtA[i:], m.BidAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.BidAmount)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BatchKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DutchAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DutchAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DutchAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DisableAutoRetire {
+		i--
+		if m.DisableAutoRetire {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.EndTime != nil {
+		{
+			size, err := m.EndTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.StartTime != nil {
+		{
+			size, err := m.StartTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.FloorAmount) > 0 {
+		i -= len(m.FloorAmount)
+		copy(dAtA[i:], m.FloorAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.FloorAmount)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.StartAmount) > 0 {
+		i -= len(m.StartAmount)
+		copy(dAtA[i:], m.StartAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.StartAmount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BatchKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SealedBidAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SealedBidAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SealedBidAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TakerFeeBps != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.TakerFeeBps))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MakerFeeBps != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MakerFeeBps))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.DisableAutoRetire {
+		i--
+		if m.DisableAutoRetire {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.RevealEndHeight != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.RevealEndHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CommitEndHeight != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.CommitEndHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ReserveAmount) > 0 {
+		i -= len(m.ReserveAmount)
+		copy(dAtA[i:], m.ReserveAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.ReserveAmount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BatchKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SealedBid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SealedBid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SealedBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RetirementJurisdiction) > 0 {
+		i -= len(m.RetirementJurisdiction)
+		copy(dAtA[i:], m.RetirementJurisdiction)
+		i = encodeVarintState(dAtA, i, uint64(len(m.RetirementJurisdiction)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.DisableAutoRetire {
+		i--
+		if m.DisableAutoRetire {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.BidAmount) > 0 {
+		i -= len(m.BidAmount)
+		copy(dAtA[i:], m.BidAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.BidAmount)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Revealed {
+		i--
+		if m.Revealed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.DepositAmount) > 0 {
+		i -= len(m.DepositAmount)
+		copy(dAtA[i:], m.DepositAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.DepositAmount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForwardSale) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardSale) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardSale) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DisableAutoRetire {
+		i--
+		if m.DisableAutoRetire {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.DeliveryDeadline != nil {
+		{
+			size, err := m.DeliveryDeadline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.AskAmount) > 0 {
+		i -= len(m.AskAmount)
+		copy(dAtA[i:], m.AskAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.AskAmount)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.VintageEnd != nil {
+		{
+			size, err := m.VintageEnd.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.VintageStart != nil {
+		{
+			size, err := m.VintageStart.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ProjectKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ProjectKey))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForwardContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TakerFeeBps != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.TakerFeeBps))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MakerFeeBps != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MakerFeeBps))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.RetirementJurisdiction) > 0 {
+		i -= len(m.RetirementJurisdiction)
+		copy(dAtA[i:], m.RetirementJurisdiction)
+		i = encodeVarintState(dAtA, i, uint64(len(m.RetirementJurisdiction)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.DisableAutoRetire {
+		i--
+		if m.DisableAutoRetire {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.EscrowAmount) > 0 {
+		i -= len(m.EscrowAmount)
+		copy(dAtA[i:], m.EscrowAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.EscrowAmount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ProjectKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ProjectKey))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ForwardSaleId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ForwardSaleId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketRegistration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketRegistration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketRegistration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DepositAmount) > 0 {
+		i -= len(m.DepositAmount)
+		copy(dAtA[i:], m.DepositAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.DepositAmount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DepositDenom) > 0 {
+		i -= len(m.DepositDenom)
+		copy(dAtA[i:], m.DepositDenom)
+		i = encodeVarintState(dAtA, i, uint64(len(m.DepositDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintState(dAtA []byte, offset int, v uint64) int {
+	offset -= sovState(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SellOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.BatchKey != 0 {
+		n += 1 + sovState(uint64(m.BatchKey))
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovState(uint64(m.MarketId))
+	}
+	l = len(m.AskAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.DisableAutoRetire {
+		n += 2
+	}
+	if m.Expiration != nil {
+		l = m.Expiration.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Maker {
+		n += 2
+	}
+	if len(m.AllowedBuyers) > 0 {
+		for _, b := range m.AllowedBuyers {
+			l = len(b)
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	l = len(m.BuyerGroupPolicy)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.ClassKey != 0 {
+		n += 1 + sovState(uint64(m.ClassKey))
+	}
+	if m.ProjectKey != 0 {
+		n += 1 + sovState(uint64(m.ProjectKey))
+	}
+	if m.BatchStartDate != nil {
+		l = m.BatchStartDate.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.AskAmountKey)
+	if l > 0 {
+		n += 2 + l + sovState(uint64(l))
+	}
+	l = len(m.MinFillQuantity)
+	if l > 0 {
+		n += 2 + l + sovState(uint64(l))
+	}
+	if m.AllOrNone {
+		n += 3
+	}
+	return n
+}
+
+func (m *BuyOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovState(uint64(m.MarketId))
+	}
+	l = len(m.BidAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.DisableAutoRetire {
+		n += 2
+	}
+	l = len(m.RetirementJurisdiction)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = m.Expiration.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.TakerFeeBps != 0 {
+		n += 1 + sovState(uint64(m.TakerFeeBps))
+	}
+	return n
+}
+
+func (m *BuyOrder_Filter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Or) > 0 {
+		for _, e := range m.Or {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BuyOrder_Filter_Criteria) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *BuyOrder_Filter_Criteria_ClassSelector) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClassSelector != nil {
+		l = m.ClassSelector.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+func (m *BuyOrder_Filter_Criteria_ProjectSelector) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProjectSelector != nil {
+		l = m.ProjectSelector.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+func (m *BuyOrder_Filter_Criteria_BatchSelector) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchSelector != nil {
+		l = m.BatchSelector.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+func (m *BuyOrder_ClassSelector) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.ProjectJurisdiction)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.MinStartDate != nil {
+		l = m.MinStartDate.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.MaxEndDate != nil {
+		l = m.MaxEndDate.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *BuyOrder_ProjectSelector) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProjectId)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.MinStartDate != nil {
+		l = m.MinStartDate.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.MaxEndDate != nil {
+		l = m.MaxEndDate.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *BuyOrder_BatchSelector) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BatchDenom)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *AllowedDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BankDenom)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.DisplayDenom)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Exponent != 0 {
+		n += 1 + sovState(uint64(m.Exponent))
+	}
+	return n
+}
+
+func (m *Market) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.CreditTypeAbbrev)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
 	}
-	var l int
-	_ = l
 	l = len(m.BankDenom)
 	if l > 0 {
 		n += 1 + l + sovState(uint64(l))
 	}
-	l = len(m.DisplayDenom)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if m.PrecisionModifier != 0 {
+		n += 1 + sovState(uint64(m.PrecisionModifier))
+	}
+	l = len(m.Registrant)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.DisplayDenom)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Exponent != 0 {
+		n += 1 + sovState(uint64(m.Exponent))
+	}
+	if m.Delisted {
+		n += 2
+	}
+	return n
+}
+
+func (m *MarketFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovState(uint64(m.MarketId))
+	}
+	if m.MakerFeeBps != 0 {
+		n += 1 + sovState(uint64(m.MakerFeeBps))
+	}
+	if m.TakerFeeBps != 0 {
+		n += 1 + sovState(uint64(m.TakerFeeBps))
+	}
+	return n
+}
+
+func (m *CreditTypeFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CreditTypeAbbrev)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.MakerFeeBps != 0 {
+		n += 1 + sovState(uint64(m.MakerFeeBps))
+	}
+	if m.TakerFeeBps != 0 {
+		n += 1 + sovState(uint64(m.TakerFeeBps))
+	}
+	return n
+}
+
+func (m *FeeDestination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Destination != 0 {
+		n += 1 + sovState(uint64(m.Destination))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *MarketFeeTotal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovState(uint64(m.MarketId))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *Trade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.BatchKey != 0 {
+		n += 1 + sovState(uint64(m.BatchKey))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovState(uint64(m.MarketId))
+	}
+	if m.SellOrderId != 0 {
+		n += 1 + sovState(uint64(m.SellOrderId))
+	}
+	if m.BuyOrderId != 0 {
+		n += 1 + sovState(uint64(m.BuyOrderId))
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.UnitPrice)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.OfferId != 0 {
+		n += 1 + sovState(uint64(m.OfferId))
+	}
+	if m.DutchAuctionId != 0 {
+		n += 1 + sovState(uint64(m.DutchAuctionId))
+	}
+	if m.SealedBidAuctionId != 0 {
+		n += 1 + sovState(uint64(m.SealedBidAuctionId))
+	}
+	if m.ForwardContractId != 0 {
+		n += 1 + sovState(uint64(m.ForwardContractId))
+	}
+	return n
+}
+
+func (m *AddressTrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.TradeId != 0 {
+		n += 1 + sovState(uint64(m.TradeId))
+	}
+	return n
+}
+
+func (m *Offer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.BatchKey != 0 {
+		n += 1 + sovState(uint64(m.BatchKey))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovState(uint64(m.MarketId))
+	}
+	l = len(m.BidAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.DisableAutoRetire {
+		n += 2
+	}
+	l = len(m.RetirementJurisdiction)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = m.Expiration.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.MakerFeeBps != 0 {
+		n += 1 + sovState(uint64(m.MakerFeeBps))
+	}
+	return n
+}
+
+func (m *DutchAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.BatchKey != 0 {
+		n += 1 + sovState(uint64(m.BatchKey))
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovState(uint64(m.MarketId))
+	}
+	l = len(m.StartAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.FloorAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.StartTime != nil {
+		l = m.StartTime.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = m.EndTime.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.DisableAutoRetire {
+		n += 2
+	}
+	return n
+}
+
+func (m *SealedBidAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.BatchKey != 0 {
+		n += 1 + sovState(uint64(m.BatchKey))
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovState(uint64(m.MarketId))
+	}
+	l = len(m.ReserveAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.CommitEndHeight != 0 {
+		n += 1 + sovState(uint64(m.CommitEndHeight))
+	}
+	if m.RevealEndHeight != 0 {
+		n += 1 + sovState(uint64(m.RevealEndHeight))
+	}
+	if m.DisableAutoRetire {
+		n += 2
+	}
+	if m.MakerFeeBps != 0 {
+		n += 1 + sovState(uint64(m.MakerFeeBps))
+	}
+	if m.TakerFeeBps != 0 {
+		n += 1 + sovState(uint64(m.TakerFeeBps))
+	}
+	return n
+}
+
+func (m *SealedBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovState(uint64(m.AuctionId))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.DepositAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Revealed {
+		n += 2
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.BidAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.DisableAutoRetire {
+		n += 2
+	}
+	l = len(m.RetirementJurisdiction)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *ForwardSale) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.ProjectKey != 0 {
+		n += 1 + sovState(uint64(m.ProjectKey))
+	}
+	if m.VintageStart != nil {
+		l = m.VintageStart.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.VintageEnd != nil {
+		l = m.VintageEnd.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovState(uint64(m.MarketId))
+	}
+	l = len(m.AskAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.DeliveryDeadline != nil {
+		l = m.DeliveryDeadline.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.DisableAutoRetire {
+		n += 2
+	}
+	return n
+}
+
+func (m *ForwardContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	if m.ForwardSaleId != 0 {
+		n += 1 + sovState(uint64(m.ForwardSaleId))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.ProjectKey != 0 {
+		n += 1 + sovState(uint64(m.ProjectKey))
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.EscrowAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.DisableAutoRetire {
+		n += 2
+	}
+	l = len(m.RetirementJurisdiction)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.MakerFeeBps != 0 {
+		n += 1 + sovState(uint64(m.MakerFeeBps))
+	}
+	if m.TakerFeeBps != 0 {
+		n += 1 + sovState(uint64(m.TakerFeeBps))
+	}
+	return n
+}
+
+func (m *MarketRegistration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.DepositDenom)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.DepositAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func sovState(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozState(x uint64) (n int) {
+	return sovState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SellOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SellOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SellOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = append(m.Seller[:0], dAtA[iNdEx:postIndex]...)
+			if m.Seller == nil {
+				m.Seller = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+			}
+			m.BatchKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AskAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableAutoRetire = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = &types.Timestamp{}
+			}
+			if err := m.Expiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maker", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Maker = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedBuyers", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedBuyers = append(m.AllowedBuyers, make([]byte, postIndex-iNdEx))
+			copy(m.AllowedBuyers[len(m.AllowedBuyers)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyerGroupPolicy", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuyerGroupPolicy = append(m.BuyerGroupPolicy[:0], dAtA[iNdEx:postIndex]...)
+			if m.BuyerGroupPolicy == nil {
+				m.BuyerGroupPolicy = []byte{}
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
+			}
+			m.ClassKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClassKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
+			}
+			m.ProjectKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProjectKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchStartDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BatchStartDate == nil {
+				m.BatchStartDate = &types.Timestamp{}
+			}
+			if err := m.BatchStartDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskAmountKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AskAmountKey = append(m.AskAmountKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.AskAmountKey == nil {
+				m.AskAmountKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFillQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinFillQuantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllOrNone", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllOrNone = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BuyOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BuyOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BuyOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = append(m.Buyer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Buyer == nil {
+				m.Buyer = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &BuyOrder_Filter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableAutoRetire = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementJurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetirementJurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = &types.Timestamp{}
+			}
+			if err := m.Expiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeBps", wireType)
+			}
+			m.TakerFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TakerFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BuyOrder_Filter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Filter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Filter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Or", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Or = append(m.Or, &BuyOrder_Filter_Criteria{})
+			if err := m.Or[len(m.Or)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BuyOrder_Filter_Criteria) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Criteria: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Criteria: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BuyOrder_ClassSelector{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &BuyOrder_Filter_Criteria_ClassSelector{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BuyOrder_ProjectSelector{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &BuyOrder_Filter_Criteria_ProjectSelector{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BuyOrder_BatchSelector{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &BuyOrder_Filter_Criteria_BatchSelector{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BuyOrder_ClassSelector) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassSelector: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassSelector: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectJurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectJurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStartDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MinStartDate == nil {
+				m.MinStartDate = &types.Timestamp{}
+			}
+			if err := m.MinStartDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEndDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxEndDate == nil {
+				m.MaxEndDate = &types.Timestamp{}
+			}
+			if err := m.MaxEndDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BuyOrder_ProjectSelector) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectSelector: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectSelector: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStartDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MinStartDate == nil {
+				m.MinStartDate = &types.Timestamp{}
+			}
+			if err := m.MinStartDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEndDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxEndDate == nil {
+				m.MaxEndDate = &types.Timestamp{}
+			}
+			if err := m.MaxEndDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BuyOrder_BatchSelector) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchSelector: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchSelector: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowedDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BankDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			}
+			m.Exponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Market) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Market: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Market: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BankDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrecisionModifier", wireType)
+			}
+			m.PrecisionModifier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrecisionModifier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Registrant", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Registrant = append(m.Registrant[:0], dAtA[iNdEx:postIndex]...)
+			if m.Registrant == nil {
+				m.Registrant = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			}
+			m.Exponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delisted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Delisted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeBps", wireType)
+			}
+			m.MakerFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MakerFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeBps", wireType)
+			}
+			m.TakerFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TakerFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreditTypeFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreditTypeFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreditTypeFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeBps", wireType)
+			}
+			m.MakerFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MakerFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeBps", wireType)
+			}
+			m.TakerFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TakerFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDestination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDestination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			m.Destination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Destination |= FeeDestinationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketFeeTotal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketFeeTotal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketFeeTotal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Trade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Trade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Trade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = append(m.Buyer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Buyer == nil {
+				m.Buyer = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = append(m.Seller[:0], dAtA[iNdEx:postIndex]...)
+			if m.Seller == nil {
+				m.Seller = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+			}
+			m.BatchKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellOrderId", wireType)
+			}
+			m.SellOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SellOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyOrderId", wireType)
+			}
+			m.BuyOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BuyOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnitPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &types.Timestamp{}
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferId", wireType)
+			}
+			m.OfferId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OfferId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutchAuctionId", wireType)
+			}
+			m.DutchAuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DutchAuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealedBidAuctionId", wireType)
+			}
+			m.SealedBidAuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SealedBidAuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardContractId", wireType)
+			}
+			m.ForwardContractId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwardContractId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressTrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressTrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressTrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeId", wireType)
+			}
+			m.TradeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Offer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Offer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Offer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = append(m.Buyer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Buyer == nil {
+				m.Buyer = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+			}
+			m.BatchKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = append(m.Holder[:0], dAtA[iNdEx:postIndex]...)
+			if m.Holder == nil {
+				m.Holder = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableAutoRetire = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementJurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetirementJurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = &types.Timestamp{}
+			}
+			if err := m.Expiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeBps", wireType)
+			}
+			m.MakerFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MakerFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DutchAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DutchAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DutchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = append(m.Seller[:0], dAtA[iNdEx:postIndex]...)
+			if m.Seller == nil {
+				m.Seller = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+			}
+			m.BatchKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloorAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FloorAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = &types.Timestamp{}
+			}
+			if err := m.StartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = &types.Timestamp{}
+			}
+			if err := m.EndTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableAutoRetire = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SealedBidAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SealedBidAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SealedBidAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = append(m.Seller[:0], dAtA[iNdEx:postIndex]...)
+			if m.Seller == nil {
+				m.Seller = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+			}
+			m.BatchKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitEndHeight", wireType)
+			}
+			m.CommitEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealEndHeight", wireType)
+			}
+			m.RevealEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableAutoRetire = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeBps", wireType)
+			}
+			m.MakerFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MakerFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeBps", wireType)
+			}
+			m.TakerFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TakerFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if m.Exponent != 0 {
-		n += 1 + sovState(uint64(m.Exponent))
+	return nil
+}
+func (m *SealedBid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SealedBid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SealedBid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = append(m.Bidder[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bidder == nil {
+				m.Bidder = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revealed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revealed = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableAutoRetire = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementJurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetirementJurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *Market) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovState(uint64(m.Id))
-	}
-	l = len(m.CreditTypeAbbrev)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
-	}
-	l = len(m.BankDenom)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
-	}
-	if m.PrecisionModifier != 0 {
-		n += 1 + sovState(uint64(m.PrecisionModifier))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovState(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozState(x uint64) (n int) {
-	return sovState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *SellOrder) Unmarshal(dAtA []byte) error {
+func (m *ForwardSale) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -677,10 +8933,10 @@ func (m *SellOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SellOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: ForwardSale: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SellOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ForwardSale: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -724,23 +8980,78 @@ func (m *SellOrder) Unmarshal(dAtA []byte) error {
 			if byteLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = append(m.Seller[:0], dAtA[iNdEx:postIndex]...)
+			if m.Seller == nil {
+				m.Seller = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
+			}
+			m.ProjectKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProjectKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VintageStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Seller = append(m.Seller[:0], dAtA[iNdEx:postIndex]...)
-			if m.Seller == nil {
-				m.Seller = []byte{}
+			if m.VintageStart == nil {
+				m.VintageStart = &types.Timestamp{}
+			}
+			if err := m.VintageStart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VintageEnd", wireType)
 			}
-			m.BatchKey = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -750,12 +9061,29 @@ func (m *SellOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchKey |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VintageEnd == nil {
+				m.VintageEnd = &types.Timestamp{}
+			}
+			if err := m.VintageEnd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
 			}
@@ -787,7 +9115,7 @@ func (m *SellOrder) Unmarshal(dAtA []byte) error {
 			}
 			m.Quantity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
@@ -806,7 +9134,7 @@ func (m *SellOrder) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 6:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AskAmount", wireType)
 			}
@@ -838,29 +9166,9 @@ func (m *SellOrder) Unmarshal(dAtA []byte) error {
 			}
 			m.AskAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.DisableAutoRetire = bool(v != 0)
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryDeadline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -887,16 +9195,16 @@ func (m *SellOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Expiration == nil {
-				m.Expiration = &types.Timestamp{}
+			if m.DeliveryDeadline == nil {
+				m.DeliveryDeadline = &types.Timestamp{}
 			}
-			if err := m.Expiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DeliveryDeadline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Maker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -913,7 +9221,7 @@ func (m *SellOrder) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Maker = bool(v != 0)
+			m.DisableAutoRetire = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -935,7 +9243,7 @@ func (m *SellOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllowedDenom) Unmarshal(dAtA []byte) error {
+func (m *ForwardContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -958,15 +9266,106 @@ func (m *AllowedDenom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllowedDenom: wiretype end group for non-group")
+			return fmt.Errorf("proto: ForwardContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllowedDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ForwardContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardSaleId", wireType)
+			}
+			m.ForwardSaleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwardSaleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = append(m.Buyer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Buyer == nil {
+				m.Buyer = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
+			}
+			m.ProjectKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProjectKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -994,11 +9393,11 @@ func (m *AllowedDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BankDenom = string(dAtA[iNdEx:postIndex])
+			m.Quantity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisplayDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1026,13 +9425,13 @@ func (m *AllowedDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DisplayDenom = string(dAtA[iNdEx:postIndex])
+			m.EscrowAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
 			}
-			m.Exponent = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1042,7 +9441,78 @@ func (m *AllowedDenom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Exponent |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableAutoRetire = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementJurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetirementJurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeBps", wireType)
+			}
+			m.MakerFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MakerFeeBps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeBps", wireType)
+			}
+			m.TakerFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TakerFeeBps |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1068,7 +9538,7 @@ func (m *AllowedDenom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Market) Unmarshal(dAtA []byte) error {
+func (m *MarketRegistration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1091,17 +9561,17 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Market: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketRegistration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Market: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketRegistration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			m.Id = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1111,14 +9581,15 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Enabled = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1146,11 +9617,11 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
+			m.DepositDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1178,27 +9649,8 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BankDenom = string(dAtA[iNdEx:postIndex])
+			m.DepositAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrecisionModifier", wireType)
-			}
-			m.PrecisionModifier = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PrecisionModifier |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
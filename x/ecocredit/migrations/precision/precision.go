@@ -0,0 +1,39 @@
+package precision
+
+import (
+	"context"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// MigrateCreditType raises the precision of the credit type. Credit quantities
+// stored at the previous precision remain valid at the new precision, so no
+// stored quantities are rewritten and the cost of the migration does not depend
+// on the number of credits of the credit type.
+//
+// The exponent and denom of each basket of the credit type are left unchanged.
+// Basket tokens are denominated with the exponent set at basket creation, so
+// changing it would change the value of existing basket tokens. Credits put
+// into a basket must still be whole multiples of the basket exponent.
+func MigrateCreditType(ctx context.Context, ss api.StateStore, abbrev string, precision uint32) error {
+	creditType, err := ss.CreditTypeTable().Get(ctx, abbrev)
+	if err != nil {
+		return sdkerrors.ErrNotFound.Wrapf("credit type with abbreviation %s: %s", abbrev, err)
+	}
+
+	if precision <= creditType.Precision {
+		return sdkerrors.ErrInvalidRequest.Wrapf(
+			"precision %d must be greater than current precision %d", precision, creditType.Precision,
+		)
+	}
+
+	if err := core.ValidateCreditTypePrecision(precision); err != nil {
+		return err
+	}
+
+	creditType.Precision = precision
+	return ss.CreditTypeTable().Update(ctx, creditType)
+}
@@ -0,0 +1,98 @@
+package precision_test
+
+import (
+	"context"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormdb"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormtable"
+	"github.com/cosmos/cosmos-sdk/orm/testing/ormtest"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	basketapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/migrations/precision"
+)
+
+type stores struct {
+	ctx         context.Context
+	ss          api.StateStore
+	basketStore basketapi.StateStore
+}
+
+func setup(t *testing.T) stores {
+	db, err := ormdb.NewModuleDB(&ecocredit.ModuleSchema, ormdb.ModuleDBOptions{})
+	assert.NilError(t, err)
+
+	ss, err := api.NewStateStore(db)
+	assert.NilError(t, err)
+
+	basketStore, err := basketapi.NewStateStore(db)
+	assert.NilError(t, err)
+
+	ctx := ormtable.WrapContextDefault(ormtest.NewMemoryBackend())
+
+	assert.NilError(t, ss.CreditTypeTable().Insert(ctx, &api.CreditType{
+		Abbreviation: "C",
+		Name:         "carbon",
+		Unit:         "metric ton CO2 equivalent",
+		Precision:    6,
+	}))
+
+	return stores{ctx, ss, basketStore}
+}
+
+func TestMigrateCreditType(t *testing.T) {
+	t.Parallel()
+	s := setup(t)
+	addr := sdk.AccAddress("addr1")
+
+	assert.NilError(t, s.ss.BatchBalanceTable().Insert(s.ctx, &api.BatchBalance{
+		BatchKey:       1,
+		Address:        addr,
+		TradableAmount: "5.250000",
+	}))
+
+	basketID, err := s.basketStore.BasketTable().InsertReturningID(s.ctx, &basketapi.Basket{
+		BasketDenom:      "eco.uC.NCT",
+		Name:             "NCT",
+		CreditTypeAbbrev: "C",
+		Exponent:         6,
+	})
+	assert.NilError(t, err)
+
+	assert.NilError(t, precision.MigrateCreditType(s.ctx, s.ss, "C", 9))
+
+	creditType, err := s.ss.CreditTypeTable().Get(s.ctx, "C")
+	assert.NilError(t, err)
+	assert.Equal(t, uint32(9), creditType.Precision)
+
+	balance, err := s.ss.BatchBalanceTable().Get(s.ctx, addr, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "5.250000", balance.TradableAmount)
+
+	basket, err := s.basketStore.BasketTable().Get(s.ctx, basketID)
+	assert.NilError(t, err)
+	assert.Equal(t, "eco.uC.NCT", basket.BasketDenom)
+	assert.Equal(t, uint32(6), basket.Exponent)
+}
+
+func TestMigrateCreditType_Invalid(t *testing.T) {
+	t.Parallel()
+	s := setup(t)
+
+	err := precision.MigrateCreditType(s.ctx, s.ss, "BIO", 9)
+	assert.ErrorContains(t, err, "not found")
+
+	err = precision.MigrateCreditType(s.ctx, s.ss, "C", 6)
+	assert.ErrorContains(t, err, "precision 6 must be greater than current precision 6")
+
+	err = precision.MigrateCreditType(s.ctx, s.ss, "C", 7)
+	assert.ErrorContains(t, err, "credit type precision 7 is not a supported basket exponent")
+
+	err = precision.MigrateCreditType(s.ctx, s.ss, "C", 19)
+	assert.ErrorContains(t, err, "credit type precision cannot exceed 18")
+}
@@ -0,0 +1,99 @@
+package v4
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	marketapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/orderbook"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/marketplace"
+)
+
+// MigrateState performs in-place store migrations from v4.0 to v5.0.
+func MigrateState(sdkCtx sdk.Context, ss api.StateStore, marketStore marketapi.StateStore, ob orderbook.OrderBook) error {
+	if err := migrateProjectStatus(sdkCtx, ss); err != nil {
+		return err
+	}
+
+	if err := migrateSellOrderKeys(sdkCtx, ss, marketStore); err != nil {
+		return err
+	}
+
+	// the orderbook tables are derived from the buy orders and sell orders in
+	// the marketplace state and are rebuilt so that they are consistent with
+	// the matching rules of the new version
+	return ob.Reload(sdk.WrapSDKContext(sdkCtx))
+}
+
+// migrateProjectStatus sets the status of projects created before project
+// statuses were introduced to active so that credits can still be issued to
+// them. The table is listed by primary key rather than by the status index
+// because the projects are updated after they have been listed.
+func migrateProjectStatus(sdkCtx sdk.Context, ss api.StateStore) error {
+	ctx := sdk.WrapSDKContext(sdkCtx)
+
+	it, err := ss.ProjectTable().List(ctx, api.ProjectKeyIndexKey{})
+	if err != nil {
+		return err
+	}
+
+	var projects []*api.Project
+	for it.Next() {
+		project, err := it.Value()
+		if err != nil {
+			it.Close()
+			return err
+		}
+		if project.Status == api.ProjectStatus_PROJECT_STATUS_UNSPECIFIED {
+			projects = append(projects, project)
+		}
+	}
+	it.Close()
+
+	for _, project := range projects {
+		project.Status = api.ProjectStatus_PROJECT_STATUS_ACTIVE
+		if err := ss.ProjectTable().Update(ctx, project); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// migrateSellOrderKeys sets the class key, project key, batch start date and
+// ask amount key of sell orders created before the fields were introduced so
+// that the sell orders are found by the class, project and market indexes.
+func migrateSellOrderKeys(sdkCtx sdk.Context, ss api.StateStore, marketStore marketapi.StateStore) error {
+	ctx := sdk.WrapSDKContext(sdkCtx)
+
+	it, err := marketStore.SellOrderTable().List(ctx, marketapi.SellOrderIdIndexKey{})
+	if err != nil {
+		return err
+	}
+
+	var sellOrders []*marketapi.SellOrder
+	for it.Next() {
+		sellOrder, err := it.Value()
+		if err != nil {
+			it.Close()
+			return err
+		}
+		sellOrders = append(sellOrders, sellOrder)
+	}
+	it.Close()
+
+	for _, sellOrder := range sellOrders {
+		batch, err := ss.BatchTable().Get(ctx, sellOrder.BatchKey)
+		if err != nil {
+			return err
+		}
+		if err := marketplace.SetSellOrderKeys(ctx, ss, sellOrder, batch); err != nil {
+			return err
+		}
+		if err := marketStore.SellOrderTable().Update(ctx, sellOrder); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
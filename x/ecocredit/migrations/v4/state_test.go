@@ -0,0 +1,126 @@
+package v4_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormdb"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormtable"
+	"github.com/cosmos/cosmos-sdk/orm/testing/ormtest"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	marketapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	orderbookapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/orderbook/v1alpha1"
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+	v4 "github.com/regen-network/regen-ledger/x/ecocredit/migrations/v4"
+	"github.com/regen-network/regen-ledger/x/ecocredit/orderbook"
+	marketkeeper "github.com/regen-network/regen-ledger/x/ecocredit/server/marketplace"
+)
+
+func TestMigrateState(t *testing.T) {
+	db, err := ormdb.NewModuleDB(&ecocredit.ModuleSchema, ormdb.ModuleDBOptions{})
+	assert.NilError(t, err)
+	ss, err := api.NewStateStore(db)
+	assert.NilError(t, err)
+	marketStore, err := marketapi.NewStateStore(db)
+	assert.NilError(t, err)
+	memStore, err := orderbookapi.NewMemoryStore(db)
+	assert.NilError(t, err)
+	ob, err := orderbook.NewOrderBook(db)
+	assert.NilError(t, err)
+
+	cms := store.NewCommitMultiStore(dbm.NewMemDB())
+	assert.NilError(t, cms.LoadLatestVersion())
+	ormCtx := ormtable.WrapContextDefault(ormtest.NewMemoryBackend())
+	sdkCtx := sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger()).WithContext(ormCtx)
+	ctx := sdk.WrapSDKContext(sdkCtx)
+
+	classKey, err := ss.ClassTable().InsertReturningID(ctx, &api.Class{Id: "C01", CreditTypeAbbrev: "C"})
+	assert.NilError(t, err)
+
+	// a project created before project statuses were introduced
+	legacyKey, err := ss.ProjectTable().InsertReturningID(ctx, &api.Project{Id: "C01-001", ClassKey: 1})
+	assert.NilError(t, err)
+
+	// a project that has already been suspended
+	suspendedKey, err := ss.ProjectTable().InsertReturningID(ctx, &api.Project{
+		Id:       "C01-002",
+		ClassKey: 1,
+		Status:   api.ProjectStatus_PROJECT_STATUS_SUSPENDED,
+	})
+	assert.NilError(t, err)
+
+	// a match left over from orders that no longer exist
+	assert.NilError(t, memStore.BuyOrderSellOrderMatchTable().Insert(ctx, &orderbookapi.BuyOrderSellOrderMatch{
+		MarketId:    1,
+		BuyOrderId:  1,
+		SellOrderId: 1,
+	}))
+
+	// sell orders created before the sell order keys were introduced
+	batchKey, err := ss.BatchTable().InsertReturningID(ctx, &api.Batch{
+		ProjectKey: legacyKey,
+		Denom:      "C01-001-20200101-20210101-001",
+		StartDate:  timestamppb.New(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+	})
+	assert.NilError(t, err)
+	marketId, err := marketStore.MarketTable().InsertReturningID(ctx, &marketapi.Market{
+		CreditTypeAbbrev: "C",
+		BankDenom:        "uregen",
+	})
+	assert.NilError(t, err)
+	for _, askAmount := range []string{"20", "3"} {
+		assert.NilError(t, marketStore.SellOrderTable().Insert(ctx, &marketapi.SellOrder{
+			Seller:    sdk.AccAddress("seller"),
+			BatchKey:  batchKey,
+			Quantity:  "10",
+			MarketId:  marketId,
+			AskAmount: askAmount,
+		}))
+	}
+
+	assert.NilError(t, v4.MigrateState(sdkCtx, ss, marketStore, ob))
+
+	legacy, err := ss.ProjectTable().Get(ctx, legacyKey)
+	assert.NilError(t, err)
+	assert.Equal(t, api.ProjectStatus_PROJECT_STATUS_ACTIVE, legacy.Status)
+
+	suspended, err := ss.ProjectTable().Get(ctx, suspendedKey)
+	assert.NilError(t, err)
+	assert.Equal(t, api.ProjectStatus_PROJECT_STATUS_SUSPENDED, suspended.Status)
+
+	found, err := memStore.BuyOrderSellOrderMatchTable().Has(ctx, 1, 1)
+	assert.NilError(t, err)
+	assert.Assert(t, !found)
+
+	// the sell orders are found by the class, project and market indexes
+	sellOrder, err := marketStore.SellOrderTable().Get(ctx, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, classKey, sellOrder.ClassKey)
+	assert.Equal(t, legacyKey, sellOrder.ProjectKey)
+
+	k := marketkeeper.NewKeeper(marketStore, ss, nil, nil, nil, ob)
+
+	byClass, err := k.SellOrdersByClass(ctx, &marketplace.QuerySellOrdersByClassRequest{ClassId: "C01"})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(byClass.SellOrders))
+
+	byProject, err := k.SellOrdersByProject(ctx, &marketplace.QuerySellOrdersByProjectRequest{ProjectId: "C01-001"})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(byProject.SellOrders))
+
+	byMarket, err := k.SellOrdersByMarket(ctx, &marketplace.QuerySellOrdersByMarketRequest{MarketId: marketId})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(byMarket.SellOrders))
+	assert.Equal(t, "3", byMarket.SellOrders[0].AskAmount)
+	assert.Equal(t, "20", byMarket.SellOrders[1].AskAmount)
+}
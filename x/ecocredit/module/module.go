@@ -42,6 +42,8 @@ type Module struct {
 	paramSpace    paramtypes.Subspace
 	accountKeeper ecocredit.AccountKeeper
 	bankKeeper    ecocredit.BankKeeper
+	distrKeeper   ecocredit.DistributionKeeper
+	dataKeeper    ecocredit.DataKeeper
 	Keeper        server.Keeper
 }
 
@@ -50,6 +52,8 @@ func NewModule(
 	paramSpace paramtypes.Subspace,
 	accountKeeper ecocredit.AccountKeeper,
 	bankKeeper ecocredit.BankKeeper,
+	distrKeeper ecocredit.DistributionKeeper,
+	dataKeeper ecocredit.DataKeeper,
 ) *Module {
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(coretypes.ParamKeyTable())
@@ -59,6 +63,8 @@ func NewModule(
 		paramSpace:    paramSpace,
 		bankKeeper:    bankKeeper,
 		accountKeeper: accountKeeper,
+		distrKeeper:   distrKeeper,
+		dataKeeper:    dataKeeper,
 	}
 }
 
@@ -79,7 +85,7 @@ func (a Module) RegisterInterfaces(registry types.InterfaceRegistry) {
 }
 
 func (a *Module) RegisterServices(configurator servermodule.Configurator) {
-	a.Keeper = server.RegisterServices(configurator, a.paramSpace, a.accountKeeper, a.bankKeeper)
+	a.Keeper = server.RegisterServices(configurator, a.paramSpace, a.accountKeeper, a.bankKeeper, a.distrKeeper, a.dataKeeper)
 }
 
 //nolint:errcheck
@@ -170,7 +176,7 @@ func (a Module) GetTxCmd() *cobra.Command {
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (Module) ConsensusVersion() uint64 { return 2 }
+func (Module) ConsensusVersion() uint64 { return 3 }
 
 /**** DEPRECATED ****/
 func (a Module) RegisterRESTRoutes(sdkclient.Context, *mux.Router) {}
@@ -209,10 +215,21 @@ func (Module) WeightedOperations(simState module.SimulationState) []simtypes.Wei
 	return nil
 }
 
-// BeginBlock checks if there are any expired sell or buy orders and removes them from state.
+// BeginBlock checks if there are any expired sell or buy orders and removes them from state,
+// and releases locked credits that have unlocked into their tradable balance.
 func (a Module) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 	err := server.BeginBlocker(ctx, a.Keeper)
 	if err != nil {
 		panic(err)
 	}
 }
+
+// EndBlock fills buy orders with matching sell orders and settles sealed-bid auctions.
+func (a Module) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
+	err := server.EndBlocker(ctx, a.Keeper)
+	if err != nil {
+		panic(err)
+	}
+
+	return []abci.ValidatorUpdate{}
+}
@@ -116,10 +116,11 @@ func (m *BuyOrderSellOrderMatch) GetAskPrice() uint32 {
 type BuyOrderClassSelector struct {
 	// buy_order_id is the buy order ID.
 	BuyOrderId uint64 `protobuf:"varint,1,opt,name=buy_order_id,json=buyOrderId,proto3" json:"buy_order_id,omitempty"`
-	// class_id is the class ID.
-	ClassId uint64 `protobuf:"varint,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
-	// project_location is the project location in the selector's criteria.
-	ProjectLocation string `protobuf:"bytes,3,opt,name=project_location,json=projectLocation,proto3" json:"project_location,omitempty"`
+	// class_key is the table row identifier of the credit class.
+	ClassKey uint64 `protobuf:"varint,2,opt,name=class_key,json=classKey,proto3" json:"class_key,omitempty"`
+	// project_jurisdiction is the project jurisdiction prefix in the selector's
+	// criteria.
+	ProjectJurisdiction string `protobuf:"bytes,3,opt,name=project_jurisdiction,json=projectJurisdiction,proto3" json:"project_jurisdiction,omitempty"`
 	// min_start_date is the minimum start date in the selector's criteria.
 	MinStartDate *types.Timestamp `protobuf:"bytes,4,opt,name=min_start_date,json=minStartDate,proto3" json:"min_start_date,omitempty"`
 	// max_end_date is the maximum end date in the selector's criteria.
@@ -166,16 +167,16 @@ func (m *BuyOrderClassSelector) GetBuyOrderId() uint64 {
 	return 0
 }
 
-func (m *BuyOrderClassSelector) GetClassId() uint64 {
+func (m *BuyOrderClassSelector) GetClassKey() uint64 {
 	if m != nil {
-		return m.ClassId
+		return m.ClassKey
 	}
 	return 0
 }
 
-func (m *BuyOrderClassSelector) GetProjectLocation() string {
+func (m *BuyOrderClassSelector) GetProjectJurisdiction() string {
 	if m != nil {
-		return m.ProjectLocation
+		return m.ProjectJurisdiction
 	}
 	return ""
 }
@@ -198,8 +199,8 @@ func (m *BuyOrderClassSelector) GetMaxEndDate() *types.Timestamp {
 type BuyOrderProjectSelector struct {
 	// buy_order_id is the buy order ID.
 	BuyOrderId uint64 `protobuf:"varint,1,opt,name=buy_order_id,json=buyOrderId,proto3" json:"buy_order_id,omitempty"`
-	// project_id is the project ID.
-	ProjectId uint64 `protobuf:"varint,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// project_key is the table row identifier of the project.
+	ProjectKey uint64 `protobuf:"varint,2,opt,name=project_key,json=projectKey,proto3" json:"project_key,omitempty"`
 	// min_start_date is the minimum start date in the selector's criteria.
 	MinStartDate *types.Timestamp `protobuf:"bytes,3,opt,name=min_start_date,json=minStartDate,proto3" json:"min_start_date,omitempty"`
 	// max_end_date is the maximum end date in the selector's criteria.
@@ -246,9 +247,9 @@ func (m *BuyOrderProjectSelector) GetBuyOrderId() uint64 {
 	return 0
 }
 
-func (m *BuyOrderProjectSelector) GetProjectId() uint64 {
+func (m *BuyOrderProjectSelector) GetProjectKey() uint64 {
 	if m != nil {
-		return m.ProjectId
+		return m.ProjectKey
 	}
 	return 0
 }
@@ -271,8 +272,8 @@ func (m *BuyOrderProjectSelector) GetMaxEndDate() *types.Timestamp {
 type BuyOrderBatchSelector struct {
 	// buy_order_id is the buy order ID.
 	BuyOrderId uint64 `protobuf:"varint,1,opt,name=buy_order_id,json=buyOrderId,proto3" json:"buy_order_id,omitempty"`
-	// batch_id is the batch ID.
-	BatchId uint64 `protobuf:"varint,2,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// batch_key is the table row identifier of the credit batch.
+	BatchKey uint64 `protobuf:"varint,2,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
 }
 
 func (m *BuyOrderBatchSelector) Reset()         { *m = BuyOrderBatchSelector{} }
@@ -315,9 +316,9 @@ func (m *BuyOrderBatchSelector) GetBuyOrderId() uint64 {
 	return 0
 }
 
-func (m *BuyOrderBatchSelector) GetBatchId() uint64 {
+func (m *BuyOrderBatchSelector) GetBatchKey() uint64 {
 	if m != nil {
-		return m.BatchId
+		return m.BatchKey
 	}
 	return 0
 }
@@ -334,46 +335,46 @@ func init() {
 }
 
 var fileDescriptor_6c0285db5dc9271c = []byte{
-	// 610 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x4d, 0x6e, 0xd3, 0x40,
-	0x14, 0xc7, 0x3b, 0x4e, 0x4a, 0xdb, 0x69, 0x28, 0x66, 0x44, 0xa9, 0x1b, 0xa8, 0xa9, 0xbc, 0x6a,
-	0xa5, 0xd6, 0xa6, 0x74, 0x57, 0x58, 0xa0, 0x16, 0x84, 0x2c, 0x81, 0xa8, 0x52, 0xd8, 0xb0, 0xb1,
-	0xc6, 0x9e, 0x21, 0x31, 0xf1, 0x78, 0xac, 0xf1, 0x04, 0x12, 0x89, 0x15, 0x12, 0x5b, 0xe0, 0x04,
-	0x9c, 0x81, 0x63, 0xb0, 0xa3, 0x12, 0x1b, 0x96, 0x28, 0xb9, 0x01, 0x27, 0x40, 0x1e, 0xc7, 0x76,
-	0x42, 0x83, 0x50, 0xc4, 0xce, 0xf3, 0xde, 0xfb, 0xbf, 0x8f, 0xdf, 0x3c, 0x0f, 0x74, 0x04, 0x6d,
-	0xd3, 0xd8, 0xa1, 0x01, 0x0f, 0x04, 0x25, 0xa1, 0x74, 0xb8, 0x20, 0x54, 0xf8, 0x9c, 0x77, 0x9d,
-	0xd7, 0x07, 0x38, 0x4a, 0x3a, 0xf8, 0xc0, 0x61, 0x94, 0x71, 0x31, 0xb0, 0x13, 0xc1, 0x25, 0x47,
-	0x96, 0x12, 0xd8, 0xa5, 0xc0, 0x2e, 0x05, 0x76, 0x21, 0x68, 0xde, 0x6a, 0x73, 0xde, 0x8e, 0xa8,
-	0xa3, 0x14, 0x7e, 0xef, 0xa5, 0x23, 0x43, 0x46, 0x53, 0x89, 0x59, 0x92, 0x27, 0x69, 0x6e, 0x05,
-	0x3c, 0x65, 0x3c, 0x75, 0xb8, 0x60, 0x55, 0x19, 0x2e, 0x58, 0xee, 0xb6, 0xbe, 0x69, 0xf0, 0xfa,
-	0x71, 0x6f, 0xf0, 0x34, 0xcb, 0x7c, 0x46, 0xa3, 0x48, 0x7d, 0x3c, 0xc1, 0x32, 0xe8, 0xa0, 0x1b,
-	0x70, 0x85, 0x61, 0xd1, 0xa5, 0xd2, 0x0b, 0x89, 0x01, 0xb6, 0xc1, 0x4e, 0xbd, 0xb5, 0x9c, 0x1b,
-	0x5c, 0x82, 0xb6, 0x61, 0xc3, 0xef, 0x0d, 0x3c, 0xd5, 0x51, 0xe6, 0xd7, 0x94, 0x1f, 0xfa, 0xe3,
-	0x54, 0x2e, 0x41, 0x16, 0xbc, 0x9c, 0xd2, 0x28, 0xaa, 0x42, 0x6a, 0x2a, 0x64, 0x35, 0x2d, 0xaa,
-	0xb8, 0x04, 0xdd, 0x86, 0xd7, 0xfc, 0x90, 0x78, 0x89, 0x08, 0x03, 0xea, 0x05, 0x9c, 0x25, 0x11,
-	0x65, 0x34, 0x96, 0x46, 0x7d, 0x1b, 0xec, 0x2c, 0xb5, 0x90, 0x1f, 0x92, 0xd3, 0xcc, 0x75, 0x52,
-	0x7a, 0xb2, 0xa6, 0x70, 0xda, 0xcd, 0x15, 0xc6, 0xa2, 0x0a, 0x5b, 0xc6, 0x69, 0x57, 0x85, 0x1d,
-	0xbd, 0x03, 0xbf, 0x3e, 0x7f, 0xff, 0x50, 0x7b, 0x0b, 0x6f, 0xc2, 0xe6, 0x64, 0x77, 0x7b, 0x53,
-	0x8d, 0xa0, 0x47, 0xf0, 0xa4, 0x1c, 0x6c, 0x6f, 0x56, 0xfd, 0xbd, 0x29, 0x71, 0x59, 0x6f, 0x3a,
-	0x8d, 0x0e, 0xd0, 0xd5, 0x3f, 0x46, 0xd4, 0x35, 0x03, 0x58, 0x5f, 0x34, 0xb8, 0x5e, 0x10, 0x3d,
-	0x89, 0x70, 0x9a, 0x9e, 0xd1, 0x88, 0x06, 0x92, 0x8b, 0x0b, 0xcc, 0xc0, 0x05, 0x66, 0x9b, 0x70,
-	0x39, 0xc8, 0x24, 0x15, 0xd1, 0x25, 0x75, 0x76, 0x09, 0xda, 0x85, 0x7a, 0x22, 0xf8, 0x2b, 0x1a,
-	0x48, 0x2f, 0xe2, 0x01, 0x96, 0x21, 0x8f, 0x15, 0xd1, 0x95, 0xd6, 0x95, 0xb1, 0xfd, 0xf1, 0xd8,
-	0x8c, 0xee, 0xc3, 0x35, 0x16, 0xc6, 0x5e, 0x2a, 0xb1, 0x90, 0x1e, 0xc1, 0x92, 0x2a, 0x9e, 0xab,
-	0x77, 0x9a, 0x76, 0xbe, 0x2c, 0x76, 0xb1, 0x2c, 0xf6, 0xb3, 0x62, 0x59, 0x5a, 0x0d, 0x16, 0xc6,
-	0x67, 0x99, 0xe0, 0x01, 0x96, 0x14, 0xdd, 0x83, 0x0d, 0x86, 0xfb, 0x1e, 0x8d, 0x49, 0xae, 0x5f,
-	0xfc, 0xa7, 0x1e, 0x32, 0xdc, 0x7f, 0x18, 0x93, 0x4c, 0x7d, 0xe4, 0xa8, 0x5b, 0xd8, 0x85, 0x1b,
-	0x70, 0x7d, 0x0a, 0x64, 0x31, 0x1a, 0x6a, 0x54, 0x63, 0xea, 0xc0, 0xd0, 0xac, 0x8f, 0x1a, 0xdc,
-	0x28, 0x90, 0x9d, 0xe6, 0xc3, 0xcc, 0x01, 0x6d, 0x0b, 0xc2, 0x82, 0x4c, 0x89, 0x6d, 0x65, 0x6c,
-	0x71, 0xc9, 0x0c, 0x1a, 0xb5, 0xff, 0xa4, 0x51, 0x9f, 0x8b, 0xc6, 0xa1, 0xa2, 0xb1, 0x0f, 0x37,
-	0xe1, 0xc6, 0x14, 0x8d, 0xaa, 0x67, 0xb4, 0x36, 0x39, 0x81, 0x0e, 0x8c, 0x9a, 0xf5, 0x1e, 0x54,
-	0x4b, 0x74, 0x9c, 0xfd, 0x8d, 0xf3, 0x2d, 0x91, 0x9f, 0x49, 0x26, 0x96, 0x48, 0x9d, 0x5d, 0xf2,
-	0xd7, 0x9b, 0x29, 0xe2, 0xb3, 0x9b, 0x29, 0xbe, 0x75, 0x60, 0xd4, 0x8f, 0x9f, 0x7f, 0x1d, 0x9a,
-	0xe0, 0x7c, 0x68, 0x82, 0x9f, 0x43, 0x13, 0x7c, 0x1a, 0x99, 0x0b, 0xe7, 0x23, 0x73, 0xe1, 0xc7,
-	0xc8, 0x5c, 0x78, 0x71, 0xb7, 0x1d, 0xca, 0x4e, 0xcf, 0xb7, 0x03, 0xce, 0xf2, 0x87, 0x6d, 0x3f,
-	0xa6, 0xf2, 0x0d, 0x17, 0xdd, 0xf1, 0x29, 0xa2, 0xa4, 0x4d, 0x85, 0xd3, 0x9f, 0xf5, 0xde, 0xf9,
-	0x97, 0x14, 0xb3, 0xc3, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x5d, 0xf0, 0x53, 0xe9, 0x13, 0x05,
-	0x00, 0x00,
+	// 616 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0xbb, 0x49, 0x0a, 0xed, 0xb6, 0x85, 0xb0, 0x94, 0x62, 0xa5, 0x90, 0x56, 0x39, 0x55,
+	0xa2, 0xd8, 0x04, 0xc4, 0xa5, 0x70, 0x40, 0x2d, 0x08, 0x95, 0x0a, 0x51, 0xa5, 0x70, 0xe1, 0x62,
+	0xad, 0xbd, 0x43, 0xba, 0xb5, 0xd7, 0x6b, 0xad, 0x37, 0x50, 0x4b, 0x9c, 0xb8, 0x71, 0x41, 0x48,
+	0xdc, 0x79, 0x12, 0x1e, 0x80, 0x1b, 0x95, 0xb8, 0x70, 0x44, 0xed, 0x1b, 0xf0, 0x04, 0xc8, 0xeb,
+	0xd8, 0x89, 0x69, 0x11, 0x8a, 0xb8, 0x79, 0x67, 0xe6, 0x3f, 0x1f, 0xbf, 0x1d, 0x2f, 0x76, 0x14,
+	0xf4, 0x21, 0x72, 0xc0, 0x97, 0xbe, 0x02, 0xc6, 0xb5, 0x23, 0x15, 0x03, 0xe5, 0x49, 0x19, 0x38,
+	0xaf, 0xbb, 0x34, 0x8c, 0xf7, 0x69, 0xd7, 0x11, 0x20, 0xa4, 0x4a, 0xed, 0x58, 0x49, 0x2d, 0x49,
+	0xc7, 0x08, 0xec, 0x52, 0x60, 0x97, 0x02, 0xbb, 0x10, 0xb4, 0x56, 0xfa, 0x52, 0xf6, 0x43, 0x70,
+	0x8c, 0xc2, 0x1b, 0xbc, 0x72, 0x34, 0x17, 0x90, 0x68, 0x2a, 0xe2, 0x3c, 0x49, 0xeb, 0xba, 0x2f,
+	0x13, 0x21, 0x13, 0x47, 0x2a, 0x31, 0x2a, 0x23, 0x95, 0xc8, 0xdd, 0x9d, 0x6f, 0x35, 0xbc, 0xb4,
+	0x39, 0x48, 0x9f, 0x65, 0x99, 0xf7, 0x20, 0x0c, 0xcd, 0xc7, 0x53, 0xaa, 0xfd, 0x7d, 0xb2, 0x8c,
+	0x67, 0x05, 0x55, 0x01, 0x68, 0x97, 0x33, 0x0b, 0xad, 0xa2, 0xb5, 0x46, 0x6f, 0x26, 0x37, 0x6c,
+	0x33, 0xb2, 0x8a, 0xe7, 0xbd, 0x41, 0xea, 0x9a, 0x8e, 0x32, 0x7f, 0xcd, 0xf8, 0xb1, 0x37, 0x4c,
+	0xb5, 0xcd, 0x48, 0x07, 0x2f, 0x24, 0x10, 0x86, 0xa3, 0x90, 0xba, 0x09, 0x99, 0x4b, 0x8a, 0x2a,
+	0xdb, 0x8c, 0xdc, 0xc2, 0x8b, 0x1e, 0x67, 0x6e, 0xac, 0xb8, 0x0f, 0xae, 0x2f, 0x45, 0x1c, 0x82,
+	0x80, 0x48, 0x5b, 0x8d, 0x55, 0xb4, 0x76, 0xbe, 0x47, 0x3c, 0xce, 0x76, 0x33, 0xd7, 0x56, 0xe9,
+	0xc9, 0x9a, 0xa2, 0x49, 0x90, 0x2b, 0xac, 0x69, 0x13, 0x36, 0x43, 0x93, 0xc0, 0x84, 0x6d, 0xbc,
+	0x43, 0xbf, 0x3e, 0x7f, 0xff, 0x50, 0x7f, 0x8b, 0xaf, 0xe1, 0xd6, 0x78, 0x77, 0xeb, 0x95, 0x46,
+	0xc8, 0x63, 0xbc, 0x55, 0x0e, 0xb6, 0x7e, 0x56, 0xfd, 0xf5, 0x8a, 0xb8, 0xac, 0x57, 0x4d, 0xd3,
+	0x44, 0xe4, 0xd2, 0x1f, 0x23, 0x36, 0x6b, 0x16, 0xea, 0x7c, 0xa9, 0xe1, 0x2b, 0x05, 0xd1, 0xad,
+	0x90, 0x26, 0xc9, 0x1e, 0x84, 0xe0, 0x6b, 0xa9, 0x4e, 0x31, 0x43, 0xa7, 0x98, 0x2d, 0xe3, 0x59,
+	0x3f, 0x93, 0xb8, 0x01, 0xa4, 0x43, 0xa4, 0x33, 0xc6, 0xb0, 0x03, 0x29, 0xe9, 0xe2, 0xc5, 0x58,
+	0xc9, 0x03, 0xf0, 0xb5, 0x7b, 0x30, 0x50, 0x3c, 0x61, 0xdc, 0xd7, 0x5c, 0x46, 0x86, 0xeb, 0x6c,
+	0xef, 0xf2, 0xd0, 0xf7, 0x64, 0xcc, 0x45, 0x1e, 0xe0, 0x0b, 0x82, 0x47, 0x6e, 0xa2, 0xa9, 0xd2,
+	0x2e, 0xa3, 0x1a, 0x0c, 0xd9, 0xb9, 0xdb, 0x2d, 0x3b, 0x5f, 0x1b, 0xbb, 0x58, 0x1b, 0xfb, 0x79,
+	0xb1, 0x36, 0xbd, 0x79, 0xc1, 0xa3, 0xbd, 0x4c, 0xf0, 0x90, 0x6a, 0x20, 0xf7, 0xf1, 0xbc, 0xa0,
+	0x87, 0x2e, 0x44, 0x2c, 0xd7, 0x4f, 0xff, 0x53, 0x8f, 0x05, 0x3d, 0x7c, 0x14, 0xb1, 0x4c, 0xbd,
+	0xd1, 0x35, 0xf7, 0x71, 0x03, 0x5b, 0x78, 0xa9, 0x82, 0xb4, 0x1c, 0x92, 0x2c, 0x8c, 0x4d, 0xdc,
+	0x44, 0x56, 0xad, 0xf3, 0xa9, 0x86, 0xaf, 0x16, 0xf8, 0x76, 0xf3, 0x91, 0x26, 0x00, 0xb8, 0x82,
+	0xe7, 0x0a, 0x46, 0x23, 0x84, 0x78, 0x68, 0xca, 0x20, 0x9e, 0x26, 0x52, 0xff, 0x4f, 0x22, 0x8d,
+	0x89, 0x88, 0xdc, 0x35, 0x44, 0x1c, 0xdc, 0xc2, 0x56, 0x85, 0xc8, 0x58, 0xd7, 0xe4, 0x62, 0x65,
+	0x88, 0x26, 0xb2, 0xea, 0x9d, 0xf7, 0x68, 0xb4, 0x54, 0x9b, 0xd9, 0xdf, 0x39, 0xd9, 0x52, 0x79,
+	0x99, 0x64, 0x7c, 0xa9, 0x8c, 0x61, 0x07, 0xd2, 0xbf, 0xde, 0x50, 0xa9, 0xc8, 0x6e, 0xa8, 0x3c,
+	0x34, 0x91, 0xd5, 0xd8, 0x7c, 0xf1, 0xf5, 0xb8, 0x8d, 0x8e, 0x8e, 0xdb, 0xe8, 0xe7, 0x71, 0x1b,
+	0x7d, 0x3c, 0x69, 0x4f, 0x1d, 0x9d, 0xb4, 0xa7, 0x7e, 0x9c, 0xb4, 0xa7, 0x5e, 0xde, 0xeb, 0x73,
+	0xbd, 0x3f, 0xf0, 0x6c, 0x5f, 0x8a, 0xfc, 0xb1, 0xbb, 0x19, 0x81, 0x7e, 0x23, 0x55, 0x30, 0x3c,
+	0x85, 0xc0, 0xfa, 0xa0, 0x9c, 0xc3, 0xb3, 0xde, 0x40, 0xef, 0x9c, 0x21, 0x77, 0xe7, 0xf7, 0x00,
+	0xd4, 0xb3, 0x63, 0x62, 0x27, 0x05, 0x00, 0x00,
 }
 
 func (m *BuyOrderSellOrderMatch) Marshal() (dAtA []byte, err error) {
@@ -470,15 +471,15 @@ func (m *BuyOrderClassSelector) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.ProjectLocation) > 0 {
-		i -= len(m.ProjectLocation)
-		copy(dAtA[i:], m.ProjectLocation)
-		i = encodeVarintMemory(dAtA, i, uint64(len(m.ProjectLocation)))
+	if len(m.ProjectJurisdiction) > 0 {
+		i -= len(m.ProjectJurisdiction)
+		copy(dAtA[i:], m.ProjectJurisdiction)
+		i = encodeVarintMemory(dAtA, i, uint64(len(m.ProjectJurisdiction)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.ClassId != 0 {
-		i = encodeVarintMemory(dAtA, i, uint64(m.ClassId))
+	if m.ClassKey != 0 {
+		i = encodeVarintMemory(dAtA, i, uint64(m.ClassKey))
 		i--
 		dAtA[i] = 0x10
 	}
@@ -534,8 +535,8 @@ func (m *BuyOrderProjectSelector) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.ProjectId != 0 {
-		i = encodeVarintMemory(dAtA, i, uint64(m.ProjectId))
+	if m.ProjectKey != 0 {
+		i = encodeVarintMemory(dAtA, i, uint64(m.ProjectKey))
 		i--
 		dAtA[i] = 0x10
 	}
@@ -567,8 +568,8 @@ func (m *BuyOrderBatchSelector) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.BatchId != 0 {
-		i = encodeVarintMemory(dAtA, i, uint64(m.BatchId))
+	if m.BatchKey != 0 {
+		i = encodeVarintMemory(dAtA, i, uint64(m.BatchKey))
 		i--
 		dAtA[i] = 0x10
 	}
@@ -624,10 +625,10 @@ func (m *BuyOrderClassSelector) Size() (n int) {
 	if m.BuyOrderId != 0 {
 		n += 1 + sovMemory(uint64(m.BuyOrderId))
 	}
-	if m.ClassId != 0 {
-		n += 1 + sovMemory(uint64(m.ClassId))
+	if m.ClassKey != 0 {
+		n += 1 + sovMemory(uint64(m.ClassKey))
 	}
-	l = len(m.ProjectLocation)
+	l = len(m.ProjectJurisdiction)
 	if l > 0 {
 		n += 1 + l + sovMemory(uint64(l))
 	}
@@ -651,8 +652,8 @@ func (m *BuyOrderProjectSelector) Size() (n int) {
 	if m.BuyOrderId != 0 {
 		n += 1 + sovMemory(uint64(m.BuyOrderId))
 	}
-	if m.ProjectId != 0 {
-		n += 1 + sovMemory(uint64(m.ProjectId))
+	if m.ProjectKey != 0 {
+		n += 1 + sovMemory(uint64(m.ProjectKey))
 	}
 	if m.MinStartDate != nil {
 		l = m.MinStartDate.Size()
@@ -674,8 +675,8 @@ func (m *BuyOrderBatchSelector) Size() (n int) {
 	if m.BuyOrderId != 0 {
 		n += 1 + sovMemory(uint64(m.BuyOrderId))
 	}
-	if m.BatchId != 0 {
-		n += 1 + sovMemory(uint64(m.BatchId))
+	if m.BatchKey != 0 {
+		n += 1 + sovMemory(uint64(m.BatchKey))
 	}
 	return n
 }
@@ -863,9 +864,9 @@ func (m *BuyOrderClassSelector) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
 			}
-			m.ClassId = 0
+			m.ClassKey = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMemory
@@ -875,14 +876,14 @@ func (m *BuyOrderClassSelector) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClassId |= uint64(b&0x7F) << shift
+				m.ClassKey |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProjectLocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectJurisdiction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -910,7 +911,7 @@ func (m *BuyOrderClassSelector) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProjectLocation = string(dAtA[iNdEx:postIndex])
+			m.ProjectJurisdiction = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
@@ -1055,9 +1056,9 @@ func (m *BuyOrderProjectSelector) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProjectId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
 			}
-			m.ProjectId = 0
+			m.ProjectKey = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMemory
@@ -1067,7 +1068,7 @@ func (m *BuyOrderProjectSelector) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProjectId |= uint64(b&0x7F) << shift
+				m.ProjectKey |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1215,9 +1216,9 @@ func (m *BuyOrderBatchSelector) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
 			}
-			m.BatchId = 0
+			m.BatchKey = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMemory
@@ -1227,7 +1228,7 @@ func (m *BuyOrderBatchSelector) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchId |= uint64(b&0x7F) << shift
+				m.BatchKey |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
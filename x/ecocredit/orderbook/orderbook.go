@@ -4,14 +4,15 @@ import (
 	"context"
 
 	"github.com/cosmos/cosmos-sdk/orm/model/ormdb"
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	marketplacev1 "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
 	orderbookv1alpha1 "github.com/regen-network/regen-ledger/api/regen/ecocredit/orderbook/v1alpha1"
 	ecocreditv1 "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 )
 
-// TODO: revisit when BuyOrder is reintroduced for marketplace order-book https://github.com/regen-network/regen-ledger/issues/505
-
 type orderbook struct {
 	memStore         orderbookv1alpha1.MemoryStore
 	marketplaceStore marketplacev1.StateStore
@@ -42,33 +43,534 @@ func NewOrderBook(db ormdb.ModuleDB) (OrderBook, error) {
 	}, nil
 }
 
+// FillHandler fills a matched buy order and sell order. The handler is
+// responsible for moving credits and funds and for updating or removing the
+// buy order and the sell order in state.
+type FillHandler func(ctx context.Context, buyOrder *marketplacev1.BuyOrder, sellOrder *marketplacev1.SellOrder) error
+
 type OrderBook interface {
 	// OnInsertBuyOrder gets called whenever a buy order is inserted into the marketplace state.
-	//OnInsertBuyOrder(ctx context.Context, buyOrder *marketplacev1.BuyOrder) error
+	OnInsertBuyOrder(ctx context.Context, buyOrder *marketplacev1.BuyOrder) error
 
 	// OnInsertSellOrder gets called whenever a sell order is inserted into the marketplace state.
 	OnInsertSellOrder(ctx context.Context, sellOrder *marketplacev1.SellOrder, batch *ecocreditv1.Batch) error
 
+	// OnDeleteBuyOrder gets called whenever a buy order is removed from the marketplace state.
+	OnDeleteBuyOrder(ctx context.Context, buyOrder *marketplacev1.BuyOrder) error
+
+	// OnDeleteSellOrder gets called whenever a sell order is removed from the marketplace state.
+	OnDeleteSellOrder(ctx context.Context, sellOrder *marketplacev1.SellOrder) error
+
 	// ProcessBatch called in end blocker, can happen every block or at some epoch.
-	ProcessBatch(ctx context.Context) error
+	// At most limit matches are processed and each processed match is removed,
+	// including matches that the fill handler does not fill.
+	ProcessBatch(ctx context.Context, limit int, fill FillHandler) error
 
-	// Reload gets call on end blocker only when a node starts up.
+	// Reload rebuilds the selectors and matches from the buy orders and sell
+	// orders in the marketplace state.
 	Reload(ctx context.Context) error
 }
 
-/*
 func (o orderbook) OnInsertBuyOrder(ctx context.Context, buyOrder *marketplacev1.BuyOrder) error {
+	market, err := o.marketplaceStore.MarketTable().Get(ctx, buyOrder.MarketId)
+	if err != nil {
+		return err
+	}
+
+	bidPrice, err := encodePrice(buyOrder.BidAmount, market.PrecisionModifier)
+	if err != nil {
+		return err
+	}
+
+	for _, criteria := range buyOrder.Filter.GetOr() {
+		var batchKeys []uint64
+
+		switch selector := criteria.Sum.(type) {
+		case *marketplacev1.BuyOrder_Filter_Criteria_ClassSelector:
+			batchKeys, err = o.insertClassSelector(ctx, buyOrder.Id, selector.ClassSelector)
+		case *marketplacev1.BuyOrder_Filter_Criteria_ProjectSelector:
+			batchKeys, err = o.insertProjectSelector(ctx, buyOrder.Id, selector.ProjectSelector)
+		case *marketplacev1.BuyOrder_Filter_Criteria_BatchSelector:
+			batchKeys, err = o.insertBatchSelector(ctx, buyOrder.Id, selector.BatchSelector)
+		default:
+			err = sdkerrors.ErrInvalidRequest.Wrapf("unknown selector type %T", selector)
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, batchKey := range batchKeys {
+			if err := o.matchBatchSellOrders(ctx, buyOrder, bidPrice, batchKey, market.PrecisionModifier); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
-*/
+
 func (o orderbook) OnInsertSellOrder(ctx context.Context, sellOrder *marketplacev1.SellOrder, batch *ecocreditv1.Batch) error {
+	market, err := o.marketplaceStore.MarketTable().Get(ctx, sellOrder.MarketId)
+	if err != nil {
+		return err
+	}
+
+	askPrice, err := encodePrice(sellOrder.AskAmount, market.PrecisionModifier)
+	if err != nil {
+		return err
+	}
+
+	buyOrderIds, err := o.getBuyOrderIdsForBatch(ctx, batch)
+	if err != nil {
+		return err
+	}
+
+	for _, buyOrderId := range buyOrderIds {
+		buyOrder, err := o.marketplaceStore.BuyOrderTable().Get(ctx, buyOrderId)
+		if err != nil {
+			return err
+		}
+
+		matched, err := isMatch(buyOrder, sellOrder)
+		if err != nil {
+			return err
+		}
+		if !matched {
+			continue
+		}
+
+		bidPrice, err := encodePrice(buyOrder.BidAmount, market.PrecisionModifier)
+		if err != nil {
+			return err
+		}
+
+		if err := o.saveMatch(ctx, buyOrder, sellOrder, bidPrice, askPrice); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-func (o orderbook) ProcessBatch(ctx context.Context) error {
+func (o orderbook) OnDeleteBuyOrder(ctx context.Context, buyOrder *marketplacev1.BuyOrder) error {
+	if err := o.memStore.BuyOrderClassSelectorTable().DeleteBy(ctx,
+		orderbookv1alpha1.BuyOrderClassSelectorBuyOrderIdClassKeyIndexKey{}.WithBuyOrderId(buyOrder.Id),
+	); err != nil {
+		return err
+	}
+
+	if err := o.memStore.BuyOrderProjectSelectorTable().DeleteBy(ctx,
+		orderbookv1alpha1.BuyOrderProjectSelectorBuyOrderIdProjectKeyIndexKey{}.WithBuyOrderId(buyOrder.Id),
+	); err != nil {
+		return err
+	}
+
+	if err := o.memStore.BuyOrderBatchSelectorTable().DeleteBy(ctx,
+		orderbookv1alpha1.BuyOrderBatchSelectorBuyOrderIdBatchKeyIndexKey{}.WithBuyOrderId(buyOrder.Id),
+	); err != nil {
+		return err
+	}
+
+	return o.memStore.BuyOrderSellOrderMatchTable().DeleteBy(ctx,
+		orderbookv1alpha1.BuyOrderSellOrderMatchBuyOrderIdSellOrderIdIndexKey{}.WithBuyOrderId(buyOrder.Id),
+	)
+}
+
+func (o orderbook) OnDeleteSellOrder(ctx context.Context, sellOrder *marketplacev1.SellOrder) error {
+	return o.memStore.BuyOrderSellOrderMatchTable().DeleteBy(ctx,
+		orderbookv1alpha1.BuyOrderSellOrderMatchSellOrderIdIndexKey{}.WithSellOrderId(sellOrder.Id),
+	)
+}
+
+func (o orderbook) ProcessBatch(ctx context.Context, limit int, fill FillHandler) error {
+	matchTable := o.memStore.BuyOrderSellOrderMatchTable()
+
+	matches, err := o.getMatches(ctx, limit)
+	if err != nil {
+		return err
+	}
+
+	for _, match := range matches {
+		// previous fills may have already removed the match
+		found, err := matchTable.Has(ctx, match.BuyOrderId, match.SellOrderId)
+		if err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+
+		buyOrder, err := o.marketplaceStore.BuyOrderTable().Get(ctx, match.BuyOrderId)
+		if err != nil {
+			if ormerrors.IsNotFound(err) {
+				if err := matchTable.Delete(ctx, match); err != nil {
+					return err
+				}
+				continue
+			}
+			return err
+		}
+
+		sellOrder, err := o.marketplaceStore.SellOrderTable().Get(ctx, match.SellOrderId)
+		if err != nil {
+			if ormerrors.IsNotFound(err) {
+				if err := matchTable.Delete(ctx, match); err != nil {
+					return err
+				}
+				continue
+			}
+			return err
+		}
+
+		// the sell order may have been updated since the match was created,
+		// in which case the match is stale and is not filled
+		matched, err := isMatch(buyOrder, sellOrder)
+		if err != nil {
+			return err
+		}
+		if matched {
+			if err := fill(ctx, buyOrder, sellOrder); err != nil {
+				return err
+			}
+		}
+
+		// the match is removed by the delete hooks if the fill consumed the buy
+		// order or the sell order, otherwise the match cannot be filled (e.g. the
+		// sell order expired or the market was delisted) and is removed so that
+		// it is not processed again. Updating the sell order matches it again.
+		found, err = matchTable.Has(ctx, match.BuyOrderId, match.SellOrderId)
+		if err != nil {
+			return err
+		}
+		if found {
+			if err := matchTable.Delete(ctx, match); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
+// getMatches returns at most limit matches. The index sorts matches by market, then by bid price from
+// high to low and then by ask price from low to high, with order ids breaking ties.
+func (o orderbook) getMatches(ctx context.Context, limit int) ([]*orderbookv1alpha1.BuyOrderSellOrderMatch, error) {
+	it, err := o.memStore.BuyOrderSellOrderMatchTable().List(ctx,
+		orderbookv1alpha1.BuyOrderSellOrderMatchMarketIdBidPriceComplementBuyOrderIdAskPriceSellOrderIdIndexKey{},
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var matches []*orderbookv1alpha1.BuyOrderSellOrderMatch
+	for len(matches) < limit && it.Next() {
+		match, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, match)
+	}
+
+	return matches, nil
+}
+
 func (o orderbook) Reload(ctx context.Context) error {
+	if err := o.memStore.BuyOrderSellOrderMatchTable().DeleteBy(ctx,
+		orderbookv1alpha1.BuyOrderSellOrderMatchBuyOrderIdSellOrderIdIndexKey{},
+	); err != nil {
+		return err
+	}
+
+	if err := o.memStore.BuyOrderClassSelectorTable().DeleteBy(ctx,
+		orderbookv1alpha1.BuyOrderClassSelectorBuyOrderIdClassKeyIndexKey{},
+	); err != nil {
+		return err
+	}
+
+	if err := o.memStore.BuyOrderProjectSelectorTable().DeleteBy(ctx,
+		orderbookv1alpha1.BuyOrderProjectSelectorBuyOrderIdProjectKeyIndexKey{},
+	); err != nil {
+		return err
+	}
+
+	if err := o.memStore.BuyOrderBatchSelectorTable().DeleteBy(ctx,
+		orderbookv1alpha1.BuyOrderBatchSelectorBuyOrderIdBatchKeyIndexKey{},
+	); err != nil {
+		return err
+	}
+
+	it, err := o.marketplaceStore.BuyOrderTable().List(ctx, marketplacev1.BuyOrderIdIndexKey{})
+	if err != nil {
+		return err
+	}
+
+	var buyOrders []*marketplacev1.BuyOrder
+	for it.Next() {
+		buyOrder, err := it.Value()
+		if err != nil {
+			it.Close()
+			return err
+		}
+		buyOrders = append(buyOrders, buyOrder)
+	}
+	it.Close()
+
+	for _, buyOrder := range buyOrders {
+		if err := o.OnInsertBuyOrder(ctx, buyOrder); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
+
+// insertClassSelector saves the class selector and returns the keys of the
+// credit batches currently selected by the class selector.
+func (o orderbook) insertClassSelector(ctx context.Context, buyOrderId uint64, selector *marketplacev1.BuyOrder_ClassSelector) ([]uint64, error) {
+	class, err := o.ecocreditStore.ClassTable().GetById(ctx, selector.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get class with id %s: %s", selector.ClassId, err.Error())
+	}
+
+	classSelector := &orderbookv1alpha1.BuyOrderClassSelector{
+		BuyOrderId:          buyOrderId,
+		ClassKey:            class.Key,
+		ProjectJurisdiction: selector.ProjectJurisdiction,
+		MinStartDate:        selector.MinStartDate,
+		MaxEndDate:          selector.MaxEndDate,
+	}
+	if err := o.memStore.BuyOrderClassSelectorTable().Insert(ctx, classSelector); err != nil {
+		return nil, err
+	}
+
+	projects, err := o.getProjectsByClass(ctx, class.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	var batchKeys []uint64
+	for _, project := range projects {
+		if !jurisdictionMatches(selector.ProjectJurisdiction, project.Jurisdiction) {
+			continue
+		}
+
+		keys, err := o.getBatchKeysByProject(ctx, project.Key, selector.MinStartDate, selector.MaxEndDate)
+		if err != nil {
+			return nil, err
+		}
+		batchKeys = append(batchKeys, keys...)
+	}
+
+	return batchKeys, nil
+}
+
+// insertProjectSelector saves the project selector and returns the keys of the
+// credit batches currently selected by the project selector.
+func (o orderbook) insertProjectSelector(ctx context.Context, buyOrderId uint64, selector *marketplacev1.BuyOrder_ProjectSelector) ([]uint64, error) {
+	project, err := o.ecocreditStore.ProjectTable().GetById(ctx, selector.ProjectId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get project with id %s: %s", selector.ProjectId, err.Error())
+	}
+
+	projectSelector := &orderbookv1alpha1.BuyOrderProjectSelector{
+		BuyOrderId:   buyOrderId,
+		ProjectKey:   project.Key,
+		MinStartDate: selector.MinStartDate,
+		MaxEndDate:   selector.MaxEndDate,
+	}
+	if err := o.memStore.BuyOrderProjectSelectorTable().Insert(ctx, projectSelector); err != nil {
+		return nil, err
+	}
+
+	return o.getBatchKeysByProject(ctx, project.Key, selector.MinStartDate, selector.MaxEndDate)
+}
+
+// insertBatchSelector saves the batch selector and returns the key of the
+// credit batch selected by the batch selector.
+func (o orderbook) insertBatchSelector(ctx context.Context, buyOrderId uint64, selector *marketplacev1.BuyOrder_BatchSelector) ([]uint64, error) {
+	batch, err := o.ecocreditStore.BatchTable().GetByDenom(ctx, selector.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", selector.BatchDenom, err.Error())
+	}
+
+	batchSelector := &orderbookv1alpha1.BuyOrderBatchSelector{
+		BuyOrderId: buyOrderId,
+		BatchKey:   batch.Key,
+	}
+	if err := o.memStore.BuyOrderBatchSelectorTable().Insert(ctx, batchSelector); err != nil {
+		return nil, err
+	}
+
+	return []uint64{batch.Key}, nil
+}
+
+// matchBatchSellOrders saves a match for each sell order of the credit batch
+// that can be filled by the buy order.
+func (o orderbook) matchBatchSellOrders(ctx context.Context, buyOrder *marketplacev1.BuyOrder, bidPrice uint32, batchKey uint64, precisionModifier uint32) error {
+	it, err := o.marketplaceStore.SellOrderTable().List(ctx, marketplacev1.SellOrderBatchKeyIndexKey{}.WithBatchKey(batchKey))
+	if err != nil {
+		return err
+	}
+
+	var sellOrders []*marketplacev1.SellOrder
+	for it.Next() {
+		sellOrder, err := it.Value()
+		if err != nil {
+			it.Close()
+			return err
+		}
+		sellOrders = append(sellOrders, sellOrder)
+	}
+	it.Close()
+
+	for _, sellOrder := range sellOrders {
+		matched, err := isMatch(buyOrder, sellOrder)
+		if err != nil {
+			return err
+		}
+		if !matched {
+			continue
+		}
+
+		askPrice, err := encodePrice(sellOrder.AskAmount, precisionModifier)
+		if err != nil {
+			return err
+		}
+
+		if err := o.saveMatch(ctx, buyOrder, sellOrder, bidPrice, askPrice); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// saveMatch saves a match between a buy order and a sell order. Save is used
+// rather than Insert because a buy order may select the same credit batch
+// through more than one criteria.
+func (o orderbook) saveMatch(ctx context.Context, buyOrder *marketplacev1.BuyOrder, sellOrder *marketplacev1.SellOrder, bidPrice, askPrice uint32) error {
+	return o.memStore.BuyOrderSellOrderMatchTable().Save(ctx, &orderbookv1alpha1.BuyOrderSellOrderMatch{
+		MarketId:           buyOrder.MarketId,
+		BuyOrderId:         buyOrder.Id,
+		SellOrderId:        sellOrder.Id,
+		BidPriceComplement: ^bidPrice,
+		AskPrice:           askPrice,
+	})
+}
+
+// getBuyOrderIdsForBatch returns the ids of all buy orders with a selector
+// that selects the credit batch.
+func (o orderbook) getBuyOrderIdsForBatch(ctx context.Context, batch *ecocreditv1.Batch) ([]uint64, error) {
+	var ids []uint64
+	seen := make(map[uint64]bool)
+	add := func(id uint64) {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	batchIt, err := o.memStore.BuyOrderBatchSelectorTable().List(ctx,
+		orderbookv1alpha1.BuyOrderBatchSelectorBatchKeyIndexKey{}.WithBatchKey(batch.Key),
+	)
+	if err != nil {
+		return nil, err
+	}
+	for batchIt.Next() {
+		selector, err := batchIt.Value()
+		if err != nil {
+			batchIt.Close()
+			return nil, err
+		}
+		add(selector.BuyOrderId)
+	}
+	batchIt.Close()
+
+	project, err := o.ecocreditStore.ProjectTable().Get(ctx, batch.ProjectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	projectIt, err := o.memStore.BuyOrderProjectSelectorTable().List(ctx,
+		orderbookv1alpha1.BuyOrderProjectSelectorProjectKeyIndexKey{}.WithProjectKey(project.Key),
+	)
+	if err != nil {
+		return nil, err
+	}
+	for projectIt.Next() {
+		selector, err := projectIt.Value()
+		if err != nil {
+			projectIt.Close()
+			return nil, err
+		}
+		if datesMatch(selector.MinStartDate, selector.MaxEndDate, batch) {
+			add(selector.BuyOrderId)
+		}
+	}
+	projectIt.Close()
+
+	classIt, err := o.memStore.BuyOrderClassSelectorTable().List(ctx,
+		orderbookv1alpha1.BuyOrderClassSelectorClassKeyIndexKey{}.WithClassKey(project.ClassKey),
+	)
+	if err != nil {
+		return nil, err
+	}
+	for classIt.Next() {
+		selector, err := classIt.Value()
+		if err != nil {
+			classIt.Close()
+			return nil, err
+		}
+		if jurisdictionMatches(selector.ProjectJurisdiction, project.Jurisdiction) &&
+			datesMatch(selector.MinStartDate, selector.MaxEndDate, batch) {
+			add(selector.BuyOrderId)
+		}
+	}
+	classIt.Close()
+
+	return ids, nil
+}
+
+// getProjectsByClass returns all projects within the credit class.
+func (o orderbook) getProjectsByClass(ctx context.Context, classKey uint64) ([]*ecocreditv1.Project, error) {
+	it, err := o.ecocreditStore.ProjectTable().List(ctx, ecocreditv1.ProjectClassKeyIdIndexKey{}.WithClassKey(classKey))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var projects []*ecocreditv1.Project
+	for it.Next() {
+		project, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		projects = append(projects, project)
+	}
+
+	return projects, nil
+}
+
+// getBatchKeysByProject returns the keys of all credit batches within the
+// project that satisfy the date criteria.
+func (o orderbook) getBatchKeysByProject(ctx context.Context, projectKey uint64, minStartDate, maxEndDate *timestamppb.Timestamp) ([]uint64, error) {
+	it, err := o.ecocreditStore.BatchTable().List(ctx, ecocreditv1.BatchProjectKeyIndexKey{}.WithProjectKey(projectKey))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var batchKeys []uint64
+	for it.Next() {
+		batch, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		if datesMatch(minStartDate, maxEndDate, batch) {
+			batchKeys = append(batchKeys, batch.Key)
+		}
+	}
+
+	return batchKeys, nil
+}
@@ -0,0 +1,93 @@
+package orderbook
+
+import (
+	"bytes"
+	"math"
+	"math/big"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	marketplacev1 "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	ecocreditv1 "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// isMatch checks whether the sell order can be used to fill the buy order.
+// The caller is responsible for checking that the credit batch of the sell
+// order is selected by the filter of the buy order.
+func isMatch(buyOrder *marketplacev1.BuyOrder, sellOrder *marketplacev1.SellOrder) (bool, error) {
+	if buyOrder.MarketId != sellOrder.MarketId {
+		return false, nil
+	}
+
+	if bytes.Equal(buyOrder.Buyer, sellOrder.Seller) {
+		return false, nil
+	}
+
+	if buyOrder.DisableAutoRetire && !sellOrder.DisableAutoRetire {
+		return false, nil
+	}
+
+	if !utils.IsBuyerAllowed(sellOrder, buyOrder.Buyer) {
+		return false, nil
+	}
+
+	bid, ok := sdk.NewIntFromString(buyOrder.BidAmount)
+	if !ok {
+		return false, sdkerrors.ErrInvalidType.Wrapf("invalid bid amount %s", buyOrder.BidAmount)
+	}
+
+	ask, ok := sdk.NewIntFromString(sellOrder.AskAmount)
+	if !ok {
+		return false, sdkerrors.ErrInvalidType.Wrapf("invalid ask amount %s", sellOrder.AskAmount)
+	}
+
+	return bid.GTE(ask), nil
+}
+
+// encodePrice encodes the amount as a uint32 used to sort matches by price. The
+// amount is divided by 10^precisionModifier and rounded half away from zero.
+// Amounts that do not fit in a uint32 are capped at the maximum uint32 value,
+// which only affects the order in which matches with such prices are processed.
+func encodePrice(amount string, precisionModifier uint32) (uint32, error) {
+	x, ok := new(big.Int).SetString(amount, 10)
+	if !ok || x.Sign() < 0 {
+		return 0, sdkerrors.ErrInvalidType.Wrapf("invalid price amount %s", amount)
+	}
+
+	divisor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(precisionModifier)), nil)
+	quo, rem := new(big.Int).QuoRem(x, divisor, new(big.Int))
+	if rem.Mul(rem, big.NewInt(2)).Cmp(divisor) >= 0 {
+		quo.Add(quo, big.NewInt(1))
+	}
+
+	if !quo.IsUint64() || quo.Uint64() > math.MaxUint32 {
+		return math.MaxUint32, nil
+	}
+
+	return uint32(quo.Uint64()), nil
+}
+
+// jurisdictionMatches checks whether the project jurisdiction is within the
+// jurisdiction of the selector. An empty selector jurisdiction matches any
+// project jurisdiction.
+func jurisdictionMatches(selectorJurisdiction, projectJurisdiction string) bool {
+	return strings.HasPrefix(projectJurisdiction, selectorJurisdiction)
+}
+
+// datesMatch checks whether the start date and end date of the credit batch
+// are within the date criteria. Unset dates are not checked.
+func datesMatch(minStartDate, maxEndDate *timestamppb.Timestamp, batch *ecocreditv1.Batch) bool {
+	if minStartDate != nil && batch.StartDate.AsTime().Before(minStartDate.AsTime()) {
+		return false
+	}
+
+	if maxEndDate != nil && batch.EndDate.AsTime().After(maxEndDate.AsTime()) {
+		return false
+	}
+
+	return true
+}
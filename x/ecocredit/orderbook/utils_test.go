@@ -0,0 +1,120 @@
+package orderbook
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	marketplacev1 "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+)
+
+func TestEncodePrice(t *testing.T) {
+	tests := []struct {
+		name              string
+		amount            string
+		precisionModifier uint32
+		expected          uint32
+		expErr            bool
+	}{
+		{"zero", "0", 0, 0, false},
+		{"no precision modifier", "1234", 0, 1234, false},
+		{"round down", "1234", 2, 12, false},
+		{"round half up", "1250", 2, 13, false},
+		{"max uint32", "4294967295", 0, math.MaxUint32, false},
+		{"capped at max uint32", "4294967296", 0, math.MaxUint32, false},
+		{"negative", "-1", 0, 0, true},
+		{"invalid", "foo", 0, 0, true},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			price, err := encodePrice(tc.amount, tc.precisionModifier)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, price)
+		})
+	}
+}
+
+func TestIsMatch(t *testing.T) {
+	buyer, seller := []byte("buyer"), []byte("seller")
+	tests := []struct {
+		name      string
+		buyOrder  *marketplacev1.BuyOrder
+		sellOrder *marketplacev1.SellOrder
+		expected  bool
+	}{
+		{
+			"bid equal to ask",
+			&marketplacev1.BuyOrder{Buyer: buyer, MarketId: 1, BidAmount: "10"},
+			&marketplacev1.SellOrder{Seller: seller, MarketId: 1, AskAmount: "10"},
+			true,
+		},
+		{
+			"bid lower than ask",
+			&marketplacev1.BuyOrder{Buyer: buyer, MarketId: 1, BidAmount: "9"},
+			&marketplacev1.SellOrder{Seller: seller, MarketId: 1, AskAmount: "10"},
+			false,
+		},
+		{
+			"different market",
+			&marketplacev1.BuyOrder{Buyer: buyer, MarketId: 1, BidAmount: "10"},
+			&marketplacev1.SellOrder{Seller: seller, MarketId: 2, AskAmount: "10"},
+			false,
+		},
+		{
+			"buyer is seller",
+			&marketplacev1.BuyOrder{Buyer: seller, MarketId: 1, BidAmount: "10"},
+			&marketplacev1.SellOrder{Seller: seller, MarketId: 1, AskAmount: "10"},
+			false,
+		},
+		{
+			"auto-retire required by sell order",
+			&marketplacev1.BuyOrder{Buyer: buyer, MarketId: 1, BidAmount: "10", DisableAutoRetire: true},
+			&marketplacev1.SellOrder{Seller: seller, MarketId: 1, AskAmount: "10"},
+			false,
+		},
+		{
+			"auto-retire not required by sell order",
+			&marketplacev1.BuyOrder{Buyer: buyer, MarketId: 1, BidAmount: "10"},
+			&marketplacev1.SellOrder{Seller: seller, MarketId: 1, AskAmount: "10", DisableAutoRetire: true},
+			true,
+		},
+		{
+			"buyer is allowed buyer",
+			&marketplacev1.BuyOrder{Buyer: buyer, MarketId: 1, BidAmount: "10"},
+			&marketplacev1.SellOrder{Seller: seller, MarketId: 1, AskAmount: "10", AllowedBuyers: [][]byte{buyer}},
+			true,
+		},
+		{
+			"buyer is not allowed buyer",
+			&marketplacev1.BuyOrder{Buyer: buyer, MarketId: 1, BidAmount: "10"},
+			&marketplacev1.SellOrder{Seller: seller, MarketId: 1, AskAmount: "10", AllowedBuyers: [][]byte{[]byte("other")}},
+			false,
+		},
+		{
+			"buyer is buyer group policy",
+			&marketplacev1.BuyOrder{Buyer: buyer, MarketId: 1, BidAmount: "10"},
+			&marketplacev1.SellOrder{Seller: seller, MarketId: 1, AskAmount: "10", BuyerGroupPolicy: buyer},
+			true,
+		},
+		{
+			"buyer is not buyer group policy",
+			&marketplacev1.BuyOrder{Buyer: buyer, MarketId: 1, BidAmount: "10"},
+			&marketplacev1.SellOrder{Seller: seller, MarketId: 1, AskAmount: "10", BuyerGroupPolicy: []byte("policy")},
+			false,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			matched, err := isMatch(tc.buyOrder, tc.sellOrder)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, matched)
+		})
+	}
+}
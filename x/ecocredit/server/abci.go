@@ -9,7 +9,9 @@ import (
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 )
 
-// BeginBlocker checks if there are any expired sell or buy orders and removes them from state.
+// BeginBlocker checks if there are any expired sell or buy orders and removes them from state,
+// releases locked credits that have unlocked into their tradable balance, removes expired
+// pending bridge attestations, and refunds outbound bridge requests that have timed out.
 func BeginBlocker(ctx sdk.Context, k Keeper) error {
 	defer telemetry.ModuleMeasureSince(ecocredit.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
@@ -17,5 +19,28 @@ func BeginBlocker(ctx sdk.Context, k Keeper) error {
 		return err
 	}
 
+	if err := k.ReleaseLocks(ctx); err != nil {
+		return err
+	}
+
+	if err := k.PruneBridgeAttestations(ctx); err != nil {
+		return err
+	}
+
+	if err := k.ExpireOutboundBridgeRequests(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// EndBlocker fills buy orders with matching sell orders and settles sealed-bid auctions.
+func EndBlocker(ctx sdk.Context, k Keeper) error {
+	defer telemetry.ModuleMeasureSince(ecocredit.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	if err := k.FillOrders(ctx); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -0,0 +1,150 @@
+package basket
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
+	ecoApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// getAdmissionReasons returns the reasons why credits from a batch cannot be
+// put into a basket, in the order in which the criteria are checked. An empty
+// list is returned if the batch is admissible. The following is checked:
+//   - batch's start time is within the basket's specified time window or min start date
+//   - class is in the basket's allowed class store
+//   - type matches the baskets specified credit type
+//   - project is allowed and not denied by the basket's admission criteria
+//   - project jurisdiction matches the basket's allowed and denied jurisdiction prefixes
+//   - batch was issued within the basket's max batch age
+//   - batch metadata has been attested to by the basket's attestor.
+func (k Keeper) getAdmissionReasons(ctx context.Context, basket *api.Basket, batch *ecoApi.Batch) ([]string, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime()
+
+	var reasons []string
+
+	if basket.DateCriteria != nil {
+		// check time window match
+		var minStartDate time.Time
+		var criteria = basket.DateCriteria
+		if criteria.MinStartDate != nil {
+			minStartDate = criteria.MinStartDate.AsTime()
+		} else if criteria.StartDateWindow != nil {
+			window := criteria.StartDateWindow.AsDuration()
+			minStartDate = blockTime.Add(-window)
+		} else if criteria.YearsInThePast != 0 {
+			year := blockTime.Year() - int(criteria.YearsInThePast)
+			minStartDate = time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+		}
+
+		startDate := batch.StartDate.AsTime()
+		if startDate.Before(minStartDate) {
+			reasons = append(reasons, fmt.Sprintf("cannot put a credit from a batch with start date %s "+
+				"into a basket that requires an earliest start date of %s", batch.StartDate.AsTime().String(), minStartDate.String()))
+		}
+	}
+
+	classId := core.GetClassIdFromBatchDenom(batch.Denom)
+
+	// check credit class match
+	found, err := k.stateStore.BasketClassTable().Has(ctx, basket.Id, classId)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		reasons = append(reasons, fmt.Sprintf("credit class %s is not allowed in this basket", classId))
+	}
+
+	// check credit type match
+	class, err := k.coreStore.ClassTable().GetById(ctx, classId)
+	if err != nil {
+		return nil, err
+	}
+	if class.CreditTypeAbbrev != basket.CreditTypeAbbrev {
+		reasons = append(reasons, fmt.Sprintf("basket requires credit type %s but a credit with type %s was given", basket.CreditTypeAbbrev, class.CreditTypeAbbrev))
+	}
+
+	criteria := basket.AdmissionCriteria
+	if criteria == nil {
+		return reasons, nil
+	}
+
+	project, err := k.coreStore.ProjectTable().Get(ctx, batch.ProjectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// check project match
+	if len(criteria.AllowedProjectIds) > 0 && !containsString(criteria.AllowedProjectIds, project.Id) {
+		reasons = append(reasons, fmt.Sprintf("project %s is not allowed in this basket", project.Id))
+	}
+	if containsString(criteria.DeniedProjectIds, project.Id) {
+		reasons = append(reasons, fmt.Sprintf("project %s is denied in this basket", project.Id))
+	}
+
+	// check project jurisdiction match
+	if len(criteria.AllowedJurisdictionPrefixes) > 0 && !hasAnyPrefix(project.Jurisdiction, criteria.AllowedJurisdictionPrefixes) {
+		reasons = append(reasons, fmt.Sprintf("project jurisdiction %s does not match any allowed jurisdiction prefix of this basket", project.Jurisdiction))
+	}
+	for _, prefix := range criteria.DeniedJurisdictionPrefixes {
+		if strings.HasPrefix(project.Jurisdiction, prefix) {
+			reasons = append(reasons, fmt.Sprintf("project jurisdiction %s matches denied jurisdiction prefix %s of this basket", project.Jurisdiction, prefix))
+			break
+		}
+	}
+
+	// check batch age
+	if criteria.MaxBatchAge != nil {
+		minIssuanceDate := blockTime.Add(-criteria.MaxBatchAge.AsDuration())
+		issuanceDate := batch.IssuanceDate.AsTime()
+		if issuanceDate.Before(minIssuanceDate) {
+			reasons = append(reasons, fmt.Sprintf("cannot put a credit from a batch with issuance date %s "+
+				"into a basket that requires an earliest issuance date of %s", issuanceDate.String(), minIssuanceDate.String()))
+		}
+	}
+
+	// check batch metadata attestation
+	if criteria.Attestor != "" {
+		if k.dataKeeper == nil {
+			return nil, sdkerrors.ErrLogic.Wrap("data keeper is required to check batch attestations")
+		}
+		attestor, err := sdk.AccAddressFromBech32(criteria.Attestor)
+		if err != nil {
+			return nil, err
+		}
+		attested, err := k.dataKeeper.HasAttestation(sdkCtx, batch.Metadata, attestor)
+		if err != nil {
+			return nil, err
+		}
+		if !attested {
+			reasons = append(reasons, fmt.Sprintf("batch metadata %s has not been attested to by %s", batch.Metadata, criteria.Attestor))
+		}
+	}
+
+	return reasons, nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
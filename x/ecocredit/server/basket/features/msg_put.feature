@@ -127,6 +127,21 @@ Feature: Msg/Put
         | one decimal  | 1         | 2.25          |
         | two decimals | 2         | 2.333         |
 
+    Scenario: credit amount exceeds basket exponent after credit type precision was raised
+      Given a credit type with abbreviation "C" and precision "6"
+      And a basket with credit type "C" and exponent "2"
+      And alice owns credit amount "2.333"
+      When alice attempts to put credit amount "2.333" into the basket
+      Then expect error contains "exceeds maximum decimal places"
+
+    Scenario: basket tokens are calculated using basket exponent
+      Given a credit type with abbreviation "C" and precision "6"
+      And a basket with credit type "C" and exponent "2"
+      And alice owns credit amount "2.25"
+      And alice owns basket token amount "0"
+      When alice attempts to put credit amount "2.25" into the basket
+      Then expect alice basket token balance amount "225"
+
   Rule: Credits from a batch with a start date before basket minimum start date cannot be put into the basket
 
     Background:
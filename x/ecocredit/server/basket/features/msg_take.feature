@@ -137,6 +137,22 @@ Feature: Msg/Take
         | precision non-zero, credits whole   | 6         | 2000000      | 2.000000         |
         | precision non-zero, credits decimal | 6         | 2500000      | 2.500000         |
 
+    Scenario: user tradable credit balance is updated using basket exponent
+      Given a credit type with abbreviation "C" and precision "9"
+      And a basket with credit type "C" and exponent "6"
+      And basket token supply amount "2500000"
+      And alice owns basket token amount "2500000"
+      When alice attempts to take credits with basket token amount "2500000" and retire on take "false"
+      Then expect alice tradable credit balance amount "2.500000"
+
+    Scenario: beneficiary retired credit balance is updated
+      Given a credit type with abbreviation "C" and precision "6"
+      And a basket with credit type "C" and disable auto retire "false"
+      And basket token supply amount "2000000"
+      And alice owns basket token amount "2000000"
+      When alice attempts to take credits with basket token amount "2000000" on behalf of bob
+      Then expect bob retired credit balance amount "2.000000"
+
     # no failing scenario - state transitions only occur upon successful message execution
 
   Rule: The basket credit balance is updated when credits are taken from the basket
@@ -15,6 +15,7 @@ type Keeper struct {
 	coreStore     ecoApi.StateStore
 	bankKeeper    ecocredit.BankKeeper
 	paramsKeeper  ecocredit.ParamKeeper
+	dataKeeper    ecocredit.DataKeeper
 	moduleAddress sdk.AccAddress
 }
 
@@ -27,6 +28,7 @@ func NewKeeper(
 	cs ecoApi.StateStore,
 	bk ecocredit.BankKeeper,
 	pk ecocredit.ParamKeeper,
+	dk ecocredit.DataKeeper,
 	ma sdk.AccAddress,
 ) Keeper {
 	return Keeper{
@@ -34,6 +36,7 @@ func NewKeeper(
 		coreStore:     cs,
 		bankKeeper:    bk,
 		paramsKeeper:  pk,
+		dataKeeper:    dk,
 		moduleAddress: ma,
 	}
 }
@@ -45,6 +45,7 @@ type baseSuite struct {
 	coreStore    ecocreditApi.StateStore
 	bankKeeper   *mocks.MockBankKeeper
 	paramsKeeper *mocks.MockParamKeeper
+	dataKeeper   *mocks.MockDataKeeper
 	storeKey     *sdk.KVStoreKey
 	sdkCtx       sdk.Context
 }
@@ -74,9 +75,10 @@ func setupBase(t gocuke.TestingT) *baseSuite {
 	assert.NilError(t, err)
 	s.bankKeeper = mocks.NewMockBankKeeper(s.ctrl)
 	s.paramsKeeper = mocks.NewMockParamKeeper(s.ctrl)
+	s.dataKeeper = mocks.NewMockDataKeeper(s.ctrl)
 
 	_, _, moduleAddress := testdata.KeyTestPubAddr()
-	s.k = basket.NewKeeper(s.stateStore, s.coreStore, s.bankKeeper, s.paramsKeeper, moduleAddress)
+	s.k = basket.NewKeeper(s.stateStore, s.coreStore, s.bankKeeper, s.paramsKeeper, s.dataKeeper, moduleAddress)
 	s.coreStore, err = ecoApi.NewStateStore(s.db)
 	assert.NilError(t, err)
 
@@ -0,0 +1,44 @@
+package basket
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket"
+)
+
+// AddBasketClasses adds credit classes to the allowed classes of a basket.
+func (k Keeper) AddBasketClasses(ctx context.Context, req *baskettypes.MsgAddBasketClasses) (*baskettypes.MsgAddBasketClassesResponse, error) {
+	basket, err := k.getCuratedBasket(ctx, req.BasketDenom, req.Curator)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, classId := range req.ClassIds {
+		found, err := k.stateStore.BasketClassTable().Has(ctx, basket.Id, classId)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"credit class %s is already allowed in basket %s", classId, req.BasketDenom,
+			)
+		}
+	}
+
+	if err = k.indexAllowedClasses(ctx, basket.Id, req.ClassIds, basket.CreditTypeAbbrev); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err = sdkCtx.EventManager().EmitTypedEvent(&baskettypes.EventAddBasketClasses{
+		BasketDenom: req.BasketDenom,
+		ClassIds:    req.ClassIds,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &baskettypes.MsgAddBasketClassesResponse{}, nil
+}
@@ -0,0 +1,61 @@
+package basket_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket"
+)
+
+func TestAddBasketClasses(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	insertClass(t, s, "C01", "C")
+	insertClass(t, s, "C02", "C")
+	insertClass(t, s, "BIO01", "BIO")
+	insertBasket(t, s, curatedBasketDenom, "NCT", "C", nil, []string{"C01"})
+
+	curator := s.addrs[0].String()
+
+	_, err := s.k.AddBasketClasses(s.ctx, &baskettypes.MsgAddBasketClasses{
+		Curator:     s.addrs[1].String(),
+		BasketDenom: curatedBasketDenom,
+		ClassIds:    []string{"C02"},
+	})
+	require.ErrorContains(t, err, "is not the curator of basket")
+
+	_, err = s.k.AddBasketClasses(s.ctx, &baskettypes.MsgAddBasketClasses{
+		Curator:     curator,
+		BasketDenom: curatedBasketDenom,
+		ClassIds:    []string{"C01"},
+	})
+	require.ErrorContains(t, err, "credit class C01 is already allowed in basket")
+
+	_, err = s.k.AddBasketClasses(s.ctx, &baskettypes.MsgAddBasketClasses{
+		Curator:     curator,
+		BasketDenom: curatedBasketDenom,
+		ClassIds:    []string{"C03"},
+	})
+	require.ErrorContains(t, err, "could not get credit class C03")
+
+	_, err = s.k.AddBasketClasses(s.ctx, &baskettypes.MsgAddBasketClasses{
+		Curator:     curator,
+		BasketDenom: curatedBasketDenom,
+		ClassIds:    []string{"BIO01"},
+	})
+	require.ErrorContains(t, err, "basket specified credit type C, but class BIO01 is of type BIO")
+
+	_, err = s.k.AddBasketClasses(s.ctx, &baskettypes.MsgAddBasketClasses{
+		Curator:     curator,
+		BasketDenom: curatedBasketDenom,
+		ClassIds:    []string{"C02"},
+	})
+	require.NoError(t, err)
+
+	basket, err := s.stateStore.BasketTable().GetByBasketDenom(s.ctx, curatedBasketDenom)
+	require.NoError(t, err)
+	found, err := s.stateStore.BasketClassTable().Has(s.ctx, basket.Id, "C02")
+	require.NoError(t, err)
+	require.True(t, found)
+}
@@ -104,7 +104,8 @@ func (k Keeper) Create(ctx context.Context, msg *basket.MsgCreate) (*basket.MsgC
 		DisableAutoRetire: msg.DisableAutoRetire,
 		CreditTypeAbbrev:  msg.CreditTypeAbbrev,
 		DateCriteria:      msg.DateCriteria.ToApi(),
-		Exponent:          creditType.Precision, // exponent is no longer used but set until removed
+		AdmissionCriteria: msg.AdmissionCriteria.ToApi(),
+		Exponent:          creditType.Precision,
 		Name:              msg.Name,
 	})
 	if err != nil {
@@ -174,7 +175,7 @@ func (k Keeper) indexAllowedClasses(ctx context.Context, basketID uint64, allowe
 			return err
 		}
 
-		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/basket/MsgCreate class iteration")
+		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/basket class iteration")
 	}
 	return nil
 }
@@ -2,7 +2,6 @@ package basket
 
 import (
 	"context"
-	"time"
 
 	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -14,6 +13,7 @@ import (
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket"
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
 )
 
 // Put deposits ecocredits into a basket, returning fungible coins to the depositor.
@@ -50,13 +50,17 @@ func (k Keeper) Put(ctx context.Context, req *baskettypes.MsgPut) (*baskettypes.
 		if err != nil {
 			return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch %s: %s", credit.BatchDenom, err.Error())
 		}
+		if err := utils.AssertBatchNotFrozen(batch); err != nil {
+			return nil, err
+		}
 
 		// validate that the credit batch adheres to the basket's specifications
 		if err := k.canBasketAcceptCredit(ctx, basket, batch); err != nil {
 			return nil, err
 		}
-		// get the amount of credits in dec
-		amt, err := regenmath.NewPositiveFixedDecFromString(credit.Amount, creditType.Precision)
+		// get the amount of credits in dec, limited to the decimal places that
+		// can be represented as basket tokens
+		amt, err := regenmath.NewPositiveFixedDecFromString(credit.Amount, basket.Exponent)
 		if err != nil {
 			return nil, err
 		}
@@ -68,7 +72,7 @@ func (k Keeper) Put(ctx context.Context, req *baskettypes.MsgPut) (*baskettypes.
 			return nil, err
 		}
 		// get the amount of basket tokens to give to the depositor
-		tokens, err := creditAmountToBasketCoins(amt, creditType.Precision, basket.BasketDenom)
+		tokens, err := creditAmountToBasketCoins(amt, basket.Exponent, basket.BasketDenom)
 		if err != nil {
 			return nil, err
 		}
@@ -113,54 +117,16 @@ func (k Keeper) Put(ctx context.Context, req *baskettypes.MsgPut) (*baskettypes.
 // canBasketAcceptCredit checks that a credit adheres to the specifications of a basket. Specifically, it checks:
 //  - batch's start time is within the basket's specified time window or min start date
 //  - class is in the basket's allowed class store
-//  - type matches the baskets specified credit type.
+//  - type matches the baskets specified credit type
+//  - batch adheres to the basket's admission criteria (see getAdmissionReasons).
 func (k Keeper) canBasketAcceptCredit(ctx context.Context, basket *api.Basket, batch *ecoApi.Batch) error {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	blockTime := sdkCtx.BlockTime()
-	errInvalidReq := sdkerrors.ErrInvalidRequest
-
-	if basket.DateCriteria != nil {
-		// check time window match
-		var minStartDate time.Time
-		var criteria = basket.DateCriteria
-		if criteria.MinStartDate != nil {
-			minStartDate = criteria.MinStartDate.AsTime()
-		} else if criteria.StartDateWindow != nil {
-			window := criteria.StartDateWindow.AsDuration()
-			minStartDate = blockTime.Add(-window)
-		} else if criteria.YearsInThePast != 0 {
-			year := blockTime.Year() - int(criteria.YearsInThePast)
-			minStartDate = time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
-		}
-
-		startDate := batch.StartDate.AsTime()
-		if startDate.Before(minStartDate) {
-			return errInvalidReq.Wrapf("cannot put a credit from a batch with start date %s "+
-				"into a basket that requires an earliest start date of %s", batch.StartDate.AsTime().String(), minStartDate.String())
-		}
-
-	}
-
-	classId := core.GetClassIdFromBatchDenom(batch.Denom)
-
-	// check credit class match
-	found, err := k.stateStore.BasketClassTable().Has(ctx, basket.Id, classId)
-	if err != nil {
-		return err
-	}
-	if !found {
-		return errInvalidReq.Wrapf("credit class %s is not allowed in this basket", classId)
-	}
-
-	// check credit type match
-	class, err := k.coreStore.ClassTable().GetById(ctx, classId)
+	reasons, err := k.getAdmissionReasons(ctx, basket, batch)
 	if err != nil {
 		return err
 	}
-	if class.CreditTypeAbbrev != basket.CreditTypeAbbrev {
-		return errInvalidReq.Wrapf("basket requires credit type %s but a credit with type %s was given", basket.CreditTypeAbbrev, class.CreditTypeAbbrev)
+	if len(reasons) > 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap(reasons[0])
 	}
-
 	return nil
 }
 
@@ -11,6 +11,7 @@ import (
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
@@ -100,6 +101,27 @@ func (s *putSuite) ABasket() {
 	basketId, err := s.stateStore.BasketTable().InsertReturningID(s.ctx, &api.Basket{
 		BasketDenom:      s.basketDenom,
 		CreditTypeAbbrev: s.creditTypeAbbrev,
+		Exponent:         s.creditTypePrecision,
+	})
+	require.NoError(s.t, err)
+
+	err = s.stateStore.BasketClassTable().Insert(s.ctx, &api.BasketClass{
+		BasketId: basketId,
+		ClassId:  s.classId,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *putSuite) ABasketWithCreditTypeAndExponent(a string, b string) {
+	exponent, err := strconv.ParseUint(b, 10, 32)
+	require.NoError(s.t, err)
+
+	s.creditTypeAbbrev = a
+
+	basketId, err := s.stateStore.BasketTable().InsertReturningID(s.ctx, &api.Basket{
+		BasketDenom:      s.basketDenom,
+		CreditTypeAbbrev: s.creditTypeAbbrev,
+		Exponent:         uint32(exponent),
 	})
 	require.NoError(s.t, err)
 
@@ -116,6 +138,7 @@ func (s *putSuite) ABasketWithCreditType(a string) {
 	basketId, err := s.stateStore.BasketTable().InsertReturningID(s.ctx, &api.Basket{
 		BasketDenom:      s.basketDenom,
 		CreditTypeAbbrev: s.creditTypeAbbrev,
+		Exponent:         s.creditTypePrecision,
 	})
 	require.NoError(s.t, err)
 
@@ -130,6 +153,7 @@ func (s *putSuite) ABasketWithDenom(a string) {
 	basketId, err := s.stateStore.BasketTable().InsertReturningID(s.ctx, &api.Basket{
 		BasketDenom:      a,
 		CreditTypeAbbrev: s.creditTypeAbbrev,
+		Exponent:         s.creditTypePrecision,
 	})
 	require.NoError(s.t, err)
 
@@ -146,6 +170,7 @@ func (s *putSuite) ABasketWithAllowedCreditClass(a string) {
 	basketId, err := s.stateStore.BasketTable().InsertReturningID(s.ctx, &api.Basket{
 		BasketDenom:      s.basketDenom,
 		CreditTypeAbbrev: creditTypeAbbrev,
+		Exponent:         s.creditTypePrecision,
 	})
 	require.NoError(s.t, err)
 
@@ -163,6 +188,7 @@ func (s *putSuite) ABasketWithMinimumStartDate(a string) {
 	basketId, err := s.stateStore.BasketTable().InsertReturningID(s.ctx, &api.Basket{
 		BasketDenom:      s.basketDenom,
 		CreditTypeAbbrev: s.creditTypeAbbrev,
+		Exponent:         s.creditTypePrecision,
 		DateCriteria: &api.DateCriteria{
 			MinStartDate: timestamppb.New(minStartDate),
 		},
@@ -183,6 +209,7 @@ func (s *putSuite) ABasketWithStartDateWindow(a string) {
 	basketId, err := s.stateStore.BasketTable().InsertReturningID(s.ctx, &api.Basket{
 		BasketDenom:      s.basketDenom,
 		CreditTypeAbbrev: s.creditTypeAbbrev,
+		Exponent:         s.creditTypePrecision,
 		DateCriteria: &api.DateCriteria{
 			StartDateWindow: &durationpb.Duration{
 				Seconds: startDateWindow,
@@ -205,6 +232,7 @@ func (s *putSuite) ABasketWithYearsInThePast(a string) {
 	basketId, err := s.stateStore.BasketTable().InsertReturningID(s.ctx, &api.Basket{
 		BasketDenom:      s.basketDenom,
 		CreditTypeAbbrev: s.creditTypeAbbrev,
+		Exponent:         s.creditTypePrecision,
 		DateCriteria: &api.DateCriteria{
 			YearsInThePast: uint32(yearsInThePast),
 		},
@@ -532,17 +560,21 @@ func (s *putSuite) putExpectCalls() {
 }
 
 func (s *putSuite) calculateExpectedCoin(amount string) sdk.Coin {
-	creditType, err := s.coreStore.CreditTypeTable().Get(s.ctx, s.creditTypeAbbrev)
+	basket, err := s.stateStore.BasketTable().GetByBasketDenom(s.ctx, s.basketDenom)
+	if err != nil && ormerrors.IsNotFound(err) {
+		// expected coins irrelevant if basket does not exist
+		return sdk.NewCoin(s.basketDenom, sdk.NewInt(0))
+	}
 	require.NoError(s.t, err)
 
-	dec, err := math.NewPositiveFixedDecFromString(amount, creditType.Precision)
+	dec, err := math.NewPositiveFixedDecFromString(amount, basket.Exponent)
 	if err != nil && strings.Contains(err.Error(), "exceeds maximum decimal places") {
 		// expected coins irrelevant if amount exceeds maximum decimal places
 		return sdk.NewCoin(s.basketDenom, sdk.NewInt(0))
 	}
 	require.NoError(s.t, err)
 
-	tokenAmt, err := math.NewDecFinite(1, int32(creditType.Precision)).MulExact(dec)
+	tokenAmt, err := math.NewDecFinite(1, int32(basket.Exponent)).MulExact(dec)
 	require.NoError(s.t, err)
 
 	amtInt, err := tokenAmt.BigInt()
@@ -0,0 +1,79 @@
+package basket
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket"
+)
+
+// RemoveBasketClasses removes credit classes from the allowed classes of a
+// basket. Credits from the removed classes that are already in the basket are
+// not affected and can still be taken from the basket.
+func (k Keeper) RemoveBasketClasses(ctx context.Context, req *baskettypes.MsgRemoveBasketClasses) (*baskettypes.MsgRemoveBasketClassesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	basket, err := k.getCuratedBasket(ctx, req.BasketDenom, req.Curator)
+	if err != nil {
+		return nil, err
+	}
+
+	allowedClasses, err := k.getBasketClasses(ctx, basket.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, classId := range req.ClassIds {
+		if _, ok := allowedClasses[classId]; !ok {
+			return nil, sdkerrors.ErrNotFound.Wrapf(
+				"credit class %s is not allowed in basket %s", classId, req.BasketDenom,
+			)
+		}
+	}
+
+	// a basket must always allow at least one credit class
+	if len(req.ClassIds) >= len(allowedClasses) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("cannot remove all allowed classes from a basket")
+	}
+
+	for _, classId := range req.ClassIds {
+		if err = k.stateStore.BasketClassTable().Delete(ctx, allowedClasses[classId]); err != nil {
+			return nil, err
+		}
+
+		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/basket/MsgRemoveBasketClasses class iteration")
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&baskettypes.EventRemoveBasketClasses{
+		BasketDenom: req.BasketDenom,
+		ClassIds:    req.ClassIds,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &baskettypes.MsgRemoveBasketClassesResponse{}, nil
+}
+
+// getBasketClasses returns the allowed classes of a basket keyed by class ID.
+func (k Keeper) getBasketClasses(ctx context.Context, basketId uint64) (map[string]*api.BasketClass, error) {
+	it, err := k.stateStore.BasketClassTable().List(ctx, api.BasketClassPrimaryKey{}.WithBasketId(basketId))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	classes := make(map[string]*api.BasketClass)
+	for it.Next() {
+		class, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		classes[class.ClassId] = class
+	}
+
+	return classes, nil
+}
@@ -0,0 +1,54 @@
+package basket_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket"
+)
+
+func TestRemoveBasketClasses(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	insertBasket(t, s, curatedBasketDenom, "NCT", "C", nil, []string{"C01", "C02"})
+
+	curator := s.addrs[0].String()
+
+	_, err := s.k.RemoveBasketClasses(s.ctx, &baskettypes.MsgRemoveBasketClasses{
+		Curator:     s.addrs[1].String(),
+		BasketDenom: curatedBasketDenom,
+		ClassIds:    []string{"C02"},
+	})
+	require.ErrorContains(t, err, "is not the curator of basket")
+
+	_, err = s.k.RemoveBasketClasses(s.ctx, &baskettypes.MsgRemoveBasketClasses{
+		Curator:     curator,
+		BasketDenom: curatedBasketDenom,
+		ClassIds:    []string{"C03"},
+	})
+	require.ErrorContains(t, err, "credit class C03 is not allowed in basket")
+
+	_, err = s.k.RemoveBasketClasses(s.ctx, &baskettypes.MsgRemoveBasketClasses{
+		Curator:     curator,
+		BasketDenom: curatedBasketDenom,
+		ClassIds:    []string{"C01", "C02"},
+	})
+	require.ErrorContains(t, err, "cannot remove all allowed classes from a basket")
+
+	_, err = s.k.RemoveBasketClasses(s.ctx, &baskettypes.MsgRemoveBasketClasses{
+		Curator:     curator,
+		BasketDenom: curatedBasketDenom,
+		ClassIds:    []string{"C02"},
+	})
+	require.NoError(t, err)
+
+	basket, err := s.stateStore.BasketTable().GetByBasketDenom(s.ctx, curatedBasketDenom)
+	require.NoError(t, err)
+	found, err := s.stateStore.BasketClassTable().Has(s.ctx, basket.Id, "C02")
+	require.NoError(t, err)
+	require.False(t, found)
+	found, err = s.stateStore.BasketClassTable().Has(s.ctx, basket.Id, "C01")
+	require.NoError(t, err)
+	require.True(t, found)
+}
@@ -2,16 +2,16 @@ package basket
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
-	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
+	ecoApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/types"
 	"github.com/regen-network/regen-ledger/types/math"
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket"
 	coretypes "github.com/regen-network/regen-ledger/x/ecocredit/core"
 	"github.com/regen-network/regen-ledger/x/ecocredit/server/core"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -26,11 +26,6 @@ func (k Keeper) Take(ctx context.Context, msg *baskettypes.MsgTake) (*baskettype
 		return nil, err
 	}
 
-	creditType, err := k.coreStore.CreditTypeTable().Get(ctx, basket.CreditTypeAbbrev)
-	if err != nil {
-		return nil, err
-	}
-
 	retire := msg.RetireOnTake
 	if !basket.DisableAutoRetire && !retire {
 		return nil, ErrCantDisableRetire
@@ -46,11 +41,25 @@ func (k Keeper) Take(ctx context.Context, msg *baskettypes.MsgTake) (*baskettype
 		return nil, err
 	}
 
+	premium := getTakePremium(basket, msg.Strategy, amountBasketTokens)
+	if len(msg.MaxPremium) != 0 {
+		maxPremium, ok := sdk.NewIntFromString(msg.MaxPremium)
+		if !ok {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("bad integer %s", msg.MaxPremium)
+		}
+		if premium.GT(maxPremium) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"take premium %s exceeds max premium %s", premium, maxPremium,
+			)
+		}
+	}
+
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	basketCoins := sdk.NewCoins(sdk.NewCoin(basket.BasketDenom, amountBasketTokens))
+	totalCoin := sdk.NewCoin(basket.BasketDenom, amountBasketTokens.Add(premium))
 
 	ownerBalance := k.bankKeeper.GetBalance(sdkCtx, acct, basket.BasketDenom)
-	if ownerBalance.IsNil() || ownerBalance.IsLT(basketCoins[0]) {
+	if ownerBalance.IsNil() || ownerBalance.IsLT(totalCoin) {
 		return nil, sdkerrors.ErrInsufficientFunds.Wrapf("insufficient balance for basket denom %s", basket.BasketDenom)
 	}
 
@@ -64,69 +73,73 @@ func (k Keeper) Take(ctx context.Context, msg *baskettypes.MsgTake) (*baskettype
 		return nil, err
 	}
 
+	// the take premium is paid to the curator in basket tokens
+	if premium.IsPositive() {
+		premiumCoins := sdk.NewCoins(sdk.NewCoin(basket.BasketDenom, premium))
+		if err = k.bankKeeper.SendCoins(sdkCtx, acct, basket.Curator, premiumCoins); err != nil {
+			return nil, err
+		}
+	}
+
 	amountBasketCreditsDec, err := math.NewDecFromString(msg.Amount)
 	if err != nil {
 		return nil, err
 	}
 
-	multiplier := math.NewDecFinite(1, int32(creditType.Precision))
+	multiplier := math.NewDecFinite(1, int32(basket.Exponent))
 	amountCreditsNeeded, err := amountBasketCreditsDec.QuoExact(multiplier)
 	if err != nil {
 		return nil, err
 	}
 
-	var credits []*baskettypes.BasketCredit
-	for {
-		it, err := k.stateStore.BasketBalanceTable().List(ctx,
-			api.BasketBalanceBasketIdBatchStartDateIndexKey{}.WithBasketId(basket.Id),
-		)
+	// retirement_location is deprecated but still supported
+	opts := retireOptions{
+		jurisdiction:    msg.RetirementJurisdiction,
+		beneficiaryName: msg.BeneficiaryName,
+		reason:          msg.Reason,
+	}
+	if len(opts.jurisdiction) == 0 {
+		opts.jurisdiction = msg.RetirementLocation
+	}
+	if retire && len(msg.BeneficiaryAddress) != 0 {
+		opts.beneficiary, err = sdk.AccAddressFromBech32(msg.BeneficiaryAddress)
 		if err != nil {
-			return nil, err
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("beneficiary address: %s", err)
 		}
+	}
 
-		if !it.Next() {
-			return nil, fmt.Errorf("unexpected failure - balance invariant broken")
-		}
+	allocations, err := k.getTakeAllocations(ctx, basket, msg, amountCreditsNeeded)
+	if err != nil {
+		return nil, err
+	}
 
-		basketBalance, err := it.Value()
-		if err != nil {
-			return nil, err
-		}
-		it.Close()
+	credits := make([]*baskettypes.BasketCredit, 0, len(allocations))
+	for _, allocation := range allocations {
+		basketBalance := allocation.basketBalance
+		credits = append(credits, &baskettypes.BasketCredit{
+			BatchDenom: basketBalance.BatchDenom,
+			Amount:     allocation.amount.String(),
+		})
 
-		balance, err := math.NewDecFromString(basketBalance.Balance)
+		err = k.addCreditBalance(
+			ctx,
+			acct,
+			basketBalance.BatchDenom,
+			allocation.amount,
+			retire,
+			opts,
+		)
 		if err != nil {
 			return nil, err
 		}
 
-		// retirement_location is deprecated but still supported
-		var retirementJurisdiction string
-		if len(msg.RetirementJurisdiction) != 0 {
-			retirementJurisdiction = msg.RetirementJurisdiction
-		} else {
-			retirementJurisdiction = msg.RetirementLocation
-		}
-
-		cmp := balance.Cmp(amountCreditsNeeded)
-		if cmp > 0 {
-			credits = append(credits, &baskettypes.BasketCredit{
-				BatchDenom: basketBalance.BatchDenom,
-				Amount:     amountCreditsNeeded.String(),
-			})
-
-			err = k.addCreditBalance(
-				ctx,
-				acct,
-				basketBalance.BatchDenom,
-				amountCreditsNeeded,
-				retire,
-				retirementJurisdiction,
-			)
+		if allocation.amount.Equal(allocation.balance) {
+			err = k.stateStore.BasketBalanceTable().Delete(ctx, basketBalance)
 			if err != nil {
 				return nil, err
 			}
-
-			newBalance, err := balance.Sub(amountCreditsNeeded)
+		} else {
+			newBalance, err := allocation.balance.Sub(allocation.amount)
 			if err != nil {
 				return nil, err
 			}
@@ -136,57 +149,40 @@ func (k Keeper) Take(ctx context.Context, msg *baskettypes.MsgTake) (*baskettype
 			if err != nil {
 				return nil, err
 			}
-
-			break
-		} else {
-			credits = append(credits, &baskettypes.BasketCredit{
-				BatchDenom: basketBalance.BatchDenom,
-				Amount:     balance.String(),
-			})
-
-			err = k.addCreditBalance(
-				ctx,
-				acct,
-				basketBalance.BatchDenom,
-				balance,
-				retire,
-				retirementJurisdiction,
-			)
-			if err != nil {
-				return nil, err
-			}
-
-			err = k.stateStore.BasketBalanceTable().Delete(ctx, basketBalance)
-			if err != nil {
-				return nil, err
-			}
-
-			// basket balance == credits needed
-			if cmp == 0 {
-				break
-			}
-
-			amountCreditsNeeded, err = amountCreditsNeeded.Sub(balance)
-			if err != nil {
-				return nil, err
-			}
 		}
 
 		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/basket/MsgTake balance iteration")
 	}
 
+	// the premium is only reported when a premium is charged
+	var premiumAmount string
+	if premium.IsPositive() {
+		premiumAmount = premium.String()
+	}
+
 	err = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&baskettypes.EventTake{
 		Owner:       msg.Owner,
 		BasketDenom: msg.BasketDenom,
 		Credits:     credits,    // deprecated
 		Amount:      msg.Amount, // deprecated
+		Strategy:    msg.Strategy,
+		Premium:     premiumAmount,
 	})
 	return &baskettypes.MsgTakeResponse{
 		Credits: credits,
+		Premium: premiumAmount,
 	}, err
 }
 
-func (k Keeper) addCreditBalance(ctx context.Context, owner sdk.AccAddress, batchDenom string, amount math.Dec, retire bool, jurisdiction string) error {
+// retireOptions contains the retirement information used when credits taken from a basket are retired.
+type retireOptions struct {
+	jurisdiction    string
+	beneficiary     sdk.AccAddress
+	beneficiaryName string
+	reason          string
+}
+
+func (k Keeper) addCreditBalance(ctx context.Context, owner sdk.AccAddress, batchDenom string, amount math.Dec, retire bool, opts retireOptions) error {
 	sdkCtx := types.UnwrapSDKContext(ctx)
 	batch, err := k.coreStore.BatchTable().GetByDenom(ctx, batchDenom)
 	if err != nil {
@@ -203,7 +199,12 @@ func (k Keeper) addCreditBalance(ctx context.Context, owner sdk.AccAddress, batc
 			TradableAmount: amount.String(),
 		})
 	} else {
-		if err = core.RetireAndSaveBalance(ctx, k.coreStore.BatchBalanceTable(), owner, batch.Key, amount); err != nil {
+		// retired credits are attributed to the beneficiary if set, otherwise the owner
+		retireTo := owner
+		if len(opts.beneficiary) != 0 {
+			retireTo = opts.beneficiary
+		}
+		if err = core.RetireAndSaveBalance(ctx, k.coreStore.BatchBalanceTable(), retireTo, batch.Key, amount); err != nil {
 			return err
 		}
 		if err = core.RetireSupply(ctx, k.coreStore.BatchSupplyTable(), batch.Key, amount); err != nil {
@@ -211,18 +212,39 @@ func (k Keeper) addCreditBalance(ctx context.Context, owner sdk.AccAddress, batc
 		}
 		err = sdkCtx.EventManager().EmitTypedEvent(&coretypes.EventTransfer{
 			Sender:        k.moduleAddress.String(), // basket submodule
-			Recipient:     owner.String(),
+			Recipient:     retireTo.String(),
 			BatchDenom:    batchDenom,
 			RetiredAmount: amount.String(),
 		})
 		if err != nil {
 			return err
 		}
+		retirementId, err := utils.InsertRetirement(ctx, k.coreStore.RetirementTable(), &ecoApi.Retirement{
+			Owner:              owner,
+			BatchKey:           batch.Key,
+			ProjectKey:         batch.ProjectKey,
+			Amount:             amount.String(),
+			Jurisdiction:       opts.jurisdiction,
+			Reason:             opts.reason,
+			BeneficiaryAddress: opts.beneficiary,
+			BeneficiaryName:    opts.beneficiaryName,
+		})
+		if err != nil {
+			return err
+		}
+		var beneficiaryAddress string
+		if len(opts.beneficiary) != 0 {
+			beneficiaryAddress = opts.beneficiary.String()
+		}
 		return sdkCtx.EventManager().EmitTypedEvent(&coretypes.EventRetire{
-			Owner:        owner.String(),
-			BatchDenom:   batchDenom,
-			Amount:       amount.String(),
-			Jurisdiction: jurisdiction,
+			Owner:              owner.String(),
+			BatchDenom:         batchDenom,
+			Amount:             amount.String(),
+			Jurisdiction:       opts.jurisdiction,
+			Reason:             opts.reason,
+			RetirementId:       retirementId,
+			BeneficiaryAddress: beneficiaryAddress,
+			BeneficiaryName:    opts.beneficiaryName,
 		})
 	}
 }
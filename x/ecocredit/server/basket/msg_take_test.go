@@ -20,6 +20,7 @@ import (
 type takeSuite struct {
 	*baseSuite
 	alice               sdk.AccAddress
+	bob                 sdk.AccAddress
 	aliceTokenBalance   sdk.Coin
 	basketTokenSupply   sdk.Coin
 	classId             string
@@ -40,6 +41,7 @@ func TestTake(t *testing.T) {
 func (s *takeSuite) Before(t gocuke.TestingT) {
 	s.baseSuite = setupBase(t)
 	s.alice = s.addrs[0]
+	s.bob = s.addrs[1]
 	s.aliceTokenBalance = sdk.Coin{
 		Denom:  "eco.uC.NCT",
 		Amount: sdk.NewInt(100),
@@ -98,6 +100,7 @@ func (s *takeSuite) ABasket() {
 	basketId, err := s.stateStore.BasketTable().InsertReturningID(s.ctx, &api.Basket{
 		BasketDenom:      s.basketDenom,
 		CreditTypeAbbrev: s.creditTypeAbbrev,
+		Exponent:         s.creditTypePrecision,
 	})
 	require.NoError(s.t, err)
 
@@ -111,6 +114,7 @@ func (s *takeSuite) ABasketWithDenom(a string) {
 	basketId, err := s.stateStore.BasketTable().InsertReturningID(s.ctx, &api.Basket{
 		BasketDenom:      s.basketDenom,
 		CreditTypeAbbrev: s.creditTypeAbbrev,
+		Exponent:         s.creditTypePrecision,
 	})
 	require.NoError(s.t, err)
 
@@ -125,6 +129,7 @@ func (s *takeSuite) ABasketWithDisableAutoRetire(a string) {
 	basketId, err := s.stateStore.BasketTable().InsertReturningID(s.ctx, &api.Basket{
 		BasketDenom:       s.basketDenom,
 		CreditTypeAbbrev:  s.creditTypeAbbrev,
+		Exponent:          s.creditTypePrecision,
 		DisableAutoRetire: disableAutoRetire,
 	})
 	require.NoError(s.t, err)
@@ -137,6 +142,7 @@ func (s *takeSuite) ABasketWithCreditBalance(a string) {
 	basketId, err := s.stateStore.BasketTable().InsertReturningID(s.ctx, &api.Basket{
 		BasketDenom:      s.basketDenom,
 		CreditTypeAbbrev: s.creditTypeAbbrev,
+		Exponent:         s.creditTypePrecision,
 	})
 	require.NoError(s.t, err)
 
@@ -152,6 +158,7 @@ func (s *takeSuite) ABasketWithCreditTypeAndDisableAutoRetire(a string, b string
 	basketId, err := s.stateStore.BasketTable().InsertReturningID(s.ctx, &api.Basket{
 		BasketDenom:       s.basketDenom,
 		CreditTypeAbbrev:  s.creditTypeAbbrev,
+		Exponent:          s.creditTypePrecision,
 		DisableAutoRetire: disableAutoRetire,
 	})
 	require.NoError(s.t, err)
@@ -160,12 +167,31 @@ func (s *takeSuite) ABasketWithCreditTypeAndDisableAutoRetire(a string, b string
 	s.addBasketClassAndBalance(basketId, s.tokenAmount)
 }
 
+func (s *takeSuite) ABasketWithCreditTypeAndExponent(a string, b string) {
+	exponent, err := strconv.ParseUint(b, 10, 32)
+	require.NoError(s.t, err)
+
+	s.creditTypeAbbrev = a
+
+	basketId, err := s.stateStore.BasketTable().InsertReturningID(s.ctx, &api.Basket{
+		BasketDenom:       s.basketDenom,
+		CreditTypeAbbrev:  s.creditTypeAbbrev,
+		Exponent:          uint32(exponent),
+		DisableAutoRetire: true,
+	})
+	require.NoError(s.t, err)
+
+	// add balance with credit amount = token amount
+	s.addBasketClassAndBalance(basketId, s.tokenAmount)
+}
+
 func (s *takeSuite) ABasketWithCreditTypeAndCreditBalance(a string, b string) {
 	s.creditTypeAbbrev = a
 
 	basketId, err := s.stateStore.BasketTable().InsertReturningID(s.ctx, &api.Basket{
 		BasketDenom:      s.basketDenom,
 		CreditTypeAbbrev: s.creditTypeAbbrev,
+		Exponent:         s.creditTypePrecision,
 	})
 	require.NoError(s.t, err)
 
@@ -247,6 +273,22 @@ func (s *takeSuite) AliceAttemptsToTakeCreditsWithBasketTokenAmountAndRetireOnTa
 	})
 }
 
+func (s *takeSuite) AliceAttemptsToTakeCreditsWithBasketTokenAmountOnBehalfOfBob(a string) {
+	s.tokenAmount = a
+
+	s.takeExpectCalls()
+
+	s.res, s.err = s.k.Take(s.ctx, &basket.MsgTake{
+		Owner:                  s.alice.String(),
+		BasketDenom:            s.basketDenom,
+		Amount:                 s.tokenAmount,
+		RetirementJurisdiction: s.jurisdiction,
+		RetireOnTake:           true,
+		BeneficiaryAddress:     s.bob.String(),
+		BeneficiaryName:        "Bob",
+	})
+}
+
 func (s *takeSuite) AliceAttemptsToTakeCreditsWithRetireOnTake(a string) {
 	retireOnTake, err := strconv.ParseBool(a)
 	require.NoError(s.t, err)
@@ -290,6 +332,16 @@ func (s *takeSuite) ExpectAliceRetiredCreditBalanceAmount(a string) {
 	require.Equal(s.t, a, balance.RetiredAmount)
 }
 
+func (s *takeSuite) ExpectBobRetiredCreditBalanceAmount(a string) {
+	batch, err := s.coreStore.BatchTable().GetByDenom(s.ctx, s.batchDenom)
+	require.NoError(s.t, err)
+
+	balance, err := s.coreStore.BatchBalanceTable().Get(s.ctx, s.bob, batch.Key)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, a, balance.RetiredAmount)
+}
+
 func (s *takeSuite) ExpectAliceBasketTokenBalanceAmount(a string) {
 	amount, err := strconv.ParseInt(a, 10, 32)
 	require.NoError(s.t, err)
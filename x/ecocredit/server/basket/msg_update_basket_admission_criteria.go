@@ -0,0 +1,34 @@
+package basket
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket"
+)
+
+// UpdateBasketAdmissionCriteria updates the admission criteria of a basket.
+// The admission criteria is only checked when credits are put into the basket,
+// so credits already in the basket are not affected by the update.
+func (k Keeper) UpdateBasketAdmissionCriteria(ctx context.Context, req *baskettypes.MsgUpdateBasketAdmissionCriteria) (*baskettypes.MsgUpdateBasketAdmissionCriteriaResponse, error) {
+	basket, err := k.getCuratedBasket(ctx, req.BasketDenom, req.Curator)
+	if err != nil {
+		return nil, err
+	}
+
+	basket.AdmissionCriteria = req.AdmissionCriteria.ToApi()
+	if err := k.stateStore.BasketTable().Update(ctx, basket); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := sdkCtx.EventManager().EmitTypedEvent(&baskettypes.EventUpdateBasketAdmissionCriteria{
+		BasketDenom:       req.BasketDenom,
+		AdmissionCriteria: req.AdmissionCriteria,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &baskettypes.MsgUpdateBasketAdmissionCriteriaResponse{}, nil
+}
@@ -0,0 +1,53 @@
+package basket_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket"
+)
+
+func TestUpdateBasketAdmissionCriteria(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	insertBasket(t, s, curatedBasketDenom, "NCT", "C", nil, []string{"C01"})
+
+	curator := s.addrs[0].String()
+	criteria := &baskettypes.AdmissionCriteria{
+		DeniedProjectIds:            []string{"C01-002"},
+		AllowedJurisdictionPrefixes: []string{"US-", "KE"},
+		Attestor:                    s.addrs[1].String(),
+	}
+
+	_, err := s.k.UpdateBasketAdmissionCriteria(s.ctx, &baskettypes.MsgUpdateBasketAdmissionCriteria{
+		Curator:           s.addrs[1].String(),
+		BasketDenom:       curatedBasketDenom,
+		AdmissionCriteria: criteria,
+	})
+	require.ErrorContains(t, err, "is not the curator of basket")
+
+	_, err = s.k.UpdateBasketAdmissionCriteria(s.ctx, &baskettypes.MsgUpdateBasketAdmissionCriteria{
+		Curator:           curator,
+		BasketDenom:       curatedBasketDenom,
+		AdmissionCriteria: criteria,
+	})
+	require.NoError(t, err)
+
+	basket, err := s.stateStore.BasketTable().GetByBasketDenom(s.ctx, curatedBasketDenom)
+	require.NoError(t, err)
+	require.Equal(t, criteria.DeniedProjectIds, basket.AdmissionCriteria.DeniedProjectIds)
+	require.Equal(t, criteria.AllowedJurisdictionPrefixes, basket.AdmissionCriteria.AllowedJurisdictionPrefixes)
+	require.Equal(t, criteria.Attestor, basket.AdmissionCriteria.Attestor)
+
+	// an empty admission criteria removes the admission criteria
+	_, err = s.k.UpdateBasketAdmissionCriteria(s.ctx, &baskettypes.MsgUpdateBasketAdmissionCriteria{
+		Curator:     curator,
+		BasketDenom: curatedBasketDenom,
+	})
+	require.NoError(t, err)
+
+	basket, err = s.stateStore.BasketTable().GetByBasketDenom(s.ctx, curatedBasketDenom)
+	require.NoError(t, err)
+	require.Nil(t, basket.AdmissionCriteria)
+}
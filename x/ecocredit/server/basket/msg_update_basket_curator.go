@@ -0,0 +1,39 @@
+package basket
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket"
+)
+
+// UpdateBasketCurator transfers the curator role of a basket to a new address.
+// WARNING: the current curator forfeits control of the basket settings to the
+// provided address.
+func (k Keeper) UpdateBasketCurator(ctx context.Context, req *baskettypes.MsgUpdateBasketCurator) (*baskettypes.MsgUpdateBasketCuratorResponse, error) {
+	newCurator, err := sdk.AccAddressFromBech32(req.NewCurator)
+	if err != nil {
+		return nil, err
+	}
+
+	basket, err := k.getCuratedBasket(ctx, req.BasketDenom, req.Curator)
+	if err != nil {
+		return nil, err
+	}
+
+	basket.Curator = newCurator
+	if err := k.stateStore.BasketTable().Update(ctx, basket); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := sdkCtx.EventManager().EmitTypedEvent(&baskettypes.EventUpdateBasketCurator{
+		BasketDenom: req.BasketDenom,
+		Curator:     req.NewCurator,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &baskettypes.MsgUpdateBasketCuratorResponse{}, nil
+}
@@ -0,0 +1,53 @@
+package basket_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket"
+)
+
+const curatedBasketDenom = "eco.uC.NCT"
+
+func TestUpdateBasketCurator(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	insertBasket(t, s, curatedBasketDenom, "NCT", "C", nil, []string{"C01"})
+
+	curator, newCurator := s.addrs[0].String(), s.addrs[1].String()
+
+	_, err := s.k.UpdateBasketCurator(s.ctx, &baskettypes.MsgUpdateBasketCurator{
+		Curator:     newCurator,
+		BasketDenom: curatedBasketDenom,
+		NewCurator:  curator,
+	})
+	require.ErrorContains(t, err, "is not the curator of basket")
+
+	_, err = s.k.UpdateBasketCurator(s.ctx, &baskettypes.MsgUpdateBasketCurator{
+		Curator:     curator,
+		BasketDenom: "eco.uC.FOO",
+		NewCurator:  newCurator,
+	})
+	require.ErrorContains(t, err, "basket eco.uC.FOO not found")
+
+	_, err = s.k.UpdateBasketCurator(s.ctx, &baskettypes.MsgUpdateBasketCurator{
+		Curator:     curator,
+		BasketDenom: curatedBasketDenom,
+		NewCurator:  newCurator,
+	})
+	require.NoError(t, err)
+
+	basket, err := s.stateStore.BasketTable().GetByBasketDenom(s.ctx, curatedBasketDenom)
+	require.NoError(t, err)
+	require.Equal(t, s.addrs[1], sdk.AccAddress(basket.Curator))
+
+	// the previous curator can no longer update the basket
+	_, err = s.k.UpdateBasketDescription(s.ctx, &baskettypes.MsgUpdateBasketDescription{
+		Curator:     curator,
+		BasketDenom: curatedBasketDenom,
+	})
+	require.ErrorContains(t, err, "is not the curator of basket")
+}
@@ -0,0 +1,34 @@
+package basket
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket"
+)
+
+// UpdateBasketDateCriteria updates the date criteria of a basket. The date
+// criteria is only checked when credits are put into the basket, so credits
+// already in the basket are not affected by the update.
+func (k Keeper) UpdateBasketDateCriteria(ctx context.Context, req *baskettypes.MsgUpdateBasketDateCriteria) (*baskettypes.MsgUpdateBasketDateCriteriaResponse, error) {
+	basket, err := k.getCuratedBasket(ctx, req.BasketDenom, req.Curator)
+	if err != nil {
+		return nil, err
+	}
+
+	basket.DateCriteria = req.DateCriteria.ToApi()
+	if err := k.stateStore.BasketTable().Update(ctx, basket); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := sdkCtx.EventManager().EmitTypedEvent(&baskettypes.EventUpdateBasketDateCriteria{
+		BasketDenom:  req.BasketDenom,
+		DateCriteria: req.DateCriteria,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &baskettypes.MsgUpdateBasketDateCriteriaResponse{}, nil
+}
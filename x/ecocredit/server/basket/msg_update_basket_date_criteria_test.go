@@ -0,0 +1,47 @@
+package basket_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
+	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket"
+)
+
+func TestUpdateBasketDateCriteria(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	insertBasket(t, s, curatedBasketDenom, "NCT", "C", &api.DateCriteria{YearsInThePast: 3}, []string{"C01"})
+
+	curator := s.addrs[0].String()
+
+	_, err := s.k.UpdateBasketDateCriteria(s.ctx, &baskettypes.MsgUpdateBasketDateCriteria{
+		Curator:      s.addrs[1].String(),
+		BasketDenom:  curatedBasketDenom,
+		DateCriteria: &baskettypes.DateCriteria{YearsInThePast: 10},
+	})
+	require.ErrorContains(t, err, "is not the curator of basket")
+
+	_, err = s.k.UpdateBasketDateCriteria(s.ctx, &baskettypes.MsgUpdateBasketDateCriteria{
+		Curator:      curator,
+		BasketDenom:  curatedBasketDenom,
+		DateCriteria: &baskettypes.DateCriteria{YearsInThePast: 10},
+	})
+	require.NoError(t, err)
+
+	basket, err := s.stateStore.BasketTable().GetByBasketDenom(s.ctx, curatedBasketDenom)
+	require.NoError(t, err)
+	require.Equal(t, uint32(10), basket.DateCriteria.YearsInThePast)
+
+	// an empty date criteria removes the date criteria
+	_, err = s.k.UpdateBasketDateCriteria(s.ctx, &baskettypes.MsgUpdateBasketDateCriteria{
+		Curator:     curator,
+		BasketDenom: curatedBasketDenom,
+	})
+	require.NoError(t, err)
+
+	basket, err = s.stateStore.BasketTable().GetByBasketDenom(s.ctx, curatedBasketDenom)
+	require.NoError(t, err)
+	require.Nil(t, basket.DateCriteria)
+}
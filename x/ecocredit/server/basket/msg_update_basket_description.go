@@ -0,0 +1,35 @@
+package basket
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket"
+)
+
+// UpdateBasketDescription updates the description of a basket, which is stored
+// in the bank denom metadata of the basket token.
+func (k Keeper) UpdateBasketDescription(ctx context.Context, req *baskettypes.MsgUpdateBasketDescription) (*baskettypes.MsgUpdateBasketDescriptionResponse, error) {
+	if _, err := k.getCuratedBasket(ctx, req.BasketDenom, req.Curator); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	metadata, found := k.bankKeeper.GetDenomMetaData(sdkCtx, req.BasketDenom)
+	if !found {
+		return nil, sdkerrors.ErrNotFound.Wrapf("denom metadata for basket %s not found", req.BasketDenom)
+	}
+
+	metadata.Description = req.Description
+	k.bankKeeper.SetDenomMetaData(sdkCtx, metadata)
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&baskettypes.EventUpdateBasketDescription{
+		BasketDenom: req.BasketDenom,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &baskettypes.MsgUpdateBasketDescriptionResponse{}, nil
+}
@@ -0,0 +1,42 @@
+package basket_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket"
+)
+
+func TestUpdateBasketDescription(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	insertBasket(t, s, curatedBasketDenom, "NCT", "C", nil, []string{"C01"})
+
+	curator := s.addrs[0].String()
+
+	_, err := s.k.UpdateBasketDescription(s.ctx, &baskettypes.MsgUpdateBasketDescription{
+		Curator:     s.addrs[1].String(),
+		BasketDenom: curatedBasketDenom,
+		Description: "bar",
+	})
+	require.ErrorContains(t, err, "is not the curator of basket")
+
+	metadata := banktypes.Metadata{
+		Base:        curatedBasketDenom,
+		Description: "foo",
+	}
+	updated := metadata
+	updated.Description = "bar"
+	s.bankKeeper.EXPECT().GetDenomMetaData(gmAny, curatedBasketDenom).Return(metadata, true).Times(1)
+	s.bankKeeper.EXPECT().SetDenomMetaData(gmAny, updated).Times(1)
+
+	_, err = s.k.UpdateBasketDescription(s.ctx, &baskettypes.MsgUpdateBasketDescription{
+		Curator:     curator,
+		BasketDenom: curatedBasketDenom,
+		Description: "bar",
+	})
+	require.NoError(t, err)
+}
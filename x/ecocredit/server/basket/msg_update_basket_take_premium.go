@@ -0,0 +1,33 @@
+package basket
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket"
+)
+
+// UpdateBasketTakePremium updates the premium paid to the curator when credits
+// are taken from a basket with a strategy other than oldest first.
+func (k Keeper) UpdateBasketTakePremium(ctx context.Context, req *baskettypes.MsgUpdateBasketTakePremium) (*baskettypes.MsgUpdateBasketTakePremiumResponse, error) {
+	basket, err := k.getCuratedBasket(ctx, req.BasketDenom, req.Curator)
+	if err != nil {
+		return nil, err
+	}
+
+	basket.TakePremiumBps = req.TakePremiumBps
+	if err := k.stateStore.BasketTable().Update(ctx, basket); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := sdkCtx.EventManager().EmitTypedEvent(&baskettypes.EventUpdateBasketTakePremium{
+		BasketDenom:    req.BasketDenom,
+		TakePremiumBps: req.TakePremiumBps,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &baskettypes.MsgUpdateBasketTakePremiumResponse{}, nil
+}
@@ -0,0 +1,33 @@
+package basket_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket"
+)
+
+func TestUpdateBasketTakePremium(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	insertBasket(t, s, curatedBasketDenom, "NCT", "C", nil, []string{"C01"})
+
+	_, err := s.k.UpdateBasketTakePremium(s.ctx, &baskettypes.MsgUpdateBasketTakePremium{
+		Curator:        s.addrs[1].String(),
+		BasketDenom:    curatedBasketDenom,
+		TakePremiumBps: 100,
+	})
+	require.ErrorContains(t, err, "is not the curator of basket")
+
+	_, err = s.k.UpdateBasketTakePremium(s.ctx, &baskettypes.MsgUpdateBasketTakePremium{
+		Curator:        s.addrs[0].String(),
+		BasketDenom:    curatedBasketDenom,
+		TakePremiumBps: 100,
+	})
+	require.NoError(t, err)
+
+	res, err := s.k.Basket(s.ctx, &baskettypes.QueryBasketRequest{BasketDenom: curatedBasketDenom})
+	require.NoError(t, err)
+	require.Equal(t, uint32(100), res.Basket.TakePremiumBps)
+}
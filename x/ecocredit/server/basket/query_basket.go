@@ -58,6 +58,7 @@ func (k Keeper) Basket(ctx context.Context, request *baskettypes.QueryBasketRequ
 		DisableAutoRetire: basket.DisableAutoRetire,
 		Exponent:          basket.Exponent,
 		Curator:           sdk.AccAddress(basket.Curator).String(),
+		TakePremiumBps:    basket.TakePremiumBps,
 	}
 
 	if basket.DateCriteria != nil {
@@ -69,5 +70,14 @@ func (k Keeper) Basket(ctx context.Context, request *baskettypes.QueryBasketRequ
 		basketInfo.DateCriteria = criteria
 	}
 
+	if basket.AdmissionCriteria != nil {
+		criteria := &baskettypes.AdmissionCriteria{}
+		if err := ormutil.PulsarToGogoSlow(basket.AdmissionCriteria, criteria); err != nil {
+			return nil, err
+		}
+
+		basketInfo.AdmissionCriteria = criteria
+	}
+
 	return &baskettypes.QueryBasketResponse{Basket: basketGogo, BasketInfo: basketInfo, Classes: classes}, nil
 }
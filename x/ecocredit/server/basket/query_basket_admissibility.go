@@ -0,0 +1,53 @@
+package basket
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket"
+)
+
+// BasketAdmissibility queries whether credits from a credit batch can be put
+// into a basket and the reasons why they cannot if they are not admissible.
+func (k Keeper) BasketAdmissibility(ctx context.Context, request *baskettypes.QueryBasketAdmissibilityRequest) (*baskettypes.QueryBasketAdmissibilityResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	basket, err := k.stateStore.BasketTable().GetByBasketDenom(ctx, request.BasketDenom)
+	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			return nil, sdkerrors.Wrapf(err, "basket %s not found", request.BasketDenom)
+		}
+		return nil, sdkerrors.Wrapf(err, "failed to get basket %s", request.BasketDenom)
+	}
+
+	batch, err := k.coreStore.BatchTable().GetByDenom(ctx, request.BatchDenom)
+	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			return nil, sdkerrors.Wrapf(err, "credit batch %s not found", request.BatchDenom)
+		}
+		return nil, sdkerrors.Wrapf(err, "failed to get credit batch %s", request.BatchDenom)
+	}
+
+	var reasons []string
+	if batch.Frozen {
+		reasons = append(reasons, "credit batch "+batch.Denom+" is frozen")
+	}
+
+	admissionReasons, err := k.getAdmissionReasons(ctx, basket, batch)
+	if err != nil {
+		return nil, err
+	}
+	reasons = append(reasons, admissionReasons...)
+
+	return &baskettypes.QueryBasketAdmissibilityResponse{
+		Admissible: len(reasons) == 0,
+		Reasons:    reasons,
+	}, nil
+}
@@ -0,0 +1,148 @@
+package basket_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
+	ecoApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestQueryBasketAdmissibility(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	batchDenom := "C01-001-20200101-20210101-001"
+	metadata := "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf"
+	issuanceDate := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	attestor := s.addrs[1]
+
+	require.NoError(t, s.coreStore.CreditTypeTable().Insert(s.ctx, &ecoApi.CreditType{
+		Abbreviation: "C",
+		Precision:    6,
+	}))
+	insertClass(t, s, "C01", "C")
+	projectKey, err := s.coreStore.ProjectTable().InsertReturningID(s.ctx, &ecoApi.Project{
+		Id:           "C01-001",
+		Admin:        s.addrs[0],
+		ClassKey:     1,
+		Jurisdiction: "US-WA",
+	})
+	require.NoError(t, err)
+	require.NoError(t, s.coreStore.BatchTable().Insert(s.ctx, &ecoApi.Batch{
+		ProjectKey:   projectKey,
+		Denom:        batchDenom,
+		Metadata:     metadata,
+		StartDate:    timestamppb.New(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+		IssuanceDate: timestamppb.New(issuanceDate),
+	}))
+	insertBasket(t, s, curatedBasketDenom, "NCT", "C", nil, []string{"C01"})
+
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx.WithBlockTime(issuanceDate.AddDate(2, 0, 0)))
+
+	// basket without admission criteria
+	res, err := s.k.BasketAdmissibility(s.ctx, &baskettypes.QueryBasketAdmissibilityRequest{
+		BasketDenom: curatedBasketDenom,
+		BatchDenom:  batchDenom,
+	})
+	require.NoError(t, err)
+	require.True(t, res.Admissible)
+	require.Empty(t, res.Reasons)
+
+	// basket with admission criteria not met by the batch
+	basket, err := s.stateStore.BasketTable().GetByBasketDenom(s.ctx, curatedBasketDenom)
+	require.NoError(t, err)
+	basket.AdmissionCriteria = &api.AdmissionCriteria{
+		DeniedProjectIds:            []string{"C01-001"},
+		AllowedJurisdictionPrefixes: []string{"KE"},
+		DeniedJurisdictionPrefixes:  []string{"US-"},
+		MaxBatchAge:                 durationpb.New(time.Hour * 24 * 365),
+		Attestor:                    attestor.String(),
+	}
+	require.NoError(t, s.stateStore.BasketTable().Update(s.ctx, basket))
+
+	s.dataKeeper.EXPECT().HasAttestation(gmAny, metadata, attestor).Return(false, nil).Times(2)
+
+	res, err = s.k.BasketAdmissibility(s.ctx, &baskettypes.QueryBasketAdmissibilityRequest{
+		BasketDenom: curatedBasketDenom,
+		BatchDenom:  batchDenom,
+	})
+	require.NoError(t, err)
+	require.False(t, res.Admissible)
+	require.Equal(t, []string{
+		"project C01-001 is denied in this basket",
+		"project jurisdiction US-WA does not match any allowed jurisdiction prefix of this basket",
+		"project jurisdiction US-WA matches denied jurisdiction prefix US- of this basket",
+		"cannot put a credit from a batch with issuance date 2021-01-01 00:00:00 +0000 UTC " +
+			"into a basket that requires an earliest issuance date of 2022-01-01 00:00:00 +0000 UTC",
+		"batch metadata " + metadata + " has not been attested to by " + attestor.String(),
+	}, res.Reasons)
+
+	// put returns the first reason the batch is not admissible
+	_, err = s.k.Put(s.ctx, &baskettypes.MsgPut{
+		Owner:       s.addrs[0].String(),
+		BasketDenom: curatedBasketDenom,
+		Credits:     []*baskettypes.BasketCredit{{BatchDenom: batchDenom, Amount: "1"}},
+	})
+	require.EqualError(t, err, "project C01-001 is denied in this basket: invalid request")
+
+	// basket with admission criteria met by the batch
+	basket.AdmissionCriteria = &api.AdmissionCriteria{
+		AllowedProjectIds:           []string{"C01-001"},
+		AllowedJurisdictionPrefixes: []string{"KE", "US-"},
+		DeniedJurisdictionPrefixes:  []string{"US-OR"},
+		MaxBatchAge:                 durationpb.New(time.Hour * 24 * 365 * 3),
+		Attestor:                    attestor.String(),
+	}
+	require.NoError(t, s.stateStore.BasketTable().Update(s.ctx, basket))
+
+	s.dataKeeper.EXPECT().HasAttestation(gmAny, metadata, attestor).Return(true, nil).Times(1)
+
+	res, err = s.k.BasketAdmissibility(s.ctx, &baskettypes.QueryBasketAdmissibilityRequest{
+		BasketDenom: curatedBasketDenom,
+		BatchDenom:  batchDenom,
+	})
+	require.NoError(t, err)
+	require.True(t, res.Admissible)
+	require.Empty(t, res.Reasons)
+
+	// frozen batch and class not allowed in basket
+	insertBasket(t, s, "eco.uC.OTHER", "OTHER", "C", nil, []string{"C02"})
+	batch, err := s.coreStore.BatchTable().GetByDenom(s.ctx, batchDenom)
+	require.NoError(t, err)
+	batch.Frozen = true
+	require.NoError(t, s.coreStore.BatchTable().Update(s.ctx, batch))
+
+	res, err = s.k.BasketAdmissibility(s.ctx, &baskettypes.QueryBasketAdmissibilityRequest{
+		BasketDenom: "eco.uC.OTHER",
+		BatchDenom:  batchDenom,
+	})
+	require.NoError(t, err)
+	require.False(t, res.Admissible)
+	require.Equal(t, []string{
+		"credit batch " + batchDenom + " is frozen",
+		"credit class " + core.GetClassIdFromBatchDenom(batchDenom) + " is not allowed in this basket",
+	}, res.Reasons)
+
+	// basket not found
+	_, err = s.k.BasketAdmissibility(s.ctx, &baskettypes.QueryBasketAdmissibilityRequest{
+		BasketDenom: "eco.uC.FOO",
+		BatchDenom:  batchDenom,
+	})
+	require.ErrorContains(t, err, "basket eco.uC.FOO not found")
+
+	// batch not found
+	_, err = s.k.BasketAdmissibility(s.ctx, &baskettypes.QueryBasketAdmissibilityRequest{
+		BasketDenom: curatedBasketDenom,
+		BatchDenom:  "C01-001-20200101-20210101-002",
+	})
+	require.ErrorContains(t, err, "credit batch C01-001-20200101-20210101-002 not found")
+}
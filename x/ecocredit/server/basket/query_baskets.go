@@ -53,6 +53,14 @@ func (k Keeper) Baskets(ctx context.Context, request *baskettypes.QueryBasketsRe
 			}
 		}
 
+		var admissionCriteria *baskettypes.AdmissionCriteria
+		if basket.AdmissionCriteria != nil {
+			admissionCriteria = &baskettypes.AdmissionCriteria{}
+			if err := ormutil.PulsarToGogoSlow(basket.AdmissionCriteria, admissionCriteria); err != nil {
+				return nil, err
+			}
+		}
+
 		res.BasketsInfo = append(res.BasketsInfo, &baskettypes.BasketInfo{
 			BasketDenom:       basket.BasketDenom,
 			Name:              basket.Name,
@@ -60,7 +68,9 @@ func (k Keeper) Baskets(ctx context.Context, request *baskettypes.QueryBasketsRe
 			CreditTypeAbbrev:  basket.CreditTypeAbbrev,
 			Exponent:          basket.Exponent,
 			Curator:           sdk.AccAddress(basket.Curator).String(),
+			TakePremiumBps:    basket.TakePremiumBps,
 			DateCriteria:      criteria,
+			AdmissionCriteria: admissionCriteria,
 		})
 	}
 
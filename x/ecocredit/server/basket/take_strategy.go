@@ -0,0 +1,240 @@
+package basket
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// takeAllocation is the amount of credits taken from a batch balance in a basket.
+type takeAllocation struct {
+	basketBalance *api.BasketBalance
+	balance       math.Dec
+	amount        math.Dec
+}
+
+// getTakePremium returns the premium in basket tokens paid to the curator for
+// taking amount basket tokens with the strategy. Only strategies other than
+// oldest first are charged a premium. The premium is truncated.
+func getTakePremium(basket *api.Basket, strategy baskettypes.TakeStrategy, amount sdk.Int) sdk.Int {
+	switch strategy {
+	case baskettypes.TakeStrategy_TAKE_STRATEGY_UNSPECIFIED, baskettypes.TakeStrategy_TAKE_STRATEGY_OLDEST_FIRST:
+		return sdk.ZeroInt()
+	}
+
+	if len(basket.Curator) == 0 {
+		return sdk.ZeroInt()
+	}
+
+	return amount.MulRaw(int64(basket.TakePremiumBps)).QuoRaw(int64(baskettypes.MaxTakePremiumBps))
+}
+
+// getTakeAllocations selects the credits taken from the basket using the
+// strategy of the request.
+func (k Keeper) getTakeAllocations(ctx context.Context, basket *api.Basket, msg *baskettypes.MsgTake, amount math.Dec) ([]takeAllocation, error) {
+	switch msg.Strategy {
+	case baskettypes.TakeStrategy_TAKE_STRATEGY_NEWEST_FIRST:
+		return k.allocateByStartDate(ctx, basket.Id, amount, ormlist.Reverse())
+	case baskettypes.TakeStrategy_TAKE_STRATEGY_PROPORTIONAL:
+		return k.allocateProportionally(ctx, basket, amount)
+	case baskettypes.TakeStrategy_TAKE_STRATEGY_SELECTED_BATCHES:
+		return k.allocateSelectedBatches(ctx, basket, msg.BatchDenoms, amount)
+	default:
+		return k.allocateByStartDate(ctx, basket.Id, amount)
+	}
+}
+
+// allocateByStartDate takes credits from the batches in the basket ordered by
+// batch start date, oldest first unless the reverse option is provided.
+func (k Keeper) allocateByStartDate(ctx context.Context, basketId uint64, amount math.Dec, opts ...ormlist.Option) ([]takeAllocation, error) {
+	it, err := k.stateStore.BasketBalanceTable().List(ctx,
+		api.BasketBalanceBasketIdBatchStartDateIndexKey{}.WithBasketId(basketId), opts...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var allocations []takeAllocation
+	for !amount.IsZero() {
+		if !it.Next() {
+			return nil, fmt.Errorf("unexpected failure - balance invariant broken")
+		}
+
+		basketBalance, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		var allocation takeAllocation
+		allocation, amount, err = allocate(basketBalance, amount)
+		if err != nil {
+			return nil, err
+		}
+		allocations = append(allocations, allocation)
+	}
+
+	return allocations, nil
+}
+
+// allocateSelectedBatches takes credits from the selected batches in the order
+// they are listed.
+func (k Keeper) allocateSelectedBatches(ctx context.Context, basket *api.Basket, batchDenoms []string, amount math.Dec) ([]takeAllocation, error) {
+	var allocations []takeAllocation
+	for _, batchDenom := range batchDenoms {
+		if amount.IsZero() {
+			break
+		}
+
+		basketBalance, err := k.stateStore.BasketBalanceTable().Get(ctx, basket.Id, batchDenom)
+		if err != nil {
+			if ormerrors.IsNotFound(err) {
+				return nil, sdkerrors.ErrNotFound.Wrapf(
+					"credit batch %s not found in basket %s", batchDenom, basket.BasketDenom,
+				)
+			}
+			return nil, err
+		}
+
+		var allocation takeAllocation
+		allocation, amount, err = allocate(basketBalance, amount)
+		if err != nil {
+			return nil, err
+		}
+		allocations = append(allocations, allocation)
+	}
+
+	if !amount.IsZero() {
+		return nil, sdkerrors.ErrInsufficientFunds.Wrapf(
+			"selected batches have %s fewer credits than requested", amount,
+		)
+	}
+
+	return allocations, nil
+}
+
+// allocateProportionally takes credits from every batch in the basket
+// proportionally to the balance of the batch in the basket. Each share is
+// truncated to the basket exponent and the remainder is taken from the oldest
+// batches first.
+func (k Keeper) allocateProportionally(ctx context.Context, basket *api.Basket, amount math.Dec) ([]takeAllocation, error) {
+	it, err := k.stateStore.BasketBalanceTable().List(ctx,
+		api.BasketBalanceBasketIdBatchStartDateIndexKey{}.WithBasketId(basket.Id),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var allocations []takeAllocation
+	total := math.NewDecFromInt64(0)
+	for it.Next() {
+		basketBalance, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		balance, err := math.NewDecFromString(basketBalance.Balance)
+		if err != nil {
+			return nil, err
+		}
+
+		if total, err = total.Add(balance); err != nil {
+			return nil, err
+		}
+
+		allocations = append(allocations, takeAllocation{
+			basketBalance: basketBalance,
+			balance:       balance,
+		})
+	}
+
+	if total.Cmp(amount) < 0 {
+		return nil, fmt.Errorf("unexpected failure - balance invariant broken")
+	}
+
+	remaining := amount
+	for i := range allocations {
+		share, err := amount.Mul(allocations[i].balance)
+		if err != nil {
+			return nil, err
+		}
+		if share, err = share.Quo(total); err != nil {
+			return nil, err
+		}
+		if share, err = utils.TruncateDec(share, basket.Exponent); err != nil {
+			return nil, err
+		}
+		if remaining, err = remaining.Sub(share); err != nil {
+			return nil, err
+		}
+		allocations[i].amount = share
+	}
+
+	for i := range allocations {
+		if remaining.IsZero() {
+			break
+		}
+
+		available, err := allocations[i].balance.Sub(allocations[i].amount)
+		if err != nil {
+			return nil, err
+		}
+
+		extra := remaining
+		if available.Cmp(extra) < 0 {
+			extra = available
+		}
+
+		if allocations[i].amount, err = allocations[i].amount.Add(extra); err != nil {
+			return nil, err
+		}
+		if remaining, err = remaining.Sub(extra); err != nil {
+			return nil, err
+		}
+	}
+
+	// batches with a share too small to be represented are not taken from
+	nonZero := allocations[:0]
+	for _, allocation := range allocations {
+		if !allocation.amount.IsZero() {
+			nonZero = append(nonZero, allocation)
+		}
+	}
+
+	return nonZero, nil
+}
+
+// allocate takes up to amount credits from the basket balance and returns the
+// allocation and the amount of credits still needed.
+func allocate(basketBalance *api.BasketBalance, amount math.Dec) (takeAllocation, math.Dec, error) {
+	balance, err := math.NewDecFromString(basketBalance.Balance)
+	if err != nil {
+		return takeAllocation{}, math.Dec{}, err
+	}
+
+	taken := amount
+	if balance.Cmp(amount) <= 0 {
+		taken = balance
+	}
+
+	remaining, err := amount.Sub(taken)
+	if err != nil {
+		return takeAllocation{}, math.Dec{}, err
+	}
+
+	return takeAllocation{
+		basketBalance: basketBalance,
+		balance:       balance,
+		amount:        taken,
+	}, remaining, nil
+}
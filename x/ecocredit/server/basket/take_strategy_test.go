@@ -0,0 +1,165 @@
+package basket_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket"
+)
+
+const (
+	takeBatchDenom1 = "C01-001-20200101-20210101-001"
+	takeBatchDenom2 = "C01-001-20210101-20220101-002"
+	takeBatchDenom3 = "C01-001-20220101-20230101-003"
+)
+
+// takeStrategySetup creates a basket with a take premium of 100 basis points
+// holding 2, 3 and 5 credits from three batches with increasing start dates.
+func takeStrategySetup(t *testing.T) *baseSuite {
+	s := setupBase(t)
+	insertBasket(t, s, curatedBasketDenom, "NCT", "C", nil, []string{"C01"})
+
+	basket, err := s.stateStore.BasketTable().GetByBasketDenom(s.ctx, curatedBasketDenom)
+	require.NoError(t, err)
+	basket.DisableAutoRetire = true
+	basket.TakePremiumBps = 100
+	require.NoError(t, s.stateStore.BasketTable().Update(s.ctx, basket))
+
+	batches := []struct {
+		denom     string
+		startYear int
+		balance   string
+	}{
+		{takeBatchDenom1, 2020, "2"},
+		{takeBatchDenom2, 2021, "3"},
+		{takeBatchDenom3, 2022, "5"},
+	}
+	for _, batch := range batches {
+		startDate := timestamppb.New(time.Date(batch.startYear, 1, 1, 0, 0, 0, 0, time.UTC))
+		initBatch(t, s, 1, batch.denom, startDate)
+		require.NoError(t, s.stateStore.BasketBalanceTable().Insert(s.ctx, &api.BasketBalance{
+			BasketId:       basket.Id,
+			BatchDenom:     batch.denom,
+			Balance:        batch.balance,
+			BatchStartDate: startDate,
+		}))
+	}
+
+	s.bankKeeper.EXPECT().GetBalance(gmAny, gmAny, curatedBasketDenom).
+		Return(sdk.NewInt64Coin(curatedBasketDenom, 100000000)).AnyTimes()
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gmAny, gmAny, gmAny, gmAny).Return(nil).AnyTimes()
+	s.bankKeeper.EXPECT().BurnCoins(gmAny, gmAny, gmAny).Return(nil).AnyTimes()
+
+	return s
+}
+
+func takeStrategyMsg(s *baseSuite, amount string, strategy baskettypes.TakeStrategy, batchDenoms ...string) *baskettypes.MsgTake {
+	return &baskettypes.MsgTake{
+		Owner:       s.addrs[1].String(),
+		BasketDenom: curatedBasketDenom,
+		Amount:      amount,
+		Strategy:    strategy,
+		BatchDenoms: batchDenoms,
+	}
+}
+
+func assertTakenCredits(t *testing.T, credits []*baskettypes.BasketCredit, expected [][2]string) {
+	require.Len(t, credits, len(expected))
+	for i, credit := range credits {
+		require.Equal(t, expected[i][0], credit.BatchDenom)
+		amount, err := math.NewDecFromString(credit.Amount)
+		require.NoError(t, err)
+		expectedAmount, err := math.NewDecFromString(expected[i][1])
+		require.NoError(t, err)
+		require.True(t, amount.Equal(expectedAmount), "expected %s, got %s", expected[i][1], credit.Amount)
+	}
+}
+
+func TestTake_OldestFirst(t *testing.T) {
+	t.Parallel()
+	s := takeStrategySetup(t)
+
+	res, err := s.k.Take(s.ctx, takeStrategyMsg(s, "4000000", baskettypes.TakeStrategy_TAKE_STRATEGY_OLDEST_FIRST))
+	require.NoError(t, err)
+	require.Empty(t, res.Premium)
+	assertTakenCredits(t, res.Credits, [][2]string{{takeBatchDenom1, "2"}, {takeBatchDenom2, "2"}})
+
+	found, err := s.stateStore.BasketBalanceTable().Has(s.ctx, 1, takeBatchDenom1)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	balance, err := s.stateStore.BasketBalanceTable().Get(s.ctx, 1, takeBatchDenom2)
+	require.NoError(t, err)
+	require.Equal(t, "1.000000", balance.Balance)
+}
+
+func TestTake_NewestFirst(t *testing.T) {
+	t.Parallel()
+	s := takeStrategySetup(t)
+
+	premium := sdk.NewCoins(sdk.NewInt64Coin(curatedBasketDenom, 40000))
+	s.bankKeeper.EXPECT().SendCoins(gmAny, s.addrs[1], s.addrs[0], premium).Return(nil).Times(1)
+
+	res, err := s.k.Take(s.ctx, takeStrategyMsg(s, "4000000", baskettypes.TakeStrategy_TAKE_STRATEGY_NEWEST_FIRST))
+	require.NoError(t, err)
+	require.Equal(t, "40000", res.Premium)
+	assertTakenCredits(t, res.Credits, [][2]string{{takeBatchDenom3, "4"}})
+}
+
+func TestTake_Proportional(t *testing.T) {
+	t.Parallel()
+	s := takeStrategySetup(t)
+	s.bankKeeper.EXPECT().SendCoins(gmAny, gmAny, gmAny, gmAny).Return(nil).Times(1)
+
+	res, err := s.k.Take(s.ctx, takeStrategyMsg(s, "4000000", baskettypes.TakeStrategy_TAKE_STRATEGY_PROPORTIONAL))
+	require.NoError(t, err)
+	assertTakenCredits(t, res.Credits, [][2]string{
+		{takeBatchDenom1, "0.8"},
+		{takeBatchDenom2, "1.2"},
+		{takeBatchDenom3, "2"},
+	})
+
+	// the remainder of shares too small to represent is taken oldest first and
+	// no premium is charged on an amount too small to carry one
+	res, err = s.k.Take(s.ctx, takeStrategyMsg(s, "1", baskettypes.TakeStrategy_TAKE_STRATEGY_PROPORTIONAL))
+	require.NoError(t, err)
+	require.Empty(t, res.Premium)
+	assertTakenCredits(t, res.Credits, [][2]string{{takeBatchDenom1, "0.000001"}})
+}
+
+func TestTake_SelectedBatches(t *testing.T) {
+	t.Parallel()
+	s := takeStrategySetup(t)
+	s.bankKeeper.EXPECT().SendCoins(gmAny, gmAny, gmAny, gmAny).Return(nil).AnyTimes()
+
+	_, err := s.k.Take(s.ctx, takeStrategyMsg(s, "3000000",
+		baskettypes.TakeStrategy_TAKE_STRATEGY_SELECTED_BATCHES, takeBatchDenom1))
+	require.ErrorContains(t, err, "selected batches have 1.000000 fewer credits than requested")
+
+	_, err = s.k.Take(s.ctx, takeStrategyMsg(s, "3000000",
+		baskettypes.TakeStrategy_TAKE_STRATEGY_SELECTED_BATCHES, "C01-001-20230101-20240101-004"))
+	require.ErrorContains(t, err, "credit batch C01-001-20230101-20240101-004 not found in basket")
+
+	res, err := s.k.Take(s.ctx, takeStrategyMsg(s, "6000000",
+		baskettypes.TakeStrategy_TAKE_STRATEGY_SELECTED_BATCHES, takeBatchDenom3, takeBatchDenom1))
+	require.NoError(t, err)
+	require.Equal(t, "60000", res.Premium)
+	assertTakenCredits(t, res.Credits, [][2]string{{takeBatchDenom3, "5"}, {takeBatchDenom1, "1"}})
+}
+
+func TestTake_MaxPremium(t *testing.T) {
+	t.Parallel()
+	s := takeStrategySetup(t)
+
+	msg := takeStrategyMsg(s, "4000000", baskettypes.TakeStrategy_TAKE_STRATEGY_NEWEST_FIRST)
+	msg.MaxPremium = "39999"
+	_, err := s.k.Take(s.ctx, msg)
+	require.ErrorContains(t, err, "take premium 40000 exceeds max premium 39999")
+}
@@ -3,6 +3,10 @@ package basket
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
 	"github.com/regen-network/regen-ledger/types/math"
 )
@@ -56,3 +60,28 @@ func (k Keeper) GetBasketBalanceMap(ctx context.Context) (map[uint64]math.Dec, e
 
 	return batchKeyToBalance, nil
 }
+
+// getCuratedBasket gets the basket with the given basket denom and checks that
+// curator is the curator of the basket.
+func (k Keeper) getCuratedBasket(ctx context.Context, basketDenom, curator string) (*api.Basket, error) {
+	curatorAddr, err := sdk.AccAddressFromBech32(curator)
+	if err != nil {
+		return nil, err
+	}
+
+	basket, err := k.stateStore.BasketTable().GetByBasketDenom(ctx, basketDenom)
+	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			return nil, sdkerrors.ErrNotFound.Wrapf("basket %s not found", basketDenom)
+		}
+		return nil, err
+	}
+
+	if !curatorAddr.Equals(sdk.AccAddress(basket.Curator)) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"%s is not the curator of basket %s", curator, basketDenom,
+		)
+	}
+
+	return basket, nil
+}
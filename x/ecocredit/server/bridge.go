@@ -0,0 +1,15 @@
+package server
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PruneBridgeAttestations removes pending bridge attestations that have expired.
+func (s serverImpl) PruneBridgeAttestations(ctx sdk.Context) error {
+	return s.coreKeeper.PruneBridgeAttestations(sdk.WrapSDKContext(ctx))
+}
+
+// ExpireOutboundBridgeRequests refunds pending outbound bridge requests that have timed out.
+func (s serverImpl) ExpireOutboundBridgeRequests(ctx sdk.Context) error {
+	return s.coreKeeper.ExpireOutboundBridgeRequests(sdk.WrapSDKContext(ctx))
+}
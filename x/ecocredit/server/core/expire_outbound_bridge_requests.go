@@ -0,0 +1,59 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+)
+
+// ExpireOutboundBridgeRequests is a BeginBlock function that marks pending outbound bridge requests
+// that have not been acknowledged before their expiration as failed and refunds the credits to the
+// owner.
+func (k Keeper) ExpireOutboundBridgeRequests(ctx context.Context) error {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	// requests without an expiration are indexed with the 0 value timestamp and never expire
+	fromKey := api.OutboundBridgeRequestExpirationIndexKey{}.WithExpiration(timestamppb.New(time.Unix(0, 1)))
+	toKey := api.OutboundBridgeRequestExpirationIndexKey{}.WithExpiration(timestamppb.New(blockTime))
+
+	it, err := k.stateStore.OutboundBridgeRequestTable().ListRange(ctx, fromKey, toKey)
+	if err != nil {
+		return err
+	}
+
+	// collect requests before updating them to avoid modifying the table while iterating
+	var expired []*api.OutboundBridgeRequest
+	for it.Next() {
+		request, err := it.Value()
+		if err != nil {
+			it.Close()
+			return err
+		}
+		expired = append(expired, request)
+	}
+	it.Close()
+
+	for _, request := range expired {
+		batch, err := k.stateStore.BatchTable().Get(ctx, request.BatchKey)
+		if err != nil {
+			return err
+		}
+
+		request.Status = api.OutboundBridgeStatus_OUTBOUND_BRIDGE_STATUS_FAILED
+		request.Expiration = nil
+		if err = k.stateStore.OutboundBridgeRequestTable().Update(ctx, request); err != nil {
+			return err
+		}
+
+		if err = k.refundOutboundBridgeRequest(ctx, request, batch); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
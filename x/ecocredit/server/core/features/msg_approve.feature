@@ -0,0 +1,59 @@
+Feature: Msg/Approve
+
+  An owner can approve an operator to send or retire credits on their behalf:
+  - when the credit batch or credit class exists
+  - when the decimal places in amount does not exceed credit type precision
+  - when the expiration is in the future
+  - the allowance is created or replaced
+
+  Background:
+    Given a credit type with abbreviation "C" and precision "6"
+    And a credit batch from credit class with credit type "C"
+
+  Rule: The credit batch or credit class must exist
+
+    Scenario: the credit batch exists
+      When alice attempts to approve bob with batch denom "C01-001-20200101-20210101-001" and amount "10"
+      Then expect no error
+
+    Scenario: the credit batch does not exist
+      When alice attempts to approve bob with batch denom "C01-001-20200101-20210101-002" and amount "10"
+      Then expect the error "could not get batch with denom C01-001-20200101-20210101-002: not found: invalid request"
+
+    Scenario: the credit class exists
+      When alice attempts to approve bob with class id "C01" and amount "10"
+      Then expect no error
+
+    Scenario: the credit class does not exist
+      When alice attempts to approve bob with class id "C02" and amount "10"
+      Then expect the error "could not get class with id C02: not found: invalid request"
+
+  Rule: The decimal places in amount must not exceed credit type precision
+
+    Scenario: the decimal places in amount is equal to credit type precision
+      When alice attempts to approve bob with batch denom "C01-001-20200101-20210101-001" and amount "9.123456"
+      Then expect no error
+
+    Scenario: the decimal places in amount is greater than credit type precision
+      When alice attempts to approve bob with batch denom "C01-001-20200101-20210101-001" and amount "9.1234567"
+      Then expect the error "amount: 9.1234567 exceeds maximum decimal places: 6: invalid request"
+
+  Rule: The expiration must be in the future
+
+    Background:
+      Given the block time "2020-01-01"
+
+    Scenario: the expiration is in the future
+      When alice attempts to approve bob with expiration "2020-01-02"
+      Then expect no error
+
+    Scenario: the expiration is in the past
+      When alice attempts to approve bob with expiration "2019-12-31"
+      Then expect the error "expiration must be in the future: 2019-12-31 00:00:00 +0000 UTC: invalid request"
+
+  Rule: An existing allowance is replaced
+
+    Scenario: the allowance is replaced
+      Given alice has approved bob with batch denom "C01-001-20200101-20210101-001" and amount "10"
+      When alice attempts to approve bob with batch denom "C01-001-20200101-20210101-001" and amount "5"
+      Then expect bob allowance amount "5"
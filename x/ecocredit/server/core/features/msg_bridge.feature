@@ -2,6 +2,7 @@ Feature: Msg/Bridge
 
   Credits can be bridged to another chain:
   - when a batch contract entry exists
+  - when the credit batch is not frozen
   - the credits are cancelled and the owner balance is updated
   - the credits are cancelled and the total supply is updated
 
@@ -19,6 +20,21 @@ Feature: Msg/Bridge
       When alice attempts to bridge credits from the credit batch
       Then expect the error "only credits previously bridged from another chain are supported: invalid request"
 
+  Rule: The credit batch must not be frozen
+
+    Background:
+      Given a credit batch exists with a batch contract entry
+      And alice owns tradable credits from the credit batch
+
+    Scenario: the credit batch is not frozen
+      When alice attempts to bridge credits from the credit batch
+      Then expect no error
+
+    Scenario: the credit batch is frozen
+      Given the credit batch is frozen
+      When alice attempts to bridge credits from the credit batch
+      Then expect the error "C01-001-20200101-20210101-001: credit batch is frozen"
+
   Rule: The credits are cancelled and the owner balance is updated
 
     Scenario: the owner balance is updated
@@ -2,6 +2,7 @@ Feature: Msg/Cancel
 
   Credits can be cancelled by the owner:
   - when the credit batch exists
+  - when the credit batch is not frozen
   - when the owner has a tradable credit balance greater than or equal to the amount to cancel
   - when the decimal places in amount to cancel does not exceed credit type precision
   - the owner credit balance is updated
@@ -19,6 +20,21 @@ Feature: Msg/Cancel
       When alice attempts to cancel credits with batch denom "C01-001-20200101-20210101-001"
       Then expect the error "could not get batch with denom C01-001-20200101-20210101-001: not found: invalid request"
 
+  Rule: The credit batch must not be frozen
+
+    Background:
+      Given a credit batch
+      And alice owns tradable credit amount "10"
+
+    Scenario: the credit batch is not frozen
+      When alice attempts to cancel credit amount "5"
+      Then expect no error
+
+    Scenario: the credit batch is frozen
+      Given the credit batch is frozen
+      When alice attempts to cancel credit amount "5"
+      Then expect the error "C01-001-20200101-20210101-001: credit batch is frozen"
+
   Rule: The owner must have a tradable credit balance greater that or equal to the amount to cancel
 
     Background:
@@ -2,6 +2,7 @@ Feature: Msg/CreateBatch
 
   Credits can be issued in batches:
   - when the project exists
+  - when the project is active
   - when the issuer is an allowed credit class issuer
   - when the decimal places in issuance amount does not exceed credit type precision
   - when the origin tx is unique within the scope of a credit class
@@ -28,6 +29,29 @@ Feature: Msg/CreateBatch
       When alice attempts to create a batch with project id "C01-002"
       Then expect the error "could not get project with id C01-002: not found: invalid request"
 
+  Rule: The project must be active
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and issuer alice
+      And a project with project id "C01-001"
+
+    Scenario: the project is active
+      When alice attempts to create a batch with project id "C01-001"
+      Then expect no error
+
+    Scenario Outline: the project is not active
+      Given project "C01-001" has status "<status>"
+      When alice attempts to create a batch with project id "C01-001"
+      Then expect the error "project C01-001 is not active: <status>: invalid request"
+
+      Examples:
+        | status                   |
+        | PROJECT_STATUS_PROPOSED  |
+        | PROJECT_STATUS_ACCEPTED  |
+        | PROJECT_STATUS_SUSPENDED |
+        | PROJECT_STATUS_RETIRED   |
+
   Rule: The issuer must be an allowed credit class issuer
 
     Background:
@@ -254,6 +278,30 @@ Feature: Msg/CreateBatch
       }
       """
 
+    Scenario: balance updated from issuance with locked amount
+      When alice attempts to create a batch with project id "C01-001" and issuance
+      """
+      [
+        {
+          "recipient": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+          "tradable_amount": "100",
+          "locked_amount": "50",
+          "lock_schedule": {
+            "start_time": "2030-01-01T00:00:00Z"
+          }
+        }
+      ]
+      """
+      Then expect recipient batch balance with address "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+      """
+      {
+        "tradable_amount": "100",
+        "retired_amount": "0",
+        "escrowed_amount": "0",
+        "locked_amount": "50"
+      }
+      """
+
     # no failing scenario - state transitions only occur upon successful message execution
 
   Rule: The batch supply is updated
@@ -313,6 +361,46 @@ Feature: Msg/CreateBatch
 
     # no failing scenario - state transitions only occur upon successful message execution
 
+  Rule: The buffer pool share of the tradable amount is withheld
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and issuer alice
+      And a project with project id "C01-001"
+      And a class buffer percentage of "10"
+
+    Scenario: buffer pool share withheld from issuance
+      When alice attempts to create a batch with project id "C01-001" and issuance
+      """
+      [
+        {
+          "recipient": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+          "tradable_amount": "100.000005",
+          "retired_amount": "100",
+          "retirement_jurisdiction": "US-WA"
+        }
+      ]
+      """
+      Then expect recipient batch balance with address "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+      """
+      {
+        "tradable_amount": "90.000005",
+        "retired_amount": "100",
+        "escrowed_amount": "0"
+      }
+      """
+      And expect buffer pool batch balance with tradable amount "10"
+      And expect batch supply
+      """
+      {
+        "tradable_amount": "100.000005",
+        "retired_amount": "100",
+        "cancelled_amount": "0"
+      }
+      """
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
   Rule: the batch sequence is updated
 
     Background:
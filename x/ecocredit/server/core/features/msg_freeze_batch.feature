@@ -0,0 +1,89 @@
+Feature: Msg/FreezeBatch
+
+  A credit batch can be frozen:
+  - when the credit batch exists
+  - when the admin is the admin of the credit class
+  - when the credit batch is not already frozen
+  - the credit batch is frozen
+  - credits from a frozen credit batch cannot be sent or retired
+
+  Rule: The credit batch must exist
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+      And a project with project id "C01-001"
+      And a credit batch with batch denom "C01-001-20200101-20210101-001"
+
+    Scenario: the credit batch exists
+      When alice attempts to freeze batch "C01-001-20200101-20210101-001"
+      Then expect no error
+
+    Scenario: the credit batch does not exist
+      When alice attempts to freeze batch "C01-001-20200101-20210101-002"
+      Then expect the error "could not get batch with denom C01-001-20200101-20210101-002: not found: invalid request"
+
+  Rule: The admin must be the admin of the credit class
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+      And a project with project id "C01-001"
+      And a credit batch with batch denom "C01-001-20200101-20210101-001"
+
+    Scenario: the admin is the admin of the credit class
+      When alice attempts to freeze batch "C01-001-20200101-20210101-001"
+      Then expect no error
+
+    Scenario: the admin is not the admin of the credit class
+      When bob attempts to freeze batch "C01-001-20200101-20210101-001"
+      Then expect error contains "is not the admin of credit class C01: unauthorized"
+
+  Rule: The credit batch must not already be frozen
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+      And a project with project id "C01-001"
+      And a credit batch with batch denom "C01-001-20200101-20210101-001"
+
+    Scenario: the credit batch is not frozen
+      When alice attempts to freeze batch "C01-001-20200101-20210101-001"
+      Then expect no error
+
+    Scenario: the credit batch is already frozen
+      Given alice froze batch "C01-001-20200101-20210101-001"
+      When alice attempts to freeze batch "C01-001-20200101-20210101-001"
+      Then expect the error "credit batch C01-001-20200101-20210101-001 is already frozen: invalid request"
+
+  Rule: The credit batch is frozen
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+      And a project with project id "C01-001"
+      And a credit batch with batch denom "C01-001-20200101-20210101-001"
+
+    Scenario: the credit batch is frozen
+      When alice attempts to freeze batch "C01-001-20200101-20210101-001"
+      Then expect batch "C01-001-20200101-20210101-001" to be frozen
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: Credits from a frozen credit batch cannot be sent or retired
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+      And a project with project id "C01-001"
+      And a credit batch with batch denom "C01-001-20200101-20210101-001"
+      And alice has a tradable balance of "10" in batch "C01-001-20200101-20210101-001"
+      And alice froze batch "C01-001-20200101-20210101-001"
+
+    Scenario: credits from a frozen credit batch cannot be sent
+      When alice attempts to send "5" credits from batch "C01-001-20200101-20210101-001" to bob
+      Then expect the error "C01-001-20200101-20210101-001: credit batch is frozen"
+
+    Scenario: credits from a frozen credit batch cannot be retired
+      When alice attempts to retire "5" credits from batch "C01-001-20200101-20210101-001"
+      Then expect the error "C01-001-20200101-20210101-001: credit batch is frozen"
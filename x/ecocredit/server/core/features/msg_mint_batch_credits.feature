@@ -3,6 +3,7 @@ Feature: Msg/MintBatchCredits
   Credits can be dynamically minted to an existing credit batch:
   - when the credit batch exists
   - when the credit batch is open
+  - when the project is active
   - when the issuer is the issuer of the credit batch
   - when the origin tx id and source is unique within the scope of the credit class
   - when the contract is unique within the scope of the credit class
@@ -42,6 +43,23 @@ Feature: Msg/MintBatchCredits
       When alice attempts to mint credits with batch denom "C01-001-20200101-20210101-001"
       Then expect no error
 
+  Rule: The project must be active
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with id "C01" and issuer alice
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20210101-001" and issuer alice
+
+    Scenario: the project is active
+      When alice attempts to mint credits with batch denom "C01-001-20200101-20210101-001"
+      Then expect no error
+
+    Scenario: the project is not active
+      Given project "C01-001" has status "PROJECT_STATUS_SUSPENDED"
+      When alice attempts to mint credits with batch denom "C01-001-20200101-20210101-001"
+      Then expect the error "project C01-001 is not active: PROJECT_STATUS_SUSPENDED: invalid request"
+
   Rule: The issuer must be the issuer of the credit batch
 
     Background:
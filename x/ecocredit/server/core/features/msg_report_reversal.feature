@@ -0,0 +1,59 @@
+Feature: Msg/ReportReversal
+
+  A reversal can be reported against a project:
+  - when the project exists
+  - when the admin is the admin of the credit class of the project
+  - when the credit batch is within the credit class of the project
+  - when the buffer pool has enough tradable credits from the credit batch
+  - the buffer pool credits are cancelled
+  - the reversal is recorded
+
+  Background:
+    Given a credit type with abbreviation "C" and precision "6"
+    And a credit class with class id "C01" and admin alice
+    And a project with project id "C01-001"
+    And a credit batch with denom "C01-001-20200101-20210101-001" and a buffer pool balance of "10"
+
+  Rule: The project must exist
+
+    Scenario: the project exists
+      When alice attempts to report a reversal against project "C01-001" with amount "5"
+      Then expect no error
+
+    Scenario: the project does not exist
+      When alice attempts to report a reversal against project "C01-002" with amount "5"
+      Then expect the error "could not get project with id C01-002: not found: invalid request"
+
+  Rule: The admin must be the admin of the credit class
+
+    Scenario: the admin is not the admin of the credit class
+      When bob attempts to report a reversal against project "C01-001" with amount "5"
+      Then expect error contains "is not the admin of credit class C01: unauthorized"
+
+  Rule: The credit batch must be within the credit class of the project
+
+    Scenario: the credit batch is within a different credit class
+      Given a credit class with class id "C02" and admin alice
+      And a project with project id "C02-001"
+      When alice attempts to report a reversal against project "C02-001" with amount "5"
+      Then expect the error "credit batch C01-001-20200101-20210101-001 is not within credit class C02: invalid request"
+
+  Rule: The buffer pool must have enough tradable credits
+
+    Scenario: the buffer pool has enough tradable credits
+      When alice attempts to report a reversal against project "C01-001" with amount "10"
+      Then expect no error
+
+    Scenario: the buffer pool does not have enough tradable credits
+      When alice attempts to report a reversal against project "C01-001" with amount "11"
+      Then expect the error "buffer pool tradable balance: 10, cancel amount 11: insufficient credit balance"
+
+  Rule: The buffer pool credits are cancelled and the reversal is recorded
+
+    Scenario: the buffer pool credits are cancelled
+      When alice attempts to report a reversal against project "C01-001" with amount "4"
+      Then expect a buffer pool tradable balance of "6"
+      And expect batch supply with tradable amount "16" and cancelled amount "4"
+      And expect a reversal against project "C01-001" with amount "4"
+
+    # no failing scenario - state transitions only occur upon successful message execution
@@ -6,6 +6,8 @@ Feature: Msg/Retire
   - when the decimal places in amount to cancel does not exceed credit type precision
   - the owner credit balance is updated
   - the batch supply is updated
+  - a retirement record is created
+  - the retired credits are attributed to the beneficiary if set
 
   Rule: The credit batch must exist
 
@@ -106,3 +108,52 @@ Feature: Msg/Retire
       """
 
     # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: A retirement record is created
+
+    Scenario: a retirement record is created
+      Given a credit batch
+      And alice owns tradable credit amount "10"
+      When alice attempts to retire credit amount "10" with reason "offsetting emissions from business travel"
+      Then expect retirement record
+      """
+      {
+        "id": 1,
+        "amount": "10",
+        "reason": "offsetting emissions from business travel"
+      }
+      """
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: The retired credits are attributed to the beneficiary
+
+    Scenario: the beneficiary balance is updated
+      Given a credit batch
+      And alice has the batch balance
+      """
+      {
+        "retired_amount": "0",
+        "tradable_amount": "10",
+        "escrowed_amount": "0"
+      }
+      """
+      When alice attempts to retire credit amount "10" on behalf of bob
+      Then expect alice batch balance
+      """
+      {
+        "retired_amount": "0",
+        "tradable_amount": "0",
+        "escrowed_amount": "0"
+      }
+      """
+      And expect bob batch balance
+      """
+      {
+        "retired_amount": "10",
+        "tradable_amount": "0",
+        "escrowed_amount": "0"
+      }
+      """
+
+    # no failing scenario - state transitions only occur upon successful message execution
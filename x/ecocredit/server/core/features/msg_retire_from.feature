@@ -0,0 +1,40 @@
+Feature: Msg/RetireFrom
+
+  Credits can be retired by an operator on behalf of the owner:
+  - when the operator has an allowance for the credit batch or the credit class
+  - when the allowance is greater than or equal to the amount to retire
+  - the allowance is updated
+  - the owner credit balance is updated
+  - a retirement record is created for the owner
+
+  Background:
+    Given a credit batch
+    And alice owns tradable credit amount "20"
+
+  Rule: The operator must have an allowance
+
+    Scenario: the operator has an allowance for the credit class
+      Given alice has approved bob for the credit class with amount "10"
+      When bob attempts to retire credit amount "4" from alice
+      Then expect no error
+      And expect bob class allowance amount "6"
+      And expect alice batch balance
+      """
+      {
+        "tradable_amount": "16",
+        "retired_amount": "4",
+        "escrowed_amount": "0"
+      }
+      """
+      And expect retirement record for alice with amount "4"
+
+    Scenario: the operator does not have an allowance
+      When bob attempts to retire credit amount "4" from alice
+      Then expect error contains "has no allowance"
+
+  Rule: The allowance must be greater than or equal to the amount to retire
+
+    Scenario: the allowance is less than the amount to retire
+      Given alice has approved bob for the credit class with amount "10"
+      When bob attempts to retire credit amount "15" from alice
+      Then expect the error "allowance: 10, spend amount 15: insufficient allowance: unauthorized"
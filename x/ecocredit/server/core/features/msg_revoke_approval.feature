@@ -0,0 +1,20 @@
+Feature: Msg/RevokeApproval
+
+  An owner can revoke an allowance previously granted to an operator:
+  - when the allowance exists
+  - the allowance is removed
+
+  Background:
+    Given a credit batch with denom "C01-001-20200101-20210101-001"
+
+  Rule: The allowance must exist
+
+    Scenario: the allowance exists
+      Given alice has approved bob with batch denom "C01-001-20200101-20210101-001" and amount "10"
+      When alice attempts to revoke the approval of bob with batch denom "C01-001-20200101-20210101-001"
+      Then expect no error
+      And expect bob has no allowance
+
+    Scenario: the allowance does not exist
+      When alice attempts to revoke the approval of bob with batch denom "C01-001-20200101-20210101-001"
+      Then expect error contains "not found"
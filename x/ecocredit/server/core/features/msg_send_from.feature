@@ -0,0 +1,75 @@
+Feature: Msg/SendFrom
+
+  Credits can be sent by an operator on behalf of the owner:
+  - when the operator has an allowance for the credit batch or the credit class
+  - when the allowance has not expired
+  - when the allowance is greater than or equal to the amount to send
+  - the allowance is updated and removed once fully spent
+  - the owner credit balance is updated
+  - the recipient credit balance is updated
+
+  Background:
+    Given a credit batch
+    And alice owns tradable credit amount "20"
+
+  Rule: The operator must have an allowance
+
+    Scenario: the operator has an allowance for the credit batch
+      Given alice has approved bob for the credit batch with amount "10"
+      When bob attempts to send credits from alice to bob with tradable amount "5"
+      Then expect no error
+      And expect bob allowance amount "5"
+      And expect alice batch balance
+      """
+      {
+        "tradable_amount": "15",
+        "retired_amount": "0",
+        "escrowed_amount": "0"
+      }
+      """
+      And expect bob batch balance
+      """
+      {
+        "tradable_amount": "5",
+        "retired_amount": "0",
+        "escrowed_amount": "0"
+      }
+      """
+
+    Scenario: the operator has an allowance for the credit class
+      Given alice has approved bob for the credit class with amount "10"
+      When bob attempts to send credits from alice to bob with tradable amount "5"
+      Then expect no error
+
+    Scenario: the operator does not have an allowance
+      When bob attempts to send credits from alice to bob with tradable amount "5"
+      Then expect error contains "has no allowance"
+
+  Rule: The allowance must not be expired
+
+    Background:
+      Given alice has approved bob for the credit batch with amount "10" and expiration "2020-01-02"
+
+    Scenario: the allowance has not expired
+      Given the block time "2020-01-01"
+      When bob attempts to send credits from alice to bob with tradable amount "5"
+      Then expect no error
+
+    Scenario: the allowance has expired
+      Given the block time "2020-01-02"
+      When bob attempts to send credits from alice to bob with tradable amount "5"
+      Then expect error contains "expired"
+
+  Rule: The allowance must be greater than or equal to the amount to send
+
+    Background:
+      Given alice has approved bob for the credit batch with amount "10"
+
+    Scenario: the allowance is fully spent
+      When bob attempts to send credits from alice to bob with retired amount "10"
+      Then expect no error
+      And expect bob has no allowance
+
+    Scenario: the allowance is less than the amount to send
+      When bob attempts to send credits from alice to bob with tradable amount "15"
+      Then expect the error "allowance: 10, spend amount 15: insufficient allowance: unauthorized"
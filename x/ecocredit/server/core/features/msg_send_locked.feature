@@ -0,0 +1,57 @@
+Feature: Msg/SendLocked
+
+  Credits can be sent to another account and locked:
+  - when the credit batch exists
+  - when the sender has a tradable credit balance greater than or equal to the amount to send
+  - the sender tradable balance is updated
+  - the recipient locked balance is updated
+  - a lock is created for the recipient
+
+  Background:
+    Given a credit batch
+    And alice owns tradable credit amount "20"
+
+  Rule: The sender must have a tradable credit balance greater than or equal to the amount to send
+
+    Scenario: sender has tradable balance greater than amount to send
+      When alice attempts to send locked credit amount "5" to bob
+      Then expect no error
+      And expect alice batch balance
+      """
+      {
+        "tradable_amount": "15",
+        "retired_amount": "0",
+        "escrowed_amount": "0",
+        "locked_amount": ""
+      }
+      """
+      And expect bob batch balance
+      """
+      {
+        "tradable_amount": "0",
+        "retired_amount": "0",
+        "escrowed_amount": "0",
+        "locked_amount": "5"
+      }
+      """
+      And expect bob lock with amount "5"
+
+    Scenario: sender has tradable balance less than amount to send
+      When alice attempts to send locked credit amount "25" to bob
+      Then expect the error "tradable balance: 20, send amount 25: insufficient credit balance"
+
+  Rule: Locked credits are added to an existing locked balance
+
+    Scenario: recipient has an existing locked balance
+      Given alice has sent locked credit amount "5" to bob
+      When alice attempts to send locked credit amount "5" to bob
+      Then expect no error
+      And expect bob batch balance
+      """
+      {
+        "tradable_amount": "0",
+        "retired_amount": "0",
+        "escrowed_amount": "0",
+        "locked_amount": "10"
+      }
+      """
@@ -0,0 +1,80 @@
+Feature: Msg/UnfreezeBatch
+
+  A credit batch can be unfrozen:
+  - when the credit batch exists
+  - when the admin is the admin of the credit class
+  - when the credit batch is frozen
+  - the credit batch is unfrozen
+  - credits from an unfrozen credit batch can be sent again
+
+  Rule: The credit batch must exist
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+      And a project with project id "C01-001"
+      And a credit batch with batch denom "C01-001-20200101-20210101-001"
+      And alice froze batch "C01-001-20200101-20210101-001"
+
+    Scenario: the credit batch exists
+      When alice attempts to unfreeze batch "C01-001-20200101-20210101-001"
+      Then expect no error
+
+    Scenario: the credit batch does not exist
+      When alice attempts to unfreeze batch "C01-001-20200101-20210101-002"
+      Then expect the error "could not get batch with denom C01-001-20200101-20210101-002: not found: invalid request"
+
+  Rule: The admin must be the admin of the credit class
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+      And a project with project id "C01-001"
+      And a credit batch with batch denom "C01-001-20200101-20210101-001"
+      And alice froze batch "C01-001-20200101-20210101-001"
+
+    Scenario: the admin is the admin of the credit class
+      When alice attempts to unfreeze batch "C01-001-20200101-20210101-001"
+      Then expect no error
+
+    Scenario: the admin is not the admin of the credit class
+      When bob attempts to unfreeze batch "C01-001-20200101-20210101-001"
+      Then expect error contains "is not the admin of credit class C01: unauthorized"
+
+  Rule: The credit batch must be frozen
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+      And a project with project id "C01-001"
+      And a credit batch with batch denom "C01-001-20200101-20210101-001"
+
+    Scenario: the credit batch is frozen
+      Given alice froze batch "C01-001-20200101-20210101-001"
+      When alice attempts to unfreeze batch "C01-001-20200101-20210101-001"
+      Then expect no error
+
+    Scenario: the credit batch is not frozen
+      When alice attempts to unfreeze batch "C01-001-20200101-20210101-001"
+      Then expect the error "credit batch C01-001-20200101-20210101-001 is not frozen: invalid request"
+
+  Rule: The credit batch is unfrozen
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+      And a project with project id "C01-001"
+      And a credit batch with batch denom "C01-001-20200101-20210101-001"
+      And alice has a tradable balance of "10" in batch "C01-001-20200101-20210101-001"
+      And alice froze batch "C01-001-20200101-20210101-001"
+
+    Scenario: the credit batch is unfrozen
+      When alice attempts to unfreeze batch "C01-001-20200101-20210101-001"
+      Then expect batch "C01-001-20200101-20210101-001" to not be frozen
+
+    Scenario: credits from an unfrozen credit batch can be sent
+      Given alice unfroze batch "C01-001-20200101-20210101-001"
+      When alice attempts to send "5" credits from batch "C01-001-20200101-20210101-001" to bob
+      Then expect no error
+
+    # no failing scenario - state transitions only occur upon successful message execution
@@ -0,0 +1,83 @@
+Feature: Msg/UpdateBatchMetadata
+
+  The metadata of a credit batch can be updated:
+  - when the credit batch exists
+  - when the issuer is the issuer of the credit batch
+  - when the credit batch is open
+  - the credit batch metadata is updated
+  - the metadata change is recorded
+
+  Rule: The credit batch must exist
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with id "C01" and issuer alice
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20210101-001" and issuer alice
+
+    Scenario: the credit batch exists
+      When alice attempts to update batch metadata with denom "C01-001-20200101-20210101-001"
+      Then expect no error
+
+    Scenario: the credit batch does not exist
+      When alice attempts to update batch metadata with denom "C01-001-20200101-20210101-002"
+      Then expect the error "could not get batch with denom C01-001-20200101-20210101-002: not found: invalid request"
+
+  Rule: The issuer must be the issuer of the credit batch
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with id "C01" and issuer alice
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20210101-001" and issuer alice
+
+    Scenario: the issuer is the credit batch issuer
+      When alice attempts to update batch metadata with denom "C01-001-20200101-20210101-001"
+      Then expect no error
+
+    Scenario: the issuer is not the credit batch issuer
+      When bob attempts to update batch metadata with denom "C01-001-20200101-20210101-001"
+      Then expect the error "only the batch issuer can update the batch metadata: unauthorized"
+
+  Rule: The credit batch must be open
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with id "C01" and issuer alice
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20210101-001" and issuer alice
+
+    Scenario: the credit batch is open
+      When alice attempts to update batch metadata with denom "C01-001-20200101-20210101-001"
+      Then expect no error
+
+    Scenario: the credit batch is sealed
+      Given the credit batch is sealed
+      When alice attempts to update batch metadata with denom "C01-001-20200101-20210101-001"
+      Then expect the error "credit batch C01-001-20200101-20210101-001 is not open: invalid request"
+
+  Rule: The credit batch metadata is updated and the change is recorded
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with id "C01" and issuer alice
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20210101-001" and issuer alice
+
+    Scenario: the credit batch metadata is updated
+      When alice attempts to update batch metadata with denom "C01-001-20200101-20210101-001" and new metadata
+      """
+      regen:13toVgf5aZqSVSeJQv562xkkeoe3rr3bJWa29PHVKVf77VAkVMcDvVd.rdf
+      """
+      Then expect credit batch with denom "C01-001-20200101-20210101-001" and metadata
+      """
+      regen:13toVgf5aZqSVSeJQv562xkkeoe3rr3bJWa29PHVKVf77VAkVMcDvVd.rdf
+      """
+      And expect batch metadata change "1" with previous metadata and metadata
+      """
+
+      regen:13toVgf5aZqSVSeJQv562xkkeoe3rr3bJWa29PHVKVf77VAkVMcDvVd.rdf
+      """
+      And expect batch metadata change "1" signed by alice
+
+    # no failing scenario - state transitions only occur upon successful message execution
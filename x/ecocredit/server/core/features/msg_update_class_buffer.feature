@@ -0,0 +1,57 @@
+Feature: Msg/UpdateClassBuffer
+
+  The buffer percentage of a credit class can be updated:
+  - when the credit class exists
+  - when the admin is the admin of the credit class
+  - the buffer percentage is updated
+  - the buffer percentage is removed when set to zero
+
+  Rule: The credit class must exist
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+
+    Scenario: the credit class exists
+      When alice attempts to update the buffer percentage of class "C01" to "10"
+      Then expect no error
+
+    Scenario: the credit class does not exist
+      When alice attempts to update the buffer percentage of class "C02" to "10"
+      Then expect the error "could not get credit class with id C02: not found: invalid request"
+
+  Rule: The admin must be the admin of the credit class
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+
+    Scenario: the admin is the admin of the credit class
+      When alice attempts to update the buffer percentage of class "C01" to "10"
+      Then expect no error
+
+    Scenario: the admin is not the admin of the credit class
+      When bob attempts to update the buffer percentage of class "C01" to "10"
+      Then expect error contains "is not the admin of credit class C01: unauthorized"
+
+  Rule: The buffer percentage is updated
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+
+    Scenario: the buffer percentage is set
+      When alice attempts to update the buffer percentage of class "C01" to "10"
+      Then expect the buffer percentage of class "C01" to be "10"
+
+    Scenario: the buffer percentage is replaced
+      Given alice updated the buffer percentage of class "C01" to "10"
+      When alice attempts to update the buffer percentage of class "C01" to "12.5"
+      Then expect the buffer percentage of class "C01" to be "12.5"
+
+    Scenario: the buffer percentage is removed
+      Given alice updated the buffer percentage of class "C01" to "10"
+      When alice attempts to update the buffer percentage of class "C01" to "0"
+      Then expect class "C01" to have no buffer percentage
+
+    # no failing scenario - state transitions only occur upon successful message execution
@@ -4,6 +4,7 @@ Feature: Msg/UpdateClassMetadata
   - when the credit class exists
   - when the admin is the admin of the credit class
   - the credit class metadata is updated
+  - the metadata change is recorded
 
   Rule: The credit class must exist
 
@@ -50,3 +51,27 @@ Feature: Msg/UpdateClassMetadata
       """
 
     # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: The metadata change is recorded
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+
+    Scenario: the metadata change is recorded
+      Given alice attempts to update class metadata with class id "C01" and new metadata
+      """
+      regen:13toVgf5aZqSVSeJQv562xkkeoe3rr3bJWa29PHVKVf77VAkVMcDvVd.rdf
+      """
+      When alice attempts to update class metadata with class id "C01" and new metadata
+      """
+      regen:13toVgf5bjcUfo1jMmw2ZJiLNzFyKw5AbQTVWw1BuyMBV5WbAjNNB7e.rdf
+      """
+      Then expect class metadata change "2" with previous metadata and metadata
+      """
+      regen:13toVgf5aZqSVSeJQv562xkkeoe3rr3bJWa29PHVKVf77VAkVMcDvVd.rdf
+      regen:13toVgf5bjcUfo1jMmw2ZJiLNzFyKw5AbQTVWw1BuyMBV5WbAjNNB7e.rdf
+      """
+      And expect class metadata change "2" signed by alice
+
+    # no failing scenario - state transitions only occur upon successful message execution
@@ -4,6 +4,7 @@ Feature: Msg/UpdateProjectMetadata
   - when the project exists
   - when the admin is the admin of the project
   - the project metadata is updated
+  - the metadata change is recorded
 
   Rule: The project must exist
 
@@ -53,3 +54,28 @@ Feature: Msg/UpdateProjectMetadata
       """
 
     # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: The metadata change is recorded
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01"
+      And a project with project id "C01-001" and admin alice
+
+    Scenario: the metadata change is recorded
+      Given alice attempts to update project metadata with project id "C01-001" and new metadata
+      """
+      regen:13toVgf5aZqSVSeJQv562xkkeoe3rr3bJWa29PHVKVf77VAkVMcDvVd.rdf
+      """
+      When alice attempts to update project metadata with project id "C01-001" and new metadata
+      """
+      regen:13toVgf5bjcUfo1jMmw2ZJiLNzFyKw5AbQTVWw1BuyMBV5WbAjNNB7e.rdf
+      """
+      Then expect project metadata change "2" with previous metadata and metadata
+      """
+      regen:13toVgf5aZqSVSeJQv562xkkeoe3rr3bJWa29PHVKVf77VAkVMcDvVd.rdf
+      regen:13toVgf5bjcUfo1jMmw2ZJiLNzFyKw5AbQTVWw1BuyMBV5WbAjNNB7e.rdf
+      """
+      And expect project metadata change "2" signed by alice
+
+    # no failing scenario - state transitions only occur upon successful message execution
@@ -0,0 +1,90 @@
+Feature: Msg/UpdateProjectStatus
+
+  The status of a project can be updated:
+  - when the project exists
+  - when the admin is the admin of the credit class
+  - when the project is not retired
+  - when the status is different from the current status
+  - the project status is updated
+  - the status change is recorded
+
+  Rule: The project must exist
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+      And a project with project id "C01-001"
+
+    Scenario: the project exists
+      When alice attempts to update the status of project "C01-001" to "PROJECT_STATUS_SUSPENDED"
+      Then expect no error
+
+    Scenario: the project does not exist
+      When alice attempts to update the status of project "C01-002" to "PROJECT_STATUS_SUSPENDED"
+      Then expect the error "could not get project with id C01-002: not found: invalid request"
+
+  Rule: The admin must be the admin of the credit class
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+      And a project with project id "C01-001"
+
+    Scenario: the admin is the admin of the credit class
+      When alice attempts to update the status of project "C01-001" to "PROJECT_STATUS_SUSPENDED"
+      Then expect no error
+
+    Scenario: the admin is not the admin of the credit class
+      When bob attempts to update the status of project "C01-001" to "PROJECT_STATUS_SUSPENDED"
+      Then expect error contains "is not the admin of credit class C01: unauthorized"
+
+  Rule: The project must not be retired
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+      And a project with project id "C01-001"
+
+    Scenario: the project is not retired
+      Given alice updated the status of project "C01-001" to "PROJECT_STATUS_SUSPENDED"
+      When alice attempts to update the status of project "C01-001" to "PROJECT_STATUS_ACTIVE"
+      Then expect no error
+
+    Scenario: the project is retired
+      Given alice updated the status of project "C01-001" to "PROJECT_STATUS_RETIRED"
+      When alice attempts to update the status of project "C01-001" to "PROJECT_STATUS_ACTIVE"
+      Then expect the error "project C01-001 is retired: invalid request"
+
+  Rule: The status must be different from the current status
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+      And a project with project id "C01-001"
+
+    Scenario: the status is different from the current status
+      When alice attempts to update the status of project "C01-001" to "PROJECT_STATUS_SUSPENDED"
+      Then expect no error
+
+    Scenario: the status is the same as the current status
+      When alice attempts to update the status of project "C01-001" to "PROJECT_STATUS_ACTIVE"
+      Then expect the error "project C01-001 already has status PROJECT_STATUS_ACTIVE: invalid request"
+
+  Rule: The project status is updated and the status change is recorded
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+      And a project with project id "C01-001"
+
+    Scenario: the project status is updated
+      When alice attempts to update the status of project "C01-001" to "PROJECT_STATUS_SUSPENDED"
+      Then expect project "C01-001" to have status "PROJECT_STATUS_SUSPENDED"
+
+    Scenario: the status change is recorded
+      Given alice updated the status of project "C01-001" to "PROJECT_STATUS_SUSPENDED"
+      When alice attempts to update the status of project "C01-001" to "PROJECT_STATUS_ACTIVE"
+      Then expect project "C01-001" to have 2 status changes
+      And expect the last status change of project "C01-001" from "PROJECT_STATUS_SUSPENDED" to "PROJECT_STATUS_ACTIVE"
+
+    # no failing scenario - state transitions only occur upon successful message execution
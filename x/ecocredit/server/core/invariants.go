@@ -52,6 +52,9 @@ func BatchSupplyInvariant(ctx context.Context, k Keeper, basketBalances map[uint
 		//escrowed balance
 		balanceFunc("tradable", batchIdToBalanceTradable, bBalance.BatchKey, bBalance.EscrowedAmount)
 
+		// locked balance
+		balanceFunc("tradable", batchIdToBalanceTradable, bBalance.BatchKey, bBalance.LockedAmount)
+
 		// retired balance
 		balanceFunc("retired", batchIdToBalanceRetired, bBalance.BatchKey, bBalance.RetiredAmount)
 	}
@@ -103,6 +103,7 @@ func (s baseSuite) setupClassProjectBatch(t gocuke.TestingT) (classId, projectId
 		ClassKey:     cKey,
 		Jurisdiction: "US-OR",
 		Metadata:     "",
+		Status:       api.ProjectStatus_PROJECT_STATUS_ACTIVE,
 	})
 	assert.NilError(t, err)
 	assert.NilError(t, s.stateStore.ProjectSequenceTable().Insert(s.ctx, &api.ProjectSequence{
@@ -0,0 +1,95 @@
+package core
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// Approve sets the amount of credits an operator is approved to send or retire on behalf
+// of the owner from a credit batch or from any credit batch within a credit class. An
+// existing allowance with the same scope is replaced.
+func (k Keeper) Approve(ctx context.Context, req *core.MsgApprove) (*core.MsgApproveResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	owner, _ := sdk.AccAddressFromBech32(req.Owner)
+	operator, _ := sdk.AccAddressFromBech32(req.Operator)
+
+	classKey, batchKey, creditType, err := k.getAllowanceScope(ctx, req.BatchDenom, req.ClassId)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = math.NewPositiveFixedDecFromString(req.Amount, creditType.Precision); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("amount: %s", err)
+	}
+
+	var expiration *timestamppb.Timestamp
+	if req.Expiration != nil {
+		if !req.Expiration.UTC().After(sdkCtx.BlockTime()) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("expiration must be in the future: %s", req.Expiration)
+		}
+		expiration = timestamppb.New(*req.Expiration)
+	}
+
+	if err = k.stateStore.BatchAllowanceTable().Save(ctx, &api.BatchAllowance{
+		Owner:      owner,
+		Operator:   operator,
+		ClassKey:   classKey,
+		BatchKey:   batchKey,
+		Amount:     req.Amount,
+		Expiration: expiration,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&core.EventApprove{
+		Owner:      req.Owner,
+		Operator:   req.Operator,
+		BatchDenom: req.BatchDenom,
+		ClassId:    req.ClassId,
+		Amount:     req.Amount,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &core.MsgApproveResponse{}, nil
+}
+
+// getAllowanceScope returns the class key and the batch key identifying the scope of an
+// allowance along with the credit type of the credits within that scope. Only one of the
+// batch denom or the class id is expected to be set.
+func (k Keeper) getAllowanceScope(ctx context.Context, batchDenom, classId string) (classKey, batchKey uint64, creditType *api.CreditType, err error) {
+	if batchDenom != "" {
+		batch, err := k.stateStore.BatchTable().GetByDenom(ctx, batchDenom)
+		if err != nil {
+			return 0, 0, nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", batchDenom, err.Error())
+		}
+
+		creditType, err = utils.GetCreditTypeFromBatchDenom(ctx, k.stateStore, batchDenom)
+		if err != nil {
+			return 0, 0, nil, err
+		}
+
+		return 0, batch.Key, creditType, nil
+	}
+
+	class, err := k.stateStore.ClassTable().GetById(ctx, classId)
+	if err != nil {
+		return 0, 0, nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get class with id %s: %s", classId, err.Error())
+	}
+
+	creditType, err = k.stateStore.CreditTypeTable().Get(ctx, class.CreditTypeAbbrev)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
+	return class.Key, 0, creditType, nil
+}
@@ -0,0 +1,127 @@
+package core
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+type approve struct {
+	*baseSuite
+	alice      sdk.AccAddress
+	bob        sdk.AccAddress
+	batchDenom string
+	batchKey   uint64
+	res        *core.MsgApproveResponse
+	err        error
+}
+
+func TestApprove(t *testing.T) {
+	gocuke.NewRunner(t, &approve{}).Path("./features/msg_approve.feature").Run()
+}
+
+func (s *approve) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+	s.batchDenom = "C01-001-20200101-20210101-001"
+}
+
+func (s *approve) ACreditTypeWithAbbreviationAndPrecision(a, b string) {
+	precision, err := strconv.ParseUint(b, 10, 32)
+	require.NoError(s.t, err)
+
+	err = s.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: a,
+		Precision:    uint32(precision),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *approve) ACreditBatchFromCreditClassWithCreditType(a string) {
+	cKey, err := s.k.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               "C01",
+		CreditTypeAbbrev: a,
+	})
+	require.NoError(s.t, err)
+
+	pKey, err := s.k.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
+		Id:       "C01-001",
+		ClassKey: cKey,
+	})
+	require.NoError(s.t, err)
+
+	bKey, err := s.k.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey: pKey,
+		Denom:      s.batchDenom,
+	})
+	require.NoError(s.t, err)
+
+	s.batchKey = bKey
+}
+
+func (s *approve) TheBlockTime(a string) {
+	blockTime, err := types.ParseDate("block time", a)
+	require.NoError(s.t, err)
+
+	s.sdkCtx = s.sdkCtx.WithBlockTime(blockTime)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+}
+
+func (s *approve) AliceHasApprovedBobWithBatchDenomAndAmount(a, b string) {
+	s.AliceAttemptsToApproveBobWithBatchDenomAndAmount(a, b)
+	require.NoError(s.t, s.err)
+}
+
+func (s *approve) AliceAttemptsToApproveBobWithBatchDenomAndAmount(a, b string) {
+	s.res, s.err = s.k.Approve(s.ctx, &core.MsgApprove{
+		Owner:      s.alice.String(),
+		Operator:   s.bob.String(),
+		BatchDenom: a,
+		Amount:     b,
+	})
+}
+
+func (s *approve) AliceAttemptsToApproveBobWithClassIdAndAmount(a, b string) {
+	s.res, s.err = s.k.Approve(s.ctx, &core.MsgApprove{
+		Owner:    s.alice.String(),
+		Operator: s.bob.String(),
+		ClassId:  a,
+		Amount:   b,
+	})
+}
+
+func (s *approve) AliceAttemptsToApproveBobWithExpiration(a string) {
+	expiration, err := types.ParseDate("expiration", a)
+	require.NoError(s.t, err)
+
+	s.res, s.err = s.k.Approve(s.ctx, &core.MsgApprove{
+		Owner:      s.alice.String(),
+		Operator:   s.bob.String(),
+		BatchDenom: s.batchDenom,
+		Amount:     "10",
+		Expiration: &expiration,
+	})
+}
+
+func (s *approve) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *approve) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *approve) ExpectBobAllowanceAmount(a string) {
+	allowance, err := s.stateStore.BatchAllowanceTable().Get(s.ctx, s.alice, s.bob, 0, s.batchKey)
+	require.NoError(s.t, err)
+	require.Equal(s.t, a, allowance.Amount)
+}
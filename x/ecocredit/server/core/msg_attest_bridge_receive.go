@@ -0,0 +1,199 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// AttestBridgeReceive records the attestation of a bridge operator to credits received from
+// another chain. Once the number of bridge operators that have attested to the same origin
+// transaction reaches the threshold of the bridge operator set, the pending attestation is
+// removed and the credits are bridged. A pending attestation that has expired is replaced.
+func (k Keeper) AttestBridgeReceive(ctx context.Context, req *core.MsgAttestBridgeReceive) (*core.MsgAttestBridgeReceiveResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	operator, err := sdk.AccAddressFromBech32(req.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		if ormerrors.NotFound.Is(err) {
+			return nil, sdkerrors.ErrNotFound.Wrapf("credit class with id %s", req.ClassId)
+		}
+		return nil, err
+	}
+
+	operatorSet, err := k.getBridgeOperatorSet(ctx, class.Key, req.OriginTx.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	if operatorSet == nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf(
+			"bridge operators of credit class %s for source %s", req.ClassId, req.OriginTx.Source,
+		)
+	}
+
+	if !isBridgeOperator(operatorSet, operator) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"%s is not a bridge operator of credit class %s", req.Operator, req.ClassId,
+		)
+	}
+
+	bridged, err := k.stateStore.OriginTxIndexTable().Has(ctx, class.Key, req.OriginTx.Id, req.OriginTx.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	if bridged {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("credits already issued with tx id: %s", req.OriginTx.Id)
+	}
+
+	bridgeReq := req.ToBridgeReceive()
+	contentHash, err := bridgeReceiveContentHash(bridgeReq)
+	if err != nil {
+		return nil, err
+	}
+
+	blockTime := sdkCtx.BlockTime()
+
+	pending := true
+	attestation, err := k.stateStore.BridgeAttestationTable().Get(ctx, class.Key, req.OriginTx.Id, req.OriginTx.Source)
+	if err != nil {
+		if !ormerrors.NotFound.Is(err) {
+			return nil, err
+		}
+		pending = false
+	} else if !blockTime.Before(attestation.Expiration.AsTime()) {
+		// the pending attestation expired and has not yet been pruned
+		if err = sdkCtx.EventManager().EmitTypedEvent(&core.EventBridgeAttestationExpired{
+			ClassId:        req.ClassId,
+			OriginTxId:     attestation.Id,
+			OriginTxSource: attestation.Source,
+		}); err != nil {
+			return nil, err
+		}
+		pending = false
+	}
+
+	if pending {
+		if !bytes.Equal(attestation.ContentHash, contentHash) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"attested content does not match the pending attestation of origin tx %s", req.OriginTx.Id,
+			)
+		}
+
+		for _, attestor := range attestation.Attestors {
+			if operator.Equals(sdk.AccAddress(attestor)) {
+				return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+					"%s has already attested to origin tx %s", req.Operator, req.OriginTx.Id,
+				)
+			}
+		}
+	} else {
+		attestation = &api.BridgeAttestation{
+			ClassKey:    class.Key,
+			Id:          req.OriginTx.Id,
+			Source:      req.OriginTx.Source,
+			ContentHash: contentHash,
+			Expiration:  timestamppb.New(blockTime.Add(operatorSet.Timeout.AsDuration())),
+		}
+	}
+
+	attestation.Attestors = append(attestation.Attestors, operator)
+
+	// only attestations of accounts that are still bridge operators count towards the threshold
+	var attestations uint32
+	for _, attestor := range attestation.Attestors {
+		if isBridgeOperator(operatorSet, attestor) {
+			attestations++
+		}
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&core.EventAttestBridgeReceive{
+		ClassId:        req.ClassId,
+		Operator:       req.Operator,
+		OriginTxId:     req.OriginTx.Id,
+		OriginTxSource: req.OriginTx.Source,
+		Attestations:   attestations,
+		Threshold:      operatorSet.Threshold,
+	}); err != nil {
+		return nil, err
+	}
+
+	response := &core.MsgAttestBridgeReceiveResponse{
+		Attestations: attestations,
+		Threshold:    operatorSet.Threshold,
+	}
+
+	if attestations < operatorSet.Threshold {
+		if err = k.stateStore.BridgeAttestationTable().Save(ctx, attestation); err != nil {
+			return nil, err
+		}
+		return response, nil
+	}
+
+	if pending {
+		if err = k.stateStore.BridgeAttestationTable().Delete(ctx, attestation); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := k.bridgeReceive(ctx, class, bridgeReq)
+	if err != nil {
+		return nil, err
+	}
+
+	response.BatchDenom = res.BatchDenom
+	response.ProjectId = res.ProjectId
+
+	return response, nil
+}
+
+// getBridgeOperatorSet returns the bridge operator set of the credit class for the given source,
+// falling back to the bridge operator set that applies to any source. If the credit class has no
+// bridge operator set for the source, nil is returned for both values.
+func (k Keeper) getBridgeOperatorSet(ctx context.Context, classKey uint64, source string) (*api.BridgeOperatorSet, error) {
+	for _, s := range []string{source, ""} {
+		operatorSet, err := k.stateStore.BridgeOperatorSetTable().Get(ctx, classKey, s)
+		if err == nil {
+			return operatorSet, nil
+		}
+		if !ormerrors.NotFound.Is(err) {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+// isBridgeOperator returns whether the address is a bridge operator of the bridge operator set.
+func isBridgeOperator(operatorSet *api.BridgeOperatorSet, addr sdk.AccAddress) bool {
+	for _, operator := range operatorSet.Operators {
+		if addr.Equals(sdk.AccAddress(operator)) {
+			return true
+		}
+	}
+	return false
+}
+
+// bridgeReceiveContentHash returns the hash of the bridge receive request attested to by bridge
+// operators, ensuring each bridge operator attests to the same issuer, project, batch and origin
+// transaction.
+func bridgeReceiveContentHash(req *core.MsgBridgeReceive) ([]byte, error) {
+	bz, err := req.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(bz)
+	return hash[:], nil
+}
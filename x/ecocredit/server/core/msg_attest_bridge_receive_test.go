@@ -0,0 +1,205 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestAttestBridgeReceive_Threshold(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	classKey, operators := bridgeOperatorSetup(t, s, "polygon", 2)
+
+	msg := attestBridgeReceiveMsg(s, operators[0])
+
+	// the credits are not bridged until the threshold is reached
+	res, err := s.k.AttestBridgeReceive(s.ctx, msg)
+	assert.NilError(t, err)
+	assert.Equal(t, uint32(1), res.Attestations)
+	assert.Equal(t, uint32(2), res.Threshold)
+	assert.Equal(t, "", res.BatchDenom)
+
+	attestation, err := s.stateStore.BridgeAttestationTable().Get(s.ctx, classKey, msg.OriginTx.Id, msg.OriginTx.Source)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(attestation.Attestors))
+	assert.Equal(t, bridgeBlockTime.Add(time.Hour), attestation.Expiration.AsTime())
+
+	// the same operator cannot attest twice
+	_, err = s.k.AttestBridgeReceive(s.ctx, msg)
+	assert.ErrorContains(t, err, "has already attested to origin tx")
+
+	// the credits are bridged once the threshold is reached
+	msg.Operator = operators[1].String()
+	res, err = s.k.AttestBridgeReceive(s.ctx, msg)
+	assert.NilError(t, err)
+	assert.Equal(t, uint32(2), res.Attestations)
+	assert.Equal(t, "C01-001", res.ProjectId)
+	assert.Equal(t, "C01-001-20200101-20210101-001", res.BatchDenom)
+
+	_, err = s.stateStore.BridgeAttestationTable().Get(s.ctx, classKey, msg.OriginTx.Id, msg.OriginTx.Source)
+	assert.ErrorIs(t, err, ormerrors.NotFound)
+
+	batch, err := s.stateStore.BatchTable().GetByDenom(s.ctx, res.BatchDenom)
+	assert.NilError(t, err)
+
+	bal, err := s.stateStore.BatchBalanceTable().Get(s.ctx, s.addr2, batch.Key)
+	assert.NilError(t, err)
+	assert.Equal(t, "10", bal.TradableAmount)
+
+	// the origin tx cannot be attested again once the credits have been bridged
+	msg.Operator = operators[2].String()
+	_, err = s.k.AttestBridgeReceive(s.ctx, msg)
+	assert.ErrorContains(t, err, "credits already issued with tx id")
+}
+
+func TestAttestBridgeReceive_Unauthorized(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, operators := bridgeOperatorSetup(t, s, "polygon", 2)
+
+	// the operator must be a bridge operator of the credit class
+	_, err := s.k.AttestBridgeReceive(s.ctx, attestBridgeReceiveMsg(s, s.addr2))
+	assert.ErrorContains(t, err, "is not a bridge operator of credit class C01: unauthorized")
+
+	// the credit class must have a bridge operator set for the source
+	msg := attestBridgeReceiveMsg(s, operators[0])
+	msg.OriginTx.Source = "verra"
+	_, err = s.k.AttestBridgeReceive(s.ctx, msg)
+	assert.ErrorContains(t, err, "bridge operators of credit class C01 for source verra: not found")
+
+	// credits can no longer be bridged directly by a credit class issuer
+	_, err = s.k.BridgeReceive(s.ctx, attestBridgeReceiveMsg(s, operators[0]).ToBridgeReceive())
+	assert.ErrorContains(t, err, "must be attested by bridge operators: unauthorized")
+}
+
+func TestAttestBridgeReceive_ContentMismatch(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, operators := bridgeOperatorSetup(t, s, "", 2)
+
+	_, err := s.k.AttestBridgeReceive(s.ctx, attestBridgeReceiveMsg(s, operators[0]))
+	assert.NilError(t, err)
+
+	// each operator must attest to the same content
+	msg := attestBridgeReceiveMsg(s, operators[1])
+	msg.Batch.Amount = "20"
+	_, err = s.k.AttestBridgeReceive(s.ctx, msg)
+	assert.ErrorContains(t, err, "attested content does not match the pending attestation")
+}
+
+func TestAttestBridgeReceive_Expired(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	classKey, operators := bridgeOperatorSetup(t, s, "polygon", 2)
+
+	msg := attestBridgeReceiveMsg(s, operators[0])
+	_, err := s.k.AttestBridgeReceive(s.ctx, msg)
+	assert.NilError(t, err)
+
+	// an expired attestation is replaced rather than counted towards the threshold
+	s.setBlockTime(bridgeBlockTime.Add(time.Hour))
+	msg.Operator = operators[1].String()
+	res, err := s.k.AttestBridgeReceive(s.ctx, msg)
+	assert.NilError(t, err)
+	assert.Equal(t, uint32(1), res.Attestations)
+
+	attestation, err := s.stateStore.BridgeAttestationTable().Get(s.ctx, classKey, msg.OriginTx.Id, msg.OriginTx.Source)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, [][]byte{operators[1]}, attestation.Attestors)
+
+	// the pending attestation is not pruned before it expires
+	s.setBlockTime(bridgeBlockTime.Add(90 * time.Minute))
+	assert.NilError(t, s.k.PruneBridgeAttestations(s.ctx))
+	_, err = s.stateStore.BridgeAttestationTable().Get(s.ctx, classKey, msg.OriginTx.Id, msg.OriginTx.Source)
+	assert.NilError(t, err)
+
+	// the pending attestation is pruned once it expires
+	s.setBlockTime(bridgeBlockTime.Add(2 * time.Hour))
+	assert.NilError(t, s.k.PruneBridgeAttestations(s.ctx))
+	_, err = s.stateStore.BridgeAttestationTable().Get(s.ctx, classKey, msg.OriginTx.Id, msg.OriginTx.Source)
+	assert.ErrorIs(t, err, ormerrors.NotFound)
+}
+
+var bridgeBlockTime = time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// bridgeOperatorSetup creates a credit class "C01" with admin and issuer s.addr and a bridge operator set
+// of three operators with the given source and threshold and a timeout of one hour.
+func bridgeOperatorSetup(t *testing.T, s *baseSuite, source string, threshold uint32) (uint64, []sdk.AccAddress) {
+	s.setBlockTime(bridgeBlockTime)
+
+	err := s.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: "C",
+		Name:         "carbon",
+		Precision:    6,
+	})
+	assert.NilError(t, err)
+
+	classKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               "C01",
+		Admin:            s.addr,
+		CreditTypeAbbrev: "C",
+	})
+	assert.NilError(t, err)
+
+	err = s.stateStore.ClassIssuerTable().Insert(s.ctx, &api.ClassIssuer{
+		ClassKey: classKey,
+		Issuer:   s.addr,
+	})
+	assert.NilError(t, err)
+
+	operators := make([]sdk.AccAddress, 3)
+	operatorStrs := make([]string, 3)
+	for i := range operators {
+		_, _, operators[i] = testdata.KeyTestPubAddr()
+		operatorStrs[i] = operators[i].String()
+	}
+
+	timeout := time.Hour
+	_, err = s.k.UpdateBridgeOperators(s.ctx, &core.MsgUpdateBridgeOperators{
+		Admin:     s.addr.String(),
+		ClassId:   "C01",
+		Source:    source,
+		Operators: operatorStrs,
+		Threshold: threshold,
+		Timeout:   &timeout,
+	})
+	assert.NilError(t, err)
+
+	return classKey, operators
+}
+
+func attestBridgeReceiveMsg(s *baseSuite, operator sdk.AccAddress) *core.MsgAttestBridgeReceive {
+	startDate := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	return &core.MsgAttestBridgeReceive{
+		Operator: operator.String(),
+		Issuer:   s.addr.String(),
+		ClassId:  "C01",
+		Project: &core.MsgBridgeReceive_Project{
+			ReferenceId:  "VCS-001",
+			Jurisdiction: "US-WA",
+			Metadata:     "regen:13toVgf5aZqSVSeJQv562xkkeoe3rr3bJWa29PHVKVf77VAkVMcDvVd.rdf",
+		},
+		Batch: &core.MsgBridgeReceive_Batch{
+			Recipient: s.addr2.String(),
+			Amount:    "10",
+			StartDate: &startDate,
+			EndDate:   &endDate,
+			Metadata:  "regen:13toVgf5aZqSVSeJQv562xkkeoe3rr3bJWa29PHVKVf77VAkVMcDvVd.rdf",
+		},
+		OriginTx: &core.OriginTx{
+			Id:       "0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e",
+			Source:   "polygon",
+			Contract: "0x0E65079a29d7793ab5CA500c2d88e60EE99Ba606",
+		},
+	}
+}
@@ -5,15 +5,25 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/types"
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
 )
 
-// Bridge cancel credits, removing them from the supply and balance of the holder
+// Bridge cancel credits, removing them from the supply and balance of the holder, and records an
+// outbound bridge request for each of the credits to track the transfer to the target chain. Credits
+// from a frozen credit batch cannot be bridged because they cannot be cancelled.
 func (k Keeper) Bridge(ctx context.Context, req *core.MsgBridge) (*core.MsgBridgeResponse, error) {
-	_, err := k.Cancel(ctx, &core.MsgCancel{
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = k.Cancel(ctx, &core.MsgCancel{
 		Owner:   req.Owner,
 		Credits: req.Credits,
 		Reason:  fmt.Sprintf("bridge-%s", req.Target),
@@ -23,7 +33,10 @@ func (k Keeper) Bridge(ctx context.Context, req *core.MsgBridge) (*core.MsgBridg
 	}
 
 	sdkCtx := types.UnwrapSDKContext(ctx)
-	for _, credit := range req.Credits {
+	blockTime := sdkCtx.BlockTime()
+
+	requestIds := make([]uint64, len(req.Credits))
+	for i, credit := range req.Credits {
 		batch, err := k.stateStore.BatchTable().GetByDenom(ctx, credit.BatchDenom)
 		if err != nil {
 			return nil, err // we already know the batch exists from Cancel
@@ -42,15 +55,44 @@ func (k Keeper) Bridge(ctx context.Context, req *core.MsgBridge) (*core.MsgBridg
 			return nil, err
 		}
 
+		operatorSet, err := k.getBridgeOperatorSet(ctx, batchContract.ClassKey, req.Target)
+		if err != nil {
+			return nil, err
+		}
+
+		// outbound bridge requests only time out when bridge operators are expected to
+		// acknowledge them, otherwise the credits could be refunded after the transfer
+		var expiration *timestamppb.Timestamp
+		if operatorSet != nil && operatorSet.OutboundTimeout != nil {
+			expiration = timestamppb.New(blockTime.Add(operatorSet.OutboundTimeout.AsDuration()))
+		}
+
+		requestId, err := k.stateStore.OutboundBridgeRequestTable().InsertReturningID(ctx, &api.OutboundBridgeRequest{
+			Owner:      owner,
+			BatchKey:   batch.Key,
+			Amount:     credit.Amount,
+			Target:     req.Target,
+			Recipient:  req.Recipient,
+			Contract:   batchContract.Contract,
+			Status:     api.OutboundBridgeStatus_OUTBOUND_BRIDGE_STATUS_PENDING,
+			Timestamp:  timestamppb.New(blockTime),
+			Expiration: expiration,
+		})
+		if err != nil {
+			return nil, err
+		}
+		requestIds[i] = requestId
+
 		if err = sdkCtx.EventManager().EmitTypedEvent(&core.EventBridge{
 			Target:    req.Target,
 			Recipient: req.Recipient,
 			Contract:  batchContract.Contract,
 			Amount:    credit.Amount,
+			RequestId: requestId,
 		}); err != nil {
 			return nil, err
 		}
 	}
 
-	return &core.MsgBridgeResponse{}, nil
+	return &core.MsgBridgeResponse{RequestIds: requestIds}, nil
 }
@@ -0,0 +1,212 @@
+package core
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// BridgeAck acknowledges a pending outbound bridge request as either completed on the target chain
+// or failed. A completed acknowledgement must come from a bridge operator of the credit class for
+// the target, or a credit class issuer if the credit class has no bridge operators for the target.
+// A failed acknowledgement must come from a bridge operator of the credit class for the target and
+// the credits are only refunded to the owner once the threshold of the bridge operator set has
+// been reached.
+func (k Keeper) BridgeAck(ctx context.Context, req *core.MsgBridgeAck) (*core.MsgBridgeAckResponse, error) {
+	operator, err := sdk.AccAddressFromBech32(req.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := k.stateStore.OutboundBridgeRequestTable().Get(ctx, req.RequestId)
+	if err != nil {
+		if ormerrors.NotFound.Is(err) {
+			return nil, sdkerrors.ErrNotFound.Wrapf("outbound bridge request with id %d", req.RequestId)
+		}
+		return nil, err
+	}
+
+	if request.Status != api.OutboundBridgeStatus_OUTBOUND_BRIDGE_STATUS_PENDING {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"outbound bridge request with id %d is not pending", req.RequestId,
+		)
+	}
+
+	batch, err := k.stateStore.BatchTable().Get(ctx, request.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := k.stateStore.ProjectTable().Get(ctx, batch.ProjectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	operatorSet, err := k.getBridgeOperatorSet(ctx, project.ClassKey, request.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Status == core.OutboundBridgeStatus_OUTBOUND_BRIDGE_STATUS_FAILED {
+		return k.bridgeAckFailed(ctx, req, operator, request, batch, operatorSet)
+	}
+
+	if operatorSet != nil {
+		if !isBridgeOperator(operatorSet, operator) {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf(
+				"%s is not a bridge operator of the credit class of batch %s", req.Operator, batch.Denom,
+			)
+		}
+	} else if err = k.assertClassIssuer(ctx, project.ClassKey, operator); err != nil {
+		return nil, err
+	}
+
+	request.Status = api.OutboundBridgeStatus(req.Status)
+	request.TargetTxHash = req.TargetTxHash
+	request.Expiration = nil
+
+	if err = k.stateStore.OutboundBridgeRequestTable().Update(ctx, request); err != nil {
+		return nil, err
+	}
+
+	if err = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&core.EventBridgeAck{
+		RequestId:    req.RequestId,
+		Operator:     req.Operator,
+		Status:       req.Status,
+		TargetTxHash: req.TargetTxHash,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &core.MsgBridgeAckResponse{}, nil
+}
+
+// bridgeAckFailed records a failed acknowledgement of a pending outbound bridge request by a bridge
+// operator and refunds the credits to the owner once the threshold of the bridge operator set has
+// been reached. Credit class issuers cannot acknowledge a request as failed, otherwise a single
+// issuer could mint credits back into circulation that were already bridged to the target chain.
+func (k Keeper) bridgeAckFailed(ctx context.Context, req *core.MsgBridgeAck, operator sdk.AccAddress,
+	request *api.OutboundBridgeRequest, batch *api.Batch, operatorSet *api.BridgeOperatorSet,
+) (*core.MsgBridgeAckResponse, error) {
+	if operatorSet == nil {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"failed acknowledgements require bridge operators of the credit class of batch %s for target %s",
+			batch.Denom, request.Target,
+		)
+	}
+
+	if !isBridgeOperator(operatorSet, operator) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"%s is not a bridge operator of the credit class of batch %s", req.Operator, batch.Denom,
+		)
+	}
+
+	for _, attestor := range request.FailureAttestors {
+		if operator.Equals(sdk.AccAddress(attestor)) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"%s has already acknowledged outbound bridge request with id %d as failed", req.Operator, req.RequestId,
+			)
+		}
+	}
+
+	request.FailureAttestors = append(request.FailureAttestors, operator)
+
+	// only acknowledgements of accounts that are still bridge operators count towards the threshold
+	var attestations uint32
+	for _, attestor := range request.FailureAttestors {
+		if isBridgeOperator(operatorSet, attestor) {
+			attestations++
+		}
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&core.EventBridgeAck{
+		RequestId:    req.RequestId,
+		Operator:     req.Operator,
+		Status:       req.Status,
+		TargetTxHash: req.TargetTxHash,
+		Attestations: attestations,
+		Threshold:    operatorSet.Threshold,
+	}); err != nil {
+		return nil, err
+	}
+
+	response := &core.MsgBridgeAckResponse{
+		Attestations: attestations,
+		Threshold:    operatorSet.Threshold,
+	}
+
+	if attestations < operatorSet.Threshold {
+		if err := k.stateStore.OutboundBridgeRequestTable().Update(ctx, request); err != nil {
+			return nil, err
+		}
+		return response, nil
+	}
+
+	request.Status = api.OutboundBridgeStatus_OUTBOUND_BRIDGE_STATUS_FAILED
+	request.TargetTxHash = req.TargetTxHash
+	request.Expiration = nil
+
+	if err := k.stateStore.OutboundBridgeRequestTable().Update(ctx, request); err != nil {
+		return nil, err
+	}
+
+	if err := k.refundOutboundBridgeRequest(ctx, request, batch); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// refundOutboundBridgeRequest mints the cancelled credits of the outbound bridge request back into
+// the tradable balance of the owner, reversing the cancellation of the credits.
+func (k Keeper) refundOutboundBridgeRequest(ctx context.Context, request *api.OutboundBridgeRequest, batch *api.Batch) error {
+	creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.stateStore, batch.Denom)
+	if err != nil {
+		return err
+	}
+
+	supply, err := k.stateStore.BatchSupplyTable().Get(ctx, batch.Key)
+	if err != nil {
+		return err
+	}
+
+	decs, err := utils.GetNonNegativeFixedDecs(creditType.Precision, request.Amount, supply.TradableAmount, supply.CancelledAmount)
+	if err != nil {
+		return err
+	}
+	amount, tradable, cancelled := decs[0], decs[1], decs[2]
+
+	cancelled, err = math.SafeSubBalance(cancelled, amount)
+	if err != nil {
+		return err
+	}
+
+	tradable, err = math.SafeAddBalance(tradable, amount)
+	if err != nil {
+		return err
+	}
+
+	supply.TradableAmount = tradable.String()
+	supply.CancelledAmount = cancelled.String()
+	if err = k.stateStore.BatchSupplyTable().Update(ctx, supply); err != nil {
+		return err
+	}
+
+	if err = AddAndSaveBalance(ctx, k.stateStore.BatchBalanceTable(), request.Owner, batch.Key, amount); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&core.EventBridgeRefund{
+		RequestId:  request.Id,
+		Owner:      sdk.AccAddress(request.Owner).String(),
+		BatchDenom: batch.Denom,
+		Amount:     request.Amount,
+	})
+}
@@ -0,0 +1,265 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"gotest.tools/v3/assert"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+const bridgeTargetTxHash = "0x8a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e"
+
+func TestBridgeAck_Completed(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, operators := bridgeOperatorSetup(t, s, "polygon", 2)
+	batchKey, requestId := outboundBridgeSetup(t, s)
+
+	// only a bridge operator can acknowledge the request
+	_, err := s.k.BridgeAck(s.ctx, &core.MsgBridgeAck{
+		Operator:     s.addr.String(),
+		RequestId:    requestId,
+		Status:       core.OutboundBridgeStatus_OUTBOUND_BRIDGE_STATUS_COMPLETED,
+		TargetTxHash: bridgeTargetTxHash,
+	})
+	assert.ErrorContains(t, err, "is not a bridge operator of the credit class of batch")
+
+	_, err = s.k.BridgeAck(s.ctx, &core.MsgBridgeAck{
+		Operator:     operators[0].String(),
+		RequestId:    requestId,
+		Status:       core.OutboundBridgeStatus_OUTBOUND_BRIDGE_STATUS_COMPLETED,
+		TargetTxHash: bridgeTargetTxHash,
+	})
+	assert.NilError(t, err)
+
+	request, err := s.stateStore.OutboundBridgeRequestTable().Get(s.ctx, requestId)
+	assert.NilError(t, err)
+	assert.Equal(t, api.OutboundBridgeStatus_OUTBOUND_BRIDGE_STATUS_COMPLETED, request.Status)
+	assert.Equal(t, bridgeTargetTxHash, request.TargetTxHash)
+	assert.Assert(t, request.Expiration == nil)
+
+	// the credits remain cancelled
+	assertOutboundBridgeBalance(t, s, batchKey, "0", "10")
+
+	// a completed request cannot be acknowledged again
+	_, err = s.k.BridgeAck(s.ctx, &core.MsgBridgeAck{
+		Operator:  operators[1].String(),
+		RequestId: requestId,
+		Status:    core.OutboundBridgeStatus_OUTBOUND_BRIDGE_STATUS_FAILED,
+	})
+	assert.ErrorContains(t, err, "is not pending")
+
+	// a completed request does not time out
+	s.setBlockTime(bridgeBlockTime.Add(48 * time.Hour))
+	assert.NilError(t, s.k.ExpireOutboundBridgeRequests(s.ctx))
+	assertOutboundBridgeBalance(t, s, batchKey, "0", "10")
+}
+
+func TestBridgeAck_Failed(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, operators := bridgeOperatorSetup(t, s, "polygon", 2)
+	batchKey, requestId := outboundBridgeSetup(t, s)
+
+	res, err := s.k.BridgeAck(s.ctx, &core.MsgBridgeAck{
+		Operator:  operators[0].String(),
+		RequestId: requestId,
+		Status:    core.OutboundBridgeStatus_OUTBOUND_BRIDGE_STATUS_FAILED,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, uint32(1), res.Attestations)
+	assert.Equal(t, uint32(2), res.Threshold)
+
+	// the request remains pending and the credits are not refunded below the threshold
+	request, err := s.stateStore.OutboundBridgeRequestTable().Get(s.ctx, requestId)
+	assert.NilError(t, err)
+	assert.Equal(t, api.OutboundBridgeStatus_OUTBOUND_BRIDGE_STATUS_PENDING, request.Status)
+	assert.Assert(t, request.Expiration != nil)
+	assertOutboundBridgeBalance(t, s, batchKey, "0", "10")
+
+	// an operator cannot acknowledge the request as failed twice
+	_, err = s.k.BridgeAck(s.ctx, &core.MsgBridgeAck{
+		Operator:  operators[0].String(),
+		RequestId: requestId,
+		Status:    core.OutboundBridgeStatus_OUTBOUND_BRIDGE_STATUS_FAILED,
+	})
+	assert.ErrorContains(t, err, "has already acknowledged outbound bridge request with id 1 as failed")
+
+	res, err = s.k.BridgeAck(s.ctx, &core.MsgBridgeAck{
+		Operator:  operators[1].String(),
+		RequestId: requestId,
+		Status:    core.OutboundBridgeStatus_OUTBOUND_BRIDGE_STATUS_FAILED,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, uint32(2), res.Attestations)
+
+	request, err = s.stateStore.OutboundBridgeRequestTable().Get(s.ctx, requestId)
+	assert.NilError(t, err)
+	assert.Equal(t, api.OutboundBridgeStatus_OUTBOUND_BRIDGE_STATUS_FAILED, request.Status)
+	assert.Assert(t, request.Expiration == nil)
+
+	// the credits are refunded to the owner
+	assertOutboundBridgeBalance(t, s, batchKey, "10", "0")
+
+	// the request does not exist
+	_, err = s.k.BridgeAck(s.ctx, &core.MsgBridgeAck{
+		Operator:  operators[0].String(),
+		RequestId: 2,
+		Status:    core.OutboundBridgeStatus_OUTBOUND_BRIDGE_STATUS_FAILED,
+	})
+	assert.ErrorContains(t, err, "outbound bridge request with id 2: not found")
+}
+
+func TestBridgeAck_ClassIssuer(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, _ = bridgeOperatorSetup(t, s, "verra", 2)
+	batchKey, requestId := outboundBridgeSetup(t, s)
+
+	// the request does not time out without bridge operators for the target
+	request, err := s.stateStore.OutboundBridgeRequestTable().Get(s.ctx, requestId)
+	assert.NilError(t, err)
+	assert.Assert(t, request.Expiration == nil)
+
+	// a credit class issuer cannot acknowledge the request as failed
+	_, err = s.k.BridgeAck(s.ctx, &core.MsgBridgeAck{
+		Operator:  s.addr.String(),
+		RequestId: requestId,
+		Status:    core.OutboundBridgeStatus_OUTBOUND_BRIDGE_STATUS_FAILED,
+	})
+	assert.ErrorContains(t, err, "failed acknowledgements require bridge operators")
+	assertOutboundBridgeBalance(t, s, batchKey, "0", "10")
+
+	// a credit class issuer acknowledges the request as completed without bridge operators for the target
+	_, err = s.k.BridgeAck(s.ctx, &core.MsgBridgeAck{
+		Operator:     s.addr2.String(),
+		RequestId:    requestId,
+		Status:       core.OutboundBridgeStatus_OUTBOUND_BRIDGE_STATUS_COMPLETED,
+		TargetTxHash: "0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e",
+	})
+	assert.ErrorContains(t, err, "is not an issuer for the class: unauthorized")
+
+	_, err = s.k.BridgeAck(s.ctx, &core.MsgBridgeAck{
+		Operator:     s.addr.String(),
+		RequestId:    requestId,
+		Status:       core.OutboundBridgeStatus_OUTBOUND_BRIDGE_STATUS_COMPLETED,
+		TargetTxHash: "0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e",
+	})
+	assert.NilError(t, err)
+	assertOutboundBridgeBalance(t, s, batchKey, "0", "10")
+}
+
+func TestExpireOutboundBridgeRequests(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, _ = bridgeOperatorSetup(t, s, "polygon", 2)
+	batchKey, requestId := outboundBridgeSetup(t, s)
+
+	request, err := s.stateStore.OutboundBridgeRequestTable().Get(s.ctx, requestId)
+	assert.NilError(t, err)
+	assert.Equal(t, bridgeBlockTime.Add(24*time.Hour), request.Expiration.AsTime())
+
+	// the request is not refunded before it expires
+	s.setBlockTime(bridgeBlockTime.Add(time.Hour))
+	assert.NilError(t, s.k.ExpireOutboundBridgeRequests(s.ctx))
+	assertOutboundBridgeBalance(t, s, batchKey, "0", "10")
+
+	// the request is refunded once it expires
+	s.setBlockTime(bridgeBlockTime.Add(24 * time.Hour))
+	assert.NilError(t, s.k.ExpireOutboundBridgeRequests(s.ctx))
+	assertOutboundBridgeBalance(t, s, batchKey, "10", "0")
+
+	request, err = s.stateStore.OutboundBridgeRequestTable().Get(s.ctx, requestId)
+	assert.NilError(t, err)
+	assert.Equal(t, api.OutboundBridgeStatus_OUTBOUND_BRIDGE_STATUS_FAILED, request.Status)
+	assert.Assert(t, request.Expiration == nil)
+
+	// the request is only refunded once
+	assert.NilError(t, s.k.ExpireOutboundBridgeRequests(s.ctx))
+	assertOutboundBridgeBalance(t, s, batchKey, "10", "0")
+}
+
+// outboundBridgeSetup creates a project and a bridged credit batch within credit class "C01" with a
+// tradable balance of 10 credits owned by s.addr2, sets an outbound timeout of one day on the bridge
+// operators, and bridges all credits back to polygon.
+func outboundBridgeSetup(t *testing.T, s *baseSuite) (batchKey, requestId uint64) {
+	classKey := uint64(1)
+
+	it, err := s.stateStore.BridgeOperatorSetTable().List(s.ctx, api.BridgeOperatorSetClassKeySourceIndexKey{}.WithClassKey(classKey))
+	assert.NilError(t, err)
+	var operatorSets []*api.BridgeOperatorSet
+	for it.Next() {
+		operatorSet, err := it.Value()
+		assert.NilError(t, err)
+		operatorSets = append(operatorSets, operatorSet)
+	}
+	it.Close()
+
+	for _, operatorSet := range operatorSets {
+		operatorSet.OutboundTimeout = durationpb.New(24 * time.Hour)
+		assert.NilError(t, s.stateStore.BridgeOperatorSetTable().Update(s.ctx, operatorSet))
+	}
+
+	projectKey, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
+		Id:       "C01-001",
+		ClassKey: classKey,
+		Status:   api.ProjectStatus_PROJECT_STATUS_ACTIVE,
+	})
+	assert.NilError(t, err)
+
+	batchDenom := "C01-001-20200101-20210101-001"
+	batchKey, err = s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey: projectKey,
+		Denom:      batchDenom,
+		Open:       true,
+	})
+	assert.NilError(t, err)
+
+	assert.NilError(t, s.stateStore.BatchContractTable().Insert(s.ctx, &api.BatchContract{
+		BatchKey: batchKey,
+		ClassKey: classKey,
+		Contract: "0x0E65079a29d7793ab5CA500c2d88e60EE99Ba606",
+	}))
+
+	assert.NilError(t, s.stateStore.BatchSupplyTable().Insert(s.ctx, &api.BatchSupply{
+		BatchKey:        batchKey,
+		TradableAmount:  "10",
+		RetiredAmount:   "0",
+		CancelledAmount: "0",
+	}))
+
+	assert.NilError(t, s.stateStore.BatchBalanceTable().Insert(s.ctx, &api.BatchBalance{
+		BatchKey:       batchKey,
+		Address:        s.addr2,
+		TradableAmount: "10",
+		RetiredAmount:  "0",
+	}))
+
+	res, err := s.k.Bridge(s.ctx, &core.MsgBridge{
+		Owner:     s.addr2.String(),
+		Target:    "polygon",
+		Recipient: "0x1000000000000000000000000000000000000000",
+		Credits:   []*core.Credits{{BatchDenom: batchDenom, Amount: "10"}},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.RequestIds))
+
+	assertOutboundBridgeBalance(t, s, batchKey, "0", "10")
+
+	return batchKey, res.RequestIds[0]
+}
+
+// assertOutboundBridgeBalance asserts the tradable balance of s.addr2 and the cancelled supply of the batch.
+func assertOutboundBridgeBalance(t *testing.T, s *baseSuite, batchKey uint64, tradable, cancelled string) {
+	bal, err := s.stateStore.BatchBalanceTable().Get(s.ctx, s.addr2, batchKey)
+	assert.NilError(t, err)
+	assert.Equal(t, tradable, bal.TradableAmount)
+
+	supply, err := s.stateStore.BatchSupplyTable().Get(s.ctx, batchKey)
+	assert.NilError(t, err)
+	assert.Equal(t, cancelled, supply.CancelledAmount)
+}
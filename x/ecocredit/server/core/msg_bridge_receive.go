@@ -11,7 +11,9 @@ import (
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
 )
 
-// BridgeReceive bridges credits received from another chain.
+// BridgeReceive bridges credits received from another chain. Credits cannot be bridged with
+// BridgeReceive when the credit class has a bridge operator set for the source of the origin
+// transaction, in which case the credits must be attested with AttestBridgeReceive.
 func (k Keeper) BridgeReceive(ctx context.Context, req *core.MsgBridgeReceive) (*core.MsgBridgeReceiveResponse, error) {
 	// check class id and get class information (specifically class key)
 	class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
@@ -22,6 +24,24 @@ func (k Keeper) BridgeReceive(ctx context.Context, req *core.MsgBridgeReceive) (
 		return nil, err
 	}
 
+	operatorSet, err := k.getBridgeOperatorSet(ctx, class.Key, req.OriginTx.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	if operatorSet != nil {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"credits bridged from %s into credit class %s must be attested by bridge operators",
+			req.OriginTx.Source, req.ClassId,
+		)
+	}
+
+	return k.bridgeReceive(ctx, class, req)
+}
+
+// bridgeReceive bridges credits received from another chain into the given credit class.
+func (k Keeper) bridgeReceive(ctx context.Context, class *api.Class, req *core.MsgBridgeReceive) (*core.MsgBridgeReceiveResponse, error) {
+
 	// check if batch contract entry exists
 	batchContract, err := k.stateStore.BatchContractTable().GetByClassKeyContract(ctx, class.Key, req.OriginTx.Contract)
 	if err != nil {
@@ -97,6 +97,7 @@ func (s *bridgeReceiveSuite) AProjectWithId(a string) {
 	pKey, err := s.k.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
 		Id:       a,
 		ClassKey: s.classKey,
+		Status:   api.ProjectStatus_PROJECT_STATUS_ACTIVE,
 	})
 	require.NoError(s.t, err)
 
@@ -115,6 +116,7 @@ func (s *bridgeReceiveSuite) AProjectWithIdAndReferenceId(a, b string) {
 		Id:          a,
 		ClassKey:    s.classKey,
 		ReferenceId: b,
+		Status:      api.ProjectStatus_PROJECT_STATUS_ACTIVE,
 	})
 	require.NoError(s.t, err)
 
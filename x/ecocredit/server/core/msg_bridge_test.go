@@ -69,6 +69,15 @@ func (s *bridgeSuite) ACreditBatchExistsWithoutABatchContractEntry() {
 	s.creditBatchSetup()
 }
 
+func (s *bridgeSuite) TheCreditBatchIsFrozen() {
+	batch, err := s.k.stateStore.BatchTable().Get(s.ctx, s.batchKey)
+	require.NoError(s.t, err)
+
+	batch.Frozen = true
+	err = s.k.stateStore.BatchTable().Update(s.ctx, batch)
+	require.NoError(s.t, err)
+}
+
 func (s *bridgeSuite) AliceOwnsTradableCreditsFromTheCreditBatch() {
 	err := s.k.stateStore.BatchBalanceTable().Insert(s.ctx, &api.BatchBalance{
 		BatchKey:       s.batchKey,
@@ -17,7 +17,8 @@ import (
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
 )
 
-// Cancel credits, removing them from the supply and balance of the owner
+// Cancel credits, removing them from the supply and balance of the owner. Credits from a frozen
+// credit batch cannot be cancelled.
 func (k Keeper) Cancel(ctx context.Context, req *core.MsgCancel) (*core.MsgCancelResponse, error) {
 	sdkCtx := types.UnwrapSDKContext(ctx)
 	owner, err := sdk.AccAddressFromBech32(req.Owner)
@@ -30,6 +31,9 @@ func (k Keeper) Cancel(ctx context.Context, req *core.MsgCancel) (*core.MsgCance
 		if err != nil {
 			return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", credit.BatchDenom, err.Error())
 		}
+		if err = utils.AssertBatchNotFrozen(batch); err != nil {
+			return nil, err
+		}
 		creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.stateStore, batch.Denom)
 		if err != nil {
 			return nil, err
@@ -68,6 +72,7 @@ func (k Keeper) Cancel(ctx context.Context, req *core.MsgCancel) (*core.MsgCance
 			TradableAmount: userBalTradable.String(),
 			RetiredAmount:  userBalance.RetiredAmount,
 			EscrowedAmount: userBalance.EscrowedAmount,
+			LockedAmount:   userBalance.LockedAmount,
 		}); err != nil {
 			return nil, err
 		}
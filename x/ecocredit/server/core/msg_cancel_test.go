@@ -127,6 +127,15 @@ func (s *cancel) AliceHasTheBatchBalance(a gocuke.DocString) {
 	require.NoError(s.t, err)
 }
 
+func (s *cancel) TheCreditBatchIsFrozen() {
+	batch, err := s.k.stateStore.BatchTable().Get(s.ctx, s.batchKey)
+	require.NoError(s.t, err)
+
+	batch.Frozen = true
+	err = s.k.stateStore.BatchTable().Update(s.ctx, batch)
+	require.NoError(s.t, err)
+}
+
 func (s *cancel) AliceOwnsTradableCreditAmount(a string) {
 	err := s.k.stateStore.BatchBalanceTable().Insert(s.ctx, &api.BatchBalance{
 		BatchKey:       s.batchKey,
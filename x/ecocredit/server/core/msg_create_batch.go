@@ -26,6 +26,10 @@ func (k Keeper) CreateBatch(ctx context.Context, req *core.MsgCreateBatch) (*cor
 		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get project with id %s: %s", req.ProjectId, err.Error())
 	}
 
+	if err = assertProjectActive(project); err != nil {
+		return nil, err
+	}
+
 	class, err := k.stateStore.ClassTable().Get(ctx, project.ClassKey)
 	if err != nil {
 		return nil, err
@@ -73,6 +77,11 @@ func (k Keeper) CreateBatch(ctx context.Context, req *core.MsgCreateBatch) (*cor
 	}
 	maxDecimalPlaces := creditType.Precision
 
+	bufferFraction, err := k.getBufferFraction(ctx, class.Key)
+	if err != nil {
+		return nil, err
+	}
+
 	tradableSupply, retiredSupply := math.NewDecFromInt64(0), math.NewDecFromInt64(0)
 
 	// set module address string once for better performance
@@ -80,14 +89,25 @@ func (k Keeper) CreateBatch(ctx context.Context, req *core.MsgCreateBatch) (*cor
 
 	for _, issuance := range req.Issuance {
 		// get and set decimal values of tradable amount and retired amount
-		decs, err := utils.GetNonNegativeFixedDecs(maxDecimalPlaces, issuance.TradableAmount, issuance.RetiredAmount)
+		decs, err := utils.GetNonNegativeFixedDecs(maxDecimalPlaces, issuance.TradableAmount, issuance.RetiredAmount, issuance.LockedAmount)
 		if err != nil {
 			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 		}
-		tradableAmount, retiredAmount := decs[0], decs[1]
+		tradableAmount, retiredAmount, lockedAmount := decs[0], decs[1], decs[2]
 
 		recipient, _ := sdk.AccAddressFromBech32(issuance.Recipient)
 
+		// withhold the buffer pool share of the tradable amount from the recipient
+		// (withheld credits are counted in the tradable supply)
+		recipientTradable, bufferAmount, err := k.withholdBuffer(ctx, class.Id, batchKey, batchDenom, tradableAmount, bufferFraction, maxDecimalPlaces)
+		if err != nil {
+			return nil, err
+		}
+		transferTradable := issuance.TradableAmount
+		if !bufferAmount.IsZero() {
+			transferTradable = recipientTradable.String()
+		}
+
 		// get the current batch balance of the recipient account
 		// Note: Issuance could be for an account with no prior balance,
 		// so we must catch the not found case and apply a zero value balance.
@@ -112,7 +132,7 @@ func (k Keeper) CreateBatch(ctx context.Context, req *core.MsgCreateBatch) (*cor
 		}
 
 		// add tradable amount and retired amount to existing batch balance
-		tradableBalance, err = tradableBalance.Add(tradableAmount)
+		tradableBalance, err = tradableBalance.Add(recipientTradable)
 		if err != nil {
 			return nil, err
 		}
@@ -130,10 +150,22 @@ func (k Keeper) CreateBatch(ctx context.Context, req *core.MsgCreateBatch) (*cor
 			TradableAmount: tradableBalance.String(),
 			RetiredAmount:  retiredBalance.String(),
 			EscrowedAmount: balance.EscrowedAmount,
+			LockedAmount:   balance.LockedAmount,
 		}); err != nil {
 			return nil, err
 		}
 
+		// lock credits and update tradable supply (locked credits are counted in the tradable supply)
+		if !lockedAmount.IsZero() {
+			if _, err = k.lockCredits(ctx, recipient, batchKey, batchDenom, lockedAmount, issuance.LockSchedule); err != nil {
+				return nil, err
+			}
+			tradableSupply, err = tradableSupply.Add(lockedAmount)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		// update tradable supply (updated in state at the end of the loop)
 		if !tradableAmount.IsZero() {
 			tradableSupply, err = tradableSupply.Add(tradableAmount)
@@ -148,12 +180,23 @@ func (k Keeper) CreateBatch(ctx context.Context, req *core.MsgCreateBatch) (*cor
 			if err != nil {
 				return nil, err
 			}
-			// emit retired event only if retired amount is positive
+			// record retirement and emit retired event only if retired amount is positive
+			retirementId, err := utils.InsertRetirement(ctx, k.stateStore.RetirementTable(), &api.Retirement{
+				Owner:        recipient,
+				BatchKey:     batchKey,
+				ProjectKey:   project.Key,
+				Amount:       issuance.RetiredAmount,
+				Jurisdiction: issuance.RetirementJurisdiction,
+			})
+			if err != nil {
+				return nil, err
+			}
 			if err = sdkCtx.EventManager().EmitTypedEvent(&core.EventRetire{
 				Owner:        issuance.Recipient,
 				BatchDenom:   batchDenom,
 				Amount:       issuance.RetiredAmount,
 				Jurisdiction: issuance.RetirementJurisdiction,
+				RetirementId: retirementId,
 			}); err != nil {
 				return nil, err
 			}
@@ -171,7 +214,7 @@ func (k Keeper) CreateBatch(ctx context.Context, req *core.MsgCreateBatch) (*cor
 			Sender:         moduleAddrString, // ecocredit module
 			Recipient:      issuance.Recipient,
 			BatchDenom:     batchDenom,
-			TradableAmount: issuance.TradableAmount,
+			TradableAmount: transferTradable,
 			RetiredAmount:  issuance.RetiredAmount,
 		}); err != nil {
 			return nil, err
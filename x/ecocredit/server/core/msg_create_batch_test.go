@@ -126,6 +126,7 @@ func (s *createBatchSuite) AProjectWithProjectId(a string) {
 	pKey, err := s.k.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
 		Id:       a,
 		ClassKey: class.Key,
+		Status:   api.ProjectStatus_PROJECT_STATUS_ACTIVE,
 	})
 	require.NoError(s.t, err)
 
@@ -141,6 +142,23 @@ func (s *createBatchSuite) AProjectWithProjectId(a string) {
 	s.projectKey = pKey
 }
 
+func (s *createBatchSuite) ProjectHasStatus(a, b string) {
+	project, err := s.k.stateStore.ProjectTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	project.Status = api.ProjectStatus(api.ProjectStatus_value[b])
+	err = s.k.stateStore.ProjectTable().Update(s.ctx, project)
+	require.NoError(s.t, err)
+}
+
+func (s *createBatchSuite) AClassBufferPercentageOf(a string) {
+	err := s.k.stateStore.ClassBufferTable().Insert(s.ctx, &api.ClassBuffer{
+		ClassKey:   s.classKey,
+		Percentage: a,
+	})
+	require.NoError(s.t, err)
+}
+
 func (s *createBatchSuite) ABatchSequenceWithProjectIdAndNextSequence(a string, b string) {
 	project, err := s.stateStore.ProjectTable().GetById(s.ctx, a)
 	require.NoError(s.t, err)
@@ -327,6 +345,20 @@ func (s *createBatchSuite) ExpectRecipientBatchBalanceWithAddress(a string, b go
 	require.Equal(s.t, expected.RetiredAmount, balance.RetiredAmount)
 	require.Equal(s.t, expected.TradableAmount, balance.TradableAmount)
 	require.Equal(s.t, expected.EscrowedAmount, balance.EscrowedAmount)
+
+	if expected.LockedAmount != "" {
+		require.Equal(s.t, expected.LockedAmount, balance.LockedAmount)
+	}
+}
+
+func (s *createBatchSuite) ExpectBufferPoolBatchBalanceWithTradableAmount(a string) {
+	batch, err := s.stateStore.BatchTable().GetByDenom(s.ctx, s.res.BatchDenom)
+	require.NoError(s.t, err)
+
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, core.BufferAddress(s.classId), batch.Key)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, a, balance.TradableAmount)
 }
 
 func (s *createBatchSuite) ExpectBatchSupply(a gocuke.DocString) {
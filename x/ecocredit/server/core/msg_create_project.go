@@ -48,6 +48,7 @@ func (k Keeper) CreateProject(ctx context.Context, req *core.MsgCreateProject) (
 		Jurisdiction: req.Jurisdiction,
 		Metadata:     req.Metadata,
 		ReferenceId:  req.ReferenceId,
+		Status:       api.ProjectStatus_PROJECT_STATUS_ACTIVE,
 	}); err != nil {
 		return nil, err
 	}
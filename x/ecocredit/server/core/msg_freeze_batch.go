@@ -0,0 +1,90 @@
+package core
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// FreezeBatch freezes a credit batch. Credits from a frozen credit batch cannot
+// be sent or retired. Sell orders of the credit batch are cancelled separately
+// by the marketplace submodule.
+func (k Keeper) FreezeBatch(ctx context.Context, req *core.MsgFreezeBatch) (*core.MsgFreezeBatchResponse, error) {
+	batch, err := k.getBatchForClassAdmin(ctx, req.Admin, req.BatchDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.setBatchFrozen(ctx, batch, true, req.Evidence, req.Reason); err != nil {
+		return nil, err
+	}
+
+	return &core.MsgFreezeBatchResponse{}, nil
+}
+
+// getBatchForClassAdmin gets the credit batch and makes sure the admin is the
+// admin of the credit class of the credit batch.
+func (k Keeper) getBatchForClassAdmin(ctx context.Context, admin, batchDenom string) (*api.Batch, error) {
+	adminAddr, err := sdk.AccAddressFromBech32(admin)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := k.stateStore.BatchTable().GetByDenom(ctx, batchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", batchDenom, err.Error())
+	}
+
+	project, err := k.stateStore.ProjectTable().Get(ctx, batch.ProjectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := k.stateStore.ClassTable().Get(ctx, project.ClassKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if !adminAddr.Equals(sdk.AccAddress(class.Admin)) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"%s is not the admin of credit class %s", admin, class.Id,
+		)
+	}
+
+	return batch, nil
+}
+
+// setBatchFrozen freezes or unfreezes the credit batch and emits the
+// corresponding event.
+func (k Keeper) setBatchFrozen(ctx context.Context, batch *api.Batch, frozen bool, evidence, reason string) error {
+	if batch.Frozen == frozen {
+		if frozen {
+			return sdkerrors.ErrInvalidRequest.Wrapf("credit batch %s is already frozen", batch.Denom)
+		}
+		return sdkerrors.ErrInvalidRequest.Wrapf("credit batch %s is not frozen", batch.Denom)
+	}
+
+	batch.Frozen = frozen
+	if err := k.stateStore.BatchTable().Update(ctx, batch); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if frozen {
+		return sdkCtx.EventManager().EmitTypedEvent(&core.EventFreezeBatch{
+			BatchDenom: batch.Denom,
+			Evidence:   evidence,
+			Reason:     reason,
+		})
+	}
+
+	return sdkCtx.EventManager().EmitTypedEvent(&core.EventUnfreezeBatch{
+		BatchDenom: batch.Denom,
+		Evidence:   evidence,
+		Reason:     reason,
+	})
+}
@@ -0,0 +1,204 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+type freezeBatch struct {
+	*baseSuite
+	alice sdk.AccAddress
+	bob   sdk.AccAddress
+	err   error
+}
+
+func TestFreezeBatch(t *testing.T) {
+	gocuke.NewRunner(t, &freezeBatch{}).Path("./features/msg_freeze_batch.feature").Run()
+}
+
+func (s *freezeBatch) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *freezeBatch) ACreditTypeWithAbbreviation(a string) {
+	err := s.k.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: a,
+		Name:         a,
+		Precision:    6,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *freezeBatch) ACreditClassWithClassIdAndAdminAlice(a string) {
+	creditTypeAbbrev := core.GetCreditTypeAbbrevFromClassId(a)
+
+	err := s.k.stateStore.ClassTable().Insert(s.ctx, &api.Class{
+		Id:               a,
+		Admin:            s.alice,
+		CreditTypeAbbrev: creditTypeAbbrev,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *freezeBatch) AProjectWithProjectId(a string) {
+	classId := core.GetClassIdFromProjectId(a)
+
+	class, err := s.k.stateStore.ClassTable().GetById(s.ctx, classId)
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.ProjectTable().Insert(s.ctx, &api.Project{
+		Id:       a,
+		ClassKey: class.Key,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *freezeBatch) ACreditBatchWithBatchDenom(a string) {
+	projectId := core.GetProjectIdFromBatchDenom(a)
+
+	project, err := s.k.stateStore.ProjectTable().GetById(s.ctx, projectId)
+	require.NoError(s.t, err)
+
+	batchKey, err := s.k.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey: project.Key,
+		Denom:      a,
+	})
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.BatchSupplyTable().Insert(s.ctx, &api.BatchSupply{
+		BatchKey:        batchKey,
+		TradableAmount:  "0",
+		RetiredAmount:   "0",
+		CancelledAmount: "0",
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *freezeBatch) AliceHasATradableBalanceOfInBatch(a, b string) {
+	batch, err := s.k.stateStore.BatchTable().GetByDenom(s.ctx, b)
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.BatchBalanceTable().Insert(s.ctx, &api.BatchBalance{
+		BatchKey:       batch.Key,
+		Address:        s.alice,
+		TradableAmount: a,
+		RetiredAmount:  "0",
+		EscrowedAmount: "0",
+	})
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.BatchSupplyTable().Update(s.ctx, &api.BatchSupply{
+		BatchKey:        batch.Key,
+		TradableAmount:  a,
+		RetiredAmount:   "0",
+		CancelledAmount: "0",
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *freezeBatch) AliceFrozeBatch(a string) {
+	_, err := s.k.FreezeBatch(s.ctx, &core.MsgFreezeBatch{
+		Admin:      s.alice.String(),
+		BatchDenom: a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *freezeBatch) AliceUnfrozeBatch(a string) {
+	_, err := s.k.UnfreezeBatch(s.ctx, &core.MsgUnfreezeBatch{
+		Admin:      s.alice.String(),
+		BatchDenom: a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *freezeBatch) AliceAttemptsToFreezeBatch(a string) {
+	_, s.err = s.k.FreezeBatch(s.ctx, &core.MsgFreezeBatch{
+		Admin:      s.alice.String(),
+		BatchDenom: a,
+	})
+}
+
+func (s *freezeBatch) BobAttemptsToFreezeBatch(a string) {
+	_, s.err = s.k.FreezeBatch(s.ctx, &core.MsgFreezeBatch{
+		Admin:      s.bob.String(),
+		BatchDenom: a,
+	})
+}
+
+func (s *freezeBatch) AliceAttemptsToUnfreezeBatch(a string) {
+	_, s.err = s.k.UnfreezeBatch(s.ctx, &core.MsgUnfreezeBatch{
+		Admin:      s.alice.String(),
+		BatchDenom: a,
+	})
+}
+
+func (s *freezeBatch) BobAttemptsToUnfreezeBatch(a string) {
+	_, s.err = s.k.UnfreezeBatch(s.ctx, &core.MsgUnfreezeBatch{
+		Admin:      s.bob.String(),
+		BatchDenom: a,
+	})
+}
+
+func (s *freezeBatch) AliceAttemptsToSendCreditsFromBatchToBob(a, b string) {
+	_, s.err = s.k.Send(s.ctx, &core.MsgSend{
+		Sender:    s.alice.String(),
+		Recipient: s.bob.String(),
+		Credits: []*core.MsgSend_SendCredits{
+			{
+				BatchDenom:     b,
+				TradableAmount: a,
+			},
+		},
+	})
+}
+
+func (s *freezeBatch) AliceAttemptsToRetireCreditsFromBatch(a, b string) {
+	_, s.err = s.k.Retire(s.ctx, &core.MsgRetire{
+		Owner: s.alice.String(),
+		Credits: []*core.Credits{
+			{
+				BatchDenom: b,
+				Amount:     a,
+			},
+		},
+		Jurisdiction: "US-WA",
+	})
+}
+
+func (s *freezeBatch) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *freezeBatch) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *freezeBatch) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *freezeBatch) ExpectBatchToBeFrozen(a string) {
+	require.NoError(s.t, s.err)
+
+	batch, err := s.k.stateStore.BatchTable().GetByDenom(s.ctx, a)
+	require.NoError(s.t, err)
+	require.True(s.t, batch.Frozen)
+}
+
+func (s *freezeBatch) ExpectBatchToNotBeFrozen(a string) {
+	require.NoError(s.t, s.err)
+
+	batch, err := s.k.stateStore.BatchTable().GetByDenom(s.ctx, a)
+	require.NoError(s.t, err)
+	require.False(s.t, batch.Frozen)
+}
@@ -33,6 +33,10 @@ func (k Keeper) MintBatchCredits(ctx context.Context, req *core.MsgMintBatchCred
 		return nil, err
 	}
 
+	if err = assertProjectActive(project); err != nil {
+		return nil, err
+	}
+
 	if err = k.stateStore.OriginTxIndexTable().Insert(ctx, &api.OriginTxIndex{
 		ClassKey: project.ClassKey,
 		Id:       req.OriginTx.Id,
@@ -49,6 +53,17 @@ func (k Keeper) MintBatchCredits(ctx context.Context, req *core.MsgMintBatchCred
 		return nil, err
 	}
 	precision := ct.Precision
+
+	class, err := k.stateStore.ClassTable().Get(ctx, project.ClassKey)
+	if err != nil {
+		return nil, err
+	}
+
+	bufferFraction, err := k.getBufferFraction(ctx, class.Key)
+	if err != nil {
+		return nil, err
+	}
+
 	moduleAddrString := k.moduleAddress.String()
 	for _, iss := range req.Issuance {
 		sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -56,11 +71,22 @@ func (k Keeper) MintBatchCredits(ctx context.Context, req *core.MsgMintBatchCred
 		if err != nil {
 			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address %s: %s", iss.Recipient, err.Error())
 		}
-		decs, err := utils.GetNonNegativeFixedDecs(precision, iss.TradableAmount, iss.RetiredAmount)
+		decs, err := utils.GetNonNegativeFixedDecs(precision, iss.TradableAmount, iss.RetiredAmount, iss.LockedAmount)
+		if err != nil {
+			return nil, err
+		}
+		tradable, retired, locked := decs[0], decs[1], decs[2]
+
+		// withhold the buffer pool share of the tradable amount from the recipient
+		// (withheld credits are counted in the tradable supply)
+		recipientTradable, bufferAmount, err := k.withholdBuffer(ctx, class.Id, batch.Key, batch.Denom, tradable, bufferFraction, precision)
 		if err != nil {
 			return nil, err
 		}
-		tradable, retired := decs[0], decs[1]
+		transferTradable := iss.TradableAmount
+		if !bufferAmount.IsZero() {
+			transferTradable = recipientTradable.String()
+		}
 
 		balance, err := utils.GetBalance(ctx, k.stateStore.BatchBalanceTable(), recipient, batch.Key)
 		if err != nil {
@@ -87,11 +113,22 @@ func (k Keeper) MintBatchCredits(ctx context.Context, req *core.MsgMintBatchCred
 			if err != nil {
 				return nil, err
 			}
+			retirementId, err := utils.InsertRetirement(ctx, k.stateStore.RetirementTable(), &api.Retirement{
+				Owner:        recipient,
+				BatchKey:     batch.Key,
+				ProjectKey:   batch.ProjectKey,
+				Amount:       iss.RetiredAmount,
+				Jurisdiction: iss.RetirementJurisdiction,
+			})
+			if err != nil {
+				return nil, err
+			}
 			if err := sdkCtx.EventManager().EmitTypedEvent(&core.EventRetire{
 				Owner:        iss.Recipient,
 				BatchDenom:   req.BatchDenom,
 				Amount:       iss.RetiredAmount,
 				Jurisdiction: iss.RetirementJurisdiction,
+				RetirementId: retirementId,
 			}); err != nil {
 				return nil, err
 			}
@@ -99,7 +136,7 @@ func (k Keeper) MintBatchCredits(ctx context.Context, req *core.MsgMintBatchCred
 			supply.RetiredAmount = supplyRetired.String()
 		}
 		if !tradable.IsZero() {
-			balanceTradable, err = balanceTradable.Add(tradable)
+			balanceTradable, err = balanceTradable.Add(recipientTradable)
 			if err != nil {
 				return nil, err
 			}
@@ -111,7 +148,7 @@ func (k Keeper) MintBatchCredits(ctx context.Context, req *core.MsgMintBatchCred
 				Sender:         moduleAddrString, // ecocredit module
 				Recipient:      iss.Recipient,
 				BatchDenom:     req.BatchDenom,
-				TradableAmount: iss.TradableAmount,
+				TradableAmount: transferTradable,
 				RetiredAmount:  iss.RetiredAmount,
 			}); err != nil {
 				return nil, err
@@ -119,12 +156,25 @@ func (k Keeper) MintBatchCredits(ctx context.Context, req *core.MsgMintBatchCred
 			balance.TradableAmount = balanceTradable.String()
 			supply.TradableAmount = supplyTradable.String()
 		}
+		// locked credits are counted in the tradable supply
+		if !locked.IsZero() {
+			supplyTradable, err = supplyTradable.Add(locked)
+			if err != nil {
+				return nil, err
+			}
+			supply.TradableAmount = supplyTradable.String()
+		}
 		if err := k.stateStore.BatchBalanceTable().Save(ctx, balance); err != nil {
 			return nil, err
 		}
 		if err := k.stateStore.BatchSupplyTable().Update(ctx, supply); err != nil {
 			return nil, err
 		}
+		if !locked.IsZero() {
+			if _, err := k.lockCredits(ctx, recipient, batch.Key, batch.Denom, locked, iss.LockSchedule); err != nil {
+				return nil, err
+			}
+		}
 
 		if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&core.EventMint{
 			BatchDenom:     batch.Denom,
@@ -73,12 +73,22 @@ func (s *mintBatchCredits) AProjectWithId(a string) {
 	pKey, err := s.k.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
 		Id:       a,
 		ClassKey: s.classKey,
+		Status:   api.ProjectStatus_PROJECT_STATUS_ACTIVE,
 	})
 	require.NoError(s.t, err)
 
 	s.projectKey = pKey
 }
 
+func (s *mintBatchCredits) ProjectHasStatus(a, b string) {
+	project, err := s.k.stateStore.ProjectTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	project.Status = api.ProjectStatus(api.ProjectStatus_value[b])
+	err = s.k.stateStore.ProjectTable().Update(s.ctx, project)
+	require.NoError(s.t, err)
+}
+
 func (s *mintBatchCredits) ACreditBatchWithDenomAndIssuerAlice(a string) {
 	projectId := core.GetProjectIdFromBatchDenom(a)
 
@@ -0,0 +1,150 @@
+package core
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// ReportReversal records a reversal against a project and cancels credits held
+// in the buffer pool of the credit class of the project.
+func (k Keeper) ReportReversal(ctx context.Context, req *core.MsgReportReversal) (*core.MsgReportReversalResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	reqAddr, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := k.stateStore.ProjectTable().GetById(ctx, req.ProjectId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get project with id %s: %s", req.ProjectId, err.Error())
+	}
+
+	class, err := k.stateStore.ClassTable().Get(ctx, project.ClassKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if !reqAddr.Equals(sdk.AccAddress(class.Admin)) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"%s is not the admin of credit class %s", req.Admin, class.Id,
+		)
+	}
+
+	bufferAddr := core.BufferAddress(class.Id)
+	reversalIds := make([]uint64, 0, len(req.Credits))
+
+	for _, credit := range req.Credits {
+		batch, err := k.stateStore.BatchTable().GetByDenom(ctx, credit.BatchDenom)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", credit.BatchDenom, err.Error())
+		}
+
+		batchProject, err := k.stateStore.ProjectTable().Get(ctx, batch.ProjectKey)
+		if err != nil {
+			return nil, err
+		}
+
+		if batchProject.ClassKey != class.Key {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"credit batch %s is not within credit class %s", credit.BatchDenom, class.Id,
+			)
+		}
+
+		creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.stateStore, batch.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		bufferBalance, err := utils.GetBalance(ctx, k.stateStore.BatchBalanceTable(), bufferAddr, batch.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		batchSupply, err := k.stateStore.BatchSupplyTable().Get(ctx, batch.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		decs, err := utils.GetNonNegativeFixedDecs(creditType.Precision, credit.Amount, bufferBalance.TradableAmount, batchSupply.TradableAmount, batchSupply.CancelledAmount)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+		amount, bufferTradable, supplyTradable, supplyCancelled := decs[0], decs[1], decs[2], decs[3]
+
+		bufferTradable, err = math.SafeSubBalance(bufferTradable, amount)
+		if err != nil {
+			return nil, ecocredit.ErrInsufficientCredits.Wrapf(
+				"buffer pool tradable balance: %s, cancel amount %s", decs[1], amount,
+			)
+		}
+
+		supplyTradable, err = math.SafeSubBalance(supplyTradable, amount)
+		if err != nil {
+			return nil, err
+		}
+
+		supplyCancelled, err = supplyCancelled.Add(amount)
+		if err != nil {
+			return nil, err
+		}
+
+		bufferBalance.TradableAmount = bufferTradable.String()
+		if err = k.stateStore.BatchBalanceTable().Save(ctx, bufferBalance); err != nil {
+			return nil, err
+		}
+
+		batchSupply.TradableAmount = supplyTradable.String()
+		batchSupply.CancelledAmount = supplyCancelled.String()
+		if err = k.stateStore.BatchSupplyTable().Update(ctx, batchSupply); err != nil {
+			return nil, err
+		}
+
+		reversalId, err := k.stateStore.ReversalTable().InsertReturningID(ctx, &api.Reversal{
+			ProjectKey:  project.Key,
+			BatchKey:    batch.Key,
+			Amount:      credit.Amount,
+			Evidence:    req.Evidence,
+			Reporter:    reqAddr,
+			BlockHeight: sdkCtx.BlockHeight(),
+			Timestamp:   timestamppb.New(sdkCtx.BlockTime()),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if err = sdkCtx.EventManager().EmitTypedEvent(&core.EventCancel{
+			Owner:      bufferAddr.String(),
+			BatchDenom: credit.BatchDenom,
+			Amount:     credit.Amount,
+			Reason:     req.Evidence,
+		}); err != nil {
+			return nil, err
+		}
+
+		if err = sdkCtx.EventManager().EmitTypedEvent(&core.EventReversal{
+			ReversalId: reversalId,
+			ProjectId:  project.Id,
+			BatchDenom: credit.BatchDenom,
+			Amount:     credit.Amount,
+			Evidence:   req.Evidence,
+		}); err != nil {
+			return nil, err
+		}
+
+		reversalIds = append(reversalIds, reversalId)
+
+		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/core/MsgReportReversal credit iteration")
+	}
+
+	return &core.MsgReportReversalResponse{ReversalIds: reversalIds}, nil
+}
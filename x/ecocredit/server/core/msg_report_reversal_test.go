@@ -0,0 +1,160 @@
+package core
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+type reportReversalSuite struct {
+	*baseSuite
+	alice      sdk.AccAddress
+	bob        sdk.AccAddress
+	batchKey   uint64
+	batchDenom string
+	res        *core.MsgReportReversalResponse
+	err        error
+}
+
+func TestReportReversal(t *testing.T) {
+	gocuke.NewRunner(t, &reportReversalSuite{}).Path("./features/msg_report_reversal.feature").Run()
+}
+
+func (s *reportReversalSuite) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *reportReversalSuite) ACreditTypeWithAbbreviationAndPrecision(a, b string) {
+	precision, err := strconv.ParseUint(b, 10, 32)
+	require.NoError(s.t, err)
+
+	err = s.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: a,
+		Name:         a,
+		Precision:    uint32(precision),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *reportReversalSuite) ACreditClassWithClassIdAndAdminAlice(a string) {
+	err := s.stateStore.ClassTable().Insert(s.ctx, &api.Class{
+		Id:               a,
+		Admin:            s.alice,
+		CreditTypeAbbrev: core.GetCreditTypeAbbrevFromClassId(a),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *reportReversalSuite) AProjectWithProjectId(a string) {
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, core.GetClassIdFromProjectId(a))
+	require.NoError(s.t, err)
+
+	err = s.stateStore.ProjectTable().Insert(s.ctx, &api.Project{
+		Id:       a,
+		ClassKey: class.Key,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *reportReversalSuite) ACreditBatchWithDenomAndABufferPoolBalanceOf(a, b string) {
+	project, err := s.stateStore.ProjectTable().GetById(s.ctx, core.GetProjectIdFromBatchDenom(a))
+	require.NoError(s.t, err)
+
+	s.batchKey, err = s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey: project.Key,
+		Denom:      a,
+	})
+	require.NoError(s.t, err)
+	s.batchDenom = a
+
+	err = s.stateStore.BatchSupplyTable().Insert(s.ctx, &api.BatchSupply{
+		BatchKey:        s.batchKey,
+		TradableAmount:  "20",
+		RetiredAmount:   "0",
+		CancelledAmount: "0",
+	})
+	require.NoError(s.t, err)
+
+	err = s.stateStore.BatchBalanceTable().Insert(s.ctx, &api.BatchBalance{
+		BatchKey:       s.batchKey,
+		Address:        core.BufferAddress(core.GetClassIdFromBatchDenom(a)),
+		TradableAmount: b,
+		RetiredAmount:  "0",
+		EscrowedAmount: "0",
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *reportReversalSuite) AliceAttemptsToReportAReversalAgainstProjectWithAmount(a, b string) {
+	s.reportReversal(s.alice, a, b)
+}
+
+func (s *reportReversalSuite) BobAttemptsToReportAReversalAgainstProjectWithAmount(a, b string) {
+	s.reportReversal(s.bob, a, b)
+}
+
+func (s *reportReversalSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *reportReversalSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *reportReversalSuite) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *reportReversalSuite) ExpectABufferPoolTradableBalanceOf(a string) {
+	require.NoError(s.t, s.err)
+
+	bufferAddr := core.BufferAddress(core.GetClassIdFromBatchDenom(s.batchDenom))
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, bufferAddr, s.batchKey)
+	require.NoError(s.t, err)
+	require.Equal(s.t, a, balance.TradableAmount)
+}
+
+func (s *reportReversalSuite) ExpectBatchSupplyWithTradableAmountAndCancelledAmount(a, b string) {
+	supply, err := s.stateStore.BatchSupplyTable().Get(s.ctx, s.batchKey)
+	require.NoError(s.t, err)
+	require.Equal(s.t, a, supply.TradableAmount)
+	require.Equal(s.t, b, supply.CancelledAmount)
+}
+
+func (s *reportReversalSuite) ExpectAReversalAgainstProjectWithAmount(a, b string) {
+	require.Len(s.t, s.res.ReversalIds, 1)
+
+	reversal, err := s.stateStore.ReversalTable().Get(s.ctx, s.res.ReversalIds[0])
+	require.NoError(s.t, err)
+
+	project, err := s.stateStore.ProjectTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, project.Key, reversal.ProjectKey)
+	require.Equal(s.t, s.batchKey, reversal.BatchKey)
+	require.Equal(s.t, b, reversal.Amount)
+	require.Equal(s.t, s.alice.Bytes(), reversal.Reporter)
+}
+
+func (s *reportReversalSuite) reportReversal(admin sdk.AccAddress, projectId, amount string) {
+	s.res, s.err = s.k.ReportReversal(s.ctx, &core.MsgReportReversal{
+		Admin:     admin.String(),
+		ProjectId: projectId,
+		Credits: []*core.Credits{
+			{
+				BatchDenom: s.batchDenom,
+				Amount:     amount,
+			},
+		},
+		Evidence: "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf",
+	})
+}
@@ -22,11 +22,26 @@ func (k Keeper) Retire(ctx context.Context, req *core.MsgRetire) (*core.MsgRetir
 	sdkCtx := types.UnwrapSDKContext(ctx)
 	owner, _ := sdk.AccAddressFromBech32(req.Owner)
 
+	// retired credits are attributed to the beneficiary if set, otherwise the owner
+	var beneficiary sdk.AccAddress
+	retireTo := owner
+	if len(req.BeneficiaryAddress) != 0 {
+		var err error
+		beneficiary, err = sdk.AccAddressFromBech32(req.BeneficiaryAddress)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("beneficiary address: %s", err)
+		}
+		retireTo = beneficiary
+	}
+
 	for _, credit := range req.Credits {
 		batch, err := k.stateStore.BatchTable().GetByDenom(ctx, credit.BatchDenom)
 		if err != nil {
 			return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", credit.BatchDenom, err.Error())
 		}
+		if err = utils.AssertBatchNotFrozen(batch); err != nil {
+			return nil, err
+		}
 		creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.stateStore, batch.Denom)
 		if err != nil {
 			return nil, err
@@ -48,14 +63,6 @@ func (k Keeper) Retire(ctx context.Context, req *core.MsgRetire) (*core.MsgRetir
 				"tradable balance: %s, retire amount %s", decs[1], amtToRetire,
 			)
 		}
-		userRetiredBalance, err := math.NewNonNegativeFixedDecFromString(userBalance.RetiredAmount, creditType.Precision)
-		if err != nil {
-			return nil, err
-		}
-		userRetiredBalance, err = userRetiredBalance.Add(amtToRetire)
-		if err != nil {
-			return nil, err
-		}
 		batchSupply, err := k.stateStore.BatchSupplyTable().Get(ctx, batch.Key)
 		if err != nil {
 			return nil, err
@@ -78,12 +85,17 @@ func (k Keeper) Retire(ctx context.Context, req *core.MsgRetire) (*core.MsgRetir
 			BatchKey:       batch.Key,
 			Address:        owner,
 			TradableAmount: userTradableBalance.String(),
-			RetiredAmount:  userRetiredBalance.String(),
+			RetiredAmount:  userBalance.RetiredAmount,
 			EscrowedAmount: userBalance.EscrowedAmount,
+			LockedAmount:   userBalance.LockedAmount,
 		}); err != nil {
 			return nil, err
 		}
 
+		if err = RetireAndSaveBalance(ctx, k.stateStore.BatchBalanceTable(), retireTo, batch.Key, amtToRetire); err != nil {
+			return nil, err
+		}
+
 		if err = k.stateStore.BatchSupplyTable().Update(ctx, &api.BatchSupply{
 			BatchKey:        batch.Key,
 			TradableAmount:  supplyTradable.String(),
@@ -93,11 +105,29 @@ func (k Keeper) Retire(ctx context.Context, req *core.MsgRetire) (*core.MsgRetir
 			return nil, err
 		}
 
+		retirementId, err := utils.InsertRetirement(ctx, k.stateStore.RetirementTable(), &api.Retirement{
+			Owner:              owner,
+			BatchKey:           batch.Key,
+			ProjectKey:         batch.ProjectKey,
+			Amount:             credit.Amount,
+			Jurisdiction:       req.Jurisdiction,
+			Reason:             req.Reason,
+			BeneficiaryAddress: beneficiary,
+			BeneficiaryName:    req.BeneficiaryName,
+		})
+		if err != nil {
+			return nil, err
+		}
+
 		if err = sdkCtx.EventManager().EmitTypedEvent(&core.EventRetire{
-			Owner:        req.Owner,
-			BatchDenom:   credit.BatchDenom,
-			Amount:       credit.Amount,
-			Jurisdiction: req.Jurisdiction,
+			Owner:              req.Owner,
+			BatchDenom:         credit.BatchDenom,
+			Amount:             credit.Amount,
+			Jurisdiction:       req.Jurisdiction,
+			Reason:             req.Reason,
+			RetirementId:       retirementId,
+			BeneficiaryAddress: req.BeneficiaryAddress,
+			BeneficiaryName:    req.BeneficiaryName,
 		}); err != nil {
 			return nil, err
 		}
@@ -0,0 +1,41 @@
+package core
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// RetireFrom retires credits from the owner on behalf of the owner. The amount of each
+// credit batch is spent from the allowances the owner has granted the operator.
+// WARNING: retiring credits is permanent. Retired credits cannot be un-retired.
+func (k Keeper) RetireFrom(ctx context.Context, req *core.MsgRetireFrom) (*core.MsgRetireFromResponse, error) {
+	operator, _ := sdk.AccAddressFromBech32(req.Operator)
+	owner, _ := sdk.AccAddressFromBech32(req.Owner)
+
+	for _, credit := range req.Credits {
+		amount, err := math.NewPositiveDecFromString(credit.Amount)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+
+		if err = k.spendAllowance(ctx, owner, operator, credit.BatchDenom, amount); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := k.Retire(ctx, &core.MsgRetire{
+		Owner:        req.Owner,
+		Credits:      req.Credits,
+		Jurisdiction: req.Jurisdiction,
+		Reason:       req.Reason,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &core.MsgRetireFromResponse{}, nil
+}
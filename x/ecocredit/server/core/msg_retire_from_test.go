@@ -0,0 +1,113 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+type retireFrom struct {
+	*baseSuite
+	alice      sdk.AccAddress
+	bob        sdk.AccAddress
+	classKey   uint64
+	batchDenom string
+	batchKey   uint64
+	res        *core.MsgRetireFromResponse
+	err        error
+}
+
+func TestRetireFrom(t *testing.T) {
+	gocuke.NewRunner(t, &retireFrom{}).Path("./features/msg_retire_from.feature").Run()
+}
+
+func (s *retireFrom) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+	s.batchDenom = "C01-001-20200101-20210101-001"
+}
+
+func (s *retireFrom) ACreditBatch() {
+	s.classKey, s.batchKey = allowanceBatchSetup(s.t, s.baseSuite, s.batchDenom)
+}
+
+func (s *retireFrom) AliceOwnsTradableCreditAmount(a string) {
+	err := s.k.stateStore.BatchBalanceTable().Insert(s.ctx, &api.BatchBalance{
+		BatchKey:       s.batchKey,
+		Address:        s.alice,
+		TradableAmount: a,
+		RetiredAmount:  "0",
+		EscrowedAmount: "0",
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *retireFrom) AliceHasApprovedBobForTheCreditClassWithAmount(a string) {
+	err := s.stateStore.BatchAllowanceTable().Insert(s.ctx, &api.BatchAllowance{
+		Owner:    s.alice,
+		Operator: s.bob,
+		ClassKey: s.classKey,
+		Amount:   a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *retireFrom) BobAttemptsToRetireCreditAmountFromAlice(a string) {
+	s.res, s.err = s.k.RetireFrom(s.ctx, &core.MsgRetireFrom{
+		Operator: s.bob.String(),
+		Owner:    s.alice.String(),
+		Credits: []*core.Credits{
+			{
+				BatchDenom: s.batchDenom,
+				Amount:     a,
+			},
+		},
+		Jurisdiction: "US-WA",
+	})
+}
+
+func (s *retireFrom) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *retireFrom) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *retireFrom) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *retireFrom) ExpectBobClassAllowanceAmount(a string) {
+	allowance, err := s.stateStore.BatchAllowanceTable().Get(s.ctx, s.alice, s.bob, s.classKey, 0)
+	require.NoError(s.t, err)
+	require.Equal(s.t, a, allowance.Amount)
+}
+
+func (s *retireFrom) ExpectAliceBatchBalance(a gocuke.DocString) {
+	expected := &api.BatchBalance{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, s.alice, s.batchKey)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.RetiredAmount, balance.RetiredAmount)
+	require.Equal(s.t, expected.TradableAmount, balance.TradableAmount)
+	require.Equal(s.t, expected.EscrowedAmount, balance.EscrowedAmount)
+}
+
+func (s *retireFrom) ExpectRetirementRecordForAliceWithAmount(a string) {
+	retirement, err := s.stateStore.RetirementTable().Get(s.ctx, 1)
+	require.NoError(s.t, err)
+	require.Equal(s.t, s.alice, sdk.AccAddress(retirement.Owner))
+	require.Equal(s.t, a, retirement.Amount)
+}
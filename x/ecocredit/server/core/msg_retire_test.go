@@ -17,6 +17,7 @@ import (
 type retire struct {
 	*baseSuite
 	alice            sdk.AccAddress
+	bob              sdk.AccAddress
 	creditTypeAbbrev string
 	classId          string
 	classKey         uint64
@@ -36,6 +37,7 @@ func TestRetire(t *testing.T) {
 func (s *retire) Before(t gocuke.TestingT) {
 	s.baseSuite = setupBase(t)
 	s.alice = s.addr
+	s.bob = s.addr2
 	s.creditTypeAbbrev = "C"
 	s.classId = "C01"
 	s.projectId = "C01-001"
@@ -184,6 +186,33 @@ func (s *retire) AliceAttemptsToRetireCreditsWithBatchDenom(a string) {
 	})
 }
 
+func (s *retire) AliceAttemptsToRetireCreditAmountWithReason(a, b string) {
+	s.res, s.err = s.k.Retire(s.ctx, &core.MsgRetire{
+		Owner: s.alice.String(),
+		Credits: []*core.Credits{
+			{
+				BatchDenom: s.batchDenom,
+				Amount:     a,
+			},
+		},
+		Reason: b,
+	})
+}
+
+func (s *retire) AliceAttemptsToRetireCreditAmountOnBehalfOfBob(a string) {
+	s.res, s.err = s.k.Retire(s.ctx, &core.MsgRetire{
+		Owner: s.alice.String(),
+		Credits: []*core.Credits{
+			{
+				BatchDenom: s.batchDenom,
+				Amount:     a,
+			},
+		},
+		BeneficiaryAddress: s.bob.String(),
+		BeneficiaryName:    "Bob",
+	})
+}
+
 func (s *retire) ExpectNoError() {
 	require.NoError(s.t, s.err)
 }
@@ -205,6 +234,19 @@ func (s *retire) ExpectAliceBatchBalance(a gocuke.DocString) {
 	require.Equal(s.t, expected.EscrowedAmount, balance.EscrowedAmount)
 }
 
+func (s *retire) ExpectBobBatchBalance(a gocuke.DocString) {
+	expected := &api.BatchBalance{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, s.bob, s.batchKey)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.RetiredAmount, balance.RetiredAmount)
+	require.Equal(s.t, expected.TradableAmount, balance.TradableAmount)
+	require.Equal(s.t, expected.EscrowedAmount, balance.EscrowedAmount)
+}
+
 func (s *retire) ExpectBatchSupply(a gocuke.DocString) {
 	expected := &api.BatchSupply{}
 	err := jsonpb.UnmarshalString(a.Content, expected)
@@ -217,6 +259,22 @@ func (s *retire) ExpectBatchSupply(a gocuke.DocString) {
 	require.Equal(s.t, expected.TradableAmount, balance.TradableAmount)
 }
 
+func (s *retire) ExpectRetirementRecord(a gocuke.DocString) {
+	expected := &api.Retirement{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	retirement, err := s.stateStore.RetirementTable().Get(s.ctx, expected.Id)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, s.alice.Bytes(), retirement.Owner)
+	require.Equal(s.t, s.batchKey, retirement.BatchKey)
+	require.Equal(s.t, s.projectKey, retirement.ProjectKey)
+	require.Equal(s.t, expected.Amount, retirement.Amount)
+	require.Equal(s.t, expected.Reason, retirement.Reason)
+	require.Equal(s.t, s.sdkCtx.BlockHeight(), retirement.BlockHeight)
+}
+
 func (s *retire) projectSetup() {
 	err := s.k.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
 		Abbreviation: s.creditTypeAbbrev,
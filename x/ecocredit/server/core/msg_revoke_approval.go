@@ -0,0 +1,41 @@
+package core
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// RevokeApproval removes an allowance previously granted to an operator.
+func (k Keeper) RevokeApproval(ctx context.Context, req *core.MsgRevokeApproval) (*core.MsgRevokeApprovalResponse, error) {
+	owner, _ := sdk.AccAddressFromBech32(req.Owner)
+	operator, _ := sdk.AccAddressFromBech32(req.Operator)
+
+	classKey, batchKey, _, err := k.getAllowanceScope(ctx, req.BatchDenom, req.ClassId)
+	if err != nil {
+		return nil, err
+	}
+
+	allowance, err := k.stateStore.BatchAllowanceTable().Get(ctx, owner, operator, classKey, batchKey)
+	if err != nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("allowance for operator %s: %s", req.Operator, err.Error())
+	}
+
+	if err = k.stateStore.BatchAllowanceTable().Delete(ctx, allowance); err != nil {
+		return nil, err
+	}
+
+	if err = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&core.EventRevokeApproval{
+		Owner:      req.Owner,
+		Operator:   req.Operator,
+		BatchDenom: req.BatchDenom,
+		ClassId:    req.ClassId,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &core.MsgRevokeApprovalResponse{}, nil
+}
@@ -0,0 +1,91 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+type revokeApproval struct {
+	*baseSuite
+	alice    sdk.AccAddress
+	bob      sdk.AccAddress
+	batchKey uint64
+	res      *core.MsgRevokeApprovalResponse
+	err      error
+}
+
+func TestRevokeApproval(t *testing.T) {
+	gocuke.NewRunner(t, &revokeApproval{}).Path("./features/msg_revoke_approval.feature").Run()
+}
+
+func (s *revokeApproval) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *revokeApproval) ACreditBatchWithDenom(a string) {
+	err := s.k.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: "C",
+	})
+	require.NoError(s.t, err)
+
+	cKey, err := s.k.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               "C01",
+		CreditTypeAbbrev: "C",
+	})
+	require.NoError(s.t, err)
+
+	pKey, err := s.k.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
+		Id:       "C01-001",
+		ClassKey: cKey,
+	})
+	require.NoError(s.t, err)
+
+	bKey, err := s.k.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey: pKey,
+		Denom:      a,
+	})
+	require.NoError(s.t, err)
+
+	s.batchKey = bKey
+}
+
+func (s *revokeApproval) AliceHasApprovedBobWithBatchDenomAndAmount(a, b string) {
+	err := s.stateStore.BatchAllowanceTable().Insert(s.ctx, &api.BatchAllowance{
+		Owner:    s.alice,
+		Operator: s.bob,
+		BatchKey: s.batchKey,
+		Amount:   b,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *revokeApproval) AliceAttemptsToRevokeTheApprovalOfBobWithBatchDenom(a string) {
+	s.res, s.err = s.k.RevokeApproval(s.ctx, &core.MsgRevokeApproval{
+		Owner:      s.alice.String(),
+		Operator:   s.bob.String(),
+		BatchDenom: a,
+	})
+}
+
+func (s *revokeApproval) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *revokeApproval) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *revokeApproval) ExpectBobHasNoAllowance() {
+	_, err := s.stateStore.BatchAllowanceTable().Get(s.ctx, s.alice, s.bob, 0, s.batchKey)
+	require.ErrorIs(s.t, err, ormerrors.NotFound)
+}
@@ -49,6 +49,9 @@ func (k Keeper) sendEcocredits(ctx context.Context, credit *core.MsgSend_SendCre
 	if err != nil {
 		return sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", credit.BatchDenom, err.Error())
 	}
+	if err = utils.AssertBatchNotFrozen(batch); err != nil {
+		return err
+	}
 	creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.stateStore, batch.Denom)
 	if err != nil {
 		return err
@@ -132,6 +135,7 @@ func (k Keeper) sendEcocredits(ctx context.Context, credit *core.MsgSend_SendCre
 		TradableAmount: toTradableBalance.String(),
 		RetiredAmount:  toRetiredBalance.String(),
 		EscrowedAmount: toBalance.EscrowedAmount,
+		LockedAmount:   toBalance.LockedAmount,
 	}); err != nil {
 		return err
 	}
@@ -143,6 +147,7 @@ func (k Keeper) sendEcocredits(ctx context.Context, credit *core.MsgSend_SendCre
 		TradableAmount: fromTradableBalance.String(),
 		RetiredAmount:  fromRetiredBalance.String(),
 		EscrowedAmount: fromBalance.EscrowedAmount,
+		LockedAmount:   fromBalance.LockedAmount,
 	}); err != nil {
 		return err
 	}
@@ -156,11 +161,22 @@ func (k Keeper) sendEcocredits(ctx context.Context, credit *core.MsgSend_SendCre
 		}); err != nil {
 			return err
 		}
+		retirementId, err := utils.InsertRetirement(ctx, k.stateStore.RetirementTable(), &api.Retirement{
+			Owner:        to,
+			BatchKey:     batch.Key,
+			ProjectKey:   batch.ProjectKey,
+			Amount:       sendAmtRetired.String(),
+			Jurisdiction: credit.RetirementJurisdiction,
+		})
+		if err != nil {
+			return err
+		}
 		if err = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&core.EventRetire{
 			Owner:        to.String(),
 			BatchDenom:   credit.BatchDenom,
 			Amount:       sendAmtRetired.String(),
 			Jurisdiction: credit.RetirementJurisdiction,
+			RetirementId: retirementId,
 		}); err != nil {
 			return err
 		}
@@ -0,0 +1,61 @@
+package core
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// SendFrom sends credits from the owner to a recipient on behalf of the owner. The sum of
+// the tradable amount and the retired amount of each credit batch is spent from the
+// allowances the owner has granted the operator.
+func (k Keeper) SendFrom(ctx context.Context, req *core.MsgSendFrom) (*core.MsgSendFromResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	operator, _ := sdk.AccAddressFromBech32(req.Operator)
+	owner, _ := sdk.AccAddressFromBech32(req.Owner)
+	recipient, _ := sdk.AccAddressFromBech32(req.Recipient)
+
+	for _, credit := range req.Credits {
+		tradableAmount, err := math.NewNonNegativeDecFromString(credit.TradableAmount)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+
+		retiredAmount, err := math.NewNonNegativeDecFromString(credit.RetiredAmount)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+
+		amount, err := tradableAmount.Add(retiredAmount)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = k.spendAllowance(ctx, owner, operator, credit.BatchDenom, amount); err != nil {
+			return nil, err
+		}
+
+		if err = k.sendEcocredits(ctx, credit, recipient, owner); err != nil {
+			return nil, err
+		}
+
+		if err = sdkCtx.EventManager().EmitTypedEvent(&core.EventTransfer{
+			Sender:         req.Owner,
+			Recipient:      req.Recipient,
+			BatchDenom:     credit.BatchDenom,
+			TradableAmount: credit.TradableAmount,
+			RetiredAmount:  credit.RetiredAmount,
+		}); err != nil {
+			return nil, err
+		}
+
+		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/core/MsgSendFrom credit iteration")
+	}
+
+	return &core.MsgSendFromResponse{}, nil
+}
@@ -0,0 +1,207 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+type sendFrom struct {
+	*baseSuite
+	alice      sdk.AccAddress
+	bob        sdk.AccAddress
+	classKey   uint64
+	batchDenom string
+	batchKey   uint64
+	res        *core.MsgSendFromResponse
+	err        error
+}
+
+func TestSendFrom(t *testing.T) {
+	gocuke.NewRunner(t, &sendFrom{}).Path("./features/msg_send_from.feature").Run()
+}
+
+func (s *sendFrom) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+	s.batchDenom = "C01-001-20200101-20210101-001"
+}
+
+func (s *sendFrom) ACreditBatch() {
+	s.classKey, s.batchKey = allowanceBatchSetup(s.t, s.baseSuite, s.batchDenom)
+}
+
+func (s *sendFrom) AliceOwnsTradableCreditAmount(a string) {
+	err := s.k.stateStore.BatchBalanceTable().Insert(s.ctx, &api.BatchBalance{
+		BatchKey:       s.batchKey,
+		Address:        s.alice,
+		TradableAmount: a,
+		RetiredAmount:  "0",
+		EscrowedAmount: "0",
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *sendFrom) AliceHasApprovedBobForTheCreditBatchWithAmount(a string) {
+	err := s.stateStore.BatchAllowanceTable().Insert(s.ctx, &api.BatchAllowance{
+		Owner:    s.alice,
+		Operator: s.bob,
+		BatchKey: s.batchKey,
+		Amount:   a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *sendFrom) AliceHasApprovedBobForTheCreditBatchWithAmountAndExpiration(a, b string) {
+	expiration, err := types.ParseDate("expiration", b)
+	require.NoError(s.t, err)
+
+	err = s.stateStore.BatchAllowanceTable().Insert(s.ctx, &api.BatchAllowance{
+		Owner:      s.alice,
+		Operator:   s.bob,
+		BatchKey:   s.batchKey,
+		Amount:     a,
+		Expiration: timestamppb.New(expiration),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *sendFrom) AliceHasApprovedBobForTheCreditClassWithAmount(a string) {
+	err := s.stateStore.BatchAllowanceTable().Insert(s.ctx, &api.BatchAllowance{
+		Owner:    s.alice,
+		Operator: s.bob,
+		ClassKey: s.classKey,
+		Amount:   a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *sendFrom) TheBlockTime(a string) {
+	blockTime, err := types.ParseDate("block time", a)
+	require.NoError(s.t, err)
+
+	s.sdkCtx = s.sdkCtx.WithBlockTime(blockTime)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+}
+
+func (s *sendFrom) BobAttemptsToSendCreditsFromAliceToBobWithTradableAmount(a string) {
+	s.res, s.err = s.k.SendFrom(s.ctx, &core.MsgSendFrom{
+		Operator:  s.bob.String(),
+		Owner:     s.alice.String(),
+		Recipient: s.bob.String(),
+		Credits: []*core.MsgSend_SendCredits{
+			{
+				BatchDenom:     s.batchDenom,
+				TradableAmount: a,
+			},
+		},
+	})
+}
+
+func (s *sendFrom) BobAttemptsToSendCreditsFromAliceToBobWithRetiredAmount(a string) {
+	s.res, s.err = s.k.SendFrom(s.ctx, &core.MsgSendFrom{
+		Operator:  s.bob.String(),
+		Owner:     s.alice.String(),
+		Recipient: s.bob.String(),
+		Credits: []*core.MsgSend_SendCredits{
+			{
+				BatchDenom:             s.batchDenom,
+				RetiredAmount:          a,
+				RetirementJurisdiction: "US-WA",
+			},
+		},
+	})
+}
+
+func (s *sendFrom) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *sendFrom) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *sendFrom) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *sendFrom) ExpectBobAllowanceAmount(a string) {
+	allowance, err := s.stateStore.BatchAllowanceTable().Get(s.ctx, s.alice, s.bob, 0, s.batchKey)
+	require.NoError(s.t, err)
+	require.Equal(s.t, a, allowance.Amount)
+}
+
+func (s *sendFrom) ExpectBobHasNoAllowance() {
+	_, err := s.stateStore.BatchAllowanceTable().Get(s.ctx, s.alice, s.bob, 0, s.batchKey)
+	require.ErrorIs(s.t, err, ormerrors.NotFound)
+}
+
+func (s *sendFrom) ExpectAliceBatchBalance(a gocuke.DocString) {
+	s.expectBatchBalance(s.alice, a)
+}
+
+func (s *sendFrom) ExpectBobBatchBalance(a gocuke.DocString) {
+	s.expectBatchBalance(s.bob, a)
+}
+
+func (s *sendFrom) expectBatchBalance(addr sdk.AccAddress, a gocuke.DocString) {
+	expected := &api.BatchBalance{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, addr, s.batchKey)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.RetiredAmount, balance.RetiredAmount)
+	require.Equal(s.t, expected.TradableAmount, balance.TradableAmount)
+	require.Equal(s.t, expected.EscrowedAmount, balance.EscrowedAmount)
+}
+
+// allowanceBatchSetup inserts a credit class, project, and credit batch with a supply of
+// tradable credits and returns the class key and the batch key.
+func allowanceBatchSetup(t gocuke.TestingT, s *baseSuite, batchDenom string) (classKey, batchKey uint64) {
+	err := s.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: "C",
+		Precision:    6,
+	})
+	require.NoError(t, err)
+
+	classKey, err = s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               "C01",
+		CreditTypeAbbrev: "C",
+	})
+	require.NoError(t, err)
+
+	projectKey, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
+		Id:       "C01-001",
+		ClassKey: classKey,
+	})
+	require.NoError(t, err)
+
+	batchKey, err = s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey: projectKey,
+		Denom:      batchDenom,
+	})
+	require.NoError(t, err)
+
+	err = s.stateStore.BatchSupplyTable().Insert(s.ctx, &api.BatchSupply{
+		BatchKey:        batchKey,
+		TradableAmount:  "20",
+		RetiredAmount:   "0",
+		CancelledAmount: "0",
+	})
+	require.NoError(t, err)
+
+	return classKey, batchKey
+}
@@ -0,0 +1,77 @@
+package core
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// SendLocked sends tradable credits from the sender to the recipient where the credits are
+// locked and released into the tradable balance of the recipient according to the lock schedule.
+func (k Keeper) SendLocked(ctx context.Context, req *core.MsgSendLocked) (*core.MsgSendLockedResponse, error) {
+	sender, _ := sdk.AccAddressFromBech32(req.Sender)
+	recipient, _ := sdk.AccAddressFromBech32(req.Recipient)
+
+	batch, err := k.stateStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", req.BatchDenom, err.Error())
+	}
+
+	if err = utils.AssertBatchNotFrozen(batch); err != nil {
+		return nil, err
+	}
+
+	creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.stateStore, batch.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	senderBalance, err := k.stateStore.BatchBalanceTable().Get(ctx, sender, batch.Key)
+	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			return nil, ecocredit.ErrInsufficientCredits.Wrapf("you do not have any credits from batch %s", batch.Denom)
+		}
+		return nil, err
+	}
+
+	decs, err := utils.GetNonNegativeFixedDecs(creditType.Precision, req.Amount, senderBalance.TradableAmount)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	amount, senderTradable := decs[0], decs[1]
+
+	senderTradable, err = math.SafeSubBalance(senderTradable, amount)
+	if err != nil {
+		return nil, ecocredit.ErrInsufficientCredits.Wrapf(
+			"tradable balance: %s, send amount %s", decs[1], amount,
+		)
+	}
+
+	senderBalance.TradableAmount = senderTradable.String()
+	if err = k.stateStore.BatchBalanceTable().Update(ctx, senderBalance); err != nil {
+		return nil, err
+	}
+
+	lockId, err := k.lockCredits(ctx, recipient, batch.Key, batch.Denom, amount, req.LockSchedule)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&core.EventTransfer{
+		Sender:       req.Sender,
+		Recipient:    req.Recipient,
+		BatchDenom:   req.BatchDenom,
+		LockedAmount: amount.String(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &core.MsgSendLockedResponse{LockId: lockId}, nil
+}
@@ -0,0 +1,107 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+type sendLocked struct {
+	*baseSuite
+	alice      sdk.AccAddress
+	bob        sdk.AccAddress
+	batchDenom string
+	batchKey   uint64
+	res        *core.MsgSendLockedResponse
+	err        error
+}
+
+func TestSendLocked(t *testing.T) {
+	gocuke.NewRunner(t, &sendLocked{}).Path("./features/msg_send_locked.feature").Run()
+}
+
+func (s *sendLocked) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+	s.batchDenom = "C01-001-20200101-20210101-001"
+}
+
+func (s *sendLocked) ACreditBatch() {
+	_, s.batchKey = allowanceBatchSetup(s.t, s.baseSuite, s.batchDenom)
+}
+
+func (s *sendLocked) AliceOwnsTradableCreditAmount(a string) {
+	err := s.k.stateStore.BatchBalanceTable().Insert(s.ctx, &api.BatchBalance{
+		BatchKey:       s.batchKey,
+		Address:        s.alice,
+		TradableAmount: a,
+		RetiredAmount:  "0",
+		EscrowedAmount: "0",
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *sendLocked) AliceHasSentLockedCreditAmountToBob(a string) {
+	s.AliceAttemptsToSendLockedCreditAmountToBob(a)
+	require.NoError(s.t, s.err)
+}
+
+func (s *sendLocked) AliceAttemptsToSendLockedCreditAmountToBob(a string) {
+	startTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	s.res, s.err = s.k.SendLocked(s.ctx, &core.MsgSendLocked{
+		Sender:       s.alice.String(),
+		Recipient:    s.bob.String(),
+		BatchDenom:   s.batchDenom,
+		Amount:       a,
+		LockSchedule: &core.LockSchedule{StartTime: &startTime},
+	})
+}
+
+func (s *sendLocked) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *sendLocked) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *sendLocked) ExpectAliceBatchBalance(a gocuke.DocString) {
+	s.expectBatchBalance(s.alice, a)
+}
+
+func (s *sendLocked) ExpectBobBatchBalance(a gocuke.DocString) {
+	s.expectBatchBalance(s.bob, a)
+}
+
+func (s *sendLocked) ExpectBobLockWithAmount(a string) {
+	lock, err := s.stateStore.BatchLockTable().Get(s.ctx, s.res.LockId)
+	require.NoError(s.t, err)
+	require.Equal(s.t, s.bob, sdk.AccAddress(lock.Address))
+	require.Equal(s.t, s.batchKey, lock.BatchKey)
+	require.Equal(s.t, a, lock.Amount)
+	require.Equal(s.t, "0", lock.ReleasedAmount)
+}
+
+func (s *sendLocked) expectBatchBalance(addr sdk.AccAddress, a gocuke.DocString) {
+	expected := &api.BatchBalance{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, addr, s.batchKey)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.TradableAmount, balance.TradableAmount)
+	require.Equal(s.t, expected.RetiredAmount, balance.RetiredAmount)
+	require.Equal(s.t, expected.EscrowedAmount, balance.EscrowedAmount)
+	require.Equal(s.t, expected.LockedAmount, balance.LockedAmount)
+}
@@ -0,0 +1,21 @@
+package core
+
+import (
+	"context"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// UnfreezeBatch unfreezes a frozen credit batch.
+func (k Keeper) UnfreezeBatch(ctx context.Context, req *core.MsgUnfreezeBatch) (*core.MsgUnfreezeBatchResponse, error) {
+	batch, err := k.getBatchForClassAdmin(ctx, req.Admin, req.BatchDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.setBatchFrozen(ctx, batch, false, req.Evidence, req.Reason); err != nil {
+		return nil, err
+	}
+
+	return &core.MsgUnfreezeBatchResponse{}, nil
+}
@@ -0,0 +1,12 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/regen-network/gocuke"
+)
+
+// TestUnfreezeBatch reuses the freezeBatch steps defined in msg_freeze_batch_test.go.
+func TestUnfreezeBatch(t *testing.T) {
+	gocuke.NewRunner(t, &freezeBatch{}).Path("./features/msg_unfreeze_batch.feature").Run()
+}
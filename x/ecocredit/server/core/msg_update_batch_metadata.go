@@ -0,0 +1,60 @@
+package core
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// UpdateBatchMetadata updates the metadata of an open credit batch. Only the
+// issuer of the credit batch can update the metadata.
+func (k Keeper) UpdateBatchMetadata(ctx context.Context, req *core.MsgUpdateBatchMetadata) (*core.MsgUpdateBatchMetadataResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	issuer, err := sdk.AccAddressFromBech32(req.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := k.stateStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", req.BatchDenom, err.Error())
+	}
+
+	if !sdk.AccAddress(batch.Issuer).Equals(issuer) {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("only the batch issuer can update the batch metadata")
+	}
+
+	if !batch.Open {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("credit batch %s is not open", req.BatchDenom)
+	}
+
+	previousMetadata := batch.Metadata
+	batch.Metadata = req.NewMetadata
+	if err := k.stateStore.BatchTable().Update(ctx, batch); err != nil {
+		return nil, err
+	}
+
+	if err := k.stateStore.BatchMetadataChangeTable().Insert(ctx, &api.BatchMetadataChange{
+		BatchKey:         batch.Key,
+		PreviousMetadata: previousMetadata,
+		Metadata:         req.NewMetadata,
+		Signer:           issuer,
+		BlockHeight:      sdkCtx.BlockHeight(),
+		Timestamp:        timestamppb.New(sdkCtx.BlockTime()),
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&core.EventUpdateBatchMetadata{
+		BatchDenom: batch.Denom,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &core.MsgUpdateBatchMetadataResponse{}, nil
+}
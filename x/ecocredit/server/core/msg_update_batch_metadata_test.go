@@ -0,0 +1,157 @@
+package core
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+type updateBatchMetadata struct {
+	*baseSuite
+	alice            sdk.AccAddress
+	bob              sdk.AccAddress
+	creditTypeAbbrev string
+	classKey         uint64
+	batchDenom       string
+	res              *core.MsgUpdateBatchMetadataResponse
+	err              error
+}
+
+func TestUpdateBatchMetadata(t *testing.T) {
+	gocuke.NewRunner(t, &updateBatchMetadata{}).Path("./features/msg_update_batch_metadata.feature").Run()
+}
+
+func (s *updateBatchMetadata) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *updateBatchMetadata) ACreditTypeWithAbbreviation(a string) {
+	err := s.k.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: a,
+		Name:         a,
+	})
+	require.NoError(s.t, err)
+
+	s.creditTypeAbbrev = a
+}
+
+func (s *updateBatchMetadata) ACreditClassWithIdAndIssuerAlice(a string) {
+	cKey, err := s.k.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               a,
+		CreditTypeAbbrev: s.creditTypeAbbrev,
+	})
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.ClassIssuerTable().Insert(s.ctx, &api.ClassIssuer{
+		ClassKey: cKey,
+		Issuer:   s.alice,
+	})
+	require.NoError(s.t, err)
+
+	s.classKey = cKey
+}
+
+func (s *updateBatchMetadata) AProjectWithId(a string) {
+	err := s.k.stateStore.ProjectTable().Insert(s.ctx, &api.Project{
+		Id:       a,
+		ClassKey: s.classKey,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateBatchMetadata) ACreditBatchWithDenomAndIssuerAlice(a string) {
+	projectId := core.GetProjectIdFromBatchDenom(a)
+
+	project, err := s.k.stateStore.ProjectTable().GetById(s.ctx, projectId)
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.BatchTable().Insert(s.ctx, &api.Batch{
+		ProjectKey: project.Key,
+		Issuer:     s.alice,
+		Denom:      a,
+		Open:       true,
+	})
+	require.NoError(s.t, err)
+
+	s.batchDenom = a
+}
+
+func (s *updateBatchMetadata) TheCreditBatchIsSealed() {
+	batch, err := s.k.stateStore.BatchTable().GetByDenom(s.ctx, s.batchDenom)
+	require.NoError(s.t, err)
+
+	batch.Open = false
+
+	err = s.k.stateStore.BatchTable().Update(s.ctx, batch)
+	require.NoError(s.t, err)
+}
+
+func (s *updateBatchMetadata) AliceAttemptsToUpdateBatchMetadataWithDenom(a string) {
+	s.res, s.err = s.k.UpdateBatchMetadata(s.ctx, &core.MsgUpdateBatchMetadata{
+		Issuer:     s.alice.String(),
+		BatchDenom: a,
+	})
+}
+
+func (s *updateBatchMetadata) BobAttemptsToUpdateBatchMetadataWithDenom(a string) {
+	s.res, s.err = s.k.UpdateBatchMetadata(s.ctx, &core.MsgUpdateBatchMetadata{
+		Issuer:     s.bob.String(),
+		BatchDenom: a,
+	})
+}
+
+func (s *updateBatchMetadata) AliceAttemptsToUpdateBatchMetadataWithDenomAndNewMetadata(a string, b gocuke.DocString) {
+	s.res, s.err = s.k.UpdateBatchMetadata(s.ctx, &core.MsgUpdateBatchMetadata{
+		Issuer:      s.alice.String(),
+		BatchDenom:  a,
+		NewMetadata: b.Content,
+	})
+}
+
+func (s *updateBatchMetadata) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *updateBatchMetadata) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *updateBatchMetadata) ExpectCreditBatchWithDenomAndMetadata(a string, b gocuke.DocString) {
+	batch, err := s.stateStore.BatchTable().GetByDenom(s.ctx, a)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, b.Content, batch.Metadata)
+}
+
+func (s *updateBatchMetadata) ExpectBatchMetadataChangeWithPreviousMetadataAndMetadata(a string, b gocuke.DocString) {
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	change, err := s.stateStore.BatchMetadataChangeTable().Get(s.ctx, id)
+	require.NoError(s.t, err)
+
+	metadata := strings.Split(b.Content, "\n")
+	require.Len(s.t, metadata, 2)
+	require.Equal(s.t, metadata[0], change.PreviousMetadata)
+	require.Equal(s.t, metadata[1], change.Metadata)
+}
+
+func (s *updateBatchMetadata) ExpectBatchMetadataChangeSignedByAlice(a string) {
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	change, err := s.stateStore.BatchMetadataChangeTable().Get(s.ctx, id)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, s.alice.String(), sdk.AccAddress(change.Signer).String())
+}
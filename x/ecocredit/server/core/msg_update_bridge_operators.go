@@ -0,0 +1,84 @@
+package core
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// UpdateBridgeOperators sets the bridge operator set of a credit class for the given source. An
+// empty list of operators removes the bridge operator set from the credit class.
+func (k Keeper) UpdateBridgeOperators(ctx context.Context, req *core.MsgUpdateBridgeOperators) (*core.MsgUpdateBridgeOperatorsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	reqAddr, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"could not get credit class with id %s: %s", req.ClassId, err,
+		)
+	}
+
+	admin := sdk.AccAddress(class.Admin)
+	if !reqAddr.Equals(admin) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"%s is not the admin of credit class %s", req.Admin, req.ClassId,
+		)
+	}
+
+	if len(req.Operators) == 0 {
+		err = k.stateStore.BridgeOperatorSetTable().Delete(ctx, &api.BridgeOperatorSet{
+			ClassKey: class.Key,
+			Source:   req.Source,
+		})
+		if err != nil && !ormerrors.NotFound.Is(err) {
+			return nil, err
+		}
+	} else {
+		operators := make([][]byte, len(req.Operators))
+		for i, operator := range req.Operators {
+			operatorAcc, err := sdk.AccAddressFromBech32(operator)
+			if err != nil {
+				return nil, err
+			}
+			operators[i] = operatorAcc
+
+			sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/core/MsgUpdateBridgeOperators operator iteration")
+		}
+
+		operatorSet := &api.BridgeOperatorSet{
+			ClassKey:  class.Key,
+			Source:    req.Source,
+			Operators: operators,
+			Threshold: req.Threshold,
+			Timeout:   durationpb.New(*req.Timeout),
+		}
+
+		if req.OutboundTimeout != nil {
+			operatorSet.OutboundTimeout = durationpb.New(*req.OutboundTimeout)
+		}
+
+		if err = k.stateStore.BridgeOperatorSetTable().Save(ctx, operatorSet); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&core.EventUpdateBridgeOperators{
+		ClassId: req.ClassId,
+		Source:  req.Source,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &core.MsgUpdateBridgeOperatorsResponse{}, nil
+}
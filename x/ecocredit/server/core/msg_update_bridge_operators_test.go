@@ -0,0 +1,52 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestUpdateBridgeOperators(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	classKey, operators := bridgeOperatorSetup(t, s, "polygon", 2)
+
+	operatorSet, err := s.stateStore.BridgeOperatorSetTable().Get(s.ctx, classKey, "polygon")
+	assert.NilError(t, err)
+	assert.Equal(t, 3, len(operatorSet.Operators))
+	assert.DeepEqual(t, []byte(operators[0]), operatorSet.Operators[0])
+	assert.Equal(t, uint32(2), operatorSet.Threshold)
+	assert.Equal(t, time.Hour, operatorSet.Timeout.AsDuration())
+
+	// only the class admin can update the bridge operators
+	timeout := time.Hour
+	_, err = s.k.UpdateBridgeOperators(s.ctx, &core.MsgUpdateBridgeOperators{
+		Admin:     s.addr2.String(),
+		ClassId:   "C01",
+		Source:    "polygon",
+		Operators: []string{s.addr2.String()},
+		Threshold: 1,
+		Timeout:   &timeout,
+	})
+	assert.ErrorContains(t, err, "is not the admin of credit class C01: unauthorized")
+
+	// an empty list of operators removes the bridge operator set
+	_, err = s.k.UpdateBridgeOperators(s.ctx, &core.MsgUpdateBridgeOperators{
+		Admin:   s.addr.String(),
+		ClassId: "C01",
+		Source:  "polygon",
+	})
+	assert.NilError(t, err)
+
+	_, err = s.stateStore.BridgeOperatorSetTable().Get(s.ctx, classKey, "polygon")
+	assert.ErrorIs(t, err, ormerrors.NotFound)
+
+	// credits can be bridged directly once the bridge operator set is removed
+	_, err = s.k.BridgeReceive(s.ctx, attestBridgeReceiveMsg(s, operators[0]).ToBridgeReceive())
+	assert.NilError(t, err)
+}
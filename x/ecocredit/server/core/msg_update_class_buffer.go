@@ -0,0 +1,65 @@
+package core
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// UpdateClassBuffer updates the buffer percentage of a credit class. A buffer
+// percentage of zero removes the buffer percentage from the credit class.
+func (k Keeper) UpdateClassBuffer(ctx context.Context, req *core.MsgUpdateClassBuffer) (*core.MsgUpdateClassBufferResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	reqAddr, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"could not get credit class with id %s: %s", req.ClassId, err,
+		)
+	}
+
+	admin := sdk.AccAddress(class.Admin)
+	if !reqAddr.Equals(admin) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"%s is not the admin of credit class %s", req.Admin, req.ClassId,
+		)
+	}
+
+	percentage, err := math.NewNonNegativeDecFromString(req.Percentage)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("percentage: %s", err)
+	}
+
+	if percentage.IsZero() {
+		err = k.stateStore.ClassBufferTable().Delete(ctx, &api.ClassBuffer{ClassKey: class.Key})
+		if err != nil && !ormerrors.NotFound.Is(err) {
+			return nil, err
+		}
+	} else {
+		if err = k.stateStore.ClassBufferTable().Save(ctx, &api.ClassBuffer{
+			ClassKey:   class.Key,
+			Percentage: req.Percentage,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&core.EventUpdateClassBuffer{
+		ClassId:    req.ClassId,
+		Percentage: req.Percentage,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &core.MsgUpdateClassBufferResponse{}, nil
+}
@@ -0,0 +1,109 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+type updateClassBuffer struct {
+	*baseSuite
+	alice sdk.AccAddress
+	bob   sdk.AccAddress
+	res   *core.MsgUpdateClassBufferResponse
+	err   error
+}
+
+func TestUpdateClassBuffer(t *testing.T) {
+	gocuke.NewRunner(t, &updateClassBuffer{}).Path("./features/msg_update_class_buffer.feature").Run()
+}
+
+func (s *updateClassBuffer) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *updateClassBuffer) ACreditTypeWithAbbreviation(a string) {
+	err := s.k.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: a,
+		Name:         a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateClassBuffer) ACreditClassWithClassIdAndAdminAlice(a string) {
+	creditTypeAbbrev := core.GetCreditTypeAbbrevFromClassId(a)
+
+	err := s.k.stateStore.ClassTable().Insert(s.ctx, &api.Class{
+		Id:               a,
+		Admin:            s.alice,
+		CreditTypeAbbrev: creditTypeAbbrev,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateClassBuffer) AliceUpdatedTheBufferPercentageOfClassTo(a, b string) {
+	_, err := s.k.UpdateClassBuffer(s.ctx, &core.MsgUpdateClassBuffer{
+		Admin:      s.alice.String(),
+		ClassId:    a,
+		Percentage: b,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateClassBuffer) AliceAttemptsToUpdateTheBufferPercentageOfClassTo(a, b string) {
+	s.res, s.err = s.k.UpdateClassBuffer(s.ctx, &core.MsgUpdateClassBuffer{
+		Admin:      s.alice.String(),
+		ClassId:    a,
+		Percentage: b,
+	})
+}
+
+func (s *updateClassBuffer) BobAttemptsToUpdateTheBufferPercentageOfClassTo(a, b string) {
+	s.res, s.err = s.k.UpdateClassBuffer(s.ctx, &core.MsgUpdateClassBuffer{
+		Admin:      s.bob.String(),
+		ClassId:    a,
+		Percentage: b,
+	})
+}
+
+func (s *updateClassBuffer) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *updateClassBuffer) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *updateClassBuffer) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *updateClassBuffer) ExpectTheBufferPercentageOfClassToBe(a, b string) {
+	require.NoError(s.t, s.err)
+
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	buffer, err := s.stateStore.ClassBufferTable().Get(s.ctx, class.Key)
+	require.NoError(s.t, err)
+	require.Equal(s.t, b, buffer.Percentage)
+}
+
+func (s *updateClassBuffer) ExpectClassToHaveNoBufferPercentage(a string) {
+	require.NoError(s.t, s.err)
+
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	_, err = s.stateStore.ClassBufferTable().Get(s.ctx, class.Key)
+	require.ErrorIs(s.t, err, ormerrors.NotFound)
+}
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
@@ -32,11 +33,23 @@ func (k Keeper) UpdateClassMetadata(ctx context.Context, req *core.MsgUpdateClas
 		)
 	}
 
+	previousMetadata := classInfo.Metadata
 	classInfo.Metadata = req.NewMetadata
 	if err = k.stateStore.ClassTable().Update(ctx, classInfo); err != nil {
 		return nil, err
 	}
 
+	if err = k.stateStore.ClassMetadataChangeTable().Insert(ctx, &api.ClassMetadataChange{
+		ClassKey:         classInfo.Key,
+		PreviousMetadata: previousMetadata,
+		Metadata:         req.NewMetadata,
+		Signer:           reqAddr,
+		BlockHeight:      sdkCtx.BlockHeight(),
+		Timestamp:        timestamppb.New(sdkCtx.BlockTime()),
+	}); err != nil {
+		return nil, err
+	}
+
 	if err = sdkCtx.EventManager().EmitTypedEvent(&api.EventUpdateClassMetadata{
 		ClassId: req.ClassId,
 	}); err != nil {
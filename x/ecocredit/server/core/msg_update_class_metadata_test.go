@@ -1,6 +1,8 @@
 package core
 
 import (
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/regen-network/gocuke"
@@ -90,3 +92,26 @@ func (s *updateClassMetadata) ExpectCreditClassWithClassIdAndMetadata(a string,
 
 	require.Equal(s.t, b.Content, class.Metadata)
 }
+
+func (s *updateClassMetadata) ExpectClassMetadataChangeWithPreviousMetadataAndMetadata(a string, b gocuke.DocString) {
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	change, err := s.stateStore.ClassMetadataChangeTable().Get(s.ctx, id)
+	require.NoError(s.t, err)
+
+	metadata := strings.Split(b.Content, "\n")
+	require.Len(s.t, metadata, 2)
+	require.Equal(s.t, metadata[0], change.PreviousMetadata)
+	require.Equal(s.t, metadata[1], change.Metadata)
+}
+
+func (s *updateClassMetadata) ExpectClassMetadataChangeSignedByAlice(a string) {
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	change, err := s.stateStore.ClassMetadataChangeTable().Get(s.ctx, id)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, s.alice.String(), sdk.AccAddress(change.Signer).String())
+}
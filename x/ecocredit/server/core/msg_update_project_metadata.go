@@ -5,12 +5,15 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
 )
 
 // UpdateProjectMetadata updates the project metadata.
 func (k Keeper) UpdateProjectMetadata(ctx context.Context, req *core.MsgUpdateProjectMetadata) (*core.MsgUpdateProjectMetadataResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	admin, err := sdk.AccAddressFromBech32(req.Admin)
 	if err != nil {
 		return nil, err
@@ -26,12 +29,24 @@ func (k Keeper) UpdateProjectMetadata(ctx context.Context, req *core.MsgUpdatePr
 			"%s is not the admin of project %s", req.Admin, req.ProjectId,
 		)
 	}
+	previousMetadata := project.Metadata
 	project.Metadata = req.NewMetadata
 	if err := k.stateStore.ProjectTable().Update(ctx, project); err != nil {
 		return nil, err
 	}
 
-	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&core.EventUpdateProjectMetadata{
+	if err := k.stateStore.ProjectMetadataChangeTable().Insert(ctx, &api.ProjectMetadataChange{
+		ProjectKey:       project.Key,
+		PreviousMetadata: previousMetadata,
+		Metadata:         req.NewMetadata,
+		Signer:           admin,
+		BlockHeight:      sdkCtx.BlockHeight(),
+		Timestamp:        timestamppb.New(sdkCtx.BlockTime()),
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&core.EventUpdateProjectMetadata{
 		ProjectId: project.Id,
 	}); err != nil {
 		return nil, err
@@ -1,6 +1,8 @@
 package core
 
 import (
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/regen-network/gocuke"
@@ -98,3 +100,26 @@ func (s *updateProjectMetadata) ExpectProjectWithProjectIdAndMetadata(a string,
 
 	require.Equal(s.t, b.Content, project.Metadata)
 }
+
+func (s *updateProjectMetadata) ExpectProjectMetadataChangeWithPreviousMetadataAndMetadata(a string, b gocuke.DocString) {
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	change, err := s.stateStore.ProjectMetadataChangeTable().Get(s.ctx, id)
+	require.NoError(s.t, err)
+
+	metadata := strings.Split(b.Content, "\n")
+	require.Len(s.t, metadata, 2)
+	require.Equal(s.t, metadata[0], change.PreviousMetadata)
+	require.Equal(s.t, metadata[1], change.Metadata)
+}
+
+func (s *updateProjectMetadata) ExpectProjectMetadataChangeSignedByAlice(a string) {
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	change, err := s.stateStore.ProjectMetadataChangeTable().Get(s.ctx, id)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, s.alice.String(), sdk.AccAddress(change.Signer).String())
+}
@@ -0,0 +1,79 @@
+package core
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// UpdateProjectStatus updates the lifecycle status of a project and records the
+// status change. Only the admin of the credit class of the project can update the
+// status and a retired project cannot change status.
+func (k Keeper) UpdateProjectStatus(ctx context.Context, req *core.MsgUpdateProjectStatus) (*core.MsgUpdateProjectStatusResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	reqAddr, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := k.stateStore.ProjectTable().GetById(ctx, req.ProjectId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get project with id %s: %s", req.ProjectId, err.Error())
+	}
+
+	class, err := k.stateStore.ClassTable().Get(ctx, project.ClassKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if !reqAddr.Equals(sdk.AccAddress(class.Admin)) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"%s is not the admin of credit class %s", req.Admin, class.Id,
+		)
+	}
+
+	status := api.ProjectStatus(req.Status)
+	previousStatus := project.Status
+
+	if previousStatus == api.ProjectStatus_PROJECT_STATUS_RETIRED {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("project %s is retired", project.Id)
+	}
+
+	if previousStatus == status {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("project %s already has status %s", project.Id, status)
+	}
+
+	project.Status = status
+	if err = k.stateStore.ProjectTable().Update(ctx, project); err != nil {
+		return nil, err
+	}
+
+	if err = k.stateStore.ProjectStatusChangeTable().Insert(ctx, &api.ProjectStatusChange{
+		ProjectKey:     project.Key,
+		PreviousStatus: previousStatus,
+		Status:         status,
+		Reason:         req.Reason,
+		Admin:          reqAddr,
+		BlockHeight:    sdkCtx.BlockHeight(),
+		Timestamp:      timestamppb.New(sdkCtx.BlockTime()),
+	}); err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&core.EventUpdateProjectStatus{
+		ProjectId:      project.Id,
+		PreviousStatus: core.ProjectStatus(previousStatus),
+		Status:         req.Status,
+		Reason:         req.Reason,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &core.MsgUpdateProjectStatusResponse{}, nil
+}
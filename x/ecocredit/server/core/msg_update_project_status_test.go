@@ -0,0 +1,150 @@
+package core
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+type updateProjectStatus struct {
+	*baseSuite
+	alice sdk.AccAddress
+	bob   sdk.AccAddress
+	res   *core.MsgUpdateProjectStatusResponse
+	err   error
+}
+
+func TestUpdateProjectStatus(t *testing.T) {
+	gocuke.NewRunner(t, &updateProjectStatus{}).Path("./features/msg_update_project_status.feature").Run()
+}
+
+func (s *updateProjectStatus) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *updateProjectStatus) ACreditTypeWithAbbreviation(a string) {
+	err := s.k.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: a,
+		Name:         a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateProjectStatus) ACreditClassWithClassIdAndAdminAlice(a string) {
+	creditTypeAbbrev := core.GetCreditTypeAbbrevFromClassId(a)
+
+	err := s.k.stateStore.ClassTable().Insert(s.ctx, &api.Class{
+		Id:               a,
+		Admin:            s.alice,
+		CreditTypeAbbrev: creditTypeAbbrev,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateProjectStatus) AProjectWithProjectId(a string) {
+	classId := core.GetClassIdFromProjectId(a)
+
+	class, err := s.k.stateStore.ClassTable().GetById(s.ctx, classId)
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.ProjectTable().Insert(s.ctx, &api.Project{
+		Id:       a,
+		ClassKey: class.Key,
+		Status:   api.ProjectStatus_PROJECT_STATUS_ACTIVE,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateProjectStatus) AliceUpdatedTheStatusOfProjectTo(a, b string) {
+	_, err := s.k.UpdateProjectStatus(s.ctx, &core.MsgUpdateProjectStatus{
+		Admin:     s.alice.String(),
+		ProjectId: a,
+		Status:    core.ProjectStatus(core.ProjectStatus_value[b]),
+		Reason:    "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf",
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateProjectStatus) AliceAttemptsToUpdateTheStatusOfProjectTo(a, b string) {
+	s.res, s.err = s.k.UpdateProjectStatus(s.ctx, &core.MsgUpdateProjectStatus{
+		Admin:     s.alice.String(),
+		ProjectId: a,
+		Status:    core.ProjectStatus(core.ProjectStatus_value[b]),
+		Reason:    "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf",
+	})
+}
+
+func (s *updateProjectStatus) BobAttemptsToUpdateTheStatusOfProjectTo(a, b string) {
+	s.res, s.err = s.k.UpdateProjectStatus(s.ctx, &core.MsgUpdateProjectStatus{
+		Admin:     s.bob.String(),
+		ProjectId: a,
+		Status:    core.ProjectStatus(core.ProjectStatus_value[b]),
+		Reason:    "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf",
+	})
+}
+
+func (s *updateProjectStatus) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *updateProjectStatus) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *updateProjectStatus) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *updateProjectStatus) ExpectProjectToHaveStatus(a, b string) {
+	require.NoError(s.t, s.err)
+
+	project, err := s.stateStore.ProjectTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+	require.Equal(s.t, b, project.Status.String())
+}
+
+func (s *updateProjectStatus) ExpectProjectToHaveStatusChanges(a, b string) {
+	require.NoError(s.t, s.err)
+
+	expected, err := strconv.Atoi(b)
+	require.NoError(s.t, err)
+
+	require.Len(s.t, s.getStatusChanges(a), expected)
+}
+
+func (s *updateProjectStatus) ExpectTheLastStatusChangeOfProjectFromTo(a, b, c string) {
+	changes := s.getStatusChanges(a)
+	require.NotEmpty(s.t, changes)
+
+	last := changes[len(changes)-1]
+	require.Equal(s.t, b, last.PreviousStatus.String())
+	require.Equal(s.t, c, last.Status.String())
+	require.Equal(s.t, s.alice, sdk.AccAddress(last.Admin))
+}
+
+func (s *updateProjectStatus) getStatusChanges(projectId string) []*api.ProjectStatusChange {
+	project, err := s.stateStore.ProjectTable().GetById(s.ctx, projectId)
+	require.NoError(s.t, err)
+
+	it, err := s.stateStore.ProjectStatusChangeTable().List(s.ctx, api.ProjectStatusChangeProjectKeyIndexKey{}.WithProjectKey(project.Key))
+	require.NoError(s.t, err)
+	defer it.Close()
+
+	var changes []*api.ProjectStatusChange
+	for it.Next() {
+		change, err := it.Value()
+		require.NoError(s.t, err)
+		changes = append(changes, change)
+	}
+
+	return changes
+}
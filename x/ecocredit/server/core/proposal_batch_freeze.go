@@ -0,0 +1,24 @@
+package core
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// BatchFreeze is a gov handler method that freezes or unfreezes a credit batch
+func (k Keeper) BatchFreeze(ctx sdk.Context, p *core.BatchFreezeProposal) error {
+	if p == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("nil proposal")
+	}
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	goCtx := sdk.WrapSDKContext(ctx)
+	batch, err := k.stateStore.BatchTable().GetByDenom(goCtx, p.BatchDenom)
+	if err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", p.BatchDenom, err.Error())
+	}
+	return k.setBatchFrozen(goCtx, batch, p.Freeze, p.Evidence, p.Reason)
+}
@@ -0,0 +1,61 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// PruneBridgeAttestations is a BeginBlock function that removes pending bridge attestations
+// that have expired before reaching the threshold of the bridge operator set.
+func (k Keeper) PruneBridgeAttestations(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// attestations always have an expiration so the 0 value timestamp can be used as the min
+	fromKey := api.BridgeAttestationExpirationIndexKey{}.WithExpiration(timestamppb.New(time.Unix(0, 0)))
+	toKey := api.BridgeAttestationExpirationIndexKey{}.WithExpiration(timestamppb.New(sdkCtx.BlockTime()))
+
+	it, err := k.stateStore.BridgeAttestationTable().ListRange(ctx, fromKey, toKey)
+	if err != nil {
+		return err
+	}
+
+	// collect attestations before deleting them to avoid modifying the table while iterating
+	var expired []*api.BridgeAttestation
+	for it.Next() {
+		attestation, err := it.Value()
+		if err != nil {
+			it.Close()
+			return err
+		}
+		expired = append(expired, attestation)
+	}
+	it.Close()
+
+	for _, attestation := range expired {
+		class, err := k.stateStore.ClassTable().Get(ctx, attestation.ClassKey)
+		if err != nil {
+			return err
+		}
+
+		if err = k.stateStore.BridgeAttestationTable().Delete(ctx, attestation); err != nil {
+			return err
+		}
+
+		if err = sdkCtx.EventManager().EmitTypedEvent(&core.EventBridgeAttestationExpired{
+			ClassId:        class.Id,
+			OriginTxId:     attestation.Id,
+			OriginTxSource: attestation.Source,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package core
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// Allowance queries the allowance an owner has granted an operator for a credit batch or credit class.
+func (k Keeper) Allowance(ctx context.Context, req *core.QueryAllowanceRequest) (*core.QueryAllowanceResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	operator, err := sdk.AccAddressFromBech32(req.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = core.ValidateAllowanceScope(req.BatchDenom, req.ClassId); err != nil {
+		return nil, err
+	}
+
+	classKey, batchKey, _, err := k.getAllowanceScope(ctx, req.BatchDenom, req.ClassId)
+	if err != nil {
+		return nil, err
+	}
+
+	allowance, err := k.stateStore.BatchAllowanceTable().Get(ctx, owner, operator, classKey, batchKey)
+	if err != nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("allowance for operator %s: %s", req.Operator, err.Error())
+	}
+
+	info, err := k.getAllowanceInfo(ctx, allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.QueryAllowanceResponse{Allowance: info}, nil
+}
+
+// getAllowanceInfo converts an allowance into its human-readable form.
+func (k Keeper) getAllowanceInfo(ctx context.Context, allowance *api.BatchAllowance) (*core.AllowanceInfo, error) {
+	info := &core.AllowanceInfo{
+		Owner:      sdk.AccAddress(allowance.Owner).String(),
+		Operator:   sdk.AccAddress(allowance.Operator).String(),
+		Amount:     allowance.Amount,
+		Expiration: types.ProtobufToGogoTimestamp(allowance.Expiration),
+	}
+
+	if allowance.BatchKey != 0 {
+		batch, err := k.stateStore.BatchTable().Get(ctx, allowance.BatchKey)
+		if err != nil {
+			return nil, err
+		}
+		info.BatchDenom = batch.Denom
+	} else {
+		class, err := k.stateStore.ClassTable().Get(ctx, allowance.ClassKey)
+		if err != nil {
+			return nil, err
+		}
+		info.ClassId = class.Id
+	}
+
+	return info, nil
+}
@@ -0,0 +1,66 @@
+package core
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestQuery_Allowance(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	classKey, batchKey := allowanceBatchSetup(t, s, "C01-001-20200101-20210101-001")
+
+	assert.NilError(t, s.stateStore.BatchAllowanceTable().Insert(s.ctx, &api.BatchAllowance{
+		Owner:    s.addr,
+		Operator: s.addr2,
+		BatchKey: batchKey,
+		Amount:   "10",
+	}))
+	assert.NilError(t, s.stateStore.BatchAllowanceTable().Insert(s.ctx, &api.BatchAllowance{
+		Owner:    s.addr,
+		Operator: s.addr2,
+		ClassKey: classKey,
+		Amount:   "20",
+	}))
+
+	// query allowance scoped to the credit batch
+	res, err := s.k.Allowance(s.ctx, &core.QueryAllowanceRequest{
+		Owner:      s.addr.String(),
+		Operator:   s.addr2.String(),
+		BatchDenom: "C01-001-20200101-20210101-001",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, s.addr.String(), res.Allowance.Owner)
+	assert.Equal(t, s.addr2.String(), res.Allowance.Operator)
+	assert.Equal(t, "C01-001-20200101-20210101-001", res.Allowance.BatchDenom)
+	assert.Equal(t, "10", res.Allowance.Amount)
+
+	// query allowance scoped to the credit class
+	res, err = s.k.Allowance(s.ctx, &core.QueryAllowanceRequest{
+		Owner:    s.addr.String(),
+		Operator: s.addr2.String(),
+		ClassId:  "C01",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "C01", res.Allowance.ClassId)
+	assert.Equal(t, "20", res.Allowance.Amount)
+
+	// query allowance that does not exist
+	_, err = s.k.Allowance(s.ctx, &core.QueryAllowanceRequest{
+		Owner:    s.addr2.String(),
+		Operator: s.addr.String(),
+		ClassId:  "C01",
+	})
+	assert.ErrorContains(t, err, "not found")
+
+	// query allowance without a batch denom or class id
+	_, err = s.k.Allowance(s.ctx, &core.QueryAllowanceRequest{
+		Owner:    s.addr.String(),
+		Operator: s.addr2.String(),
+	})
+	assert.ErrorContains(t, err, "batch denom or class id required")
+}
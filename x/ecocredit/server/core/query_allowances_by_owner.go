@@ -0,0 +1,56 @@
+package core
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// AllowancesByOwner queries all allowances granted by the given owner.
+func (k Keeper) AllowancesByOwner(ctx context.Context, req *core.QueryAllowancesByOwnerRequest) (*core.QueryAllowancesByOwnerResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.BatchAllowanceTable().List(ctx, api.BatchAllowancePrimaryKey{}.WithOwner(owner), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	allowances := make([]*core.AllowanceInfo, 0)
+	for it.Next() {
+		allowance, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := k.getAllowanceInfo(ctx, allowance)
+		if err != nil {
+			return nil, err
+		}
+
+		allowances = append(allowances, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.QueryAllowancesByOwnerResponse{
+		Allowances: allowances,
+		Pagination: pr,
+	}, nil
+}
@@ -0,0 +1,52 @@
+package core
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestQuery_AllowancesByOwner(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	classKey, batchKey := allowanceBatchSetup(t, s, "C01-001-20200101-20210101-001")
+
+	// insert two allowances granted by s.addr and one allowance granted by s.addr2
+	assert.NilError(t, s.stateStore.BatchAllowanceTable().Insert(s.ctx, &api.BatchAllowance{
+		Owner:    s.addr,
+		Operator: s.addr2,
+		BatchKey: batchKey,
+		Amount:   "10",
+	}))
+	assert.NilError(t, s.stateStore.BatchAllowanceTable().Insert(s.ctx, &api.BatchAllowance{
+		Owner:    s.addr,
+		Operator: s.addr2,
+		ClassKey: classKey,
+		Amount:   "20",
+	}))
+	assert.NilError(t, s.stateStore.BatchAllowanceTable().Insert(s.ctx, &api.BatchAllowance{
+		Owner:    s.addr2,
+		Operator: s.addr,
+		BatchKey: batchKey,
+		Amount:   "30",
+	}))
+
+	// query allowances granted by s.addr
+	res, err := s.k.AllowancesByOwner(s.ctx, &core.QueryAllowancesByOwnerRequest{
+		Owner:      s.addr.String(),
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Allowances))
+	assert.Equal(t, s.addr.String(), res.Allowances[0].Owner)
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+
+	// query allowances by invalid address
+	_, err = s.k.AllowancesByOwner(s.ctx, &core.QueryAllowancesByOwnerRequest{Owner: "foo"})
+	assert.ErrorContains(t, err, "decoding bech32 failed")
+}
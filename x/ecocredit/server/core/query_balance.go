@@ -34,6 +34,7 @@ func (k Keeper) Balance(ctx context.Context, req *core.QueryBalanceRequest) (*co
 		TradableAmount: balance.TradableAmount,
 		RetiredAmount:  balance.RetiredAmount,
 		EscrowedAmount: balance.EscrowedAmount,
+		LockedAmount:   balance.LockedAmount,
 	}
 
 	return &core.QueryBalanceResponse{Balance: &info}, nil
@@ -43,6 +43,7 @@ func (k Keeper) Balances(ctx context.Context, req *core.QueryBalancesRequest) (*
 			TradableAmount: balance.TradableAmount,
 			RetiredAmount:  balance.RetiredAmount,
 			EscrowedAmount: balance.EscrowedAmount,
+			LockedAmount:   balance.LockedAmount,
 		}
 
 		balances = append(balances, &info)
@@ -37,6 +37,7 @@ func (k Keeper) Batch(ctx context.Context, request *core.QueryBatchRequest) (*co
 		EndDate:      types.ProtobufToGogoTimestamp(batch.EndDate),
 		IssuanceDate: types.ProtobufToGogoTimestamp(batch.IssuanceDate),
 		Open:         batch.Open,
+		Frozen:       batch.Frozen,
 	}
 
 	return &core.QueryBatchResponse{Batch: &info}, nil
@@ -48,6 +48,7 @@ func (k Keeper) Batches(ctx context.Context, request *core.QueryBatchesRequest)
 			EndDate:      types.ProtobufToGogoTimestamp(batch.EndDate),
 			IssuanceDate: types.ProtobufToGogoTimestamp(batch.IssuanceDate),
 			Open:         batch.Open,
+			Frozen:       batch.Frozen,
 		}
 
 		batches = append(batches, &info)
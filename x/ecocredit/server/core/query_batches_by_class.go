@@ -55,6 +55,7 @@ func (k Keeper) BatchesByClass(ctx context.Context, request *core.QueryBatchesBy
 			EndDate:      types.ProtobufToGogoTimestamp(batch.EndDate),
 			IssuanceDate: types.ProtobufToGogoTimestamp(batch.IssuanceDate),
 			Open:         batch.Open,
+			Frozen:       batch.Frozen,
 		}
 
 		batches = append(batches, &info)
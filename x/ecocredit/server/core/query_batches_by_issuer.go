@@ -53,6 +53,7 @@ func (k Keeper) BatchesByIssuer(ctx context.Context, req *core.QueryBatchesByIss
 			EndDate:      types.ProtobufToGogoTimestamp(batch.EndDate),
 			IssuanceDate: types.ProtobufToGogoTimestamp(batch.IssuanceDate),
 			Open:         batch.Open,
+			Frozen:       batch.Frozen,
 		}
 
 		batches = append(batches, &info)
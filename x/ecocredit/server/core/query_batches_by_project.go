@@ -49,6 +49,7 @@ func (k Keeper) BatchesByProject(ctx context.Context, request *core.QueryBatches
 			EndDate:      types.ProtobufToGogoTimestamp(batch.EndDate),
 			IssuanceDate: types.ProtobufToGogoTimestamp(batch.IssuanceDate),
 			Open:         batch.Open,
+			Frozen:       batch.Frozen,
 		}
 
 		batches = append(batches, &info)
@@ -0,0 +1,64 @@
+package core
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// BridgeAttestations queries the pending bridge attestations of the given credit class.
+func (k Keeper) BridgeAttestations(ctx context.Context, req *core.QueryBridgeAttestationsRequest) (*core.QueryBridgeAttestationsResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get class with id %s: %s", req.ClassId, err.Error())
+	}
+
+	it, err := k.stateStore.BridgeAttestationTable().List(ctx, api.BridgeAttestationClassKeyIdSourceIndexKey{}.WithClassKey(class.Key), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	attestations := make([]*core.BridgeAttestationInfo, 0)
+	for it.Next() {
+		attestation, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		attestors := make([]string, len(attestation.Attestors))
+		for i, attestor := range attestation.Attestors {
+			attestors[i] = sdk.AccAddress(attestor).String()
+		}
+
+		attestations = append(attestations, &core.BridgeAttestationInfo{
+			OriginTxId:     attestation.Id,
+			OriginTxSource: attestation.Source,
+			ContentHash:    attestation.ContentHash,
+			Attestors:      attestors,
+			Expiration:     types.ProtobufToGogoTimestamp(attestation.Expiration),
+		})
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.QueryBridgeAttestationsResponse{
+		Attestations: attestations,
+		Pagination:   pr,
+	}, nil
+}
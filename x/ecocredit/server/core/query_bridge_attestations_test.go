@@ -0,0 +1,40 @@
+package core
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestQuery_BridgeAttestations(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, operators := bridgeOperatorSetup(t, s, "polygon", 2)
+
+	msg := attestBridgeReceiveMsg(s, operators[0])
+	_, err := s.k.AttestBridgeReceive(s.ctx, msg)
+	assert.NilError(t, err)
+
+	msg.OriginTx.Id = "0x8a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e"
+	_, err = s.k.AttestBridgeReceive(s.ctx, msg)
+	assert.NilError(t, err)
+
+	res, err := s.k.BridgeAttestations(s.ctx, &core.QueryBridgeAttestationsRequest{
+		ClassId:    "C01",
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Attestations))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+	assert.Equal(t, "polygon", res.Attestations[0].OriginTxSource)
+	assert.DeepEqual(t, []string{operators[0].String()}, res.Attestations[0].Attestors)
+	assert.Equal(t, bridgeBlockTime.Unix()+3600, res.Attestations[0].Expiration.Seconds)
+
+	// invalid class id
+	_, err = s.k.BridgeAttestations(s.ctx, &core.QueryBridgeAttestationsRequest{ClassId: "C02"})
+	assert.ErrorContains(t, err, "could not get class with id C02")
+}
@@ -0,0 +1,60 @@
+package core
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gogotypes "github.com/gogo/protobuf/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// BridgeOperators queries the bridge operator sets of the given credit class.
+func (k Keeper) BridgeOperators(ctx context.Context, req *core.QueryBridgeOperatorsRequest) (*core.QueryBridgeOperatorsResponse, error) {
+	class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get class with id %s: %s", req.ClassId, err.Error())
+	}
+
+	it, err := k.stateStore.BridgeOperatorSetTable().List(ctx, api.BridgeOperatorSetClassKeySourceIndexKey{}.WithClassKey(class.Key))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	operatorSets := make([]*core.BridgeOperatorSetInfo, 0)
+	for it.Next() {
+		operatorSet, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		operators := make([]string, len(operatorSet.Operators))
+		for i, operator := range operatorSet.Operators {
+			operators[i] = sdk.AccAddress(operator).String()
+		}
+
+		info := &core.BridgeOperatorSetInfo{
+			Source:    operatorSet.Source,
+			Operators: operators,
+			Threshold: operatorSet.Threshold,
+			Timeout: &gogotypes.Duration{
+				Seconds: operatorSet.Timeout.Seconds,
+				Nanos:   operatorSet.Timeout.Nanos,
+			},
+		}
+
+		if operatorSet.OutboundTimeout != nil {
+			info.OutboundTimeout = &gogotypes.Duration{
+				Seconds: operatorSet.OutboundTimeout.Seconds,
+				Nanos:   operatorSet.OutboundTimeout.Nanos,
+			}
+		}
+
+		operatorSets = append(operatorSets, info)
+	}
+
+	return &core.QueryBridgeOperatorsResponse{OperatorSets: operatorSets}, nil
+}
@@ -0,0 +1,27 @@
+package core
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestQuery_BridgeOperators(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, operators := bridgeOperatorSetup(t, s, "polygon", 2)
+
+	res, err := s.k.BridgeOperators(s.ctx, &core.QueryBridgeOperatorsRequest{ClassId: "C01"})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.OperatorSets))
+	assert.Equal(t, "polygon", res.OperatorSets[0].Source)
+	assert.Equal(t, operators[0].String(), res.OperatorSets[0].Operators[0])
+	assert.Equal(t, uint32(2), res.OperatorSets[0].Threshold)
+	assert.Equal(t, int64(3600), res.OperatorSets[0].Timeout.Seconds)
+
+	// invalid class id
+	_, err = s.k.BridgeOperators(s.ctx, &core.QueryBridgeOperatorsRequest{ClassId: "C02"})
+	assert.ErrorContains(t, err, "could not get class with id C02")
+}
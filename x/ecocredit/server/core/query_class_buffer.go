@@ -0,0 +1,33 @@
+package core
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// ClassBuffer queries the buffer percentage and the buffer pool account of the given credit class.
+func (k Keeper) ClassBuffer(ctx context.Context, req *core.QueryClassBufferRequest) (*core.QueryClassBufferResponse, error) {
+	class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get class with id %s: %s", req.ClassId, err.Error())
+	}
+
+	var percentage string
+	buffer, err := k.stateStore.ClassBufferTable().Get(ctx, class.Key)
+	if err != nil {
+		if !ormerrors.NotFound.Is(err) {
+			return nil, err
+		}
+	} else {
+		percentage = buffer.Percentage
+	}
+
+	return &core.QueryClassBufferResponse{
+		Percentage: percentage,
+		Address:    core.BufferAddress(class.Id).String(),
+	}, nil
+}
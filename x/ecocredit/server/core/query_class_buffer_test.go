@@ -0,0 +1,38 @@
+package core
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestQuery_ClassBuffer(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	classKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{Id: "C01"})
+	assert.NilError(t, err)
+
+	// query class without buffer percentage
+	res, err := s.k.ClassBuffer(s.ctx, &core.QueryClassBufferRequest{ClassId: "C01"})
+	assert.NilError(t, err)
+	assert.Equal(t, "", res.Percentage)
+	assert.Equal(t, core.BufferAddress("C01").String(), res.Address)
+
+	assert.NilError(t, s.stateStore.ClassBufferTable().Insert(s.ctx, &api.ClassBuffer{
+		ClassKey:   classKey,
+		Percentage: "10",
+	}))
+
+	// query class with buffer percentage
+	res, err = s.k.ClassBuffer(s.ctx, &core.QueryClassBufferRequest{ClassId: "C01"})
+	assert.NilError(t, err)
+	assert.Equal(t, "10", res.Percentage)
+
+	// query unknown class
+	_, err = s.k.ClassBuffer(s.ctx, &core.QueryClassBufferRequest{ClassId: "C02"})
+	assert.ErrorContains(t, err, "not found")
+}
@@ -0,0 +1,60 @@
+package core
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// ClassMetadataHistory queries the metadata changes of the given credit class.
+func (k Keeper) ClassMetadataHistory(ctx context.Context, req *core.QueryClassMetadataHistoryRequest) (*core.QueryClassMetadataHistoryResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get class with id %s: %s", req.ClassId, err.Error())
+	}
+
+	it, err := k.stateStore.ClassMetadataChangeTable().List(ctx, api.ClassMetadataChangeClassKeyIndexKey{}.WithClassKey(class.Key), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	changes := make([]*core.MetadataChangeInfo, 0)
+	for it.Next() {
+		change, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, &core.MetadataChangeInfo{
+			Id:               change.Id,
+			PreviousMetadata: change.PreviousMetadata,
+			Metadata:         change.Metadata,
+			Signer:           sdk.AccAddress(change.Signer).String(),
+			BlockHeight:      change.BlockHeight,
+			Timestamp:        types.ProtobufToGogoTimestamp(change.Timestamp),
+		})
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.QueryClassMetadataHistoryResponse{
+		Changes:    changes,
+		Pagination: pr,
+	}, nil
+}
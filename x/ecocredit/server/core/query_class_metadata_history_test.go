@@ -0,0 +1,53 @@
+package core
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestQuery_ClassMetadataHistory(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	key, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{Id: "C01"})
+	assert.NilError(t, err)
+
+	// insert two metadata changes for the credit class
+	assert.NilError(t, s.stateStore.ClassMetadataChangeTable().Insert(s.ctx, &api.ClassMetadataChange{
+		ClassKey:         key,
+		PreviousMetadata: "",
+		Metadata:         "regen:13toVgf5aZqSVSeJQv562xkkeoe3rr3bJWa29PHVKVf77VAkVMcDvVd.rdf",
+		Signer:           s.addr,
+		BlockHeight:      1,
+	}))
+	assert.NilError(t, s.stateStore.ClassMetadataChangeTable().Insert(s.ctx, &api.ClassMetadataChange{
+		ClassKey:         key,
+		PreviousMetadata: "regen:13toVgf5aZqSVSeJQv562xkkeoe3rr3bJWa29PHVKVf77VAkVMcDvVd.rdf",
+		Metadata:         "regen:13toVgf5bjcUfo1jMmw2ZJiLNzFyKw5AbQTVWw1BuyMBV5WbAjNNB7e.rdf",
+		Signer:           s.addr,
+		BlockHeight:      2,
+	}))
+
+	// query metadata history of the credit class
+	res, err := s.k.ClassMetadataHistory(s.ctx, &core.QueryClassMetadataHistoryRequest{
+		ClassId:    "C01",
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Changes))
+	assert.Equal(t, "", res.Changes[0].PreviousMetadata)
+	assert.Equal(t, "regen:13toVgf5aZqSVSeJQv562xkkeoe3rr3bJWa29PHVKVf77VAkVMcDvVd.rdf", res.Changes[0].Metadata)
+	assert.Equal(t, s.addr.String(), res.Changes[0].Signer)
+	assert.Equal(t, int64(1), res.Changes[0].BlockHeight)
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+
+	// query metadata history of unknown credit class
+	_, err = s.k.ClassMetadataHistory(s.ctx, &core.QueryClassMetadataHistoryRequest{ClassId: "C02"})
+	assert.ErrorContains(t, err, "not found")
+}
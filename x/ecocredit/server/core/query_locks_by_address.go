@@ -0,0 +1,65 @@
+package core
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// LocksByAddress queries all pending locks of credits owned by the given account.
+func (k Keeper) LocksByAddress(ctx context.Context, req *core.QueryLocksByAddressRequest) (*core.QueryLocksByAddressResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.BatchLockTable().List(ctx, api.BatchLockAddressBatchKeyIndexKey{}.WithAddress(addr), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	locks := make([]*core.LockInfo, 0)
+	for it.Next() {
+		lock, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		batch, err := k.stateStore.BatchTable().Get(ctx, lock.BatchKey)
+		if err != nil {
+			return nil, err
+		}
+
+		locks = append(locks, &core.LockInfo{
+			Id:             lock.Id,
+			Address:        req.Address,
+			BatchDenom:     batch.Denom,
+			Amount:         lock.Amount,
+			ReleasedAmount: lock.ReleasedAmount,
+			StartTime:      types.ProtobufToGogoTimestamp(lock.StartTime),
+			EndTime:        types.ProtobufToGogoTimestamp(lock.EndTime),
+		})
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.QueryLocksByAddressResponse{
+		Locks:      locks,
+		Pagination: pr,
+	}, nil
+}
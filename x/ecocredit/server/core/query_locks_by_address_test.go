@@ -0,0 +1,46 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestQuery_LocksByAddress(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	batchDenom := "C01-001-20200101-20210101-001"
+	_, batchKey := allowanceBatchSetup(t, s, batchDenom)
+
+	startTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	schedule := &core.LockSchedule{StartTime: &startTime}
+
+	// insert two locks for s.addr and one lock for s.addr2
+	for _, addr := range [][]byte{s.addr, s.addr, s.addr2} {
+		_, err := s.k.lockCredits(s.ctx, addr, batchKey, batchDenom, math.NewDecFromInt64(10), schedule)
+		assert.NilError(t, err)
+	}
+
+	// query locks by s.addr
+	res, err := s.k.LocksByAddress(s.ctx, &core.QueryLocksByAddressRequest{
+		Address:    s.addr.String(),
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Locks))
+	assert.Equal(t, s.addr.String(), res.Locks[0].Address)
+	assert.Equal(t, batchDenom, res.Locks[0].BatchDenom)
+	assert.Equal(t, "10", res.Locks[0].Amount)
+	assert.Equal(t, startTime.Unix(), res.Locks[0].StartTime.Seconds)
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+
+	// query locks by invalid address
+	_, err = s.k.LocksByAddress(s.ctx, &core.QueryLocksByAddressRequest{Address: "foo"})
+	assert.ErrorContains(t, err, "decoding bech32 failed")
+}
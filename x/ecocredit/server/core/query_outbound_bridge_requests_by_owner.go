@@ -0,0 +1,79 @@
+package core
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// OutboundBridgeRequestsByOwner queries all outbound bridge requests of the given owner.
+func (k Keeper) OutboundBridgeRequestsByOwner(ctx context.Context, req *core.QueryOutboundBridgeRequestsByOwnerRequest) (*core.QueryOutboundBridgeRequestsByOwnerResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.OutboundBridgeRequestTable().List(ctx, api.OutboundBridgeRequestOwnerIndexKey{}.WithOwner(owner), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	requests := make([]*core.OutboundBridgeRequestInfo, 0)
+	for it.Next() {
+		request, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := k.getOutboundBridgeRequestInfo(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		requests = append(requests, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.QueryOutboundBridgeRequestsByOwnerResponse{
+		Requests:   requests,
+		Pagination: pr,
+	}, nil
+}
+
+// getOutboundBridgeRequestInfo converts an outbound bridge request into its human-readable form.
+func (k Keeper) getOutboundBridgeRequestInfo(ctx context.Context, request *api.OutboundBridgeRequest) (*core.OutboundBridgeRequestInfo, error) {
+	batch, err := k.stateStore.BatchTable().Get(ctx, request.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.OutboundBridgeRequestInfo{
+		Id:           request.Id,
+		Owner:        sdk.AccAddress(request.Owner).String(),
+		BatchDenom:   batch.Denom,
+		Amount:       request.Amount,
+		Target:       request.Target,
+		Recipient:    request.Recipient,
+		Contract:     request.Contract,
+		Status:       core.OutboundBridgeStatus(request.Status),
+		TargetTxHash: request.TargetTxHash,
+		Timestamp:    types.ProtobufToGogoTimestamp(request.Timestamp),
+		Expiration:   types.ProtobufToGogoTimestamp(request.Expiration),
+	}, nil
+}
@@ -0,0 +1,40 @@
+package core
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestQuery_OutboundBridgeRequestsByOwner(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, _ = bridgeOperatorSetup(t, s, "polygon", 2)
+	_, requestId := outboundBridgeSetup(t, s)
+
+	res, err := s.k.OutboundBridgeRequestsByOwner(s.ctx, &core.QueryOutboundBridgeRequestsByOwnerRequest{
+		Owner: s.addr2.String(),
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Requests))
+	assert.Equal(t, requestId, res.Requests[0].Id)
+	assert.Equal(t, s.addr2.String(), res.Requests[0].Owner)
+	assert.Equal(t, "C01-001-20200101-20210101-001", res.Requests[0].BatchDenom)
+	assert.Equal(t, "10", res.Requests[0].Amount)
+	assert.Equal(t, core.OutboundBridgeStatus_OUTBOUND_BRIDGE_STATUS_PENDING, res.Requests[0].Status)
+
+	// no requests for another owner
+	res, err = s.k.OutboundBridgeRequestsByOwner(s.ctx, &core.QueryOutboundBridgeRequestsByOwnerRequest{
+		Owner: s.addr.String(),
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(res.Requests))
+
+	// invalid owner address
+	_, err = s.k.OutboundBridgeRequestsByOwner(s.ctx, &core.QueryOutboundBridgeRequestsByOwnerRequest{
+		Owner: "foo",
+	})
+	assert.ErrorContains(t, err, "decoding bech32 failed")
+}
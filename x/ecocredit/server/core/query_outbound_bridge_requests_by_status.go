@@ -0,0 +1,57 @@
+package core
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// OutboundBridgeRequestsByStatus queries all outbound bridge requests with the given status.
+func (k Keeper) OutboundBridgeRequestsByStatus(ctx context.Context, req *core.QueryOutboundBridgeRequestsByStatusRequest) (*core.QueryOutboundBridgeRequestsByStatusResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	status := api.OutboundBridgeStatus(req.Status)
+	filter := ormlist.Filter(func(msg proto.Message) bool {
+		return msg.(*api.OutboundBridgeRequest).Status == status
+	})
+
+	it, err := k.stateStore.OutboundBridgeRequestTable().List(ctx, api.OutboundBridgeRequestIdIndexKey{}, filter, ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	requests := make([]*core.OutboundBridgeRequestInfo, 0)
+	for it.Next() {
+		request, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := k.getOutboundBridgeRequestInfo(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		requests = append(requests, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.QueryOutboundBridgeRequestsByStatusResponse{
+		Requests:   requests,
+		Pagination: pr,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"gotest.tools/v3/assert"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestQuery_OutboundBridgeRequestsByStatus(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, operators := bridgeOperatorSetup(t, s, "polygon", 2)
+	_, requestId := outboundBridgeSetup(t, s)
+
+	res, err := s.k.OutboundBridgeRequestsByStatus(s.ctx, &core.QueryOutboundBridgeRequestsByStatusRequest{
+		Status:     core.OutboundBridgeStatus_OUTBOUND_BRIDGE_STATUS_PENDING,
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Requests))
+	assert.Equal(t, requestId, res.Requests[0].Id)
+	assert.Equal(t, uint64(1), res.Pagination.Total)
+
+	_, err = s.k.BridgeAck(s.ctx, &core.MsgBridgeAck{
+		Operator:     operators[0].String(),
+		RequestId:    requestId,
+		Status:       core.OutboundBridgeStatus_OUTBOUND_BRIDGE_STATUS_COMPLETED,
+		TargetTxHash: bridgeTargetTxHash,
+	})
+	assert.NilError(t, err)
+
+	res, err = s.k.OutboundBridgeRequestsByStatus(s.ctx, &core.QueryOutboundBridgeRequestsByStatusRequest{
+		Status: core.OutboundBridgeStatus_OUTBOUND_BRIDGE_STATUS_PENDING,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(res.Requests))
+
+	res, err = s.k.OutboundBridgeRequestsByStatus(s.ctx, &core.QueryOutboundBridgeRequestsByStatusRequest{
+		Status: core.OutboundBridgeStatus_OUTBOUND_BRIDGE_STATUS_COMPLETED,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Requests))
+	assert.Equal(t, bridgeTargetTxHash, res.Requests[0].TargetTxHash)
+}
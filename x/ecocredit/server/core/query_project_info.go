@@ -30,6 +30,7 @@ func (k Keeper) Project(ctx context.Context, request *core.QueryProjectRequest)
 		Jurisdiction: project.Jurisdiction,
 		Metadata:     project.Metadata,
 		ReferenceId:  project.ReferenceId,
+		Status:       core.ProjectStatus(project.Status),
 	}
 
 	return &core.QueryProjectResponse{Project: &info}, nil
@@ -0,0 +1,60 @@
+package core
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// ProjectMetadataHistory queries the metadata changes of the given project.
+func (k Keeper) ProjectMetadataHistory(ctx context.Context, req *core.QueryProjectMetadataHistoryRequest) (*core.QueryProjectMetadataHistoryResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := k.stateStore.ProjectTable().GetById(ctx, req.ProjectId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get project with id %s: %s", req.ProjectId, err.Error())
+	}
+
+	it, err := k.stateStore.ProjectMetadataChangeTable().List(ctx, api.ProjectMetadataChangeProjectKeyIndexKey{}.WithProjectKey(project.Key), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	changes := make([]*core.MetadataChangeInfo, 0)
+	for it.Next() {
+		change, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, &core.MetadataChangeInfo{
+			Id:               change.Id,
+			PreviousMetadata: change.PreviousMetadata,
+			Metadata:         change.Metadata,
+			Signer:           sdk.AccAddress(change.Signer).String(),
+			BlockHeight:      change.BlockHeight,
+			Timestamp:        types.ProtobufToGogoTimestamp(change.Timestamp),
+		})
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.QueryProjectMetadataHistoryResponse{
+		Changes:    changes,
+		Pagination: pr,
+	}, nil
+}
@@ -0,0 +1,62 @@
+package core
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// ProjectStatusHistory queries the status changes of the given project.
+func (k Keeper) ProjectStatusHistory(ctx context.Context, req *core.QueryProjectStatusHistoryRequest) (*core.QueryProjectStatusHistoryResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := k.stateStore.ProjectTable().GetById(ctx, req.ProjectId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get project with id %s: %s", req.ProjectId, err.Error())
+	}
+
+	it, err := k.stateStore.ProjectStatusChangeTable().List(ctx, api.ProjectStatusChangeProjectKeyIndexKey{}.WithProjectKey(project.Key), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	changes := make([]*core.ProjectStatusChangeInfo, 0)
+	for it.Next() {
+		change, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, &core.ProjectStatusChangeInfo{
+			Id:             change.Id,
+			ProjectId:      project.Id,
+			PreviousStatus: core.ProjectStatus(change.PreviousStatus),
+			Status:         core.ProjectStatus(change.Status),
+			Reason:         change.Reason,
+			Admin:          sdk.AccAddress(change.Admin).String(),
+			BlockHeight:    change.BlockHeight,
+			Timestamp:      types.ProtobufToGogoTimestamp(change.Timestamp),
+		})
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.QueryProjectStatusHistoryResponse{
+		Changes:    changes,
+		Pagination: pr,
+	}, nil
+}
@@ -0,0 +1,53 @@
+package core
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestQuery_ProjectStatusHistory(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	pKey, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{Id: "C01-001"})
+	assert.NilError(t, err)
+
+	// insert two status changes for the project
+	assert.NilError(t, s.stateStore.ProjectStatusChangeTable().Insert(s.ctx, &api.ProjectStatusChange{
+		ProjectKey:     pKey,
+		PreviousStatus: api.ProjectStatus_PROJECT_STATUS_ACTIVE,
+		Status:         api.ProjectStatus_PROJECT_STATUS_SUSPENDED,
+		Reason:         "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf",
+		Admin:          s.addr,
+	}))
+	assert.NilError(t, s.stateStore.ProjectStatusChangeTable().Insert(s.ctx, &api.ProjectStatusChange{
+		ProjectKey:     pKey,
+		PreviousStatus: api.ProjectStatus_PROJECT_STATUS_SUSPENDED,
+		Status:         api.ProjectStatus_PROJECT_STATUS_ACTIVE,
+		Reason:         "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf",
+		Admin:          s.addr,
+	}))
+
+	// query status history of the project
+	res, err := s.k.ProjectStatusHistory(s.ctx, &core.QueryProjectStatusHistoryRequest{
+		ProjectId:  "C01-001",
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Changes))
+	assert.Equal(t, "C01-001", res.Changes[0].ProjectId)
+	assert.Equal(t, core.ProjectStatus_PROJECT_STATUS_ACTIVE, res.Changes[0].PreviousStatus)
+	assert.Equal(t, core.ProjectStatus_PROJECT_STATUS_SUSPENDED, res.Changes[0].Status)
+	assert.Equal(t, s.addr.String(), res.Changes[0].Admin)
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+
+	// query status history of unknown project
+	_, err = s.k.ProjectStatusHistory(s.ctx, &core.QueryProjectStatusHistoryRequest{ProjectId: "C01-002"})
+	assert.ErrorContains(t, err, "not found")
+}
@@ -49,6 +49,7 @@ func (k Keeper) ProjectsByReferenceId(ctx context.Context, req *core.QueryProjec
 			Jurisdiction: project.Jurisdiction,
 			Metadata:     project.Metadata,
 			ReferenceId:  project.ReferenceId,
+			Status:       core.ProjectStatus(project.Status),
 		}
 
 		projects = append(projects, info)
@@ -0,0 +1,70 @@
+package core
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// ProjectsByStatus queries all projects with the given lifecycle status.
+func (k Keeper) ProjectsByStatus(ctx context.Context, req *core.QueryProjectsByStatusRequest) (*core.QueryProjectsByStatusResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	status := api.ProjectStatus(req.Status)
+	filter := ormlist.Filter(func(msg proto.Message) bool {
+		return msg.(*api.Project).Status == status
+	})
+
+	it, err := k.stateStore.ProjectTable().List(ctx, api.ProjectKeyIndexKey{}, filter, ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	projects := make([]*core.ProjectInfo, 0)
+	for it.Next() {
+		project, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		admin := sdk.AccAddress(project.Admin)
+
+		class, err := k.stateStore.ClassTable().Get(ctx, project.ClassKey)
+		if err != nil {
+			return nil, err
+		}
+
+		info := core.ProjectInfo{
+			Id:           project.Id,
+			Admin:        admin.String(),
+			ClassId:      class.Id,
+			Jurisdiction: project.Jurisdiction,
+			Metadata:     project.Metadata,
+			ReferenceId:  project.ReferenceId,
+			Status:       req.Status,
+		}
+
+		projects = append(projects, &info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.QueryProjectsByStatusResponse{
+		Projects:   projects,
+		Pagination: pr,
+	}, nil
+}
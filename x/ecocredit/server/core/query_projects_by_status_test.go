@@ -0,0 +1,56 @@
+package core
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestQuery_ProjectsByStatus(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	classKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{Id: "C01"})
+	assert.NilError(t, err)
+
+	// insert two active projects and one suspended project
+	for _, project := range []*api.Project{
+		{Id: "C01-001", Admin: s.addr, ClassKey: classKey, Status: api.ProjectStatus_PROJECT_STATUS_ACTIVE},
+		{Id: "C01-002", Admin: s.addr, ClassKey: classKey, Status: api.ProjectStatus_PROJECT_STATUS_ACTIVE},
+		{Id: "C01-003", Admin: s.addr, ClassKey: classKey, Status: api.ProjectStatus_PROJECT_STATUS_SUSPENDED},
+	} {
+		assert.NilError(t, s.stateStore.ProjectTable().Insert(s.ctx, project))
+	}
+
+	// query active projects
+	res, err := s.k.ProjectsByStatus(s.ctx, &core.QueryProjectsByStatusRequest{
+		Status:     core.ProjectStatus_PROJECT_STATUS_ACTIVE,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Projects))
+	assert.Equal(t, "C01-001", res.Projects[0].Id)
+	assert.Equal(t, "C01", res.Projects[0].ClassId)
+	assert.Equal(t, core.ProjectStatus_PROJECT_STATUS_ACTIVE, res.Projects[0].Status)
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+
+	// query suspended projects
+	res, err = s.k.ProjectsByStatus(s.ctx, &core.QueryProjectsByStatusRequest{
+		Status: core.ProjectStatus_PROJECT_STATUS_SUSPENDED,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Projects))
+	assert.Equal(t, "C01-003", res.Projects[0].Id)
+
+	// query retired projects
+	res, err = s.k.ProjectsByStatus(s.ctx, &core.QueryProjectsByStatusRequest{
+		Status: core.ProjectStatus_PROJECT_STATUS_RETIRED,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(res.Projects))
+}
@@ -0,0 +1,59 @@
+package core
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// Retirement queries a retirement record by its unique identifier.
+func (k Keeper) Retirement(ctx context.Context, req *core.QueryRetirementRequest) (*core.QueryRetirementResponse, error) {
+	retirement, err := k.stateStore.RetirementTable().Get(ctx, req.Id)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get retirement with id %d: %s", req.Id, err.Error())
+	}
+
+	info, err := k.getRetirementInfo(ctx, retirement)
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.QueryRetirementResponse{Retirement: info}, nil
+}
+
+// getRetirementInfo converts a retirement record into its human-readable form.
+func (k Keeper) getRetirementInfo(ctx context.Context, retirement *api.Retirement) (*core.RetirementInfo, error) {
+	batch, err := k.stateStore.BatchTable().Get(ctx, retirement.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := k.stateStore.ProjectTable().Get(ctx, retirement.ProjectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var beneficiaryAddress string
+	if len(retirement.BeneficiaryAddress) != 0 {
+		beneficiaryAddress = sdk.AccAddress(retirement.BeneficiaryAddress).String()
+	}
+
+	return &core.RetirementInfo{
+		Id:                 retirement.Id,
+		Owner:              sdk.AccAddress(retirement.Owner).String(),
+		BatchDenom:         batch.Denom,
+		ProjectId:          project.Id,
+		Amount:             retirement.Amount,
+		Jurisdiction:       retirement.Jurisdiction,
+		Reason:             retirement.Reason,
+		BlockHeight:        retirement.BlockHeight,
+		Timestamp:          types.ProtobufToGogoTimestamp(retirement.Timestamp),
+		BeneficiaryAddress: beneficiaryAddress,
+		BeneficiaryName:    retirement.BeneficiaryName,
+	}, nil
+}
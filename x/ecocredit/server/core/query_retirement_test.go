@@ -0,0 +1,63 @@
+package core
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestQuery_Retirement(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	pKey, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{Id: "C01-001"})
+	assert.NilError(t, err)
+
+	bKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey: pKey,
+		Denom:      "C01-001-20200101-20220101-001",
+	})
+	assert.NilError(t, err)
+
+	timestamp, err := types.ParseDate("timestamp", "2022-01-01")
+	assert.NilError(t, err)
+
+	retirement := &api.Retirement{
+		Owner:        s.addr,
+		BatchKey:     bKey,
+		ProjectKey:   pKey,
+		Amount:       "10",
+		Jurisdiction: "US-WA",
+		Reason:       "offsetting emissions from business travel",
+		BlockHeight:  10,
+		Timestamp:    timestamppb.New(timestamp),
+	}
+
+	// insert retirement
+	id, err := s.stateStore.RetirementTable().InsertReturningID(s.ctx, retirement)
+	assert.NilError(t, err)
+
+	// query retirement by id
+	res, err := s.k.Retirement(s.ctx, &core.QueryRetirementRequest{Id: id})
+	assert.NilError(t, err)
+	assert.Equal(t, id, res.Retirement.Id)
+	assert.Equal(t, s.addr.String(), res.Retirement.Owner)
+	assert.Equal(t, "C01-001-20200101-20220101-001", res.Retirement.BatchDenom)
+	assert.Equal(t, "C01-001", res.Retirement.ProjectId)
+	assert.Equal(t, retirement.Amount, res.Retirement.Amount)
+	assert.Equal(t, retirement.Jurisdiction, res.Retirement.Jurisdiction)
+	assert.Equal(t, retirement.Reason, res.Retirement.Reason)
+	assert.Equal(t, retirement.BlockHeight, res.Retirement.BlockHeight)
+	assert.DeepEqual(t, types.ProtobufToGogoTimestamp(retirement.Timestamp), res.Retirement.Timestamp)
+
+	// query retirement by unknown id
+	_, err = s.k.Retirement(s.ctx, &core.QueryRetirementRequest{Id: 2})
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+}
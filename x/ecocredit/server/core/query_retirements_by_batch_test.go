@@ -0,0 +1,58 @@
+package core
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestQuery_RetirementsByBatch(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	pKey, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{Id: "C01-001"})
+	assert.NilError(t, err)
+
+	bKey1, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey: pKey,
+		Denom:      "C01-001-20200101-20220101-001",
+	})
+	assert.NilError(t, err)
+
+	bKey2, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey: pKey,
+		Denom:      "C01-001-20200101-20220101-002",
+	})
+	assert.NilError(t, err)
+
+	// insert two retirements from the first batch and one retirement from the second batch
+	for _, bKey := range []uint64{bKey1, bKey1, bKey2} {
+		assert.NilError(t, s.stateStore.RetirementTable().Insert(s.ctx, &api.Retirement{
+			Owner:      s.addr,
+			BatchKey:   bKey,
+			ProjectKey: pKey,
+			Amount:     "10",
+		}))
+	}
+
+	// query retirements by the first batch
+	res, err := s.k.RetirementsByBatch(s.ctx, &core.QueryRetirementsByBatchRequest{
+		BatchDenom: "C01-001-20200101-20220101-001",
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Retirements))
+	assert.Equal(t, "C01-001-20200101-20220101-001", res.Retirements[0].BatchDenom)
+	assert.Equal(t, "C01-001", res.Retirements[0].ProjectId)
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+
+	// query retirements by unknown batch
+	_, err = s.k.RetirementsByBatch(s.ctx, &core.QueryRetirementsByBatchRequest{BatchDenom: "A00-000-00000000-00000000-000"})
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+}
@@ -0,0 +1,56 @@
+package core
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// RetirementsByOwner queries all retirement records of credits retired by the given account.
+func (k Keeper) RetirementsByOwner(ctx context.Context, req *core.QueryRetirementsByOwnerRequest) (*core.QueryRetirementsByOwnerResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.RetirementTable().List(ctx, api.RetirementOwnerIndexKey{}.WithOwner(owner), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	retirements := make([]*core.RetirementInfo, 0)
+	for it.Next() {
+		retirement, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := k.getRetirementInfo(ctx, retirement)
+		if err != nil {
+			return nil, err
+		}
+
+		retirements = append(retirements, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.QueryRetirementsByOwnerResponse{
+		Retirements: retirements,
+		Pagination:  pr,
+	}, nil
+}
@@ -0,0 +1,51 @@
+package core
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestQuery_RetirementsByOwner(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	pKey, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{Id: "C01-001"})
+	assert.NilError(t, err)
+
+	bKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey: pKey,
+		Denom:      "C01-001-20200101-20220101-001",
+	})
+	assert.NilError(t, err)
+
+	// insert two retirements by s.addr and one retirement by s.addr2
+	for _, owner := range [][]byte{s.addr, s.addr, s.addr2} {
+		assert.NilError(t, s.stateStore.RetirementTable().Insert(s.ctx, &api.Retirement{
+			Owner:      owner,
+			BatchKey:   bKey,
+			ProjectKey: pKey,
+			Amount:     "10",
+		}))
+	}
+
+	// query retirements by s.addr
+	res, err := s.k.RetirementsByOwner(s.ctx, &core.QueryRetirementsByOwnerRequest{
+		Owner:      s.addr.String(),
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Retirements))
+	assert.Equal(t, s.addr.String(), res.Retirements[0].Owner)
+	assert.Equal(t, "C01-001-20200101-20220101-001", res.Retirements[0].BatchDenom)
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+
+	// query retirements by invalid address
+	_, err = s.k.RetirementsByOwner(s.ctx, &core.QueryRetirementsByOwnerRequest{Owner: "foo"})
+	assert.ErrorContains(t, err, "decoding bech32 failed")
+}
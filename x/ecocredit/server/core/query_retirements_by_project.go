@@ -0,0 +1,57 @@
+package core
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// RetirementsByProject queries all retirement records of credits retired from credit batches issued
+// within the given project.
+func (k Keeper) RetirementsByProject(ctx context.Context, req *core.QueryRetirementsByProjectRequest) (*core.QueryRetirementsByProjectResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := k.stateStore.ProjectTable().GetById(ctx, req.ProjectId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get project with id %s: %s", req.ProjectId, err.Error())
+	}
+
+	it, err := k.stateStore.RetirementTable().List(ctx, api.RetirementProjectKeyIndexKey{}.WithProjectKey(project.Key), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	retirements := make([]*core.RetirementInfo, 0)
+	for it.Next() {
+		retirement, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := k.getRetirementInfo(ctx, retirement)
+		if err != nil {
+			return nil, err
+		}
+
+		retirements = append(retirements, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.QueryRetirementsByProjectResponse{
+		Retirements: retirements,
+		Pagination:  pr,
+	}, nil
+}
@@ -0,0 +1,60 @@
+package core
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestQuery_RetirementsByProject(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	pKey1, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{Id: "C01-001"})
+	assert.NilError(t, err)
+
+	pKey2, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{Id: "C01-002"})
+	assert.NilError(t, err)
+
+	bKey1, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey: pKey1,
+		Denom:      "C01-001-20200101-20220101-001",
+	})
+	assert.NilError(t, err)
+
+	bKey2, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey: pKey2,
+		Denom:      "C01-002-20200101-20220101-001",
+	})
+	assert.NilError(t, err)
+
+	// insert two retirements from the first project and one retirement from the second project
+	for _, r := range []*api.Retirement{
+		{Owner: s.addr, BatchKey: bKey1, ProjectKey: pKey1, Amount: "10"},
+		{Owner: s.addr, BatchKey: bKey1, ProjectKey: pKey1, Amount: "20"},
+		{Owner: s.addr, BatchKey: bKey2, ProjectKey: pKey2, Amount: "30"},
+	} {
+		assert.NilError(t, s.stateStore.RetirementTable().Insert(s.ctx, r))
+	}
+
+	// query retirements by the first project
+	res, err := s.k.RetirementsByProject(s.ctx, &core.QueryRetirementsByProjectRequest{
+		ProjectId:  "C01-001",
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Retirements))
+	assert.Equal(t, "C01-001", res.Retirements[0].ProjectId)
+	assert.Equal(t, "10", res.Retirements[0].Amount)
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+
+	// query retirements by unknown project
+	_, err = s.k.RetirementsByProject(s.ctx, &core.QueryRetirementsByProjectRequest{ProjectId: "F01-001"})
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+}
@@ -0,0 +1,67 @@
+package core
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// ReversalsByProject queries all reversal records reported against the given project.
+func (k Keeper) ReversalsByProject(ctx context.Context, req *core.QueryReversalsByProjectRequest) (*core.QueryReversalsByProjectResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := k.stateStore.ProjectTable().GetById(ctx, req.ProjectId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get project with id %s: %s", req.ProjectId, err.Error())
+	}
+
+	it, err := k.stateStore.ReversalTable().List(ctx, api.ReversalProjectKeyIndexKey{}.WithProjectKey(project.Key), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	reversals := make([]*core.ReversalInfo, 0)
+	for it.Next() {
+		reversal, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		batch, err := k.stateStore.BatchTable().Get(ctx, reversal.BatchKey)
+		if err != nil {
+			return nil, err
+		}
+
+		reversals = append(reversals, &core.ReversalInfo{
+			Id:          reversal.Id,
+			ProjectId:   project.Id,
+			BatchDenom:  batch.Denom,
+			Amount:      reversal.Amount,
+			Evidence:    reversal.Evidence,
+			Reporter:    sdk.AccAddress(reversal.Reporter).String(),
+			BlockHeight: reversal.BlockHeight,
+			Timestamp:   types.ProtobufToGogoTimestamp(reversal.Timestamp),
+		})
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.QueryReversalsByProjectResponse{
+		Reversals:  reversals,
+		Pagination: pr,
+	}, nil
+}
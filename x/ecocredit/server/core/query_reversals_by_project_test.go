@@ -0,0 +1,54 @@
+package core
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestQuery_ReversalsByProject(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	pKey, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{Id: "C01-001"})
+	assert.NilError(t, err)
+
+	bKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey: pKey,
+		Denom:      "C01-001-20200101-20220101-001",
+	})
+	assert.NilError(t, err)
+
+	// insert two reversals against the project
+	for i := 0; i < 2; i++ {
+		assert.NilError(t, s.stateStore.ReversalTable().Insert(s.ctx, &api.Reversal{
+			ProjectKey: pKey,
+			BatchKey:   bKey,
+			Amount:     "10",
+			Evidence:   "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf",
+			Reporter:   s.addr,
+		}))
+	}
+
+	// query reversals by project
+	res, err := s.k.ReversalsByProject(s.ctx, &core.QueryReversalsByProjectRequest{
+		ProjectId:  "C01-001",
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Reversals))
+	assert.Equal(t, "C01-001", res.Reversals[0].ProjectId)
+	assert.Equal(t, "C01-001-20200101-20220101-001", res.Reversals[0].BatchDenom)
+	assert.Equal(t, "10", res.Reversals[0].Amount)
+	assert.Equal(t, s.addr.String(), res.Reversals[0].Reporter)
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+
+	// query reversals by unknown project
+	_, err = s.k.ReversalsByProject(s.ctx, &core.QueryReversalsByProjectRequest{ProjectId: "C01-002"})
+	assert.ErrorContains(t, err, "not found")
+}
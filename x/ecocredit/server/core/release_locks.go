@@ -0,0 +1,181 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// LockReleaseLimit is the maximum number of locks that are processed in a single block. Locks
+// that exceed the limit are processed in the following blocks.
+const LockReleaseLimit = 100
+
+// LockReleaseInterval is the minimum time between two releases of credits of a lock with an end
+// time. Credits that unlock between two releases are released together in the second release.
+const LockReleaseInterval = time.Hour
+
+// ReleaseLocks is a BeginBlock function that releases locked credits into the tradable balance
+// of their owner according to the schedule of each lock. Locks are removed once all credits have
+// been released. At most LockReleaseLimit locks are processed per block, starting with the locks
+// with the earliest next release time.
+func (k Keeper) ReleaseLocks(ctx context.Context) error {
+	return k.releaseLocks(ctx, LockReleaseLimit)
+}
+
+// releaseLocks processes at most limit locks with a next release time before or at the block time.
+func (k Keeper) releaseLocks(ctx context.Context, limit int) error {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	// locks always have a next release time so the 0 value timestamp can be used as the min
+	fromKey := api.BatchLockNextReleaseTimeIndexKey{}.WithNextReleaseTime(timestamppb.New(time.Unix(0, 0)))
+	toKey := api.BatchLockNextReleaseTimeIndexKey{}.WithNextReleaseTime(timestamppb.New(blockTime))
+
+	it, err := k.stateStore.BatchLockTable().ListRange(ctx, fromKey, toKey)
+	if err != nil {
+		return err
+	}
+
+	// collect locks before updating them to avoid modifying the table while iterating
+	var locks []*api.BatchLock
+	for len(locks) < limit && it.Next() {
+		lock, err := it.Value()
+		if err != nil {
+			it.Close()
+			return err
+		}
+		locks = append(locks, lock)
+	}
+	it.Close()
+
+	for _, lock := range locks {
+		if err := k.releaseLock(ctx, lock, blockTime); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// releaseLock moves the credits of the lock that have unlocked as of blockTime and have not yet
+// been released into the tradable balance of the owner.
+func (k Keeper) releaseLock(ctx context.Context, lock *api.BatchLock, blockTime time.Time) error {
+	batch, err := k.stateStore.BatchTable().Get(ctx, lock.BatchKey)
+	if err != nil {
+		return err
+	}
+
+	creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.stateStore, batch.Denom)
+	if err != nil {
+		return err
+	}
+
+	decs, err := utils.GetNonNegativeFixedDecs(creditType.Precision, lock.Amount, lock.ReleasedAmount)
+	if err != nil {
+		return err
+	}
+	amount, released := decs[0], decs[1]
+
+	unlocked, err := getUnlockedAmount(lock, amount, creditType.Precision, blockTime)
+	if err != nil {
+		return err
+	}
+
+	toRelease, err := math.SafeSubBalance(unlocked, released)
+	if err != nil {
+		return err
+	}
+
+	if !toRelease.IsZero() {
+		bal, err := k.stateStore.BatchBalanceTable().Get(ctx, lock.Address, lock.BatchKey)
+		if err != nil {
+			return err
+		}
+
+		locked, err := math.NewDecFromString(bal.LockedAmount)
+		if err != nil {
+			return err
+		}
+
+		tradable, err := math.NewDecFromString(bal.TradableAmount)
+		if err != nil {
+			return err
+		}
+
+		locked, err = math.SafeSubBalance(locked, toRelease)
+		if err != nil {
+			return err
+		}
+
+		tradable, err = math.SafeAddBalance(tradable, toRelease)
+		if err != nil {
+			return err
+		}
+
+		bal.LockedAmount = locked.String()
+		bal.TradableAmount = tradable.String()
+		if err = k.stateStore.BatchBalanceTable().Update(ctx, bal); err != nil {
+			return err
+		}
+
+		if err = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&core.EventUnlock{
+			LockId:     lock.Id,
+			Owner:      sdk.AccAddress(lock.Address).String(),
+			BatchDenom: batch.Denom,
+			Amount:     toRelease.String(),
+		}); err != nil {
+			return err
+		}
+	}
+
+	if unlocked.Equal(amount) {
+		return k.stateStore.BatchLockTable().Delete(ctx, lock)
+	}
+
+	// the lock is moved behind the locks that have not been processed so that locks exceeding
+	// the limit are processed in the following blocks
+	nextReleaseTime := blockTime.Add(LockReleaseInterval)
+	if endTime := lock.EndTime.AsTime(); endTime.Before(nextReleaseTime) {
+		nextReleaseTime = endTime
+	}
+
+	lock.ReleasedAmount = unlocked.String()
+	lock.NextReleaseTime = timestamppb.New(nextReleaseTime)
+	return k.stateStore.BatchLockTable().Update(ctx, lock)
+}
+
+// getUnlockedAmount returns the total amount of credits of the lock that have unlocked as of
+// blockTime. Credits of a linear lock unlock in proportion to the time elapsed since the start
+// time, truncated to the credit type precision.
+func getUnlockedAmount(lock *api.BatchLock, amount math.Dec, precision uint32, blockTime time.Time) (math.Dec, error) {
+	if lock.EndTime == nil || !blockTime.Before(lock.EndTime.AsTime()) {
+		return amount, nil
+	}
+
+	startTime := lock.StartTime.AsTime()
+	elapsed := math.NewDecFromInt64(int64(blockTime.Sub(startTime) / time.Second))
+	duration := math.NewDecFromInt64(int64(lock.EndTime.AsTime().Sub(startTime) / time.Second))
+	if duration.IsZero() {
+		return amount, nil
+	}
+
+	unlocked, err := amount.Mul(elapsed)
+	if err != nil {
+		return math.Dec{}, err
+	}
+
+	unlocked, err = unlocked.Quo(duration)
+	if err != nil {
+		return math.Dec{}, err
+	}
+
+	// truncate the unlocked amount to the credit type precision
+	return utils.TruncateDec(unlocked, precision)
+}
@@ -0,0 +1,122 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestReleaseLocks_Cliff(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	batchDenom := "C01-001-20200101-20210101-001"
+	_, batchKey := allowanceBatchSetup(t, s, batchDenom)
+
+	startTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	id, err := s.k.lockCredits(s.ctx, s.addr, batchKey, batchDenom, math.NewDecFromInt64(10), &core.LockSchedule{StartTime: &startTime})
+	assert.NilError(t, err)
+
+	// credits are not released before the start time
+	s.setBlockTime(startTime.Add(-time.Second))
+	assert.NilError(t, s.k.ReleaseLocks(s.ctx))
+	s.assertBalance(t, batchKey, "0", "10")
+
+	// all credits are released at the start time and the lock is removed
+	s.setBlockTime(startTime)
+	assert.NilError(t, s.k.ReleaseLocks(s.ctx))
+	s.assertBalance(t, batchKey, "10", "0")
+
+	_, err = s.stateStore.BatchLockTable().Get(s.ctx, id)
+	assert.ErrorIs(t, err, ormerrors.NotFound)
+}
+
+func TestReleaseLocks_Linear(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	batchDenom := "C01-001-20200101-20210101-001"
+	_, batchKey := allowanceBatchSetup(t, s, batchDenom)
+
+	startTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := startTime.Add(3 * time.Hour)
+	id, err := s.k.lockCredits(s.ctx, s.addr, batchKey, batchDenom, math.NewDecFromInt64(10), &core.LockSchedule{
+		StartTime: &startTime,
+		EndTime:   &endTime,
+	})
+	assert.NilError(t, err)
+
+	// one third of the credits are released, truncated to the credit type precision
+	s.setBlockTime(startTime.Add(time.Hour))
+	assert.NilError(t, s.k.ReleaseLocks(s.ctx))
+	s.assertBalance(t, batchKey, "3.333333", "6.666667")
+
+	lock, err := s.stateStore.BatchLockTable().Get(s.ctx, id)
+	assert.NilError(t, err)
+	assert.Equal(t, "3.333333", lock.ReleasedAmount)
+
+	// no credits are released before the next release time
+	s.setBlockTime(startTime.Add(90 * time.Minute))
+	assert.NilError(t, s.k.ReleaseLocks(s.ctx))
+	s.assertBalance(t, batchKey, "3.333333", "6.666667")
+
+	// two thirds of the credits are released at the next release time
+	s.setBlockTime(startTime.Add(2 * time.Hour))
+	assert.NilError(t, s.k.ReleaseLocks(s.ctx))
+	s.assertBalance(t, batchKey, "6.666666", "3.333334")
+
+	// the remaining credits are released at the end time and the lock is removed
+	s.setBlockTime(endTime)
+	assert.NilError(t, s.k.ReleaseLocks(s.ctx))
+	s.assertBalance(t, batchKey, "10.000000", "0.000000")
+
+	_, err = s.stateStore.BatchLockTable().Get(s.ctx, id)
+	assert.ErrorIs(t, err, ormerrors.NotFound)
+}
+
+func TestReleaseLocks_Limit(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	batchDenom := "C01-001-20200101-20210101-001"
+	_, batchKey := allowanceBatchSetup(t, s, batchDenom)
+
+	startTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := startTime.Add(2 * LockReleaseInterval)
+	for i := 0; i < 3; i++ {
+		_, err := s.k.lockCredits(s.ctx, s.addr, batchKey, batchDenom, math.NewDecFromInt64(10), &core.LockSchedule{
+			StartTime: &startTime,
+			EndTime:   &endTime,
+		})
+		assert.NilError(t, err)
+	}
+
+	// only two locks are processed in the first block
+	s.setBlockTime(startTime.Add(LockReleaseInterval))
+	assert.NilError(t, s.k.releaseLocks(s.ctx, 2))
+	s.assertBalance(t, batchKey, "10", "20")
+
+	// the remaining lock is processed in the following block
+	assert.NilError(t, s.k.releaseLocks(s.ctx, 2))
+	s.assertBalance(t, batchKey, "15", "15")
+
+	// processed locks are not processed again before their next release time
+	assert.NilError(t, s.k.releaseLocks(s.ctx, 2))
+	s.assertBalance(t, batchKey, "15", "15")
+}
+
+func (s *baseSuite) setBlockTime(blockTime time.Time) {
+	s.sdkCtx = s.sdkCtx.WithBlockTime(blockTime)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+}
+
+func (s *baseSuite) assertBalance(t *testing.T, batchKey uint64, tradable, locked string) {
+	bal, err := s.stateStore.BatchBalanceTable().Get(s.ctx, s.addr, batchKey)
+	assert.NilError(t, err)
+	assert.Equal(t, tradable, bal.TradableAmount)
+	assert.Equal(t, locked, bal.LockedAmount)
+}
@@ -3,8 +3,11 @@ package core
 import (
 	"context"
 
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	ecoApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
 	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
 
 	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
@@ -25,6 +28,17 @@ func (k Keeper) assertClassIssuer(goCtx context.Context, classKey uint64, addr s
 	return nil
 }
 
+// assertProjectActive makes sure that credits can be issued to the project.
+// Returns ErrInvalidRequest if the project does not have an active status.
+func assertProjectActive(project *ecoApi.Project) error {
+	if project.Status != ecoApi.ProjectStatus_PROJECT_STATUS_ACTIVE {
+		return sdkerrors.ErrInvalidRequest.Wrapf(
+			"project %s is not active: %s", project.Id, project.Status,
+		)
+	}
+	return nil
+}
+
 // AddAndSaveBalance adds 'amt' to the addr's tradable balance.
 func AddAndSaveBalance(ctx context.Context, table ecoApi.BatchBalanceTable, addr sdk.AccAddress, batchKey uint64, amt math.Dec) error {
 	bal, err := utils.GetBalance(ctx, table, addr, batchKey)
@@ -102,3 +116,183 @@ func RetireSupply(ctx context.Context, table ecoApi.BatchSupplyTable, batchKey u
 
 	return table.Update(ctx, supply)
 }
+
+// spendAllowance subtracts `amount` from the allowance the owner has granted the operator for
+// the given credit batch. An allowance scoped to the credit batch is used if one exists,
+// otherwise an allowance scoped to the credit class of the credit batch is used. The allowance
+// is removed once it has been fully spent.
+func (k Keeper) spendAllowance(ctx context.Context, owner, operator sdk.AccAddress, batchDenom string, amount math.Dec) error {
+	batch, err := k.stateStore.BatchTable().GetByDenom(ctx, batchDenom)
+	if err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", batchDenom, err.Error())
+	}
+
+	allowance, err := k.stateStore.BatchAllowanceTable().Get(ctx, owner, operator, 0, batch.Key)
+	if err != nil {
+		if !ormerrors.NotFound.Is(err) {
+			return err
+		}
+
+		project, err := k.stateStore.ProjectTable().Get(ctx, batch.ProjectKey)
+		if err != nil {
+			return err
+		}
+
+		allowance, err = k.stateStore.BatchAllowanceTable().Get(ctx, owner, operator, project.ClassKey, 0)
+		if err != nil {
+			if ormerrors.NotFound.Is(err) {
+				return sdkerrors.ErrUnauthorized.Wrapf(
+					"operator %s has no allowance from %s for batch %s", operator, owner, batchDenom,
+				)
+			}
+			return err
+		}
+	}
+
+	if allowance.Expiration != nil {
+		blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+		if !blockTime.Before(allowance.Expiration.AsTime()) {
+			return sdkerrors.ErrUnauthorized.Wrapf(
+				"allowance of operator %s for batch %s expired at %s", operator, batchDenom, allowance.Expiration.AsTime(),
+			)
+		}
+	}
+
+	allowed, err := math.NewDecFromString(allowance.Amount)
+	if err != nil {
+		return err
+	}
+
+	remaining, err := math.SafeSubBalance(allowed, amount)
+	if err != nil {
+		return sdkerrors.ErrUnauthorized.Wrapf(
+			"allowance: %s, spend amount %s: insufficient allowance", allowed, amount,
+		)
+	}
+
+	if remaining.IsZero() {
+		return k.stateStore.BatchAllowanceTable().Delete(ctx, allowance)
+	}
+
+	allowance.Amount = remaining.String()
+	return k.stateStore.BatchAllowanceTable().Update(ctx, allowance)
+}
+
+// lockCredits adds `amount` to the addr's locked balance and creates a lock that releases
+// the credits into the addr's tradable balance according to the lock schedule. The supply
+// is not updated because locked credits are counted in the tradable supply.
+func (k Keeper) lockCredits(ctx context.Context, addr sdk.AccAddress, batchKey uint64, batchDenom string, amount math.Dec, schedule *core.LockSchedule) (uint64, error) {
+	bal, err := utils.GetBalance(ctx, k.stateStore.BatchBalanceTable(), addr, batchKey)
+	if err != nil {
+		return 0, err
+	}
+
+	locked, err := math.NewNonNegativeDecFromString(bal.LockedAmount)
+	if err != nil {
+		return 0, err
+	}
+
+	locked, err = math.SafeAddBalance(locked, amount)
+	if err != nil {
+		return 0, err
+	}
+
+	bal.LockedAmount = locked.String()
+	if err = k.stateStore.BatchBalanceTable().Save(ctx, bal); err != nil {
+		return 0, err
+	}
+
+	lock := &ecoApi.BatchLock{
+		Address:         addr,
+		BatchKey:        batchKey,
+		Amount:          amount.String(),
+		ReleasedAmount:  "0",
+		StartTime:       timestamppb.New(*schedule.StartTime),
+		NextReleaseTime: timestamppb.New(*schedule.StartTime),
+	}
+	if schedule.EndTime != nil {
+		lock.EndTime = timestamppb.New(*schedule.EndTime)
+	}
+
+	id, err := k.stateStore.BatchLockTable().InsertReturningID(ctx, lock)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&core.EventLock{
+		LockId:     id,
+		Owner:      addr.String(),
+		BatchDenom: batchDenom,
+		Amount:     amount.String(),
+	}); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// getBufferFraction returns the buffer percentage of the credit class as a
+// decimal fraction (e.g. 10% is returned as 0.1). Zero is returned if the class
+// does not have a buffer percentage.
+func (k Keeper) getBufferFraction(ctx context.Context, classKey uint64) (math.Dec, error) {
+	buffer, err := k.stateStore.ClassBufferTable().Get(ctx, classKey)
+	if err != nil {
+		if ormerrors.NotFound.Is(err) {
+			return math.NewDecFromInt64(0), nil
+		}
+		return math.Dec{}, err
+	}
+
+	percentage, err := math.NewNonNegativeDecFromString(buffer.Percentage)
+	if err != nil {
+		return math.Dec{}, err
+	}
+
+	return percentage.Quo(math.NewDecFromInt64(100))
+}
+
+// withholdBuffer withholds the buffer pool share of the tradable amount of an
+// issuance. The withheld amount is truncated to the credit type precision and
+// added to the tradable balance of the buffer pool account of the credit class.
+// The remaining tradable amount to be issued to the recipient and the withheld
+// amount are returned.
+func (k Keeper) withholdBuffer(ctx context.Context, classId string, batchKey uint64, batchDenom string, tradable, fraction math.Dec, precision uint32) (math.Dec, math.Dec, error) {
+	if fraction.IsZero() || tradable.IsZero() {
+		return tradable, math.NewDecFromInt64(0), nil
+	}
+
+	buffer, err := tradable.Mul(fraction)
+	if err != nil {
+		return math.Dec{}, math.Dec{}, err
+	}
+
+	buffer, err = utils.TruncateDec(buffer, precision)
+	if err != nil {
+		return math.Dec{}, math.Dec{}, err
+	}
+
+	if buffer.IsZero() {
+		return tradable, buffer, nil
+	}
+
+	bufferAddr := core.BufferAddress(classId)
+	if err = AddAndSaveBalance(ctx, k.stateStore.BatchBalanceTable(), bufferAddr, batchKey, buffer); err != nil {
+		return math.Dec{}, math.Dec{}, err
+	}
+
+	if err = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&core.EventTransfer{
+		Sender:         k.moduleAddress.String(), // ecocredit module
+		Recipient:      bufferAddr.String(),
+		BatchDenom:     batchDenom,
+		TradableAmount: buffer.String(),
+	}); err != nil {
+		return math.Dec{}, math.Dec{}, err
+	}
+
+	remaining, err := tradable.Sub(buffer)
+	if err != nil {
+		return math.Dec{}, math.Dec{}, err
+	}
+
+	return remaining, buffer, nil
+}
@@ -8,4 +8,8 @@ import (
 type Keeper interface {
 	ProposalKeeper
 	PruneOrders(ctx sdk.Context) error
+	FillOrders(ctx sdk.Context) error
+	ReleaseLocks(ctx sdk.Context) error
+	PruneBridgeAttestations(ctx sdk.Context) error
+	ExpireOutboundBridgeRequests(ctx sdk.Context) error
 }
@@ -4,7 +4,32 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// PruneOrders checks if there are any expired sell or buy orders and removes them from state.
+// PruneOrders checks if there are any expired sell orders, buy orders, offers or forward sales and removes
+// them from state.
 func (s serverImpl) PruneOrders(ctx sdk.Context) error {
-	return s.marketplaceKeeper.PruneSellOrders(sdk.WrapSDKContext(ctx))
+	goCtx := sdk.WrapSDKContext(ctx)
+	if err := s.marketplaceKeeper.PruneSellOrders(goCtx); err != nil {
+		return err
+	}
+
+	if err := s.marketplaceKeeper.PruneBuyOrders(goCtx); err != nil {
+		return err
+	}
+
+	if err := s.marketplaceKeeper.PruneOffers(goCtx); err != nil {
+		return err
+	}
+
+	return s.marketplaceKeeper.PruneForwardSales(goCtx)
+}
+
+// FillOrders fills buy orders with matching sell orders and settles sealed-bid auctions that have reached
+// their reveal end height.
+func (s serverImpl) FillOrders(ctx sdk.Context) error {
+	goCtx := sdk.WrapSDKContext(ctx)
+	if err := s.marketplaceKeeper.FillBuyOrders(goCtx); err != nil {
+		return err
+	}
+
+	return s.marketplaceKeeper.SettleSealedBidAuctions(goCtx)
 }
@@ -0,0 +1,75 @@
+package server
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	coretypes "github.com/regen-network/regen-ledger/x/ecocredit/core"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/marketplace"
+)
+
+// CreateBatch creates a credit batch and delivers the forward contracts of the
+// project that are filled by the issued credits.
+func (m coreMsgServer) CreateBatch(ctx context.Context, req *coretypes.MsgCreateBatch) (*coretypes.MsgCreateBatchResponse, error) {
+	res, err := m.Keeper.CreateBatch(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = deliverForwardContracts(ctx, m.stateStore, m.marketplaceKeeper, res.BatchDenom, req.Issuance); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// MintBatchCredits mints credits in an open credit batch and delivers the
+// forward contracts of the project that are filled by the minted credits.
+func (m coreMsgServer) MintBatchCredits(ctx context.Context, req *coretypes.MsgMintBatchCredits) (*coretypes.MsgMintBatchCreditsResponse, error) {
+	res, err := m.Keeper.MintBatchCredits(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = deliverForwardContracts(ctx, m.stateStore, m.marketplaceKeeper, req.BatchDenom, req.Issuance); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// deliverForwardContracts delivers the forward contracts of the project of the
+// credit batch out of the tradable credits of the issuance.
+func deliverForwardContracts(ctx context.Context, ss api.StateStore, mk marketplace.Keeper, batchDenom string,
+	issuance []*coretypes.BatchIssuance) error {
+	batch, err := ss.BatchTable().GetByDenom(ctx, batchDenom)
+	if err != nil {
+		return err
+	}
+
+	issued := make(map[string]math.Dec)
+	for _, iss := range issuance {
+		if iss.TradableAmount == "" {
+			continue
+		}
+		recipient, err := sdk.AccAddressFromBech32(iss.Recipient)
+		if err != nil {
+			return err
+		}
+		tradable, err := math.NewDecFromString(iss.TradableAmount)
+		if err != nil {
+			return err
+		}
+		if total, ok := issued[recipient.String()]; ok {
+			if tradable, err = total.Add(tradable); err != nil {
+				return err
+			}
+		}
+		issued[recipient.String()] = tradable
+	}
+
+	return mk.DeliverForwardContracts(ctx, batch.Key, issued)
+}
@@ -0,0 +1,69 @@
+package server
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	coretypes "github.com/regen-network/regen-ledger/x/ecocredit/core"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/core"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/marketplace"
+)
+
+// coreMsgServer wraps the core keeper so that freezing a credit batch also
+// cancels the sell orders and auctions of the credit batch in the marketplace
+// submodule, and issuing credits delivers forward contracts in the marketplace
+// submodule.
+type coreMsgServer struct {
+	core.Keeper
+	marketplaceKeeper marketplace.Keeper
+	stateStore        api.StateStore
+}
+
+var _ coretypes.MsgServer = coreMsgServer{}
+
+// FreezeBatch freezes a credit batch and cancels all of its sell orders and
+// auctions.
+func (m coreMsgServer) FreezeBatch(ctx context.Context, req *coretypes.MsgFreezeBatch) (*coretypes.MsgFreezeBatchResponse, error) {
+	res, err := m.Keeper.FreezeBatch(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = cancelMarketplaceByBatch(ctx, m.stateStore, m.marketplaceKeeper, req.BatchDenom); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// BatchFreeze freezes or unfreezes a credit batch through governance. All sell
+// orders and auctions of the credit batch are cancelled when the credit batch
+// is frozen.
+func (s serverImpl) BatchFreeze(ctx sdk.Context, proposal *coretypes.BatchFreezeProposal) error {
+	if err := s.coreKeeper.BatchFreeze(ctx, proposal); err != nil {
+		return err
+	}
+
+	if !proposal.Freeze {
+		return nil
+	}
+
+	return cancelMarketplaceByBatch(sdk.WrapSDKContext(ctx), s.stateStore, s.marketplaceKeeper, proposal.BatchDenom)
+}
+
+// cancelMarketplaceByBatch cancels the sell orders, dutch auctions and sealed-bid
+// auctions of the credit batch.
+func cancelMarketplaceByBatch(ctx context.Context, ss api.StateStore, mk marketplace.Keeper, batchDenom string) error {
+	batch, err := ss.BatchTable().GetByDenom(ctx, batchDenom)
+	if err != nil {
+		return err
+	}
+
+	if err = mk.CancelSellOrdersByBatch(ctx, batch.Key); err != nil {
+		return err
+	}
+
+	return mk.CancelAuctionsByBatch(ctx, batch.Key)
+}
@@ -2,6 +2,7 @@ package server
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 
 	"github.com/gogo/protobuf/jsonpb"
@@ -12,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/orm/types/ormjson"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/types"
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
 	"github.com/regen-network/regen-ledger/x/ecocredit/genesis"
@@ -30,6 +32,10 @@ func (s serverImpl) InitGenesis(ctx types.Context, cdc codec.Codec, data json.Ra
 		return nil, err
 	}
 
+	if err = s.setDefaultProjectStatus(ctx); err != nil {
+		return nil, err
+	}
+
 	var params core.Params
 	r, err := jsonSource.OpenReader(protoreflect.FullName(proto.MessageName(&params)))
 	if err != nil {
@@ -51,9 +57,46 @@ func (s serverImpl) InitGenesis(ctx types.Context, cdc codec.Codec, data json.Ra
 
 	s.paramSpace.SetParamSet(ctx.Context, &params)
 
+	// rebuild the order book from the buy orders and sell orders in state
+	if err = s.orderBook.Reload(ctx); err != nil {
+		return nil, err
+	}
+
 	return []abci.ValidatorUpdate{}, nil
 }
 
+// setDefaultProjectStatus sets the status of imported projects without a status
+// to active, consistent with the store migration of projects created before
+// project statuses were introduced.
+func (s serverImpl) setDefaultProjectStatus(ctx context.Context) error {
+	it, err := s.stateStore.ProjectTable().List(ctx, api.ProjectKeyIndexKey{})
+	if err != nil {
+		return err
+	}
+
+	var projects []*api.Project
+	for it.Next() {
+		project, err := it.Value()
+		if err != nil {
+			it.Close()
+			return err
+		}
+		if project.Status == api.ProjectStatus_PROJECT_STATUS_UNSPECIFIED {
+			projects = append(projects, project)
+		}
+	}
+	it.Close()
+
+	for _, project := range projects {
+		project.Status = api.ProjectStatus_PROJECT_STATUS_ACTIVE
+		if err := s.stateStore.ProjectTable().Update(ctx, project); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ExportGenesis will dump the ecocredit module state into a serializable GenesisState.
 func (s serverImpl) ExportGenesis(ctx types.Context, cdc codec.Codec) (json.RawMessage, error) {
 	// Get Params from the store and put them in the genesis state
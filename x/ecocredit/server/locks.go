@@ -0,0 +1,10 @@
+package server
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ReleaseLocks releases locked credits that have unlocked into their tradable balance.
+func (s serverImpl) ReleaseLocks(ctx sdk.Context) error {
+	return s.coreKeeper.ReleaseLocks(sdk.WrapSDKContext(ctx))
+}
@@ -0,0 +1,58 @@
+package marketplace
+
+import (
+	"context"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+)
+
+// CancelAuctionsByBatch cancels all dutch auctions and sealed-bid auctions of the credit batch. The
+// escrowed credits are returned to the sellers and the deposits of sealed bids are returned to the
+// bidders. It is called when a credit batch is frozen.
+func (k Keeper) CancelAuctionsByBatch(ctx context.Context, batchKey uint64) error {
+	dutchIt, err := k.stateStore.DutchAuctionTable().List(ctx, api.DutchAuctionBatchKeyIndexKey{}.WithBatchKey(batchKey))
+	if err != nil {
+		return err
+	}
+
+	var dutchAuctions []*api.DutchAuction
+	for dutchIt.Next() {
+		auction, err := dutchIt.Value()
+		if err != nil {
+			dutchIt.Close()
+			return err
+		}
+		dutchAuctions = append(dutchAuctions, auction)
+	}
+	dutchIt.Close()
+
+	for _, auction := range dutchAuctions {
+		if err = k.cancelDutchAuction(ctx, auction); err != nil {
+			return err
+		}
+	}
+
+	sealedIt, err := k.stateStore.SealedBidAuctionTable().List(ctx, api.SealedBidAuctionBatchKeyIndexKey{}.WithBatchKey(batchKey))
+	if err != nil {
+		return err
+	}
+
+	var sealedBidAuctions []*api.SealedBidAuction
+	for sealedIt.Next() {
+		auction, err := sealedIt.Value()
+		if err != nil {
+			sealedIt.Close()
+			return err
+		}
+		sealedBidAuctions = append(sealedBidAuctions, auction)
+	}
+	sealedIt.Close()
+
+	for _, auction := range sealedBidAuctions {
+		if err = k.cancelSealedBidAuction(ctx, auction); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
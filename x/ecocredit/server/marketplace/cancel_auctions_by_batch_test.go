@@ -0,0 +1,51 @@
+package marketplace
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func TestCancelAuctionsByBatch(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, bidder := s.addrs[0], s.addrs[1]
+
+	startPrice, floorPrice := sdk.NewInt64Coin(ask.Denom, 100), sdk.NewInt64Coin(ask.Denom, 40)
+	dutchRes, err := s.k.CreateDutchAuction(s.ctx, &marketplace.MsgCreateDutchAuction{
+		Seller:     seller.String(),
+		BatchDenom: batchDenom,
+		Quantity:   "40",
+		StartPrice: &startPrice,
+		FloorPrice: &floorPrice,
+		Duration:   time.Hour,
+	})
+	assert.NilError(t, err)
+
+	s.setBlockHeight(1)
+	sealedId := s.createSealedBidAuction(seller, "50", 10)
+	s.commitSealedBid(sealedId, bidder, "10", "12", 200)
+
+	// the deposit is refunded and the credits of both auctions are returned to the seller
+	s.expectRelease(bidder, 200)
+	assert.NilError(t, s.k.CancelAuctionsByBatch(s.ctx, 1))
+
+	_, err = s.marketStore.DutchAuctionTable().Get(s.ctx, dutchRes.AuctionId)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+	_, err = s.marketStore.SealedBidAuctionTable().Get(s.ctx, sealedId)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+	_, err = s.marketStore.SealedBidTable().Get(s.ctx, sealedId, bidder)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	sellerBal, err := s.coreStore.BatchBalanceTable().Get(s.ctx, seller, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "100", sellerBal.TradableAmount)
+	assert.Equal(t, "0", sellerBal.EscrowedAmount)
+}
@@ -0,0 +1,54 @@
+package marketplace
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	marketApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// CancelSellOrdersByBatch cancels all sell orders of the credit batch and returns
+// the escrowed credits to the sellers. It is called when a credit batch is frozen.
+func (k Keeper) CancelSellOrdersByBatch(ctx context.Context, batchKey uint64) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	it, err := k.stateStore.SellOrderTable().List(ctx, marketApi.SellOrderBatchKeyIndexKey{}.WithBatchKey(batchKey))
+	if err != nil {
+		return err
+	}
+
+	var sellOrders []*marketApi.SellOrder
+	for it.Next() {
+		sellOrder, err := it.Value()
+		if err != nil {
+			it.Close()
+			return err
+		}
+		sellOrders = append(sellOrders, sellOrder)
+	}
+	it.Close()
+
+	for _, sellOrder := range sellOrders {
+		if err = k.unescrowCredits(ctx, sellOrder.Seller, sellOrder.BatchKey, sellOrder.Quantity); err != nil {
+			return err
+		}
+
+		if err = sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventCancelSellOrder{
+			SellOrderId: sellOrder.Id,
+		}); err != nil {
+			return err
+		}
+
+		if err = k.stateStore.SellOrderTable().Delete(ctx, sellOrder); err != nil {
+			return err
+		}
+
+		if err = k.orderBook.OnDeleteSellOrder(ctx, sellOrder); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package marketplace
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func TestCancelSellOrdersByBatch(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 1)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+
+	balBefore, err := s.coreStore.BatchBalanceTable().Get(s.ctx, s.addrs[0], 1)
+	assert.NilError(t, err)
+
+	res, err := s.k.Sell(s.ctx, &marketplace.MsgSell{
+		Seller: s.addrs[0].String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: "10", AskPrice: &ask},
+			{BatchDenom: batchDenom, Quantity: "5", AskPrice: &ask},
+		},
+	})
+	assert.NilError(t, err)
+
+	err = s.k.CancelSellOrdersByBatch(s.ctx, 1)
+	assert.NilError(t, err)
+
+	for _, id := range res.SellOrderIds {
+		_, err = s.marketStore.SellOrderTable().Get(s.ctx, id)
+		assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+	}
+
+	balAfter, err := s.coreStore.BatchBalanceTable().Get(s.ctx, s.addrs[0], 1)
+	assert.NilError(t, err)
+	assertCreditsEscrowed(t, balBefore, balAfter, math.NewDecFromInt64(0))
+}
+
+func TestSell_FrozenBatch(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 1)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+
+	batch, err := s.coreStore.BatchTable().GetByDenom(s.ctx, batchDenom)
+	assert.NilError(t, err)
+	batch.Frozen = true
+	assert.NilError(t, s.coreStore.BatchTable().Update(s.ctx, batch))
+
+	_, err = s.k.Sell(s.ctx, &marketplace.MsgSell{
+		Seller: s.addrs[0].String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: "10", AskPrice: &ask},
+		},
+	})
+	assert.ErrorContains(t, err, ecocredit.ErrFrozenBatch.Error())
+}
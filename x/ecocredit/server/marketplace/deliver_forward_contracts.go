@@ -0,0 +1,219 @@
+package marketplace
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// DeliverForwardContracts delivers credits from a credit batch to fill the forward contracts of the project
+// of the credit batch. It is called when credits are issued in a new credit batch or minted in an existing
+// credit batch with the tradable credits issued to each recipient, keyed by the address of the recipient.
+// Forward contracts are delivered in the order they were created and only when the dates of the credit
+// batch are within the vintage range of the forward sale. Only the tradable credits issued to the seller of
+// the forward sale are delivered, so credits the seller held before the issuance are never delivered. The
+// escrowed funds for the credits delivered are paid to the seller. Forward contracts that are fully
+// delivered are removed from state.
+func (k Keeper) DeliverForwardContracts(ctx context.Context, batchKey uint64, issued map[string]math.Dec) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	batch, err := k.coreStore.BatchTable().Get(ctx, batchKey)
+	if err != nil {
+		return err
+	}
+
+	// credits from a frozen credit batch cannot be traded
+	if utils.AssertBatchNotFrozen(batch) != nil {
+		return nil
+	}
+
+	contracts, err := k.getForwardContracts(ctx, batch.ProjectKey)
+	if err != nil {
+		return err
+	}
+
+	for _, contract := range contracts {
+		sale, err := k.stateStore.ForwardSaleTable().Get(ctx, contract.ForwardSaleId)
+		if err != nil {
+			return err
+		}
+
+		if batch.StartDate.AsTime().Before(sale.VintageStart.AsTime()) || batch.EndDate.AsTime().After(sale.VintageEnd.AsTime()) {
+			continue
+		}
+
+		sellerAcc := sdk.AccAddress(sale.Seller)
+		available, ok := issued[sellerAcc.String()]
+		if !ok || available.IsZero() {
+			continue
+		}
+
+		// the issued credits may have been moved out of the tradable balance of the seller within the same
+		// transaction, so the delivered quantity is also bounded by the tradable balance
+		bal, err := utils.GetBalance(ctx, k.coreStore.BatchBalanceTable(), sellerAcc, batchKey)
+		if err != nil {
+			return err
+		}
+		tradable, err := math.NewDecFromString(bal.TradableAmount)
+		if err != nil {
+			return err
+		}
+		if tradable.Cmp(available) == math.LessThan {
+			available = tradable
+		}
+		if available.IsZero() {
+			continue
+		}
+
+		contractQty, err := math.NewDecFromString(contract.Quantity)
+		if err != nil {
+			return err
+		}
+		deliverQty := contractQty
+		if available.Cmp(contractQty) == math.LessThan {
+			deliverQty = available
+		}
+
+		if err = k.deliverForwardContract(ctx, contract, sale, batch.Key, batch.Denom, deliverQty); err != nil {
+			return err
+		}
+
+		if issued[sellerAcc.String()], err = available.Sub(deliverQty); err != nil {
+			return err
+		}
+
+		remainingQty, err := contractQty.Sub(deliverQty)
+		if err != nil {
+			return err
+		}
+		contract.Quantity = remainingQty.String()
+		if !remainingQty.IsZero() {
+			if err = k.stateStore.ForwardContractTable().Update(ctx, contract); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// return any escrowed funds remaining after the final delivery, which can only result from
+		// the total cost of partial deliveries being rounded down
+		escrowAmount, ok := sdk.NewIntFromString(contract.EscrowAmount)
+		if !ok {
+			return sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", contract.EscrowAmount, sdk.Int{})
+		}
+		if escrowAmount.IsPositive() {
+			market, err := k.stateStore.MarketTable().Get(ctx, sale.MarketId)
+			if err != nil {
+				return err
+			}
+			coins := sdk.NewCoins(sdk.NewCoin(market.BankDenom, escrowAmount))
+			if err = k.bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, ecocredit.ModuleName, contract.Buyer, coins); err != nil {
+				return err
+			}
+		}
+
+		if err = k.stateStore.ForwardContractTable().Delete(ctx, contract); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getForwardContracts returns the forward contracts of the project in the order they were created.
+func (k Keeper) getForwardContracts(ctx context.Context, projectKey uint64) ([]*api.ForwardContract, error) {
+	it, err := k.stateStore.ForwardContractTable().List(ctx, api.ForwardContractProjectKeyIndexKey{}.WithProjectKey(projectKey))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var contracts []*api.ForwardContract
+	for it.Next() {
+		contract, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		contracts = append(contracts, contract)
+	}
+
+	return contracts, nil
+}
+
+// deliverForwardContract moves the quantity of credits from the tradable balance of the seller to the
+// buyer of the forward contract, pays the seller from the escrowed funds of the forward contract and
+// records the trade. The escrow amount of the forward contract is updated but not saved.
+func (k Keeper) deliverForwardContract(ctx context.Context, contract *api.ForwardContract, sale *api.ForwardSale,
+	batchKey uint64, batchDenom string, quantity math.Dec) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sellerAcc, buyerAcc := sdk.AccAddress(sale.Seller), sdk.AccAddress(contract.Buyer)
+
+	orderIndex := fmt.Sprintf("forward contract %d", contract.Id)
+	if err := k.withdrawCredits(ctx, orderIndex, sellerAcc, batchKey, quantity); err != nil {
+		return err
+	}
+	if err := k.depositCredits(ctx, sellerAcc, buyerAcc, batchKey, quantity, orderOptions{
+		autoRetire:   !contract.DisableAutoRetire,
+		batchDenom:   batchDenom,
+		jurisdiction: contract.RetirementJurisdiction,
+	}); err != nil {
+		return err
+	}
+
+	market, err := k.stateStore.MarketTable().Get(ctx, sale.MarketId)
+	if err != nil {
+		return err
+	}
+	askAmount, ok := sdk.NewIntFromString(sale.AskAmount)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", sale.AskAmount, sdk.Int{})
+	}
+	escrowAmount, ok := sdk.NewIntFromString(contract.EscrowAmount)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", contract.EscrowAmount, sdk.Int{})
+	}
+
+	cost, err := getTotalCost(askAmount, quantity)
+	if err != nil {
+		return err
+	}
+	makerFee, takerFee := getFee(cost, contract.MakerFeeBps), getFee(cost, contract.TakerFeeBps)
+	proceeds := cost.Sub(makerFee)
+	if proceeds.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(market.BankDenom, proceeds))
+		if err = k.bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, ecocredit.ModuleName, sellerAcc, coins); err != nil {
+			return err
+		}
+	}
+	if err = k.collectFees(ctx, market, makerFee.Add(takerFee)); err != nil {
+		return err
+	}
+	contract.EscrowAmount = escrowAmount.Sub(cost).Sub(takerFee).String()
+
+	tradeId, err := k.recordTrade(ctx, &api.Trade{
+		Buyer:             buyerAcc,
+		Seller:            sellerAcc,
+		BatchKey:          batchKey,
+		MarketId:          sale.MarketId,
+		ForwardContractId: contract.Id,
+		Quantity:          quantity.String(),
+		UnitPrice:         sale.AskAmount,
+	})
+	if err != nil {
+		return err
+	}
+
+	return sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventDeliverForwardContract{
+		ForwardContractId: contract.Id,
+		BatchDenom:        batchDenom,
+		Quantity:          quantity.String(),
+		TradeId:           tradeId,
+	})
+}
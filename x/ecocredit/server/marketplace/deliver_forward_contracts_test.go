@@ -0,0 +1,166 @@
+package marketplace
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	ecoApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+func TestDeliverForwardContracts(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 3)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, buyer1, buyer2 := s.addrs[0], s.addrs[1], s.addrs[2]
+	s.setCreditTypeFees(100, 200)
+	assert.NilError(t, s.marketStore.FeeDestinationTable().Save(s.ctx, &api.FeeDestination{
+		Destination: api.FeeDestinationType_FEE_DESTINATION_TYPE_BURN,
+	}))
+
+	saleRes, err := s.k.CreateForwardSale(s.ctx, s.forwardSaleSetup())
+	assert.NilError(t, err)
+	id1 := s.buyForwardContract(saleRes.ForwardSaleId, buyer1, "30", 306)
+	id2 := s.buyForwardContract(saleRes.ForwardSaleId, buyer2, "50", 510)
+
+	// a credit batch outside of the vintage range of the forward sale is not delivered
+	outside := timestamppb.New(end.AsTime().Add(time.Hour * 24 * 365))
+	assert.NilError(t, s.coreStore.BatchTable().Insert(s.ctx, &ecoApi.Batch{
+		ProjectKey: 1,
+		Denom:      "C01-001-20300101-20310101-002",
+		StartDate:  outside,
+		EndDate:    outside,
+	}))
+	assert.NilError(t, s.coreStore.BatchBalanceTable().Insert(s.ctx, &ecoApi.BatchBalance{
+		BatchKey:       2,
+		Address:        seller,
+		TradableAmount: "100",
+	}))
+	assert.NilError(t, s.k.DeliverForwardContracts(s.ctx, 2, map[string]math.Dec{
+		seller.String(): math.NewDecFromInt64(100),
+	}))
+
+	contract, err := s.marketStore.ForwardContractTable().Get(s.ctx, id1)
+	assert.NilError(t, err)
+	assert.Equal(t, "30", contract.Quantity)
+
+	// nothing is delivered when no credits are issued to the seller, even though the seller holds
+	// tradable credits of the credit batch
+	assert.NilError(t, s.k.DeliverForwardContracts(s.ctx, 1, map[string]math.Dec{
+		buyer1.String(): math.NewDecFromInt64(100),
+	}))
+
+	contract, err = s.marketStore.ForwardContractTable().Get(s.ctx, id1)
+	assert.NilError(t, err)
+	assert.Equal(t, "30", contract.Quantity)
+
+	// 40 credits are issued to the seller, the first forward contract is fully delivered and the
+	// second forward contract is partially delivered, the seller is paid the cost minus the maker
+	// fee (300 - 3, 100 - 1) and the maker and taker fees are burned (3 + 6, 1 + 2)
+	s.expectRelease(seller, 297)
+	s.expectRelease(seller, 99)
+	s.bankKeeper.EXPECT().BurnCoins(gmAny, ecocredit.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 9))).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().BurnCoins(gmAny, ecocredit.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 3))).Return(nil).Times(1)
+	assert.NilError(t, s.k.DeliverForwardContracts(s.ctx, 1, map[string]math.Dec{
+		seller.String(): math.NewDecFromInt64(40),
+	}))
+
+	_, err = s.marketStore.ForwardContractTable().Get(s.ctx, id1)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	contract, err = s.marketStore.ForwardContractTable().Get(s.ctx, id2)
+	assert.NilError(t, err)
+	assert.Equal(t, "40", contract.Quantity)
+	assert.Equal(t, "408", contract.EscrowAmount)
+
+	// the credits the seller held before the issuance are not delivered
+	sellerBal, err := s.coreStore.BatchBalanceTable().Get(s.ctx, seller, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "60", sellerBal.TradableAmount)
+
+	buyer1Bal, err := s.coreStore.BatchBalanceTable().Get(s.ctx, buyer1, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "30", buyer1Bal.RetiredAmount)
+
+	buyer2Bal, err := s.coreStore.BatchBalanceTable().Get(s.ctx, buyer2, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "10", buyer2Bal.RetiredAmount)
+
+	for tradeId, expected := range map[uint64]struct {
+		contractId uint64
+		quantity   string
+	}{1: {id1, "30"}, 2: {id2, "10"}} {
+		trade, err := s.marketStore.TradeTable().Get(s.ctx, tradeId)
+		assert.NilError(t, err)
+		assert.Equal(t, expected.contractId, trade.ForwardContractId)
+		assert.Equal(t, expected.quantity, trade.Quantity)
+		assert.Equal(t, "10", trade.UnitPrice)
+	}
+
+	// nothing is delivered when the seller has no tradable credits
+	sellerBal.TradableAmount = "0"
+	assert.NilError(t, s.coreStore.BatchBalanceTable().Update(s.ctx, sellerBal))
+	assert.NilError(t, s.k.DeliverForwardContracts(s.ctx, 1, map[string]math.Dec{
+		seller.String(): math.NewDecFromInt64(40),
+	}))
+
+	contract, err = s.marketStore.ForwardContractTable().Get(s.ctx, id2)
+	assert.NilError(t, err)
+	assert.Equal(t, "40", contract.Quantity)
+
+	// the remaining escrow of the second forward contract is refunded at the delivery deadline
+	s.sdkCtx = s.sdkCtx.WithBlockTime(forwardSaleBlockTime.Add(time.Hour * 24 * 365))
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+	s.expectRelease(buyer2, 408)
+	assert.NilError(t, s.k.PruneForwardSales(s.ctx))
+
+	_, err = s.marketStore.ForwardContractTable().Get(s.ctx, id2)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+	_, err = s.marketStore.ForwardSaleTable().Get(s.ctx, saleRes.ForwardSaleId)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+}
+
+func TestPruneForwardSales(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	buyer := s.addrs[1]
+
+	msg := s.forwardSaleSetup()
+	saleRes, err := s.k.CreateForwardSale(s.ctx, msg)
+	assert.NilError(t, err)
+	deadline := *msg.DeliveryDeadline
+	later := deadline.Add(time.Hour)
+	msg.DeliveryDeadline = &later
+	laterRes, err := s.k.CreateForwardSale(s.ctx, msg)
+	assert.NilError(t, err)
+
+	id := s.buyForwardContract(saleRes.ForwardSaleId, buyer, "10", 100)
+
+	// nothing is pruned before the delivery deadline
+	assert.NilError(t, s.k.PruneForwardSales(s.ctx))
+	_, err = s.marketStore.ForwardContractTable().Get(s.ctx, id)
+	assert.NilError(t, err)
+
+	s.sdkCtx = s.sdkCtx.WithBlockTime(deadline)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+	s.expectRelease(buyer, 100)
+	assert.NilError(t, s.k.PruneForwardSales(s.ctx))
+
+	_, err = s.marketStore.ForwardSaleTable().Get(s.ctx, saleRes.ForwardSaleId)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+	_, err = s.marketStore.ForwardContractTable().Get(s.ctx, id)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	// the forward sale with a later delivery deadline is not pruned
+	_, err = s.marketStore.ForwardSaleTable().Get(s.ctx, laterRes.ForwardSaleId)
+	assert.NilError(t, err)
+}
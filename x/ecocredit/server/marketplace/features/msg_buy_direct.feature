@@ -3,6 +3,7 @@ Feature: Msg/BuyDirect
   Credits can be bought directly:
   - when the sell order exists
   - when the buyer is not the seller
+  - when the buyer is allowed to buy from a private sell order
   - when the bid denom matches the sell denom
   - when the buyer has a bank balance greater than or equal to the total cost
   - when the buyer provides a bid price greater than or equal to the ask price
@@ -46,6 +47,31 @@ Feature: Msg/BuyDirect
       When alice attempts to buy credits with sell order id "1"
       Then expect the error "orders[0]: buyer account cannot be the same as seller account: unauthorized"
 
+  Rule: The buyer must be allowed to buy from a private sell order
+
+    Background:
+      Given a credit type
+
+    Scenario: the buyer is an allowed buyer
+      Given alice created a sell order with allowed buyer "bob"
+      When bob attempts to buy credits with sell order id "1"
+      Then expect no error
+
+    Scenario: the buyer is the buyer group policy
+      Given alice created a sell order with buyer group policy "bob"
+      When bob attempts to buy credits with sell order id "1"
+      Then expect no error
+
+    Scenario: the buyer is not an allowed buyer
+      Given alice created a sell order with allowed buyer "charlie"
+      When bob attempts to buy credits with sell order id "1"
+      Then expect the error "orders[0]: buyer account is not allowed to buy from sell order with id 1: unauthorized"
+
+    Scenario: the buyer is not the buyer group policy
+      Given alice created a sell order with buyer group policy "charlie"
+      When bob attempts to buy credits with sell order id "1"
+      Then expect the error "orders[0]: buyer account is not allowed to buy from sell order with id 1: unauthorized"
+
   Rule: The bid denom must match the sell denom
 
     Background:
@@ -140,6 +166,66 @@ Feature: Msg/BuyDirect
       When bob attempts to buy credits with quantity "15"
       Then expect the error "orders[0]: requested quantity: 15, sell order quantity 10: invalid request"
 
+  Rule: The buyer must provide a quantity greater than or equal to the sell order min fill quantity
+
+    Background:
+      Given a credit type
+      And alice created a sell order with quantity "10" and min fill quantity "5"
+      And bob has a bank balance with amount "150"
+
+    Scenario Outline: quantity greater than or equal to min fill quantity
+      When bob attempts to buy credits with quantity "<quantity>"
+      Then expect no error
+
+      Examples:
+        | description  | quantity |
+        | greater than | 7        |
+        | equal to     | 5        |
+
+    Scenario: quantity less than min fill quantity
+      When bob attempts to buy credits with quantity "3"
+      Then expect the error "orders[0]: requested quantity: 3, min fill quantity: 5: invalid request"
+
+  Rule: The buyer must provide the entire sell order quantity if the sell order is all-or-none
+
+    Background:
+      Given a credit type
+      And alice created a sell order with quantity "10" and all or none "true"
+      And bob has a bank balance with amount "150"
+
+    Scenario: quantity equal to sell order quantity
+      When bob attempts to buy credits with quantity "10"
+      Then expect no error
+      And expect no sell order with id "1"
+
+    Scenario: quantity less than sell order quantity
+      When bob attempts to buy credits with quantity "5"
+      Then expect the error "orders[0]: requested quantity: 5, sell order quantity: 10, sell order with id 1 is all-or-none: invalid request"
+
+  Rule: The total cost must be less than or equal to the max total cost
+
+    Background:
+      Given a credit type
+      And alice created a sell order with quantity "10" and ask amount "10"
+      And bob has a bank balance with amount "150"
+
+    Scenario Outline: total cost less than or equal to max total cost
+      When bob attempts to buy credits with quantity "10" and max total cost "<max-total-cost>"
+      Then expect no error
+
+      Examples:
+        | description  | max-total-cost |
+        | greater than | 150regen       |
+        | equal to     | 100regen       |
+
+    Scenario: total cost greater than max total cost
+      When bob attempts to buy credits with quantity "10" and max total cost "50regen"
+      Then expect the error "total cost: 100regen, max total cost: 50regen, total cost exceeds max total cost: invalid request"
+
+    Scenario: max total cost does not include the ask denom
+      When bob attempts to buy credits with quantity "10" and max total cost "100uatom"
+      Then expect the error "total cost: 100regen, max total cost: 100uatom, total cost exceeds max total cost: invalid request"
+
   Rule: The number of decimal places in quantity must be less than or equal to the credit type precision
 
     Background:
@@ -275,6 +361,25 @@ Feature: Msg/BuyDirect
       }
       """
 
+    Scenario: beneficiary batch balance updated with retired credits
+      When bob attempts to buy credits with quantity "10" on behalf of charlie
+      Then expect bob batch balance
+      """
+      {
+        "retired_amount": "0",
+        "tradable_amount": "0",
+        "escrowed_amount": "0"
+      }
+      """
+      And expect charlie batch balance
+      """
+      {
+        "retired_amount": "10",
+        "tradable_amount": "0",
+        "escrowed_amount": "0"
+      }
+      """
+
     # no failing scenario - state transitions only occur upon successful message execution
 
   Rule: the seller batch balance is updated
@@ -230,6 +230,26 @@ Feature: Msg/Sell
       }
       """
 
+    Scenario: the private sell order is stored in state
+      Given alice has a tradable batch balance with denom "C01-001-20200101-20210101-001" and amount "10"
+      When alice attempts to create a sell order with the properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "quantity": "10",
+        "ask_price": {
+          "denom": "regen",
+          "amount": "10"
+        },
+        "allowed_buyers": [
+          "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+        ],
+        "buyer_group_policy": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+      }
+      """
+      Then expect sell order with id "1" and allowed buyer "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+      And expect sell order with id "1" and buyer group policy "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+
     # no failing scenario - state transitions only occur upon successful message execution
 
   Rule: The response includes the sell order ids
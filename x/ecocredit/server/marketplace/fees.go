@@ -0,0 +1,104 @@
+package marketplace
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// getMarketFees returns the maker and taker fees in basis points that apply to the market. The fees of
+// the market take precedence over the fees of the credit type of the market. If neither the market nor
+// the credit type have fees, no fees apply.
+func (k Keeper) getMarketFees(ctx context.Context, market *api.Market) (makerBps, takerBps uint32, err error) {
+	marketFee, err := k.stateStore.MarketFeeTable().Get(ctx, market.Id)
+	switch {
+	case err == nil:
+		return marketFee.MakerFeeBps, marketFee.TakerFeeBps, nil
+	case !ormerrors.NotFound.Is(err):
+		return 0, 0, err
+	}
+
+	creditTypeFee, err := k.stateStore.CreditTypeFeeTable().Get(ctx, market.CreditTypeAbbrev)
+	switch {
+	case err == nil:
+		return creditTypeFee.MakerFeeBps, creditTypeFee.TakerFeeBps, nil
+	case !ormerrors.NotFound.Is(err):
+		return 0, 0, err
+	}
+
+	return 0, 0, nil
+}
+
+// getFee calculates the fee for the amount using the fee in basis points. The fee is truncated.
+func getFee(amount sdk.Int, bps uint32) sdk.Int {
+	return amount.MulRaw(int64(bps)).QuoRaw(int64(marketplace.MaxFeeBps))
+}
+
+// getEscrowAmount calculates the amount escrowed for a buy order, which is the total cost of the buy
+// order plus the taker fee for the total cost.
+func getEscrowAmount(cost sdk.Int, takerBps uint32) sdk.Int {
+	return cost.Add(getFee(cost, takerBps))
+}
+
+// collectFees sends fees held in the ecocredit module account to the fee destination and adds the fees
+// to the total amount of fees collected in the market.
+func (k Keeper) collectFees(ctx context.Context, market *api.Market, fees sdk.Int) error {
+	if !fees.IsPositive() {
+		return nil
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(market.BankDenom, fees))
+	if err := k.sendToFeeDestination(ctx, coins); err != nil {
+		return err
+	}
+
+	total := sdk.ZeroInt()
+	feeTotal, err := k.stateStore.MarketFeeTotalTable().Get(ctx, market.Id)
+	switch {
+	case err == nil:
+		var ok bool
+		total, ok = sdk.NewIntFromString(feeTotal.Amount)
+		if !ok {
+			return sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", feeTotal.Amount, sdk.Int{})
+		}
+	case !ormerrors.NotFound.Is(err):
+		return err
+	}
+
+	return k.stateStore.MarketFeeTotalTable().Save(ctx, &api.MarketFeeTotal{
+		MarketId: market.Id,
+		Amount:   total.Add(fees).String(),
+	})
+}
+
+// sendToFeeDestination sends coins held in the ecocredit module account to the fee destination.
+func (k Keeper) sendToFeeDestination(ctx context.Context, coins sdk.Coins) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	dest, err := k.stateStore.FeeDestinationTable().Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	switch dest.Destination {
+	case api.FeeDestinationType_FEE_DESTINATION_TYPE_BURN:
+		return k.bankKeeper.BurnCoins(sdkCtx, ecocredit.ModuleName, coins)
+	case api.FeeDestinationType_FEE_DESTINATION_TYPE_ACCOUNT:
+		return k.bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, ecocredit.ModuleName, dest.Address, coins)
+	default:
+		return k.distrKeeper.FundCommunityPool(sdkCtx, coins, authtypes.NewModuleAddress(ecocredit.ModuleName))
+	}
+}
+
+// newFeeCoin returns a coin for an event from the fee amount.
+func newFeeCoin(denom string, fee sdk.Int) *sdk.Coin {
+	coin := sdk.NewCoin(denom, fee)
+	return &coin
+}
@@ -0,0 +1,191 @@
+package marketplace
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func (s *baseSuite) setCreditTypeFees(makerBps, takerBps uint32) {
+	assert.NilError(s.t, s.marketStore.CreditTypeFeeTable().Insert(s.ctx, &api.CreditTypeFee{
+		CreditTypeAbbrev: creditType.Abbreviation,
+		MakerFeeBps:      makerBps,
+		TakerFeeBps:      takerBps,
+	}))
+}
+
+func TestFees_GetFee(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "0", getFee(sdk.NewInt(100), 0).String())
+	assert.Equal(t, "1", getFee(sdk.NewInt(100), 100).String())
+	assert.Equal(t, "3", getFee(sdk.NewInt(180), 200).String()) // 3.6 truncated
+	assert.Equal(t, "100", getFee(sdk.NewInt(100), marketplace.MaxFeeBps).String())
+	assert.Equal(t, "183", getEscrowAmount(sdk.NewInt(180), 200).String())
+}
+
+func TestFees_MarketFeesPrecedence(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 1)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+
+	market, err := s.marketStore.MarketTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+
+	// no fees
+	makerBps, takerBps, err := s.k.getMarketFees(s.ctx, market)
+	assert.NilError(t, err)
+	assert.Equal(t, uint32(0), makerBps)
+	assert.Equal(t, uint32(0), takerBps)
+
+	// credit type fees
+	s.setCreditTypeFees(100, 200)
+	makerBps, takerBps, err = s.k.getMarketFees(s.ctx, market)
+	assert.NilError(t, err)
+	assert.Equal(t, uint32(100), makerBps)
+	assert.Equal(t, uint32(200), takerBps)
+
+	// market fees take precedence over credit type fees
+	assert.NilError(t, s.marketStore.MarketFeeTable().Insert(s.ctx, &api.MarketFee{
+		MarketId:    market.Id,
+		MakerFeeBps: 50,
+		TakerFeeBps: 0,
+	}))
+	makerBps, takerBps, err = s.k.getMarketFees(s.ctx, market)
+	assert.NilError(t, err)
+	assert.Equal(t, uint32(50), makerBps)
+	assert.Equal(t, uint32(0), takerBps)
+}
+
+func TestFees_BuyDirect(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, buyer := s.addrs[0], s.addrs[1]
+	s.setCreditTypeFees(100, 200)
+
+	sellOrderIds := s.createSellOrder(&marketplace.MsgSell{
+		Seller: seller.String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: "20", AskPrice: &ask, DisableAutoRetire: true},
+		},
+	})
+
+	order := &marketplace.MsgBuyDirect_Order{
+		SellOrderId: sellOrderIds[0], Quantity: "10", BidPrice: &ask, DisableAutoRetire: true,
+	}
+
+	// the buyer must cover the cost and the taker fee
+	s.bankKeeper.EXPECT().GetBalance(gmAny, buyer, ask.Denom).Return(sdk.NewInt64Coin(ask.Denom, 101)).Times(1)
+	_, err := s.k.BuyDirect(s.ctx, &marketplace.MsgBuyDirect{
+		Buyer:  buyer.String(),
+		Orders: []*marketplace.MsgBuyDirect_Order{order},
+	})
+	assert.ErrorContains(t, err, "total price: 102")
+
+	// the seller receives the cost minus the maker fee and the fees are sent to the community pool
+	moduleAddr := authtypes.NewModuleAddress(ecocredit.ModuleName)
+	fees := sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 3))
+	s.bankKeeper.EXPECT().GetBalance(gmAny, buyer, ask.Denom).Return(sdk.NewInt64Coin(ask.Denom, 102)).Times(1)
+	s.bankKeeper.EXPECT().SendCoins(gmAny, buyer, seller, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 99))).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gmAny, buyer, ecocredit.ModuleName, fees).Return(nil).Times(1)
+	s.distrKeeper.EXPECT().FundCommunityPool(gmAny, fees, moduleAddr).Return(nil).Times(1)
+	_, err = s.k.BuyDirect(s.ctx, &marketplace.MsgBuyDirect{
+		Buyer:  buyer.String(),
+		Orders: []*marketplace.MsgBuyDirect_Order{order},
+	})
+	assert.NilError(t, err)
+
+	total, err := s.marketStore.MarketFeeTotalTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "3", total.Amount)
+
+	// fees are sent to the fee destination account and added to the total
+	feeAddr := s.addrs[0]
+	assert.NilError(t, s.marketStore.FeeDestinationTable().Save(s.ctx, &api.FeeDestination{
+		Destination: api.FeeDestinationType_FEE_DESTINATION_TYPE_ACCOUNT,
+		Address:     feeAddr,
+	}))
+	s.bankKeeper.EXPECT().GetBalance(gmAny, buyer, ask.Denom).Return(sdk.NewInt64Coin(ask.Denom, 102)).Times(1)
+	s.bankKeeper.EXPECT().SendCoins(gmAny, buyer, seller, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 99))).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gmAny, buyer, ecocredit.ModuleName, fees).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gmAny, ecocredit.ModuleName, feeAddr, fees).Return(nil).Times(1)
+	_, err = s.k.BuyDirect(s.ctx, &marketplace.MsgBuyDirect{
+		Buyer:  buyer.String(),
+		Orders: []*marketplace.MsgBuyDirect_Order{order},
+	})
+	assert.NilError(t, err)
+
+	total, err = s.marketStore.MarketFeeTotalTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "6", total.Amount)
+}
+
+func TestFees_FillBuyOrders(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, buyer := s.addrs[0], s.addrs[1]
+	s.setCreditTypeFees(100, 200)
+	assert.NilError(t, s.marketStore.FeeDestinationTable().Save(s.ctx, &api.FeeDestination{
+		Destination: api.FeeDestinationType_FEE_DESTINATION_TYPE_BURN,
+	}))
+
+	s.createSellOrder(&marketplace.MsgSell{
+		Seller: seller.String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: "10", AskPrice: &ask},
+		},
+	})
+
+	// bid 12 for 15 credits, the bid amount and the taker fee are escrowed (180 + 3)
+	bid := sdk.NewInt64Coin(ask.Denom, 12)
+	s.expectEscrow(buyer, 183)
+	buyRes, err := s.k.Buy(s.ctx, &marketplace.MsgBuy{
+		Buyer: buyer.String(),
+		Orders: []*marketplace.MsgBuy_Order{
+			{Filter: classFilter("C01", ""), Quantity: "15", BidPrice: &bid},
+		},
+	})
+	assert.NilError(t, err)
+
+	buyOrder, err := s.marketStore.BuyOrderTable().Get(s.ctx, buyRes.BuyOrderIds[0])
+	assert.NilError(t, err)
+	assert.Equal(t, uint32(200), buyOrder.TakerFeeBps)
+
+	// the taker fee is locked when the buy order is created
+	s.setMarketFees(1, 100, 500)
+
+	// 122 is released from escrow (183 - 61), the seller receives the payment minus the
+	// maker fee (100 - 1), the fees are burned (1 + 2) and the buyer is refunded the rest
+	s.expectRelease(seller, 99)
+	s.expectRelease(buyer, 20)
+	s.bankKeeper.EXPECT().BurnCoins(gmAny, ecocredit.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 3))).Return(nil).Times(1)
+	assert.NilError(t, s.k.FillBuyOrders(s.ctx))
+
+	// the remaining escrow includes the taker fee for the remaining quantity (60 + 1)
+	s.expectRelease(buyer, 61)
+	_, err = s.k.CancelBuyOrder(s.ctx, &marketplace.MsgCancelBuyOrder{
+		Buyer:      buyer.String(),
+		BuyOrderId: buyRes.BuyOrderIds[0],
+	})
+	assert.NilError(t, err)
+
+	total, err := s.marketStore.MarketFeeTotalTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "3", total.Amount)
+}
+
+func (s *baseSuite) setMarketFees(marketId uint64, makerBps, takerBps uint32) {
+	assert.NilError(s.t, s.marketStore.MarketFeeTable().Save(s.ctx, &api.MarketFee{
+		MarketId:    marketId,
+		MakerFeeBps: makerBps,
+		TakerFeeBps: takerBps,
+	}))
+}
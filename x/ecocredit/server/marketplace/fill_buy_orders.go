@@ -0,0 +1,194 @@
+package marketplace
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// MatchFillLimit is the maximum number of matches of buy orders and sell orders that are processed in a
+// single block. Matches that exceed the limit are processed in the following blocks.
+const MatchFillLimit = 100
+
+// FillBuyOrders is an EndBlock function that fills buy orders with matching sell orders. Matches are
+// processed from the highest bid price to the lowest bid price and then from the lowest ask price to
+// the highest ask price. At most MatchFillLimit matches are processed per block.
+func (k Keeper) FillBuyOrders(ctx context.Context) error {
+	return k.fillBuyOrders(ctx, MatchFillLimit)
+}
+
+// fillBuyOrders processes at most limit matches. Each match is filled in a cached context so that a
+// match that cannot be filled neither leaves partial state changes nor prevents the other matches from
+// being filled. The failure is logged and the match is removed by the order book.
+func (k Keeper) fillBuyOrders(ctx context.Context, limit int) error {
+	return k.orderBook.ProcessBatch(ctx, limit, func(ctx context.Context, buyOrder *api.BuyOrder, sellOrder *api.SellOrder) error {
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+		err := k.applyCached(sdkCtx, func(ctx context.Context) error {
+			return k.fillBuyOrder(ctx, buyOrder, sellOrder)
+		})
+		if err != nil {
+			sdkCtx.Logger().Error("failed to fill buy order",
+				"buy_order_id", buyOrder.Id, "sell_order_id", sellOrder.Id, "err", err)
+		}
+		return nil
+	})
+}
+
+// fillBuyOrder fills the buy order with as many credits from the sell order as possible. It will:
+// - move credits from the seller's escrowed balance to the buyer's tradable/retired balance.
+// - update the sell order and the buy order, removing each if its quantity becomes 0.
+// - send the ask price for the credits minus the maker fee from the escrowed funds to the seller.
+// - collect the maker fee and the taker fee from the escrowed funds.
+// - return the difference between the released funds and the ask price plus the taker fee to the buyer.
+// - record the trade.
+func (k Keeper) fillBuyOrder(ctx context.Context, buyOrder *api.BuyOrder, sellOrder *api.SellOrder) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// expired sell orders that exceeded the prune limit are not filled and the match is removed
+	if utils.IsSellOrderExpired(sellOrder, sdkCtx.BlockTime()) {
+		return nil
+	}
+
+	buyQty, err := math.NewDecFromString(buyOrder.Quantity)
+	if err != nil {
+		return err
+	}
+	sellQty, err := math.NewDecFromString(sellOrder.Quantity)
+	if err != nil {
+		return err
+	}
+
+	fillQty := buyQty
+	if sellQty.Cmp(buyQty) == math.LessThan {
+		fillQty = sellQty
+	}
+
+	// the sell order is not filled if the fill does not satisfy the min fill quantity
+	// or the all-or-none requirement of the sell order
+	if assertSellOrderFill(sellOrder, fillQty) != nil {
+		return nil
+	}
+
+	remainingQty, err := buyQty.Sub(fillQty)
+	if err != nil {
+		return err
+	}
+
+	market, err := k.stateStore.MarketTable().Get(ctx, buyOrder.MarketId)
+	if err != nil {
+		return err
+	}
+
+	// buy orders are not filled in a delisted market
+	if market.Delisted {
+		return nil
+	}
+
+	batch, err := k.coreStore.BatchTable().Get(ctx, sellOrder.BatchKey)
+	if err != nil {
+		return err
+	}
+
+	buyerAcc := sdk.AccAddress(buyOrder.Buyer)
+	orderIndex := fmt.Sprintf("buy order %d", buyOrder.Id)
+
+	if err = k.transferCredits(ctx, orderIndex, sellOrder, buyerAcc, fillQty, orderOptions{
+		autoRetire:   !buyOrder.DisableAutoRetire,
+		batchDenom:   batch.Denom,
+		jurisdiction: buyOrder.RetirementJurisdiction,
+	}); err != nil {
+		return err
+	}
+
+	bidAmount, ok := sdk.NewIntFromString(buyOrder.BidAmount)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", buyOrder.BidAmount, sdk.Int{})
+	}
+	askAmount, ok := sdk.NewIntFromString(sellOrder.AskAmount)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", sellOrder.AskAmount, sdk.Int{})
+	}
+
+	// the funds released from escrow are calculated from the remaining quantity of the buy
+	// order to ensure the escrowed funds always cover the remaining quantity
+	costBefore, err := getTotalCost(bidAmount, buyQty)
+	if err != nil {
+		return err
+	}
+	costAfter, err := getTotalCost(bidAmount, remainingQty)
+	if err != nil {
+		return err
+	}
+	escrowBefore := getEscrowAmount(costBefore, buyOrder.TakerFeeBps)
+	escrowAfter := getEscrowAmount(costAfter, buyOrder.TakerFeeBps)
+	released := escrowBefore.Sub(escrowAfter)
+
+	payment, err := getTotalCost(askAmount, fillQty)
+	if err != nil {
+		return err
+	}
+
+	// the maker fee is the fee of the market at the time of the fill and the taker fee is
+	// the fee of the market at the time the buy order was created
+	makerBps, _, err := k.getMarketFees(ctx, market)
+	if err != nil {
+		return err
+	}
+	makerFee := getFee(payment, makerBps)
+	takerFee := getFee(payment, buyOrder.TakerFeeBps)
+	proceeds := payment.Sub(makerFee)
+	refund := released.Sub(payment).Sub(takerFee)
+
+	if proceeds.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(market.BankDenom, proceeds))
+		if err = k.bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, ecocredit.ModuleName, sellOrder.Seller, coins); err != nil {
+			return err
+		}
+	}
+	if refund.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(market.BankDenom, refund))
+		if err = k.bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, ecocredit.ModuleName, buyerAcc, coins); err != nil {
+			return err
+		}
+	}
+
+	if err = k.collectFees(ctx, market, makerFee.Add(takerFee)); err != nil {
+		return err
+	}
+
+	tradeId, err := k.recordTrade(ctx, newSellOrderTrade(sellOrder, buyOrder.Id, buyerAcc, fillQty))
+	if err != nil {
+		return err
+	}
+
+	if remainingQty.IsZero() {
+		if err = k.stateStore.BuyOrderTable().Delete(ctx, buyOrder); err != nil {
+			return err
+		}
+		if err = k.orderBook.OnDeleteBuyOrder(ctx, buyOrder); err != nil {
+			return err
+		}
+	} else {
+		buyOrder.Quantity = remainingQty.String()
+		if err = k.stateStore.BuyOrderTable().Update(ctx, buyOrder); err != nil {
+			return err
+		}
+	}
+
+	return sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventFillBuyOrder{
+		BuyOrderId:  buyOrder.Id,
+		SellOrderId: sellOrder.Id,
+		Quantity:    fillQty.String(),
+		MakerFee:    newFeeCoin(market.BankDenom, makerFee),
+		TakerFee:    newFeeCoin(market.BankDenom, takerFee),
+		TradeId:     tradeId,
+	})
+}
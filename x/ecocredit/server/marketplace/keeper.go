@@ -5,21 +5,26 @@ import (
 	ecoApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+	"github.com/regen-network/regen-ledger/x/ecocredit/orderbook"
 )
 
 type Keeper struct {
 	stateStore   marketApi.StateStore
 	coreStore    ecoApi.StateStore
 	bankKeeper   ecocredit.BankKeeper
+	distrKeeper  ecocredit.DistributionKeeper
 	paramsKeeper ecocredit.ParamKeeper
+	orderBook    orderbook.OrderBook
 }
 
-func NewKeeper(ss marketApi.StateStore, cs ecoApi.StateStore, bk ecocredit.BankKeeper, params ecocredit.ParamKeeper) Keeper {
+func NewKeeper(ss marketApi.StateStore, cs ecoApi.StateStore, bk ecocredit.BankKeeper, dk ecocredit.DistributionKeeper, params ecocredit.ParamKeeper, ob orderbook.OrderBook) Keeper {
 	return Keeper{
 		coreStore:    cs,
 		stateStore:   ss,
 		bankKeeper:   bk,
+		distrKeeper:  dk,
 		paramsKeeper: params,
+		orderBook:    ob,
 	}
 }
 
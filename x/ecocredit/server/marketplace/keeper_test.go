@@ -26,6 +26,7 @@ import (
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
 	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
 	"github.com/regen-network/regen-ledger/x/ecocredit/mocks"
+	"github.com/regen-network/regen-ledger/x/ecocredit/orderbook"
 	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
 )
 
@@ -43,6 +44,7 @@ type baseSuite struct {
 	ctrl         *gomock.Controller
 	addrs        []sdk.AccAddress
 	bankKeeper   *mocks.MockBankKeeper
+	distrKeeper  *mocks.MockDistributionKeeper
 	paramsKeeper *mocks.MockParamKeeper
 	storeKey     *sdk.KVStoreKey
 	sdkCtx       sdk.Context
@@ -72,8 +74,11 @@ func setupBase(t gocuke.TestingT, numAddresses int) *baseSuite {
 	s.ctrl = gomock.NewController(t)
 	assert.NilError(t, err)
 	s.bankKeeper = mocks.NewMockBankKeeper(s.ctrl)
+	s.distrKeeper = mocks.NewMockDistributionKeeper(s.ctrl)
 	s.paramsKeeper = mocks.NewMockParamKeeper(s.ctrl)
-	s.k = NewKeeper(s.marketStore, s.coreStore, s.bankKeeper, s.paramsKeeper)
+	ob, err := orderbook.NewOrderBook(s.db)
+	assert.NilError(t, err)
+	s.k = NewKeeper(s.marketStore, s.coreStore, s.bankKeeper, s.distrKeeper, s.paramsKeeper, ob)
 
 	// set test accounts
 	for i := 0; i < numAddresses; i++ {
@@ -185,6 +190,10 @@ func (s *baseSuite) testSellSetup(batchDenom, bankDenom, displayDenom, classId s
 		Metadata:         "",
 		CreditTypeAbbrev: creditType.Abbreviation,
 	}))
+	assert.NilError(s.t, s.coreStore.ProjectTable().Insert(s.ctx, &ecoApi.Project{
+		Id:       classId + "-001",
+		ClassKey: 1,
+	}))
 	assert.NilError(s.t, s.coreStore.BatchTable().Insert(s.ctx, &ecoApi.Batch{
 		ProjectKey: 1,
 		Denom:      batchDenom,
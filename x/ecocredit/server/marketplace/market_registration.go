@@ -0,0 +1,71 @@
+package marketplace
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// SetMarketRegistration is a gov handler method that enables or disables permissionless market
+// registration and sets the deposit paid to register a market.
+func (k Keeper) SetMarketRegistration(ctx sdk.Context, p *marketplace.MarketRegistrationProposal) error {
+	if p == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("nil proposal")
+	}
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	registration := &api.MarketRegistration{Enabled: p.Enabled}
+	if p.Deposit != nil {
+		registration.DepositDenom = p.Deposit.Denom
+		registration.DepositAmount = p.Deposit.Amount.String()
+	}
+
+	if err := k.stateStore.MarketRegistrationTable().Save(sdk.WrapSDKContext(ctx), registration); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&marketplace.EventSetMarketRegistration{
+		Enabled: p.Enabled,
+		Deposit: p.Deposit,
+	})
+}
+
+// DelistMarket is a gov handler method that delists a market. New orders cannot be created and existing
+// orders cannot be filled in a delisted market.
+func (k Keeper) DelistMarket(ctx sdk.Context, p *marketplace.DelistMarketProposal) error {
+	if p == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("nil proposal")
+	}
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	goCtx := sdk.WrapSDKContext(ctx)
+	market, err := k.stateStore.MarketTable().Get(goCtx, p.MarketId)
+	if err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("could not get market with id %d: %s", p.MarketId, err)
+	}
+
+	if market.Delisted {
+		return sdkerrors.ErrInvalidRequest.Wrapf("market with id %d has already been delisted", p.MarketId)
+	}
+
+	market.Delisted = true
+	if err = k.stateStore.MarketTable().Update(goCtx, market); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&marketplace.EventDelistMarket{MarketId: p.MarketId})
+}
+
+// assertMarketListed checks that the market has not been delisted.
+func assertMarketListed(market *api.Market) error {
+	if market.Delisted {
+		return sdkerrors.ErrInvalidRequest.Wrapf("market with id %d has been delisted", market.Id)
+	}
+	return nil
+}
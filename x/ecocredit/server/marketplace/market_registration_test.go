@@ -0,0 +1,104 @@
+package marketplace
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func TestSetMarketRegistration(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 1)
+
+	res, err := s.k.MarketRegistration(s.ctx, &marketplace.QueryMarketRegistrationRequest{})
+	assert.NilError(t, err)
+	assert.Check(t, !res.Enabled)
+	assert.Check(t, res.Deposit == nil)
+
+	deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	s.enableMarketRegistration(deposit)
+
+	res, err = s.k.MarketRegistration(s.ctx, &marketplace.QueryMarketRegistrationRequest{})
+	assert.NilError(t, err)
+	assert.Check(t, res.Enabled)
+	assert.Equal(t, deposit.String(), res.Deposit.String())
+
+	err = s.k.SetMarketRegistration(s.sdkCtx, &marketplace.MarketRegistrationProposal{
+		Title:       "foo",
+		Description: "bar",
+	})
+	assert.NilError(t, err)
+
+	res, err = s.k.MarketRegistration(s.ctx, &marketplace.QueryMarketRegistrationRequest{})
+	assert.NilError(t, err)
+	assert.Check(t, !res.Enabled)
+	assert.Check(t, res.Deposit == nil)
+}
+
+func TestDelistMarket(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, buyer := s.addrs[0], s.addrs[1]
+
+	deposit := s.enableMarketRegistration(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	marketId := s.registerMarket(buyer, deposit)
+
+	askPrice := sdk.NewInt64Coin(registeredDenom, 10)
+	sellOrderIds := s.createSellOrder(&marketplace.MsgSell{
+		Seller: seller.String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: "10", AskPrice: &askPrice},
+		},
+	})
+
+	delist := &marketplace.DelistMarketProposal{Title: "foo", Description: "bar", MarketId: marketId}
+	assert.NilError(t, s.k.DelistMarket(s.sdkCtx, delist))
+
+	market, err := s.marketStore.MarketTable().Get(s.ctx, marketId)
+	assert.NilError(t, err)
+	assert.Check(t, market.Delisted)
+
+	err = s.k.DelistMarket(s.sdkCtx, delist)
+	assert.ErrorContains(t, err, "has already been delisted")
+
+	err = s.k.DelistMarket(s.sdkCtx, &marketplace.DelistMarketProposal{Title: "foo", Description: "bar", MarketId: 3})
+	assert.ErrorContains(t, err, "could not get market with id 3")
+
+	// existing sell orders cannot be filled in a delisted market
+	_, err = s.k.BuyDirect(s.ctx, &marketplace.MsgBuyDirect{
+		Buyer: buyer.String(),
+		Orders: []*marketplace.MsgBuyDirect_Order{
+			{SellOrderId: sellOrderIds[0], Quantity: "10", BidPrice: &askPrice, RetirementJurisdiction: "US-WA"},
+		},
+	})
+	assert.ErrorContains(t, err, "orders[0]: market with id 2 has been delisted")
+
+	// a delisted market cannot be registered again
+	_, err = s.k.RegisterMarket(s.ctx, &marketplace.MsgRegisterMarket{
+		Registrant:       buyer.String(),
+		CreditTypeAbbrev: creditType.Abbreviation,
+		BankDenom:        registeredDenom,
+	})
+	assert.ErrorContains(t, err, "market with id 2 has been delisted")
+
+	// new sell orders cannot be created in a delisted market
+	_, err = s.k.Sell(s.ctx, &marketplace.MsgSell{
+		Seller: seller.String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: "10", AskPrice: &askPrice},
+		},
+	})
+	assert.ErrorContains(t, err, "is not allowed to be used in sell orders")
+
+	// governance delisting applies to markets of denoms allowed by governance
+	delist.MarketId = 1
+	assert.NilError(t, s.k.DelistMarket(s.sdkCtx, delist))
+	allowed, err := s.k.isDenomAllowed(s.ctx, creditType.Abbreviation, ask.Denom)
+	assert.NilError(t, err)
+	assert.Check(t, !allowed)
+}
@@ -0,0 +1,220 @@
+package marketplace
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// AcceptOffer accepts an offer to buy credits. It will:
+// - move credits from the holder's tradable balance to the buyer's tradable/retired balance.
+// - send the bid price for the credits minus the taker fee from the escrowed funds to the holder.
+// - collect the maker fee and the taker fee from the escrowed funds.
+// - return the difference between the released funds and the bid price plus the maker fee to the buyer.
+// - update the offer, removing it if its quantity becomes 0.
+// - record the trade.
+func (k Keeper) AcceptOffer(ctx context.Context, req *marketplace.MsgAcceptOffer) (*marketplace.MsgAcceptOfferResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	holderAcc, err := sdk.AccAddressFromBech32(req.Holder)
+	if err != nil {
+		return nil, err
+	}
+
+	offer, err := k.stateStore.OfferTable().Get(ctx, req.OfferId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("offer with id %d: %s", req.OfferId, err.Error())
+	}
+
+	buyerAcc := sdk.AccAddress(offer.Buyer)
+	if holderAcc.Equals(buyerAcc) {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("holder cannot accept their own offer")
+	}
+	if len(offer.Holder) != 0 && !holderAcc.Equals(sdk.AccAddress(offer.Holder)) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"%s: holder account is not allowed to accept offer with id %d", req.Holder, offer.Id,
+		)
+	}
+
+	batch, err := k.coreStore.BatchTable().Get(ctx, offer.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = utils.AssertBatchNotFrozen(batch); err != nil {
+		return nil, err
+	}
+
+	creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.coreStore, batch.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	// check decimal places does not exceed credit type precision
+	acceptQty, err := math.NewPositiveFixedDecFromString(req.Quantity, creditType.Precision)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"decimal places exceeds precision: quantity: %s, credit type precision: %d",
+			req.Quantity, creditType.Precision,
+		)
+	}
+
+	offerQty, err := math.NewDecFromString(offer.Quantity)
+	if err != nil {
+		return nil, err
+	}
+	if offerQty.Cmp(acceptQty) == math.LessThan {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"offer %d: requested quantity: %v, offer quantity %s",
+			offer.Id, acceptQty, offer.Quantity,
+		)
+	}
+
+	remainingQty, err := offerQty.Sub(acceptQty)
+	if err != nil {
+		return nil, err
+	}
+
+	orderIndex := fmt.Sprintf("offer %d", offer.Id)
+	if err = k.withdrawCredits(ctx, orderIndex, holderAcc, offer.BatchKey, acceptQty); err != nil {
+		return nil, err
+	}
+
+	if err = k.depositCredits(ctx, holderAcc, buyerAcc, offer.BatchKey, acceptQty, orderOptions{
+		autoRetire:   !offer.DisableAutoRetire,
+		batchDenom:   batch.Denom,
+		jurisdiction: offer.RetirementJurisdiction,
+	}); err != nil {
+		return nil, err
+	}
+
+	market, err := k.stateStore.MarketTable().Get(ctx, offer.MarketId)
+	if err != nil {
+		return nil, err
+	}
+	if err = assertMarketListed(market); err != nil {
+		return nil, err
+	}
+
+	bidAmount, ok := sdk.NewIntFromString(offer.BidAmount)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", offer.BidAmount, sdk.Int{})
+	}
+
+	// the funds released from escrow are calculated from the remaining quantity of the offer
+	// to ensure the escrowed funds always cover the remaining quantity
+	costBefore, err := getTotalCost(bidAmount, offerQty)
+	if err != nil {
+		return nil, err
+	}
+	costAfter, err := getTotalCost(bidAmount, remainingQty)
+	if err != nil {
+		return nil, err
+	}
+	escrowBefore := getEscrowAmount(costBefore, offer.MakerFeeBps)
+	escrowAfter := getEscrowAmount(costAfter, offer.MakerFeeBps)
+	released := escrowBefore.Sub(escrowAfter)
+
+	payment, err := getTotalCost(bidAmount, acceptQty)
+	if err != nil {
+		return nil, err
+	}
+
+	// the maker fee is the fee of the market at the time the offer was made and the taker fee
+	// is the fee of the market at the time the offer is accepted
+	_, takerBps, err := k.getMarketFees(ctx, market)
+	if err != nil {
+		return nil, err
+	}
+	makerFee := getFee(payment, offer.MakerFeeBps)
+	takerFee := getFee(payment, takerBps)
+	proceeds := payment.Sub(takerFee)
+	refund := released.Sub(payment).Sub(makerFee)
+
+	if proceeds.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(market.BankDenom, proceeds))
+		if err = k.bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, ecocredit.ModuleName, holderAcc, coins); err != nil {
+			return nil, err
+		}
+	}
+	if refund.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(market.BankDenom, refund))
+		if err = k.bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, ecocredit.ModuleName, buyerAcc, coins); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = k.collectFees(ctx, market, makerFee.Add(takerFee)); err != nil {
+		return nil, err
+	}
+
+	tradeId, err := k.recordTrade(ctx, &api.Trade{
+		Buyer:     buyerAcc,
+		Seller:    holderAcc,
+		BatchKey:  offer.BatchKey,
+		MarketId:  offer.MarketId,
+		OfferId:   offer.Id,
+		Quantity:  acceptQty.String(),
+		UnitPrice: offer.BidAmount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if remainingQty.IsZero() {
+		err = k.stateStore.OfferTable().Delete(ctx, offer)
+	} else {
+		offer.Quantity = remainingQty.String()
+		err = k.stateStore.OfferTable().Update(ctx, offer)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventAcceptOffer{
+		OfferId:  offer.Id,
+		Holder:   req.Holder,
+		Quantity: acceptQty.String(),
+		MakerFee: newFeeCoin(market.BankDenom, makerFee),
+		TakerFee: newFeeCoin(market.BankDenom, takerFee),
+		TradeId:  tradeId,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &marketplace.MsgAcceptOfferResponse{}, nil
+}
+
+// withdrawCredits removes credits from the tradable balance of the account.
+func (k Keeper) withdrawCredits(ctx context.Context, orderIndex string, account sdk.AccAddress, batchKey uint64, quantity math.Dec) error {
+	bal, err := k.coreStore.BatchBalanceTable().Get(ctx, account, batchKey)
+	if err != nil {
+		return ecocredit.ErrInsufficientCredits.Wrapf(
+			"%s: credit quantity: %v, tradable balance: 0", orderIndex, quantity,
+		)
+	}
+
+	tradable, err := math.NewDecFromString(bal.TradableAmount)
+	if err != nil {
+		return err
+	}
+
+	newTradable, err := math.SafeSubBalance(tradable, quantity)
+	if err != nil {
+		return ecocredit.ErrInsufficientCredits.Wrapf(
+			"%s: credit quantity: %v, tradable balance: %v", orderIndex, quantity, tradable,
+		)
+	}
+
+	bal.TradableAmount = newTradable.String()
+
+	return k.coreStore.BatchBalanceTable().Update(ctx, bal)
+}
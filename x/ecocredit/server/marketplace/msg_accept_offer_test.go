@@ -0,0 +1,159 @@
+package marketplace
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func TestAcceptOffer(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	holder, buyer := s.addrs[0], s.addrs[1]
+	s.setCreditTypeFees(100, 200)
+	assert.NilError(t, s.marketStore.FeeDestinationTable().Save(s.ctx, &api.FeeDestination{
+		Destination: api.FeeDestinationType_FEE_DESTINATION_TYPE_BURN,
+	}))
+
+	// bid 12 for 15 credits, the bid amount and the maker fee are escrowed (180 + 1)
+	bid := sdk.NewInt64Coin(ask.Denom, 12)
+	s.expectEscrow(buyer, 181)
+	res, err := s.k.MakeOffer(s.ctx, &marketplace.MsgMakeOffer{
+		Buyer:                  buyer.String(),
+		BatchDenom:             batchDenom,
+		Quantity:               "15",
+		BidPrice:               &bid,
+		RetirementJurisdiction: "US-WA",
+	})
+	assert.NilError(t, err)
+
+	// 121 is released from escrow (181 - 60), the holder receives the payment minus the
+	// taker fee (120 - 2) and the fees are burned (1 + 2)
+	s.expectRelease(holder, 118)
+	s.bankKeeper.EXPECT().BurnCoins(gmAny, ecocredit.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 3))).Return(nil).Times(1)
+	_, err = s.k.AcceptOffer(s.ctx, &marketplace.MsgAcceptOffer{
+		Holder:   holder.String(),
+		OfferId:  res.OfferId,
+		Quantity: "10",
+	})
+	assert.NilError(t, err)
+
+	offer, err := s.marketStore.OfferTable().Get(s.ctx, res.OfferId)
+	assert.NilError(t, err)
+	assert.Equal(t, "5", offer.Quantity)
+
+	holderBal, err := s.coreStore.BatchBalanceTable().Get(s.ctx, holder, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "90", holderBal.TradableAmount)
+
+	buyerBal, err := s.coreStore.BatchBalanceTable().Get(s.ctx, buyer, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "10", buyerBal.RetiredAmount)
+
+	supply, err := s.coreStore.BatchSupplyTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "90", supply.TradableAmount)
+	assert.Equal(t, "110", supply.RetiredAmount)
+
+	trade, err := s.marketStore.TradeTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, res.OfferId, trade.OfferId)
+	assert.Equal(t, "10", trade.Quantity)
+	assert.Equal(t, bid.Amount.String(), trade.UnitPrice)
+
+	// accepting the remaining quantity removes the offer
+	s.expectRelease(holder, 59)
+	s.bankKeeper.EXPECT().BurnCoins(gmAny, ecocredit.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 1))).Return(nil).Times(1)
+	_, err = s.k.AcceptOffer(s.ctx, &marketplace.MsgAcceptOffer{
+		Holder:   holder.String(),
+		OfferId:  res.OfferId,
+		Quantity: "5",
+	})
+	assert.NilError(t, err)
+
+	_, err = s.marketStore.OfferTable().Get(s.ctx, res.OfferId)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+}
+
+func TestAcceptOffer_Invalid(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 3)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	holder, buyer, other := s.addrs[0], s.addrs[1], s.addrs[2]
+
+	s.expectEscrow(buyer, 100)
+	s.expectEscrow(buyer, 2000)
+	res, err := s.k.MakeOffer(s.ctx, &marketplace.MsgMakeOffer{
+		Buyer:             buyer.String(),
+		BatchDenom:        batchDenom,
+		Holder:            holder.String(),
+		Quantity:          "10",
+		BidPrice:          &ask,
+		DisableAutoRetire: true,
+	})
+	assert.NilError(t, err)
+	openRes, err := s.k.MakeOffer(s.ctx, &marketplace.MsgMakeOffer{
+		Buyer:             buyer.String(),
+		BatchDenom:        batchDenom,
+		Quantity:          "200",
+		BidPrice:          &ask,
+		DisableAutoRetire: true,
+	})
+	assert.NilError(t, err)
+
+	// offer not found
+	_, err = s.k.AcceptOffer(s.ctx, &marketplace.MsgAcceptOffer{
+		Holder:   holder.String(),
+		OfferId:  openRes.OfferId + 1,
+		Quantity: "10",
+	})
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	// holder is not the holder of the offer
+	_, err = s.k.AcceptOffer(s.ctx, &marketplace.MsgAcceptOffer{
+		Holder:   other.String(),
+		OfferId:  res.OfferId,
+		Quantity: "10",
+	})
+	assert.ErrorContains(t, err, "holder account is not allowed to accept offer")
+
+	// buyer cannot accept their own offer
+	_, err = s.k.AcceptOffer(s.ctx, &marketplace.MsgAcceptOffer{
+		Holder:   buyer.String(),
+		OfferId:  openRes.OfferId,
+		Quantity: "10",
+	})
+	assert.ErrorContains(t, err, "holder cannot accept their own offer")
+
+	// quantity exceeds offer quantity
+	_, err = s.k.AcceptOffer(s.ctx, &marketplace.MsgAcceptOffer{
+		Holder:   holder.String(),
+		OfferId:  res.OfferId,
+		Quantity: "11",
+	})
+	assert.ErrorContains(t, err, "requested quantity: 11, offer quantity 10")
+
+	// quantity exceeds tradable balance
+	_, err = s.k.AcceptOffer(s.ctx, &marketplace.MsgAcceptOffer{
+		Holder:   holder.String(),
+		OfferId:  openRes.OfferId,
+		Quantity: "150",
+	})
+	assert.ErrorIs(t, err, ecocredit.ErrInsufficientCredits)
+
+	// holder without credits
+	_, err = s.k.AcceptOffer(s.ctx, &marketplace.MsgAcceptOffer{
+		Holder:   other.String(),
+		OfferId:  openRes.OfferId,
+		Quantity: "1",
+	})
+	assert.ErrorIs(t, err, ecocredit.ErrInsufficientCredits)
+}
@@ -0,0 +1,208 @@
+package marketplace
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	ecoApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// Buy creates new buy orders for credits. The bid amount for the total quantity of each buy order and
+// the taker fee for the bid amount are escrowed in the ecocredit module account and buy orders are filled with matching sell orders at the
+// end of each block.
+func (k Keeper) Buy(ctx context.Context, req *marketplace.MsgBuy) (*marketplace.MsgBuyResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	buyerAcc, err := sdk.AccAddressFromBech32(req.Buyer)
+	if err != nil {
+		return nil, err
+	}
+
+	buyOrderIds := make([]uint64, len(req.Orders))
+
+	for i, order := range req.Orders {
+		// orderIndex is used for more granular error messages when
+		// an individual order in a list of orders fails to process
+		orderIndex := fmt.Sprintf("orders[%d]", i)
+
+		creditType, err := k.getFilterCreditType(ctx, orderIndex, order.Filter)
+		if err != nil {
+			return nil, err
+		}
+
+		allowed, err := k.isDenomAllowed(ctx, creditType.Abbreviation, order.BidPrice.Denom)
+		if err != nil {
+			return nil, err
+		}
+		if !allowed {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"%s: %s is not allowed to be used in buy orders", orderIndex, order.BidPrice.Denom,
+			)
+		}
+
+		marketId, err := k.getOrCreateMarketId(ctx, creditType.Abbreviation, order.BidPrice.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		// verify expiration is in the future
+		if order.Expiration != nil && !order.Expiration.UTC().After(sdkCtx.BlockTime()) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"%s: expiration must be in the future: %s", orderIndex, order.Expiration,
+			)
+		}
+
+		// check decimal places does not exceed credit type precision
+		buyQty, err := math.NewPositiveFixedDecFromString(order.Quantity, creditType.Precision)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"%s: decimal places exceeds precision: quantity: %s, credit type precision: %d",
+				orderIndex, order.Quantity, creditType.Precision,
+			)
+		}
+
+		// escrow the total cost of the buy order and the taker fee in the ecocredit module account
+		market, err := k.stateStore.MarketTable().Get(ctx, marketId)
+		if err != nil {
+			return nil, err
+		}
+		_, takerBps, err := k.getMarketFees(ctx, market)
+		if err != nil {
+			return nil, err
+		}
+		cost, err := getTotalCost(order.BidPrice.Amount, buyQty)
+		if err != nil {
+			return nil, err
+		}
+		coinCost := sdk.Coin{Amount: getEscrowAmount(cost, takerBps), Denom: order.BidPrice.Denom}
+		if err = k.bankKeeper.SendCoinsFromAccountToModule(sdkCtx, buyerAcc, ecocredit.ModuleName, sdk.NewCoins(coinCost)); err != nil {
+			return nil, sdkerrors.ErrInsufficientFunds.Wrapf(
+				"%s: quantity: %s, bid price: %v, total price: %v: %s",
+				orderIndex, order.Quantity, order.BidPrice, coinCost, err.Error(),
+			)
+		}
+
+		filter := &api.BuyOrder_Filter{}
+		if err = ormutil.GogoToPulsarSlow(order.Filter, filter); err != nil {
+			return nil, err
+		}
+
+		var expiration *timestamppb.Timestamp
+		if order.Expiration != nil {
+			expiration = timestamppb.New(*order.Expiration)
+		}
+
+		buyOrder := &api.BuyOrder{
+			Buyer:                  buyerAcc,
+			Filter:                 filter,
+			Quantity:               order.Quantity,
+			MarketId:               marketId,
+			BidAmount:              order.BidPrice.Amount.String(),
+			DisableAutoRetire:      order.DisableAutoRetire,
+			RetirementJurisdiction: order.RetirementJurisdiction,
+			Expiration:             expiration,
+			TakerFeeBps:            takerBps,
+		}
+
+		id, err := k.stateStore.BuyOrderTable().InsertReturningID(ctx, buyOrder)
+		if err != nil {
+			return nil, err
+		}
+		buyOrder.Id = id
+
+		if err = k.orderBook.OnInsertBuyOrder(ctx, buyOrder); err != nil {
+			return nil, err
+		}
+
+		buyOrderIds[i] = id
+
+		if err = sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventBuy{
+			BuyOrderId: id,
+		}); err != nil {
+			return nil, err
+		}
+
+		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/core/MsgBuy order iteration")
+	}
+
+	return &marketplace.MsgBuyResponse{BuyOrderIds: buyOrderIds}, nil
+}
+
+// getFilterCreditType returns the credit type of the credits selected by the filter. All criteria within
+// the filter must select credits of the same credit type.
+func (k Keeper) getFilterCreditType(ctx context.Context, orderIndex string, filter *marketplace.BuyOrder_Filter) (*ecoApi.CreditType, error) {
+	var creditType *ecoApi.CreditType
+
+	for j, criteria := range filter.GetOr() {
+		var abbrev string
+
+		switch {
+		case criteria.GetClassSelector() != nil:
+			classId := criteria.GetClassSelector().ClassId
+			class, err := k.coreStore.ClassTable().GetById(ctx, classId)
+			if err != nil {
+				return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+					"%s: filter.or[%d]: class with id %s: %s", orderIndex, j, classId, err.Error(),
+				)
+			}
+			abbrev = class.CreditTypeAbbrev
+		case criteria.GetProjectSelector() != nil:
+			projectId := criteria.GetProjectSelector().ProjectId
+			project, err := k.coreStore.ProjectTable().GetById(ctx, projectId)
+			if err != nil {
+				return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+					"%s: filter.or[%d]: project with id %s: %s", orderIndex, j, projectId, err.Error(),
+				)
+			}
+			class, err := k.coreStore.ClassTable().Get(ctx, project.ClassKey)
+			if err != nil {
+				return nil, err
+			}
+			abbrev = class.CreditTypeAbbrev
+		case criteria.GetBatchSelector() != nil:
+			batchDenom := criteria.GetBatchSelector().BatchDenom
+			if _, err := k.coreStore.BatchTable().GetByDenom(ctx, batchDenom); err != nil {
+				return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+					"%s: filter.or[%d]: batch denom %s: %s", orderIndex, j, batchDenom, err.Error(),
+				)
+			}
+			ct, err := utils.GetCreditTypeFromBatchDenom(ctx, k.coreStore, batchDenom)
+			if err != nil {
+				return nil, err
+			}
+			abbrev = ct.Abbreviation
+		default:
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s: filter.or[%d]: selector cannot be empty", orderIndex, j)
+		}
+
+		if creditType == nil {
+			ct, err := k.coreStore.CreditTypeTable().Get(ctx, abbrev)
+			if err != nil {
+				return nil, err
+			}
+			creditType = ct
+		} else if creditType.Abbreviation != abbrev {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"%s: filter.or[%d]: credit type %s does not match credit type %s",
+				orderIndex, j, abbrev, creditType.Abbreviation,
+			)
+		}
+	}
+
+	if creditType == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s: filter must include at least one criteria", orderIndex)
+	}
+
+	return creditType, nil
+}
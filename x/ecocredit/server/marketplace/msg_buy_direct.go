@@ -21,6 +21,9 @@ func (k Keeper) BuyDirect(ctx context.Context, req *marketplace.MsgBuyDirect) (*
 		return nil, err
 	}
 
+	// totalCost is the total cost of all orders including taker fees
+	totalCost := sdk.NewCoins()
+
 	for i, order := range req.Orders {
 		// orderIndex is used for more granular error messages when
 		// an individual order in a list of orders fails to process
@@ -31,6 +34,11 @@ func (k Keeper) BuyDirect(ctx context.Context, req *marketplace.MsgBuyDirect) (*
 			return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s: sell order with id %d: %s", orderIndex, order.SellOrderId, err.Error())
 		}
 
+		// check if the sell order has expired but has not yet been pruned
+		if utils.IsSellOrderExpired(sellOrder, sdkCtx.BlockTime()) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s: sell order with id %d has expired", orderIndex, sellOrder.Id)
+		}
+
 		// check if buyer account is equal to seller account
 		if buyerAcc.Equals(sdk.AccAddress(sellOrder.Seller)) {
 			return nil, sdkerrors.ErrUnauthorized.Wrapf(
@@ -38,6 +46,13 @@ func (k Keeper) BuyDirect(ctx context.Context, req *marketplace.MsgBuyDirect) (*
 			)
 		}
 
+		// check if buyer account is allowed to buy from a private sell order
+		if !utils.IsBuyerAllowed(sellOrder, buyerAcc) {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf(
+				"%s: buyer account is not allowed to buy from sell order with id %d", orderIndex, sellOrder.Id,
+			)
+		}
+
 		// check if disable auto-retire is required
 		if order.DisableAutoRetire && !sellOrder.DisableAutoRetire {
 			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
@@ -50,6 +65,9 @@ func (k Keeper) BuyDirect(ctx context.Context, req *marketplace.MsgBuyDirect) (*
 		if err != nil {
 			return nil, err
 		}
+		if err = utils.AssertBatchNotFrozen(batch); err != nil {
+			return nil, sdkerrors.Wrapf(err, "%s", orderIndex)
+		}
 		ct, err := utils.GetCreditTypeFromBatchDenom(ctx, k.coreStore, batch.Denom)
 		if err != nil {
 			return nil, err
@@ -62,11 +80,19 @@ func (k Keeper) BuyDirect(ctx context.Context, req *marketplace.MsgBuyDirect) (*
 			)
 		}
 
+		// check that the quantity satisfies the min fill quantity and all-or-none requirement
+		if err = assertSellOrderFill(sellOrder, creditOrderQty); err != nil {
+			return nil, sdkerrors.Wrapf(err, "%s", orderIndex)
+		}
+
 		// check that bid price and ask price denoms match
 		market, err := k.stateStore.MarketTable().Get(ctx, sellOrder.MarketId)
 		if err != nil {
 			return nil, sdkerrors.ErrInvalidRequest.Wrapf("market id %d: %s", sellOrder.MarketId, err.Error())
 		}
+		if err = assertMarketListed(market); err != nil {
+			return nil, sdkerrors.Wrapf(err, "%s", orderIndex)
+		}
 		if order.BidPrice.Denom != market.BankDenom {
 			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
 				"%s: bid price denom: %s, ask price denom: %s",
@@ -87,35 +113,69 @@ func (k Keeper) BuyDirect(ctx context.Context, req *marketplace.MsgBuyDirect) (*
 			)
 		}
 
-		// check address has the total cost (price per * order quantity)
+		// check address has the total cost (price per * order quantity) and the taker fee
 		bal := k.bankKeeper.GetBalance(sdkCtx, buyerAcc, order.BidPrice.Denom)
 		cost, err := getTotalCost(sellOrderAskAmount, creditOrderQty)
 		if err != nil {
 			return nil, err
 		}
 		coinCost := sdk.Coin{Amount: cost, Denom: market.BankDenom}
-		if bal.IsLT(coinCost) {
+		makerBps, takerBps, err := k.getMarketFees(ctx, market)
+		if err != nil {
+			return nil, err
+		}
+		makerFee, takerFee := getFee(cost, makerBps), getFee(cost, takerBps)
+		coinTotal := sdk.Coin{Amount: cost.Add(takerFee), Denom: market.BankDenom}
+		if bal.IsLT(coinTotal) {
 			return nil, sdkerrors.ErrInsufficientFunds.Wrapf(
 				"%s: quantity: %s, ask price: %s%s, total price: %v, bank balance: %v",
-				orderIndex, order.Quantity, sellOrder.AskAmount, market.BankDenom, coinCost, bal,
+				orderIndex, order.Quantity, sellOrder.AskAmount, market.BankDenom, coinTotal, bal,
 			)
 		}
 
+		totalCost = totalCost.Add(coinTotal)
+
+		var beneficiary sdk.AccAddress
+		if !order.DisableAutoRetire && len(order.BeneficiaryAddress) != 0 {
+			beneficiary, err = sdk.AccAddressFromBech32(order.BeneficiaryAddress)
+			if err != nil {
+				return nil, sdkerrors.ErrInvalidAddress.Wrapf("%s: beneficiary address: %s", orderIndex, err)
+			}
+		}
+
 		// fill the order, updating balances and the sell order in state
-		if err = k.fillOrder(ctx, orderIndex, sellOrder, buyerAcc, creditOrderQty, coinCost, orderOptions{
-			autoRetire:   !order.DisableAutoRetire,
-			batchDenom:   batch.Denom,
-			jurisdiction: order.RetirementJurisdiction,
+		if err = k.fillOrder(ctx, orderIndex, sellOrder, buyerAcc, creditOrderQty, coinCost, makerFee, takerFee, market, orderOptions{
+			autoRetire:      !order.DisableAutoRetire,
+			batchDenom:      batch.Denom,
+			jurisdiction:    order.RetirementJurisdiction,
+			beneficiary:     beneficiary,
+			beneficiaryName: order.BeneficiaryName,
+			reason:          order.Reason,
 		}); err != nil {
 			return nil, err
 		}
 
+		tradeId, err := k.recordTrade(ctx, newSellOrderTrade(sellOrder, 0, buyerAcc, creditOrderQty))
+		if err != nil {
+			return nil, err
+		}
+
 		if err = sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventBuyDirect{
 			SellOrderId: sellOrder.Id,
+			MakerFee:    newFeeCoin(market.BankDenom, makerFee),
+			TakerFee:    newFeeCoin(market.BankDenom, takerFee),
+			TradeId:     tradeId,
 		}); err != nil {
 			return nil, err
 		}
 	}
 
+	// all orders fail if the total cost exceeds the max total cost
+	if len(req.MaxTotalCost) != 0 && !totalCost.IsAllLTE(req.MaxTotalCost) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"total cost: %v, max total cost: %v, total cost exceeds max total cost", totalCost, req.MaxTotalCost,
+		)
+	}
+
 	return &marketplace.MsgBuyDirectResponse{}, nil
 }
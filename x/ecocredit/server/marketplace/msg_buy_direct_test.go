@@ -22,11 +22,16 @@ type buyDirectSuite struct {
 	aliceBankBalance  sdk.Coin
 	bob               sdk.AccAddress
 	bobBankBalance    sdk.Coin
+	charlie           sdk.AccAddress
 	creditTypeAbbrev  string
 	classId           string
 	batchDenom        string
 	sellOrderId       uint64
 	disableAutoRetire bool
+	allowedBuyers     [][]byte
+	buyerGroupPolicy  sdk.AccAddress
+	minFillQuantity   string
+	allOrNone         bool
 	quantity          string
 	askPrice          sdk.Coin
 	bidPrice          sdk.Coin
@@ -39,9 +44,10 @@ func TestBuyDirect(t *testing.T) {
 }
 
 func (s *buyDirectSuite) Before(t gocuke.TestingT) {
-	s.baseSuite = setupBase(t, 2)
+	s.baseSuite = setupBase(t, 3)
 	s.alice = s.addrs[0]
 	s.bob = s.addrs[1]
+	s.charlie = s.addrs[2]
 	s.aliceBankBalance = sdk.Coin{
 		Denom:  "regen",
 		Amount: sdk.NewInt(100),
@@ -193,6 +199,18 @@ func (s *buyDirectSuite) AliceCreatedASellOrderWithDisableAutoRetire(a string) {
 	s.createSellOrders(1)
 }
 
+func (s *buyDirectSuite) AliceCreatedASellOrderWithAllowedBuyer(a string) {
+	s.allowedBuyers = [][]byte{s.getAccount(a)}
+
+	s.createSellOrders(1)
+}
+
+func (s *buyDirectSuite) AliceCreatedASellOrderWithBuyerGroupPolicy(a string) {
+	s.buyerGroupPolicy = s.getAccount(a)
+
+	s.createSellOrders(1)
+}
+
 func (s *buyDirectSuite) AliceCreatedASellOrderWithQuantityAndAskAmount(a string, b string) {
 	askAmount, ok := sdk.NewIntFromString(b)
 	require.True(s.t, ok)
@@ -223,6 +241,23 @@ func (s *buyDirectSuite) AliceCreatedASellOrderWithQuantityAndDisableAutoRetire(
 	s.createSellOrders(1)
 }
 
+func (s *buyDirectSuite) AliceCreatedASellOrderWithQuantityAndMinFillQuantity(a string, b string) {
+	s.quantity = a
+	s.minFillQuantity = b
+
+	s.createSellOrders(1)
+}
+
+func (s *buyDirectSuite) AliceCreatedASellOrderWithQuantityAndAllOrNone(a string, b string) {
+	allOrNone, err := strconv.ParseBool(b)
+	require.NoError(s.t, err)
+
+	s.quantity = a
+	s.allOrNone = allOrNone
+
+	s.createSellOrders(1)
+}
+
 func (s *buyDirectSuite) AliceCreatedTwoSellOrdersEachWithQuantityAndAskAmount(a string, b string) {
 	askAmount, ok := sdk.NewIntFromString(b)
 	require.True(s.t, ok)
@@ -381,6 +416,45 @@ func (s *buyDirectSuite) BobAttemptsToBuyCreditsWithQuantityAndDisableAutoRetire
 	})
 }
 
+func (s *buyDirectSuite) BobAttemptsToBuyCreditsWithQuantityAndMaxTotalCost(a string, b string) {
+	maxTotalCost, err := sdk.ParseCoinsNormalized(b)
+	require.NoError(s.t, err)
+
+	s.quantity = a
+
+	s.singleBuyOrderExpectCalls()
+
+	s.res, s.err = s.k.BuyDirect(s.ctx, &marketplace.MsgBuyDirect{
+		Buyer: s.bob.String(),
+		Orders: []*marketplace.MsgBuyDirect_Order{
+			{
+				SellOrderId: s.sellOrderId,
+				Quantity:    a,
+				BidPrice:    &s.bidPrice,
+			},
+		},
+		MaxTotalCost: maxTotalCost,
+	})
+}
+
+func (s *buyDirectSuite) BobAttemptsToBuyCreditsWithQuantityOnBehalfOfCharlie(a string) {
+	s.singleBuyOrderExpectCalls()
+
+	s.res, s.err = s.k.BuyDirect(s.ctx, &marketplace.MsgBuyDirect{
+		Buyer: s.bob.String(),
+		Orders: []*marketplace.MsgBuyDirect_Order{
+			{
+				SellOrderId:        s.sellOrderId,
+				Quantity:           a,
+				BidPrice:           &s.bidPrice,
+				BeneficiaryAddress: s.charlie.String(),
+				BeneficiaryName:    "Charlie",
+				Reason:             "offsetting emissions from business travel",
+			},
+		},
+	})
+}
+
 func (s *buyDirectSuite) BobAttemptsToBuyCreditsInTwoOrdersEachWithQuantityAndBidAmount(a string, b string) {
 	s.quantity = a
 
@@ -493,6 +567,22 @@ func (s *buyDirectSuite) ExpectBobBatchBalance(a gocuke.DocString) {
 	require.Equal(s.t, expected.EscrowedAmount, balance.EscrowedAmount)
 }
 
+func (s *buyDirectSuite) ExpectCharlieBatchBalance(a gocuke.DocString) {
+	expected := &coreapi.BatchBalance{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	batch, err := s.coreStore.BatchTable().GetByDenom(s.ctx, s.batchDenom)
+	require.NoError(s.t, err)
+
+	balance, err := s.coreStore.BatchBalanceTable().Get(s.ctx, s.charlie, batch.Key)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.RetiredAmount, balance.RetiredAmount)
+	require.Equal(s.t, expected.TradableAmount, balance.TradableAmount)
+	require.Equal(s.t, expected.EscrowedAmount, balance.EscrowedAmount)
+}
+
 func (s *buyDirectSuite) ExpectBatchSupply(a gocuke.DocString) {
 	expected := &coreapi.BatchSupply{}
 	err := jsonpb.UnmarshalString(a.Content, expected)
@@ -558,6 +648,10 @@ func (s *buyDirectSuite) createSellOrders(count int) {
 		MarketId:          marketKey,
 		AskAmount:         s.askPrice.Amount.String(),
 		DisableAutoRetire: s.disableAutoRetire,
+		AllowedBuyers:     s.allowedBuyers,
+		BuyerGroupPolicy:  s.buyerGroupPolicy,
+		MinFillQuantity:   s.minFillQuantity,
+		AllOrNone:         s.allOrNone,
 	}
 
 	sellOrderId, err := s.marketStore.SellOrderTable().InsertReturningID(s.ctx, order)
@@ -571,6 +665,20 @@ func (s *buyDirectSuite) createSellOrders(count int) {
 	}
 }
 
+func (s *buyDirectSuite) getAccount(name string) sdk.AccAddress {
+	switch name {
+	case "alice":
+		return s.alice
+	case "bob":
+		return s.bob
+	case "charlie":
+		return s.charlie
+	default:
+		s.t.Fatalf("unknown account %s", name)
+		return nil
+	}
+}
+
 func (s *buyDirectSuite) singleBuyOrderExpectCalls() {
 	askTotal := s.calculateAskTotal(s.quantity, s.askPrice.Amount.String())
 	sendCoin := sdk.NewCoin(s.askPrice.Denom, askTotal)
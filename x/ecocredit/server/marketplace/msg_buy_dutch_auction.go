@@ -0,0 +1,192 @@
+package marketplace
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// BuyDutchAuction purchases credits from a dutch auction at the ask price of the auction at the current
+// block time. The buyer pays the seller directly and the auction is removed once all credits are sold.
+func (k Keeper) BuyDutchAuction(ctx context.Context, req *marketplace.MsgBuyDutchAuction) (*marketplace.MsgBuyDutchAuctionResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	buyerAcc, err := sdk.AccAddressFromBech32(req.Buyer)
+	if err != nil {
+		return nil, err
+	}
+
+	auction, err := k.stateStore.DutchAuctionTable().Get(ctx, req.AuctionId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("dutch auction with id %d: %s", req.AuctionId, err.Error())
+	}
+
+	sellerAcc := sdk.AccAddress(auction.Seller)
+	if buyerAcc.Equals(sellerAcc) {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("buyer account cannot be the same as seller account")
+	}
+
+	if req.DisableAutoRetire && !auction.DisableAutoRetire {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("cannot disable auto-retire for a dutch auction with auto-retire enabled")
+	}
+
+	batch, err := k.coreStore.BatchTable().Get(ctx, auction.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+	if err = utils.AssertBatchNotFrozen(batch); err != nil {
+		return nil, err
+	}
+	creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.coreStore, batch.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	// check decimal places does not exceed credit type precision
+	buyQty, err := math.NewPositiveFixedDecFromString(req.Quantity, creditType.Precision)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"decimal places exceeds precision: quantity: %s, credit type precision: %d",
+			req.Quantity, creditType.Precision,
+		)
+	}
+
+	auctionQty, err := math.NewDecFromString(auction.Quantity)
+	if err != nil {
+		return nil, err
+	}
+	if auctionQty.Cmp(buyQty) == math.LessThan {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"requested quantity: %v, dutch auction quantity %s", buyQty, auction.Quantity,
+		)
+	}
+
+	market, err := k.stateStore.MarketTable().Get(ctx, auction.MarketId)
+	if err != nil {
+		return nil, err
+	}
+	if err = assertMarketListed(market); err != nil {
+		return nil, err
+	}
+	if req.MaxPrice.Denom != market.BankDenom {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"max price denom: %s, ask price denom: %s", req.MaxPrice.Denom, market.BankDenom,
+		)
+	}
+
+	askAmount, err := getDutchAuctionPrice(auction, sdkCtx.BlockTime())
+	if err != nil {
+		return nil, err
+	}
+	price := sdk.NewCoin(market.BankDenom, askAmount)
+	if askAmount.GT(req.MaxPrice.Amount) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"ask price: %v, max price: %v, insufficient max price", price, req.MaxPrice,
+		)
+	}
+
+	// check address has the total cost (price per * order quantity) and the taker fee
+	cost, err := getTotalCost(askAmount, buyQty)
+	if err != nil {
+		return nil, err
+	}
+	makerBps, takerBps, err := k.getMarketFees(ctx, market)
+	if err != nil {
+		return nil, err
+	}
+	makerFee, takerFee := getFee(cost, makerBps), getFee(cost, takerBps)
+	coinTotal := sdk.NewCoin(market.BankDenom, cost.Add(takerFee))
+	bal := k.bankKeeper.GetBalance(sdkCtx, buyerAcc, market.BankDenom)
+	if bal.IsLT(coinTotal) {
+		return nil, sdkerrors.ErrInsufficientFunds.Wrapf(
+			"quantity: %s, ask price: %v, total price: %v, bank balance: %v",
+			req.Quantity, price, coinTotal, bal,
+		)
+	}
+
+	if err = k.transferEscrowedCredits(ctx, sellerAcc, buyerAcc, auction.BatchKey, buyQty, orderOptions{
+		autoRetire:   !req.DisableAutoRetire,
+		batchDenom:   batch.Denom,
+		jurisdiction: req.RetirementJurisdiction,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err = k.payForCredits(ctx, buyerAcc, sellerAcc, sdk.NewCoin(market.BankDenom, cost), makerFee, takerFee, market); err != nil {
+		return nil, err
+	}
+
+	remainingQty, err := auctionQty.Sub(buyQty)
+	if err != nil {
+		return nil, err
+	}
+	if remainingQty.IsZero() {
+		err = k.stateStore.DutchAuctionTable().Delete(ctx, auction)
+	} else {
+		auction.Quantity = remainingQty.String()
+		err = k.stateStore.DutchAuctionTable().Update(ctx, auction)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tradeId, err := k.recordTrade(ctx, &api.Trade{
+		Buyer:          buyerAcc,
+		Seller:         sellerAcc,
+		BatchKey:       auction.BatchKey,
+		MarketId:       auction.MarketId,
+		DutchAuctionId: auction.Id,
+		Quantity:       buyQty.String(),
+		UnitPrice:      askAmount.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventBuyDutchAuction{
+		AuctionId: auction.Id,
+		Quantity:  buyQty.String(),
+		Price:     &price,
+		MakerFee:  newFeeCoin(market.BankDenom, makerFee),
+		TakerFee:  newFeeCoin(market.BankDenom, takerFee),
+		TradeId:   tradeId,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &marketplace.MsgBuyDutchAuctionResponse{}, nil
+}
+
+// getDutchAuctionPrice returns the ask price per credit of the dutch auction at the given time. The ask
+// price decays linearly from the start amount to the floor amount between the start time and the end time.
+// The decay is truncated so the ask price never falls below the linear price.
+func getDutchAuctionPrice(auction *api.DutchAuction, blockTime time.Time) (sdk.Int, error) {
+	startAmount, ok := sdk.NewIntFromString(auction.StartAmount)
+	if !ok {
+		return sdk.Int{}, sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", auction.StartAmount, sdk.Int{})
+	}
+	floorAmount, ok := sdk.NewIntFromString(auction.FloorAmount)
+	if !ok {
+		return sdk.Int{}, sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", auction.FloorAmount, sdk.Int{})
+	}
+
+	startTime, endTime := auction.StartTime.AsTime(), auction.EndTime.AsTime()
+	switch {
+	case !blockTime.Before(endTime):
+		return floorAmount, nil
+	case !blockTime.After(startTime):
+		return startAmount, nil
+	}
+
+	elapsed, duration := blockTime.Sub(startTime), endTime.Sub(startTime)
+	decay := startAmount.Sub(floorAmount).MulRaw(int64(elapsed)).QuoRaw(int64(duration))
+
+	return startAmount.Sub(decay), nil
+}
@@ -0,0 +1,215 @@
+package marketplace
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func TestGetDutchAuctionPrice(t *testing.T) {
+	t.Parallel()
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	auction := &api.DutchAuction{
+		StartAmount: "100",
+		FloorAmount: "40",
+		StartTime:   timestamppb.New(startTime),
+		EndTime:     timestamppb.New(startTime.Add(time.Hour * 10)),
+	}
+
+	testCases := []struct {
+		name      string
+		blockTime time.Time
+		expected  int64
+	}{
+		{"before start", startTime.Add(-time.Hour), 100},
+		{"at start", startTime, 100},
+		{"halfway", startTime.Add(time.Hour * 5), 70},
+		{"truncated", startTime.Add(time.Minute), 100},
+		{"at end", startTime.Add(time.Hour * 10), 40},
+		{"after end", startTime.Add(time.Hour * 20), 40},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			price, err := getDutchAuctionPrice(auction, tc.blockTime)
+			assert.NilError(t, err)
+			assert.Equal(t, tc.expected, price.Int64())
+		})
+	}
+}
+
+func TestBuyDutchAuction(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, buyer := s.addrs[0], s.addrs[1]
+	s.setCreditTypeFees(100, 200)
+	assert.NilError(t, s.marketStore.FeeDestinationTable().Save(s.ctx, &api.FeeDestination{
+		Destination: api.FeeDestinationType_FEE_DESTINATION_TYPE_BURN,
+	}))
+
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.sdkCtx = s.sdkCtx.WithBlockTime(startTime)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+
+	startPrice, floorPrice := sdk.NewInt64Coin(ask.Denom, 100), sdk.NewInt64Coin(ask.Denom, 40)
+	res, err := s.k.CreateDutchAuction(s.ctx, &marketplace.MsgCreateDutchAuction{
+		Seller:     seller.String(),
+		BatchDenom: batchDenom,
+		Quantity:   "50",
+		StartPrice: &startPrice,
+		FloorPrice: &floorPrice,
+		Duration:   time.Hour * 10,
+	})
+	assert.NilError(t, err)
+
+	sellerBal, err := s.coreStore.BatchBalanceTable().Get(s.ctx, seller, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "50", sellerBal.TradableAmount)
+	assert.Equal(t, "50", sellerBal.EscrowedAmount)
+
+	// halfway through the auction the ask price is 70, the buyer pays 700 for 10 credits plus
+	// the taker fee (14) and the seller receives 700 minus the maker fee (7)
+	s.sdkCtx = s.sdkCtx.WithBlockTime(startTime.Add(time.Hour * 5))
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+	s.bankKeeper.EXPECT().GetBalance(gmAny, buyer, ask.Denom).Return(sdk.NewInt64Coin(ask.Denom, 1000)).Times(1)
+	s.bankKeeper.EXPECT().SendCoins(gmAny, buyer, seller, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 693))).Return(nil).Times(1)
+	s.expectEscrow(buyer, 21)
+	s.bankKeeper.EXPECT().BurnCoins(gmAny, ecocredit.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 21))).Return(nil).Times(1)
+
+	maxPrice := sdk.NewInt64Coin(ask.Denom, 80)
+	_, err = s.k.BuyDutchAuction(s.ctx, &marketplace.MsgBuyDutchAuction{
+		Buyer:                  buyer.String(),
+		AuctionId:              res.AuctionId,
+		Quantity:               "10",
+		MaxPrice:               &maxPrice,
+		RetirementJurisdiction: "US-WA",
+	})
+	assert.NilError(t, err)
+
+	auction, err := s.marketStore.DutchAuctionTable().Get(s.ctx, res.AuctionId)
+	assert.NilError(t, err)
+	assert.Equal(t, "40", auction.Quantity)
+
+	sellerBal, err = s.coreStore.BatchBalanceTable().Get(s.ctx, seller, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "40", sellerBal.EscrowedAmount)
+
+	buyerBal, err := s.coreStore.BatchBalanceTable().Get(s.ctx, buyer, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "10", buyerBal.RetiredAmount)
+
+	trade, err := s.marketStore.TradeTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, res.AuctionId, trade.DutchAuctionId)
+	assert.Equal(t, "70", trade.UnitPrice)
+
+	// after the end time the auction remains open at the floor price and buying the remaining
+	// quantity removes the auction
+	s.sdkCtx = s.sdkCtx.WithBlockTime(startTime.Add(time.Hour * 20))
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+	s.bankKeeper.EXPECT().GetBalance(gmAny, buyer, ask.Denom).Return(sdk.NewInt64Coin(ask.Denom, 2000)).Times(1)
+	s.bankKeeper.EXPECT().SendCoins(gmAny, buyer, seller, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 1584))).Return(nil).Times(1)
+	s.expectEscrow(buyer, 48)
+	s.bankKeeper.EXPECT().BurnCoins(gmAny, ecocredit.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 48))).Return(nil).Times(1)
+
+	_, err = s.k.BuyDutchAuction(s.ctx, &marketplace.MsgBuyDutchAuction{
+		Buyer:                  buyer.String(),
+		AuctionId:              res.AuctionId,
+		Quantity:               "40",
+		MaxPrice:               &maxPrice,
+		RetirementJurisdiction: "US-WA",
+	})
+	assert.NilError(t, err)
+
+	_, err = s.marketStore.DutchAuctionTable().Get(s.ctx, res.AuctionId)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	sellerBal, err = s.coreStore.BatchBalanceTable().Get(s.ctx, seller, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "0", sellerBal.EscrowedAmount)
+}
+
+func TestBuyDutchAuction_Invalid(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, buyer := s.addrs[0], s.addrs[1]
+
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.sdkCtx = s.sdkCtx.WithBlockTime(startTime)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+
+	startPrice, floorPrice := sdk.NewInt64Coin(ask.Denom, 100), sdk.NewInt64Coin(ask.Denom, 40)
+	res, err := s.k.CreateDutchAuction(s.ctx, &marketplace.MsgCreateDutchAuction{
+		Seller:     seller.String(),
+		BatchDenom: batchDenom,
+		Quantity:   "50",
+		StartPrice: &startPrice,
+		FloorPrice: &floorPrice,
+		Duration:   time.Hour * 10,
+	})
+	assert.NilError(t, err)
+
+	maxPrice := sdk.NewInt64Coin(ask.Denom, 80)
+	msg := func() *marketplace.MsgBuyDutchAuction {
+		return &marketplace.MsgBuyDutchAuction{
+			Buyer:                  buyer.String(),
+			AuctionId:              res.AuctionId,
+			Quantity:               "10",
+			MaxPrice:               &maxPrice,
+			RetirementJurisdiction: "US-WA",
+		}
+	}
+
+	// auction not found
+	req := msg()
+	req.AuctionId = res.AuctionId + 1
+	_, err = s.k.BuyDutchAuction(s.ctx, req)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	// seller cannot buy from their own auction
+	req = msg()
+	req.Buyer = seller.String()
+	_, err = s.k.BuyDutchAuction(s.ctx, req)
+	assert.ErrorContains(t, err, "buyer account cannot be the same as seller account")
+
+	// auto-retire cannot be disabled
+	req = msg()
+	req.DisableAutoRetire = true
+	_, err = s.k.BuyDutchAuction(s.ctx, req)
+	assert.ErrorContains(t, err, "cannot disable auto-retire")
+
+	// quantity exceeds auction quantity
+	req = msg()
+	req.Quantity = "51"
+	_, err = s.k.BuyDutchAuction(s.ctx, req)
+	assert.ErrorContains(t, err, "requested quantity: 51, dutch auction quantity 50")
+
+	// max price denom does not match
+	req = msg()
+	req.MaxPrice = &sdk.Coin{Denom: "uatom", Amount: sdk.NewInt(80)}
+	_, err = s.k.BuyDutchAuction(s.ctx, req)
+	assert.ErrorContains(t, err, "max price denom: uatom")
+
+	// max price is below the current ask price
+	_, err = s.k.BuyDutchAuction(s.ctx, msg())
+	assert.ErrorContains(t, err, "insufficient max price")
+
+	// insufficient bank balance
+	s.sdkCtx = s.sdkCtx.WithBlockTime(startTime.Add(time.Hour * 10))
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+	s.bankKeeper.EXPECT().GetBalance(gmAny, buyer, ask.Denom).Return(sdk.NewInt64Coin(ask.Denom, 399)).Times(1)
+	_, err = s.k.BuyDutchAuction(s.ctx, msg())
+	assert.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+}
@@ -0,0 +1,141 @@
+package marketplace
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// BuyForwardContract buys future credits from a forward sale at the ask price of the forward sale. The
+// total cost of the credits and the taker fee are escrowed in the ecocredit module account until the
+// credits are delivered or the delivery deadline of the forward sale is reached.
+func (k Keeper) BuyForwardContract(ctx context.Context, req *marketplace.MsgBuyForwardContract) (*marketplace.MsgBuyForwardContractResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	buyerAcc, err := sdk.AccAddressFromBech32(req.Buyer)
+	if err != nil {
+		return nil, err
+	}
+
+	sale, err := k.stateStore.ForwardSaleTable().Get(ctx, req.ForwardSaleId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("forward sale with id %d: %s", req.ForwardSaleId, err.Error())
+	}
+
+	if buyerAcc.Equals(sdk.AccAddress(sale.Seller)) {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("buyer account cannot be the same as seller account")
+	}
+
+	if req.DisableAutoRetire && !sale.DisableAutoRetire {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("cannot disable auto-retire for a forward sale with auto-retire enabled")
+	}
+
+	project, err := k.coreStore.ProjectTable().Get(ctx, sale.ProjectKey)
+	if err != nil {
+		return nil, err
+	}
+	creditType, err := k.getProjectCreditType(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+
+	// check decimal places does not exceed credit type precision
+	buyQty, err := math.NewPositiveFixedDecFromString(req.Quantity, creditType.Precision)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"decimal places exceeds precision: quantity: %s, credit type precision: %d",
+			req.Quantity, creditType.Precision,
+		)
+	}
+
+	saleQty, err := math.NewDecFromString(sale.Quantity)
+	if err != nil {
+		return nil, err
+	}
+	if saleQty.Cmp(buyQty) == math.LessThan {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"requested quantity: %v, forward sale quantity %s", buyQty, sale.Quantity,
+		)
+	}
+
+	market, err := k.stateStore.MarketTable().Get(ctx, sale.MarketId)
+	if err != nil {
+		return nil, err
+	}
+	if err = assertMarketListed(market); err != nil {
+		return nil, err
+	}
+	if req.MaxPrice.Denom != market.BankDenom {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"max price denom: %s, ask price denom: %s", req.MaxPrice.Denom, market.BankDenom,
+		)
+	}
+
+	askAmount, ok := sdk.NewIntFromString(sale.AskAmount)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", sale.AskAmount, sdk.Int{})
+	}
+	if askAmount.GT(req.MaxPrice.Amount) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"ask price: %v, max price: %v, insufficient max price",
+			sdk.NewCoin(market.BankDenom, askAmount), req.MaxPrice,
+		)
+	}
+
+	// escrow the total cost of the credits and the taker fee in the ecocredit module account
+	makerBps, takerBps, err := k.getMarketFees(ctx, market)
+	if err != nil {
+		return nil, err
+	}
+	cost, err := getTotalCost(askAmount, buyQty)
+	if err != nil {
+		return nil, err
+	}
+	coinCost := sdk.NewCoin(market.BankDenom, getEscrowAmount(cost, takerBps))
+	if err = k.bankKeeper.SendCoinsFromAccountToModule(sdkCtx, buyerAcc, ecocredit.ModuleName, sdk.NewCoins(coinCost)); err != nil {
+		return nil, sdkerrors.ErrInsufficientFunds.Wrapf(
+			"quantity: %s, ask price: %v, total price: %v: %s",
+			req.Quantity, sdk.NewCoin(market.BankDenom, askAmount), coinCost, err.Error(),
+		)
+	}
+
+	// the forward sale remains in state when sold out so that it can be referenced by its forward
+	// contracts until the delivery deadline is reached
+	saleQty, err = saleQty.Sub(buyQty)
+	if err != nil {
+		return nil, err
+	}
+	sale.Quantity = saleQty.String()
+	if err = k.stateStore.ForwardSaleTable().Update(ctx, sale); err != nil {
+		return nil, err
+	}
+
+	id, err := k.stateStore.ForwardContractTable().InsertReturningID(ctx, &api.ForwardContract{
+		ForwardSaleId:          sale.Id,
+		Buyer:                  buyerAcc,
+		ProjectKey:             sale.ProjectKey,
+		Quantity:               buyQty.String(),
+		EscrowAmount:           coinCost.Amount.String(),
+		DisableAutoRetire:      req.DisableAutoRetire,
+		RetirementJurisdiction: req.RetirementJurisdiction,
+		MakerFeeBps:            makerBps,
+		TakerFeeBps:            takerBps,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventBuyForwardContract{
+		ForwardContractId: id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &marketplace.MsgBuyForwardContractResponse{ForwardContractId: id}, nil
+}
@@ -0,0 +1,116 @@
+package marketplace
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func (s *baseSuite) buyForwardContract(saleId uint64, buyer sdk.AccAddress, quantity string, escrow int64) uint64 {
+	s.expectEscrow(buyer, escrow)
+	maxPrice := sdk.NewInt64Coin(ask.Denom, 10)
+	res, err := s.k.BuyForwardContract(s.ctx, &marketplace.MsgBuyForwardContract{
+		Buyer:                  buyer.String(),
+		ForwardSaleId:          saleId,
+		Quantity:               quantity,
+		MaxPrice:               &maxPrice,
+		RetirementJurisdiction: "US-WA",
+	})
+	assert.NilError(s.t, err)
+	return res.ForwardContractId
+}
+
+func TestBuyForwardContract(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	s.setCreditTypeFees(100, 200)
+	buyer := s.addrs[1]
+
+	saleRes, err := s.k.CreateForwardSale(s.ctx, s.forwardSaleSetup())
+	assert.NilError(t, err)
+
+	// the total cost (300) and the taker fee (6) are escrowed
+	id := s.buyForwardContract(saleRes.ForwardSaleId, buyer, "30", 306)
+
+	contract, err := s.marketStore.ForwardContractTable().Get(s.ctx, id)
+	assert.NilError(t, err)
+	assert.Equal(t, saleRes.ForwardSaleId, contract.ForwardSaleId)
+	assert.Equal(t, uint64(1), contract.ProjectKey)
+	assert.Equal(t, "30", contract.Quantity)
+	assert.Equal(t, "306", contract.EscrowAmount)
+	assert.Equal(t, uint32(100), contract.MakerFeeBps)
+	assert.Equal(t, uint32(200), contract.TakerFeeBps)
+
+	sale, err := s.marketStore.ForwardSaleTable().Get(s.ctx, saleRes.ForwardSaleId)
+	assert.NilError(t, err)
+	assert.Equal(t, "70", sale.Quantity)
+
+	// buying the remaining quantity keeps the forward sale in state
+	s.buyForwardContract(saleRes.ForwardSaleId, buyer, "70", 714)
+
+	sale, err = s.marketStore.ForwardSaleTable().Get(s.ctx, saleRes.ForwardSaleId)
+	assert.NilError(t, err)
+	assert.Equal(t, "0", sale.Quantity)
+}
+
+func TestBuyForwardContract_Invalid(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, buyer := s.addrs[0], s.addrs[1]
+
+	saleRes, err := s.k.CreateForwardSale(s.ctx, s.forwardSaleSetup())
+	assert.NilError(t, err)
+
+	maxPrice := sdk.NewInt64Coin(ask.Denom, 10)
+	msg := func() *marketplace.MsgBuyForwardContract {
+		return &marketplace.MsgBuyForwardContract{
+			Buyer:                  buyer.String(),
+			ForwardSaleId:          saleRes.ForwardSaleId,
+			Quantity:               "10",
+			MaxPrice:               &maxPrice,
+			RetirementJurisdiction: "US-WA",
+		}
+	}
+
+	// forward sale not found
+	req := msg()
+	req.ForwardSaleId = 2
+	_, err = s.k.BuyForwardContract(s.ctx, req)
+	assert.ErrorContains(t, err, "forward sale with id 2")
+
+	// seller cannot buy from their own forward sale
+	req = msg()
+	req.Buyer = seller.String()
+	_, err = s.k.BuyForwardContract(s.ctx, req)
+	assert.ErrorContains(t, err, "buyer account cannot be the same as seller account")
+
+	// auto-retire cannot be disabled
+	req = msg()
+	req.DisableAutoRetire = true
+	_, err = s.k.BuyForwardContract(s.ctx, req)
+	assert.ErrorContains(t, err, "cannot disable auto-retire")
+
+	// quantity exceeds forward sale quantity
+	req = msg()
+	req.Quantity = "101"
+	_, err = s.k.BuyForwardContract(s.ctx, req)
+	assert.ErrorContains(t, err, "requested quantity: 101, forward sale quantity 100")
+
+	// max price denom does not match
+	req = msg()
+	req.MaxPrice = &sdk.Coin{Denom: "uatom", Amount: sdk.NewInt(10)}
+	_, err = s.k.BuyForwardContract(s.ctx, req)
+	assert.ErrorContains(t, err, "max price denom: uatom")
+
+	// max price is below the ask price
+	req = msg()
+	req.MaxPrice = &sdk.Coin{Denom: ask.Denom, Amount: sdk.NewInt(9)}
+	_, err = s.k.BuyForwardContract(s.ctx, req)
+	assert.ErrorContains(t, err, "insufficient max price")
+}
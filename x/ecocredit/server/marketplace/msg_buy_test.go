@@ -0,0 +1,392 @@
+package marketplace
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func batchFilter(denom string) *marketplace.BuyOrder_Filter {
+	return &marketplace.BuyOrder_Filter{
+		Or: []*marketplace.BuyOrder_Filter_Criteria{
+			{Sum: &marketplace.BuyOrder_Filter_Criteria_BatchSelector{
+				BatchSelector: &marketplace.BuyOrder_BatchSelector{BatchDenom: denom},
+			}},
+		},
+	}
+}
+
+func classFilter(classId, jurisdiction string) *marketplace.BuyOrder_Filter {
+	return &marketplace.BuyOrder_Filter{
+		Or: []*marketplace.BuyOrder_Filter_Criteria{
+			{Sum: &marketplace.BuyOrder_Filter_Criteria_ClassSelector{
+				ClassSelector: &marketplace.BuyOrder_ClassSelector{ClassId: classId, ProjectJurisdiction: jurisdiction},
+			}},
+		},
+	}
+}
+
+func (s *baseSuite) expectEscrow(buyer sdk.AccAddress, amount int64) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, amount))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gmAny, buyer, ecocredit.ModuleName, coins).Return(nil).Times(1)
+}
+
+func (s *baseSuite) expectRelease(recipient sdk.AccAddress, amount int64) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, amount))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gmAny, ecocredit.ModuleName, recipient, coins).Return(nil).Times(1)
+}
+
+func TestBuy_ValidBuyOrder(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	buyer := s.addrs[1]
+
+	expiration, err := types.ParseDate("expiration", "2030-01-01")
+	assert.NilError(t, err)
+
+	s.expectEscrow(buyer, 100)
+	res, err := s.k.Buy(s.ctx, &marketplace.MsgBuy{
+		Buyer: buyer.String(),
+		Orders: []*marketplace.MsgBuy_Order{
+			{Filter: batchFilter(batchDenom), Quantity: "10", BidPrice: &ask, RetirementJurisdiction: "US-WA", Expiration: &expiration},
+		},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.BuyOrderIds))
+
+	buyOrder, err := s.marketStore.BuyOrderTable().Get(s.ctx, res.BuyOrderIds[0])
+	assert.NilError(t, err)
+	assert.Check(t, buyer.Equals(sdk.AccAddress(buyOrder.Buyer)))
+	assert.Equal(t, "10", buyOrder.Quantity)
+	assert.Equal(t, ask.Amount.String(), buyOrder.BidAmount)
+	assert.Equal(t, uint64(1), buyOrder.MarketId)
+	assert.Equal(t, "US-WA", buyOrder.RetirementJurisdiction)
+	assert.Equal(t, batchDenom, buyOrder.Filter.Or[0].GetBatchSelector().BatchDenom)
+}
+
+func TestBuy_Invalid(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	buyer := s.addrs[1]
+
+	// denom not allowed
+	invalidBid := sdk.NewInt64Coin("ufoo", 10)
+	_, err := s.k.Buy(s.ctx, &marketplace.MsgBuy{
+		Buyer: buyer.String(),
+		Orders: []*marketplace.MsgBuy_Order{
+			{Filter: batchFilter(batchDenom), Quantity: "10", BidPrice: &invalidBid, DisableAutoRetire: true},
+		},
+	})
+	assert.ErrorContains(t, err, "ufoo is not allowed to be used in buy orders")
+
+	// batch not found
+	_, err = s.k.Buy(s.ctx, &marketplace.MsgBuy{
+		Buyer: buyer.String(),
+		Orders: []*marketplace.MsgBuy_Order{
+			{Filter: batchFilter("C01-001-20200101-20200201-002"), Quantity: "10", BidPrice: &ask, DisableAutoRetire: true},
+		},
+	})
+	assert.ErrorContains(t, err, "batch denom C01-001-20200101-20200201-002")
+
+	// quantity exceeds precision
+	_, err = s.k.Buy(s.ctx, &marketplace.MsgBuy{
+		Buyer: buyer.String(),
+		Orders: []*marketplace.MsgBuy_Order{
+			{Filter: batchFilter(batchDenom), Quantity: "10.1234567", BidPrice: &ask, DisableAutoRetire: true},
+		},
+	})
+	assert.ErrorContains(t, err, "decimal places exceeds precision")
+
+	// expiration in the past
+	blockTime, err := types.ParseDate("block time", "2020-01-01")
+	assert.NilError(t, err)
+	expired, err := types.ParseDate("expiration", "2019-12-30")
+	assert.NilError(t, err)
+	s.sdkCtx = s.sdkCtx.WithBlockTime(blockTime)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+	_, err = s.k.Buy(s.ctx, &marketplace.MsgBuy{
+		Buyer: buyer.String(),
+		Orders: []*marketplace.MsgBuy_Order{
+			{Filter: batchFilter(batchDenom), Quantity: "10", BidPrice: &ask, DisableAutoRetire: true, Expiration: &expired},
+		},
+	})
+	assert.ErrorContains(t, err, "expiration must be in the future")
+}
+
+func TestBuy_FillBuyOrders(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, buyer := s.addrs[0], s.addrs[1]
+
+	sellRes, err := s.k.Sell(s.ctx, &marketplace.MsgSell{
+		Seller: seller.String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: "10", AskPrice: &ask},
+		},
+	})
+	assert.NilError(t, err)
+
+	// bid 12 for 15 credits, only 10 credits are available at an ask price of 10
+	bid := sdk.NewInt64Coin(ask.Denom, 12)
+	s.expectEscrow(buyer, 180)
+	buyRes, err := s.k.Buy(s.ctx, &marketplace.MsgBuy{
+		Buyer: buyer.String(),
+		Orders: []*marketplace.MsgBuy_Order{
+			{Filter: classFilter("C01", ""), Quantity: "15", BidPrice: &bid, RetirementJurisdiction: "US-WA"},
+		},
+	})
+	assert.NilError(t, err)
+
+	// the seller receives the ask price and the buyer is refunded the difference
+	s.expectRelease(seller, 100)
+	s.expectRelease(buyer, 20)
+	assert.NilError(t, s.k.FillBuyOrders(s.ctx))
+
+	_, err = s.marketStore.SellOrderTable().Get(s.ctx, sellRes.SellOrderIds[0])
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	buyOrder, err := s.marketStore.BuyOrderTable().Get(s.ctx, buyRes.BuyOrderIds[0])
+	assert.NilError(t, err)
+	assert.Equal(t, "5", buyOrder.Quantity)
+
+	buyerBal, err := s.coreStore.BatchBalanceTable().Get(s.ctx, buyer, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "10", buyerBal.RetiredAmount)
+
+	// a new sell order is matched with the remaining quantity of the buy order
+	sellRes, err = s.k.Sell(s.ctx, &marketplace.MsgSell{
+		Seller: seller.String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: "20", AskPrice: &bid},
+		},
+	})
+	assert.NilError(t, err)
+
+	s.expectRelease(seller, 60)
+	assert.NilError(t, s.k.FillBuyOrders(s.ctx))
+
+	_, err = s.marketStore.BuyOrderTable().Get(s.ctx, buyRes.BuyOrderIds[0])
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	sellOrder, err := s.marketStore.SellOrderTable().Get(s.ctx, sellRes.SellOrderIds[0])
+	assert.NilError(t, err)
+	assert.Equal(t, "15", sellOrder.Quantity)
+
+	// no matches remain
+	assert.NilError(t, s.k.FillBuyOrders(s.ctx))
+}
+
+func TestBuy_NoMatch(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, buyer := s.addrs[0], s.addrs[1]
+
+	project, err := s.coreStore.ProjectTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	project.Jurisdiction = "US-WA"
+	assert.NilError(t, s.coreStore.ProjectTable().Update(s.ctx, project))
+
+	_, err = s.k.Sell(s.ctx, &marketplace.MsgSell{
+		Seller: seller.String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: "10", AskPrice: &ask},
+		},
+	})
+	assert.NilError(t, err)
+
+	lowBid := sdk.NewInt64Coin(ask.Denom, 9)
+	s.expectEscrow(buyer, 90)
+	s.expectEscrow(buyer, 100)
+	s.expectEscrow(buyer, 100)
+	_, err = s.k.Buy(s.ctx, &marketplace.MsgBuy{
+		Buyer: buyer.String(),
+		Orders: []*marketplace.MsgBuy_Order{
+			// bid price is lower than the ask price
+			{Filter: batchFilter(batchDenom), Quantity: "10", BidPrice: &lowBid, RetirementJurisdiction: "US-WA"},
+			// project jurisdiction does not match
+			{Filter: classFilter("C01", "US-OR"), Quantity: "10", BidPrice: &ask, RetirementJurisdiction: "US-WA"},
+			// sell order has auto-retire enabled
+			{Filter: classFilter("C01", "US"), Quantity: "10", BidPrice: &ask, DisableAutoRetire: true},
+		},
+	})
+	assert.NilError(t, err)
+
+	// no calls to the bank keeper are expected
+	assert.NilError(t, s.k.FillBuyOrders(s.ctx))
+}
+
+func TestBuy_FillBuyOrdersSellOrderFillRequirements(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, buyer := s.addrs[0], s.addrs[1]
+
+	sellRes, err := s.k.Sell(s.ctx, &marketplace.MsgSell{
+		Seller: seller.String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: "10", AskPrice: &ask, AllOrNone: true},
+			{BatchDenom: batchDenom, Quantity: "10", AskPrice: &ask, MinFillQuantity: "6"},
+		},
+	})
+	assert.NilError(t, err)
+
+	s.expectEscrow(buyer, 50)
+	buyRes, err := s.k.Buy(s.ctx, &marketplace.MsgBuy{
+		Buyer: buyer.String(),
+		Orders: []*marketplace.MsgBuy_Order{
+			{Filter: batchFilter(batchDenom), Quantity: "5", BidPrice: &ask, RetirementJurisdiction: "US-WA"},
+		},
+	})
+	assert.NilError(t, err)
+
+	// neither sell order is filled by a buy order with a quantity of 5
+	assert.NilError(t, s.k.FillBuyOrders(s.ctx))
+
+	for _, id := range sellRes.SellOrderIds {
+		sellOrder, err := s.marketStore.SellOrderTable().Get(s.ctx, id)
+		assert.NilError(t, err)
+		assert.Equal(t, "10", sellOrder.Quantity)
+	}
+
+	buyOrder, err := s.marketStore.BuyOrderTable().Get(s.ctx, buyRes.BuyOrderIds[0])
+	assert.NilError(t, err)
+	assert.Equal(t, "5", buyOrder.Quantity)
+}
+
+func TestBuy_FillBuyOrdersDelistedMarket(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, buyer := s.addrs[0], s.addrs[1]
+
+	sellRes, err := s.k.Sell(s.ctx, &marketplace.MsgSell{
+		Seller: seller.String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: "10", AskPrice: &ask},
+		},
+	})
+	assert.NilError(t, err)
+
+	s.expectEscrow(buyer, 100)
+	buyRes, err := s.k.Buy(s.ctx, &marketplace.MsgBuy{
+		Buyer: buyer.String(),
+		Orders: []*marketplace.MsgBuy_Order{
+			{Filter: batchFilter(batchDenom), Quantity: "10", BidPrice: &ask, RetirementJurisdiction: "US-WA"},
+		},
+	})
+	assert.NilError(t, err)
+
+	market, err := s.marketStore.MarketTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	market.Delisted = true
+	assert.NilError(t, s.marketStore.MarketTable().Update(s.ctx, market))
+
+	// no calls to the bank keeper are expected while the market is delisted
+	assert.NilError(t, s.k.FillBuyOrders(s.ctx))
+
+	// the match is removed and is not processed again
+	market.Delisted = false
+	assert.NilError(t, s.marketStore.MarketTable().Update(s.ctx, market))
+	assert.NilError(t, s.k.FillBuyOrders(s.ctx))
+
+	sellOrder, err := s.marketStore.SellOrderTable().Get(s.ctx, sellRes.SellOrderIds[0])
+	assert.NilError(t, err)
+	assert.Equal(t, "10", sellOrder.Quantity)
+
+	buyOrder, err := s.marketStore.BuyOrderTable().Get(s.ctx, buyRes.BuyOrderIds[0])
+	assert.NilError(t, err)
+	assert.Equal(t, "10", buyOrder.Quantity)
+}
+
+func TestBuy_FillBuyOrdersLimit(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, buyer := s.addrs[0], s.addrs[1]
+
+	_, err := s.k.Sell(s.ctx, &marketplace.MsgSell{
+		Seller: seller.String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: "5", AskPrice: &ask},
+			{BatchDenom: batchDenom, Quantity: "5", AskPrice: &ask},
+		},
+	})
+	assert.NilError(t, err)
+
+	s.expectEscrow(buyer, 100)
+	buyRes, err := s.k.Buy(s.ctx, &marketplace.MsgBuy{
+		Buyer: buyer.String(),
+		Orders: []*marketplace.MsgBuy_Order{
+			{Filter: batchFilter(batchDenom), Quantity: "10", BidPrice: &ask, RetirementJurisdiction: "US-WA"},
+		},
+	})
+	assert.NilError(t, err)
+
+	// only one match is processed per block
+	s.expectRelease(seller, 50)
+	assert.NilError(t, s.k.fillBuyOrders(s.ctx, 1))
+
+	buyOrder, err := s.marketStore.BuyOrderTable().Get(s.ctx, buyRes.BuyOrderIds[0])
+	assert.NilError(t, err)
+	assert.Equal(t, "5", buyOrder.Quantity)
+
+	s.expectRelease(seller, 50)
+	assert.NilError(t, s.k.fillBuyOrders(s.ctx, 1))
+
+	_, err = s.marketStore.BuyOrderTable().Get(s.ctx, buyRes.BuyOrderIds[0])
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+}
+
+func TestBuy_FillBuyOrdersFailure(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, buyer := s.addrs[0], s.addrs[1]
+
+	sellRes, err := s.k.Sell(s.ctx, &marketplace.MsgSell{
+		Seller: seller.String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: "5", AskPrice: &ask},
+			{BatchDenom: batchDenom, Quantity: "5", AskPrice: &ask},
+		},
+	})
+	assert.NilError(t, err)
+
+	s.expectEscrow(buyer, 100)
+	buyRes, err := s.k.Buy(s.ctx, &marketplace.MsgBuy{
+		Buyer: buyer.String(),
+		Orders: []*marketplace.MsgBuy_Order{
+			{Filter: batchFilter(batchDenom), Quantity: "10", BidPrice: &ask, RetirementJurisdiction: "US-WA"},
+		},
+	})
+	assert.NilError(t, err)
+
+	// the first fill fails without preventing the second fill
+	coins := sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 50))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gmAny, ecocredit.ModuleName, seller, coins).
+		Return(sdkerrors.ErrInsufficientFunds).Times(1)
+	s.expectRelease(seller, 50)
+	assert.NilError(t, s.k.FillBuyOrders(s.ctx))
+
+	_, err = s.marketStore.SellOrderTable().Get(s.ctx, sellRes.SellOrderIds[1])
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	buyOrder, err := s.marketStore.BuyOrderTable().Get(s.ctx, buyRes.BuyOrderIds[0])
+	assert.NilError(t, err)
+	assert.Equal(t, "5", buyOrder.Quantity)
+
+	// the failed match is removed and is not processed again
+	assert.NilError(t, s.k.FillBuyOrders(s.ctx))
+}
@@ -0,0 +1,49 @@
+package marketplace
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// CancelBuyOrder cancels a buy order and returns the escrowed funds to the buyer.
+func (k Keeper) CancelBuyOrder(ctx context.Context, req *marketplace.MsgCancelBuyOrder) (*marketplace.MsgCancelBuyOrderResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	buyerAcc, err := sdk.AccAddressFromBech32(req.Buyer)
+	if err != nil {
+		return nil, err
+	}
+
+	buyOrder, err := k.stateStore.BuyOrderTable().Get(ctx, req.BuyOrderId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("buy order with id %d: %s", req.BuyOrderId, err.Error())
+	}
+
+	if !buyerAcc.Equals(sdk.AccAddress(buyOrder.Buyer)) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("buyer must be the owner of the buy order")
+	}
+
+	if err = k.unescrowFunds(ctx, buyOrder); err != nil {
+		return nil, err
+	}
+
+	if err = k.stateStore.BuyOrderTable().Delete(ctx, buyOrder); err != nil {
+		return nil, err
+	}
+
+	if err = k.orderBook.OnDeleteBuyOrder(ctx, buyOrder); err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventCancelBuyOrder{
+		BuyOrderId: buyOrder.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &marketplace.MsgCancelBuyOrderResponse{}, nil
+}
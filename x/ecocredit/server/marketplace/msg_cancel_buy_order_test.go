@@ -0,0 +1,61 @@
+package marketplace
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func TestCancelBuyOrder(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, buyer := s.addrs[0], s.addrs[1]
+
+	s.expectEscrow(buyer, 155)
+	res, err := s.k.Buy(s.ctx, &marketplace.MsgBuy{
+		Buyer: buyer.String(),
+		Orders: []*marketplace.MsgBuy_Order{
+			{Filter: batchFilter(batchDenom), Quantity: "15.5", BidPrice: &ask, DisableAutoRetire: true},
+		},
+	})
+	assert.NilError(t, err)
+	id := res.BuyOrderIds[0]
+
+	// only the buyer can cancel the buy order
+	_, err = s.k.CancelBuyOrder(s.ctx, &marketplace.MsgCancelBuyOrder{
+		Buyer:      seller.String(),
+		BuyOrderId: id,
+	})
+	assert.ErrorContains(t, err, "buyer must be the owner of the buy order")
+
+	_, err = s.k.CancelBuyOrder(s.ctx, &marketplace.MsgCancelBuyOrder{
+		Buyer:      buyer.String(),
+		BuyOrderId: id + 1,
+	})
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	s.expectRelease(buyer, 155)
+	_, err = s.k.CancelBuyOrder(s.ctx, &marketplace.MsgCancelBuyOrder{
+		Buyer:      buyer.String(),
+		BuyOrderId: id,
+	})
+	assert.NilError(t, err)
+
+	_, err = s.marketStore.BuyOrderTable().Get(s.ctx, id)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	// a sell order placed after the buy order was cancelled is not matched
+	_, err = s.k.Sell(s.ctx, &marketplace.MsgSell{
+		Seller: seller.String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: "10", AskPrice: &ask, DisableAutoRetire: true},
+		},
+	})
+	assert.NilError(t, err)
+	assert.NilError(t, s.k.FillBuyOrders(s.ctx))
+}
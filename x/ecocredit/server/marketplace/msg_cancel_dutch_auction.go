@@ -0,0 +1,50 @@
+package marketplace
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// CancelDutchAuction cancels a dutch auction and returns the escrowed credits to the seller.
+func (k Keeper) CancelDutchAuction(ctx context.Context, req *marketplace.MsgCancelDutchAuction) (*marketplace.MsgCancelDutchAuctionResponse, error) {
+	sellerAcc, err := sdk.AccAddressFromBech32(req.Seller)
+	if err != nil {
+		return nil, err
+	}
+
+	auction, err := k.stateStore.DutchAuctionTable().Get(ctx, req.AuctionId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("dutch auction with id %d: %s", req.AuctionId, err.Error())
+	}
+
+	if !sellerAcc.Equals(sdk.AccAddress(auction.Seller)) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("seller must be the owner of the dutch auction")
+	}
+
+	if err = k.cancelDutchAuction(ctx, auction); err != nil {
+		return nil, err
+	}
+
+	return &marketplace.MsgCancelDutchAuctionResponse{}, nil
+}
+
+// cancelDutchAuction returns the escrowed credits of the dutch auction to the seller's tradable balance
+// and removes the dutch auction.
+func (k Keeper) cancelDutchAuction(ctx context.Context, auction *api.DutchAuction) error {
+	if err := k.unescrowCredits(ctx, sdk.AccAddress(auction.Seller), auction.BatchKey, auction.Quantity); err != nil {
+		return err
+	}
+
+	if err := k.stateStore.DutchAuctionTable().Delete(ctx, auction); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&marketplace.EventCancelDutchAuction{
+		AuctionId: auction.Id,
+	})
+}
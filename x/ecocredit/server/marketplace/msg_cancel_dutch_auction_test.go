@@ -0,0 +1,59 @@
+package marketplace
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func TestCancelDutchAuction(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, other := s.addrs[0], s.addrs[1]
+
+	startPrice, floorPrice := sdk.NewInt64Coin(ask.Denom, 100), sdk.NewInt64Coin(ask.Denom, 40)
+	res, err := s.k.CreateDutchAuction(s.ctx, &marketplace.MsgCreateDutchAuction{
+		Seller:     seller.String(),
+		BatchDenom: batchDenom,
+		Quantity:   "50",
+		StartPrice: &startPrice,
+		FloorPrice: &floorPrice,
+		Duration:   time.Hour,
+	})
+	assert.NilError(t, err)
+
+	// only the seller can cancel the auction
+	_, err = s.k.CancelDutchAuction(s.ctx, &marketplace.MsgCancelDutchAuction{
+		Seller:    other.String(),
+		AuctionId: res.AuctionId,
+	})
+	assert.ErrorContains(t, err, "seller must be the owner of the dutch auction")
+
+	_, err = s.k.CancelDutchAuction(s.ctx, &marketplace.MsgCancelDutchAuction{
+		Seller:    seller.String(),
+		AuctionId: res.AuctionId,
+	})
+	assert.NilError(t, err)
+
+	_, err = s.marketStore.DutchAuctionTable().Get(s.ctx, res.AuctionId)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	sellerBal, err := s.coreStore.BatchBalanceTable().Get(s.ctx, seller, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "100", sellerBal.TradableAmount)
+	assert.Equal(t, "0", sellerBal.EscrowedAmount)
+
+	// auction not found
+	_, err = s.k.CancelDutchAuction(s.ctx, &marketplace.MsgCancelDutchAuction{
+		Seller:    seller.String(),
+		AuctionId: res.AuctionId,
+	})
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+}
@@ -0,0 +1,45 @@
+package marketplace
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// CancelOffer cancels an offer and returns the escrowed funds to the buyer.
+func (k Keeper) CancelOffer(ctx context.Context, req *marketplace.MsgCancelOffer) (*marketplace.MsgCancelOfferResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	buyerAcc, err := sdk.AccAddressFromBech32(req.Buyer)
+	if err != nil {
+		return nil, err
+	}
+
+	offer, err := k.stateStore.OfferTable().Get(ctx, req.OfferId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("offer with id %d: %s", req.OfferId, err.Error())
+	}
+
+	if !buyerAcc.Equals(sdk.AccAddress(offer.Buyer)) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("buyer must be the owner of the offer")
+	}
+
+	if err = k.returnEscrowedFunds(ctx, offer.Buyer, offer.MarketId, offer.Quantity, offer.BidAmount, offer.MakerFeeBps); err != nil {
+		return nil, err
+	}
+
+	if err = k.stateStore.OfferTable().Delete(ctx, offer); err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventCancelOffer{
+		OfferId: offer.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &marketplace.MsgCancelOfferResponse{}, nil
+}
@@ -0,0 +1,51 @@
+package marketplace
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func TestCancelOffer(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	holder, buyer := s.addrs[0], s.addrs[1]
+
+	s.expectEscrow(buyer, 155)
+	res, err := s.k.MakeOffer(s.ctx, &marketplace.MsgMakeOffer{
+		Buyer:             buyer.String(),
+		BatchDenom:        batchDenom,
+		Quantity:          "15.5",
+		BidPrice:          &ask,
+		DisableAutoRetire: true,
+	})
+	assert.NilError(t, err)
+
+	// only the buyer can cancel the offer
+	_, err = s.k.CancelOffer(s.ctx, &marketplace.MsgCancelOffer{
+		Buyer:   holder.String(),
+		OfferId: res.OfferId,
+	})
+	assert.ErrorContains(t, err, "buyer must be the owner of the offer")
+
+	_, err = s.k.CancelOffer(s.ctx, &marketplace.MsgCancelOffer{
+		Buyer:   buyer.String(),
+		OfferId: res.OfferId + 1,
+	})
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	s.expectRelease(buyer, 155)
+	_, err = s.k.CancelOffer(s.ctx, &marketplace.MsgCancelOffer{
+		Buyer:   buyer.String(),
+		OfferId: res.OfferId,
+	})
+	assert.NilError(t, err)
+
+	_, err = s.marketStore.OfferTable().Get(s.ctx, res.OfferId)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+}
@@ -38,5 +38,9 @@ func (k Keeper) CancelSellOrder(ctx context.Context, req *marketplace.MsgCancelS
 		return nil, err
 	}
 
-	return &marketplace.MsgCancelSellOrderResponse{}, k.stateStore.SellOrderTable().Delete(ctx, sellOrder)
+	if err = k.stateStore.SellOrderTable().Delete(ctx, sellOrder); err != nil {
+		return nil, err
+	}
+
+	return &marketplace.MsgCancelSellOrderResponse{}, k.orderBook.OnDeleteSellOrder(ctx, sellOrder)
 }
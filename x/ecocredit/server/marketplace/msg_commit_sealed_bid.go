@@ -0,0 +1,81 @@
+package marketplace
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// CommitSealedBid commits a hidden bid to a sealed-bid auction. The deposit is sent to the ecocredit module
+// account and held in escrow until the auction is settled. Each bidder can commit one bid per auction.
+func (k Keeper) CommitSealedBid(ctx context.Context, req *marketplace.MsgCommitSealedBid) (*marketplace.MsgCommitSealedBidResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	bidderAcc, err := sdk.AccAddressFromBech32(req.Bidder)
+	if err != nil {
+		return nil, err
+	}
+
+	auction, err := k.stateStore.SealedBidAuctionTable().Get(ctx, req.AuctionId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("sealed-bid auction with id %d: %s", req.AuctionId, err.Error())
+	}
+
+	if sdkCtx.BlockHeight() > auction.CommitEndHeight {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"sealed-bid auction with id %d: commit phase ended at height %d", auction.Id, auction.CommitEndHeight,
+		)
+	}
+
+	if bidderAcc.Equals(sdk.AccAddress(auction.Seller)) {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("bidder account cannot be the same as seller account")
+	}
+
+	found, err := k.stateStore.SealedBidTable().Has(ctx, auction.Id, bidderAcc)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("bidder has already committed a bid to sealed-bid auction with id %d", auction.Id)
+	}
+
+	market, err := k.stateStore.MarketTable().Get(ctx, auction.MarketId)
+	if err != nil {
+		return nil, err
+	}
+	if err = assertMarketListed(market); err != nil {
+		return nil, err
+	}
+	if req.Deposit.Denom != market.BankDenom {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"deposit denom: %s, reserve price denom: %s", req.Deposit.Denom, market.BankDenom,
+		)
+	}
+
+	if err = k.bankKeeper.SendCoinsFromAccountToModule(sdkCtx, bidderAcc, ecocredit.ModuleName, sdk.NewCoins(*req.Deposit)); err != nil {
+		return nil, sdkerrors.ErrInsufficientFunds.Wrapf("deposit: %v: %s", req.Deposit, err.Error())
+	}
+
+	if err = k.stateStore.SealedBidTable().Insert(ctx, &api.SealedBid{
+		AuctionId:     auction.Id,
+		Bidder:        bidderAcc,
+		Commitment:    req.Commitment,
+		DepositAmount: req.Deposit.Amount.String(),
+	}); err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventCommitSealedBid{
+		AuctionId: auction.Id,
+		Bidder:    req.Bidder,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &marketplace.MsgCommitSealedBidResponse{}, nil
+}
@@ -0,0 +1,91 @@
+package marketplace
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// CreateDutchAuction creates a descending-price auction for credits. The credits are moved from the
+// tradable balance of the seller to the escrowed balance of the seller until they are bought or the
+// auction is cancelled.
+func (k Keeper) CreateDutchAuction(ctx context.Context, req *marketplace.MsgCreateDutchAuction) (*marketplace.MsgCreateDutchAuctionResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	sellerAcc, err := sdk.AccAddressFromBech32(req.Seller)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := k.coreStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("batch denom %s: %s", req.BatchDenom, err.Error())
+	}
+
+	if err = utils.AssertBatchNotFrozen(batch); err != nil {
+		return nil, err
+	}
+
+	creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.coreStore, batch.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed, err := k.isDenomAllowed(ctx, creditType.Abbreviation, req.StartPrice.Denom)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s is not allowed to be used in auctions", req.StartPrice.Denom)
+	}
+
+	// check decimal places does not exceed credit type precision
+	sellQty, err := math.NewPositiveFixedDecFromString(req.Quantity, creditType.Precision)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"decimal places exceeds precision: quantity: %s, credit type precision: %d",
+			req.Quantity, creditType.Precision,
+		)
+	}
+
+	marketId, err := k.getOrCreateMarketId(ctx, creditType.Abbreviation, req.StartPrice.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.escrowCredits(ctx, "dutch auction", sellerAcc, batch.Key, sellQty); err != nil {
+		return nil, err
+	}
+
+	startTime := sdkCtx.BlockTime()
+	id, err := k.stateStore.DutchAuctionTable().InsertReturningID(ctx, &api.DutchAuction{
+		Seller:            sellerAcc,
+		BatchKey:          batch.Key,
+		Quantity:          req.Quantity,
+		MarketId:          marketId,
+		StartAmount:       req.StartPrice.Amount.String(),
+		FloorAmount:       req.FloorPrice.Amount.String(),
+		StartTime:         timestamppb.New(startTime),
+		EndTime:           timestamppb.New(startTime.Add(req.Duration)),
+		DisableAutoRetire: req.DisableAutoRetire,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventCreateDutchAuction{
+		AuctionId: id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &marketplace.MsgCreateDutchAuctionResponse{AuctionId: id}, nil
+}
@@ -0,0 +1,99 @@
+package marketplace
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	ecoApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// CreateForwardSale lists future credits from a project for sale. Only the admin of the project can
+// create a forward sale. No credits or funds are escrowed until forward contracts are bought.
+func (k Keeper) CreateForwardSale(ctx context.Context, req *marketplace.MsgCreateForwardSale) (*marketplace.MsgCreateForwardSaleResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	sellerAcc, err := sdk.AccAddressFromBech32(req.Seller)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := k.coreStore.ProjectTable().GetById(ctx, req.ProjectId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get project with id %s: %s", req.ProjectId, err.Error())
+	}
+
+	if !sdk.AccAddress(project.Admin).Equals(sellerAcc) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is not the admin of project %s", req.Seller, req.ProjectId)
+	}
+
+	creditType, err := k.getProjectCreditType(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed, err := k.isDenomAllowed(ctx, creditType.Abbreviation, req.AskPrice.Denom)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s is not allowed to be used in forward sales", req.AskPrice.Denom)
+	}
+
+	// verify delivery deadline is in the future
+	if !req.DeliveryDeadline.UTC().After(sdkCtx.BlockTime()) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("delivery deadline must be in the future: %s", req.DeliveryDeadline)
+	}
+
+	// check decimal places does not exceed credit type precision
+	if _, err = math.NewPositiveFixedDecFromString(req.Quantity, creditType.Precision); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"decimal places exceeds precision: quantity: %s, credit type precision: %d",
+			req.Quantity, creditType.Precision,
+		)
+	}
+
+	marketId, err := k.getOrCreateMarketId(ctx, creditType.Abbreviation, req.AskPrice.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := k.stateStore.ForwardSaleTable().InsertReturningID(ctx, &api.ForwardSale{
+		Seller:            sellerAcc,
+		ProjectKey:        project.Key,
+		VintageStart:      timestamppb.New(*req.VintageStart),
+		VintageEnd:        timestamppb.New(*req.VintageEnd),
+		Quantity:          req.Quantity,
+		MarketId:          marketId,
+		AskAmount:         req.AskPrice.Amount.String(),
+		DeliveryDeadline:  timestamppb.New(*req.DeliveryDeadline),
+		DisableAutoRetire: req.DisableAutoRetire,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventCreateForwardSale{
+		ForwardSaleId: id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &marketplace.MsgCreateForwardSaleResponse{ForwardSaleId: id}, nil
+}
+
+// getProjectCreditType returns the credit type of the credit class of the project.
+func (k Keeper) getProjectCreditType(ctx context.Context, project *ecoApi.Project) (*ecoApi.CreditType, error) {
+	class, err := k.coreStore.ClassTable().Get(ctx, project.ClassKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.coreStore.CreditTypeTable().Get(ctx, class.CreditTypeAbbrev)
+}
@@ -0,0 +1,102 @@
+package marketplace
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+var forwardSaleBlockTime = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// forwardSaleSetup sets the first address as the admin of the project and returns a valid forward sale
+// message with a vintage range that includes the dates of the credit batch.
+func (s *baseSuite) forwardSaleSetup() *marketplace.MsgCreateForwardSale {
+	s.sdkCtx = s.sdkCtx.WithBlockTime(forwardSaleBlockTime)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+
+	project, err := s.coreStore.ProjectTable().Get(s.ctx, 1)
+	assert.NilError(s.t, err)
+	project.Admin = s.addrs[0]
+	assert.NilError(s.t, s.coreStore.ProjectTable().Update(s.ctx, project))
+
+	askPrice := sdk.NewInt64Coin(ask.Denom, 10)
+	vintageStart, vintageEnd := start.AsTime().Add(-time.Hour*24), end.AsTime().Add(time.Hour*24)
+	deadline := forwardSaleBlockTime.Add(time.Hour * 24 * 365)
+
+	return &marketplace.MsgCreateForwardSale{
+		Seller:           s.addrs[0].String(),
+		ProjectId:        project.Id,
+		Quantity:         "100",
+		AskPrice:         &askPrice,
+		VintageStart:     &vintageStart,
+		VintageEnd:       &vintageEnd,
+		DeliveryDeadline: &deadline,
+	}
+}
+
+func TestCreateForwardSale(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 1)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	msg := s.forwardSaleSetup()
+
+	res, err := s.k.CreateForwardSale(s.ctx, msg)
+	assert.NilError(t, err)
+
+	sale, err := s.marketStore.ForwardSaleTable().Get(s.ctx, res.ForwardSaleId)
+	assert.NilError(t, err)
+	assert.Check(t, s.addrs[0].Equals(sdk.AccAddress(sale.Seller)))
+	assert.Equal(t, uint64(1), sale.ProjectKey)
+	assert.Equal(t, "100", sale.Quantity)
+	assert.Equal(t, "10", sale.AskAmount)
+	assert.Equal(t, uint64(1), sale.MarketId)
+	assert.Equal(t, msg.DeliveryDeadline.Unix(), sale.DeliveryDeadline.Seconds)
+
+	// no credits are escrowed
+	sellerBal, err := s.coreStore.BatchBalanceTable().Get(s.ctx, s.addrs[0], 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "100", sellerBal.TradableAmount)
+}
+
+func TestCreateForwardSale_Invalid(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+
+	// project not found
+	msg := s.forwardSaleSetup()
+	msg.ProjectId = "C01-002"
+	_, err := s.k.CreateForwardSale(s.ctx, msg)
+	assert.ErrorContains(t, err, "could not get project with id C01-002")
+
+	// seller is not the project admin
+	msg = s.forwardSaleSetup()
+	msg.Seller = s.addrs[1].String()
+	_, err = s.k.CreateForwardSale(s.ctx, msg)
+	assert.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// ask denom not allowed
+	msg = s.forwardSaleSetup()
+	msg.AskPrice = &sdk.Coin{Denom: "uatom", Amount: sdk.NewInt(10)}
+	_, err = s.k.CreateForwardSale(s.ctx, msg)
+	assert.ErrorContains(t, err, "uatom is not allowed to be used in forward sales")
+
+	// delivery deadline in the past
+	msg = s.forwardSaleSetup()
+	deadline := forwardSaleBlockTime.Add(-time.Hour)
+	msg.DeliveryDeadline = &deadline
+	_, err = s.k.CreateForwardSale(s.ctx, msg)
+	assert.ErrorContains(t, err, "delivery deadline must be in the future")
+
+	// quantity exceeds credit type precision
+	msg = s.forwardSaleSetup()
+	msg.Quantity = "10.1234567"
+	_, err = s.k.CreateForwardSale(s.ctx, msg)
+	assert.ErrorContains(t, err, "decimal places exceeds precision")
+}
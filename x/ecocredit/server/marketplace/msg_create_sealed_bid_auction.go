@@ -0,0 +1,103 @@
+package marketplace
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// CreateSealedBidAuction creates a sealed-bid auction for credits. The credits are moved from the tradable
+// balance of the seller to the escrowed balance of the seller until the auction is settled. The fees of the
+// market are locked when the auction is created.
+func (k Keeper) CreateSealedBidAuction(ctx context.Context, req *marketplace.MsgCreateSealedBidAuction) (*marketplace.MsgCreateSealedBidAuctionResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	sellerAcc, err := sdk.AccAddressFromBech32(req.Seller)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.CommitEndHeight <= sdkCtx.BlockHeight() {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"commit end height must be greater than the current block height: %d", sdkCtx.BlockHeight(),
+		)
+	}
+
+	batch, err := k.coreStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("batch denom %s: %s", req.BatchDenom, err.Error())
+	}
+
+	if err = utils.AssertBatchNotFrozen(batch); err != nil {
+		return nil, err
+	}
+
+	creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.coreStore, batch.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed, err := k.isDenomAllowed(ctx, creditType.Abbreviation, req.ReservePrice.Denom)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s is not allowed to be used in auctions", req.ReservePrice.Denom)
+	}
+
+	// check decimal places does not exceed credit type precision
+	sellQty, err := math.NewPositiveFixedDecFromString(req.Quantity, creditType.Precision)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"decimal places exceeds precision: quantity: %s, credit type precision: %d",
+			req.Quantity, creditType.Precision,
+		)
+	}
+
+	marketId, err := k.getOrCreateMarketId(ctx, creditType.Abbreviation, req.ReservePrice.Denom)
+	if err != nil {
+		return nil, err
+	}
+	market, err := k.stateStore.MarketTable().Get(ctx, marketId)
+	if err != nil {
+		return nil, err
+	}
+	makerBps, takerBps, err := k.getMarketFees(ctx, market)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.escrowCredits(ctx, "sealed-bid auction", sellerAcc, batch.Key, sellQty); err != nil {
+		return nil, err
+	}
+
+	id, err := k.stateStore.SealedBidAuctionTable().InsertReturningID(ctx, &api.SealedBidAuction{
+		Seller:            sellerAcc,
+		BatchKey:          batch.Key,
+		Quantity:          req.Quantity,
+		MarketId:          marketId,
+		ReserveAmount:     req.ReservePrice.Amount.String(),
+		CommitEndHeight:   req.CommitEndHeight,
+		RevealEndHeight:   req.RevealEndHeight,
+		DisableAutoRetire: req.DisableAutoRetire,
+		MakerFeeBps:       makerBps,
+		TakerFeeBps:       takerBps,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventCreateSealedBidAuction{
+		AuctionId: id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &marketplace.MsgCreateSealedBidAuctionResponse{AuctionId: id}, nil
+}
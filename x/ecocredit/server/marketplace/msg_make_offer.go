@@ -0,0 +1,127 @@
+package marketplace
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// MakeOffer creates an offer to buy credits from a credit batch. The bid amount for the total quantity of
+// the offer and the maker fee for the bid amount are escrowed in the ecocredit module account until the
+// offer is accepted, cancelled or expired.
+func (k Keeper) MakeOffer(ctx context.Context, req *marketplace.MsgMakeOffer) (*marketplace.MsgMakeOfferResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	buyerAcc, err := sdk.AccAddressFromBech32(req.Buyer)
+	if err != nil {
+		return nil, err
+	}
+
+	var holderAcc sdk.AccAddress
+	if len(req.Holder) != 0 {
+		holderAcc, err = sdk.AccAddressFromBech32(req.Holder)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	batch, err := k.coreStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("batch denom %s: %s", req.BatchDenom, err.Error())
+	}
+
+	if err = utils.AssertBatchNotFrozen(batch); err != nil {
+		return nil, err
+	}
+
+	creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.coreStore, batch.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed, err := k.isDenomAllowed(ctx, creditType.Abbreviation, req.BidPrice.Denom)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s is not allowed to be used in offers", req.BidPrice.Denom)
+	}
+
+	// verify expiration is in the future
+	if req.Expiration != nil && !req.Expiration.UTC().After(sdkCtx.BlockTime()) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("expiration must be in the future: %s", req.Expiration)
+	}
+
+	// check decimal places does not exceed credit type precision
+	offerQty, err := math.NewPositiveFixedDecFromString(req.Quantity, creditType.Precision)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"decimal places exceeds precision: quantity: %s, credit type precision: %d",
+			req.Quantity, creditType.Precision,
+		)
+	}
+
+	marketId, err := k.getOrCreateMarketId(ctx, creditType.Abbreviation, req.BidPrice.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	// escrow the total cost of the offer and the maker fee in the ecocredit module account
+	market, err := k.stateStore.MarketTable().Get(ctx, marketId)
+	if err != nil {
+		return nil, err
+	}
+	makerBps, _, err := k.getMarketFees(ctx, market)
+	if err != nil {
+		return nil, err
+	}
+	cost, err := getTotalCost(req.BidPrice.Amount, offerQty)
+	if err != nil {
+		return nil, err
+	}
+	coinCost := sdk.Coin{Amount: getEscrowAmount(cost, makerBps), Denom: req.BidPrice.Denom}
+	if err = k.bankKeeper.SendCoinsFromAccountToModule(sdkCtx, buyerAcc, ecocredit.ModuleName, sdk.NewCoins(coinCost)); err != nil {
+		return nil, sdkerrors.ErrInsufficientFunds.Wrapf(
+			"quantity: %s, bid price: %v, total price: %v: %s",
+			req.Quantity, req.BidPrice, coinCost, err.Error(),
+		)
+	}
+
+	var expiration *timestamppb.Timestamp
+	if req.Expiration != nil {
+		expiration = timestamppb.New(*req.Expiration)
+	}
+
+	id, err := k.stateStore.OfferTable().InsertReturningID(ctx, &api.Offer{
+		Buyer:                  buyerAcc,
+		BatchKey:               batch.Key,
+		Holder:                 holderAcc,
+		Quantity:               req.Quantity,
+		MarketId:               marketId,
+		BidAmount:              req.BidPrice.Amount.String(),
+		DisableAutoRetire:      req.DisableAutoRetire,
+		RetirementJurisdiction: req.RetirementJurisdiction,
+		Expiration:             expiration,
+		MakerFeeBps:            makerBps,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventMakeOffer{
+		OfferId: id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &marketplace.MsgMakeOfferResponse{OfferId: id}, nil
+}
@@ -0,0 +1,103 @@
+package marketplace
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func TestMakeOffer_Valid(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	holder, buyer := s.addrs[0], s.addrs[1]
+	s.setCreditTypeFees(100, 200)
+
+	expiration, err := types.ParseDate("expiration", "2030-01-01")
+	assert.NilError(t, err)
+
+	// the bid amount and the maker fee are escrowed (100 + 1)
+	s.expectEscrow(buyer, 101)
+	res, err := s.k.MakeOffer(s.ctx, &marketplace.MsgMakeOffer{
+		Buyer:                  buyer.String(),
+		BatchDenom:             batchDenom,
+		Holder:                 holder.String(),
+		Quantity:               "10",
+		BidPrice:               &ask,
+		RetirementJurisdiction: "US-WA",
+		Expiration:             &expiration,
+	})
+	assert.NilError(t, err)
+
+	offer, err := s.marketStore.OfferTable().Get(s.ctx, res.OfferId)
+	assert.NilError(t, err)
+	assert.Check(t, buyer.Equals(sdk.AccAddress(offer.Buyer)))
+	assert.Check(t, holder.Equals(sdk.AccAddress(offer.Holder)))
+	assert.Equal(t, uint64(1), offer.BatchKey)
+	assert.Equal(t, "10", offer.Quantity)
+	assert.Equal(t, ask.Amount.String(), offer.BidAmount)
+	assert.Equal(t, uint64(1), offer.MarketId)
+	assert.Equal(t, "US-WA", offer.RetirementJurisdiction)
+	assert.Equal(t, uint32(100), offer.MakerFeeBps)
+	assert.Equal(t, expiration.Unix(), offer.Expiration.Seconds)
+}
+
+func TestMakeOffer_Invalid(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	buyer := s.addrs[1]
+
+	// denom not allowed
+	invalidBid := sdk.NewInt64Coin("ufoo", 10)
+	_, err := s.k.MakeOffer(s.ctx, &marketplace.MsgMakeOffer{
+		Buyer:             buyer.String(),
+		BatchDenom:        batchDenom,
+		Quantity:          "10",
+		BidPrice:          &invalidBid,
+		DisableAutoRetire: true,
+	})
+	assert.ErrorContains(t, err, "ufoo is not allowed to be used in offers")
+
+	// batch not found
+	_, err = s.k.MakeOffer(s.ctx, &marketplace.MsgMakeOffer{
+		Buyer:             buyer.String(),
+		BatchDenom:        "C01-001-20200101-20200201-002",
+		Quantity:          "10",
+		BidPrice:          &ask,
+		DisableAutoRetire: true,
+	})
+	assert.ErrorContains(t, err, "batch denom C01-001-20200101-20200201-002")
+
+	// quantity exceeds precision
+	_, err = s.k.MakeOffer(s.ctx, &marketplace.MsgMakeOffer{
+		Buyer:             buyer.String(),
+		BatchDenom:        batchDenom,
+		Quantity:          "10.1234567",
+		BidPrice:          &ask,
+		DisableAutoRetire: true,
+	})
+	assert.ErrorContains(t, err, "decimal places exceeds precision")
+
+	// expiration in the past
+	blockTime, err := types.ParseDate("block time", "2020-01-01")
+	assert.NilError(t, err)
+	expired, err := types.ParseDate("expiration", "2019-12-30")
+	assert.NilError(t, err)
+	s.sdkCtx = s.sdkCtx.WithBlockTime(blockTime)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+	_, err = s.k.MakeOffer(s.ctx, &marketplace.MsgMakeOffer{
+		Buyer:             buyer.String(),
+		BatchDenom:        batchDenom,
+		Quantity:          "10",
+		BidPrice:          &ask,
+		DisableAutoRetire: true,
+		Expiration:        &expired,
+	})
+	assert.ErrorContains(t, err, "expiration must be in the future")
+}
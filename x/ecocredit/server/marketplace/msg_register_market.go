@@ -0,0 +1,114 @@
+package marketplace
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// RegisterMarket registers a market for a credit type and a bank denom with denom metadata in the bank
+// module. The registration deposit is sent from the registrant to the fee destination.
+func (k Keeper) RegisterMarket(ctx context.Context, req *marketplace.MsgRegisterMarket) (*marketplace.MsgRegisterMarketResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	registrantAcc, err := sdk.AccAddressFromBech32(req.Registrant)
+	if err != nil {
+		return nil, err
+	}
+
+	registration, err := k.stateStore.MarketRegistrationTable().Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !registration.Enabled {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("market registration is not enabled")
+	}
+
+	if _, err := k.coreStore.CreditTypeTable().Get(ctx, req.CreditTypeAbbrev); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"could not get credit type with abbreviation %s: %s", req.CreditTypeAbbrev, err,
+		)
+	}
+
+	market, err := k.stateStore.MarketTable().GetByCreditTypeAbbrevBankDenom(ctx, req.CreditTypeAbbrev, req.BankDenom)
+	switch {
+	case err == nil:
+		if market.Delisted {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("market with id %d has been delisted", market.Id)
+		}
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("market with id %d already exists", market.Id)
+	case !ormerrors.NotFound.Is(err):
+		return nil, err
+	}
+
+	metadata, found := k.bankKeeper.GetDenomMetaData(sdkCtx, req.BankDenom)
+	if !found {
+		return nil, sdkerrors.ErrNotFound.Wrapf("denom metadata for %s", req.BankDenom)
+	}
+
+	exponent, err := getDisplayExponent(metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	depositAmount, ok := sdk.NewIntFromString(registration.DepositAmount)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", registration.DepositAmount, sdk.Int{})
+	}
+	deposit := sdk.NewCoins(sdk.NewCoin(registration.DepositDenom, depositAmount))
+
+	if err = k.bankKeeper.SendCoinsFromAccountToModule(sdkCtx, registrantAcc, ecocredit.ModuleName, deposit); err != nil {
+		return nil, err
+	}
+
+	if err = k.sendToFeeDestination(ctx, deposit); err != nil {
+		return nil, err
+	}
+
+	marketId, err := k.stateStore.MarketTable().InsertReturningID(ctx, &api.Market{
+		CreditTypeAbbrev: req.CreditTypeAbbrev,
+		BankDenom:        req.BankDenom,
+		Registrant:       registrantAcc,
+		DisplayDenom:     metadata.Display,
+		Exponent:         exponent,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventRegisterMarket{
+		MarketId:         marketId,
+		CreditTypeAbbrev: req.CreditTypeAbbrev,
+		BankDenom:        req.BankDenom,
+		Registrant:       req.Registrant,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &marketplace.MsgRegisterMarketResponse{MarketId: marketId}, nil
+}
+
+// getDisplayExponent returns the exponent of the denom unit of the display denom of the denom metadata.
+func getDisplayExponent(metadata banktypes.Metadata) (uint32, error) {
+	if len(metadata.Display) == 0 {
+		return 0, sdkerrors.ErrInvalidRequest.Wrapf("denom metadata for %s has no display denom", metadata.Base)
+	}
+
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == metadata.Display {
+			return unit.Exponent, nil
+		}
+	}
+
+	return 0, sdkerrors.ErrInvalidRequest.Wrapf(
+		"denom metadata for %s has no denom unit for display denom %s", metadata.Base, metadata.Display,
+	)
+}
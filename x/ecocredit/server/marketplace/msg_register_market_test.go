@@ -0,0 +1,141 @@
+package marketplace
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+const registeredDenom = "ibc/D189335C6E4A68B513C10AB227BF1C1D38C746766278BA3EEB4FB14124F1D858"
+
+var registeredDenomMetadata = banktypes.Metadata{
+	Base:    registeredDenom,
+	Display: "usdc",
+	DenomUnits: []*banktypes.DenomUnit{
+		{Denom: registeredDenom, Exponent: 0},
+		{Denom: "usdc", Exponent: 6},
+	},
+}
+
+// enableMarketRegistration enables market registration with the deposit and returns the deposit.
+func (s *baseSuite) enableMarketRegistration(deposit sdk.Coin) sdk.Coins {
+	err := s.k.SetMarketRegistration(s.sdkCtx, &marketplace.MarketRegistrationProposal{
+		Title:       "foo",
+		Description: "bar",
+		Enabled:     true,
+		Deposit:     &deposit,
+	})
+	assert.NilError(s.t, err)
+	return sdk.NewCoins(deposit)
+}
+
+// registerMarket registers a market for the registered denom and the credit type of the test setup.
+func (s *baseSuite) registerMarket(registrant sdk.AccAddress, deposit sdk.Coins) uint64 {
+	s.bankKeeper.EXPECT().GetDenomMetaData(gmAny, registeredDenom).Return(registeredDenomMetadata, true).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gmAny, registrant, ecocredit.ModuleName, deposit).Return(nil).Times(1)
+	s.distrKeeper.EXPECT().FundCommunityPool(gmAny, deposit, authtypes.NewModuleAddress(ecocredit.ModuleName)).Return(nil).Times(1)
+
+	res, err := s.k.RegisterMarket(s.ctx, &marketplace.MsgRegisterMarket{
+		Registrant:       registrant.String(),
+		CreditTypeAbbrev: creditType.Abbreviation,
+		BankDenom:        registeredDenom,
+	})
+	assert.NilError(s.t, err)
+	return res.MarketId
+}
+
+func TestRegisterMarket(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, registrant := s.addrs[0], s.addrs[1]
+
+	deposit := s.enableMarketRegistration(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	marketId := s.registerMarket(registrant, deposit)
+
+	market, err := s.marketStore.MarketTable().Get(s.ctx, marketId)
+	assert.NilError(t, err)
+	assert.Equal(t, creditType.Abbreviation, market.CreditTypeAbbrev)
+	assert.Equal(t, registeredDenom, market.BankDenom)
+	assert.Equal(t, "usdc", market.DisplayDenom)
+	assert.Equal(t, uint32(6), market.Exponent)
+	assert.Check(t, registrant.Equals(sdk.AccAddress(market.Registrant)))
+	assert.Check(t, !market.Delisted)
+
+	// the registered denom can be used in sell orders without being allowed by governance
+	askPrice := sdk.NewInt64Coin(registeredDenom, 10)
+	sellOrderIds := s.createSellOrder(&marketplace.MsgSell{
+		Seller: seller.String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: "10", AskPrice: &askPrice},
+		},
+	})
+	sellOrder, err := s.marketStore.SellOrderTable().Get(s.ctx, sellOrderIds[0])
+	assert.NilError(t, err)
+	assert.Equal(t, marketId, sellOrder.MarketId)
+
+	// the registered denom cannot be used in other credit types
+	allowed, err := s.k.isDenomAllowed(s.ctx, "BIO", registeredDenom)
+	assert.NilError(t, err)
+	assert.Check(t, !allowed)
+
+	res, err := s.k.Markets(s.ctx, &marketplace.QueryMarketsRequest{})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(res.Markets))
+	assert.Equal(t, registrant.String(), res.Markets[1].Registrant)
+	assert.Equal(t, "usdc", res.Markets[1].DisplayDenom)
+}
+
+func TestRegisterMarket_Invalid(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 1)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	registrant := s.addrs[0]
+
+	msg := &marketplace.MsgRegisterMarket{
+		Registrant:       registrant.String(),
+		CreditTypeAbbrev: creditType.Abbreviation,
+		BankDenom:        registeredDenom,
+	}
+
+	_, err := s.k.RegisterMarket(s.ctx, msg)
+	assert.ErrorContains(t, err, "market registration is not enabled")
+
+	deposit := s.enableMarketRegistration(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+
+	_, err = s.k.RegisterMarket(s.ctx, &marketplace.MsgRegisterMarket{
+		Registrant:       registrant.String(),
+		CreditTypeAbbrev: "BIO",
+		BankDenom:        registeredDenom,
+	})
+	assert.ErrorContains(t, err, "could not get credit type with abbreviation BIO")
+
+	// a market was created for the credit type and the denom allowed by governance
+	_, err = s.k.RegisterMarket(s.ctx, &marketplace.MsgRegisterMarket{
+		Registrant:       registrant.String(),
+		CreditTypeAbbrev: creditType.Abbreviation,
+		BankDenom:        ask.Denom,
+	})
+	assert.ErrorContains(t, err, "market with id 1 already exists")
+
+	s.bankKeeper.EXPECT().GetDenomMetaData(gmAny, registeredDenom).Return(banktypes.Metadata{}, false).Times(1)
+	_, err = s.k.RegisterMarket(s.ctx, msg)
+	assert.ErrorContains(t, err, "denom metadata for "+registeredDenom+": not found")
+
+	noDisplay := banktypes.Metadata{Base: registeredDenom, Display: "usdc"}
+	s.bankKeeper.EXPECT().GetDenomMetaData(gmAny, registeredDenom).Return(noDisplay, true).Times(1)
+	_, err = s.k.RegisterMarket(s.ctx, msg)
+	assert.ErrorContains(t, err, "has no denom unit for display denom usdc")
+
+	s.registerMarket(registrant, deposit)
+
+	_, err = s.k.RegisterMarket(s.ctx, msg)
+	assert.ErrorContains(t, err, "market with id 2 already exists")
+}
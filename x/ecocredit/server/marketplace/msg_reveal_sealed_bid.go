@@ -0,0 +1,121 @@
+package marketplace
+
+import (
+	"bytes"
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// RevealSealedBid reveals a bid committed to a sealed-bid auction. The revealed bid must match the
+// commitment, the bid amount must be at least the reserve amount of the auction, and the deposit must cover
+// the bid amount for the quantity of the bid and the taker fee. Bids that are not revealed do not take part
+// in the settlement of the auction.
+func (k Keeper) RevealSealedBid(ctx context.Context, req *marketplace.MsgRevealSealedBid) (*marketplace.MsgRevealSealedBidResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	bidderAcc, err := sdk.AccAddressFromBech32(req.Bidder)
+	if err != nil {
+		return nil, err
+	}
+
+	auction, err := k.stateStore.SealedBidAuctionTable().Get(ctx, req.AuctionId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("sealed-bid auction with id %d: %s", req.AuctionId, err.Error())
+	}
+
+	height := sdkCtx.BlockHeight()
+	if height <= auction.CommitEndHeight || height > auction.RevealEndHeight {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"sealed-bid auction with id %d: bids can only be revealed from height %d to height %d",
+			auction.Id, auction.CommitEndHeight+1, auction.RevealEndHeight,
+		)
+	}
+
+	bid, err := k.stateStore.SealedBidTable().Get(ctx, auction.Id, bidderAcc)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("bid for sealed-bid auction with id %d: %s", auction.Id, err.Error())
+	}
+
+	if bid.Revealed {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("bid has already been revealed")
+	}
+
+	commitment := marketplace.SealedBidCommitment(auction.Id, req.Bidder, req.Quantity, req.BidAmount, req.Salt)
+	if !bytes.Equal(commitment, bid.Commitment) {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("revealed bid does not match commitment")
+	}
+
+	if req.DisableAutoRetire && !auction.DisableAutoRetire {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("cannot disable auto-retire for a sealed-bid auction with auto-retire enabled")
+	}
+
+	batch, err := k.coreStore.BatchTable().Get(ctx, auction.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+	creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.coreStore, batch.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	// check decimal places does not exceed credit type precision
+	bidQty, err := math.NewPositiveFixedDecFromString(req.Quantity, creditType.Precision)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"decimal places exceeds precision: quantity: %s, credit type precision: %d",
+			req.Quantity, creditType.Precision,
+		)
+	}
+
+	bidAmount, ok := sdk.NewIntFromString(req.BidAmount)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", req.BidAmount, sdk.Int{})
+	}
+	reserveAmount, ok := sdk.NewIntFromString(auction.ReserveAmount)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", auction.ReserveAmount, sdk.Int{})
+	}
+	if bidAmount.LT(reserveAmount) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"bid amount: %s, reserve amount: %s, insufficient bid amount", req.BidAmount, auction.ReserveAmount,
+		)
+	}
+
+	depositAmount, ok := sdk.NewIntFromString(bid.DepositAmount)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", bid.DepositAmount, sdk.Int{})
+	}
+	cost, err := getTotalCost(bidAmount, bidQty)
+	if err != nil {
+		return nil, err
+	}
+	if required := getEscrowAmount(cost, auction.TakerFeeBps); depositAmount.LT(required) {
+		return nil, sdkerrors.ErrInsufficientFunds.Wrapf(
+			"deposit amount: %s, required amount: %s", bid.DepositAmount, required,
+		)
+	}
+
+	bid.Revealed = true
+	bid.Quantity = req.Quantity
+	bid.BidAmount = req.BidAmount
+	bid.DisableAutoRetire = req.DisableAutoRetire
+	bid.RetirementJurisdiction = req.RetirementJurisdiction
+	if err = k.stateStore.SealedBidTable().Update(ctx, bid); err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventRevealSealedBid{
+		AuctionId: auction.Id,
+		Bidder:    req.Bidder,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &marketplace.MsgRevealSealedBidResponse{}, nil
+}
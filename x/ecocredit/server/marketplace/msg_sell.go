@@ -40,6 +40,10 @@ func (k Keeper) Sell(ctx context.Context, req *marketplace.MsgSell) (*marketplac
 			)
 		}
 
+		if err = utils.AssertBatchNotFrozen(batch); err != nil {
+			return nil, sdkerrors.Wrapf(err, "%s", orderIndex)
+		}
+
 		creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.coreStore, batch.Denom)
 		if err != nil {
 			return nil, err
@@ -62,11 +66,17 @@ func (k Keeper) Sell(ctx context.Context, req *marketplace.MsgSell) (*marketplac
 			return nil, err
 		}
 
+		if len(order.MinFillQuantity) != 0 {
+			if _, err = math.NewPositiveFixedDecFromString(order.MinFillQuantity, creditType.Precision); err != nil {
+				return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s: min fill quantity: %s", orderIndex, err)
+			}
+		}
+
 		if err = k.escrowCredits(ctx, orderIndex, sellerAcc, batch.Key, sellQty); err != nil {
 			return nil, err
 		}
 
-		allowed, err := isDenomAllowed(ctx, order.AskPrice.Denom, k.stateStore.AllowedDenomTable())
+		allowed, err := k.isDenomAllowed(ctx, creditType.Abbreviation, order.AskPrice.Denom)
 		if err != nil {
 			return nil, err
 		}
@@ -81,7 +91,24 @@ func (k Keeper) Sell(ctx context.Context, req *marketplace.MsgSell) (*marketplac
 			expiration = timestamppb.New(*order.Expiration)
 		}
 
-		id, err := k.stateStore.SellOrderTable().InsertReturningID(ctx, &marketApi.SellOrder{
+		var allowedBuyers [][]byte
+		for j, buyer := range order.AllowedBuyers {
+			buyerAcc, err := sdk.AccAddressFromBech32(buyer)
+			if err != nil {
+				return nil, sdkerrors.ErrInvalidAddress.Wrapf("%s: allowed buyers[%d]: %s", orderIndex, j, err)
+			}
+			allowedBuyers = append(allowedBuyers, buyerAcc)
+		}
+
+		var buyerGroupPolicy sdk.AccAddress
+		if len(order.BuyerGroupPolicy) != 0 {
+			buyerGroupPolicy, err = sdk.AccAddressFromBech32(order.BuyerGroupPolicy)
+			if err != nil {
+				return nil, sdkerrors.ErrInvalidAddress.Wrapf("%s: buyer group policy: %s", orderIndex, err)
+			}
+		}
+
+		sellOrder := &marketApi.SellOrder{
 			Seller:            sellerAcc,
 			BatchKey:          batch.Key,
 			Quantity:          order.Quantity,
@@ -90,10 +117,25 @@ func (k Keeper) Sell(ctx context.Context, req *marketplace.MsgSell) (*marketplac
 			DisableAutoRetire: order.DisableAutoRetire,
 			Expiration:        expiration,
 			Maker:             true, // maker is always true for sell orders
-		})
+			AllowedBuyers:     allowedBuyers,
+			BuyerGroupPolicy:  buyerGroupPolicy,
+			MinFillQuantity:   order.MinFillQuantity,
+			AllOrNone:         order.AllOrNone,
+		}
+
+		if err = k.setSellOrderKeys(ctx, sellOrder, batch); err != nil {
+			return nil, err
+		}
+
+		id, err := k.stateStore.SellOrderTable().InsertReturningID(ctx, sellOrder)
 		if err != nil {
 			return nil, err
 		}
+		sellOrder.Id = id
+
+		if err = k.orderBook.OnInsertSellOrder(ctx, sellOrder, batch); err != nil {
+			return nil, err
+		}
 
 		sellOrderIds[i] = id
 
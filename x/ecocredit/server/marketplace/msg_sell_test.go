@@ -396,6 +396,24 @@ func (s *sellSuite) ExpectSellOrderWithSellerAliceAndTheProperties(a gocuke.DocS
 	require.Equal(s.t, expected.Maker, order.Maker)
 }
 
+func (s *sellSuite) ExpectSellOrderWithIdAndAllowedBuyer(a string, b string) {
+	order := s.getSellOrder(a)
+
+	buyer, err := sdk.AccAddressFromBech32(b)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, [][]byte{buyer}, order.AllowedBuyers)
+}
+
+func (s *sellSuite) ExpectSellOrderWithIdAndBuyerGroupPolicy(a string, b string) {
+	order := s.getSellOrder(a)
+
+	policy, err := sdk.AccAddressFromBech32(b)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, policy.Bytes(), order.BuyerGroupPolicy)
+}
+
 func (s *sellSuite) ExpectTheResponse(a gocuke.DocString) {
 	res := &marketplace.MsgSellResponse{}
 	err := jsonpb.UnmarshalString(a.Content, res)
@@ -429,3 +447,13 @@ func (s *sellSuite) aliceTradableBatchBalance() {
 	})
 	require.NoError(s.t, err)
 }
+
+func (s *sellSuite) getSellOrder(id string) *api.SellOrder {
+	sellOrderId, err := strconv.ParseUint(id, 10, 64)
+	require.NoError(s.t, err)
+
+	order, err := s.marketStore.SellOrderTable().Get(s.ctx, sellOrderId)
+	require.NoError(s.t, err)
+
+	return order
+}
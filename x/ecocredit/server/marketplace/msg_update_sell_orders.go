@@ -41,6 +41,10 @@ func (k Keeper) UpdateSellOrders(ctx context.Context, req *marketplace.MsgUpdate
 			return nil, sdkerrors.ErrUnauthorized.Wrapf("%s: seller must be the seller of the sell order", updateIndex)
 		}
 
+		if utils.IsSellOrderExpired(sellOrder, sdk.UnwrapSDKContext(ctx).BlockTime()) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s: sell order with id %d has expired", updateIndex, sellOrder.Id)
+		}
+
 		if err = k.applySellOrderUpdates(ctx, updateIndex, sellOrder, update); err != nil {
 			return nil, err
 		}
@@ -62,7 +66,7 @@ func (k Keeper) applySellOrderUpdates(ctx context.Context, updateIndex string, o
 			return err
 		}
 
-		allowed, err := isDenomAllowed(ctx, update.NewAskPrice.Denom, k.stateStore.AllowedDenomTable())
+		allowed, err := k.isDenomAllowed(ctx, market.CreditTypeAbbrev, update.NewAskPrice.Denom)
 		if err != nil {
 			return err
 		}
@@ -86,6 +90,10 @@ func (k Keeper) applySellOrderUpdates(ctx context.Context, updateIndex string, o
 		}
 
 		order.AskAmount = update.NewAskPrice.Amount.String()
+		order.AskAmountKey, err = getAskAmountKey(order.AskAmount)
+		if err != nil {
+			return err
+		}
 	}
 
 	if update.NewExpiration != nil {
@@ -150,6 +158,18 @@ func (k Keeper) applySellOrderUpdates(ctx context.Context, updateIndex string, o
 		return err
 	}
 
+	// the updated sell order may no longer match the same buy orders
+	if err := k.orderBook.OnDeleteSellOrder(ctx, order); err != nil {
+		return err
+	}
+	batch, err := k.coreStore.BatchTable().Get(ctx, order.BatchKey)
+	if err != nil {
+		return err
+	}
+	if err := k.orderBook.OnInsertSellOrder(ctx, order, batch); err != nil {
+		return err
+	}
+
 	return sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventUpdateSellOrder{
 		SellOrderId: order.Id,
 	})
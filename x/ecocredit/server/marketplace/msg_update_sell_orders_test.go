@@ -423,14 +423,20 @@ func (s *updateSellOrdersSuite) sellOrderSetup(count int) {
 		totalQuantity = t.String()
 	}
 
-	err := s.coreStore.ClassTable().Insert(s.ctx, &coreapi.Class{
+	classKey, err := s.coreStore.ClassTable().InsertReturningID(s.ctx, &coreapi.Class{
 		Id:               s.classId,
 		CreditTypeAbbrev: s.creditTypeAbbrev,
 	})
 	require.NoError(s.t, err)
 
+	projectKey, err := s.coreStore.ProjectTable().InsertReturningID(s.ctx, &coreapi.Project{
+		ClassKey: classKey,
+	})
+	require.NoError(s.t, err)
+
 	batchKey, err := s.coreStore.BatchTable().InsertReturningID(s.ctx, &coreapi.Batch{
-		Denom: s.batchDenom,
+		ProjectKey: projectKey,
+		Denom:      s.batchDenom,
 	})
 	require.NoError(s.t, err)
 
@@ -0,0 +1,125 @@
+package marketplace
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	marketplaceapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// BuyOrderPruneLimit is the maximum number of expired buy orders that are pruned in a single block.
+// Expired buy orders that exceed the limit are pruned in the following blocks.
+const BuyOrderPruneLimit = 100
+
+// PruneBuyOrders is a BeginBlock function that returns escrowed funds to the buyer and deletes buy orders
+// that have expired. At most BuyOrderPruneLimit orders are pruned per block, starting with the orders that
+// expired first.
+func (k Keeper) PruneBuyOrders(ctx context.Context) error {
+	return k.pruneBuyOrders(ctx, BuyOrderPruneLimit)
+}
+
+// pruneBuyOrders prunes at most limit expired buy orders and emits an EventExpireBuyOrder for each pruned order.
+func (k Keeper) pruneBuyOrders(ctx context.Context, limit int) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	expired, err := k.getExpiredBuyOrders(ctx, sdkCtx.BlockTime(), limit)
+	if err != nil {
+		return err
+	}
+
+	for _, buyOrder := range expired {
+		if err = k.unescrowFunds(ctx, buyOrder); err != nil {
+			return err
+		}
+		if err = k.orderBook.OnDeleteBuyOrder(ctx, buyOrder); err != nil {
+			return err
+		}
+		if err = k.stateStore.BuyOrderTable().Delete(ctx, buyOrder); err != nil {
+			return err
+		}
+
+		if err = sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventExpireBuyOrder{
+			BuyOrderId: buyOrder.Id,
+			Buyer:      sdk.AccAddress(buyOrder.Buyer).String(),
+			Quantity:   buyOrder.Quantity,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getExpiredBuyOrders returns at most limit buy orders that expired before or at blockTime, starting with the
+// orders that expired first.
+func (k Keeper) getExpiredBuyOrders(ctx context.Context, blockTime time.Time, limit int) ([]*marketplaceapi.BuyOrder, error) {
+	// we set the min to 1 ns because nil expirations are encoded as the 0 value timestamp,
+	// and we DO NOT want those to be deleted/unescrowed.
+	// https://github.com/cosmos/cosmos-sdk/issues/11980
+	min, max := timestamppb.New(time.Unix(0, 1)), timestamppb.New(blockTime)
+	fromKey, toKey := marketplaceapi.BuyOrderExpirationIndexKey{}.WithExpiration(min), marketplaceapi.BuyOrderExpirationIndexKey{}.WithExpiration(max)
+
+	it, err := k.stateStore.BuyOrderTable().ListRange(ctx, fromKey, toKey)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var buyOrders []*marketplaceapi.BuyOrder
+	for len(buyOrders) < limit && it.Next() {
+		buyOrder, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		buyOrders = append(buyOrders, buyOrder)
+	}
+
+	return buyOrders, nil
+}
+
+// unescrowFunds sends the funds escrowed for the remaining quantity of the buy order, including the taker
+// fee, from the ecocredit module account back to the buyer.
+func (k Keeper) unescrowFunds(ctx context.Context, buyOrder *marketplaceapi.BuyOrder) error {
+	return k.returnEscrowedFunds(ctx, buyOrder.Buyer, buyOrder.MarketId, buyOrder.Quantity, buyOrder.BidAmount, buyOrder.TakerFeeBps)
+}
+
+// returnEscrowedFunds sends the funds escrowed for the quantity at the bid amount, including the fee in basis
+// points, from the ecocredit module account back to the buyer.
+func (k Keeper) returnEscrowedFunds(ctx context.Context, buyer sdk.AccAddress, marketId uint64, quantity, bidAmount string,
+	feeBps uint32) error {
+	market, err := k.stateStore.MarketTable().Get(ctx, marketId)
+	if err != nil {
+		return err
+	}
+
+	qty, err := math.NewDecFromString(quantity)
+	if err != nil {
+		return err
+	}
+
+	bid, ok := sdk.NewIntFromString(bidAmount)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", bidAmount, sdk.Int{})
+	}
+
+	cost, err := getTotalCost(bid, qty)
+	if err != nil {
+		return err
+	}
+	escrowed := getEscrowAmount(cost, feeBps)
+	if !escrowed.IsPositive() {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	coins := sdk.NewCoins(sdk.NewCoin(market.BankDenom, escrowed))
+	return k.bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, ecocredit.ModuleName, buyer, coins)
+}
@@ -0,0 +1,126 @@
+package marketplace
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func TestBuy_Prune(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	buyer := s.addrs[1]
+
+	blockTime, err := types.ParseDate("block time", "2020-01-01")
+	assert.NilError(t, err)
+	expired, err := types.ParseDate("expiration", "2019-12-30")
+	assert.NilError(t, err)
+	notExpired, err := types.ParseDate("expiration", "2022-01-01")
+	assert.NilError(t, err)
+
+	s.expectEscrow(buyer, 100)
+	s.expectEscrow(buyer, 100)
+	s.expectEscrow(buyer, 100)
+	res, err := s.k.Buy(s.ctx, &marketplace.MsgBuy{
+		Buyer: buyer.String(),
+		Orders: []*marketplace.MsgBuy_Order{
+			{Filter: batchFilter(batchDenom), Quantity: "10", BidPrice: &ask, DisableAutoRetire: true, Expiration: &expired},
+			{Filter: batchFilter(batchDenom), Quantity: "10", BidPrice: &ask, DisableAutoRetire: true, Expiration: &notExpired},
+			{Filter: batchFilter(batchDenom), Quantity: "10", BidPrice: &ask, DisableAutoRetire: true},
+		},
+	})
+	assert.NilError(t, err)
+
+	// setup block time so the orders expire
+	s.sdkCtx = s.sdkCtx.WithBlockTime(blockTime)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+
+	// the escrowed funds of the expired buy order are returned to the buyer
+	s.expectRelease(buyer, 100)
+	assert.NilError(t, s.k.PruneBuyOrders(s.ctx))
+
+	_, err = s.marketStore.BuyOrderTable().Get(s.ctx, res.BuyOrderIds[0])
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	_, err = s.marketStore.BuyOrderTable().Get(s.ctx, res.BuyOrderIds[1])
+	assert.NilError(t, err)
+
+	_, err = s.marketStore.BuyOrderTable().Get(s.ctx, res.BuyOrderIds[2])
+	assert.NilError(t, err)
+}
+
+func TestBuy_PruneLimit(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	buyer := s.addrs[1]
+
+	blockTime, err := types.ParseDate("block time", "2020-01-01")
+	assert.NilError(t, err)
+	expiredFirst, err := types.ParseDate("expiration", "2019-12-29")
+	assert.NilError(t, err)
+	expired, err := types.ParseDate("expiration", "2019-12-30")
+	assert.NilError(t, err)
+
+	for i := 0; i < 3; i++ {
+		s.expectEscrow(buyer, 100)
+	}
+	res, err := s.k.Buy(s.ctx, &marketplace.MsgBuy{
+		Buyer: buyer.String(),
+		Orders: []*marketplace.MsgBuy_Order{
+			{Filter: batchFilter(batchDenom), Quantity: "10", BidPrice: &ask, DisableAutoRetire: true, Expiration: &expired},
+			{Filter: batchFilter(batchDenom), Quantity: "10", BidPrice: &ask, DisableAutoRetire: true, Expiration: &expired},
+			{Filter: batchFilter(batchDenom), Quantity: "10", BidPrice: &ask, DisableAutoRetire: true, Expiration: &expiredFirst},
+		},
+	})
+	assert.NilError(t, err)
+	ids := res.BuyOrderIds
+
+	s.sdkCtx = s.sdkCtx.WithBlockTime(blockTime).WithEventManager(sdk.NewEventManager())
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+
+	// the orders that expired first are pruned first
+	s.expectRelease(buyer, 100)
+	s.expectRelease(buyer, 100)
+	assert.NilError(t, s.k.pruneBuyOrders(s.ctx, 2))
+	assert.DeepEqual(t, []uint64{ids[2], ids[0]}, expiredBuyOrderEvents(t, s.sdkCtx))
+
+	found, err := s.marketStore.BuyOrderTable().Has(s.ctx, ids[1])
+	assert.NilError(t, err)
+	assert.Assert(t, found)
+
+	// the remaining expired orders are pruned in the next block
+	s.sdkCtx = s.sdkCtx.WithEventManager(sdk.NewEventManager())
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+	s.expectRelease(buyer, 100)
+	assert.NilError(t, s.k.pruneBuyOrders(s.ctx, 2))
+	assert.DeepEqual(t, []uint64{ids[1]}, expiredBuyOrderEvents(t, s.sdkCtx))
+
+	found, err = s.marketStore.BuyOrderTable().Has(s.ctx, ids[1])
+	assert.NilError(t, err)
+	assert.Assert(t, !found)
+}
+
+func expiredBuyOrderEvents(t *testing.T, ctx sdk.Context) []uint64 {
+	var ids []uint64
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != proto.MessageName(&marketplace.EventExpireBuyOrder{}) {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		assert.NilError(t, err)
+		expired := msg.(*marketplace.EventExpireBuyOrder)
+		assert.Equal(t, "10", expired.Quantity)
+		ids = append(ids, expired.BuyOrderId)
+	}
+	return ids
+}
@@ -0,0 +1,99 @@
+package marketplace
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// PruneForwardSales is a BeginBlock function that returns the escrowed funds for credits that were not
+// delivered to the buyers of forward contracts and deletes forward sales that have reached their delivery
+// deadline along with their forward contracts.
+func (k Keeper) PruneForwardSales(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	min, blockTime := timestamppb.New(time.Unix(0, 0)), timestamppb.New(sdkCtx.BlockTime())
+	fromKey := api.ForwardSaleDeliveryDeadlineIndexKey{}.WithDeliveryDeadline(min)
+	toKey := api.ForwardSaleDeliveryDeadlineIndexKey{}.WithDeliveryDeadline(blockTime)
+
+	it, err := k.stateStore.ForwardSaleTable().ListRange(ctx, fromKey, toKey)
+	if err != nil {
+		return err
+	}
+
+	var sales []*api.ForwardSale
+	for it.Next() {
+		sale, err := it.Value()
+		if err != nil {
+			return err
+		}
+		sales = append(sales, sale)
+	}
+	it.Close()
+
+	for _, sale := range sales {
+		if err = k.refundForwardContracts(ctx, sale); err != nil {
+			return err
+		}
+	}
+
+	return k.stateStore.ForwardSaleTable().DeleteRange(ctx, fromKey, toKey)
+}
+
+// refundForwardContracts returns the escrowed funds of all forward contracts of the forward sale to the
+// buyers and deletes the forward contracts.
+func (k Keeper) refundForwardContracts(ctx context.Context, sale *api.ForwardSale) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	market, err := k.stateStore.MarketTable().Get(ctx, sale.MarketId)
+	if err != nil {
+		return err
+	}
+
+	indexKey := api.ForwardContractForwardSaleIdIndexKey{}.WithForwardSaleId(sale.Id)
+	it, err := k.stateStore.ForwardContractTable().List(ctx, indexKey)
+	if err != nil {
+		return err
+	}
+
+	var contracts []*api.ForwardContract
+	for it.Next() {
+		contract, err := it.Value()
+		if err != nil {
+			return err
+		}
+		contracts = append(contracts, contract)
+	}
+	it.Close()
+
+	for _, contract := range contracts {
+		escrowAmount, ok := sdk.NewIntFromString(contract.EscrowAmount)
+		if !ok {
+			return sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", contract.EscrowAmount, sdk.Int{})
+		}
+		refund := sdk.NewCoin(market.BankDenom, escrowAmount)
+		if escrowAmount.IsPositive() {
+			if err = k.bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, ecocredit.ModuleName, contract.Buyer, sdk.NewCoins(refund)); err != nil {
+				return err
+			}
+		}
+
+		if err = sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventRefundForwardContract{
+			ForwardContractId: contract.Id,
+			Quantity:          contract.Quantity,
+			Refund:            &refund,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return k.stateStore.ForwardContractTable().DeleteBy(ctx, indexKey)
+}
@@ -0,0 +1,47 @@
+package marketplace
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+)
+
+// PruneOffers is a BeginBlock function that returns escrowed funds to the buyer and deletes offers that
+// have expired.
+func (k Keeper) PruneOffers(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// we set the min to 1 ns because nil expirations are encoded as the 0 value timestamp,
+	// and we DO NOT want those to be deleted/unescrowed.
+	// https://github.com/cosmos/cosmos-sdk/issues/11980
+	min, blockTime := timestamppb.New(time.Unix(0, 1)), timestamppb.New(sdkCtx.BlockTime())
+	fromKey, toKey := api.OfferExpirationIndexKey{}.WithExpiration(min), api.OfferExpirationIndexKey{}.WithExpiration(blockTime)
+
+	it, err := k.stateStore.OfferTable().ListRange(ctx, fromKey, toKey)
+	if err != nil {
+		return err
+	}
+
+	var offers []*api.Offer
+	for it.Next() {
+		offer, err := it.Value()
+		if err != nil {
+			return err
+		}
+		offers = append(offers, offer)
+	}
+	it.Close()
+
+	for _, offer := range offers {
+		if err = k.returnEscrowedFunds(ctx, offer.Buyer, offer.MarketId, offer.Quantity, offer.BidAmount, offer.MakerFeeBps); err != nil {
+			return err
+		}
+	}
+
+	return k.stateStore.OfferTable().DeleteRange(ctx, fromKey, toKey)
+}
@@ -0,0 +1,60 @@
+package marketplace
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func TestOffer_Prune(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	buyer := s.addrs[1]
+
+	blockTime, err := types.ParseDate("block time", "2020-01-01")
+	assert.NilError(t, err)
+	expired, err := types.ParseDate("expiration", "2019-12-30")
+	assert.NilError(t, err)
+	notExpired, err := types.ParseDate("expiration", "2022-01-01")
+	assert.NilError(t, err)
+
+	ids := make([]uint64, 0, 3)
+	for _, expiration := range []*time.Time{&expired, &notExpired, nil} {
+		s.expectEscrow(buyer, 100)
+		res, err := s.k.MakeOffer(s.ctx, &marketplace.MsgMakeOffer{
+			Buyer:             buyer.String(),
+			BatchDenom:        batchDenom,
+			Quantity:          "10",
+			BidPrice:          &ask,
+			DisableAutoRetire: true,
+			Expiration:        expiration,
+		})
+		assert.NilError(t, err)
+		ids = append(ids, res.OfferId)
+	}
+
+	// setup block time so the offers expire
+	s.sdkCtx = s.sdkCtx.WithBlockTime(blockTime)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+
+	// the escrowed funds of the expired offer are returned to the buyer
+	s.expectRelease(buyer, 100)
+	assert.NilError(t, s.k.PruneOffers(s.ctx))
+
+	_, err = s.marketStore.OfferTable().Get(s.ctx, ids[0])
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	_, err = s.marketStore.OfferTable().Get(s.ctx, ids[1])
+	assert.NilError(t, err)
+
+	_, err = s.marketStore.OfferTable().Get(s.ctx, ids[2])
+	assert.NilError(t, err)
+}
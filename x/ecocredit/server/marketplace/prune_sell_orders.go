@@ -10,11 +10,21 @@ import (
 
 	marketplaceapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
 	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
 )
 
+// SellOrderPruneLimit is the maximum number of expired sell orders that are pruned in a single block.
+// Expired sell orders that exceed the limit are pruned in the following blocks.
+const SellOrderPruneLimit = 100
+
 // PruneSellOrders is a BeginBlock function that moves escrowed credits back into their tradable balance and deletes orders
-// that have expired.
+// that have expired. At most SellOrderPruneLimit orders are pruned per block, starting with the orders that expired first.
 func (k Keeper) PruneSellOrders(ctx context.Context) error {
+	return k.pruneSellOrders(ctx, SellOrderPruneLimit)
+}
+
+// pruneSellOrders prunes at most limit expired sell orders and emits an EventExpireSellOrder for each pruned order.
+func (k Keeper) pruneSellOrders(ctx context.Context, limit int) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
 	// we set the min to 1 ns because nil expirations are encoded as the 0 value timestamp,
@@ -27,19 +37,45 @@ func (k Keeper) PruneSellOrders(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	for it.Next() {
+
+	var expired []*marketplaceapi.SellOrder
+	for len(expired) < limit && it.Next() {
 		sellOrder, err := it.Value()
 		if err != nil {
+			it.Close()
 			return err
 		}
+		expired = append(expired, sellOrder)
+	}
+	it.Close()
+
+	for _, sellOrder := range expired {
 		if err = k.unescrowCredits(ctx, sellOrder.Seller, sellOrder.BatchKey, sellOrder.Quantity); err != nil {
 			return err
 		}
+		if err = k.orderBook.OnDeleteSellOrder(ctx, sellOrder); err != nil {
+			return err
+		}
+		if err = k.stateStore.SellOrderTable().Delete(ctx, sellOrder); err != nil {
+			return err
+		}
 
+		batch, err := k.coreStore.BatchTable().Get(ctx, sellOrder.BatchKey)
+		if err != nil {
+			return err
+		}
+
+		if err = sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventExpireSellOrder{
+			SellOrderId: sellOrder.Id,
+			Seller:      sdk.AccAddress(sellOrder.Seller).String(),
+			BatchDenom:  batch.Denom,
+			Quantity:    sellOrder.Quantity,
+		}); err != nil {
+			return err
+		}
 	}
-	it.Close()
 
-	return k.stateStore.SellOrderTable().DeleteRange(ctx, fromKey, toKey)
+	return nil
 }
 
 // unescrowCredits moves `amount` of credits from the sellerAddr's escrowed balance, into their tradable balance.
@@ -1,8 +1,11 @@
 package marketplace
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
 	"gotest.tools/v3/assert"
 
 	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
@@ -100,3 +103,90 @@ func TestPrune_NilExpiration(t *testing.T) {
 	_, err = s.marketStore.SellOrderTable().Get(s.ctx, shouldNotExistOrder)
 	assert.ErrorIs(t, err, ormerrors.NotFound)
 }
+
+func TestPrune_Limit(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+
+	blockTime, err := types.ParseDate("block time", "2020-01-01")
+	assert.NilError(t, err)
+	expiredFirst, err := types.ParseDate("expiration", "2019-12-01")
+	assert.NilError(t, err)
+	expired, err := types.ParseDate("expiration", "2019-12-30")
+	assert.NilError(t, err)
+	notExpired, err := types.ParseDate("expiration", "2022-01-01")
+	assert.NilError(t, err)
+
+	ids := s.createSellOrder(&marketplace.MsgSell{
+		Seller: s.addrs[0].String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: "10", AskPrice: &ask, Expiration: &expired},
+			{BatchDenom: batchDenom, Quantity: "10", AskPrice: &ask, Expiration: &expired},
+			{BatchDenom: batchDenom, Quantity: "10", AskPrice: &ask, Expiration: &expiredFirst},
+			{BatchDenom: batchDenom, Quantity: "10", AskPrice: &ask, Expiration: &notExpired},
+		},
+	})
+
+	s.sdkCtx = s.sdkCtx.WithBlockTime(blockTime).WithEventManager(sdk.NewEventManager())
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+
+	// expired sell orders cannot be bought or updated before they are pruned
+	_, err = s.k.BuyDirect(s.ctx, &marketplace.MsgBuyDirect{
+		Buyer: s.addrs[1].String(),
+		Orders: []*marketplace.MsgBuyDirect_Order{
+			{SellOrderId: ids[0], Quantity: "1", BidPrice: &ask, RetirementJurisdiction: "US-WA"},
+		},
+	})
+	assert.ErrorContains(t, err, fmt.Sprintf("sell order with id %d has expired", ids[0]))
+
+	_, err = s.k.UpdateSellOrders(s.ctx, &marketplace.MsgUpdateSellOrders{
+		Seller: s.addrs[0].String(),
+		Updates: []*marketplace.MsgUpdateSellOrders_Update{
+			{SellOrderId: ids[0], NewQuantity: "5"},
+		},
+	})
+	assert.ErrorContains(t, err, fmt.Sprintf("sell order with id %d has expired", ids[0]))
+
+	// the orders that expired first are pruned first
+	assert.NilError(t, s.k.pruneSellOrders(s.ctx, 2))
+	assert.DeepEqual(t, []uint64{ids[2], ids[0]}, expiredSellOrderEvents(t, s.sdkCtx))
+	assertSellOrderExists(t, s, ids[1], true)
+
+	// the remaining expired orders are pruned in the next block
+	s.sdkCtx = s.sdkCtx.WithEventManager(sdk.NewEventManager())
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+	assert.NilError(t, s.k.pruneSellOrders(s.ctx, 2))
+	assert.DeepEqual(t, []uint64{ids[1]}, expiredSellOrderEvents(t, s.sdkCtx))
+
+	for i, id := range ids {
+		assertSellOrderExists(t, s, id, i == 3)
+	}
+
+	bal, err := s.coreStore.BatchBalanceTable().Get(s.ctx, s.addrs[0], 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "90", bal.TradableAmount)
+	assert.Equal(t, "10", bal.EscrowedAmount)
+}
+
+func expiredSellOrderEvents(t *testing.T, ctx sdk.Context) []uint64 {
+	var ids []uint64
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != proto.MessageName(&marketplace.EventExpireSellOrder{}) {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		assert.NilError(t, err)
+		expired := msg.(*marketplace.EventExpireSellOrder)
+		assert.Equal(t, batchDenom, expired.BatchDenom)
+		assert.Equal(t, "10", expired.Quantity)
+		ids = append(ids, expired.SellOrderId)
+	}
+	return ids
+}
+
+func assertSellOrderExists(t *testing.T, s *baseSuite, id uint64, exists bool) {
+	found, err := s.marketStore.SellOrderTable().Has(s.ctx, id)
+	assert.NilError(t, err)
+	assert.Equal(t, exists, found)
+}
@@ -0,0 +1,118 @@
+package marketplace
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func TestQuery_DutchAuctions(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 1)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller := s.addrs[0]
+
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.sdkCtx = s.sdkCtx.WithBlockTime(startTime)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+
+	startPrice, floorPrice := sdk.NewInt64Coin(ask.Denom, 100), sdk.NewInt64Coin(ask.Denom, 40)
+	for _, qty := range []string{"10", "20"} {
+		_, err := s.k.CreateDutchAuction(s.ctx, &marketplace.MsgCreateDutchAuction{
+			Seller:     seller.String(),
+			BatchDenom: batchDenom,
+			Quantity:   qty,
+			StartPrice: &startPrice,
+			FloorPrice: &floorPrice,
+			Duration:   time.Hour * 10,
+		})
+		require.NoError(t, err)
+	}
+
+	// the current amount is the ask price at the current block time
+	s.sdkCtx = s.sdkCtx.WithBlockTime(startTime.Add(time.Hour * 5))
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+
+	res, err := s.k.DutchAuction(s.ctx, &marketplace.QueryDutchAuctionRequest{AuctionId: 1})
+	require.NoError(t, err)
+	require.Equal(t, seller.String(), res.Auction.Seller)
+	require.Equal(t, batchDenom, res.Auction.BatchDenom)
+	require.Equal(t, "10", res.Auction.Quantity)
+	require.Equal(t, ask.Denom, res.Auction.AskDenom)
+	require.Equal(t, "100", res.Auction.StartAmount)
+	require.Equal(t, "40", res.Auction.FloorAmount)
+	require.Equal(t, "70", res.Auction.CurrentAmount)
+	require.Equal(t, startTime.Unix(), res.Auction.StartTime.Seconds)
+
+	_, err = s.k.DutchAuction(s.ctx, &marketplace.QueryDutchAuctionRequest{AuctionId: 3})
+	require.ErrorContains(t, err, "could not get dutch auction with id 3")
+
+	allRes, err := s.k.DutchAuctions(s.ctx, &marketplace.QueryDutchAuctionsRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, allRes.Auctions, 1)
+	require.Equal(t, uint64(2), allRes.Pagination.Total)
+}
+
+func TestQuery_SealedBidAuctions(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 3)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, bidder1, bidder2 := s.addrs[0], s.addrs[1], s.addrs[2]
+	s.setCreditTypeFees(100, 200)
+
+	s.setBlockHeight(1)
+	id := s.createSealedBidAuction(seller, "50", 10)
+	s.createSealedBidAuction(seller, "5", 10)
+	s.commitSealedBid(id, bidder1, "10", "12", 200)
+	s.commitSealedBid(id, bidder2, "10", "15", 200)
+
+	res, err := s.k.SealedBidAuction(s.ctx, &marketplace.QuerySealedBidAuctionRequest{AuctionId: id})
+	require.NoError(t, err)
+	require.Equal(t, seller.String(), res.Auction.Seller)
+	require.Equal(t, batchDenom, res.Auction.BatchDenom)
+	require.Equal(t, "50", res.Auction.Quantity)
+	require.Equal(t, ask.Denom, res.Auction.BidDenom)
+	require.Equal(t, "10", res.Auction.ReserveAmount)
+	require.Equal(t, int64(10), res.Auction.CommitEndHeight)
+	require.Equal(t, int64(20), res.Auction.RevealEndHeight)
+	require.Equal(t, uint32(100), res.Auction.MakerFeeBps)
+	require.Equal(t, uint32(200), res.Auction.TakerFeeBps)
+
+	allRes, err := s.k.SealedBidAuctions(s.ctx, &marketplace.QuerySealedBidAuctionsRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, allRes.Auctions, 1)
+	require.Equal(t, uint64(2), allRes.Pagination.Total)
+
+	// the quantity and bid amount are only included once the bid is revealed
+	s.setBlockHeight(11)
+	require.NoError(t, s.revealSealedBid(id, bidder1, "10", "12"))
+
+	bidsRes, err := s.k.SealedBids(s.ctx, &marketplace.QuerySealedBidsRequest{AuctionId: id})
+	require.NoError(t, err)
+	require.Len(t, bidsRes.Bids, 2)
+	for _, bid := range bidsRes.Bids {
+		require.Equal(t, "200", bid.DepositAmount)
+		if bid.Bidder == bidder1.String() {
+			require.True(t, bid.Revealed)
+			require.Equal(t, "10", bid.Quantity)
+			require.Equal(t, "12", bid.BidAmount)
+		} else {
+			require.False(t, bid.Revealed)
+			require.Empty(t, bid.Quantity)
+			require.Empty(t, bid.BidAmount)
+		}
+	}
+
+	_, err = s.k.SealedBids(s.ctx, &marketplace.QuerySealedBidsRequest{AuctionId: 3})
+	require.ErrorContains(t, err, "could not get sealed-bid auction with id 3")
+}
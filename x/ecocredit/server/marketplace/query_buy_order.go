@@ -0,0 +1,54 @@
+package marketplace
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// BuyOrder queries a buy order by its unique identifier.
+func (k Keeper) BuyOrder(ctx context.Context, req *marketplace.QueryBuyOrderRequest) (*marketplace.QueryBuyOrderResponse, error) {
+	order, err := k.stateStore.BuyOrderTable().Get(ctx, req.BuyOrderId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get buy order with id %d: %s", req.BuyOrderId, err.Error())
+	}
+
+	info, err := k.getBuyOrderInfo(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QueryBuyOrderResponse{BuyOrder: info}, nil
+}
+
+// getBuyOrderInfo converts a buy order from state into the buy order info returned by queries.
+func (k Keeper) getBuyOrderInfo(ctx context.Context, order *api.BuyOrder) (*marketplace.BuyOrderInfo, error) {
+	market, err := k.stateStore.MarketTable().Get(ctx, order.MarketId)
+	if err != nil {
+		return nil, err
+	}
+
+	var filter marketplace.BuyOrder_Filter
+	if err := ormutil.PulsarToGogoSlow(order.Filter, &filter); err != nil {
+		return nil, err
+	}
+
+	return &marketplace.BuyOrderInfo{
+		Id:                     order.Id,
+		Buyer:                  sdk.AccAddress(order.Buyer).String(),
+		Filter:                 &filter,
+		Quantity:               order.Quantity,
+		BidDenom:               market.BankDenom,
+		BidAmount:              order.BidAmount,
+		DisableAutoRetire:      order.DisableAutoRetire,
+		RetirementJurisdiction: order.RetirementJurisdiction,
+		Expiration:             types.ProtobufToGogoTimestamp(order.Expiration),
+		TakerFeeBps:            order.TakerFeeBps,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package marketplace
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// BuyOrders queries all buy orders in state with optional pagination
+func (k Keeper) BuyOrders(ctx context.Context, req *marketplace.QueryBuyOrdersRequest) (*marketplace.QueryBuyOrdersResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.BuyOrderTable().List(ctx, api.BuyOrderIdIndexKey{}, ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	orders := make([]*marketplace.BuyOrderInfo, 0, 10)
+	for it.Next() {
+		order, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := k.getBuyOrderInfo(ctx, order)
+		if err != nil {
+			return nil, err
+		}
+
+		orders = append(orders, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QueryBuyOrdersResponse{BuyOrders: orders, Pagination: pr}, nil
+}
@@ -0,0 +1,53 @@
+package marketplace
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// BuyOrdersByBuyer queries all buy orders created by the given address with optional pagination
+func (k Keeper) BuyOrdersByBuyer(ctx context.Context, req *marketplace.QueryBuyOrdersByBuyerRequest) (*marketplace.QueryBuyOrdersByBuyerResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	buyer, err := sdk.AccAddressFromBech32(req.Buyer)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.BuyOrderTable().List(ctx, api.BuyOrderBuyerIndexKey{}.WithBuyer(buyer), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	orders := make([]*marketplace.BuyOrderInfo, 0, 10)
+	for it.Next() {
+		order, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := k.getBuyOrderInfo(ctx, order)
+		if err != nil {
+			return nil, err
+		}
+
+		orders = append(orders, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QueryBuyOrdersByBuyerResponse{BuyOrders: orders, Pagination: pr}, nil
+}
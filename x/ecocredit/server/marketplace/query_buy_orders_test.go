@@ -0,0 +1,90 @@
+package marketplace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func TestQuery_BuyOrders(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 3)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	buyer1, buyer2 := s.addrs[1], s.addrs[2]
+
+	expiration, err := types.ParseDate("expiration", "2030-01-01")
+	require.NoError(t, err)
+
+	s.expectEscrow(buyer1, 100)
+	s.expectEscrow(buyer1, 50)
+	res1, err := s.k.Buy(s.ctx, &marketplace.MsgBuy{
+		Buyer: buyer1.String(),
+		Orders: []*marketplace.MsgBuy_Order{
+			{Filter: batchFilter(batchDenom), Quantity: "10", BidPrice: &ask, RetirementJurisdiction: "US-WA", Expiration: &expiration},
+			{Filter: classFilter("C01", "US"), Quantity: "5", BidPrice: &ask, DisableAutoRetire: true},
+		},
+	})
+	require.NoError(t, err)
+
+	s.expectEscrow(buyer2, 10)
+	res2, err := s.k.Buy(s.ctx, &marketplace.MsgBuy{
+		Buyer: buyer2.String(),
+		Orders: []*marketplace.MsgBuy_Order{
+			{Filter: batchFilter(batchDenom), Quantity: "1", BidPrice: &ask, DisableAutoRetire: true},
+		},
+	})
+	require.NoError(t, err)
+
+	// query buy order by id
+	res, err := s.k.BuyOrder(s.ctx, &marketplace.QueryBuyOrderRequest{BuyOrderId: res1.BuyOrderIds[0]})
+	require.NoError(t, err)
+	require.Equal(t, res1.BuyOrderIds[0], res.BuyOrder.Id)
+	require.Equal(t, buyer1.String(), res.BuyOrder.Buyer)
+	require.Equal(t, batchFilter(batchDenom), res.BuyOrder.Filter)
+	require.Equal(t, "10", res.BuyOrder.Quantity)
+	require.Equal(t, ask.Denom, res.BuyOrder.BidDenom)
+	require.Equal(t, ask.Amount.String(), res.BuyOrder.BidAmount)
+	require.Equal(t, false, res.BuyOrder.DisableAutoRetire)
+	require.Equal(t, "US-WA", res.BuyOrder.RetirementJurisdiction)
+	require.Equal(t, expiration.Unix(), res.BuyOrder.Expiration.Seconds)
+
+	_, err = s.k.BuyOrder(s.ctx, &marketplace.QueryBuyOrderRequest{BuyOrderId: 4})
+	require.ErrorContains(t, err, "could not get buy order with id 4")
+
+	// query all buy orders
+	allRes, err := s.k.BuyOrders(s.ctx, &marketplace.QueryBuyOrdersRequest{
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, allRes.BuyOrders, 3)
+	require.Equal(t, uint64(3), allRes.Pagination.Total)
+	require.Equal(t, classFilter("C01", "US"), allRes.BuyOrders[1].Filter)
+
+	allRes, err = s.k.BuyOrders(s.ctx, &marketplace.QueryBuyOrdersRequest{
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, allRes.BuyOrders, 1)
+
+	// query buy orders by buyer
+	buyerRes, err := s.k.BuyOrdersByBuyer(s.ctx, &marketplace.QueryBuyOrdersByBuyerRequest{
+		Buyer:      buyer1.String(),
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, buyerRes.BuyOrders, 2)
+	require.Equal(t, uint64(2), buyerRes.Pagination.Total)
+
+	buyerRes, err = s.k.BuyOrdersByBuyer(s.ctx, &marketplace.QueryBuyOrdersByBuyerRequest{Buyer: buyer2.String()})
+	require.NoError(t, err)
+	require.Len(t, buyerRes.BuyOrders, 1)
+	require.Equal(t, res2.BuyOrderIds[0], buyerRes.BuyOrders[0].Id)
+
+	_, err = s.k.BuyOrdersByBuyer(s.ctx, &marketplace.QueryBuyOrdersByBuyerRequest{Buyer: "foo"})
+	require.Error(t, err)
+}
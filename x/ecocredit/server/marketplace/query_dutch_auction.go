@@ -0,0 +1,60 @@
+package marketplace
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// DutchAuction queries a dutch auction by its unique identifier.
+func (k Keeper) DutchAuction(ctx context.Context, req *marketplace.QueryDutchAuctionRequest) (*marketplace.QueryDutchAuctionResponse, error) {
+	auction, err := k.stateStore.DutchAuctionTable().Get(ctx, req.AuctionId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get dutch auction with id %d: %s", req.AuctionId, err.Error())
+	}
+
+	info, err := k.getDutchAuctionInfo(ctx, auction)
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QueryDutchAuctionResponse{Auction: info}, nil
+}
+
+// getDutchAuctionInfo converts a dutch auction from state into the dutch auction info returned by queries.
+// The current amount is the ask amount at the current block time.
+func (k Keeper) getDutchAuctionInfo(ctx context.Context, auction *api.DutchAuction) (*marketplace.DutchAuctionInfo, error) {
+	market, err := k.stateStore.MarketTable().Get(ctx, auction.MarketId)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := k.coreStore.BatchTable().Get(ctx, auction.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+
+	currentAmount, err := getDutchAuctionPrice(auction, sdk.UnwrapSDKContext(ctx).BlockTime())
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.DutchAuctionInfo{
+		Id:                auction.Id,
+		Seller:            sdk.AccAddress(auction.Seller).String(),
+		BatchDenom:        batch.Denom,
+		Quantity:          auction.Quantity,
+		AskDenom:          market.BankDenom,
+		StartAmount:       auction.StartAmount,
+		FloorAmount:       auction.FloorAmount,
+		CurrentAmount:     currentAmount.String(),
+		StartTime:         types.ProtobufToGogoTimestamp(auction.StartTime),
+		EndTime:           types.ProtobufToGogoTimestamp(auction.EndTime),
+		DisableAutoRetire: auction.DisableAutoRetire,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package marketplace
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// DutchAuctions queries all dutch auctions with optional pagination
+func (k Keeper) DutchAuctions(ctx context.Context, req *marketplace.QueryDutchAuctionsRequest) (*marketplace.QueryDutchAuctionsResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.DutchAuctionTable().List(ctx, api.DutchAuctionIdIndexKey{}, ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	auctions := make([]*marketplace.DutchAuctionInfo, 0, 10)
+	for it.Next() {
+		auction, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := k.getDutchAuctionInfo(ctx, auction)
+		if err != nil {
+			return nil, err
+		}
+
+		auctions = append(auctions, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QueryDutchAuctionsResponse{Auctions: auctions, Pagination: pr}, nil
+}
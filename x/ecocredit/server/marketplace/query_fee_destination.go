@@ -0,0 +1,27 @@
+package marketplace
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// FeeDestination queries the destination of marketplace fees.
+func (k Keeper) FeeDestination(ctx context.Context, _ *marketplace.QueryFeeDestinationRequest) (*marketplace.QueryFeeDestinationResponse, error) {
+	dest, err := k.stateStore.FeeDestinationTable().Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var address string
+	if len(dest.Address) != 0 {
+		address = sdk.AccAddress(dest.Address).String()
+	}
+
+	return &marketplace.QueryFeeDestinationResponse{
+		Destination: marketplace.FeeDestinationType(dest.Destination),
+		Address:     address,
+	}, nil
+}
@@ -0,0 +1,31 @@
+package marketplace
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func TestQueryFeeDestination(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 1)
+
+	// fees are sent to the community pool by default
+	res, err := s.k.FeeDestination(s.ctx, &marketplace.QueryFeeDestinationRequest{})
+	assert.NilError(t, err)
+	assert.Equal(t, marketplace.FeeDestinationType_FEE_DESTINATION_TYPE_UNSPECIFIED, res.Destination)
+	assert.Equal(t, "", res.Address)
+
+	assert.NilError(t, s.marketStore.FeeDestinationTable().Save(s.ctx, &api.FeeDestination{
+		Destination: api.FeeDestinationType_FEE_DESTINATION_TYPE_ACCOUNT,
+		Address:     s.addrs[0],
+	}))
+
+	res, err = s.k.FeeDestination(s.ctx, &marketplace.QueryFeeDestinationRequest{})
+	assert.NilError(t, err)
+	assert.Equal(t, marketplace.FeeDestinationType_FEE_DESTINATION_TYPE_ACCOUNT, res.Destination)
+	assert.Equal(t, s.addrs[0].String(), res.Address)
+}
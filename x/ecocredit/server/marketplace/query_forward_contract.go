@@ -0,0 +1,59 @@
+package marketplace
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// ForwardContract queries a forward contract by its unique identifier.
+func (k Keeper) ForwardContract(ctx context.Context, req *marketplace.QueryForwardContractRequest) (*marketplace.QueryForwardContractResponse, error) {
+	contract, err := k.stateStore.ForwardContractTable().Get(ctx, req.ForwardContractId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get forward contract with id %d: %s", req.ForwardContractId, err.Error())
+	}
+
+	info, err := k.getForwardContractInfo(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QueryForwardContractResponse{ForwardContract: info}, nil
+}
+
+// getForwardContractInfo converts a forward contract from state into the forward contract info returned
+// by queries.
+func (k Keeper) getForwardContractInfo(ctx context.Context, contract *api.ForwardContract) (*marketplace.ForwardContractInfo, error) {
+	sale, err := k.stateStore.ForwardSaleTable().Get(ctx, contract.ForwardSaleId)
+	if err != nil {
+		return nil, err
+	}
+
+	market, err := k.stateStore.MarketTable().Get(ctx, sale.MarketId)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := k.coreStore.ProjectTable().Get(ctx, contract.ProjectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.ForwardContractInfo{
+		Id:                     contract.Id,
+		ForwardSaleId:          contract.ForwardSaleId,
+		Buyer:                  sdk.AccAddress(contract.Buyer).String(),
+		ProjectId:              project.Id,
+		Quantity:               contract.Quantity,
+		EscrowDenom:            market.BankDenom,
+		EscrowAmount:           contract.EscrowAmount,
+		DisableAutoRetire:      contract.DisableAutoRetire,
+		RetirementJurisdiction: contract.RetirementJurisdiction,
+		MakerFeeBps:            contract.MakerFeeBps,
+		TakerFeeBps:            contract.TakerFeeBps,
+	}, nil
+}
@@ -0,0 +1,53 @@
+package marketplace
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// ForwardContractsByBuyer queries all forward contracts bought by the given address with optional pagination
+func (k Keeper) ForwardContractsByBuyer(ctx context.Context, req *marketplace.QueryForwardContractsByBuyerRequest) (*marketplace.QueryForwardContractsByBuyerResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	buyer, err := sdk.AccAddressFromBech32(req.Buyer)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.ForwardContractTable().List(ctx, api.ForwardContractBuyerIndexKey{}.WithBuyer(buyer), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	contracts := make([]*marketplace.ForwardContractInfo, 0, 10)
+	for it.Next() {
+		contract, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := k.getForwardContractInfo(ctx, contract)
+		if err != nil {
+			return nil, err
+		}
+
+		contracts = append(contracts, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QueryForwardContractsByBuyerResponse{ForwardContracts: contracts, Pagination: pr}, nil
+}
@@ -0,0 +1,53 @@
+package marketplace
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// ForwardContractsBySale queries all forward contracts of the given forward sale in delivery priority order
+// with optional pagination
+func (k Keeper) ForwardContractsBySale(ctx context.Context, req *marketplace.QueryForwardContractsBySaleRequest) (*marketplace.QueryForwardContractsBySaleResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := k.stateStore.ForwardSaleTable().Get(ctx, req.ForwardSaleId); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get forward sale with id %d: %s", req.ForwardSaleId, err.Error())
+	}
+
+	it, err := k.stateStore.ForwardContractTable().List(ctx, api.ForwardContractForwardSaleIdIndexKey{}.WithForwardSaleId(req.ForwardSaleId), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	contracts := make([]*marketplace.ForwardContractInfo, 0, 10)
+	for it.Next() {
+		contract, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := k.getForwardContractInfo(ctx, contract)
+		if err != nil {
+			return nil, err
+		}
+
+		contracts = append(contracts, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QueryForwardContractsBySaleResponse{ForwardContracts: contracts, Pagination: pr}, nil
+}
@@ -0,0 +1,53 @@
+package marketplace
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// ForwardSale queries a forward sale by its unique identifier.
+func (k Keeper) ForwardSale(ctx context.Context, req *marketplace.QueryForwardSaleRequest) (*marketplace.QueryForwardSaleResponse, error) {
+	sale, err := k.stateStore.ForwardSaleTable().Get(ctx, req.ForwardSaleId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get forward sale with id %d: %s", req.ForwardSaleId, err.Error())
+	}
+
+	info, err := k.getForwardSaleInfo(ctx, sale)
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QueryForwardSaleResponse{ForwardSale: info}, nil
+}
+
+// getForwardSaleInfo converts a forward sale from state into the forward sale info returned by queries.
+func (k Keeper) getForwardSaleInfo(ctx context.Context, sale *api.ForwardSale) (*marketplace.ForwardSaleInfo, error) {
+	market, err := k.stateStore.MarketTable().Get(ctx, sale.MarketId)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := k.coreStore.ProjectTable().Get(ctx, sale.ProjectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.ForwardSaleInfo{
+		Id:                sale.Id,
+		Seller:            sdk.AccAddress(sale.Seller).String(),
+		ProjectId:         project.Id,
+		VintageStart:      types.ProtobufToGogoTimestamp(sale.VintageStart),
+		VintageEnd:        types.ProtobufToGogoTimestamp(sale.VintageEnd),
+		Quantity:          sale.Quantity,
+		AskDenom:          market.BankDenom,
+		AskAmount:         sale.AskAmount,
+		DeliveryDeadline:  types.ProtobufToGogoTimestamp(sale.DeliveryDeadline),
+		DisableAutoRetire: sale.DisableAutoRetire,
+	}, nil
+}
@@ -0,0 +1,53 @@
+package marketplace
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// ForwardSalesByProject queries all forward sales of the given project with optional pagination
+func (k Keeper) ForwardSalesByProject(ctx context.Context, req *marketplace.QueryForwardSalesByProjectRequest) (*marketplace.QueryForwardSalesByProjectResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := k.coreStore.ProjectTable().GetById(ctx, req.ProjectId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get project with id %s: %s", req.ProjectId, err.Error())
+	}
+
+	it, err := k.stateStore.ForwardSaleTable().List(ctx, api.ForwardSaleProjectKeyIndexKey{}.WithProjectKey(project.Key), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	sales := make([]*marketplace.ForwardSaleInfo, 0, 10)
+	for it.Next() {
+		sale, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := k.getForwardSaleInfo(ctx, sale)
+		if err != nil {
+			return nil, err
+		}
+
+		sales = append(sales, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QueryForwardSalesByProjectResponse{ForwardSales: sales, Pagination: pr}, nil
+}
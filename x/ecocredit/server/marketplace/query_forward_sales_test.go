@@ -0,0 +1,86 @@
+package marketplace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func TestQuery_ForwardSales(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 3)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, buyer1, buyer2 := s.addrs[0], s.addrs[1], s.addrs[2]
+
+	msg := s.forwardSaleSetup()
+	saleRes, err := s.k.CreateForwardSale(s.ctx, msg)
+	require.NoError(t, err)
+	_, err = s.k.CreateForwardSale(s.ctx, msg)
+	require.NoError(t, err)
+
+	id1 := s.buyForwardContract(saleRes.ForwardSaleId, buyer1, "10", 100)
+	s.buyForwardContract(saleRes.ForwardSaleId, buyer2, "20", 200)
+	s.buyForwardContract(saleRes.ForwardSaleId, buyer1, "5", 50)
+
+	// query forward sale by id
+	res, err := s.k.ForwardSale(s.ctx, &marketplace.QueryForwardSaleRequest{ForwardSaleId: saleRes.ForwardSaleId})
+	require.NoError(t, err)
+	require.Equal(t, seller.String(), res.ForwardSale.Seller)
+	require.Equal(t, "C01-001", res.ForwardSale.ProjectId)
+	require.Equal(t, "65", res.ForwardSale.Quantity)
+	require.Equal(t, ask.Denom, res.ForwardSale.AskDenom)
+	require.Equal(t, "10", res.ForwardSale.AskAmount)
+	require.Equal(t, msg.DeliveryDeadline.Unix(), res.ForwardSale.DeliveryDeadline.Seconds)
+
+	_, err = s.k.ForwardSale(s.ctx, &marketplace.QueryForwardSaleRequest{ForwardSaleId: 3})
+	require.ErrorContains(t, err, "could not get forward sale with id 3")
+
+	// query forward sales by project
+	salesRes, err := s.k.ForwardSalesByProject(s.ctx, &marketplace.QueryForwardSalesByProjectRequest{
+		ProjectId:  "C01-001",
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, salesRes.ForwardSales, 1)
+	require.Equal(t, uint64(2), salesRes.Pagination.Total)
+
+	_, err = s.k.ForwardSalesByProject(s.ctx, &marketplace.QueryForwardSalesByProjectRequest{ProjectId: "C01-002"})
+	require.ErrorContains(t, err, "could not get project with id C01-002")
+
+	// query forward contract by id
+	contractRes, err := s.k.ForwardContract(s.ctx, &marketplace.QueryForwardContractRequest{ForwardContractId: id1})
+	require.NoError(t, err)
+	require.Equal(t, saleRes.ForwardSaleId, contractRes.ForwardContract.ForwardSaleId)
+	require.Equal(t, buyer1.String(), contractRes.ForwardContract.Buyer)
+	require.Equal(t, "C01-001", contractRes.ForwardContract.ProjectId)
+	require.Equal(t, "10", contractRes.ForwardContract.Quantity)
+	require.Equal(t, ask.Denom, contractRes.ForwardContract.EscrowDenom)
+	require.Equal(t, "100", contractRes.ForwardContract.EscrowAmount)
+	require.Equal(t, "US-WA", contractRes.ForwardContract.RetirementJurisdiction)
+
+	_, err = s.k.ForwardContract(s.ctx, &marketplace.QueryForwardContractRequest{ForwardContractId: 4})
+	require.ErrorContains(t, err, "could not get forward contract with id 4")
+
+	// query forward contracts by sale in delivery priority order
+	bySaleRes, err := s.k.ForwardContractsBySale(s.ctx, &marketplace.QueryForwardContractsBySaleRequest{
+		ForwardSaleId: saleRes.ForwardSaleId,
+	})
+	require.NoError(t, err)
+	require.Len(t, bySaleRes.ForwardContracts, 3)
+	for i, contract := range bySaleRes.ForwardContracts {
+		require.Equal(t, uint64(i+1), contract.Id)
+	}
+
+	// query forward contracts by buyer
+	byBuyerRes, err := s.k.ForwardContractsByBuyer(s.ctx, &marketplace.QueryForwardContractsByBuyerRequest{
+		Buyer:      buyer1.String(),
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, byBuyerRes.ForwardContracts, 2)
+	require.Equal(t, uint64(2), byBuyerRes.Pagination.Total)
+}
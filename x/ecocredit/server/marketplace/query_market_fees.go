@@ -0,0 +1,45 @@
+package marketplace
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// MarketFees queries the fees that apply to a market and the total amount of fees collected in the market.
+func (k Keeper) MarketFees(ctx context.Context, req *marketplace.QueryMarketFeesRequest) (*marketplace.QueryMarketFeesResponse, error) {
+	market, err := k.stateStore.MarketTable().Get(ctx, req.MarketId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get market with id %d: %s", req.MarketId, err.Error())
+	}
+
+	makerBps, takerBps, err := k.getMarketFees(ctx, market)
+	if err != nil {
+		return nil, err
+	}
+
+	collected := sdk.NewCoin(market.BankDenom, sdk.ZeroInt())
+	feeTotal, err := k.stateStore.MarketFeeTotalTable().Get(ctx, market.Id)
+	switch {
+	case err == nil:
+		amount, ok := sdk.NewIntFromString(feeTotal.Amount)
+		if !ok {
+			return nil, sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", feeTotal.Amount, sdk.Int{})
+		}
+		collected.Amount = amount
+	case !ormerrors.NotFound.Is(err):
+		return nil, err
+	}
+
+	return &marketplace.QueryMarketFeesResponse{
+		MarketId:         market.Id,
+		CreditTypeAbbrev: market.CreditTypeAbbrev,
+		MakerFeeBps:      makerBps,
+		TakerFeeBps:      takerBps,
+		Collected:        &collected,
+	}, nil
+}
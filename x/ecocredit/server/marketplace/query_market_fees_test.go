@@ -0,0 +1,41 @@
+package marketplace
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func TestQueryMarketFees(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 1)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+
+	// no fees and no fees collected
+	res, err := s.k.MarketFees(s.ctx, &marketplace.QueryMarketFeesRequest{MarketId: 1})
+	assert.NilError(t, err)
+	assert.Equal(t, creditType.Abbreviation, res.CreditTypeAbbrev)
+	assert.Equal(t, uint32(0), res.MakerFeeBps)
+	assert.Equal(t, uint32(0), res.TakerFeeBps)
+	assert.Equal(t, "0"+ask.Denom, res.Collected.String())
+
+	s.setCreditTypeFees(100, 200)
+	assert.NilError(t, s.marketStore.MarketFeeTotalTable().Insert(s.ctx, &api.MarketFeeTotal{
+		MarketId: 1,
+		Amount:   "42",
+	}))
+
+	res, err = s.k.MarketFees(s.ctx, &marketplace.QueryMarketFeesRequest{MarketId: 1})
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(1), res.MarketId)
+	assert.Equal(t, uint32(100), res.MakerFeeBps)
+	assert.Equal(t, uint32(200), res.TakerFeeBps)
+	assert.Equal(t, "42"+ask.Denom, res.Collected.String())
+
+	// market not found
+	_, err = s.k.MarketFees(s.ctx, &marketplace.QueryMarketFeesRequest{MarketId: 2})
+	assert.ErrorContains(t, err, "not found")
+}
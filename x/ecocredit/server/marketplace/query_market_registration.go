@@ -0,0 +1,34 @@
+package marketplace
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// MarketRegistration queries whether market registration is permissionless and the deposit paid to
+// register a market.
+func (k Keeper) MarketRegistration(ctx context.Context, _ *marketplace.QueryMarketRegistrationRequest) (*marketplace.QueryMarketRegistrationResponse, error) {
+	registration, err := k.stateStore.MarketRegistrationTable().Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var deposit *sdk.Coin
+	if len(registration.DepositDenom) != 0 {
+		amount, ok := sdk.NewIntFromString(registration.DepositAmount)
+		if !ok {
+			return nil, sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", registration.DepositAmount, sdk.Int{})
+		}
+		coin := sdk.NewCoin(registration.DepositDenom, amount)
+		deposit = &coin
+	}
+
+	return &marketplace.QueryMarketRegistrationResponse{
+		Enabled: registration.Enabled,
+		Deposit: deposit,
+	}, nil
+}
@@ -0,0 +1,132 @@
+package marketplace
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// MaxMarketStatsWindowSeconds is the maximum length of the window of time over which market statistics
+// are calculated (30 days).
+const MaxMarketStatsWindowSeconds = 30 * 24 * 60 * 60
+
+// MarketStats queries the last price of a market and the volume, volume weighted average price, and high
+// and low prices of trades in the market over a window of time ending at the current block time.
+func (k Keeper) MarketStats(ctx context.Context, req *marketplace.QueryMarketStatsRequest) (*marketplace.QueryMarketStatsResponse, error) {
+	if req.WindowSeconds == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("window seconds must be positive")
+	}
+	if req.WindowSeconds > MaxMarketStatsWindowSeconds {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("window seconds cannot exceed %d", MaxMarketStatsWindowSeconds)
+	}
+
+	market, err := k.stateStore.MarketTable().Get(ctx, req.MarketId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get market with id %d: %s", req.MarketId, err.Error())
+	}
+
+	res := &marketplace.QueryMarketStatsResponse{
+		MarketId:         market.Id,
+		CreditTypeAbbrev: market.CreditTypeAbbrev,
+		BankDenom:        market.BankDenom,
+		Volume:           "0",
+		Notional:         "0",
+	}
+
+	// the last trade is the last trade in the market index, which is ordered by timestamp
+	last, err := k.stateStore.TradeTable().List(ctx, api.TradeMarketIdTimestampIndexKey{}.WithMarketId(market.Id), ormlist.Reverse())
+	if err != nil {
+		return nil, err
+	}
+	if last.Next() {
+		trade, err := last.Value()
+		if err != nil {
+			last.Close()
+			return nil, err
+		}
+		res.LastPrice = trade.UnitPrice
+		res.LastTradeTime = types.ProtobufToGogoTimestamp(trade.Timestamp)
+	}
+	last.Close()
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	start := time.Unix(0, 0).UTC()
+	if req.WindowSeconds < uint64(blockTime.Unix()) {
+		start = blockTime.Add(-time.Duration(req.WindowSeconds) * time.Second)
+	}
+	res.WindowStart = types.ProtobufToGogoTimestamp(timestamppb.New(start))
+	from := api.TradeMarketIdTimestampIndexKey{}.WithMarketIdTimestamp(market.Id, timestamppb.New(start))
+	to := api.TradeMarketIdTimestampIndexKey{}.WithMarketIdTimestamp(market.Id, timestamppb.New(blockTime))
+	it, err := k.stateStore.TradeTable().ListRange(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	volume, notional := math.NewDecFromInt64(0), math.NewDecFromInt64(0)
+	var high, low sdk.Int
+	for it.Next() {
+		trade, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		quantity, err := math.NewDecFromString(trade.Quantity)
+		if err != nil {
+			return nil, err
+		}
+		price, ok := sdk.NewIntFromString(trade.UnitPrice)
+		if !ok {
+			return nil, sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", trade.UnitPrice, sdk.Int{})
+		}
+		priceDec, err := math.NewDecFromString(trade.UnitPrice)
+		if err != nil {
+			return nil, err
+		}
+		value, err := priceDec.Mul(quantity)
+		if err != nil {
+			return nil, err
+		}
+
+		if volume, err = volume.Add(quantity); err != nil {
+			return nil, err
+		}
+		if notional, err = notional.Add(value); err != nil {
+			return nil, err
+		}
+		if res.TradeCount == 0 || price.GT(high) {
+			high = price
+		}
+		if res.TradeCount == 0 || price.LT(low) {
+			low = price
+		}
+		res.TradeCount++
+	}
+
+	if res.TradeCount == 0 {
+		return res, nil
+	}
+
+	vwap, err := notional.Quo(volume)
+	if err != nil {
+		return nil, err
+	}
+
+	res.Volume = volume.String()
+	res.Notional = notional.String()
+	res.Vwap = vwap.String()
+	res.High = high.String()
+	res.Low = low.String()
+
+	return res, nil
+}
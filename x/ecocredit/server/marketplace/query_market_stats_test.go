@@ -0,0 +1,84 @@
+package marketplace
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func TestQueryMarketStats(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	buyer, seller := s.addrs[1], s.addrs[0]
+
+	blockTime := time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)
+	s.sdkCtx = s.sdkCtx.WithBlockTime(blockTime)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+
+	// no trades
+	res, err := s.k.MarketStats(s.ctx, &marketplace.QueryMarketStatsRequest{MarketId: 1, WindowSeconds: 86400})
+	assert.NilError(t, err)
+	assert.Equal(t, "C", res.CreditTypeAbbrev)
+	assert.Equal(t, ask.Denom, res.BankDenom)
+	assert.Equal(t, "", res.LastPrice)
+	assert.Equal(t, uint64(0), res.TradeCount)
+	assert.Equal(t, "0", res.Volume)
+	assert.Equal(t, "", res.Vwap)
+
+	s.insertTrade(buyer, seller, "10", "20", blockTime.Add(-48*time.Hour))
+	s.insertTrade(buyer, seller, "10", "10", blockTime.Add(-2*time.Hour))
+	s.insertTrade(buyer, seller, "30", "12", blockTime.Add(-time.Hour))
+
+	// trades within the last week
+	res, err = s.k.MarketStats(s.ctx, &marketplace.QueryMarketStatsRequest{MarketId: 1, WindowSeconds: 7 * 86400})
+	assert.NilError(t, err)
+	assert.Equal(t, "12", res.LastPrice)
+	assert.Equal(t, blockTime.Add(-time.Hour).Unix(), res.LastTradeTime.Seconds)
+	assert.Equal(t, uint64(3), res.TradeCount)
+	assert.Equal(t, "50", res.Volume)
+	assert.Equal(t, "660", res.Notional)
+	assert.Equal(t, "13.2", res.Vwap)
+	assert.Equal(t, "20", res.High)
+	assert.Equal(t, "10", res.Low)
+	assert.Equal(t, blockTime.Add(-7*24*time.Hour).Unix(), res.WindowStart.Seconds)
+
+	// trades within the last day
+	res, err = s.k.MarketStats(s.ctx, &marketplace.QueryMarketStatsRequest{MarketId: 1, WindowSeconds: 86400})
+	assert.NilError(t, err)
+	assert.Equal(t, "12", res.LastPrice)
+	assert.Equal(t, uint64(2), res.TradeCount)
+	assert.Equal(t, "40", res.Volume)
+	assert.Equal(t, "460", res.Notional)
+	assert.Equal(t, "11.5", res.Vwap)
+	assert.Equal(t, "12", res.High)
+	assert.Equal(t, "10", res.Low)
+	assert.Equal(t, blockTime.Add(-24*time.Hour).Unix(), res.WindowStart.Seconds)
+
+	// no trades within the window, the last price is still returned
+	res, err = s.k.MarketStats(s.ctx, &marketplace.QueryMarketStatsRequest{MarketId: 1, WindowSeconds: 60})
+	assert.NilError(t, err)
+	assert.Equal(t, "12", res.LastPrice)
+	assert.Equal(t, uint64(0), res.TradeCount)
+	assert.Equal(t, "", res.High)
+
+	// the window must be positive
+	_, err = s.k.MarketStats(s.ctx, &marketplace.QueryMarketStatsRequest{MarketId: 1})
+	assert.ErrorContains(t, err, "window seconds must be positive")
+
+	// the window cannot exceed the max window
+	_, err = s.k.MarketStats(s.ctx, &marketplace.QueryMarketStatsRequest{
+		MarketId:      1,
+		WindowSeconds: MaxMarketStatsWindowSeconds + 1,
+	})
+	assert.ErrorContains(t, err, "window seconds cannot exceed 2592000")
+
+	// market not found
+	_, err = s.k.MarketStats(s.ctx, &marketplace.QueryMarketStatsRequest{MarketId: 2, WindowSeconds: 86400})
+	assert.ErrorContains(t, err, "could not get market with id 2")
+}
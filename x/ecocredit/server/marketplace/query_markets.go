@@ -0,0 +1,56 @@
+package marketplace
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// Markets queries all markets in state with optional pagination.
+func (k Keeper) Markets(ctx context.Context, req *marketplace.QueryMarketsRequest) (*marketplace.QueryMarketsResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.MarketTable().List(ctx, api.MarketIdIndexKey{}, ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	markets := make([]*marketplace.MarketInfo, 0)
+	for it.Next() {
+		market, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		var registrant string
+		if len(market.Registrant) != 0 {
+			registrant = sdk.AccAddress(market.Registrant).String()
+		}
+
+		markets = append(markets, &marketplace.MarketInfo{
+			Id:               market.Id,
+			CreditTypeAbbrev: market.CreditTypeAbbrev,
+			BankDenom:        market.BankDenom,
+			DisplayDenom:     market.DisplayDenom,
+			Exponent:         market.Exponent,
+			Registrant:       registrant,
+			Delisted:         market.Delisted,
+		})
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QueryMarketsResponse{Markets: markets, Pagination: pr}, nil
+}
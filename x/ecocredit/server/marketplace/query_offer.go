@@ -0,0 +1,59 @@
+package marketplace
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// Offer queries an offer by its unique identifier.
+func (k Keeper) Offer(ctx context.Context, req *marketplace.QueryOfferRequest) (*marketplace.QueryOfferResponse, error) {
+	offer, err := k.stateStore.OfferTable().Get(ctx, req.OfferId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get offer with id %d: %s", req.OfferId, err.Error())
+	}
+
+	info, err := k.getOfferInfo(ctx, offer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QueryOfferResponse{Offer: info}, nil
+}
+
+// getOfferInfo converts an offer from state into the offer info returned by queries.
+func (k Keeper) getOfferInfo(ctx context.Context, offer *api.Offer) (*marketplace.OfferInfo, error) {
+	market, err := k.stateStore.MarketTable().Get(ctx, offer.MarketId)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := k.coreStore.BatchTable().Get(ctx, offer.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var holder string
+	if len(offer.Holder) != 0 {
+		holder = sdk.AccAddress(offer.Holder).String()
+	}
+
+	return &marketplace.OfferInfo{
+		Id:                     offer.Id,
+		Buyer:                  sdk.AccAddress(offer.Buyer).String(),
+		BatchDenom:             batch.Denom,
+		Holder:                 holder,
+		Quantity:               offer.Quantity,
+		BidDenom:               market.BankDenom,
+		BidAmount:              offer.BidAmount,
+		DisableAutoRetire:      offer.DisableAutoRetire,
+		RetirementJurisdiction: offer.RetirementJurisdiction,
+		Expiration:             types.ProtobufToGogoTimestamp(offer.Expiration),
+		MakerFeeBps:            offer.MakerFeeBps,
+	}, nil
+}
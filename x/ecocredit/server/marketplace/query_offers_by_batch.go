@@ -0,0 +1,53 @@
+package marketplace
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// OffersByBatch queries all offers for credits from the given batch denom with optional pagination
+func (k Keeper) OffersByBatch(ctx context.Context, req *marketplace.QueryOffersByBatchRequest) (*marketplace.QueryOffersByBatchResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := k.coreStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", req.BatchDenom, err.Error())
+	}
+
+	it, err := k.stateStore.OfferTable().List(ctx, api.OfferBatchKeyIndexKey{}.WithBatchKey(batch.Key), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	offers := make([]*marketplace.OfferInfo, 0, 10)
+	for it.Next() {
+		offer, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := k.getOfferInfo(ctx, offer)
+		if err != nil {
+			return nil, err
+		}
+
+		offers = append(offers, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QueryOffersByBatchResponse{Offers: offers, Pagination: pr}, nil
+}
@@ -0,0 +1,53 @@
+package marketplace
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// OffersByHolder queries all offers made to the given holder address with optional pagination
+func (k Keeper) OffersByHolder(ctx context.Context, req *marketplace.QueryOffersByHolderRequest) (*marketplace.QueryOffersByHolderResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	holder, err := sdk.AccAddressFromBech32(req.Holder)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.OfferTable().List(ctx, api.OfferHolderIndexKey{}.WithHolder(holder), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	offers := make([]*marketplace.OfferInfo, 0, 10)
+	for it.Next() {
+		offer, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := k.getOfferInfo(ctx, offer)
+		if err != nil {
+			return nil, err
+		}
+
+		offers = append(offers, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QueryOffersByHolderResponse{Offers: offers, Pagination: pr}, nil
+}
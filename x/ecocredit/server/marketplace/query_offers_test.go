@@ -0,0 +1,98 @@
+package marketplace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func TestQuery_Offers(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 3)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	holder, buyer1, buyer2 := s.addrs[0], s.addrs[1], s.addrs[2]
+
+	expiration, err := types.ParseDate("expiration", "2030-01-01")
+	require.NoError(t, err)
+
+	s.expectEscrow(buyer1, 100)
+	res1, err := s.k.MakeOffer(s.ctx, &marketplace.MsgMakeOffer{
+		Buyer:                  buyer1.String(),
+		BatchDenom:             batchDenom,
+		Holder:                 holder.String(),
+		Quantity:               "10",
+		BidPrice:               &ask,
+		RetirementJurisdiction: "US-WA",
+		Expiration:             &expiration,
+	})
+	require.NoError(t, err)
+
+	s.expectEscrow(buyer1, 50)
+	_, err = s.k.MakeOffer(s.ctx, &marketplace.MsgMakeOffer{
+		Buyer:             buyer1.String(),
+		BatchDenom:        batchDenom,
+		Quantity:          "5",
+		BidPrice:          &ask,
+		DisableAutoRetire: true,
+	})
+	require.NoError(t, err)
+
+	s.expectEscrow(buyer2, 10)
+	_, err = s.k.MakeOffer(s.ctx, &marketplace.MsgMakeOffer{
+		Buyer:             buyer2.String(),
+		BatchDenom:        batchDenom,
+		Quantity:          "1",
+		BidPrice:          &ask,
+		DisableAutoRetire: true,
+	})
+	require.NoError(t, err)
+
+	// query offer by id
+	res, err := s.k.Offer(s.ctx, &marketplace.QueryOfferRequest{OfferId: res1.OfferId})
+	require.NoError(t, err)
+	require.Equal(t, res1.OfferId, res.Offer.Id)
+	require.Equal(t, buyer1.String(), res.Offer.Buyer)
+	require.Equal(t, batchDenom, res.Offer.BatchDenom)
+	require.Equal(t, holder.String(), res.Offer.Holder)
+	require.Equal(t, "10", res.Offer.Quantity)
+	require.Equal(t, ask.Denom, res.Offer.BidDenom)
+	require.Equal(t, ask.Amount.String(), res.Offer.BidAmount)
+	require.Equal(t, false, res.Offer.DisableAutoRetire)
+	require.Equal(t, "US-WA", res.Offer.RetirementJurisdiction)
+	require.Equal(t, expiration.Unix(), res.Offer.Expiration.Seconds)
+
+	_, err = s.k.Offer(s.ctx, &marketplace.QueryOfferRequest{OfferId: 4})
+	require.ErrorContains(t, err, "could not get offer with id 4")
+
+	// query offers by batch
+	batchRes, err := s.k.OffersByBatch(s.ctx, &marketplace.QueryOffersByBatchRequest{
+		BatchDenom: batchDenom,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, batchRes.Offers, 2)
+	require.Equal(t, uint64(3), batchRes.Pagination.Total)
+
+	_, err = s.k.OffersByBatch(s.ctx, &marketplace.QueryOffersByBatchRequest{BatchDenom: "C02-001-20200101-20200201-001"})
+	require.ErrorContains(t, err, "could not get batch with denom")
+
+	// query offers by buyer
+	buyerRes, err := s.k.OffersByBuyer(s.ctx, &marketplace.QueryOffersByBuyerRequest{Buyer: buyer1.String()})
+	require.NoError(t, err)
+	require.Len(t, buyerRes.Offers, 2)
+
+	buyerRes, err = s.k.OffersByBuyer(s.ctx, &marketplace.QueryOffersByBuyerRequest{Buyer: buyer2.String()})
+	require.NoError(t, err)
+	require.Len(t, buyerRes.Offers, 1)
+
+	// query offers by holder
+	holderRes, err := s.k.OffersByHolder(s.ctx, &marketplace.QueryOffersByHolderRequest{Holder: holder.String()})
+	require.NoError(t, err)
+	require.Len(t, holderRes.Offers, 1)
+	require.Equal(t, res1.OfferId, holderRes.Offers[0].Id)
+}
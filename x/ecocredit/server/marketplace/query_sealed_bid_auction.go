@@ -0,0 +1,54 @@
+package marketplace
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// SealedBidAuction queries a sealed-bid auction by its unique identifier.
+func (k Keeper) SealedBidAuction(ctx context.Context, req *marketplace.QuerySealedBidAuctionRequest) (*marketplace.QuerySealedBidAuctionResponse, error) {
+	auction, err := k.stateStore.SealedBidAuctionTable().Get(ctx, req.AuctionId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get sealed-bid auction with id %d: %s", req.AuctionId, err.Error())
+	}
+
+	info, err := k.getSealedBidAuctionInfo(ctx, auction)
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QuerySealedBidAuctionResponse{Auction: info}, nil
+}
+
+// getSealedBidAuctionInfo converts a sealed-bid auction from state into the sealed-bid auction info
+// returned by queries.
+func (k Keeper) getSealedBidAuctionInfo(ctx context.Context, auction *api.SealedBidAuction) (*marketplace.SealedBidAuctionInfo, error) {
+	market, err := k.stateStore.MarketTable().Get(ctx, auction.MarketId)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := k.coreStore.BatchTable().Get(ctx, auction.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.SealedBidAuctionInfo{
+		Id:                auction.Id,
+		Seller:            sdk.AccAddress(auction.Seller).String(),
+		BatchDenom:        batch.Denom,
+		Quantity:          auction.Quantity,
+		BidDenom:          market.BankDenom,
+		ReserveAmount:     auction.ReserveAmount,
+		CommitEndHeight:   auction.CommitEndHeight,
+		RevealEndHeight:   auction.RevealEndHeight,
+		DisableAutoRetire: auction.DisableAutoRetire,
+		MakerFeeBps:       auction.MakerFeeBps,
+		TakerFeeBps:       auction.TakerFeeBps,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package marketplace
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// SealedBidAuctions queries all sealed-bid auctions with optional pagination
+func (k Keeper) SealedBidAuctions(ctx context.Context, req *marketplace.QuerySealedBidAuctionsRequest) (*marketplace.QuerySealedBidAuctionsResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.SealedBidAuctionTable().List(ctx, api.SealedBidAuctionIdIndexKey{}, ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	auctions := make([]*marketplace.SealedBidAuctionInfo, 0, 10)
+	for it.Next() {
+		auction, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := k.getSealedBidAuctionInfo(ctx, auction)
+		if err != nil {
+			return nil, err
+		}
+
+		auctions = append(auctions, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QuerySealedBidAuctionsResponse{Auctions: auctions, Pagination: pr}, nil
+}
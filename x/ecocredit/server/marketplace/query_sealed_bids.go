@@ -0,0 +1,60 @@
+package marketplace
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// SealedBids queries all bids committed to a sealed-bid auction with optional pagination. The quantity and
+// bid amount of a bid are only included once the bid has been revealed.
+func (k Keeper) SealedBids(ctx context.Context, req *marketplace.QuerySealedBidsRequest) (*marketplace.QuerySealedBidsResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := k.stateStore.SealedBidAuctionTable().Get(ctx, req.AuctionId); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get sealed-bid auction with id %d: %s", req.AuctionId, err.Error())
+	}
+
+	it, err := k.stateStore.SealedBidTable().List(ctx, api.SealedBidPrimaryKey{}.WithAuctionId(req.AuctionId), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	bids := make([]*marketplace.SealedBidInfo, 0, 10)
+	for it.Next() {
+		bid, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info := &marketplace.SealedBidInfo{
+			AuctionId:     bid.AuctionId,
+			Bidder:        sdk.AccAddress(bid.Bidder).String(),
+			DepositAmount: bid.DepositAmount,
+			Revealed:      bid.Revealed,
+		}
+		if bid.Revealed {
+			info.Quantity = bid.Quantity
+			info.BidAmount = bid.BidAmount
+		}
+
+		bids = append(bids, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QuerySealedBidsResponse{Bids: bids, Pagination: pr}, nil
+}
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
 	"github.com/regen-network/regen-ledger/types"
 	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
 )
@@ -16,6 +17,16 @@ func (k Keeper) SellOrder(ctx context.Context, req *marketplace.QuerySellOrderRe
 		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get sell order with id %d: %s", req.SellOrderId, err.Error())
 	}
 
+	info, err := k.getSellOrderInfo(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QuerySellOrderResponse{SellOrder: info}, nil
+}
+
+// getSellOrderInfo returns the human-readable sell order information of the sell order.
+func (k Keeper) getSellOrderInfo(ctx context.Context, order *api.SellOrder) (*marketplace.SellOrderInfo, error) {
 	seller := sdk.AccAddress(order.Seller)
 
 	batch, err := k.coreStore.BatchTable().Get(ctx, order.BatchKey)
@@ -28,7 +39,9 @@ func (k Keeper) SellOrder(ctx context.Context, req *marketplace.QuerySellOrderRe
 		return nil, err
 	}
 
-	info := marketplace.SellOrderInfo{
+	allowedBuyers, buyerGroupPolicy := getSellOrderBuyers(order)
+
+	return &marketplace.SellOrderInfo{
 		Id:                order.Id,
 		Seller:            seller.String(),
 		BatchDenom:        batch.Denom,
@@ -37,7 +50,9 @@ func (k Keeper) SellOrder(ctx context.Context, req *marketplace.QuerySellOrderRe
 		AskAmount:         order.AskAmount,
 		DisableAutoRetire: order.DisableAutoRetire,
 		Expiration:        types.ProtobufToGogoTimestamp(order.Expiration),
-	}
-
-	return &marketplace.QuerySellOrderResponse{SellOrder: &info}, nil
+		AllowedBuyers:     allowedBuyers,
+		BuyerGroupPolicy:  buyerGroupPolicy,
+		MinFillQuantity:   order.MinFillQuantity,
+		AllOrNone:         order.AllOrNone,
+	}, nil
 }
@@ -3,7 +3,6 @@ package marketplace
 import (
 	"context"
 
-	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
 	"github.com/regen-network/regen-ledger/types"
@@ -18,7 +17,7 @@ func (k Keeper) SellOrders(ctx context.Context, req *marketplace.QuerySellOrders
 		return nil, err
 	}
 
-	it, err := k.stateStore.SellOrderTable().List(ctx, api.SellOrderSellerIndexKey{}, ormlist.Paginate(pg))
+	it, err := k.stateStore.SellOrderTable().List(ctx, api.SellOrderSellerIndexKey{}, sellOrderListOptions(pg, req.IncludePrivate)...)
 	if err != nil {
 		return nil, err
 	}
@@ -43,6 +42,8 @@ func (k Keeper) SellOrders(ctx context.Context, req *marketplace.QuerySellOrders
 			return nil, err
 		}
 
+		allowedBuyers, buyerGroupPolicy := getSellOrderBuyers(order)
+
 		info := marketplace.SellOrderInfo{
 			Id:                order.Id,
 			Seller:            seller.String(),
@@ -52,6 +53,10 @@ func (k Keeper) SellOrders(ctx context.Context, req *marketplace.QuerySellOrders
 			AskAmount:         order.AskAmount,
 			DisableAutoRetire: order.DisableAutoRetire,
 			Expiration:        types.ProtobufToGogoTimestamp(order.Expiration),
+			AllowedBuyers:     allowedBuyers,
+			BuyerGroupPolicy:  buyerGroupPolicy,
+			MinFillQuantity:   order.MinFillQuantity,
+			AllOrNone:         order.AllOrNone,
 		}
 
 		orders = append(orders, &info)
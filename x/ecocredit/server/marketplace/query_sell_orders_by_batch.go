@@ -3,7 +3,6 @@ package marketplace
 import (
 	"context"
 
-	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -25,7 +24,7 @@ func (k Keeper) SellOrdersByBatch(ctx context.Context, req *marketplace.QuerySel
 		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", req.BatchDenom, err.Error())
 	}
 
-	it, err := k.stateStore.SellOrderTable().List(ctx, api.SellOrderBatchKeyIndexKey{}.WithBatchKey(batch.Key), ormlist.Paginate(pg))
+	it, err := k.stateStore.SellOrderTable().List(ctx, api.SellOrderBatchKeyIndexKey{}.WithBatchKey(batch.Key), sellOrderListOptions(pg, req.IncludePrivate)...)
 	if err != nil {
 		return nil, err
 	}
@@ -45,6 +44,8 @@ func (k Keeper) SellOrdersByBatch(ctx context.Context, req *marketplace.QuerySel
 			return nil, err
 		}
 
+		allowedBuyers, buyerGroupPolicy := getSellOrderBuyers(order)
+
 		info := marketplace.SellOrderInfo{
 			Id:                order.Id,
 			Seller:            seller.String(),
@@ -54,6 +55,10 @@ func (k Keeper) SellOrdersByBatch(ctx context.Context, req *marketplace.QuerySel
 			AskAmount:         order.AskAmount,
 			DisableAutoRetire: order.DisableAutoRetire,
 			Expiration:        types.ProtobufToGogoTimestamp(order.Expiration),
+			AllowedBuyers:     allowedBuyers,
+			BuyerGroupPolicy:  buyerGroupPolicy,
+			MinFillQuantity:   order.MinFillQuantity,
+			AllOrNone:         order.AllOrNone,
 		}
 
 		orders = append(orders, &info)
@@ -0,0 +1,80 @@
+package marketplace
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// SellOrdersByClass queries all sell orders of credits from the given credit class with optional filters for
+// the project jurisdiction and the batch start date and with optional pagination
+func (k Keeper) SellOrdersByClass(ctx context.Context, req *marketplace.QuerySellOrdersByClassRequest) (*marketplace.QuerySellOrdersByClassResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := k.coreStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get class with id %s: %s", req.ClassId, err.Error())
+	}
+
+	var filters []func(*api.SellOrder) bool
+	if req.ProjectJurisdiction != "" {
+		// the jurisdiction of each project is only looked up once
+		jurisdictionMatches := make(map[uint64]bool)
+		filters = append(filters, func(sellOrder *api.SellOrder) bool {
+			matches, ok := jurisdictionMatches[sellOrder.ProjectKey]
+			if !ok {
+				project, err := k.coreStore.ProjectTable().Get(ctx, sellOrder.ProjectKey)
+				matches = err == nil && strings.HasPrefix(project.Jurisdiction, req.ProjectJurisdiction)
+				jurisdictionMatches[sellOrder.ProjectKey] = matches
+			}
+			return matches
+		})
+	}
+
+	it, err := k.listSellOrdersByBatchStartDate(
+		ctx,
+		api.SellOrderClassKeyBatchStartDateIndexKey{}.WithClassKey(class.Key),
+		func(date *timestamppb.Timestamp) api.SellOrderIndexKey {
+			return api.SellOrderClassKeyBatchStartDateIndexKey{}.WithClassKeyBatchStartDate(class.Key, date)
+		},
+		req.MinBatchStartDate,
+		req.MaxBatchStartDate,
+		sellOrderListOptions(pg, req.IncludePrivate, filters...)...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	orders := make([]*marketplace.SellOrderInfo, 0, 10)
+	for it.Next() {
+		order, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := k.getSellOrderInfo(ctx, order)
+		if err != nil {
+			return nil, err
+		}
+
+		orders = append(orders, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QuerySellOrdersByClassResponse{SellOrders: orders, Pagination: pr}, nil
+}
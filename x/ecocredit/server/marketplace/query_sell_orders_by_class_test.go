@@ -0,0 +1,241 @@
+package marketplace
+
+import (
+	"testing"
+	"time"
+
+	gogotypes "github.com/gogo/protobuf/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	ecoApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// sellOrderDiscoverySetup creates a second project in the jurisdiction US-WA with a credit batch that
+// starts one year after the first credit batch and creates sell orders for both credit batches.
+func (s *baseSuite) sellOrderDiscoverySetup() (otherStart *timestamppb.Timestamp, sellOrderIds []uint64) {
+	project, err := s.coreStore.ProjectTable().Get(s.ctx, 1)
+	assert.NilError(s.t, err)
+	project.Jurisdiction = "CO-ANT"
+	assert.NilError(s.t, s.coreStore.ProjectTable().Update(s.ctx, project))
+
+	assert.NilError(s.t, s.coreStore.ProjectTable().Insert(s.ctx, &ecoApi.Project{
+		Id:           "C01-002",
+		ClassKey:     1,
+		Jurisdiction: "US-WA 98225",
+	}))
+
+	otherStart = timestamppb.New(start.AsTime().AddDate(1, 0, 0))
+	otherDenom := "C01-002-20200101-20210101-001"
+	assert.NilError(s.t, s.coreStore.BatchTable().Insert(s.ctx, &ecoApi.Batch{
+		ProjectKey: 2,
+		Denom:      otherDenom,
+		StartDate:  otherStart,
+		EndDate:    timestamppb.New(otherStart.AsTime().AddDate(1, 0, 0)),
+	}))
+	assert.NilError(s.t, s.coreStore.BatchBalanceTable().Insert(s.ctx, &ecoApi.BatchBalance{
+		BatchKey:       2,
+		Address:        s.addrs[0],
+		TradableAmount: "100",
+		RetiredAmount:  "0",
+		EscrowedAmount: "0",
+	}))
+
+	sellOrderIds = s.createSellOrder(&marketplace.MsgSell{
+		Seller: s.addrs[0].String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: "10", AskPrice: askPrice(10)},
+			{BatchDenom: otherDenom, Quantity: "10", AskPrice: askPrice(9)},
+			{BatchDenom: batchDenom, Quantity: "10", AskPrice: askPrice(100)},
+			{BatchDenom: otherDenom, Quantity: "10", AskPrice: askPrice(11)},
+		},
+	})
+
+	return otherStart, sellOrderIds
+}
+
+func TestSellOrdersByClass(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 1)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	otherStart, ids := s.sellOrderDiscoverySetup()
+
+	// all sell orders of the class ordered by batch start date
+	res, err := s.k.SellOrdersByClass(s.ctx, &marketplace.QuerySellOrdersByClassRequest{
+		ClassId:    "C01",
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assertSellOrderIds(t, res.SellOrders, ids[0], ids[2], ids[1], ids[3])
+	assert.Equal(t, uint64(4), res.Pagination.Total)
+
+	// filter by project jurisdiction prefix
+	res, err = s.k.SellOrdersByClass(s.ctx, &marketplace.QuerySellOrdersByClassRequest{
+		ClassId:             "C01",
+		ProjectJurisdiction: "US",
+		Pagination:          &query.PageRequest{CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assertSellOrderIds(t, res.SellOrders, ids[1], ids[3])
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+
+	res, err = s.k.SellOrdersByClass(s.ctx, &marketplace.QuerySellOrdersByClassRequest{
+		ClassId:             "C01",
+		ProjectJurisdiction: "US-OR",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(res.SellOrders))
+
+	// filter by min batch start date
+	res, err = s.k.SellOrdersByClass(s.ctx, &marketplace.QuerySellOrdersByClassRequest{
+		ClassId:           "C01",
+		MinBatchStartDate: gogoTimestamp(otherStart.AsTime()),
+	})
+	assert.NilError(t, err)
+	assertSellOrderIds(t, res.SellOrders, ids[1], ids[3])
+
+	// filter by max batch start date
+	res, err = s.k.SellOrdersByClass(s.ctx, &marketplace.QuerySellOrdersByClassRequest{
+		ClassId:           "C01",
+		MaxBatchStartDate: gogoTimestamp(otherStart.AsTime().Add(-time.Second)),
+	})
+	assert.NilError(t, err)
+	assertSellOrderIds(t, res.SellOrders, ids[0], ids[2])
+
+	// filter by min and max batch start date
+	res, err = s.k.SellOrdersByClass(s.ctx, &marketplace.QuerySellOrdersByClassRequest{
+		ClassId:           "C01",
+		MinBatchStartDate: gogoTimestamp(start.AsTime()),
+		MaxBatchStartDate: gogoTimestamp(otherStart.AsTime()),
+	})
+	assert.NilError(t, err)
+	assertSellOrderIds(t, res.SellOrders, ids[0], ids[2], ids[1], ids[3])
+
+	res, err = s.k.SellOrdersByClass(s.ctx, &marketplace.QuerySellOrdersByClassRequest{
+		ClassId:           "C01",
+		MinBatchStartDate: gogoTimestamp(otherStart.AsTime()),
+		MaxBatchStartDate: gogoTimestamp(otherStart.AsTime()),
+	})
+	assert.NilError(t, err)
+	assertSellOrderIds(t, res.SellOrders, ids[1], ids[3])
+
+	_, err = s.k.SellOrdersByClass(s.ctx, &marketplace.QuerySellOrdersByClassRequest{
+		ClassId:           "C01",
+		MinBatchStartDate: gogoTimestamp(otherStart.AsTime()),
+		MaxBatchStartDate: gogoTimestamp(start.AsTime()),
+	})
+	assert.ErrorContains(t, err, "must not be after max batch start date")
+
+	// unknown class
+	_, err = s.k.SellOrdersByClass(s.ctx, &marketplace.QuerySellOrdersByClassRequest{ClassId: "C02"})
+	assert.ErrorContains(t, err, "could not get class with id C02")
+}
+
+func TestSellOrdersByProject(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 1)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	otherStart, ids := s.sellOrderDiscoverySetup()
+
+	res, err := s.k.SellOrdersByProject(s.ctx, &marketplace.QuerySellOrdersByProjectRequest{
+		ProjectId:  "C01-002",
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assertSellOrderIds(t, res.SellOrders, ids[1], ids[3])
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+
+	res, err = s.k.SellOrdersByProject(s.ctx, &marketplace.QuerySellOrdersByProjectRequest{
+		ProjectId:         "C01-002",
+		MaxBatchStartDate: gogoTimestamp(otherStart.AsTime().Add(-time.Second)),
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(res.SellOrders))
+
+	_, err = s.k.SellOrdersByProject(s.ctx, &marketplace.QuerySellOrdersByProjectRequest{ProjectId: "C01-003"})
+	assert.ErrorContains(t, err, "could not get project with id C01-003")
+}
+
+func TestSellOrdersByMarket(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 1)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	_, ids := s.sellOrderDiscoverySetup()
+
+	// sorted by ask price
+	res, err := s.k.SellOrdersByMarket(s.ctx, &marketplace.QuerySellOrdersByMarketRequest{
+		MarketId:   1,
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assertSellOrderIds(t, res.SellOrders, ids[1], ids[0], ids[3], ids[2])
+	assert.Equal(t, uint64(4), res.Pagination.Total)
+
+	// sorted by ask price in reverse
+	res, err = s.k.SellOrdersByMarket(s.ctx, &marketplace.QuerySellOrdersByMarketRequest{
+		MarketId:   1,
+		Pagination: &query.PageRequest{Limit: 2, Reverse: true},
+	})
+	assert.NilError(t, err)
+	assertSellOrderIds(t, res.SellOrders, ids[2], ids[3])
+
+	// updating the ask price updates the order
+	_, err = s.k.UpdateSellOrders(s.ctx, &marketplace.MsgUpdateSellOrders{
+		Seller: s.addrs[0].String(),
+		Updates: []*marketplace.MsgUpdateSellOrders_Update{
+			{SellOrderId: ids[2], NewAskPrice: askPrice(1)},
+		},
+	})
+	assert.NilError(t, err)
+
+	res, err = s.k.SellOrdersByMarket(s.ctx, &marketplace.QuerySellOrdersByMarketRequest{MarketId: 1})
+	assert.NilError(t, err)
+	assertSellOrderIds(t, res.SellOrders, ids[2], ids[1], ids[0], ids[3])
+
+	_, err = s.k.SellOrdersByMarket(s.ctx, &marketplace.QuerySellOrdersByMarketRequest{MarketId: 2})
+	assert.ErrorContains(t, err, "could not get market with id 2")
+}
+
+func TestGetAskAmountKey(t *testing.T) {
+	t.Parallel()
+	amounts := []string{"0", "1", "9", "10", "255", "256", "100000000000000000000000"}
+	var prev []byte
+	for i, amount := range amounts {
+		key, err := getAskAmountKey(amount)
+		assert.NilError(t, err)
+		if i > 0 {
+			// compare as the ORM does for length-prefixed non-terminal bytes
+			assert.Check(t, len(prev) < len(key) || (len(prev) == len(key) && string(prev) < string(key)), amount)
+		}
+		prev = key
+	}
+
+	_, err := getAskAmountKey("-1")
+	assert.ErrorContains(t, err, "invalid ask amount")
+	_, err = getAskAmountKey("foo")
+	assert.ErrorContains(t, err, "invalid ask amount")
+}
+
+func assertSellOrderIds(t *testing.T, sellOrders []*marketplace.SellOrderInfo, ids ...uint64) {
+	assert.Equal(t, len(ids), len(sellOrders))
+	for i, id := range ids {
+		assert.Equal(t, id, sellOrders[i].Id)
+	}
+}
+
+func gogoTimestamp(t time.Time) *gogotypes.Timestamp {
+	ts, err := gogotypes.TimestampProto(t)
+	if err != nil {
+		panic(err)
+	}
+	return ts
+}
+
+func askPrice(amount int64) *sdk.Coin {
+	coin := sdk.NewInt64Coin(ask.Denom, amount)
+	return &coin
+}
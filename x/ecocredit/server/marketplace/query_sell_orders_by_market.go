@@ -0,0 +1,53 @@
+package marketplace
+
+import (
+	"context"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// SellOrdersByMarket queries all sell orders within the given market sorted by ask price with optional
+// pagination
+func (k Keeper) SellOrdersByMarket(ctx context.Context, req *marketplace.QuerySellOrdersByMarketRequest) (*marketplace.QuerySellOrdersByMarketResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	market, err := k.stateStore.MarketTable().Get(ctx, req.MarketId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get market with id %d: %s", req.MarketId, err.Error())
+	}
+
+	it, err := k.stateStore.SellOrderTable().List(ctx, api.SellOrderMarketIdAskAmountKeyIndexKey{}.WithMarketId(market.Id), sellOrderListOptions(pg, req.IncludePrivate)...)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	orders := make([]*marketplace.SellOrderInfo, 0, 10)
+	for it.Next() {
+		order, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := k.getSellOrderInfo(ctx, order)
+		if err != nil {
+			return nil, err
+		}
+
+		orders = append(orders, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QuerySellOrdersByMarketResponse{SellOrders: orders, Pagination: pr}, nil
+}
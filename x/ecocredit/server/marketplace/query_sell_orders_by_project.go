@@ -0,0 +1,64 @@
+package marketplace
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// SellOrdersByProject queries all sell orders of credits from the given project with an optional filter for
+// the batch start date and with optional pagination
+func (k Keeper) SellOrdersByProject(ctx context.Context, req *marketplace.QuerySellOrdersByProjectRequest) (*marketplace.QuerySellOrdersByProjectResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := k.coreStore.ProjectTable().GetById(ctx, req.ProjectId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get project with id %s: %s", req.ProjectId, err.Error())
+	}
+
+	it, err := k.listSellOrdersByBatchStartDate(
+		ctx,
+		api.SellOrderProjectKeyBatchStartDateIndexKey{}.WithProjectKey(project.Key),
+		func(date *timestamppb.Timestamp) api.SellOrderIndexKey {
+			return api.SellOrderProjectKeyBatchStartDateIndexKey{}.WithProjectKeyBatchStartDate(project.Key, date)
+		},
+		req.MinBatchStartDate,
+		req.MaxBatchStartDate,
+		sellOrderListOptions(pg, req.IncludePrivate)...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	orders := make([]*marketplace.SellOrderInfo, 0, 10)
+	for it.Next() {
+		order, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := k.getSellOrderInfo(ctx, order)
+		if err != nil {
+			return nil, err
+		}
+
+		orders = append(orders, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QuerySellOrdersByProjectResponse{SellOrders: orders, Pagination: pr}, nil
+}
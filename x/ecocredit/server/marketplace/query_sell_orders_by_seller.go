@@ -3,7 +3,6 @@ package marketplace
 import (
 	"context"
 
-	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
@@ -24,7 +23,7 @@ func (k Keeper) SellOrdersBySeller(ctx context.Context, req *marketplace.QuerySe
 		return nil, err
 	}
 
-	it, err := k.stateStore.SellOrderTable().List(ctx, api.SellOrderSellerIndexKey{}.WithSeller(seller), ormlist.Paginate(pg))
+	it, err := k.stateStore.SellOrderTable().List(ctx, api.SellOrderSellerIndexKey{}.WithSeller(seller), sellOrderListOptions(pg, req.IncludePrivate)...)
 	if err != nil {
 		return nil, err
 	}
@@ -48,6 +47,8 @@ func (k Keeper) SellOrdersBySeller(ctx context.Context, req *marketplace.QuerySe
 			return nil, err
 		}
 
+		allowedBuyers, buyerGroupPolicy := getSellOrderBuyers(order)
+
 		info := marketplace.SellOrderInfo{
 			Id:                order.Id,
 			Seller:            sellerString,
@@ -57,6 +58,10 @@ func (k Keeper) SellOrdersBySeller(ctx context.Context, req *marketplace.QuerySe
 			AskAmount:         order.AskAmount,
 			DisableAutoRetire: order.DisableAutoRetire,
 			Expiration:        types.ProtobufToGogoTimestamp(order.Expiration),
+			AllowedBuyers:     allowedBuyers,
+			BuyerGroupPolicy:  buyerGroupPolicy,
+			MinFillQuantity:   order.MinFillQuantity,
+			AllOrNone:         order.AllOrNone,
 		}
 
 		orders = append(orders, &info)
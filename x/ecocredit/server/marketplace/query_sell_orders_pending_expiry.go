@@ -0,0 +1,57 @@
+package marketplace
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// SellOrdersPendingExpiry queries all sell orders with an expiration sorted by expiration with optional
+// pagination
+func (k Keeper) SellOrdersPendingExpiry(ctx context.Context, req *marketplace.QuerySellOrdersPendingExpiryRequest) (*marketplace.QuerySellOrdersPendingExpiryResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	// sell orders with a nil expiration are encoded as the 0 value timestamp
+	fromKey := api.SellOrderExpirationIndexKey{}.WithExpiration(timestamppb.New(time.Unix(0, 1)))
+	toKey := api.SellOrderExpirationIndexKey{}
+	if req.ExpiresBefore != nil {
+		toKey = toKey.WithExpiration(types.GogoToProtobufTimestamp(req.ExpiresBefore))
+	}
+
+	it, err := k.stateStore.SellOrderTable().ListRange(ctx, fromKey, toKey, sellOrderListOptions(pg, req.IncludePrivate)...)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	orders := make([]*marketplace.SellOrderInfo, 0, 10)
+	for it.Next() {
+		order, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := k.getSellOrderInfo(ctx, order)
+		if err != nil {
+			return nil, err
+		}
+
+		orders = append(orders, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QuerySellOrdersPendingExpiryResponse{SellOrders: orders, Pagination: pr}, nil
+}
@@ -0,0 +1,46 @@
+package marketplace
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func TestSellOrdersPendingExpiry(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 1)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+
+	first, err := types.ParseDate("expiration", "2030-01-01")
+	assert.NilError(t, err)
+	second, err := types.ParseDate("expiration", "2031-01-01")
+	assert.NilError(t, err)
+
+	ids := s.createSellOrder(&marketplace.MsgSell{
+		Seller: s.addrs[0].String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: "10", AskPrice: &ask, Expiration: &second},
+			{BatchDenom: batchDenom, Quantity: "10", AskPrice: &ask},
+			{BatchDenom: batchDenom, Quantity: "10", AskPrice: &ask, Expiration: &first},
+		},
+	})
+
+	// sell orders without an expiration are not included
+	res, err := s.k.SellOrdersPendingExpiry(s.ctx, &marketplace.QuerySellOrdersPendingExpiryRequest{
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assertSellOrderIds(t, res.SellOrders, ids[2], ids[0])
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+
+	res, err = s.k.SellOrdersPendingExpiry(s.ctx, &marketplace.QuerySellOrdersPendingExpiryRequest{
+		ExpiresBefore: gogoTimestamp(first),
+	})
+	assert.NilError(t, err)
+	assertSellOrderIds(t, res.SellOrders, ids[2])
+}
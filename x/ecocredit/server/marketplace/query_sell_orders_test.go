@@ -46,6 +46,51 @@ func TestSellOrders(t *testing.T) {
 	assert.Equal(t, uint64(2), res.Pagination.Total)
 }
 
+func TestSellOrders_Private(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 3)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], classId, start, end, creditType)
+
+	public := insertSellOrder(t, s, s.addrs[0], 1)
+	private := &api.SellOrder{
+		Seller:        s.addrs[0],
+		BatchKey:      1,
+		Quantity:      "10",
+		MarketId:      1,
+		AskAmount:     "10",
+		Expiration:    timestamppb.Now(),
+		AllowedBuyers: [][]byte{s.addrs[1]},
+	}
+	assert.NilError(t, s.marketStore.SellOrderTable().Insert(s.ctx, private))
+
+	res, err := s.k.SellOrders(s.ctx, &marketplace.QuerySellOrdersRequest{})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.SellOrders))
+	assertOrderEqual(t, s.ctx, s.k, res.SellOrders[0], public)
+
+	res, err = s.k.SellOrders(s.ctx, &marketplace.QuerySellOrdersRequest{IncludePrivate: true})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(res.SellOrders))
+	assertOrderEqual(t, s.ctx, s.k, res.SellOrders[1], private)
+	assert.DeepEqual(t, []string{s.addrs[1].String()}, res.SellOrders[1].AllowedBuyers)
+
+	batchRes, err := s.k.SellOrdersByBatch(s.ctx, &marketplace.QuerySellOrdersByBatchRequest{BatchDenom: batchDenom})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(batchRes.SellOrders))
+
+	batchRes, err = s.k.SellOrdersByBatch(s.ctx, &marketplace.QuerySellOrdersByBatchRequest{BatchDenom: batchDenom, IncludePrivate: true})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(batchRes.SellOrders))
+
+	sellerRes, err := s.k.SellOrdersBySeller(s.ctx, &marketplace.QuerySellOrdersBySellerRequest{Seller: s.addrs[0].String()})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(sellerRes.SellOrders))
+
+	sellerRes, err = s.k.SellOrdersBySeller(s.ctx, &marketplace.QuerySellOrdersBySellerRequest{Seller: s.addrs[0].String(), IncludePrivate: true})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(sellerRes.SellOrders))
+}
+
 func insertSellOrder(t *testing.T, s *baseSuite, addr sdk.AccAddress, batchKey uint64) *api.SellOrder {
 	sellOrder := &api.SellOrder{
 		Seller:            addr,
@@ -81,6 +126,7 @@ func assertOrderEqual(t *testing.T, ctx context.Context, k Keeper, received *mar
 		DisableAutoRetire: order.DisableAutoRetire,
 		Expiration:        types.ProtobufToGogoTimestamp(order.Expiration),
 	}
+	info.AllowedBuyers, info.BuyerGroupPolicy = getSellOrderBuyers(order)
 
 	assert.DeepEqual(t, info, *received)
 }
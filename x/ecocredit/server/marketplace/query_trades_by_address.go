@@ -0,0 +1,58 @@
+package marketplace
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// TradesByAddress queries all trades in which an account was the buyer or the seller with optional pagination.
+func (k Keeper) TradesByAddress(ctx context.Context, req *marketplace.QueryTradesByAddressRequest) (*marketplace.QueryTradesByAddressResponse, error) {
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.AddressTradeTable().List(ctx, api.AddressTradeAddressTradeIdIndexKey{}.WithAddress(addr), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	trades := make([]*marketplace.TradeInfo, 0, 10)
+	for it.Next() {
+		addressTrade, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		trade, err := k.stateStore.TradeTable().Get(ctx, addressTrade.TradeId)
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := k.getTradeInfo(ctx, trade)
+		if err != nil {
+			return nil, err
+		}
+
+		trades = append(trades, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QueryTradesByAddressResponse{Trades: trades, Pagination: pr}, nil
+}
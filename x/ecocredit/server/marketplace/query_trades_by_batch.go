@@ -0,0 +1,53 @@
+package marketplace
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// TradesByBatch queries all trades of credits from a specific batch denom with optional pagination.
+func (k Keeper) TradesByBatch(ctx context.Context, req *marketplace.QueryTradesByBatchRequest) (*marketplace.QueryTradesByBatchResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := k.coreStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", req.BatchDenom, err.Error())
+	}
+
+	it, err := k.stateStore.TradeTable().List(ctx, api.TradeBatchKeyIndexKey{}.WithBatchKey(batch.Key), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	trades := make([]*marketplace.TradeInfo, 0, 10)
+	for it.Next() {
+		trade, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := k.getTradeInfo(ctx, trade)
+		if err != nil {
+			return nil, err
+		}
+
+		trades = append(trades, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QueryTradesByBatchResponse{Trades: trades, Pagination: pr}, nil
+}
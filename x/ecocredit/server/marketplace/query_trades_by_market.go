@@ -0,0 +1,53 @@
+package marketplace
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// TradesByMarket queries all trades in a specific market ordered by the time of the trade with optional
+// pagination.
+func (k Keeper) TradesByMarket(ctx context.Context, req *marketplace.QueryTradesByMarketRequest) (*marketplace.QueryTradesByMarketResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := k.stateStore.MarketTable().Get(ctx, req.MarketId); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get market with id %d: %s", req.MarketId, err.Error())
+	}
+
+	it, err := k.stateStore.TradeTable().List(ctx, api.TradeMarketIdTimestampIndexKey{}.WithMarketId(req.MarketId), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	trades := make([]*marketplace.TradeInfo, 0, 10)
+	for it.Next() {
+		trade, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := k.getTradeInfo(ctx, trade)
+		if err != nil {
+			return nil, err
+		}
+
+		trades = append(trades, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QueryTradesByMarketResponse{Trades: trades, Pagination: pr}, nil
+}
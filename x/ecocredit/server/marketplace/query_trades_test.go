@@ -0,0 +1,92 @@
+package marketplace
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func TestQueryTradesByBatch(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 3)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	now := time.Now().UTC()
+	s.insertTrade(s.addrs[1], s.addrs[0], "10", "10", now)
+	s.insertTrade(s.addrs[2], s.addrs[0], "5", "12", now)
+
+	res, err := s.k.TradesByBatch(s.ctx, &marketplace.QueryTradesByBatchRequest{
+		BatchDenom: batchDenom,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Trades))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+
+	trade := res.Trades[0]
+	assert.Equal(t, uint64(1), trade.Id)
+	assert.Equal(t, s.addrs[1].String(), trade.Buyer)
+	assert.Equal(t, s.addrs[0].String(), trade.Seller)
+	assert.Equal(t, batchDenom, trade.BatchDenom)
+	assert.Equal(t, "10", trade.Quantity)
+	assert.Equal(t, ask.Denom, trade.BankDenom)
+	assert.Equal(t, "10", trade.UnitPrice)
+	assert.Equal(t, now.Unix(), trade.Timestamp.Seconds)
+
+	// batch not found
+	_, err = s.k.TradesByBatch(s.ctx, &marketplace.QueryTradesByBatchRequest{BatchDenom: "C01-001-20200101-20200201-002"})
+	assert.ErrorContains(t, err, "could not get batch with denom")
+}
+
+func TestQueryTradesByMarket(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	now := time.Now().UTC()
+
+	// trades are ordered by time
+	s.insertTrade(s.addrs[1], s.addrs[0], "10", "10", now)
+	s.insertTrade(s.addrs[1], s.addrs[0], "5", "12", now.Add(-time.Hour))
+
+	res, err := s.k.TradesByMarket(s.ctx, &marketplace.QueryTradesByMarketRequest{MarketId: 1})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(res.Trades))
+	assert.Equal(t, uint64(2), res.Trades[0].Id)
+	assert.Equal(t, uint64(1), res.Trades[1].Id)
+
+	// market not found
+	_, err = s.k.TradesByMarket(s.ctx, &marketplace.QueryTradesByMarketRequest{MarketId: 2})
+	assert.ErrorContains(t, err, "could not get market with id 2")
+}
+
+func TestQueryTradesByAddress(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 3)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	now := time.Now().UTC()
+	s.insertTrade(s.addrs[1], s.addrs[0], "10", "10", now)
+	s.insertTrade(s.addrs[2], s.addrs[1], "5", "12", now)
+
+	// trades as buyer and seller
+	res, err := s.k.TradesByAddress(s.ctx, &marketplace.QueryTradesByAddressRequest{Address: s.addrs[1].String()})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(res.Trades))
+	assert.Equal(t, s.addrs[1].String(), res.Trades[0].Buyer)
+	assert.Equal(t, s.addrs[1].String(), res.Trades[1].Seller)
+
+	res, err = s.k.TradesByAddress(s.ctx, &marketplace.QueryTradesByAddressRequest{
+		Address:    s.addrs[0].String(),
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Trades))
+	assert.Equal(t, uint64(1), res.Pagination.Total)
+
+	// invalid address
+	_, err = s.k.TradesByAddress(s.ctx, &marketplace.QueryTradesByAddressRequest{Address: "foo"})
+	assert.ErrorContains(t, err, "decoding bech32 failed")
+}
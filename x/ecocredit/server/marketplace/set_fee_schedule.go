@@ -0,0 +1,85 @@
+package marketplace
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// SetFeeSchedule is a gov handler method that sets the maker and taker fees of a market, or of all markets of
+// a credit type without market specific fees if the bank denom of the proposal is empty.
+func (k Keeper) SetFeeSchedule(ctx sdk.Context, p *marketplace.FeeScheduleProposal) error {
+	if p == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("nil proposal")
+	}
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	goCtx := sdk.WrapSDKContext(ctx)
+	if _, err := k.coreStore.CreditTypeTable().Get(goCtx, p.CreditTypeAbbrev); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("could not get credit type with abbreviation %s: %s", p.CreditTypeAbbrev, err)
+	}
+
+	if p.BankDenom == "" {
+		if err := k.stateStore.CreditTypeFeeTable().Save(goCtx, &api.CreditTypeFee{
+			CreditTypeAbbrev: p.CreditTypeAbbrev,
+			MakerFeeBps:      p.MakerFeeBps,
+			TakerFeeBps:      p.TakerFeeBps,
+		}); err != nil {
+			return err
+		}
+	} else {
+		marketId, err := k.getOrCreateMarketId(goCtx, p.CreditTypeAbbrev, p.BankDenom)
+		if err != nil {
+			return err
+		}
+		if err := k.stateStore.MarketFeeTable().Save(goCtx, &api.MarketFee{
+			MarketId:    marketId,
+			MakerFeeBps: p.MakerFeeBps,
+			TakerFeeBps: p.TakerFeeBps,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&marketplace.EventSetFeeSchedule{
+		CreditTypeAbbrev: p.CreditTypeAbbrev,
+		BankDenom:        p.BankDenom,
+		MakerFeeBps:      p.MakerFeeBps,
+		TakerFeeBps:      p.TakerFeeBps,
+	})
+}
+
+// SetFeeDestination is a gov handler method that sets the destination of marketplace fees.
+func (k Keeper) SetFeeDestination(ctx sdk.Context, p *marketplace.FeeDestinationProposal) error {
+	if p == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("nil proposal")
+	}
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	var address sdk.AccAddress
+	if p.Address != "" {
+		var err error
+		address, err = sdk.AccAddressFromBech32(p.Address)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := k.stateStore.FeeDestinationTable().Save(sdk.WrapSDKContext(ctx), &api.FeeDestination{
+		Destination: api.FeeDestinationType(p.Destination),
+		Address:     address,
+	}); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&marketplace.EventSetFeeDestination{
+		Destination: p.Destination,
+		Address:     p.Address,
+	})
+}
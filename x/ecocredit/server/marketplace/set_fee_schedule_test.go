@@ -0,0 +1,114 @@
+package marketplace
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func TestSetFeeSchedule(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 1)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+
+	// credit type fees
+	err := s.k.SetFeeSchedule(s.sdkCtx, &marketplace.FeeScheduleProposal{
+		Title:            "foo",
+		Description:      "bar",
+		CreditTypeAbbrev: creditType.Abbreviation,
+		MakerFeeBps:      100,
+		TakerFeeBps:      200,
+	})
+	assert.NilError(t, err)
+	ctFee, err := s.marketStore.CreditTypeFeeTable().Get(s.ctx, creditType.Abbreviation)
+	assert.NilError(t, err)
+	assert.Equal(t, uint32(100), ctFee.MakerFeeBps)
+	assert.Equal(t, uint32(200), ctFee.TakerFeeBps)
+
+	// market fees for an existing market
+	err = s.k.SetFeeSchedule(s.sdkCtx, &marketplace.FeeScheduleProposal{
+		Title:            "foo",
+		Description:      "bar",
+		CreditTypeAbbrev: creditType.Abbreviation,
+		BankDenom:        ask.Denom,
+		MakerFeeBps:      50,
+		TakerFeeBps:      0,
+	})
+	assert.NilError(t, err)
+	marketFee, err := s.marketStore.MarketFeeTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, uint32(50), marketFee.MakerFeeBps)
+	assert.Equal(t, uint32(0), marketFee.TakerFeeBps)
+
+	// market fees for a new market
+	err = s.k.SetFeeSchedule(s.sdkCtx, &marketplace.FeeScheduleProposal{
+		Title:            "foo",
+		Description:      "bar",
+		CreditTypeAbbrev: creditType.Abbreviation,
+		BankDenom:        "uatom",
+		MakerFeeBps:      10,
+		TakerFeeBps:      20,
+	})
+	assert.NilError(t, err)
+	market, err := s.marketStore.MarketTable().GetByCreditTypeAbbrevBankDenom(s.ctx, creditType.Abbreviation, "uatom")
+	assert.NilError(t, err)
+	marketFee, err = s.marketStore.MarketFeeTable().Get(s.ctx, market.Id)
+	assert.NilError(t, err)
+	assert.Equal(t, uint32(10), marketFee.MakerFeeBps)
+	assert.Equal(t, uint32(20), marketFee.TakerFeeBps)
+
+	// credit type not found
+	err = s.k.SetFeeSchedule(s.sdkCtx, &marketplace.FeeScheduleProposal{
+		Title:            "foo",
+		Description:      "bar",
+		CreditTypeAbbrev: "BIO",
+	})
+	assert.ErrorContains(t, err, "could not get credit type with abbreviation BIO")
+
+	// fee exceeds max
+	err = s.k.SetFeeSchedule(s.sdkCtx, &marketplace.FeeScheduleProposal{
+		Title:            "foo",
+		Description:      "bar",
+		CreditTypeAbbrev: creditType.Abbreviation,
+		MakerFeeBps:      marketplace.MaxFeeBps + 1,
+	})
+	assert.ErrorContains(t, err, "fee cannot exceed")
+}
+
+func TestSetFeeDestination(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 1)
+
+	err := s.k.SetFeeDestination(s.sdkCtx, &marketplace.FeeDestinationProposal{
+		Title:       "foo",
+		Description: "bar",
+		Destination: marketplace.FeeDestinationType_FEE_DESTINATION_TYPE_ACCOUNT,
+		Address:     s.addrs[0].String(),
+	})
+	assert.NilError(t, err)
+	dest, err := s.marketStore.FeeDestinationTable().Get(s.ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, int32(marketplace.FeeDestinationType_FEE_DESTINATION_TYPE_ACCOUNT), int32(dest.Destination))
+	assert.DeepEqual(t, []byte(s.addrs[0]), dest.Address)
+
+	err = s.k.SetFeeDestination(s.sdkCtx, &marketplace.FeeDestinationProposal{
+		Title:       "foo",
+		Description: "bar",
+		Destination: marketplace.FeeDestinationType_FEE_DESTINATION_TYPE_BURN,
+	})
+	assert.NilError(t, err)
+	dest, err = s.marketStore.FeeDestinationTable().Get(s.ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, int32(marketplace.FeeDestinationType_FEE_DESTINATION_TYPE_BURN), int32(dest.Destination))
+	assert.Equal(t, 0, len(dest.Address))
+
+	// address required for account destination
+	err = s.k.SetFeeDestination(s.sdkCtx, &marketplace.FeeDestinationProposal{
+		Title:       "foo",
+		Description: "bar",
+		Destination: marketplace.FeeDestinationType_FEE_DESTINATION_TYPE_ACCOUNT,
+	})
+	assert.ErrorContains(t, err, "fee destination address")
+}
@@ -0,0 +1,324 @@
+package marketplace
+
+import (
+	"context"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// SettleSealedBidAuctions is an EndBlock function that settles sealed-bid auctions that have reached their
+// reveal end height. Each auction is settled in a cached context so that an auction that cannot be settled
+// neither leaves partial state changes nor prevents the other auctions from being settled. An auction that
+// cannot be settled is cancelled, and an auction that can neither be settled nor cancelled is logged and
+// retried in the following block.
+func (k Keeper) SettleSealedBidAuctions(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	auctions, err := k.getSealedBidAuctionsToSettle(ctx, sdkCtx.BlockHeight())
+	if err != nil {
+		return err
+	}
+
+	for _, auction := range auctions {
+		err := k.applyCached(sdkCtx, func(ctx context.Context) error {
+			return k.settleSealedBidAuction(ctx, auction)
+		})
+		if err == nil {
+			continue
+		}
+		sdkCtx.Logger().Error("failed to settle sealed-bid auction", "auction_id", auction.Id, "err", err)
+
+		err = k.applyCached(sdkCtx, func(ctx context.Context) error {
+			return k.cancelSealedBidAuction(ctx, auction)
+		})
+		if err != nil {
+			sdkCtx.Logger().Error("failed to cancel sealed-bid auction", "auction_id", auction.Id, "err", err)
+		}
+	}
+
+	return nil
+}
+
+// applyCached runs fn in a cached context and writes the state changes and events of fn to sdkCtx only
+// if fn does not return an error.
+func (k Keeper) applyCached(sdkCtx sdk.Context, fn func(ctx context.Context) error) error {
+	cacheCtx, write := sdkCtx.CacheContext()
+	if err := fn(sdk.WrapSDKContext(cacheCtx)); err != nil {
+		return err
+	}
+	write()
+	sdkCtx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
+
+// getSealedBidAuctionsToSettle returns the sealed-bid auctions with a reveal end height before or at height.
+func (k Keeper) getSealedBidAuctionsToSettle(ctx context.Context, height int64) ([]*api.SealedBidAuction, error) {
+	fromKey := api.SealedBidAuctionRevealEndHeightIndexKey{}.WithRevealEndHeight(0)
+	toKey := api.SealedBidAuctionRevealEndHeightIndexKey{}.WithRevealEndHeight(height)
+	it, err := k.stateStore.SealedBidAuctionTable().ListRange(ctx, fromKey, toKey)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var auctions []*api.SealedBidAuction
+	for it.Next() {
+		auction, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		auctions = append(auctions, auction)
+	}
+
+	return auctions, nil
+}
+
+// getSealedBids returns the bids of the sealed-bid auction ordered by bidder address.
+func (k Keeper) getSealedBids(ctx context.Context, auctionId uint64) ([]*api.SealedBid, error) {
+	it, err := k.stateStore.SealedBidTable().List(ctx, api.SealedBidPrimaryKey{}.WithAuctionId(auctionId))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var bids []*api.SealedBid
+	for it.Next() {
+		bid, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		bids = append(bids, bid)
+	}
+
+	return bids, nil
+}
+
+// revealedBid is a revealed sealed bid with its parsed quantity and bid amount.
+type revealedBid struct {
+	bid       *api.SealedBid
+	quantity  math.Dec
+	bidAmount sdk.Int
+	deposit   sdk.Int
+}
+
+// settleSealedBidAuction settles a sealed-bid auction at a uniform clearing price. It will:
+// - allocate credits to revealed bids from the highest bid amount to the lowest bid amount, with ties
+// broken by bidder address, until all credits are allocated.
+// - set the clearing price to the lowest bid amount that was allocated credits.
+// - move credits from the seller's escrowed balance to each winning bidder's tradable/retired balance.
+// - send the clearing price for the credits sold minus the maker fee to the seller.
+// - collect the maker fee and the taker fee from the escrowed deposits.
+// - return the remaining deposit of each bidder, including bids that were not revealed.
+// - return the credits that were not sold to the seller's tradable balance.
+// - record a trade for each winning bid and remove the auction and its bids.
+// The auction is cancelled rather than settled if the credit batch of the auction is frozen.
+func (k Keeper) settleSealedBidAuction(ctx context.Context, auction *api.SealedBidAuction) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	batch, err := k.coreStore.BatchTable().Get(ctx, auction.BatchKey)
+	if err != nil {
+		return err
+	}
+
+	// credits from a frozen credit batch cannot be traded
+	if utils.AssertBatchNotFrozen(batch) != nil {
+		return k.cancelSealedBidAuction(ctx, auction)
+	}
+
+	sealedBids, err := k.getSealedBids(ctx, auction.Id)
+	if err != nil {
+		return err
+	}
+
+	var bids []revealedBid
+	for _, bid := range sealedBids {
+		deposit, ok := sdk.NewIntFromString(bid.DepositAmount)
+		if !ok {
+			return sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", bid.DepositAmount, sdk.Int{})
+		}
+
+		rb := revealedBid{bid: bid, quantity: math.NewDecFromInt64(0), bidAmount: sdk.ZeroInt(), deposit: deposit}
+		if bid.Revealed {
+			if rb.quantity, err = math.NewDecFromString(bid.Quantity); err != nil {
+				return err
+			}
+			if rb.bidAmount, ok = sdk.NewIntFromString(bid.BidAmount); !ok {
+				return sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", bid.BidAmount, sdk.Int{})
+			}
+		}
+		bids = append(bids, rb)
+	}
+
+	// bids are listed by bidder address so a stable sort breaks ties by bidder address
+	sort.SliceStable(bids, func(i, j int) bool {
+		return bids[i].bidAmount.GT(bids[j].bidAmount)
+	})
+
+	remaining, err := math.NewDecFromString(auction.Quantity)
+	if err != nil {
+		return err
+	}
+
+	allocations := make([]math.Dec, len(bids))
+	clearingAmount := sdk.ZeroInt()
+	for i, bid := range bids {
+		allocations[i] = math.NewDecFromInt64(0)
+		if !bid.bid.Revealed || remaining.IsZero() {
+			continue
+		}
+
+		allocations[i] = bid.quantity
+		if remaining.Cmp(bid.quantity) == math.LessThan {
+			allocations[i] = remaining
+		}
+		if remaining, err = remaining.Sub(allocations[i]); err != nil {
+			return err
+		}
+		clearingAmount = bid.bidAmount
+	}
+
+	market, err := k.stateStore.MarketTable().Get(ctx, auction.MarketId)
+	if err != nil {
+		return err
+	}
+
+	sellerAcc := sdk.AccAddress(auction.Seller)
+	proceeds, fees := sdk.ZeroInt(), sdk.ZeroInt()
+	quantitySold := math.NewDecFromInt64(0)
+	for i, bid := range bids {
+		bidderAcc := sdk.AccAddress(bid.bid.Bidder)
+		refund := bid.deposit
+
+		if !allocations[i].IsZero() {
+			if err = k.transferEscrowedCredits(ctx, sellerAcc, bidderAcc, auction.BatchKey, allocations[i], orderOptions{
+				autoRetire:   !bid.bid.DisableAutoRetire,
+				batchDenom:   batch.Denom,
+				jurisdiction: bid.bid.RetirementJurisdiction,
+			}); err != nil {
+				return err
+			}
+
+			cost, err := getTotalCost(clearingAmount, allocations[i])
+			if err != nil {
+				return err
+			}
+			makerFee, takerFee := getFee(cost, auction.MakerFeeBps), getFee(cost, auction.TakerFeeBps)
+			proceeds = proceeds.Add(cost.Sub(makerFee))
+			fees = fees.Add(makerFee).Add(takerFee)
+			refund = refund.Sub(cost).Sub(takerFee)
+
+			if _, err = k.recordTrade(ctx, &api.Trade{
+				Buyer:              bidderAcc,
+				Seller:             sellerAcc,
+				BatchKey:           auction.BatchKey,
+				MarketId:           auction.MarketId,
+				SealedBidAuctionId: auction.Id,
+				Quantity:           allocations[i].String(),
+				UnitPrice:          clearingAmount.String(),
+			}); err != nil {
+				return err
+			}
+
+			if quantitySold, err = quantitySold.Add(allocations[i]); err != nil {
+				return err
+			}
+		}
+
+		if refund.IsPositive() {
+			coins := sdk.NewCoins(sdk.NewCoin(market.BankDenom, refund))
+			if err = k.bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, ecocredit.ModuleName, bidderAcc, coins); err != nil {
+				return err
+			}
+		}
+
+		if err = k.stateStore.SealedBidTable().Delete(ctx, bid.bid); err != nil {
+			return err
+		}
+	}
+
+	if proceeds.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(market.BankDenom, proceeds))
+		if err = k.bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, ecocredit.ModuleName, sellerAcc, coins); err != nil {
+			return err
+		}
+	}
+
+	if err = k.collectFees(ctx, market, fees); err != nil {
+		return err
+	}
+
+	if !remaining.IsZero() {
+		if err = k.unescrowCredits(ctx, sellerAcc, auction.BatchKey, remaining.String()); err != nil {
+			return err
+		}
+	}
+
+	if err = k.stateStore.SealedBidAuctionTable().Delete(ctx, auction); err != nil {
+		return err
+	}
+
+	var clearingPrice *sdk.Coin
+	if !quantitySold.IsZero() {
+		clearingPrice = newFeeCoin(market.BankDenom, clearingAmount)
+	}
+
+	return sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventSettleSealedBidAuction{
+		AuctionId:     auction.Id,
+		QuantitySold:  quantitySold.String(),
+		ClearingPrice: clearingPrice,
+	})
+}
+
+// cancelSealedBidAuction cancels a sealed-bid auction. It returns the deposit of each bidder, returns the
+// escrowed credits to the seller's tradable balance, and removes the auction and its bids.
+func (k Keeper) cancelSealedBidAuction(ctx context.Context, auction *api.SealedBidAuction) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	bids, err := k.getSealedBids(ctx, auction.Id)
+	if err != nil {
+		return err
+	}
+
+	market, err := k.stateStore.MarketTable().Get(ctx, auction.MarketId)
+	if err != nil {
+		return err
+	}
+
+	for _, bid := range bids {
+		deposit, ok := sdk.NewIntFromString(bid.DepositAmount)
+		if !ok {
+			return sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", bid.DepositAmount, sdk.Int{})
+		}
+
+		if deposit.IsPositive() {
+			coins := sdk.NewCoins(sdk.NewCoin(market.BankDenom, deposit))
+			if err = k.bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, ecocredit.ModuleName, sdk.AccAddress(bid.Bidder), coins); err != nil {
+				return err
+			}
+		}
+
+		if err = k.stateStore.SealedBidTable().Delete(ctx, bid); err != nil {
+			return err
+		}
+	}
+
+	if err = k.unescrowCredits(ctx, sdk.AccAddress(auction.Seller), auction.BatchKey, auction.Quantity); err != nil {
+		return err
+	}
+
+	if err = k.stateStore.SealedBidAuctionTable().Delete(ctx, auction); err != nil {
+		return err
+	}
+
+	return sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventCancelSealedBidAuction{
+		AuctionId: auction.Id,
+	})
+}
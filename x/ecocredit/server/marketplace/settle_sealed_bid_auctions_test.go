@@ -0,0 +1,296 @@
+package marketplace
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func (s *baseSuite) setBlockHeight(height int64) {
+	s.sdkCtx = s.sdkCtx.WithBlockHeight(height)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+}
+
+func (s *baseSuite) createSealedBidAuction(seller sdk.AccAddress, quantity string, reserveAmount int64) uint64 {
+	reservePrice := sdk.NewInt64Coin(ask.Denom, reserveAmount)
+	res, err := s.k.CreateSealedBidAuction(s.ctx, &marketplace.MsgCreateSealedBidAuction{
+		Seller:          seller.String(),
+		BatchDenom:      batchDenom,
+		Quantity:        quantity,
+		ReservePrice:    &reservePrice,
+		CommitEndHeight: 10,
+		RevealEndHeight: 20,
+	})
+	assert.NilError(s.t, err)
+	return res.AuctionId
+}
+
+func (s *baseSuite) commitSealedBid(auctionId uint64, bidder sdk.AccAddress, quantity, bidAmount string, deposit int64) {
+	s.expectEscrow(bidder, deposit)
+	depositCoin := sdk.NewInt64Coin(ask.Denom, deposit)
+	_, err := s.k.CommitSealedBid(s.ctx, &marketplace.MsgCommitSealedBid{
+		Bidder:     bidder.String(),
+		AuctionId:  auctionId,
+		Commitment: marketplace.SealedBidCommitment(auctionId, bidder.String(), quantity, bidAmount, "salt"),
+		Deposit:    &depositCoin,
+	})
+	assert.NilError(s.t, err)
+}
+
+func (s *baseSuite) revealSealedBid(auctionId uint64, bidder sdk.AccAddress, quantity, bidAmount string) error {
+	_, err := s.k.RevealSealedBid(s.ctx, &marketplace.MsgRevealSealedBid{
+		Bidder:                 bidder.String(),
+		AuctionId:              auctionId,
+		Quantity:               quantity,
+		BidAmount:              bidAmount,
+		Salt:                   "salt",
+		RetirementJurisdiction: "US-WA",
+	})
+	return err
+}
+
+func TestSettleSealedBidAuctions(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 5)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, b1, b2, b3, b4 := s.addrs[0], s.addrs[1], s.addrs[2], s.addrs[3], s.addrs[4]
+	s.setCreditTypeFees(100, 200)
+	assert.NilError(t, s.marketStore.FeeDestinationTable().Save(s.ctx, &api.FeeDestination{
+		Destination: api.FeeDestinationType_FEE_DESTINATION_TYPE_BURN,
+	}))
+
+	s.setBlockHeight(1)
+	id := s.createSealedBidAuction(seller, "50", 10)
+
+	s.commitSealedBid(id, b1, "30", "20", 700)
+	s.commitSealedBid(id, b2, "30", "15", 500)
+	s.commitSealedBid(id, b3, "10", "12", 200)
+	s.commitSealedBid(id, b4, "10", "25", 100)
+
+	s.setBlockHeight(11)
+	assert.NilError(t, s.revealSealedBid(id, b1, "30", "20"))
+	assert.NilError(t, s.revealSealedBid(id, b2, "30", "15"))
+	assert.NilError(t, s.revealSealedBid(id, b3, "10", "12"))
+
+	// the auction is not settled before the reveal end height
+	s.setBlockHeight(19)
+	assert.NilError(t, s.k.SettleSealedBidAuctions(s.ctx))
+	_, err := s.marketStore.SealedBidAuctionTable().Get(s.ctx, id)
+	assert.NilError(t, err)
+
+	// b1 is allocated 30 and b2 is allocated the remaining 20 at a clearing price of 15, each winner
+	// is refunded their deposit minus the cost and the taker fee (700 - 450 - 9, 500 - 300 - 6), b3
+	// was outbid and b4 did not reveal so both are refunded their full deposit, the seller receives
+	// the cost minus the maker fees (750 - 4 - 3) and the fees are burned (4 + 9 + 3 + 6)
+	s.expectRelease(b1, 241)
+	s.expectRelease(b2, 194)
+	s.expectRelease(b3, 200)
+	s.expectRelease(b4, 100)
+	s.expectRelease(seller, 743)
+	s.bankKeeper.EXPECT().BurnCoins(gmAny, ecocredit.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 22))).Return(nil).Times(1)
+
+	s.setBlockHeight(20)
+	assert.NilError(t, s.k.SettleSealedBidAuctions(s.ctx))
+
+	_, err = s.marketStore.SealedBidAuctionTable().Get(s.ctx, id)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+	_, err = s.marketStore.SealedBidTable().Get(s.ctx, id, b1)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	sellerBal, err := s.coreStore.BatchBalanceTable().Get(s.ctx, seller, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "50", sellerBal.TradableAmount)
+	assert.Equal(t, "0", sellerBal.EscrowedAmount)
+
+	b1Bal, err := s.coreStore.BatchBalanceTable().Get(s.ctx, b1, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "30", b1Bal.RetiredAmount)
+
+	b2Bal, err := s.coreStore.BatchBalanceTable().Get(s.ctx, b2, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "20", b2Bal.RetiredAmount)
+
+	_, err = s.coreStore.BatchBalanceTable().Get(s.ctx, b3, 1)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	for tradeId, quantity := range map[uint64]string{1: "30", 2: "20"} {
+		trade, err := s.marketStore.TradeTable().Get(s.ctx, tradeId)
+		assert.NilError(t, err)
+		assert.Equal(t, id, trade.SealedBidAuctionId)
+		assert.Equal(t, quantity, trade.Quantity)
+		assert.Equal(t, "15", trade.UnitPrice)
+	}
+}
+
+func TestSettleSealedBidAuctions_NoBids(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, bidder := s.addrs[0], s.addrs[1]
+
+	s.setBlockHeight(1)
+	id := s.createSealedBidAuction(seller, "50", 10)
+	s.commitSealedBid(id, bidder, "10", "12", 200)
+
+	// the bid is not revealed so the deposit is refunded and the credits are returned to the seller
+	s.expectRelease(bidder, 200)
+	s.setBlockHeight(25)
+	assert.NilError(t, s.k.SettleSealedBidAuctions(s.ctx))
+
+	_, err := s.marketStore.SealedBidAuctionTable().Get(s.ctx, id)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	sellerBal, err := s.coreStore.BatchBalanceTable().Get(s.ctx, seller, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "100", sellerBal.TradableAmount)
+	assert.Equal(t, "0", sellerBal.EscrowedAmount)
+}
+
+func TestSettleSealedBidAuctions_FrozenBatch(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, bidder := s.addrs[0], s.addrs[1]
+
+	s.setBlockHeight(1)
+	id := s.createSealedBidAuction(seller, "50", 10)
+	s.commitSealedBid(id, bidder, "10", "12", 200)
+
+	s.setBlockHeight(11)
+	assert.NilError(t, s.revealSealedBid(id, bidder, "10", "12"))
+
+	batch, err := s.coreStore.BatchTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	batch.Frozen = true
+	assert.NilError(t, s.coreStore.BatchTable().Update(s.ctx, batch))
+
+	// the auction is cancelled, the deposit is refunded and the credits are returned to the seller
+	s.expectRelease(bidder, 200)
+	s.setBlockHeight(20)
+	assert.NilError(t, s.k.SettleSealedBidAuctions(s.ctx))
+
+	_, err = s.marketStore.SealedBidAuctionTable().Get(s.ctx, id)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+	_, err = s.marketStore.SealedBidTable().Get(s.ctx, id, bidder)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	sellerBal, err := s.coreStore.BatchBalanceTable().Get(s.ctx, seller, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "100", sellerBal.TradableAmount)
+	assert.Equal(t, "0", sellerBal.EscrowedAmount)
+
+	_, err = s.coreStore.BatchBalanceTable().Get(s.ctx, bidder, 1)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+}
+
+func TestSettleSealedBidAuctions_InvalidAuction(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 3)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, b1, b2 := s.addrs[0], s.addrs[1], s.addrs[2]
+
+	s.setBlockHeight(1)
+	invalidId := s.createSealedBidAuction(seller, "50", 10)
+	s.commitSealedBid(invalidId, b1, "10", "12", 200)
+	validId := s.createSealedBidAuction(seller, "50", 10)
+	s.commitSealedBid(validId, b2, "10", "12", 200)
+
+	// the auction can neither be settled nor cancelled with an invalid deposit amount
+	bid, err := s.marketStore.SealedBidTable().Get(s.ctx, invalidId, b1)
+	assert.NilError(t, err)
+	bid.DepositAmount = "foo"
+	assert.NilError(t, s.marketStore.SealedBidTable().Update(s.ctx, bid))
+
+	// the invalid auction does not prevent the valid auction from being settled
+	s.expectRelease(b2, 200)
+	s.setBlockHeight(20)
+	assert.NilError(t, s.k.SettleSealedBidAuctions(s.ctx))
+
+	_, err = s.marketStore.SealedBidAuctionTable().Get(s.ctx, invalidId)
+	assert.NilError(t, err)
+	_, err = s.marketStore.SealedBidAuctionTable().Get(s.ctx, validId)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+}
+
+func TestSealedBid_Invalid(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 3)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, bidder, other := s.addrs[0], s.addrs[1], s.addrs[2]
+
+	// commit end height must be in the future
+	s.setBlockHeight(10)
+	reservePrice := sdk.NewInt64Coin(ask.Denom, 10)
+	_, err := s.k.CreateSealedBidAuction(s.ctx, &marketplace.MsgCreateSealedBidAuction{
+		Seller:          seller.String(),
+		BatchDenom:      batchDenom,
+		Quantity:        "10",
+		ReservePrice:    &reservePrice,
+		CommitEndHeight: 10,
+		RevealEndHeight: 20,
+	})
+	assert.ErrorContains(t, err, "commit end height must be greater than the current block height")
+
+	s.setBlockHeight(1)
+	id := s.createSealedBidAuction(seller, "50", 10)
+	deposit := sdk.NewInt64Coin(ask.Denom, 100)
+	commitment := marketplace.SealedBidCommitment(id, bidder.String(), "10", "9", "salt")
+
+	// seller cannot bid on their own auction
+	_, err = s.k.CommitSealedBid(s.ctx, &marketplace.MsgCommitSealedBid{
+		Bidder:     seller.String(),
+		AuctionId:  id,
+		Commitment: commitment,
+		Deposit:    &deposit,
+	})
+	assert.ErrorContains(t, err, "bidder account cannot be the same as seller account")
+
+	// deposit denom must match the auction denom
+	_, err = s.k.CommitSealedBid(s.ctx, &marketplace.MsgCommitSealedBid{
+		Bidder:     bidder.String(),
+		AuctionId:  id,
+		Commitment: commitment,
+		Deposit:    &sdk.Coin{Denom: "uatom", Amount: sdk.NewInt(100)},
+	})
+	assert.ErrorContains(t, err, "uatom")
+
+	s.commitSealedBid(id, bidder, "10", "9", 100)
+
+	// only one bid per bidder
+	_, err = s.k.CommitSealedBid(s.ctx, &marketplace.MsgCommitSealedBid{
+		Bidder:     bidder.String(),
+		AuctionId:  id,
+		Commitment: commitment,
+		Deposit:    &deposit,
+	})
+	assert.ErrorContains(t, err, "bidder has already committed a bid")
+
+	// bids cannot be revealed during the commit phase
+	err = s.revealSealedBid(id, bidder, "10", "9")
+	assert.ErrorContains(t, err, "bids can only be revealed from height 11 to height 20")
+
+	// bids cannot be committed after the commit phase
+	s.setBlockHeight(11)
+	_, err = s.k.CommitSealedBid(s.ctx, &marketplace.MsgCommitSealedBid{
+		Bidder:     other.String(),
+		AuctionId:  id,
+		Commitment: commitment,
+		Deposit:    &deposit,
+	})
+	assert.ErrorContains(t, err, "commit phase ended at height 10")
+
+	// revealed bid must match the commitment
+	err = s.revealSealedBid(id, bidder, "10", "12")
+	assert.ErrorContains(t, err, "revealed bid does not match commitment")
+
+	// bid amount must not be below the reserve price
+	err = s.revealSealedBid(id, bidder, "10", "9")
+	assert.ErrorContains(t, err, "insufficient bid amount")
+}
@@ -0,0 +1,82 @@
+package marketplace
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// recordTrade stores the trade and indexes the trade by the address of the buyer and the seller. The
+// trade is recorded at the current block time.
+func (k Keeper) recordTrade(ctx context.Context, trade *api.Trade) (uint64, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	trade.Timestamp = timestamppb.New(sdkCtx.BlockTime())
+
+	id, err := k.stateStore.TradeTable().InsertReturningID(ctx, trade)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, addr := range [][]byte{trade.Buyer, trade.Seller} {
+		if err = k.stateStore.AddressTradeTable().Insert(ctx, &api.AddressTrade{
+			Address: addr,
+			TradeId: id,
+		}); err != nil {
+			return 0, err
+		}
+	}
+
+	return id, nil
+}
+
+// getTradeInfo converts a trade from state into the trade info returned by queries.
+func (k Keeper) getTradeInfo(ctx context.Context, trade *api.Trade) (*marketplace.TradeInfo, error) {
+	batch, err := k.coreStore.BatchTable().Get(ctx, trade.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+
+	market, err := k.stateStore.MarketTable().Get(ctx, trade.MarketId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.TradeInfo{
+		Id:                 trade.Id,
+		Buyer:              sdk.AccAddress(trade.Buyer).String(),
+		Seller:             sdk.AccAddress(trade.Seller).String(),
+		BatchDenom:         batch.Denom,
+		MarketId:           trade.MarketId,
+		SellOrderId:        trade.SellOrderId,
+		BuyOrderId:         trade.BuyOrderId,
+		Quantity:           trade.Quantity,
+		BankDenom:          market.BankDenom,
+		UnitPrice:          trade.UnitPrice,
+		Timestamp:          types.ProtobufToGogoTimestamp(trade.Timestamp),
+		OfferId:            trade.OfferId,
+		DutchAuctionId:     trade.DutchAuctionId,
+		SealedBidAuctionId: trade.SealedBidAuctionId,
+		ForwardContractId:  trade.ForwardContractId,
+	}, nil
+}
+
+// newSellOrderTrade returns a trade of credits from the sell order at the ask price of the sell order.
+func newSellOrderTrade(sellOrder *api.SellOrder, buyOrderId uint64, buyer sdk.AccAddress, quantity math.Dec) *api.Trade {
+	return &api.Trade{
+		Buyer:       buyer,
+		Seller:      sellOrder.Seller,
+		BatchKey:    sellOrder.BatchKey,
+		MarketId:    sellOrder.MarketId,
+		SellOrderId: sellOrder.Id,
+		BuyOrderId:  buyOrderId,
+		Quantity:    quantity.String(),
+		UnitPrice:   sellOrder.AskAmount,
+	}
+}
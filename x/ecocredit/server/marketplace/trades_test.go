@@ -0,0 +1,121 @@
+package marketplace
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func TestTrades_BuyDirect(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, buyer := s.addrs[0], s.addrs[1]
+
+	blockTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.sdkCtx = s.sdkCtx.WithBlockTime(blockTime)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+
+	sellOrderIds := s.createSellOrder(&marketplace.MsgSell{
+		Seller: seller.String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: "10", AskPrice: &ask, DisableAutoRetire: true},
+		},
+	})
+
+	s.bankKeeper.EXPECT().GetBalance(gmAny, buyer, ask.Denom).Return(sdk.NewInt64Coin(ask.Denom, 100)).Times(1)
+	s.bankKeeper.EXPECT().SendCoins(gmAny, buyer, seller, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 100))).Return(nil).Times(1)
+	_, err := s.k.BuyDirect(s.ctx, &marketplace.MsgBuyDirect{
+		Buyer: buyer.String(),
+		Orders: []*marketplace.MsgBuyDirect_Order{
+			{SellOrderId: sellOrderIds[0], Quantity: "10", BidPrice: &ask, DisableAutoRetire: true},
+		},
+	})
+	assert.NilError(t, err)
+
+	// the trade is recorded after the sell order is removed
+	_, err = s.marketStore.SellOrderTable().Get(s.ctx, sellOrderIds[0])
+	assert.ErrorContains(t, err, "not found")
+
+	trade, err := s.marketStore.TradeTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []byte(buyer), trade.Buyer)
+	assert.DeepEqual(t, []byte(seller), trade.Seller)
+	assert.Equal(t, uint64(1), trade.BatchKey)
+	assert.Equal(t, uint64(1), trade.MarketId)
+	assert.Equal(t, sellOrderIds[0], trade.SellOrderId)
+	assert.Equal(t, uint64(0), trade.BuyOrderId)
+	assert.Equal(t, "10", trade.Quantity)
+	assert.Equal(t, ask.Amount.String(), trade.UnitPrice)
+	assert.Equal(t, blockTime.Unix(), trade.Timestamp.Seconds)
+
+	for _, addr := range []sdk.AccAddress{buyer, seller} {
+		found, err := s.marketStore.AddressTradeTable().Has(s.ctx, addr, 1)
+		assert.NilError(t, err)
+		assert.Check(t, found)
+	}
+}
+
+func TestTrades_FillBuyOrders(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, buyer := s.addrs[0], s.addrs[1]
+
+	sellOrderIds := s.createSellOrder(&marketplace.MsgSell{
+		Seller: seller.String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: "10", AskPrice: &ask},
+		},
+	})
+
+	bid := sdk.NewInt64Coin(ask.Denom, 12)
+	s.expectEscrow(buyer, 120)
+	buyRes, err := s.k.Buy(s.ctx, &marketplace.MsgBuy{
+		Buyer: buyer.String(),
+		Orders: []*marketplace.MsgBuy_Order{
+			{Filter: classFilter("C01", ""), Quantity: "10", BidPrice: &bid},
+		},
+	})
+	assert.NilError(t, err)
+
+	// the trade is recorded at the ask price
+	s.expectRelease(seller, 100)
+	s.expectRelease(buyer, 20)
+	assert.NilError(t, s.k.FillBuyOrders(s.ctx))
+
+	trade, err := s.marketStore.TradeTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, sellOrderIds[0], trade.SellOrderId)
+	assert.Equal(t, buyRes.BuyOrderIds[0], trade.BuyOrderId)
+	assert.Equal(t, "10", trade.Quantity)
+	assert.Equal(t, ask.Amount.String(), trade.UnitPrice)
+}
+
+// insertTrade inserts a trade of credits from the batch of the test sell setup in market 1.
+func (s *baseSuite) insertTrade(buyer, seller sdk.AccAddress, quantity, unitPrice string, timestamp time.Time) uint64 {
+	id, err := s.marketStore.TradeTable().InsertReturningID(s.ctx, &api.Trade{
+		Buyer:     buyer,
+		Seller:    seller,
+		BatchKey:  1,
+		MarketId:  1,
+		Quantity:  quantity,
+		UnitPrice: unitPrice,
+		Timestamp: timestamppb.New(timestamp),
+	})
+	assert.NilError(s.t, err)
+	for _, addr := range []sdk.AccAddress{buyer, seller} {
+		assert.NilError(s.t, s.marketStore.AddressTradeTable().Insert(s.ctx, &api.AddressTrade{
+			Address: addr,
+			TradeId: id,
+		}))
+	}
+	return id
+}
@@ -3,24 +3,206 @@ package marketplace
 import (
 	"context"
 
+	gogotypes "github.com/gogo/protobuf/types"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	queryv1beta1 "github.com/cosmos/cosmos-sdk/api/cosmos/base/query/v1beta1"
+
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	ecoApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types"
 	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
 	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
 )
 
-// isDenomAllowed checks if the denom is allowed to be used in orders.
-func isDenomAllowed(ctx context.Context, bankDenom string, table api.AllowedDenomTable) (bool, error) {
-	return table.Has(ctx, bankDenom)
+// isDenomAllowed checks if the denom is allowed to be used in orders of the credit type. The denom is
+// allowed if the denom was allowed by governance or if a market was registered for the credit type and
+// the denom. The denom is not allowed if the market of the credit type and the denom has been delisted.
+func (k Keeper) isDenomAllowed(ctx context.Context, creditTypeAbbrev, bankDenom string) (bool, error) {
+	market, err := k.stateStore.MarketTable().GetByCreditTypeAbbrevBankDenom(ctx, creditTypeAbbrev, bankDenom)
+	switch {
+	case err == nil:
+		if market.Delisted {
+			return false, nil
+		}
+		if len(market.Registrant) != 0 {
+			return true, nil
+		}
+	case !ormerrors.NotFound.Is(err):
+		return false, err
+	}
+
+	return k.stateStore.AllowedDenomTable().Has(ctx, bankDenom)
+}
+
+// sellOrderListOptions returns the options used to list sell orders. Private sell orders are filtered
+// out unless includePrivate is true, and sell orders that do not match all additional filters are
+// filtered out.
+func sellOrderListOptions(pg *queryv1beta1.PageRequest, includePrivate bool, filters ...func(*api.SellOrder) bool) []ormlist.Option {
+	if !includePrivate {
+		filters = append(filters, func(sellOrder *api.SellOrder) bool {
+			return !utils.IsPrivateSellOrder(sellOrder)
+		})
+	}
+
+	opts := []ormlist.Option{ormlist.Paginate(pg)}
+	if len(filters) != 0 {
+		// only one filter option can be set when listing
+		opts = append(opts, ormlist.Filter(func(msg proto.Message) bool {
+			for _, filter := range filters {
+				if !filter(msg.(*api.SellOrder)) {
+					return false
+				}
+			}
+			return true
+		}))
+	}
+	return opts
+}
+
+// getSellOrderBuyers returns the allowed buyers and the buyer group policy of the sell order as
+// bech32 addresses.
+func getSellOrderBuyers(sellOrder *api.SellOrder) (allowedBuyers []string, buyerGroupPolicy string) {
+	for _, buyer := range sellOrder.AllowedBuyers {
+		allowedBuyers = append(allowedBuyers, sdk.AccAddress(buyer).String())
+	}
+	if len(sellOrder.BuyerGroupPolicy) != 0 {
+		buyerGroupPolicy = sdk.AccAddress(sellOrder.BuyerGroupPolicy).String()
+	}
+	return allowedBuyers, buyerGroupPolicy
+}
+
+// setSellOrderKeys sets the fields of the sell order that are used internally for lookups of sell
+// orders by credit class, project, batch start date and ask price.
+func (k Keeper) setSellOrderKeys(ctx context.Context, sellOrder *api.SellOrder, batch *ecoApi.Batch) error {
+	return SetSellOrderKeys(ctx, k.coreStore, sellOrder, batch)
+}
+
+// SetSellOrderKeys sets the fields of the sell order that are used internally for lookups of sell
+// orders by credit class, project, batch start date and ask price. It is exported so that the keys
+// of sell orders created before the fields were introduced can be set in a state migration.
+func SetSellOrderKeys(ctx context.Context, coreStore ecoApi.StateStore, sellOrder *api.SellOrder, batch *ecoApi.Batch) error {
+	project, err := coreStore.ProjectTable().Get(ctx, batch.ProjectKey)
+	if err != nil {
+		return err
+	}
+
+	askAmountKey, err := getAskAmountKey(sellOrder.AskAmount)
+	if err != nil {
+		return err
+	}
+
+	sellOrder.ClassKey = project.ClassKey
+	sellOrder.ProjectKey = project.Key
+	sellOrder.BatchStartDate = batch.StartDate
+	sellOrder.AskAmountKey = askAmountKey
+
+	return nil
+}
+
+// getAskAmountKey returns the minimal big-endian encoding of the ask amount. Non-terminal bytes fields
+// are length-prefixed in ORM index keys, so the encoding sorts sell orders by ask amount.
+func getAskAmountKey(askAmount string) ([]byte, error) {
+	amount, ok := sdk.NewIntFromString(askAmount)
+	if !ok || amount.IsNegative() {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid ask amount: %s", askAmount)
+	}
+	return amount.BigInt().Bytes(), nil
+}
+
+// listSellOrdersByBatchStartDate lists sell orders with an index that starts with the prefix and is followed
+// by the batch start date. Only sell orders with a batch start date within the optional min and max dates
+// (inclusive) are listed.
+func (k Keeper) listSellOrdersByBatchStartDate(
+	ctx context.Context,
+	prefix api.SellOrderIndexKey,
+	withDate func(*timestamppb.Timestamp) api.SellOrderIndexKey,
+	min, max *gogotypes.Timestamp,
+	opts ...ormlist.Option,
+) (api.SellOrderIterator, error) {
+	minDate, maxDate := types.GogoToProtobufTimestamp(min), types.GogoToProtobufTimestamp(max)
+
+	if minDate != nil && maxDate != nil {
+		switch {
+		case minDate.AsTime().After(maxDate.AsTime()):
+			return api.SellOrderIterator{}, sdkerrors.ErrInvalidRequest.Wrapf(
+				"min batch start date %s must not be after max batch start date %s",
+				minDate.AsTime(), maxDate.AsTime(),
+			)
+		case minDate.AsTime().Equal(maxDate.AsTime()):
+			// range iteration requires different start and end keys
+			return k.stateStore.SellOrderTable().List(ctx, withDate(minDate), opts...)
+		}
+	}
+
+	if minDate == nil && maxDate == nil {
+		return k.stateStore.SellOrderTable().List(ctx, prefix, opts...)
+	}
+
+	start, end := prefix, prefix
+	if minDate != nil {
+		start = withDate(minDate)
+	}
+	if maxDate != nil {
+		end = withDate(maxDate)
+	}
+
+	return k.stateStore.SellOrderTable().ListRange(ctx, start, end, opts...)
+}
+
+// assertSellOrderFill checks that purchasing the quantity from the sell order satisfies the minimum fill
+// quantity and the all-or-none requirement of the sell order. Purchasing the entire remaining quantity of
+// the sell order always satisfies both.
+func assertSellOrderFill(sellOrder *api.SellOrder, purchaseQty math.Dec) error {
+	sellOrderQty, err := math.NewDecFromString(sellOrder.Quantity)
+	if err != nil {
+		return err
+	}
+
+	if purchaseQty.Cmp(sellOrderQty) != math.LessThan {
+		return nil
+	}
+
+	if sellOrder.AllOrNone {
+		return sdkerrors.ErrInvalidRequest.Wrapf(
+			"requested quantity: %v, sell order quantity: %s, sell order with id %d is all-or-none",
+			purchaseQty, sellOrder.Quantity, sellOrder.Id,
+		)
+	}
+
+	if len(sellOrder.MinFillQuantity) == 0 {
+		return nil
+	}
+
+	minFillQty, err := math.NewDecFromString(sellOrder.MinFillQuantity)
+	if err != nil {
+		return err
+	}
+
+	if purchaseQty.Cmp(minFillQty) == math.LessThan {
+		return sdkerrors.ErrInvalidRequest.Wrapf(
+			"requested quantity: %v, min fill quantity: %s", purchaseQty, sellOrder.MinFillQuantity,
+		)
+	}
+
+	return nil
 }
 
 type orderOptions struct {
-	autoRetire   bool
-	batchDenom   string
-	jurisdiction string
+	autoRetire      bool
+	batchDenom      string
+	jurisdiction    string
+	beneficiary     sdk.AccAddress
+	beneficiaryName string
+	reason          string
 }
 
 // fillOrder moves credits and coins according to the order. It will:
@@ -28,10 +210,45 @@ type orderOptions struct {
 // - remove the purchaseQty from the seller's escrowed balance.
 // - add credits to the buyer's tradable/retired address (based on the DisableAutoRetire field).
 // - update the supply accordingly.
-// - send the coins specified in the bid to the seller.
+// - send the cost minus the maker fee to the seller.
+// - collect the maker fee and the taker fee from the buyer.
 func (k Keeper) fillOrder(ctx context.Context, orderIndex string, sellOrder *api.SellOrder, buyerAcc sdk.AccAddress, purchaseQty math.Dec,
-	cost sdk.Coin, opts orderOptions) error {
+	cost sdk.Coin, makerFee, takerFee sdk.Int, market *api.Market, opts orderOptions) error {
+	if err := k.transferCredits(ctx, orderIndex, sellOrder, buyerAcc, purchaseQty, opts); err != nil {
+		return err
+	}
+
+	return k.payForCredits(ctx, buyerAcc, sellOrder.Seller, cost, makerFee, takerFee, market)
+}
+
+// payForCredits sends the cost minus the maker fee from the buyer to the seller and collects the maker fee
+// and the taker fee from the buyer.
+func (k Keeper) payForCredits(ctx context.Context, buyerAcc, seller sdk.AccAddress, cost sdk.Coin,
+	makerFee, takerFee sdk.Int, market *api.Market) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	proceeds := cost.Amount.Sub(makerFee)
+	if proceeds.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(cost.Denom, proceeds))
+		if err := k.bankKeeper.SendCoins(sdkCtx, buyerAcc, seller, coins); err != nil {
+			return err
+		}
+	}
+
+	fees := makerFee.Add(takerFee)
+	if !fees.IsPositive() {
+		return nil
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(cost.Denom, fees))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(sdkCtx, buyerAcc, ecocredit.ModuleName, coins); err != nil {
+		return err
+	}
+	return k.collectFees(ctx, market, fees)
+}
+
+// transferCredits moves credits from the seller's escrowed balance to the buyer's tradable or retired balance and
+// updates or removes the sell order. Payment for the credits is handled by the caller.
+func (k Keeper) transferCredits(ctx context.Context, orderIndex string, sellOrder *api.SellOrder, buyerAcc sdk.AccAddress,
+	purchaseQty math.Dec, opts orderOptions) error {
 	sellOrderQty, err := math.NewDecFromString(sellOrder.Quantity)
 	if err != nil {
 		return err
@@ -47,6 +264,9 @@ func (k Keeper) fillOrder(ctx context.Context, orderIndex string, sellOrder *api
 		if err := k.stateStore.SellOrderTable().Delete(ctx, sellOrder); err != nil {
 			return err
 		}
+		if err := k.orderBook.OnDeleteSellOrder(ctx, sellOrder); err != nil {
+			return err
+		}
 	case math.GreaterThan:
 		newSellOrderQty, err := sellOrderQty.Sub(purchaseQty)
 		if err != nil {
@@ -58,8 +278,15 @@ func (k Keeper) fillOrder(ctx context.Context, orderIndex string, sellOrder *api
 		}
 	}
 
+	return k.transferEscrowedCredits(ctx, sellOrder.Seller, buyerAcc, sellOrder.BatchKey, purchaseQty, opts)
+}
+
+// transferEscrowedCredits moves credits from the seller's escrowed balance to the buyer's tradable or retired
+// balance.
+func (k Keeper) transferEscrowedCredits(ctx context.Context, seller, buyerAcc sdk.AccAddress, batchKey uint64,
+	purchaseQty math.Dec, opts orderOptions) error {
 	// remove the credits from the seller's escrowed balance
-	sellerBal, err := k.coreStore.BatchBalanceTable().Get(ctx, sellOrder.Seller, sellOrder.BatchKey)
+	sellerBal, err := k.coreStore.BatchBalanceTable().Get(ctx, seller, batchKey)
 	if err != nil {
 		return err
 	}
@@ -76,12 +303,20 @@ func (k Keeper) fillOrder(ctx context.Context, orderIndex string, sellOrder *api
 		return err
 	}
 
-	// update the buyers balance and the batch supply
-	supply, err := k.coreStore.BatchSupplyTable().Get(ctx, sellOrder.BatchKey)
+	return k.depositCredits(ctx, seller, buyerAcc, batchKey, purchaseQty, opts)
+}
+
+// depositCredits adds credits sold by the seller to the buyer's tradable or retired balance and updates
+// the batch supply. The credits must already be removed from the seller's balance by the caller.
+func (k Keeper) depositCredits(ctx context.Context, seller, buyerAcc sdk.AccAddress, batchKey uint64,
+	purchaseQty math.Dec, opts orderOptions) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	supply, err := k.coreStore.BatchSupplyTable().Get(ctx, batchKey)
 	if err != nil {
 		return err
 	}
-	buyerBal, err := utils.GetBalance(ctx, k.coreStore.BatchBalanceTable(), buyerAcc, sellOrder.BatchKey)
+	buyerBal, err := utils.GetBalance(ctx, k.coreStore.BatchBalanceTable(), buyerAcc, batchKey)
 	if err != nil {
 		return err
 	}
@@ -98,7 +333,7 @@ func (k Keeper) fillOrder(ctx context.Context, orderIndex string, sellOrder *api
 		}
 		buyerBal.TradableAmount = tradableBalance.String()
 		if err = sdkCtx.EventManager().EmitTypedEvent(&core.EventTransfer{
-			Sender:         sdk.AccAddress(sellOrder.Seller).String(),
+			Sender:         seller.String(),
 			Recipient:      buyerAcc.String(),
 			BatchDenom:     opts.batchDenom,
 			TradableAmount: purchaseQty.String(),
@@ -107,6 +342,13 @@ func (k Keeper) fillOrder(ctx context.Context, orderIndex string, sellOrder *api
 			return err
 		}
 	} else {
+		// if a beneficiary is set, the retired credits are attributed to the beneficiary rather than the buyer
+		if len(opts.beneficiary) != 0 && !opts.beneficiary.Equals(buyerAcc) {
+			buyerBal, err = utils.GetBalance(ctx, k.coreStore.BatchBalanceTable(), opts.beneficiary, batchKey)
+			if err != nil {
+				return err
+			}
+		}
 		retiredBalance, err := math.NewDecFromString(buyerBal.RetiredAmount)
 		if err != nil {
 			return err
@@ -139,20 +381,41 @@ func (k Keeper) fillOrder(ctx context.Context, orderIndex string, sellOrder *api
 		if err = k.coreStore.BatchSupplyTable().Update(ctx, supply); err != nil {
 			return err
 		}
+		batch, err := k.coreStore.BatchTable().Get(ctx, batchKey)
+		if err != nil {
+			return err
+		}
+		retirementId, err := utils.InsertRetirement(ctx, k.coreStore.RetirementTable(), &ecoApi.Retirement{
+			Owner:              buyerAcc,
+			BatchKey:           batch.Key,
+			ProjectKey:         batch.ProjectKey,
+			Amount:             purchaseQty.String(),
+			Jurisdiction:       opts.jurisdiction,
+			Reason:             opts.reason,
+			BeneficiaryAddress: opts.beneficiary,
+			BeneficiaryName:    opts.beneficiaryName,
+		})
+		if err != nil {
+			return err
+		}
+		var beneficiaryAddress string
+		if len(opts.beneficiary) != 0 {
+			beneficiaryAddress = opts.beneficiary.String()
+		}
 		if err = sdkCtx.EventManager().EmitTypedEvent(&core.EventRetire{
-			Owner:        buyerAcc.String(),
-			BatchDenom:   opts.batchDenom,
-			Amount:       purchaseQty.String(),
-			Jurisdiction: opts.jurisdiction,
+			Owner:              buyerAcc.String(),
+			BatchDenom:         opts.batchDenom,
+			Amount:             purchaseQty.String(),
+			Jurisdiction:       opts.jurisdiction,
+			Reason:             opts.reason,
+			RetirementId:       retirementId,
+			BeneficiaryAddress: beneficiaryAddress,
+			BeneficiaryName:    opts.beneficiaryName,
 		}); err != nil {
 			return err
 		}
 	}
-	if err = k.coreStore.BatchBalanceTable().Save(ctx, buyerBal); err != nil {
-		return err
-	}
-
-	return k.bankKeeper.SendCoins(sdkCtx, buyerAcc, sellOrder.Seller, sdk.NewCoins(cost))
+	return k.coreStore.BatchBalanceTable().Save(ctx, buyerBal)
 }
 
 // getTotalCost calculates the cost of the order by multiplying the price per credit, and the amount of credits
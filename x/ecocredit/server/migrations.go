@@ -4,8 +4,23 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v3 "github.com/regen-network/regen-ledger/x/ecocredit/migrations/v3"
+	v4 "github.com/regen-network/regen-ledger/x/ecocredit/migrations/v4"
 )
 
-func (s serverImpl) RunMigrations(ctx sdk.Context, cdc codec.Codec) error {
-	return v3.MigrateState(ctx, s.storeKey, cdc, s.stateStore, s.basketStore, s.paramSpace)
+// RunMigrations runs the state migrations of the ecocredit module that have not
+// been applied at the given consensus version of the module.
+func (s serverImpl) RunMigrations(ctx sdk.Context, cdc codec.Codec, fromVersion uint64) error {
+	if fromVersion < 2 {
+		if err := v3.MigrateState(ctx, s.storeKey, cdc, s.stateStore, s.basketStore, s.paramSpace); err != nil {
+			return err
+		}
+	}
+
+	if fromVersion < 3 {
+		if err := v4.MigrateState(ctx, s.stateStore, s.marketStore, s.orderBook); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
@@ -0,0 +1,33 @@
+package server
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+	"github.com/regen-network/regen-ledger/x/ecocredit/migrations/precision"
+)
+
+// UpdateCreditTypePrecision raises the precision of a credit type. Existing
+// credit quantities and basket exponents are left unchanged; see
+// precision.MigrateCreditType.
+func (s serverImpl) UpdateCreditTypePrecision(ctx sdk.Context, proposal *core.CreditTypePrecisionProposal) error {
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	creditType, err := s.stateStore.CreditTypeTable().Get(goCtx, proposal.CreditTypeAbbrev)
+	if err != nil {
+		return sdkerrors.ErrNotFound.Wrapf("credit type with abbreviation %s: %s", proposal.CreditTypeAbbrev, err)
+	}
+	previousPrecision := creditType.Precision
+
+	err = precision.MigrateCreditType(goCtx, s.stateStore, proposal.CreditTypeAbbrev, proposal.Precision)
+	if err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&core.EventUpdateCreditTypePrecision{
+		Abbreviation:      proposal.CreditTypeAbbrev,
+		PreviousPrecision: previousPrecision,
+		Precision:         proposal.Precision,
+	})
+}
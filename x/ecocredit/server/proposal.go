@@ -13,12 +13,34 @@ import (
 type ProposalKeeper interface {
 	AddCreditType(ctx sdk.Context, ctp *core.CreditTypeProposal) error
 	AllowDenom(ctx sdk.Context, proposal *marketplace.AllowDenomProposal) error
+	BatchFreeze(ctx sdk.Context, proposal *core.BatchFreezeProposal) error
+	UpdateCreditTypePrecision(ctx sdk.Context, proposal *core.CreditTypePrecisionProposal) error
+	SetFeeSchedule(ctx sdk.Context, proposal *marketplace.FeeScheduleProposal) error
+	SetFeeDestination(ctx sdk.Context, proposal *marketplace.FeeDestinationProposal) error
+	SetMarketRegistration(ctx sdk.Context, proposal *marketplace.MarketRegistrationProposal) error
+	DelistMarket(ctx sdk.Context, proposal *marketplace.DelistMarketProposal) error
 }
 
 func (s serverImpl) AllowDenom(ctx sdk.Context, proposal *marketplace.AllowDenomProposal) error {
 	return s.marketplaceKeeper.AllowDenom(ctx, proposal)
 }
 
+func (s serverImpl) SetFeeSchedule(ctx sdk.Context, proposal *marketplace.FeeScheduleProposal) error {
+	return s.marketplaceKeeper.SetFeeSchedule(ctx, proposal)
+}
+
+func (s serverImpl) SetFeeDestination(ctx sdk.Context, proposal *marketplace.FeeDestinationProposal) error {
+	return s.marketplaceKeeper.SetFeeDestination(ctx, proposal)
+}
+
+func (s serverImpl) SetMarketRegistration(ctx sdk.Context, proposal *marketplace.MarketRegistrationProposal) error {
+	return s.marketplaceKeeper.SetMarketRegistration(ctx, proposal)
+}
+
+func (s serverImpl) DelistMarket(ctx sdk.Context, proposal *marketplace.DelistMarketProposal) error {
+	return s.marketplaceKeeper.DelistMarket(ctx, proposal)
+}
+
 func (s serverImpl) AddCreditType(ctx sdk.Context, ctp *core.CreditTypeProposal) error {
 	return s.coreKeeper.AddCreditType(ctx, ctp)
 }
@@ -30,6 +52,18 @@ func NewProposalHandler(k ProposalKeeper) govtypes.Handler {
 			return handleAddCreditTypeProposal(ctx, k, c)
 		case *marketplace.AllowDenomProposal:
 			return handleAllowDenomProposal(ctx, k, c)
+		case *core.BatchFreezeProposal:
+			return handleBatchFreezeProposal(ctx, k, c)
+		case *core.CreditTypePrecisionProposal:
+			return handleCreditTypePrecisionProposal(ctx, k, c)
+		case *marketplace.FeeScheduleProposal:
+			return handleFeeScheduleProposal(ctx, k, c)
+		case *marketplace.FeeDestinationProposal:
+			return handleFeeDestinationProposal(ctx, k, c)
+		case *marketplace.MarketRegistrationProposal:
+			return handleMarketRegistrationProposal(ctx, k, c)
+		case *marketplace.DelistMarketProposal:
+			return handleDelistMarketProposal(ctx, k, c)
 		default:
 			return sdkerrors.ErrUnknownRequest.Wrapf("unrecognized proposal content type: %T", c)
 		}
@@ -43,3 +77,27 @@ func handleAllowDenomProposal(ctx sdk.Context, k ProposalKeeper, proposal *marke
 func handleAddCreditTypeProposal(ctx sdk.Context, k ProposalKeeper, proposal *core.CreditTypeProposal) error {
 	return k.AddCreditType(ctx, proposal)
 }
+
+func handleBatchFreezeProposal(ctx sdk.Context, k ProposalKeeper, proposal *core.BatchFreezeProposal) error {
+	return k.BatchFreeze(ctx, proposal)
+}
+
+func handleCreditTypePrecisionProposal(ctx sdk.Context, k ProposalKeeper, proposal *core.CreditTypePrecisionProposal) error {
+	return k.UpdateCreditTypePrecision(ctx, proposal)
+}
+
+func handleFeeScheduleProposal(ctx sdk.Context, k ProposalKeeper, proposal *marketplace.FeeScheduleProposal) error {
+	return k.SetFeeSchedule(ctx, proposal)
+}
+
+func handleFeeDestinationProposal(ctx sdk.Context, k ProposalKeeper, proposal *marketplace.FeeDestinationProposal) error {
+	return k.SetFeeDestination(ctx, proposal)
+}
+
+func handleMarketRegistrationProposal(ctx sdk.Context, k ProposalKeeper, proposal *marketplace.MarketRegistrationProposal) error {
+	return k.SetMarketRegistration(ctx, proposal)
+}
+
+func handleDelistMarketProposal(ctx sdk.Context, k ProposalKeeper, proposal *marketplace.DelistMarketProposal) error {
+	return k.DelistMarket(ctx, proposal)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 	"github.com/regen-network/regen-ledger/x/ecocredit/basket"
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
@@ -35,9 +36,10 @@ func setup(t *testing.T) baseSuite {
 	ctrl := gomock.NewController(t)
 	accountKeeper := mocks.NewMockAccountKeeper(ctrl)
 	bankKeeper := mocks.NewMockBankKeeper(ctrl)
+	distrKeeper := mocks.NewMockDistributionKeeper(ctrl)
 	accountKeeper.EXPECT().GetModuleAddress(ecocredit.ModuleName).Return(sdk.AccAddress{}).Times(1)
 	accountKeeper.EXPECT().GetModuleAddress(basket.BasketSubModuleName).Return(sdk.AccAddress{}).Times(1)
-	s.server = newServer(storeKey, paramtypes.Subspace{}, accountKeeper, bankKeeper)
+	s.server = newServer(storeKey, paramtypes.Subspace{}, accountKeeper, bankKeeper, distrKeeper, nil)
 	db := dbm.NewMemDB()
 	cms := store.NewCommitMultiStore(db)
 	cms.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, db)
@@ -91,6 +93,106 @@ func TestProposal_AllowedDenom(t *testing.T) {
 	assert.DeepEqual(t, proposal.Denom, res.AllowedDenoms[0])
 }
 
+func TestProposal_BatchFreeze(t *testing.T) {
+	t.Parallel()
+	s := setup(t)
+	handler := NewProposalHandler(s.server)
+	batchDenom := "C01-001-20200101-20210101-001"
+	assert.NilError(t, s.server.stateStore.BatchTable().Insert(s.ctx, &api.Batch{
+		ProjectKey: 1,
+		Denom:      batchDenom,
+	}))
+	proposal := core.BatchFreezeProposal{
+		Title:       "freeze batch",
+		Description: "i would like to freeze a credit batch",
+		BatchDenom:  batchDenom,
+		Freeze:      true,
+		Evidence:    "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf",
+		Reason:      "double counting",
+	}
+	err := handler(s.sdkCtx, &proposal)
+	assert.NilError(t, err)
+	batch, err := s.server.stateStore.BatchTable().GetByDenom(s.ctx, batchDenom)
+	assert.NilError(t, err)
+	assert.Check(t, batch.Frozen)
+
+	proposal.Freeze = false
+	err = handler(s.sdkCtx, &proposal)
+	assert.NilError(t, err)
+	batch, err = s.server.stateStore.BatchTable().GetByDenom(s.ctx, batchDenom)
+	assert.NilError(t, err)
+	assert.Check(t, !batch.Frozen)
+}
+
+func TestProposal_CreditTypePrecision(t *testing.T) {
+	t.Parallel()
+	s := setup(t)
+	handler := NewProposalHandler(s.server)
+	assert.NilError(t, s.server.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: "C",
+		Name:         "carbon",
+		Unit:         "metric ton CO2 equivalent",
+		Precision:    6,
+	}))
+	proposal := core.CreditTypePrecisionProposal{
+		Title:            "carbon precision",
+		Description:      "i would like to raise the precision of carbon credits",
+		CreditTypeAbbrev: "C",
+		Precision:        9,
+	}
+	err := handler(s.sdkCtx, &proposal)
+	assert.NilError(t, err)
+	creditType, err := s.server.stateStore.CreditTypeTable().Get(s.ctx, "C")
+	assert.NilError(t, err)
+	assert.Equal(t, uint32(9), creditType.Precision)
+
+	proposal.Precision = 8
+	err = handler(s.sdkCtx, &proposal)
+	assert.ErrorContains(t, err, "precision 8 must be greater than current precision 9")
+}
+
+func TestProposal_FeeSchedule(t *testing.T) {
+	t.Parallel()
+	s := setup(t)
+	handler := NewProposalHandler(s.server)
+	assert.NilError(t, s.server.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: "C",
+		Name:         "carbon",
+		Unit:         "metric ton CO2 equivalent",
+		Precision:    6,
+	}))
+	proposal := marketplace.FeeScheduleProposal{
+		Title:            "carbon fees",
+		Description:      "i would like to set fees for carbon credit markets",
+		CreditTypeAbbrev: "C",
+		BankDenom:        "uregen",
+		MakerFeeBps:      50,
+		TakerFeeBps:      100,
+	}
+	err := handler(s.sdkCtx, &proposal)
+	assert.NilError(t, err)
+	res, err := s.server.marketplaceKeeper.MarketFees(s.ctx, &marketplace.QueryMarketFeesRequest{MarketId: 1})
+	assert.NilError(t, err)
+	assert.Equal(t, uint32(50), res.MakerFeeBps)
+	assert.Equal(t, uint32(100), res.TakerFeeBps)
+}
+
+func TestProposal_FeeDestination(t *testing.T) {
+	t.Parallel()
+	s := setup(t)
+	handler := NewProposalHandler(s.server)
+	proposal := marketplace.FeeDestinationProposal{
+		Title:       "burn fees",
+		Description: "i would like to burn marketplace fees",
+		Destination: marketplace.FeeDestinationType_FEE_DESTINATION_TYPE_BURN,
+	}
+	err := handler(s.sdkCtx, &proposal)
+	assert.NilError(t, err)
+	res, err := s.server.marketplaceKeeper.FeeDestination(s.ctx, &marketplace.QueryFeeDestinationRequest{})
+	assert.NilError(t, err)
+	assert.Equal(t, marketplace.FeeDestinationType_FEE_DESTINATION_TYPE_BURN, res.Destination)
+}
+
 func TestProposal_Invalid(t *testing.T) {
 	t.Parallel()
 	s := setup(t)
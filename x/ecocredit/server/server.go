@@ -16,6 +16,7 @@ import (
 	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket"
 	coretypes "github.com/regen-network/regen-ledger/x/ecocredit/core"
 	marketplacetypes "github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+	"github.com/regen-network/regen-ledger/x/ecocredit/orderbook"
 	"github.com/regen-network/regen-ledger/x/ecocredit/server/basket"
 	"github.com/regen-network/regen-ledger/x/ecocredit/server/core"
 	"github.com/regen-network/regen-ledger/x/ecocredit/server/marketplace"
@@ -27,23 +28,30 @@ type serverImpl struct {
 	paramSpace    paramtypes.Subspace
 	bankKeeper    ecocredit.BankKeeper
 	accountKeeper ecocredit.AccountKeeper
+	distrKeeper   ecocredit.DistributionKeeper
+	dataKeeper    ecocredit.DataKeeper
 
 	coreKeeper        core.Keeper
 	basketKeeper      basket.Keeper
 	marketplaceKeeper marketplace.Keeper
 
+	orderBook   orderbook.OrderBook
 	db          ormdb.ModuleDB
 	stateStore  api.StateStore
 	basketStore basketapi.StateStore
+	marketStore marketApi.StateStore
 }
 
 func newServer(storeKey sdk.StoreKey, paramSpace paramtypes.Subspace,
-	accountKeeper ecocredit.AccountKeeper, bankKeeper ecocredit.BankKeeper) serverImpl {
+	accountKeeper ecocredit.AccountKeeper, bankKeeper ecocredit.BankKeeper,
+	distrKeeper ecocredit.DistributionKeeper, dataKeeper ecocredit.DataKeeper) serverImpl {
 	s := serverImpl{
 		storeKey:      storeKey,
 		paramSpace:    paramSpace,
 		bankKeeper:    bankKeeper,
 		accountKeeper: accountKeeper,
+		distrKeeper:   distrKeeper,
+		dataKeeper:    dataKeeper,
 	}
 
 	// ensure ecocredit module account is set
@@ -67,9 +75,15 @@ func newServer(storeKey sdk.StoreKey, paramSpace paramtypes.Subspace,
 	coreStore, basketStore, marketStore := getStateStores(s.db)
 	s.stateStore = coreStore
 	s.basketStore = basketStore
+	s.marketStore = marketStore
 	s.coreKeeper = core.NewKeeper(coreStore, bankKeeper, s.paramSpace, coreAddr)
-	s.basketKeeper = basket.NewKeeper(basketStore, coreStore, bankKeeper, s.paramSpace, basketAddr)
-	s.marketplaceKeeper = marketplace.NewKeeper(marketStore, coreStore, bankKeeper, s.paramSpace)
+	s.basketKeeper = basket.NewKeeper(basketStore, coreStore, bankKeeper, s.paramSpace, dataKeeper, basketAddr)
+
+	s.orderBook, err = orderbook.NewOrderBook(s.db)
+	if err != nil {
+		panic(err)
+	}
+	s.marketplaceKeeper = marketplace.NewKeeper(marketStore, coreStore, bankKeeper, distrKeeper, s.paramSpace, s.orderBook)
 
 	return s
 }
@@ -95,10 +109,16 @@ func RegisterServices(
 	paramSpace paramtypes.Subspace,
 	accountKeeper ecocredit.AccountKeeper,
 	bankKeeper ecocredit.BankKeeper,
+	distrKeeper ecocredit.DistributionKeeper,
+	dataKeeper ecocredit.DataKeeper,
 ) Keeper {
-	impl := newServer(configurator.ModuleKey(), paramSpace, accountKeeper, bankKeeper)
+	impl := newServer(configurator.ModuleKey(), paramSpace, accountKeeper, bankKeeper, distrKeeper, dataKeeper)
 
-	coretypes.RegisterMsgServer(configurator.MsgServer(), impl.coreKeeper)
+	coretypes.RegisterMsgServer(configurator.MsgServer(), coreMsgServer{
+		Keeper:            impl.coreKeeper,
+		marketplaceKeeper: impl.marketplaceKeeper,
+		stateStore:        impl.stateStore,
+	})
 	coretypes.RegisterQueryServer(configurator.QueryServer(), impl.coreKeeper)
 
 	baskettypes.RegisterMsgServer(configurator.MsgServer(), impl.basketKeeper)
@@ -9,6 +9,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -58,10 +59,12 @@ func setup(t *testing.T) (*server.FixtureFactory, paramstypes.Subspace, bankkeep
 
 	authSubspace := paramstypes.NewSubspace(cdc, amino, paramsKey, tkey, authtypes.ModuleName)
 	bankSubspace := paramstypes.NewSubspace(cdc, amino, paramsKey, tkey, banktypes.ModuleName)
+	distSubspace := paramstypes.NewSubspace(cdc, amino, paramsKey, tkey, disttypes.ModuleName)
 	ecocreditSubspace := paramstypes.NewSubspace(cdc, amino, paramsKey, tkey, ecocredittypes.ModuleName)
 
 	maccPerms := map[string][]string{
 		minttypes.ModuleName:       {authtypes.Minter},
+		disttypes.ModuleName:       nil,
 		ecocredittypes.ModuleName:  {authtypes.Burner},
 		basket.BasketSubModuleName: {authtypes.Burner, authtypes.Minter},
 	}
@@ -74,7 +77,11 @@ func setup(t *testing.T) (*server.FixtureFactory, paramstypes.Subspace, bankkeep
 		cdc, bankKey, accountKeeper, bankSubspace, nil,
 	)
 
-	ecocreditModule := ecocredit.NewModule(ecocreditSubspace, accountKeeper, bankKeeper)
+	distrKeeper := distrkeeper.NewKeeper(
+		cdc, distKey, distSubspace, accountKeeper, bankKeeper, nil, authtypes.FeeCollectorName, nil,
+	)
+
+	ecocreditModule := ecocredit.NewModule(ecocreditSubspace, accountKeeper, bankKeeper, distrKeeper, nil)
 	ff.SetModules([]module.Module{ecocreditModule})
 
 	return ff, ecocreditSubspace, bankKeeper, accountKeeper
@@ -233,6 +233,8 @@ func (s *bridgeSuite) ExpectEventBridgeWithValues(a gocuke.DocString) {
 					require.Equal(s.t, expected.Contract, val)
 				case "amount":
 					require.Equal(s.t, expected.Amount, val)
+				case "request_id":
+					require.Equal(s.t, strconv.FormatUint(expected.RequestId, 10), val)
 				default:
 					require.Fail(s.t, "invalid attribute")
 				}
@@ -379,7 +379,8 @@ Feature: Bridge Integration
       "target": "polygon",
       "recipient": "0x1000000000000000000000000000000000000000",
       "contract": "0x0000000000000000000000000000000000000001",
-      "amount": "200"
+      "amount": "200",
+      "request_id": 1
     }
     """
     And expect batch supply with batch denom "C01-001-20200101-20210101-001"
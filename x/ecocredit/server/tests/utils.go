@@ -7,6 +7,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -39,10 +40,12 @@ func NewEcocreditModule(ff *server.FixtureFactory) *ecocredit.Module {
 
 	authSubspace := paramstypes.NewSubspace(cdc, amino, paramsKey, tkey, authtypes.ModuleName)
 	bankSubspace := paramstypes.NewSubspace(cdc, amino, paramsKey, tkey, banktypes.ModuleName)
+	distSubspace := paramstypes.NewSubspace(cdc, amino, paramsKey, tkey, disttypes.ModuleName)
 	ecocreditSubspace := paramstypes.NewSubspace(cdc, amino, paramsKey, tkey, ecocredittypes.ModuleName)
 
 	maccPerms := map[string][]string{
 		minttypes.ModuleName:       {authtypes.Minter},
+		disttypes.ModuleName:       nil,
 		ecocredittypes.ModuleName:  {authtypes.Burner},
 		basket.BasketSubModuleName: {authtypes.Burner, authtypes.Minter},
 	}
@@ -55,5 +58,9 @@ func NewEcocreditModule(ff *server.FixtureFactory) *ecocredit.Module {
 		cdc, bankKey, accountKeeper, bankSubspace, nil,
 	)
 
-	return ecocredit.NewModule(ecocreditSubspace, accountKeeper, bankKeeper)
+	distrKeeper := distrkeeper.NewKeeper(
+		cdc, distKey, distSubspace, accountKeeper, bankKeeper, nil, authtypes.FeeCollectorName, nil,
+	)
+
+	return ecocredit.NewModule(ecocreditSubspace, accountKeeper, bankKeeper, distrKeeper, nil)
 }
@@ -106,6 +106,18 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 	exported := s.exportGenesisState(s.genesisCtx)
 	require.NotNil(exported)
 
+	// projects imported without a status default to active
+	var exportedProjects []interface{}
+	err = json.Unmarshal(exported[string(proto.MessageName(&api.Project{}))], &exportedProjects)
+	require.NoError(err)
+	var count int
+	for _, row := range exportedProjects {
+		if project, ok := row.(map[string]interface{}); ok {
+			require.Equal("PROJECT_STATUS_ACTIVE", project["status"])
+			count++
+		}
+	}
+	require.Equal(2, count)
 }
 
 func (s *GenesisTestSuite) exportGenesisState(ctx types.Context) map[string]json.RawMessage {
@@ -1,14 +1,20 @@
 package utils
 
 import (
+	"bytes"
 	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 	"github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	marketApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
 )
 
@@ -22,6 +28,46 @@ func GetCreditTypeFromBatchDenom(ctx context.Context, store api.StateStore, deno
 	return store.CreditTypeTable().Get(ctx, classInfo.CreditTypeAbbrev)
 }
 
+// AssertBatchNotFrozen returns ErrFrozenBatch if the credit batch is frozen.
+func AssertBatchNotFrozen(batch *api.Batch) error {
+	if batch.Frozen {
+		return ecocredit.ErrFrozenBatch.Wrap(batch.Denom)
+	}
+	return nil
+}
+
+// IsPrivateSellOrder returns true if the sell order is restricted to an allowlist of buyers
+// or a buyer group policy.
+func IsPrivateSellOrder(sellOrder *marketApi.SellOrder) bool {
+	return len(sellOrder.AllowedBuyers) != 0 || len(sellOrder.BuyerGroupPolicy) != 0
+}
+
+// IsBuyerAllowed returns true if the buyer is allowed to buy credits from the sell order. Any
+// buyer is allowed to buy credits from a sell order that is not private.
+func IsBuyerAllowed(sellOrder *marketApi.SellOrder, buyer types.AccAddress) bool {
+	if !IsPrivateSellOrder(sellOrder) {
+		return true
+	}
+
+	if bytes.Equal(sellOrder.BuyerGroupPolicy, buyer) {
+		return true
+	}
+
+	for _, allowed := range sellOrder.AllowedBuyers {
+		if bytes.Equal(allowed, buyer) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsSellOrderExpired returns true if the sell order has an expiration that is not after the block time.
+// Expired sell orders may remain in state until they are pruned.
+func IsSellOrderExpired(sellOrder *marketApi.SellOrder, blockTime time.Time) bool {
+	return sellOrder.Expiration != nil && !sellOrder.Expiration.AsTime().After(blockTime)
+}
+
 // GetNonNegativeFixedDecs takes an arbitrary amount of decimal strings, and returns their corresponding fixed decimals
 // in a slice.
 func GetNonNegativeFixedDecs(precision uint32, decimals ...string) ([]math.Dec, error) {
@@ -36,6 +82,23 @@ func GetNonNegativeFixedDecs(precision uint32, decimals ...string) ([]math.Dec,
 	return decs, nil
 }
 
+// TruncateDec truncates the decimal to the given number of decimal places.
+func TruncateDec(dec math.Dec, precision uint32) (math.Dec, error) {
+	unit := math.NewDecFinite(1, -int32(precision))
+	units, err := dec.QuoInteger(unit)
+	if err != nil {
+		return math.Dec{}, err
+	}
+
+	dec, err = units.Mul(unit)
+	if err != nil {
+		return math.Dec{}, err
+	}
+
+	dec, _ = dec.Reduce()
+	return dec, nil
+}
+
 // GetBalance gets the balance from the account, returning a default, zero value balance if no balance is found.
 // NOTE: the default value is not inserted into the balance table in the `not found` case. Calling Update when the default
 // value is returned will cause an error. The `Save` method should be used when dealing with balances from this function.
@@ -55,3 +118,12 @@ func GetBalance(ctx context.Context, table api.BatchBalanceTable, addr types.Acc
 	}
 	return bal, nil
 }
+
+// InsertRetirement sets the block height and timestamp of the retirement record to those of the current block and
+// inserts the retirement record into the retirement table, returning the id of the new retirement record.
+func InsertRetirement(ctx context.Context, table api.RetirementTable, retirement *api.Retirement) (uint64, error) {
+	sdkCtx := types.UnwrapSDKContext(ctx)
+	retirement.BlockHeight = sdkCtx.BlockHeight()
+	retirement.Timestamp = timestamppb.New(sdkCtx.BlockTime())
+	return table.InsertReturningID(ctx, retirement)
+}
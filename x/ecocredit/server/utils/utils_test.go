@@ -18,7 +18,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	marketApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 )
 
@@ -91,6 +93,27 @@ func TestUtils_GetNonNegativeFixedDecs(t *testing.T) {
 	assert.ErrorContains(t, err, "10.432 exceeds maximum decimal places: 2")
 }
 
+func TestUtils_TruncateDec(t *testing.T) {
+	t.Parallel()
+	dec, err := math.NewDecFromString("10.123456789")
+	assert.NilError(t, err)
+
+	truncated, err := TruncateDec(dec, 6)
+	assert.NilError(t, err)
+	assert.Equal(t, "10.123456", truncated.String())
+
+	truncated, err = TruncateDec(dec, 0)
+	assert.NilError(t, err)
+	assert.Equal(t, "10", truncated.String())
+
+	// trailing zeros are removed
+	dec, err = math.NewDecFromString("2.500000")
+	assert.NilError(t, err)
+	truncated, err = TruncateDec(dec, 6)
+	assert.NilError(t, err)
+	assert.Equal(t, "2.5", truncated.String())
+}
+
 func TestUtils_GetCreditTypeFromBatchDenom(t *testing.T) {
 	t.Parallel()
 	s := setupBase(t)
@@ -116,3 +139,29 @@ func TestUtils_GetCreditTypeFromBatchDenom(t *testing.T) {
 	ct, err = GetCreditTypeFromBatchDenom(s.ctx, s.stateStore, invalidDenom)
 	assert.ErrorContains(t, err, "could not get class with ID C02")
 }
+
+func TestUtils_IsBuyerAllowed(t *testing.T) {
+	t.Parallel()
+	buyer := sdk.AccAddress("buyer")
+	policy := sdk.AccAddress("policy")
+	other := sdk.AccAddress("other")
+
+	public := &marketApi.SellOrder{}
+	assert.Check(t, !IsPrivateSellOrder(public))
+	assert.Check(t, IsBuyerAllowed(public, other))
+
+	allowlist := &marketApi.SellOrder{AllowedBuyers: [][]byte{buyer}}
+	assert.Check(t, IsPrivateSellOrder(allowlist))
+	assert.Check(t, IsBuyerAllowed(allowlist, buyer))
+	assert.Check(t, !IsBuyerAllowed(allowlist, other))
+
+	group := &marketApi.SellOrder{BuyerGroupPolicy: policy}
+	assert.Check(t, IsPrivateSellOrder(group))
+	assert.Check(t, IsBuyerAllowed(group, policy))
+	assert.Check(t, !IsBuyerAllowed(group, buyer))
+
+	both := &marketApi.SellOrder{AllowedBuyers: [][]byte{buyer}, BuyerGroupPolicy: policy}
+	assert.Check(t, IsBuyerAllowed(both, buyer))
+	assert.Check(t, IsBuyerAllowed(both, policy))
+	assert.Check(t, !IsBuyerAllowed(both, other))
+}
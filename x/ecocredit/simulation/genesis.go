@@ -90,7 +90,7 @@ func genCreditTypes(r *rand.Rand) []*core.CreditType {
 			Name:         "biodiversity",
 			Abbreviation: "BIO",
 			Unit:         "ton",
-			Precision:    6, // TODO: randomize precision, precision is currently locked to 6
+			Precision:    core.DefaultCreditTypePrecision,
 		},
 	}
 }
@@ -370,6 +370,7 @@ func genGenesisState(ctx context.Context, r *rand.Rand, simState *module.Simulat
 	pKey1, err := createProject(ctx, ss, &api.Project{
 		ClassKey:     cKey1,
 		Id:           "C01-001",
+		Status:       api.ProjectStatus_PROJECT_STATUS_ACTIVE,
 		Admin:        accs[0].Address,
 		Jurisdiction: "AQ",
 		Metadata:     metadata,
@@ -381,6 +382,7 @@ func genGenesisState(ctx context.Context, r *rand.Rand, simState *module.Simulat
 	pKey2, err := createProject(ctx, ss, &api.Project{
 		ClassKey:     cKey2,
 		Id:           "C02-001",
+		Status:       api.ProjectStatus_PROJECT_STATUS_ACTIVE,
 		Admin:        accs[1].Address,
 		Jurisdiction: "AQ",
 		Metadata:     metadata,